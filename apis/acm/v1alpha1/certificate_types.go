@@ -20,6 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -75,6 +77,17 @@ type CertificateExternalStatus struct {
 	// "_a79865eb4cd1a6ab990a45779b4e0b96.yourdomain.com", only
 	// "_a79865eb4cd1a6ab990a45779b4e0b96" must be used.
 	ResourceRecord *ResourceRecord `json:"resourceRecord,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An CertificateStatus represents the observed state of an Certificate manager.
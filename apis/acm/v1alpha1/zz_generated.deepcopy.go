@@ -24,6 +24,7 @@ package v1alpha1
 import (
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -62,6 +63,11 @@ func (in *CertificateExternalStatus) DeepCopyInto(out *CertificateExternalStatus
 		*out = new(ResourceRecord)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExternalStatus.
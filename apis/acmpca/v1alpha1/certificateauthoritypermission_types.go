@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CertificateAuthorityPermissionSpec defines the desired state of CertificateAuthorityPermission
@@ -27,9 +29,24 @@ type CertificateAuthorityPermissionSpec struct {
 	ForProvider       CertificateAuthorityPermissionParameters `json:"forProvider"`
 }
 
+// CertificateAuthorityPermissionObservation keeps the state for the external resource.
+type CertificateAuthorityPermissionObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
 // An CertificateAuthorityPermissionStatus represents the observed state of an Certificate Authority Permission manager.
 type CertificateAuthorityPermissionStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CertificateAuthorityPermissionObservation `json:"atProvider,omitempty"`
 }
 
 // CertificateAuthorityPermissionParameters defines the desired state of an AWS CertificateAuthority.
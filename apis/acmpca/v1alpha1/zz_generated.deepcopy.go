@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -72,6 +73,11 @@ func (in *CertificateAuthorityConfiguration) DeepCopy() *CertificateAuthorityCon
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateAuthorityExternalStatus) DeepCopyInto(out *CertificateAuthorityExternalStatus) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityExternalStatus.
@@ -211,6 +217,26 @@ func (in *CertificateAuthorityPermissionList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateAuthorityPermissionObservation) DeepCopyInto(out *CertificateAuthorityPermissionObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityPermissionObservation.
+func (in *CertificateAuthorityPermissionObservation) DeepCopy() *CertificateAuthorityPermissionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateAuthorityPermissionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateAuthorityPermissionParameters) DeepCopyInto(out *CertificateAuthorityPermissionParameters) {
 	*out = *in
@@ -272,6 +298,7 @@ func (in *CertificateAuthorityPermissionSpec) DeepCopy() *CertificateAuthorityPe
 func (in *CertificateAuthorityPermissionStatus) DeepCopyInto(out *CertificateAuthorityPermissionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityPermissionStatus.
@@ -305,7 +332,7 @@ func (in *CertificateAuthoritySpec) DeepCopy() *CertificateAuthoritySpec {
 func (in *CertificateAuthorityStatus) DeepCopyInto(out *CertificateAuthorityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityStatus.
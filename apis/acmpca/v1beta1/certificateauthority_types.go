@@ -20,6 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CertificateAuthorityParameters defines the desired state of an AWS CertificateAuthority.
@@ -175,6 +177,17 @@ type CertificateAuthorityExternalStatus struct {
 
 	// Status is the current status of the CertificateAuthority.
 	Status string `json:"status,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // CertificateAuthoritySpec defines the desired state of CertificateAuthority
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// APIKeyParameters define the desired state of an AWS API Gateway API key.
+type APIKeyParameters struct {
+	// Region is which region the APIKey will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the API key.
+	Name string `json:"name"`
+
+	// Description of the API key.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Enabled specifies whether clients can use the API key. Defaults to
+	// true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// CustomerID is an AWS Marketplace customer identifier, used when
+	// integrating with the AWS SaaS Marketplace.
+	// +optional
+	CustomerID *string `json:"customerId,omitempty"`
+
+	// ValueSecretRef references the secret key that holds the value of the
+	// API key. API Gateway generates a value if none is given.
+	// +immutable
+	// +optional
+	ValueSecretRef *xpv1.SecretKeySelector `json:"valueSecretRef,omitempty"`
+
+	// Tags to attach to the API key. Tags are only applied when the API key
+	// is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// APIKeyObservation keeps the state for the external resource
+type APIKeyObservation struct {
+	// CreatedDate is when the API key was created.
+	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
+
+	// LastUpdatedDate is when the API key was last updated.
+	LastUpdatedDate *metav1.Time `json:"lastUpdatedDate,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An APIKeySpec defines the desired state of an APIKey.
+type APIKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       APIKeyParameters `json:"forProvider"`
+}
+
+// An APIKeyStatus represents the observed state of an APIKey.
+type APIKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          APIKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An APIKey is a managed resource that represents an AWS API Gateway API key
+// that clients of REST APIs identify themselves with. The value of the key
+// is published to the connection secret.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type APIKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   APIKeySpec   `json:"spec"`
+	Status APIKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// APIKeyList contains a list of APIKeys
+type APIKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []APIKey `json:"items"`
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AuthorizerParameters define the desired state of an AWS API Gateway
+// authorizer of a REST API.
+type AuthorizerParameters struct {
+	// Region is which region the Authorizer will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// RestAPIID is the ID of the REST API the authorizer belongs to.
+	// +immutable
+	RestAPIID string `json:"restApiId"`
+
+	// Name of the authorizer.
+	Name string `json:"name"`
+
+	// Type of the authorizer. TOKEN and REQUEST authorizers invoke a Lambda
+	// function, COGNITO_USER_POOLS authorizers validate tokens of Cognito
+	// user pools.
+	// +kubebuilder:validation:Enum=TOKEN;REQUEST;COGNITO_USER_POOLS
+	Type string `json:"type"`
+
+	// FunctionARN is the ARN of the Lambda function that TOKEN and REQUEST
+	// authorizers invoke.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	FunctionARN *string `json:"functionArn,omitempty"`
+
+	// FunctionARNRef references a Lambda Function to retrieve its ARN.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionArnRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Lambda Function to
+	// retrieve its ARN.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionArnSelector,omitempty"`
+
+	// ProviderARNs are the ARNs of the Cognito user pools that
+	// COGNITO_USER_POOLS authorizers accept tokens of.
+	// +optional
+	ProviderARNs []string `json:"providerArns,omitempty"`
+
+	// AuthorizerCredentials is the ARN of the IAM role API Gateway assumes
+	// to invoke the Lambda function. API Gateway uses resource-based
+	// permissions of the function if none is given.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	AuthorizerCredentials *string `json:"authorizerCredentials,omitempty"`
+
+	// AuthorizerCredentialsRef references an IAM Role to retrieve its ARN.
+	// +optional
+	AuthorizerCredentialsRef *xpv1.Reference `json:"authorizerCredentialsRef,omitempty"`
+
+	// AuthorizerCredentialsSelector selects a reference to an IAM Role to
+	// retrieve its ARN.
+	// +optional
+	AuthorizerCredentialsSelector *xpv1.Selector `json:"authorizerCredentialsSelector,omitempty"`
+
+	// IdentitySource is where the identity of requests is taken from, e.g.
+	// method.request.header.Authorization.
+	// +optional
+	IdentitySource *string `json:"identitySource,omitempty"`
+
+	// IdentityValidationExpression is a regular expression the identity of
+	// TOKEN authorizers is validated against before the Lambda function is
+	// invoked.
+	// +optional
+	IdentityValidationExpression *string `json:"identityValidationExpression,omitempty"`
+
+	// AuthorizerResultTTLInSeconds is how long the results of the
+	// authorizer are cached. Defaults to 300, 0 disables caching.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +optional
+	AuthorizerResultTTLInSeconds *int64 `json:"authorizerResultTtlInSeconds,omitempty"`
+}
+
+// AuthorizerObservation keeps the state for the external resource
+type AuthorizerObservation struct {
+	// AuthorizerURI is the URI API Gateway invokes the Lambda function
+	// with.
+	AuthorizerURI string `json:"authorizerUri,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AuthorizerSpec defines the desired state of an Authorizer.
+type AuthorizerSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AuthorizerParameters `json:"forProvider"`
+}
+
+// An AuthorizerStatus represents the observed state of an Authorizer.
+type AuthorizerStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AuthorizerObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Authorizer is a managed resource that represents an AWS API Gateway
+// custom authorizer of a REST API.
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Authorizer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AuthorizerSpec   `json:"spec"`
+	Status AuthorizerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthorizerList contains a list of Authorizers
+type AuthorizerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Authorizer `json:"items"`
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "apigateway.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// APIKey type metadata.
+var (
+	APIKeyKind             = reflect.TypeOf(APIKey{}).Name()
+	APIKeyGroupKind        = schema.GroupKind{Group: Group, Kind: APIKeyKind}.String()
+	APIKeyKindAPIVersion   = APIKeyKind + "." + SchemeGroupVersion.String()
+	APIKeyGroupVersionKind = SchemeGroupVersion.WithKind(APIKeyKind)
+)
+
+// UsagePlan type metadata.
+var (
+	UsagePlanKind             = reflect.TypeOf(UsagePlan{}).Name()
+	UsagePlanGroupKind        = schema.GroupKind{Group: Group, Kind: UsagePlanKind}.String()
+	UsagePlanKindAPIVersion   = UsagePlanKind + "." + SchemeGroupVersion.String()
+	UsagePlanGroupVersionKind = SchemeGroupVersion.WithKind(UsagePlanKind)
+)
+
+// UsagePlanKey type metadata.
+var (
+	UsagePlanKeyKind             = reflect.TypeOf(UsagePlanKey{}).Name()
+	UsagePlanKeyGroupKind        = schema.GroupKind{Group: Group, Kind: UsagePlanKeyKind}.String()
+	UsagePlanKeyKindAPIVersion   = UsagePlanKeyKind + "." + SchemeGroupVersion.String()
+	UsagePlanKeyGroupVersionKind = SchemeGroupVersion.WithKind(UsagePlanKeyKind)
+)
+
+// Authorizer type metadata.
+var (
+	AuthorizerKind             = reflect.TypeOf(Authorizer{}).Name()
+	AuthorizerGroupKind        = schema.GroupKind{Group: Group, Kind: AuthorizerKind}.String()
+	AuthorizerKindAPIVersion   = AuthorizerKind + "." + SchemeGroupVersion.String()
+	AuthorizerGroupVersionKind = SchemeGroupVersion.WithKind(AuthorizerKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&APIKey{}, &APIKeyList{})
+	SchemeBuilder.Register(&UsagePlan{}, &UsagePlanList{})
+	SchemeBuilder.Register(&UsagePlanKey{}, &UsagePlanKeyList{})
+	SchemeBuilder.Register(&Authorizer{}, &AuthorizerList{})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ThrottleSettings limit the rate of requests to an API.
+type ThrottleSettings struct {
+	// BurstLimit is the maximum number of requests that can be served at
+	// once.
+	// +optional
+	BurstLimit *int64 `json:"burstLimit,omitempty"`
+
+	// RateLimit is the steady-state number of requests per second.
+	// +optional
+	RateLimit *float64 `json:"rateLimit,omitempty"`
+}
+
+// QuotaSettings limit the number of requests to an API in a time period.
+type QuotaSettings struct {
+	// Limit is the maximum number of requests that can be made in the
+	// period.
+	Limit int64 `json:"limit"`
+
+	// Offset is the number of requests subtracted from the limit in the
+	// first period.
+	// +optional
+	Offset *int64 `json:"offset,omitempty"`
+
+	// Period the limit applies to.
+	// +kubebuilder:validation:Enum=DAY;WEEK;MONTH
+	Period string `json:"period"`
+}
+
+// APIStage is a stage of a REST API the usage plan applies to.
+type APIStage struct {
+	// APIID is the ID of the REST API.
+	APIID string `json:"apiId"`
+
+	// Stage is the name of the stage.
+	Stage string `json:"stage"`
+
+	// Throttle limits the rate of requests to individual methods of the
+	// stage. Methods are keyed by their resource path and HTTP method, e.g.
+	// /pets/GET.
+	// +optional
+	Throttle map[string]ThrottleSettings `json:"throttle,omitempty"`
+}
+
+// UsagePlanParameters define the desired state of an AWS API Gateway usage
+// plan.
+type UsagePlanParameters struct {
+	// Region is which region the UsagePlan will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the usage plan.
+	Name string `json:"name"`
+
+	// Description of the usage plan.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// APIStages are the stages of REST APIs the usage plan applies to.
+	// +optional
+	APIStages []APIStage `json:"apiStages,omitempty"`
+
+	// Throttle limits the rate of requests of each API key of the usage
+	// plan.
+	// +optional
+	Throttle *ThrottleSettings `json:"throttle,omitempty"`
+
+	// Quota limits the number of requests of each API key of the usage
+	// plan.
+	// +optional
+	Quota *QuotaSettings `json:"quota,omitempty"`
+
+	// Tags to attach to the usage plan. Tags are only applied when the
+	// usage plan is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// UsagePlanObservation keeps the state for the external resource
+type UsagePlanObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A UsagePlanSpec defines the desired state of a UsagePlan.
+type UsagePlanSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UsagePlanParameters `json:"forProvider"`
+}
+
+// A UsagePlanStatus represents the observed state of a UsagePlan.
+type UsagePlanStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UsagePlanObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UsagePlan is a managed resource that represents an AWS API Gateway usage
+// plan, which throttles and limits the requests API keys make to stages of
+// REST APIs.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type UsagePlan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsagePlanSpec   `json:"spec"`
+	Status UsagePlanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UsagePlanList contains a list of UsagePlans
+type UsagePlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UsagePlan `json:"items"`
+}
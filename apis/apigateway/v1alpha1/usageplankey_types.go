@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// UsagePlanKeyParameters define the desired state of an AWS API Gateway
+// usage plan key.
+type UsagePlanKeyParameters struct {
+	// Region is which region the UsagePlanKey will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// UsagePlanID is the ID of the usage plan the API key is added to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=UsagePlan
+	UsagePlanID *string `json:"usagePlanId,omitempty"`
+
+	// UsagePlanIDRef references a UsagePlan to retrieve its ID.
+	// +optional
+	UsagePlanIDRef *xpv1.Reference `json:"usagePlanIdRef,omitempty"`
+
+	// UsagePlanIDSelector selects a reference to a UsagePlan to retrieve its
+	// ID.
+	// +optional
+	UsagePlanIDSelector *xpv1.Selector `json:"usagePlanIdSelector,omitempty"`
+
+	// KeyID is the ID of the API key added to the usage plan.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=APIKey
+	KeyID *string `json:"keyId,omitempty"`
+
+	// KeyIDRef references an APIKey to retrieve its ID.
+	// +optional
+	KeyIDRef *xpv1.Reference `json:"keyIdRef,omitempty"`
+
+	// KeyIDSelector selects a reference to an APIKey to retrieve its ID.
+	// +optional
+	KeyIDSelector *xpv1.Selector `json:"keyIdSelector,omitempty"`
+}
+
+// UsagePlanKeyObservation keeps the state for the external resource
+type UsagePlanKeyObservation struct {
+	// Name of the API key.
+	Name string `json:"name,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A UsagePlanKeySpec defines the desired state of a UsagePlanKey.
+type UsagePlanKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       UsagePlanKeyParameters `json:"forProvider"`
+}
+
+// A UsagePlanKeyStatus represents the observed state of a UsagePlanKey.
+type UsagePlanKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          UsagePlanKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A UsagePlanKey is a managed resource that represents an API key added to
+// an AWS API Gateway usage plan.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type UsagePlanKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   UsagePlanKeySpec   `json:"spec"`
+	Status UsagePlanKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// UsagePlanKeyList contains a list of UsagePlanKeys
+type UsagePlanKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UsagePlanKey `json:"items"`
+}
@@ -72,11 +72,13 @@ type APIObservation struct {
 
 	APIGatewayManaged *bool `json:"apiGatewayManaged,omitempty"`
 
-	APIID *string `json:"apiID,omitempty"`
+	APIID           *string `json:"apiID,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
-	ImportInfo []*string `json:"importInfo,omitempty"`
+	ImportInfo    []*string      `json:"importInfo,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 
 	Warnings []*string `json:"warnings,omitempty"`
 }
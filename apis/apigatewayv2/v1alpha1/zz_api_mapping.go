@@ -44,7 +44,9 @@ type APIMappingSpec struct {
 type APIMappingObservation struct {
 	APIID *string `json:"apiID,omitempty"`
 
-	APIMappingID *string `json:"apiMappingID,omitempty"`
+	APIMappingID    *string        `json:"apiMappingID,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 
 	Stage *string `json:"stage,omitempty"`
 }
@@ -63,7 +63,9 @@ type AuthorizerSpec struct {
 
 // AuthorizerObservation defines the observed state of Authorizer
 type AuthorizerObservation struct {
-	AuthorizerID *string `json:"authorizerID,omitempty"`
+	AuthorizerID    *string        `json:"authorizerID,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // AuthorizerStatus defines the observed state of Authorizer.
@@ -44,7 +44,8 @@ type DeploymentSpec struct {
 
 // DeploymentObservation defines the observed state of Deployment
 type DeploymentObservation struct {
-	AutoDeployed *bool `json:"autoDeployed,omitempty"`
+	AutoDeployed    *bool  `json:"autoDeployed,omitempty"`
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
@@ -52,7 +53,8 @@ type DeploymentObservation struct {
 
 	DeploymentStatus *string `json:"deploymentStatus,omitempty"`
 
-	DeploymentStatusMessage *string `json:"deploymentStatusMessage,omitempty"`
+	DeploymentStatusMessage *string        `json:"deploymentStatusMessage,omitempty"`
+	LastOperation           *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // DeploymentStatus defines the observed state of Deployment.
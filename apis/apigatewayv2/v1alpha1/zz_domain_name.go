@@ -47,8 +47,10 @@ type DomainNameSpec struct {
 // DomainNameObservation defines the observed state of DomainName
 type DomainNameObservation struct {
 	APIMappingSelectionExpression *string `json:"apiMappingSelectionExpression,omitempty"`
+	CallerAccountID               string  `json:"callerAccountID,omitempty"`
 
-	DomainName *string `json:"domainName,omitempty"`
+	DomainName    *string        `json:"domainName,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // DomainNameStatus defines the observed state of DomainName.
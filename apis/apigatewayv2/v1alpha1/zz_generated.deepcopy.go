@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -157,6 +158,11 @@ func (in *APIMappingObservation) DeepCopyInto(out *APIMappingObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Stage != nil {
 		in, out := &in.Stage, &out.Stage
 		*out = new(string)
@@ -297,6 +303,11 @@ func (in *APIObservation) DeepCopyInto(out *APIObservation) {
 			}
 		}
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Warnings != nil {
 		in, out := &in.Warnings, &out.Warnings
 		*out = make([]*string, len(*in))
@@ -653,6 +664,11 @@ func (in *AuthorizerObservation) DeepCopyInto(out *AuthorizerObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizerObservation.
@@ -1434,6 +1450,11 @@ func (in *DeploymentObservation) DeepCopyInto(out *DeploymentObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentObservation.
@@ -1686,6 +1707,11 @@ func (in *DomainNameObservation) DeepCopyInto(out *DomainNameObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainNameObservation.
@@ -1927,6 +1953,11 @@ func (in *IntegrationObservation) DeepCopyInto(out *IntegrationObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationObservation.
@@ -2122,6 +2153,11 @@ func (in *IntegrationResponseObservation) DeepCopyInto(out *IntegrationResponseO
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntegrationResponseObservation.
@@ -2576,6 +2612,11 @@ func (in *ModelList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ModelObservation) DeepCopyInto(out *ModelObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ModelID != nil {
 		in, out := &in.ModelID, &out.ModelID
 		*out = new(string)
@@ -2897,6 +2938,11 @@ func (in *RouteObservation) DeepCopyInto(out *RouteObservation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RouteID != nil {
 		in, out := &in.RouteID, &out.RouteID
 		*out = new(string)
@@ -3073,6 +3119,11 @@ func (in *RouteResponseList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteResponseObservation) DeepCopyInto(out *RouteResponseObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RouteResponseID != nil {
 		in, out := &in.RouteResponseID, &out.RouteResponseID
 		*out = new(string)
@@ -3496,6 +3547,11 @@ func (in *StageObservation) DeepCopyInto(out *StageObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LastUpdatedDate != nil {
 		in, out := &in.LastUpdatedDate, &out.LastUpdatedDate
 		*out = (*in).DeepCopy()
@@ -3903,6 +3959,11 @@ func (in *VPCLinkObservation) DeepCopyInto(out *VPCLinkObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCLinkObservation.
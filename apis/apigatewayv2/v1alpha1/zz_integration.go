@@ -73,11 +73,13 @@ type IntegrationSpec struct {
 
 // IntegrationObservation defines the observed state of Integration
 type IntegrationObservation struct {
-	APIGatewayManaged *bool `json:"apiGatewayManaged,omitempty"`
+	APIGatewayManaged *bool  `json:"apiGatewayManaged,omitempty"`
+	CallerAccountID   string `json:"callerAccountID,omitempty"`
 
 	IntegrationID *string `json:"integrationID,omitempty"`
 
-	IntegrationResponseSelectionExpression *string `json:"integrationResponseSelectionExpression,omitempty"`
+	IntegrationResponseSelectionExpression *string        `json:"integrationResponseSelectionExpression,omitempty"`
+	LastOperation                          *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // IntegrationStatus defines the observed state of Integration.
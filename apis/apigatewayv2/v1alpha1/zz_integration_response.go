@@ -51,7 +51,9 @@ type IntegrationResponseSpec struct {
 
 // IntegrationResponseObservation defines the observed state of IntegrationResponse
 type IntegrationResponseObservation struct {
-	IntegrationResponseID *string `json:"integrationResponseID,omitempty"`
+	CallerAccountID       string         `json:"callerAccountID,omitempty"`
+	IntegrationResponseID *string        `json:"integrationResponseID,omitempty"`
+	LastOperation         *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // IntegrationResponseStatus defines the observed state of IntegrationResponse.
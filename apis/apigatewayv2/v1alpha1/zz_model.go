@@ -50,7 +50,9 @@ type ModelSpec struct {
 
 // ModelObservation defines the observed state of Model
 type ModelObservation struct {
-	ModelID *string `json:"modelID,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
+	ModelID         *string        `json:"modelID,omitempty"`
 }
 
 // ModelStatus defines the observed state of Model.
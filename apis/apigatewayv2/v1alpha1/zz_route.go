@@ -63,7 +63,9 @@ type RouteSpec struct {
 
 // RouteObservation defines the observed state of Route
 type RouteObservation struct {
-	APIGatewayManaged *bool `json:"apiGatewayManaged,omitempty"`
+	APIGatewayManaged *bool          `json:"apiGatewayManaged,omitempty"`
+	CallerAccountID   string         `json:"callerAccountID,omitempty"`
+	LastOperation     *LastOperation `json:"lastOperation,omitempty"`
 
 	RouteID *string `json:"routeID,omitempty"`
 }
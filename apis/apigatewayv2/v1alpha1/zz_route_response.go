@@ -49,7 +49,9 @@ type RouteResponseSpec struct {
 
 // RouteResponseObservation defines the observed state of RouteResponse
 type RouteResponseObservation struct {
-	RouteResponseID *string `json:"routeResponseID,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
+	RouteResponseID *string        `json:"routeResponseID,omitempty"`
 }
 
 // RouteResponseStatus defines the observed state of RouteResponse.
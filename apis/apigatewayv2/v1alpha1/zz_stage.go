@@ -58,11 +58,13 @@ type StageSpec struct {
 
 // StageObservation defines the observed state of Stage
 type StageObservation struct {
-	APIGatewayManaged *bool `json:"apiGatewayManaged,omitempty"`
+	APIGatewayManaged *bool  `json:"apiGatewayManaged,omitempty"`
+	CallerAccountID   string `json:"callerAccountID,omitempty"`
 
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
 
-	LastDeploymentStatusMessage *string `json:"lastDeploymentStatusMessage,omitempty"`
+	LastDeploymentStatusMessage *string        `json:"lastDeploymentStatusMessage,omitempty"`
+	LastOperation               *LastOperation `json:"lastOperation,omitempty"`
 
 	LastUpdatedDate *metav1.Time `json:"lastUpdatedDate,omitempty"`
 
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1beta1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomVPCLinkParameters includes the custom fields.
 type CustomVPCLinkParameters struct {
@@ -52,3 +56,8 @@ type CustomVPCLinkParameters struct {
 	// +optional
 	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -45,19 +45,20 @@ type VPCLinkSpec struct {
 
 // VPCLinkObservation defines the observed state of VPCLink
 type VPCLinkObservation struct {
-	
-	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
-	
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	CreatedDate     *metav1.Time   `json:"createdDate,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
+
 	SecurityGroupIDs []*string `json:"securityGroupIDs,omitempty"`
-	
+
 	SubnetIDs []*string `json:"subnetIDs,omitempty"`
-	
+
 	VPCLinkID *string `json:"vpcLinkID,omitempty"`
-	
+
 	VPCLinkStatus *string `json:"vpcLinkStatus,omitempty"`
-	
+
 	VPCLinkStatusMessage *string `json:"vpcLinkStatusMessage,omitempty"`
-	
+
 	VPCLinkVersion *string `json:"vpcLinkVersion,omitempty"`
 }
 
@@ -13,5 +13,27 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
 // CustomWorkGroupParameters contains the additional fields for WorkGroupParameters.
-type CustomWorkGroupParameters struct{}
+type CustomWorkGroupParameters struct {
+	// ResultConfigurationBucketRef is a reference to an S3 Bucket used to
+	// set Configuration.ResultConfiguration.OutputLocation to the root of
+	// the bucket.
+	// +optional
+	ResultConfigurationBucketRef *xpv1.Reference `json:"resultConfigurationBucketRef,omitempty"`
+
+	// ResultConfigurationBucketSelector selects a reference to an S3 Bucket
+	// used to set Configuration.ResultConfiguration.OutputLocation.
+	// +optional
+	ResultConfigurationBucketSelector *xpv1.Selector `json:"resultConfigurationBucketSelector,omitempty"`
+}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
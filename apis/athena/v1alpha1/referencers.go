@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// bucketOutputLocation returns the root of the referenced bucket as an S3
+// URI.
+func bucketOutputLocation() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		name := meta.GetExternalName(mg)
+		if name == "" {
+			return ""
+		}
+		return "s3://" + name + "/"
+	}
+}
+
+// ResolveReferences of this WorkGroup
+func (mg *WorkGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.configuration.resultConfiguration.outputLocation
+	var current *string
+	if mg.Spec.ForProvider.Configuration != nil && mg.Spec.ForProvider.Configuration.ResultConfiguration != nil {
+		current = mg.Spec.ForProvider.Configuration.ResultConfiguration.OutputLocation
+	}
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(current),
+		Reference:    mg.Spec.ForProvider.ResultConfigurationBucketRef,
+		Selector:     mg.Spec.ForProvider.ResultConfigurationBucketSelector,
+		To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+		Extract:      bucketOutputLocation(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.configuration.resultConfiguration.outputLocation")
+	}
+	if rsp.ResolvedValue != "" {
+		if mg.Spec.ForProvider.Configuration == nil {
+			mg.Spec.ForProvider.Configuration = &WorkGroupConfiguration{}
+		}
+		if mg.Spec.ForProvider.Configuration.ResultConfiguration == nil {
+			mg.Spec.ForProvider.Configuration.ResultConfiguration = &ResultConfiguration{}
+		}
+		mg.Spec.ForProvider.Configuration.ResultConfiguration.OutputLocation = reference.ToPtrValue(rsp.ResolvedValue)
+	}
+	mg.Spec.ForProvider.ResultConfigurationBucketRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -22,6 +22,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -93,6 +95,16 @@ func (in *ColumnInfo) DeepCopy() *ColumnInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomWorkGroupParameters) DeepCopyInto(out *CustomWorkGroupParameters) {
 	*out = *in
+	if in.ResultConfigurationBucketRef != nil {
+		in, out := &in.ResultConfigurationBucketRef, &out.ResultConfigurationBucketRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResultConfigurationBucketSelector != nil {
+		in, out := &in.ResultConfigurationBucketSelector, &out.ResultConfigurationBucketSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomWorkGroupParameters.
@@ -583,6 +595,11 @@ func (in *WorkGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkGroupObservation) DeepCopyInto(out *WorkGroupObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupObservation.
@@ -619,7 +636,7 @@ func (in *WorkGroupParameters) DeepCopyInto(out *WorkGroupParameters) {
 			}
 		}
 	}
-	out.CustomWorkGroupParameters = in.CustomWorkGroupParameters
+	in.CustomWorkGroupParameters.DeepCopyInto(&out.CustomWorkGroupParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupParameters.
@@ -653,7 +670,7 @@ func (in *WorkGroupSpec) DeepCopy() *WorkGroupSpec {
 func (in *WorkGroupStatus) DeepCopyInto(out *WorkGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkGroupStatus.
@@ -52,6 +52,8 @@ type WorkGroupSpec struct {
 
 // WorkGroupObservation defines the observed state of WorkGroup
 type WorkGroupObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // WorkGroupStatus defines the observed state of WorkGroup.
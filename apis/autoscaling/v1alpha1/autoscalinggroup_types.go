@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// A ScalingProcess is a process of an Auto Scaling group that can be
+// suspended.
+// +kubebuilder:validation:Enum=Launch;Terminate;AddToLoadBalancer;AlarmNotification;AZRebalance;HealthCheck;InstanceRefresh;ReplaceUnhealthy;ScheduledActions
+type ScalingProcess string
+
+// AutoScalingGroupParameters define the desired state of an EC2 Auto Scaling
+// group.
+type AutoScalingGroupParameters struct {
+	// Region is which region the AutoScalingGroup will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// LaunchTemplate the instances of the group are launched from.
+	LaunchTemplate LaunchTemplateSpecification `json:"launchTemplate"`
+
+	// MinSize is the minimum number of instances in the group.
+	// +kubebuilder:validation:Minimum=0
+	MinSize int64 `json:"minSize"`
+
+	// MaxSize is the maximum number of instances in the group.
+	// +kubebuilder:validation:Minimum=0
+	MaxSize int64 `json:"maxSize"`
+
+	// DesiredCapacity is the number of instances the group should run. It
+	// must be between MinSize and MaxSize. If unset, the group starts with
+	// MinSize instances and its desired capacity is not managed.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DesiredCapacity *int64 `json:"desiredCapacity,omitempty"`
+
+	// IgnoreDesiredCapacity makes DesiredCapacity only apply when the group
+	// is created, so that changes of the desired capacity made by scaling
+	// policies or the Kubernetes cluster-autoscaler are not reverted.
+	// +optional
+	IgnoreDesiredCapacity bool `json:"ignoreDesiredCapacity,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the instances are launched in.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// TargetGroupARNs are the ARNs of the load balancer target groups the
+	// instances are registered with.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/elbv2/v1alpha1.TargetGroup
+	// +crossplane:generate:reference:refFieldName=TargetGroupARNRefs
+	// +crossplane:generate:reference:selectorFieldName=TargetGroupARNSelector
+	TargetGroupARNs []string `json:"targetGroupArns,omitempty"`
+
+	// TargetGroupARNRefs are references to TargetGroups used to set the
+	// TargetGroupARNs.
+	// +optional
+	TargetGroupARNRefs []xpv1.Reference `json:"targetGroupArnRefs,omitempty"`
+
+	// TargetGroupARNSelector selects references to TargetGroups used to set
+	// the TargetGroupARNs.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// HealthCheckType is the service whose health checks decide whether an
+	// instance is replaced. Defaults to EC2.
+	// +kubebuilder:validation:Enum=EC2;ELB
+	// +optional
+	HealthCheckType *string `json:"healthCheckType,omitempty"`
+
+	// HealthCheckGracePeriod is the time, in seconds, health checks of a new
+	// instance are ignored for.
+	// +optional
+	HealthCheckGracePeriod *int64 `json:"healthCheckGracePeriod,omitempty"`
+
+	// SuspendedProcesses are the processes of the group that are suspended.
+	// All other processes are resumed, including ones suspended outside of
+	// Crossplane.
+	// +optional
+	SuspendedProcesses []ScalingProcess `json:"suspendedProcesses,omitempty"`
+
+	// InstanceRefresh configures the instance refresh that replaces the
+	// instances of the group when they do not run the desired version of the
+	// launch template, e.g. because a LaunchTemplate with a $Latest or
+	// $Default version got a new version. Instances are not replaced if it
+	// is unset.
+	// +optional
+	InstanceRefresh *InstanceRefreshPolicy `json:"instanceRefresh,omitempty"`
+
+	// Tags to attach to the group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// LaunchTemplateSpecification selects the launch template of an Auto Scaling
+// group.
+type LaunchTemplateSpecification struct {
+	// LaunchTemplateName is the name of the launch template.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.LaunchTemplate
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// LaunchTemplateNameRef is a reference to a LaunchTemplate used to set
+	// the LaunchTemplateName.
+	// +optional
+	LaunchTemplateNameRef *xpv1.Reference `json:"launchTemplateNameRef,omitempty"`
+
+	// LaunchTemplateNameSelector selects a reference to a LaunchTemplate used
+	// to set the LaunchTemplateName.
+	// +optional
+	LaunchTemplateNameSelector *xpv1.Selector `json:"launchTemplateNameSelector,omitempty"`
+
+	// Version of the launch template, i.e. a version number, $Latest or
+	// $Default. Defaults to $Default.
+	// +kubebuilder:validation:Pattern=`^(\$Latest|\$Default|[1-9][0-9]*)$`
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// InstanceRefreshPolicy configures the instance refreshes of an Auto Scaling
+// group.
+type InstanceRefreshPolicy struct {
+	// MinHealthyPercentage is the percentage of the desired capacity that
+	// must stay in service during the refresh. Defaults to 90.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+
+	// InstanceWarmup is the time, in seconds, until a new instance is
+	// considered in service. Defaults to the health check grace period of
+	// the group.
+	// +optional
+	InstanceWarmup *int64 `json:"instanceWarmup,omitempty"`
+
+	// SkipMatching skips the replacement of instances that already run the
+	// desired version of the launch template.
+	// +optional
+	SkipMatching *bool `json:"skipMatching,omitempty"`
+}
+
+// A Tag of an Auto Scaling group.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+
+	// PropagateAtLaunch attaches the tag to the instances the group launches.
+	// Defaults to true.
+	// +optional
+	PropagateAtLaunch *bool `json:"propagateAtLaunch,omitempty"`
+}
+
+// AutoScalingGroupObservation keeps the state for the external resource
+type AutoScalingGroupObservation struct {
+	// AutoScalingGroupARN is the ARN of the group.
+	AutoScalingGroupARN string `json:"autoScalingGroupArn,omitempty"`
+
+	// DesiredCapacity is the current desired capacity of the group.
+	DesiredCapacity int64 `json:"desiredCapacity,omitempty"`
+
+	// Instances is the number of instances in the group.
+	Instances int64 `json:"instances,omitempty"`
+
+	// Status of the group. It is only set while the group is being deleted.
+	Status string `json:"status,omitempty"`
+
+	// SuspendedProcesses are the processes of the group that are suspended.
+	SuspendedProcesses []string `json:"suspendedProcesses,omitempty"`
+
+	// LaunchTemplateVersion is the version number of the launch template
+	// the instances should run. It is only resolved if InstanceRefresh is
+	// set.
+	LaunchTemplateVersion int64 `json:"launchTemplateVersion,omitempty"`
+
+	// InstanceRefreshID is the ID of the last instance refresh of the group.
+	InstanceRefreshID string `json:"instanceRefreshId,omitempty"`
+
+	// InstanceRefreshStatus is the status of the last instance refresh of
+	// the group, e.g. InProgress or Successful.
+	InstanceRefreshStatus string `json:"instanceRefreshStatus,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AutoScalingGroupSpec defines the desired state of an AutoScalingGroup.
+type AutoScalingGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AutoScalingGroupParameters `json:"forProvider"`
+}
+
+// An AutoScalingGroupStatus represents the observed state of an
+// AutoScalingGroup.
+type AutoScalingGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AutoScalingGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AutoScalingGroup is a managed resource that represents an EC2 Auto
+// Scaling group. Deleting it terminates the instances of the group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.atProvider.desiredCapacity"
+// +kubebuilder:printcolumn:name="INSTANCES",type="integer",JSONPath=".status.atProvider.instances"
+// +kubebuilder:printcolumn:name="REFRESH",type="string",JSONPath=".status.atProvider.instanceRefreshStatus"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AutoScalingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoScalingGroupSpec   `json:"spec"`
+	Status AutoScalingGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoScalingGroupList contains a list of AutoScalingGroups
+type AutoScalingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoScalingGroup `json:"items"`
+}
@@ -24,18 +24,27 @@ import (
 	acmv1beta1 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	acmpcav1alpha1 "github.com/crossplane/provider-aws/apis/acmpca/v1alpha1"
 	acmpcav1beta1 "github.com/crossplane/provider-aws/apis/acmpca/v1beta1"
+	apigatewayv1alpha1 "github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
 	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	apigatewayv2v1beta1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1beta1"
 	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	autoscalingv1alpha1 "github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
 	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	cloudformationv1alpha1 "github.com/crossplane/provider-aws/apis/cloudformation/v1alpha1"
 	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	cloudsearchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudsearch/v1alpha1"
+	cloudtrailv1alpha1 "github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	cloudwatchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
 	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	cognitoidentityv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
 	cognitoidentityproviderv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	configservicev1alpha1 "github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	databasev1beta1 "github.com/crossplane/provider-aws/apis/database/v1beta1"
 	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	ec2ebsv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
 	ec2manualv1alpha1 "github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
 	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
@@ -48,9 +57,12 @@ import (
 	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	elasticloadbalancingv1alpha1 "github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	eventbridgev1alpha1 "github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
 	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	guarddutyv1alpha1 "github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
 	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	importerv1alpha1 "github.com/crossplane/provider-aws/apis/importer/v1alpha1"
 	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
 	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
 	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
@@ -60,6 +72,8 @@ import (
 	mqv1alpha1 "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
 	neptunev1alpha1 "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
 	notificationv1alpha1 "github.com/crossplane/provider-aws/apis/notification/v1alpha1"
+	opensearchservicev1alpha1 "github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	organizationsv1alpha1 "github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
 	prometheusservice "github.com/crossplane/provider-aws/apis/prometheusservice/v1alpha1"
 	ramv1alpha1 "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
 	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
@@ -71,7 +85,10 @@ import (
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
 	secretsmanagerv1alpha1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1alpha1"
 	secretsmanagerv1beta1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1beta1"
+	securityhubv1alpha1 "github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
 	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	servicequotasv1alpha1 "github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	sesv1alpha1 "github.com/crossplane/provider-aws/apis/ses/v1alpha1"
 	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
 	snsv1beta1 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
 	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
@@ -79,6 +96,7 @@ import (
 	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsv1alpha3 "github.com/crossplane/provider-aws/apis/v1alpha3"
 	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	wafv2v1alpha1 "github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
 )
 
 func init() {
@@ -86,6 +104,7 @@ func init() {
 	AddToSchemes = append(AddToSchemes,
 		cachev1alpha1.SchemeBuilder.AddToScheme,
 		cachev1beta1.SchemeBuilder.AddToScheme,
+		databasev1alpha1.SchemeBuilder.AddToScheme,
 		databasev1beta1.SchemeBuilder.AddToScheme,
 		docdbv1alpha1.AddToScheme,
 		elasticloadbalancingv1alpha1.SchemeBuilder.AddToScheme,
@@ -106,6 +125,8 @@ func init() {
 		secretsmanagerv1alpha1.SchemeBuilder.AddToScheme,
 		secretsmanagerv1beta1.SchemeBuilder.AddToScheme,
 		servicediscoveryv1alpha1.SchemeBuilder.AddToScheme,
+		servicequotasv1alpha1.SchemeBuilder.AddToScheme,
+		sesv1alpha1.SchemeBuilder.AddToScheme,
 		acmpcav1alpha1.SchemeBuilder.AddToScheme,
 		acmpcav1beta1.SchemeBuilder.AddToScheme,
 		eksv1alpha1.SchemeBuilder.AddToScheme,
@@ -123,6 +144,7 @@ func init() {
 		efsv1alpha1.SchemeBuilder.AddToScheme,
 		rdsv1alpha1.SchemeBuilder.AddToScheme,
 		ec2manualv1alpha1.SchemeBuilder.AddToScheme,
+		ec2ebsv1alpha1.SchemeBuilder.AddToScheme,
 		ec2v1alpha1.SchemeBuilder.AddToScheme,
 		lambdav1alpha1.SchemeBuilder.AddToScheme,
 		lambdav1beta1.SchemeBuilder.AddToScheme,
@@ -133,16 +155,30 @@ func init() {
 		transferv1alpha1.SchemeBuilder.AddToScheme,
 		gluev1alpha1.SchemeBuilder.AddToScheme,
 		mqv1alpha1.SchemeBuilder.AddToScheme,
+		cloudwatchv1alpha1.SchemeBuilder.AddToScheme,
 		cloudwatchlogsv1alpha1.SchemeBuilder.AddToScheme,
+		eventbridgev1alpha1.SchemeBuilder.AddToScheme,
+		opensearchservicev1alpha1.SchemeBuilder.AddToScheme,
 		iotv1alpha1.SchemeBuilder.AddToScheme,
 		athenav1alpha1.SchemeBuilder.AddToScheme,
 		ramv1alpha1.SchemeBuilder.AddToScheme,
 		kinesisv1alpha1.SchemeBuilder.AddToScheme,
+		cognitoidentityv1alpha1.AddToScheme,
 		cognitoidentityproviderv1alpha1.AddToScheme,
 		neptunev1alpha1.SchemeBuilder.AddToScheme,
 		snsv1beta1.SchemeBuilder.AddToScheme,
 		prometheusservice.SchemeBuilder.AddToScheme,
 		cloudsearchv1alpha1.AddToScheme,
+		importerv1alpha1.SchemeBuilder.AddToScheme,
+		cloudtrailv1alpha1.SchemeBuilder.AddToScheme,
+		configservicev1alpha1.SchemeBuilder.AddToScheme,
+		guarddutyv1alpha1.SchemeBuilder.AddToScheme,
+		securityhubv1alpha1.SchemeBuilder.AddToScheme,
+		organizationsv1alpha1.SchemeBuilder.AddToScheme,
+		apigatewayv1alpha1.SchemeBuilder.AddToScheme,
+		wafv2v1alpha1.SchemeBuilder.AddToScheme,
+		cloudformationv1alpha1.SchemeBuilder.AddToScheme,
+		autoscalingv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CacheCluster states.
@@ -162,6 +164,17 @@ type CacheClusterObservation struct {
 
 	// A flag that enables in-transit encryption when set to true.
 	TransitEncryptionEnabled bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // CacheClusterParameters define the desired state of an AWS ElastiCache
@@ -207,6 +220,14 @@ type CacheClusterParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// A referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameRef *xpv1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the name of a CacheParameterGroup
+	// +optional
+	CacheParameterGroupNameSelector *xpv1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// A list of security group names to associate with this cluster.
 	// +optional
 	CacheSecurityGroupNames []string `json:"cacheSecurityGroupNames,omitempty"`
@@ -303,6 +324,11 @@ type CacheClusterParameters struct {
 	// +optional
 	// +immutable
 	Tags []Tag `json:"tags,omitempty"`
+
+	// ReadinessProbe, if set, keeps the CacheCluster from becoming ready until
+	// its endpoint accepts TCP connections from the provider.
+	// +optional
+	ReadinessProbe *awsv1beta1.ReadinessProbe `json:"readinessProbe,omitempty"`
 }
 
 // A CacheClusterSpec defines the desired state of a CacheCluster.
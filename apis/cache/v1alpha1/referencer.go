@@ -23,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
 )
 
@@ -65,6 +66,20 @@ func (mg *CacheCluster) ResolveReferences(ctx context.Context, c client.Reader)
 	mg.Spec.ForProvider.CacheSubnetGroupName = aws.String(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CacheSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: aws.StringValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &elasticachev1alpha1.CacheParameterGroup{}, List: &elasticachev1alpha1.CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return err
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = aws.String(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.securityGroupIds
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
@@ -53,7 +53,16 @@ var (
 	CacheClusterGroupVersionKind = SchemeGroupVersion.WithKind(CacheClusterKind)
 )
 
+// ReservedCacheNodeCoverage type metadata.
+var (
+	ReservedCacheNodeCoverageKind             = reflect.TypeOf(ReservedCacheNodeCoverage{}).Name()
+	ReservedCacheNodeCoverageGroupKind        = schema.GroupKind{Group: Group, Kind: ReservedCacheNodeCoverageKind}.String()
+	ReservedCacheNodeCoverageKindAPIVersion   = ReservedCacheNodeCoverageKind + "." + SchemeGroupVersion.String()
+	ReservedCacheNodeCoverageGroupVersionKind = SchemeGroupVersion.WithKind(ReservedCacheNodeCoverageKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&CacheCluster{}, &CacheClusterList{})
 	SchemeBuilder.Register(&CacheSubnetGroup{}, &CacheSubnetGroupList{})
+	SchemeBuilder.Register(&ReservedCacheNodeCoverage{}, &ReservedCacheNodeCoverageList{})
 }
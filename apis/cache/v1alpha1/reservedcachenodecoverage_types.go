@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReservedCacheNodeCoverageParameters define which ElastiCache reserved
+// nodes and cache clusters are taken into account when computing coverage.
+type ReservedCacheNodeCoverageParameters struct {
+	// Region is the region whose reserved nodes and cache clusters are
+	// observed.
+	Region string `json:"region"`
+
+	// CacheNodeTypes limits the observation to the given cache node types,
+	// e.g. cache.r6g.large. All node types are observed if omitted.
+	// +optional
+	CacheNodeTypes []string `json:"cacheNodeTypes,omitempty"`
+}
+
+// A ReservedCacheNodeCoverageSpec defines the desired state of a
+// ReservedCacheNodeCoverage.
+type ReservedCacheNodeCoverageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReservedCacheNodeCoverageParameters `json:"forProvider"`
+}
+
+// CacheNodeReservation is an active ElastiCache reserved node purchase.
+type CacheNodeReservation struct {
+	// ReservedCacheNodeID is the unique identifier of the reservation.
+	ReservedCacheNodeID string `json:"reservedCacheNodeId"`
+
+	// CacheNodeType is the cache node type that is reserved.
+	CacheNodeType string `json:"cacheNodeType"`
+
+	// CacheNodeCount is the number of cache nodes that have been reserved.
+	CacheNodeCount int32 `json:"cacheNodeCount"`
+
+	// ProductDescription is the engine the reservation applies to, e.g.
+	// redis or memcached.
+	ProductDescription string `json:"productDescription"`
+
+	// OfferingType is the payment option of the reservation, e.g.
+	// All Upfront.
+	// +optional
+	OfferingType string `json:"offeringType,omitempty"`
+
+	// StartTime is the time the reservation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time the reservation expires.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// CacheNodeTypeCoverage reports how many running cache nodes of a node type
+// and engine are covered by active reservations.
+type CacheNodeTypeCoverage struct {
+	// CacheNodeType is the cache node type, e.g. cache.r6g.large.
+	CacheNodeType string `json:"cacheNodeType"`
+
+	// ProductDescription is the cache engine, e.g. redis or memcached.
+	ProductDescription string `json:"productDescription"`
+
+	// ReservedNodes is the number of nodes covered by active reservations.
+	ReservedNodes int32 `json:"reservedNodes"`
+
+	// RunningNodes is the number of nodes of existing cache clusters.
+	RunningNodes int32 `json:"runningNodes"`
+
+	// UncoveredNodes is the number of running nodes that are not covered by
+	// any active reservation.
+	UncoveredNodes int32 `json:"uncoveredNodes"`
+}
+
+// ReservedCacheNodeCoverageObservation is the observed reserved capacity
+// coverage of ElastiCache nodes.
+type ReservedCacheNodeCoverageObservation struct {
+	// Reservations are the active reserved node purchases.
+	// +optional
+	Reservations []CacheNodeReservation `json:"reservations,omitempty"`
+
+	// Coverage is the reservation coverage per cache node type and engine.
+	// +optional
+	Coverage []CacheNodeTypeCoverage `json:"coverage,omitempty"`
+
+	// UncoveredNodes is the total number of running nodes that are not
+	// covered by any active reservation.
+	UncoveredNodes int32 `json:"uncoveredNodes"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ReservedCacheNodeCoverageStatus represents the observed state of a
+// ReservedCacheNodeCoverage.
+type ReservedCacheNodeCoverageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReservedCacheNodeCoverageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReservedCacheNodeCoverage is a read-only managed resource that reports
+// how well the running ElastiCache nodes of a region are covered by reserved
+// nodes. It never creates, updates or deletes anything in AWS.
+// +kubebuilder:printcolumn:name="UNCOVERED",type="integer",JSONPath=".status.atProvider.uncoveredNodes"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReservedCacheNodeCoverage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReservedCacheNodeCoverageSpec   `json:"spec"`
+	Status ReservedCacheNodeCoverageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReservedCacheNodeCoverageList contains a list of ReservedCacheNodeCoverage
+type ReservedCacheNodeCoverageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReservedCacheNodeCoverage `json:"items"`
+}
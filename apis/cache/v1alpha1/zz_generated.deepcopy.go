@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -99,6 +100,11 @@ func (in *CacheClusterObservation) DeepCopyInto(out *CacheClusterObservation) {
 	out.ConfigurationEndpoint = in.ConfigurationEndpoint
 	in.NotificationConfiguration.DeepCopyInto(&out.NotificationConfiguration)
 	in.PendingModifiedValues.DeepCopyInto(&out.PendingModifiedValues)
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterObservation.
@@ -144,6 +150,16 @@ func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CacheParameterGroupNameRef != nil {
+		in, out := &in.CacheParameterGroupNameRef, &out.CacheParameterGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CacheParameterGroupNameSelector != nil {
+		in, out := &in.CacheParameterGroupNameSelector, &out.CacheParameterGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CacheSecurityGroupNames != nil {
 		in, out := &in.CacheSecurityGroupNames, &out.CacheSecurityGroupNames
 		*out = make([]string, len(*in))
@@ -246,6 +262,11 @@ func (in *CacheClusterParameters) DeepCopyInto(out *CacheClusterParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(v1beta1.ReadinessProbe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheClusterParameters.
@@ -317,6 +338,44 @@ func (in *CacheNode) DeepCopy() *CacheNode {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheNodeReservation) DeepCopyInto(out *CacheNodeReservation) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheNodeReservation.
+func (in *CacheNodeReservation) DeepCopy() *CacheNodeReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheNodeReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheNodeTypeCoverage) DeepCopyInto(out *CacheNodeTypeCoverage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheNodeTypeCoverage.
+func (in *CacheNodeTypeCoverage) DeepCopy() *CacheNodeTypeCoverage {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheNodeTypeCoverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
 	*out = *in
@@ -367,6 +426,11 @@ func (in *CacheSubnetGroup) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheSubnetGroupExternalStatus) DeepCopyInto(out *CacheSubnetGroupExternalStatus) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSubnetGroupExternalStatus.
@@ -462,7 +526,7 @@ func (in *CacheSubnetGroupSpec) DeepCopy() *CacheSubnetGroupSpec {
 func (in *CacheSubnetGroupStatus) DeepCopyInto(out *CacheSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheSubnetGroupStatus.
@@ -540,6 +604,151 @@ func (in *PendingModifiedValues) DeepCopy() *PendingModifiedValues {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverage) DeepCopyInto(out *ReservedCacheNodeCoverage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverage.
+func (in *ReservedCacheNodeCoverage) DeepCopy() *ReservedCacheNodeCoverage {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedCacheNodeCoverage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverageList) DeepCopyInto(out *ReservedCacheNodeCoverageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReservedCacheNodeCoverage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverageList.
+func (in *ReservedCacheNodeCoverageList) DeepCopy() *ReservedCacheNodeCoverageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedCacheNodeCoverageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverageObservation) DeepCopyInto(out *ReservedCacheNodeCoverageObservation) {
+	*out = *in
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]CacheNodeReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = make([]CacheNodeTypeCoverage, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverageObservation.
+func (in *ReservedCacheNodeCoverageObservation) DeepCopy() *ReservedCacheNodeCoverageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverageParameters) DeepCopyInto(out *ReservedCacheNodeCoverageParameters) {
+	*out = *in
+	if in.CacheNodeTypes != nil {
+		in, out := &in.CacheNodeTypes, &out.CacheNodeTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverageParameters.
+func (in *ReservedCacheNodeCoverageParameters) DeepCopy() *ReservedCacheNodeCoverageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverageSpec) DeepCopyInto(out *ReservedCacheNodeCoverageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverageSpec.
+func (in *ReservedCacheNodeCoverageSpec) DeepCopy() *ReservedCacheNodeCoverageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedCacheNodeCoverageStatus) DeepCopyInto(out *ReservedCacheNodeCoverageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedCacheNodeCoverageStatus.
+func (in *ReservedCacheNodeCoverageStatus) DeepCopy() *ReservedCacheNodeCoverageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedCacheNodeCoverageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -151,3 +151,69 @@ func (mg *CacheSubnetGroup) SetPublishConnectionDetailsTo(r *xpv1.PublishConnect
 func (mg *CacheSubnetGroup) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this ReservedCacheNodeCoverage.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *ReservedCacheNodeCoverage) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetPublishConnectionDetailsTo of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// GetWriteConnectionSecretToReference of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this ReservedCacheNodeCoverage.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *ReservedCacheNodeCoverage) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetPublishConnectionDetailsTo of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// SetWriteConnectionSecretToReference of this ReservedCacheNodeCoverage.
+func (mg *ReservedCacheNodeCoverage) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 )
 
 const errDeprecatedRef = "spec.forProvider.cacheSubnetGroupNameRefs is deprecated - please set only spec.forProvider.cacheSubnetGroupNameRef"
@@ -70,6 +71,20 @@ func (mg *ReplicationGroup) ResolveReferences(ctx context.Context, c client.Read
 		mg.Spec.ForProvider.CacheSubnetGroupNameRef = resp.ResolvedReference
 	}
 
+	// Resolve spec.forProvider.cacheParameterGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CacheParameterGroupName),
+		Reference:    mg.Spec.ForProvider.CacheParameterGroupNameRef,
+		Selector:     mg.Spec.ForProvider.CacheParameterGroupNameSelector,
+		To:           reference.To{Managed: &elasticachev1alpha1.CacheParameterGroup{}, List: &elasticachev1alpha1.CacheParameterGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.cacheParameterGroupName")
+	}
+	mg.Spec.ForProvider.CacheParameterGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CacheParameterGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.securityGroupIds
 	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
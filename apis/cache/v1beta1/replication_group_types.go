@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ReplicationGroup states.
@@ -141,6 +143,10 @@ type ReplicationGroupPendingModifiedValues struct {
 
 	// Resharding is the status of an online resharding operation.
 	Resharding ReshardingStatus `json:"resharding,omitempty"`
+
+	// AuthTokenStatus is the status of an auth token update, i.e. SETTING or
+	// ROTATING.
+	AuthTokenStatus string `json:"authTokenStatus,omitempty"`
 }
 
 // ReshardingStatus is the status of an online resharding operation.
@@ -178,6 +184,9 @@ type ReplicationGroupObservation struct {
 	// endpoint to connect to this replication group.
 	ConfigurationEndpoint Endpoint `json:"configurationEndpoint,omitempty"`
 
+	// AuthTokenLastModifiedDate is the date the auth token was last modified.
+	AuthTokenLastModifiedDate *metav1.Time `json:"authTokenLastModifiedDate,omitempty"`
+
 	// MemberClusters is the list of names of all the cache clusters that are
 	// part of this replication group.
 	MemberClusters []string `json:"memberClusters,omitempty"`
@@ -195,6 +204,17 @@ type ReplicationGroupObservation struct {
 	// Status is the current state of this replication group - creating,
 	// available, modifying, deleting, create-failed, snapshotting.
 	Status string `json:"status,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // A Tag is used to tag the ElastiCache resources in AWS.
@@ -275,11 +295,27 @@ type ReplicationGroupParameters struct {
 	// While ReplicationGroupSpec mirrors the fields of the upstream replication
 	// group object as closely as possible, we expose a boolean here rather than
 	// requiring the operator pass in a string authentication token. Crossplane
-	// will generate a token automatically and expose it via a Secret.
+	// will generate a token automatically and expose it via a Secret, unless
+	// AuthTokenSecretRef is set.
 	// +immutable
 	// +optional
 	AuthEnabled *bool `json:"authEnabled,omitempty"`
 
+	// AuthTokenSecretRef references the secret that contains the auth token
+	// used to authenticate to the replication group, which enables auth.
+	// Changing the token updates it using AuthTokenUpdateStrategy. The
+	// connection secret is updated once the update is complete.
+	// +optional
+	AuthTokenSecretRef *xpv1.SecretKeySelector `json:"authTokenSecretRef,omitempty"`
+
+	// AuthTokenUpdateStrategy specifies how the auth token is updated when the
+	// token referenced by AuthTokenSecretRef changes. ROTATE keeps the
+	// previous token valid alongside the new one until the token is updated
+	// again, while SET allows only the new token. Defaults to ROTATE.
+	// +kubebuilder:validation:Enum=ROTATE;SET
+	// +optional
+	AuthTokenUpdateStrategy *string `json:"authTokenUpdateStrategy,omitempty"`
+
 	// AutomaticFailoverEnabled specifies whether a read-only replica is
 	// automatically promoted to read/write primary if the existing primary
 	// fails. If true, Multi-AZ is enabled for this replication group. If false,
@@ -318,6 +354,16 @@ type ReplicationGroupParameters struct {
 	// +optional
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
 
+	// CacheParameterGroupNameRef is a reference to a CacheParameterGroup used
+	// to set the CacheParameterGroupName.
+	// +optional
+	CacheParameterGroupNameRef *xpv1.Reference `json:"cacheParameterGroupNameRef,omitempty"`
+
+	// CacheParameterGroupNameSelector selects a reference to a
+	// CacheParameterGroup.
+	// +optional
+	CacheParameterGroupNameSelector *xpv1.Selector `json:"cacheParameterGroupNameSelector,omitempty"`
+
 	// CacheSecurityGroupNames specifies a list of cache security group names to
 	// associate with this replication group. Only for EC2-Classic mode.
 	// +optional
@@ -576,6 +622,11 @@ type ReplicationGroupParameters struct {
 	// +immutable
 	// +optional
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// ReadinessProbe, if set, keeps the ReplicationGroup from becoming ready
+	// until its primary endpoint accepts TCP connections from the provider.
+	// +optional
+	ReadinessProbe *awsv1beta1.ReadinessProbe `json:"readinessProbe,omitempty"`
 }
 
 // A ReplicationGroupSpec defines the desired state of a ReplicationGroup.
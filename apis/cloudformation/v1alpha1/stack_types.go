@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// StackParameters define the desired state of an AWS CloudFormation stack.
+type StackParameters struct {
+	// Region is which region the Stack will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// TemplateBody is the inline template of the stack. Exactly one of
+	// TemplateBody, TemplateBodyFrom and TemplateURL must be specified.
+	// +optional
+	TemplateBody *string `json:"templateBody,omitempty"`
+
+	// TemplateBodyFrom reads the template of the stack from a ConfigMap.
+	// +optional
+	TemplateBodyFrom *TemplateSource `json:"templateBodyFrom,omitempty"`
+
+	// TemplateURL is the URL of a template stored in an S3 bucket. Changes
+	// of the object behind an unchanged URL are not detected, so use
+	// versioned URLs to roll out new templates.
+	// +optional
+	TemplateURL *string `json:"templateUrl,omitempty"`
+
+	// Parameters of the template.
+	// +optional
+	Parameters []StackParameter `json:"parameters,omitempty"`
+
+	// Capabilities the template requires, e.g. CAPABILITY_IAM if it
+	// creates IAM resources.
+	// +optional
+	Capabilities []string `json:"capabilities,omitempty"`
+
+	// RoleARN is the ARN of the IAM role CloudFormation assumes to manage the
+	// resources of the stack.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to a Role to retrieve its ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// TimeoutInMinutes after which the creation of the stack fails.
+	// +immutable
+	// +optional
+	TimeoutInMinutes *int64 `json:"timeoutInMinutes,omitempty"`
+
+	// DriftDetectionInterval is the interval in which CloudFormation is asked
+	// to detect whether the resources of the stack drifted from the
+	// template, for example 6h. Defaults to 1h. Drift detection is disabled
+	// if it is 0s.
+	// +optional
+	DriftDetectionInterval *metav1.Duration `json:"driftDetectionInterval,omitempty"`
+
+	// Tags to attach to the stack and the resources it creates.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// A StackParameter is a parameter of the template of a stack.
+type StackParameter struct {
+	// Key of the parameter.
+	Key string `json:"key"`
+
+	// Value of the parameter.
+	Value string `json:"value"`
+}
+
+// TemplateSource selects the key of a ConfigMap that holds a template.
+type TemplateSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	ConfigMapKeyRef ConfigMapKeySelector `json:"configMapKeyRef"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// StackObservation keeps the state for the external resource
+type StackObservation struct {
+	// StackID is the unique ID of the stack.
+	StackID string `json:"stackId,omitempty"`
+
+	// StackStatus is the status of the stack, e.g. CREATE_COMPLETE.
+	StackStatus string `json:"stackStatus,omitempty"`
+
+	// StackStatusReason explains the status of the stack.
+	StackStatusReason string `json:"stackStatusReason,omitempty"`
+
+	// DriftStatus is the result of the last drift detection of the stack,
+	// i.e. DRIFTED, IN_SYNC, UNKNOWN or NOT_CHECKED.
+	DriftStatus string `json:"driftStatus,omitempty"`
+
+	// LastDriftCheckTime is when the drift of the stack was last detected.
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A StackSpec defines the desired state of a Stack.
+type StackSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StackParameters `json:"forProvider"`
+}
+
+// A StackStatus represents the observed state of a Stack.
+type StackStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StackObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Stack is a managed resource that represents an AWS CloudFormation stack.
+// It allows to manage AWS resources this provider does not model yet. The
+// outputs of the stack are published as connection details.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.stackStatus"
+// +kubebuilder:printcolumn:name="DRIFT",type="string",JSONPath=".status.atProvider.driftStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Stack struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StackSpec   `json:"spec"`
+	Status StackStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StackList contains a list of Stacks
+type StackList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Stack `json:"items"`
+}
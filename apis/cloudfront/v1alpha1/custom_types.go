@@ -16,8 +16,36 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
 // CustomDistributionParameters includes the custom fields of Distribution.
-type CustomDistributionParameters struct{}
+type CustomDistributionParameters struct {
+	// TrustedKeyGroupRefs references KeyGroups to retrieve their IDs and
+	// set them as the trusted key groups of the default cache behavior.
+	// +optional
+	TrustedKeyGroupRefs []xpv1.Reference `json:"trustedKeyGroupRefs,omitempty"`
+
+	// TrustedKeyGroupSelector selects references to KeyGroups to retrieve
+	// their IDs and set them as the trusted key groups of the default cache
+	// behavior.
+	// +optional
+	TrustedKeyGroupSelector *xpv1.Selector `json:"trustedKeyGroupSelector,omitempty"`
+
+	// WebACLIDRef references a CLOUDFRONT scoped WAFv2 WebACL to retrieve its
+	// ARN and set it as the webACLID of the distribution.
+	// +optional
+	WebACLIDRef *xpv1.Reference `json:"webACLIDRef,omitempty"`
+
+	// WebACLIDSelector selects a reference to a CLOUDFRONT scoped WAFv2
+	// WebACL to retrieve its ARN and set it as the webACLID of the
+	// distribution.
+	// +optional
+	WebACLIDSelector *xpv1.Selector `json:"webACLIDSelector,omitempty"`
+}
 
 // CustomCachePolicyParameters includes the custom fields of CachePolicy.
 type CustomCachePolicyParameters struct{}
@@ -27,3 +55,8 @@ type CustomCloudFrontOriginAccessIdentityParameters struct{}
 
 // CustomResponseHeadersPolicyParameters includes metadata about the response headers policy, and a set of configurations
 type CustomResponseHeadersPolicyParameters struct{}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
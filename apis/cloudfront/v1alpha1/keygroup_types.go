@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// KeyGroupParameters define the desired state of an AWS CloudFront key
+// group.
+type KeyGroupParameters struct {
+	// Region is which region the KeyGroup will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the key group.
+	Name string `json:"name"`
+
+	// Comment about the key group.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// PublicKeyIDs are the IDs of the public keys in the key group.
+	// +optional
+	PublicKeyIDs []string `json:"publicKeyIds,omitempty"`
+
+	// PublicKeyIDRefs references PublicKeys to retrieve their IDs.
+	// +optional
+	PublicKeyIDRefs []xpv1.Reference `json:"publicKeyIdRefs,omitempty"`
+
+	// PublicKeyIDSelector selects references to PublicKeys to retrieve
+	// their IDs.
+	// +optional
+	PublicKeyIDSelector *xpv1.Selector `json:"publicKeyIdSelector,omitempty"`
+}
+
+// KeyGroupObservation keeps the state for the external resource
+type KeyGroupObservation struct {
+	// ETag is the current version of the key group.
+	ETag string `json:"etag,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A KeyGroupSpec defines the desired state of a KeyGroup.
+type KeyGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       KeyGroupParameters `json:"forProvider"`
+}
+
+// A KeyGroupStatus represents the observed state of a KeyGroup.
+type KeyGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          KeyGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A KeyGroup is a managed resource that represents a group of public keys
+// that Distributions trust to sign URLs and cookies.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type KeyGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeyGroupSpec   `json:"spec"`
+	Status KeyGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeyGroupList contains a list of KeyGroups
+type KeyGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyGroup `json:"items"`
+}
+
+// KeyGroup type metadata.
+var (
+	KeyGroupKind             = "KeyGroup"
+	KeyGroupGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: KeyGroupKind}.String()
+	KeyGroupKindAPIVersion   = KeyGroupKind + "." + GroupVersion.String()
+	KeyGroupGroupVersionKind = GroupVersion.WithKind(KeyGroupKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&KeyGroup{}, &KeyGroupList{})
+}
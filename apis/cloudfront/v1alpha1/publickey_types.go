@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PublicKeyParameters define the desired state of an AWS CloudFront public
+// key.
+type PublicKeyParameters struct {
+	// Region is which region the PublicKey will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the public key.
+	// +immutable
+	Name string `json:"name"`
+
+	// Comment about the public key.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// EncodedKey is the PEM encoded public key. CloudFront does not allow
+	// the key of a public key to be changed; create a new PublicKey and add
+	// it to the KeyGroup to rotate keys. Exactly one of EncodedKey and
+	// EncodedKeyFrom must be specified.
+	// +immutable
+	// +optional
+	EncodedKey *string `json:"encodedKey,omitempty"`
+
+	// EncodedKeyFrom reads the PEM encoded public key from a ConfigMap or
+	// Secret.
+	// +immutable
+	// +optional
+	EncodedKeyFrom *KeySource `json:"encodedKeyFrom,omitempty"`
+}
+
+// KeySource selects a key of a ConfigMap or Secret. Exactly one of its
+// fields must be specified.
+type KeySource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// PublicKeyObservation keeps the state for the external resource
+type PublicKeyObservation struct {
+	// ETag is the current version of the public key.
+	ETag string `json:"etag,omitempty"`
+
+	// CreatedTime is the time the public key was uploaded to CloudFront.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A PublicKeySpec defines the desired state of a PublicKey.
+type PublicKeySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PublicKeyParameters `json:"forProvider"`
+}
+
+// A PublicKeyStatus represents the observed state of a PublicKey.
+type PublicKeyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PublicKeyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PublicKey is a managed resource that represents a public key CloudFront
+// uses to verify signed URLs and signed cookies.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PublicKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PublicKeySpec   `json:"spec"`
+	Status PublicKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PublicKeyList contains a list of PublicKeys
+type PublicKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PublicKey `json:"items"`
+}
+
+// PublicKey type metadata.
+var (
+	PublicKeyKind             = "PublicKey"
+	PublicKeyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PublicKeyKind}.String()
+	PublicKeyKindAPIVersion   = PublicKeyKind + "." + GroupVersion.String()
+	PublicKeyGroupVersionKind = GroupVersion.WithKind(PublicKeyKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&PublicKey{}, &PublicKeyList{})
+}
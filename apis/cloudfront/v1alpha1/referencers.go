@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	wafv2 "github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+// ResolveReferences of this KeyGroup
+func (mg *KeyGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.publicKeyIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.PublicKeyIDs,
+		References:    mg.Spec.ForProvider.PublicKeyIDRefs,
+		Selector:      mg.Spec.ForProvider.PublicKeyIDSelector,
+		To:            reference.To{Managed: &PublicKey{}, List: &PublicKeyList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.publicKeyIds")
+	}
+	mg.Spec.ForProvider.PublicKeyIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.PublicKeyIDRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this Distribution
+func (mg *Distribution) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	if mg.Spec.ForProvider.TrustedKeyGroupRefs != nil || mg.Spec.ForProvider.TrustedKeyGroupSelector != nil {
+		if mg.Spec.ForProvider.DistributionConfig == nil {
+			mg.Spec.ForProvider.DistributionConfig = &DistributionConfig{}
+		}
+		dc := mg.Spec.ForProvider.DistributionConfig
+		if dc.DefaultCacheBehavior == nil {
+			dc.DefaultCacheBehavior = &DefaultCacheBehavior{}
+		}
+		if dc.DefaultCacheBehavior.TrustedKeyGroups == nil {
+			enabled := true
+			dc.DefaultCacheBehavior.TrustedKeyGroups = &TrustedKeyGroups{Enabled: &enabled}
+		}
+		tkg := dc.DefaultCacheBehavior.TrustedKeyGroups
+
+		// Resolve spec.forProvider.distributionConfig.defaultCacheBehavior.trustedKeyGroups.items
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: reference.FromPtrValues(tkg.Items),
+			References:    mg.Spec.ForProvider.TrustedKeyGroupRefs,
+			Selector:      mg.Spec.ForProvider.TrustedKeyGroupSelector,
+			To:            reference.To{Managed: &KeyGroup{}, List: &KeyGroupList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.defaultCacheBehavior.trustedKeyGroups.items")
+		}
+		tkg.Items = reference.ToPtrValues(mrsp.ResolvedValues)
+		mg.Spec.ForProvider.TrustedKeyGroupRefs = mrsp.ResolvedReferences
+	}
+
+	if mg.Spec.ForProvider.WebACLIDRef != nil || mg.Spec.ForProvider.WebACLIDSelector != nil {
+		if mg.Spec.ForProvider.DistributionConfig == nil {
+			mg.Spec.ForProvider.DistributionConfig = &DistributionConfig{}
+		}
+		dc := mg.Spec.ForProvider.DistributionConfig
+
+		// Resolve spec.forProvider.distributionConfig.webACLID
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(dc.WebACLID),
+			Reference:    mg.Spec.ForProvider.WebACLIDRef,
+			Selector:     mg.Spec.ForProvider.WebACLIDSelector,
+			To:           reference.To{Managed: &wafv2.WebACL{}, List: &wafv2.WebACLList{}},
+			Extract:      wafv2.WebACLARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.distributionConfig.webACLID")
+		}
+		dc.WebACLID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.WebACLIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -44,9 +44,11 @@ type CachePolicySpec struct {
 // CachePolicyObservation defines the observed state of CachePolicy
 type CachePolicyObservation struct {
 	// A cache policy.
-	CachePolicy *CachePolicy_SDK `json:"cachePolicy,omitempty"`
+	CachePolicy     *CachePolicy_SDK `json:"cachePolicy,omitempty"`
+	CallerAccountID string           `json:"callerAccountID,omitempty"`
 	// The current version of the cache policy.
-	ETag *string `json:"eTag,omitempty"`
+	ETag          *string        `json:"eTag,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The fully qualified URI of the cache policy just created.
 	Location *string `json:"location,omitempty"`
 }
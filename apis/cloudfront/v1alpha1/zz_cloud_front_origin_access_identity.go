@@ -43,10 +43,12 @@ type CloudFrontOriginAccessIdentitySpec struct {
 
 // CloudFrontOriginAccessIdentityObservation defines the observed state of CloudFrontOriginAccessIdentity
 type CloudFrontOriginAccessIdentityObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The origin access identity's information.
 	CloudFrontOriginAccessIdentity *OriginAccessIdentity `json:"cloudFrontOriginAccessIdentity,omitempty"`
 	// The current version of the origin access identity created.
-	ETag *string `json:"eTag,omitempty"`
+	ETag          *string        `json:"eTag,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The fully qualified URI of the new origin access identity just created.
 	Location *string `json:"location,omitempty"`
 }
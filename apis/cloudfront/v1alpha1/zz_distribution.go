@@ -43,10 +43,12 @@ type DistributionSpec struct {
 
 // DistributionObservation defines the observed state of Distribution
 type DistributionObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The distribution's information.
 	Distribution *Distribution_SDK `json:"distribution,omitempty"`
 	// The current version of the distribution created.
-	ETag *string `json:"eTag,omitempty"`
+	ETag          *string        `json:"eTag,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The fully qualified URI of the new distribution resource just created.
 	Location *string `json:"location,omitempty"`
 }
@@ -22,6 +22,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -523,6 +525,11 @@ func (in *CachePolicyObservation) DeepCopyInto(out *CachePolicyObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Location != nil {
 		in, out := &in.Location, &out.Location
 		*out = new(string)
@@ -772,6 +779,11 @@ func (in *CloudFrontOriginAccessIdentityObservation) DeepCopyInto(out *CloudFron
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Location != nil {
 		in, out := &in.Location, &out.Location
 		*out = new(string)
@@ -844,6 +856,21 @@ func (in *CloudFrontOriginAccessIdentityStatus) DeepCopy() *CloudFrontOriginAcce
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConflictingAlias) DeepCopyInto(out *ConflictingAlias) {
 	*out = *in
@@ -1058,6 +1085,26 @@ func (in *CustomCloudFrontOriginAccessIdentityParameters) DeepCopy() *CustomClou
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDistributionParameters) DeepCopyInto(out *CustomDistributionParameters) {
 	*out = *in
+	if in.TrustedKeyGroupRefs != nil {
+		in, out := &in.TrustedKeyGroupRefs, &out.TrustedKeyGroupRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedKeyGroupSelector != nil {
+		in, out := &in.TrustedKeyGroupSelector, &out.TrustedKeyGroupSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WebACLIDRef != nil {
+		in, out := &in.WebACLIDRef, &out.WebACLIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.WebACLIDSelector != nil {
+		in, out := &in.WebACLIDSelector, &out.WebACLIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDistributionParameters.
@@ -1600,6 +1647,11 @@ func (in *DistributionObservation) DeepCopyInto(out *DistributionObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Location != nil {
 		in, out := &in.Location, &out.Location
 		*out = new(string)
@@ -1625,7 +1677,7 @@ func (in *DistributionParameters) DeepCopyInto(out *DistributionParameters) {
 		*out = new(DistributionConfig)
 		(*in).DeepCopyInto(*out)
 	}
-	out.CustomDistributionParameters = in.CustomDistributionParameters
+	in.CustomDistributionParameters.DeepCopyInto(&out.CustomDistributionParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DistributionParameters.
@@ -2565,15 +2617,10 @@ func (in *KGKeyPairIDs) DeepCopy() *KGKeyPairIDs {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroup) DeepCopyInto(out *KeyGroup) {
 	*out = *in
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
-	if in.LastModifiedTime != nil {
-		in, out := &in.LastModifiedTime, &out.LastModifiedTime
-		*out = (*in).DeepCopy()
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroup.
@@ -2586,6 +2633,14 @@ func (in *KeyGroup) DeepCopy() *KeyGroup {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroupConfig) DeepCopyInto(out *KeyGroupConfig) {
 	*out = *in
@@ -2614,29 +2669,120 @@ func (in *KeyGroupConfig) DeepCopy() *KeyGroupConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyGroupList) DeepCopyInto(out *KeyGroupList) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
+func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupObservation) DeepCopyInto(out *KeyGroupObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupObservation.
+func (in *KeyGroupObservation) DeepCopy() *KeyGroupObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupParameters) DeepCopyInto(out *KeyGroupParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
-		**out = **in
+	if in.PublicKeyIDs != nil {
+		in, out := &in.PublicKeyIDs, &out.PublicKeyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicKeyIDRefs != nil {
+		in, out := &in.PublicKeyIDRefs, &out.PublicKeyIDRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.PublicKeyIDSelector != nil {
+		in, out := &in.PublicKeyIDSelector, &out.PublicKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupList.
-func (in *KeyGroupList) DeepCopy() *KeyGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupParameters.
+func (in *KeyGroupParameters) DeepCopy() *KeyGroupParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(KeyGroupList)
+	out := new(KeyGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupSpec) DeepCopyInto(out *KeyGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupSpec.
+func (in *KeyGroupSpec) DeepCopy() *KeyGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGroupStatus) DeepCopyInto(out *KeyGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGroupStatus.
+func (in *KeyGroupStatus) DeepCopy() *KeyGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGroupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2672,6 +2818,31 @@ func (in *KeyPairIDs) DeepCopy() *KeyPairIDs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeySource) DeepCopyInto(out *KeySource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeySource.
+func (in *KeySource) DeepCopy() *KeySource {
+	if in == nil {
+		return nil
+	}
+	out := new(KeySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KinesisStreamConfig) DeepCopyInto(out *KinesisStreamConfig) {
 	*out = *in
@@ -3410,15 +3581,10 @@ func (in *Paths) DeepCopy() *Paths {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKey) DeepCopyInto(out *PublicKey) {
 	*out = *in
-	if in.CreatedTime != nil {
-		in, out := &in.CreatedTime, &out.CreatedTime
-		*out = (*in).DeepCopy()
-	}
-	if in.ID != nil {
-		in, out := &in.ID, &out.ID
-		*out = new(string)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKey.
@@ -3431,6 +3597,14 @@ func (in *PublicKey) DeepCopy() *PublicKey {
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyConfig) DeepCopyInto(out *PublicKeyConfig) {
 	*out = *in
@@ -3469,29 +3643,119 @@ func (in *PublicKeyConfig) DeepCopy() *PublicKeyConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicKeyList) DeepCopyInto(out *PublicKeyList) {
 	*out = *in
-	if in.MaxItems != nil {
-		in, out := &in.MaxItems, &out.MaxItems
-		*out = new(int64)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PublicKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.NextMarker != nil {
-		in, out := &in.NextMarker, &out.NextMarker
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
+func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PublicKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyObservation) DeepCopyInto(out *PublicKeyObservation) {
+	*out = *in
+	if in.CreatedTime != nil {
+		in, out := &in.CreatedTime, &out.CreatedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyObservation.
+func (in *PublicKeyObservation) DeepCopy() *PublicKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyParameters) DeepCopyInto(out *PublicKeyParameters) {
+	*out = *in
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	if in.Quantity != nil {
-		in, out := &in.Quantity, &out.Quantity
-		*out = new(int64)
+	if in.EncodedKey != nil {
+		in, out := &in.EncodedKey, &out.EncodedKey
+		*out = new(string)
 		**out = **in
 	}
+	if in.EncodedKeyFrom != nil {
+		in, out := &in.EncodedKeyFrom, &out.EncodedKeyFrom
+		*out = new(KeySource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyList.
-func (in *PublicKeyList) DeepCopy() *PublicKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyParameters.
+func (in *PublicKeyParameters) DeepCopy() *PublicKeyParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(PublicKeyList)
+	out := new(PublicKeyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeySpec) DeepCopyInto(out *PublicKeySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeySpec.
+func (in *PublicKeySpec) DeepCopy() *PublicKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicKeyStatus) DeepCopyInto(out *PublicKeyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicKeyStatus.
+func (in *PublicKeyStatus) DeepCopy() *PublicKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4150,6 +4414,11 @@ func (in *ResponseHeadersPolicyObservation) DeepCopyInto(out *ResponseHeadersPol
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Location != nil {
 		in, out := &in.Location, &out.Location
 		*out = new(string)
@@ -44,8 +44,10 @@ type ResponseHeadersPolicySpec struct {
 
 // ResponseHeadersPolicyObservation defines the observed state of ResponseHeadersPolicy
 type ResponseHeadersPolicyObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The version identifier for the current version of the response headers policy.
-	ETag *string `json:"eTag,omitempty"`
+	ETag          *string        `json:"eTag,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The URL of the response headers policy.
 	Location *string `json:"location,omitempty"`
 	// Contains a response headers policy.
@@ -905,13 +905,6 @@ type KGKeyPairIDs struct {
 	KeyPairIDs *KeyPairIDs `json:"keyPairIDs,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type KeyGroup struct {
-	ID *string `json:"id,omitempty"`
-
-	LastModifiedTime *metav1.Time `json:"lastModifiedTime,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type KeyGroupConfig struct {
 	Comment *string `json:"comment,omitempty"`
@@ -919,15 +912,6 @@ type KeyGroupConfig struct {
 	Name *string `json:"name,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type KeyGroupList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type KeyPairIDs struct {
 	Items []*string `json:"items,omitempty"`
@@ -1165,13 +1149,6 @@ type Paths struct {
 	Quantity *int64 `json:"quantity,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type PublicKey struct {
-	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
-
-	ID *string `json:"id,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type PublicKeyConfig struct {
 	CallerReference *string `json:"callerReference,omitempty"`
@@ -1183,15 +1160,6 @@ type PublicKeyConfig struct {
 	Name *string `json:"name,omitempty"`
 }
 
-// +kubebuilder:skipversion
-type PublicKeyList struct {
-	MaxItems *int64 `json:"maxItems,omitempty"`
-
-	NextMarker *string `json:"nextMarker,omitempty"`
-
-	Quantity *int64 `json:"quantity,omitempty"`
-}
-
 // +kubebuilder:skipversion
 type PublicKeySummary struct {
 	Comment *string `json:"comment,omitempty"`
@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+
 // CustomDomainParameters includes the custom fields of a CloudSearch Domain.
 type CustomDomainParameters struct {
 
@@ -35,3 +37,8 @@ type CustomDomainParameters struct {
 	// +optional
 	AccessPolicies *string `json:"accessPolicies,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
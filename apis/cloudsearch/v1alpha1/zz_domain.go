@@ -45,7 +45,8 @@ type DomainSpec struct {
 
 // DomainObservation defines the observed state of Domain
 type DomainObservation struct {
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// True if the search domain is created. It can take several minutes to initialize
 	// a domain when CreateDomain is called. Newly created search domains are returned
 	// from DescribeDomains with a false value for Created until domain creation
@@ -59,7 +60,8 @@ type DomainObservation struct {
 	// The service endpoint for updating documents in a search domain.
 	DocService *ServiceEndpoint `json:"docService,omitempty"`
 
-	DomainID *string `json:"domainID,omitempty"`
+	DomainID      *string        `json:"domainID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 
 	Limits *Limits `json:"limits,omitempty"`
 	// True if processing is being done to activate the current domain configuration.
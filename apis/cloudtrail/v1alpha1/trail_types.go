@@ -0,0 +1,239 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Tag is a key-value pair attached to a trail.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// DataResource selects the S3 objects, Lambda functions or other resources
+// whose data events are logged.
+type DataResource struct {
+	// Type of the resource, for example AWS::S3::Object or
+	// AWS::Lambda::Function.
+	Type string `json:"type"`
+
+	// Values are ARNs or ARN prefixes of the resources to log data events
+	// for.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// EventSelector decides which management and data events a trail logs.
+type EventSelector struct {
+	// ReadWriteType specifies whether read-only events, write-only events
+	// or all events are logged. Defaults to All.
+	// +kubebuilder:validation:Enum=ReadOnly;WriteOnly;All
+	// +optional
+	ReadWriteType *string `json:"readWriteType,omitempty"`
+
+	// IncludeManagementEvents specifies whether management events are
+	// logged. Defaults to true.
+	// +optional
+	IncludeManagementEvents *bool `json:"includeManagementEvents,omitempty"`
+
+	// DataResources to log data events for.
+	// +optional
+	DataResources []DataResource `json:"dataResources,omitempty"`
+
+	// ExcludeManagementEventSources are event sources, such as
+	// kms.amazonaws.com, whose management events are not logged.
+	// +optional
+	ExcludeManagementEventSources []string `json:"excludeManagementEventSources,omitempty"`
+}
+
+// TrailParameters define the desired state of an AWS CloudTrail trail.
+type TrailParameters struct {
+	// Region is which region the Trail will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// S3BucketName is the name of the bucket the log files are delivered
+	// to. The bucket policy must allow CloudTrail to write to it.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/s3/v1beta1.Bucket
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketNameRef *xpv1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	S3BucketNameSelector *xpv1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// S3KeyPrefix is the prefix of the log file keys in the bucket.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+
+	// SNSTopicName is the name or ARN of the SNS topic that is notified
+	// when log files are delivered.
+	// +optional
+	SNSTopicName *string `json:"snsTopicName,omitempty"`
+
+	// IncludeGlobalServiceEvents specifies whether events of global
+	// services such as IAM are logged. Defaults to true.
+	// +optional
+	IncludeGlobalServiceEvents *bool `json:"includeGlobalServiceEvents,omitempty"`
+
+	// IsMultiRegionTrail specifies whether the trail logs events of all
+	// regions.
+	// +optional
+	IsMultiRegionTrail *bool `json:"isMultiRegionTrail,omitempty"`
+
+	// IsOrganizationTrail specifies whether the trail logs events of all
+	// accounts of the organization. It can only be set in the management
+	// account.
+	// +optional
+	IsOrganizationTrail *bool `json:"isOrganizationTrail,omitempty"`
+
+	// EnableLogFileValidation specifies whether digest files are created
+	// to validate the integrity of the log files.
+	// +optional
+	EnableLogFileValidation *bool `json:"enableLogFileValidation,omitempty"`
+
+	// CloudWatchLogsLogGroupARN is the ARN of the log group the events are
+	// delivered to in addition to the bucket.
+	// +optional
+	CloudWatchLogsLogGroupARN *string `json:"cloudWatchLogsLogGroupArn,omitempty"`
+
+	// CloudWatchLogsRoleARN is the ARN of the role CloudTrail assumes to
+	// write to the log group.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	CloudWatchLogsRoleARN *string `json:"cloudWatchLogsRoleArn,omitempty"`
+
+	// CloudWatchLogsRoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNRef *xpv1.Reference `json:"cloudWatchLogsRoleArnRef,omitempty"`
+
+	// CloudWatchLogsRoleARNSelector selects a reference to a Role to
+	// retrieve its ARN.
+	// +optional
+	CloudWatchLogsRoleARNSelector *xpv1.Selector `json:"cloudWatchLogsRoleArnSelector,omitempty"`
+
+	// KMSKeyID is the ARN of the KMS key used to encrypt the log files.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef references a Key to retrieve its ARN.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a Key to retrieve its ARN.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+
+	// EventSelectors decide which management and data events are logged.
+	// If none are given, all management events are logged.
+	// +optional
+	EventSelectors []EventSelector `json:"eventSelectors,omitempty"`
+
+	// EnableLogging specifies whether the trail records events. Defaults to
+	// true.
+	// +optional
+	EnableLogging *bool `json:"enableLogging,omitempty"`
+
+	// Tags to attach to the trail.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// TrailObservation keeps the state for the external resource
+type TrailObservation struct {
+	// TrailARN is the ARN of the trail.
+	TrailARN string `json:"trailArn,omitempty"`
+
+	// HomeRegion is the region the trail was created in.
+	HomeRegion string `json:"homeRegion,omitempty"`
+
+	// IsLogging indicates whether the trail is currently recording events.
+	IsLogging bool `json:"isLogging,omitempty"`
+
+	// LatestDeliveryError is the last error CloudTrail ran into when
+	// delivering log files to the bucket.
+	LatestDeliveryError string `json:"latestDeliveryError,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A TrailSpec defines the desired state of a Trail.
+type TrailSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TrailParameters `json:"forProvider"`
+}
+
+// A TrailStatus represents the observed state of a Trail.
+type TrailStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TrailObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Trail is a managed resource that represents an AWS CloudTrail trail,
+// which delivers the API activity of an account to S3.
+// +kubebuilder:printcolumn:name="LOGGING",type="boolean",JSONPath=".status.atProvider.isLogging"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Trail struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TrailSpec   `json:"spec"`
+	Status TrailStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TrailList contains a list of Trails
+type TrailList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Trail `json:"items"`
+}
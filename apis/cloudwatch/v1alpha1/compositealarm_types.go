@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CompositeAlarmParameters define the desired state of a CloudWatch
+// composite alarm.
+type CompositeAlarmParameters struct {
+	// Region is which region the alarm will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// AlarmDescription is the description for the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// ActionsEnabled indicates whether actions are executed during any
+	// changes to the alarm state. Defaults to true.
+	// +optional
+	ActionsEnabled *bool `json:"actionsEnabled,omitempty"`
+
+	AlarmActionParameters `json:",inline"`
+
+	// AlarmRule is an expression that specifies which other alarms are to
+	// be evaluated to determine this composite alarm's state, for example
+	// ALARM("cpu-high") AND NOT OK("disk-full").
+	AlarmRule string `json:"alarmRule"`
+
+	// Tags to attach to the alarm.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A CompositeAlarmSpec defines the desired state of a CompositeAlarm.
+type CompositeAlarmSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CompositeAlarmParameters `json:"forProvider"`
+}
+
+// A CompositeAlarmStatus represents the observed state of a CompositeAlarm.
+type CompositeAlarmStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AlarmObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CompositeAlarm is a managed resource that represents an AWS CloudWatch
+// alarm whose state is determined by the states of other alarms.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type CompositeAlarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CompositeAlarmSpec   `json:"spec"`
+	Status CompositeAlarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CompositeAlarmList contains a list of CompositeAlarms
+type CompositeAlarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompositeAlarm `json:"items"`
+}
@@ -0,0 +1,336 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Tag is a key-value pair attached to an alarm.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// Dimension is a name-value pair that is part of the identity of a metric.
+type Dimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+
+	// Value of the dimension.
+	Value string `json:"value"`
+}
+
+// Metric identifies a CloudWatch metric.
+type Metric struct {
+	// MetricName is the name of the metric.
+	// +optional
+	MetricName *string `json:"metricName,omitempty"`
+
+	// Namespace of the metric.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Dimensions of the metric.
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+}
+
+// MetricStat defines the metric, statistic and period of a metric that is
+// retrieved by a MetricDataQuery.
+type MetricStat struct {
+	// Metric to return.
+	Metric Metric `json:"metric"`
+
+	// Period is the granularity, in seconds, of the returned data points.
+	Period int64 `json:"period"`
+
+	// Stat is the statistic to return. It can include any CloudWatch
+	// statistic or extended statistic.
+	Stat string `json:"stat"`
+
+	// Unit to filter the returned data points by.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// MetricDataQuery is either a metric to retrieve or a metric math
+// expression to evaluate on retrieved metrics.
+type MetricDataQuery struct {
+	// ID is a short name used to tie this query to the results and to
+	// refer to it in math expressions. It must start with a lowercase
+	// letter.
+	ID string `json:"id"`
+
+	// AccountID is the ID of the account where the metric is located, if
+	// this is a cross-account alarm.
+	// +optional
+	AccountID *string `json:"accountId,omitempty"`
+
+	// Expression is the math expression to be performed on the returned
+	// data. Exactly one of Expression and MetricStat must be set.
+	// +optional
+	Expression *string `json:"expression,omitempty"`
+
+	// Label is a human-readable label for this metric or expression.
+	// +optional
+	Label *string `json:"label,omitempty"`
+
+	// MetricStat is the metric to be returned, along with statistics,
+	// period, and units.
+	// +optional
+	MetricStat *MetricStat `json:"metricStat,omitempty"`
+
+	// Period is the granularity, in seconds, of the returned data points
+	// of a math expression.
+	// +optional
+	Period *int64 `json:"period,omitempty"`
+
+	// ReturnData indicates whether this query is the one whose result the
+	// alarm watches. Exactly one query of an alarm must return data.
+	// +optional
+	ReturnData *bool `json:"returnData,omitempty"`
+}
+
+// MetricAlarmParameters define the desired state of a CloudWatch metric
+// alarm.
+type MetricAlarmParameters struct {
+	// Region is which region the alarm will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// AlarmDescription is the description for the alarm.
+	// +optional
+	AlarmDescription *string `json:"alarmDescription,omitempty"`
+
+	// ActionsEnabled indicates whether actions are executed during any
+	// changes to the alarm state. Defaults to true.
+	// +optional
+	ActionsEnabled *bool `json:"actionsEnabled,omitempty"`
+
+	AlarmActionParameters `json:",inline"`
+
+	// ComparisonOperator is the arithmetic operation to use when comparing
+	// the specified statistic and threshold.
+	// +kubebuilder:validation:Enum=GreaterThanOrEqualToThreshold;GreaterThanThreshold;LessThanThreshold;LessThanOrEqualToThreshold;LessThanLowerOrGreaterThanUpperThreshold;LessThanLowerThreshold;GreaterThanUpperThreshold
+	ComparisonOperator string `json:"comparisonOperator"`
+
+	// EvaluationPeriods is the number of periods over which data is
+	// compared to the specified threshold.
+	// +kubebuilder:validation:Minimum=1
+	EvaluationPeriods int64 `json:"evaluationPeriods"`
+
+	// DatapointsToAlarm is the number of data points that must be breaching
+	// to trigger the alarm.
+	// +optional
+	DatapointsToAlarm *int64 `json:"datapointsToAlarm,omitempty"`
+
+	// Threshold is the value against which the specified statistic is
+	// compared. It is required unless the alarm is based on an anomaly
+	// detection model.
+	// +optional
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// ThresholdMetricID is the ID of the anomaly detection band function
+	// used as the threshold of an anomaly detection alarm.
+	// +optional
+	ThresholdMetricID *string `json:"thresholdMetricId,omitempty"`
+
+	// TreatMissingData sets how the alarm handles missing data points.
+	// +kubebuilder:validation:Enum=breaching;notBreaching;ignore;missing
+	// +optional
+	TreatMissingData *string `json:"treatMissingData,omitempty"`
+
+	// EvaluateLowSampleCountPercentile sets how percentile-based alarms
+	// behave when there are too few data points.
+	// +kubebuilder:validation:Enum=evaluate;ignore
+	// +optional
+	EvaluateLowSampleCountPercentile *string `json:"evaluateLowSampleCountPercentile,omitempty"`
+
+	// MetricName is the name of the metric associated with the alarm. It
+	// must not be set if Metrics is set.
+	// +optional
+	MetricName *string `json:"metricName,omitempty"`
+
+	// Namespace of the metric associated with the alarm.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Dimensions of the metric associated with the alarm.
+	// +optional
+	Dimensions []Dimension `json:"dimensions,omitempty"`
+
+	// Statistic for the metric associated with the alarm, other than
+	// percentile.
+	// +kubebuilder:validation:Enum=SampleCount;Average;Sum;Minimum;Maximum
+	// +optional
+	Statistic *string `json:"statistic,omitempty"`
+
+	// ExtendedStatistic is the percentile statistic for the metric
+	// associated with the alarm.
+	// +optional
+	ExtendedStatistic *string `json:"extendedStatistic,omitempty"`
+
+	// Period, in seconds, over which the statistic is applied.
+	// +optional
+	Period *int64 `json:"period,omitempty"`
+
+	// Unit of the metric associated with the alarm.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+
+	// Metrics is an array of metric data queries that allows the alarm to
+	// be based on the result of a metric math expression. It must not be
+	// set together with MetricName.
+	// +optional
+	Metrics []MetricDataQuery `json:"metrics,omitempty"`
+
+	// Tags to attach to the alarm.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// AlarmActionParameters are the actions of an alarm that are executed when
+// it transitions into a state.
+type AlarmActionParameters struct {
+	// AlarmActions are the ARNs of the actions to execute when the alarm
+	// transitions into the ALARM state.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
+	// +crossplane:generate:reference:refFieldName=AlarmActionRefs
+	// +crossplane:generate:reference:selectorFieldName=AlarmActionSelector
+	AlarmActions []string `json:"alarmActions,omitempty"`
+
+	// AlarmActionRefs are references to SNS Topics used to set the
+	// AlarmActions.
+	// +optional
+	AlarmActionRefs []xpv1.Reference `json:"alarmActionRefs,omitempty"`
+
+	// AlarmActionSelector selects references to SNS Topics used to set the
+	// AlarmActions.
+	// +optional
+	AlarmActionSelector *xpv1.Selector `json:"alarmActionSelector,omitempty"`
+
+	// OKActions are the ARNs of the actions to execute when the alarm
+	// transitions into the OK state.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
+	// +crossplane:generate:reference:refFieldName=OKActionRefs
+	// +crossplane:generate:reference:selectorFieldName=OKActionSelector
+	OKActions []string `json:"okActions,omitempty"`
+
+	// OKActionRefs are references to SNS Topics used to set the OKActions.
+	// +optional
+	OKActionRefs []xpv1.Reference `json:"okActionRefs,omitempty"`
+
+	// OKActionSelector selects references to SNS Topics used to set the
+	// OKActions.
+	// +optional
+	OKActionSelector *xpv1.Selector `json:"okActionSelector,omitempty"`
+
+	// InsufficientDataActions are the ARNs of the actions to execute when
+	// the alarm transitions into the INSUFFICIENT_DATA state.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
+	// +crossplane:generate:reference:refFieldName=InsufficientDataActionRefs
+	// +crossplane:generate:reference:selectorFieldName=InsufficientDataActionSelector
+	InsufficientDataActions []string `json:"insufficientDataActions,omitempty"`
+
+	// InsufficientDataActionRefs are references to SNS Topics used to set
+	// the InsufficientDataActions.
+	// +optional
+	InsufficientDataActionRefs []xpv1.Reference `json:"insufficientDataActionRefs,omitempty"`
+
+	// InsufficientDataActionSelector selects references to SNS Topics used
+	// to set the InsufficientDataActions.
+	// +optional
+	InsufficientDataActionSelector *xpv1.Selector `json:"insufficientDataActionSelector,omitempty"`
+}
+
+// AlarmObservation keeps the state of an alarm.
+type AlarmObservation struct {
+	// AlarmARN is the Amazon Resource Name (ARN) of the alarm.
+	AlarmARN string `json:"alarmArn,omitempty"`
+
+	// StateValue is the state value of the alarm.
+	StateValue string `json:"stateValue,omitempty"`
+
+	// StateReason is an explanation of the alarm state, in text format.
+	StateReason string `json:"stateReason,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A MetricAlarmSpec defines the desired state of a MetricAlarm.
+type MetricAlarmSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MetricAlarmParameters `json:"forProvider"`
+}
+
+// A MetricAlarmStatus represents the observed state of a MetricAlarm.
+type MetricAlarmStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AlarmObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MetricAlarm is a managed resource that represents an AWS CloudWatch
+// alarm that watches a single metric or the result of a metric math
+// expression.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.stateValue"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MetricAlarm struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MetricAlarmSpec   `json:"spec"`
+	Status MetricAlarmStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MetricAlarmList contains a list of MetricAlarms
+type MetricAlarmList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MetricAlarm `json:"items"`
+}
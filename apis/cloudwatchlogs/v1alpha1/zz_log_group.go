@@ -50,6 +50,8 @@ type LogGroupSpec struct {
 
 // LogGroupObservation defines the observed state of LogGroup
 type LogGroupObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // LogGroupStatus defines the observed state of LogGroup.
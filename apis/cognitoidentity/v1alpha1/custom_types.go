@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// CustomIdentityPoolParameters includes custom additional fields for IdentityPoolParameters.
+type CustomIdentityPoolParameters struct {
+	// An array of Amazon Cognito user pools and their client IDs.
+	// +optional
+	CognitoIdentityProviders []*CognitoIdentityProviderParameters `json:"cognitoIdentityProviders,omitempty"`
+
+	// The Amazon Resource Names (ARN) of the OpenID Connect providers.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.OpenIDConnectProvider
+	// +crossplane:generate:reference:refFieldName=OpenIDConnectProviderARNRefs
+	// +crossplane:generate:reference:selectorFieldName=OpenIDConnectProviderARNSelector
+	// +optional
+	OpenIDConnectProviderARNs []*string `json:"openIdConnectProviderARNs,omitempty"`
+
+	// OpenIDConnectProviderARNRefs is a list of references to
+	// OpenIDConnectProviders used to set the OpenIDConnectProviderARNs.
+	// +optional
+	OpenIDConnectProviderARNRefs []xpv1.Reference `json:"openIdConnectProviderARNRefs,omitempty"`
+
+	// OpenIDConnectProviderARNSelector selects references to
+	// OpenIDConnectProviders used to set the OpenIDConnectProviderARNs.
+	// +optional
+	OpenIDConnectProviderARNSelector *xpv1.Selector `json:"openIdConnectProviderARNSelector,omitempty"`
+}
+
+// CognitoIdentityProviderParameters is a Cognito user pool and client that
+// are used as an identity provider of an IdentityPool.
+type CognitoIdentityProviderParameters struct {
+	// The client ID for the Amazon Cognito user pool.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1.UserPoolClient
+	// +optional
+	ClientID *string `json:"clientId,omitempty"`
+
+	// ClientIDRef is a reference to a UserPoolClient used to set the ClientID.
+	// +optional
+	ClientIDRef *xpv1.Reference `json:"clientIdRef,omitempty"`
+
+	// ClientIDSelector selects a reference to a UserPoolClient used to set
+	// the ClientID.
+	// +optional
+	ClientIDSelector *xpv1.Selector `json:"clientIdSelector,omitempty"`
+
+	// The provider name for an Amazon Cognito user pool. For example,
+	// cognito-idp.us-east-1.amazonaws.com/us-east-1_123456789.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1.UserPool
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1.UserPoolProviderName()
+	// +optional
+	ProviderName *string `json:"providerName,omitempty"`
+
+	// ProviderNameRef is a reference to a UserPool used to set the
+	// ProviderName.
+	// +optional
+	ProviderNameRef *xpv1.Reference `json:"providerNameRef,omitempty"`
+
+	// ProviderNameSelector selects a reference to a UserPool used to set the
+	// ProviderName.
+	// +optional
+	ProviderNameSelector *xpv1.Selector `json:"providerNameSelector,omitempty"`
+
+	// TRUE if server-side token validation is enabled for the identity
+	// provider's token.
+	// +optional
+	ServerSideTokenCheck *bool `json:"serverSideTokenCheck,omitempty"`
+}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -0,0 +1,213 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// IdentityPoolRoleAttachmentParameters defines the desired state of
+// IdentityPoolRoleAttachment
+type IdentityPoolRoleAttachmentParameters struct {
+	// Region is which region the IdentityPoolRoleAttachment will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the identity pool the roles are attached to.
+	// +immutable
+	// +crossplane:generate:reference:type=IdentityPool
+	// +optional
+	IdentityPoolID *string `json:"identityPoolId,omitempty"`
+
+	// IdentityPoolIDRef is a reference to an IdentityPool used to set the
+	// IdentityPoolID.
+	// +optional
+	IdentityPoolIDRef *xpv1.Reference `json:"identityPoolIdRef,omitempty"`
+
+	// IdentityPoolIDSelector selects a reference to an IdentityPool used to
+	// set the IdentityPoolID.
+	// +optional
+	IdentityPoolIDSelector *xpv1.Selector `json:"identityPoolIdSelector,omitempty"`
+
+	// The roles of authenticated and unauthenticated identities.
+	// +kubebuilder:validation:Required
+	Roles IdentityPoolRoles `json:"roles"`
+
+	// How users for specific identity providers are mapped to roles.
+	// +optional
+	RoleMappings []RoleMappingParameters `json:"roleMappings,omitempty"`
+}
+
+// IdentityPoolRoles are the default roles of the identities of an
+// IdentityPool.
+type IdentityPoolRoles struct {
+	// The ARN of the role assumed by authenticated identities.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	Authenticated *string `json:"authenticated,omitempty"`
+
+	// AuthenticatedRef is a reference to a Role used to set Authenticated.
+	// +optional
+	AuthenticatedRef *xpv1.Reference `json:"authenticatedRef,omitempty"`
+
+	// AuthenticatedSelector selects a reference to a Role used to set
+	// Authenticated.
+	// +optional
+	AuthenticatedSelector *xpv1.Selector `json:"authenticatedSelector,omitempty"`
+
+	// The ARN of the role assumed by unauthenticated identities.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	Unauthenticated *string `json:"unauthenticated,omitempty"`
+
+	// UnauthenticatedRef is a reference to a Role used to set
+	// Unauthenticated.
+	// +optional
+	UnauthenticatedRef *xpv1.Reference `json:"unauthenticatedRef,omitempty"`
+
+	// UnauthenticatedSelector selects a reference to a Role used to set
+	// Unauthenticated.
+	// +optional
+	UnauthenticatedSelector *xpv1.Selector `json:"unauthenticatedSelector,omitempty"`
+}
+
+// RoleMappingParameters maps the users of an identity provider to roles.
+type RoleMappingParameters struct {
+	// The identity provider the mapping applies to, e.g. graph.facebook.com
+	// or cognito-idp.us-east-1.amazonaws.com/us-east-1_abcdefghi:app_client_id.
+	// +kubebuilder:validation:Required
+	IdentityProvider string `json:"identityProvider"`
+
+	// The role mapping type. Token uses the cognito:roles and
+	// cognito:preferred_role claims of the token, Rules uses the rules of
+	// the RulesConfiguration.
+	// +kubebuilder:validation:Enum=Token;Rules
+	Type string `json:"type"`
+
+	// How to resolve the role if the mapping doesn't yield one, or more
+	// than one role. Required for mappings of type Token or Rules.
+	// +kubebuilder:validation:Enum=AuthenticatedRole;Deny
+	// +optional
+	AmbiguousRoleResolution *string `json:"ambiguousRoleResolution,omitempty"`
+
+	// The rules of a mapping of type Rules. The first matching rule wins.
+	// +optional
+	Rules []MappingRuleParameters `json:"rules,omitempty"`
+}
+
+// MappingRuleParameters maps a claim of the token to a role.
+type MappingRuleParameters struct {
+	// The claim name that must be present in the token.
+	// +kubebuilder:validation:Required
+	Claim string `json:"claim"`
+
+	// How to compare the claim with the value.
+	// +kubebuilder:validation:Enum=Equals;Contains;StartsWith;NotEqual
+	MatchType string `json:"matchType"`
+
+	// The value of the claim.
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+
+	// The ARN of the role the rule maps to.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to a Role used to set the RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to a Role used to set the RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+}
+
+// IdentityPoolRoleAttachmentSpec defines the desired state of
+// IdentityPoolRoleAttachment
+type IdentityPoolRoleAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IdentityPoolRoleAttachmentParameters `json:"forProvider"`
+}
+
+// IdentityPoolRoleAttachmentObservation defines the observed state of
+// IdentityPoolRoleAttachment
+type IdentityPoolRoleAttachmentObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// IdentityPoolRoleAttachmentStatus defines the observed state of
+// IdentityPoolRoleAttachment.
+type IdentityPoolRoleAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IdentityPoolRoleAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityPoolRoleAttachment sets the roles of an IdentityPool. An identity
+// pool has exactly one set of roles, so there must be at most one
+// IdentityPoolRoleAttachment per IdentityPool.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="IDENTITYPOOL",type="string",JSONPath=".spec.forProvider.identityPoolId"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IdentityPoolRoleAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              IdentityPoolRoleAttachmentSpec   `json:"spec"`
+	Status            IdentityPoolRoleAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IdentityPoolRoleAttachmentList contains a list of
+// IdentityPoolRoleAttachments
+type IdentityPoolRoleAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IdentityPoolRoleAttachment `json:"items"`
+}
+
+// Repository type metadata.
+var (
+	IdentityPoolRoleAttachmentKind             = "IdentityPoolRoleAttachment"
+	IdentityPoolRoleAttachmentGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: IdentityPoolRoleAttachmentKind}.String()
+	IdentityPoolRoleAttachmentKindAPIVersion   = IdentityPoolRoleAttachmentKind + "." + GroupVersion.String()
+	IdentityPoolRoleAttachmentGroupVersionKind = GroupVersion.WithKind(IdentityPoolRoleAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&IdentityPoolRoleAttachment{}, &IdentityPoolRoleAttachmentList{})
+}
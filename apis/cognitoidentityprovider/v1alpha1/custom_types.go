@@ -16,7 +16,27 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AnnotationKeyRotateClientSecret can be set on a UserPoolClient that has a
+// secret to rotate it. A new rotation is triggered every time the value of the
+// annotation changes. Cognito cannot regenerate the secret of a client, so it
+// is rotated by replacing the client with a new one of the same configuration,
+// which changes the client ID too.
+const AnnotationKeyRotateClientSecret = CRDGroup + "/rotate-secret"
+
+// AnnotationKeyLastSecretRotation records the value of the rotate-secret
+// annotation that the secret of a UserPoolClient was last rotated for.
+const AnnotationKeyLastSecretRotation = CRDGroup + "/last-secret-rotation"
+
+// AnnotationKeyPreviousClientID records the ID of the client that was replaced
+// by the last secret rotation of a UserPoolClient. The previous client is
+// deleted once the connection details of the new one were published.
+const AnnotationKeyPreviousClientID = CRDGroup + "/previous-client-id"
 
 // CustomGroupParameters includes custom additional fields for GroupParameters.
 type CustomGroupParameters struct {
@@ -86,10 +106,249 @@ type CustomIdentityProviderParameters struct {
 }
 
 // CustomUserPoolParameters includes custom additional fields for UserPoolParameters.
-type CustomUserPoolParameters struct{}
+type CustomUserPoolParameters struct {
+	// The Lambda trigger configuration information for the new user pool.
+	//
+	// In a push model, event sources (such as Amazon S3 and custom applications)
+	// need permission to invoke a function. So you will need to make an extra call
+	// to add permission for these event sources to invoke your Lambda function.
+	//
+	// For more information on using the Lambda API to add permission, see AddPermission
+	// (https://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html).
+	// +optional
+	LambdaConfig *LambdaConfigParameters `json:"lambdaConfig,omitempty"`
+
+	// The SMS text message MFA configuration. It is applied with a separate
+	// SetUserPoolMfaConfig call after the user pool has been created.
+	// +optional
+	SmsMFAConfiguration *SmsMFAConfigType `json:"smsMFAConfiguration,omitempty"`
+
+	// The software token (TOTP) MFA configuration. It is applied with a
+	// separate SetUserPoolMfaConfig call after the user pool has been created.
+	// +optional
+	SoftwareTokenMFAConfiguration *SoftwareTokenMFAConfigType `json:"softwareTokenMFAConfiguration,omitempty"`
+}
+
+// LambdaConfigParameters specifies the Lambda triggers of a user pool. Each
+// trigger may reference a Lambda Function instead of specifying its ARN.
+type LambdaConfigParameters struct {
+	// Creates an authentication challenge.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	CreateAuthChallenge *string `json:"createAuthChallenge,omitempty"`
+
+	// CreateAuthChallengeRef is a reference to the Lambda Function used as CreateAuthChallenge trigger.
+	// +optional
+	CreateAuthChallengeRef *xpv1.Reference `json:"createAuthChallengeRef,omitempty"`
+
+	// CreateAuthChallengeSelector selects a reference to the Lambda Function used as
+	// CreateAuthChallenge trigger.
+	// +optional
+	CreateAuthChallengeSelector *xpv1.Selector `json:"createAuthChallengeSelector,omitempty"`
+
+	// A custom email sender Lambda configuration type.
+	// +optional
+	CustomEmailSender *CustomLambdaVersionConfig `json:"customEmailSender,omitempty"`
+
+	// A custom Message Lambda trigger.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	CustomMessage *string `json:"customMessage,omitempty"`
+
+	// CustomMessageRef is a reference to the Lambda Function used as CustomMessage trigger.
+	// +optional
+	CustomMessageRef *xpv1.Reference `json:"customMessageRef,omitempty"`
+
+	// CustomMessageSelector selects a reference to the Lambda Function used as
+	// CustomMessage trigger.
+	// +optional
+	CustomMessageSelector *xpv1.Selector `json:"customMessageSelector,omitempty"`
+
+	// A custom SMS sender Lambda configuration type.
+	// +optional
+	CustomSMSSender *CustomLambdaVersionConfig `json:"customSMSSender,omitempty"`
+
+	// Defines the authentication challenge.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	DefineAuthChallenge *string `json:"defineAuthChallenge,omitempty"`
+
+	// DefineAuthChallengeRef is a reference to the Lambda Function used as DefineAuthChallenge trigger.
+	// +optional
+	DefineAuthChallengeRef *xpv1.Reference `json:"defineAuthChallengeRef,omitempty"`
+
+	// DefineAuthChallengeSelector selects a reference to the Lambda Function used as
+	// DefineAuthChallenge trigger.
+	// +optional
+	DefineAuthChallengeSelector *xpv1.Selector `json:"defineAuthChallengeSelector,omitempty"`
+
+	// The Amazon Resource Name of Key Management Service Customer master keys
+	// (/kms/latest/developerguide/concepts.html#master_keys) . Amazon Cognito uses
+	// the key to encrypt codes and temporary passwords sent to CustomEmailSender
+	// and CustomSMSSender.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	// KMSKeyIDRef is a reference to the KMS Key used to encrypt codes and
+	// temporary passwords.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIDRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to the KMS Key used to encrypt
+	// codes and temporary passwords.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIDSelector,omitempty"`
+
+	// A post-authentication Lambda trigger.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	PostAuthentication *string `json:"postAuthentication,omitempty"`
+
+	// PostAuthenticationRef is a reference to the Lambda Function used as PostAuthentication trigger.
+	// +optional
+	PostAuthenticationRef *xpv1.Reference `json:"postAuthenticationRef,omitempty"`
+
+	// PostAuthenticationSelector selects a reference to the Lambda Function used as
+	// PostAuthentication trigger.
+	// +optional
+	PostAuthenticationSelector *xpv1.Selector `json:"postAuthenticationSelector,omitempty"`
+
+	// A post-confirmation Lambda trigger.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	PostConfirmation *string `json:"postConfirmation,omitempty"`
+
+	// PostConfirmationRef is a reference to the Lambda Function used as PostConfirmation trigger.
+	// +optional
+	PostConfirmationRef *xpv1.Reference `json:"postConfirmationRef,omitempty"`
+
+	// PostConfirmationSelector selects a reference to the Lambda Function used as
+	// PostConfirmation trigger.
+	// +optional
+	PostConfirmationSelector *xpv1.Selector `json:"postConfirmationSelector,omitempty"`
+
+	// A pre-authentication Lambda trigger.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	PreAuthentication *string `json:"preAuthentication,omitempty"`
+
+	// PreAuthenticationRef is a reference to the Lambda Function used as PreAuthentication trigger.
+	// +optional
+	PreAuthenticationRef *xpv1.Reference `json:"preAuthenticationRef,omitempty"`
+
+	// PreAuthenticationSelector selects a reference to the Lambda Function used as
+	// PreAuthentication trigger.
+	// +optional
+	PreAuthenticationSelector *xpv1.Selector `json:"preAuthenticationSelector,omitempty"`
+
+	// A pre-registration Lambda trigger.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	PreSignUp *string `json:"preSignUp,omitempty"`
+
+	// PreSignUpRef is a reference to the Lambda Function used as PreSignUp trigger.
+	// +optional
+	PreSignUpRef *xpv1.Reference `json:"preSignUpRef,omitempty"`
+
+	// PreSignUpSelector selects a reference to the Lambda Function used as
+	// PreSignUp trigger.
+	// +optional
+	PreSignUpSelector *xpv1.Selector `json:"preSignUpSelector,omitempty"`
+
+	// A Lambda trigger that is invoked before token generation.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	PreTokenGeneration *string `json:"preTokenGeneration,omitempty"`
+
+	// PreTokenGenerationRef is a reference to the Lambda Function used as PreTokenGeneration trigger.
+	// +optional
+	PreTokenGenerationRef *xpv1.Reference `json:"preTokenGenerationRef,omitempty"`
+
+	// PreTokenGenerationSelector selects a reference to the Lambda Function used as
+	// PreTokenGeneration trigger.
+	// +optional
+	PreTokenGenerationSelector *xpv1.Selector `json:"preTokenGenerationSelector,omitempty"`
+
+	// The user migration Lambda config type.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	UserMigration *string `json:"userMigration,omitempty"`
+
+	// UserMigrationRef is a reference to the Lambda Function used as UserMigration trigger.
+	// +optional
+	UserMigrationRef *xpv1.Reference `json:"userMigrationRef,omitempty"`
+
+	// UserMigrationSelector selects a reference to the Lambda Function used as
+	// UserMigration trigger.
+	// +optional
+	UserMigrationSelector *xpv1.Selector `json:"userMigrationSelector,omitempty"`
+
+	// Verifies the authentication challenge response.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	VerifyAuthChallengeResponse *string `json:"verifyAuthChallengeResponse,omitempty"`
+
+	// VerifyAuthChallengeResponseRef is a reference to the Lambda Function used as VerifyAuthChallengeResponse trigger.
+	// +optional
+	VerifyAuthChallengeResponseRef *xpv1.Reference `json:"verifyAuthChallengeResponseRef,omitempty"`
+
+	// VerifyAuthChallengeResponseSelector selects a reference to the Lambda Function used as
+	// VerifyAuthChallengeResponse trigger.
+	// +optional
+	VerifyAuthChallengeResponseSelector *xpv1.Selector `json:"verifyAuthChallengeResponseSelector,omitempty"`
+}
+
+// CustomLambdaVersionConfig specifies a custom email or SMS sender Lambda
+// trigger.
+type CustomLambdaVersionConfig struct {
+	// The Lambda Amazon Resource Name of the Lambda function that Amazon Cognito
+	// triggers to send messages to users.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/lambda/v1beta1.Function
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/lambda/v1beta1.FunctionARN()
+	LambdaARN *string `json:"lambdaARN,omitempty"`
+
+	// LambdaARNRef is a reference to the Lambda Function that sends messages.
+	// +optional
+	LambdaARNRef *xpv1.Reference `json:"lambdaARNRef,omitempty"`
+
+	// LambdaARNSelector selects a reference to the Lambda Function that sends
+	// messages.
+	// +optional
+	LambdaARNSelector *xpv1.Selector `json:"lambdaARNSelector,omitempty"`
+
+	// The Lambda version represents the signature of the "request" attribute
+	// in the "event" information Amazon Cognito passes to your sender Lambda
+	// function. The only supported value is V1_0.
+	// +kubebuilder:validation:Required
+	LambdaVersion *string `json:"lambdaVersion"`
+}
 
 // CustomUserPoolDomainParameters includes custom additional fields for UserPoolDomainParameters.
 type CustomUserPoolDomainParameters struct {
+	// The configuration for a custom domain that hosts the sign-up and sign-in
+	// webpages for your application. The external name of a UserPoolDomain
+	// with a custom domain configuration is the fully qualified custom domain,
+	// e.g. auth.example.com; otherwise it is the prefix of the Amazon Cognito
+	// hosted domain.
+	//
+	// For more information about the hosted domain and custom domains, see Configuring
+	// a User Pool Domain (https://docs.aws.amazon.com/cognito/latest/developerguide/cognito-user-pools-assign-domain.html).
+	// +optional
+	CustomDomainConfig *CustomDomainConfigParameters `json:"customDomainConfig,omitempty"`
+
 	// The user pool ID.
 	// +immutable
 	// +crossplane:generate:reference:type=UserPool
@@ -104,6 +363,26 @@ type CustomUserPoolDomainParameters struct {
 	UserPoolIDSelector *xpv1.Selector `json:"userPoolIdSelector,omitempty"`
 }
 
+// CustomDomainConfigParameters configures a custom domain of a user pool.
+type CustomDomainConfigParameters struct {
+	// The Amazon Resource Name (ARN) of an Certificate Manager SSL certificate.
+	// You use this certificate for the subdomain of your custom domain. The
+	// certificate must be issued in the us-east-1 region.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/acm/v1beta1.Certificate
+	CertificateARN *string `json:"certificateARN,omitempty"`
+
+	// CertificateARNRef is a reference to the ACM Certificate of the custom
+	// domain.
+	// +optional
+	CertificateARNRef *xpv1.Reference `json:"certificateARNRef,omitempty"`
+
+	// CertificateARNSelector selects a reference to the ACM Certificate of the
+	// custom domain.
+	// +optional
+	CertificateARNSelector *xpv1.Selector `json:"certificateARNSelector,omitempty"`
+}
+
 // CustomUserPoolClientParameters includes custom additional fields for UserPoolClientParameters.
 type CustomUserPoolClientParameters struct {
 	// The user pool ID.
@@ -119,3 +398,8 @@ type CustomUserPoolClientParameters struct {
 	// +optional
 	UserPoolIDSelector *xpv1.Selector `json:"userPoolIdSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -0,0 +1,41 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// UserPoolProviderName returns the name a UserPool is known by as an identity
+// provider, e.g. cognito-idp.us-east-1.amazonaws.com/us-east-1_123456789.
+func UserPoolProviderName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*UserPool)
+		if !ok {
+			return ""
+		}
+		id := meta.GetExternalName(r)
+		if id == "" {
+			return ""
+		}
+		return fmt.Sprintf("cognito-idp.%s.amazonaws.com/%s", r.Spec.ForProvider.Region, id)
+	}
+}
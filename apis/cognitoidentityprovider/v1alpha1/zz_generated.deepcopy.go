@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -246,6 +247,36 @@ func (in *ContextDataType) DeepCopy() *ContextDataType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomDomainConfigParameters) DeepCopyInto(out *CustomDomainConfigParameters) {
+	*out = *in
+	if in.CertificateARN != nil {
+		in, out := &in.CertificateARN, &out.CertificateARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.CertificateARNRef != nil {
+		in, out := &in.CertificateARNRef, &out.CertificateARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CertificateARNSelector != nil {
+		in, out := &in.CertificateARNSelector, &out.CertificateARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomDomainConfigParameters.
+func (in *CustomDomainConfigParameters) DeepCopy() *CustomDomainConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomDomainConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomDomainConfigType) DeepCopyInto(out *CustomDomainConfigType) {
 	*out = *in
@@ -367,6 +398,41 @@ func (in *CustomIdentityProviderParameters) DeepCopy() *CustomIdentityProviderPa
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomLambdaVersionConfig) DeepCopyInto(out *CustomLambdaVersionConfig) {
+	*out = *in
+	if in.LambdaARN != nil {
+		in, out := &in.LambdaARN, &out.LambdaARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.LambdaARNRef != nil {
+		in, out := &in.LambdaARNRef, &out.LambdaARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LambdaARNSelector != nil {
+		in, out := &in.LambdaARNSelector, &out.LambdaARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LambdaVersion != nil {
+		in, out := &in.LambdaVersion, &out.LambdaVersion
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomLambdaVersionConfig.
+func (in *CustomLambdaVersionConfig) DeepCopy() *CustomLambdaVersionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomLambdaVersionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomSMSLambdaVersionConfigType) DeepCopyInto(out *CustomSMSLambdaVersionConfigType) {
 	*out = *in
@@ -425,6 +491,11 @@ func (in *CustomUserPoolClientParameters) DeepCopy() *CustomUserPoolClientParame
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomUserPoolDomainParameters) DeepCopyInto(out *CustomUserPoolDomainParameters) {
 	*out = *in
+	if in.CustomDomainConfig != nil {
+		in, out := &in.CustomDomainConfig, &out.CustomDomainConfig
+		*out = new(CustomDomainConfigParameters)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UserPoolID != nil {
 		in, out := &in.UserPoolID, &out.UserPoolID
 		*out = new(string)
@@ -455,6 +526,21 @@ func (in *CustomUserPoolDomainParameters) DeepCopy() *CustomUserPoolDomainParame
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomUserPoolParameters) DeepCopyInto(out *CustomUserPoolParameters) {
 	*out = *in
+	if in.LambdaConfig != nil {
+		in, out := &in.LambdaConfig, &out.LambdaConfig
+		*out = new(LambdaConfigParameters)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SmsMFAConfiguration != nil {
+		in, out := &in.SmsMFAConfiguration, &out.SmsMFAConfiguration
+		*out = new(SmsMFAConfigType)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoftwareTokenMFAConfiguration != nil {
+		in, out := &in.SoftwareTokenMFAConfiguration, &out.SoftwareTokenMFAConfiguration
+		*out = new(SoftwareTokenMFAConfigType)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomUserPoolParameters.
@@ -798,6 +884,11 @@ func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 		in, out := &in.LastModifiedDate, &out.LastModifiedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RoleARN != nil {
 		in, out := &in.RoleARN, &out.RoleARN
 		*out = new(string)
@@ -1023,6 +1114,11 @@ func (in *IdentityProviderObservation) DeepCopyInto(out *IdentityProviderObserva
 		in, out := &in.LastModifiedDate, &out.LastModifiedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderName != nil {
 		in, out := &in.ProviderName, &out.ProviderName
 		*out = new(string)
@@ -1190,6 +1286,196 @@ func (in *IdentityProviderType) DeepCopy() *IdentityProviderType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LambdaConfigParameters) DeepCopyInto(out *LambdaConfigParameters) {
+	*out = *in
+	if in.CreateAuthChallenge != nil {
+		in, out := &in.CreateAuthChallenge, &out.CreateAuthChallenge
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreateAuthChallengeRef != nil {
+		in, out := &in.CreateAuthChallengeRef, &out.CreateAuthChallengeRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CreateAuthChallengeSelector != nil {
+		in, out := &in.CreateAuthChallengeSelector, &out.CreateAuthChallengeSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomEmailSender != nil {
+		in, out := &in.CustomEmailSender, &out.CustomEmailSender
+		*out = new(CustomLambdaVersionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomMessage != nil {
+		in, out := &in.CustomMessage, &out.CustomMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.CustomMessageRef != nil {
+		in, out := &in.CustomMessageRef, &out.CustomMessageRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.CustomMessageSelector != nil {
+		in, out := &in.CustomMessageSelector, &out.CustomMessageSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomSMSSender != nil {
+		in, out := &in.CustomSMSSender, &out.CustomSMSSender
+		*out = new(CustomLambdaVersionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefineAuthChallenge != nil {
+		in, out := &in.DefineAuthChallenge, &out.DefineAuthChallenge
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefineAuthChallengeRef != nil {
+		in, out := &in.DefineAuthChallengeRef, &out.DefineAuthChallengeRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DefineAuthChallengeSelector != nil {
+		in, out := &in.DefineAuthChallengeSelector, &out.DefineAuthChallengeSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KMSKeyIDRef != nil {
+		in, out := &in.KMSKeyIDRef, &out.KMSKeyIDRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KMSKeyIDSelector != nil {
+		in, out := &in.KMSKeyIDSelector, &out.KMSKeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostAuthentication != nil {
+		in, out := &in.PostAuthentication, &out.PostAuthentication
+		*out = new(string)
+		**out = **in
+	}
+	if in.PostAuthenticationRef != nil {
+		in, out := &in.PostAuthenticationRef, &out.PostAuthenticationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PostAuthenticationSelector != nil {
+		in, out := &in.PostAuthenticationSelector, &out.PostAuthenticationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostConfirmation != nil {
+		in, out := &in.PostConfirmation, &out.PostConfirmation
+		*out = new(string)
+		**out = **in
+	}
+	if in.PostConfirmationRef != nil {
+		in, out := &in.PostConfirmationRef, &out.PostConfirmationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PostConfirmationSelector != nil {
+		in, out := &in.PostConfirmationSelector, &out.PostConfirmationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreAuthentication != nil {
+		in, out := &in.PreAuthentication, &out.PreAuthentication
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreAuthenticationRef != nil {
+		in, out := &in.PreAuthenticationRef, &out.PreAuthenticationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PreAuthenticationSelector != nil {
+		in, out := &in.PreAuthenticationSelector, &out.PreAuthenticationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreSignUp != nil {
+		in, out := &in.PreSignUp, &out.PreSignUp
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreSignUpRef != nil {
+		in, out := &in.PreSignUpRef, &out.PreSignUpRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PreSignUpSelector != nil {
+		in, out := &in.PreSignUpSelector, &out.PreSignUpSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreTokenGeneration != nil {
+		in, out := &in.PreTokenGeneration, &out.PreTokenGeneration
+		*out = new(string)
+		**out = **in
+	}
+	if in.PreTokenGenerationRef != nil {
+		in, out := &in.PreTokenGenerationRef, &out.PreTokenGenerationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.PreTokenGenerationSelector != nil {
+		in, out := &in.PreTokenGenerationSelector, &out.PreTokenGenerationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserMigration != nil {
+		in, out := &in.UserMigration, &out.UserMigration
+		*out = new(string)
+		**out = **in
+	}
+	if in.UserMigrationRef != nil {
+		in, out := &in.UserMigrationRef, &out.UserMigrationRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.UserMigrationSelector != nil {
+		in, out := &in.UserMigrationSelector, &out.UserMigrationSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerifyAuthChallengeResponse != nil {
+		in, out := &in.VerifyAuthChallengeResponse, &out.VerifyAuthChallengeResponse
+		*out = new(string)
+		**out = **in
+	}
+	if in.VerifyAuthChallengeResponseRef != nil {
+		in, out := &in.VerifyAuthChallengeResponseRef, &out.VerifyAuthChallengeResponseRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.VerifyAuthChallengeResponseSelector != nil {
+		in, out := &in.VerifyAuthChallengeResponseSelector, &out.VerifyAuthChallengeResponseSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaConfigParameters.
+func (in *LambdaConfigParameters) DeepCopy() *LambdaConfigParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(LambdaConfigParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LambdaConfigType) DeepCopyInto(out *LambdaConfigType) {
 	*out = *in
@@ -2029,6 +2315,11 @@ func (in *UserPoolClientObservation) DeepCopyInto(out *UserPoolClientObservation
 		in, out := &in.LastModifiedDate, &out.LastModifiedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UserPoolID != nil {
 		in, out := &in.UserPoolID, &out.UserPoolID
 		*out = new(string)
@@ -2525,6 +2816,11 @@ func (in *UserPoolDomainObservation) DeepCopyInto(out *UserPoolDomainObservation
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPoolDomainObservation.
@@ -2540,11 +2836,6 @@ func (in *UserPoolDomainObservation) DeepCopy() *UserPoolDomainObservation {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserPoolDomainParameters) DeepCopyInto(out *UserPoolDomainParameters) {
 	*out = *in
-	if in.CustomDomainConfig != nil {
-		in, out := &in.CustomDomainConfig, &out.CustomDomainConfig
-		*out = new(CustomDomainConfigType)
-		(*in).DeepCopyInto(*out)
-	}
 	in.CustomUserPoolDomainParameters.DeepCopyInto(&out.CustomUserPoolDomainParameters)
 }
 
@@ -2665,6 +2956,11 @@ func (in *UserPoolObservation) DeepCopyInto(out *UserPoolObservation) {
 		in, out := &in.LastModifiedDate, &out.LastModifiedDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -2758,11 +3054,6 @@ func (in *UserPoolParameters) DeepCopyInto(out *UserPoolParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.LambdaConfig != nil {
-		in, out := &in.LambdaConfig, &out.LambdaConfig
-		*out = new(LambdaConfigType)
-		(*in).DeepCopyInto(*out)
-	}
 	if in.MFAConfiguration != nil {
 		in, out := &in.MFAConfiguration, &out.MFAConfiguration
 		*out = new(string)
@@ -2845,7 +3136,7 @@ func (in *UserPoolParameters) DeepCopyInto(out *UserPoolParameters) {
 		*out = new(VerificationMessageTemplateType)
 		(*in).DeepCopyInto(*out)
 	}
-	out.CustomUserPoolParameters = in.CustomUserPoolParameters
+	in.CustomUserPoolParameters.DeepCopyInto(&out.CustomUserPoolParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPoolParameters.
@@ -21,7 +21,10 @@ package v1alpha1
 import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	v1beta12 "github.com/crossplane/provider-aws/apis/acm/v1beta1"
 	v1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	v1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	v1beta11 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -94,6 +97,255 @@ func (mg *IdentityProvider) ResolveReferences(ctx context.Context, c client.Read
 	return nil
 }
 
+// ResolveReferences of this UserPool.
+func (mg *UserPool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallenge),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallengeRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallengeSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallenge")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallenge = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CreateAuthChallengeRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARN),
+				Extract:      v1beta11.FunctionARN(),
+				Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARNRef,
+				Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARNSelector,
+				To: reference.To{
+					List:    &v1beta11.FunctionList{},
+					Managed: &v1beta11.Function{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARN")
+			}
+			mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomEmailSender.LambdaARNRef = rsp.ResolvedReference
+
+		}
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessage),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessageRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessageSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessage")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessage = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomMessageRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender != nil {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARN),
+				Extract:      v1beta11.FunctionARN(),
+				Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARNRef,
+				Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARNSelector,
+				To: reference.To{
+					List:    &v1beta11.FunctionList{},
+					Managed: &v1beta11.Function{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARN")
+			}
+			mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+			mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.CustomSMSSender.LambdaARNRef = rsp.ResolvedReference
+
+		}
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallenge),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallengeRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallengeSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallenge")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallenge = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.DefineAuthChallengeRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyID),
+			Extract:      v1alpha1.KMSKeyARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyIDRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyIDSelector,
+			To: reference.To{
+				List:    &v1alpha1.KeyList{},
+				Managed: &v1alpha1.Key{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyID")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.KMSKeyIDRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthentication),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthenticationRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthenticationSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthentication")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthentication = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostAuthenticationRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmation),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmationRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmationSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmation")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmation = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PostConfirmationRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthentication),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthenticationRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthenticationSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthentication")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthentication = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreAuthenticationRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUp),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUpRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUpSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUp")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUp = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreSignUpRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGeneration),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGenerationRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGenerationSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGeneration")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGeneration = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.PreTokenGenerationRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigration),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigrationRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigrationSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigration")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigration = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.UserMigrationRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponse),
+			Extract:      v1beta11.FunctionARN(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponseRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponseSelector,
+			To: reference.To{
+				List:    &v1beta11.FunctionList{},
+				Managed: &v1beta11.Function{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponse")
+		}
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponse = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolParameters.LambdaConfig.VerifyAuthChallengeResponseRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
 // ResolveReferences of this UserPoolClient.
 func (mg *UserPoolClient) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -127,6 +379,24 @@ func (mg *UserPoolDomain) ResolveReferences(ctx context.Context, c client.Reader
 	var rsp reference.ResolutionResponse
 	var err error
 
+	if mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARN),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARNRef,
+			Selector:     mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARNSelector,
+			To: reference.To{
+				List:    &v1beta12.CertificateList{},
+				Managed: &v1beta12.Certificate{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARN")
+		}
+		mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARN = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.CustomUserPoolDomainParameters.CustomDomainConfig.CertificateARNRef = rsp.ResolvedReference
+
+	}
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomUserPoolDomainParameters.UserPoolID),
 		Extract:      reference.ExternalName(),
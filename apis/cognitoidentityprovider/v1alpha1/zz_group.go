@@ -58,12 +58,14 @@ type GroupSpec struct {
 
 // GroupObservation defines the observed state of Group
 type GroupObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The date the group was created.
 	CreationDate *metav1.Time `json:"creationDate,omitempty"`
 	// The name of the group.
 	GroupName *string `json:"groupName,omitempty"`
 	// The date the group was last modified.
-	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+	LastModifiedDate *metav1.Time   `json:"lastModifiedDate,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 	// The role ARN for the group.
 	RoleARN *string `json:"roleARN,omitempty"`
 	// The user pool ID for the user pool.
@@ -48,10 +48,12 @@ type IdentityProviderSpec struct {
 
 // IdentityProviderObservation defines the observed state of IdentityProvider
 type IdentityProviderObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The date the identity provider was created.
 	CreationDate *metav1.Time `json:"creationDate,omitempty"`
 	// The date the identity provider was last modified.
-	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+	LastModifiedDate *metav1.Time   `json:"lastModifiedDate,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 	// The identity provider name.
 	ProviderName *string `json:"providerName,omitempty"`
 	// The user pool ID.
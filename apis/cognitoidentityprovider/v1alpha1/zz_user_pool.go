@@ -56,17 +56,6 @@ type UserPoolParameters struct {
 	// is allowed only if EmailSendingAccount (https://docs.aws.amazon.com/cognito-user-identity-pools/latest/APIReference/API_EmailConfigurationType.html#CognitoUserPools-Type-EmailConfigurationType-EmailSendingAccount)
 	// is DEVELOPER.
 	EmailVerificationSubject *string `json:"emailVerificationSubject,omitempty"`
-	// The Lambda trigger configuration information for the new user pool.
-	//
-	// In a push model, event sources (such as Amazon S3 and custom applications)
-	// need permission to invoke a function. So you will need to make an extra call
-	// to add permission for these event sources to invoke your Lambda function.
-	//
-	// For more information on using the Lambda API to add permission, see AddPermission
-	// (https://docs.aws.amazon.com/lambda/latest/dg/API_AddPermission.html).
-	//
-	// For adding permission using the CLI, see add-permission (https://docs.aws.amazon.com/cli/latest/reference/lambda/add-permission.html).
-	LambdaConfig *LambdaConfigType `json:"lambdaConfig,omitempty"`
 	// Specifies MFA configuration details.
 	MFAConfiguration *string `json:"mfaConfiguration,omitempty"`
 	// The policies associated with the new user pool.
@@ -114,7 +103,8 @@ type UserPoolSpec struct {
 // UserPoolObservation defines the observed state of UserPool
 type UserPoolObservation struct {
 	// The Amazon Resource Name (ARN) for the user pool.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The date the user pool was created.
 	CreationDate *metav1.Time `json:"creationDate,omitempty"`
 	// A custom domain name that you provide to Amazon Cognito. This parameter applies
@@ -133,7 +123,8 @@ type UserPoolObservation struct {
 	// The ID of the user pool.
 	ID *string `json:"id,omitempty"`
 	// The date the user pool was last modified.
-	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+	LastModifiedDate *metav1.Time   `json:"lastModifiedDate,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 	// The name of the user pool.
 	Name *string `json:"name,omitempty"`
 	// A container with the schema attributes of a user pool.
@@ -188,6 +188,7 @@ type UserPoolClientSpec struct {
 
 // UserPoolClientObservation defines the observed state of UserPoolClient
 type UserPoolClientObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The ID of the client associated with the user pool.
 	ClientID *string `json:"clientID,omitempty"`
 	// The client secret from the user pool request of the client type.
@@ -195,7 +196,8 @@ type UserPoolClientObservation struct {
 	// The date the user pool client was created.
 	CreationDate *metav1.Time `json:"creationDate,omitempty"`
 	// The date the user pool client was last modified.
-	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+	LastModifiedDate *metav1.Time   `json:"lastModifiedDate,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 	// The user pool ID for the user pool client.
 	UserPoolID *string `json:"userPoolID,omitempty"`
 }
@@ -28,17 +28,7 @@ import (
 type UserPoolDomainParameters struct {
 	// Region is which region the UserPoolDomain will be created.
 	// +kubebuilder:validation:Required
-	Region string `json:"region"`
-	// The configuration for a custom domain that hosts the sign-up and sign-in
-	// webpages for your application.
-	//
-	// Provide this parameter only if you want to use a custom domain for your user
-	// pool. Otherwise, you can exclude this parameter and use the Amazon Cognito
-	// hosted domain instead.
-	//
-	// For more information about the hosted domain and custom domains, see Configuring
-	// a User Pool Domain (https://docs.aws.amazon.com/cognito/latest/developerguide/cognito-user-pools-assign-domain.html).
-	CustomDomainConfig             *CustomDomainConfigType `json:"customDomainConfig,omitempty"`
+	Region                         string `json:"region"`
 	CustomUserPoolDomainParameters `json:",inline"`
 }
 
@@ -50,9 +40,11 @@ type UserPoolDomainSpec struct {
 
 // UserPoolDomainObservation defines the observed state of UserPoolDomain
 type UserPoolDomainObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon CloudFront endpoint that you use as the target of the alias that
 	// you set up with your Domain Name Service (DNS) provider.
-	CloudFrontDomain *string `json:"cloudFrontDomain,omitempty"`
+	CloudFrontDomain *string        `json:"cloudFrontDomain,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // UserPoolDomainStatus defines the observed state of UserPoolDomain.
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// RecordingGroup specifies which resource types are recorded.
+type RecordingGroup struct {
+	// AllSupported specifies whether all supported resource types of the
+	// region are recorded, including those added in the future. It must
+	// not be set together with ResourceTypes.
+	// +optional
+	AllSupported *bool `json:"allSupported,omitempty"`
+
+	// IncludeGlobalResourceTypes specifies whether global resources such
+	// as IAM users are recorded. It requires AllSupported to be true.
+	// +optional
+	IncludeGlobalResourceTypes *bool `json:"includeGlobalResourceTypes,omitempty"`
+
+	// ResourceTypes to record, for example AWS::EC2::Instance.
+	// +optional
+	ResourceTypes []string `json:"resourceTypes,omitempty"`
+}
+
+// ConfigurationRecorderParameters define the desired state of an AWS Config
+// configuration recorder.
+type ConfigurationRecorderParameters struct {
+	// Region is which region the ConfigurationRecorder will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// RoleARN is the ARN of the role AWS Config assumes to describe the
+	// resources of the account.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to a Role to retrieve its ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// RecordingGroup specifies which resource types are recorded. All
+	// supported resource types are recorded if it is omitted.
+	// +optional
+	RecordingGroup *RecordingGroup `json:"recordingGroup,omitempty"`
+
+	// Recording specifies whether the recorder is started. A recorder can
+	// only be started once a DeliveryChannel exists in the region. Defaults
+	// to true.
+	// +optional
+	Recording *bool `json:"recording,omitempty"`
+}
+
+// ConfigurationRecorderObservation keeps the state for the external resource
+type ConfigurationRecorderObservation struct {
+	// Recording indicates whether the recorder is currently recording.
+	Recording bool `json:"recording,omitempty"`
+
+	// LastStatus is the status of the last recording event.
+	LastStatus string `json:"lastStatus,omitempty"`
+
+	// LastErrorMessage is the message of the last error of the recorder.
+	LastErrorMessage string `json:"lastErrorMessage,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ConfigurationRecorderSpec defines the desired state of a
+// ConfigurationRecorder.
+type ConfigurationRecorderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurationRecorderParameters `json:"forProvider"`
+}
+
+// A ConfigurationRecorderStatus represents the observed state of a
+// ConfigurationRecorder.
+type ConfigurationRecorderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurationRecorderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurationRecorder is a managed resource that represents an AWS
+// Config configuration recorder, which records the configuration changes of
+// the resources in a region. Only one recorder can exist per region.
+// +kubebuilder:printcolumn:name="RECORDING",type="boolean",JSONPath=".status.atProvider.recording"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ConfigurationRecorder struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationRecorderSpec   `json:"spec"`
+	Status ConfigurationRecorderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurationRecorderList contains a list of ConfigurationRecorders
+type ConfigurationRecorderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurationRecorder `json:"items"`
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DeliveryChannelParameters define the desired state of an AWS Config
+// delivery channel.
+type DeliveryChannelParameters struct {
+	// Region is which region the DeliveryChannel will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// S3BucketName is the name of the bucket configuration snapshots and
+	// history files are delivered to.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/s3/v1beta1.Bucket
+	S3BucketName *string `json:"s3BucketName,omitempty"`
+
+	// S3BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	S3BucketNameRef *xpv1.Reference `json:"s3BucketNameRef,omitempty"`
+
+	// S3BucketNameSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	S3BucketNameSelector *xpv1.Selector `json:"s3BucketNameSelector,omitempty"`
+
+	// S3KeyPrefix is the prefix of the delivered object keys.
+	// +optional
+	S3KeyPrefix *string `json:"s3KeyPrefix,omitempty"`
+
+	// S3KMSKeyARN is the ARN of the KMS key used to encrypt the delivered
+	// objects.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/kms/v1alpha1.KMSKeyARN()
+	S3KMSKeyARN *string `json:"s3KmsKeyArn,omitempty"`
+
+	// S3KMSKeyARNRef references a Key to retrieve its ARN.
+	// +optional
+	S3KMSKeyARNRef *xpv1.Reference `json:"s3KmsKeyArnRef,omitempty"`
+
+	// S3KMSKeyARNSelector selects a reference to a Key to retrieve its ARN.
+	// +optional
+	S3KMSKeyARNSelector *xpv1.Selector `json:"s3KmsKeyArnSelector,omitempty"`
+
+	// SNSTopicARN is the ARN of the topic AWS Config sends notifications
+	// about configuration changes to.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/sns/v1beta1.Topic
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/sns/v1beta1.SNSTopicARN()
+	SNSTopicARN *string `json:"snsTopicArn,omitempty"`
+
+	// SNSTopicARNRef references a Topic to retrieve its ARN.
+	// +optional
+	SNSTopicARNRef *xpv1.Reference `json:"snsTopicArnRef,omitempty"`
+
+	// SNSTopicARNSelector selects a reference to a Topic to retrieve its
+	// ARN.
+	// +optional
+	SNSTopicARNSelector *xpv1.Selector `json:"snsTopicArnSelector,omitempty"`
+
+	// DeliveryFrequency is how often configuration snapshots are delivered.
+	// +kubebuilder:validation:Enum=One_Hour;Three_Hours;Six_Hours;Twelve_Hours;TwentyFour_Hours
+	// +optional
+	DeliveryFrequency *string `json:"deliveryFrequency,omitempty"`
+}
+
+// A DeliveryChannelSpec defines the desired state of a DeliveryChannel.
+type DeliveryChannelSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DeliveryChannelParameters `json:"forProvider"`
+}
+
+// DeliveryChannelObservation keeps the state for the external resource.
+type DeliveryChannelObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A DeliveryChannelStatus represents the observed state of a
+// DeliveryChannel.
+type DeliveryChannelStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DeliveryChannelObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DeliveryChannel is a managed resource that represents an AWS Config
+// delivery channel, which delivers the recorded configurations to S3. Only
+// one delivery channel can exist per region.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DeliveryChannel struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeliveryChannelSpec   `json:"spec"`
+	Status DeliveryChannelStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeliveryChannelList contains a list of DeliveryChannels
+type DeliveryChannelList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeliveryChannel `json:"items"`
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// OptionSetting is the name and value of an option setting.
+type OptionSetting struct {
+	// Name of the option setting.
+	Name string `json:"name"`
+
+	// Value of the option setting.
+	Value string `json:"value"`
+}
+
+// OptionConfiguration is an option of an option group.
+type OptionConfiguration struct {
+	// OptionName is the name of the option, e.g. MEMCACHED or
+	// MARIADB_AUDIT_PLUGIN.
+	OptionName string `json:"optionName"`
+
+	// OptionVersion is the version of the option.
+	// +optional
+	OptionVersion *string `json:"optionVersion,omitempty"`
+
+	// Port is the port the option uses, if it uses one.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// OptionSettings are the settings of the option. Settings that are not
+	// listed keep their current value.
+	// +optional
+	OptionSettings []OptionSetting `json:"optionSettings,omitempty"`
+
+	// VPCSecurityGroupMemberships are the IDs of the VPC security groups
+	// allowed to access the option.
+	// +optional
+	VPCSecurityGroupMemberships []string `json:"vpcSecurityGroupMemberships,omitempty"`
+
+	// VPCSecurityGroupMembershipRefs are references to SecurityGroups used to
+	// set the VPCSecurityGroupMemberships.
+	// +optional
+	VPCSecurityGroupMembershipRefs []xpv1.Reference `json:"vpcSecurityGroupMembershipRefs,omitempty"`
+
+	// VPCSecurityGroupMembershipSelector selects references to SecurityGroups
+	// used to set the VPCSecurityGroupMemberships.
+	// +optional
+	VPCSecurityGroupMembershipSelector *xpv1.Selector `json:"vpcSecurityGroupMembershipSelector,omitempty"`
+}
+
+// OptionGroupParameters define the desired state of an RDS option group.
+type OptionGroupParameters struct {
+	// Region is the region you'd like your OptionGroup to be created in.
+	Region string `json:"region"`
+
+	// EngineName is the name of the engine the option group can be applied
+	// to, e.g. mysql or sqlserver-se.
+	// +immutable
+	EngineName string `json:"engineName"`
+
+	// MajorEngineVersion is the major version of the engine the option group
+	// can be applied to, e.g. 8.0.
+	// +immutable
+	MajorEngineVersion string `json:"majorEngineVersion"`
+
+	// Description of the option group.
+	// +immutable
+	Description string `json:"description"`
+
+	// Options of the option group. Options that are not listed are removed
+	// from the option group unless they are permanent.
+	// +optional
+	Options []OptionConfiguration `json:"options,omitempty"`
+
+	// ApplyImmediately indicates whether option changes are applied
+	// immediately to the DB instances using the option group, or during
+	// their next maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+}
+
+// An OptionGroupSpec defines the desired state of an OptionGroup.
+type OptionGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OptionGroupParameters `json:"forProvider"`
+}
+
+// OptionGroupObservation is the representation of the current state that is
+// observed.
+type OptionGroupObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the option group.
+	ARN string `json:"arn,omitempty"`
+
+	// VPCID is the ID of the VPC the option group is restricted to, if any.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// AllowsVPCAndNonVPCInstanceMemberships indicates whether the option
+	// group can be applied to both VPC and non-VPC instances.
+	AllowsVPCAndNonVPCInstanceMemberships bool `json:"allowsVpcAndNonVpcInstanceMemberships,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An OptionGroupStatus represents the observed state of an OptionGroup.
+type OptionGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OptionGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OptionGroup is a managed resource that represents an RDS option group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ENGINE",type="string",JSONPath=".spec.forProvider.engineName"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.forProvider.majorEngineVersion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OptionGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OptionGroupSpec   `json:"spec"`
+	Status OptionGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OptionGroupList contains a list of OptionGroups
+type OptionGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OptionGroup `json:"items"`
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+// ResolveReferences of this OptionGroup
+func (mg *OptionGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	for i := range mg.Spec.ForProvider.Options {
+		o := &mg.Spec.ForProvider.Options[i]
+
+		// Resolve spec.forProvider.options[].vpcSecurityGroupMemberships
+		mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+			CurrentValues: o.VPCSecurityGroupMemberships,
+			References:    o.VPCSecurityGroupMembershipRefs,
+			Selector:      o.VPCSecurityGroupMembershipSelector,
+			To:            reference.To{Managed: &ec2v1beta1.SecurityGroup{}, List: &ec2v1beta1.SecurityGroupList{}},
+			Extract:       reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("spec.forProvider.options[%d].vpcSecurityGroupMemberships", i))
+		}
+		o.VPCSecurityGroupMemberships = mrsp.ResolvedValues
+		o.VPCSecurityGroupMembershipRefs = mrsp.ResolvedReferences
+	}
+
+	return nil
+}
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // SQL database engines.
@@ -517,7 +519,7 @@ type RDSInstanceParameters struct {
 
 	// MasterPasswordSecretRef references the secret that contains the password used
 	// in the creation of this RDS instance. If no reference is given, a password
-	// will be auto-generated.
+	// will be auto-generated and only stored in the connection secret.
 	// +optional
 	// +immutable
 	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
@@ -812,8 +814,19 @@ type RDSInstanceParameters struct {
 	// can't be removed from an option group, and that option group can't be removed
 	// from a DB instance once it is associated with a DB instance
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/database/v1alpha1.OptionGroup
 	OptionGroupName *string `json:"optionGroupName,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *xpv1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *xpv1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// A value that specifies that the DB instance class of the DB instance uses
 	// its default processor features.
 	UseDefaultProcessorFeatures *bool `json:"useDefaultProcessorFeatures,omitempty"`
@@ -840,6 +853,11 @@ type RDSInstanceParameters struct {
 	//    * Cannot end with a hyphen or contain two consecutive hyphens
 	//    * Cannot be specified when deleting a Read Replica.
 	FinalDBSnapshotIdentifier *string `json:"finalDBSnapshotIdentifier,omitempty"`
+
+	// ReadinessProbe, if set, keeps the RDSInstance from becoming ready until
+	// its endpoint accepts TCP connections from the provider.
+	// +optional
+	ReadinessProbe *awsv1beta1.ReadinessProbe `json:"readinessProbe,omitempty"`
 }
 
 // An RDSInstanceSpec defines the desired state of an RDSInstance.
@@ -1180,6 +1198,17 @@ type RDSInstanceObservation struct {
 	// VPCSecurityGroups provides a list of VPC security group elements that the DB instance belongs
 	// to.
 	VPCSecurityGroups []VPCSecurityGroupMembership `json:"vpcSecurityGroups,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An RDSInstanceStatus represents the observed state of an RDSInstance.
@@ -23,6 +23,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -198,6 +199,11 @@ func (in *DBSubnetGroupObservation) DeepCopyInto(out *DBSubnetGroupObservation)
 		*out = make([]Subnet, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBSubnetGroupObservation.
@@ -503,6 +509,11 @@ func (in *RDSInstanceObservation) DeepCopyInto(out *RDSInstanceObservation) {
 		*out = make([]VPCSecurityGroupMembership, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceObservation.
@@ -798,6 +809,16 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.UseDefaultProcessorFeatures != nil {
 		in, out := &in.UseDefaultProcessorFeatures, &out.UseDefaultProcessorFeatures
 		*out = new(bool)
@@ -813,6 +834,11 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(apisv1beta1.ReadinessProbe)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RDSInstanceParameters.
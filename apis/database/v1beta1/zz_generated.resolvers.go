@@ -21,6 +21,7 @@ package v1beta1
 import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
+	v1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	v1beta12 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	v1beta11 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
@@ -166,5 +167,21 @@ func (mg *RDSInstance) ResolveReferences(ctx context.Context, c client.Reader) e
 	mg.Spec.ForProvider.DomainIAMRoleName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DomainIAMRoleNameRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To: reference.To{
+			List:    &v1alpha1.OptionGroupList{},
+			Managed: &v1alpha1.OptionGroup{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.OptionGroupName")
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
 	return nil
 }
@@ -157,6 +157,7 @@ type DBClusterObservation struct {
 	// grant permission for the cluster to access other Amazon Web Services services
 	// on your behalf.
 	AssociatedRoles []*DBClusterRole `json:"associatedRoles,omitempty"`
+	CallerAccountID string           `json:"callerAccountID,omitempty"`
 	// Specifies the time when the cluster was created, in Universal Coordinated
 	// Time (UTC).
 	ClusterCreateTime *metav1.Time `json:"clusterCreateTime,omitempty"`
@@ -185,7 +186,8 @@ type DBClusterObservation struct {
 	// Specifies the connection endpoint for the primary instance of the cluster.
 	Endpoint *string `json:"endpoint,omitempty"`
 	// Specifies the ID that Amazon Route 53 assigns when you create a hosted zone.
-	HostedZoneID *string `json:"hostedZoneID,omitempty"`
+	HostedZoneID  *string        `json:"hostedZoneID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
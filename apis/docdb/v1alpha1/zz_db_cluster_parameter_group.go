@@ -48,10 +48,12 @@ type DBClusterParameterGroupSpec struct {
 
 // DBClusterParameterGroupObservation defines the observed state of DBClusterParameterGroup
 type DBClusterParameterGroupObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon Resource Name (ARN) for the cluster parameter group.
 	DBClusterParameterGroupARN *string `json:"dbClusterParameterGroupARN,omitempty"`
 	// Provides the name of the cluster parameter group.
-	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+	DBClusterParameterGroupName *string        `json:"dbClusterParameterGroupName,omitempty"`
+	LastOperation               *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // DBClusterParameterGroupStatus defines the observed state of DBClusterParameterGroup.
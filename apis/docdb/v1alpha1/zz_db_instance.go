@@ -86,6 +86,7 @@ type DBInstanceObservation struct {
 	BackupRetentionPeriod *int64 `json:"backupRetentionPeriod,omitempty"`
 	// The identifier of the CA certificate for this DB instance.
 	CACertificateIdentifier *string `json:"caCertificateIdentifier,omitempty"`
+	CallerAccountID         string  `json:"callerAccountID,omitempty"`
 	// Contains the name of the cluster that the instance is a member of if the
 	// instance is a member of a cluster.
 	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
@@ -113,7 +114,8 @@ type DBInstanceObservation struct {
 	// Provides the date and time that the instance was created.
 	InstanceCreateTime *metav1.Time `json:"instanceCreateTime,omitempty"`
 	// If StorageEncrypted is true, the KMS key identifier for the encrypted instance.
-	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	KMSKeyID      *string        `json:"kmsKeyID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
@@ -45,10 +45,12 @@ type DBSubnetGroupSpec struct {
 
 // DBSubnetGroupObservation defines the observed state of DBSubnetGroup
 type DBSubnetGroupObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon Resource Name (ARN) for the DB subnet group.
 	DBSubnetGroupARN *string `json:"dbSubnetGroupARN,omitempty"`
 	// The name of the subnet group.
-	DBSubnetGroupName *string `json:"dbSubnetGroupName,omitempty"`
+	DBSubnetGroupName *string        `json:"dbSubnetGroupName,omitempty"`
+	LastOperation     *LastOperation `json:"lastOperation,omitempty"`
 	// Provides the status of the subnet group.
 	SubnetGroupStatus *string `json:"subnetGroupStatus,omitempty"`
 	// Detailed information about one or more subnets within a subnet group.
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomBackupParameters are custom parameters for Backup.
 type CustomBackupParameters struct {
@@ -32,7 +36,54 @@ type CustomBackupParameters struct {
 }
 
 // CustomTableParameters are custom parameters for Table.
-type CustomTableParameters struct{}
+type CustomTableParameters struct {
+	// TimeToLive configures the automatic expiry of items in the table. It
+	// is managed using the UpdateTimeToLive API.
+	// +optional
+	TimeToLive *TableTimeToLive `json:"timeToLive,omitempty"`
+
+	// PointInTimeRecoveryEnabled enables continuous backups of the table,
+	// allowing it to be restored to any point in time within the last 35
+	// days. It is managed using the UpdateContinuousBackups API.
+	// +optional
+	PointInTimeRecoveryEnabled *bool `json:"pointInTimeRecoveryEnabled,omitempty"`
+
+	// Replicas of the table in other regions, which make it a global table
+	// (version 2019.11.21). Replicas are added and removed one at a time and
+	// require streams to be enabled with the NEW_AND_OLD_IMAGES view type.
+	// The status of each replica is reported in status.atProvider.replicas.
+	// +optional
+	Replicas []*TableReplica `json:"replicas,omitempty"`
+}
+
+// TableReplica is a replica of a Table in another region.
+type TableReplica struct {
+	// RegionName is the region in which the replica is created.
+	RegionName string `json:"regionName"`
+
+	// KMSMasterKeyID is the KMS key that should be used for encryption of
+	// the replica, if it differs from the default DynamoDB KMS key.
+	// +optional
+	KMSMasterKeyID *string `json:"kmsMasterKeyID,omitempty"`
+}
+
+// TableTimeToLive is the time to live configuration of a Table.
+type TableTimeToLive struct {
+	// Enabled indicates whether items expire once the time stored in
+	// AttributeName has passed.
+	Enabled bool `json:"enabled"`
+
+	// AttributeName is the name of the attribute that stores the expiry
+	// time of an item, in seconds since the Unix epoch. It is required when
+	// Enabled is true.
+	// +optional
+	AttributeName string `json:"attributeName,omitempty"`
+}
 
 // CustomGlobalTableParameters are custom parameters for GlobalTable.
 type CustomGlobalTableParameters struct{}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
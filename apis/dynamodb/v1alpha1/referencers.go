@@ -23,8 +23,24 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
+// TableStreamARN returns a function that returns the latest stream ARN of the
+// given Table, e.g. for use as the event source of a Lambda function.
+func TableStreamARN() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		r, ok := mg.(*Table)
+		if !ok {
+			return ""
+		}
+		if r.Status.AtProvider.LatestStreamARN == nil {
+			return ""
+		}
+		return *r.Status.AtProvider.LatestStreamARN
+	}
+}
+
 // ResolveReferences of this Backup
 func (mg *Backup) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
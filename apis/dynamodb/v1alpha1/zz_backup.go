@@ -64,7 +64,9 @@ type BackupObservation struct {
 	//    to the state it was in just before the point of deletion.
 	//
 	//    * AWS_BACKUP - On-demand backup created by you from AWS Backup service.
-	BackupType *string `json:"backupType,omitempty"`
+	BackupType      *string        `json:"backupType,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // BackupStatus defines the observed state of Backup.
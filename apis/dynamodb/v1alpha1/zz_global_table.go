@@ -43,6 +43,7 @@ type GlobalTableSpec struct {
 
 // GlobalTableObservation defines the observed state of GlobalTable
 type GlobalTableObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The creation time of the global table.
 	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
 	// The unique identifier of the global table.
@@ -58,7 +59,8 @@ type GlobalTableObservation struct {
 	//    * DELETING - The global table is being deleted.
 	//
 	//    * ACTIVE - The global table is ready for use.
-	GlobalTableStatus *string `json:"globalTableStatus,omitempty"`
+	GlobalTableStatus *string        `json:"globalTableStatus,omitempty"`
+	LastOperation     *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // GlobalTableStatus defines the observed state of GlobalTable.
@@ -169,6 +169,7 @@ type TableObservation struct {
 	ArchivalSummary *ArchivalSummary `json:"archivalSummary,omitempty"`
 	// Contains the details for the read/write capacity mode.
 	BillingModeSummary *BillingModeSummary `json:"billingModeSummary,omitempty"`
+	CallerAccountID    string              `json:"callerAccountID,omitempty"`
 	// The date and time when the table was created, in UNIX epoch time (http://www.epochconverter.com/)
 	// format.
 	CreationDateTime *metav1.Time `json:"creationDateTime,omitempty"`
@@ -177,7 +178,8 @@ type TableObservation struct {
 	GlobalTableVersion *string `json:"globalTableVersion,omitempty"`
 	// The number of items in the specified table. DynamoDB updates this value approximately
 	// every six hours. Recent changes might not be reflected in this value.
-	ItemCount *int64 `json:"itemCount,omitempty"`
+	ItemCount     *int64         `json:"itemCount,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The Amazon Resource Name (ARN) that uniquely identifies the latest stream
 	// for this table.
 	LatestStreamARN *string `json:"latestStreamARN,omitempty"`
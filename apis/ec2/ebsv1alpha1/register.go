@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ebsv1alpha1 contains the hand-written EBS kinds of the
+// ec2.aws.crossplane.io v1alpha1 API group. They live in their own package
+// because they reference Volumes of the generated v1alpha1 package, which in
+// turn imports manualv1alpha1.
+// +kubebuilder:object:generate=true
+// +groupName=ec2.aws.crossplane.io
+// +versionName=v1alpha1
+package ebsv1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "ec2.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// Snapshot type metadata.
+var (
+	SnapshotKind             = reflect.TypeOf(Snapshot{}).Name()
+	SnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: SnapshotKind}.String()
+	SnapshotKindAPIVersion   = SnapshotKind + "." + SchemeGroupVersion.String()
+	SnapshotGroupVersionKind = SchemeGroupVersion.WithKind(SnapshotKind)
+)
+
+// VolumeAttachment type metadata.
+var (
+	VolumeAttachmentKind             = reflect.TypeOf(VolumeAttachment{}).Name()
+	VolumeAttachmentGroupKind        = schema.GroupKind{Group: Group, Kind: VolumeAttachmentKind}.String()
+	VolumeAttachmentKindAPIVersion   = VolumeAttachmentKind + "." + SchemeGroupVersion.String()
+	VolumeAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(VolumeAttachmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Snapshot{}, &SnapshotList{})
+	SchemeBuilder.Register(&VolumeAttachment{}, &VolumeAttachmentList{})
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Snapshot states.
+const (
+	SnapshotStatePending   = "pending"
+	SnapshotStateCompleted = "completed"
+	SnapshotStateError     = "error"
+)
+
+// SnapshotParameters define the desired state of an EBS Snapshot.
+type SnapshotParameters struct {
+	// Region is the region you'd like your Snapshot to be created in.
+	Region string `json:"region"`
+
+	// A description for the snapshot.
+	// +immutable
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The ID of the EBS volume to snapshot.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.Volume
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef is a reference to a Volume used to set the VolumeID.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume used to set the
+	// VolumeID.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the Outpost on which to create a
+	// local snapshot. Omit it to create the snapshot in the Region of the
+	// volume.
+	// +immutable
+	// +optional
+	OutpostARN *string `json:"outpostArn,omitempty"`
+
+	// Tags to apply to the snapshot.
+	// +optional
+	Tags []manualv1alpha1.Tag `json:"tags,omitempty"`
+}
+
+// A SnapshotSpec defines the desired state of a Snapshot.
+type SnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SnapshotParameters `json:"forProvider"`
+}
+
+// SnapshotObservation keeps the state for the external resource.
+type SnapshotObservation struct {
+	// The ID of the snapshot.
+	SnapshotID string `json:"snapshotId,omitempty"`
+
+	// The state of the snapshot, i.e. pending, completed or error.
+	State string `json:"state,omitempty"`
+
+	// The reason the snapshot failed, if it is in the error state.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The progress of the snapshot, as a percentage.
+	Progress string `json:"progress,omitempty"`
+
+	// The time the snapshot was started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// The size of the volume, in GiB.
+	VolumeSize int32 `json:"volumeSize,omitempty"`
+
+	// Whether the snapshot is encrypted.
+	Encrypted bool `json:"encrypted,omitempty"`
+
+	// The ARN of the KMS key that was used to protect the volume encryption
+	// key of the snapshot.
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+
+	// The ID of the AWS account that owns the snapshot.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A SnapshotStatus represents the observed state of a Snapshot.
+type SnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Snapshot is a managed resource that represents a point-in-time snapshot
+// of an EBS Volume.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="PROGRESS",type="string",JSONPath=".status.atProvider.progress"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SnapshotSpec   `json:"spec"`
+	Status SnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SnapshotList contains a list of Snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ebsv1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Volume attachment states.
+const (
+	VolumeAttachmentStateAttaching = "attaching"
+	VolumeAttachmentStateAttached  = "attached"
+	VolumeAttachmentStateDetaching = "detaching"
+	VolumeAttachmentStateDetached  = "detached"
+	VolumeAttachmentStateBusy      = "busy"
+)
+
+// VolumeAttachmentParameters define the desired state of an attachment of an
+// EBS Volume to an EC2 Instance.
+type VolumeAttachmentParameters struct {
+	// Region is the region of the volume and the instance.
+	Region string `json:"region"`
+
+	// The device name, e.g. /dev/sdh or xvdh, to expose the volume to the
+	// instance as.
+	// +immutable
+	Device string `json:"device"`
+
+	// The ID of the EBS volume to attach.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.Volume
+	VolumeID *string `json:"volumeId,omitempty"`
+
+	// VolumeIDRef is a reference to a Volume used to set the VolumeID.
+	// +optional
+	VolumeIDRef *xpv1.Reference `json:"volumeIdRef,omitempty"`
+
+	// VolumeIDSelector selects a reference to a Volume used to set the
+	// VolumeID.
+	// +optional
+	VolumeIDSelector *xpv1.Selector `json:"volumeIdSelector,omitempty"`
+
+	// The ID of the instance to attach the volume to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1.Instance
+	InstanceID *string `json:"instanceId,omitempty"`
+
+	// InstanceIDRef is a reference to an Instance used to set the
+	// InstanceID.
+	// +optional
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// InstanceIDSelector selects a reference to an Instance used to set the
+	// InstanceID.
+	// +optional
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
+	// ForceDetach forces the volume to be detached when the attachment is
+	// deleted, even if the instance did not release it. The instance then
+	// has no chance to flush file system caches or metadata, so only use it
+	// as a last resort for failed instances.
+	// +optional
+	ForceDetach *bool `json:"forceDetach,omitempty"`
+}
+
+// A VolumeAttachmentSpec defines the desired state of a VolumeAttachment.
+type VolumeAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       VolumeAttachmentParameters `json:"forProvider"`
+}
+
+// VolumeAttachmentObservation keeps the state for the external resource.
+type VolumeAttachmentObservation struct {
+	// The state of the attachment, i.e. attaching, attached, detaching,
+	// detached or busy.
+	State string `json:"state,omitempty"`
+
+	// The time the volume was attached.
+	AttachTime *metav1.Time `json:"attachTime,omitempty"`
+
+	// Whether the volume is deleted when the instance is terminated.
+	DeleteOnTermination bool `json:"deleteOnTermination,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A VolumeAttachmentStatus represents the observed state of a
+// VolumeAttachment.
+type VolumeAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          VolumeAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VolumeAttachment is a managed resource that represents the attachment of
+// an EBS Volume to an EC2 Instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VOLUME",type="string",JSONPath=".spec.forProvider.volumeId"
+// +kubebuilder:printcolumn:name="INSTANCE",type="string",JSONPath=".spec.forProvider.instanceId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type VolumeAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VolumeAttachmentSpec   `json:"spec"`
+	Status VolumeAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeAttachmentList contains a list of VolumeAttachments
+type VolumeAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VolumeAttachment `json:"items"`
+}
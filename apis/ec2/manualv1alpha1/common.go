@@ -324,7 +324,16 @@ type IAMInstanceProfileSpecification struct {
 
 	// The name of the instance profile.
 	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1alpha1.InstanceProfile
 	Name *string `json:"name,omitempty"`
+
+	// NameRef is a reference to an InstanceProfile used to set Name.
+	// +optional
+	NameRef *xpv1.Reference `json:"nameRef,omitempty"`
+
+	// NameSelector selects a reference to an InstanceProfile used to set Name.
+	// +optional
+	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 }
 
 // InstanceBlockDeviceMapping describes a block device mapping.
@@ -22,6 +22,7 @@ import (
 	"context"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	v1beta1 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	v1alpha11 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	v1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,6 +56,24 @@ func (mg *Instance) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 		}
 	}
+	if mg.Spec.ForProvider.IAMInstanceProfile != nil {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IAMInstanceProfile.Name),
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.IAMInstanceProfile.NameRef,
+			Selector:     mg.Spec.ForProvider.IAMInstanceProfile.NameSelector,
+			To: reference.To{
+				List:    &v1alpha11.InstanceProfileList{},
+				Managed: &v1alpha11.InstanceProfile{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.IAMInstanceProfile.Name")
+		}
+		mg.Spec.ForProvider.IAMInstanceProfile.Name = reference.ToPtrValue(rsp.ResolvedValue)
+		mg.Spec.ForProvider.IAMInstanceProfile.NameRef = rsp.ResolvedReference
+
+	}
 	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
 		CurrentValues: mg.Spec.ForProvider.SecurityGroupIDs,
 		Extract:       reference.ExternalName(),
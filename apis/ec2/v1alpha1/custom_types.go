@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CustomLaunchTemplateParameters includes the custom fields of LaunchTemplate.
@@ -25,6 +27,54 @@ type CustomLaunchTemplateParameters struct {
 	// Metadata tagging key value pairs
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// The IDs of the security groups to add to the launch template data. They
+	// are set on the first network interface if the launch template data
+	// specifies network interfaces or SubnetID is set, and as the security
+	// group IDs of the launch template data otherwise.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []*string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a list of references to SecurityGroups used to set
+	// the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used
+	// to set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+
+	// The ID of the subnet to launch instances in. It is set on the first
+	// network interface of the launch template data, which is added if the
+	// launch template data specifies none. Leave it empty for launch templates
+	// used by EKS node groups, which do not accept a subnet.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	SubnetID *string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef is a reference to a Subnet used to set the SubnetID.
+	// +optional
+	SubnetIDRef *xpv1.Reference `json:"subnetIdRef,omitempty"`
+
+	// SubnetIDSelector selects a reference to a Subnet used to set the
+	// SubnetID.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// DefaultVersion pins the default version of the launch template. Set it
+	// to a version number to pin that version, or to $Latest to make every
+	// version the provider creates the default one. When the launch template
+	// data drifts from the latest version a new version is created, so
+	// consumers that use the default version, like EKS node groups without an
+	// explicit version, only pick up changes when this allows it. If unset
+	// the default version is left untouched.
+	// +kubebuilder:validation:Pattern=`^(\$Latest|[1-9][0-9]*)$`
+	// +optional
+	DefaultVersion *string `json:"defaultVersion,omitempty"`
 }
 
 // CustomVPCEndpointServiceConfigurationParameters contains the additional fields
@@ -438,3 +488,8 @@ type CustomTransitGatewayRouteTableParameters struct {
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
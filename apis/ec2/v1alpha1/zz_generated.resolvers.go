@@ -29,6 +29,49 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this LaunchTemplate.
+func (mg *LaunchTemplate) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var mrsp reference.MultiResolutionResponse
+	var err error
+
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: reference.FromPtrValues(mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDs),
+		Extract:       reference.ExternalName(),
+		References:    mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDRefs,
+		Selector:      mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDSelector,
+		To: reference.To{
+			List:    &v1beta1.SecurityGroupList{},
+			Managed: &v1beta1.SecurityGroup{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDs")
+	}
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDs = reference.ToPtrValues(mrsp.ResolvedValues)
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.SecurityGroupIDRefs = mrsp.ResolvedReferences
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetIDRef,
+		Selector:     mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetIDSelector,
+		To: reference.To{
+			List:    &v1beta1.SubnetList{},
+			Managed: &v1beta1.Subnet{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetID")
+	}
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomLaunchTemplateParameters.SubnetIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this LaunchTemplateVersion.
 func (mg *LaunchTemplateVersion) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
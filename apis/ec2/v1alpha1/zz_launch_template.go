@@ -50,6 +50,8 @@ type LaunchTemplateSpec struct {
 
 // LaunchTemplateObservation defines the observed state of LaunchTemplate
 type LaunchTemplateObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the launch template.
 	LaunchTemplate *LaunchTemplate_SDK `json:"launchTemplate,omitempty"`
 	// If the launch template contains parameters or parameter combinations that
@@ -51,6 +51,8 @@ type LaunchTemplateVersionSpec struct {
 
 // LaunchTemplateVersionObservation defines the observed state of LaunchTemplateVersion
 type LaunchTemplateVersionObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the launch template version.
 	LaunchTemplateVersion *LaunchTemplateVersion_SDK `json:"launchTemplateVersion,omitempty"`
 	// If the new version of the launch template contains parameters or parameter
@@ -63,6 +63,8 @@ type RouteSpec struct {
 
 // RouteObservation defines the observed state of Route
 type RouteObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Returns true if the request succeeds; otherwise, it returns an error.
 	Return *bool `json:"return_,omitempty"`
 }
@@ -46,8 +46,10 @@ type TransitGatewaySpec struct {
 
 // TransitGatewayObservation defines the observed state of TransitGateway
 type TransitGatewayObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The creation time.
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+	CreationTime  *metav1.Time   `json:"creationTime,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The ID of the Amazon Web Services account that owns the transit gateway.
 	OwnerID *string `json:"ownerID,omitempty"`
 	// The state of the transit gateway.
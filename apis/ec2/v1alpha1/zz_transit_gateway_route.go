@@ -46,6 +46,8 @@ type TransitGatewayRouteSpec struct {
 
 // TransitGatewayRouteObservation defines the observed state of TransitGatewayRoute
 type TransitGatewayRouteObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The ID of the prefix list used for destination matches.
 	PrefixListID *string `json:"prefixListID,omitempty"`
 	// The state of the route.
@@ -42,6 +42,7 @@ type TransitGatewayRouteTableSpec struct {
 
 // TransitGatewayRouteTableObservation defines the observed state of TransitGatewayRouteTable
 type TransitGatewayRouteTableObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The creation time.
 	CreationTime *metav1.Time `json:"creationTime,omitempty"`
 	// Indicates whether this is the default association route table for the transit
@@ -49,7 +50,8 @@ type TransitGatewayRouteTableObservation struct {
 	DefaultAssociationRouteTable *bool `json:"defaultAssociationRouteTable,omitempty"`
 	// Indicates whether this is the default propagation route table for the transit
 	// gateway.
-	DefaultPropagationRouteTable *bool `json:"defaultPropagationRouteTable,omitempty"`
+	DefaultPropagationRouteTable *bool          `json:"defaultPropagationRouteTable,omitempty"`
+	LastOperation                *LastOperation `json:"lastOperation,omitempty"`
 	// The state of the transit gateway route table.
 	State *string `json:"state,omitempty"`
 	// Any tags assigned to the route table.
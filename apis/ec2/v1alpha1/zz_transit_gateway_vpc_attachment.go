@@ -44,8 +44,10 @@ type TransitGatewayVPCAttachmentSpec struct {
 
 // TransitGatewayVPCAttachmentObservation defines the observed state of TransitGatewayVPCAttachment
 type TransitGatewayVPCAttachmentObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The creation time.
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+	CreationTime  *metav1.Time   `json:"creationTime,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The state of the VPC attachment. Note that the initiating state has been
 	// deprecated.
 	State *string `json:"state,omitempty"`
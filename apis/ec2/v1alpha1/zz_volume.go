@@ -126,14 +126,16 @@ type VolumeSpec struct {
 // VolumeObservation defines the observed state of Volume
 type VolumeObservation struct {
 	// Information about the volume attachments.
-	Attachments []*VolumeAttachment `json:"attachments,omitempty"`
+	Attachments     []*VolumeAttachment `json:"attachments,omitempty"`
+	CallerAccountID string              `json:"callerAccountID,omitempty"`
 	// The time stamp when volume creation was initiated.
 	CreateTime *metav1.Time `json:"createTime,omitempty"`
 	// Indicates whether the volume was created using fast snapshot restore.
 	FastRestored *bool `json:"fastRestored,omitempty"`
 	// The Amazon Resource Name (ARN) of the Key Management Service (KMS) KMS key
 	// that was used to protect the volume encryption key for the volume.
-	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+	KMSKeyID      *string        `json:"kmsKeyID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The volume state.
 	State *string `json:"state,omitempty"`
 	// Any tags assigned to the volume.
@@ -69,6 +69,7 @@ type VPCEndpointSpec struct {
 
 // VPCEndpointObservation defines the observed state of VPCEndpoint
 type VPCEndpointObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The date and time that the VPC endpoint was created.
 	CreationTimestamp *metav1.Time `json:"creationTimestamp,omitempty"`
 	// (Interface endpoint) The DNS entries for the endpoint.
@@ -77,7 +78,8 @@ type VPCEndpointObservation struct {
 	// with the network interface.
 	Groups []*SecurityGroupIdentifier `json:"groups,omitempty"`
 	// The last error that occurred for VPC endpoint.
-	LastError *LastError `json:"lastError,omitempty"`
+	LastError     *LastError     `json:"lastError,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// (Interface endpoint) One or more network interfaces for the endpoint.
 	NetworkInterfaceIDs []*string `json:"networkInterfaceIDs,omitempty"`
 	// The ID of the Amazon Web Services account that owns the VPC endpoint.
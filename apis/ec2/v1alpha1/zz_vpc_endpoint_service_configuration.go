@@ -48,9 +48,11 @@ type VPCEndpointServiceConfigurationSpec struct {
 
 // VPCEndpointServiceConfigurationObservation defines the observed state of VPCEndpointServiceConfiguration
 type VPCEndpointServiceConfigurationObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// Unique, case-sensitive identifier that you provide to ensure the idempotency
 	// of the request.
-	ClientToken *string `json:"clientToken,omitempty"`
+	ClientToken   *string        `json:"clientToken,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the service configuration.
 	ServiceConfiguration *ServiceConfiguration `json:"serviceConfiguration,omitempty"`
 }
@@ -54,8 +54,10 @@ type VPCPeeringConnectionObservation struct {
 	// Information about the accepter VPC. CIDR block information is only returned
 	// when describing an active VPC peering connection.
 	AccepterVPCInfo *VPCPeeringConnectionVPCInfo `json:"accepterVPCInfo,omitempty"`
+	CallerAccountID string                       `json:"callerAccountID,omitempty"`
 	// The time that an unaccepted VPC peering connection will expire.
-	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+	ExpirationTime *metav1.Time   `json:"expirationTime,omitempty"`
+	LastOperation  *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the requester VPC. CIDR block information is only returned
 	// when describing an active VPC peering connection.
 	RequesterVPCInfo *VPCPeeringConnectionVPCInfo `json:"requesterVPCInfo,omitempty"`
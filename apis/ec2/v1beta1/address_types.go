@@ -20,6 +20,22 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// ResourceCredentialsSecretPublicIPKey is the key in the connection secret
+	// for the public IP address of an Address or NATGateway.
+	ResourceCredentialsSecretPublicIPKey = "publicIp"
+
+	// ResourceCredentialsSecretPrivateIPKey is the key in the connection secret
+	// for the private IP address of an Address or NATGateway.
+	ResourceCredentialsSecretPrivateIPKey = "privateIp"
+
+	// ResourceCredentialsSecretAllocationIDKey is the key in the connection
+	// secret for the allocation ID of an Address or NATGateway.
+	ResourceCredentialsSecretAllocationIDKey = "allocationId"
 )
 
 // AddressParameters define the desired state of an AWS Elastic IP
@@ -121,6 +137,17 @@ type AddressObservation struct {
 
 	// The ID of an address pool.
 	PublicIPv4Pool string `json:"publicIpv4Pool,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An AddressStatus represents the observed state of an Address.
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ToDo (haarchri): changed Route to RouteBeta otherwise we got error "CRD for Route.ec2.aws.crossplane.io has no storage version"
@@ -59,6 +61,12 @@ type RouteBeta struct {
 	// an instance ID unless exactly one network interface is attached.
 	InstanceID *string `json:"instanceId,omitempty"`
 
+	// A referencer to retrieve the ID of an Instance
+	InstanceIDRef *xpv1.Reference `json:"instanceIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of an Instance
+	InstanceIDSelector *xpv1.Selector `json:"instanceIdSelector,omitempty"`
+
 	// The ID of the local gateway.
 	LocalGatewayID *string `json:"localGatewayId,omitempty"`
 
@@ -77,8 +85,21 @@ type RouteBeta struct {
 	// The ID of a transit gateway.
 	TransitGatewayID *string `json:"transitGatewayId,omitempty"`
 
+	// A referencer to retrieve the ID of a transit gateway
+	TransitGatewayIDRef *xpv1.Reference `json:"transitGatewayIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a transit gateway
+	TransitGatewayIDSelector *xpv1.Selector `json:"transitGatewayIdSelector,omitempty"`
+
 	// The ID of a VPC peering connection.
 	VpcPeeringConnectionID *string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// A referencer to retrieve the ID of a VPC peering connection
+	VpcPeeringConnectionIDRef *xpv1.Reference `json:"vpcPeeringConnectionIdRef,omitempty"`
+
+	// A selector to select a referencer to retrieve the ID of a VPC peering
+	// connection
+	VpcPeeringConnectionIDSelector *xpv1.Selector `json:"vpcPeeringConnectionIdSelector,omitempty"`
 }
 
 // ClearRefSelectors nils out ref and selectors
@@ -87,6 +108,12 @@ func (r *RouteBeta) ClearRefSelectors() {
 	r.GatewayIDSelector = nil
 	r.NatGatewayIDSelector = nil
 	r.NatGatewayIDRef = nil
+	r.InstanceIDRef = nil
+	r.InstanceIDSelector = nil
+	r.TransitGatewayIDRef = nil
+	r.TransitGatewayIDSelector = nil
+	r.VpcPeeringConnectionIDRef = nil
+	r.VpcPeeringConnectionIDSelector = nil
 }
 
 // RouteState describes a route state in the route table.
@@ -215,6 +242,17 @@ type RouteTableObservation struct {
 
 	// The actual associations created for the route table.
 	Associations []AssociationState `json:"associations,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // A RouteTableStatus represents the observed state of a RouteTable.
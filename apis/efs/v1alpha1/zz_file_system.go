@@ -117,6 +117,7 @@ type FileSystemObservation struct {
 	// Availability Zone ID for the us-east-1 Amazon Web Services Region, and it
 	// has the same location in every Amazon Web Services account.
 	AvailabilityZoneID *string `json:"availabilityZoneID,omitempty"`
+	CallerAccountID    string  `json:"callerAccountID,omitempty"`
 	// The time that the file system was created, in seconds (since 1970-01-01T00:00:00Z).
 	CreationTime *metav1.Time `json:"creationTime,omitempty"`
 	// The opaque string specified in the request.
@@ -125,7 +126,8 @@ type FileSystemObservation struct {
 	// . Example with sample data: arn:aws:elasticfilesystem:us-west-2:1111333322228888:file-system/fs-01234567
 	FileSystemARN *string `json:"fileSystemARN,omitempty"`
 	// The ID of the file system, assigned by Amazon EFS.
-	FileSystemID *string `json:"fileSystemID,omitempty"`
+	FileSystemID  *string        `json:"fileSystemID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The lifecycle phase of the file system.
 	LifeCycleState *string `json:"lifeCycleState,omitempty"`
 	// You can add tags to a file system, including a Name tag. For more information,
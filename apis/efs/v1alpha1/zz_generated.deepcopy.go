@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -348,6 +349,11 @@ func (in *FileSystemObservation) DeepCopyInto(out *FileSystemObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LifeCycleState != nil {
 		in, out := &in.LifeCycleState, &out.LifeCycleState
 		*out = new(string)
@@ -652,6 +658,11 @@ func (in *MountTargetObservation) DeepCopyInto(out *MountTargetObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LifeCycleState != nil {
 		in, out := &in.LifeCycleState, &out.LifeCycleState
 		*out = new(string)
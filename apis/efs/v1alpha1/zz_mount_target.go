@@ -52,8 +52,10 @@ type MountTargetObservation struct {
 	// account might not be the same location as us-east-1a for another Amazon Web
 	// Services account.
 	AvailabilityZoneName *string `json:"availabilityZoneName,omitempty"`
+	CallerAccountID      string  `json:"callerAccountID,omitempty"`
 	// The ID of the file system for which the mount target is intended.
-	FileSystemID *string `json:"fileSystemID,omitempty"`
+	FileSystemID  *string        `json:"fileSystemID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Lifecycle state of the mount target.
 	LifeCycleState *string `json:"lifeCycleState,omitempty"`
 	// System-assigned mount target ID.
@@ -19,6 +19,8 @@ package manualv1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // IdentityProviderConfigType is a type of IdentityProviderConfig
@@ -138,6 +140,17 @@ type IdentityProviderConfigObservation struct {
 	Status IdentityProviderConfigStatusType `json:"status,omitempty"`
 
 	IdentityProviderConfigArn string `json:"identityProviderConfigArn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // A IdentityProviderConfigSpec defines the desired state of an EKS identity provider.
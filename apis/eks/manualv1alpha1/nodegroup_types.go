@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // NodeGroupStatusType is a type of NodeGroup status.
@@ -239,7 +241,8 @@ type LaunchTemplateSpecification struct {
 	NameSelector *xpv1.Selector `json:"nameSelector,omitempty"`
 
 	// The version of the launch template to use. If no version is specified, then the
-	// template's default version is used.
+	// template's default version is used. Changing it updates the node group to
+	// the new version.
 	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.LaunchTemplateVersion
 	Version *string `json:"version,omitempty"`
 
@@ -338,6 +341,17 @@ type NodeGroupObservation struct {
 
 	// The current status of the managed node group.
 	Status NodeGroupStatusType `json:"status,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // NodeGroupHealth describes the health of a node group.
@@ -20,8 +20,15 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
+// AddonVersionLatest can be used as addonVersion to keep an add-on on the
+// newest version that is compatible with the Kubernetes version of its
+// cluster.
+const AddonVersionLatest = "latest"
+
 // CustomAddonParameters contains the additional fields for AddonParameters.
 type CustomAddonParameters struct {
 	// The name of the cluster to create the add-on for.
@@ -41,4 +48,36 @@ type CustomAddonParameters struct {
 	// +immutable
 	// +optional
 	ClusterNameSelector *xpv1.Selector `json:"clusterNameSelector,omitempty"`
+
+	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the add-on's
+	// service account. The role must be assigned the IAM permissions required by
+	// the add-on. If you don't specify an existing IAM role, then the add-on uses
+	// the permissions assigned to the node IAM role. For more information, see
+	// Amazon EKS node IAM role (https://docs.aws.amazon.com/eks/latest/userguide/create-node-role.html)
+	// in the Amazon EKS User Guide.
+	//
+	// To specify an existing IAM role, you must have an IAM OpenID Connect (OIDC)
+	// provider created for your cluster. For more information, see Enabling IAM
+	// roles for service accounts on your cluster (https://docs.aws.amazon.com/eks/latest/userguide/enable-iam-roles-for-service-accounts.html)
+	// in the Amazon EKS User Guide.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	// +crossplane:generate:reference:refFieldName=ServiceAccountRoleARNRef
+	// +crossplane:generate:reference:selectorFieldName=ServiceAccountRoleARNSelector
+	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
+
+	// ServiceAccountRoleARNRef is a reference to an IAM Role used to set
+	// the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNRef *xpv1.Reference `json:"serviceAccountRoleARNRef,omitempty"`
+
+	// ServiceAccountRoleARNSelector selects references to an IAM Role used
+	// to set the ServiceAccountRoleARN.
+	// +optional
+	ServiceAccountRoleARNSelector *xpv1.Selector `json:"serviceAccountRoleARNSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -39,18 +39,6 @@ type AddonParameters struct {
 	// How to resolve parameter value conflicts when migrating an existing add-on
 	// to an Amazon EKS add-on.
 	ResolveConflicts *string `json:"resolveConflicts,omitempty"`
-	// The Amazon Resource Name (ARN) of an existing IAM role to bind to the add-on's
-	// service account. The role must be assigned the IAM permissions required by
-	// the add-on. If you don't specify an existing IAM role, then the add-on uses
-	// the permissions assigned to the node IAM role. For more information, see
-	// Amazon EKS node IAM role (https://docs.aws.amazon.com/eks/latest/userguide/create-node-role.html)
-	// in the Amazon EKS User Guide.
-	//
-	// To specify an existing IAM role, you must have an IAM OpenID Connect (OIDC)
-	// provider created for your cluster. For more information, see Enabling IAM
-	// roles for service accounts on your cluster (https://docs.aws.amazon.com/eks/latest/userguide/enable-iam-roles-for-service-accounts.html)
-	// in the Amazon EKS User Guide.
-	ServiceAccountRoleARN *string `json:"serviceAccountRoleARN,omitempty"`
 	// The metadata to apply to the cluster to assist with categorization and organization.
 	// Each tag consists of a key and an optional value, both of which you define.
 	Tags                  map[string]*string `json:"tags,omitempty"`
@@ -66,13 +54,15 @@ type AddonSpec struct {
 // AddonObservation defines the observed state of Addon
 type AddonObservation struct {
 	// The Amazon Resource Name (ARN) of the add-on.
-	AddonARN *string `json:"addonARN,omitempty"`
+	AddonARN        *string `json:"addonARN,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The name of the cluster.
 	ClusterName *string `json:"clusterName,omitempty"`
 	// The date and time that the add-on was created.
 	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
 	// An object that represents the health of the add-on.
-	Health *AddonHealth `json:"health,omitempty"`
+	Health        *AddonHealth   `json:"health,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The date and time that the add-on was last modified.
 	ModifiedAt *metav1.Time `json:"modifiedAt,omitempty"`
 	// The status of the add-on.
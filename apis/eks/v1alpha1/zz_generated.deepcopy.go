@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -194,6 +195,11 @@ func (in *AddonObservation) DeepCopyInto(out *AddonObservation) {
 		*out = new(AddonHealth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ModifiedAt != nil {
 		in, out := &in.ModifiedAt, &out.ModifiedAt
 		*out = (*in).DeepCopy()
@@ -233,11 +239,6 @@ func (in *AddonParameters) DeepCopyInto(out *AddonParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.ServiceAccountRoleARN != nil {
-		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
-		*out = new(string)
-		**out = **in
-	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make(map[string]*string, len(*in))
@@ -557,6 +558,21 @@ func (in *CustomAddonParameters) DeepCopyInto(out *CustomAddonParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceAccountRoleARN != nil {
+		in, out := &in.ServiceAccountRoleARN, &out.ServiceAccountRoleARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNRef != nil {
+		in, out := &in.ServiceAccountRoleARNRef, &out.ServiceAccountRoleARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ServiceAccountRoleARNSelector != nil {
+		in, out := &in.ServiceAccountRoleARNSelector, &out.ServiceAccountRoleARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAddonParameters.
@@ -16,9 +16,16 @@ limitations under the License.
 
 package v1alpha1
 
+import awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+
 // CustomCacheParameterGroupParameters includes the custom fields.
 type CustomCacheParameterGroupParameters struct {
 	// A list of parameters to associate with this DB parameter group
 	// +optional
 	ParameterNameValues []ParameterNameValue `json:"parameters,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
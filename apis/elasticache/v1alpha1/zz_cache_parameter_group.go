@@ -57,8 +57,10 @@ type CacheParameterGroupObservation struct {
 	ARN *string `json:"arn,omitempty"`
 	// The name of the cache parameter group.
 	CacheParameterGroupName *string `json:"cacheParameterGroupName,omitempty"`
+	CallerAccountID         string  `json:"callerAccountID,omitempty"`
 	// Indicates whether the parameter group is associated with a Global datastore
-	IsGlobal *bool `json:"isGlobal,omitempty"`
+	IsGlobal      *bool          `json:"isGlobal,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // CacheParameterGroupStatus defines the observed state of CacheParameterGroup.
@@ -20,6 +20,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// ResourceCredentialsSecretDNSNameKey is the key in the connection secret
+	// for the DNS name of an ELB.
+	ResourceCredentialsSecretDNSNameKey = "dnsName"
+
+	// ResourceCredentialsSecretHostedZoneIDKey is the key in the connection
+	// secret for the ID of the Route 53 hosted zone of an ELB, as used by
+	// alias records.
+	ResourceCredentialsSecretHostedZoneIDKey = "hostedZoneId"
 )
 
 // Tag defines a key value pair that can be attached to an ELB
@@ -161,6 +174,17 @@ type ELBObservation struct {
 
 	// The ID of the VPC for the load balancer.
 	VPCID string `json:"vpcId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An ELBStatus represents the observed state of an ELB.
@@ -1,6 +1,25 @@
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// ResourceCredentialsSecretDNSNameKey is the key in the connection secret
+	// for the DNS name of a LoadBalancer.
+	ResourceCredentialsSecretDNSNameKey = "dnsName"
+
+	// ResourceCredentialsSecretHostedZoneIDKey is the key in the connection
+	// secret for the ID of the Route 53 hosted zone of a LoadBalancer, as used
+	// by alias records.
+	ResourceCredentialsSecretHostedZoneIDKey = "hostedZoneId"
+
+	// ResourceCredentialsSecretARNKey is the key in the connection secret for
+	// the ARN of a LoadBalancer.
+	ResourceCredentialsSecretARNKey = "arn"
+)
 
 // CustomCertificate includes custom fields about certificates.
 type CustomCertificate struct {
@@ -164,3 +183,8 @@ type CustomTargetGroupParameters struct {
 	// +optional
 	VPCIDSelector *xpv1.Selector `json:"vpcIdSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
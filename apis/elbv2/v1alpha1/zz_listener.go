@@ -74,6 +74,8 @@ type ListenerSpec struct {
 
 // ListenerObservation defines the observed state of Listener
 type ListenerObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the listener.
 	Listeners []*Listener_SDK `json:"listeners,omitempty"`
 }
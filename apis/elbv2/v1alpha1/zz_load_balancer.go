@@ -111,6 +111,8 @@ type LoadBalancerSpec struct {
 
 // LoadBalancerObservation defines the observed state of LoadBalancer
 type LoadBalancerObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the load balancer.
 	LoadBalancers []*LoadBalancer_SDK `json:"loadBalancers,omitempty"`
 }
@@ -137,6 +137,8 @@ type TargetGroupSpec struct {
 
 // TargetGroupObservation defines the observed state of TargetGroup
 type TargetGroupObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the target group.
 	TargetGroups []*TargetGroup_SDK `json:"targetGroups,omitempty"`
 }
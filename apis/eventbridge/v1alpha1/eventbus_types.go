@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Tag is a key-value pair attached to an EventBridge resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// EventBusParameters define the desired state of an EventBridge event bus.
+type EventBusParameters struct {
+	// Region is which region the event bus will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// EventSourceName is the name of the partner event source to associate
+	// with a partner event bus. The external name of a partner event bus
+	// must match it.
+	// +immutable
+	// +optional
+	EventSourceName *string `json:"eventSourceName,omitempty"`
+
+	// Tags to attach to the event bus.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// EventBusObservation keeps the state of an event bus.
+type EventBusObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the event bus.
+	ARN string `json:"arn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An EventBusSpec defines the desired state of an EventBus.
+type EventBusSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventBusParameters `json:"forProvider"`
+}
+
+// An EventBusStatus represents the observed state of an EventBus.
+type EventBusStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventBusObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventBus is a managed resource that represents an AWS EventBridge
+// event bus.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EventBus struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventBusSpec   `json:"spec"`
+	Status EventBusStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventBusList contains a list of EventBuses
+type EventBusList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventBus `json:"items"`
+}
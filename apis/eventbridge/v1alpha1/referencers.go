@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+	snsv1beta1 "github.com/crossplane/provider-aws/apis/sns/v1beta1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+// ResolveReferences of this Target.
+func (mg *Target) ResolveReferences(ctx context.Context, c client.Reader) error { // nolint:gocyclo
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.ForProvider
+
+	// Resolve spec.forProvider.rule
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.Rule),
+		Reference:    p.RuleRef,
+		Selector:     p.RuleSelector,
+		To:           reference.To{Managed: &Rule{}, List: &RuleList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rule")
+	}
+	p.Rule = reference.ToPtrValue(rsp.ResolvedValue)
+	p.RuleRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventBusName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.EventBusName),
+		Reference:    p.EventBusNameRef,
+		Selector:     p.EventBusNameSelector,
+		To:           reference.To{Managed: &EventBus{}, List: &EventBusList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.eventBusName")
+	}
+	p.EventBusName = reference.ToPtrValue(rsp.ResolvedValue)
+	p.EventBusNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.arn from whichever kind of target is
+	// referenced. Once resolved, the remaining requests are no-ops.
+	targets := []struct {
+		path     string
+		ref      **xpv1.Reference
+		selector *xpv1.Selector
+		to       reference.To
+		extract  reference.ExtractValueFn
+	}{
+		{
+			path:     "spec.forProvider.lambdaFunctionRef",
+			ref:      &p.LambdaFunctionRef,
+			selector: p.LambdaFunctionSelector,
+			to:       reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+			extract:  lambdav1beta1.FunctionARN(),
+		},
+		{
+			path:     "spec.forProvider.sqsQueueRef",
+			ref:      &p.SQSQueueRef,
+			selector: p.SQSQueueSelector,
+			to:       reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			extract:  sqsv1beta1.QueueARN(),
+		},
+		{
+			path:     "spec.forProvider.snsTopicRef",
+			ref:      &p.SNSTopicRef,
+			selector: p.SNSTopicSelector,
+			to:       reference.To{Managed: &snsv1beta1.Topic{}, List: &snsv1beta1.TopicList{}},
+			extract:  snsv1beta1.SNSTopicARN(),
+		},
+		{
+			path:     "spec.forProvider.stateMachineRef",
+			ref:      &p.StateMachineRef,
+			selector: p.StateMachineSelector,
+			to:       reference.To{Managed: &sfnv1alpha1.StateMachine{}, List: &sfnv1alpha1.StateMachineList{}},
+			extract:  reference.ExternalName(),
+		},
+	}
+	for _, t := range targets {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.ARN),
+			Reference:    *t.ref,
+			Selector:     t.selector,
+			To:           t.to,
+			Extract:      t.extract,
+		})
+		if err != nil {
+			return errors.Wrap(err, t.path)
+		}
+		p.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		*t.ref = rsp.ResolvedReference
+	}
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.RoleARN),
+		Reference:    p.RoleARNRef,
+		Selector:     p.RoleARNSelector,
+		To:           reference.To{Managed: &iamv1beta1.Role{}, List: &iamv1beta1.RoleList{}},
+		Extract:      iamv1beta1.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleArn")
+	}
+	p.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	p.RoleARNRef = rsp.ResolvedReference
+
+	if p.DeadLetterConfig != nil {
+		// Resolve spec.forProvider.deadLetterConfig.arn
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.DeadLetterConfig.ARN),
+			Reference:    p.DeadLetterConfig.ARNRef,
+			Selector:     p.DeadLetterConfig.ARNSelector,
+			To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			Extract:      sqsv1beta1.QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.deadLetterConfig.arn")
+		}
+		p.DeadLetterConfig.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.DeadLetterConfig.ARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "eventbridge.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// EventBus type metadata.
+var (
+	EventBusKind             = reflect.TypeOf(EventBus{}).Name()
+	EventBusGroupKind        = schema.GroupKind{Group: Group, Kind: EventBusKind}.String()
+	EventBusKindAPIVersion   = EventBusKind + "." + SchemeGroupVersion.String()
+	EventBusGroupVersionKind = SchemeGroupVersion.WithKind(EventBusKind)
+)
+
+// Rule type metadata.
+var (
+	RuleKind             = reflect.TypeOf(Rule{}).Name()
+	RuleGroupKind        = schema.GroupKind{Group: Group, Kind: RuleKind}.String()
+	RuleKindAPIVersion   = RuleKind + "." + SchemeGroupVersion.String()
+	RuleGroupVersionKind = SchemeGroupVersion.WithKind(RuleKind)
+)
+
+// Target type metadata.
+var (
+	TargetKind             = reflect.TypeOf(Target{}).Name()
+	TargetGroupKind        = schema.GroupKind{Group: Group, Kind: TargetKind}.String()
+	TargetKindAPIVersion   = TargetKind + "." + SchemeGroupVersion.String()
+	TargetGroupVersionKind = SchemeGroupVersion.WithKind(TargetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EventBus{}, &EventBusList{})
+	SchemeBuilder.Register(&Rule{}, &RuleList{})
+	SchemeBuilder.Register(&Target{}, &TargetList{})
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// RuleParameters define the desired state of an EventBridge rule.
+type RuleParameters struct {
+	// Region is which region the rule will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// EventBusName is the name of the event bus the rule is associated
+	// with. The default event bus is used if omitted.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=EventBus
+	EventBusName *string `json:"eventBusName,omitempty"`
+
+	// EventBusNameRef references an EventBus to retrieve its name.
+	// +optional
+	EventBusNameRef *xpv1.Reference `json:"eventBusNameRef,omitempty"`
+
+	// EventBusNameSelector selects a reference to an EventBus to retrieve
+	// its name.
+	// +optional
+	EventBusNameSelector *xpv1.Selector `json:"eventBusNameSelector,omitempty"`
+
+	// Description of the rule.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// EventPattern is the JSON event pattern that events must match to
+	// trigger the rule. At least one of EventPattern and
+	// ScheduleExpression must be set.
+	// +optional
+	EventPattern *string `json:"eventPattern,omitempty"`
+
+	// ScheduleExpression is the rate or cron expression that triggers the
+	// rule, for example "rate(5 minutes)" or "cron(0 20 * * ? *)".
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// State of the rule. Defaults to ENABLED.
+	// +kubebuilder:validation:Enum=ENABLED;DISABLED
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// RoleARN is the ARN of the IAM role associated with the rule.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/iam/v1beta1.RoleARN()
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to a Role to retrieve its ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// Tags to attach to the rule.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// RuleObservation keeps the state of a rule.
+type RuleObservation struct {
+	// ARN is the Amazon Resource Name (ARN) of the rule.
+	ARN string `json:"arn,omitempty"`
+
+	// State of the rule.
+	State string `json:"state,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A RuleSpec defines the desired state of a Rule.
+type RuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RuleParameters `json:"forProvider"`
+}
+
+// A RuleStatus represents the observed state of a Rule.
+type RuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Rule is a managed resource that represents an AWS EventBridge rule,
+// which routes matching or scheduled events to its Targets.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Rule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuleSpec   `json:"spec"`
+	Status RuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RuleList contains a list of Rules
+type RuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Rule `json:"items"`
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// InputTransformer customizes the input sent to a target based on the
+// event data.
+type InputTransformer struct {
+	// InputPathsMap maps variable names to JSON paths in the event.
+	// +optional
+	InputPathsMap map[string]string `json:"inputPathsMap,omitempty"`
+
+	// InputTemplate is the input sent to the target, in which the variables
+	// of InputPathsMap can be used as <name>.
+	InputTemplate string `json:"inputTemplate"`
+}
+
+// DeadLetterConfig is the SQS queue events that could not be delivered to
+// the target are sent to.
+type DeadLetterConfig struct {
+	// ARN of the SQS queue.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// ARNRef references a Queue to retrieve its ARN.
+	// +optional
+	ARNRef *xpv1.Reference `json:"arnRef,omitempty"`
+
+	// ARNSelector selects a reference to a Queue to retrieve its ARN.
+	// +optional
+	ARNSelector *xpv1.Selector `json:"arnSelector,omitempty"`
+}
+
+// RetryPolicy sets how often EventBridge retries to deliver an event to the
+// target.
+type RetryPolicy struct {
+	// MaximumEventAgeInSeconds is the maximum amount of time to keep
+	// unprocessed events.
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=86400
+	// +optional
+	MaximumEventAgeInSeconds *int64 `json:"maximumEventAgeInSeconds,omitempty"`
+
+	// MaximumRetryAttempts is the maximum number of times to retry sending
+	// an event.
+	// +kubebuilder:validation:Maximum=185
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+}
+
+// SQSParameters are the parameters of an SQS FIFO queue target.
+type SQSParameters struct {
+	// MessageGroupID is the FIFO message group ID to use as the target.
+	// +optional
+	MessageGroupID *string `json:"messageGroupId,omitempty"`
+}
+
+// TargetParameters define the desired state of an EventBridge target.
+type TargetParameters struct {
+	// Region is which region the target will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Rule is the name of the rule the target belongs to.
+	// +immutable
+	// +optional
+	Rule *string `json:"rule,omitempty"`
+
+	// RuleRef references a Rule to retrieve its name.
+	// +optional
+	RuleRef *xpv1.Reference `json:"ruleRef,omitempty"`
+
+	// RuleSelector selects a reference to a Rule to retrieve its name.
+	// +optional
+	RuleSelector *xpv1.Selector `json:"ruleSelector,omitempty"`
+
+	// EventBusName is the name of the event bus of the rule. The default
+	// event bus is used if omitted.
+	// +immutable
+	// +optional
+	EventBusName *string `json:"eventBusName,omitempty"`
+
+	// EventBusNameRef references an EventBus to retrieve its name.
+	// +optional
+	EventBusNameRef *xpv1.Reference `json:"eventBusNameRef,omitempty"`
+
+	// EventBusNameSelector selects a reference to an EventBus to retrieve
+	// its name.
+	// +optional
+	EventBusNameSelector *xpv1.Selector `json:"eventBusNameSelector,omitempty"`
+
+	// ARN of the target. It can be set directly or resolved from exactly
+	// one of the Lambda function, SQS queue, SNS topic or state machine
+	// references and selectors.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// LambdaFunctionRef references a Lambda Function to retrieve its ARN.
+	// +optional
+	LambdaFunctionRef *xpv1.Reference `json:"lambdaFunctionRef,omitempty"`
+
+	// LambdaFunctionSelector selects a reference to a Lambda Function to
+	// retrieve its ARN.
+	// +optional
+	LambdaFunctionSelector *xpv1.Selector `json:"lambdaFunctionSelector,omitempty"`
+
+	// SQSQueueRef references an SQS Queue to retrieve its ARN.
+	// +optional
+	SQSQueueRef *xpv1.Reference `json:"sqsQueueRef,omitempty"`
+
+	// SQSQueueSelector selects a reference to an SQS Queue to retrieve its
+	// ARN.
+	// +optional
+	SQSQueueSelector *xpv1.Selector `json:"sqsQueueSelector,omitempty"`
+
+	// SNSTopicRef references an SNS Topic to retrieve its ARN.
+	// +optional
+	SNSTopicRef *xpv1.Reference `json:"snsTopicRef,omitempty"`
+
+	// SNSTopicSelector selects a reference to an SNS Topic to retrieve its
+	// ARN.
+	// +optional
+	SNSTopicSelector *xpv1.Selector `json:"snsTopicSelector,omitempty"`
+
+	// StateMachineRef references a Step Functions StateMachine to retrieve
+	// its ARN.
+	// +optional
+	StateMachineRef *xpv1.Reference `json:"stateMachineRef,omitempty"`
+
+	// StateMachineSelector selects a reference to a Step Functions
+	// StateMachine to retrieve its ARN.
+	// +optional
+	StateMachineSelector *xpv1.Selector `json:"stateMachineSelector,omitempty"`
+
+	// RoleARN is the ARN of the IAM role EventBridge uses to invoke the
+	// target. It is required for Step Functions targets.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef references a Role to retrieve its ARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects a reference to a Role to retrieve its ARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// Input is valid JSON text passed to the target instead of the
+	// matching event.
+	// +optional
+	Input *string `json:"input,omitempty"`
+
+	// InputPath is the JSONPath of the part of the matching event that is
+	// passed to the target.
+	// +optional
+	InputPath *string `json:"inputPath,omitempty"`
+
+	// InputTransformer customizes the input passed to the target based on
+	// the matching event.
+	// +optional
+	InputTransformer *InputTransformer `json:"inputTransformer,omitempty"`
+
+	// DeadLetterConfig is the SQS queue undeliverable events are sent to.
+	// +optional
+	DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"`
+
+	// RetryPolicy sets how often delivery of an event is retried.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// SQSParameters are the parameters of SQS FIFO queue targets.
+	// +optional
+	SQSParameters *SQSParameters `json:"sqsParameters,omitempty"`
+}
+
+// A TargetSpec defines the desired state of a Target.
+type TargetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TargetParameters `json:"forProvider"`
+}
+
+// TargetObservation keeps the state for the external resource.
+type TargetObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A TargetStatus represents the observed state of a Target.
+type TargetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TargetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Target is a managed resource that represents a target of an AWS
+// EventBridge rule. Its external name is the ID of the target within the
+// rule.
+// +kubebuilder:printcolumn:name="RULE",type="string",JSONPath=".spec.forProvider.rule"
+// +kubebuilder:printcolumn:name="ARN",type="string",JSONPath=".spec.forProvider.arn"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Target struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TargetSpec   `json:"spec"`
+	Status TargetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TargetList contains a list of Targets
+type TargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Target `json:"items"`
+}
@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // CustomJobParameters contains the additional fields for JobParameters.
@@ -179,6 +181,16 @@ type CustomCrawlerParameters struct {
 	// to set the RoleArn.
 	// +optional
 	RoleArnSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+
+	// DatabaseNameRef is a reference to a Database used to set
+	// the DatabaseName.
+	// +optional
+	DatabaseNameRef *xpv1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector selects references to Database used
+	// to set the DatabaseName.
+	// +optional
+	DatabaseNameSelector *xpv1.Selector `json:"databaseNameSelector,omitempty"`
 }
 
 // CustomClassifierParameters contains the additional fields for ClassifierParameters
@@ -265,3 +277,8 @@ type CustomCreateCsvClassifierRequest struct {
 	// +optional
 	QuoteSymbol *string `json:"quoteSymbol,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -0,0 +1,262 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// TableColumn is a column of a Table.
+type TableColumn struct {
+	// The name of the column.
+	Name string `json:"name"`
+
+	// The data type of the column, e.g. string or bigint.
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// A free-form comment for the column.
+	// +optional
+	Comment *string `json:"comment,omitempty"`
+
+	// Key-value pairs that define properties associated with the column.
+	// +optional
+	Parameters map[string]*string `json:"parameters,omitempty"`
+}
+
+// TableSerDeInfo describes the serialization and deserialization of the
+// table data.
+type TableSerDeInfo struct {
+	// Name of the SerDe.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// The library that implements the SerDe, e.g.
+	// org.apache.hadoop.hive.serde2.columnar.ColumnarSerDe.
+	// +optional
+	SerializationLibrary *string `json:"serializationLibrary,omitempty"`
+
+	// Initialization parameters of the SerDe.
+	// +optional
+	Parameters map[string]*string `json:"parameters,omitempty"`
+}
+
+// TableSortColumn is a column the table data is sorted by.
+type TableSortColumn struct {
+	// The name of the column.
+	Column string `json:"column"`
+
+	// The sort order of the column, 1 for ascending and 0 for descending.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1
+	SortOrder int64 `json:"sortOrder"`
+}
+
+// TableStorageDescriptor describes the physical storage of the table data.
+type TableStorageDescriptor struct {
+	// The columns of the table.
+	// +optional
+	Columns []TableColumn `json:"columns,omitempty"`
+
+	// The physical location of the table, e.g. s3://bucket/prefix/.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// The input format, e.g. org.apache.hadoop.mapred.TextInputFormat.
+	// +optional
+	InputFormat *string `json:"inputFormat,omitempty"`
+
+	// The output format, e.g.
+	// org.apache.hadoop.hive.ql.io.HiveIgnoreKeyTextOutputFormat.
+	// +optional
+	OutputFormat *string `json:"outputFormat,omitempty"`
+
+	// Whether the data in the table is compressed.
+	// +optional
+	Compressed *bool `json:"compressed,omitempty"`
+
+	// The number of buckets. Must be set if the table contains any
+	// dimension columns.
+	// +optional
+	NumberOfBuckets *int64 `json:"numberOfBuckets,omitempty"`
+
+	// The reducer grouping columns, clustering columns, and bucketing
+	// columns of the table.
+	// +optional
+	BucketColumns []string `json:"bucketColumns,omitempty"`
+
+	// The serialization and deserialization of the table data.
+	// +optional
+	SerDeInfo *TableSerDeInfo `json:"serDeInfo,omitempty"`
+
+	// The sort order of each bucket in the table.
+	// +optional
+	SortColumns []TableSortColumn `json:"sortColumns,omitempty"`
+
+	// User-supplied properties in key-value form.
+	// +optional
+	Parameters map[string]*string `json:"parameters,omitempty"`
+
+	// Whether the table data is stored in subdirectories.
+	// +optional
+	StoredAsSubDirectories *bool `json:"storedAsSubDirectories,omitempty"`
+}
+
+// TableParameters define the desired state of a Glue Table.
+type TableParameters struct {
+	// Region is which region the Table will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// The ID of the Data Catalog in which to create the table. Defaults to
+	// the AWS account ID.
+	// +immutable
+	// +optional
+	CatalogID *string `json:"catalogID,omitempty"`
+
+	// The name of the database the table is created in.
+	// +immutable
+	// +optional
+	DatabaseName *string `json:"databaseName,omitempty"`
+
+	// DatabaseNameRef is a reference to a Database used to set the
+	// DatabaseName.
+	// +immutable
+	// +optional
+	DatabaseNameRef *xpv1.Reference `json:"databaseNameRef,omitempty"`
+
+	// DatabaseNameSelector selects a reference to a Database used to set the
+	// DatabaseName.
+	// +optional
+	DatabaseNameSelector *xpv1.Selector `json:"databaseNameSelector,omitempty"`
+
+	// A description of the table.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// The owner of the table.
+	// +optional
+	Owner *string `json:"owner,omitempty"`
+
+	// Properties associated with the table, e.g. classification.
+	// +optional
+	Parameters map[string]*string `json:"parameters,omitempty"`
+
+	// The columns by which the table is partitioned.
+	// +optional
+	PartitionKeys []TableColumn `json:"partitionKeys,omitempty"`
+
+	// The retention time of the table.
+	// +optional
+	Retention *int64 `json:"retention,omitempty"`
+
+	// The physical storage of the table data.
+	// +optional
+	StorageDescriptor *TableStorageDescriptor `json:"storageDescriptor,omitempty"`
+
+	// The type of the table, e.g. EXTERNAL_TABLE or VIRTUAL_VIEW.
+	// +optional
+	TableType *string `json:"tableType,omitempty"`
+
+	// The expanded text of the view if the table is a view.
+	// +optional
+	ViewExpandedText *string `json:"viewExpandedText,omitempty"`
+
+	// The original text of the view if the table is a view.
+	// +optional
+	ViewOriginalText *string `json:"viewOriginalText,omitempty"`
+}
+
+// TableSpec defines the desired state of a Table.
+type TableSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       TableParameters `json:"forProvider"`
+}
+
+// TableObservation defines the observed state of a Table.
+type TableObservation struct {
+	// The time the table was created.
+	CreateTime *metav1.Time `json:"createTime,omitempty"`
+
+	// The time the table was last updated.
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+
+	// The person or entity who created the table.
+	CreatedBy *string `json:"createdBy,omitempty"`
+
+	// Whether the table is registered with Lake Formation.
+	IsRegisteredWithLakeFormation *bool `json:"isRegisteredWithLakeFormation,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// TableStatus defines the observed state of a Table.
+type TableStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          TableObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Table is a managed resource that represents a table in the Glue Data
+// Catalog.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Table struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              TableSpec   `json:"spec"`
+	Status            TableStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TableList contains a list of Tables
+type TableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Table `json:"items"`
+}
+
+// Table type metadata.
+var (
+	TableKind             = "Table"
+	TableGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: TableKind}.String()
+	TableKindAPIVersion   = TableKind + "." + GroupVersion.String()
+	TableGroupVersionKind = GroupVersion.WithKind(TableKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Table{}, &TableList{})
+}
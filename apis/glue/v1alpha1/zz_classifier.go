@@ -40,6 +40,8 @@ type ClassifierSpec struct {
 
 // ClassifierObservation defines the observed state of Classifier
 type ClassifierObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // ClassifierStatus defines the observed state of Classifier.
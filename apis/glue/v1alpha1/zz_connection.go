@@ -45,6 +45,8 @@ type ConnectionSpec struct {
 
 // ConnectionObservation defines the observed state of Connection
 type ConnectionObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // ConnectionStatus defines the observed state of Connection.
@@ -76,6 +76,8 @@ type CrawlerSpec struct {
 
 // CrawlerObservation defines the observed state of Crawler
 type CrawlerObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // CrawlerStatus defines the observed state of Crawler.
@@ -43,6 +43,8 @@ type DatabaseSpec struct {
 
 // DatabaseObservation defines the observed state of Database
 type DatabaseObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // DatabaseStatus defines the observed state of Database.
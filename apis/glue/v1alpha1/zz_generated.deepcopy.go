@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -329,6 +330,11 @@ func (in *ClassifierList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClassifierObservation) DeepCopyInto(out *ClassifierObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassifierObservation.
@@ -378,7 +384,7 @@ func (in *ClassifierSpec) DeepCopy() *ClassifierSpec {
 func (in *ClassifierStatus) DeepCopyInto(out *ClassifierStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClassifierStatus.
@@ -718,6 +724,11 @@ func (in *ConnectionList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionObservation) DeepCopyInto(out *ConnectionObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionObservation.
@@ -812,7 +823,7 @@ func (in *ConnectionSpec) DeepCopy() *ConnectionSpec {
 func (in *ConnectionStatus) DeepCopyInto(out *ConnectionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionStatus.
@@ -1050,6 +1061,11 @@ func (in *CrawlerMetrics) DeepCopy() *CrawlerMetrics {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CrawlerObservation) DeepCopyInto(out *CrawlerObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerObservation.
@@ -1175,7 +1191,7 @@ func (in *CrawlerSpec) DeepCopy() *CrawlerSpec {
 func (in *CrawlerStatus) DeepCopyInto(out *CrawlerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrawlerStatus.
@@ -1741,6 +1757,16 @@ func (in *CustomCrawlerParameters) DeepCopyInto(out *CustomCrawlerParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DatabaseNameRef != nil {
+		in, out := &in.DatabaseNameRef, &out.DatabaseNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseNameSelector != nil {
+		in, out := &in.DatabaseNameSelector, &out.DatabaseNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCrawlerParameters.
@@ -2267,6 +2293,11 @@ func (in *DatabaseList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatabaseObservation) DeepCopyInto(out *DatabaseObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseObservation.
@@ -2321,7 +2352,7 @@ func (in *DatabaseSpec) DeepCopy() *DatabaseSpec {
 func (in *DatabaseStatus) DeepCopyInto(out *DatabaseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatabaseStatus.
@@ -3129,6 +3160,11 @@ func (in *JobList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JobObservation) DeepCopyInto(out *JobObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -4491,6 +4527,11 @@ func (in *SecurityConfigurationObservation) DeepCopyInto(out *SecurityConfigurat
 		in, out := &in.CreatedTimestamp, &out.CreatedTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
 		*out = new(string)
@@ -4715,34 +4756,66 @@ func (in *StorageDescriptor) DeepCopy() *StorageDescriptor {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Table) DeepCopyInto(out *Table) {
 	*out = *in
-	if in.CatalogID != nil {
-		in, out := &in.CatalogID, &out.CatalogID
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Table.
+func (in *Table) DeepCopy() *Table {
+	if in == nil {
+		return nil
 	}
-	if in.ConnectionName != nil {
-		in, out := &in.ConnectionName, &out.ConnectionName
-		*out = new(string)
-		**out = **in
+	out := new(Table)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Table) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.DatabaseName != nil {
-		in, out := &in.DatabaseName, &out.DatabaseName
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableColumn) DeepCopyInto(out *TableColumn) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
 		*out = new(string)
 		**out = **in
 	}
-	if in.TableName != nil {
-		in, out := &in.TableName, &out.TableName
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Table.
-func (in *Table) DeepCopy() *Table {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableColumn.
+func (in *TableColumn) DeepCopy() *TableColumn {
 	if in == nil {
 		return nil
 	}
-	out := new(Table)
+	out := new(TableColumn)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4931,6 +5004,339 @@ func (in *TableInput) DeepCopy() *TableInput {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableList) DeepCopyInto(out *TableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Table, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableList.
+func (in *TableList) DeepCopy() *TableList {
+	if in == nil {
+		return nil
+	}
+	out := new(TableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableObservation) DeepCopyInto(out *TableObservation) {
+	*out = *in
+	if in.CreateTime != nil {
+		in, out := &in.CreateTime, &out.CreateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdateTime != nil {
+		in, out := &in.UpdateTime, &out.UpdateTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedBy != nil {
+		in, out := &in.CreatedBy, &out.CreatedBy
+		*out = new(string)
+		**out = **in
+	}
+	if in.IsRegisteredWithLakeFormation != nil {
+		in, out := &in.IsRegisteredWithLakeFormation, &out.IsRegisteredWithLakeFormation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableObservation.
+func (in *TableObservation) DeepCopy() *TableObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(TableObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableParameters) DeepCopyInto(out *TableParameters) {
+	*out = *in
+	if in.CatalogID != nil {
+		in, out := &in.CatalogID, &out.CatalogID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseNameRef != nil {
+		in, out := &in.DatabaseNameRef, &out.DatabaseNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DatabaseNameSelector != nil {
+		in, out := &in.DatabaseNameSelector, &out.DatabaseNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Owner != nil {
+		in, out := &in.Owner, &out.Owner
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.PartitionKeys != nil {
+		in, out := &in.PartitionKeys, &out.PartitionKeys
+		*out = make([]TableColumn, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StorageDescriptor != nil {
+		in, out := &in.StorageDescriptor, &out.StorageDescriptor
+		*out = new(TableStorageDescriptor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TableType != nil {
+		in, out := &in.TableType, &out.TableType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ViewExpandedText != nil {
+		in, out := &in.ViewExpandedText, &out.ViewExpandedText
+		*out = new(string)
+		**out = **in
+	}
+	if in.ViewOriginalText != nil {
+		in, out := &in.ViewOriginalText, &out.ViewOriginalText
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableParameters.
+func (in *TableParameters) DeepCopy() *TableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableSerDeInfo) DeepCopyInto(out *TableSerDeInfo) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.SerializationLibrary != nil {
+		in, out := &in.SerializationLibrary, &out.SerializationLibrary
+		*out = new(string)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableSerDeInfo.
+func (in *TableSerDeInfo) DeepCopy() *TableSerDeInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(TableSerDeInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableSortColumn) DeepCopyInto(out *TableSortColumn) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableSortColumn.
+func (in *TableSortColumn) DeepCopy() *TableSortColumn {
+	if in == nil {
+		return nil
+	}
+	out := new(TableSortColumn)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableSpec) DeepCopyInto(out *TableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableSpec.
+func (in *TableSpec) DeepCopy() *TableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableStatus) DeepCopyInto(out *TableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableStatus.
+func (in *TableStatus) DeepCopy() *TableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableStorageDescriptor) DeepCopyInto(out *TableStorageDescriptor) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]TableColumn, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.InputFormat != nil {
+		in, out := &in.InputFormat, &out.InputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.OutputFormat != nil {
+		in, out := &in.OutputFormat, &out.OutputFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.Compressed != nil {
+		in, out := &in.Compressed, &out.Compressed
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NumberOfBuckets != nil {
+		in, out := &in.NumberOfBuckets, &out.NumberOfBuckets
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BucketColumns != nil {
+		in, out := &in.BucketColumns, &out.BucketColumns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SerDeInfo != nil {
+		in, out := &in.SerDeInfo, &out.SerDeInfo
+		*out = new(TableSerDeInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SortColumns != nil {
+		in, out := &in.SortColumns, &out.SortColumns
+		*out = make([]TableSortColumn, len(*in))
+		copy(*out, *in)
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.StoredAsSubDirectories != nil {
+		in, out := &in.StoredAsSubDirectories, &out.StoredAsSubDirectories
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableStorageDescriptor.
+func (in *TableStorageDescriptor) DeepCopy() *TableStorageDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(TableStorageDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TableVersionError) DeepCopyInto(out *TableVersionError) {
 	*out = *in
@@ -4951,6 +5357,41 @@ func (in *TableVersionError) DeepCopy() *TableVersionError {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Table_SDK) DeepCopyInto(out *Table_SDK) {
+	*out = *in
+	if in.CatalogID != nil {
+		in, out := &in.CatalogID, &out.CatalogID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConnectionName != nil {
+		in, out := &in.ConnectionName, &out.ConnectionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DatabaseName != nil {
+		in, out := &in.DatabaseName, &out.DatabaseName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TableName != nil {
+		in, out := &in.TableName, &out.TableName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Table_SDK.
+func (in *Table_SDK) DeepCopy() *Table_SDK {
+	if in == nil {
+		return nil
+	}
+	out := new(Table_SDK)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TaskRun) DeepCopyInto(out *TaskRun) {
 	*out = *in
@@ -73,3 +73,12 @@ func (l *SecurityConfigurationList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this TableList.
+func (l *TableList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -141,6 +141,8 @@ type JobSpec struct {
 
 // JobObservation defines the observed state of Job
 type JobObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The unique name that was provided for this job definition.
 	Name *string `json:"name,omitempty"`
 }
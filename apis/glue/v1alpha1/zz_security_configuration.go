@@ -40,8 +40,10 @@ type SecurityConfigurationSpec struct {
 
 // SecurityConfigurationObservation defines the observed state of SecurityConfiguration
 type SecurityConfigurationObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The time at which the new security configuration was created.
-	CreatedTimestamp *metav1.Time `json:"createdTimestamp,omitempty"`
+	CreatedTimestamp *metav1.Time   `json:"createdTimestamp,omitempty"`
+	LastOperation    *LastOperation `json:"lastOperation,omitempty"`
 	// The name assigned to the new security configuration.
 	Name *string `json:"name,omitempty"`
 }
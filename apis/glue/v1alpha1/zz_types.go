@@ -953,7 +953,7 @@ type StorageDescriptor struct {
 }
 
 // +kubebuilder:skipversion
-type Table struct {
+type Table_SDK struct {
 	CatalogID *string `json:"catalogID,omitempty"`
 
 	ConnectionName *string `json:"connectionName,omitempty"`
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DetectorDataSources configure the optional data sources a detector
+// analyses in addition to CloudTrail, DNS and VPC flow logs.
+type DetectorDataSources struct {
+	// S3Logs specifies whether S3 data events are analysed.
+	// +optional
+	S3Logs *bool `json:"s3Logs,omitempty"`
+}
+
+// DetectorParameters define the desired state of an AWS GuardDuty detector.
+type DetectorParameters struct {
+	// Region is which region the Detector will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Enable specifies whether the detector generates findings. Defaults to
+	// true.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+
+	// FindingPublishingFrequency is how often updated findings are exported
+	// to EventBridge and S3. Defaults to SIX_HOURS.
+	// +kubebuilder:validation:Enum=FIFTEEN_MINUTES;ONE_HOUR;SIX_HOURS
+	// +optional
+	FindingPublishingFrequency *string `json:"findingPublishingFrequency,omitempty"`
+
+	// DataSources configure the optional data sources of the detector.
+	// +optional
+	DataSources *DetectorDataSources `json:"dataSources,omitempty"`
+
+	// Tags to attach to the detector. Tags are only applied when the
+	// detector is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// DetectorObservation keeps the state for the external resource
+type DetectorObservation struct {
+	// ServiceRole is the service-linked role GuardDuty uses.
+	ServiceRole string `json:"serviceRole,omitempty"`
+
+	// Status of the detector, either ENABLED or DISABLED.
+	Status string `json:"status,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A DetectorSpec defines the desired state of a Detector.
+type DetectorSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DetectorParameters `json:"forProvider"`
+}
+
+// A DetectorStatus represents the observed state of a Detector.
+type DetectorStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DetectorObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Detector is a managed resource that represents an AWS GuardDuty
+// detector. There can only be one detector per account and region.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Detector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DetectorSpec   `json:"spec"`
+	Status DetectorStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DetectorList contains a list of Detectors
+type DetectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Detector `json:"items"`
+}
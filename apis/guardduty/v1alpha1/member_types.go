@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// MemberParameters define the desired state of an AWS GuardDuty member
+// account.
+type MemberParameters struct {
+	// Region is which region the Member will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// DetectorID is the ID of the detector of the administrator account.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Detector
+	DetectorID *string `json:"detectorId,omitempty"`
+
+	// DetectorIDRef references a Detector to retrieve its ID.
+	// +optional
+	DetectorIDRef *xpv1.Reference `json:"detectorIdRef,omitempty"`
+
+	// DetectorIDSelector selects a reference to a Detector to retrieve its
+	// ID.
+	// +optional
+	DetectorIDSelector *xpv1.Selector `json:"detectorIdSelector,omitempty"`
+
+	// AccountID is the ID of the member account.
+	// +immutable
+	AccountID string `json:"accountId"`
+
+	// Email is the email address of the member account.
+	// +immutable
+	Email string `json:"email"`
+
+	// Invite specifies whether the member account is invited to accept the
+	// administrator account. Defaults to true.
+	// +optional
+	Invite *bool `json:"invite,omitempty"`
+
+	// InvitationMessage is added to the invitation sent to the member
+	// account.
+	// +optional
+	InvitationMessage *string `json:"invitationMessage,omitempty"`
+
+	// DisableEmailNotification specifies whether the email notification of
+	// the invitation is skipped.
+	// +optional
+	DisableEmailNotification *bool `json:"disableEmailNotification,omitempty"`
+}
+
+// MemberObservation keeps the state for the external resource
+type MemberObservation struct {
+	// RelationshipStatus of the member account, for example Invited or
+	// Enabled.
+	RelationshipStatus string `json:"relationshipStatus,omitempty"`
+
+	// InvitedAt is when the member account was invited.
+	InvitedAt string `json:"invitedAt,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A MemberSpec defines the desired state of a Member.
+type MemberSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MemberParameters `json:"forProvider"`
+}
+
+// A MemberStatus represents the observed state of a Member.
+type MemberStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MemberObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Member is a managed resource that represents an AWS GuardDuty member
+// account associated with, and optionally invited by, the detector of an
+// administrator account.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.relationshipStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Member struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MemberSpec   `json:"spec"`
+	Status MemberStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MemberList contains a list of Members
+type MemberList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Member `json:"items"`
+}
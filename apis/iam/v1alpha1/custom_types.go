@@ -1,11 +1,17 @@
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomInstanceProfileParameters includes the custom fields of InstanceProfile.
 type CustomInstanceProfileParameters struct {
-	// Role is the ID for the Role to add to Instance Profile.
-	// +immutable
+	// Role is the name of the Role to add to the InstanceProfile. An instance
+	// profile contains at most one role, so changing it replaces the role that
+	// is currently attached.
+	// +optional
 	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/iam/v1beta1.Role
 	Role *string `json:"role,omitempty"`
 
@@ -17,3 +23,8 @@ type CustomInstanceProfileParameters struct {
 	// +optional
 	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -63,7 +63,8 @@ type InstanceProfileObservation struct {
 	// information about ARNs and how to use them in policies, see IAM identifiers
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/Using_Identifiers.html)
 	// in the IAM User Guide.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The date when the instance profile was created.
 	CreateDate *metav1.Time `json:"createDate,omitempty"`
 	// The stable and unique string identifying the instance profile. For more information
@@ -71,7 +72,8 @@ type InstanceProfileObservation struct {
 	// in the IAM User Guide.
 	InstanceProfileID *string `json:"instanceProfileID,omitempty"`
 	// The name identifying the instance profile.
-	InstanceProfileName *string `json:"instanceProfileName,omitempty"`
+	InstanceProfileName *string        `json:"instanceProfileName,omitempty"`
+	LastOperation       *LastOperation `json:"lastOperation,omitempty"`
 	// The role associated with the instance profile.
 	Roles []*Role `json:"roles,omitempty"`
 }
@@ -20,8 +20,42 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AnnotationKeyRotateAccessKey can be set on an AccessKey to rotate it. A new
+// rotation is triggered every time the value of the annotation changes.
+const AnnotationKeyRotateAccessKey = "iam.aws.crossplane.io/rotate"
+
+// Policies for the access key that was replaced by a rotation.
+const (
+	PreviousAccessKeyPolicyDelete     = "Delete"
+	PreviousAccessKeyPolicyDeactivate = "Deactivate"
 )
 
+// AccessKeyRotation configures the rotation of an AccessKey.
+type AccessKeyRotation struct {
+	// MaxAge is the age after which the AccessKey is rotated, for example
+	// 2160h. Keys are only rotated on demand if MaxAge is not set.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+
+	// GracePeriod for which the previous access key stays active after a
+	// rotation, so that its consumers can pick up the new one. Defaults to
+	// 24h.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// PreviousKeyPolicy determines whether the previous access key is
+	// deleted or only deactivated once the grace period ended. A deactivated
+	// key is deleted at the latest on the next rotation, because IAM users
+	// can have only two access keys. Defaults to Delete.
+	// +optional
+	// +kubebuilder:validation:Enum=Delete;Deactivate
+	PreviousKeyPolicy *string `json:"previousKeyPolicy,omitempty"`
+}
+
 // AccessKeyParameters define the desired state of an AWS IAM Access Key.
 type AccessKeyParameters struct {
 	// Username contains the name of the User.
@@ -42,6 +76,14 @@ type AccessKeyParameters struct {
 	// Must be either Active or Inactive.
 	// +kubebuilder:validation:Enum=Active;Inactive
 	Status string `json:"accessKeyStatus,omitempty"`
+
+	// Rotation configures the rotation of this AccessKey. When the key is
+	// rotated a new access key is created and published to the connection
+	// secret, and the previous one is deactivated or deleted after the grace
+	// period. A rotation can also be triggered by changing the value of the
+	// iam.aws.crossplane.io/rotate annotation.
+	// +optional
+	Rotation *AccessKeyRotation `json:"rotation,omitempty"`
 }
 
 // An AccessKeySpec defines the desired state of an IAM Access Key.
@@ -50,9 +92,42 @@ type AccessKeySpec struct {
 	ForProvider       AccessKeyParameters `json:"forProvider"`
 }
 
+// AccessKeyObservation keeps the state for observation of an IAM Access Key.
+type AccessKeyObservation struct {
+	// AccessKeyID is the ID of the current access key. After a rotation it
+	// is the ID of the new key until the external name is set to it.
+	AccessKeyID string `json:"accessKeyId,omitempty"`
+
+	// CreateDate is the date when the current access key was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// LastRotationTime is the time the access key was last rotated.
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// LastRotationRequest is the value of the rotate annotation that
+	// triggered the last rotation.
+	LastRotationRequest string `json:"lastRotationRequest,omitempty"`
+
+	// PreviousAccessKeyID is the ID of the access key that was replaced by
+	// the last rotation, as long as it exists.
+	PreviousAccessKeyID string `json:"previousAccessKeyId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccessKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AccountAliasParameters define the desired state of the alias of an AWS
+// account.
+type AccountAliasParameters struct {
+	// AccountAlias is the alias of the account, which is part of the URL of
+	// its sign-in page. An account has at most one alias, which must be
+	// unique across all AWS accounts.
+	// +kubebuilder:validation:MinLength=3
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9]|-[a-z0-9])*$`
+	AccountAlias string `json:"accountAlias"`
+}
+
+// An AccountAliasSpec defines the desired state of an AccountAlias.
+type AccountAliasSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountAliasParameters `json:"forProvider"`
+}
+
+// AccountAliasObservation is the observed state of an AccountAlias.
+type AccountAliasObservation struct {
+	// AccountAlias is the current alias of the account.
+	AccountAlias string `json:"accountAlias,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AccountAliasStatus represents the observed state of an AccountAlias.
+type AccountAliasStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountAliasObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountAlias manages the alias of the AWS account that its
+// ProviderConfig resolves to. Its external name is the ID of the account, and
+// only one AccountAlias may manage the alias of an account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="ALIAS",type="string",JSONPath=".spec.forProvider.accountAlias"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountAlias struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountAliasSpec   `json:"spec"`
+	Status AccountAliasStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountAliasList contains a list of AccountAliases
+type AccountAliasList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountAlias `json:"items"`
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AccountPasswordPolicyParameters define the desired state of the password
+// policy of the IAM users of an AWS account. Fields that are not set are
+// late-initialized from the current policy.
+type AccountPasswordPolicyParameters struct {
+	// AllowUsersToChangePassword allows IAM users to change their own
+	// password.
+	// +optional
+	AllowUsersToChangePassword *bool `json:"allowUsersToChangePassword,omitempty"`
+
+	// HardExpiry prevents IAM users from setting a new password after their
+	// password has expired, so that an administrator must reset it.
+	// +optional
+	HardExpiry *bool `json:"hardExpiry,omitempty"`
+
+	// MaxPasswordAge is the number of days that a password is valid. Zero
+	// means passwords never expire.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1095
+	// +optional
+	MaxPasswordAge *int32 `json:"maxPasswordAge,omitempty"`
+
+	// MinimumPasswordLength is the minimum number of characters of a
+	// password.
+	// +kubebuilder:validation:Minimum=6
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	MinimumPasswordLength *int32 `json:"minimumPasswordLength,omitempty"`
+
+	// PasswordReusePrevention is the number of previous passwords that IAM
+	// users cannot reuse. Zero means passwords may be reused.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=24
+	// +optional
+	PasswordReusePrevention *int32 `json:"passwordReusePrevention,omitempty"`
+
+	// RequireLowercaseCharacters requires passwords to contain at least one
+	// lowercase letter.
+	// +optional
+	RequireLowercaseCharacters *bool `json:"requireLowercaseCharacters,omitempty"`
+
+	// RequireNumbers requires passwords to contain at least one number.
+	// +optional
+	RequireNumbers *bool `json:"requireNumbers,omitempty"`
+
+	// RequireSymbols requires passwords to contain at least one
+	// non-alphanumeric character.
+	// +optional
+	RequireSymbols *bool `json:"requireSymbols,omitempty"`
+
+	// RequireUppercaseCharacters requires passwords to contain at least one
+	// uppercase letter.
+	// +optional
+	RequireUppercaseCharacters *bool `json:"requireUppercaseCharacters,omitempty"`
+}
+
+// An AccountPasswordPolicySpec defines the desired state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountPasswordPolicyParameters `json:"forProvider"`
+}
+
+// AccountPasswordPolicyObservation is the observed state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicyObservation struct {
+	// ExpirePasswords is true if passwords expire, i.e. maxPasswordAge is
+	// greater than zero.
+	ExpirePasswords bool `json:"expirePasswords,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AccountPasswordPolicyStatus represents the observed state of an
+// AccountPasswordPolicy.
+type AccountPasswordPolicyStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountPasswordPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPasswordPolicy manages the password policy of the IAM users of
+// the AWS account that its ProviderConfig resolves to. Its external name is
+// the ID of the account, and only one AccountPasswordPolicy may manage the
+// password policy of an account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPasswordPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPasswordPolicySpec   `json:"spec"`
+	Status AccountPasswordPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPasswordPolicyList contains a list of AccountPasswordPolicies
+type AccountPasswordPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPasswordPolicy `json:"items"`
+}
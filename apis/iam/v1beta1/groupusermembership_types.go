@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // GroupUserMembershipParameters define the desired state of an AWS GroupUserMembership.
@@ -67,6 +69,17 @@ type GroupUserMembershipObservation struct {
 	// AttachedGroupARN is the arn for the attached group. If nil, the group
 	// is not yet attached
 	AttachedGroupARN string `json:"attachedGroupArn"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An GroupUserMembershipStatus represents the observed state of an
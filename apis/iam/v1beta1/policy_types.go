@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // PolicyParameters define the desired state of an AWS IAM Policy.
@@ -33,6 +35,9 @@ type PolicyParameters struct {
 	Path *string `json:"path,omitempty"`
 
 	// The JSON policy document that is the content for the policy.
+	// Changing it creates a new policy version that is set as the default.
+	// IAM keeps at most 5 versions of a policy, so the oldest non-default
+	// versions are deleted as needed.
 	Document string `json:"document"`
 
 	// The name of the policy.
@@ -73,6 +78,17 @@ type PolicyObservation struct {
 
 	// The stable and unique string identifying the policy.
 	PolicyID string `json:"policyId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // An PolicyStatus represents the observed state of an Policy.
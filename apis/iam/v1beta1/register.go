@@ -120,6 +120,30 @@ var (
 	OpenIDConnectProviderGroupVersionKind = SchemeGroupVersion.WithKind(OpenIDConnectProviderKind)
 )
 
+// SAMLProvider type metadata.
+var (
+	SAMLProviderKind             = "SAMLProvider"
+	SAMLProviderGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: SAMLProviderKind}.String()
+	SAMLProviderKindAPIVersion   = SAMLProviderKind + "." + SchemeGroupVersion.String()
+	SAMLProviderGroupVersionKind = SchemeGroupVersion.WithKind(SAMLProviderKind)
+)
+
+// AccountAlias type metadata.
+var (
+	AccountAliasKind             = "AccountAlias"
+	AccountAliasGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountAliasKind}.String()
+	AccountAliasKindAPIVersion   = AccountAliasKind + "." + SchemeGroupVersion.String()
+	AccountAliasGroupVersionKind = SchemeGroupVersion.WithKind(AccountAliasKind)
+)
+
+// AccountPasswordPolicy type metadata.
+var (
+	AccountPasswordPolicyKind             = "AccountPasswordPolicy"
+	AccountPasswordPolicyGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: AccountPasswordPolicyKind}.String()
+	AccountPasswordPolicyKindAPIVersion   = AccountPasswordPolicyKind + "." + SchemeGroupVersion.String()
+	AccountPasswordPolicyGroupVersionKind = SchemeGroupVersion.WithKind(AccountPasswordPolicyKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&Role{}, &RoleList{})
 	SchemeBuilder.Register(&RolePolicyAttachment{}, &RolePolicyAttachmentList{})
@@ -131,4 +155,7 @@ func init() {
 	SchemeBuilder.Register(&GroupPolicyAttachment{}, &GroupPolicyAttachmentList{})
 	SchemeBuilder.Register(&AccessKey{}, &AccessKeyList{})
 	SchemeBuilder.Register(&OpenIDConnectProvider{}, &OpenIDConnectProviderList{})
+	SchemeBuilder.Register(&SAMLProvider{}, &SAMLProviderList{})
+	SchemeBuilder.Register(&AccountAlias{}, &AccountAliasList{})
+	SchemeBuilder.Register(&AccountPasswordPolicy{}, &AccountPasswordPolicyList{})
 }
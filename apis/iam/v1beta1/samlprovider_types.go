@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// SAMLProviderParameters defines the desired state of SAMLProvider
+type SAMLProviderParameters struct {
+	// The name of the provider to create. The name is part of the ARN of the
+	// provider, which is used as its external name.
+	// +immutable
+	Name string `json:"name"`
+
+	// An XML document generated by an identity provider (IdP) that supports
+	// SAML 2.0. The document includes the issuer's name, expiration information,
+	// and keys that can be used to validate the SAML authentication response
+	// (assertions) that are received from the IdP. You must generate the metadata
+	// document using the identity management software that is used as your
+	// organization's IdP. Exactly one of SAMLMetadataDocument and
+	// SAMLMetadataDocumentFrom must be specified.
+	// +optional
+	SAMLMetadataDocument *string `json:"samlMetadataDocument,omitempty"`
+
+	// SAMLMetadataDocumentFrom reads the SAML metadata document from a
+	// ConfigMap or Secret.
+	// +optional
+	SAMLMetadataDocumentFrom *SAMLMetadataDocumentSource `json:"samlMetadataDocumentFrom,omitempty"`
+
+	// Tags. For more information about tagging, see Tagging IAM identity
+	// providers (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_tags_idps.html)
+	// in the IAM User Guide.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// SAMLMetadataDocumentSource selects a key of a ConfigMap or Secret. Exactly
+// one of its fields must be specified.
+type SAMLMetadataDocumentSource struct {
+	// ConfigMapKeyRef selects a key of a ConfigMap.
+	// +optional
+	ConfigMapKeyRef *ConfigMapKeySelector `json:"configMapKeyRef,omitempty"`
+
+	// SecretKeyRef selects a key of a Secret.
+	// +optional
+	SecretKeyRef *xpv1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+}
+
+// A ConfigMapKeySelector is a reference to a ConfigMap key in an arbitrary
+// namespace.
+type ConfigMapKeySelector struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+
+	// The key to select.
+	Key string `json:"key"`
+}
+
+// SAMLProviderSpec defines the desired state of SAMLProvider
+type SAMLProviderSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       SAMLProviderParameters `json:"forProvider"`
+}
+
+// SAMLProviderObservation defines the observed state of SAMLProvider
+type SAMLProviderObservation struct {
+	// The date and time when the SAML provider was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
+
+	// The expiration date and time for the SAML provider.
+	ValidUntil *metav1.Time `json:"validUntil,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// SAMLProviderStatus defines the observed state of SAMLProvider.
+type SAMLProviderStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          SAMLProviderObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProvider is the Schema for the SAMLProviders API
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="VALID-UNTIL",type="string",JSONPath=".status.atProvider.validUntil"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type SAMLProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SAMLProviderSpec   `json:"spec"`
+	Status            SAMLProviderStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SAMLProviderList contains a list of SAMLProviders
+type SAMLProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SAMLProvider `json:"items"`
+}
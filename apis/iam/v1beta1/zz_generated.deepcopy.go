@@ -22,7 +22,9 @@ limitations under the License.
 package v1beta1
 
 import (
-	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	commonv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -85,17 +87,50 @@ func (in *AccessKeyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyObservation) DeepCopyInto(out *AccessKeyObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyObservation.
+func (in *AccessKeyObservation) DeepCopy() *AccessKeyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeyParameters) DeepCopyInto(out *AccessKeyParameters) {
 	*out = *in
 	if in.UsernameRef != nil {
 		in, out := &in.UsernameRef, &out.UsernameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.UsernameSelector != nil {
 		in, out := &in.UsernameSelector, &out.UsernameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(AccessKeyRotation)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -110,6 +145,36 @@ func (in *AccessKeyParameters) DeepCopy() *AccessKeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessKeyRotation) DeepCopyInto(out *AccessKeyRotation) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.PreviousKeyPolicy != nil {
+		in, out := &in.PreviousKeyPolicy, &out.PreviousKeyPolicy
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyRotation.
+func (in *AccessKeyRotation) DeepCopy() *AccessKeyRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessKeyRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessKeySpec) DeepCopyInto(out *AccessKeySpec) {
 	*out = *in
@@ -131,6 +196,7 @@ func (in *AccessKeySpec) DeepCopy() *AccessKeySpec {
 func (in *AccessKeyStatus) DeepCopyInto(out *AccessKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessKeyStatus.
@@ -143,6 +209,322 @@ func (in *AccessKeyStatus) DeepCopy() *AccessKeyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAlias) DeepCopyInto(out *AccountAlias) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAlias.
+func (in *AccountAlias) DeepCopy() *AccountAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAlias) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasList) DeepCopyInto(out *AccountAliasList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasList.
+func (in *AccountAliasList) DeepCopy() *AccountAliasList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountAliasList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasObservation) DeepCopyInto(out *AccountAliasObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasObservation.
+func (in *AccountAliasObservation) DeepCopy() *AccountAliasObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasParameters) DeepCopyInto(out *AccountAliasParameters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasParameters.
+func (in *AccountAliasParameters) DeepCopy() *AccountAliasParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasSpec) DeepCopyInto(out *AccountAliasSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ForProvider = in.ForProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasSpec.
+func (in *AccountAliasSpec) DeepCopy() *AccountAliasSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountAliasStatus) DeepCopyInto(out *AccountAliasStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountAliasStatus.
+func (in *AccountAliasStatus) DeepCopy() *AccountAliasStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountAliasStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicy) DeepCopyInto(out *AccountPasswordPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicy.
+func (in *AccountPasswordPolicy) DeepCopy() *AccountPasswordPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPasswordPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyList) DeepCopyInto(out *AccountPasswordPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccountPasswordPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyList.
+func (in *AccountPasswordPolicyList) DeepCopy() *AccountPasswordPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccountPasswordPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyObservation) DeepCopyInto(out *AccountPasswordPolicyObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyObservation.
+func (in *AccountPasswordPolicyObservation) DeepCopy() *AccountPasswordPolicyObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyParameters) DeepCopyInto(out *AccountPasswordPolicyParameters) {
+	*out = *in
+	if in.AllowUsersToChangePassword != nil {
+		in, out := &in.AllowUsersToChangePassword, &out.AllowUsersToChangePassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HardExpiry != nil {
+		in, out := &in.HardExpiry, &out.HardExpiry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxPasswordAge != nil {
+		in, out := &in.MaxPasswordAge, &out.MaxPasswordAge
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinimumPasswordLength != nil {
+		in, out := &in.MinimumPasswordLength, &out.MinimumPasswordLength
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PasswordReusePrevention != nil {
+		in, out := &in.PasswordReusePrevention, &out.PasswordReusePrevention
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RequireLowercaseCharacters != nil {
+		in, out := &in.RequireLowercaseCharacters, &out.RequireLowercaseCharacters
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireNumbers != nil {
+		in, out := &in.RequireNumbers, &out.RequireNumbers
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireSymbols != nil {
+		in, out := &in.RequireSymbols, &out.RequireSymbols
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireUppercaseCharacters != nil {
+		in, out := &in.RequireUppercaseCharacters, &out.RequireUppercaseCharacters
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyParameters.
+func (in *AccountPasswordPolicyParameters) DeepCopy() *AccountPasswordPolicyParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicySpec) DeepCopyInto(out *AccountPasswordPolicySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicySpec.
+func (in *AccountPasswordPolicySpec) DeepCopy() *AccountPasswordPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccountPasswordPolicyStatus) DeepCopyInto(out *AccountPasswordPolicyStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccountPasswordPolicyStatus.
+func (in *AccountPasswordPolicyStatus) DeepCopy() *AccountPasswordPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AccountPasswordPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Group) DeepCopyInto(out *Group) {
 	*out = *in
@@ -205,6 +587,11 @@ func (in *GroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupObservation) DeepCopyInto(out *GroupObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupObservation.
@@ -299,6 +686,11 @@ func (in *GroupPolicyAttachmentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupPolicyAttachmentObservation) DeepCopyInto(out *GroupPolicyAttachmentObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentObservation.
@@ -316,22 +708,22 @@ func (in *GroupPolicyAttachmentParameters) DeepCopyInto(out *GroupPolicyAttachme
 	*out = *in
 	if in.PolicyARNRef != nil {
 		in, out := &in.PolicyARNRef, &out.PolicyARNRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.PolicyARNSelector != nil {
 		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.GroupNameRef != nil {
 		in, out := &in.GroupNameRef, &out.GroupNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.GroupNameSelector != nil {
 		in, out := &in.GroupNameSelector, &out.GroupNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -367,7 +759,7 @@ func (in *GroupPolicyAttachmentSpec) DeepCopy() *GroupPolicyAttachmentSpec {
 func (in *GroupPolicyAttachmentStatus) DeepCopyInto(out *GroupPolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupPolicyAttachmentStatus.
@@ -401,7 +793,7 @@ func (in *GroupSpec) DeepCopy() *GroupSpec {
 func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
@@ -476,6 +868,11 @@ func (in *GroupUserMembershipList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GroupUserMembershipObservation) DeepCopyInto(out *GroupUserMembershipObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipObservation.
@@ -493,22 +890,22 @@ func (in *GroupUserMembershipParameters) DeepCopyInto(out *GroupUserMembershipPa
 	*out = *in
 	if in.GroupNameRef != nil {
 		in, out := &in.GroupNameRef, &out.GroupNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.GroupNameSelector != nil {
 		in, out := &in.GroupNameSelector, &out.GroupNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.UserNameRef != nil {
 		in, out := &in.UserNameRef, &out.UserNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.UserNameSelector != nil {
 		in, out := &in.UserNameSelector, &out.UserNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -544,7 +941,7 @@ func (in *GroupUserMembershipSpec) DeepCopy() *GroupUserMembershipSpec {
 func (in *GroupUserMembershipStatus) DeepCopyInto(out *GroupUserMembershipStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupUserMembershipStatus.
@@ -623,6 +1020,11 @@ func (in *OpenIDConnectProviderObservation) DeepCopyInto(out *OpenIDConnectProvi
 		in, out := &in.CreateDate, &out.CreateDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenIDConnectProviderObservation.
@@ -761,6 +1163,11 @@ func (in *PolicyList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyObservation) DeepCopyInto(out *PolicyObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyObservation.
@@ -824,7 +1231,7 @@ func (in *PolicySpec) DeepCopy() *PolicySpec {
 func (in *PolicyStatus) DeepCopyInto(out *PolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyStatus.
@@ -867,6 +1274,11 @@ func (in *Role) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RoleExternalStatus) DeepCopyInto(out *RoleExternalStatus) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleExternalStatus.
@@ -981,6 +1393,11 @@ func (in *RolePolicyAttachment) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolePolicyAttachmentExternalStatus) DeepCopyInto(out *RolePolicyAttachmentExternalStatus) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentExternalStatus.
@@ -1030,22 +1447,22 @@ func (in *RolePolicyAttachmentParameters) DeepCopyInto(out *RolePolicyAttachment
 	*out = *in
 	if in.PolicyARNRef != nil {
 		in, out := &in.PolicyARNRef, &out.PolicyARNRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.PolicyARNSelector != nil {
 		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.RoleNameRef != nil {
 		in, out := &in.RoleNameRef, &out.RoleNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.RoleNameSelector != nil {
 		in, out := &in.RoleNameSelector, &out.RoleNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1081,7 +1498,7 @@ func (in *RolePolicyAttachmentSpec) DeepCopy() *RolePolicyAttachmentSpec {
 func (in *RolePolicyAttachmentStatus) DeepCopyInto(out *RolePolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolePolicyAttachmentStatus.
@@ -1115,7 +1532,7 @@ func (in *RoleSpec) DeepCopy() *RoleSpec {
 func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
@@ -1128,6 +1545,182 @@ func (in *RoleStatus) DeepCopy() *RoleStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLMetadataDocumentSource) DeepCopyInto(out *SAMLMetadataDocumentSource) {
+	*out = *in
+	if in.ConfigMapKeyRef != nil {
+		in, out := &in.ConfigMapKeyRef, &out.ConfigMapKeyRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
+	}
+	if in.SecretKeyRef != nil {
+		in, out := &in.SecretKeyRef, &out.SecretKeyRef
+		*out = new(commonv1.SecretKeySelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLMetadataDocumentSource.
+func (in *SAMLMetadataDocumentSource) DeepCopy() *SAMLMetadataDocumentSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLMetadataDocumentSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProvider) DeepCopyInto(out *SAMLProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProvider.
+func (in *SAMLProvider) DeepCopy() *SAMLProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderList) DeepCopyInto(out *SAMLProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SAMLProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderList.
+func (in *SAMLProviderList) DeepCopy() *SAMLProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SAMLProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderObservation) DeepCopyInto(out *SAMLProviderObservation) {
+	*out = *in
+	if in.CreateDate != nil {
+		in, out := &in.CreateDate, &out.CreateDate
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidUntil != nil {
+		in, out := &in.ValidUntil, &out.ValidUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderObservation.
+func (in *SAMLProviderObservation) DeepCopy() *SAMLProviderObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderParameters) DeepCopyInto(out *SAMLProviderParameters) {
+	*out = *in
+	if in.SAMLMetadataDocument != nil {
+		in, out := &in.SAMLMetadataDocument, &out.SAMLMetadataDocument
+		*out = new(string)
+		**out = **in
+	}
+	if in.SAMLMetadataDocumentFrom != nil {
+		in, out := &in.SAMLMetadataDocumentFrom, &out.SAMLMetadataDocumentFrom
+		*out = new(SAMLMetadataDocumentSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderParameters.
+func (in *SAMLProviderParameters) DeepCopy() *SAMLProviderParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderSpec) DeepCopyInto(out *SAMLProviderSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderSpec.
+func (in *SAMLProviderSpec) DeepCopy() *SAMLProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SAMLProviderStatus) DeepCopyInto(out *SAMLProviderStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SAMLProviderStatus.
+func (in *SAMLProviderStatus) DeepCopy() *SAMLProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SAMLProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Tag) DeepCopyInto(out *Tag) {
 	*out = *in
@@ -1205,6 +1798,11 @@ func (in *UserList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserObservation) DeepCopyInto(out *UserObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserObservation.
@@ -1309,6 +1907,11 @@ func (in *UserPolicyAttachmentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserPolicyAttachmentObservation) DeepCopyInto(out *UserPolicyAttachmentObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicyAttachmentObservation.
@@ -1326,22 +1929,22 @@ func (in *UserPolicyAttachmentParameters) DeepCopyInto(out *UserPolicyAttachment
 	*out = *in
 	if in.PolicyARNRef != nil {
 		in, out := &in.PolicyARNRef, &out.PolicyARNRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.PolicyARNSelector != nil {
 		in, out := &in.PolicyARNSelector, &out.PolicyARNSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.UserNameRef != nil {
 		in, out := &in.UserNameRef, &out.UserNameRef
-		*out = new(v1.Reference)
+		*out = new(commonv1.Reference)
 		**out = **in
 	}
 	if in.UserNameSelector != nil {
 		in, out := &in.UserNameSelector, &out.UserNameSelector
-		*out = new(v1.Selector)
+		*out = new(commonv1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -1377,7 +1980,7 @@ func (in *UserPolicyAttachmentSpec) DeepCopy() *UserPolicyAttachmentSpec {
 func (in *UserPolicyAttachmentStatus) DeepCopyInto(out *UserPolicyAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserPolicyAttachmentStatus.
@@ -1411,7 +2014,7 @@ func (in *UserSpec) DeepCopy() *UserSpec {
 func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// LabelKeyImport is the label of the managed resources an Import created. Its
+// value is the name of the Import.
+const LabelKeyImport = "importer.aws.crossplane.io/import"
+
+// ReasonUnsupported indicates that the AWS resources an Import selects can't
+// be imported.
+const ReasonUnsupported xpv1.ConditionReason = "UnsupportedResourceType"
+
+// Unsupported returns a condition that indicates that the AWS resources an
+// Import selects can't be imported.
+func Unsupported(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUnsupported,
+		Message:            msg,
+	}
+}
+
+// ImportParameters define the desired state of an Import.
+type ImportParameters struct {
+	// Region of the AWS resources to import.
+	// +immutable
+	Region string `json:"region"`
+
+	// Service of the AWS resources to import, i.e. the API group of their
+	// managed resources without its aws.crossplane.io suffix, e.g.
+	// cognitoidentityprovider.
+	Service string `json:"service"`
+
+	// ResourceType of the AWS resources to import, i.e. the kind of their
+	// managed resources, e.g. UserPool. Only the resources of the kinds whose
+	// resources can be enumerated can be imported, i.e. IdentityPools of the
+	// cognitoidentity service and UserPools of the cognitoidentityprovider
+	// service. The Import is unavailable, with reason UnsupportedResourceType,
+	// if any other kind is specified.
+	ResourceType string `json:"resourceType"`
+
+	// NameFilter is a regular expression the names of the AWS resources to
+	// import must match. All resources are imported if omitted.
+	// +optional
+	NameFilter *string `json:"nameFilter,omitempty"`
+
+	// TagFilter are the tags the AWS resources to import must have.
+	// +optional
+	TagFilter map[string]string `json:"tagFilter,omitempty"`
+}
+
+// An ImportSpec defines the desired state of an Import.
+type ImportSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ImportParameters `json:"forProvider"`
+}
+
+// An ImportedResource is an AWS resource matched by an Import.
+type ImportedResource struct {
+	// ID of the AWS resource.
+	ID string `json:"id"`
+
+	// Name of the managed resource that represents the AWS resource.
+	Name string `json:"name"`
+}
+
+// ImportObservation is the representation of the current state that is observed.
+type ImportObservation struct {
+	// Resources are the AWS resources the Import matched, and the managed
+	// resources that represent them.
+	// +optional
+	Resources []ImportedResource `json:"resources,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An ImportStatus represents the observed state of an Import.
+type ImportStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ImportObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Import enumerates existing AWS resources of a type that pass its filters
+// and creates managed resources that represent them. The managed resources
+// are created in observe-only mode: the provider observes the AWS resources
+// but never changes or deletes them. Managed resources are not deleted when
+// the Import is.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.service"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.resourceType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Import struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ImportSpec   `json:"spec"`
+	Status ImportStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImportList contains a list of Imports.
+type ImportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Import `json:"items"`
+}
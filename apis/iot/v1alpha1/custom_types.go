@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+
 // CustomThingParameters includes the custom fields of Thing.
 type CustomThingParameters struct {
 }
@@ -23,3 +25,8 @@ type CustomThingParameters struct {
 // CustomPolicyParameters includes the custom fields of Policy.
 type CustomPolicyParameters struct {
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
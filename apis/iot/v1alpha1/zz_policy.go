@@ -52,6 +52,8 @@ type PolicySpec struct {
 
 // PolicyObservation defines the observed state of Policy
 type PolicyObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The policy ARN.
 	PolicyARN *string `json:"policyARN,omitempty"`
 	// The policy version ID.
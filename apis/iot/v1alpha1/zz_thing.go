@@ -49,6 +49,8 @@ type ThingSpec struct {
 
 // ThingObservation defines the observed state of Thing
 type ThingObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The ARN of the new thing.
 	ThingARN *string `json:"thingARN,omitempty"`
 	// The thing ID.
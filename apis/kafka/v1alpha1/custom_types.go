@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomConfigurationParameters contains the additional fields for ConfigurationParameters.
 type CustomConfigurationParameters struct {
@@ -31,6 +35,11 @@ type CustomClusterParameters struct {
 
 	// Represents the configuration that you want MSK to use for the cluster.
 	CustomConfigurationInfo *CustomConfigurationInfo `json:"configurationInfo,omitempty"`
+
+	// ReadinessProbe, if set, keeps the Cluster from becoming ready until its
+	// first bootstrap broker accepts TCP connections from the provider.
+	// +optional
+	ReadinessProbe *awsv1beta1.ReadinessProbe `json:"readinessProbe,omitempty"`
 }
 
 // CustomConfigurationInfo contains the additional fields for ConfigurationInfo.
@@ -90,3 +99,8 @@ type CustomBrokerNodeGroupInfo struct {
 	// Contains information about storage volumes attached to MSK broker nodes.
 	StorageInfo *StorageInfo `json:"storageInfo,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -59,10 +59,12 @@ type ClusterSpec struct {
 
 // ClusterObservation defines the observed state of Cluster
 type ClusterObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon Resource Name (ARN) of the cluster.
 	ClusterARN *string `json:"clusterARN,omitempty"`
 	// The name of the MSK cluster.
-	ClusterName *string `json:"clusterName,omitempty"`
+	ClusterName   *string        `json:"clusterName,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The state of the cluster. The possible states are ACTIVE, CREATING, DELETING,
 	// FAILED, HEALING, MAINTENANCE, REBOOTING_BROKER, and UPDATING.
 	State *string `json:"state,omitempty"`
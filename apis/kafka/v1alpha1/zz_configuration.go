@@ -45,9 +45,11 @@ type ConfigurationSpec struct {
 // ConfigurationObservation defines the observed state of Configuration
 type ConfigurationObservation struct {
 	// The Amazon Resource Name (ARN) of the configuration.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The time when the configuration was created.
-	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+	CreationTime  *metav1.Time   `json:"creationTime,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Latest revision of the configuration.
 	LatestRevision *ConfigurationRevision `json:"latestRevision,omitempty"`
 	// The name of the configuration. Configuration names are strings that match
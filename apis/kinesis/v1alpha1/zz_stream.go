@@ -45,6 +45,7 @@ type StreamSpec struct {
 
 // StreamObservation defines the observed state of Stream
 type StreamObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The server-side encryption type used on the stream. This parameter can be
 	// one of the following values:
 	//
@@ -71,7 +72,8 @@ type StreamObservation struct {
 	//    * Alias name example: alias/MyAliasName
 	//
 	//    * Master key owned by Kinesis Data Streams: alias/aws/kinesis
-	KeyID *string `json:"keyID,omitempty"`
+	KeyID         *string        `json:"keyID,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The current retention period, in hours. Minimum value of 24. Maximum value
 	// of 168.
 	RetentionPeriodHours *int64 `json:"retentionPeriodHours,omitempty"`
@@ -230,7 +230,8 @@ type KeyObservation struct {
 	// The Amazon Resource Name (ARN) of the KMS key. For examples, see Key Management
 	// Service (KMS) (https://docs.aws.amazon.com/general/latest/gr/aws-arns-and-namespaces.html#arn-syntax-kms)
 	// in the Example ARNs section of the Amazon Web Services General Reference.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The cluster ID of the CloudHSM cluster that contains the key material for
 	// the KMS key. When you create a KMS key in a custom key store (https://docs.aws.amazon.com/kms/latest/developerguide/custom-key-store-overview.html),
 	// KMS creates the key material for the KMS key in the associated CloudHSM cluster.
@@ -269,7 +270,8 @@ type KeyObservation struct {
 	// For more information about how key state affects the use of a KMS key, see
 	// Key state: Effect on your KMS key (https://docs.aws.amazon.com/kms/latest/developerguide/key-state.html)
 	// in the Key Management Service Developer Guide.
-	KeyState *string `json:"keyState,omitempty"`
+	KeyState      *string        `json:"keyState,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// Lists the primary and replica keys in same multi-Region key. This field is
 	// present only when the value of the MultiRegion field is True.
 	//
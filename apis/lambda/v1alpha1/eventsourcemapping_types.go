@@ -0,0 +1,260 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// OnFailureDestination is where records that could not be processed are
+// sent.
+type OnFailureDestination struct {
+	// ARN of the SQS queue or SNS topic.
+	// +optional
+	ARN *string `json:"arn,omitempty"`
+
+	// ARNRef references an SQS Queue to retrieve its ARN.
+	// +optional
+	ARNRef *xpv1.Reference `json:"arnRef,omitempty"`
+
+	// ARNSelector selects a reference to an SQS Queue to retrieve its ARN.
+	// +optional
+	ARNSelector *xpv1.Selector `json:"arnSelector,omitempty"`
+}
+
+// EventSourceMappingParameters define the desired state of a Lambda event
+// source mapping.
+type EventSourceMappingParameters struct {
+	// Region is which region the event source mapping will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// FunctionName is the name, ARN or qualified ARN of the function that
+	// processes the events.
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// EventSourceARN is the ARN of the event source. It can be set directly
+	// or resolved from exactly one of the SQS queue, Kinesis stream or
+	// DynamoDB table references and selectors.
+	// +immutable
+	// +optional
+	EventSourceARN *string `json:"eventSourceArn,omitempty"`
+
+	// SQSQueueRef references an SQS Queue to retrieve its ARN.
+	// +optional
+	SQSQueueRef *xpv1.Reference `json:"sqsQueueRef,omitempty"`
+
+	// SQSQueueSelector selects a reference to an SQS Queue to retrieve its
+	// ARN.
+	// +optional
+	SQSQueueSelector *xpv1.Selector `json:"sqsQueueSelector,omitempty"`
+
+	// KinesisStreamRef references a Kinesis Stream to retrieve its ARN.
+	// +optional
+	KinesisStreamRef *xpv1.Reference `json:"kinesisStreamRef,omitempty"`
+
+	// KinesisStreamSelector selects a reference to a Kinesis Stream to
+	// retrieve its ARN.
+	// +optional
+	KinesisStreamSelector *xpv1.Selector `json:"kinesisStreamSelector,omitempty"`
+
+	// DynamoDBTableRef references a DynamoDB Table to retrieve the ARN of
+	// its latest stream.
+	// +optional
+	DynamoDBTableRef *xpv1.Reference `json:"dynamoDBTableRef,omitempty"`
+
+	// DynamoDBTableSelector selects a reference to a DynamoDB Table to
+	// retrieve the ARN of its latest stream.
+	// +optional
+	DynamoDBTableSelector *xpv1.Selector `json:"dynamoDBTableSelector,omitempty"`
+
+	// Enabled indicates whether the event source mapping is active.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// BatchSize is the maximum number of records in each batch sent to the
+	// function.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// MaximumBatchingWindowInSeconds is the maximum amount of time to
+	// gather records before invoking the function.
+	// +kubebuilder:validation:Maximum=300
+	// +optional
+	MaximumBatchingWindowInSeconds *int64 `json:"maximumBatchingWindowInSeconds,omitempty"`
+
+	// StartingPosition is the position in a stream from which to start
+	// reading, one of TRIM_HORIZON, LATEST or AT_TIMESTAMP. It is required
+	// for Kinesis and DynamoDB streams.
+	// +kubebuilder:validation:Enum=TRIM_HORIZON;LATEST;AT_TIMESTAMP
+	// +immutable
+	// +optional
+	StartingPosition *string `json:"startingPosition,omitempty"`
+
+	// StartingPositionTimestamp is the time from which to start reading
+	// if StartingPosition is AT_TIMESTAMP.
+	// +immutable
+	// +optional
+	StartingPositionTimestamp *metav1.Time `json:"startingPositionTimestamp,omitempty"`
+
+	// ParallelizationFactor is the number of batches to process from each
+	// shard of a stream concurrently.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	ParallelizationFactor *int64 `json:"parallelizationFactor,omitempty"`
+
+	// BisectBatchOnFunctionError splits a batch of stream records in two
+	// and retries them if the function returns an error.
+	// +optional
+	BisectBatchOnFunctionError *bool `json:"bisectBatchOnFunctionError,omitempty"`
+
+	// MaximumRecordAgeInSeconds discards stream records older than the
+	// given age. -1 means records are never discarded.
+	// +optional
+	MaximumRecordAgeInSeconds *int64 `json:"maximumRecordAgeInSeconds,omitempty"`
+
+	// MaximumRetryAttempts discards stream records after the given number
+	// of retries. -1 means records are retried until they expire.
+	// +optional
+	MaximumRetryAttempts *int64 `json:"maximumRetryAttempts,omitempty"`
+
+	// TumblingWindowInSeconds is the duration of the processing windows of
+	// stream records.
+	// +kubebuilder:validation:Maximum=900
+	// +optional
+	TumblingWindowInSeconds *int64 `json:"tumblingWindowInSeconds,omitempty"`
+
+	// FunctionResponseTypes is a list of response types of the function,
+	// e.g. ReportBatchItemFailures.
+	// +optional
+	FunctionResponseTypes []string `json:"functionResponseTypes,omitempty"`
+
+	// OnFailure is where discarded stream records are sent.
+	// +optional
+	OnFailure *OnFailureDestination `json:"onFailure,omitempty"`
+}
+
+// EventSourceMappingObservation defines the observed state of a Lambda
+// event source mapping.
+type EventSourceMappingObservation struct {
+	// UUID of the event source mapping.
+	UUID string `json:"uuid,omitempty"`
+
+	// FunctionARN is the ARN of the function that processes the events.
+	FunctionARN string `json:"functionArn,omitempty"`
+
+	// State of the event source mapping, e.g. Creating, Enabled or
+	// Disabled.
+	State string `json:"state,omitempty"`
+
+	// StateTransitionReason indicates whether the state was last changed
+	// by a user or by Lambda.
+	StateTransitionReason string `json:"stateTransitionReason,omitempty"`
+
+	// LastProcessingResult is the result of the last invocation of the
+	// function.
+	LastProcessingResult string `json:"lastProcessingResult,omitempty"`
+
+	// LastModified is the time the event source mapping was last updated
+	// or its state last changed.
+	LastModified *metav1.Time `json:"lastModified,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An EventSourceMappingSpec defines the desired state of an
+// EventSourceMapping.
+type EventSourceMappingSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EventSourceMappingParameters `json:"forProvider"`
+}
+
+// An EventSourceMappingStatus represents the observed state of an
+// EventSourceMapping.
+type EventSourceMappingStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EventSourceMappingObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EventSourceMapping is a managed resource that represents a Lambda event
+// source mapping, which invokes a function with records read from an SQS
+// queue, a Kinesis stream or a DynamoDB stream. Its external name is the
+// UUID of the event source mapping.
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EventSourceMapping struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventSourceMappingSpec   `json:"spec"`
+	Status EventSourceMappingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EventSourceMappingList contains a list of EventSourceMappings
+type EventSourceMappingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EventSourceMapping `json:"items"`
+}
+
+// EventSourceMapping type metadata.
+var (
+	EventSourceMappingKind             = "EventSourceMapping"
+	EventSourceMappingGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: EventSourceMappingKind}.String()
+	EventSourceMappingKindAPIVersion   = EventSourceMappingKind + "." + GroupVersion.String()
+	EventSourceMappingGroupVersionKind = GroupVersion.WithKind(EventSourceMappingKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&EventSourceMapping{}, &EventSourceMappingList{})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PermissionParameters define the desired state of a statement of the
+// resource-based policy of a Lambda function.
+type PermissionParameters struct {
+	// Region is which region the function is in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// FunctionName is the name, ARN or partial ARN of the function.
+	// +immutable
+	// +optional
+	FunctionName *string `json:"functionName,omitempty"`
+
+	// FunctionNameRef references a Function to retrieve its name.
+	// +optional
+	FunctionNameRef *xpv1.Reference `json:"functionNameRef,omitempty"`
+
+	// FunctionNameSelector selects a reference to a Function to retrieve its
+	// name.
+	// +optional
+	FunctionNameSelector *xpv1.Selector `json:"functionNameSelector,omitempty"`
+
+	// Qualifier is the version or alias of the function the permission
+	// applies to.
+	// +immutable
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// Action the principal can use on the function, e.g.
+	// lambda:InvokeFunction.
+	// +kubebuilder:default="lambda:InvokeFunction"
+	// +optional
+	Action string `json:"action,omitempty"`
+
+	// Principal is the AWS service or account that invokes the function,
+	// e.g. s3.amazonaws.com or an account ID.
+	Principal string `json:"principal"`
+
+	// SourceARN restricts the permission to the AWS resource with the given
+	// ARN, e.g. an S3 bucket or an SNS topic.
+	// +optional
+	SourceARN *string `json:"sourceArn,omitempty"`
+
+	// SourceAccount restricts the permission to resources owned by the
+	// given account.
+	// +optional
+	SourceAccount *string `json:"sourceAccount,omitempty"`
+
+	// EventSourceToken is the token that must be supplied by the invoker
+	// of an Alexa Smart Home function.
+	// +optional
+	EventSourceToken *string `json:"eventSourceToken,omitempty"`
+}
+
+// A PermissionSpec defines the desired state of a Permission.
+type PermissionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PermissionParameters `json:"forProvider"`
+}
+
+// PermissionObservation keeps the state for the external resource.
+type PermissionObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A PermissionStatus represents the observed state of a Permission.
+type PermissionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PermissionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Permission is a managed resource that represents a statement of the
+// resource-based policy of a Lambda function, which grants an AWS service or
+// account permission to use the function. Its external name is the ID of the
+// statement.
+// +kubebuilder:printcolumn:name="FUNCTION",type="string",JSONPath=".spec.forProvider.functionName"
+// +kubebuilder:printcolumn:name="PRINCIPAL",type="string",JSONPath=".spec.forProvider.principal"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Permission struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PermissionSpec   `json:"spec"`
+	Status PermissionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PermissionList contains a list of Permissions
+type PermissionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Permission `json:"items"`
+}
+
+// Permission type metadata.
+var (
+	PermissionKind             = "Permission"
+	PermissionGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: PermissionKind}.String()
+	PermissionKindAPIVersion   = PermissionKind + "." + GroupVersion.String()
+	PermissionGroupVersionKind = GroupVersion.WithKind(PermissionKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Permission{}, &PermissionList{})
+}
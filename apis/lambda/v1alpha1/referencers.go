@@ -21,10 +21,15 @@ import (
 
 	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
 
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	ec2 "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
 	kms "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reference"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -109,3 +114,106 @@ func (mg *Function) ResolveReferences(ctx context.Context, c client.Reader) erro
 
 	return nil
 }
+
+// ResolveReferences of this EventSourceMapping.
+func (mg *EventSourceMapping) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+	p := &mg.Spec.ForProvider
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(p.FunctionName),
+		Reference:    p.FunctionNameRef,
+		Selector:     p.FunctionNameSelector,
+		To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	p.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	p.FunctionNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.eventSourceArn from whichever kind of event
+	// source is referenced. Once resolved, the remaining requests are no-ops.
+	sources := []struct {
+		path     string
+		ref      **xpv1.Reference
+		selector *xpv1.Selector
+		to       reference.To
+		extract  reference.ExtractValueFn
+	}{
+		{
+			path:     "spec.forProvider.sqsQueueRef",
+			ref:      &p.SQSQueueRef,
+			selector: p.SQSQueueSelector,
+			to:       reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			extract:  sqsv1beta1.QueueARN(),
+		},
+		{
+			path:     "spec.forProvider.kinesisStreamRef",
+			ref:      &p.KinesisStreamRef,
+			selector: p.KinesisStreamSelector,
+			to:       reference.To{Managed: &kinesisv1alpha1.Stream{}, List: &kinesisv1alpha1.StreamList{}},
+			extract:  kinesisv1alpha1.StreamARN(),
+		},
+		{
+			path:     "spec.forProvider.dynamoDBTableRef",
+			ref:      &p.DynamoDBTableRef,
+			selector: p.DynamoDBTableSelector,
+			to:       reference.To{Managed: &dynamodbv1alpha1.Table{}, List: &dynamodbv1alpha1.TableList{}},
+			extract:  dynamodbv1alpha1.TableStreamARN(),
+		},
+	}
+	for _, s := range sources {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.EventSourceARN),
+			Reference:    *s.ref,
+			Selector:     s.selector,
+			To:           s.to,
+			Extract:      s.extract,
+		})
+		if err != nil {
+			return errors.Wrap(err, s.path)
+		}
+		p.EventSourceARN = reference.ToPtrValue(rsp.ResolvedValue)
+		*s.ref = rsp.ResolvedReference
+	}
+
+	if p.OnFailure != nil {
+		// Resolve spec.forProvider.onFailure.arn
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(p.OnFailure.ARN),
+			Reference:    p.OnFailure.ARNRef,
+			Selector:     p.OnFailure.ARNSelector,
+			To:           reference.To{Managed: &sqsv1beta1.Queue{}, List: &sqsv1beta1.QueueList{}},
+			Extract:      sqsv1beta1.QueueARN(),
+		})
+		if err != nil {
+			return errors.Wrap(err, "spec.forProvider.onFailure.arn")
+		}
+		p.OnFailure.ARN = reference.ToPtrValue(rsp.ResolvedValue)
+		p.OnFailure.ARNRef = rsp.ResolvedReference
+	}
+	return nil
+}
+
+// ResolveReferences of this Permission.
+func (mg *Permission) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.functionName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.FunctionName),
+		Reference:    mg.Spec.ForProvider.FunctionNameRef,
+		Selector:     mg.Spec.ForProvider.FunctionNameSelector,
+		To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.functionName")
+	}
+	mg.Spec.ForProvider.FunctionName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.FunctionNameRef = rsp.ResolvedReference
+	return nil
+}
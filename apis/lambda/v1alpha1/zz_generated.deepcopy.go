@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -384,6 +385,247 @@ func (in *EnvironmentResponse) DeepCopy() *EnvironmentResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMapping) DeepCopyInto(out *EventSourceMapping) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMapping.
+func (in *EventSourceMapping) DeepCopy() *EventSourceMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSourceMapping) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingList) DeepCopyInto(out *EventSourceMappingList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventSourceMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingList.
+func (in *EventSourceMappingList) DeepCopy() *EventSourceMappingList {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventSourceMappingList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingObservation) DeepCopyInto(out *EventSourceMappingObservation) {
+	*out = *in
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingObservation.
+func (in *EventSourceMappingObservation) DeepCopy() *EventSourceMappingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingParameters) DeepCopyInto(out *EventSourceMappingParameters) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventSourceARN != nil {
+		in, out := &in.EventSourceARN, &out.EventSourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SQSQueueRef != nil {
+		in, out := &in.SQSQueueRef, &out.SQSQueueRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.SQSQueueSelector != nil {
+		in, out := &in.SQSQueueSelector, &out.SQSQueueSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KinesisStreamRef != nil {
+		in, out := &in.KinesisStreamRef, &out.KinesisStreamRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.KinesisStreamSelector != nil {
+		in, out := &in.KinesisStreamSelector, &out.KinesisStreamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DynamoDBTableRef != nil {
+		in, out := &in.DynamoDBTableRef, &out.DynamoDBTableRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.DynamoDBTableSelector != nil {
+		in, out := &in.DynamoDBTableSelector, &out.DynamoDBTableSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumBatchingWindowInSeconds != nil {
+		in, out := &in.MaximumBatchingWindowInSeconds, &out.MaximumBatchingWindowInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.StartingPosition != nil {
+		in, out := &in.StartingPosition, &out.StartingPosition
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartingPositionTimestamp != nil {
+		in, out := &in.StartingPositionTimestamp, &out.StartingPositionTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ParallelizationFactor != nil {
+		in, out := &in.ParallelizationFactor, &out.ParallelizationFactor
+		*out = new(int64)
+		**out = **in
+	}
+	if in.BisectBatchOnFunctionError != nil {
+		in, out := &in.BisectBatchOnFunctionError, &out.BisectBatchOnFunctionError
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaximumRecordAgeInSeconds != nil {
+		in, out := &in.MaximumRecordAgeInSeconds, &out.MaximumRecordAgeInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaximumRetryAttempts != nil {
+		in, out := &in.MaximumRetryAttempts, &out.MaximumRetryAttempts
+		*out = new(int64)
+		**out = **in
+	}
+	if in.TumblingWindowInSeconds != nil {
+		in, out := &in.TumblingWindowInSeconds, &out.TumblingWindowInSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FunctionResponseTypes != nil {
+		in, out := &in.FunctionResponseTypes, &out.FunctionResponseTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnFailure != nil {
+		in, out := &in.OnFailure, &out.OnFailure
+		*out = new(OnFailureDestination)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingParameters.
+func (in *EventSourceMappingParameters) DeepCopy() *EventSourceMappingParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingSpec) DeepCopyInto(out *EventSourceMappingSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingSpec.
+func (in *EventSourceMappingSpec) DeepCopy() *EventSourceMappingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSourceMappingStatus) DeepCopyInto(out *EventSourceMappingStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSourceMappingStatus.
+func (in *EventSourceMappingStatus) DeepCopy() *EventSourceMappingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSourceMappingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FileSystemConfig) DeepCopyInto(out *FileSystemConfig) {
 	*out = *in
@@ -812,6 +1054,11 @@ func (in *FunctionObservation) DeepCopyInto(out *FunctionObservation) {
 		*out = new(VPCConfigResponse)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FunctionObservation.
@@ -1173,6 +1420,199 @@ func (in *LayerVersionsListItem) DeepCopy() *LayerVersionsListItem {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnFailureDestination) DeepCopyInto(out *OnFailureDestination) {
+	*out = *in
+	if in.ARN != nil {
+		in, out := &in.ARN, &out.ARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ARNRef != nil {
+		in, out := &in.ARNRef, &out.ARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ARNSelector != nil {
+		in, out := &in.ARNSelector, &out.ARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnFailureDestination.
+func (in *OnFailureDestination) DeepCopy() *OnFailureDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(OnFailureDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Permission) DeepCopyInto(out *Permission) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Permission.
+func (in *Permission) DeepCopy() *Permission {
+	if in == nil {
+		return nil
+	}
+	out := new(Permission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Permission) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionList) DeepCopyInto(out *PermissionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Permission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionList.
+func (in *PermissionList) DeepCopy() *PermissionList {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PermissionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionObservation) DeepCopyInto(out *PermissionObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionObservation.
+func (in *PermissionObservation) DeepCopy() *PermissionObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionParameters) DeepCopyInto(out *PermissionParameters) {
+	*out = *in
+	if in.FunctionName != nil {
+		in, out := &in.FunctionName, &out.FunctionName
+		*out = new(string)
+		**out = **in
+	}
+	if in.FunctionNameRef != nil {
+		in, out := &in.FunctionNameRef, &out.FunctionNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.FunctionNameSelector != nil {
+		in, out := &in.FunctionNameSelector, &out.FunctionNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceARN != nil {
+		in, out := &in.SourceARN, &out.SourceARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceAccount != nil {
+		in, out := &in.SourceAccount, &out.SourceAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventSourceToken != nil {
+		in, out := &in.EventSourceToken, &out.EventSourceToken
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionParameters.
+func (in *PermissionParameters) DeepCopy() *PermissionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionSpec) DeepCopyInto(out *PermissionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionSpec.
+func (in *PermissionSpec) DeepCopy() *PermissionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PermissionStatus) DeepCopyInto(out *PermissionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PermissionStatus.
+func (in *PermissionStatus) DeepCopy() *PermissionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PermissionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProvisionedConcurrencyConfigListItem) DeepCopyInto(out *ProvisionedConcurrencyConfigListItem) {
 	*out = *in
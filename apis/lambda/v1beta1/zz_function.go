@@ -94,6 +94,7 @@ type FunctionSpec struct {
 
 // FunctionObservation defines the observed state of Function
 type FunctionObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The SHA256 hash of the function's deployment package.
 	CodeSHA256 *string `json:"codeSHA256,omitempty"`
 	// The size of the function's deployment package, in bytes.
@@ -105,10 +106,11 @@ type FunctionObservation struct {
 	// The function's image configuration values.
 	ImageConfigResponse *ImageConfigResponse `json:"imageConfigResponse,omitempty"`
 	// The date and time that the function was last updated, in ISO-8601 format
-// (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).
-	LastModified *string `json:"lastModified,omitempty"`
+	// (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).
+	LastModified  *string        `json:"lastModified,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The status of the last update that was performed on the function. This is
-// first set to Successful after function creation completes.
+	// first set to Successful after function creation completes.
 	LastUpdateStatus *string `json:"lastUpdateStatus,omitempty"`
 	// The reason for the last update that was performed on the function.
 	LastUpdateStatusReason *string `json:"lastUpdateStatusReason,omitempty"`
@@ -125,12 +127,12 @@ type FunctionObservation struct {
 	// The ARN of the signing profile version.
 	SigningProfileVersionARN *string `json:"signingProfileVersionARN,omitempty"`
 	// The current state of the function. When the state is Inactive, you can reactivate
-// the function by invoking it.
+	// the function by invoking it.
 	State *string `json:"state,omitempty"`
 	// The reason for the function's current state.
 	StateReason *string `json:"stateReason,omitempty"`
 	// The reason code for the function's current state. When the code is Creating,
-// you can't invoke or modify the function.
+	// you can't invoke or modify the function.
 	StateReasonCode *string `json:"stateReasonCode,omitempty"`
 	// The version of the Lambda function.
 	Version *string `json:"version,omitempty"`
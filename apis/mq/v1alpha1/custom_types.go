@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomBrokerParameters contains the additional fields for CustomBrokerParameters
 type CustomBrokerParameters struct {
@@ -81,3 +85,8 @@ type CustomUserParameters struct {
 
 	PasswordSecretRef xpv1.SecretKeySelector `json:"passwordSecretRef,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
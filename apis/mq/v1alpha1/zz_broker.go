@@ -78,7 +78,9 @@ type BrokerSpec struct {
 type BrokerObservation struct {
 	BrokerARN *string `json:"brokerARN,omitempty"`
 
-	BrokerID *string `json:"brokerID,omitempty"`
+	BrokerID        *string        `json:"brokerID,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // BrokerStatus defines the observed state of Broker.
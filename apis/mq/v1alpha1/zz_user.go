@@ -44,6 +44,8 @@ type UserSpec struct {
 
 // UserObservation defines the observed state of User
 type UserObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // UserStatus defines the observed state of User.
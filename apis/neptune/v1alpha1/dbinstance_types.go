@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Neptune DB instance states.
+const (
+	// DBInstanceStateAvailable is the state of an instance that is ready to
+	// serve requests.
+	DBInstanceStateAvailable = "available"
+	// DBInstanceStateCreating is the state of an instance that is being
+	// created.
+	DBInstanceStateCreating = "creating"
+	// DBInstanceStateDeleting is the state of an instance that is being
+	// deleted.
+	DBInstanceStateDeleting = "deleting"
+)
+
+// DBInstanceParameters define the desired state of a Neptune DB instance.
+type DBInstanceParameters struct {
+	// Region is which region the DBInstance will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// DBInstanceClass is the compute and memory capacity of the instance,
+	// e.g. db.r5.large.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// Engine is the name of the database engine to be used for this
+	// instance. Valid values: neptune
+	// +immutable
+	// +kubebuilder:default=neptune
+	// +optional
+	Engine string `json:"engine,omitempty"`
+
+	// DBClusterIdentifier is the identifier of the Neptune cluster the
+	// instance belongs to.
+	// +immutable
+	// +optional
+	DBClusterIdentifier *string `json:"dbClusterIdentifier,omitempty"`
+
+	// DBClusterIdentifierRef is a reference to a DBCluster used to set
+	// DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierRef *xpv1.Reference `json:"dbClusterIdentifierRef,omitempty"`
+
+	// DBClusterIdentifierSelector selects a reference to a DBCluster used to
+	// set DBClusterIdentifier.
+	// +immutable
+	// +optional
+	DBClusterIdentifierSelector *xpv1.Selector `json:"dbClusterIdentifierSelector,omitempty"`
+
+	// AvailabilityZone is the EC2 Availability Zone the instance is created
+	// in.
+	// +immutable
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+
+	// AutoMinorVersionUpgrade indicates that minor engine upgrades are
+	// applied automatically to the instance during the maintenance window.
+	// +optional
+	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
+
+	// DBParameterGroupName is the name of the DB parameter group to associate
+	// with this instance.
+	// +optional
+	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
+
+	// DBParameterGroupNameRef is a reference to an RDS DBParameterGroup used
+	// to set DBParameterGroupName. The parameter group must use a neptune
+	// family.
+	// +optional
+	DBParameterGroupNameRef *xpv1.Reference `json:"dbParameterGroupNameRef,omitempty"`
+
+	// DBParameterGroupNameSelector selects a reference to an RDS
+	// DBParameterGroup used to set DBParameterGroupName.
+	// +optional
+	DBParameterGroupNameSelector *xpv1.Selector `json:"dbParameterGroupNameSelector,omitempty"`
+
+	// PreferredMaintenanceWindow is the weekly time range during which system
+	// maintenance can occur, in UTC, e.g. sun:05:00-sun:06:00.
+	// +optional
+	PreferredMaintenanceWindow *string `json:"preferredMaintenanceWindow,omitempty"`
+
+	// PromotionTier is the order in which a read replica is promoted to the
+	// primary instance after a failure of the existing primary.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=15
+	// +optional
+	PromotionTier *int64 `json:"promotionTier,omitempty"`
+
+	// ApplyImmediately indicates whether changes are applied immediately or
+	// during the next maintenance window.
+	// +optional
+	ApplyImmediately *bool `json:"applyImmediately,omitempty"`
+
+	// Tags to assign to the instance when it is created.
+	// +immutable
+	// +optional
+	Tags []*Tag `json:"tags,omitempty"`
+}
+
+// A DBInstanceSpec defines the desired state of a DBInstance.
+type DBInstanceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DBInstanceParameters `json:"forProvider"`
+}
+
+// DBInstanceEndpoint is the connection endpoint of a DB instance.
+type DBInstanceEndpoint struct {
+	// Address is the DNS address of the instance.
+	Address string `json:"address,omitempty"`
+
+	// Port is the port the instance listens on.
+	Port int64 `json:"port,omitempty"`
+
+	// HostedZoneID is the ID of the Route 53 hosted zone of the endpoint.
+	HostedZoneID string `json:"hostedZoneID,omitempty"`
+}
+
+// DBInstanceObservation is the representation of the current state that is
+// observed.
+type DBInstanceObservation struct {
+	// DBInstanceARN is the Amazon Resource Name (ARN) of the instance.
+	DBInstanceARN string `json:"dbInstanceARN,omitempty"`
+
+	// DBInstanceStatus is the current state of the instance.
+	DBInstanceStatus string `json:"dbInstanceStatus,omitempty"`
+
+	// DBIResourceID is the region-unique, immutable identifier of the
+	// instance.
+	DBIResourceID string `json:"dbiResourceID,omitempty"`
+
+	// EngineVersion is the version of the database engine.
+	EngineVersion string `json:"engineVersion,omitempty"`
+
+	// Endpoint is the connection endpoint of the instance.
+	Endpoint DBInstanceEndpoint `json:"endpoint,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A DBInstanceStatus represents the observed state of a DBInstance.
+type DBInstanceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DBInstanceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DBInstance is a managed resource that represents a Neptune DB instance.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.dbInstanceStatus"
+// +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.forProvider.dbInstanceClass"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DBInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DBInstanceSpec   `json:"spec"`
+	Status DBInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DBInstanceList contains a list of DBInstances
+type DBInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DBInstance `json:"items"`
+}
+
+// DBInstance type metadata.
+var (
+	DBInstanceKind             = "DBInstance"
+	DBInstanceGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: DBInstanceKind}.String()
+	DBInstanceKindAPIVersion   = DBInstanceKind + "." + GroupVersion.String()
+	DBInstanceGroupVersionKind = GroupVersion.WithKind(DBInstanceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&DBInstance{}, &DBInstanceList{})
+}
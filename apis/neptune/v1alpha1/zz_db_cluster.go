@@ -191,6 +191,7 @@ type DBClusterObservation struct {
 	AssociatedRoles []*DBClusterRole `json:"associatedRoles,omitempty"`
 	// Time at which the DB cluster will be automatically restarted.
 	AutomaticRestartTime *metav1.Time `json:"automaticRestartTime,omitempty"`
+	CallerAccountID      string       `json:"callerAccountID,omitempty"`
 	// Identifies the clone group to which the DB cluster is associated.
 	CloneGroupID *string `json:"cloneGroupID,omitempty"`
 	// Specifies the time when the DB cluster was created, in Universal Coordinated
@@ -228,7 +229,8 @@ type DBClusterObservation struct {
 	HostedZoneID *string `json:"hostedZoneID,omitempty"`
 	// True if mapping of Amazon Identity and Access Management (IAM) accounts to
 	// database accounts is enabled, and otherwise false.
-	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
+	IAMDatabaseAuthenticationEnabled *bool          `json:"iamDatabaseAuthenticationEnabled,omitempty"`
+	LastOperation                    *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
@@ -253,7 +253,7 @@ type DBEngineVersion struct {
 }
 
 // +kubebuilder:skipversion
-type DBInstance struct {
+type DBInstance_SDK struct {
 	AutoMinorVersionUpgrade *bool `json:"autoMinorVersionUpgrade,omitempty"`
 
 	AvailabilityZone *string `json:"availabilityZone,omitempty"`
@@ -0,0 +1,406 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Tag is a key-value pair to label a Domain.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	Value string `json:"value"`
+}
+
+// ZoneAwarenessConfig configures how the nodes of a Domain are spread across
+// availability zones.
+type ZoneAwarenessConfig struct {
+	// AvailabilityZoneCount is the number of availability zones the nodes
+	// are spread across.
+	// +kubebuilder:validation:Enum=2;3
+	// +optional
+	AvailabilityZoneCount *int64 `json:"availabilityZoneCount,omitempty"`
+}
+
+// ColdStorageOptions configures the cold storage of a Domain.
+type ColdStorageOptions struct {
+	// Enabled indicates whether cold storage is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// ClusterConfig configures the instances of a Domain.
+type ClusterConfig struct {
+	// InstanceType of the data nodes, for example r6g.large.search.
+	// +optional
+	InstanceType *string `json:"instanceType,omitempty"`
+
+	// InstanceCount is the number of data nodes.
+	// +optional
+	InstanceCount *int64 `json:"instanceCount,omitempty"`
+
+	// DedicatedMasterEnabled indicates whether the Domain uses dedicated
+	// master nodes.
+	// +optional
+	DedicatedMasterEnabled *bool `json:"dedicatedMasterEnabled,omitempty"`
+
+	// DedicatedMasterType is the instance type of the dedicated master
+	// nodes.
+	// +optional
+	DedicatedMasterType *string `json:"dedicatedMasterType,omitempty"`
+
+	// DedicatedMasterCount is the number of dedicated master nodes.
+	// +optional
+	DedicatedMasterCount *int64 `json:"dedicatedMasterCount,omitempty"`
+
+	// ZoneAwarenessEnabled indicates whether the nodes are spread across
+	// availability zones.
+	// +optional
+	ZoneAwarenessEnabled *bool `json:"zoneAwarenessEnabled,omitempty"`
+
+	// ZoneAwarenessConfig configures the zone awareness of the Domain.
+	// +optional
+	ZoneAwarenessConfig *ZoneAwarenessConfig `json:"zoneAwarenessConfig,omitempty"`
+
+	// WarmEnabled indicates whether the Domain uses UltraWarm nodes.
+	// +optional
+	WarmEnabled *bool `json:"warmEnabled,omitempty"`
+
+	// WarmType is the instance type of the UltraWarm nodes.
+	// +optional
+	WarmType *string `json:"warmType,omitempty"`
+
+	// WarmCount is the number of UltraWarm nodes.
+	// +optional
+	WarmCount *int64 `json:"warmCount,omitempty"`
+
+	// ColdStorageOptions configures the cold storage of the Domain.
+	// +optional
+	ColdStorageOptions *ColdStorageOptions `json:"coldStorageOptions,omitempty"`
+}
+
+// EBSOptions configures the EBS volumes attached to the data nodes of a
+// Domain.
+type EBSOptions struct {
+	// EBSEnabled indicates whether EBS volumes are attached to the data
+	// nodes.
+	EBSEnabled bool `json:"ebsEnabled"`
+
+	// VolumeType is the type of the EBS volumes.
+	// +kubebuilder:validation:Enum=standard;gp2;gp3;io1
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+
+	// VolumeSize is the size of the EBS volume of each data node in GiB.
+	// +optional
+	VolumeSize *int64 `json:"volumeSize,omitempty"`
+
+	// IOPS is the baseline input/output performance of io1 and gp3 volumes.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+}
+
+// VPCOptions places a Domain in a VPC.
+type VPCOptions struct {
+	// SubnetIDs are the IDs of the subnets the endpoints of the Domain are
+	// created in.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:refFieldName=SubnetIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetIDSelector
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to Subnets used to set the SubnetIDs.
+	// +immutable
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to Subnets used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// SecurityGroupIDs are the IDs of the security groups of the endpoints
+	// of the Domain.
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SecurityGroup
+	// +crossplane:generate:reference:refFieldName=SecurityGroupIDRefs
+	// +crossplane:generate:reference:selectorFieldName=SecurityGroupIDSelector
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs are references to SecurityGroups used to set the
+	// SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDRefs []xpv1.Reference `json:"securityGroupIdRefs,omitempty"`
+
+	// SecurityGroupIDSelector selects references to SecurityGroups used to
+	// set the SecurityGroupIDs.
+	// +optional
+	SecurityGroupIDSelector *xpv1.Selector `json:"securityGroupIdSelector,omitempty"`
+}
+
+// MasterUserOptions configures the master user of the fine-grained access
+// control of a Domain. Either MasterUserARN or MasterUserName and
+// MasterUserPasswordSecretRef must be set.
+type MasterUserOptions struct {
+	// MasterUserARN is the ARN of the IAM principal that is the master user.
+	// +optional
+	MasterUserARN *string `json:"masterUserArn,omitempty"`
+
+	// MasterUserName is the name of the master user in the internal user
+	// database.
+	// +optional
+	MasterUserName *string `json:"masterUserName,omitempty"`
+
+	// MasterUserPasswordSecretRef references the secret that contains the
+	// password of the master user in the internal user database.
+	// +optional
+	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
+}
+
+// AdvancedSecurityOptions configures the fine-grained access control of a
+// Domain.
+type AdvancedSecurityOptions struct {
+	// Enabled indicates whether fine-grained access control is enabled. It
+	// can't be disabled once it was enabled.
+	Enabled bool `json:"enabled"`
+
+	// InternalUserDatabaseEnabled indicates whether the internal user
+	// database is enabled.
+	// +optional
+	InternalUserDatabaseEnabled *bool `json:"internalUserDatabaseEnabled,omitempty"`
+
+	// MasterUserOptions configures the master user.
+	// +optional
+	MasterUserOptions *MasterUserOptions `json:"masterUserOptions,omitempty"`
+}
+
+// EncryptionAtRestOptions configures the encryption at rest of a Domain.
+type EncryptionAtRestOptions struct {
+	// Enabled indicates whether encryption at rest is enabled.
+	// +immutable
+	Enabled bool `json:"enabled"`
+
+	// KMSKeyID is the ID of the KMS key used to encrypt the data at rest.
+	// Defaults to the AWS owned key.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/kms/v1alpha1.Key
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
+
+	// KMSKeyIDRef is a reference to a KMS Key used to set the KMSKeyID.
+	// +optional
+	KMSKeyIDRef *xpv1.Reference `json:"kmsKeyIdRef,omitempty"`
+
+	// KMSKeyIDSelector selects a reference to a KMS Key used to set the
+	// KMSKeyID.
+	// +optional
+	KMSKeyIDSelector *xpv1.Selector `json:"kmsKeyIdSelector,omitempty"`
+}
+
+// NodeToNodeEncryptionOptions configures the encryption of the traffic
+// between the nodes of a Domain.
+type NodeToNodeEncryptionOptions struct {
+	// Enabled indicates whether node-to-node encryption is enabled.
+	Enabled bool `json:"enabled"`
+}
+
+// DomainEndpointOptions configures the endpoint of a Domain.
+type DomainEndpointOptions struct {
+	// EnforceHTTPS indicates whether only HTTPS requests are accepted.
+	// +optional
+	EnforceHTTPS *bool `json:"enforceHTTPS,omitempty"`
+
+	// TLSSecurityPolicy is the TLS security policy of the endpoint.
+	// +kubebuilder:validation:Enum=Policy-Min-TLS-1-0-2019-07;Policy-Min-TLS-1-2-2019-07
+	// +optional
+	TLSSecurityPolicy *string `json:"tlsSecurityPolicy,omitempty"`
+}
+
+// DomainParameters define the desired state of an OpenSearch Service
+// domain.
+type DomainParameters struct {
+	// Region is which region the Domain will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// EngineVersion is the version of OpenSearch or Elasticsearch, for
+	// example OpenSearch_1.0 or Elasticsearch_7.10. Defaults to the latest
+	// version of OpenSearch.
+	// +immutable
+	// +optional
+	EngineVersion *string `json:"engineVersion,omitempty"`
+
+	// ClusterConfig configures the instances of the Domain.
+	// +optional
+	ClusterConfig *ClusterConfig `json:"clusterConfig,omitempty"`
+
+	// EBSOptions configures the EBS volumes of the data nodes.
+	// +optional
+	EBSOptions *EBSOptions `json:"ebsOptions,omitempty"`
+
+	// VPCOptions places the Domain in a VPC. Domains are publicly
+	// accessible unless they're placed in a VPC, which can't be changed
+	// after the Domain was created.
+	// +optional
+	VPCOptions *VPCOptions `json:"vpcOptions,omitempty"`
+
+	// AccessPolicies is the IAM policy document that controls access to the
+	// Domain.
+	// +optional
+	AccessPolicies *string `json:"accessPolicies,omitempty"`
+
+	// AdvancedOptions are the advanced cluster settings, for example
+	// rest.action.multi.allow_explicit_index.
+	// +optional
+	AdvancedOptions map[string]string `json:"advancedOptions,omitempty"`
+
+	// AdvancedSecurityOptions configures the fine-grained access control of
+	// the Domain.
+	// +optional
+	AdvancedSecurityOptions *AdvancedSecurityOptions `json:"advancedSecurityOptions,omitempty"`
+
+	// EncryptionAtRestOptions configures the encryption at rest of the
+	// Domain.
+	// +optional
+	EncryptionAtRestOptions *EncryptionAtRestOptions `json:"encryptionAtRestOptions,omitempty"`
+
+	// NodeToNodeEncryptionOptions configures the encryption of the traffic
+	// between the nodes of the Domain.
+	// +optional
+	NodeToNodeEncryptionOptions *NodeToNodeEncryptionOptions `json:"nodeToNodeEncryptionOptions,omitempty"`
+
+	// DomainEndpointOptions configures the endpoint of the Domain.
+	// +optional
+	DomainEndpointOptions *DomainEndpointOptions `json:"domainEndpointOptions,omitempty"`
+
+	// Tags to attach to the Domain.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ServiceSoftwareOptions is the state of the service software of a Domain.
+type ServiceSoftwareOptions struct {
+	// CurrentVersion of the service software.
+	CurrentVersion string `json:"currentVersion,omitempty"`
+
+	// NewVersion of the service software, if an update is available.
+	NewVersion string `json:"newVersion,omitempty"`
+
+	// UpdateAvailable indicates whether an update is available.
+	UpdateAvailable bool `json:"updateAvailable,omitempty"`
+
+	// UpdateStatus is the status of the update, e.g. PENDING_UPDATE or
+	// IN_PROGRESS.
+	UpdateStatus string `json:"updateStatus,omitempty"`
+
+	// AutomatedUpdateDate is when the update will be applied automatically.
+	AutomatedUpdateDate *metav1.Time `json:"automatedUpdateDate,omitempty"`
+
+	// Description of the state of the service software.
+	Description string `json:"description,omitempty"`
+}
+
+// DomainObservation is the observed state of a Domain.
+type DomainObservation struct {
+	// ARN of the Domain.
+	ARN string `json:"arn,omitempty"`
+
+	// DomainID is the ID of the Domain.
+	DomainID string `json:"domainId,omitempty"`
+
+	// Endpoint of a public Domain.
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// VPCEndpoint is the endpoint of a Domain that was placed in a VPC.
+	VPCEndpoint string `json:"vpcEndpoint,omitempty"`
+
+	// VPCID is the ID of the VPC of a Domain that was placed in a VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Processing indicates whether a configuration change is being
+	// applied to the Domain. Most changes are applied with a blue/green
+	// deployment, during which the Domain runs twice its nodes.
+	Processing bool `json:"processing,omitempty"`
+
+	// UpgradeProcessing indicates whether an upgrade of the engine version
+	// is being applied to the Domain.
+	UpgradeProcessing bool `json:"upgradeProcessing,omitempty"`
+
+	// ServiceSoftwareOptions is the state of the service software of the
+	// Domain.
+	ServiceSoftwareOptions *ServiceSoftwareOptions `json:"serviceSoftwareOptions,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A DomainSpec defines the desired state of a Domain.
+type DomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainParameters `json:"forProvider"`
+}
+
+// A DomainStatus represents the observed state of a Domain.
+type DomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Domain is a managed resource that represents an Amazon OpenSearch
+// Service domain.
+// +kubebuilder:printcolumn:name="ENDPOINT",type="string",JSONPath=".status.atProvider.endpoint"
+// +kubebuilder:printcolumn:name="PROCESSING",type="boolean",JSONPath=".status.atProvider.processing"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Domain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainSpec   `json:"spec"`
+	Status DomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainList contains a list of Domains
+type DomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Domain `json:"items"`
+}
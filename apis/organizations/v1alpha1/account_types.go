@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Tag is a key-value pair attached to an AWS Organizations resource.
+type Tag struct {
+	// Key of the tag.
+	Key string `json:"key"`
+
+	// Value of the tag.
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// AccountParameters define the desired state of an AWS Organizations member
+// account.
+type AccountParameters struct {
+	// Name of the account.
+	// +immutable
+	Name string `json:"name"`
+
+	// Email is the email address of the root user of the account. It must
+	// not be used by any other AWS account.
+	// +immutable
+	Email string `json:"email"`
+
+	// IAMUserAccessToBilling specifies whether IAM users and roles of the
+	// account can access its billing information. Defaults to ALLOW.
+	// +immutable
+	// +kubebuilder:validation:Enum=ALLOW;DENY
+	// +optional
+	IAMUserAccessToBilling *string `json:"iamUserAccessToBilling,omitempty"`
+
+	// RoleName is the name of the role created in the account that the
+	// management account can assume. Defaults to
+	// OrganizationAccountAccessRole.
+	// +immutable
+	// +optional
+	RoleName *string `json:"roleName,omitempty"`
+
+	// ParentID is the ID of the root or organizational unit the account is
+	// moved to. The account stays in the root if none is given.
+	// +optional
+	// +crossplane:generate:reference:type=OrganizationalUnit
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef references an OrganizationalUnit to retrieve its ID.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+
+	// Tags to attach to the account.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// AccountObservation keeps the state for the external resource
+type AccountObservation struct {
+	// AccountID is the ID of the account.
+	AccountID string `json:"accountId,omitempty"`
+
+	// ARN of the account.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the account, for example ACTIVE or SUSPENDED.
+	Status string `json:"status,omitempty"`
+
+	// ParentID is the ID of the root or organizational unit the account is
+	// currently in.
+	ParentID string `json:"parentId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AccountSpec defines the desired state of an Account.
+type AccountSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountParameters `json:"forProvider"`
+}
+
+// An AccountStatus represents the observed state of an Account.
+type AccountStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Account is a managed resource that represents an AWS account created
+// by the management account of an organization. Deleting it removes the
+// account from the organization; the account itself is not closed.
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.atProvider.accountId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Account struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountSpec   `json:"spec"`
+	Status AccountStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountList contains a list of Accounts
+type AccountList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Account `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// OrganizationalUnitParameters define the desired state of an AWS
+// Organizations organizational unit.
+type OrganizationalUnitParameters struct {
+	// Name of the organizational unit.
+	Name string `json:"name"`
+
+	// ParentID is the ID of the root or organizational unit the
+	// organizational unit is created in.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=OrganizationalUnit
+	ParentID *string `json:"parentId,omitempty"`
+
+	// ParentIDRef references an OrganizationalUnit to retrieve its ID.
+	// +optional
+	ParentIDRef *xpv1.Reference `json:"parentIdRef,omitempty"`
+
+	// ParentIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +optional
+	ParentIDSelector *xpv1.Selector `json:"parentIdSelector,omitempty"`
+
+	// Tags to attach to the organizational unit.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// OrganizationalUnitObservation keeps the state for the external resource
+type OrganizationalUnitObservation struct {
+	// ARN of the organizational unit.
+	ARN string `json:"arn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An OrganizationalUnitSpec defines the desired state of an
+// OrganizationalUnit.
+type OrganizationalUnitSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationalUnitParameters `json:"forProvider"`
+}
+
+// An OrganizationalUnitStatus represents the observed state of an
+// OrganizationalUnit.
+type OrganizationalUnitStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationalUnitObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationalUnit is a managed resource that represents an AWS
+// Organizations organizational unit.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type OrganizationalUnit struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationalUnitSpec   `json:"spec"`
+	Status OrganizationalUnitStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationalUnitList contains a list of OrganizationalUnits
+type OrganizationalUnitList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationalUnit `json:"items"`
+}
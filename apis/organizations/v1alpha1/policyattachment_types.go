@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// PolicyAttachmentParameters define the desired state of an AWS
+// Organizations policy attachment.
+type PolicyAttachmentParameters struct {
+	// PolicyID is the ID of the policy to attach.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=Policy
+	PolicyID *string `json:"policyId,omitempty"`
+
+	// PolicyIDRef references a Policy to retrieve its ID.
+	// +optional
+	PolicyIDRef *xpv1.Reference `json:"policyIdRef,omitempty"`
+
+	// PolicyIDSelector selects a reference to a Policy to retrieve its ID.
+	// +optional
+	PolicyIDSelector *xpv1.Selector `json:"policyIdSelector,omitempty"`
+
+	// TargetID is the ID of the root, organizational unit or account the
+	// policy is attached to.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=OrganizationalUnit
+	TargetID *string `json:"targetId,omitempty"`
+
+	// TargetIDRef references an OrganizationalUnit to retrieve its ID.
+	// +optional
+	TargetIDRef *xpv1.Reference `json:"targetIdRef,omitempty"`
+
+	// TargetIDSelector selects a reference to an OrganizationalUnit to
+	// retrieve its ID.
+	// +optional
+	TargetIDSelector *xpv1.Selector `json:"targetIdSelector,omitempty"`
+}
+
+// A PolicyAttachmentSpec defines the desired state of a PolicyAttachment.
+type PolicyAttachmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       PolicyAttachmentParameters `json:"forProvider"`
+}
+
+// PolicyAttachmentObservation keeps the state for the external resource.
+type PolicyAttachmentObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A PolicyAttachmentStatus represents the observed state of a
+// PolicyAttachment.
+type PolicyAttachmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          PolicyAttachmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A PolicyAttachment is a managed resource that attaches an AWS
+// Organizations policy to a root, organizational unit or account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type PolicyAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PolicyAttachmentSpec   `json:"spec"`
+	Status PolicyAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// PolicyAttachmentList contains a list of PolicyAttachments
+type PolicyAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PolicyAttachment `json:"items"`
+}
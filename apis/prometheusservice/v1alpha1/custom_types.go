@@ -16,5 +16,12 @@ limitations under the License.
 
 package v1alpha1
 
+import awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+
 // CustomWorkspaceParameters includes custom fields about WorkspaceParameters.
 type CustomWorkspaceParameters struct{}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -46,7 +46,9 @@ type WorkspaceSpec struct {
 // WorkspaceObservation defines the observed state of Workspace
 type WorkspaceObservation struct {
 	// The ARN of the workspace that was just created.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string        `json:"arn,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Prometheus endpoint URI.
 	PrometheusEndpoint *string `json:"prometheusEndpoint,omitempty"`
 	// The status of the workspace that was just created (usually CREATING).
@@ -16,5 +16,54 @@ limitations under the License.
 
 package v1alpha1
 
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
 // CustomResourceShareParameters includes custom fields for ResourceShareParameters.
-type CustomResourceShareParameters struct{}
+type CustomResourceShareParameters struct {
+	// TransitGatewayARNs are the ARNs of transit gateways to share in addition
+	// to the ResourceARNs.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.TransitGateway
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/ec2/v1alpha1.TransitGatewayARN()
+	// +crossplane:generate:reference:refFieldName=TransitGatewayARNRefs
+	// +crossplane:generate:reference:selectorFieldName=TransitGatewayARNSelector
+	// +optional
+	TransitGatewayARNs []*string `json:"transitGatewayARNs,omitempty"`
+
+	// TransitGatewayARNRefs is a list of references to TransitGateways used to
+	// set the TransitGatewayARNs.
+	// +optional
+	TransitGatewayARNRefs []xpv1.Reference `json:"transitGatewayARNRefs,omitempty"`
+
+	// TransitGatewayARNSelector selects references to TransitGateways used to
+	// set the TransitGatewayARNs.
+	// +optional
+	TransitGatewayARNSelector *xpv1.Selector `json:"transitGatewayARNSelector,omitempty"`
+
+	// SubnetARNs are the ARNs of subnets to share in addition to the
+	// ResourceARNs.
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/ec2/v1beta1.Subnet
+	// +crossplane:generate:reference:extractor=github.com/crossplane/provider-aws/apis/ec2/v1beta1.SubnetARN()
+	// +crossplane:generate:reference:refFieldName=SubnetARNRefs
+	// +crossplane:generate:reference:selectorFieldName=SubnetARNSelector
+	// +optional
+	SubnetARNs []*string `json:"subnetARNs,omitempty"`
+
+	// SubnetARNRefs is a list of references to Subnets used to set the
+	// SubnetARNs.
+	// +optional
+	SubnetARNRefs []xpv1.Reference `json:"subnetARNRefs,omitempty"`
+
+	// SubnetARNSelector selects references to Subnets used to set the
+	// SubnetARNs.
+	// +optional
+	SubnetARNSelector *xpv1.Selector `json:"subnetARNSelector,omitempty"`
+}
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ResourceShareAccepterParameters define the desired state of a
+// ResourceShareAccepter.
+type ResourceShareAccepterParameters struct {
+	// Region is which region the ResourceShareAccepter will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// ResourceShareARN is the ARN of the resource share that was shared with
+	// this account and should be accepted.
+	// +crossplane:generate:reference:type=ResourceShare
+	// +immutable
+	// +optional
+	ResourceShareARN *string `json:"resourceShareARN,omitempty"`
+
+	// ResourceShareARNRef is a reference to a ResourceShare used to set the
+	// ResourceShareARN.
+	// +optional
+	ResourceShareARNRef *xpv1.Reference `json:"resourceShareARNRef,omitempty"`
+
+	// ResourceShareARNSelector selects a reference to a ResourceShare used to
+	// set the ResourceShareARN.
+	// +optional
+	ResourceShareARNSelector *xpv1.Selector `json:"resourceShareARNSelector,omitempty"`
+}
+
+// ResourceShareAccepterObservation is the observed state of a
+// ResourceShareAccepter.
+type ResourceShareAccepterObservation struct {
+	// InvitationARN is the ARN of the resource share invitation. It is empty
+	// when the share was accepted automatically because sharing within the
+	// AWS Organization is enabled.
+	InvitationARN string `json:"invitationARN,omitempty"`
+
+	// Status of the resource share invitation.
+	Status string `json:"status,omitempty"`
+
+	// SenderAccountID is the ID of the account that owns the resource share.
+	SenderAccountID string `json:"senderAccountID,omitempty"`
+
+	// ResourceShareName is the name of the accepted resource share.
+	ResourceShareName string `json:"resourceShareName,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ResourceShareAccepterSpec defines the desired state of a
+// ResourceShareAccepter.
+type ResourceShareAccepterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ResourceShareAccepterParameters `json:"forProvider"`
+}
+
+// A ResourceShareAccepterStatus represents the observed state of a
+// ResourceShareAccepter.
+type ResourceShareAccepterStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourceShareAccepterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ResourceShareAccepter accepts a RAM resource share that another account
+// shared with the account of its provider config.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ResourceShareAccepter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResourceShareAccepterSpec   `json:"spec"`
+	Status ResourceShareAccepterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ResourceShareAccepterList contains a list of ResourceShareAccepters
+type ResourceShareAccepterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ResourceShareAccepter `json:"items"`
+}
+
+// ResourceShareAccepter type metadata.
+var (
+	ResourceShareAccepterKind             = "ResourceShareAccepter"
+	ResourceShareAccepterGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ResourceShareAccepterKind}.String()
+	ResourceShareAccepterKindAPIVersion   = ResourceShareAccepterKind + "." + GroupVersion.String()
+	ResourceShareAccepterGroupVersionKind = GroupVersion.WithKind(ResourceShareAccepterKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ResourceShareAccepter{}, &ResourceShareAccepterList{})
+}
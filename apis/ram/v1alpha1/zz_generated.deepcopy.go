@@ -22,12 +22,56 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourceShareParameters) DeepCopyInto(out *CustomResourceShareParameters) {
 	*out = *in
+	if in.TransitGatewayARNs != nil {
+		in, out := &in.TransitGatewayARNs, &out.TransitGatewayARNs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.TransitGatewayARNRefs != nil {
+		in, out := &in.TransitGatewayARNRefs, &out.TransitGatewayARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.TransitGatewayARNSelector != nil {
+		in, out := &in.TransitGatewayARNSelector, &out.TransitGatewayARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubnetARNs != nil {
+		in, out := &in.SubnetARNs, &out.SubnetARNs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
+	if in.SubnetARNRefs != nil {
+		in, out := &in.SubnetARNRefs, &out.SubnetARNRefs
+		*out = make([]v1.Reference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetARNSelector != nil {
+		in, out := &in.SubnetARNSelector, &out.SubnetARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomResourceShareParameters.
@@ -153,6 +197,149 @@ func (in *ResourceShare) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepter) DeepCopyInto(out *ResourceShareAccepter) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepter.
+func (in *ResourceShareAccepter) DeepCopy() *ResourceShareAccepter {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceShareAccepter) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepterList) DeepCopyInto(out *ResourceShareAccepterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ResourceShareAccepter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepterList.
+func (in *ResourceShareAccepterList) DeepCopy() *ResourceShareAccepterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ResourceShareAccepterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepterObservation) DeepCopyInto(out *ResourceShareAccepterObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepterObservation.
+func (in *ResourceShareAccepterObservation) DeepCopy() *ResourceShareAccepterObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepterObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepterParameters) DeepCopyInto(out *ResourceShareAccepterParameters) {
+	*out = *in
+	if in.ResourceShareARN != nil {
+		in, out := &in.ResourceShareARN, &out.ResourceShareARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.ResourceShareARNRef != nil {
+		in, out := &in.ResourceShareARNRef, &out.ResourceShareARNRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.ResourceShareARNSelector != nil {
+		in, out := &in.ResourceShareARNSelector, &out.ResourceShareARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepterParameters.
+func (in *ResourceShareAccepterParameters) DeepCopy() *ResourceShareAccepterParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepterParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepterSpec) DeepCopyInto(out *ResourceShareAccepterSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepterSpec.
+func (in *ResourceShareAccepterSpec) DeepCopy() *ResourceShareAccepterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceShareAccepterStatus) DeepCopyInto(out *ResourceShareAccepterStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareAccepterStatus.
+func (in *ResourceShareAccepterStatus) DeepCopy() *ResourceShareAccepterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceShareAccepterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceShareAssociation) DeepCopyInto(out *ResourceShareAssociation) {
 	*out = *in
@@ -285,6 +472,11 @@ func (in *ResourceShareList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceShareObservation) DeepCopyInto(out *ResourceShareObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceShare != nil {
 		in, out := &in.ResourceShare, &out.ResourceShare
 		*out = new(ResourceShare_SDK)
@@ -364,7 +556,7 @@ func (in *ResourceShareParameters) DeepCopyInto(out *ResourceShareParameters) {
 			}
 		}
 	}
-	out.CustomResourceShareParameters = in.CustomResourceShareParameters
+	in.CustomResourceShareParameters.DeepCopyInto(&out.CustomResourceShareParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceShareParameters.
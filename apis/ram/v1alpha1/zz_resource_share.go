@@ -75,6 +75,8 @@ type ResourceShareSpec struct {
 
 // ResourceShareObservation defines the observed state of ResourceShare
 type ResourceShareObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Information about the resource share.
 	ResourceShare *ResourceShare_SDK `json:"resourceShare,omitempty"`
 }
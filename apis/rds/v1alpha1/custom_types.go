@@ -16,24 +16,43 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomDBParameterGroupParameters are custom parameters for DBParameterGroup
 type CustomDBParameterGroupParameters struct {
-	// A list of parameters to associate with this DB parameter group
+	// A list of parameters to associate with this DB parameter group. Only these
+	// parameters are overridden; parameters that are removed from this list
+	// are reset to their engine defaults. If applyMethod is omitted, dynamic
+	// parameters are applied immediately and static ones on the next reboot.
 	// +optional
 	Parameters []Parameter `json:"parameters,omitempty"`
 }
 
 // CustomDBClusterParameterGroupParameters are custom parameters for DBClusterParameterGroup
 type CustomDBClusterParameterGroupParameters struct {
-	// A list of parameters to associate with this DB cluster parameter group
+	// A list of parameters to associate with this DB cluster parameter group. Only these
+	// parameters are overridden; parameters that are removed from this list
+	// are reset to their engine defaults. If applyMethod is omitted, dynamic
+	// parameters are applied immediately and static ones on the next reboot.
 	// +optional
 	Parameters []Parameter `json:"parameters,omitempty"`
 }
 
 // CustomDBClusterParameters are custom parameters for DBCluster
 type CustomDBClusterParameters struct {
+	// AutogeneratePassword indicates whether the controller should generate
+	// a random password for the master user if MasterUserPasswordSecretRef
+	// is not set.
+	//
+	// The generated password is only stored in the connection secret of the
+	// DBCluster.
+	// +immutable
+	// +optional
+	AutogeneratePassword bool `json:"autogeneratePassword,omitempty"`
 
 	// DomainIAMRoleNameRef is a reference to an IAMRole used to set
 	// DomainIAMRoleName.
@@ -56,8 +75,10 @@ type CustomDBClusterParameters struct {
 	// The password for the master database user. This password can contain any
 	// printable ASCII character except "/", """, or "@".
 	//
-	// Constraints: Must contain from 8 to 41 characters. Required.
-	MasterUserPasswordSecretRef xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef"`
+	// Constraints: Must contain from 8 to 41 characters. Required unless
+	// AutogeneratePassword is set.
+	// +optional
+	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
 
 	// A list of EC2 VPC security groups to associate with this DB cluster.
 	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIDs,omitempty"`
@@ -84,6 +105,16 @@ type CustomDBClusterParameters struct {
 	// +optional
 	DBSubnetGroupNameSelector *xpv1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *xpv1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *xpv1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// The DB cluster snapshot identifier of the new DB cluster snapshot created
 	// when SkipFinalSnapshot is disabled.
 	//
@@ -193,6 +224,16 @@ type CustomDBInstanceParameters struct {
 	// +optional
 	DBSubnetGroupNameSelector *xpv1.Selector `json:"dbSubnetGroupNameSelector,omitempty"`
 
+	// OptionGroupNameRef is a reference to an OptionGroup used to set
+	// OptionGroupName.
+	// +optional
+	OptionGroupNameRef *xpv1.Reference `json:"optionGroupNameRef,omitempty"`
+
+	// OptionGroupNameSelector selects a reference to an OptionGroup used to
+	// set OptionGroupName.
+	// +optional
+	OptionGroupNameSelector *xpv1.Selector `json:"optionGroupNameSelector,omitempty"`
+
 	// DomainIAMRoleNameRef is a reference to an IAMRole used to set
 	// DomainIAMRoleName.
 	// +optional
@@ -325,3 +366,8 @@ type CustomDBInstanceRoleAssociationParameters struct {
 	// +optional
 	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
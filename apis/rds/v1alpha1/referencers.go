@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	"context"
 
+	databasev1alpha1 "github.com/crossplane/provider-aws/apis/database/v1alpha1"
 	database "github.com/crossplane/provider-aws/apis/database/v1beta1"
 	network "github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
@@ -90,6 +91,20 @@ func (mg *DBCluster) ResolveReferences(ctx context.Context, c client.Reader) err
 	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.optionGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To:           reference.To{Managed: &databasev1alpha1.OptionGroup{}, List: &databasev1alpha1.OptionGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.optionGroupName")
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DBClusterParameterGroupName),
 		Reference:    mg.Spec.ForProvider.DBClusterParameterGroupNameRef,
@@ -173,6 +188,20 @@ func (mg *DBInstance) ResolveReferences(ctx context.Context, c client.Reader) er
 	mg.Spec.ForProvider.DBSubnetGroupName = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.DBSubnetGroupNameRef = rsp.ResolvedReference
 
+	// Resolve spec.forProvider.optionGroupName
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.OptionGroupName),
+		Reference:    mg.Spec.ForProvider.OptionGroupNameRef,
+		Selector:     mg.Spec.ForProvider.OptionGroupNameSelector,
+		To:           reference.To{Managed: &databasev1alpha1.OptionGroup{}, List: &databasev1alpha1.OptionGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.optionGroupName")
+	}
+	mg.Spec.ForProvider.OptionGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.OptionGroupNameRef = rsp.ResolvedReference
+
 	// Resolve spec.forProvider.domainIAMRoleName
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.DomainIAMRoleName),
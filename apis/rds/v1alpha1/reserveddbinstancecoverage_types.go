@@ -0,0 +1,176 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReservedDBInstanceCoverageParameters define which RDS reserved instances
+// and DB instances are taken into account when computing coverage.
+type ReservedDBInstanceCoverageParameters struct {
+	// Region is the region whose reserved instances and DB instances are
+	// observed.
+	Region string `json:"region"`
+
+	// DBInstanceClasses limits the observation to the given DB instance
+	// classes, e.g. db.r6g.large. All instance classes are observed if
+	// omitted.
+	// +optional
+	DBInstanceClasses []string `json:"dbInstanceClasses,omitempty"`
+}
+
+// A ReservedDBInstanceCoverageSpec defines the desired state of a
+// ReservedDBInstanceCoverage.
+type ReservedDBInstanceCoverageSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReservedDBInstanceCoverageParameters `json:"forProvider"`
+}
+
+// DBInstanceReservation is an active RDS reserved instance purchase.
+type DBInstanceReservation struct {
+	// ReservedDBInstanceID is the unique identifier of the reservation.
+	ReservedDBInstanceID string `json:"reservedDBInstanceId"`
+
+	// DBInstanceClass is the DB instance class that is reserved.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// DBInstanceCount is the number of DB instances that have been reserved.
+	DBInstanceCount int32 `json:"dbInstanceCount"`
+
+	// MultiAZ indicates whether the reservation applies to Multi-AZ
+	// deployments.
+	MultiAZ bool `json:"multiAZ"`
+
+	// ProductDescription is the database engine the reservation applies to,
+	// e.g. postgresql.
+	ProductDescription string `json:"productDescription"`
+
+	// OfferingType is the payment option of the reservation, e.g.
+	// All Upfront.
+	// +optional
+	OfferingType string `json:"offeringType,omitempty"`
+
+	// StartTime is the time the reservation started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time the reservation expires.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// DBInstanceClassCoverage reports how many DB instances of an instance class
+// and deployment option are covered by active reservations.
+type DBInstanceClassCoverage struct {
+	// DBInstanceClass is the DB instance class, e.g. db.r6g.large.
+	DBInstanceClass string `json:"dbInstanceClass"`
+
+	// MultiAZ indicates whether this entry counts Multi-AZ deployments.
+	MultiAZ bool `json:"multiAZ"`
+
+	// ReservedInstances is the number of instances covered by active
+	// reservations.
+	ReservedInstances int32 `json:"reservedInstances"`
+
+	// RunningInstances is the number of existing DB instances.
+	RunningInstances int32 `json:"runningInstances"`
+
+	// UncoveredInstances is the number of running instances that are not
+	// covered by any active reservation.
+	UncoveredInstances int32 `json:"uncoveredInstances"`
+}
+
+// ReservedDBInstanceCoverageObservation is the observed reserved capacity
+// coverage of RDS instances.
+type ReservedDBInstanceCoverageObservation struct {
+	// Reservations are the active reserved instance purchases.
+	// +optional
+	Reservations []DBInstanceReservation `json:"reservations,omitempty"`
+
+	// Coverage is the reservation coverage per DB instance class and
+	// deployment option. Engines are not distinguished.
+	// +optional
+	Coverage []DBInstanceClassCoverage `json:"coverage,omitempty"`
+
+	// UncoveredInstances is the total number of running instances that are
+	// not covered by any active reservation.
+	UncoveredInstances int32 `json:"uncoveredInstances"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ReservedDBInstanceCoverageStatus represents the observed state of a
+// ReservedDBInstanceCoverage.
+type ReservedDBInstanceCoverageStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReservedDBInstanceCoverageObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReservedDBInstanceCoverage is a read-only managed resource that reports
+// how well the running RDS instances of a region are covered by reserved
+// instances. It never creates, updates or deletes anything in AWS.
+// +kubebuilder:printcolumn:name="UNCOVERED",type="integer",JSONPath=".status.atProvider.uncoveredInstances"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReservedDBInstanceCoverage struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReservedDBInstanceCoverageSpec   `json:"spec"`
+	Status ReservedDBInstanceCoverageStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReservedDBInstanceCoverageList contains a list of ReservedDBInstanceCoverages
+type ReservedDBInstanceCoverageList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReservedDBInstanceCoverage `json:"items"`
+}
+
+// ReservedDBInstanceCoverage type metadata.
+var (
+	ReservedDBInstanceCoverageKind             = "ReservedDBInstanceCoverage"
+	ReservedDBInstanceCoverageGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ReservedDBInstanceCoverageKind}.String()
+	ReservedDBInstanceCoverageKindAPIVersion   = ReservedDBInstanceCoverageKind + "." + GroupVersion.String()
+	ReservedDBInstanceCoverageGroupVersionKind = GroupVersion.WithKind(ReservedDBInstanceCoverageKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ReservedDBInstanceCoverage{}, &ReservedDBInstanceCoverageList{})
+}
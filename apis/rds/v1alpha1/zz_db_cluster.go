@@ -367,6 +367,7 @@ type DBClusterObservation struct {
 	AutomaticRestartTime *metav1.Time `json:"automaticRestartTime,omitempty"`
 	// The number of change records stored for Backtrack.
 	BacktrackConsumedChangeRecords *int64 `json:"backtrackConsumedChangeRecords,omitempty"`
+	CallerAccountID                string `json:"callerAccountID,omitempty"`
 	// The current capacity of an Aurora Serverless DB cluster. The capacity is
 	// 0 (zero) when the cluster is paused.
 	//
@@ -441,7 +442,8 @@ type DBClusterObservation struct {
 	HTTPEndpointEnabled *bool `json:"httpEndpointEnabled,omitempty"`
 	// A value that indicates whether the mapping of Amazon Web Services Identity
 	// and Access Management (IAM) accounts to database accounts is enabled.
-	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
+	IAMDatabaseAuthenticationEnabled *bool          `json:"iamDatabaseAuthenticationEnabled,omitempty"`
+	LastOperation                    *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
@@ -81,10 +81,13 @@ type DBClusterParameterGroupSpec struct {
 
 // DBClusterParameterGroupObservation defines the observed state of DBClusterParameterGroup
 type DBClusterParameterGroupObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon Resource Name (ARN) for the DB cluster parameter group.
 	DBClusterParameterGroupARN *string `json:"dbClusterParameterGroupARN,omitempty"`
 	// The name of the DB cluster parameter group.
-	DBClusterParameterGroupName *string `json:"dbClusterParameterGroupName,omitempty"`
+	DBClusterParameterGroupName *string        `json:"dbClusterParameterGroupName,omitempty"`
+	LastOperation               *LastOperation `json:"lastOperation,omitempty"`
+	PendingRebootParameters     []*string      `json:"pendingRebootParameters,omitempty"`
 }
 
 // DBClusterParameterGroupStatus defines the observed state of DBClusterParameterGroup.
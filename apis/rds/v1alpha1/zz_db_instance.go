@@ -871,6 +871,7 @@ type DBInstanceObservation struct {
 	AWSBackupRecoveryPointARN *string `json:"awsBackupRecoveryPointARN,omitempty"`
 	// The identifier of the CA certificate for this DB instance.
 	CACertificateIdentifier *string `json:"caCertificateIdentifier,omitempty"`
+	CallerAccountID         string  `json:"callerAccountID,omitempty"`
 	// Specifies whether a customer-owned IP address (CoIP) is enabled for an RDS
 	// on Outposts DB instance.
 	//
@@ -943,7 +944,8 @@ type DBInstanceObservation struct {
 	//    see DBCluster Type.
 	IAMDatabaseAuthenticationEnabled *bool `json:"iamDatabaseAuthenticationEnabled,omitempty"`
 	// Provides the date and time the DB instance was created.
-	InstanceCreateTime *metav1.Time `json:"instanceCreateTime,omitempty"`
+	InstanceCreateTime *metav1.Time   `json:"instanceCreateTime,omitempty"`
+	LastOperation      *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the latest time to which a database can be restored with point-in-time
 	// restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
@@ -44,6 +44,8 @@ type DBInstanceRoleAssociationSpec struct {
 
 // DBInstanceRoleAssociationObservation defines the observed state of DBInstanceRoleAssociation
 type DBInstanceRoleAssociationObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // DBInstanceRoleAssociationStatus defines the observed state of DBInstanceRoleAssociation.
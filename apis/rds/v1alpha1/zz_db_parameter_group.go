@@ -95,10 +95,13 @@ type DBParameterGroupSpec struct {
 
 // DBParameterGroupObservation defines the observed state of DBParameterGroup
 type DBParameterGroupObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The Amazon Resource Name (ARN) for the DB parameter group.
 	DBParameterGroupARN *string `json:"dbParameterGroupARN,omitempty"`
 	// The name of the DB parameter group.
-	DBParameterGroupName *string `json:"dbParameterGroupName,omitempty"`
+	DBParameterGroupName    *string        `json:"dbParameterGroupName,omitempty"`
+	LastOperation           *LastOperation `json:"lastOperation,omitempty"`
+	PendingRebootParameters []*string      `json:"pendingRebootParameters,omitempty"`
 }
 
 // DBParameterGroupStatus defines the observed state of DBParameterGroup.
@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -417,7 +418,11 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	out.MasterUserPasswordSecretRef = in.MasterUserPasswordSecretRef
+	if in.MasterUserPasswordSecretRef != nil {
+		in, out := &in.MasterUserPasswordSecretRef, &out.MasterUserPasswordSecretRef
+		*out = new(v1.SecretKeySelector)
+		**out = **in
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]string, len(*in))
@@ -443,6 +448,16 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DBClusterParameterGroupNameRef != nil {
 		in, out := &in.DBClusterParameterGroupNameRef, &out.DBClusterParameterGroupNameRef
 		*out = new(v1.Reference)
@@ -498,6 +513,16 @@ func (in *CustomDBInstanceParameters) DeepCopyInto(out *CustomDBInstanceParamete
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OptionGroupNameRef != nil {
+		in, out := &in.OptionGroupNameRef, &out.OptionGroupNameRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.OptionGroupNameSelector != nil {
+		in, out := &in.OptionGroupNameSelector, &out.OptionGroupNameSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DomainIAMRoleNameRef != nil {
 		in, out := &in.DomainIAMRoleNameRef, &out.DomainIAMRoleNameRef
 		*out = new(v1.Reference)
@@ -1013,6 +1038,11 @@ func (in *DBClusterObservation) DeepCopyInto(out *DBClusterObservation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LatestRestorableTime != nil {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
@@ -1184,6 +1214,22 @@ func (in *DBClusterParameterGroupObservation) DeepCopyInto(out *DBClusterParamet
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingRebootParameters != nil {
+		in, out := &in.PendingRebootParameters, &out.PendingRebootParameters
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBClusterParameterGroupObservation.
@@ -2404,6 +2450,21 @@ func (in *DBInstanceAutomatedBackupsReplication) DeepCopy() *DBInstanceAutomated
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceClassCoverage) DeepCopyInto(out *DBInstanceClassCoverage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceClassCoverage.
+func (in *DBInstanceClassCoverage) DeepCopy() *DBInstanceClassCoverage {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceClassCoverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBInstanceList) DeepCopyInto(out *DBInstanceList) {
 	*out = *in
@@ -2603,6 +2664,11 @@ func (in *DBInstanceObservation) DeepCopyInto(out *DBInstanceObservation) {
 		in, out := &in.InstanceCreateTime, &out.InstanceCreateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LatestRestorableTime != nil {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
@@ -2978,6 +3044,29 @@ func (in *DBInstanceParameters) DeepCopy() *DBInstanceParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBInstanceReservation) DeepCopyInto(out *DBInstanceReservation) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceReservation.
+func (in *DBInstanceReservation) DeepCopy() *DBInstanceReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(DBInstanceReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBInstanceRole) DeepCopyInto(out *DBInstanceRole) {
 	*out = *in
@@ -3070,6 +3159,11 @@ func (in *DBInstanceRoleAssociationList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DBInstanceRoleAssociationObservation) DeepCopyInto(out *DBInstanceRoleAssociationObservation) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceRoleAssociationObservation.
@@ -3124,7 +3218,7 @@ func (in *DBInstanceRoleAssociationSpec) DeepCopy() *DBInstanceRoleAssociationSp
 func (in *DBInstanceRoleAssociationStatus) DeepCopyInto(out *DBInstanceRoleAssociationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBInstanceRoleAssociationStatus.
@@ -3732,6 +3826,22 @@ func (in *DBParameterGroupObservation) DeepCopyInto(out *DBParameterGroupObserva
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingRebootParameters != nil {
+		in, out := &in.PendingRebootParameters, &out.PendingRebootParameters
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBParameterGroupObservation.
@@ -5007,6 +5117,11 @@ func (in *GlobalClusterObservation) DeepCopyInto(out *GlobalClusterObservation)
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Status != nil {
 		in, out := &in.Status, &out.Status
 		*out = new(string)
@@ -6257,6 +6372,151 @@ func (in *ReservedDBInstance) DeepCopy() *ReservedDBInstance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverage) DeepCopyInto(out *ReservedDBInstanceCoverage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverage.
+func (in *ReservedDBInstanceCoverage) DeepCopy() *ReservedDBInstanceCoverage {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedDBInstanceCoverage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverageList) DeepCopyInto(out *ReservedDBInstanceCoverageList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ReservedDBInstanceCoverage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverageList.
+func (in *ReservedDBInstanceCoverageList) DeepCopy() *ReservedDBInstanceCoverageList {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverageList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ReservedDBInstanceCoverageList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverageObservation) DeepCopyInto(out *ReservedDBInstanceCoverageObservation) {
+	*out = *in
+	if in.Reservations != nil {
+		in, out := &in.Reservations, &out.Reservations
+		*out = make([]DBInstanceReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Coverage != nil {
+		in, out := &in.Coverage, &out.Coverage
+		*out = make([]DBInstanceClassCoverage, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverageObservation.
+func (in *ReservedDBInstanceCoverageObservation) DeepCopy() *ReservedDBInstanceCoverageObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverageObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverageParameters) DeepCopyInto(out *ReservedDBInstanceCoverageParameters) {
+	*out = *in
+	if in.DBInstanceClasses != nil {
+		in, out := &in.DBInstanceClasses, &out.DBInstanceClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverageParameters.
+func (in *ReservedDBInstanceCoverageParameters) DeepCopy() *ReservedDBInstanceCoverageParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverageParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverageSpec) DeepCopyInto(out *ReservedDBInstanceCoverageSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverageSpec.
+func (in *ReservedDBInstanceCoverageSpec) DeepCopy() *ReservedDBInstanceCoverageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedDBInstanceCoverageStatus) DeepCopyInto(out *ReservedDBInstanceCoverageStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedDBInstanceCoverageStatus.
+func (in *ReservedDBInstanceCoverageStatus) DeepCopy() *ReservedDBInstanceCoverageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedDBInstanceCoverageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReservedDBInstancesOffering) DeepCopyInto(out *ReservedDBInstancesOffering) {
 	*out = *in
@@ -415,3 +415,69 @@ func (mg *GlobalCluster) SetPublishConnectionDetailsTo(r *xpv1.PublishConnection
 func (mg *GlobalCluster) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetCondition of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetDeletionPolicy of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) GetDeletionPolicy() xpv1.DeletionPolicy {
+	return mg.Spec.DeletionPolicy
+}
+
+// GetProviderConfigReference of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) GetProviderConfigReference() *xpv1.Reference {
+	return mg.Spec.ProviderConfigReference
+}
+
+/*
+GetProviderReference of this ReservedDBInstanceCoverage.
+Deprecated: Use GetProviderConfigReference.
+*/
+func (mg *ReservedDBInstanceCoverage) GetProviderReference() *xpv1.Reference {
+	return mg.Spec.ProviderReference
+}
+
+// GetPublishConnectionDetailsTo of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) GetPublishConnectionDetailsTo() *xpv1.PublishConnectionDetailsTo {
+	return mg.Spec.PublishConnectionDetailsTo
+}
+
+// GetWriteConnectionSecretToReference of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) GetWriteConnectionSecretToReference() *xpv1.SecretReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetConditions of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) SetConditions(c ...xpv1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetDeletionPolicy of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) SetDeletionPolicy(r xpv1.DeletionPolicy) {
+	mg.Spec.DeletionPolicy = r
+}
+
+// SetProviderConfigReference of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) SetProviderConfigReference(r *xpv1.Reference) {
+	mg.Spec.ProviderConfigReference = r
+}
+
+/*
+SetProviderReference of this ReservedDBInstanceCoverage.
+Deprecated: Use SetProviderConfigReference.
+*/
+func (mg *ReservedDBInstanceCoverage) SetProviderReference(r *xpv1.Reference) {
+	mg.Spec.ProviderReference = r
+}
+
+// SetPublishConnectionDetailsTo of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) SetPublishConnectionDetailsTo(r *xpv1.PublishConnectionDetailsTo) {
+	mg.Spec.PublishConnectionDetailsTo = r
+}
+
+// SetWriteConnectionSecretToReference of this ReservedDBInstanceCoverage.
+func (mg *ReservedDBInstanceCoverage) SetWriteConnectionSecretToReference(r *xpv1.SecretReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
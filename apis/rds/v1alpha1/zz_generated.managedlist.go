@@ -73,3 +73,12 @@ func (l *GlobalClusterList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this ReservedDBInstanceCoverageList.
+func (l *ReservedDBInstanceCoverageList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
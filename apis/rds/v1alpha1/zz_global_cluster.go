@@ -56,6 +56,7 @@ type GlobalClusterSpec struct {
 
 // GlobalClusterObservation defines the observed state of GlobalCluster
 type GlobalClusterObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// A data object containing all properties for the current state of an in-process
 	// or pending failover process for this Aurora global database. This object
 	// is empty unless the FailoverGlobalCluster API operation has been called on
@@ -73,7 +74,8 @@ type GlobalClusterObservation struct {
 	// database cluster. This identifier is found in Amazon Web Services CloudTrail
 	// log entries whenever the Amazon Web Services KMS key for the DB cluster is
 	// accessed.
-	GlobalClusterResourceID *string `json:"globalClusterResourceID,omitempty"`
+	GlobalClusterResourceID *string        `json:"globalClusterResourceID,omitempty"`
+	LastOperation           *LastOperation `json:"lastOperation,omitempty"`
 	// Specifies the current state of this global database cluster.
 	Status *string `json:"status,omitempty"`
 }
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // Redshift cluster states.
@@ -34,6 +36,8 @@ const (
 	StateModifying = "modifying"
 	// The cluster has failed and Amazon Redshift can't recover it. Perform a point-in-time restore to the latest restorable time of the Cluster to recover the data.
 	StateFailed = "failed"
+	// The cluster is paused. Compute is released while the cluster is paused.
+	StatePaused = "paused"
 )
 
 // ClusterParameters define the parameters available for an AWS Redshift cluster
@@ -58,6 +62,13 @@ type ClusterParameters struct {
 	// +immutable
 	MasterUsername string `json:"masterUsername"`
 
+	// MasterUserPasswordSecretRef references the secret that contains the
+	// password of the master user. A random password is generated if it is
+	// not set. The password of the cluster is updated when the value in the
+	// secret changes.
+	// +optional
+	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
+
 	// AllowVersionUpgrade indicates that major engine upgrades are applied automatically to the
 	// cluster during the maintenance window.
 	// default=true
@@ -268,6 +279,18 @@ type ClusterParameters struct {
 	// +optional
 	NumberOfNodes *int32 `json:"numberOfNodes,omitempty"`
 
+	// OwnerAccount is the AWS account used to create or copy the snapshot
+	// the cluster is restored from. Required only if the snapshot is owned
+	// by a different account.
+	// +immutable
+	// +optional
+	OwnerAccount *string `json:"ownerAccount,omitempty"`
+
+	// Paused pauses the cluster when true and resumes it when false. A paused
+	// cluster is billed for storage only.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+
 	// Port specifies the port number on which the cluster accepts incoming connections.
 	// The cluster is accessible only via the JDBC and ODBC connection strings.
 	// Part of the connection string requires the port on which the cluster will
@@ -306,6 +329,19 @@ type ClusterParameters struct {
 	// +optional
 	SnapshotScheduleIdentifier *string `json:"snapshotScheduleIdentifier,omitempty"`
 
+	// SnapshotIdentifier is the name of the snapshot from which to restore
+	// the cluster. The cluster is created empty if it is not set.
+	// +immutable
+	// +optional
+	SnapshotIdentifier *string `json:"snapshotIdentifier,omitempty"`
+
+	// SnapshotClusterIdentifier is the name of the cluster the source
+	// snapshot was created from. Required if the snapshot is not a manual
+	// snapshot of a cluster that still exists.
+	// +immutable
+	// +optional
+	SnapshotClusterIdentifier *string `json:"snapshotClusterIdentifier,omitempty"`
+
 	// Tags indicates a list of tags for the clusters.
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
@@ -406,6 +442,17 @@ type ClusterObservation struct {
 
 	// The identifier of the VPC the cluster is in, if the cluster is in a VPC.
 	VPCID string `json:"vpcId,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // ClusterParameterGroupStatus is the status of the Cluster parameter group.
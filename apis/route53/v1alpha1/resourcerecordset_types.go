@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // ResourceRecordSetParameters define the desired state of an AWS Route53 Resource Record.
@@ -801,9 +803,24 @@ type ResourceRecordSetSpec struct {
 	ForProvider       ResourceRecordSetParameters `json:"forProvider"`
 }
 
+// ResourceRecordSetObservation keeps the state for the external resource.
+type ResourceRecordSetObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
 // ResourceRecordSetStatus represents the observed state of a ResourceRecordSet.
 type ResourceRecordSetStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ResourceRecordSetObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -185,6 +186,11 @@ func (in *HostedZoneObservation) DeepCopyInto(out *HostedZoneObservation) {
 		*out = make([]VPCObservation, len(*in))
 		copy(*out, *in)
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostedZoneObservation.
@@ -366,6 +372,26 @@ func (in *ResourceRecordSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRecordSetObservation) DeepCopyInto(out *ResourceRecordSetObservation) {
+	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetObservation.
+func (in *ResourceRecordSetObservation) DeepCopy() *ResourceRecordSetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRecordSetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRecordSetParameters) DeepCopyInto(out *ResourceRecordSetParameters) {
 	*out = *in
@@ -462,6 +488,7 @@ func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
 func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
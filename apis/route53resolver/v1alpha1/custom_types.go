@@ -1,6 +1,10 @@
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomResolverEndpointParameters are custom parameters for ResolverEndpoint
 type CustomResolverEndpointParameters struct {
@@ -59,3 +63,8 @@ type IPAddressRequest struct {
 	// to set the SubnetIDs.
 	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
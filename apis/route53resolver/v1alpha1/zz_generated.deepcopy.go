@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -715,6 +716,11 @@ func (in *ResolverEndpointObservation) DeepCopyInto(out *ResolverEndpointObserva
 		*out = new(int64)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ModificationTime != nil {
 		in, out := &in.ModificationTime, &out.ModificationTime
 		*out = new(string)
@@ -1103,6 +1109,11 @@ func (in *ResolverRuleObservation) DeepCopyInto(out *ResolverRuleObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ModificationTime != nil {
 		in, out := &in.ModificationTime, &out.ModificationTime
 		*out = new(string)
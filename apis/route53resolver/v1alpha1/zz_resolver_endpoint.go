@@ -55,7 +55,8 @@ type ResolverEndpointSpec struct {
 // ResolverEndpointObservation defines the observed state of ResolverEndpoint
 type ResolverEndpointObservation struct {
 	// The ARN (Amazon Resource Name) for the Resolver endpoint.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The date and time that the endpoint was created, in Unix time format and
 	// Coordinated Universal Time (UTC).
 	CreationTime *string `json:"creationTime,omitempty"`
@@ -68,7 +69,8 @@ type ResolverEndpointObservation struct {
 	// The ID of the Resolver endpoint.
 	ID *string `json:"id,omitempty"`
 	// The number of IP addresses that the Resolver endpoint can use for DNS queries.
-	IPAddressCount *int64 `json:"ipAddressCount,omitempty"`
+	IPAddressCount *int64         `json:"ipAddressCount,omitempty"`
+	LastOperation  *LastOperation `json:"lastOperation,omitempty"`
 	// The date and time that the endpoint was last modified, in Unix time format
 	// and Coordinated Universal Time (UTC).
 	ModificationTime *string `json:"modificationTime,omitempty"`
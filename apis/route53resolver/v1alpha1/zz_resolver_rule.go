@@ -76,7 +76,8 @@ type ResolverRuleSpec struct {
 // ResolverRuleObservation defines the observed state of ResolverRule
 type ResolverRuleObservation struct {
 	// The ARN (Amazon Resource Name) for the Resolver rule specified by Id.
-	ARN *string `json:"arn,omitempty"`
+	ARN             *string `json:"arn,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The date and time that the Resolver rule was created, in Unix time format
 	// and Coordinated Universal Time (UTC).
 	CreationTime *string `json:"creationTime,omitempty"`
@@ -85,7 +86,8 @@ type ResolverRuleObservation struct {
 	// risk of running the operation twice.
 	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
 	// The ID that Resolver assigned to the Resolver rule when you created it.
-	ID *string `json:"id,omitempty"`
+	ID            *string        `json:"id,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The date and time that the Resolver rule was last updated, in Unix time format
 	// and Coordinated Universal Time (UTC).
 	ModificationTime *string `json:"modificationTime,omitempty"`
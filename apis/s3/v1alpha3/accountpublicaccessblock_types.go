@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// AccountPublicAccessBlockParameters define the desired state of the S3
+// public access block of an AWS account, which applies to all of its buckets
+// in addition to their own public access block. Fields that are not set are
+// treated as false, like AWS does.
+type AccountPublicAccessBlockParameters struct {
+	// Region of the S3 Control endpoint the public access block is managed
+	// through. The public access block applies to all regions regardless.
+	// +immutable
+	Region string `json:"region"`
+
+	// BlockPublicAcls rejects requests that set public ACLs on buckets and
+	// objects of the account. Existing ACLs are not affected.
+	// +optional
+	BlockPublicAcls *bool `json:"blockPublicAcls,omitempty"`
+
+	// BlockPublicPolicy rejects requests that set bucket policies allowing
+	// public access on buckets of the account. Existing policies are not
+	// affected.
+	// +optional
+	BlockPublicPolicy *bool `json:"blockPublicPolicy,omitempty"`
+
+	// IgnorePublicAcls ignores all public ACLs on buckets and objects of the
+	// account.
+	// +optional
+	IgnorePublicAcls *bool `json:"ignorePublicAcls,omitempty"`
+
+	// RestrictPublicBuckets restricts access to buckets of the account with
+	// public policies to AWS services and authorized users of the account.
+	// +optional
+	RestrictPublicBuckets *bool `json:"restrictPublicBuckets,omitempty"`
+}
+
+// An AccountPublicAccessBlockSpec defines the desired state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AccountPublicAccessBlockParameters `json:"forProvider"`
+}
+
+// AccountPublicAccessBlockObservation keeps the state for the external resource.
+type AccountPublicAccessBlockObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An AccountPublicAccessBlockStatus represents the observed state of an
+// AccountPublicAccessBlock.
+type AccountPublicAccessBlockStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          AccountPublicAccessBlockObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AccountPublicAccessBlock manages the S3 public access block of the AWS
+// account that its ProviderConfig resolves to. Its external name is the ID of
+// the account, and only one AccountPublicAccessBlock may manage the public
+// access block of an account.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AccountPublicAccessBlock struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AccountPublicAccessBlockSpec   `json:"spec"`
+	Status AccountPublicAccessBlockStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccountPublicAccessBlockList contains a list of AccountPublicAccessBlocks
+type AccountPublicAccessBlockList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccountPublicAccessBlock `json:"items"`
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha3
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// BucketCORSConfigurationParameters define the desired state of the CORS
+// configuration of an AWS Bucket.
+type BucketCORSConfigurationParameters struct {
+	// Region is where the Bucket referenced by this BucketCORSConfiguration
+	// resides.
+	// +immutable
+	Region string `json:"region"`
+
+	// BucketName presents the name of the bucket.
+	// +optional
+	// +immutable
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to an S3Bucket to retrieve its bucketName
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// A set of origins and methods (cross-origin access that you want to allow).
+	// You can add up to 100 rules to the configuration.
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=100
+	CORSRules []v1beta1.CORSRule `json:"corsRules"`
+}
+
+// A BucketCORSConfigurationSpec defines the desired state of a
+// BucketCORSConfiguration.
+type BucketCORSConfigurationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	Parameters        BucketCORSConfigurationParameters `json:"forProvider"`
+}
+
+// BucketCORSConfigurationObservation keeps the state for the external resource.
+type BucketCORSConfigurationObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A BucketCORSConfigurationStatus represents the observed state of a
+// BucketCORSConfiguration.
+type BucketCORSConfigurationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketCORSConfigurationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BucketCORSConfiguration is a managed resource that represents the CORS
+// configuration of an AWS Bucket. The referenced Bucket must not specify a
+// corsConfiguration itself.
+// +kubebuilder:printcolumn:name="BUCKETNAME",type="string",JSONPath=".spec.forProvider.bucketName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type BucketCORSConfiguration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BucketCORSConfigurationSpec   `json:"spec"`
+	Status BucketCORSConfigurationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BucketCORSConfigurationList contains a list of BucketCORSConfigurations
+type BucketCORSConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BucketCORSConfiguration `json:"items"`
+}
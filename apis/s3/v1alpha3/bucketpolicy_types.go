@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // BucketPolicyParameters define the desired state of an AWS BucketPolicy.
@@ -222,10 +224,25 @@ type BucketPolicySpec struct {
 	Parameters        BucketPolicyParameters `json:"forProvider"`
 }
 
+// BucketPolicyObservation keeps the state for the external resource.
+type BucketPolicyObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
 // An BucketPolicyStatus represents the observed state of an
 // BucketPolicy.
 type BucketPolicyStatus struct {
 	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BucketPolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -45,6 +45,33 @@ var (
 	BucketPolicyGroupVersionKind = SchemeGroupVersion.WithKind(BucketPolicyKind)
 )
 
+// BucketCORSConfiguration type metadata.
+var (
+	BucketCORSConfigurationKind             = reflect.TypeOf(BucketCORSConfiguration{}).Name()
+	BucketCORSConfigurationGroupKind        = schema.GroupKind{Group: Group, Kind: BucketCORSConfigurationKind}.String()
+	BucketCORSConfigurationKindAPIVersion   = BucketCORSConfigurationKind + "." + SchemeGroupVersion.String()
+	BucketCORSConfigurationGroupVersionKind = SchemeGroupVersion.WithKind(BucketCORSConfigurationKind)
+)
+
+// Object type metadata.
+var (
+	ObjectKind             = reflect.TypeOf(Object{}).Name()
+	ObjectGroupKind        = schema.GroupKind{Group: Group, Kind: ObjectKind}.String()
+	ObjectKindAPIVersion   = ObjectKind + "." + SchemeGroupVersion.String()
+	ObjectGroupVersionKind = SchemeGroupVersion.WithKind(ObjectKind)
+)
+
+// AccountPublicAccessBlock type metadata.
+var (
+	AccountPublicAccessBlockKind             = reflect.TypeOf(AccountPublicAccessBlock{}).Name()
+	AccountPublicAccessBlockGroupKind        = schema.GroupKind{Group: Group, Kind: AccountPublicAccessBlockKind}.String()
+	AccountPublicAccessBlockKindAPIVersion   = AccountPublicAccessBlockKind + "." + SchemeGroupVersion.String()
+	AccountPublicAccessBlockGroupVersionKind = SchemeGroupVersion.WithKind(AccountPublicAccessBlockKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&BucketPolicy{}, &BucketPolicyList{})
+	SchemeBuilder.Register(&BucketCORSConfiguration{}, &BucketCORSConfigurationList{})
+	SchemeBuilder.Register(&Object{}, &ObjectList{})
+	SchemeBuilder.Register(&AccountPublicAccessBlock{}, &AccountPublicAccessBlockList{})
 }
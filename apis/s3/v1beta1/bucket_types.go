@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 const (
@@ -89,6 +91,10 @@ type BucketParameters struct {
 	// S3 bucket. For more information, see Enabling Cross-Origin Resource Sharing
 	// (https://docs.aws.amazon.com/AmazonS3/latest/dev/cors.html) in the Amazon
 	// Simple Storage Service Developer Guide.
+	// The CORS configuration of the bucket is ignored if this is not specified,
+	// so that it can be managed by a BucketCORSConfiguration instead. The CORS
+	// configuration of the bucket is deleted if this specifies an empty list
+	// of corsRules.
 	// +optional
 	CORSConfiguration *CORSConfiguration `json:"corsConfiguration,omitempty"`
 
@@ -142,6 +148,20 @@ type BucketParameters struct {
 	// PublicAccessBlockConfiguration that you want to apply to this Amazon
 	// S3 bucket.
 	PublicAccessBlockConfiguration *PublicAccessBlockConfiguration `json:"publicAccessBlockConfiguration,omitempty"`
+
+	// OwnershipControls of the bucket, which determine who owns the objects
+	// uploaded to it and whether ACLs are enabled.
+	// For more information, see Controlling ownership of objects
+	// (https://docs.aws.amazon.com/AmazonS3/latest/userguide/about-object-ownership.html).
+	// +optional
+	OwnershipControls *OwnershipControls `json:"ownershipControls,omitempty"`
+
+	// EnforceSecureDefaults blocks all public access to the bucket unless
+	// publicAccessBlockConfiguration allows it explicitly. Settings of the
+	// public access block that are omitted are set to true, rather than
+	// being initialized with the values observed in AWS.
+	// +optional
+	EnforceSecureDefaults *bool `json:"enforceSecureDefaults,omitempty"`
 }
 
 // BucketSpec represents the desired state of the Bucket.
@@ -156,6 +176,17 @@ type BucketExternalStatus struct {
 	// about ARNs and how to use them, see S3 Resources (https://docs.aws.amazon.com/AmazonS3/latest/dev/s3-arn-format.html)
 	// in the Amazon Simple Storage Service guide.
 	ARN string `json:"arn"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 }
 
 // BucketStatus represents the observed state of the Bucket.
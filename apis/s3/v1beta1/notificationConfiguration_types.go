@@ -106,9 +106,19 @@ type LambdaFunctionConfiguration struct {
 
 	// The Amazon Resource Name (ARN) of the AWS Lambda function that Amazon S3
 	// invokes when the specified event type occurs.
-	//
-	// LambdaFunctionArn is a required field
-	LambdaFunctionArn string `json:"lambdaFunctionArn"`
+	// At least one of lambdaFunctionArn, lambdaFunctionArnRef or
+	// lambdaFunctionArnSelector is required.
+	// +optional
+	LambdaFunctionArn string `json:"lambdaFunctionArn,omitempty"`
+
+	// LambdaFunctionArnRef references a Lambda Function to retrieve its ARN
+	// +optional
+	LambdaFunctionArnRef *xpv1.Reference `json:"lambdaFunctionArnRef,omitempty"`
+
+	// LambdaFunctionArnSelector selects a reference to a Lambda Function to
+	// retrieve its ARN
+	// +optional
+	LambdaFunctionArnSelector *xpv1.Selector `json:"lambdaFunctionArnSelector,omitempty"`
 }
 
 // QueueConfiguration specifies the configuration for publishing messages to an Amazon Simple Queue
@@ -23,6 +23,7 @@ package v1beta1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	apisv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -101,6 +102,11 @@ func (in *Bucket) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BucketExternalStatus) DeepCopyInto(out *BucketExternalStatus) {
 	*out = *in
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(apisv1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketExternalStatus.
@@ -265,6 +271,16 @@ func (in *BucketParameters) DeepCopyInto(out *BucketParameters) {
 		*out = new(PublicAccessBlockConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OwnershipControls != nil {
+		in, out := &in.OwnershipControls, &out.OwnershipControls
+		*out = new(OwnershipControls)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnforceSecureDefaults != nil {
+		in, out := &in.EnforceSecureDefaults, &out.EnforceSecureDefaults
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketParameters.
@@ -298,7 +314,7 @@ func (in *BucketSpec) DeepCopy() *BucketSpec {
 func (in *BucketStatus) DeepCopyInto(out *BucketStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketStatus.
@@ -581,6 +597,16 @@ func (in *LambdaFunctionConfiguration) DeepCopyInto(out *LambdaFunctionConfigura
 		*out = new(string)
 		**out = **in
 	}
+	if in.LambdaFunctionArnRef != nil {
+		in, out := &in.LambdaFunctionArnRef, &out.LambdaFunctionArnRef
+		*out = new(v1.Reference)
+		**out = **in
+	}
+	if in.LambdaFunctionArnSelector != nil {
+		in, out := &in.LambdaFunctionArnSelector, &out.LambdaFunctionArnSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LambdaFunctionConfiguration.
@@ -862,6 +888,41 @@ func (in *NotificationConfigurationFilter) DeepCopy() *NotificationConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnershipControls) DeepCopyInto(out *OwnershipControls) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]OwnershipControlsRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnershipControls.
+func (in *OwnershipControls) DeepCopy() *OwnershipControls {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnershipControls)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OwnershipControlsRule) DeepCopyInto(out *OwnershipControlsRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OwnershipControlsRule.
+func (in *OwnershipControlsRule) DeepCopy() *OwnershipControlsRule {
+	if in == nil {
+		return nil
+	}
+	out := new(OwnershipControlsRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PaymentConfiguration) DeepCopyInto(out *PaymentConfiguration) {
 	*out = *in
@@ -112,14 +112,16 @@ type SecretSpec struct {
 // SecretObservation defines the observed state of Secret
 type SecretObservation struct {
 	// The Amazon Resource Name (ARN) of the secret that you just created.
-// 
-// Secrets Manager automatically adds several random characters to the name
-// at the end of the ARN when you initially create a secret. This affects only
-// the ARN and not the actual friendly name. This ensures that if you create
-// a new secret with the same name as an old secret that you previously deleted,
-// then users with access to the old secret don't automatically get access to
-// the new secret because the ARNs are different.
-	ARN *string `json:"arn,omitempty"`
+	//
+	// Secrets Manager automatically adds several random characters to the name
+	// at the end of the ARN when you initially create a secret. This affects only
+	// the ARN and not the actual friendly name. This ensures that if you create
+	// a new secret with the same name as an old secret that you previously deleted,
+	// then users with access to the old secret don't automatically get access to
+	// the new secret because the ARNs are different.
+	ARN             *string        `json:"arn,omitempty"`
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// Describes a list of replication status objects as InProgress, Failed or InSync.
 	ReplicationStatus []*ReplicationStatusType `json:"replicationStatus,omitempty"`
 }
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// HubParameters define the desired state of AWS Security Hub in an account
+// and region.
+type HubParameters struct {
+	// Region is which region Security Hub will be enabled in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// EnableDefaultStandards specifies whether the default security
+	// standards are enabled together with Security Hub. Defaults to true.
+	// +immutable
+	// +optional
+	EnableDefaultStandards *bool `json:"enableDefaultStandards,omitempty"`
+
+	// AutoEnableControls specifies whether new controls of enabled
+	// standards are enabled automatically. Defaults to true.
+	// +optional
+	AutoEnableControls *bool `json:"autoEnableControls,omitempty"`
+
+	// Tags to attach to the hub.
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// HubObservation keeps the state for the external resource
+type HubObservation struct {
+	// HubARN is the ARN of the hub.
+	HubARN string `json:"hubArn,omitempty"`
+
+	// SubscribedAt is when Security Hub was enabled.
+	SubscribedAt string `json:"subscribedAt,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A HubSpec defines the desired state of a Hub.
+type HubSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       HubParameters `json:"forProvider"`
+}
+
+// A HubStatus represents the observed state of a Hub.
+type HubStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          HubObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Hub is a managed resource that represents AWS Security Hub being
+// enabled in the account and region of its provider config.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Hub struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HubSpec   `json:"spec"`
+	Status HubStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// HubList contains a list of Hubs
+type HubList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Hub `json:"items"`
+}
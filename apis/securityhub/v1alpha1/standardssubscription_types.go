@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// StandardsSubscriptionParameters define the desired state of an AWS
+// Security Hub standards subscription.
+type StandardsSubscriptionParameters struct {
+	// Region is which region the standard will be enabled in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// StandardsARN is the ARN of the standard to enable, for example
+	// arn:aws:securityhub:us-east-1::standards/aws-foundational-security-best-practices/v/1.0.0.
+	// +immutable
+	StandardsARN string `json:"standardsArn"`
+
+	// StandardsInput are the parameters of the standard, if it has any.
+	// +immutable
+	// +optional
+	StandardsInput map[string]string `json:"standardsInput,omitempty"`
+}
+
+// StandardsSubscriptionObservation keeps the state for the external resource
+type StandardsSubscriptionObservation struct {
+	// StandardsStatus is the status of the subscription, for example
+	// PENDING or READY.
+	StandardsStatus string `json:"standardsStatus,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A StandardsSubscriptionSpec defines the desired state of a
+// StandardsSubscription.
+type StandardsSubscriptionSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       StandardsSubscriptionParameters `json:"forProvider"`
+}
+
+// A StandardsSubscriptionStatus represents the observed state of a
+// StandardsSubscription.
+type StandardsSubscriptionStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          StandardsSubscriptionObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A StandardsSubscription is a managed resource that represents a security
+// standard enabled in AWS Security Hub. Its external name is the ARN of the
+// subscription.
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.standardsStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type StandardsSubscription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StandardsSubscriptionSpec   `json:"spec"`
+	Status StandardsSubscriptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StandardsSubscriptionList contains a list of StandardsSubscriptions
+type StandardsSubscriptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StandardsSubscription `json:"items"`
+}
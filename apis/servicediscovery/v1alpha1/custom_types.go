@@ -5,6 +5,8 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 )
 
 // AnnotationKeyOperationID is the key in the annotations map of a
@@ -102,3 +104,8 @@ func (in *PublicDNSNamespace) GetDescription() *string {
 func (in *PublicDNSNamespace) SetDescription(d *string) {
 	in.Spec.ForProvider.Description = d
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -30,3 +30,23 @@ func (mg *PrivateDNSNamespace) ResolveReferences(ctx context.Context, c client.R
 	mg.Spec.ForProvider.VPCRef = rsp.ResolvedReference
 	return nil
 }
+
+// ResolveReferences of this Service.
+func (mg *Service) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.namespaceId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.NamespaceID),
+		Reference:    mg.Spec.ForProvider.NamespaceIDRef,
+		Selector:     mg.Spec.ForProvider.NamespaceIDSelector,
+		To:           reference.To{Managed: &PrivateDNSNamespace{}, List: &PrivateDNSNamespaceList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.namespaceId")
+	}
+	mg.Spec.ForProvider.NamespaceID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.NamespaceIDRef = rsp.ResolvedReference
+	return nil
+}
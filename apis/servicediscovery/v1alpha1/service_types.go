@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ServiceDNSRecord is a DNS record that Cloud Map creates in Route 53 when an
+// instance is registered with the service.
+type ServiceDNSRecord struct {
+	// Type of the DNS record.
+	// +kubebuilder:validation:Enum=SRV;A;AAAA;CNAME
+	Type string `json:"type"`
+
+	// TTL is the amount of time, in seconds, that resolvers cache the record.
+	TTL int64 `json:"ttl"`
+}
+
+// ServiceDNSConfig holds the DNS records that Cloud Map creates for the
+// instances of a service.
+type ServiceDNSConfig struct {
+	// RoutingPolicy to apply when Route 53 answers queries for the service.
+	// +kubebuilder:validation:Enum=MULTIVALUE;WEIGHTED
+	// +optional
+	RoutingPolicy *string `json:"routingPolicy,omitempty"`
+
+	// DNSRecords that are created for each registered instance.
+	DNSRecords []ServiceDNSRecord `json:"dnsRecords"`
+}
+
+// ServiceHealthCheckConfig configures an optional Route 53 health check for
+// the instances of a service in a public DNS namespace.
+type ServiceHealthCheckConfig struct {
+	// Type of the health check.
+	// +kubebuilder:validation:Enum=HTTP;HTTPS;TCP
+	Type string `json:"type"`
+
+	// ResourcePath that Route 53 requests for HTTP and HTTPS health checks.
+	// +optional
+	ResourcePath *string `json:"resourcePath,omitempty"`
+
+	// FailureThreshold is the number of consecutive checks an instance must
+	// fail or pass to change its health status.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// ServiceHealthCheckCustomConfig configures a custom health check whose
+// status is reported through UpdateInstanceCustomHealthStatus.
+type ServiceHealthCheckCustomConfig struct {
+	// FailureThreshold is deprecated by AWS and always treated as 1.
+	// +optional
+	FailureThreshold *int64 `json:"failureThreshold,omitempty"`
+}
+
+// ServiceParameters define the desired state of an AWS Cloud Map service.
+type ServiceParameters struct {
+	// Region is which region the Service will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the service.
+	// +immutable
+	Name string `json:"name"`
+
+	// NamespaceID is the ID of the namespace the service is created in.
+	// One of namespaceId, namespaceIdRef or namespaceIdSelector has to be
+	// supplied.
+	// +immutable
+	// +optional
+	NamespaceID *string `json:"namespaceId,omitempty"`
+
+	// NamespaceIDRef references a PrivateDNSNamespace to retrieve its ID.
+	// +optional
+	NamespaceIDRef *xpv1.Reference `json:"namespaceIdRef,omitempty"`
+
+	// NamespaceIDSelector selects a reference to a PrivateDNSNamespace to
+	// retrieve its ID.
+	// +optional
+	NamespaceIDSelector *xpv1.Selector `json:"namespaceIdSelector,omitempty"`
+
+	// Description of the service.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DNSConfig holds the DNS records created for each registered instance.
+	// Only the TTLs can be changed after creation. Omit it for services in
+	// an HTTP namespace.
+	// +optional
+	DNSConfig *ServiceDNSConfig `json:"dnsConfig,omitempty"`
+
+	// HealthCheckConfig configures a Route 53 health check. It can only be
+	// used in public DNS namespaces.
+	// +optional
+	HealthCheckConfig *ServiceHealthCheckConfig `json:"healthCheckConfig,omitempty"`
+
+	// HealthCheckCustomConfig configures a custom health check. It cannot be
+	// changed after creation.
+	// +immutable
+	// +optional
+	HealthCheckCustomConfig *ServiceHealthCheckCustomConfig `json:"healthCheckCustomConfig,omitempty"`
+
+	// Tags to add to the service.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ServiceObservation keeps the state for the external resource
+type ServiceObservation struct {
+	// ARN of the service.
+	ARN string `json:"arn,omitempty"`
+
+	// ID of the service.
+	ID string `json:"id,omitempty"`
+
+	// InstanceCount is the number of instances registered with the service.
+	InstanceCount int64 `json:"instanceCount,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ServiceSpec defines the desired state of a Service.
+type ServiceSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ServiceParameters `json:"forProvider"`
+}
+
+// A ServiceStatus represents the observed state of a Service.
+type ServiceStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ServiceObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Service is a managed resource that represents an AWS Cloud Map service,
+// which workloads register instances with so that they can be discovered.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Service struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ServiceSpec   `json:"spec"`
+	Status ServiceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ServiceList contains a list of Services
+type ServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Service `json:"items"`
+}
+
+// Service type metadata.
+var (
+	ServiceKind             = "Service"
+	ServiceGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: ServiceKind}.String()
+	ServiceKindAPIVersion   = ServiceKind + "." + GroupVersion.String()
+	ServiceGroupVersionKind = GroupVersion.WithKind(ServiceKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Service{}, &ServiceList{})
+}
@@ -53,6 +53,8 @@ type PrivateDNSNamespaceSpec struct {
 
 // PrivateDNSNamespaceObservation defines the observed state of PrivateDNSNamespace
 type PrivateDNSNamespaceObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// A value that you can use to determine whether the request completed successfully.
 	// To get the status of the operation, see GetOperation (https://docs.aws.amazon.com/cloud-map/latest/api/API_GetOperation.html).
 	OperationID *string `json:"operationID,omitempty"`
@@ -51,6 +51,8 @@ type PublicDNSNamespaceSpec struct {
 
 // PublicDNSNamespaceObservation defines the observed state of PublicDNSNamespace
 type PublicDNSNamespaceObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// A value that you can use to determine whether the request completed successfully.
 	// To get the status of the operation, see GetOperation (https://docs.aws.amazon.com/cloud-map/latest/api/API_GetOperation.html).
 	OperationID *string `json:"operationID,omitempty"`
@@ -167,7 +167,7 @@ type SOAChange struct {
 }
 
 // +kubebuilder:skipversion
-type Service struct {
+type Service_SDK struct {
 	CreatorRequestID *string `json:"creatorRequestID,omitempty"`
 
 	Description *string `json:"description,omitempty"`
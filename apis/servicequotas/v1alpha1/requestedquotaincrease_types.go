@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// RequestedQuotaIncreaseParameters define the desired increase of an AWS
+// service quota. A request cannot be changed once it was made, so all of
+// its parameters are immutable.
+type RequestedQuotaIncreaseParameters struct {
+	// Region is the region whose quota is increased.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// ServiceCode identifies the service of the quota, e.g. ec2 or vpc.
+	// +immutable
+	ServiceCode string `json:"serviceCode"`
+
+	// QuotaCode identifies the quota, e.g. L-F678F1CE for the number of VPCs
+	// per region.
+	// +immutable
+	QuotaCode string `json:"quotaCode"`
+
+	// DesiredValue is the value the quota is requested to be increased to.
+	// +immutable
+	DesiredValue float64 `json:"desiredValue"`
+}
+
+// RequestedQuotaIncreaseObservation is the observed state of a request to
+// increase an AWS service quota.
+type RequestedQuotaIncreaseObservation struct {
+	// Status of the request, i.e. PENDING, CASE_OPENED, APPROVED, DENIED or
+	// CASE_CLOSED.
+	Status string `json:"status,omitempty"`
+
+	// CaseID is the ID of the AWS Support case opened for the request, if
+	// any.
+	CaseID string `json:"caseId,omitempty"`
+
+	// QuotaName is the name of the quota.
+	QuotaName string `json:"quotaName,omitempty"`
+
+	// QuotaARN is the ARN of the quota.
+	QuotaARN string `json:"quotaArn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A RequestedQuotaIncreaseSpec defines the desired state of a
+// RequestedQuotaIncrease.
+type RequestedQuotaIncreaseSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RequestedQuotaIncreaseParameters `json:"forProvider"`
+}
+
+// A RequestedQuotaIncreaseStatus represents the observed state of a
+// RequestedQuotaIncrease.
+type RequestedQuotaIncreaseStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RequestedQuotaIncreaseObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RequestedQuotaIncrease is a managed resource that represents a request to
+// increase an AWS service quota. Its external name is the ID of the request.
+// It becomes ready once the request was approved. Requests cannot be
+// withdrawn, so deleting a RequestedQuotaIncrease leaves its request alone.
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.forProvider.serviceCode"
+// +kubebuilder:printcolumn:name="QUOTA",type="string",JSONPath=".spec.forProvider.quotaCode"
+// +kubebuilder:printcolumn:name="VALUE",type="number",JSONPath=".spec.forProvider.desiredValue"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RequestedQuotaIncrease struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RequestedQuotaIncreaseSpec   `json:"spec"`
+	Status RequestedQuotaIncreaseStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RequestedQuotaIncreaseList contains a list of RequestedQuotaIncreases
+type RequestedQuotaIncreaseList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RequestedQuotaIncrease `json:"items"`
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ConfigurationSetParameters define the desired state of an AWS SES
+// configuration set.
+type ConfigurationSetParameters struct {
+	// Region is which region the ConfigurationSet will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// ReputationMetricsEnabled publishes the reputation metrics of the mail
+	// sent with the configuration set, such as its bounce and complaint
+	// rates, to Amazon CloudWatch.
+	// +optional
+	ReputationMetricsEnabled *bool `json:"reputationMetricsEnabled,omitempty"`
+
+	// SendingEnabled allows mail to be sent with the configuration set.
+	// Defaults to true.
+	// +optional
+	SendingEnabled *bool `json:"sendingEnabled,omitempty"`
+
+	// TLSPolicy specifies whether mail sent with the configuration set is
+	// only delivered if a TLS connection can be established. Defaults to
+	// Optional.
+	// +kubebuilder:validation:Enum=Require;Optional
+	// +optional
+	TLSPolicy *string `json:"tlsPolicy,omitempty"`
+}
+
+// ConfigurationSetObservation keeps the state for the external resource
+type ConfigurationSetObservation struct {
+	// LastFreshStart is when the reputation metrics of the configuration set
+	// were last reset.
+	LastFreshStart *metav1.Time `json:"lastFreshStart,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ConfigurationSetSpec defines the desired state of a ConfigurationSet.
+type ConfigurationSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ConfigurationSetParameters `json:"forProvider"`
+}
+
+// A ConfigurationSetStatus represents the observed state of a
+// ConfigurationSet.
+type ConfigurationSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ConfigurationSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ConfigurationSet is a managed resource that represents an AWS SES
+// configuration set.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ConfigurationSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConfigurationSetSpec   `json:"spec"`
+	Status ConfigurationSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ConfigurationSetList contains a list of ConfigurationSets
+type ConfigurationSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ConfigurationSet `json:"items"`
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// DomainIdentityParameters define the desired state of an AWS SES domain
+// identity.
+type DomainIdentityParameters struct {
+	// Region is which region the identity is verified in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Domain to verify.
+	// +immutable
+	Domain string `json:"domain"`
+
+	// DKIMEnabled enables Easy DKIM, which signs the mail sent from the
+	// domain with DKIM tokens generated by SES.
+	// +optional
+	DKIMEnabled *bool `json:"dkimEnabled,omitempty"`
+
+	// HostedZoneID of the Route53 hosted zone that the TXT record verifying
+	// the domain and the CNAME records of its DKIM tokens are maintained in.
+	// The records are not managed if omitted.
+	// +optional
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+}
+
+// DomainIdentityObservation keeps the state for the external resource
+type DomainIdentityObservation struct {
+	// VerificationStatus is the state of the verification of the domain,
+	// for example Pending or Success.
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+
+	// VerificationToken is the value of the TXT record named
+	// _amazonses.<domain> that verifies the domain.
+	VerificationToken string `json:"verificationToken,omitempty"`
+
+	// DKIMEnabled is true if mail sent from the domain is signed with DKIM.
+	DKIMEnabled bool `json:"dkimEnabled,omitempty"`
+
+	// DKIMVerificationStatus is the state of the verification of the DKIM
+	// tokens of the domain, for example Pending or Success.
+	DKIMVerificationStatus string `json:"dkimVerificationStatus,omitempty"`
+
+	// DKIMTokens of the domain. Each is verified by a CNAME record named
+	// <token>._domainkey.<domain> whose value is <token>.dkim.amazonses.com.
+	DKIMTokens []string `json:"dkimTokens,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A DomainIdentitySpec defines the desired state of a DomainIdentity.
+type DomainIdentitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       DomainIdentityParameters `json:"forProvider"`
+}
+
+// A DomainIdentityStatus represents the observed state of a DomainIdentity.
+type DomainIdentityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          DomainIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A DomainIdentity is a managed resource that represents a domain verified
+// with AWS SES. It becomes ready once the domain is verified.
+// +kubebuilder:printcolumn:name="DOMAIN",type="string",JSONPath=".spec.forProvider.domain"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.verificationStatus"
+// +kubebuilder:printcolumn:name="DKIM",type="string",JSONPath=".status.atProvider.dkimVerificationStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type DomainIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DomainIdentitySpec   `json:"spec"`
+	Status DomainIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DomainIdentityList contains a list of DomainIdentities
+type DomainIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DomainIdentity `json:"items"`
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// EmailIdentityParameters define the desired state of an AWS SES email
+// address identity.
+type EmailIdentityParameters struct {
+	// Region is which region the identity is verified in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// EmailAddress to verify. SES sends a verification email to it, and the
+	// identity is verified once its recipient follows the link it contains.
+	// +immutable
+	EmailAddress string `json:"emailAddress"`
+}
+
+// EmailIdentityObservation keeps the state for the external resource
+type EmailIdentityObservation struct {
+	// VerificationStatus is the state of the verification of the email
+	// address, for example Pending or Success.
+	VerificationStatus string `json:"verificationStatus,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An EmailIdentitySpec defines the desired state of an EmailIdentity.
+type EmailIdentitySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       EmailIdentityParameters `json:"forProvider"`
+}
+
+// An EmailIdentityStatus represents the observed state of an EmailIdentity.
+type EmailIdentityStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          EmailIdentityObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EmailIdentity is a managed resource that represents an email address
+// verified with AWS SES. It becomes ready once the email address is
+// verified. A new verification email is sent if the previous one expired.
+// +kubebuilder:printcolumn:name="EMAIL",type="string",JSONPath=".spec.forProvider.emailAddress"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.verificationStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type EmailIdentity struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EmailIdentitySpec   `json:"spec"`
+	Status EmailIdentityStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EmailIdentityList contains a list of EmailIdentities
+type EmailIdentityList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EmailIdentity `json:"items"`
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// MailFromDomainParameters define the desired custom MAIL FROM domain of a
+// verified AWS SES identity.
+type MailFromDomainParameters struct {
+	// Region is which region the identity is verified in.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Identity is the verified domain or email address that uses the MAIL
+	// FROM domain.
+	// +immutable
+	Identity string `json:"identity"`
+
+	// MailFromDomain is the custom MAIL FROM domain, which must be a
+	// subdomain of the verified identity.
+	MailFromDomain string `json:"mailFromDomain"`
+
+	// BehaviorOnMXFailure is the action taken if the MX record of the MAIL
+	// FROM domain is not found.
+	// +kubebuilder:validation:Enum=UseDefaultValue;RejectMessage
+	// +optional
+	BehaviorOnMXFailure *string `json:"behaviorOnMXFailure,omitempty"`
+
+	// HostedZoneID of the Route53 hosted zone that the MX and SPF records of
+	// the MAIL FROM domain are maintained in. The records are not managed if
+	// omitted.
+	// +optional
+	HostedZoneID *string `json:"hostedZoneId,omitempty"`
+
+	// HostedZoneIDRef references a HostedZone to retrieve its ID.
+	// +optional
+	HostedZoneIDRef *xpv1.Reference `json:"hostedZoneIdRef,omitempty"`
+
+	// HostedZoneIDSelector selects a reference to a HostedZone to retrieve
+	// its ID.
+	// +optional
+	HostedZoneIDSelector *xpv1.Selector `json:"hostedZoneIdSelector,omitempty"`
+}
+
+// MailFromDomainObservation keeps the state for the external resource
+type MailFromDomainObservation struct {
+	// MailFromDomainStatus is the state of the verification of the MX record
+	// of the MAIL FROM domain.
+	MailFromDomainStatus string `json:"mailFromDomainStatus,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A MailFromDomainSpec defines the desired state of a MailFromDomain.
+type MailFromDomainSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MailFromDomainParameters `json:"forProvider"`
+}
+
+// A MailFromDomainStatus represents the observed state of a MailFromDomain.
+type MailFromDomainStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MailFromDomainObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MailFromDomain is a managed resource that represents the custom MAIL
+// FROM domain of an AWS SES identity.
+// +kubebuilder:printcolumn:name="IDENTITY",type="string",JSONPath=".spec.forProvider.identity"
+// +kubebuilder:printcolumn:name="MAILFROMDOMAIN",type="string",JSONPath=".spec.forProvider.mailFromDomain"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.atProvider.mailFromDomainStatus"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type MailFromDomain struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MailFromDomainSpec   `json:"spec"`
+	Status MailFromDomainStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MailFromDomainList contains a list of MailFromDomains
+type MailFromDomainList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MailFromDomain `json:"items"`
+}
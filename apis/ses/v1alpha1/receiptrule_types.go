@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReceiptRuleParameters define the desired state of an AWS SES receipt rule.
+type ReceiptRuleParameters struct {
+	// Region is which region the ReceiptRule will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// RuleSetName is the name of the receipt rule set the rule belongs to.
+	// +immutable
+	// +optional
+	RuleSetName *string `json:"ruleSetName,omitempty"`
+
+	// RuleSetNameRef references a ReceiptRuleSet to retrieve its name.
+	// +optional
+	RuleSetNameRef *xpv1.Reference `json:"ruleSetNameRef,omitempty"`
+
+	// RuleSetNameSelector selects a reference to a ReceiptRuleSet to retrieve
+	// its name.
+	// +optional
+	RuleSetNameSelector *xpv1.Selector `json:"ruleSetNameSelector,omitempty"`
+
+	// After is the name of an existing rule after which the rule is placed.
+	// The rule is placed at the beginning of the rule set if omitted.
+	// +optional
+	After *string `json:"after,omitempty"`
+
+	// Enabled rules process incoming mail.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Recipients are the email addresses and domains that the rule applies
+	// to. The rule applies to all recipients of verified identities if
+	// omitted.
+	// +optional
+	Recipients []string `json:"recipients,omitempty"`
+
+	// ScanEnabled enables spam and virus scanning of incoming mail.
+	// +optional
+	ScanEnabled *bool `json:"scanEnabled,omitempty"`
+
+	// TLSPolicy specifies whether incoming mail must be received over TLS.
+	// +kubebuilder:validation:Enum=Require;Optional
+	// +optional
+	TLSPolicy *string `json:"tlsPolicy,omitempty"`
+
+	// Actions are performed in order for each incoming mail the rule
+	// applies to.
+	// +optional
+	Actions []ReceiptAction `json:"actions,omitempty"`
+}
+
+// ReceiptAction is an action taken for incoming mail. Exactly one of its
+// fields must be specified.
+type ReceiptAction struct {
+	// S3Action saves the mail to an S3 bucket.
+	// +optional
+	S3Action *S3Action `json:"s3Action,omitempty"`
+
+	// LambdaAction calls a Lambda function.
+	// +optional
+	LambdaAction *LambdaAction `json:"lambdaAction,omitempty"`
+
+	// StopAction terminates the evaluation of the receipt rule set.
+	// +optional
+	StopAction *StopAction `json:"stopAction,omitempty"`
+}
+
+// S3Action saves incoming mail to an S3 bucket.
+type S3Action struct {
+	// BucketName is the name of the bucket the mail is saved to.
+	// +optional
+	BucketName *string `json:"bucketName,omitempty"`
+
+	// BucketNameRef references a Bucket to retrieve its name.
+	// +optional
+	BucketNameRef *xpv1.Reference `json:"bucketNameRef,omitempty"`
+
+	// BucketNameSelector selects a reference to a Bucket to retrieve its
+	// name.
+	// +optional
+	BucketNameSelector *xpv1.Selector `json:"bucketNameSelector,omitempty"`
+
+	// ObjectKeyPrefix is prepended to the key of the saved mail.
+	// +optional
+	ObjectKeyPrefix *string `json:"objectKeyPrefix,omitempty"`
+
+	// KMSKeyARN of the key used to encrypt the mail before it is saved.
+	// +optional
+	KMSKeyARN *string `json:"kmsKeyArn,omitempty"`
+
+	// TopicARN of the SNS topic that is notified when the mail is saved.
+	// +optional
+	TopicARN *string `json:"topicArn,omitempty"`
+}
+
+// LambdaAction calls a Lambda function for incoming mail.
+type LambdaAction struct {
+	// FunctionARN of the Lambda function that is called.
+	// +optional
+	FunctionARN *string `json:"functionArn,omitempty"`
+
+	// FunctionARNRef references a Function to retrieve its ARN.
+	// +optional
+	FunctionARNRef *xpv1.Reference `json:"functionArnRef,omitempty"`
+
+	// FunctionARNSelector selects a reference to a Function to retrieve its
+	// ARN.
+	// +optional
+	FunctionARNSelector *xpv1.Selector `json:"functionArnSelector,omitempty"`
+
+	// InvocationType of the function. RequestResponse lets the function
+	// control the mail flow, but stops the rule set after 30 seconds.
+	// +kubebuilder:validation:Enum=Event;RequestResponse
+	// +optional
+	InvocationType *string `json:"invocationType,omitempty"`
+
+	// TopicARN of the SNS topic that is notified when the function is
+	// called.
+	// +optional
+	TopicARN *string `json:"topicArn,omitempty"`
+}
+
+// StopAction terminates the evaluation of the receipt rule set.
+type StopAction struct {
+	// Scope of the stop action. The only valid value is RuleSet.
+	// +kubebuilder:validation:Enum=RuleSet
+	Scope string `json:"scope"`
+
+	// TopicARN of the SNS topic that is notified when the stop action is
+	// taken.
+	// +optional
+	TopicARN *string `json:"topicArn,omitempty"`
+}
+
+// A ReceiptRuleSpec defines the desired state of a ReceiptRule.
+type ReceiptRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReceiptRuleParameters `json:"forProvider"`
+}
+
+// ReceiptRuleObservation keeps the state for the external resource.
+type ReceiptRuleObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ReceiptRuleStatus represents the observed state of a ReceiptRule.
+type ReceiptRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReceiptRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReceiptRule is a managed resource that represents an AWS SES receipt
+// rule.
+// +kubebuilder:printcolumn:name="RULESET",type="string",JSONPath=".spec.forProvider.ruleSetName"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReceiptRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReceiptRuleSpec   `json:"spec"`
+	Status ReceiptRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReceiptRuleList contains a list of ReceiptRules
+type ReceiptRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReceiptRule `json:"items"`
+}
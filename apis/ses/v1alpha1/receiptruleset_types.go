@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReceiptRuleSetParameters define the desired state of an AWS SES receipt
+// rule set.
+type ReceiptRuleSetParameters struct {
+	// Region is which region the ReceiptRuleSet will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Active makes this the active receipt rule set of the account in its
+	// region. Only one receipt rule set can be active at a time.
+	// +optional
+	Active *bool `json:"active,omitempty"`
+}
+
+// ReceiptRuleSetObservation keeps the state for the external resource
+type ReceiptRuleSetObservation struct {
+	// Active is true if this is the active receipt rule set.
+	Active bool `json:"active,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A ReceiptRuleSetSpec defines the desired state of a ReceiptRuleSet.
+type ReceiptRuleSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ReceiptRuleSetParameters `json:"forProvider"`
+}
+
+// A ReceiptRuleSetStatus represents the observed state of a ReceiptRuleSet.
+type ReceiptRuleSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ReceiptRuleSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ReceiptRuleSet is a managed resource that represents an AWS SES receipt
+// rule set.
+// +kubebuilder:printcolumn:name="ACTIVE",type="boolean",JSONPath=".status.atProvider.active"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ReceiptRuleSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ReceiptRuleSetSpec   `json:"spec"`
+	Status ReceiptRuleSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReceiptRuleSetList contains a list of ReceiptRuleSets
+type ReceiptRuleSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReceiptRuleSet `json:"items"`
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	s3v1beta1 "github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+// ResolveReferences of this ReceiptRule
+func (mg *ReceiptRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.ruleSetName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RuleSetName),
+		Reference:    mg.Spec.ForProvider.RuleSetNameRef,
+		Selector:     mg.Spec.ForProvider.RuleSetNameSelector,
+		To:           reference.To{Managed: &ReceiptRuleSet{}, List: &ReceiptRuleSetList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.ruleSetName")
+	}
+	mg.Spec.ForProvider.RuleSetName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RuleSetNameRef = rsp.ResolvedReference
+
+	for i := range mg.Spec.ForProvider.Actions {
+		a := &mg.Spec.ForProvider.Actions[i]
+		if a.S3Action != nil {
+			// Resolve spec.forProvider.actions[i].s3Action.bucketName
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.S3Action.BucketName),
+				Reference:    a.S3Action.BucketNameRef,
+				Selector:     a.S3Action.BucketNameSelector,
+				To:           reference.To{Managed: &s3v1beta1.Bucket{}, List: &s3v1beta1.BucketList{}},
+				Extract:      reference.ExternalName(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.actions[%d].s3Action.bucketName", i))
+			}
+			a.S3Action.BucketName = reference.ToPtrValue(rsp.ResolvedValue)
+			a.S3Action.BucketNameRef = rsp.ResolvedReference
+		}
+		if a.LambdaAction != nil {
+			// Resolve spec.forProvider.actions[i].lambdaAction.functionArn
+			rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: reference.FromPtrValue(a.LambdaAction.FunctionARN),
+				Reference:    a.LambdaAction.FunctionARNRef,
+				Selector:     a.LambdaAction.FunctionARNSelector,
+				To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+				Extract:      lambdav1beta1.FunctionARN(),
+			})
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("spec.forProvider.actions[%d].lambdaAction.functionArn", i))
+			}
+			a.LambdaAction.FunctionARN = reference.ToPtrValue(rsp.ResolvedValue)
+			a.LambdaAction.FunctionARNRef = rsp.ResolvedReference
+		}
+	}
+	return nil
+}
+
+// ResolveReferences of this MailFromDomain
+func (mg *MailFromDomain) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.hostedZoneId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HostedZoneID),
+		Reference:    mg.Spec.ForProvider.HostedZoneIDRef,
+		Selector:     mg.Spec.ForProvider.HostedZoneIDSelector,
+		To:           reference.To{Managed: &route53v1alpha1.HostedZone{}, List: &route53v1alpha1.HostedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.hostedZoneId")
+	}
+	mg.Spec.ForProvider.HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HostedZoneIDRef = rsp.ResolvedReference
+	return nil
+}
+
+// ResolveReferences of this DomainIdentity
+func (mg *DomainIdentity) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.hostedZoneId
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.HostedZoneID),
+		Reference:    mg.Spec.ForProvider.HostedZoneIDRef,
+		Selector:     mg.Spec.ForProvider.HostedZoneIDSelector,
+		To:           reference.To{Managed: &route53v1alpha1.HostedZone{}, List: &route53v1alpha1.HostedZoneList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.hostedZoneId")
+	}
+	mg.Spec.ForProvider.HostedZoneID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.HostedZoneIDRef = rsp.ResolvedReference
+	return nil
+}
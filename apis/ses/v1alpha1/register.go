@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "ses.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// ReceiptRuleSet type metadata.
+var (
+	ReceiptRuleSetKind             = reflect.TypeOf(ReceiptRuleSet{}).Name()
+	ReceiptRuleSetGroupKind        = schema.GroupKind{Group: Group, Kind: ReceiptRuleSetKind}.String()
+	ReceiptRuleSetKindAPIVersion   = ReceiptRuleSetKind + "." + SchemeGroupVersion.String()
+	ReceiptRuleSetGroupVersionKind = SchemeGroupVersion.WithKind(ReceiptRuleSetKind)
+)
+
+// ReceiptRule type metadata.
+var (
+	ReceiptRuleKind             = reflect.TypeOf(ReceiptRule{}).Name()
+	ReceiptRuleGroupKind        = schema.GroupKind{Group: Group, Kind: ReceiptRuleKind}.String()
+	ReceiptRuleKindAPIVersion   = ReceiptRuleKind + "." + SchemeGroupVersion.String()
+	ReceiptRuleGroupVersionKind = SchemeGroupVersion.WithKind(ReceiptRuleKind)
+)
+
+// MailFromDomain type metadata.
+var (
+	MailFromDomainKind             = reflect.TypeOf(MailFromDomain{}).Name()
+	MailFromDomainGroupKind        = schema.GroupKind{Group: Group, Kind: MailFromDomainKind}.String()
+	MailFromDomainKindAPIVersion   = MailFromDomainKind + "." + SchemeGroupVersion.String()
+	MailFromDomainGroupVersionKind = SchemeGroupVersion.WithKind(MailFromDomainKind)
+)
+
+// EmailIdentity type metadata.
+var (
+	EmailIdentityKind             = reflect.TypeOf(EmailIdentity{}).Name()
+	EmailIdentityGroupKind        = schema.GroupKind{Group: Group, Kind: EmailIdentityKind}.String()
+	EmailIdentityKindAPIVersion   = EmailIdentityKind + "." + SchemeGroupVersion.String()
+	EmailIdentityGroupVersionKind = SchemeGroupVersion.WithKind(EmailIdentityKind)
+)
+
+// DomainIdentity type metadata.
+var (
+	DomainIdentityKind             = reflect.TypeOf(DomainIdentity{}).Name()
+	DomainIdentityGroupKind        = schema.GroupKind{Group: Group, Kind: DomainIdentityKind}.String()
+	DomainIdentityKindAPIVersion   = DomainIdentityKind + "." + SchemeGroupVersion.String()
+	DomainIdentityGroupVersionKind = SchemeGroupVersion.WithKind(DomainIdentityKind)
+)
+
+// ConfigurationSet type metadata.
+var (
+	ConfigurationSetKind             = reflect.TypeOf(ConfigurationSet{}).Name()
+	ConfigurationSetGroupKind        = schema.GroupKind{Group: Group, Kind: ConfigurationSetKind}.String()
+	ConfigurationSetKindAPIVersion   = ConfigurationSetKind + "." + SchemeGroupVersion.String()
+	ConfigurationSetGroupVersionKind = SchemeGroupVersion.WithKind(ConfigurationSetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ReceiptRuleSet{}, &ReceiptRuleSetList{})
+	SchemeBuilder.Register(&ReceiptRule{}, &ReceiptRuleList{})
+	SchemeBuilder.Register(&MailFromDomain{}, &MailFromDomainList{})
+	SchemeBuilder.Register(&EmailIdentity{}, &EmailIdentityList{})
+	SchemeBuilder.Register(&DomainIdentity{}, &DomainIdentityList{})
+	SchemeBuilder.Register(&ConfigurationSet{}, &ConfigurationSetList{})
+}
@@ -16,7 +16,11 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
 
 // CustomActivityParameters includes custom additional fields for ActivityParameters.
 type CustomActivityParameters struct{}
@@ -46,3 +50,8 @@ type CustomStateMachineParameters struct {
 	// +kubebuilder:validation:Enum=STANDARD;EXPRESS
 	Type StateMachineType `json:"type,omitempty"`
 }
+
+// LastOperation is the last AWS API operation the provider made to change a
+// resource. It is declared in this package so that generated types can refer
+// to it.
+type LastOperation = awsv1beta1.LastOperation
@@ -72,9 +72,11 @@ type ActivitySpec struct {
 // ActivityObservation defines the observed state of Activity
 type ActivityObservation struct {
 	// The Amazon Resource Name (ARN) that identifies the created activity.
-	ActivityARN *string `json:"activityARN,omitempty"`
+	ActivityARN     *string `json:"activityARN,omitempty"`
+	CallerAccountID string  `json:"callerAccountID,omitempty"`
 	// The date the activity is created.
-	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+	CreationDate  *metav1.Time   `json:"creationDate,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 }
 
 // ActivityStatus defines the observed state of Activity.
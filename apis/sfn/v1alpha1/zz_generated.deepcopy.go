@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -126,6 +127,11 @@ func (in *ActivityObservation) DeepCopyInto(out *ActivityObservation) {
 		in, out := &in.CreationDate, &out.CreationDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActivityObservation.
@@ -602,6 +608,11 @@ func (in *StateMachineObservation) DeepCopyInto(out *StateMachineObservation) {
 		in, out := &in.CreationDate, &out.CreationDate
 		*out = (*in).DeepCopy()
 	}
+	if in.LastOperation != nil {
+		in, out := &in.LastOperation, &out.LastOperation
+		*out = new(v1beta1.LastOperation)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StateMachineARN != nil {
 		in, out := &in.StateMachineARN, &out.StateMachineARN
 		*out = new(string)
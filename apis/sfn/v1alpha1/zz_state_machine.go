@@ -80,8 +80,10 @@ type StateMachineSpec struct {
 
 // StateMachineObservation defines the observed state of StateMachine
 type StateMachineObservation struct {
+	CallerAccountID string `json:"callerAccountID,omitempty"`
 	// The date the state machine is created.
-	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+	CreationDate  *metav1.Time   `json:"creationDate,omitempty"`
+	LastOperation *LastOperation `json:"lastOperation,omitempty"`
 	// The Amazon Resource Name (ARN) that identifies the created state machine.
 	StateMachineARN *string `json:"stateMachineARN,omitempty"`
 }
@@ -128,6 +128,8 @@ type ServerSpec struct {
 
 // ServerObservation defines the observed state of Server
 type ServerObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The service-assigned ID of the server that is created.
 	ServerID *string `json:"serverID,omitempty"`
 }
@@ -110,6 +110,8 @@ type UserSpec struct {
 
 // UserObservation defines the observed state of User
 type UserObservation struct {
+	CallerAccountID string         `json:"callerAccountID,omitempty"`
+	LastOperation   *LastOperation `json:"lastOperation,omitempty"`
 	// The ID of the server that the user is attached to.
 	ServerID *string `json:"serverID,omitempty"`
 	// A unique string that identifies a user account associated with a server.
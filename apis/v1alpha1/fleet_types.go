@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Labels set on the managed resources stamped out by a Fleet.
+const (
+	// LabelKeyFleet is the name of the Fleet a managed resource belongs to.
+	LabelKeyFleet = "aws.crossplane.io/fleet"
+
+	// LabelKeyFleetProviderConfig is the name of the ProviderConfig a Fleet
+	// member has been stamped out for.
+	LabelKeyFleetProviderConfig = "aws.crossplane.io/fleet-provider-config"
+)
+
+// AnnotationKeyFleetTemplate of the managed resources stamped out by a Fleet
+// records the labels, annotations and spec last stamped out from the
+// template, so that fields removed from the template are removed from the
+// managed resources too.
+const AnnotationKeyFleetTemplate = "aws.crossplane.io/fleet-template"
+
+// A FleetSpec defines the desired state of a Fleet.
+type FleetSpec struct {
+	// ProviderConfigSelector selects the ProviderConfigs the template is
+	// stamped out for. One managed resource is created per matching
+	// ProviderConfig.
+	ProviderConfigSelector metav1.LabelSelector `json:"providerConfigSelector"`
+
+	// Template of the managed resource to stamp out. It must contain the
+	// apiVersion, kind and spec of a managed resource of this provider. The
+	// providerConfigRef of the template is overridden for every member, and
+	// the name of its writeConnectionSecretToRef is suffixed with the name of
+	// the ProviderConfig, like the names of the members.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:EmbeddedResource
+	Template runtime.RawExtension `json:"template"`
+}
+
+// A FleetMember is a managed resource stamped out by a Fleet.
+type FleetMember struct {
+	// ProviderConfigName is the name of the ProviderConfig the member uses.
+	ProviderConfigName string `json:"providerConfigName"`
+
+	// ResourceRef references the managed resource of the member.
+	ResourceRef xpv1.TypedReference `json:"resourceRef"`
+
+	// Ready indicates whether the managed resource of the member is ready.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+}
+
+// A FleetStatus represents the observed state of a Fleet.
+type FleetStatus struct {
+	xpv1.ConditionedStatus `json:",inline"`
+
+	// Members are the managed resources stamped out by this Fleet.
+	// +optional
+	Members []FleetMember `json:"members,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Fleet stamps out the same managed resource for every ProviderConfig that
+// matches a label selector, e.g. to create baseline resources in every
+// account of an organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="KIND",type="string",JSONPath=".spec.template.kind"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:resource:path=fleets,scope=Cluster,categories={crossplane,aws}
+// +kubebuilder:subresource:status
+type Fleet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FleetSpec   `json:"spec"`
+	Status FleetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FleetList contains a list of Fleet
+type FleetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Fleet `json:"items"`
+}
+
+// GetCondition of this Fleet.
+func (in *Fleet) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
+	return in.Status.GetCondition(ct)
+}
+
+// SetConditions of this Fleet.
+func (in *Fleet) SetConditions(c ...xpv1.Condition) {
+	in.Status.SetConditions(c...)
+}
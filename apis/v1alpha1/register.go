@@ -45,6 +45,15 @@ var (
 	StoreConfigGroupVersionKind = SchemeGroupVersion.WithKind(StoreConfigKind)
 )
 
+// Fleet type metadata.
+var (
+	FleetKind             = reflect.TypeOf(Fleet{}).Name()
+	FleetGroupKind        = schema.GroupKind{Group: Group, Kind: FleetKind}.String()
+	FleetKindAPIVersion   = FleetKind + "." + SchemeGroupVersion.String()
+	FleetGroupVersionKind = SchemeGroupVersion.WithKind(FleetKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&StoreConfig{}, &StoreConfigList{})
+	SchemeBuilder.Register(&Fleet{}, &FleetList{})
 }
@@ -25,6 +25,51 @@ import (
 // A StoreConfigSpec defines the desired state of a ProviderConfig.
 type StoreConfigSpec struct {
 	xpv1.SecretStoreConfig `json:",inline"`
+
+	// AWS configures a secret store in AWS Secrets Manager or SSM Parameter
+	// Store. The store configured by type is ignored if this is set.
+	// +optional
+	AWS *AWSSecretStoreConfig `json:"aws,omitempty"`
+}
+
+// An AWSSecretStoreService is an AWS service that stores connection details.
+type AWSSecretStoreService string
+
+// AWS services that store connection details.
+const (
+	// AWSSecretStoreSecretsManager stores each connection secret as a
+	// Secrets Manager secret.
+	AWSSecretStoreSecretsManager AWSSecretStoreService = "SecretsManager"
+
+	// AWSSecretStoreParameterStore stores each connection secret as an SSM
+	// Parameter Store SecureString parameter.
+	AWSSecretStoreParameterStore AWSSecretStoreService = "ParameterStore"
+)
+
+// AWSSecretStoreConfig represents the required configuration for a secret
+// store in AWS. Connection details are stored as a JSON object, named after
+// the connection secret and scoped by the default scope.
+type AWSSecretStoreConfig struct {
+	// Service that stores connection details.
+	// +optional
+	// +kubebuilder:validation:Enum=SecretsManager;ParameterStore
+	// +kubebuilder:default=SecretsManager
+	Service AWSSecretStoreService `json:"service,omitempty"`
+
+	// Region of the secret store.
+	Region string `json:"region"`
+
+	// ProviderConfigReference specifies the ProviderConfig whose credentials
+	// are used to access the secret store.
+	// +optional
+	// +kubebuilder:default={"name": "default"}
+	ProviderConfigReference *xpv1.Reference `json:"providerConfigRef,omitempty"`
+
+	// KMSKeyID is the ID, ARN or alias of the KMS key that encrypts
+	// connection details. The AWS managed key of the service is used if
+	// omitted.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyId,omitempty"`
 }
 
 // A StoreConfigStatus represents the status of a StoreConfig.
@@ -64,6 +109,11 @@ func (in *StoreConfig) GetStoreConfig() xpv1.SecretStoreConfig {
 	return in.Spec.SecretStoreConfig
 }
 
+// GetAWSStoreConfig returns the AWSSecretStoreConfig, if any.
+func (in *StoreConfig) GetAWSStoreConfig() *AWSSecretStoreConfig {
+	return in.Spec.AWS
+}
+
 // GetCondition of this StoreConfig.
 func (in *StoreConfig) GetCondition(ct xpv1.ConditionType) xpv1.Condition {
 	return in.Status.GetCondition(ct)
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// An OperationResult is the result of an AWS API operation.
+type OperationResult string
+
+// Results of AWS API operations.
+const (
+	OperationSucceeded OperationResult = "Succeeded"
+	OperationFailed    OperationResult = "Failed"
+)
+
+// LastOperation is the last AWS API operation the provider made to change
+// the external resource. Its request ID matches the requestID of the
+// corresponding CloudTrail event.
+type LastOperation struct {
+	// Operation is the name of the AWS API operation, e.g. CreateQueue.
+	Operation string `json:"operation"`
+
+	// RequestID is the ID AWS assigned to the request.
+	// +optional
+	RequestID string `json:"requestID,omitempty"`
+
+	// Time at which the operation completed.
+	Time metav1.Time `json:"time"`
+
+	// Result of the operation.
+	// +kubebuilder:validation:Enum=Succeeded;Failed
+	Result OperationResult `json:"result"`
+
+	// ErrorCode is the error code AWS returned if the operation failed.
+	// +optional
+	ErrorCode string `json:"errorCode,omitempty"`
+}
@@ -44,15 +44,103 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// STS configures the AWS STS endpoint the provider assumes roles and
+	// exchanges web identity tokens with.
+	// +optional
+	STS *STSConfig `json:"sts,omitempty"`
+
+	// IAM configures guardrails that are enforced on the IAM Roles, Users
+	// and Policies created with this ProviderConfig.
+	// +optional
+	IAM *IAMGuardrails `json:"iam,omitempty"`
+}
+
+// IAMGuardrails are enforced on the IAM resources created with a
+// ProviderConfig, so that organization guardrails need not be repeated in
+// every managed resource. They are not applied to resources that were
+// created before they were configured.
+type IAMGuardrails struct {
+	// PermissionsBoundary is the ARN of the managed policy that is set as
+	// the permissions boundary of Roles and Users that don't specify one.
+	// Roles and Users that specify a different permissions boundary are not
+	// created.
+	// +optional
+	PermissionsBoundary *string `json:"permissionsBoundary,omitempty"`
+
+	// PathPrefix is the path Roles, Users and Policies that don't specify
+	// one are created at. Those whose path doesn't start with it are not
+	// created. It must begin and end with a slash, e.g. /platform/.
+	// +kubebuilder:validation:Pattern=`^/(.*/)?$`
+	// +optional
+	PathPrefix *string `json:"pathPrefix,omitempty"`
 }
 
+// STS endpoint modes.
+const (
+	// STSEndpointsRegional calls the STS endpoint of a region, e.g.
+	// sts.eu-west-1.amazonaws.com.
+	STSEndpointsRegional = "Regional"
+
+	// STSEndpointsLegacy calls the global STS endpoint sts.amazonaws.com,
+	// which is served from us-east-1 only.
+	STSEndpointsLegacy = "Legacy"
+)
+
+// STSConfig configures the AWS STS endpoint the provider calls.
+type STSConfig struct {
+	// Endpoints is Regional to call the STS endpoint of a region, or Legacy
+	// to call the global STS endpoint. Regional endpoints have lower latency
+	// and keep working if us-east-1 is impaired. Defaults to Regional.
+	// +kubebuilder:validation:Enum=Regional;Legacy
+	// +optional
+	Endpoints *string `json:"endpoints,omitempty"`
+
+	// Region of the regional STS endpoint. Defaults to the region of the
+	// managed resource being reconciled, or us-east-1 for managed resources
+	// of global services like IAM.
+	// +optional
+	Region *string `json:"region,omitempty"`
+}
+
+// CredentialsSourceSSO indicates that the provider should get temporary role
+// credentials from AWS IAM Identity Center (successor to AWS SSO).
+const CredentialsSourceSSO xpv1.CredentialsSource = "SSO"
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;SSO
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// SSO configures getting temporary role credentials from AWS IAM
+	// Identity Center. Required if the source is SSO.
+	// +optional
+	SSO *SSOCredentials `json:"sso,omitempty"`
+}
+
+// SSOCredentials configure getting the credentials of a permission set role
+// from AWS IAM Identity Center (successor to AWS SSO).
+type SSOCredentials struct {
+	// AccountID of the AWS account to get role credentials for.
+	AccountID string `json:"accountID"`
+
+	// RoleName of the permission set role to get credentials for.
+	RoleName string `json:"roleName"`
+
+	// Region of the IAM Identity Center instance. Defaults to the region of
+	// the token.
+	// +optional
+	Region *string `json:"region,omitempty"`
+
+	// TokenSecretRef references a Secret key that contains an SSO token
+	// cache entry, as written by `aws sso login` to ~/.aws/sso/cache. If the
+	// entry includes the refresh token and client registration, the access
+	// token is refreshed automatically before it expires, and written back to
+	// the Secret.
+	TokenSecretRef xpv1.SecretKeySelector `json:"tokenSecretRef"`
 }
 
 // Tag is session tag that can be used to assume an IAM Role
@@ -89,6 +177,15 @@ type AssumeRoleOptions struct {
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_role-chaining).
 	// +optional
 	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// TagResourceIdentity adds the kind, name and namespace of the managed
+	// resource being reconciled and the name of this ProviderConfig to the
+	// role session as session tags, and sets the source identity of the
+	// session to the managed resource, so that CloudTrail attributes every
+	// change to a specific Kubernetes object. The trust policy of the role
+	// must allow the sts:TagSession and sts:SetSourceIdentity actions.
+	// +optional
+	TagResourceIdentity *bool `json:"tagResourceIdentity,omitempty"`
 }
 
 // EndpointConfig is used to configure the AWS client for a custom endpoint.
@@ -193,6 +290,27 @@ type DynamicURLConfig struct {
 // A ProviderConfigStatus represents the status of a ProviderConfig.
 type ProviderConfigStatus struct {
 	xpv1.ProviderConfigStatus `json:",inline"`
+
+	// Identity is the AWS principal that the credentials of this
+	// ProviderConfig resolve to, as reported by STS GetCallerIdentity.
+	// +optional
+	Identity *CallerIdentity `json:"identity,omitempty"`
+}
+
+// CallerIdentity describes the AWS principal used by a ProviderConfig.
+type CallerIdentity struct {
+	// AccountID is the ID of the AWS account that owns the principal.
+	AccountID string `json:"accountId"`
+
+	// ARN of the principal, e.g. the assumed role session.
+	ARN string `json:"arn"`
+
+	// Partition of the AWS account, e.g. aws, aws-cn or aws-us-gov.
+	Partition string `json:"partition"`
+
+	// UserID is the unique identifier of the principal.
+	// +optional
+	UserID string `json:"userId,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -200,6 +318,7 @@ type ProviderConfigStatus struct {
 // A ProviderConfig configures how AWS controllers will connect to AWS API.
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
+// +kubebuilder:printcolumn:name="ACCOUNT",type="string",JSONPath=".status.identity.accountId"
 // +kubebuilder:resource:scope=Cluster,categories={crossplane,provider,aws}
 // +kubebuilder:subresource:status
 type ProviderConfig struct {
@@ -0,0 +1,35 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// A ReadinessProbe configures a connectivity check of the endpoint of a data
+// store. When it is set, the managed resource only becomes ready once the
+// endpoint's hostname resolves and a TCP connection to it can be opened from
+// the provider pod. This surfaces security group and routing
+// misconfigurations early rather than when the first client connects.
+type ReadinessProbe struct {
+	// Port to connect to. Defaults to the port of the endpoint.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// TimeoutSeconds after which the check fails. Defaults to 5.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
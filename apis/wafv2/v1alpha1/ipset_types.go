@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Scopes of WAFv2 resources.
+const (
+	ScopeRegional   = "REGIONAL"
+	ScopeCloudFront = "CLOUDFRONT"
+)
+
+// IPSetParameters define the desired state of an AWS WAFv2 IP set.
+type IPSetParameters struct {
+	// Region is which region the IPSet will be created. IP sets of the
+	// CLOUDFRONT scope must be created in us-east-1.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the IP set.
+	// +immutable
+	Name string `json:"name"`
+
+	// Scope specifies whether the IP set is used by regional resources such
+	// as load balancers and API Gateway stages or by CloudFront
+	// distributions.
+	// +immutable
+	// +kubebuilder:validation:Enum=REGIONAL;CLOUDFRONT
+	Scope string `json:"scope"`
+
+	// Description of the IP set.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// IPAddressVersion is the IP address version of the addresses.
+	// +immutable
+	// +kubebuilder:validation:Enum=IPV4;IPV6
+	IPAddressVersion string `json:"ipAddressVersion"`
+
+	// Addresses are the IP addresses of the IP set in CIDR notation, e.g.
+	// 192.0.2.0/24.
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+
+	// Tags to attach to the IP set. Tags are only applied when the IP set is
+	// created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// IPSetObservation keeps the state for the external resource
+type IPSetObservation struct {
+	// ARN of the IP set.
+	ARN string `json:"arn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// An IPSetSpec defines the desired state of an IPSet.
+type IPSetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPSetParameters `json:"forProvider"`
+}
+
+// An IPSetStatus represents the observed state of an IPSet.
+type IPSetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IPSetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPSet is a managed resource that represents an AWS WAFv2 IP set, which
+// rule statements reference to match the IP addresses of requests.
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPSetSpec   `json:"spec"`
+	Status IPSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPSetList contains a list of IPSets
+type IPSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPSet `json:"items"`
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// VisibilityConfig defines the CloudWatch metrics and request sampling of a
+// web ACL, rule group or rule.
+type VisibilityConfig struct {
+	// CloudWatchMetricsEnabled specifies whether metrics are sent to
+	// CloudWatch.
+	CloudWatchMetricsEnabled bool `json:"cloudWatchMetricsEnabled"`
+
+	// MetricName is the name of the CloudWatch metric.
+	MetricName string `json:"metricName"`
+
+	// SampledRequestsEnabled specifies whether WAF stores a sampling of the
+	// web requests that match the rules.
+	SampledRequestsEnabled bool `json:"sampledRequestsEnabled"`
+}
+
+// RuleGroupParameters define the desired state of an AWS WAFv2 rule group.
+type RuleGroupParameters struct {
+	// Region is which region the RuleGroup will be created. Rule groups of
+	// the CLOUDFRONT scope must be created in us-east-1.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the rule group.
+	// +immutable
+	Name string `json:"name"`
+
+	// Scope specifies whether the rule group is used by regional resources
+	// or by CloudFront distributions.
+	// +immutable
+	// +kubebuilder:validation:Enum=REGIONAL;CLOUDFRONT
+	Scope string `json:"scope"`
+
+	// Capacity is the web ACL capacity units the rule group reserves. It
+	// cannot be changed once the rule group is created.
+	// +immutable
+	// +kubebuilder:validation:Minimum=1
+	Capacity int64 `json:"capacity"`
+
+	// Description of the rule group.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Rules is a JSON array of the rules of the rule group, in the format of
+	// the WAFv2 API, e.g. as printed by `aws wafv2 get-rule-group`. Blob
+	// fields such as the SearchString of a ByteMatchStatement are base64
+	// encoded. The rules are compared semantically, so formatting, key order
+	// and rule order do not matter.
+	// +optional
+	Rules string `json:"rules,omitempty"`
+
+	// VisibilityConfig of the rule group.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+
+	// Tags to attach to the rule group. Tags are only applied when the rule
+	// group is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// RuleGroupObservation keeps the state for the external resource
+type RuleGroupObservation struct {
+	// ARN of the rule group.
+	ARN string `json:"arn,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A RuleGroupSpec defines the desired state of a RuleGroup.
+type RuleGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RuleGroupParameters `json:"forProvider"`
+}
+
+// A RuleGroupStatus represents the observed state of a RuleGroup.
+type RuleGroupStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RuleGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RuleGroup is a managed resource that represents an AWS WAFv2 rule group,
+// a reusable set of rules web ACLs reference.
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type RuleGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuleGroupSpec   `json:"spec"`
+	Status RuleGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RuleGroupList contains a list of RuleGroups
+type RuleGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuleGroup `json:"items"`
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Default actions of a web ACL.
+const (
+	DefaultActionAllow = "Allow"
+	DefaultActionBlock = "Block"
+)
+
+// WebACLParameters define the desired state of an AWS WAFv2 web ACL.
+type WebACLParameters struct {
+	// Region is which region the WebACL will be created. Web ACLs of the
+	// CLOUDFRONT scope must be created in us-east-1.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// Name of the web ACL.
+	// +immutable
+	Name string `json:"name"`
+
+	// Scope specifies whether the web ACL protects regional resources such
+	// as load balancers and API Gateway stages or CloudFront distributions.
+	// +immutable
+	// +kubebuilder:validation:Enum=REGIONAL;CLOUDFRONT
+	Scope string `json:"scope"`
+
+	// Description of the web ACL.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// DefaultAction is the action taken on requests that match none of the
+	// rules.
+	// +kubebuilder:validation:Enum=Allow;Block
+	DefaultAction string `json:"defaultAction"`
+
+	// Rules is a JSON array of the rules of the web ACL, in the format of
+	// the WAFv2 API, e.g. as printed by `aws wafv2 get-web-acl`. Blob fields
+	// such as the SearchString of a ByteMatchStatement are base64 encoded.
+	// The rules are compared semantically, so formatting, key order and rule
+	// order do not matter.
+	// +optional
+	Rules string `json:"rules,omitempty"`
+
+	// VisibilityConfig of the web ACL.
+	VisibilityConfig VisibilityConfig `json:"visibilityConfig"`
+
+	// Tags to attach to the web ACL. Tags are only applied when the web ACL
+	// is created.
+	// +immutable
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// WebACLObservation keeps the state for the external resource
+type WebACLObservation struct {
+	// ARN of the web ACL.
+	ARN string `json:"arn,omitempty"`
+
+	// Capacity is the web ACL capacity units the rules of the web ACL use.
+	Capacity int64 `json:"capacity,omitempty"`
+
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A WebACLSpec defines the desired state of a WebACL.
+type WebACLSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebACLParameters `json:"forProvider"`
+}
+
+// A WebACLStatus represents the observed state of a WebACL.
+type WebACLStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebACLObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebACL is a managed resource that represents an AWS WAFv2 web ACL.
+// +kubebuilder:printcolumn:name="SCOPE",type="string",JSONPath=".spec.forProvider.scope"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebACLSpec   `json:"spec"`
+	Status WebACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLList contains a list of WebACLs
+type WebACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACL `json:"items"`
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// WebACLAssociationParameters define the desired state of an AWS WAFv2 web
+// ACL association.
+type WebACLAssociationParameters struct {
+	// Region is which region the WebACLAssociation will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// WebACLARN is the ARN of the REGIONAL web ACL to associate.
+	// +optional
+	// +crossplane:generate:reference:type=WebACL
+	// +crossplane:generate:reference:extractor=WebACLARN()
+	WebACLARN *string `json:"webAclArn,omitempty"`
+
+	// WebACLARNRef references a WebACL to retrieve its ARN.
+	// +optional
+	WebACLARNRef *xpv1.Reference `json:"webAclArnRef,omitempty"`
+
+	// WebACLARNSelector selects a reference to a WebACL to retrieve its ARN.
+	// +optional
+	WebACLARNSelector *xpv1.Selector `json:"webAclArnSelector,omitempty"`
+
+	// ResourceARN is the ARN of the resource the web ACL protects, i.e. an
+	// application load balancer, a REST API Gateway stage such as
+	// arn:aws:apigateway:us-east-1::/restapis/a1b2c3/stages/prod, an AppSync
+	// GraphQL API or a Cognito user pool. CloudFront distributions are
+	// associated with a web ACL through their webACLID instead.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane/provider-aws/apis/elbv2/v1alpha1.LoadBalancer
+	ResourceARN *string `json:"resourceArn,omitempty"`
+
+	// ResourceARNRef references a LoadBalancer to retrieve its ARN.
+	// +optional
+	ResourceARNRef *xpv1.Reference `json:"resourceArnRef,omitempty"`
+
+	// ResourceARNSelector selects a reference to a LoadBalancer to retrieve
+	// its ARN.
+	// +optional
+	ResourceARNSelector *xpv1.Selector `json:"resourceArnSelector,omitempty"`
+}
+
+// WebACLAssociationObservation keeps the state for the external resource
+type WebACLAssociationObservation struct {
+	// LastOperation is the last AWS API operation the provider made to change
+	// this resource.
+	// +optional
+	LastOperation *awsv1beta1.LastOperation `json:"lastOperation,omitempty"`
+
+	// CallerAccountID is the ID of the AWS account the resource is managed
+	// in, i.e. the account of the caller identity STS resolved for the
+	// credentials of its ProviderConfig.
+	// +optional
+	CallerAccountID string `json:"callerAccountID,omitempty"`
+}
+
+// A WebACLAssociationSpec defines the desired state of a WebACLAssociation.
+type WebACLAssociationSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WebACLAssociationParameters `json:"forProvider"`
+}
+
+// A WebACLAssociationStatus represents the observed state of a
+// WebACLAssociation.
+type WebACLAssociationStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WebACLAssociationObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WebACLAssociation is a managed resource that represents the association
+// of an AWS WAFv2 web ACL with a regional resource.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WebACLAssociation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WebACLAssociationSpec   `json:"spec"`
+	Status WebACLAssociationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WebACLAssociationList contains a list of WebACLAssociations
+type WebACLAssociationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WebACLAssociation `json:"items"`
+}
@@ -22,24 +22,47 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws/session"
 	"gopkg.in/alecthomas/kingpin.v2"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/feature"
 	"github.com/crossplane/crossplane-runtime/pkg/logging"
-	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/backoff"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/accessanalyzer"
+	"github.com/crossplane/provider-aws/pkg/clients/apply"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
+	"github.com/crossplane/provider-aws/pkg/clients/health"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/quota"
+	"github.com/crossplane/provider-aws/pkg/clients/usage"
+	"github.com/crossplane/provider-aws/pkg/concurrency"
 	"github.com/crossplane/provider-aws/pkg/controller"
+	"github.com/crossplane/provider-aws/pkg/controllerfilter"
+	"github.com/crossplane/provider-aws/pkg/conversion"
+	"github.com/crossplane/provider-aws/pkg/eventfilter"
+	"github.com/crossplane/provider-aws/pkg/fairness"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
+	"github.com/crossplane/provider-aws/pkg/probe"
+	"github.com/crossplane/provider-aws/pkg/setup"
+	"github.com/crossplane/provider-aws/pkg/shard"
+	"github.com/crossplane/provider-aws/pkg/throttle"
+	"github.com/crossplane/provider-aws/pkg/warmup"
 )
 
 func main() {
@@ -48,13 +71,63 @@ func main() {
 		debug            = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncInterval     = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
+		pollOverrides    = app.Flag("poll-override", "Poll interval of the resources of an API group or kind, overriding --poll. Formatted as <group>=<duration> or <kind>.<group>=<duration>, e.g. rds.aws.crossplane.io=15m. May be repeated.").Strings()
+		pollTransition   = app.Flag("poll-transitioning", "Poll interval of resources whose external resources are transitioning between states, such as an RDS instance that is modifying. Never longer than their poll interval. Disabled if zero.").Default(pollinterval.DefaultTransitioning.String()).Duration()
 		leaderElection   = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
+		maxConcurrent    = app.Flag("max-concurrent-reconciles", "The maximum number of resources of a kind that are reconciled concurrently. Defaults to --max-reconcile-rate if zero.").Default("0").Int()
+		concurrencyOvr   = app.Flag("concurrency-override", "The maximum number of concurrent reconciles of the resources of an API group or kind, overriding --max-concurrent-reconciles. Formatted as <group>=<n> or <kind>.<group>=<n>, e.g. SecurityGroup.ec2.aws.crossplane.io=50. May be repeated.").Strings()
+		backoffBase      = app.Flag("backoff-base", "How long to wait before reconciling a resource whose last AWS API call failed with a retryable error, such as throttling. Doubled with every consecutive failure. Disabled if zero.").Default(backoff.DefaultBase.String()).Duration()
+		backoffMax       = app.Flag("backoff-max", "The maximum time to wait before reconciling a resource whose AWS API calls keep failing with retryable errors.").Default(backoff.DefaultMax.String()).Duration()
+		backoffJitter    = app.Flag("backoff-jitter", "The maximum fraction by which backoffs are randomly shortened, so that resources that fail together don't retry together.").Default("0.2").Float64()
+		maxRequestRate   = app.Flag("max-account-request-rate", "The maximum rate per second at which AWS API requests are made to each AWS account, shared by all ProviderConfigs that resolve to the same account. Unlimited if zero.").Default("0").Float64()
+		enableCtrls      = app.Flag("enable-controllers", "Only set up the controllers of the API groups or kinds matching these glob patterns, e.g. ec2.aws.crossplane.io or queue.sqs.aws.crossplane.io. All controllers are set up if omitted. May be repeated or comma separated.").Strings()
+		disableCtrls     = app.Flag("disable-controllers", "Don't set up the controllers of the API groups or kinds matching these glob patterns, e.g. *.ec2.aws.crossplane.io. Takes precedence over --enable-controllers. May be repeated or comma separated.").Strings()
+		resourceSelector = app.Flag("resource-selector", "Only reconcile managed resources matching this label selector, e.g. team=platform, so that several deployments of the provider can each reconcile a shard of them. Managed resources referenced by those that match must match too.").String()
+		eventWindow      = app.Flag("event-dedup-window", "Suppress events about a managed resource that are identical to one emitted within this window, e.g. the same error on every reconcile. The number of suppressed events is reported with the next one emitted. Disabled if zero.").Default(eventfilter.DefaultWindow.String()).Duration()
+		shards           = app.Flag("shards", "Distribute the controllers across this many shards by API group, each with its own leader election lease, so that several replicas of the provider each reconcile the shards they lead. Replaces --leader-election. Disabled if one.").Default("1").Int()
+		shardMaxLeases   = app.Flag("shard-max-leases", "The maximum number of shards a replica leads, so that shards spread across replicas. Should leave room to take over the shards of a failed replica. Unlimited if zero.").Default("0").Int()
+		iamPropagation   = app.Flag("wait-for-iam-propagation", "How long creating a resource that uses an IAM role, policy or instance profile, like a Lambda Function or EKS Cluster, is retried while it fails because the IAM entity was only just created and has not propagated yet. Disabled if zero.").Default(iam.DefaultPropagationTimeout.String()).Duration()
+		warmUpStage      = app.Flag("warm-up-stage", "Reconcile resources in dependency order after the provider starts, opening one tier (networking, identity, data stores, workloads) per stage of this duration. Disabled if zero.").Default("0s").Duration()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
+		enableHealthEvents         = app.Flag("enable-health-events", "Report open AWS Health events affecting managed resources in their AWSHealth condition. Requires a Business or Enterprise support plan.").Default("false").Envar("ENABLE_HEALTH_EVENTS").Bool()
+		enableQuotaChecks          = app.Flag("enable-quota-checks", "Check the AWS service quotas that creating a managed resource counts against, like VPCs per region, before creating it, and report quotas it would exceed in its QuotaExceeded condition.").Default("false").Envar("ENABLE_QUOTA_CHECKS").Bool()
+		enablePolicyValidation     = app.Flag("enable-policy-validation", "Validate the documents of IAM Policies and the trust policies of IAM Roles with IAM Access Analyzer before writing them, and report the issues found in their PolicyFindings condition instead.").Default("false").Envar("ENABLE_POLICY_VALIDATION").Bool()
+		enableConversionWebhooks   = app.Flag("enable-conversion-webhooks", "Serve the conversion webhook of CRDs that are served at several versions.").Default("false").Envar("ENABLE_CONVERSION_WEBHOOKS").Bool()
+		webhookPort                = app.Flag("webhook-port", "The port the conversion webhook is served at.").Default("9443").Int()
+		webhookTLSCertDir          = app.Flag("webhook-tls-cert-dir", "The directory of the TLS certificate (tls.crt) and key (tls.key) of the conversion webhook.").Default("/tmp/k8s-webhook-server/serving-certs").String()
+		probeAddress               = app.Flag("health-probe-bind-address", "The address the /healthz and /readyz probe endpoints bind to.").Default(":8081").String()
+		readinessInterval          = app.Flag("readiness-interval", "Interval at which /readyz checks that the credentials of each ProviderConfig in use resolve to an AWS identity.").Default(probe.DefaultInterval.String()).Duration()
+		healthInterval             = app.Flag("health-interval", "Interval at which the open AWS Health events of an account are refreshed.").Default(health.DefaultInterval.String()).Duration()
+		quotaInterval              = app.Flag("quota-interval", "Interval for which the value of an AWS service quota is cached.").Default(quota.DefaultInterval.String()).Duration()
+		policyFindings             = app.Flag("policy-validation-findings", "Types of IAM Access Analyzer findings that prevent a policy from being written, i.e. ERROR, SECURITY_WARNING, WARNING or SUGGESTION. May be repeated.").Default("ERROR", "SECURITY_WARNING").Strings()
+
+		_          = app.Command("start", "Start the AWS provider controllers.").Default()
+		renderCmd  = app.Command("render", "Print the inputs of the AWS API operations the controllers would make for the managed resources of a manifest, without calling AWS.")
+		renderFile = renderCmd.Arg("file", "Manifest to render. Reads from stdin if omitted or -.").Default("-").String()
+
+		importCmd    = app.Command("import", "Print the manifest of a managed resource that represents an existing AWS resource.")
+		importKind   = importCmd.Arg("kind", "Kind of the managed resource, e.g. UserPoolClient.").Required().String()
+		importID     = importCmd.Arg("id", "Identifier of the AWS resource, e.g. userPoolID/clientID for a UserPoolClient.").Required().String()
+		importRegion = importCmd.Flag("region", "Region of the AWS resource.").Required().String()
+		importName   = importCmd.Flag("name", "Name of the managed resource. Derived from the identifier if omitted.").String()
+
+		migrateCmd  = app.Command("migrate", "Rewrite the stored resources of CRDs at their storage version, so that their other versions can be removed.")
+		migrateCRDs = migrateCmd.Arg("crd", "Names of the CRDs, e.g. queues.sqs.aws.crossplane.io.").Required().Strings()
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	switch kingpin.MustParse(app.Parse(os.Args[1:])) {
+	case renderCmd.FullCommand():
+		kingpin.FatalIfError(renderManifest(*renderFile), "Cannot render manifest")
+		return
+	case importCmd.FullCommand():
+		kingpin.FatalIfError(importResource(*importKind, *importID, *importRegion, *importName), "Cannot import resource")
+		return
+	case migrateCmd.FullCommand():
+		kingpin.FatalIfError(migrate(*migrateCRDs, os.Stdout), "Cannot migrate CRDs")
+		return
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-aws"))
@@ -67,9 +140,30 @@ func main() {
 
 	log.Debug("Starting", "sync-period", syncInterval.String())
 
+	po, err := pollinterval.Parse(*pollOverrides)
+	kingpin.FatalIfError(err, "Cannot parse poll interval overrides")
+	co, err := concurrency.Parse(*concurrencyOvr)
+	kingpin.FatalIfError(err, "Cannot parse concurrency overrides")
+	if *maxConcurrent <= 0 {
+		*maxConcurrent = *maxReconcileRate
+	}
+	cf, err := controllerfilter.New(*enableCtrls, *disableCtrls)
+	kingpin.FatalIfError(err, "Cannot parse enabled or disabled controllers")
+	var b *backoff.Backoff
+	if *backoffBase > 0 {
+		b = backoff.New(*backoffBase, *backoffMax, *backoffJitter)
+	}
+
 	cfg, err := ctrl.GetConfig()
 	kingpin.FatalIfError(err, "Cannot get API server rest config")
 
+	// The AWS APIs must be added to the scheme before the manager creates its
+	// cache, which needs to know the managed resource kinds to restrict them
+	// to the --resource-selector.
+	kingpin.FatalIfError(apis.AddToScheme(scheme.Scheme), "Cannot add AWS APIs to scheme")
+	newCache, err := controllerfilter.NewCacheFunc(*resourceSelector)
+	kingpin.FatalIfError(err, "Cannot parse resource selector")
+
 	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
 		SyncPeriod: syncInterval,
 
@@ -80,21 +174,58 @@ func main() {
 		// hundreds of reconciles per second and ~200rps to the API
 		// server. Switching to Leases only and longer leases appears to
 		// alleviate this.
-		LeaderElection:             *leaderElection,
+		LeaderElection:             *leaderElection && *shards <= 1,
 		LeaderElectionID:           "crossplane-leader-election-provider-aws",
 		LeaderElectionResourceLock: resourcelock.LeasesResourceLock,
 		LeaseDuration:              func() *time.Duration { d := 60 * time.Second; return &d }(),
 		RenewDeadline:              func() *time.Duration { d := 50 * time.Second; return &d }(),
+
+		// Managed resources are written using server-side apply, so that we
+		// don't overwrite fields set by other controllers or users. The usages
+		// of ProviderConfigs, one per managed resource, are read from a cache
+		// of their metadata only. The managed resources read while resolving
+		// references are recorded to order their deletion.
+		NewClient: dependency.WrapNewClientFunc(usage.WrapNewClientFunc(apply.NewClientFunc(apply.FieldOwner))),
+		NewCache:  newCache,
+
+		HealthProbeBindAddress: *probeAddress,
+
+		Port:    *webhookPort,
+		CertDir: *webhookTLSCertDir,
 	})
 	kingpin.FatalIfError(err, "Cannot create controller manager")
-	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
-
-	o := xpcontroller.Options{
-		Logger:                  log,
-		MaxConcurrentReconciles: *maxReconcileRate,
-		PollInterval:            *pollInterval,
-		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
-		Features:                &feature.Flags{},
+	if *eventWindow > 0 {
+		mgr = eventfilter.WrapManager(mgr, *eventWindow)
+	}
+	if *shards > 1 {
+		el, err := shard.NewElector(cfg, *namespace, "crossplane-leader-election-provider-aws", shard.WithMaxLeases(*shardMaxLeases), shard.WithElectorLogger(log))
+		kingpin.FatalIfError(err, "Cannot create shard leader elector")
+		mgr = shard.WrapManager(mgr, *shards, shard.WithElectFn(el.Elect), shard.WithLogger(log))
+		log.Info("Sharding controllers", "shards", *shards)
+	}
+
+	var rl workqueue.RateLimiter = fairness.NewRateLimiter(*maxReconcileRate)
+	if *warmUpStage > 0 {
+		rl = warmup.NewRateLimiter(rl, *maxReconcileRate, *warmUpStage)
+	}
+
+	o := setup.Options{
+		Options: xpcontroller.Options{
+			Logger:                  log,
+			MaxConcurrentReconciles: *maxConcurrent,
+			PollInterval:            *pollInterval,
+			GlobalRateLimiter:       rl,
+			Features:                &feature.Flags{},
+		},
+		PollIntervals:         po,
+		Transitioning:         *pollTransition,
+		Backoff:               b,
+		Concurrency:           co,
+		Filter:                cf,
+		IAMPropagationTimeout: *iamPropagation,
+	}
+	if *maxRequestRate > 0 {
+		o.Throttle = throttle.NewLimiter(*maxRequestRate)
 	}
 
 	if *enableExternalSecretStores {
@@ -116,6 +247,46 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableHealthEvents {
+		o.Features.Enable(features.EnableAlphaHealthEvents)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaHealthEvents)
+
+		kube := mgr.GetClient()
+		o.Health = health.NewWatcher(func(ctx context.Context, mg resource.Managed) (*session.Session, error) {
+			// The AWS Health API is only served from us-east-1.
+			return awsclient.GetConfigV1(ctx, kube, mg, "us-east-1")
+		}, health.WithInterval(*healthInterval))
+	}
+
+	if *enableQuotaChecks {
+		o.Features.Enable(features.EnableAlphaQuotaChecks)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaQuotaChecks)
+
+		kube := mgr.GetClient()
+		o.Quota = quota.NewChecker(func(ctx context.Context, mg resource.Managed, region string) (*session.Session, error) {
+			return awsclient.GetConfigV1(ctx, kube, mg, region)
+		}, quota.WithInterval(*quotaInterval))
+	}
+
+	if *enablePolicyValidation {
+		o.Features.Enable(features.EnableAlphaPolicyValidation)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaPolicyValidation)
+
+		kube := mgr.GetClient()
+		o.PolicyValidator = accessanalyzer.NewValidator(func(ctx context.Context, mg resource.Managed) (*session.Session, error) {
+			// IAM is a global service, but IAM Access Analyzer is regional.
+			return awsclient.GetConfigV1(ctx, kube, mg, "us-east-1")
+		}, accessanalyzer.WithBlockingFindingTypes(*policyFindings...))
+	}
+
+	if *enableConversionWebhooks {
+		kingpin.FatalIfError(conversion.SetupWebhook(mgr), "Cannot setup conversion webhook")
+		log.Info("Serving conversion webhook", "port", *webhookPort)
+	}
+
+	kingpin.FatalIfError(mgr.AddHealthzCheck("ping", healthz.Ping), "Cannot add health check")
+	kingpin.FatalIfError(mgr.AddReadyzCheck("aws-credentials", probe.NewChecker(mgr.GetClient(), probe.WithInterval(*readinessInterval)).Check), "Cannot add readiness check")
+
 	kingpin.FatalIfError(controller.Setup(mgr, o), "Cannot setup AWS controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/pkg/conversion"
+)
+
+// migrate migrates the stored resources of the CRDs with the supplied
+// names to their storage version, using the kubeconfig of the environment.
+func migrate(names []string, out io.Writer) error {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+	s := runtime.NewScheme()
+	if err := extv1.AddToScheme(s); err != nil {
+		return err
+	}
+	if err := apis.AddToScheme(s); err != nil {
+		return err
+	}
+	kube, err := client.New(cfg, client.Options{Scheme: s})
+	if err != nil {
+		return err
+	}
+	m := conversion.NewMigrator(kube)
+	for _, name := range names {
+		n, err := m.Migrate(context.Background(), name)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Migrated %d resources of %s\n", n, name)
+	}
+	return nil
+}
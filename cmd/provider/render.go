@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/pkg/render"
+)
+
+// renderManifest prints the inputs of the AWS API operations the controllers
+// would make for the managed resources of the supplied manifest file.
+func renderManifest(file string) error {
+	var r io.Reader = os.Stdin
+	if file != "-" {
+		f, err := os.Open(file) // nolint:gosec
+		if err != nil {
+			return err
+		}
+		defer f.Close() // nolint:errcheck
+		r = f
+	}
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		return err
+	}
+	return render.Render(s, r, os.Stdout)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backoff delays the next reconcile of a managed resource whose last
+// AWS API call failed with a retryable error, such as throttling or a server
+// error, exponentially and with jitter. This keeps a resource that is stuck
+// from hot-looping against an AWS API that is already struggling.
+package backoff
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// Defaults of the backoff.
+const (
+	DefaultBase = 1 * time.Second
+	DefaultMax  = 5 * time.Minute
+)
+
+// A Backoff computes exponentially growing, jittered delays for items that
+// keep failing.
+type Backoff struct {
+	base   time.Duration
+	max    time.Duration
+	jitter float64
+	random func() float64
+
+	mu       sync.Mutex
+	failures map[interface{}]int
+}
+
+// An Option configures a Backoff.
+type Option func(*Backoff)
+
+// WithRandom configures the source of randomness used to jitter delays. It
+// must return numbers in [0, 1).
+func WithRandom(fn func() float64) Option {
+	return func(b *Backoff) { b.random = fn }
+}
+
+// New returns a Backoff that waits base after the first failure of an item,
+// doubling with every subsequent failure up to max. Each delay is shortened
+// by a random fraction of up to jitter, so that items that fail together
+// don't retry together.
+func New(base, max time.Duration, jitter float64, o ...Option) *Backoff {
+	b := &Backoff{
+		base:     base,
+		max:      max,
+		jitter:   math.Min(math.Max(jitter, 0), 1),
+		random:   rand.Float64, // nolint:gosec // Jitter doesn't need a secure source.
+		failures: map[interface{}]int{},
+	}
+	for _, fn := range o {
+		fn(b)
+	}
+	return b
+}
+
+// When records a failure of the supplied item and returns how long to wait
+// before retrying it.
+func (b *Backoff) When(item interface{}) time.Duration {
+	b.mu.Lock()
+	n := b.failures[item]
+	b.failures[item] = n + 1
+	b.mu.Unlock()
+
+	d := b.max
+	if exp := float64(b.base) * math.Pow(2, float64(n)); exp < float64(b.max) {
+		d = time.Duration(exp)
+	}
+	return d - time.Duration(float64(d)*b.jitter*b.random())
+}
+
+// Forget the failures of the supplied item.
+func (b *Backoff) Forget(item interface{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.failures, item)
+}
+
+// NumFailures returns how often the supplied item failed since it was last
+// forgotten.
+func (b *Backoff) NumFailures(item interface{}) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.failures[item]
+}
+
+type contextKey struct{}
+
+// An outcome of the last AWS API call made during a reconcile.
+type outcome struct {
+	mu        sync.Mutex
+	failed    bool
+	retryable bool
+}
+
+func (o *outcome) set(failed, retryable bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.failed = failed
+	o.retryable = retryable
+}
+
+func (o *outcome) failedRetryably() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.failed && o.retryable
+}
+
+func record(ctx context.Context, err error, retryable bool) {
+	o, ok := ctx.Value(contextKey{}).(*outcome)
+	if !ok {
+		return
+	}
+	o.set(err != nil, err != nil && retryable)
+}
+
+// HandlerV1 records whether AWS SDK v1 requests failed with a retryable
+// error. It must be added to the Complete handlers of a session, so that it
+// sees the outcome of the request after the SDK's own retries.
+var HandlerV1 = request.NamedHandler{
+	Name: "crossplane.BackoffRecorder",
+	Fn: func(r *request.Request) {
+		record(r.Context(), r.Error, r.IsErrorRetryable() || r.IsErrorThrottle())
+	},
+}
+
+// AddMiddlewareV2 adds a middleware to the supplied AWS SDK v2 stack that
+// records whether its operations failed with a retryable error.
+func AddMiddlewareV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("crossplane.BackoffRecorder",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, md, err := next.HandleInitialize(ctx, in)
+			record(ctx, err, retry.IsErrorRetryables(retry.DefaultRetryables).IsErrorRetryable(err) == aws.TrueTernary)
+			return out, md, err
+		}), middleware.After)
+}
+
+// A Reconciler backs off a resource whose reconcile failed because the last
+// AWS API call it made failed with a retryable error. Other failures are left
+// to the rate limiter of the controller.
+type Reconciler struct {
+	inner   reconcile.Reconciler
+	backoff *Backoff
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithBackoff configures the Backoff used by the Reconciler. Backing off is
+// disabled if it is nil, which is the default.
+func WithBackoff(b *Backoff) ReconcilerOption {
+	return func(r *Reconciler) { r.backoff = b }
+}
+
+// NewReconciler wraps the supplied reconciler, typically a managed resource
+// reconciler, so that it backs off failures of retryable AWS API calls.
+func NewReconciler(r reconcile.Reconciler, o ...ReconcilerOption) *Reconciler {
+	br := &Reconciler{inner: r}
+	for _, fn := range o {
+		fn(br)
+	}
+	return br
+}
+
+// Reconcile the supplied request, backing off if it failed retryably.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	b := r.backoff
+	if b == nil {
+		return r.inner.Reconcile(ctx, req)
+	}
+
+	o := &outcome{}
+	res, err := r.inner.Reconcile(context.WithValue(ctx, contextKey{}, o), req)
+
+	// The managed reconciler asks to be requeued immediately, subject to the
+	// rate limiter of the controller, when it fails.
+	failed := err != nil || (res.Requeue && res.RequeueAfter == 0)
+	switch {
+	case !failed:
+		b.Forget(req)
+	case err == nil && o.failedRetryably():
+		return reconcile.Result{RequeueAfter: b.When(req)}, nil
+	}
+	return res, err
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var (
+	errBoom = errors.New("boom")
+	req     = reconcile.Request{NamespacedName: types.NamespacedName{Name: "example"}}
+)
+
+func TestWhen(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		b        *Backoff
+		failures int
+		want     time.Duration
+	}{
+		"FirstFailure": {
+			reason:   "The first failure should wait the base delay.",
+			b:        New(time.Second, time.Minute, 0),
+			failures: 1,
+			want:     time.Second,
+		},
+		"Exponential": {
+			reason:   "Each consecutive failure should double the delay.",
+			b:        New(time.Second, time.Minute, 0),
+			failures: 4,
+			want:     8 * time.Second,
+		},
+		"Capped": {
+			reason:   "The delay should never exceed the maximum.",
+			b:        New(time.Second, time.Minute, 0),
+			failures: 100,
+			want:     time.Minute,
+		},
+		"Jittered": {
+			reason:   "The delay should be shortened by the jittered fraction.",
+			b:        New(time.Second, time.Minute, 0.5, WithRandom(func() float64 { return 0.5 })),
+			failures: 3,
+			want:     3 * time.Second,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got time.Duration
+			for i := 0; i < tc.failures; i++ {
+				got = tc.b.When(req)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type reconcileFn func(ctx context.Context, req reconcile.Request) (reconcile.Result, error)
+
+func (fn reconcileFn) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	return fn(ctx, req)
+}
+
+func TestReconcile(t *testing.T) {
+	type want struct {
+		result   reconcile.Result
+		err      error
+		failures int
+	}
+
+	cases := map[string]struct {
+		reason   string
+		inner    reconcileFn
+		failures int
+		want     want
+	}{
+		"RetryableFailure": {
+			reason: "A reconcile whose last AWS API call failed retryably should be backed off.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				record(ctx, errBoom, true)
+				return reconcile.Result{Requeue: true}, nil
+			},
+			failures: 2,
+			want: want{
+				result:   reconcile.Result{RequeueAfter: 4 * time.Second},
+				failures: 3,
+			},
+		},
+		"NonRetryableFailure": {
+			reason: "A reconcile whose last AWS API call failed with an error that isn't retryable should be left to the controller.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				record(ctx, errBoom, false)
+				return reconcile.Result{Requeue: true}, nil
+			},
+			want: want{
+				result: reconcile.Result{Requeue: true},
+			},
+		},
+		"RecoveredFailure": {
+			reason: "A reconcile should not be backed off if a later AWS API call succeeded, for example a create after a describe found nothing.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				record(ctx, errBoom, true)
+				record(ctx, nil, false)
+				return reconcile.Result{Requeue: true}, nil
+			},
+			failures: 1,
+			want: want{
+				result:   reconcile.Result{Requeue: true},
+				failures: 1,
+			},
+		},
+		"ReconcileError": {
+			reason: "Errors returned by the reconciler should be left to the controller.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				record(ctx, errBoom, true)
+				return reconcile.Result{}, errBoom
+			},
+			failures: 1,
+			want: want{
+				err:      errBoom,
+				failures: 1,
+			},
+		},
+		"Success": {
+			reason: "A successful reconcile should forget earlier failures.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				record(ctx, nil, false)
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			failures: 3,
+			want: want{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			b := New(time.Second, time.Minute, 0)
+			for i := 0; i < tc.failures; i++ {
+				b.When(req)
+			}
+			r := NewReconciler(tc.inner, WithBackoff(b))
+			got, err := r.Reconcile(context.Background(), req)
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want result, +got result:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, cmpopts.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.failures, b.NumFailures(req)); diff != "" {
+				t.Errorf("\n%s\nNumFailures(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestReconcileDisabled(t *testing.T) {
+	inner := reconcileFn(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+		record(ctx, errBoom, true)
+		return reconcile.Result{Requeue: true}, nil
+	})
+	got, err := NewReconciler(inner, WithBackoff(nil)).Reconcile(context.Background(), req)
+	if err != nil {
+		t.Errorf("Reconcile(...): %v", err)
+	}
+	if diff := cmp.Diff(reconcile.Result{Requeue: true}, got); diff != "" {
+		t.Errorf("Reconcile(...): -want, +got:\n%s", diff)
+	}
+}
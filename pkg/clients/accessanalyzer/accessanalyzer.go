@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package accessanalyzer validates the IAM policy documents of managed
+// resources with IAM Access Analyzer before they are written to IAM, so that
+// invalid or overly permissive policies are reported in the PolicyFindings
+// condition of the managed resource instead of being created.
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypePolicyFindings is the type of the condition that reports whether IAM
+// Access Analyzer found issues in the policy documents of a managed resource.
+const TypePolicyFindings xpv1.ConditionType = "PolicyFindings"
+
+// Reasons of the PolicyFindings condition.
+const (
+	ReasonFindings   xpv1.ConditionReason = "PolicyFindings"
+	ReasonNoFindings xpv1.ConditionReason = "NoPolicyFindings"
+)
+
+// Types of policy documents.
+const (
+	IdentityPolicy = svcsdk.PolicyTypeIdentityPolicy
+	ResourcePolicy = svcsdk.PolicyTypeResourcePolicy
+)
+
+const errFmtFindings = "IAM Access Analyzer found issues in the %s: %s"
+
+// Client is the external client used for IAM Access Analyzer. It is only
+// supported by the first version of the AWS SDK this provider uses.
+type Client interface {
+	ValidatePolicyPagesWithContext(ctx aws.Context, input *svcsdk.ValidatePolicyInput, fn func(*svcsdk.ValidatePolicyOutput, bool) bool, opts ...request.Option) error
+}
+
+// NewClient returns a new client using the supplied session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
+
+// A Document is an IAM policy document of a managed resource.
+type Document struct {
+	// Name describes the document in findings, e.g. "assume role policy".
+	Name string
+
+	// Type of the policy, i.e. IdentityPolicy or ResourcePolicy.
+	Type string
+
+	// Policy is the JSON policy document.
+	Policy string
+}
+
+// A Finding is an issue IAM Access Analyzer found in a policy document.
+type Finding struct {
+	Type      string
+	IssueCode string
+	Details   string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s %s: %s", f.Type, f.IssueCode, f.Details)
+}
+
+// A ConfigFn returns a session for the AWS account and credentials of the
+// supplied managed resource.
+type ConfigFn func(ctx context.Context, mg resource.Managed) (*session.Session, error)
+
+// A Validator validates the policy documents of managed resources.
+type Validator struct {
+	config    ConfigFn
+	newClient func(*session.Session) Client
+	blocking  map[string]bool
+}
+
+// An Option configures a Validator.
+type Option func(*Validator)
+
+// WithClientFn configures how the IAM Access Analyzer client is created.
+func WithClientFn(fn func(*session.Session) Client) Option {
+	return func(v *Validator) { v.newClient = fn }
+}
+
+// WithBlockingFindingTypes configures the types of findings, e.g. WARNING,
+// that prevent a policy document from being written. Errors and security
+// warnings are blocking by default.
+func WithBlockingFindingTypes(types ...string) Option {
+	return func(v *Validator) {
+		v.blocking = map[string]bool{}
+		for _, t := range types {
+			v.blocking[t] = true
+		}
+	}
+}
+
+// NewValidator returns a Validator that uses the supplied function to connect
+// to IAM Access Analyzer.
+func NewValidator(config ConfigFn, o ...Option) *Validator {
+	v := &Validator{
+		config:    config,
+		newClient: NewClient,
+		blocking: map[string]bool{
+			svcsdk.ValidatePolicyFindingTypeError:           true,
+			svcsdk.ValidatePolicyFindingTypeSecurityWarning: true,
+		},
+	}
+	for _, fn := range o {
+		fn(v)
+	}
+	return v
+}
+
+// findings returns the blocking findings of the supplied policy document.
+func (v *Validator) findings(ctx context.Context, c Client, doc Document) ([]Finding, error) {
+	var findings []Finding
+	err := c.ValidatePolicyPagesWithContext(ctx, &svcsdk.ValidatePolicyInput{
+		PolicyDocument: aws.String(doc.Policy),
+		PolicyType:     aws.String(doc.Type),
+	}, func(page *svcsdk.ValidatePolicyOutput, _ bool) bool {
+		for _, f := range page.Findings {
+			if !v.blocking[aws.StringValue(f.FindingType)] {
+				continue
+			}
+			findings = append(findings, Finding{
+				Type:      aws.StringValue(f.FindingType),
+				IssueCode: aws.StringValue(f.IssueCode),
+				Details:   aws.StringValue(f.FindingDetails),
+			})
+		}
+		return true
+	})
+	return findings, err
+}
+
+// Validate returns an error and sets the PolicyFindings condition of the
+// supplied managed resource if IAM Access Analyzer finds blocking issues in
+// any of the supplied policy documents. The condition is only added once
+// issues were found, and is set to false once none are found anymore. A nil
+// Validator validates no policy documents.
+func (v *Validator) Validate(ctx context.Context, mg resource.Managed, docs ...Document) error {
+	if v == nil {
+		return nil
+	}
+	sess, err := v.config(ctx, mg)
+	if err != nil {
+		return nil
+	}
+	c := v.newClient(sess)
+	for _, doc := range docs {
+		// Validating policies is a best effort. Failing to reach IAM Access
+		// Analyzer, e.g. because the credentials may not use it, must not
+		// prevent the policy from being written.
+		findings, err := v.findings(ctx, c, doc)
+		if err != nil || len(findings) == 0 {
+			continue
+		}
+		msgs := make([]string, len(findings))
+		for i, f := range findings {
+			msgs[i] = f.String()
+		}
+		err = errors.Errorf(errFmtFindings, doc.Name, strings.Join(msgs, "; "))
+		mg.SetConditions(Findings(err.Error()))
+		return err
+	}
+	if mg.GetCondition(TypePolicyFindings).Status != corev1.ConditionUnknown {
+		mg.SetConditions(NoFindings())
+	}
+	return nil
+}
+
+// Findings returns a condition that indicates IAM Access Analyzer found
+// blocking issues in a policy document of the managed resource.
+func Findings(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePolicyFindings,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonFindings,
+		Message:            msg,
+	}
+}
+
+// NoFindings returns a condition that indicates IAM Access Analyzer found no
+// blocking issues in the policy documents of the managed resource.
+func NoFindings() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePolicyFindings,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoFindings,
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accessanalyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/accessanalyzer"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+var (
+	errBoom = errors.New("boom")
+
+	document = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:*","Resource":"*"}]}`
+)
+
+type mockClient struct {
+	findings []*svcsdk.ValidatePolicyFinding
+	err      error
+	inputs   []*svcsdk.ValidatePolicyInput
+}
+
+func (m *mockClient) ValidatePolicyPagesWithContext(_ aws.Context, in *svcsdk.ValidatePolicyInput, fn func(*svcsdk.ValidatePolicyOutput, bool) bool, _ ...request.Option) error {
+	m.inputs = append(m.inputs, in)
+	fn(&svcsdk.ValidatePolicyOutput{Findings: m.findings}, true)
+	return m.err
+}
+
+func finding(findingType, issueCode string) *svcsdk.ValidatePolicyFinding {
+	return &svcsdk.ValidatePolicyFinding{
+		FindingType:    aws.String(findingType),
+		IssueCode:      aws.String(issueCode),
+		FindingDetails: aws.String("details"),
+	}
+}
+
+func policy(c ...xpv1.Condition) *v1beta1.Policy {
+	cr := &v1beta1.Policy{}
+	cr.SetConditions(c...)
+	return cr
+}
+
+func TestValidatorValidate(t *testing.T) {
+	doc := Document{Name: "policy document", Type: IdentityPolicy, Policy: document}
+	errFindings := errors.Errorf(errFmtFindings, "policy document", "ERROR MISSING_VERSION: details; SECURITY_WARNING PASS_ROLE_WITH_STAR_IN_RESOURCE: details")
+
+	type want struct {
+		err        error
+		conditions []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		client *mockClient
+		opts   []Option
+		mg     *v1beta1.Policy
+		want   want
+	}{
+		"NoFindings": {
+			reason: "No condition should be added if no issues are found.",
+			client: &mockClient{},
+			mg:     policy(),
+		},
+		"NonBlockingFindings": {
+			reason: "Warnings and suggestions should not prevent a policy from being written by default.",
+			client: &mockClient{findings: []*svcsdk.ValidatePolicyFinding{
+				finding(svcsdk.ValidatePolicyFindingTypeWarning, "REDUNDANT_ACTION"),
+				finding(svcsdk.ValidatePolicyFindingTypeSuggestion, "EMPTY_ARRAY_ACTION"),
+			}},
+			mg: policy(),
+		},
+		"BlockingFindings": {
+			reason: "Errors and security warnings should be reported.",
+			client: &mockClient{findings: []*svcsdk.ValidatePolicyFinding{
+				finding(svcsdk.ValidatePolicyFindingTypeError, "MISSING_VERSION"),
+				finding(svcsdk.ValidatePolicyFindingTypeWarning, "REDUNDANT_ACTION"),
+				finding(svcsdk.ValidatePolicyFindingTypeSecurityWarning, "PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+			}},
+			mg: policy(),
+			want: want{
+				err:        errFindings,
+				conditions: []xpv1.Condition{Findings(errFindings.Error())},
+			},
+		},
+		"ConfiguredBlockingFindings": {
+			reason: "Only findings of the configured types should be reported.",
+			client: &mockClient{findings: []*svcsdk.ValidatePolicyFinding{
+				finding(svcsdk.ValidatePolicyFindingTypeSecurityWarning, "PASS_ROLE_WITH_STAR_IN_RESOURCE"),
+			}},
+			opts: []Option{WithBlockingFindingTypes(svcsdk.ValidatePolicyFindingTypeError)},
+			mg:   policy(),
+		},
+		"NoLongerFindings": {
+			reason: "A PolicyFindings condition should be set to false once no issues are found anymore.",
+			client: &mockClient{},
+			mg:     policy(Findings("findings")),
+			want: want{
+				conditions: []xpv1.Condition{NoFindings()},
+			},
+		},
+		"AccessAnalyzerUnavailable": {
+			reason: "Failing to validate the policy should not prevent it from being written.",
+			client: &mockClient{err: errBoom},
+			mg:     policy(Findings("findings")),
+			want: want{
+				conditions: []xpv1.Condition{NoFindings()},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			v := NewValidator(func(context.Context, resource.Managed) (*session.Session, error) { return nil, nil },
+				append([]Option{WithClientFn(func(*session.Session) Client { return tc.client })}, tc.opts...)...)
+			err := v.Validate(context.Background(), tc.mg, doc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.mg.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+			want := []*svcsdk.ValidatePolicyInput{{PolicyDocument: aws.String(document), PolicyType: aws.String(IdentityPolicy)}}
+			if diff := cmp.Diff(want, tc.client.inputs); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want inputs, +got inputs:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNilValidatorValidate(t *testing.T) {
+	cr := policy()
+	var v *Validator
+	if err := v.Validate(context.Background(), cr, Document{Policy: document}); err != nil {
+		t.Errorf("Validate(...): want no error from a nil Validator, got %v", err)
+	}
+	if c := cr.GetCondition(TypePolicyFindings); c.Status != corev1.ConditionUnknown {
+		t.Errorf("Validate(...): want no PolicyFindings condition from a nil Validator, got %v", c)
+	}
+}
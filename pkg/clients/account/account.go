@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package account reports the AWS account that managed resources are managed
+// in, which may differ from resource to resource when their ProviderConfigs
+// assume roles in different accounts.
+package account
+
+import (
+	"reflect"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// GetID returns the status.atProvider.callerAccountID field of the supplied
+// managed resource, or an empty string if it has none.
+func GetID(mg resource.Managed) string {
+	v, ok := accountID(mg)
+	if !ok {
+		return ""
+	}
+	return v.String()
+}
+
+// SetID sets the status.atProvider.callerAccountID field of the supplied managed
+// resource, if it has one.
+func SetID(mg resource.Managed, id string) {
+	if v, ok := accountID(mg); ok {
+		v.SetString(id)
+	}
+}
+
+// accountID returns the status.atProvider.callerAccountID field of the supplied
+// managed resource. Every managed resource of this provider has one, but
+// they're distinct types that share no interface to access it.
+func accountID(mg resource.Managed) (reflect.Value, bool) {
+	v := reflect.ValueOf(mg)
+	for _, name := range []string{"Status", "AtProvider", "CallerAccountID"} {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		if v = v.FieldByName(name); !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	return v, v.CanSet() && v.Kind() == reflect.String
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	route53v1alpha1 "github.com/crossplane/provider-aws/apis/route53/v1alpha1"
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+func TestID(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		id   string
+		want string
+	}{
+		"Queue": {
+			mg:   &sqsv1beta1.Queue{},
+			id:   "123456789012",
+			want: "123456789012",
+		},
+		"ResourceRecordSet": {
+			mg:   &route53v1alpha1.ResourceRecordSet{},
+			id:   "123456789012",
+			want: "123456789012",
+		},
+		"NoAtProvider": {
+			mg:   &fake.Managed{},
+			id:   "123456789012",
+			want: "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			SetID(tc.mg, tc.id)
+			if diff := cmp.Diff(tc.want, GetID(tc.mg)); diff != "" {
+				t.Errorf("GetID(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,339 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// KeyTypeAPIKey is the type of the keys added to usage plans.
+const KeyTypeAPIKey = "API_KEY"
+
+// Client defines the API Gateway operations used by the API key, usage plan
+// and authorizer controllers.
+type Client interface {
+	CreateApiKeyWithContext(context.Context, *awsapigateway.CreateApiKeyInput, ...request.Option) (*awsapigateway.ApiKey, error)
+	GetApiKeyWithContext(context.Context, *awsapigateway.GetApiKeyInput, ...request.Option) (*awsapigateway.ApiKey, error)
+	UpdateApiKeyWithContext(context.Context, *awsapigateway.UpdateApiKeyInput, ...request.Option) (*awsapigateway.ApiKey, error)
+	DeleteApiKeyWithContext(context.Context, *awsapigateway.DeleteApiKeyInput, ...request.Option) (*awsapigateway.DeleteApiKeyOutput, error)
+
+	CreateUsagePlanWithContext(context.Context, *awsapigateway.CreateUsagePlanInput, ...request.Option) (*awsapigateway.UsagePlan, error)
+	GetUsagePlanWithContext(context.Context, *awsapigateway.GetUsagePlanInput, ...request.Option) (*awsapigateway.UsagePlan, error)
+	UpdateUsagePlanWithContext(context.Context, *awsapigateway.UpdateUsagePlanInput, ...request.Option) (*awsapigateway.UsagePlan, error)
+	DeleteUsagePlanWithContext(context.Context, *awsapigateway.DeleteUsagePlanInput, ...request.Option) (*awsapigateway.DeleteUsagePlanOutput, error)
+
+	CreateUsagePlanKeyWithContext(context.Context, *awsapigateway.CreateUsagePlanKeyInput, ...request.Option) (*awsapigateway.UsagePlanKey, error)
+	GetUsagePlanKeyWithContext(context.Context, *awsapigateway.GetUsagePlanKeyInput, ...request.Option) (*awsapigateway.UsagePlanKey, error)
+	DeleteUsagePlanKeyWithContext(context.Context, *awsapigateway.DeleteUsagePlanKeyInput, ...request.Option) (*awsapigateway.DeleteUsagePlanKeyOutput, error)
+
+	CreateAuthorizerWithContext(context.Context, *awsapigateway.CreateAuthorizerInput, ...request.Option) (*awsapigateway.Authorizer, error)
+	GetAuthorizerWithContext(context.Context, *awsapigateway.GetAuthorizerInput, ...request.Option) (*awsapigateway.Authorizer, error)
+	UpdateAuthorizerWithContext(context.Context, *awsapigateway.UpdateAuthorizerInput, ...request.Option) (*awsapigateway.Authorizer, error)
+	DeleteAuthorizerWithContext(context.Context, *awsapigateway.DeleteAuthorizerInput, ...request.Option) (*awsapigateway.DeleteAuthorizerOutput, error)
+}
+
+// NewClient returns a new API Gateway client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsapigateway.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the resource does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsapigateway.ErrCodeNotFoundException
+}
+
+// AuthorizerURI returns the URI API Gateway invokes the Lambda function with
+// the given ARN with.
+func AuthorizerURI(region, functionARN string) string {
+	partition := "aws"
+	if parts := strings.SplitN(functionARN, ":", 3); len(parts) == 3 {
+		partition = parts[1]
+	}
+	return fmt.Sprintf("arn:%s:apigateway:%s:lambda:path/2015-03-31/functions/%s/invocations", partition, region, functionARN)
+}
+
+// APIKeyEnabled returns whether an API key with the given parameters is
+// enabled. API keys are enabled by default.
+func APIKeyEnabled(p v1alpha1.APIKeyParameters) bool {
+	if p.Enabled != nil {
+		return *p.Enabled
+	}
+	return true
+}
+
+// GenerateCreateAPIKeyInput returns the input that creates an API key with
+// the given parameters and value. API Gateway generates a value if it is
+// empty.
+func GenerateCreateAPIKeyInput(p v1alpha1.APIKeyParameters, value string) *awsapigateway.CreateApiKeyInput {
+	return &awsapigateway.CreateApiKeyInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+		Enabled:     aws.Bool(APIKeyEnabled(p)),
+		CustomerId:  p.CustomerID,
+		Value:       awsclient.String(value),
+		Tags:        aws.StringMap(p.Tags),
+	}
+}
+
+// GenerateAPIKeyPatch returns the operations that update the observed API
+// key to match the given parameters.
+func GenerateAPIKeyPatch(p v1alpha1.APIKeyParameters, observed *awsapigateway.ApiKey) []*awsapigateway.PatchOperation {
+	var ops []*awsapigateway.PatchOperation
+	ops = appendReplace(ops, "/name", &p.Name, observed.Name)
+	ops = appendReplace(ops, "/description", p.Description, observed.Description)
+	ops = appendReplace(ops, "/customerId", p.CustomerID, observed.CustomerId)
+	if APIKeyEnabled(p) != aws.BoolValue(observed.Enabled) {
+		ops = append(ops, replace("/enabled", strconv.FormatBool(APIKeyEnabled(p))))
+	}
+	return ops
+}
+
+// GenerateCreateUsagePlanInput returns the input that creates a usage plan
+// with the given parameters.
+func GenerateCreateUsagePlanInput(p v1alpha1.UsagePlanParameters) *awsapigateway.CreateUsagePlanInput {
+	in := &awsapigateway.CreateUsagePlanInput{
+		Name:        aws.String(p.Name),
+		Description: p.Description,
+		Throttle:    generateThrottleSettings(p.Throttle),
+		Tags:        aws.StringMap(p.Tags),
+	}
+	if p.Quota != nil {
+		in.Quota = &awsapigateway.QuotaSettings{
+			Limit:  aws.Int64(p.Quota.Limit),
+			Offset: p.Quota.Offset,
+			Period: aws.String(p.Quota.Period),
+		}
+	}
+	for _, s := range p.APIStages {
+		stage := &awsapigateway.ApiStage{ApiId: aws.String(s.APIID), Stage: aws.String(s.Stage)}
+		if len(s.Throttle) > 0 {
+			stage.Throttle = map[string]*awsapigateway.ThrottleSettings{}
+			for m, t := range s.Throttle {
+				t := t
+				stage.Throttle[m] = generateThrottleSettings(&t)
+			}
+		}
+		in.ApiStages = append(in.ApiStages, stage)
+	}
+	return in
+}
+
+func generateThrottleSettings(t *v1alpha1.ThrottleSettings) *awsapigateway.ThrottleSettings {
+	if t == nil {
+		return nil
+	}
+	return &awsapigateway.ThrottleSettings{BurstLimit: t.BurstLimit, RateLimit: t.RateLimit}
+}
+
+// GenerateUsagePlanPatch returns the operations that update the observed
+// usage plan to match the given parameters.
+func GenerateUsagePlanPatch(p v1alpha1.UsagePlanParameters, observed *awsapigateway.UsagePlan) []*awsapigateway.PatchOperation {
+	var ops []*awsapigateway.PatchOperation
+	ops = appendReplace(ops, "/name", &p.Name, observed.Name)
+	ops = appendReplace(ops, "/description", p.Description, observed.Description)
+	ops = append(ops, throttlePatch("/throttle", p.Throttle, observed.Throttle)...)
+
+	switch {
+	case p.Quota == nil && observed.Quota != nil:
+		ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/quota")})
+	case p.Quota != nil:
+		q := observed.Quota
+		if q == nil {
+			q = &awsapigateway.QuotaSettings{}
+		}
+		if p.Quota.Limit != aws.Int64Value(q.Limit) {
+			ops = append(ops, replace("/quota/limit", strconv.FormatInt(p.Quota.Limit, 10)))
+		}
+		if p.Quota.Offset != nil && *p.Quota.Offset != aws.Int64Value(q.Offset) {
+			ops = append(ops, replace("/quota/offset", strconv.FormatInt(*p.Quota.Offset, 10)))
+		}
+		ops = appendReplace(ops, "/quota/period", &p.Quota.Period, q.Period)
+	}
+
+	observedStages := map[string]*awsapigateway.ApiStage{}
+	for _, s := range observed.ApiStages {
+		observedStages[stageKey(aws.StringValue(s.ApiId), aws.StringValue(s.Stage))] = s
+	}
+	desiredStages := map[string]bool{}
+	for _, s := range p.APIStages {
+		key := stageKey(s.APIID, s.Stage)
+		desiredStages[key] = true
+		o, ok := observedStages[key]
+		if !ok {
+			ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpAdd), Path: aws.String("/apiStages"), Value: aws.String(key)})
+			o = &awsapigateway.ApiStage{}
+		}
+		ops = append(ops, stageThrottlePatch(key, s.Throttle, o.Throttle)...)
+	}
+	for _, s := range observed.ApiStages {
+		key := stageKey(aws.StringValue(s.ApiId), aws.StringValue(s.Stage))
+		if !desiredStages[key] {
+			ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/apiStages"), Value: aws.String(key)})
+		}
+	}
+	return ops
+}
+
+// stageThrottlePatch returns the operations that update the observed
+// throttle settings of the methods of a stage to the desired ones.
+func stageThrottlePatch(key string, desired map[string]v1alpha1.ThrottleSettings, observed map[string]*awsapigateway.ThrottleSettings) []*awsapigateway.PatchOperation {
+	var ops []*awsapigateway.PatchOperation
+	methods := make([]string, 0, len(desired))
+	for m := range desired {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	for _, m := range methods {
+		t := desired[m]
+		ops = append(ops, throttlePatch(methodThrottlePath(key, m), &t, observed[m])...)
+	}
+	removed := []string{}
+	for m := range observed {
+		if _, ok := desired[m]; !ok {
+			removed = append(removed, m)
+		}
+	}
+	sort.Strings(removed)
+	for _, m := range removed {
+		ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpRemove), Path: aws.String(methodThrottlePath(key, m))})
+	}
+	return ops
+}
+
+// throttlePatch returns the operations that update the observed throttle
+// settings at the given path to the desired ones.
+func throttlePatch(path string, desired *v1alpha1.ThrottleSettings, observed *awsapigateway.ThrottleSettings) []*awsapigateway.PatchOperation {
+	if desired == nil {
+		if observed == nil {
+			return nil
+		}
+		return []*awsapigateway.PatchOperation{{Op: aws.String(awsapigateway.OpRemove), Path: aws.String(path)}}
+	}
+	if observed == nil {
+		observed = &awsapigateway.ThrottleSettings{}
+	}
+	var ops []*awsapigateway.PatchOperation
+	if desired.BurstLimit != nil && *desired.BurstLimit != aws.Int64Value(observed.BurstLimit) {
+		ops = append(ops, replace(path+"/burstLimit", strconv.FormatInt(*desired.BurstLimit, 10)))
+	}
+	if desired.RateLimit != nil && *desired.RateLimit != aws.Float64Value(observed.RateLimit) {
+		ops = append(ops, replace(path+"/rateLimit", strconv.FormatFloat(*desired.RateLimit, 'f', -1, 64)))
+	}
+	return ops
+}
+
+func stageKey(apiID, stage string) string {
+	return apiID + ":" + stage
+}
+
+// methodThrottlePath returns the patch path of the throttle settings of the
+// given method of a stage. Methods are keyed by their resource path and HTTP
+// method, of which the slashes of the resource path are escaped.
+func methodThrottlePath(key, method string) string {
+	resource, verb := "", method
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		resource, verb = method[:i], method[i+1:]
+	}
+	resource = strings.NewReplacer("~", "~0", "/", "~1").Replace(resource)
+	return fmt.Sprintf("/apiStages/%s/throttle/%s/%s", key, resource, verb)
+}
+
+// GenerateCreateAuthorizerInput returns the input that creates an authorizer
+// with the given parameters.
+func GenerateCreateAuthorizerInput(p v1alpha1.AuthorizerParameters) *awsapigateway.CreateAuthorizerInput {
+	in := &awsapigateway.CreateAuthorizerInput{
+		RestApiId:                    aws.String(p.RestAPIID),
+		Name:                         aws.String(p.Name),
+		Type:                         aws.String(p.Type),
+		AuthorizerCredentials:        p.AuthorizerCredentials,
+		IdentitySource:               p.IdentitySource,
+		IdentityValidationExpression: p.IdentityValidationExpression,
+		AuthorizerResultTtlInSeconds: p.AuthorizerResultTTLInSeconds,
+	}
+	if p.FunctionARN != nil {
+		in.AuthorizerUri = aws.String(AuthorizerURI(p.Region, *p.FunctionARN))
+	}
+	if len(p.ProviderARNs) > 0 {
+		in.ProviderARNs = aws.StringSlice(p.ProviderARNs)
+	}
+	return in
+}
+
+// GenerateAuthorizerPatch returns the operations that update the observed
+// authorizer to match the given parameters.
+func GenerateAuthorizerPatch(p v1alpha1.AuthorizerParameters, observed *awsapigateway.Authorizer) []*awsapigateway.PatchOperation {
+	var ops []*awsapigateway.PatchOperation
+	ops = appendReplace(ops, "/name", &p.Name, observed.Name)
+	ops = appendReplace(ops, "/type", &p.Type, observed.Type)
+	if p.FunctionARN != nil {
+		ops = appendReplace(ops, "/authorizerUri", aws.String(AuthorizerURI(p.Region, *p.FunctionARN)), observed.AuthorizerUri)
+	}
+	ops = appendReplace(ops, "/authorizerCredentials", p.AuthorizerCredentials, observed.AuthorizerCredentials)
+	ops = appendReplace(ops, "/identitySource", p.IdentitySource, observed.IdentitySource)
+	ops = appendReplace(ops, "/identityValidationExpression", p.IdentityValidationExpression, observed.IdentityValidationExpression)
+	if p.AuthorizerResultTTLInSeconds != nil && *p.AuthorizerResultTTLInSeconds != aws.Int64Value(observed.AuthorizerResultTtlInSeconds) {
+		ops = append(ops, replace("/authorizerResultTtlInSeconds", strconv.FormatInt(*p.AuthorizerResultTTLInSeconds, 10)))
+	}
+
+	observedARNs := map[string]bool{}
+	for _, arn := range observed.ProviderARNs {
+		observedARNs[aws.StringValue(arn)] = true
+	}
+	desiredARNs := map[string]bool{}
+	for _, arn := range p.ProviderARNs {
+		desiredARNs[arn] = true
+		if !observedARNs[arn] {
+			ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpAdd), Path: aws.String("/providerARNs"), Value: aws.String(arn)})
+		}
+	}
+	for _, arn := range aws.StringValueSlice(observed.ProviderARNs) {
+		if !desiredARNs[arn] {
+			ops = append(ops, &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpRemove), Path: aws.String("/providerARNs"), Value: aws.String(arn)})
+		}
+	}
+	return ops
+}
+
+// appendReplace appends an operation that replaces the value at the given
+// path with the desired one if it is given and differs from the observed
+// one.
+func appendReplace(ops []*awsapigateway.PatchOperation, path string, desired, observed *string) []*awsapigateway.PatchOperation {
+	if desired == nil || *desired == aws.StringValue(observed) {
+		return ops
+	}
+	return append(ops, replace(path, *desired))
+}
+
+func replace(path, value string) *awsapigateway.PatchOperation {
+	return &awsapigateway.PatchOperation{Op: aws.String(awsapigateway.OpReplace), Path: aws.String(path), Value: aws.String(value)}
+}
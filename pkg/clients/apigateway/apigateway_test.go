@@ -0,0 +1,262 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+)
+
+var (
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:authorizer"
+	poolARN     = "arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_abc"
+
+	ignoreUnexported = cmpopts.IgnoreUnexported(awsapigateway.PatchOperation{})
+)
+
+func op(o, path, value string) *awsapigateway.PatchOperation {
+	p := &awsapigateway.PatchOperation{Op: aws.String(o), Path: aws.String(path)}
+	if value != "" {
+		p.Value = aws.String(value)
+	}
+	return p
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  errors.Wrap(awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil), "wrapped"),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New(awsapigateway.ErrCodeConflictException, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAuthorizerURI(t *testing.T) {
+	cases := map[string]struct {
+		region string
+		arn    string
+		want   string
+	}{
+		"AWS": {
+			region: "us-east-1",
+			arn:    functionARN,
+			want:   "arn:aws:apigateway:us-east-1:lambda:path/2015-03-31/functions/" + functionARN + "/invocations",
+		},
+		"China": {
+			region: "cn-north-1",
+			arn:    "arn:aws-cn:lambda:cn-north-1:123456789012:function:authorizer",
+			want:   "arn:aws-cn:apigateway:cn-north-1:lambda:path/2015-03-31/functions/arn:aws-cn:lambda:cn-north-1:123456789012:function:authorizer/invocations",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AuthorizerURI(tc.region, tc.arn)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAPIKeyPatch(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.APIKeyParameters
+		observed *awsapigateway.ApiKey
+		want     []*awsapigateway.PatchOperation
+	}{
+		"UpToDate": {
+			p:        v1alpha1.APIKeyParameters{Name: "key"},
+			observed: &awsapigateway.ApiKey{Name: aws.String("key"), Enabled: aws.Bool(true)},
+		},
+		"Changed": {
+			p: v1alpha1.APIKeyParameters{Name: "key", Description: aws.String("new"), Enabled: aws.Bool(false)},
+			observed: &awsapigateway.ApiKey{
+				Name:        aws.String("old"),
+				Description: aws.String("old"),
+				Enabled:     aws.Bool(true),
+			},
+			want: []*awsapigateway.PatchOperation{
+				op(awsapigateway.OpReplace, "/name", "key"),
+				op(awsapigateway.OpReplace, "/description", "new"),
+				op(awsapigateway.OpReplace, "/enabled", "false"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateAPIKeyPatch(tc.p, tc.observed), ignoreUnexported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUsagePlanPatch(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.UsagePlanParameters
+		observed *awsapigateway.UsagePlan
+		want     []*awsapigateway.PatchOperation
+	}{
+		"UpToDate": {
+			p: v1alpha1.UsagePlanParameters{
+				Name:     "plan",
+				Throttle: &v1alpha1.ThrottleSettings{BurstLimit: aws.Int64(10), RateLimit: aws.Float64(5)},
+				Quota:    &v1alpha1.QuotaSettings{Limit: 1000, Period: "DAY"},
+				APIStages: []v1alpha1.APIStage{{
+					APIID:    "api",
+					Stage:    "prod",
+					Throttle: map[string]v1alpha1.ThrottleSettings{"/pets/GET": {RateLimit: aws.Float64(1)}},
+				}},
+			},
+			observed: &awsapigateway.UsagePlan{
+				Name:     aws.String("plan"),
+				Throttle: &awsapigateway.ThrottleSettings{BurstLimit: aws.Int64(10), RateLimit: aws.Float64(5)},
+				Quota:    &awsapigateway.QuotaSettings{Limit: aws.Int64(1000), Offset: aws.Int64(0), Period: aws.String("DAY")},
+				ApiStages: []*awsapigateway.ApiStage{{
+					ApiId:    aws.String("api"),
+					Stage:    aws.String("prod"),
+					Throttle: map[string]*awsapigateway.ThrottleSettings{"/pets/GET": {BurstLimit: aws.Int64(0), RateLimit: aws.Float64(1)}},
+				}},
+			},
+		},
+		"Changed": {
+			p: v1alpha1.UsagePlanParameters{
+				Name:     "plan",
+				Throttle: &v1alpha1.ThrottleSettings{RateLimit: aws.Float64(2.5)},
+				APIStages: []v1alpha1.APIStage{{
+					APIID:    "api",
+					Stage:    "dev",
+					Throttle: map[string]v1alpha1.ThrottleSettings{"/pets/{id}/GET": {BurstLimit: aws.Int64(2)}},
+				}},
+			},
+			observed: &awsapigateway.UsagePlan{
+				Name:     aws.String("plan"),
+				Throttle: &awsapigateway.ThrottleSettings{RateLimit: aws.Float64(5)},
+				Quota:    &awsapigateway.QuotaSettings{Limit: aws.Int64(1000), Period: aws.String("DAY")},
+				ApiStages: []*awsapigateway.ApiStage{{
+					ApiId: aws.String("api"),
+					Stage: aws.String("prod"),
+				}},
+			},
+			want: []*awsapigateway.PatchOperation{
+				op(awsapigateway.OpReplace, "/throttle/rateLimit", "2.5"),
+				op(awsapigateway.OpRemove, "/quota", ""),
+				op(awsapigateway.OpAdd, "/apiStages", "api:dev"),
+				op(awsapigateway.OpReplace, "/apiStages/api:dev/throttle/~1pets~1{id}/GET/burstLimit", "2"),
+				op(awsapigateway.OpRemove, "/apiStages", "api:prod"),
+			},
+		},
+		"MethodThrottleRemoved": {
+			p: v1alpha1.UsagePlanParameters{
+				Name:      "plan",
+				APIStages: []v1alpha1.APIStage{{APIID: "api", Stage: "prod"}},
+			},
+			observed: &awsapigateway.UsagePlan{
+				Name: aws.String("plan"),
+				ApiStages: []*awsapigateway.ApiStage{{
+					ApiId:    aws.String("api"),
+					Stage:    aws.String("prod"),
+					Throttle: map[string]*awsapigateway.ThrottleSettings{"/pets/GET": {RateLimit: aws.Float64(1)}},
+				}},
+			},
+			want: []*awsapigateway.PatchOperation{
+				op(awsapigateway.OpRemove, "/apiStages/api:prod/throttle/~1pets/GET", ""),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUsagePlanPatch(tc.p, tc.observed), ignoreUnexported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateAuthorizerPatch(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.AuthorizerParameters
+		observed *awsapigateway.Authorizer
+		want     []*awsapigateway.PatchOperation
+	}{
+		"UpToDate": {
+			p: v1alpha1.AuthorizerParameters{
+				Region:      "us-east-1",
+				Name:        "auth",
+				Type:        "TOKEN",
+				FunctionARN: &functionARN,
+			},
+			observed: &awsapigateway.Authorizer{
+				Name:                         aws.String("auth"),
+				Type:                         aws.String("TOKEN"),
+				AuthorizerUri:                aws.String(AuthorizerURI("us-east-1", functionARN)),
+				AuthorizerResultTtlInSeconds: aws.Int64(300),
+			},
+		},
+		"Changed": {
+			p: v1alpha1.AuthorizerParameters{
+				Name:                         "auth",
+				Type:                         "COGNITO_USER_POOLS",
+				ProviderARNs:                 []string{poolARN},
+				AuthorizerResultTTLInSeconds: aws.Int64(0),
+			},
+			observed: &awsapigateway.Authorizer{
+				Name:                         aws.String("auth"),
+				Type:                         aws.String("COGNITO_USER_POOLS"),
+				ProviderARNs:                 aws.StringSlice([]string{"arn:old"}),
+				AuthorizerResultTtlInSeconds: aws.Int64(300),
+			},
+			want: []*awsapigateway.PatchOperation{
+				op(awsapigateway.OpReplace, "/authorizerResultTtlInSeconds", "0"),
+				op(awsapigateway.OpAdd, "/providerARNs", poolARN),
+				op(awsapigateway.OpRemove, "/providerARNs", "arn:old"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateAuthorizerPatch(tc.p, tc.observed), ignoreUnexported); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
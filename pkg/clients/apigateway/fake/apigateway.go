@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/apigateway"
+)
+
+// MockClient is a type that implements all the methods for the API Gateway
+// Client interface.
+type MockClient struct {
+	MockCreateApiKey       func(ctx context.Context, input *apigateway.CreateApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockGetApiKey          func(ctx context.Context, input *apigateway.GetApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockUpdateApiKey       func(ctx context.Context, input *apigateway.UpdateApiKeyInput, opts []request.Option) (*apigateway.ApiKey, error)
+	MockDeleteApiKey       func(ctx context.Context, input *apigateway.DeleteApiKeyInput, opts []request.Option) (*apigateway.DeleteApiKeyOutput, error)
+	MockCreateUsagePlan    func(ctx context.Context, input *apigateway.CreateUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockGetUsagePlan       func(ctx context.Context, input *apigateway.GetUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockUpdateUsagePlan    func(ctx context.Context, input *apigateway.UpdateUsagePlanInput, opts []request.Option) (*apigateway.UsagePlan, error)
+	MockDeleteUsagePlan    func(ctx context.Context, input *apigateway.DeleteUsagePlanInput, opts []request.Option) (*apigateway.DeleteUsagePlanOutput, error)
+	MockCreateUsagePlanKey func(ctx context.Context, input *apigateway.CreateUsagePlanKeyInput, opts []request.Option) (*apigateway.UsagePlanKey, error)
+	MockGetUsagePlanKey    func(ctx context.Context, input *apigateway.GetUsagePlanKeyInput, opts []request.Option) (*apigateway.UsagePlanKey, error)
+	MockDeleteUsagePlanKey func(ctx context.Context, input *apigateway.DeleteUsagePlanKeyInput, opts []request.Option) (*apigateway.DeleteUsagePlanKeyOutput, error)
+	MockCreateAuthorizer   func(ctx context.Context, input *apigateway.CreateAuthorizerInput, opts []request.Option) (*apigateway.Authorizer, error)
+	MockGetAuthorizer      func(ctx context.Context, input *apigateway.GetAuthorizerInput, opts []request.Option) (*apigateway.Authorizer, error)
+	MockUpdateAuthorizer   func(ctx context.Context, input *apigateway.UpdateAuthorizerInput, opts []request.Option) (*apigateway.Authorizer, error)
+	MockDeleteAuthorizer   func(ctx context.Context, input *apigateway.DeleteAuthorizerInput, opts []request.Option) (*apigateway.DeleteAuthorizerOutput, error)
+}
+
+// CreateApiKeyWithContext mocks CreateApiKeyWithContext method
+func (m *MockClient) CreateApiKeyWithContext(ctx context.Context, input *apigateway.CreateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockCreateApiKey(ctx, input, opts)
+}
+
+// GetApiKeyWithContext mocks GetApiKeyWithContext method
+func (m *MockClient) GetApiKeyWithContext(ctx context.Context, input *apigateway.GetApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockGetApiKey(ctx, input, opts)
+}
+
+// UpdateApiKeyWithContext mocks UpdateApiKeyWithContext method
+func (m *MockClient) UpdateApiKeyWithContext(ctx context.Context, input *apigateway.UpdateApiKeyInput, opts ...request.Option) (*apigateway.ApiKey, error) {
+	return m.MockUpdateApiKey(ctx, input, opts)
+}
+
+// DeleteApiKeyWithContext mocks DeleteApiKeyWithContext method
+func (m *MockClient) DeleteApiKeyWithContext(ctx context.Context, input *apigateway.DeleteApiKeyInput, opts ...request.Option) (*apigateway.DeleteApiKeyOutput, error) {
+	return m.MockDeleteApiKey(ctx, input, opts)
+}
+
+// CreateUsagePlanWithContext mocks CreateUsagePlanWithContext method
+func (m *MockClient) CreateUsagePlanWithContext(ctx context.Context, input *apigateway.CreateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockCreateUsagePlan(ctx, input, opts)
+}
+
+// GetUsagePlanWithContext mocks GetUsagePlanWithContext method
+func (m *MockClient) GetUsagePlanWithContext(ctx context.Context, input *apigateway.GetUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockGetUsagePlan(ctx, input, opts)
+}
+
+// UpdateUsagePlanWithContext mocks UpdateUsagePlanWithContext method
+func (m *MockClient) UpdateUsagePlanWithContext(ctx context.Context, input *apigateway.UpdateUsagePlanInput, opts ...request.Option) (*apigateway.UsagePlan, error) {
+	return m.MockUpdateUsagePlan(ctx, input, opts)
+}
+
+// DeleteUsagePlanWithContext mocks DeleteUsagePlanWithContext method
+func (m *MockClient) DeleteUsagePlanWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanInput, opts ...request.Option) (*apigateway.DeleteUsagePlanOutput, error) {
+	return m.MockDeleteUsagePlan(ctx, input, opts)
+}
+
+// CreateUsagePlanKeyWithContext mocks CreateUsagePlanKeyWithContext method
+func (m *MockClient) CreateUsagePlanKeyWithContext(ctx context.Context, input *apigateway.CreateUsagePlanKeyInput, opts ...request.Option) (*apigateway.UsagePlanKey, error) {
+	return m.MockCreateUsagePlanKey(ctx, input, opts)
+}
+
+// GetUsagePlanKeyWithContext mocks GetUsagePlanKeyWithContext method
+func (m *MockClient) GetUsagePlanKeyWithContext(ctx context.Context, input *apigateway.GetUsagePlanKeyInput, opts ...request.Option) (*apigateway.UsagePlanKey, error) {
+	return m.MockGetUsagePlanKey(ctx, input, opts)
+}
+
+// DeleteUsagePlanKeyWithContext mocks DeleteUsagePlanKeyWithContext method
+func (m *MockClient) DeleteUsagePlanKeyWithContext(ctx context.Context, input *apigateway.DeleteUsagePlanKeyInput, opts ...request.Option) (*apigateway.DeleteUsagePlanKeyOutput, error) {
+	return m.MockDeleteUsagePlanKey(ctx, input, opts)
+}
+
+// CreateAuthorizerWithContext mocks CreateAuthorizerWithContext method
+func (m *MockClient) CreateAuthorizerWithContext(ctx context.Context, input *apigateway.CreateAuthorizerInput, opts ...request.Option) (*apigateway.Authorizer, error) {
+	return m.MockCreateAuthorizer(ctx, input, opts)
+}
+
+// GetAuthorizerWithContext mocks GetAuthorizerWithContext method
+func (m *MockClient) GetAuthorizerWithContext(ctx context.Context, input *apigateway.GetAuthorizerInput, opts ...request.Option) (*apigateway.Authorizer, error) {
+	return m.MockGetAuthorizer(ctx, input, opts)
+}
+
+// UpdateAuthorizerWithContext mocks UpdateAuthorizerWithContext method
+func (m *MockClient) UpdateAuthorizerWithContext(ctx context.Context, input *apigateway.UpdateAuthorizerInput, opts ...request.Option) (*apigateway.Authorizer, error) {
+	return m.MockUpdateAuthorizer(ctx, input, opts)
+}
+
+// DeleteAuthorizerWithContext mocks DeleteAuthorizerWithContext method
+func (m *MockClient) DeleteAuthorizerWithContext(ctx context.Context, input *apigateway.DeleteAuthorizerInput, opts ...request.Option) (*apigateway.DeleteAuthorizerOutput, error) {
+	return m.MockDeleteAuthorizer(ctx, input, opts)
+}
@@ -0,0 +1,361 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apply contains a Kubernetes client that writes managed resources
+// using server-side apply, so that the provider only takes ownership of the
+// fields it actually sets.
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// FieldOwner is the field manager the provider applies spec changes with. It
+// prefixes the field managers of the controllers of each kind.
+const FieldOwner = "provider-aws"
+
+const (
+	errGetGVK         = "cannot get GroupVersionKind of object"
+	errToUnstructured = "cannot convert object to unstructured"
+	errFromApplied    = "cannot convert applied object"
+	errGetLive        = "cannot get object to compute applied fields"
+	errApply          = "cannot apply object"
+	errApplyStatus    = "cannot apply object status"
+)
+
+// NewClientFunc returns a cluster.NewClientFunc that wraps the default client
+// of a controller manager with a Client using the supplied field owner.
+func NewClientFunc(owner string) cluster.NewClientFunc {
+	return func(c cache.Cache, config *rest.Config, o client.Options, uncached ...client.Object) (client.Client, error) {
+		kube, err := cluster.DefaultNewClient(c, config, o, uncached...)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(kube, owner), nil
+	}
+}
+
+// A Client writes managed resources using server-side apply. Status updates
+// are applied with forced ownership; the provider is the only writer of the
+// status of a managed resource. Other updates, e.g. late initialization,
+// resolved references and the external name, apply the fields they change
+// along with the fields the provider applied before, as one field owner and
+// without forcing ownership. Fields owned only by other field managers are
+// therefore neither overwritten nor co-owned by the provider, so that they
+// are deleted when their owners remove them. Conflicts are returned as
+// errors, which the managed reconciler reports in the Synced condition of the
+// managed resource.
+//
+// Status updates are applied as the field owner of the controller of the
+// kind, e.g. provider-aws/queue.sqs.aws.crossplane.io, and without a
+// resource version. They therefore never fail because the managed resource
+// was changed concurrently, e.g. by another reconcile. Forcing ownership is
+// safe because the status is a subresource: applies of the spec never own
+// status fields and applies of the status never own spec fields, so they
+// can't conflict. Forcing only takes over status fields written by other
+// field managers, e.g. by earlier versions of the provider that updated the
+// status, which the controller of the kind is the authority for.
+//
+// Updates that remove fields can't be expressed as an apply, since fields
+// that are also owned by other field managers would be kept, and fall back
+// to a regular update, as do updates of other objects.
+type Client struct {
+	client.Client
+	owner string
+}
+
+// NewClient returns a Client that wraps the supplied client.
+func NewClient(c client.Client, owner string) *Client {
+	return &Client{Client: c, owner: owner}
+}
+
+// Update the supplied object. Managed resources that changed are updated by
+// applying the changed fields of their spec, labels and annotations, and the
+// fields of those the provider already owns.
+func (c *Client) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if _, ok := obj.(resource.Managed); !ok || len(opts) > 0 {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+
+	live, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+	if err := c.Client.Get(ctx, client.ObjectKeyFromObject(obj), live); err != nil {
+		return errors.Wrap(err, errGetLive)
+	}
+
+	observed, err := runtime.DefaultUnstructuredConverter.ToUnstructured(live)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	owned := ownedFields(desired, live, c.owner)
+	changed, ok := changedFields(observed, desired)
+	if !ok {
+		return c.Client.Update(ctx, obj, opts...)
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	u, err := c.applyConfiguration(obj)
+	if err != nil {
+		return err
+	}
+	// A field manager gives up the fields it leaves out of an apply, which
+	// deletes them unless another manager owns them. The fields the provider
+	// owns are therefore applied along with the changed ones. Setting the
+	// resource version makes the apply fail if the object was changed since
+	// it was read, just like an update would.
+	merge(u.Object, owned)
+	merge(u.Object, changed)
+	u.SetResourceVersion(obj.GetResourceVersion())
+
+	if err := c.Client.Patch(ctx, u, client.Apply, client.FieldOwner(c.owner)); err != nil {
+		return errors.Wrap(err, errApply)
+	}
+	return errors.Wrap(fromUnstructured(u, obj), errFromApplied)
+}
+
+// Status returns a client.StatusWriter that applies the status of managed
+// resources.
+func (c *Client) Status() client.StatusWriter {
+	return &statusWriter{StatusWriter: c.Client.Status(), client: c}
+}
+
+type statusWriter struct {
+	client.StatusWriter
+	client *Client
+}
+
+// Update the status of the supplied object. The status of managed resources
+// is applied with forced ownership.
+func (s *statusWriter) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	if _, ok := obj.(resource.Managed); !ok || len(opts) > 0 {
+		return s.StatusWriter.Update(ctx, obj, opts...)
+	}
+
+	desired, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	u, err := s.client.applyConfiguration(obj)
+	if err != nil {
+		return err
+	}
+	if status, ok := desired["status"]; ok {
+		u.Object["status"] = status
+	}
+
+	if err := s.StatusWriter.Patch(ctx, u, client.Apply, client.FieldOwner(s.client.ownerOf(u)), client.ForceOwnership); err != nil {
+		return errors.Wrap(err, errApplyStatus)
+	}
+	return errors.Wrap(fromUnstructured(u, obj), errFromApplied)
+}
+
+// applyConfiguration returns an empty apply configuration for the supplied
+// object, i.e. one that only identifies it.
+func (c *Client) applyConfiguration(obj client.Object) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return nil, errors.Wrap(err, errGetGVK)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(gvk)
+	u.SetName(obj.GetName())
+	u.SetNamespace(obj.GetNamespace())
+	return u, nil
+}
+
+// ownerOf returns the field owner of the controller of the kind of the
+// supplied apply configuration.
+func (c *Client) ownerOf(u *unstructured.Unstructured) string {
+	return c.owner + "/" + strings.ToLower(u.GroupVersionKind().GroupKind().String())
+}
+
+// fromUnstructured replaces the content of obj with the object returned by
+// an apply.
+func fromUnstructured(u *unstructured.Unstructured, obj client.Object) error {
+	n := reflect.New(reflect.TypeOf(obj).Elem())
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, n.Interface()); err != nil {
+		return err
+	}
+	reflect.ValueOf(obj).Elem().Set(n.Elem())
+	return nil
+}
+
+// ownedFields returns the spec, labels and annotations of desired that the
+// supplied field owner applied to live. Lists are returned as a whole.
+func ownedFields(desired map[string]interface{}, live client.Object, owner string) map[string]interface{} {
+	owned := map[string]interface{}{}
+	for _, mf := range live.GetManagedFields() {
+		if mf.Manager != owner || mf.Operation != metav1.ManagedFieldsOperationApply || mf.Subresource != "" || mf.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(mf.FieldsV1.Raw, &fields); err != nil {
+			continue
+		}
+		m, _ := fields["f:metadata"].(map[string]interface{})
+		fields = map[string]interface{}{
+			"f:spec":     fields["f:spec"],
+			"f:metadata": map[string]interface{}{"f:labels": m["f:labels"], "f:annotations": m["f:annotations"]},
+		}
+		if o, ok := extract(desired, fields).(map[string]interface{}); ok {
+			merge(owned, o)
+		}
+	}
+	return owned
+}
+
+// extract returns the parts of v that are in the supplied set of fields, in
+// the FieldsV1 format of managed fields. It returns nil if there are none.
+func extract(v interface{}, fields map[string]interface{}) interface{} {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return v
+	}
+	out := map[string]interface{}{}
+	for f, sub := range fields {
+		k := strings.TrimPrefix(f, "f:")
+		fv, ok := m[k]
+		if k == f || !ok {
+			continue
+		}
+		if sf, ok := sub.(map[string]interface{}); ok && len(sf) > 0 {
+			fv = extract(fv, sf)
+		}
+		if fv != nil {
+			out[k] = fv
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// merge sets the fields of src in dst, merging maps.
+func merge(dst, src map[string]interface{}) {
+	for k, sv := range src {
+		sm, sok := sv.(map[string]interface{})
+		dm, dok := dst[k].(map[string]interface{})
+		if sok && dok {
+			merge(dm, sm)
+			continue
+		}
+		dst[k] = sv
+	}
+}
+
+// changedFields returns the spec, labels and annotations of desired that are
+// missing from or differ from those of observed. It returns false if desired
+// changes anything else, or removes a field, neither of which an apply of the
+// changed fields can express.
+func changedFields(observed, desired map[string]interface{}) (map[string]interface{}, bool) {
+	for _, o := range []map[string]interface{}{observed, desired} {
+		delete(o, "status")
+		unstructured.RemoveNestedField(o, "metadata", "resourceVersion")
+		unstructured.RemoveNestedField(o, "metadata", "managedFields")
+	}
+
+	changed := map[string]interface{}{}
+	metadata := map[string]interface{}{}
+	for _, path := range [][]string{{"spec"}, {"metadata", "labels"}, {"metadata", "annotations"}} {
+		o, _, _ := unstructured.NestedFieldNoCopy(observed, path...)
+		d, _, _ := unstructured.NestedFieldNoCopy(desired, path...)
+		unstructured.RemoveNestedField(observed, path...)
+		unstructured.RemoveNestedField(desired, path...)
+
+		c, ok := diff(o, d)
+		if !ok {
+			return nil, false
+		}
+		if c == nil {
+			continue
+		}
+		if path[0] == "metadata" {
+			metadata[path[1]] = c
+			continue
+		}
+		changed[path[0]] = c
+	}
+	if len(metadata) > 0 {
+		changed["metadata"] = metadata
+	}
+
+	// Anything else must be unchanged.
+	if !reflect.DeepEqual(observed, desired) {
+		return nil, false
+	}
+	return changed, true
+}
+
+// diff returns the parts of desired that differ from observed. Lists are
+// compared as a whole. It returns false if desired lacks a field that is set
+// in observed.
+func diff(observed, desired interface{}) (interface{}, bool) {
+	if desired == nil {
+		return nil, observed == nil
+	}
+	om, ook := observed.(map[string]interface{})
+	dm, dok := desired.(map[string]interface{})
+	if !ook || !dok {
+		if reflect.DeepEqual(observed, desired) {
+			return nil, true
+		}
+		return desired, true
+	}
+
+	for k := range om {
+		if _, ok := dm[k]; !ok {
+			return nil, false
+		}
+	}
+	changed := map[string]interface{}{}
+	for k, dv := range dm {
+		c, ok := diff(om[k], dv)
+		if !ok {
+			return nil, false
+		}
+		if c != nil {
+			changed[k] = c
+		}
+	}
+	if len(changed) == 0 {
+		return nil, true
+	}
+	return changed, true
+}
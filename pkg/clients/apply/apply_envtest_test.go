@@ -0,0 +1,207 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// startEnv starts an API server with the Queue CRD and returns a client for
+// it, or skips the test unless the control plane binaries are available, see
+// setup-envtest.
+func startEnv(t *testing.T) (client.Client, func()) {
+	t.Helper()
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS is not set")
+	}
+	env := &envtest.Environment{
+		CRDInstallOptions: envtest.CRDInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "..", "package", "crds", "sqs.aws.crossplane.io_queues.yaml")},
+		},
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("Start(): %v", err)
+	}
+	kube, err := client.New(cfg, client.Options{Scheme: scheme()})
+	if err != nil {
+		_ = env.Stop()
+		t.Fatalf("New(...): %v", err)
+	}
+	return kube, func() { _ = env.Stop() }
+}
+
+// TestUpdateEnvtest runs updates against a real API server, since only it
+// tracks which field manager owns which field.
+func TestUpdateEnvtest(t *testing.T) {
+	kube, stop := startEnv(t)
+	defer stop()
+	c := NewClient(kube, FieldOwner)
+	ctx := context.Background()
+
+	q := queue()
+	q.SetResourceVersion("")
+	if err := kube.Create(ctx, q); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+
+	// Late initialization, the external name and a later spec change are
+	// each written by their own update. None of them may drop the fields
+	// written by the others.
+	q.Spec.ForProvider.DelaySeconds = awsclients.Int64(5)
+	if err := c.Update(ctx, q); err != nil {
+		t.Fatalf("Update(...): late initialization: %v", err)
+	}
+	meta.SetExternalName(q, "url")
+	if err := c.Update(ctx, q); err != nil {
+		t.Fatalf("Update(...): external name: %v", err)
+	}
+	q.Spec.ForProvider.MaximumMessageSize = awsclients.Int64(1024)
+	if err := c.Update(ctx, q); err != nil {
+		t.Fatalf("Update(...): spec: %v", err)
+	}
+
+	got := &v1beta1.Queue{}
+	if err := kube.Get(ctx, client.ObjectKeyFromObject(q), got); err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	if diff := cmp.Diff(q.Spec.ForProvider, got.Spec.ForProvider); diff != "" {
+		t.Errorf("Update(...): -want spec, +got spec:\n%s", diff)
+	}
+	if diff := cmp.Diff("url", meta.GetExternalName(got)); diff != "" {
+		t.Errorf("Update(...): -want external name, +got external name:\n%s", diff)
+	}
+
+	// An update whose resource version is outdated must fail, like a
+	// regular update would.
+	stale := got.DeepCopy()
+	got.Spec.ForProvider.VisibilityTimeout = awsclients.Int64(30)
+	if err := c.Update(ctx, got); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	stale.Spec.ForProvider.VisibilityTimeout = awsclients.Int64(60)
+	if err := c.Update(ctx, stale); err == nil {
+		t.Errorf("Update(...): want conflict for stale resource version")
+	}
+
+	// A field owned only by a user survives updates of the provider, and is
+	// deleted when the user removes it.
+	user := &unstructured.Unstructured{}
+	user.SetGroupVersionKind(v1beta1.QueueGroupVersionKind)
+	user.SetName(q.GetName())
+	_ = unstructured.SetNestedField(user.Object, "p", "spec", "forProvider", "policy")
+	if err := kube.Patch(ctx, user.DeepCopy(), client.Apply, client.FieldOwner("user")); err != nil {
+		t.Fatalf("Patch(...): user: %v", err)
+	}
+	if err := kube.Get(ctx, client.ObjectKeyFromObject(q), got); err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	got.Spec.ForProvider.MessageRetentionPeriod = awsclients.Int64(3600)
+	if err := c.Update(ctx, got); err != nil {
+		t.Fatalf("Update(...): user field: %v", err)
+	}
+	if diff := cmp.Diff(awsclients.String("p"), got.Spec.ForProvider.Policy); diff != "" {
+		t.Errorf("Update(...): -want user field, +got user field:\n%s", diff)
+	}
+	unstructured.RemoveNestedField(user.Object, "spec")
+	if err := kube.Patch(ctx, user.DeepCopy(), client.Apply, client.FieldOwner("user")); err != nil {
+		t.Fatalf("Patch(...): user removal: %v", err)
+	}
+	if err := kube.Get(ctx, client.ObjectKeyFromObject(q), got); err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	if got.Spec.ForProvider.Policy != nil {
+		t.Errorf("Patch(...): want user field removed, got %q", *got.Spec.ForProvider.Policy)
+	}
+	if diff := cmp.Diff(awsclients.Int64(3600), got.Spec.ForProvider.MessageRetentionPeriod); diff != "" {
+		t.Errorf("Patch(...): -want provider field, +got provider field:\n%s", diff)
+	}
+}
+
+// TestStatusUpdateEnvtest checks that forcing the ownership of the status
+// never conflicts with the field owner of the spec, and takes over status
+// fields from other field managers.
+func TestStatusUpdateEnvtest(t *testing.T) {
+	kube, stop := startEnv(t)
+	defer stop()
+	c := NewClient(kube, FieldOwner)
+	ctx := context.Background()
+
+	q := queue()
+	q.SetResourceVersion("")
+	if err := kube.Create(ctx, q); err != nil {
+		t.Fatalf("Create(...): %v", err)
+	}
+	q.Status.SetConditions(xpv1.Creating())
+	if err := kube.Status().Update(ctx, q, client.FieldOwner("other")); err != nil {
+		t.Fatalf("Status().Update(...): other: %v", err)
+	}
+
+	// Spec and status updates alternate, as they do in the managed
+	// reconciler. None of them may conflict with another.
+	q.Spec.ForProvider.DelaySeconds = awsclients.Int64(5)
+	if err := c.Update(ctx, q); err != nil {
+		t.Fatalf("Update(...): %v", err)
+	}
+	q.Status.SetConditions(xpv1.Available())
+	if err := c.Status().Update(ctx, q); err != nil {
+		t.Fatalf("Status().Update(...): %v", err)
+	}
+	q.Spec.ForProvider.MaximumMessageSize = awsclients.Int64(1024)
+	if err := c.Update(ctx, q); err != nil {
+		t.Fatalf("Update(...): spec after status: %v", err)
+	}
+
+	got := &v1beta1.Queue{}
+	if err := kube.Get(ctx, client.ObjectKeyFromObject(q), got); err != nil {
+		t.Fatalf("Get(...): %v", err)
+	}
+	if diff := cmp.Diff(xpv1.Available(), got.Status.GetCondition(xpv1.TypeReady), test.EquateConditions()); diff != "" {
+		t.Errorf("Status().Update(...): -want condition, +got condition:\n%s", diff)
+	}
+	if diff := cmp.Diff(q.Spec.ForProvider, got.Spec.ForProvider); diff != "" {
+		t.Errorf("Update(...): -want spec, +got spec:\n%s", diff)
+	}
+	for _, mf := range got.GetManagedFields() {
+		fields := string(mf.FieldsV1.Raw)
+		switch {
+		case mf.Manager == FieldOwner && strings.Contains(fields, `"f:status"`):
+			t.Errorf("Update(...): %s owns status fields: %s", mf.Manager, fields)
+		case mf.Manager == queueOwner && strings.Contains(fields, `"f:spec"`):
+			t.Errorf("Status().Update(...): %s owns spec fields: %s", mf.Manager, fields)
+		case mf.Manager == "other" && strings.Contains(fields, `"f:conditions"`):
+			t.Errorf("Status().Update(...): %s still owns the conditions: %s", mf.Manager, fields)
+		}
+	}
+}
@@ -0,0 +1,426 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+const queueOwner = FieldOwner + "/queue.sqs.aws.crossplane.io"
+
+type queueModifier func(*v1beta1.Queue)
+
+func withDelay(d int64) queueModifier {
+	return func(q *v1beta1.Queue) { q.Spec.ForProvider.DelaySeconds = &d }
+}
+
+func withMaximumMessageSize(m int64) queueModifier {
+	return func(q *v1beta1.Queue) { q.Spec.ForProvider.MaximumMessageSize = &m }
+}
+
+func withPolicy(p string) queueModifier {
+	return func(q *v1beta1.Queue) { q.Spec.ForProvider.Policy = &p }
+}
+
+func withAnnotation(k, v string) queueModifier {
+	return func(q *v1beta1.Queue) {
+		if q.Annotations == nil {
+			q.Annotations = map[string]string{}
+		}
+		q.Annotations[k] = v
+	}
+}
+
+func withManagedFields(manager string, op metav1.ManagedFieldsOperationType, fields string) queueModifier {
+	return func(q *v1beta1.Queue) {
+		q.ManagedFields = append(q.ManagedFields, metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  op,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(fields)},
+		})
+	}
+}
+
+func withFinalizer(f string) queueModifier {
+	return func(q *v1beta1.Queue) { q.Finalizers = append(q.Finalizers, f) }
+}
+
+func withConditions(c ...xpv1.Condition) queueModifier {
+	return func(q *v1beta1.Queue) { q.Status.SetConditions(c...) }
+}
+
+func queue(m ...queueModifier) *v1beta1.Queue {
+	q := &v1beta1.Queue{}
+	q.SetName("example")
+	q.SetResourceVersion("1")
+	q.Spec.ForProvider.Region = "us-east-1"
+	for _, f := range m {
+		f(q)
+	}
+	return q
+}
+
+func scheme() *runtime.Scheme {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	return s
+}
+
+func TestUpdate(t *testing.T) {
+	type args struct {
+		kube client.Client
+		obj  client.Object
+	}
+
+	type want struct {
+		obj client.Object
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ApplyChangedFields": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue(withPolicy("p"), withManagedFields("kubectl", metav1.ManagedFieldsOperationUpdate, `{"f:spec":{"f:forProvider":{"f:policy":{},"f:region":{}}}}`)).DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockPatch: func(_ context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+						want := map[string]interface{}{
+							"apiVersion": v1beta1.QueueGroupVersionKind.GroupVersion().String(),
+							"kind":       v1beta1.QueueKind,
+							"metadata": map[string]interface{}{
+								"name":            "example",
+								"resourceVersion": "1",
+								"annotations":     map[string]interface{}{"crossplane.io/external-name": "url"},
+							},
+							"spec": map[string]interface{}{
+								"forProvider": map[string]interface{}{"delaySeconds": int64(5)},
+							},
+						}
+						if diff := cmp.Diff(want, obj.(*unstructured.Unstructured).Object); diff != "" {
+							t.Errorf("Patch(...): -want, +got:\n%s", diff)
+						}
+						if p != client.Apply {
+							t.Errorf("Patch(...): want apply patch, got %s", p.Type())
+						}
+						po := &client.PatchOptions{}
+						po.ApplyOptions(opts)
+						if po.Force != nil || po.FieldManager != FieldOwner {
+							t.Errorf("Patch(...): want unforced apply as %s, got %+v", FieldOwner, po)
+						}
+						applied := queue(withPolicy("p"), withDelay(5), withAnnotation("crossplane.io/external-name", "url"))
+						applied.SetResourceVersion("2")
+						u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(applied)
+						obj.(*unstructured.Unstructured).Object = u
+						return nil
+					},
+					MockScheme: test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withPolicy("p"), withDelay(5), withAnnotation("crossplane.io/external-name", "url"),
+					withManagedFields("kubectl", metav1.ManagedFieldsOperationUpdate, `{"f:spec":{"f:forProvider":{"f:policy":{},"f:region":{}}}}`)),
+			},
+			want: want{
+				obj: func() client.Object {
+					q := queue(withPolicy("p"), withDelay(5), withAnnotation("crossplane.io/external-name", "url"))
+					q.SetResourceVersion("2")
+					return q
+				}(),
+			},
+		},
+		"ApplyOwnedFields": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue(withPolicy("p"), withDelay(5), withAnnotation("crossplane.io/external-name", "url"),
+							withManagedFields("kubectl", metav1.ManagedFieldsOperationUpdate, `{"f:spec":{"f:forProvider":{"f:policy":{},"f:region":{}}}}`),
+							withManagedFields(FieldOwner, metav1.ManagedFieldsOperationApply, `{"f:metadata":{"f:annotations":{"f:crossplane.io/external-name":{}}},"f:spec":{"f:forProvider":{"f:delaySeconds":{}}}}`),
+						).DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockPatch: func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) error {
+						want := map[string]interface{}{
+							"apiVersion": v1beta1.QueueGroupVersionKind.GroupVersion().String(),
+							"kind":       v1beta1.QueueKind,
+							"metadata": map[string]interface{}{
+								"name":            "example",
+								"resourceVersion": "1",
+								"annotations":     map[string]interface{}{"crossplane.io/external-name": "url"},
+							},
+							"spec": map[string]interface{}{
+								"forProvider": map[string]interface{}{"delaySeconds": int64(5), "maximumMessageSize": int64(1024)},
+							},
+						}
+						if diff := cmp.Diff(want, obj.(*unstructured.Unstructured).Object); diff != "" {
+							t.Errorf("Patch(...): -want, +got:\n%s", diff)
+						}
+						applied := queue(withPolicy("p"), withDelay(5), withMaximumMessageSize(1024), withAnnotation("crossplane.io/external-name", "url"))
+						applied.SetResourceVersion("2")
+						u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(applied)
+						obj.(*unstructured.Unstructured).Object = u
+						return nil
+					},
+					MockScheme: test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withPolicy("p"), withDelay(5), withMaximumMessageSize(1024), withAnnotation("crossplane.io/external-name", "url")),
+			},
+			want: want{
+				obj: func() client.Object {
+					q := queue(withPolicy("p"), withDelay(5), withMaximumMessageSize(1024), withAnnotation("crossplane.io/external-name", "url"))
+					q.SetResourceVersion("2")
+					return q
+				}(),
+			},
+		},
+		"ApplyChangedMetadata": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue(withDelay(5)).DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockPatch: func(_ context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+						want := map[string]interface{}{
+							"apiVersion": v1beta1.QueueGroupVersionKind.GroupVersion().String(),
+							"kind":       v1beta1.QueueKind,
+							"metadata": map[string]interface{}{
+								"name":            "example",
+								"resourceVersion": "1",
+								"annotations":     map[string]interface{}{"crossplane.io/external-name": "url"},
+							},
+						}
+						if diff := cmp.Diff(want, obj.(*unstructured.Unstructured).Object); diff != "" {
+							t.Errorf("Patch(...): -want, +got:\n%s", diff)
+						}
+						po := &client.PatchOptions{}
+						po.ApplyOptions(opts)
+						if po.Force != nil || po.FieldManager != FieldOwner {
+							t.Errorf("Patch(...): want unforced apply as %s, got %+v", FieldOwner, po)
+						}
+						applied := queue(withDelay(5), withAnnotation("crossplane.io/external-name", "url"))
+						applied.SetResourceVersion("3")
+						u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(applied)
+						obj.(*unstructured.Unstructured).Object = u
+						return nil
+					},
+					MockScheme: test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withDelay(5), withAnnotation("crossplane.io/external-name", "url")),
+			},
+			want: want{
+				obj: func() client.Object {
+					q := queue(withDelay(5), withAnnotation("crossplane.io/external-name", "url"))
+					q.SetResourceVersion("3")
+					return q
+				}(),
+			},
+		},
+		"NoChanges": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue(withDelay(5)).DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+				},
+				obj: queue(withDelay(5)),
+			},
+			want: want{
+				obj: queue(withDelay(5)),
+			},
+		},
+		"RemovedFieldFallsBackToUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue(withPolicy("p")).DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				obj: queue(),
+			},
+			want: want{
+				obj: queue(),
+			},
+		},
+		"FinalizerFallsBackToUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue().DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockUpdate: test.NewMockUpdateFn(nil),
+				},
+				obj: queue(withFinalizer("finalizer.managedresource.crossplane.io")),
+			},
+			want: want{
+				obj: queue(withFinalizer("finalizer.managedresource.crossplane.io")),
+			},
+		},
+		"NotManaged": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				obj: &corev1.Secret{},
+			},
+			want: want{
+				obj: &corev1.Secret{},
+				err: errBoom,
+			},
+		},
+		"GetError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				obj: queue(),
+			},
+			want: want{
+				obj: queue(),
+				err: errors.Wrap(errBoom, errGetLive),
+			},
+		},
+		"ApplyConflict": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						queue().DeepCopyInto(obj.(*v1beta1.Queue))
+						return nil
+					}),
+					MockPatch:  test.NewMockPatchFn(errBoom),
+					MockScheme: test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withDelay(5)),
+			},
+			want: want{
+				obj: queue(withDelay(5)),
+				err: errors.Wrap(errBoom, errApply),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(tc.args.kube, FieldOwner)
+			err := c.Update(context.Background(), tc.args.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.args.obj, test.EquateConditions()); diff != "" {
+				t.Errorf("Update(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestStatusUpdate(t *testing.T) {
+	type args struct {
+		kube client.Client
+		obj  client.Object
+	}
+
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"ApplyStatus": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusPatch: func(_ context.Context, obj client.Object, p client.Patch, opts ...client.PatchOption) error {
+						u := obj.(*unstructured.Unstructured)
+						if _, ok := u.Object["spec"]; ok {
+							t.Errorf("Status().Patch(...): unexpected spec in %v", u.Object)
+						}
+						conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+						if len(conditions) != 1 {
+							t.Errorf("Status().Patch(...): want 1 condition, got %d", len(conditions))
+						}
+						po := &client.PatchOptions{}
+						po.ApplyOptions(opts)
+						if awsclients.BoolValue(po.Force) != true || po.FieldManager != queueOwner {
+							t.Errorf("Status().Patch(...): want forced apply as %s, got %+v", queueOwner, po)
+						}
+						return nil
+					},
+					MockScheme: test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withDelay(5), withConditions(xpv1.Available())),
+			},
+		},
+		"ApplyError": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusPatch: test.NewMockStatusPatchFn(errBoom),
+					MockScheme:      test.NewMockSchemeFn(scheme()),
+				},
+				obj: queue(withConditions(xpv1.Available())),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errApplyStatus),
+			},
+		},
+		"NotManaged": {
+			args: args{
+				kube: &test.MockClient{
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				obj: &corev1.Secret{},
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewClient(tc.args.kube, FieldOwner)
+			err := c.Status().Update(context.Background(), tc.args.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Status().Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package arnref validates that the ARNs by which managed resources refer to
+// other AWS resources are ARNs of the kind of resource the field requires,
+// e.g. that the dead-letter target of an SQS queue is another SQS queue and
+// not an SNS topic. ARNs are validated after references are resolved and
+// before AWS is called, so that such mistakes surface as a condition of the
+// managed resource instead of as an obscure AWS error, or not at all.
+package arnref
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errFmtNotARN   = "%s: %q is not an ARN"
+	errFmtMismatch = "%s: %q is not the ARN of %s"
+)
+
+// TypeInvalidReference resources refer to an AWS resource of a kind that the
+// referring field does not accept. They are not reconciled with AWS until the
+// reference is corrected.
+const TypeInvalidReference xpv1.ConditionType = "InvalidReference"
+
+// Reasons a resource does or does not refer to invalid AWS resources.
+const (
+	ReasonKindMismatch    xpv1.ConditionReason = "ReferencedKindMismatch"
+	ReasonReferencesValid xpv1.ConditionReason = "ReferencesValid"
+)
+
+// A Kind of AWS resource that an ARN may identify.
+type Kind struct {
+	// Name of the kind, as shown to users.
+	Name string
+
+	// Service that the ARN must belong to, e.g. sqs.
+	Service string
+
+	// Prefix that the resource part of the ARN must start with, e.g.
+	// function: for Lambda functions. Kinds whose resource part is just the
+	// name of the resource, like SQS queues, have no prefix.
+	Prefix string
+
+	// Contains is a string that the resource part of the ARN must contain
+	// after the prefix, if any.
+	Contains string
+}
+
+// Kinds of AWS resources that managed resources commonly refer to by ARN.
+var (
+	SQSQueue       = Kind{Name: "an SQS queue", Service: "sqs"}
+	SNSTopic       = Kind{Name: "an SNS topic", Service: "sns"}
+	LambdaFunction = Kind{Name: "a Lambda function", Service: "lambda", Prefix: "function:"}
+	KinesisStream  = Kind{Name: "a Kinesis stream", Service: "kinesis", Prefix: "stream/"}
+	DynamoDBStream = Kind{Name: "a DynamoDB stream", Service: "dynamodb", Prefix: "table/", Contains: "/stream/"}
+	MSKCluster     = Kind{Name: "an MSK cluster", Service: "kafka", Prefix: "cluster/"}
+	MQBroker       = Kind{Name: "an MQ broker", Service: "mq", Prefix: "broker:"}
+)
+
+// Matches returns true if the supplied ARN identifies a resource of this
+// kind.
+func (k Kind) Matches(a arn.ARN) bool {
+	if a.Service != k.Service {
+		return false
+	}
+	if k.Prefix == "" {
+		// Resources without a type in their ARN can't contain one either,
+		// e.g. an SNS subscription is topic:id, not a topic.
+		return !strings.ContainsAny(a.Resource, ":/")
+	}
+	rest := strings.TrimPrefix(a.Resource, k.Prefix)
+	return rest != a.Resource && rest != "" && strings.Contains(rest, k.Contains)
+}
+
+// A Check validates one field of a managed resource that holds an ARN.
+type Check struct {
+	// Field is the path of the field, e.g.
+	// spec.forProvider.redrivePolicy.deadLetterTargetArn.
+	Field string
+
+	// ARN returns the value of the field, or nil if it is not set or need not
+	// be validated, e.g. because another field determines what it refers to.
+	ARN func(mg resource.Managed) *string
+
+	// Kinds of AWS resources that the field accepts.
+	Kinds []Kind
+}
+
+// Validate the ARNs of the supplied managed resource with the supplied
+// checks. The first ARN that is not of a kind its field accepts is returned
+// as an error.
+func Validate(mg resource.Managed, checks ...Check) error {
+	for _, c := range checks {
+		v := c.ARN(mg)
+		if v == nil || *v == "" {
+			continue
+		}
+		a, err := arn.Parse(*v)
+		if err != nil {
+			return errors.Errorf(errFmtNotARN, c.Field, *v)
+		}
+		if !matchesAny(a, c.Kinds) {
+			return errors.Errorf(errFmtMismatch, c.Field, *v, names(c.Kinds))
+		}
+	}
+	return nil
+}
+
+func matchesAny(a arn.ARN, kinds []Kind) bool {
+	for _, k := range kinds {
+		if k.Matches(a) {
+			return true
+		}
+	}
+	return false
+}
+
+func names(kinds []Kind) string {
+	n := make([]string, len(kinds))
+	for i := range kinds {
+		n[i] = kinds[i].Name
+	}
+	if len(n) < 2 {
+		return strings.Join(n, "")
+	}
+	return strings.Join(n[:len(n)-1], ", ") + " or " + n[len(n)-1]
+}
+
+// InvalidReference returns a condition that indicates a resource refers to an
+// AWS resource of the wrong kind.
+func InvalidReference(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeInvalidReference,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonKindMismatch,
+		Message:            err.Error(),
+	}
+}
+
+// ValidReferences returns a condition that indicates a resource refers only
+// to AWS resources of the right kinds.
+func ValidReferences() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeInvalidReference,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReferencesValid,
+	}
+}
+
+// A ReferenceResolver validates the ARNs of a managed resource after its
+// references are resolved.
+type ReferenceResolver struct {
+	resolver managed.ReferenceResolver
+	checks   []Check
+}
+
+// NewReferenceResolver returns a ReferenceResolver that resolves references
+// with the supplied resolver, then validates ARNs with the supplied checks.
+func NewReferenceResolver(r managed.ReferenceResolver, checks ...Check) *ReferenceResolver {
+	return &ReferenceResolver{resolver: r, checks: checks}
+}
+
+// ResolveReferences of the supplied managed resource and validate its ARNs.
+// A managed resource with an ARN of the wrong kind is marked as such, and an
+// error is returned so that it is not reconciled with AWS. It stays that way
+// until its spec is corrected, since retrying cannot fix it.
+func (r *ReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	if err := r.resolver.ResolveReferences(ctx, mg); err != nil {
+		return err
+	}
+	if err := Validate(mg, r.checks...); err != nil {
+		mg.SetConditions(InvalidReference(err))
+		return err
+	}
+	if mg.GetCondition(TypeInvalidReference).Status == corev1.ConditionTrue {
+		mg.SetConditions(ValidReferences())
+	}
+	return nil
+}
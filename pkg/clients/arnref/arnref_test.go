@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package arnref
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const (
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:dlq"
+	topicARN    = "arn:aws:sns:us-east-1:123456789012:topic"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:fn:live"
+)
+
+var errBoom = errors.New("boom")
+
+// target is where the test checks read the ARN they validate from.
+const target = "target"
+
+func withTarget(v string, c ...xpv1.Condition) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetAnnotations(map[string]string{target: v})
+	mg.SetConditions(c...)
+	return mg
+}
+
+func check(k ...Kind) Check {
+	return Check{
+		Field: "spec.forProvider.target",
+		ARN: func(mg resource.Managed) *string {
+			v, ok := mg.GetAnnotations()[target]
+			if !ok {
+				return nil
+			}
+			return &v
+		},
+		Kinds: k,
+	}
+}
+
+func TestKindMatches(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		kind   Kind
+		arn    string
+		want   bool
+	}{
+		"Queue": {
+			reason: "An SQS queue ARN should match the SQS queue kind.",
+			kind:   SQSQueue,
+			arn:    queueARN,
+			want:   true,
+		},
+		"TopicIsNotQueue": {
+			reason: "An SNS topic ARN should not match the SQS queue kind.",
+			kind:   SQSQueue,
+			arn:    topicARN,
+			want:   false,
+		},
+		"SubscriptionIsNotTopic": {
+			reason: "An SNS subscription ARN should not match the SNS topic kind.",
+			kind:   SNSTopic,
+			arn:    topicARN + ":0f1e2d3c",
+			want:   false,
+		},
+		"QualifiedFunction": {
+			reason: "A qualified Lambda function ARN should match the Lambda function kind.",
+			kind:   LambdaFunction,
+			arn:    functionARN,
+			want:   true,
+		},
+		"LayerIsNotFunction": {
+			reason: "A Lambda layer ARN should not match the Lambda function kind.",
+			kind:   LambdaFunction,
+			arn:    "arn:aws:lambda:us-east-1:123456789012:layer:deps:1",
+			want:   false,
+		},
+		"DynamoDBStream": {
+			reason: "A DynamoDB stream ARN should match the DynamoDB stream kind.",
+			kind:   DynamoDBStream,
+			arn:    "arn:aws:dynamodb:us-east-1:123456789012:table/t/stream/2022-01-01T00:00:00.000",
+			want:   true,
+		},
+		"TableIsNotStream": {
+			reason: "A DynamoDB table ARN should not match the DynamoDB stream kind.",
+			kind:   DynamoDBStream,
+			arn:    "arn:aws:dynamodb:us-east-1:123456789012:table/t",
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, err := arn.Parse(tc.arn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, tc.kind.Matches(a)); diff != "" {
+				t.Errorf("\n%s\nMatches(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		checks []Check
+		want   error
+	}{
+		"Unset": {
+			reason: "Fields that are not set should not be validated.",
+			mg:     &fake.Managed{},
+			checks: []Check{check(SQSQueue)},
+		},
+		"Valid": {
+			reason: "An ARN of a kind the field accepts should be valid.",
+			mg:     withTarget(topicARN),
+			checks: []Check{check(SQSQueue, SNSTopic)},
+		},
+		"NotARN": {
+			reason: "A value that is not an ARN should be invalid.",
+			mg:     withTarget("dlq"),
+			checks: []Check{check(SQSQueue)},
+			want:   errors.Errorf(errFmtNotARN, "spec.forProvider.target", "dlq"),
+		},
+		"Mismatch": {
+			reason: "An ARN of a kind the field does not accept should be invalid.",
+			mg:     withTarget(topicARN),
+			checks: []Check{check(SQSQueue)},
+			want:   errors.Errorf(errFmtMismatch, "spec.forProvider.target", topicARN, "an SQS queue"),
+		},
+		"MismatchAny": {
+			reason: "The error should name every kind the field accepts.",
+			mg:     withTarget(functionARN),
+			checks: []Check{check(SQSQueue, SNSTopic, KinesisStream)},
+			want:   errors.Errorf(errFmtMismatch, "spec.forProvider.target", functionARN, "an SQS queue, an SNS topic or a Kinesis stream"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := Validate(tc.mg, tc.checks...)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestResolveReferences(t *testing.T) {
+	type want struct {
+		err       error
+		condition xpv1.Condition
+	}
+	cases := map[string]struct {
+		reason   string
+		resolver managed.ReferenceResolver
+		mg       resource.Managed
+		want     want
+	}{
+		"ResolveError": {
+			reason: "Errors resolving references should be returned without validating ARNs.",
+			resolver: managed.ReferenceResolverFn(func(context.Context, resource.Managed) error {
+				return errBoom
+			}),
+			mg: withTarget(topicARN),
+			want: want{
+				err:       errBoom,
+				condition: xpv1.Condition{Type: TypeInvalidReference, Status: corev1.ConditionUnknown},
+			},
+		},
+		"Invalid": {
+			reason:   "Resources that refer to AWS resources of the wrong kind should be marked as such.",
+			resolver: managed.ReferenceResolverFn(func(context.Context, resource.Managed) error { return nil }),
+			mg:       withTarget(topicARN),
+			want: want{
+				err:       errors.Errorf(errFmtMismatch, "spec.forProvider.target", topicARN, "an SQS queue"),
+				condition: InvalidReference(errors.Errorf(errFmtMismatch, "spec.forProvider.target", topicARN, "an SQS queue")),
+			},
+		},
+		"Corrected": {
+			reason:   "Resources that were marked invalid should be marked valid once corrected.",
+			resolver: managed.ReferenceResolverFn(func(context.Context, resource.Managed) error { return nil }),
+			mg:       withTarget(queueARN, InvalidReference(errBoom)),
+			want: want{
+				condition: ValidReferences(),
+			},
+		},
+		"Valid": {
+			reason:   "Resources that were never invalid should not gain a condition.",
+			resolver: managed.ReferenceResolverFn(func(context.Context, resource.Managed) error { return nil }),
+			mg:       withTarget(queueARN),
+			want: want{
+				condition: xpv1.Condition{Type: TypeInvalidReference, Status: corev1.ConditionUnknown},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewReferenceResolver(tc.resolver, check(SQSQueue))
+			err := r.ResolveReferences(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			got := tc.mg.GetCondition(TypeInvalidReference)
+			if diff := cmp.Diff(tc.want.condition, got, cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nGetCondition(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
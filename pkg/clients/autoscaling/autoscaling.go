@@ -0,0 +1,352 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsas "github.com/aws/aws-sdk-go/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+const (
+	// StatusDeleteInProgress is the status of a group that is being deleted.
+	StatusDeleteInProgress = "Delete in progress"
+
+	// DefaultLaunchTemplateVersion refers to the default version of a
+	// launch template.
+	DefaultLaunchTemplateVersion = "$Default"
+
+	// LatestLaunchTemplateVersion refers to the latest version of a launch
+	// template.
+	LatestLaunchTemplateVersion = "$Latest"
+
+	resourceTypeGroup = "auto-scaling-group"
+	errCodeValidation = "ValidationError"
+	msgNotFound       = "not found"
+)
+
+// Client defines the EC2 Auto Scaling operations used by the
+// AutoScalingGroup controller.
+type Client interface {
+	CreateAutoScalingGroupWithContext(context.Context, *awsas.CreateAutoScalingGroupInput, ...request.Option) (*awsas.CreateAutoScalingGroupOutput, error)
+	DescribeAutoScalingGroupsWithContext(context.Context, *awsas.DescribeAutoScalingGroupsInput, ...request.Option) (*awsas.DescribeAutoScalingGroupsOutput, error)
+	UpdateAutoScalingGroupWithContext(context.Context, *awsas.UpdateAutoScalingGroupInput, ...request.Option) (*awsas.UpdateAutoScalingGroupOutput, error)
+	DeleteAutoScalingGroupWithContext(context.Context, *awsas.DeleteAutoScalingGroupInput, ...request.Option) (*awsas.DeleteAutoScalingGroupOutput, error)
+	AttachLoadBalancerTargetGroupsWithContext(context.Context, *awsas.AttachLoadBalancerTargetGroupsInput, ...request.Option) (*awsas.AttachLoadBalancerTargetGroupsOutput, error)
+	DetachLoadBalancerTargetGroupsWithContext(context.Context, *awsas.DetachLoadBalancerTargetGroupsInput, ...request.Option) (*awsas.DetachLoadBalancerTargetGroupsOutput, error)
+	SuspendProcessesWithContext(context.Context, *awsas.ScalingProcessQuery, ...request.Option) (*awsas.SuspendProcessesOutput, error)
+	ResumeProcessesWithContext(context.Context, *awsas.ScalingProcessQuery, ...request.Option) (*awsas.ResumeProcessesOutput, error)
+	CreateOrUpdateTagsWithContext(context.Context, *awsas.CreateOrUpdateTagsInput, ...request.Option) (*awsas.CreateOrUpdateTagsOutput, error)
+	DeleteTagsWithContext(context.Context, *awsas.DeleteTagsInput, ...request.Option) (*awsas.DeleteTagsOutput, error)
+	StartInstanceRefreshWithContext(context.Context, *awsas.StartInstanceRefreshInput, ...request.Option) (*awsas.StartInstanceRefreshOutput, error)
+	DescribeInstanceRefreshesWithContext(context.Context, *awsas.DescribeInstanceRefreshesInput, ...request.Option) (*awsas.DescribeInstanceRefreshesOutput, error)
+}
+
+// LaunchTemplateClient defines the EC2 operations used to resolve the
+// launch template version of an Auto Scaling group.
+type LaunchTemplateClient interface {
+	DescribeLaunchTemplatesWithContext(context.Context, *awsec2.DescribeLaunchTemplatesInput, ...request.Option) (*awsec2.DescribeLaunchTemplatesOutput, error)
+}
+
+// NewClient returns a new EC2 Auto Scaling client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsas.New(sess)
+}
+
+// NewLaunchTemplateClient returns a new EC2 client for the given session.
+func NewLaunchTemplateClient(sess *session.Session) LaunchTemplateClient {
+	return awsec2.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the group does not
+// exist. EC2 Auto Scaling reports missing groups as a generic validation
+// error.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeValidation && strings.Contains(awsErr.Message(), msgNotFound)
+}
+
+// IsInstanceRefreshInProgress returns true if an instance refresh with the
+// given status has not finished yet.
+func IsInstanceRefreshInProgress(status string) bool {
+	switch status {
+	case awsas.InstanceRefreshStatusPending, awsas.InstanceRefreshStatusInProgress, awsas.InstanceRefreshStatusCancelling:
+		return true
+	}
+	return false
+}
+
+// GenerateLaunchTemplate returns the launch template specification of the
+// given parameters.
+func GenerateLaunchTemplate(p v1alpha1.AutoScalingGroupParameters) *awsas.LaunchTemplateSpecification {
+	version := DefaultLaunchTemplateVersion
+	if p.LaunchTemplate.Version != nil {
+		version = *p.LaunchTemplate.Version
+	}
+	return &awsas.LaunchTemplateSpecification{
+		LaunchTemplateName: p.LaunchTemplate.LaunchTemplateName,
+		Version:            aws.String(version),
+	}
+}
+
+// GenerateTags returns the EC2 Auto Scaling representation of the given tags
+// of the group with the given name.
+func GenerateTags(name string, tags []v1alpha1.Tag) []*awsas.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awsas.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awsas.Tag{
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String(resourceTypeGroup),
+			Key:               aws.String(t.Key),
+			Value:             aws.String(t.Value),
+			PropagateAtLaunch: aws.Bool(propagateAtLaunch(t)),
+		}
+	}
+	return res
+}
+
+func propagateAtLaunch(t v1alpha1.Tag) bool {
+	return t.PropagateAtLaunch == nil || *t.PropagateAtLaunch
+}
+
+// GenerateCreateAutoScalingGroupInput returns the input to create a group
+// with the given name from the given parameters.
+func GenerateCreateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *awsas.CreateAutoScalingGroupInput {
+	in := &awsas.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(name),
+		LaunchTemplate:         GenerateLaunchTemplate(p),
+		MinSize:                aws.Int64(p.MinSize),
+		MaxSize:                aws.Int64(p.MaxSize),
+		DesiredCapacity:        p.DesiredCapacity,
+		HealthCheckType:        p.HealthCheckType,
+		HealthCheckGracePeriod: p.HealthCheckGracePeriod,
+		TargetGroupARNs:        aws.StringSlice(p.TargetGroupARNs),
+		Tags:                   GenerateTags(name, p.Tags),
+	}
+	if len(p.SubnetIDs) > 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GenerateUpdateAutoScalingGroupInput returns the input to update the group
+// with the given name to the given parameters. The desired capacity is left
+// alone if it is unset or ignored.
+func GenerateUpdateAutoScalingGroupInput(name string, p v1alpha1.AutoScalingGroupParameters) *awsas.UpdateAutoScalingGroupInput {
+	in := &awsas.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String(name),
+		LaunchTemplate:         GenerateLaunchTemplate(p),
+		MinSize:                aws.Int64(p.MinSize),
+		MaxSize:                aws.Int64(p.MaxSize),
+		HealthCheckType:        p.HealthCheckType,
+		HealthCheckGracePeriod: p.HealthCheckGracePeriod,
+	}
+	if !p.IgnoreDesiredCapacity {
+		in.DesiredCapacity = p.DesiredCapacity
+	}
+	if len(p.SubnetIDs) > 0 {
+		in.VPCZoneIdentifier = aws.String(strings.Join(p.SubnetIDs, ","))
+	}
+	return in
+}
+
+// GenerateStartInstanceRefreshInput returns the input to start a rolling
+// instance refresh of the group with the given name.
+func GenerateStartInstanceRefreshInput(name string, p v1alpha1.InstanceRefreshPolicy) *awsas.StartInstanceRefreshInput {
+	return &awsas.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             aws.String(awsas.RefreshStrategyRolling),
+		Preferences: &awsas.RefreshPreferences{
+			MinHealthyPercentage: p.MinHealthyPercentage,
+			InstanceWarmup:       p.InstanceWarmup,
+			SkipMatching:         p.SkipMatching,
+		},
+	}
+}
+
+// GenerateObservation returns the observation of the given group.
+func GenerateObservation(g *awsas.Group) v1alpha1.AutoScalingGroupObservation {
+	o := v1alpha1.AutoScalingGroupObservation{
+		AutoScalingGroupARN: aws.StringValue(g.AutoScalingGroupARN),
+		DesiredCapacity:     aws.Int64Value(g.DesiredCapacity),
+		Instances:           int64(len(g.Instances)),
+		Status:              aws.StringValue(g.Status),
+	}
+	for _, sp := range g.SuspendedProcesses {
+		o.SuspendedProcesses = append(o.SuspendedProcesses, aws.StringValue(sp.ProcessName))
+	}
+	return o
+}
+
+// IsUpToDate returns true if the given group matches the desired parameters.
+// The instances of the group are compared separately.
+func IsUpToDate(name string, p v1alpha1.AutoScalingGroupParameters, g *awsas.Group) bool { // nolint:gocyclo
+	if p.MinSize != aws.Int64Value(g.MinSize) || p.MaxSize != aws.Int64Value(g.MaxSize) {
+		return false
+	}
+	if !p.IgnoreDesiredCapacity && p.DesiredCapacity != nil && *p.DesiredCapacity != aws.Int64Value(g.DesiredCapacity) {
+		return false
+	}
+	lt := GenerateLaunchTemplate(p)
+	if g.LaunchTemplate == nil ||
+		aws.StringValue(lt.LaunchTemplateName) != aws.StringValue(g.LaunchTemplate.LaunchTemplateName) ||
+		aws.StringValue(lt.Version) != aws.StringValue(g.LaunchTemplate.Version) {
+		return false
+	}
+	if len(p.SubnetIDs) > 0 && !equalSets(p.SubnetIDs, strings.Split(aws.StringValue(g.VPCZoneIdentifier), ",")) {
+		return false
+	}
+	if p.HealthCheckType != nil && *p.HealthCheckType != aws.StringValue(g.HealthCheckType) {
+		return false
+	}
+	if p.HealthCheckGracePeriod != nil && *p.HealthCheckGracePeriod != aws.Int64Value(g.HealthCheckGracePeriod) {
+		return false
+	}
+	if attach, detach := DiffTargetGroups(p, g); len(attach) > 0 || len(detach) > 0 {
+		return false
+	}
+	if suspend, resume := DiffSuspendedProcesses(p, g); len(suspend) > 0 || len(resume) > 0 {
+		return false
+	}
+	upsert, remove := DiffTags(name, p, g)
+	return len(upsert) == 0 && len(remove) == 0
+}
+
+// DiffTargetGroups returns the ARNs of the target groups that must be
+// attached to and detached from the given group.
+func DiffTargetGroups(p v1alpha1.AutoScalingGroupParameters, g *awsas.Group) (attach, detach []*string) {
+	attach, detach = diffSets(p.TargetGroupARNs, aws.StringValueSlice(g.TargetGroupARNs))
+	return attach, detach
+}
+
+// DiffSuspendedProcesses returns the processes of the given group that must
+// be suspended and resumed.
+func DiffSuspendedProcesses(p v1alpha1.AutoScalingGroupParameters, g *awsas.Group) (suspend, resume []*string) {
+	desired := make([]string, len(p.SuspendedProcesses))
+	for i, sp := range p.SuspendedProcesses {
+		desired[i] = string(sp)
+	}
+	current := make([]string, len(g.SuspendedProcesses))
+	for i, sp := range g.SuspendedProcesses {
+		current[i] = aws.StringValue(sp.ProcessName)
+	}
+	return diffSets(desired, current)
+}
+
+// DiffTags returns the tags of the group with the given name that must be
+// created or updated, and the ones that must be deleted.
+func DiffTags(name string, p v1alpha1.AutoScalingGroupParameters, g *awsas.Group) (upsert, remove []*awsas.Tag) {
+	current := make(map[string]*awsas.TagDescription, len(g.Tags))
+	for _, t := range g.Tags {
+		current[aws.StringValue(t.Key)] = t
+	}
+	desired := make(map[string]bool, len(p.Tags))
+	for _, t := range GenerateTags(name, p.Tags) {
+		key := aws.StringValue(t.Key)
+		desired[key] = true
+		if c, ok := current[key]; ok && aws.StringValue(c.Value) == aws.StringValue(t.Value) && aws.BoolValue(c.PropagateAtLaunch) == aws.BoolValue(t.PropagateAtLaunch) {
+			continue
+		}
+		upsert = append(upsert, t)
+	}
+	for _, t := range g.Tags {
+		if desired[aws.StringValue(t.Key)] {
+			continue
+		}
+		remove = append(remove, &awsas.Tag{
+			ResourceId:   aws.String(name),
+			ResourceType: aws.String(resourceTypeGroup),
+			Key:          t.Key,
+		})
+	}
+	sort.Slice(remove, func(i, j int) bool { return aws.StringValue(remove[i].Key) < aws.StringValue(remove[j].Key) })
+	return upsert, remove
+}
+
+// ResolveLaunchTemplateVersion returns the number of the given version of
+// the given launch template, resolving $Latest and $Default.
+func ResolveLaunchTemplateVersion(version string, lt *awsec2.LaunchTemplate) int64 {
+	switch version {
+	case LatestLaunchTemplateVersion:
+		return aws.Int64Value(lt.LatestVersionNumber)
+	case DefaultLaunchTemplateVersion:
+		return aws.Int64Value(lt.DefaultVersionNumber)
+	}
+	v, _ := strconv.ParseInt(version, 10, 64)
+	return v
+}
+
+// HasOutdatedInstances returns true if any instance of the given group was
+// not launched from the given version of the launch template with the given
+// name.
+func HasOutdatedInstances(g *awsas.Group, name string, version int64) bool {
+	want := strconv.FormatInt(version, 10)
+	for _, i := range g.Instances {
+		if i.LaunchTemplate == nil ||
+			aws.StringValue(i.LaunchTemplate.LaunchTemplateName) != name ||
+			aws.StringValue(i.LaunchTemplate.Version) != want {
+			return true
+		}
+	}
+	return false
+}
+
+// diffSets returns the elements of desired that are missing in current and
+// the elements of current that are not desired, both sorted.
+func diffSets(desired, current []string) (add, remove []*string) {
+	c := make(map[string]bool, len(current))
+	for _, v := range current {
+		c[v] = true
+	}
+	d := make(map[string]bool, len(desired))
+	for _, v := range desired {
+		d[v] = true
+		if !c[v] {
+			add = append(add, aws.String(v))
+		}
+	}
+	for _, v := range current {
+		if !d[v] {
+			remove = append(remove, aws.String(v))
+		}
+	}
+	sortStrings := func(s []*string) {
+		sort.Slice(s, func(i, j int) bool { return aws.StringValue(s[i]) < aws.StringValue(s[j]) })
+	}
+	sortStrings(add)
+	sortStrings(remove)
+	return add, remove
+}
+
+func equalSets(a, b []string) bool {
+	add, remove := diffSets(a, b)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,343 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsas "github.com/aws/aws-sdk-go/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+const testName = "workers"
+
+func testParams(m ...func(*v1alpha1.AutoScalingGroupParameters)) v1alpha1.AutoScalingGroupParameters {
+	p := v1alpha1.AutoScalingGroupParameters{
+		LaunchTemplate:     v1alpha1.LaunchTemplateSpecification{LaunchTemplateName: aws.String("workers")},
+		MinSize:            1,
+		MaxSize:            5,
+		DesiredCapacity:    aws.Int64(2),
+		SubnetIDs:          []string{"subnet-a", "subnet-b"},
+		TargetGroupARNs:    []string{"arn:tg1"},
+		SuspendedProcesses: []v1alpha1.ScalingProcess{"AZRebalance"},
+		Tags:               []v1alpha1.Tag{{Key: "team", Value: "compute"}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func testGroup(m ...func(*awsas.Group)) *awsas.Group {
+	g := &awsas.Group{
+		AutoScalingGroupName: aws.String(testName),
+		LaunchTemplate:       &awsas.LaunchTemplateSpecification{LaunchTemplateName: aws.String("workers"), Version: aws.String(DefaultLaunchTemplateVersion)},
+		MinSize:              aws.Int64(1),
+		MaxSize:              aws.Int64(5),
+		DesiredCapacity:      aws.Int64(2),
+		VPCZoneIdentifier:    aws.String("subnet-b,subnet-a"),
+		HealthCheckType:      aws.String("EC2"),
+		TargetGroupARNs:      aws.StringSlice([]string{"arn:tg1"}),
+		SuspendedProcesses:   []*awsas.SuspendedProcess{{ProcessName: aws.String("AZRebalance")}},
+		Tags: []*awsas.TagDescription{{
+			Key:               aws.String("team"),
+			Value:             aws.String("compute"),
+			PropagateAtLaunch: aws.Bool(true),
+		}},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  errors.Wrap(awserr.New(errCodeValidation, "AutoScalingGroup name not found - AutoScalingGroup workers not found", nil), "wrapped"),
+			want: true,
+		},
+		"OtherValidationError": {
+			err:  awserr.New(errCodeValidation, "Max bound, 1, must be greater than or equal to min bound, 2", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.AutoScalingGroupParameters
+		group  *awsas.Group
+		want   bool
+	}{
+		"UpToDate": {
+			params: testParams(),
+			group:  testGroup(),
+			want:   true,
+		},
+		"MaxSizeChanged": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.MaxSize = 10 }),
+			group:  testGroup(),
+			want:   false,
+		},
+		"DesiredCapacityChanged": {
+			params: testParams(),
+			group:  testGroup(func(g *awsas.Group) { g.DesiredCapacity = aws.Int64(4) }),
+			want:   false,
+		},
+		"DesiredCapacityIgnored": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.IgnoreDesiredCapacity = true }),
+			group:  testGroup(func(g *awsas.Group) { g.DesiredCapacity = aws.Int64(4) }),
+			want:   true,
+		},
+		"DesiredCapacityUnset": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.DesiredCapacity = nil }),
+			group:  testGroup(func(g *awsas.Group) { g.DesiredCapacity = aws.Int64(4) }),
+			want:   true,
+		},
+		"LaunchTemplateVersionChanged": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.LaunchTemplate.Version = aws.String("3") }),
+			group:  testGroup(),
+			want:   false,
+		},
+		"SubnetRemoved": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.SubnetIDs = []string{"subnet-a"} }),
+			group:  testGroup(),
+			want:   false,
+		},
+		"HealthCheckTypeUnset": {
+			params: testParams(),
+			group:  testGroup(func(g *awsas.Group) { g.HealthCheckType = aws.String("ELB") }),
+			want:   true,
+		},
+		"TargetGroupAdded": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.TargetGroupARNs = append(p.TargetGroupARNs, "arn:tg2") }),
+			group:  testGroup(),
+			want:   false,
+		},
+		"ProcessResumedOutside": {
+			params: testParams(),
+			group:  testGroup(func(g *awsas.Group) { g.SuspendedProcesses = nil }),
+			want:   false,
+		},
+		"TagPropagationChanged": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.Tags[0].PropagateAtLaunch = aws.Bool(false) }),
+			group:  testGroup(),
+			want:   false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsUpToDate(testName, tc.params, tc.group)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateAutoScalingGroupInput(t *testing.T) {
+	cases := map[string]struct {
+		params v1alpha1.AutoScalingGroupParameters
+		want   *awsas.UpdateAutoScalingGroupInput
+	}{
+		"DesiredCapacity": {
+			params: testParams(),
+			want: &awsas.UpdateAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String(testName),
+				LaunchTemplate:       &awsas.LaunchTemplateSpecification{LaunchTemplateName: aws.String("workers"), Version: aws.String(DefaultLaunchTemplateVersion)},
+				MinSize:              aws.Int64(1),
+				MaxSize:              aws.Int64(5),
+				DesiredCapacity:      aws.Int64(2),
+				VPCZoneIdentifier:    aws.String("subnet-a,subnet-b"),
+			},
+		},
+		"DesiredCapacityIgnored": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.IgnoreDesiredCapacity = true }),
+			want: &awsas.UpdateAutoScalingGroupInput{
+				AutoScalingGroupName: aws.String(testName),
+				LaunchTemplate:       &awsas.LaunchTemplateSpecification{LaunchTemplateName: aws.String("workers"), Version: aws.String(DefaultLaunchTemplateVersion)},
+				MinSize:              aws.Int64(1),
+				MaxSize:              aws.Int64(5),
+				VPCZoneIdentifier:    aws.String("subnet-a,subnet-b"),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateUpdateAutoScalingGroupInput(testName, tc.params)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffSuspendedProcesses(t *testing.T) {
+	type want struct {
+		suspend []*string
+		resume  []*string
+	}
+	cases := map[string]struct {
+		params v1alpha1.AutoScalingGroupParameters
+		group  *awsas.Group
+		want   want
+	}{
+		"NoChange": {
+			params: testParams(),
+			group:  testGroup(),
+			want:   want{},
+		},
+		"SuspendAndResume": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.SuspendedProcesses = []v1alpha1.ScalingProcess{"ScheduledActions", "AlarmNotification"}
+			}),
+			group: testGroup(),
+			want: want{
+				suspend: aws.StringSlice([]string{"AlarmNotification", "ScheduledActions"}),
+				resume:  aws.StringSlice([]string{"AZRebalance"}),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			suspend, resume := DiffSuspendedProcesses(tc.params, tc.group)
+			if diff := cmp.Diff(tc.want, want{suspend: suspend, resume: resume}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		upsert []*awsas.Tag
+		remove []*awsas.Tag
+	}
+	cases := map[string]struct {
+		params v1alpha1.AutoScalingGroupParameters
+		group  *awsas.Group
+		want   want
+	}{
+		"NoChange": {
+			params: testParams(),
+			group:  testGroup(),
+			want:   want{},
+		},
+		"ChangedAndRemoved": {
+			params: testParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.Tags[0].Value = "batch" }),
+			group: testGroup(func(g *awsas.Group) {
+				g.Tags = append(g.Tags, &awsas.TagDescription{Key: aws.String("old"), Value: aws.String("v")})
+			}),
+			want: want{
+				upsert: []*awsas.Tag{{
+					ResourceId:        aws.String(testName),
+					ResourceType:      aws.String(resourceTypeGroup),
+					Key:               aws.String("team"),
+					Value:             aws.String("batch"),
+					PropagateAtLaunch: aws.Bool(true),
+				}},
+				remove: []*awsas.Tag{{
+					ResourceId:   aws.String(testName),
+					ResourceType: aws.String(resourceTypeGroup),
+					Key:          aws.String("old"),
+				}},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upsert, remove := DiffTags(testName, tc.params, tc.group)
+			if diff := cmp.Diff(tc.want, want{upsert: upsert, remove: remove}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveLaunchTemplateVersion(t *testing.T) {
+	lt := &awsec2.LaunchTemplate{DefaultVersionNumber: aws.Int64(2), LatestVersionNumber: aws.Int64(4)}
+	cases := map[string]struct {
+		version string
+		want    int64
+	}{
+		"Default": {version: DefaultLaunchTemplateVersion, want: 2},
+		"Latest":  {version: LatestLaunchTemplateVersion, want: 4},
+		"Number":  {version: "3", want: 3},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ResolveLaunchTemplateVersion(tc.version, lt)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasOutdatedInstances(t *testing.T) {
+	instance := func(version string) *awsas.Instance {
+		return &awsas.Instance{LaunchTemplate: &awsas.LaunchTemplateSpecification{LaunchTemplateName: aws.String("workers"), Version: aws.String(version)}}
+	}
+	cases := map[string]struct {
+		group *awsas.Group
+		want  bool
+	}{
+		"NoInstances": {
+			group: testGroup(),
+			want:  false,
+		},
+		"AllCurrent": {
+			group: testGroup(func(g *awsas.Group) { g.Instances = []*awsas.Instance{instance("3"), instance("3")} }),
+			want:  false,
+		},
+		"OtherLaunchTemplate": {
+			group: testGroup(func(g *awsas.Group) {
+				g.Instances = []*awsas.Instance{{LaunchTemplate: &awsas.LaunchTemplateSpecification{LaunchTemplateName: aws.String("old"), Version: aws.String("3")}}}
+			}),
+			want: true,
+		},
+		"Outdated": {
+			group: testGroup(func(g *awsas.Group) { g.Instances = []*awsas.Instance{instance("3"), instance("2")} }),
+			want:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, HasOutdatedInstances(tc.group, "workers", 3)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
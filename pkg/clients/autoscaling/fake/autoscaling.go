@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+)
+
+// MockClient is a type that implements all the methods for the EC2 Auto
+// Scaling Client interface.
+type MockClient struct {
+	MockCreateAutoScalingGroup         func(ctx context.Context, input *autoscaling.CreateAutoScalingGroupInput, opts []request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error)
+	MockDescribeAutoScalingGroups      func(ctx context.Context, input *autoscaling.DescribeAutoScalingGroupsInput, opts []request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	MockUpdateAutoScalingGroup         func(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, opts []request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+	MockDeleteAutoScalingGroup         func(ctx context.Context, input *autoscaling.DeleteAutoScalingGroupInput, opts []request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error)
+	MockAttachLoadBalancerTargetGroups func(ctx context.Context, input *autoscaling.AttachLoadBalancerTargetGroupsInput, opts []request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error)
+	MockDetachLoadBalancerTargetGroups func(ctx context.Context, input *autoscaling.DetachLoadBalancerTargetGroupsInput, opts []request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error)
+	MockSuspendProcesses               func(ctx context.Context, input *autoscaling.ScalingProcessQuery, opts []request.Option) (*autoscaling.SuspendProcessesOutput, error)
+	MockResumeProcesses                func(ctx context.Context, input *autoscaling.ScalingProcessQuery, opts []request.Option) (*autoscaling.ResumeProcessesOutput, error)
+	MockCreateOrUpdateTags             func(ctx context.Context, input *autoscaling.CreateOrUpdateTagsInput, opts []request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error)
+	MockDeleteTags                     func(ctx context.Context, input *autoscaling.DeleteTagsInput, opts []request.Option) (*autoscaling.DeleteTagsOutput, error)
+	MockStartInstanceRefresh           func(ctx context.Context, input *autoscaling.StartInstanceRefreshInput, opts []request.Option) (*autoscaling.StartInstanceRefreshOutput, error)
+	MockDescribeInstanceRefreshes      func(ctx context.Context, input *autoscaling.DescribeInstanceRefreshesInput, opts []request.Option) (*autoscaling.DescribeInstanceRefreshesOutput, error)
+}
+
+// CreateAutoScalingGroupWithContext mocks CreateAutoScalingGroupWithContext method
+func (m *MockClient) CreateAutoScalingGroupWithContext(ctx context.Context, input *autoscaling.CreateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+	return m.MockCreateAutoScalingGroup(ctx, input, opts)
+}
+
+// DescribeAutoScalingGroupsWithContext mocks DescribeAutoScalingGroupsWithContext method
+func (m *MockClient) DescribeAutoScalingGroupsWithContext(ctx context.Context, input *autoscaling.DescribeAutoScalingGroupsInput, opts ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return m.MockDescribeAutoScalingGroups(ctx, input, opts)
+}
+
+// UpdateAutoScalingGroupWithContext mocks UpdateAutoScalingGroupWithContext method
+func (m *MockClient) UpdateAutoScalingGroupWithContext(ctx context.Context, input *autoscaling.UpdateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	return m.MockUpdateAutoScalingGroup(ctx, input, opts)
+}
+
+// DeleteAutoScalingGroupWithContext mocks DeleteAutoScalingGroupWithContext method
+func (m *MockClient) DeleteAutoScalingGroupWithContext(ctx context.Context, input *autoscaling.DeleteAutoScalingGroupInput, opts ...request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
+	return m.MockDeleteAutoScalingGroup(ctx, input, opts)
+}
+
+// AttachLoadBalancerTargetGroupsWithContext mocks AttachLoadBalancerTargetGroupsWithContext method
+func (m *MockClient) AttachLoadBalancerTargetGroupsWithContext(ctx context.Context, input *autoscaling.AttachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+	return m.MockAttachLoadBalancerTargetGroups(ctx, input, opts)
+}
+
+// DetachLoadBalancerTargetGroupsWithContext mocks DetachLoadBalancerTargetGroupsWithContext method
+func (m *MockClient) DetachLoadBalancerTargetGroupsWithContext(ctx context.Context, input *autoscaling.DetachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+	return m.MockDetachLoadBalancerTargetGroups(ctx, input, opts)
+}
+
+// SuspendProcessesWithContext mocks SuspendProcessesWithContext method
+func (m *MockClient) SuspendProcessesWithContext(ctx context.Context, input *autoscaling.ScalingProcessQuery, opts ...request.Option) (*autoscaling.SuspendProcessesOutput, error) {
+	return m.MockSuspendProcesses(ctx, input, opts)
+}
+
+// ResumeProcessesWithContext mocks ResumeProcessesWithContext method
+func (m *MockClient) ResumeProcessesWithContext(ctx context.Context, input *autoscaling.ScalingProcessQuery, opts ...request.Option) (*autoscaling.ResumeProcessesOutput, error) {
+	return m.MockResumeProcesses(ctx, input, opts)
+}
+
+// CreateOrUpdateTagsWithContext mocks CreateOrUpdateTagsWithContext method
+func (m *MockClient) CreateOrUpdateTagsWithContext(ctx context.Context, input *autoscaling.CreateOrUpdateTagsInput, opts ...request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	return m.MockCreateOrUpdateTags(ctx, input, opts)
+}
+
+// DeleteTagsWithContext mocks DeleteTagsWithContext method
+func (m *MockClient) DeleteTagsWithContext(ctx context.Context, input *autoscaling.DeleteTagsInput, opts ...request.Option) (*autoscaling.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
+
+// StartInstanceRefreshWithContext mocks StartInstanceRefreshWithContext method
+func (m *MockClient) StartInstanceRefreshWithContext(ctx context.Context, input *autoscaling.StartInstanceRefreshInput, opts ...request.Option) (*autoscaling.StartInstanceRefreshOutput, error) {
+	return m.MockStartInstanceRefresh(ctx, input, opts)
+}
+
+// DescribeInstanceRefreshesWithContext mocks DescribeInstanceRefreshesWithContext method
+func (m *MockClient) DescribeInstanceRefreshesWithContext(ctx context.Context, input *autoscaling.DescribeInstanceRefreshesInput, opts ...request.Option) (*autoscaling.DescribeInstanceRefreshesOutput, error) {
+	return m.MockDescribeInstanceRefreshes(ctx, input, opts)
+}
+
+// MockLaunchTemplateClient is a type that implements all the methods for
+// the LaunchTemplateClient interface.
+type MockLaunchTemplateClient struct {
+	MockDescribeLaunchTemplates func(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts []request.Option) (*ec2.DescribeLaunchTemplatesOutput, error)
+}
+
+// DescribeLaunchTemplatesWithContext mocks DescribeLaunchTemplatesWithContext method
+func (m *MockLaunchTemplateClient) DescribeLaunchTemplatesWithContext(ctx context.Context, input *ec2.DescribeLaunchTemplatesInput, opts ...request.Option) (*ec2.DescribeLaunchTemplatesOutput, error) {
+	return m.MockDescribeLaunchTemplates(ctx, input, opts)
+}
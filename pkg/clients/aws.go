@@ -34,7 +34,6 @@ import (
 	stscredstypesv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 
 	ec2type "github.com/aws/aws-sdk-go-v2/service/ec2/types"
-	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsv1 "github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
@@ -58,6 +57,11 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/v1alpha3"
 	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/backoff"
+	"github.com/crossplane/provider-aws/pkg/clients/dryrun"
+	"github.com/crossplane/provider-aws/pkg/clients/operation"
+	"github.com/crossplane/provider-aws/pkg/clients/requestlog"
+	"github.com/crossplane/provider-aws/pkg/throttle"
 	"github.com/crossplane/provider-aws/pkg/version"
 )
 
@@ -92,6 +96,16 @@ var userAgentV2 = config.WithAPIOptions([]func(*middleware.Stack) error{
 	awsmiddleware.AddUserAgentKeyValue("crossplane-provider-aws", version.Version),
 })
 
+// recordOperationV2 records and logs the operations of AWS v2 clients, and
+// intercepts those of managed resources in dry-run mode, see packages dryrun,
+// operation, backoff and requestlog.
+var recordOperationV2 = config.WithAPIOptions([]func(*middleware.Stack) error{
+	dryrun.AddMiddlewareV2,
+	operation.AddMiddlewareV2,
+	backoff.AddMiddlewareV2,
+	requestlog.AddMiddlewareV2,
+})
+
 // userAgentV1 constructs the Crossplane user agent for AWS v1 clients
 var userAgentV1 = requestv1.NamedHandler{
 	Name: "crossplane.UserAgentHandler",
@@ -112,7 +126,7 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { // nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
 		return nil, errors.Wrap(err, "cannot get referenced Provider")
@@ -123,6 +137,23 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
 
+	ctx, err := withResourceIdentity(ctx, c, mg, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := UseProviderConfigCredentials(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	setCallerAccountID(mg, pc)
+	cfg.APIOptions = append(cfg.APIOptions, throttle.AddMiddlewareV2(throttleKey(pc)))
+	return cfg, nil
+}
+
+// UseProviderConfigCredentials produces a config that can be used to
+// authenticate to AWS with the credentials of the supplied ProviderConfig.
+func UseProviderConfigCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
@@ -132,13 +163,13 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 			}
 			return SetResolver(pc, cfg), nil
 		}
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+		cfg, err := usePodServiceAccount(ctx, pc, region)
 		if err != nil {
 			return nil, err
 		}
 		return SetResolver(pc, cfg), nil
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		data, err := extractCredentials(ctx, s, c, pc)
 		if err != nil {
 			return nil, errors.Wrap(err, "cannot get credentials")
 		}
@@ -157,6 +188,15 @@ func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed
 	}
 }
 
+// extractCredentials returns the credentials of the supplied ProviderConfig in
+// the format of an AWS credentials file.
+func extractCredentials(ctx context.Context, s xpv1.CredentialsSource, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	if s == v1beta1.CredentialsSourceSSO {
+		return UseSSO(ctx, c, pc)
+	}
+	return resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+}
+
 type awsEndpointResolverAdaptorWithOptions func(service, region string, options interface{}) (aws.Endpoint, error)
 
 func (a awsEndpointResolverAdaptorWithOptions) ResolveEndpoint(service, region string, options ...interface{}) (aws.Endpoint, error) {
@@ -299,6 +339,7 @@ func UseProviderSecret(ctx context.Context, data []byte, profile, region string)
 	config, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
+		recordOperationV2,
 		config.WithRegion(region),
 		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
 			Value: creds,
@@ -318,6 +359,7 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 	config, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
+		recordOperationV2,
 		config.WithRegion(region),
 		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
 			Value: creds,
@@ -332,15 +374,7 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 		return nil, err
 	}
 
-	stsSvc := sts.NewFromConfig(config)
-
-	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
-	stsAssume := stscreds.NewAssumeRoleProvider(
-		stsSvc,
-		StringValue(roleArn),
-		stsAssumeRoleOptions,
-	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = assumeRoleCredentials(ctx, config, pc, data, region, roleArn)
 
 	return &config, err
 }
@@ -349,7 +383,7 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 // assume Cross account IAM roles
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
 func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, userAgentV2)
+	cfg, err := podServiceAccountConfig(ctx, pc, region)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -359,19 +393,12 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 		return nil, err
 	}
 
-	stsclient := sts.NewFromConfig(cfg)
-	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
+		recordOperationV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stsAssumeRoleOptions,
-			)),
-		),
+		config.WithCredentialsProvider(assumeRoleCredentials(ctx, *cfg, pc, []byte(xpv1.CredentialsSourceInjectedIdentity), region, roleArn)),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
@@ -382,15 +409,57 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 // UsePodServiceAccount assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
 func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws.Config, error) {
+	return usePodServiceAccount(ctx, nil, region)
+}
+
+// usePodServiceAccount returns a config that uses the identity injected into
+// the provider pod, whose STS endpoint is configured by the supplied
+// ProviderConfig, which may be nil.
+func usePodServiceAccount(ctx context.Context, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	cfg, err := podServiceAccountConfig(ctx, pc, region)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	cfg.Region = region
+	return cfg, nil
+}
+
+// podServiceAccountConfig returns a config whose credentials are those of the
+// identity injected into the provider pod, e.g. by IAM roles for service
+// accounts. Its credentials are shared by all managed resources, and web
+// identity tokens are exchanged with the STS endpoint configured by the
+// supplied ProviderConfig.
+func podServiceAccountConfig(ctx context.Context, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error) {
+	stsRegion := STSRegion(pc, region)
 	cfg, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
-		config.WithRegion(region),
+		recordOperationV2,
+		config.WithRegion(stsRegion),
 	)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to load default AWS config")
+		return nil, err
 	}
-	return &cfg, err
+	cfg.Credentials = sharedCredentials.get(string(xpv1.CredentialsSourceInjectedIdentity)+"/"+stsRegion, func() aws.CredentialsProvider {
+		return cfg.Credentials
+	})
+	return &cfg, nil
+}
+
+// assumeRoleCredentials returns the credentials of the role the supplied
+// ProviderConfig assumes with the credentials of the supplied config, which
+// were obtained from the supplied source. The credentials are shared by all
+// managed resources that use the ProviderConfig, and refreshed before they
+// expire.
+func assumeRoleCredentials(ctx context.Context, cfg aws.Config, pc *v1beta1.ProviderConfig, source []byte, region string, roleARN *string) *aws.CredentialsCache {
+	return sharedCredentials.get(credentialsKey(ctx, pc, source, STSRegion(pc, region)), func() aws.CredentialsProvider {
+		return stscreds.NewAssumeRoleProvider(
+			newSTSClient(cfg, pc, region),
+			StringValue(roleARN),
+			SetAssumeRoleOptions(pc),
+			SetResourceIdentityOptions(ctx, pc),
+		)
+	})
 }
 
 // NOTE(muvaf): ACK-generated controllers use aws/aws-sdk-go instead of
@@ -398,7 +467,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -411,7 +480,46 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
-	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+
+	ctx, err := withResourceIdentity(ctx, c, mg, pc)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := UseProviderConfigCredentialsV1(ctx, c, pc, region)
+	if err != nil {
+		return nil, err
+	}
+	setCallerAccountID(mg, pc)
+	return sess, nil
+}
+
+// UseProviderConfigCredentialsV1 constructs a session that can be used to
+// authenticate to AWS by the AWSv1 clients with the credentials of the supplied
+// ProviderConfig. Sessions are shared by all managed resources that use the
+// ProviderConfig in the same region.
+func UseProviderConfigCredentialsV1(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*session.Session, error) {
+	source := []byte(xpv1.CredentialsSourceInjectedIdentity)
+	if s := pc.Spec.Credentials.Source; s != xpv1.CredentialsSourceInjectedIdentity {
+		data, err := extractCredentials(ctx, s, c, pc)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot get credentials")
+		}
+		source = data
+	}
+	return sharedSessions.get(sessionKey(ctx, pc, source, region), func() (*session.Session, error) {
+		sess, err := newSessionV1(ctx, pc, source, region)
+		if err != nil {
+			return nil, err
+		}
+		sess.Handlers.Sign.PushFrontNamed(throttle.HandlerV1(throttleKey(pc)))
+		return sess, nil
+	})
+}
+
+// newSessionV1 constructs a session with the credentials of the supplied
+// ProviderConfig, which were extracted from the supplied source data.
+func newSessionV1(ctx context.Context, pc *v1beta1.ProviderConfig, data []byte, region string) (*session.Session, error) {
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region)
@@ -426,11 +534,6 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 		}
 		return GetSessionV1(cfg)
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
 			if err != nil {
@@ -452,7 +555,11 @@ func GetSessionV1(cfg *awsv1.Config) (*session.Session, error) {
 	if err != nil {
 		return nil, err
 	}
+	session.Handlers.Validate.PushBackNamed(dryrun.HandlerV1)
 	session.Handlers.Build.PushBackNamed(userAgentV1)
+	session.Handlers.Complete.PushBackNamed(operation.HandlerV1)
+	session.Handlers.Complete.PushBackNamed(backoff.HandlerV1)
+	session.Handlers.Complete.PushBackNamed(requestlog.HandlerV1)
 	return session, nil
 }
 
@@ -467,6 +574,7 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 	config, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
+		recordOperationV2,
 		config.WithRegion(region),
 		config.WithCredentialsProvider(credentials.StaticCredentialsProvider{
 			Value: creds,
@@ -481,26 +589,13 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
 
-	stsSvc := sts.NewFromConfig(config)
-	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
-	stsAssume := stscreds.NewAssumeRoleProvider(
-		stsSvc,
-		StringValue(roleArn),
-		stsAssumeRoleOptions,
-	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = assumeRoleCredentials(ctx, config, pc, data, region, roleArn)
 
-	v2creds, err := config.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := config.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
 
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-
-	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(credentialsV1(config.Credentials)).WithRegion(region)), nil
 }
 
 // UseProviderSecretV1 retrieves AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY from
@@ -539,7 +634,7 @@ func UseProviderSecretV1(_ context.Context, data []byte, pc *v1beta1.ProviderCon
 // assume Cross account IAM role
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
 func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string) (*awsv1.Config, error) {
-	cfg, err := config.LoadDefaultConfig(ctx, userAgentV2)
+	cfg, err := podServiceAccountConfig(ctx, pc, region)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
@@ -548,54 +643,33 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to assume IAM Role")
 	}
-	stsclient := sts.NewFromConfig(cfg)
-	stsAssumeRoleOptions := SetAssumeRoleOptions(pc)
 	cnf, err := config.LoadDefaultConfig(
 		ctx,
 		userAgentV2,
+		recordOperationV2,
 		config.WithRegion(region),
-		config.WithCredentialsProvider(aws.NewCredentialsCache(
-			stscreds.NewAssumeRoleProvider(
-				stsclient,
-				StringValue(roleArn),
-				stsAssumeRoleOptions,
-			)),
-		),
+		config.WithCredentialsProvider(assumeRoleCredentials(ctx, *cfg, pc, []byte(xpv1.CredentialsSourceInjectedIdentity), region, roleArn)),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := cnf.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(credentialsV1(cnf.Credentials)).WithRegion(region)), nil
 }
 
 // UsePodServiceAccountV1 assumes an IAM role configured via a ServiceAccount.
 // https://docs.aws.amazon.com/eks/latest/userguide/iam-roles-for-service-accounts.html
 func UsePodServiceAccountV1(ctx context.Context, _ []byte, pc *v1beta1.ProviderConfig, _, region string) (*awsv1.Config, error) {
-	cfg, err := config.LoadDefaultConfig(
-		ctx,
-		userAgentV2,
-		config.WithRegion(region),
-	)
+	cfg, err := podServiceAccountConfig(ctx, pc, region)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load default AWS config")
 	}
-	v2creds, err := cfg.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
-	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
+	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(credentialsV1(cfg.Credentials)).WithRegion(region)), nil
 }
 
 // SetResolverV1 parses annotations from the managed resource
@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package capability detects optional features of managed resources that the
+// provider lacks the AWS permissions for, so that controllers can skip them
+// rather than failing the whole resource.
+//
+// Controllers opt in feature by feature: they share a Detector across their
+// resources, only make the API calls of a feature while it is Available,
+// pass the errors of these calls to Check, and report skipped features with
+// SetConditions when they observe a resource. Features of controllers that
+// don't opt in are not optional; denied calls fail the resource as usual.
+package capability
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// A Feature is an optional part of a managed resource that is reconciled
+// with its own AWS API calls, and thus its own permissions.
+type Feature string
+
+// Optional features of managed resources.
+const (
+	Tagging            Feature = "Tagging"
+	EnhancedMonitoring Feature = "EnhancedMonitoring"
+)
+
+// TypeDegraded resources are in sync, except for optional features that the
+// provider isn't permitted to manage.
+const TypeDegraded xpv1.ConditionType = "Degraded"
+
+// Reasons a resource is or is not degraded.
+const (
+	ReasonMissingPermissions xpv1.ConditionReason = "MissingPermissions"
+	ReasonAllFeatures        xpv1.ConditionReason = "AllFeaturesAvailable"
+)
+
+// DefaultTTL is how long a feature is skipped after access to it was denied.
+// Once it passes the feature is tried again, so that granting the missing
+// permissions eventually takes effect without restarting the provider.
+const DefaultTTL = time.Hour
+
+// accessDeniedCodes are the error codes AWS APIs return when the caller
+// lacks permissions.
+var accessDeniedCodes = map[string]bool{
+	"AccessDenied":          true,
+	"AccessDeniedException": true,
+	"AuthorizationError":    true,
+	"UnauthorizedOperation": true,
+}
+
+// IsAccessDenied returns true if the supplied error, of either version of the
+// AWS SDK, is because the caller lacks permissions.
+func IsAccessDenied(err error) bool {
+	var v1 awserr.Error
+	if errors.As(err, &v1) {
+		return accessDeniedCodes[v1.Code()]
+	}
+	var v2 smithy.APIError
+	if errors.As(err, &v2) {
+		return accessDeniedCodes[v2.ErrorCode()]
+	}
+	return false
+}
+
+// Degraded returns a condition that indicates the supplied features of a
+// resource are not managed because the provider lacks permissions.
+func Degraded(f ...Feature) xpv1.Condition {
+	names := make([]string, len(f))
+	for i := range f {
+		names[i] = string(f[i])
+	}
+	sort.Strings(names)
+	return xpv1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMissingPermissions,
+		Message:            "Skipped features the provider is not permitted to manage: " + strings.Join(names, ", "),
+	}
+}
+
+// NotDegraded returns a condition that indicates all features of a resource
+// are managed.
+func NotDegraded() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDegraded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonAllFeatures,
+	}
+}
+
+type key struct {
+	scope   string
+	feature Feature
+}
+
+// A Detector remembers which features the provider was denied access to.
+// Denials are scoped to the ProviderConfig of a resource, since that's what
+// determines the permissions of the provider. A Detector is meant to be
+// shared by all resources of a controller.
+type Detector struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu     sync.RWMutex
+	denied map[key]time.Time
+}
+
+// An Option configures a Detector.
+type Option func(*Detector)
+
+// WithTTL configures how long a feature is skipped after access to it was
+// denied.
+func WithTTL(ttl time.Duration) Option {
+	return func(d *Detector) { d.ttl = ttl }
+}
+
+// WithClock configures the clock of the Detector.
+func WithClock(now func() time.Time) Option {
+	return func(d *Detector) { d.now = now }
+}
+
+// NewDetector returns a new Detector.
+func NewDetector(o ...Option) *Detector {
+	d := &Detector{
+		ttl:    DefaultTTL,
+		now:    time.Now,
+		denied: map[key]time.Time{},
+	}
+	for _, fn := range o {
+		fn(d)
+	}
+	return d
+}
+
+// Available returns false if access to the supplied feature was recently
+// denied for the supplied resource, in which case it should be skipped.
+func (d *Detector) Available(mg resource.Managed, f Feature) bool {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	at, ok := d.denied[key{scope: scope(mg), feature: f}]
+	return !ok || d.now().Sub(at) > d.ttl
+}
+
+// Check the supplied error of an API call of the supplied feature. Access
+// denied errors are recorded and swallowed; the feature is skipped from now
+// on. Other errors are returned as is.
+func (d *Detector) Check(mg resource.Managed, f Feature, err error) error {
+	k := key{scope: scope(mg), feature: f}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	switch {
+	case err == nil:
+		delete(d.denied, k)
+		return nil
+	case IsAccessDenied(err):
+		d.denied[k] = d.now()
+		return nil
+	default:
+		return err
+	}
+}
+
+// SetConditions sets the Degraded condition of the supplied resource to true
+// if any of the supplied features is not available. Otherwise it sets it to
+// false, but only if the resource was degraded before, so that resources
+// that never were don't carry the condition.
+func (d *Detector) SetConditions(mg resource.Managed, f ...Feature) {
+	skipped := make([]Feature, 0, len(f))
+	for i := range f {
+		if !d.Available(mg, f[i]) {
+			skipped = append(skipped, f[i])
+		}
+	}
+	if len(skipped) > 0 {
+		mg.SetConditions(Degraded(skipped...))
+		return
+	}
+	if mg.GetCondition(TypeDegraded).Status == corev1.ConditionTrue {
+		mg.SetConditions(NotDegraded())
+	}
+}
+
+// scope returns the name of the ProviderConfig of the supplied resource.
+func scope(mg resource.Managed) string {
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		return ref.Name
+	}
+	if ref := mg.GetProviderReference(); ref != nil {
+		return ref.Name
+	}
+	return ""
+}
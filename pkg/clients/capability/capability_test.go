@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var errBoom = errors.New("boom")
+
+func managed(pc string, c ...xpv1.Condition) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: pc})
+	mg.SetConditions(c...)
+	return mg
+}
+
+func TestIsAccessDenied(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"V1AccessDenied": {
+			err:  awserr.New("AccessDeniedException", "", nil),
+			want: true,
+		},
+		"V2AccessDenied": {
+			err:  errors.Wrap(&smithy.GenericAPIError{Code: "UnauthorizedOperation"}, "wrapped"),
+			want: true,
+		},
+		"V1OtherError": {
+			err:  awserr.New("ResourceNotFoundException", "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errBoom,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsAccessDenied(tc.err)); diff != "" {
+				t.Errorf("IsAccessDenied(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDetector(t *testing.T) {
+	now := time.Now()
+	d := NewDetector(WithClock(func() time.Time { return now }))
+	a, b := managed("a"), managed("b")
+
+	if err := d.Check(a, Tagging, awserr.New("AccessDenied", "", nil)); err != nil {
+		t.Errorf("Check(...): want nil error, got %s", err)
+	}
+	if diff := cmp.Diff(errBoom, d.Check(a, EnhancedMonitoring, errBoom), test.EquateErrors()); diff != "" {
+		t.Errorf("Check(...): -want error, +got error:\n%s", diff)
+	}
+
+	cases := map[string]struct {
+		available bool
+		want      bool
+	}{
+		"DeniedFeature":       {available: d.Available(a, Tagging), want: false},
+		"OtherFeature":        {available: d.Available(a, EnhancedMonitoring), want: true},
+		"OtherProviderConfig": {available: d.Available(b, Tagging), want: true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.available); diff != "" {
+				t.Errorf("Available(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	now = now.Add(DefaultTTL + time.Second)
+	if !d.Available(a, Tagging) {
+		t.Errorf("Available(...): want true after the TTL passed, got false")
+	}
+
+	_ = d.Check(a, Tagging, awserr.New("AccessDenied", "", nil))
+	_ = d.Check(a, Tagging, nil)
+	if !d.Available(a, Tagging) {
+		t.Errorf("Available(...): want true after a successful call, got false")
+	}
+}
+
+func TestSetConditions(t *testing.T) {
+	denied := func() *Detector {
+		d := NewDetector()
+		_ = d.Check(managed("a"), Tagging, awserr.New("AccessDenied", "", nil))
+		return d
+	}
+
+	cases := map[string]struct {
+		d    *Detector
+		mg   *fake.Managed
+		want *fake.Managed
+	}{
+		"Degraded": {
+			d:    denied(),
+			mg:   managed("a"),
+			want: managed("a", Degraded(Tagging)),
+		},
+		"NeverDegraded": {
+			d:    NewDetector(),
+			mg:   managed("a"),
+			want: managed("a"),
+		},
+		"NoLongerDegraded": {
+			d:    NewDetector(),
+			mg:   managed("a", Degraded(Tagging)),
+			want: managed("a", NotDegraded()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tc.d.SetConditions(tc.mg, Tagging, EnhancedMonitoring)
+			if diff := cmp.Diff(tc.want, tc.mg, test.EquateConditions()); diff != "" {
+				t.Errorf("SetConditions(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
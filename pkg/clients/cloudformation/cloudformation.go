@@ -0,0 +1,276 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudformation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscf "github.com/aws/aws-sdk-go/service/cloudformation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudformation/v1alpha1"
+)
+
+const (
+	// AnnotationKeyTemplateURL records the template URL a stack was last
+	// created or updated with. CloudFormation does not report the URL a
+	// template was read from, so it is the only way to tell whether the
+	// desired template URL changed.
+	AnnotationKeyTemplateURL = "cloudformation.aws.crossplane.io/template-url"
+
+	// DefaultDriftDetectionInterval is used if a stack does not specify a
+	// drift detection interval.
+	DefaultDriftDetectionInterval = time.Hour
+
+	errCodeValidation = "ValidationError"
+	msgNotFound       = "does not exist"
+	msgNoUpdates      = "No updates are to be performed"
+	statusInProgress  = "_IN_PROGRESS"
+
+	errTemplateSource = "exactly one of templateBody, templateBodyFrom and templateUrl must be specified"
+)
+
+// Client defines the CloudFormation operations used by the stack controller.
+type Client interface {
+	CreateStackWithContext(context.Context, *awscf.CreateStackInput, ...request.Option) (*awscf.CreateStackOutput, error)
+	DescribeStacksWithContext(context.Context, *awscf.DescribeStacksInput, ...request.Option) (*awscf.DescribeStacksOutput, error)
+	UpdateStackWithContext(context.Context, *awscf.UpdateStackInput, ...request.Option) (*awscf.UpdateStackOutput, error)
+	DeleteStackWithContext(context.Context, *awscf.DeleteStackInput, ...request.Option) (*awscf.DeleteStackOutput, error)
+	GetTemplateWithContext(context.Context, *awscf.GetTemplateInput, ...request.Option) (*awscf.GetTemplateOutput, error)
+	DetectStackDriftWithContext(context.Context, *awscf.DetectStackDriftInput, ...request.Option) (*awscf.DetectStackDriftOutput, error)
+}
+
+// NewClient returns a new CloudFormation client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awscf.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the stack does not
+// exist. CloudFormation reports missing stacks as a generic validation error.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeValidation && strings.Contains(awsErr.Message(), msgNotFound)
+}
+
+// IsNoUpdates returns true if the error indicates that an update of a stack
+// did not change anything.
+func IsNoUpdates(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeValidation && strings.Contains(awsErr.Message(), msgNoUpdates)
+}
+
+// IsInProgress returns true if the stack is being created, updated, rolled
+// back or deleted.
+func IsInProgress(status string) bool {
+	return strings.HasSuffix(status, statusInProgress)
+}
+
+// GenerateParameters returns the CloudFormation representation of the given
+// template parameters.
+func GenerateParameters(params []v1alpha1.StackParameter) []*awscf.Parameter {
+	if len(params) == 0 {
+		return nil
+	}
+	res := make([]*awscf.Parameter, len(params))
+	for i, p := range params {
+		res[i] = &awscf.Parameter{ParameterKey: aws.String(p.Key), ParameterValue: aws.String(p.Value)}
+	}
+	return res
+}
+
+// GenerateTags returns the CloudFormation representation of the given tags,
+// sorted by key.
+func GenerateTags(tags map[string]string) []*awscf.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awscf.Tag, 0, len(tags))
+	for k, v := range tags {
+		res = append(res, &awscf.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(res, func(i, j int) bool { return aws.StringValue(res[i].Key) < aws.StringValue(res[j].Key) })
+	return res
+}
+
+// GenerateCreateStackInput returns the input to create a stack with the given
+// name from the given parameters and template body. The template body is
+// ignored if the parameters specify a template URL.
+func GenerateCreateStackInput(name string, p v1alpha1.StackParameters, body string) *awscf.CreateStackInput {
+	in := &awscf.CreateStackInput{
+		StackName:        aws.String(name),
+		Parameters:       GenerateParameters(p.Parameters),
+		Capabilities:     aws.StringSlice(p.Capabilities),
+		RoleARN:          p.RoleARN,
+		TimeoutInMinutes: p.TimeoutInMinutes,
+		Tags:             GenerateTags(p.Tags),
+	}
+	if p.TemplateURL != nil {
+		in.TemplateURL = p.TemplateURL
+	} else {
+		in.TemplateBody = aws.String(body)
+	}
+	return in
+}
+
+// GenerateUpdateStackInput returns the input to update the stack with the
+// given name to the given parameters and template body. The template body is
+// ignored if the parameters specify a template URL.
+func GenerateUpdateStackInput(name string, p v1alpha1.StackParameters, body string) *awscf.UpdateStackInput {
+	in := &awscf.UpdateStackInput{
+		StackName:    aws.String(name),
+		Parameters:   GenerateParameters(p.Parameters),
+		Capabilities: aws.StringSlice(p.Capabilities),
+		RoleARN:      p.RoleARN,
+		Tags:         GenerateTags(p.Tags),
+	}
+	if p.TemplateURL != nil {
+		in.TemplateURL = p.TemplateURL
+	} else {
+		in.TemplateBody = aws.String(body)
+	}
+	return in
+}
+
+// GenerateObservation returns the observation of the given stack.
+func GenerateObservation(s *awscf.Stack) v1alpha1.StackObservation {
+	o := v1alpha1.StackObservation{
+		StackID:           aws.StringValue(s.StackId),
+		StackStatus:       aws.StringValue(s.StackStatus),
+		StackStatusReason: aws.StringValue(s.StackStatusReason),
+	}
+	if s.DriftInformation != nil {
+		o.DriftStatus = aws.StringValue(s.DriftInformation.StackDriftStatus)
+		if t := s.DriftInformation.LastCheckTimestamp; t != nil {
+			o.LastDriftCheckTime = &metav1.Time{Time: *t}
+		}
+	}
+	return o
+}
+
+// GenerateConnectionDetails returns the outputs of the given stack as
+// connection details, keyed by output key.
+func GenerateConnectionDetails(s *awscf.Stack) map[string][]byte {
+	if len(s.Outputs) == 0 {
+		return nil
+	}
+	cd := make(map[string][]byte, len(s.Outputs))
+	for _, o := range s.Outputs {
+		cd[aws.StringValue(o.OutputKey)] = []byte(aws.StringValue(o.OutputValue))
+	}
+	return cd
+}
+
+// IsStackUpToDate returns true if the parameters, capabilities, role and tags
+// of the given stack match the desired parameters. The template is compared
+// separately.
+func IsStackUpToDate(p v1alpha1.StackParameters, s *awscf.Stack) bool {
+	params := map[string]string{}
+	for _, sp := range s.Parameters {
+		params[aws.StringValue(sp.ParameterKey)] = aws.StringValue(sp.ParameterValue)
+	}
+	desiredParams := map[string]string{}
+	for _, dp := range p.Parameters {
+		desiredParams[dp.Key] = dp.Value
+	}
+	if !reflect.DeepEqual(params, desiredParams) {
+		return false
+	}
+
+	caps := aws.StringValueSlice(s.Capabilities)
+	desiredCaps := append([]string{}, p.Capabilities...)
+	sort.Strings(caps)
+	sort.Strings(desiredCaps)
+	if len(caps) != len(desiredCaps) || (len(caps) > 0 && !reflect.DeepEqual(caps, desiredCaps)) {
+		return false
+	}
+
+	if aws.StringValue(p.RoleARN) != aws.StringValue(s.RoleARN) {
+		return false
+	}
+
+	tags := map[string]string{}
+	for _, t := range s.Tags {
+		tags[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+	desiredTags := map[string]string{}
+	for k, v := range p.Tags {
+		desiredTags[k] = v
+	}
+	return reflect.DeepEqual(tags, desiredTags)
+}
+
+// IsTemplateUpToDate returns true if the given templates are equivalent. JSON
+// templates are compared semantically, all others after trimming surrounding
+// whitespace.
+func IsTemplateUpToDate(desired, current string) bool {
+	var d, c interface{}
+	if json.Unmarshal([]byte(desired), &d) == nil && json.Unmarshal([]byte(current), &c) == nil {
+		return reflect.DeepEqual(d, c)
+	}
+	return strings.TrimSpace(desired) == strings.TrimSpace(current)
+}
+
+// IsTemplateURLUpToDate returns true if the stack was last created or updated
+// with the desired template URL.
+func IsTemplateURLUpToDate(cr *v1alpha1.Stack) bool {
+	return cr.GetAnnotations()[AnnotationKeyTemplateURL] == aws.StringValue(cr.Spec.ForProvider.TemplateURL)
+}
+
+// IsDriftDetectionDue returns true if the drift of the given stack should be
+// detected at the given time.
+func IsDriftDetectionDue(p v1alpha1.StackParameters, s *awscf.Stack, now time.Time) bool {
+	interval := DefaultDriftDetectionInterval
+	if p.DriftDetectionInterval != nil {
+		interval = p.DriftDetectionInterval.Duration
+	}
+	if interval <= 0 {
+		return false
+	}
+	if s.DriftInformation == nil || s.DriftInformation.LastCheckTimestamp == nil {
+		return true
+	}
+	return now.Sub(*s.DriftInformation.LastCheckTimestamp) >= interval
+}
+
+// IsTemplateSourceValid returns an error unless exactly one template source
+// is specified.
+func IsTemplateSourceValid(p v1alpha1.StackParameters) error {
+	n := 0
+	if p.TemplateBody != nil {
+		n++
+	}
+	if p.TemplateBodyFrom != nil {
+		n++
+	}
+	if p.TemplateURL != nil {
+		n++
+	}
+	if n != 1 {
+		return errors.New(errTemplateSource)
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudformation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awscf "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cloudformation/v1alpha1"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  errors.Wrap(awserr.New(errCodeValidation, "Stack with id network does not exist", nil), "wrapped"),
+			want: true,
+		},
+		"OtherValidationError": {
+			err:  awserr.New(errCodeValidation, "Template format error", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTemplateUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired string
+		current string
+		want    bool
+	}{
+		"SameJSON": {
+			desired: `{"Resources":{"Topic":{"Type":"AWS::SNS::Topic"}}}`,
+			current: "{\n  \"Resources\": {\n    \"Topic\": {\"Type\": \"AWS::SNS::Topic\"}\n  }\n}\n",
+			want:    true,
+		},
+		"DifferentJSON": {
+			desired: `{"Resources":{"Topic":{"Type":"AWS::SNS::Topic"}}}`,
+			current: `{"Resources":{"Queue":{"Type":"AWS::SQS::Queue"}}}`,
+			want:    false,
+		},
+		"SameYAML": {
+			desired: "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n",
+			current: "Resources:\n  Topic:\n    Type: AWS::SNS::Topic",
+			want:    true,
+		},
+		"DifferentYAML": {
+			desired: "Resources:\n  Topic:\n    Type: AWS::SNS::Topic\n",
+			current: "Resources:\n  Queue:\n    Type: AWS::SQS::Queue\n",
+			want:    false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsTemplateUpToDate(tc.desired, tc.current)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsStackUpToDate(t *testing.T) {
+	params := v1alpha1.StackParameters{
+		Parameters:   []v1alpha1.StackParameter{{Key: "Env", Value: "prod"}},
+		Capabilities: []string{awscf.CapabilityCapabilityNamedIam, awscf.CapabilityCapabilityIam},
+		Tags:         map[string]string{"team": "network"},
+	}
+	stack := func(m ...func(*awscf.Stack)) *awscf.Stack {
+		s := &awscf.Stack{
+			Parameters:   []*awscf.Parameter{{ParameterKey: aws.String("Env"), ParameterValue: aws.String("prod")}},
+			Capabilities: aws.StringSlice([]string{awscf.CapabilityCapabilityIam, awscf.CapabilityCapabilityNamedIam}),
+			Tags:         []*awscf.Tag{{Key: aws.String("team"), Value: aws.String("network")}},
+		}
+		for _, f := range m {
+			f(s)
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		stack *awscf.Stack
+		want  bool
+	}{
+		"UpToDate": {
+			stack: stack(),
+			want:  true,
+		},
+		"ParameterChanged": {
+			stack: stack(func(s *awscf.Stack) { s.Parameters[0].ParameterValue = aws.String("dev") }),
+			want:  false,
+		},
+		"CapabilityMissing": {
+			stack: stack(func(s *awscf.Stack) { s.Capabilities = s.Capabilities[:1] }),
+			want:  false,
+		},
+		"TagChanged": {
+			stack: stack(func(s *awscf.Stack) { s.Tags = nil }),
+			want:  false,
+		},
+		"RoleChanged": {
+			stack: stack(func(s *awscf.Stack) { s.RoleARN = aws.String("arn:aws:iam::123456789012:role/cfn") }),
+			want:  false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsStackUpToDate(params, tc.stack)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDriftDetectionDue(t *testing.T) {
+	now := time.Now()
+	checked := now.Add(-2 * time.Hour)
+
+	cases := map[string]struct {
+		interval *metav1.Duration
+		stack    *awscf.Stack
+		want     bool
+	}{
+		"NeverChecked": {
+			stack: &awscf.Stack{},
+			want:  true,
+		},
+		"DefaultIntervalElapsed": {
+			stack: &awscf.Stack{DriftInformation: &awscf.StackDriftInformation{LastCheckTimestamp: &checked}},
+			want:  true,
+		},
+		"IntervalNotElapsed": {
+			interval: &metav1.Duration{Duration: 6 * time.Hour},
+			stack:    &awscf.Stack{DriftInformation: &awscf.StackDriftInformation{LastCheckTimestamp: &checked}},
+			want:     false,
+		},
+		"Disabled": {
+			interval: &metav1.Duration{},
+			stack:    &awscf.Stack{},
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := v1alpha1.StackParameters{DriftDetectionInterval: tc.interval}
+			if diff := cmp.Diff(tc.want, IsDriftDetectionDue(p, tc.stack, now)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
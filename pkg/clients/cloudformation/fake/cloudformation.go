@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+)
+
+// MockClient is a type that implements all the methods for the CloudFormation
+// Client interface.
+type MockClient struct {
+	MockCreateStack      func(ctx context.Context, input *cloudformation.CreateStackInput, opts []request.Option) (*cloudformation.CreateStackOutput, error)
+	MockDescribeStacks   func(ctx context.Context, input *cloudformation.DescribeStacksInput, opts []request.Option) (*cloudformation.DescribeStacksOutput, error)
+	MockUpdateStack      func(ctx context.Context, input *cloudformation.UpdateStackInput, opts []request.Option) (*cloudformation.UpdateStackOutput, error)
+	MockDeleteStack      func(ctx context.Context, input *cloudformation.DeleteStackInput, opts []request.Option) (*cloudformation.DeleteStackOutput, error)
+	MockGetTemplate      func(ctx context.Context, input *cloudformation.GetTemplateInput, opts []request.Option) (*cloudformation.GetTemplateOutput, error)
+	MockDetectStackDrift func(ctx context.Context, input *cloudformation.DetectStackDriftInput, opts []request.Option) (*cloudformation.DetectStackDriftOutput, error)
+}
+
+// CreateStackWithContext mocks CreateStackWithContext method
+func (m *MockClient) CreateStackWithContext(ctx context.Context, input *cloudformation.CreateStackInput, opts ...request.Option) (*cloudformation.CreateStackOutput, error) {
+	return m.MockCreateStack(ctx, input, opts)
+}
+
+// DescribeStacksWithContext mocks DescribeStacksWithContext method
+func (m *MockClient) DescribeStacksWithContext(ctx context.Context, input *cloudformation.DescribeStacksInput, opts ...request.Option) (*cloudformation.DescribeStacksOutput, error) {
+	return m.MockDescribeStacks(ctx, input, opts)
+}
+
+// UpdateStackWithContext mocks UpdateStackWithContext method
+func (m *MockClient) UpdateStackWithContext(ctx context.Context, input *cloudformation.UpdateStackInput, opts ...request.Option) (*cloudformation.UpdateStackOutput, error) {
+	return m.MockUpdateStack(ctx, input, opts)
+}
+
+// DeleteStackWithContext mocks DeleteStackWithContext method
+func (m *MockClient) DeleteStackWithContext(ctx context.Context, input *cloudformation.DeleteStackInput, opts ...request.Option) (*cloudformation.DeleteStackOutput, error) {
+	return m.MockDeleteStack(ctx, input, opts)
+}
+
+// GetTemplateWithContext mocks GetTemplateWithContext method
+func (m *MockClient) GetTemplateWithContext(ctx context.Context, input *cloudformation.GetTemplateInput, opts ...request.Option) (*cloudformation.GetTemplateOutput, error) {
+	return m.MockGetTemplate(ctx, input, opts)
+}
+
+// DetectStackDriftWithContext mocks DetectStackDriftWithContext method
+func (m *MockClient) DetectStackDriftWithContext(ctx context.Context, input *cloudformation.DetectStackDriftInput, opts ...request.Option) (*cloudformation.DetectStackDriftOutput, error) {
+	return m.MockDetectStackDrift(ctx, input, opts)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the CloudFront operations used by the PublicKey and
+// KeyGroup controllers.
+type Client interface {
+	CreatePublicKeyWithContext(context.Context, *svcsdk.CreatePublicKeyInput, ...request.Option) (*svcsdk.CreatePublicKeyOutput, error)
+	GetPublicKeyWithContext(context.Context, *svcsdk.GetPublicKeyInput, ...request.Option) (*svcsdk.GetPublicKeyOutput, error)
+	UpdatePublicKeyWithContext(context.Context, *svcsdk.UpdatePublicKeyInput, ...request.Option) (*svcsdk.UpdatePublicKeyOutput, error)
+	DeletePublicKeyWithContext(context.Context, *svcsdk.DeletePublicKeyInput, ...request.Option) (*svcsdk.DeletePublicKeyOutput, error)
+
+	CreateKeyGroupWithContext(context.Context, *svcsdk.CreateKeyGroupInput, ...request.Option) (*svcsdk.CreateKeyGroupOutput, error)
+	GetKeyGroupWithContext(context.Context, *svcsdk.GetKeyGroupInput, ...request.Option) (*svcsdk.GetKeyGroupOutput, error)
+	UpdateKeyGroupWithContext(context.Context, *svcsdk.UpdateKeyGroupInput, ...request.Option) (*svcsdk.UpdateKeyGroupOutput, error)
+	DeleteKeyGroupWithContext(context.Context, *svcsdk.DeleteKeyGroupInput, ...request.Option) (*svcsdk.DeleteKeyGroupOutput, error)
+}
+
+// NewClient returns a new CloudFront client for the given session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the public key or key
+// group does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	return awsErr.Code() == svcsdk.ErrCodeNoSuchPublicKey || awsErr.Code() == svcsdk.ErrCodeNoSuchResource
+}
+
+// GeneratePublicKeyConfig returns the public key configuration for the
+// given parameters and PEM encoded key.
+func GeneratePublicKeyConfig(callerReference string, p v1alpha1.PublicKeyParameters, encodedKey string) *svcsdk.PublicKeyConfig {
+	return &svcsdk.PublicKeyConfig{
+		CallerReference: awsclient.String(callerReference),
+		Name:            awsclient.String(p.Name),
+		Comment:         p.Comment,
+		EncodedKey:      awsclient.String(encodedKey),
+	}
+}
+
+// IsPublicKeyEncodedKeyUpToDate returns true if the observed public key
+// has the given PEM encoded key. Surrounding whitespace is ignored.
+func IsPublicKeyEncodedKeyUpToDate(encodedKey string, observed *svcsdk.PublicKeyConfig) bool {
+	return strings.TrimSpace(encodedKey) == strings.TrimSpace(awsclient.StringValue(observed.EncodedKey))
+}
+
+// IsPublicKeyUpToDate returns true if the observed public key matches the
+// given parameters and PEM encoded key.
+func IsPublicKeyUpToDate(p v1alpha1.PublicKeyParameters, encodedKey string, observed *svcsdk.PublicKeyConfig) bool {
+	return awsclient.StringValue(p.Comment) == awsclient.StringValue(observed.Comment) &&
+		IsPublicKeyEncodedKeyUpToDate(encodedKey, observed)
+}
+
+// GenerateKeyGroupConfig returns the key group configuration for the given
+// parameters.
+func GenerateKeyGroupConfig(p v1alpha1.KeyGroupParameters) *svcsdk.KeyGroupConfig {
+	return &svcsdk.KeyGroupConfig{
+		Name:    awsclient.String(p.Name),
+		Comment: p.Comment,
+		Items:   aws.StringSlice(p.PublicKeyIDs),
+	}
+}
+
+// IsKeyGroupUpToDate returns true if the observed key group matches the
+// given parameters. The order of the public keys is ignored.
+func IsKeyGroupUpToDate(p v1alpha1.KeyGroupParameters, observed *svcsdk.KeyGroupConfig) bool {
+	if p.Name != awsclient.StringValue(observed.Name) ||
+		awsclient.StringValue(p.Comment) != awsclient.StringValue(observed.Comment) {
+		return false
+	}
+	desired := append([]string{}, p.PublicKeyIDs...)
+	current := aws.StringValueSlice(observed.Items)
+	sort.Strings(desired)
+	sort.Strings(current)
+	return cmp.Equal(desired, current)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudfront
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	encodedKey = "-----BEGIN PUBLIC KEY-----\nMIIB\n-----END PUBLIC KEY-----"
+	comment    = "comment"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NoSuchPublicKey": {
+			err:  awserr.New(svcsdk.ErrCodeNoSuchPublicKey, "", nil),
+			want: true,
+		},
+		"NoSuchResource": {
+			err:  errors.Wrap(awserr.New(svcsdk.ErrCodeNoSuchResource, "", nil), "wrapped"),
+			want: true,
+		},
+		"InUse": {
+			err:  awserr.New(svcsdk.ErrCodePublicKeyInUse, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPublicKeyUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.PublicKeyParameters
+		key      string
+		observed *svcsdk.PublicKeyConfig
+		want     bool
+	}{
+		"UpToDate": {
+			p:        v1alpha1.PublicKeyParameters{Comment: &comment},
+			key:      encodedKey + "\n",
+			observed: &svcsdk.PublicKeyConfig{Comment: &comment, EncodedKey: &encodedKey},
+			want:     true,
+		},
+		"CommentChanged": {
+			p:        v1alpha1.PublicKeyParameters{},
+			key:      encodedKey,
+			observed: &svcsdk.PublicKeyConfig{Comment: &comment, EncodedKey: &encodedKey},
+			want:     false,
+		},
+		"KeyChanged": {
+			p:        v1alpha1.PublicKeyParameters{Comment: &comment},
+			key:      "other",
+			observed: &svcsdk.PublicKeyConfig{Comment: &comment, EncodedKey: &encodedKey},
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPublicKeyUpToDate(tc.p, tc.key, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsKeyGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.KeyGroupParameters
+		observed *svcsdk.KeyGroupConfig
+		want     bool
+	}{
+		"UpToDateInAnyOrder": {
+			p: v1alpha1.KeyGroupParameters{Name: "group", PublicKeyIDs: []string{"a", "b"}},
+			observed: &svcsdk.KeyGroupConfig{
+				Name:  awsclient.String("group"),
+				Items: []*string{awsclient.String("b"), awsclient.String("a")},
+			},
+			want: true,
+		},
+		"KeyAdded": {
+			p: v1alpha1.KeyGroupParameters{Name: "group", PublicKeyIDs: []string{"a", "b"}},
+			observed: &svcsdk.KeyGroupConfig{
+				Name:  awsclient.String("group"),
+				Items: []*string{awsclient.String("a")},
+			},
+			want: false,
+		},
+		"CommentChanged": {
+			p: v1alpha1.KeyGroupParameters{Name: "group", Comment: &comment, PublicKeyIDs: []string{"a"}},
+			observed: &svcsdk.KeyGroupConfig{
+				Name:  awsclient.String("group"),
+				Items: []*string{awsclient.String("a")},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsKeyGroupUpToDate(tc.p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudfront"
+)
+
+// MockClient is a type that implements all the methods for the CloudFront
+// Client interface.
+type MockClient struct {
+	MockCreatePublicKey func(ctx context.Context, input *cloudfront.CreatePublicKeyInput, opts []request.Option) (*cloudfront.CreatePublicKeyOutput, error)
+	MockGetPublicKey    func(ctx context.Context, input *cloudfront.GetPublicKeyInput, opts []request.Option) (*cloudfront.GetPublicKeyOutput, error)
+	MockUpdatePublicKey func(ctx context.Context, input *cloudfront.UpdatePublicKeyInput, opts []request.Option) (*cloudfront.UpdatePublicKeyOutput, error)
+	MockDeletePublicKey func(ctx context.Context, input *cloudfront.DeletePublicKeyInput, opts []request.Option) (*cloudfront.DeletePublicKeyOutput, error)
+	MockCreateKeyGroup  func(ctx context.Context, input *cloudfront.CreateKeyGroupInput, opts []request.Option) (*cloudfront.CreateKeyGroupOutput, error)
+	MockGetKeyGroup     func(ctx context.Context, input *cloudfront.GetKeyGroupInput, opts []request.Option) (*cloudfront.GetKeyGroupOutput, error)
+	MockUpdateKeyGroup  func(ctx context.Context, input *cloudfront.UpdateKeyGroupInput, opts []request.Option) (*cloudfront.UpdateKeyGroupOutput, error)
+	MockDeleteKeyGroup  func(ctx context.Context, input *cloudfront.DeleteKeyGroupInput, opts []request.Option) (*cloudfront.DeleteKeyGroupOutput, error)
+}
+
+// CreatePublicKeyWithContext mocks CreatePublicKeyWithContext method
+func (m *MockClient) CreatePublicKeyWithContext(ctx context.Context, input *cloudfront.CreatePublicKeyInput, opts ...request.Option) (*cloudfront.CreatePublicKeyOutput, error) {
+	return m.MockCreatePublicKey(ctx, input, opts)
+}
+
+// GetPublicKeyWithContext mocks GetPublicKeyWithContext method
+func (m *MockClient) GetPublicKeyWithContext(ctx context.Context, input *cloudfront.GetPublicKeyInput, opts ...request.Option) (*cloudfront.GetPublicKeyOutput, error) {
+	return m.MockGetPublicKey(ctx, input, opts)
+}
+
+// UpdatePublicKeyWithContext mocks UpdatePublicKeyWithContext method
+func (m *MockClient) UpdatePublicKeyWithContext(ctx context.Context, input *cloudfront.UpdatePublicKeyInput, opts ...request.Option) (*cloudfront.UpdatePublicKeyOutput, error) {
+	return m.MockUpdatePublicKey(ctx, input, opts)
+}
+
+// DeletePublicKeyWithContext mocks DeletePublicKeyWithContext method
+func (m *MockClient) DeletePublicKeyWithContext(ctx context.Context, input *cloudfront.DeletePublicKeyInput, opts ...request.Option) (*cloudfront.DeletePublicKeyOutput, error) {
+	return m.MockDeletePublicKey(ctx, input, opts)
+}
+
+// CreateKeyGroupWithContext mocks CreateKeyGroupWithContext method
+func (m *MockClient) CreateKeyGroupWithContext(ctx context.Context, input *cloudfront.CreateKeyGroupInput, opts ...request.Option) (*cloudfront.CreateKeyGroupOutput, error) {
+	return m.MockCreateKeyGroup(ctx, input, opts)
+}
+
+// GetKeyGroupWithContext mocks GetKeyGroupWithContext method
+func (m *MockClient) GetKeyGroupWithContext(ctx context.Context, input *cloudfront.GetKeyGroupInput, opts ...request.Option) (*cloudfront.GetKeyGroupOutput, error) {
+	return m.MockGetKeyGroup(ctx, input, opts)
+}
+
+// UpdateKeyGroupWithContext mocks UpdateKeyGroupWithContext method
+func (m *MockClient) UpdateKeyGroupWithContext(ctx context.Context, input *cloudfront.UpdateKeyGroupInput, opts ...request.Option) (*cloudfront.UpdateKeyGroupOutput, error) {
+	return m.MockUpdateKeyGroup(ctx, input, opts)
+}
+
+// DeleteKeyGroupWithContext mocks DeleteKeyGroupWithContext method
+func (m *MockClient) DeleteKeyGroupWithContext(ctx context.Context, input *cloudfront.DeleteKeyGroupInput, opts ...request.Option) (*cloudfront.DeleteKeyGroupOutput, error) {
+	return m.MockDeleteKeyGroup(ctx, input, opts)
+}
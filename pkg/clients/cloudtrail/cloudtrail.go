@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtrail
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags  = "cannot list tags"
+	errAddTags   = "cannot add tags to trail"
+	errRemoveTag = "cannot remove tags from trail"
+)
+
+// Client defines the CloudTrail operations used by the trail controller.
+type Client interface {
+	CreateTrailWithContext(context.Context, *awscloudtrail.CreateTrailInput, ...request.Option) (*awscloudtrail.CreateTrailOutput, error)
+	GetTrailWithContext(context.Context, *awscloudtrail.GetTrailInput, ...request.Option) (*awscloudtrail.GetTrailOutput, error)
+	GetTrailStatusWithContext(context.Context, *awscloudtrail.GetTrailStatusInput, ...request.Option) (*awscloudtrail.GetTrailStatusOutput, error)
+	UpdateTrailWithContext(context.Context, *awscloudtrail.UpdateTrailInput, ...request.Option) (*awscloudtrail.UpdateTrailOutput, error)
+	DeleteTrailWithContext(context.Context, *awscloudtrail.DeleteTrailInput, ...request.Option) (*awscloudtrail.DeleteTrailOutput, error)
+	GetEventSelectorsWithContext(context.Context, *awscloudtrail.GetEventSelectorsInput, ...request.Option) (*awscloudtrail.GetEventSelectorsOutput, error)
+	PutEventSelectorsWithContext(context.Context, *awscloudtrail.PutEventSelectorsInput, ...request.Option) (*awscloudtrail.PutEventSelectorsOutput, error)
+	StartLoggingWithContext(context.Context, *awscloudtrail.StartLoggingInput, ...request.Option) (*awscloudtrail.StartLoggingOutput, error)
+	StopLoggingWithContext(context.Context, *awscloudtrail.StopLoggingInput, ...request.Option) (*awscloudtrail.StopLoggingOutput, error)
+	ListTagsWithContext(context.Context, *awscloudtrail.ListTagsInput, ...request.Option) (*awscloudtrail.ListTagsOutput, error)
+	AddTagsWithContext(context.Context, *awscloudtrail.AddTagsInput, ...request.Option) (*awscloudtrail.AddTagsOutput, error)
+	RemoveTagsWithContext(context.Context, *awscloudtrail.RemoveTagsInput, ...request.Option) (*awscloudtrail.RemoveTagsOutput, error)
+}
+
+// NewClient returns a new CloudTrail client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awscloudtrail.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the trail does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awscloudtrail.ErrCodeTrailNotFoundException
+}
+
+// GenerateCreateTrailInput returns the input that creates the trail with the
+// given name and parameters.
+func GenerateCreateTrailInput(name string, p v1alpha1.TrailParameters) *awscloudtrail.CreateTrailInput {
+	return &awscloudtrail.CreateTrailInput{
+		Name:                       awsclient.String(name),
+		S3BucketName:               p.S3BucketName,
+		S3KeyPrefix:                p.S3KeyPrefix,
+		SnsTopicName:               p.SNSTopicName,
+		IncludeGlobalServiceEvents: p.IncludeGlobalServiceEvents,
+		IsMultiRegionTrail:         p.IsMultiRegionTrail,
+		IsOrganizationTrail:        p.IsOrganizationTrail,
+		EnableLogFileValidation:    p.EnableLogFileValidation,
+		CloudWatchLogsLogGroupArn:  p.CloudWatchLogsLogGroupARN,
+		CloudWatchLogsRoleArn:      p.CloudWatchLogsRoleARN,
+		KmsKeyId:                   p.KMSKeyID,
+		TagsList:                   GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateTrailInput returns the input that updates the trail with the
+// given name to match the given parameters.
+func GenerateUpdateTrailInput(name string, p v1alpha1.TrailParameters) *awscloudtrail.UpdateTrailInput {
+	return &awscloudtrail.UpdateTrailInput{
+		Name:                       awsclient.String(name),
+		S3BucketName:               p.S3BucketName,
+		S3KeyPrefix:                p.S3KeyPrefix,
+		SnsTopicName:               p.SNSTopicName,
+		IncludeGlobalServiceEvents: p.IncludeGlobalServiceEvents,
+		IsMultiRegionTrail:         p.IsMultiRegionTrail,
+		IsOrganizationTrail:        p.IsOrganizationTrail,
+		EnableLogFileValidation:    p.EnableLogFileValidation,
+		CloudWatchLogsLogGroupArn:  p.CloudWatchLogsLogGroupARN,
+		CloudWatchLogsRoleArn:      p.CloudWatchLogsRoleARN,
+		KmsKeyId:                   p.KMSKeyID,
+	}
+}
+
+// IsTrailUpToDate returns true if the observed trail matches the given
+// parameters. Fields that are not set in the parameters are left to their
+// AWS defaults and not compared.
+func IsTrailUpToDate(p v1alpha1.TrailParameters, t *awscloudtrail.Trail) bool {
+	switch {
+	case !stringUpToDate(p.S3BucketName, t.S3BucketName),
+		!stringUpToDate(p.S3KeyPrefix, t.S3KeyPrefix),
+		!stringUpToDate(p.CloudWatchLogsLogGroupARN, t.CloudWatchLogsLogGroupArn),
+		!stringUpToDate(p.CloudWatchLogsRoleARN, t.CloudWatchLogsRoleArn),
+		!boolUpToDate(p.IncludeGlobalServiceEvents, t.IncludeGlobalServiceEvents),
+		!boolUpToDate(p.IsMultiRegionTrail, t.IsMultiRegionTrail),
+		!boolUpToDate(p.IsOrganizationTrail, t.IsOrganizationTrail),
+		!boolUpToDate(p.EnableLogFileValidation, t.LogFileValidationEnabled):
+		return false
+	}
+	// The topic can be given by name or ARN, but CloudTrail reports both.
+	if p.SNSTopicName != nil && *p.SNSTopicName != awsclient.StringValue(t.SnsTopicName) && *p.SNSTopicName != awsclient.StringValue(t.SnsTopicARN) {
+		return false
+	}
+	// CloudTrail always reports the ARN of the key even if it was given by
+	// ID.
+	if p.KMSKeyID != nil && *p.KMSKeyID != awsclient.StringValue(t.KmsKeyId) && !strings.HasSuffix(awsclient.StringValue(t.KmsKeyId), "/"+*p.KMSKeyID) {
+		return false
+	}
+	return true
+}
+
+// GenerateEventSelectors returns the given event selectors with the AWS
+// defaults filled in.
+func GenerateEventSelectors(selectors []v1alpha1.EventSelector) []*awscloudtrail.EventSelector {
+	res := make([]*awscloudtrail.EventSelector, len(selectors))
+	for i, s := range selectors {
+		es := &awscloudtrail.EventSelector{
+			ReadWriteType:                 aws.String(awscloudtrail.ReadWriteTypeAll),
+			IncludeManagementEvents:       aws.Bool(true),
+			ExcludeManagementEventSources: aws.StringSlice(s.ExcludeManagementEventSources),
+		}
+		if s.ReadWriteType != nil {
+			es.ReadWriteType = s.ReadWriteType
+		}
+		if s.IncludeManagementEvents != nil {
+			es.IncludeManagementEvents = s.IncludeManagementEvents
+		}
+		for _, d := range s.DataResources {
+			es.DataResources = append(es.DataResources, &awscloudtrail.DataResource{
+				Type:   awsclient.String(d.Type),
+				Values: aws.StringSlice(d.Values),
+			})
+		}
+		res[i] = es
+	}
+	return res
+}
+
+// AreEventSelectorsUpToDate returns true if the observed event selectors
+// match the given ones. The default selector of CloudTrail is kept if no
+// selectors are given.
+func AreEventSelectorsUpToDate(selectors []v1alpha1.EventSelector, observed []*awscloudtrail.EventSelector) bool {
+	if len(selectors) == 0 {
+		return true
+	}
+	return cmp.Equal(GenerateEventSelectors(selectors), observed, cmpopts.EquateEmpty())
+}
+
+// IsLoggingUpToDate returns true if the logging state of the trail matches
+// the desired one. Logging is enabled unless it is explicitly disabled.
+func IsLoggingUpToDate(enable *bool, isLogging bool) bool {
+	return (enable == nil || *enable) == isLogging
+}
+
+// GenerateTags returns the given tags in the form CloudTrail accepts them.
+func GenerateTags(tags []v1alpha1.Tag) []*awscloudtrail.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awscloudtrail.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awscloudtrail.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be added to and removed from the
+// trail so that its tags match the desired ones.
+func DiffTags(desired []v1alpha1.Tag, observed []*awscloudtrail.Tag) (add, remove []*awscloudtrail.Tag) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	addMap, removeKeys := awsclient.DiffTags(local, remote)
+	for k, v := range addMap {
+		add = append(add, &awscloudtrail.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	}
+	sort.Slice(add, func(i, j int) bool {
+		return *add[i].Key < *add[j].Key
+	})
+	sort.Strings(removeKeys)
+	for _, k := range removeKeys {
+		remove = append(remove, &awscloudtrail.Tag{Key: awsclient.String(k)})
+	}
+	return add, remove
+}
+
+// ListTags returns the tags of the trail with the given ARN.
+func ListTags(ctx context.Context, c Client, arn string) ([]*awscloudtrail.Tag, error) {
+	out, err := c.ListTagsWithContext(ctx, &awscloudtrail.ListTagsInput{ResourceIdList: []*string{&arn}})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errListTags)
+	}
+	for _, r := range out.ResourceTagList {
+		if awsclient.StringValue(r.ResourceId) == arn {
+			return r.TagsList, nil
+		}
+	}
+	return nil, nil
+}
+
+// UpdateTags makes the tags of the trail with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, c Client, arn string, desired []v1alpha1.Tag) error {
+	observed, err := ListTags(ctx, c, arn)
+	if err != nil {
+		return err
+	}
+	add, remove := DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := c.RemoveTagsWithContext(ctx, &awscloudtrail.RemoveTagsInput{ResourceId: &arn, TagsList: remove}); err != nil {
+			return awsclient.Wrap(err, errRemoveTag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := c.AddTagsWithContext(ctx, &awscloudtrail.AddTagsInput{ResourceId: &arn, TagsList: add}); err != nil {
+			return awsclient.Wrap(err, errAddTags)
+		}
+	}
+	return nil
+}
+
+func stringUpToDate(desired, observed *string) bool {
+	return desired == nil || *desired == awsclient.StringValue(observed)
+}
+
+func boolUpToDate(desired, observed *bool) bool {
+	return desired == nil || *desired == aws.BoolValue(observed)
+}
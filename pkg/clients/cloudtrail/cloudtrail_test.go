@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudtrail
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awscloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+)
+
+func TestIsTrailUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p     v1alpha1.TrailParameters
+		trail *awscloudtrail.Trail
+		want  bool
+	}{
+		"UnsetFieldsIgnored": {
+			p: v1alpha1.TrailParameters{S3BucketName: aws.String("logs")},
+			trail: &awscloudtrail.Trail{
+				S3BucketName:               aws.String("logs"),
+				IncludeGlobalServiceEvents: aws.Bool(true),
+				LogFileValidationEnabled:   aws.Bool(false),
+			},
+			want: true,
+		},
+		"BucketChanged": {
+			p:     v1alpha1.TrailParameters{S3BucketName: aws.String("new")},
+			trail: &awscloudtrail.Trail{S3BucketName: aws.String("old")},
+			want:  false,
+		},
+		"MultiRegionChanged": {
+			p:     v1alpha1.TrailParameters{IsMultiRegionTrail: aws.Bool(true)},
+			trail: &awscloudtrail.Trail{IsMultiRegionTrail: aws.Bool(false)},
+			want:  false,
+		},
+		"TopicByARN": {
+			p: v1alpha1.TrailParameters{SNSTopicName: aws.String("arn:aws:sns:us-east-1:123456789012:trail")},
+			trail: &awscloudtrail.Trail{
+				SnsTopicName: aws.String("trail"),
+				SnsTopicARN:  aws.String("arn:aws:sns:us-east-1:123456789012:trail"),
+			},
+			want: true,
+		},
+		"KeyByID": {
+			p:     v1alpha1.TrailParameters{KMSKeyID: aws.String("1234abcd")},
+			trail: &awscloudtrail.Trail{KmsKeyId: aws.String("arn:aws:kms:us-east-1:123456789012:key/1234abcd")},
+			want:  true,
+		},
+		"KeyChanged": {
+			p:     v1alpha1.TrailParameters{KMSKeyID: aws.String("arn:aws:kms:us-east-1:123456789012:key/new")},
+			trail: &awscloudtrail.Trail{KmsKeyId: aws.String("arn:aws:kms:us-east-1:123456789012:key/old")},
+			want:  false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsTrailUpToDate(tc.p, tc.trail)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsTrailUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAreEventSelectorsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		selectors []v1alpha1.EventSelector
+		observed  []*awscloudtrail.EventSelector
+		want      bool
+	}{
+		"NoneDesired": {
+			observed: []*awscloudtrail.EventSelector{{ReadWriteType: aws.String("All")}},
+			want:     true,
+		},
+		"DefaultsFilledIn": {
+			selectors: []v1alpha1.EventSelector{{}},
+			observed: []*awscloudtrail.EventSelector{{
+				ReadWriteType:                 aws.String("All"),
+				IncludeManagementEvents:       aws.Bool(true),
+				DataResources:                 []*awscloudtrail.DataResource{},
+				ExcludeManagementEventSources: []*string{},
+			}},
+			want: true,
+		},
+		"DataResourceAdded": {
+			selectors: []v1alpha1.EventSelector{{
+				DataResources: []v1alpha1.DataResource{{Type: "AWS::S3::Object", Values: []string{"arn:aws:s3:::bucket/"}}},
+			}},
+			observed: []*awscloudtrail.EventSelector{{
+				ReadWriteType:           aws.String("All"),
+				IncludeManagementEvents: aws.Bool(true),
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := AreEventSelectorsUpToDate(tc.selectors, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("AreEventSelectorsUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*awscloudtrail.Tag
+		remove []*awscloudtrail.Tag
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []*awscloudtrail.Tag
+		want     want
+	}{
+		"Equal": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []*awscloudtrail.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		"AddChangeAndRemove": {
+			desired: []v1alpha1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}},
+			observed: []*awscloudtrail.Tag{
+				{Key: aws.String("a"), Value: aws.String("0")},
+				{Key: aws.String("c"), Value: aws.String("3")},
+			},
+			want: want{
+				add: []*awscloudtrail.Tag{
+					{Key: aws.String("a"), Value: aws.String("1")},
+					{Key: aws.String("b"), Value: aws.String("2")},
+				},
+				remove: []*awscloudtrail.Tag{{Key: aws.String("a")}, {Key: aws.String("c")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("DiffTags(...): add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("DiffTags(...): remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
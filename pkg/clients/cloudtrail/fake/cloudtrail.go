@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudtrail"
+)
+
+// MockClient is a type that implements all the methods for the CloudTrail
+// Client interface.
+type MockClient struct {
+	MockCreateTrail       func(ctx context.Context, input *cloudtrail.CreateTrailInput, opts []request.Option) (*cloudtrail.CreateTrailOutput, error)
+	MockGetTrail          func(ctx context.Context, input *cloudtrail.GetTrailInput, opts []request.Option) (*cloudtrail.GetTrailOutput, error)
+	MockGetTrailStatus    func(ctx context.Context, input *cloudtrail.GetTrailStatusInput, opts []request.Option) (*cloudtrail.GetTrailStatusOutput, error)
+	MockUpdateTrail       func(ctx context.Context, input *cloudtrail.UpdateTrailInput, opts []request.Option) (*cloudtrail.UpdateTrailOutput, error)
+	MockDeleteTrail       func(ctx context.Context, input *cloudtrail.DeleteTrailInput, opts []request.Option) (*cloudtrail.DeleteTrailOutput, error)
+	MockGetEventSelectors func(ctx context.Context, input *cloudtrail.GetEventSelectorsInput, opts []request.Option) (*cloudtrail.GetEventSelectorsOutput, error)
+	MockPutEventSelectors func(ctx context.Context, input *cloudtrail.PutEventSelectorsInput, opts []request.Option) (*cloudtrail.PutEventSelectorsOutput, error)
+	MockStartLogging      func(ctx context.Context, input *cloudtrail.StartLoggingInput, opts []request.Option) (*cloudtrail.StartLoggingOutput, error)
+	MockStopLogging       func(ctx context.Context, input *cloudtrail.StopLoggingInput, opts []request.Option) (*cloudtrail.StopLoggingOutput, error)
+	MockListTags          func(ctx context.Context, input *cloudtrail.ListTagsInput, opts []request.Option) (*cloudtrail.ListTagsOutput, error)
+	MockAddTags           func(ctx context.Context, input *cloudtrail.AddTagsInput, opts []request.Option) (*cloudtrail.AddTagsOutput, error)
+	MockRemoveTags        func(ctx context.Context, input *cloudtrail.RemoveTagsInput, opts []request.Option) (*cloudtrail.RemoveTagsOutput, error)
+}
+
+// CreateTrailWithContext mocks CreateTrailWithContext method
+func (m *MockClient) CreateTrailWithContext(ctx context.Context, input *cloudtrail.CreateTrailInput, opts ...request.Option) (*cloudtrail.CreateTrailOutput, error) {
+	return m.MockCreateTrail(ctx, input, opts)
+}
+
+// GetTrailWithContext mocks GetTrailWithContext method
+func (m *MockClient) GetTrailWithContext(ctx context.Context, input *cloudtrail.GetTrailInput, opts ...request.Option) (*cloudtrail.GetTrailOutput, error) {
+	return m.MockGetTrail(ctx, input, opts)
+}
+
+// GetTrailStatusWithContext mocks GetTrailStatusWithContext method
+func (m *MockClient) GetTrailStatusWithContext(ctx context.Context, input *cloudtrail.GetTrailStatusInput, opts ...request.Option) (*cloudtrail.GetTrailStatusOutput, error) {
+	return m.MockGetTrailStatus(ctx, input, opts)
+}
+
+// UpdateTrailWithContext mocks UpdateTrailWithContext method
+func (m *MockClient) UpdateTrailWithContext(ctx context.Context, input *cloudtrail.UpdateTrailInput, opts ...request.Option) (*cloudtrail.UpdateTrailOutput, error) {
+	return m.MockUpdateTrail(ctx, input, opts)
+}
+
+// DeleteTrailWithContext mocks DeleteTrailWithContext method
+func (m *MockClient) DeleteTrailWithContext(ctx context.Context, input *cloudtrail.DeleteTrailInput, opts ...request.Option) (*cloudtrail.DeleteTrailOutput, error) {
+	return m.MockDeleteTrail(ctx, input, opts)
+}
+
+// GetEventSelectorsWithContext mocks GetEventSelectorsWithContext method
+func (m *MockClient) GetEventSelectorsWithContext(ctx context.Context, input *cloudtrail.GetEventSelectorsInput, opts ...request.Option) (*cloudtrail.GetEventSelectorsOutput, error) {
+	return m.MockGetEventSelectors(ctx, input, opts)
+}
+
+// PutEventSelectorsWithContext mocks PutEventSelectorsWithContext method
+func (m *MockClient) PutEventSelectorsWithContext(ctx context.Context, input *cloudtrail.PutEventSelectorsInput, opts ...request.Option) (*cloudtrail.PutEventSelectorsOutput, error) {
+	return m.MockPutEventSelectors(ctx, input, opts)
+}
+
+// StartLoggingWithContext mocks StartLoggingWithContext method
+func (m *MockClient) StartLoggingWithContext(ctx context.Context, input *cloudtrail.StartLoggingInput, opts ...request.Option) (*cloudtrail.StartLoggingOutput, error) {
+	return m.MockStartLogging(ctx, input, opts)
+}
+
+// StopLoggingWithContext mocks StopLoggingWithContext method
+func (m *MockClient) StopLoggingWithContext(ctx context.Context, input *cloudtrail.StopLoggingInput, opts ...request.Option) (*cloudtrail.StopLoggingOutput, error) {
+	return m.MockStopLogging(ctx, input, opts)
+}
+
+// ListTagsWithContext mocks ListTagsWithContext method
+func (m *MockClient) ListTagsWithContext(ctx context.Context, input *cloudtrail.ListTagsInput, opts ...request.Option) (*cloudtrail.ListTagsOutput, error) {
+	return m.MockListTags(ctx, input, opts)
+}
+
+// AddTagsWithContext mocks AddTagsWithContext method
+func (m *MockClient) AddTagsWithContext(ctx context.Context, input *cloudtrail.AddTagsInput, opts ...request.Option) (*cloudtrail.AddTagsOutput, error) {
+	return m.MockAddTags(ctx, input, opts)
+}
+
+// RemoveTagsWithContext mocks RemoveTagsWithContext method
+func (m *MockClient) RemoveTagsWithContext(ctx context.Context, input *cloudtrail.RemoveTagsInput, opts ...request.Option) (*cloudtrail.RemoveTagsOutput, error) {
+	return m.MockRemoveTags(ctx, input, opts)
+}
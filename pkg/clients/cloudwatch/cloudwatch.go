@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags = "cannot list tags"
+	errTag      = "cannot tag alarm"
+	errUntag    = "cannot untag alarm"
+)
+
+// Client defines the CloudWatch operations used by the alarm controllers.
+type Client interface {
+	DescribeAlarmsWithContext(context.Context, *awscloudwatch.DescribeAlarmsInput, ...request.Option) (*awscloudwatch.DescribeAlarmsOutput, error)
+	PutMetricAlarmWithContext(context.Context, *awscloudwatch.PutMetricAlarmInput, ...request.Option) (*awscloudwatch.PutMetricAlarmOutput, error)
+	PutCompositeAlarmWithContext(context.Context, *awscloudwatch.PutCompositeAlarmInput, ...request.Option) (*awscloudwatch.PutCompositeAlarmOutput, error)
+	DeleteAlarmsWithContext(context.Context, *awscloudwatch.DeleteAlarmsInput, ...request.Option) (*awscloudwatch.DeleteAlarmsOutput, error)
+	ListTagsForResourceWithContext(context.Context, *awscloudwatch.ListTagsForResourceInput, ...request.Option) (*awscloudwatch.ListTagsForResourceOutput, error)
+	TagResourceWithContext(context.Context, *awscloudwatch.TagResourceInput, ...request.Option) (*awscloudwatch.TagResourceOutput, error)
+	UntagResourceWithContext(context.Context, *awscloudwatch.UntagResourceInput, ...request.Option) (*awscloudwatch.UntagResourceOutput, error)
+}
+
+// NewClient returns a new CloudWatch client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awscloudwatch.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the alarm does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awscloudwatch.ErrCodeResourceNotFound
+}
+
+// GenerateMetricAlarmInput returns the input that creates or updates the
+// metric alarm with the given name and parameters. Tags are left out since
+// PutMetricAlarm only applies them when the alarm is created.
+func GenerateMetricAlarmInput(name string, p v1alpha1.MetricAlarmParameters) *awscloudwatch.PutMetricAlarmInput {
+	return &awscloudwatch.PutMetricAlarmInput{
+		AlarmName:                        awsclient.String(name),
+		AlarmDescription:                 p.AlarmDescription,
+		ActionsEnabled:                   actionsEnabled(p.ActionsEnabled),
+		AlarmActions:                     aws.StringSlice(p.AlarmActions),
+		OKActions:                        aws.StringSlice(p.OKActions),
+		InsufficientDataActions:          aws.StringSlice(p.InsufficientDataActions),
+		ComparisonOperator:               awsclient.String(p.ComparisonOperator),
+		EvaluationPeriods:                awsclient.Int64(int(p.EvaluationPeriods)),
+		DatapointsToAlarm:                p.DatapointsToAlarm,
+		Threshold:                        p.Threshold,
+		ThresholdMetricId:                p.ThresholdMetricID,
+		TreatMissingData:                 p.TreatMissingData,
+		EvaluateLowSampleCountPercentile: p.EvaluateLowSampleCountPercentile,
+		MetricName:                       p.MetricName,
+		Namespace:                        p.Namespace,
+		Dimensions:                       generateDimensions(p.Dimensions),
+		Statistic:                        p.Statistic,
+		ExtendedStatistic:                p.ExtendedStatistic,
+		Period:                           p.Period,
+		Unit:                             p.Unit,
+		Metrics:                          generateMetrics(p.Metrics),
+	}
+}
+
+func actionsEnabled(b *bool) *bool {
+	if b == nil {
+		return awsclient.Bool(true)
+	}
+	return b
+}
+
+func generateDimensions(in []v1alpha1.Dimension) []*awscloudwatch.Dimension {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]*awscloudwatch.Dimension, len(in))
+	for i, d := range in {
+		res[i] = &awscloudwatch.Dimension{Name: awsclient.String(d.Name), Value: awsclient.String(d.Value)}
+	}
+	return res
+}
+
+func generateMetrics(in []v1alpha1.MetricDataQuery) []*awscloudwatch.MetricDataQuery {
+	if len(in) == 0 {
+		return nil
+	}
+	res := make([]*awscloudwatch.MetricDataQuery, len(in))
+	for i, q := range in {
+		res[i] = &awscloudwatch.MetricDataQuery{
+			Id:         awsclient.String(q.ID),
+			AccountId:  q.AccountID,
+			Expression: q.Expression,
+			Label:      q.Label,
+			Period:     q.Period,
+			ReturnData: q.ReturnData,
+		}
+		if q.MetricStat != nil {
+			res[i].MetricStat = &awscloudwatch.MetricStat{
+				Metric: &awscloudwatch.Metric{
+					MetricName: q.MetricStat.Metric.MetricName,
+					Namespace:  q.MetricStat.Metric.Namespace,
+					Dimensions: generateDimensions(q.MetricStat.Metric.Dimensions),
+				},
+				Period: awsclient.Int64(int(q.MetricStat.Period)),
+				Stat:   awsclient.String(q.MetricStat.Stat),
+				Unit:   q.MetricStat.Unit,
+			}
+		}
+	}
+	return res
+}
+
+// IsMetricAlarmUpToDate returns true if the observed metric alarm matches
+// the given parameters.
+func IsMetricAlarmUpToDate(name string, p v1alpha1.MetricAlarmParameters, obs *awscloudwatch.MetricAlarm) bool {
+	observed := &awscloudwatch.PutMetricAlarmInput{
+		AlarmName:                        obs.AlarmName,
+		AlarmDescription:                 obs.AlarmDescription,
+		ActionsEnabled:                   actionsEnabled(obs.ActionsEnabled),
+		AlarmActions:                     obs.AlarmActions,
+		OKActions:                        obs.OKActions,
+		InsufficientDataActions:          obs.InsufficientDataActions,
+		ComparisonOperator:               obs.ComparisonOperator,
+		EvaluationPeriods:                obs.EvaluationPeriods,
+		DatapointsToAlarm:                obs.DatapointsToAlarm,
+		Threshold:                        obs.Threshold,
+		ThresholdMetricId:                obs.ThresholdMetricId,
+		TreatMissingData:                 obs.TreatMissingData,
+		EvaluateLowSampleCountPercentile: obs.EvaluateLowSampleCountPercentile,
+		MetricName:                       obs.MetricName,
+		Namespace:                        obs.Namespace,
+		Dimensions:                       obs.Dimensions,
+		Statistic:                        obs.Statistic,
+		ExtendedStatistic:                obs.ExtendedStatistic,
+		Period:                           obs.Period,
+		Unit:                             obs.Unit,
+		Metrics:                          obs.Metrics,
+	}
+	return cmp.Equal(GenerateMetricAlarmInput(name, p), observed, cmpopts.EquateEmpty(), sortStrings, sortDimensions,
+		cmpopts.IgnoreUnexported(awscloudwatch.PutMetricAlarmInput{}, awscloudwatch.Dimension{}, awscloudwatch.MetricDataQuery{},
+			awscloudwatch.MetricStat{}, awscloudwatch.Metric{}))
+}
+
+// GenerateCompositeAlarmInput returns the input that creates or updates the
+// composite alarm with the given name and parameters. Tags are left out
+// since PutCompositeAlarm only applies them when the alarm is created.
+func GenerateCompositeAlarmInput(name string, p v1alpha1.CompositeAlarmParameters) *awscloudwatch.PutCompositeAlarmInput {
+	return &awscloudwatch.PutCompositeAlarmInput{
+		AlarmName:               awsclient.String(name),
+		AlarmDescription:        p.AlarmDescription,
+		ActionsEnabled:          actionsEnabled(p.ActionsEnabled),
+		AlarmActions:            aws.StringSlice(p.AlarmActions),
+		OKActions:               aws.StringSlice(p.OKActions),
+		InsufficientDataActions: aws.StringSlice(p.InsufficientDataActions),
+		AlarmRule:               awsclient.String(p.AlarmRule),
+	}
+}
+
+// IsCompositeAlarmUpToDate returns true if the observed composite alarm
+// matches the given parameters.
+func IsCompositeAlarmUpToDate(name string, p v1alpha1.CompositeAlarmParameters, obs *awscloudwatch.CompositeAlarm) bool {
+	observed := &awscloudwatch.PutCompositeAlarmInput{
+		AlarmName:               obs.AlarmName,
+		AlarmDescription:        obs.AlarmDescription,
+		ActionsEnabled:          actionsEnabled(obs.ActionsEnabled),
+		AlarmActions:            obs.AlarmActions,
+		OKActions:               obs.OKActions,
+		InsufficientDataActions: obs.InsufficientDataActions,
+		AlarmRule:               obs.AlarmRule,
+	}
+	return cmp.Equal(GenerateCompositeAlarmInput(name, p), observed, cmpopts.EquateEmpty(), sortStrings,
+		cmpopts.IgnoreUnexported(awscloudwatch.PutCompositeAlarmInput{}))
+}
+
+// CloudWatch does not guarantee the order of alarm actions and dimensions.
+var (
+	sortStrings = cmpopts.SortSlices(func(a, b *string) bool {
+		return awsclient.StringValue(a) < awsclient.StringValue(b)
+	})
+	sortDimensions = cmpopts.SortSlices(func(a, b *awscloudwatch.Dimension) bool {
+		return awsclient.StringValue(a.Name) < awsclient.StringValue(b.Name)
+	})
+)
+
+// DiffTags returns the tags that need to be added to and removed from an
+// alarm with the observed tags to match the desired ones. Tags whose value
+// changed are both removed and added, so removals must be applied first.
+func DiffTags(desired []v1alpha1.Tag, observed []*awscloudwatch.Tag) ([]*awscloudwatch.Tag, []*string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	add, remove := awsclient.DiffTags(local, remote)
+	var addTags []*awscloudwatch.Tag
+	for k, v := range add {
+		addTags = append(addTags, &awscloudwatch.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	}
+	sort.Slice(addTags, func(i, j int) bool {
+		return *addTags[i].Key < *addTags[j].Key
+	})
+	sort.Strings(remove)
+	return addTags, aws.StringSlice(remove)
+}
+
+// GenerateTags returns the given tags in the form CloudWatch accepts them.
+func GenerateTags(tags []v1alpha1.Tag) []*awscloudwatch.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awscloudwatch.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awscloudwatch.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return res
+}
+
+// UpdateTags makes the tags of the alarm with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, c Client, arn string, desired []v1alpha1.Tag) error {
+	tags, err := c.ListTagsForResourceWithContext(ctx, &awscloudwatch.ListTagsForResourceInput{ResourceARN: &arn})
+	if err != nil {
+		return awsclient.Wrap(err, errListTags)
+	}
+	add, remove := DiffTags(desired, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := c.UntagResourceWithContext(ctx, &awscloudwatch.UntagResourceInput{ResourceARN: &arn, TagKeys: remove}); err != nil {
+			return awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := c.TagResourceWithContext(ctx, &awscloudwatch.TagResourceInput{ResourceARN: &arn, Tags: add}); err != nil {
+			return awsclient.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudwatch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	alarmName = "alarm"
+	topicA    = "arn:aws:sns:us-east-1:123456789012:a"
+	topicB    = "arn:aws:sns:us-east-1:123456789012:b"
+	greater   = awscloudwatch.ComparisonOperatorGreaterThanThreshold
+)
+
+func metricAlarmParams() v1alpha1.MetricAlarmParameters {
+	return v1alpha1.MetricAlarmParameters{
+		AlarmActionParameters: v1alpha1.AlarmActionParameters{
+			AlarmActions: []string{topicA, topicB},
+		},
+		ComparisonOperator: greater,
+		EvaluationPeriods:  2,
+		Threshold:          aws.Float64(0.5),
+		Metrics: []v1alpha1.MetricDataQuery{
+			{
+				ID: "m1",
+				MetricStat: &v1alpha1.MetricStat{
+					Metric: v1alpha1.Metric{
+						MetricName: awsclient.String("Errors"),
+						Namespace:  awsclient.String("AWS/Lambda"),
+						Dimensions: []v1alpha1.Dimension{{Name: "FunctionName", Value: "fn"}},
+					},
+					Period: 60,
+					Stat:   "Sum",
+				},
+				ReturnData: aws.Bool(false),
+			},
+			{
+				ID:         "e1",
+				Expression: awsclient.String("m1 / 60"),
+				ReturnData: aws.Bool(true),
+			},
+		},
+	}
+}
+
+func metricAlarm() *awscloudwatch.MetricAlarm {
+	return &awscloudwatch.MetricAlarm{
+		AlarmName:          &alarmName,
+		ActionsEnabled:     aws.Bool(true),
+		AlarmActions:       []*string{&topicB, &topicA},
+		ComparisonOperator: &greater,
+		EvaluationPeriods:  awsclient.Int64(2),
+		Threshold:          aws.Float64(0.5),
+		Metrics: []*awscloudwatch.MetricDataQuery{
+			{
+				Id: awsclient.String("m1"),
+				MetricStat: &awscloudwatch.MetricStat{
+					Metric: &awscloudwatch.Metric{
+						MetricName: awsclient.String("Errors"),
+						Namespace:  awsclient.String("AWS/Lambda"),
+						Dimensions: []*awscloudwatch.Dimension{{Name: awsclient.String("FunctionName"), Value: awsclient.String("fn")}},
+					},
+					Period: awsclient.Int64(60),
+					Stat:   awsclient.String("Sum"),
+				},
+				ReturnData: aws.Bool(false),
+			},
+			{
+				Id:         awsclient.String("e1"),
+				Expression: awsclient.String("m1 / 60"),
+				ReturnData: aws.Bool(true),
+			},
+		},
+		StateValue: awsclient.String(awscloudwatch.StateValueOk),
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"ResourceNotFound": {
+			err:  errors.Wrap(awserr.New(awscloudwatch.ErrCodeResourceNotFound, "", nil), "wrapped"),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New(awscloudwatch.ErrCodeLimitExceededFault, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMetricAlarmUpToDate(t *testing.T) {
+	type args struct {
+		p   v1alpha1.MetricAlarmParameters
+		obs *awscloudwatch.MetricAlarm
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDateInDifferentOrder": {
+			args: args{
+				p:   metricAlarmParams(),
+				obs: metricAlarm(),
+			},
+			want: true,
+		},
+		"ThresholdChanged": {
+			args: args{
+				p: func() v1alpha1.MetricAlarmParameters {
+					p := metricAlarmParams()
+					p.Threshold = aws.Float64(0.7)
+					return p
+				}(),
+				obs: metricAlarm(),
+			},
+			want: false,
+		},
+		"ExpressionChanged": {
+			args: args{
+				p: func() v1alpha1.MetricAlarmParameters {
+					p := metricAlarmParams()
+					p.Metrics[1].Expression = awsclient.String("m1 / 30")
+					return p
+				}(),
+				obs: metricAlarm(),
+			},
+			want: false,
+		},
+		"ActionsDisabled": {
+			args: args{
+				p: func() v1alpha1.MetricAlarmParameters {
+					p := metricAlarmParams()
+					p.ActionsEnabled = aws.Bool(false)
+					return p
+				}(),
+				obs: metricAlarm(),
+			},
+			want: false,
+		},
+		"ActionRemoved": {
+			args: args{
+				p: func() v1alpha1.MetricAlarmParameters {
+					p := metricAlarmParams()
+					p.AlarmActions = []string{topicA}
+					return p
+				}(),
+				obs: metricAlarm(),
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMetricAlarmUpToDate(alarmName, tc.args.p, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsCompositeAlarmUpToDate(t *testing.T) {
+	rule := `ALARM("a") OR ALARM("b")`
+	obs := &awscloudwatch.CompositeAlarm{
+		AlarmName:      &alarmName,
+		ActionsEnabled: aws.Bool(true),
+		OKActions:      []*string{&topicA},
+		AlarmRule:      &rule,
+	}
+	type args struct {
+		p   v1alpha1.CompositeAlarmParameters
+		obs *awscloudwatch.CompositeAlarm
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.CompositeAlarmParameters{
+					AlarmActionParameters: v1alpha1.AlarmActionParameters{OKActions: []string{topicA}},
+					AlarmRule:             rule,
+				},
+				obs: obs,
+			},
+			want: true,
+		},
+		"RuleChanged": {
+			args: args{
+				p: v1alpha1.CompositeAlarmParameters{
+					AlarmActionParameters: v1alpha1.AlarmActionParameters{OKActions: []string{topicA}},
+					AlarmRule:             `ALARM("a")`,
+				},
+				obs: obs,
+			},
+			want: false,
+		},
+		"DescriptionAdded": {
+			args: args{
+				p: v1alpha1.CompositeAlarmParameters{
+					AlarmDescription:      awsclient.String("desc"),
+					AlarmActionParameters: v1alpha1.AlarmActionParameters{OKActions: []string{topicA}},
+					AlarmRule:             rule,
+				},
+				obs: obs,
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsCompositeAlarmUpToDate(alarmName, tc.args.p, tc.args.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*awscloudwatch.Tag
+		remove []*string
+	}
+	cases := map[string]struct {
+		desired  []v1alpha1.Tag
+		observed []*awscloudwatch.Tag
+		want     want
+	}{
+		"NoChange": {
+			desired:  []v1alpha1.Tag{{Key: "k", Value: "v"}},
+			observed: []*awscloudwatch.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+		},
+		"AddUpdateRemove": {
+			desired: []v1alpha1.Tag{{Key: "k", Value: "new"}, {Key: "a", Value: "b"}},
+			observed: []*awscloudwatch.Tag{
+				{Key: awsclient.String("k"), Value: awsclient.String("v")},
+				{Key: awsclient.String("old"), Value: awsclient.String("v")},
+			},
+			want: want{
+				add: []*awscloudwatch.Tag{
+					{Key: awsclient.String("a"), Value: awsclient.String("b")},
+					{Key: awsclient.String("k"), Value: awsclient.String("new")},
+				},
+				remove: []*string{awsclient.String("k"), awsclient.String("old")},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(awscloudwatch.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
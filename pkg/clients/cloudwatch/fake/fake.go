@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+)
+
+// MockClient is a type that implements all the methods for the CloudWatch
+// Client interface.
+type MockClient struct {
+	MockDescribeAlarms      func(ctx context.Context, input *cloudwatch.DescribeAlarmsInput, opts []request.Option) (*cloudwatch.DescribeAlarmsOutput, error)
+	MockPutMetricAlarm      func(ctx context.Context, input *cloudwatch.PutMetricAlarmInput, opts []request.Option) (*cloudwatch.PutMetricAlarmOutput, error)
+	MockPutCompositeAlarm   func(ctx context.Context, input *cloudwatch.PutCompositeAlarmInput, opts []request.Option) (*cloudwatch.PutCompositeAlarmOutput, error)
+	MockDeleteAlarms        func(ctx context.Context, input *cloudwatch.DeleteAlarmsInput, opts []request.Option) (*cloudwatch.DeleteAlarmsOutput, error)
+	MockListTagsForResource func(ctx context.Context, input *cloudwatch.ListTagsForResourceInput, opts []request.Option) (*cloudwatch.ListTagsForResourceOutput, error)
+	MockTagResource         func(ctx context.Context, input *cloudwatch.TagResourceInput, opts []request.Option) (*cloudwatch.TagResourceOutput, error)
+	MockUntagResource       func(ctx context.Context, input *cloudwatch.UntagResourceInput, opts []request.Option) (*cloudwatch.UntagResourceOutput, error)
+}
+
+// DescribeAlarmsWithContext mocks DescribeAlarmsWithContext method
+func (m *MockClient) DescribeAlarmsWithContext(ctx context.Context, input *cloudwatch.DescribeAlarmsInput, opts ...request.Option) (*cloudwatch.DescribeAlarmsOutput, error) {
+	return m.MockDescribeAlarms(ctx, input, opts)
+}
+
+// PutMetricAlarmWithContext mocks PutMetricAlarmWithContext method
+func (m *MockClient) PutMetricAlarmWithContext(ctx context.Context, input *cloudwatch.PutMetricAlarmInput, opts ...request.Option) (*cloudwatch.PutMetricAlarmOutput, error) {
+	return m.MockPutMetricAlarm(ctx, input, opts)
+}
+
+// PutCompositeAlarmWithContext mocks PutCompositeAlarmWithContext method
+func (m *MockClient) PutCompositeAlarmWithContext(ctx context.Context, input *cloudwatch.PutCompositeAlarmInput, opts ...request.Option) (*cloudwatch.PutCompositeAlarmOutput, error) {
+	return m.MockPutCompositeAlarm(ctx, input, opts)
+}
+
+// DeleteAlarmsWithContext mocks DeleteAlarmsWithContext method
+func (m *MockClient) DeleteAlarmsWithContext(ctx context.Context, input *cloudwatch.DeleteAlarmsInput, opts ...request.Option) (*cloudwatch.DeleteAlarmsOutput, error) {
+	return m.MockDeleteAlarms(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *cloudwatch.ListTagsForResourceInput, opts ...request.Option) (*cloudwatch.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResource(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *cloudwatch.TagResourceInput, opts ...request.Option) (*cloudwatch.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *cloudwatch.UntagResourceInput, opts ...request.Option) (*cloudwatch.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsconfigservice "github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the AWS Config operations used by the configuration
+// recorder and delivery channel controllers.
+type Client interface {
+	PutConfigurationRecorderWithContext(context.Context, *awsconfigservice.PutConfigurationRecorderInput, ...request.Option) (*awsconfigservice.PutConfigurationRecorderOutput, error)
+	DescribeConfigurationRecordersWithContext(context.Context, *awsconfigservice.DescribeConfigurationRecordersInput, ...request.Option) (*awsconfigservice.DescribeConfigurationRecordersOutput, error)
+	DescribeConfigurationRecorderStatusWithContext(context.Context, *awsconfigservice.DescribeConfigurationRecorderStatusInput, ...request.Option) (*awsconfigservice.DescribeConfigurationRecorderStatusOutput, error)
+	StartConfigurationRecorderWithContext(context.Context, *awsconfigservice.StartConfigurationRecorderInput, ...request.Option) (*awsconfigservice.StartConfigurationRecorderOutput, error)
+	StopConfigurationRecorderWithContext(context.Context, *awsconfigservice.StopConfigurationRecorderInput, ...request.Option) (*awsconfigservice.StopConfigurationRecorderOutput, error)
+	DeleteConfigurationRecorderWithContext(context.Context, *awsconfigservice.DeleteConfigurationRecorderInput, ...request.Option) (*awsconfigservice.DeleteConfigurationRecorderOutput, error)
+	PutDeliveryChannelWithContext(context.Context, *awsconfigservice.PutDeliveryChannelInput, ...request.Option) (*awsconfigservice.PutDeliveryChannelOutput, error)
+	DescribeDeliveryChannelsWithContext(context.Context, *awsconfigservice.DescribeDeliveryChannelsInput, ...request.Option) (*awsconfigservice.DescribeDeliveryChannelsOutput, error)
+	DeleteDeliveryChannelWithContext(context.Context, *awsconfigservice.DeleteDeliveryChannelInput, ...request.Option) (*awsconfigservice.DeleteDeliveryChannelOutput, error)
+}
+
+// NewClient returns a new AWS Config client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsconfigservice.New(sess)
+}
+
+// IsConfigurationRecorderNotFound returns true if the error indicates that
+// the configuration recorder does not exist.
+func IsConfigurationRecorderNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsconfigservice.ErrCodeNoSuchConfigurationRecorderException
+}
+
+// IsDeliveryChannelNotFound returns true if the error indicates that the
+// delivery channel does not exist.
+func IsDeliveryChannelNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsconfigservice.ErrCodeNoSuchDeliveryChannelException
+}
+
+// GenerateConfigurationRecorder returns the configuration recorder with the
+// given name and parameters. The recording group defaults of AWS Config are
+// filled in so that the result can be compared with the observed recorder.
+func GenerateConfigurationRecorder(name string, p v1alpha1.ConfigurationRecorderParameters) *awsconfigservice.ConfigurationRecorder {
+	rg := &awsconfigservice.RecordingGroup{
+		AllSupported:               aws.Bool(true),
+		IncludeGlobalResourceTypes: aws.Bool(false),
+	}
+	if p.RecordingGroup != nil {
+		types := append([]string{}, p.RecordingGroup.ResourceTypes...)
+		sort.Strings(types)
+		rg.ResourceTypes = aws.StringSlice(types)
+		rg.AllSupported = aws.Bool(len(types) == 0)
+		if p.RecordingGroup.AllSupported != nil {
+			rg.AllSupported = p.RecordingGroup.AllSupported
+		}
+		if p.RecordingGroup.IncludeGlobalResourceTypes != nil {
+			rg.IncludeGlobalResourceTypes = p.RecordingGroup.IncludeGlobalResourceTypes
+		}
+	}
+	return &awsconfigservice.ConfigurationRecorder{
+		Name:           awsclient.String(name),
+		RoleARN:        p.RoleARN,
+		RecordingGroup: rg,
+	}
+}
+
+// IsConfigurationRecorderUpToDate returns true if the observed configuration
+// recorder matches the given parameters.
+func IsConfigurationRecorderUpToDate(name string, p v1alpha1.ConfigurationRecorderParameters, observed *awsconfigservice.ConfigurationRecorder) bool {
+	desired := GenerateConfigurationRecorder(name, p)
+	current := *observed
+	if current.RecordingGroup != nil {
+		rg := *current.RecordingGroup
+		types := aws.StringValueSlice(rg.ResourceTypes)
+		sort.Strings(types)
+		rg.ResourceTypes = aws.StringSlice(types)
+		current.RecordingGroup = &rg
+	}
+	return cmp.Equal(desired, &current, cmpopts.EquateEmpty())
+}
+
+// IsRecordingUpToDate returns true if the recording state of the recorder
+// matches the desired one. Recording is enabled unless it is explicitly
+// disabled.
+func IsRecordingUpToDate(recording *bool, isRecording bool) bool {
+	return (recording == nil || *recording) == isRecording
+}
+
+// GenerateDeliveryChannel returns the delivery channel with the given name
+// and parameters.
+func GenerateDeliveryChannel(name string, p v1alpha1.DeliveryChannelParameters) *awsconfigservice.DeliveryChannel {
+	dc := &awsconfigservice.DeliveryChannel{
+		Name:         awsclient.String(name),
+		S3BucketName: p.S3BucketName,
+		S3KeyPrefix:  p.S3KeyPrefix,
+		S3KmsKeyArn:  p.S3KMSKeyARN,
+		SnsTopicARN:  p.SNSTopicARN,
+	}
+	if p.DeliveryFrequency != nil {
+		dc.ConfigSnapshotDeliveryProperties = &awsconfigservice.ConfigSnapshotDeliveryProperties{
+			DeliveryFrequency: p.DeliveryFrequency,
+		}
+	}
+	return dc
+}
+
+// IsDeliveryChannelUpToDate returns true if the observed delivery channel
+// matches the given parameters. The delivery frequency is only compared if
+// it is set, since AWS Config reports its default otherwise.
+func IsDeliveryChannelUpToDate(p v1alpha1.DeliveryChannelParameters, observed *awsconfigservice.DeliveryChannel) bool {
+	switch {
+	case awsclient.StringValue(p.S3BucketName) != awsclient.StringValue(observed.S3BucketName),
+		awsclient.StringValue(p.S3KeyPrefix) != awsclient.StringValue(observed.S3KeyPrefix),
+		awsclient.StringValue(p.S3KMSKeyARN) != awsclient.StringValue(observed.S3KmsKeyArn),
+		awsclient.StringValue(p.SNSTopicARN) != awsclient.StringValue(observed.SnsTopicARN):
+		return false
+	}
+	if p.DeliveryFrequency == nil {
+		return true
+	}
+	return observed.ConfigSnapshotDeliveryProperties != nil &&
+		*p.DeliveryFrequency == awsclient.StringValue(observed.ConfigSnapshotDeliveryProperties.DeliveryFrequency)
+}
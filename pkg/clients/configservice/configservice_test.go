@@ -0,0 +1,154 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsconfigservice "github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+)
+
+const (
+	recorderName = "default"
+	roleARN      = "arn:aws:iam::123456789012:role/config"
+)
+
+func TestIsConfigurationRecorderUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.ConfigurationRecorderParameters
+		observed *awsconfigservice.ConfigurationRecorder
+		want     bool
+	}{
+		"DefaultRecordingGroup": {
+			p: v1alpha1.ConfigurationRecorderParameters{RoleARN: aws.String(roleARN)},
+			observed: &awsconfigservice.ConfigurationRecorder{
+				Name:    aws.String(recorderName),
+				RoleARN: aws.String(roleARN),
+				RecordingGroup: &awsconfigservice.RecordingGroup{
+					AllSupported:               aws.Bool(true),
+					IncludeGlobalResourceTypes: aws.Bool(false),
+					ResourceTypes:              []*string{},
+				},
+			},
+			want: true,
+		},
+		"ResourceTypesInDifferentOrder": {
+			p: v1alpha1.ConfigurationRecorderParameters{
+				RoleARN: aws.String(roleARN),
+				RecordingGroup: &v1alpha1.RecordingGroup{
+					ResourceTypes: []string{"AWS::S3::Bucket", "AWS::EC2::Instance"},
+				},
+			},
+			observed: &awsconfigservice.ConfigurationRecorder{
+				Name:    aws.String(recorderName),
+				RoleARN: aws.String(roleARN),
+				RecordingGroup: &awsconfigservice.RecordingGroup{
+					AllSupported:               aws.Bool(false),
+					IncludeGlobalResourceTypes: aws.Bool(false),
+					ResourceTypes:              aws.StringSlice([]string{"AWS::EC2::Instance", "AWS::S3::Bucket"}),
+				},
+			},
+			want: true,
+		},
+		"GlobalResourcesEnabled": {
+			p: v1alpha1.ConfigurationRecorderParameters{
+				RoleARN:        aws.String(roleARN),
+				RecordingGroup: &v1alpha1.RecordingGroup{IncludeGlobalResourceTypes: aws.Bool(true)},
+			},
+			observed: &awsconfigservice.ConfigurationRecorder{
+				Name:    aws.String(recorderName),
+				RoleARN: aws.String(roleARN),
+				RecordingGroup: &awsconfigservice.RecordingGroup{
+					AllSupported:               aws.Bool(true),
+					IncludeGlobalResourceTypes: aws.Bool(false),
+				},
+			},
+			want: false,
+		},
+		"RoleChanged": {
+			p: v1alpha1.ConfigurationRecorderParameters{RoleARN: aws.String(roleARN)},
+			observed: &awsconfigservice.ConfigurationRecorder{
+				Name:    aws.String(recorderName),
+				RoleARN: aws.String("arn:aws:iam::123456789012:role/other"),
+				RecordingGroup: &awsconfigservice.RecordingGroup{
+					AllSupported:               aws.Bool(true),
+					IncludeGlobalResourceTypes: aws.Bool(false),
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsConfigurationRecorderUpToDate(recorderName, tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsConfigurationRecorderUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDeliveryChannelUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.DeliveryChannelParameters
+		observed *awsconfigservice.DeliveryChannel
+		want     bool
+	}{
+		"DefaultFrequencyIgnored": {
+			p: v1alpha1.DeliveryChannelParameters{S3BucketName: aws.String("config")},
+			observed: &awsconfigservice.DeliveryChannel{
+				S3BucketName: aws.String("config"),
+				ConfigSnapshotDeliveryProperties: &awsconfigservice.ConfigSnapshotDeliveryProperties{
+					DeliveryFrequency: aws.String("TwentyFour_Hours"),
+				},
+			},
+			want: true,
+		},
+		"FrequencyChanged": {
+			p: v1alpha1.DeliveryChannelParameters{S3BucketName: aws.String("config"), DeliveryFrequency: aws.String("One_Hour")},
+			observed: &awsconfigservice.DeliveryChannel{
+				S3BucketName: aws.String("config"),
+				ConfigSnapshotDeliveryProperties: &awsconfigservice.ConfigSnapshotDeliveryProperties{
+					DeliveryFrequency: aws.String("TwentyFour_Hours"),
+				},
+			},
+			want: false,
+		},
+		"TopicRemoved": {
+			p: v1alpha1.DeliveryChannelParameters{S3BucketName: aws.String("config")},
+			observed: &awsconfigservice.DeliveryChannel{
+				S3BucketName: aws.String("config"),
+				SnsTopicARN:  aws.String("arn:aws:sns:us-east-1:123456789012:config"),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsDeliveryChannelUpToDate(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsDeliveryChannelUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
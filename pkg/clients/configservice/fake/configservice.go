@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/configservice"
+)
+
+// MockClient is a type that implements all the methods for the AWS Config
+// Client interface.
+type MockClient struct {
+	MockPutConfigurationRecorder            func(ctx context.Context, input *configservice.PutConfigurationRecorderInput, opts []request.Option) (*configservice.PutConfigurationRecorderOutput, error)
+	MockDescribeConfigurationRecorders      func(ctx context.Context, input *configservice.DescribeConfigurationRecordersInput, opts []request.Option) (*configservice.DescribeConfigurationRecordersOutput, error)
+	MockDescribeConfigurationRecorderStatus func(ctx context.Context, input *configservice.DescribeConfigurationRecorderStatusInput, opts []request.Option) (*configservice.DescribeConfigurationRecorderStatusOutput, error)
+	MockStartConfigurationRecorder          func(ctx context.Context, input *configservice.StartConfigurationRecorderInput, opts []request.Option) (*configservice.StartConfigurationRecorderOutput, error)
+	MockStopConfigurationRecorder           func(ctx context.Context, input *configservice.StopConfigurationRecorderInput, opts []request.Option) (*configservice.StopConfigurationRecorderOutput, error)
+	MockDeleteConfigurationRecorder         func(ctx context.Context, input *configservice.DeleteConfigurationRecorderInput, opts []request.Option) (*configservice.DeleteConfigurationRecorderOutput, error)
+	MockPutDeliveryChannel                  func(ctx context.Context, input *configservice.PutDeliveryChannelInput, opts []request.Option) (*configservice.PutDeliveryChannelOutput, error)
+	MockDescribeDeliveryChannels            func(ctx context.Context, input *configservice.DescribeDeliveryChannelsInput, opts []request.Option) (*configservice.DescribeDeliveryChannelsOutput, error)
+	MockDeleteDeliveryChannel               func(ctx context.Context, input *configservice.DeleteDeliveryChannelInput, opts []request.Option) (*configservice.DeleteDeliveryChannelOutput, error)
+}
+
+// PutConfigurationRecorderWithContext mocks PutConfigurationRecorderWithContext method
+func (m *MockClient) PutConfigurationRecorderWithContext(ctx context.Context, input *configservice.PutConfigurationRecorderInput, opts ...request.Option) (*configservice.PutConfigurationRecorderOutput, error) {
+	return m.MockPutConfigurationRecorder(ctx, input, opts)
+}
+
+// DescribeConfigurationRecordersWithContext mocks DescribeConfigurationRecordersWithContext method
+func (m *MockClient) DescribeConfigurationRecordersWithContext(ctx context.Context, input *configservice.DescribeConfigurationRecordersInput, opts ...request.Option) (*configservice.DescribeConfigurationRecordersOutput, error) {
+	return m.MockDescribeConfigurationRecorders(ctx, input, opts)
+}
+
+// DescribeConfigurationRecorderStatusWithContext mocks DescribeConfigurationRecorderStatusWithContext method
+func (m *MockClient) DescribeConfigurationRecorderStatusWithContext(ctx context.Context, input *configservice.DescribeConfigurationRecorderStatusInput, opts ...request.Option) (*configservice.DescribeConfigurationRecorderStatusOutput, error) {
+	return m.MockDescribeConfigurationRecorderStatus(ctx, input, opts)
+}
+
+// StartConfigurationRecorderWithContext mocks StartConfigurationRecorderWithContext method
+func (m *MockClient) StartConfigurationRecorderWithContext(ctx context.Context, input *configservice.StartConfigurationRecorderInput, opts ...request.Option) (*configservice.StartConfigurationRecorderOutput, error) {
+	return m.MockStartConfigurationRecorder(ctx, input, opts)
+}
+
+// StopConfigurationRecorderWithContext mocks StopConfigurationRecorderWithContext method
+func (m *MockClient) StopConfigurationRecorderWithContext(ctx context.Context, input *configservice.StopConfigurationRecorderInput, opts ...request.Option) (*configservice.StopConfigurationRecorderOutput, error) {
+	return m.MockStopConfigurationRecorder(ctx, input, opts)
+}
+
+// DeleteConfigurationRecorderWithContext mocks DeleteConfigurationRecorderWithContext method
+func (m *MockClient) DeleteConfigurationRecorderWithContext(ctx context.Context, input *configservice.DeleteConfigurationRecorderInput, opts ...request.Option) (*configservice.DeleteConfigurationRecorderOutput, error) {
+	return m.MockDeleteConfigurationRecorder(ctx, input, opts)
+}
+
+// PutDeliveryChannelWithContext mocks PutDeliveryChannelWithContext method
+func (m *MockClient) PutDeliveryChannelWithContext(ctx context.Context, input *configservice.PutDeliveryChannelInput, opts ...request.Option) (*configservice.PutDeliveryChannelOutput, error) {
+	return m.MockPutDeliveryChannel(ctx, input, opts)
+}
+
+// DescribeDeliveryChannelsWithContext mocks DescribeDeliveryChannelsWithContext method
+func (m *MockClient) DescribeDeliveryChannelsWithContext(ctx context.Context, input *configservice.DescribeDeliveryChannelsInput, opts ...request.Option) (*configservice.DescribeDeliveryChannelsOutput, error) {
+	return m.MockDescribeDeliveryChannels(ctx, input, opts)
+}
+
+// DeleteDeliveryChannelWithContext mocks DeleteDeliveryChannelWithContext method
+func (m *MockClient) DeleteDeliveryChannelWithContext(ctx context.Context, input *configservice.DeleteDeliveryChannelInput, opts ...request.Option) (*configservice.DeleteDeliveryChannelOutput, error) {
+	return m.MockDeleteDeliveryChannel(ctx, input, opts)
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// stsDefaultRegion is the region of the STS endpoint used for managed
+// resources of global services, and of the legacy global endpoint.
+const stsDefaultRegion = "us-east-1"
+
+// STSRegion returns the region of the STS endpoint that roles are assumed
+// with for managed resources in the supplied region, as configured by the
+// supplied ProviderConfig, which may be nil.
+func STSRegion(pc *v1beta1.ProviderConfig, region string) string {
+	if pc != nil && pc.Spec.STS != nil {
+		if StringValue(pc.Spec.STS.Endpoints) == v1beta1.STSEndpointsLegacy {
+			return GlobalRegion
+		}
+		if r := StringValue(pc.Spec.STS.Region); r != "" {
+			return r
+		}
+	}
+	if region == "" || region == GlobalRegion {
+		return stsDefaultRegion
+	}
+	return region
+}
+
+// newSTSClient returns an STS client that calls the STS endpoint configured
+// by the supplied ProviderConfig for managed resources in the supplied region.
+func newSTSClient(cfg aws.Config, pc *v1beta1.ProviderConfig, region string) *sts.Client {
+	return sts.NewFromConfig(cfg, func(o *sts.Options) {
+		o.Region = STSRegion(pc, region)
+	})
+}
+
+// credentialsIdleTimeout is how long cached credentials that were not used
+// are kept.
+const credentialsIdleTimeout = time.Hour
+
+type cachedCredentials struct {
+	provider *aws.CredentialsCache
+	used     time.Time
+}
+
+// A credentialsCache shares temporary credentials across controllers and
+// reconciles, so that roles are assumed and web identity tokens exchanged
+// once per ProviderConfig rather than every time a managed resource is
+// reconciled. The cached providers refresh credentials before they expire.
+type credentialsCache struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedCredentials
+	pruned  time.Time
+}
+
+func newCredentialsCache(now func() time.Time) *credentialsCache {
+	return &credentialsCache{now: now, entries: map[string]*cachedCredentials{}}
+}
+
+// sharedCredentials is the credentialsCache of the provider process.
+var sharedCredentials = newCredentialsCache(time.Now)
+
+// get returns the cached credentials provider of the supplied key, creating
+// it with the supplied function if none is cached.
+func (c *credentialsCache) get(key string, newProvider func() aws.CredentialsProvider) *aws.CredentialsCache {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.pruned) > credentialsIdleTimeout {
+		for k, e := range c.entries {
+			if now.Sub(e.used) > credentialsIdleTimeout {
+				delete(c.entries, k)
+			}
+		}
+		c.pruned = now
+	}
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &cachedCredentials{provider: aws.NewCredentialsCache(newProvider())}
+		c.entries[key] = e
+	}
+	e.used = now
+	return e.provider
+}
+
+// credentialsKey returns the key of the temporary credentials obtained with
+// the supplied source credentials for the supplied ProviderConfig. A new key
+// is used once the ProviderConfig or its source credentials change. Sessions
+// tagged with the identity of a managed resource are not shared with other
+// managed resources.
+func credentialsKey(ctx context.Context, pc *v1beta1.ProviderConfig, source []byte, stsRegion string) string {
+	sum := sha256.Sum256(source)
+	parts := []string{string(pc.GetUID()), pc.GetName(), strconv.FormatInt(pc.GetGeneration(), 10), hex.EncodeToString(sum[:]), stsRegion}
+	if id, ok := ctx.Value(resourceIdentityKey{}).(resourceIdentity); ok && tagResourceIdentity(pc) {
+		parts = append(parts, id.kind, id.namespace, id.name)
+	}
+	return strings.Join(parts, "/")
+}
+
+// sessionKey returns the key of the AWS SDK v1 session in the supplied region
+// with the credentials of the supplied ProviderConfig, which were obtained
+// from the supplied source. Sessions whose requests count against the budget
+// of different accounts are not shared, so a new key is used once the
+// identity of the ProviderConfig was resolved.
+func sessionKey(ctx context.Context, pc *v1beta1.ProviderConfig, source []byte, region string) string {
+	return strings.Join([]string{credentialsKey(ctx, pc, source, region), throttleKey(pc)}, "/")
+}
+
+type cachedSession struct {
+	session *session.Session
+	used    time.Time
+}
+
+// A sessionCache shares AWS SDK v1 sessions across controllers and
+// reconciles, so that the shared config of the SDK is loaded and the
+// credentials of a ProviderConfig resolved once per ProviderConfig and region
+// rather than every time a managed resource is reconciled.
+type sessionCache struct {
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*cachedSession
+	pruned  time.Time
+}
+
+func newSessionCache(now func() time.Time) *sessionCache {
+	return &sessionCache{now: now, entries: map[string]*cachedSession{}}
+}
+
+// sharedSessions is the sessionCache of the provider process.
+var sharedSessions = newSessionCache(time.Now)
+
+// get returns the cached session of the supplied key, creating it with the
+// supplied function if none is cached. Sessions that could not be created are
+// not cached.
+func (c *sessionCache) get(key string, newSession func() (*session.Session, error)) (*session.Session, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if now.Sub(c.pruned) > credentialsIdleTimeout {
+		for k, e := range c.entries {
+			if now.Sub(e.used) > credentialsIdleTimeout {
+				delete(c.entries, k)
+			}
+		}
+		c.pruned = now
+	}
+
+	e, ok := c.entries[key]
+	if !ok {
+		s, err := newSession()
+		if err != nil {
+			return nil, err
+		}
+		e = &cachedSession{session: s}
+		c.entries[key] = e
+	}
+	e.used = now
+	return e.session, nil
+}
+
+// credentialsV1 returns AWS SDK v1 credentials that are retrieved from the
+// supplied AWS SDK v2 provider, typically a shared CredentialsCache. Unlike a
+// snapshot of its credentials, they're refreshed once they expire, which
+// cached sessions outlive.
+func credentialsV1(p aws.CredentialsProvider) *credentialsv1.Credentials {
+	return credentialsv1.NewCredentials(&v1CredentialsProvider{provider: p, now: time.Now})
+}
+
+// A v1CredentialsProvider adapts an AWS SDK v2 credentials provider to AWS
+// SDK v1. Its fields are guarded by the credentials it is used by.
+type v1CredentialsProvider struct {
+	provider aws.CredentialsProvider
+	now      func() time.Time
+
+	canExpire bool
+	expires   time.Time
+}
+
+func (p *v1CredentialsProvider) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+func (p *v1CredentialsProvider) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	c, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, err
+	}
+	p.canExpire, p.expires = c.CanExpire, c.Expires
+	return credentialsv1.Value{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		ProviderName:    c.Source,
+	}, nil
+}
+
+func (p *v1CredentialsProvider) IsExpired() bool {
+	return p.canExpire && !p.now().Before(p.expires)
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestSTSRegion(t *testing.T) {
+	cases := map[string]struct {
+		pc     *v1beta1.ProviderConfig
+		region string
+		want   string
+	}{
+		"NoProviderConfig": {
+			region: "eu-west-1",
+			want:   "eu-west-1",
+		},
+		"Regional": {
+			pc:     &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{STS: &v1beta1.STSConfig{Endpoints: String(v1beta1.STSEndpointsRegional)}}},
+			region: "eu-west-1",
+			want:   "eu-west-1",
+		},
+		"GlobalResource": {
+			pc:     &v1beta1.ProviderConfig{},
+			region: GlobalRegion,
+			want:   stsDefaultRegion,
+		},
+		"NoRegion": {
+			pc:   &v1beta1.ProviderConfig{},
+			want: stsDefaultRegion,
+		},
+		"ConfiguredRegion": {
+			pc:     &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{STS: &v1beta1.STSConfig{Region: String("eu-central-1")}}},
+			region: "eu-west-1",
+			want:   "eu-central-1",
+		},
+		"Legacy": {
+			pc:     &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{STS: &v1beta1.STSConfig{Endpoints: String(v1beta1.STSEndpointsLegacy), Region: String("eu-central-1")}}},
+			region: "eu-west-1",
+			want:   GlobalRegion,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := STSRegion(tc.pc, tc.region)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("STSRegion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCredentialsKey(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "sandbox", UID: "uid", Generation: 1}}
+	tagged := pc.DeepCopy()
+	tagged.Spec.AssumeRole = &v1beta1.AssumeRoleOptions{TagResourceIdentity: Bool(true)}
+	changed := pc.DeepCopy()
+	changed.SetGeneration(2)
+
+	bucket := context.WithValue(context.Background(), resourceIdentityKey{}, resourceIdentity{kind: "Bucket.s3.aws.crossplane.io", name: "a"})
+	other := context.WithValue(context.Background(), resourceIdentityKey{}, resourceIdentity{kind: "Bucket.s3.aws.crossplane.io", name: "b"})
+
+	base := credentialsKey(bucket, pc, []byte("secret"), "eu-west-1")
+
+	cases := map[string]struct {
+		key  string
+		same bool
+	}{
+		"SameProviderConfig": {
+			key:  credentialsKey(other, pc, []byte("secret"), "eu-west-1"),
+			same: true,
+		},
+		"ProviderConfigChanged": {
+			key: credentialsKey(bucket, changed, []byte("secret"), "eu-west-1"),
+		},
+		"SourceChanged": {
+			key: credentialsKey(bucket, pc, []byte("rotated"), "eu-west-1"),
+		},
+		"STSRegionChanged": {
+			key: credentialsKey(bucket, pc, []byte("secret"), "us-east-1"),
+		},
+		"ResourceIdentityTagged": {
+			key: credentialsKey(other, tagged, []byte("secret"), "eu-west-1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.same, tc.key == base); diff != "" {
+				t.Errorf("credentialsKey(...) == base: -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	if credentialsKey(bucket, tagged, nil, "eu-west-1") == credentialsKey(other, tagged, nil, "eu-west-1") {
+		t.Errorf("credentialsKey(...): tagged sessions of different managed resources must not share a key")
+	}
+}
+
+func TestCredentialsCache(t *testing.T) {
+	now := time.Now()
+	c := newCredentialsCache(func() time.Time { return now })
+
+	created := 0
+	newProvider := func() aws.CredentialsProvider {
+		created++
+		return aws.AnonymousCredentials{}
+	}
+
+	first := c.get("a", newProvider)
+	if got := c.get("a", newProvider); got != first {
+		t.Errorf("get(...): want cached provider to be reused")
+	}
+	c.get("b", newProvider)
+	if diff := cmp.Diff(2, created); diff != "" {
+		t.Errorf("get(...): providers created: -want, +got:\n%s", diff)
+	}
+
+	// Keep "a" in use, and let "b" go idle.
+	now = now.Add(credentialsIdleTimeout / 2)
+	c.get("a", newProvider)
+	now = now.Add(credentialsIdleTimeout/2 + time.Minute)
+	c.get("a", newProvider)
+
+	if _, ok := c.entries["b"]; ok {
+		t.Errorf("get(...): want idle credentials to be pruned")
+	}
+	if got := c.get("a", newProvider); got != first {
+		t.Errorf("get(...): want credentials in use to be kept")
+	}
+}
+
+func TestSessionKey(t *testing.T) {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: "pc", UID: "uid"}}
+	unresolved := sessionKey(context.Background(), pc, []byte("creds"), "us-east-1")
+
+	if got := sessionKey(context.Background(), pc, []byte("creds"), "eu-west-1"); got == unresolved {
+		t.Errorf("sessionKey(...): want sessions in different regions not to be shared")
+	}
+
+	pc.Status.Identity = &v1beta1.CallerIdentity{AccountID: "123456789012"}
+	if got := sessionKey(context.Background(), pc, []byte("creds"), "us-east-1"); got == unresolved {
+		t.Errorf("sessionKey(...): want a new session once the account of the ProviderConfig was resolved")
+	}
+}
+
+func TestSessionCache(t *testing.T) {
+	now := time.Now()
+	c := newSessionCache(func() time.Time { return now })
+
+	created := 0
+	newSession := func() (*session.Session, error) {
+		created++
+		return session.NewSession()
+	}
+
+	first, err := c.get("a", newSession)
+	if err != nil {
+		t.Fatalf("get(...): %s", err)
+	}
+	if got, _ := c.get("a", newSession); got != first {
+		t.Errorf("get(...): want cached session to be reused")
+	}
+	boom := errors.New(errBoom)
+	if _, err := c.get("b", func() (*session.Session, error) { return nil, boom }); !errors.Is(err, boom) {
+		t.Errorf("get(...): want error %q, got %v", boom, err)
+	}
+	if _, ok := c.entries["b"]; ok {
+		t.Errorf("get(...): want sessions that could not be created not to be cached")
+	}
+	c.get("c", newSession)
+	if diff := cmp.Diff(2, created); diff != "" {
+		t.Errorf("get(...): sessions created: -want, +got:\n%s", diff)
+	}
+
+	// Keep "a" in use, and let "c" go idle.
+	now = now.Add(credentialsIdleTimeout / 2)
+	c.get("a", newSession)
+	now = now.Add(credentialsIdleTimeout/2 + time.Minute)
+	c.get("a", newSession)
+
+	if _, ok := c.entries["c"]; ok {
+		t.Errorf("get(...): want idle sessions to be pruned")
+	}
+	if got, _ := c.get("a", newSession); got != first {
+		t.Errorf("get(...): want sessions in use to be kept")
+	}
+}
+
+// A rotatingProvider returns new credentials every time they're retrieved.
+type rotatingProvider struct {
+	retrieved int
+	expires   time.Time
+}
+
+func (p *rotatingProvider) Retrieve(_ context.Context) (aws.Credentials, error) {
+	p.retrieved++
+	return aws.Credentials{AccessKeyID: strconv.Itoa(p.retrieved), CanExpire: true, Expires: p.expires}, nil
+}
+
+func TestCredentialsV1(t *testing.T) {
+	now := time.Now()
+	p := &rotatingProvider{expires: now.Add(time.Hour)}
+	creds := credentialsv1.NewCredentials(&v1CredentialsProvider{provider: p, now: func() time.Time { return now }})
+
+	got, err := creds.Get()
+	if err != nil {
+		t.Fatalf("Get(): %s", err)
+	}
+	if diff := cmp.Diff("1", got.AccessKeyID); diff != "" {
+		t.Errorf("Get(): -want access key, +got access key:\n%s", diff)
+	}
+	if got, _ := creds.Get(); got.AccessKeyID != "1" {
+		t.Errorf("Get(): want credentials to be kept until they expire")
+	}
+
+	now = now.Add(2 * time.Hour)
+	got, err = creds.Get()
+	if err != nil {
+		t.Fatalf("Get(): %s", err)
+	}
+	if diff := cmp.Diff("2", got.AccessKeyID); diff != "" {
+		t.Errorf("Get(): want expired credentials to be refreshed: -want access key, +got access key:\n%s", diff)
+	}
+}
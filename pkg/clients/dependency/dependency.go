@@ -0,0 +1,422 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dependency orders the deletion of managed resources that reference
+// each other. A managed resource is not deleted while managed resources that
+// reference it still exist, e.g. a VPC is not deleted before its Subnets,
+// rather than failing to delete it until they are.
+//
+// Dependencies are recorded whenever references are resolved, as a label on
+// the referencing managed resource that names the UID of the referenced one. The
+// referenced managed resource is labelled with the kinds of the managed
+// resources that reference it, so that only those need to be listed before
+// it is deleted.
+package dependency
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	kmeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// LabelKeyPrefixDependency prefixes the keys of the labels of managed
+	// resources that record the UIDs of the managed resources they reference,
+	// e.g. dependency.aws.crossplane.io/<uid>: "true". Removing such a label
+	// releases the referenced managed resource.
+	LabelKeyPrefixDependency = "dependency.aws.crossplane.io/"
+
+	// LabelKeyPrefixDependents prefixes the keys of the labels of managed
+	// resources that record the kinds of the managed resources that reference
+	// them, e.g. dependents.aws.crossplane.io/subnet.ec2.aws.crossplane.io:
+	// v1beta1.
+	LabelKeyPrefixDependents = "dependents.aws.crossplane.io/"
+)
+
+const (
+	errGetKind          = "cannot determine the kind of the managed resource"
+	errLabelReferenced  = "cannot label referenced managed resource"
+	errUpdateManaged    = "cannot update managed resource"
+	errListDependents   = "cannot list the managed resources referencing the managed resource"
+	errFmtInUse         = "cannot delete external resource while it is referenced by managed resources: %s"
+	errFmtUnknownKind   = "unknown dependent kind %s"
+	errFmtUnexpectedObj = "unexpected object of type %T"
+)
+
+type contextKey struct{}
+
+// A recorder records the managed resources read with a context.
+type recorder struct {
+	mu   sync.Mutex
+	read []resource.Managed
+}
+
+func withRecorder(ctx context.Context) (context.Context, *recorder) {
+	r := &recorder{}
+	return context.WithValue(ctx, contextKey{}, r), r
+}
+
+func record(ctx context.Context, o runtime.Object) {
+	r, ok := ctx.Value(contextKey{}).(*recorder)
+	if !ok {
+		return
+	}
+	mg, ok := o.(resource.Managed)
+	if !ok {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.read = append(r.read, mg.DeepCopyObject().(resource.Managed))
+}
+
+// WrapNewClientFunc returns a cluster.NewClientFunc that wraps the clients
+// returned by the supplied one with a Client.
+func WrapNewClientFunc(fn cluster.NewClientFunc) cluster.NewClientFunc {
+	return func(c cache.Cache, config *rest.Config, o client.Options, uncached ...client.Object) (client.Client, error) {
+		kube, err := fn(c, config, o, uncached...)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(kube), nil
+	}
+}
+
+// A Client records the managed resources read while references are resolved,
+// so that the ReferenceResolver can tell which managed resources were
+// referenced regardless of how references are resolved.
+type Client struct {
+	client.Client
+}
+
+// NewClient returns a Client that wraps the supplied client.
+func NewClient(c client.Client) *Client {
+	return &Client{Client: c}
+}
+
+// Get the supplied object.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+	record(ctx, obj)
+	return nil
+}
+
+// List the supplied objects.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	return kmeta.EachListItem(list, func(o runtime.Object) error {
+		record(ctx, o)
+		return nil
+	})
+}
+
+// A ReferenceResolver records the managed resources referenced by a managed
+// resource once the references are resolved by the ReferenceResolver it
+// wraps. References are only resolved while they have no value, so the
+// ReferenceResolver also resolves the references of a copy of the managed
+// resource without their values to tell which managed resources are
+// referenced now.
+type ReferenceResolver struct {
+	kube     client.Client
+	resolver managed.ReferenceResolver
+}
+
+// NewReferenceResolver returns a ReferenceResolver that wraps the supplied
+// one. The supplied client must read through a Client.
+func NewReferenceResolver(kube client.Client, r managed.ReferenceResolver) *ReferenceResolver {
+	return &ReferenceResolver{kube: kube, resolver: r}
+}
+
+// ResolveReferences of the supplied managed resource, and record the managed
+// resources it references. Recorded managed resources that it no longer
+// references are released.
+func (r *ReferenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	rctx, rec := withRecorder(ctx)
+	if err := r.resolver.ResolveReferences(rctx, mg); err != nil {
+		return err
+	}
+	read, complete := rec.read, false
+	if current, ok := r.referenced(ctx, mg); ok {
+		read, complete = append(read, current...), true
+	}
+
+	names := referencedNames(mg)
+	referenced := map[string]bool{}
+	added := map[string]string{}
+	for _, ref := range read {
+		key := LabelKeyPrefixDependency + string(ref.GetUID())
+		if ref.GetUID() == mg.GetUID() || !names[ref.GetName()] {
+			continue
+		}
+		referenced[key] = true
+		if mg.GetLabels()[key] == "true" || added[key] != "" {
+			continue
+		}
+		// The referenced managed resource is labelled first, so that it's
+		// never referenced by a managed resource of a kind it doesn't know.
+		if err := r.labelReferenced(ctx, mg, ref); err != nil {
+			return errors.Wrap(err, errLabelReferenced)
+		}
+		added[key] = "true"
+	}
+	var removed []string
+	for k := range mg.GetLabels() {
+		if complete && strings.HasPrefix(k, LabelKeyPrefixDependency) && !referenced[k] {
+			removed = append(removed, k)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	meta.AddLabels(mg, added)
+	meta.RemoveLabels(mg, removed...)
+	return errors.Wrap(r.kube.Update(ctx, mg), errUpdateManaged)
+}
+
+// referenced returns the managed resources the supplied one references now.
+// It resolves the references of a copy of the managed resource whose
+// resolved values are cleared, so that every reference is read again. It
+// returns false if they can't all be read, e.g. because a referenced managed
+// resource is not ready or the value of a reference can't be found.
+func (r *ReferenceResolver) referenced(ctx context.Context, mg resource.Managed) ([]resource.Managed, bool) {
+	cp, ok := mg.DeepCopyObject().(interface {
+		resource.Managed
+		ResolveReferences(context.Context, client.Reader) error
+	})
+	if !ok || !clearResolvedValues(cp) {
+		return nil, false
+	}
+	cctx, rec := withRecorder(ctx)
+	if err := cp.ResolveReferences(cctx, r.kube); err != nil {
+		return nil, false
+	}
+	return rec.read, true
+}
+
+func (r *ReferenceResolver) labelReferenced(ctx context.Context, mg, ref resource.Managed) error {
+	gvk, err := apiutil.GVKForObject(mg, r.kube.Scheme())
+	if err != nil {
+		return errors.Wrap(err, errGetKind)
+	}
+	key := LabelKeyPrefixDependents + strings.ToLower(gvk.Kind) + "." + gvk.Group
+	if ref.GetLabels()[key] == gvk.Version {
+		return nil
+	}
+	if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	p := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, key, gvk.Version)
+	return r.kube.Patch(ctx, ref, client.RawPatch(types.MergePatchType, []byte(p)))
+}
+
+// referencedNames returns the names of all references of the supplied
+// managed resource.
+func referencedNames(mg resource.Managed) map[string]bool {
+	names := map[string]bool{}
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			if r, ok := v.Interface().(xpv1.Reference); ok {
+				names[r.Name] = true
+				return
+			}
+			for i := 0; i < v.NumField(); i++ {
+				if v.Type().Field(i).IsExported() {
+					walk(v.Field(i))
+				}
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		case reflect.Map:
+			for _, k := range v.MapKeys() {
+				walk(v.MapIndex(k))
+			}
+		}
+	}
+	if v := reflect.Indirect(reflect.ValueOf(mg)); v.Kind() == reflect.Struct {
+		if spec := v.FieldByName("Spec"); spec.IsValid() {
+			walk(spec)
+		}
+	}
+	return names
+}
+
+// clearResolvedValues clears the values of the resolved references of the
+// supplied managed resource. The value of a reference XRef is X, and that of
+// XRefs is Xs. It returns false if a value can't be found.
+func clearResolvedValues(mg resource.Managed) bool {
+	ok := true
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		switch v.Kind() { //nolint:exhaustive
+		case reflect.Ptr, reflect.Interface:
+			if !v.IsNil() {
+				walk(v.Elem())
+			}
+		case reflect.Struct:
+			for i := 0; i < v.NumField(); i++ {
+				f := v.Type().Field(i)
+				if !f.IsExported() {
+					continue
+				}
+				value := ""
+				switch {
+				case f.Type == reflect.TypeOf(&xpv1.Reference{}) && !v.Field(i).IsNil():
+					value = strings.TrimSuffix(f.Name, "Ref")
+				case f.Type == reflect.TypeOf([]xpv1.Reference{}) && v.Field(i).Len() > 0:
+					value = strings.TrimSuffix(f.Name, "Refs") + "s"
+				default:
+					walk(v.Field(i))
+					continue
+				}
+				if vf := v.FieldByName(value); vf.IsValid() && vf.CanSet() {
+					vf.Set(reflect.Zero(vf.Type()))
+					continue
+				}
+				ok = false
+			}
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < v.Len(); i++ {
+				walk(v.Index(i))
+			}
+		}
+	}
+	if v := reflect.Indirect(reflect.ValueOf(mg)); v.Kind() == reflect.Struct {
+		if spec := v.FieldByName("Spec"); spec.IsValid() {
+			walk(spec)
+		}
+	}
+	return ok
+}
+
+// Dependents returns the kinds and names of the managed resources that
+// reference the supplied one, e.g. subnet.ec2.aws.crossplane.io/my-subnet.
+func Dependents(ctx context.Context, kube client.Client, mg resource.Managed) ([]string, error) {
+	var deps []string
+	for k, version := range mg.GetLabels() {
+		if !strings.HasPrefix(k, LabelKeyPrefixDependents) {
+			continue
+		}
+		gk := schema.ParseGroupKind(strings.TrimPrefix(k, LabelKeyPrefixDependents))
+		l, err := newList(kube.Scheme(), gk.WithVersion(version))
+		if err != nil {
+			return nil, err
+		}
+		if err := kube.List(ctx, l, client.MatchingLabels{LabelKeyPrefixDependency + string(mg.GetUID()): "true"}); err != nil {
+			return nil, err
+		}
+		if err := kmeta.EachListItem(l, func(o runtime.Object) error {
+			if d, ok := o.(client.Object); ok {
+				deps = append(deps, strings.TrimPrefix(k, LabelKeyPrefixDependents)+"/"+d.GetName())
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+	sort.Strings(deps)
+	return deps, nil
+}
+
+// newList returns a new list of the supplied kind, which is lower case.
+func newList(s *runtime.Scheme, gvk schema.GroupVersionKind) (client.ObjectList, error) {
+	for known := range s.AllKnownTypes() {
+		if known.Group != gvk.Group || known.Version != gvk.Version || !strings.EqualFold(known.Kind, gvk.Kind+"List") {
+			continue
+		}
+		o, err := s.New(known)
+		if err != nil {
+			return nil, err
+		}
+		l, ok := o.(client.ObjectList)
+		if !ok {
+			return nil, errors.Errorf(errFmtUnexpectedObj, o)
+		}
+		return l, nil
+	}
+	return nil, errors.Errorf(errFmtUnknownKind, gvk)
+}
+
+// A Connecter returns ExternalClients that don't delete the external
+// resources of managed resources while managed resources that reference them
+// exist.
+type Connecter struct {
+	managed.ExternalConnecter
+	kube client.Client
+}
+
+// NewConnecter returns a Connecter that wraps the supplied ExternalConnecter.
+func NewConnecter(kube client.Client, c managed.ExternalConnecter) *Connecter {
+	return &Connecter{ExternalConnecter: c, kube: kube}
+}
+
+// Connect returns the ExternalClient of the wrapped ExternalConnecter.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return e, err
+	}
+	return &external{ExternalClient: e, kube: c.kube}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	kube client.Client
+}
+
+// Delete the external resource, unless managed resources reference it. Its
+// deletion is retried with backoff until they're gone.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	deps, err := Dependents(ctx, e.kube, mg)
+	if err != nil {
+		return errors.Wrap(err, errListDependents)
+	}
+	if len(deps) > 0 {
+		return errors.Errorf(errFmtInUse, strings.Join(deps, ", "))
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
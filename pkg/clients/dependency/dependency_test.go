@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dependency
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	vpcUID   = "6b0ac7d4-5a57-4a4c-8ae7-1a3c5a4b2bb4"
+	labelVPC = LabelKeyPrefixDependency + vpcUID
+	labelOld = LabelKeyPrefixDependency + "0e4a8b5c-3f0a-4c1e-9d8e-2b7f6a1c9d30"
+	labelKnd = LabelKeyPrefixDependents + "subnet.ec2.aws.crossplane.io"
+)
+
+func scheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := v1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestReferenceResolver(t *testing.T) {
+	errBoom := errors.New("boom")
+	s := scheme(t)
+
+	subnet := func(vpcID string, labels ...string) *v1beta1.Subnet {
+		sn := &v1beta1.Subnet{}
+		sn.Spec.ForProvider.VPCIDRef = &xpv1.Reference{Name: "vpc"}
+		if vpcID != "" {
+			sn.Spec.ForProvider.VPCID = &vpcID
+		}
+		for _, l := range labels {
+			meta.AddLabels(sn, map[string]string{l: "true"})
+		}
+		return sn
+	}
+
+	type want struct {
+		err    error
+		labels map[string]string
+		patch  string
+	}
+	cases := map[string]struct {
+		reason   string
+		resolver managed.ReferenceResolver
+		subnet   *v1beta1.Subnet
+		vpcReady bool
+		patchErr error
+		want     want
+	}{
+		"Referenced": {
+			reason:   "Managed resources should be labelled with the UIDs of the managed resources they reference, which should be labelled with their kind.",
+			subnet:   subnet(""),
+			vpcReady: true,
+			want: want{
+				labels: map[string]string{labelVPC: "true"},
+				patch:  `{"metadata":{"labels":{"` + labelKnd + `":"v1beta1"}}}`,
+			},
+		},
+		"AlreadyRecorded": {
+			reason:   "Dependencies that were already recorded should not be recorded again.",
+			subnet:   subnet("vpc-1", labelVPC),
+			vpcReady: true,
+			want: want{
+				labels: map[string]string{labelVPC: "true"},
+			},
+		},
+		"ResolvedBeforeRecording": {
+			reason:   "Dependencies of references that were resolved before dependencies were recorded should be recorded.",
+			subnet:   subnet("vpc-1"),
+			vpcReady: true,
+			want: want{
+				labels: map[string]string{labelVPC: "true"},
+				patch:  `{"metadata":{"labels":{"` + labelKnd + `":"v1beta1"}}}`,
+			},
+		},
+		"NoLongerReferenced": {
+			reason:   "Dependencies of managed resources that are no longer referenced should be released.",
+			subnet:   subnet("vpc-1", labelOld),
+			vpcReady: true,
+			want: want{
+				labels: map[string]string{labelVPC: "true"},
+				patch:  `{"metadata":{"labels":{"` + labelKnd + `":"v1beta1"}}}`,
+			},
+		},
+		"ReferenceNotReady": {
+			reason: "Dependencies should not be released if the references can't all be read again.",
+			subnet: subnet("vpc-1", labelOld),
+			want: want{
+				labels: map[string]string{labelOld: "true"},
+			},
+		},
+		"ReadButNotReferenced": {
+			reason: "Managed resources that were read but are not referenced should not be recorded.",
+			resolver: managed.ReferenceResolverFn(func(ctx context.Context, mg resource.Managed) error {
+				mg.(*v1beta1.Subnet).Spec.ForProvider.VPCIDRef = &xpv1.Reference{Name: "other"}
+				return nil
+			}),
+			subnet:   subnet(""),
+			vpcReady: true,
+		},
+		"ResolveError": {
+			reason: "Errors resolving references should be returned.",
+			resolver: managed.ReferenceResolverFn(func(context.Context, resource.Managed) error {
+				return errBoom
+			}),
+			subnet: subnet(""),
+			want: want{
+				err: errBoom,
+			},
+		},
+		"PatchError": {
+			reason:   "Managed resources should not be labelled if the managed resources they reference could not be.",
+			subnet:   subnet(""),
+			vpcReady: true,
+			patchErr: errBoom,
+			want: want{
+				err:   errors.Wrap(errBoom, errLabelReferenced),
+				patch: `{"metadata":{"labels":{"` + labelKnd + `":"v1beta1"}}}`,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			patch := ""
+			// The referenced VPC is read through a Client, like the
+			// generated resolvers do.
+			kube := NewClient(&test.MockClient{
+				MockScheme: test.NewMockSchemeFn(s),
+				MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+					if key.Name != "vpc" {
+						return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+					}
+					obj.SetName("vpc")
+					obj.SetUID(vpcUID)
+					if tc.vpcReady {
+						meta.SetExternalName(obj, "vpc-1")
+					}
+					return nil
+				},
+				MockPatch: func(_ context.Context, obj client.Object, p client.Patch, _ ...client.PatchOption) error {
+					b, _ := p.Data(obj)
+					patch = string(b)
+					return tc.patchErr
+				},
+				MockUpdate: test.NewMockUpdateFn(nil),
+			})
+			resolver := tc.resolver
+			if resolver == nil {
+				resolver = managed.NewAPISimpleReferenceResolver(kube)
+			}
+			err := NewReferenceResolver(kube, resolver).ResolveReferences(context.Background(), tc.subnet)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.labels, tc.subnet.GetLabels(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want labels, +got labels:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.patch, patch); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want patch, +got patch:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+	errDeleted := errors.New("deleted")
+	s := scheme(t)
+
+	vpc := func(labels map[string]string) *v1beta1.VPC {
+		return &v1beta1.VPC{ObjectMeta: metav1.ObjectMeta{Name: "vpc", UID: vpcUID, Labels: labels}}
+	}
+	list := func(err error) test.MockListFn {
+		return func(_ context.Context, obj client.ObjectList, opts ...client.ListOption) error {
+			lo := &client.ListOptions{}
+			lo.ApplyOptions(opts)
+			if lo.LabelSelector.String() != labelVPC+"=true" {
+				return errors.New("unexpected selector")
+			}
+			obj.(*v1beta1.SubnetList).Items = []v1beta1.Subnet{{ObjectMeta: metav1.ObjectMeta{Name: "b"}}, {ObjectMeta: metav1.ObjectMeta{Name: "a"}}}
+			return err
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   test.MockListFn
+		mg     resource.Managed
+		want   error
+	}{
+		"NoDependents": {
+			reason: "Managed resources that aren't referenced should be deleted.",
+			mg:     vpc(nil),
+			want:   errDeleted,
+		},
+		"Dependents": {
+			reason: "Managed resources should not be deleted while managed resources reference them.",
+			list:   list(nil),
+			mg:     vpc(map[string]string{labelKnd: "v1beta1"}),
+			want:   errors.Errorf(errFmtInUse, "subnet.ec2.aws.crossplane.io/a, subnet.ec2.aws.crossplane.io/b"),
+		},
+		"ListError": {
+			reason: "Errors listing dependents should be returned.",
+			list:   list(errBoom),
+			mg:     vpc(map[string]string{labelKnd: "v1beta1"}),
+			want:   errors.Wrap(errBoom, errListDependents),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{MockScheme: test.NewMockSchemeFn(s), MockList: tc.list}
+			c := NewConnecter(kube, managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+				return &managed.ExternalClientFns{DeleteFn: func(context.Context, resource.Managed) error { return errDeleted }}, nil
+			}))
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			err = e.Delete(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nDelete(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift counts how often managed resources are found out of sync with
+// their external resources, and which fields of their spec caused it.
+package drift
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// UnknownField is the field label of drift that was reported without a diff,
+// which is the case for most generated controllers.
+const UnknownField = "unknown"
+
+var (
+	outOfSync = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_aws_drift_total",
+		Help: "Total number of times a managed resource of a kind was found out of sync with its external resource.",
+	}, []string{"kind"})
+
+	fields = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_aws_drift_fields_total",
+		Help: "Total number of times a top-level field of a managed resource of a kind differed from its external resource.",
+	}, []string{"kind", "field"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(outOfSync, fields)
+}
+
+// Record the supplied observation of the supplied managed resource. Nothing
+// is recorded unless the external resource exists but is not up to date.
+func Record(mg resource.Managed, obs managed.ExternalObservation) {
+	if !obs.ResourceExists || obs.ResourceUpToDate {
+		return
+	}
+	kind := KindOf(mg)
+	outOfSync.WithLabelValues(kind).Inc()
+
+	f := Fields(obs.Diff)
+	if len(f) == 0 {
+		f = []string{UnknownField}
+	}
+	for _, name := range f {
+		fields.WithLabelValues(kind, name).Inc()
+	}
+}
+
+// KindOf returns the kind of the supplied managed resource in the form used by
+// the names of controllers, e.g. queue.sqs.aws.crossplane.io. Only the name of
+// its type is known if the resource has no GroupVersionKind set.
+func KindOf(mg resource.Managed) string {
+	if gvk := mg.GetObjectKind().GroupVersionKind(); gvk.Kind != "" {
+		return strings.ToLower(gvk.Kind) + "." + gvk.Group
+	}
+	t := reflect.TypeOf(mg)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return strings.ToLower(t.Name())
+}
+
+// Fields returns the sorted names of the top-level fields that differ in the
+// supplied output of cmp.Diff, which compared the parameters of a managed
+// resource with the ones observed in AWS. Each line of such a diff starts
+// with a two character prefix, of which the first is - or + for lines that
+// differ, followed by a tab per level of nesting.
+func Fields(diff string) []string {
+	seen := map[string]bool{}
+	current := ""
+	for _, line := range strings.Split(diff, "\n") {
+		r := []rune(line)
+		if len(r) < 3 {
+			continue
+		}
+		changed := r[0] == '-' || r[0] == '+'
+		body := string(r[2:])
+		depth := len(body) - len(strings.TrimLeft(body, "\t"))
+		switch {
+		case depth == 1:
+			current = fieldName(body[1:])
+			if changed && current != "" {
+				seen[current] = true
+			}
+		case depth > 1 && changed && current != "":
+			seen[current] = true
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fieldName returns the name of the field a line of a diff starts with, if
+// any. Lines that don't start with a field, e.g. elided identical fields,
+// return an empty string.
+func fieldName(s string) string {
+	i := strings.Index(s, ":")
+	if i < 1 {
+		return ""
+	}
+	for _, c := range s[:i] {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && c != '_' {
+			return ""
+		}
+	}
+	return s[:i]
+}
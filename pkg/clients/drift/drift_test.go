@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+type params struct {
+	Name        *string
+	Size        int
+	Tags        []tag
+	Description string
+}
+
+type tag struct {
+	Key, Value string
+}
+
+func TestFields(t *testing.T) {
+	a, b := "a", "b"
+
+	cases := map[string]struct {
+		diff string
+		want []string
+	}{
+		"NoDiff": {
+			diff: "",
+			want: []string{},
+		},
+		"TopLevelFields": {
+			diff: cmp.Diff(&params{Name: &a, Size: 1}, &params{Name: &b, Size: 2}),
+			want: []string{"Name", "Size"},
+		},
+		"NestedField": {
+			diff: cmp.Diff(params{Tags: []tag{{Key: "k", Value: "v"}}}, params{Tags: []tag{{Key: "k", Value: "w"}}}),
+			want: []string{"Tags"},
+		},
+		"PrefixedDiff": {
+			diff: "Found observed difference\n" + cmp.Diff(params{Description: "a"}, params{Description: "b"}),
+			want: []string{"Description"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Fields(tc.diff)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Fields(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKindOf(t *testing.T) {
+	cases := map[string]struct {
+		mg   *v1beta1.Queue
+		want string
+	}{
+		"GroupVersionKind": {
+			mg:   &v1beta1.Queue{TypeMeta: metav1.TypeMeta{APIVersion: "sqs.aws.crossplane.io/v1beta1", Kind: "Queue"}},
+			want: "queue.sqs.aws.crossplane.io",
+		},
+		"TypeName": {
+			mg:   &v1beta1.Queue{},
+			want: "queue",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, KindOf(tc.mg)); diff != "" {
+				t.Errorf("KindOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecord(t *testing.T) {
+	mg := &v1beta1.Queue{}
+	mg.SetGroupVersionKind(schema.GroupVersionKind{Group: "sqs.aws.crossplane.io", Version: "v1beta1", Kind: "Queue"})
+	kind := "queue.sqs.aws.crossplane.io"
+
+	Record(mg, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true})
+	Record(mg, managed.ExternalObservation{ResourceExists: false})
+	if got := testutil.ToFloat64(outOfSync.WithLabelValues(kind)); got != 0 {
+		t.Errorf("Record(...): want no drift, got %v", got)
+	}
+
+	Record(mg, managed.ExternalObservation{ResourceExists: true, Diff: cmp.Diff(params{Size: 1}, params{Size: 2})})
+	Record(mg, managed.ExternalObservation{ResourceExists: true})
+	if got := testutil.ToFloat64(outOfSync.WithLabelValues(kind)); got != 2 {
+		t.Errorf("Record(...): want 2 drifts, got %v", got)
+	}
+	if got := testutil.ToFloat64(fields.WithLabelValues(kind, "Size")); got != 1 {
+		t.Errorf("Record(...): want 1 drift of Size, got %v", got)
+	}
+	if got := testutil.ToFloat64(fields.WithLabelValues(kind, UnknownField)); got != 1 {
+		t.Errorf("Record(...): want 1 drift of unknown fields, got %v", got)
+	}
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun allows the changes the provider would make to the external
+// resources of managed resources to be reviewed before they are made.
+package dryrun
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/pkg/clients/operation"
+)
+
+// AnnotationKey of managed resources that are reconciled in dry-run mode. Its
+// value must be "true".
+const AnnotationKey = "aws.crossplane.io/dry-run"
+
+// TypeDryRun is the type of the condition that reports the change the
+// provider would make to the external resource of a managed resource that is
+// reconciled in dry-run mode.
+const TypeDryRun xpv1.ConditionType = "DryRun"
+
+// Reasons of the DryRun condition.
+const (
+	ReasonWouldCreate xpv1.ConditionReason = "WouldCreate"
+	ReasonWouldUpdate xpv1.ConditionReason = "WouldUpdate"
+	ReasonWouldDelete xpv1.ConditionReason = "WouldDelete"
+	ReasonNoChanges   xpv1.ConditionReason = "NoChanges"
+	ReasonDisabled    xpv1.ConditionReason = "DryRunDisabled"
+)
+
+// maxCallLength is the length after which the description of an intercepted
+// AWS API operation is truncated, so that the DryRun condition stays readable.
+const maxCallLength = 2048
+
+// errIntercepted is returned by AWS API operations that were intercepted
+// instead of being sent.
+var errIntercepted = errors.New("operation intercepted in dry-run mode")
+
+// sensitive matches the names of the fields of AWS API operation inputs whose
+// values must not be reported.
+var sensitive = regexp.MustCompile(`(?i)password|secret|token|privatekey|credentials`)
+
+// IsDryRun returns true if the supplied managed resource is reconciled in
+// dry-run mode. ExternalConnecters must not write managed resources that are
+// reconciled in dry-run mode to the API server, since only the AWS API
+// operations of their ExternalClients are intercepted.
+func IsDryRun(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKey] == "true"
+}
+
+// WouldCreate returns a condition that indicates the external resource would
+// be created, including the AWS API call that would create it if known.
+func WouldCreate(call string) xpv1.Condition {
+	msg := "The external resource does not exist and would be created"
+	if call != "" {
+		msg = fmt.Sprintf("%s by calling %s", msg, call)
+	}
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWouldCreate,
+		Message:            msg,
+	}
+}
+
+// WouldUpdate returns a condition that indicates the external resource would
+// be updated, including the supplied diff and the first AWS API call that
+// would update it if known.
+func WouldUpdate(diff, call string) xpv1.Condition {
+	msg := "The external resource is not up to date and would be updated"
+	if diff != "" {
+		msg = fmt.Sprintf("%s: %s", msg, diff)
+	}
+	if call != "" {
+		msg = fmt.Sprintf("%s, first by calling %s", msg, call)
+	}
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWouldUpdate,
+		Message:            msg,
+	}
+}
+
+// WouldDelete returns a condition that indicates the external resource would
+// be deleted.
+func WouldDelete() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWouldDelete,
+		Message:            "The managed resource was deleted and its external resource would be deleted once dry-run mode is disabled",
+	}
+}
+
+// NoChanges returns a condition that indicates the external resource is up to
+// date.
+func NoChanges() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoChanges,
+	}
+}
+
+// Disabled returns a condition that indicates the managed resource is no
+// longer reconciled in dry-run mode.
+func Disabled() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDryRun,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDisabled,
+	}
+}
+
+type contextKey struct{}
+
+// A recorder records the first AWS API operation that would have changed
+// something, and intercepts it and all that follow.
+type recorder struct {
+	mu    sync.Mutex
+	op    string
+	input interface{}
+}
+
+// intercept records the supplied AWS API operation if the supplied context
+// has a recorder and the operation would change something. It returns true
+// if the operation must not be sent.
+func intercept(ctx context.Context, op string, input interface{}) bool {
+	r, ok := ctx.Value(contextKey{}).(*recorder)
+	if !ok || operation.IsReadOnly(op) {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.op == "" {
+		r.op, r.input = op, input
+	}
+	return true
+}
+
+// call describes the recorded AWS API operation as its name followed by its
+// input, with the values of sensitive fields redacted.
+func (r *recorder) call() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.op == "" {
+		return ""
+	}
+	var in interface{}
+	if b, err := json.Marshal(r.input); err == nil && json.Unmarshal(b, &in) == nil {
+		redact(in)
+	}
+	b, _ := json.Marshal(in)
+	c := fmt.Sprintf("%s with %s", r.op, b)
+	if len(c) > maxCallLength {
+		c = c[:maxCallLength] + "..."
+	}
+	return c
+}
+
+func redact(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, f := range v {
+			if sensitive.MatchString(k) {
+				v[k] = "REDACTED"
+				continue
+			}
+			redact(f)
+		}
+	case []interface{}:
+		for _, f := range v {
+			redact(f)
+		}
+	}
+}
+
+// HandlerV1 intercepts AWS SDK v1 requests that would change something while
+// a change is simulated. It must be added to the Validate handlers of a
+// session, so that intercepted requests are never signed or sent.
+var HandlerV1 = request.NamedHandler{
+	Name: "crossplane.DryRunInterceptor",
+	Fn: func(r *request.Request) {
+		if intercept(r.Context(), r.Operation.Name, r.Params) {
+			r.Error = errIntercepted
+			r.Retryable = awsv1.Bool(false)
+		}
+	},
+}
+
+// AddMiddlewareV2 adds a middleware to the supplied AWS SDK v2 stack that
+// intercepts operations that would change something while a change is
+// simulated.
+func AddMiddlewareV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("crossplane.DryRunInterceptor",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			if intercept(ctx, awsmiddleware.GetOperationName(ctx), in.Parameters) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, errIntercepted
+			}
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+}
+
+// simulate calls the supplied function with a copy of the supplied managed
+// resource and a context that intercepts AWS API operations that would
+// change something. It returns the first intercepted operation, if any.
+func simulate(ctx context.Context, mg resource.Managed, fn func(context.Context, resource.Managed) error) string {
+	r := &recorder{}
+	_ = fn(context.WithValue(ctx, contextKey{}, r), mg.DeepCopyObject().(resource.Managed))
+	return r.call()
+}
+
+// A Connecter returns ExternalClients that never change the external
+// resources of managed resources that are reconciled in dry-run mode, but
+// report the changes they would make in the DryRun condition instead.
+// ExternalClients of other managed resources are passed through.
+type Connecter struct {
+	managed.ExternalConnecter
+}
+
+// NewConnecter returns a Connecter that wraps the supplied ExternalConnecter.
+func NewConnecter(c managed.ExternalConnecter) *Connecter {
+	return &Connecter{ExternalConnecter: c}
+}
+
+// Connect returns the ExternalClient of the wrapped ExternalConnecter, which
+// doesn't change the external resource of the supplied managed resource if
+// it is reconciled in dry-run mode.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return e, err
+	}
+	if !IsDryRun(mg) {
+		if mg.GetCondition(TypeDryRun).Status == corev1.ConditionTrue {
+			mg.SetConditions(Disabled())
+		}
+		return e, nil
+	}
+	return &external{ExternalClient: e}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// Observe reports the change that would be made to the external resource,
+// and the external resource as existing and up to date so that it is neither
+// created nor updated. The change is simulated on a copy of the managed
+// resource to find the AWS API call that would make it. Deleted managed resources are reported as is, so that
+// their deletion blocks on Delete until dry-run mode is disabled.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	switch {
+	case err != nil, meta.WasDeleted(mg):
+		return obs, err
+	case !obs.ResourceExists:
+		mg.SetConditions(WouldCreate(simulate(ctx, mg, e.create)))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	case !obs.ResourceUpToDate:
+		mg.SetConditions(WouldUpdate(obs.Diff, simulate(ctx, mg, e.update)))
+		obs.ResourceUpToDate = true
+		return obs, nil
+	default:
+		mg.SetConditions(NoChanges())
+		return obs, nil
+	}
+}
+
+func (e *external) create(ctx context.Context, mg resource.Managed) error {
+	_, err := e.ExternalClient.Create(ctx, mg)
+	return err
+}
+
+func (e *external) update(ctx context.Context, mg resource.Managed) error {
+	_, err := e.ExternalClient.Update(ctx, mg)
+	return err
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	mg.SetConditions(WouldCreate(simulate(ctx, mg, e.create)))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	mg.SetConditions(WouldUpdate("", simulate(ctx, mg, e.update)))
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, mg resource.Managed) error {
+	mg.SetConditions(WouldDelete())
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestDryRun(t *testing.T) {
+	errUnexpected := errors.New("unexpected call")
+
+	ec := &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+			switch mg.GetName() {
+			case "up-to-date":
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+			case "drifted":
+				return managed.ExternalObservation{ResourceExists: true, Diff: "spec.forProvider.size: 1 != 2"}, nil
+			}
+			return managed.ExternalObservation{}, nil
+		},
+		CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+			if !intercept(ctx, "CreateQueue", map[string]interface{}{"QueueName": mg.GetName(), "Attributes": map[string]string{"KmsSecret": "s3cr3t"}}) {
+				return managed.ExternalCreation{}, errUnexpected
+			}
+			meta.SetExternalName(mg, "created")
+			return managed.ExternalCreation{}, errIntercepted
+		},
+		UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+			if intercept(ctx, "GetQueueAttributes", nil) {
+				return managed.ExternalUpdate{}, errUnexpected
+			}
+			if !intercept(ctx, "SetQueueAttributes", map[string]interface{}{"QueueUrl": mg.GetName()}) {
+				return managed.ExternalUpdate{}, errUnexpected
+			}
+			return managed.ExternalUpdate{}, errIntercepted
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			return errUnexpected
+		},
+	}
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	}))
+
+	dryRun := func(name string) *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetName(name)
+		mg.SetAnnotations(map[string]string{AnnotationKey: "true"})
+		return mg
+	}
+	deleted := dryRun("up-to-date")
+	now := metav1.Now()
+	deleted.SetDeletionTimestamp(&now)
+	disabled := &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "drifted"}}
+	disabled.SetConditions(NoChanges())
+
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+		c   xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"WouldCreate": {
+			reason: "Nonexistent external resources should be reported as existing and up to date, so that they're not created.",
+			mg:     dryRun("missing"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				c:   WouldCreate(`CreateQueue with {"Attributes":{"KmsSecret":"REDACTED"},"QueueName":"missing"}`),
+			},
+		},
+		"WouldUpdate": {
+			reason: "Drifted external resources should be reported as up to date, so that they're not updated.",
+			mg:     dryRun("drifted"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "spec.forProvider.size: 1 != 2"},
+				c:   WouldUpdate("spec.forProvider.size: 1 != 2", `SetQueueAttributes with {"QueueUrl":"drifted"}`),
+			},
+		},
+		"NoChanges": {
+			reason: "Up to date external resources should be reported as is.",
+			mg:     dryRun("up-to-date"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				c:   NoChanges(),
+			},
+		},
+		"WouldDelete": {
+			reason: "External resources of deleted managed resources should be reported as is, and not be deleted.",
+			mg:     deleted,
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				c:   WouldDelete(),
+			},
+		},
+		"Disabled": {
+			reason: "Managed resources that aren't reconciled in dry-run mode should be passed through.",
+			mg:     disabled,
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, Diff: "spec.forProvider.size: 1 != 2"},
+				c:   Disabled(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if IsDryRun(tc.mg) && tc.mg.GetDeletionTimestamp() != nil {
+				if err := e.Delete(context.Background(), tc.mg); err != nil {
+					t.Errorf("\n%s\nDelete(...): %s", tc.reason, err)
+				}
+			}
+			if meta.GetExternalName(tc.mg) != "" {
+				t.Errorf("\n%s\nObserve(...): the change was not simulated on a copy", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.c, tc.mg.GetCondition(TypeDryRun), cmpopts.IgnoreFields(xpv1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("\n%s\nGetCondition(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
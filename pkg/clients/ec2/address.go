@@ -10,6 +10,8 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/smithy-go"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
@@ -19,6 +21,8 @@ const (
 	AddressAddressNotFound = "InvalidAddress.NotFound"
 	// AddressAllocationNotFound addreess not found by allocation
 	AddressAllocationNotFound = "InvalidAllocationID.NotFound"
+	// AddressInUse address is still associated, e.g. with a NAT gateway
+	AddressInUse = "InvalidIPAddress.InUse"
 )
 
 // AddressClient is the external client used for ElasticIP Custom Resource
@@ -35,6 +39,31 @@ func IsAddressNotFoundErr(err error) bool {
 	return errors.As(err, &awsErr) && (awsErr.ErrorCode() == AddressAddressNotFound || awsErr.ErrorCode() == AddressAllocationNotFound)
 }
 
+// IsAddressInUseErr returns true if the error is because the address is still
+// associated with another resource and cannot be released yet.
+func IsAddressInUseErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == AddressInUse
+}
+
+// GetAddressConnectionDetails extracts managed.ConnectionDetails out of
+// v1beta1.AddressObservation.
+func GetAddressConnectionDetails(in v1beta1.AddressObservation) managed.ConnectionDetails {
+	if in.PublicIP == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		v1beta1.ResourceCredentialsSecretPublicIPKey: []byte(in.PublicIP),
+	}
+	if in.AllocationID != "" {
+		conn[v1beta1.ResourceCredentialsSecretAllocationIDKey] = []byte(in.AllocationID)
+	}
+	if in.PrivateIPAddress != "" {
+		conn[v1beta1.ResourceCredentialsSecretPrivateIPKey] = []byte(in.PrivateIPAddress)
+	}
+	return conn
+}
+
 // GenerateAddressObservation is used to produce v1beta1.AddressObservation from
 // ec2.Subnet
 func GenerateAddressObservation(address ec2types.Address) v1beta1.AddressObservation {
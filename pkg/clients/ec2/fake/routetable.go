@@ -34,6 +34,7 @@ type MockRouteTableClient struct {
 	MockDescribe     func(ctx context.Context, input *ec2.DescribeRouteTablesInput, opts []func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
 	MockCreateRoute  func(ctx context.Context, input *ec2.CreateRouteInput, opts []func(*ec2.Options)) (*ec2.CreateRouteOutput, error)
 	MockDeleteRoute  func(ctx context.Context, input *ec2.DeleteRouteInput, opts []func(*ec2.Options)) (*ec2.DeleteRouteOutput, error)
+	MockReplaceRoute func(ctx context.Context, input *ec2.ReplaceRouteInput, opts []func(*ec2.Options)) (*ec2.ReplaceRouteOutput, error)
 	MockAssociate    func(ctx context.Context, input *ec2.AssociateRouteTableInput, opts []func(*ec2.Options)) (*ec2.AssociateRouteTableOutput, error)
 	MockDisassociate func(ctx context.Context, input *ec2.DisassociateRouteTableInput, opts []func(*ec2.Options)) (*ec2.DisassociateRouteTableOutput, error)
 	MockCreateTags   func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
@@ -75,6 +76,11 @@ func (m *MockRouteTableClient) DeleteRoute(ctx context.Context, input *ec2.Delet
 	return m.MockDeleteRoute(ctx, input, opts)
 }
 
+// ReplaceRoute mocks ReplaceRoute method
+func (m *MockRouteTableClient) ReplaceRoute(ctx context.Context, input *ec2.ReplaceRouteInput, opts ...func(*ec2.Options)) (*ec2.ReplaceRouteOutput, error) {
+	return m.MockReplaceRoute(ctx, input, opts)
+}
+
 // CreateTags mocks CreateTags method
 func (m *MockRouteTableClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
 	return m.MockCreateTags(ctx, input, opts)
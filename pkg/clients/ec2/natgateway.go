@@ -11,6 +11,8 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 )
 
@@ -68,3 +70,27 @@ func GenerateNATGatewayObservation(nat ec2types.NatGateway) v1beta1.NATGatewayOb
 	}
 	return observation
 }
+
+// GetNATGatewayConnectionDetails extracts managed.ConnectionDetails out of
+// v1beta1.NATGatewayObservation. Only the primary address of the NAT gateway
+// is published.
+func GetNATGatewayConnectionDetails(in v1beta1.NATGatewayObservation) managed.ConnectionDetails {
+	if len(in.NatGatewayAddresses) == 0 {
+		return nil
+	}
+	a := in.NatGatewayAddresses[0]
+	conn := managed.ConnectionDetails{}
+	if a.PublicIP != "" {
+		conn[v1beta1.ResourceCredentialsSecretPublicIPKey] = []byte(a.PublicIP)
+	}
+	if a.PrivateIP != "" {
+		conn[v1beta1.ResourceCredentialsSecretPrivateIPKey] = []byte(a.PrivateIP)
+	}
+	if a.AllocationID != "" {
+		conn[v1beta1.ResourceCredentialsSecretAllocationIDKey] = []byte(a.AllocationID)
+	}
+	if len(conn) == 0 {
+		return nil
+	}
+	return conn
+}
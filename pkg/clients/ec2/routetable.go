@@ -17,6 +17,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/normalize"
 )
 
 const (
@@ -33,6 +34,12 @@ const (
 	AssociationIDNotFound = "InvalidAssociationID.NotFound"
 )
 
+// EC2 reports route destinations as the network of the CIDR block.
+var routeTableNormalizers = normalize.Register(v1beta1.RouteTableGroupKind, normalize.Fields{
+	"Routes.DestinationCIDRBlock":     normalize.CIDR,
+	"Routes.DestinationIPV6CIDRBlock": normalize.CIDR,
+})
+
 // RouteTableClient is the external client used for RouteTable Custom Resource
 type RouteTableClient interface {
 	CreateRouteTable(ctx context.Context, input *ec2.CreateRouteTableInput, opts ...func(*ec2.Options)) (*ec2.CreateRouteTableOutput, error)
@@ -40,6 +47,7 @@ type RouteTableClient interface {
 	DescribeRouteTables(ctx context.Context, input *ec2.DescribeRouteTablesInput, opts ...func(*ec2.Options)) (*ec2.DescribeRouteTablesOutput, error)
 	CreateRoute(ctx context.Context, input *ec2.CreateRouteInput, opts ...func(*ec2.Options)) (*ec2.CreateRouteOutput, error)
 	DeleteRoute(ctx context.Context, input *ec2.DeleteRouteInput, opts ...func(*ec2.Options)) (*ec2.DeleteRouteOutput, error)
+	ReplaceRoute(ctx context.Context, input *ec2.ReplaceRouteInput, opts ...func(*ec2.Options)) (*ec2.ReplaceRouteOutput, error)
 	AssociateRouteTable(ctx context.Context, input *ec2.AssociateRouteTableInput, opts ...func(*ec2.Options)) (*ec2.AssociateRouteTableOutput, error)
 	DisassociateRouteTable(ctx context.Context, input *ec2.DisassociateRouteTableInput, opts ...func(*ec2.Options)) (*ec2.DisassociateRouteTableOutput, error)
 	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
@@ -157,18 +165,23 @@ func CreateRTPatch(in ec2types.RouteTable, target v1beta1.RouteTableParameters)
 
 	v1beta1.SortTags(target.Tags, in.Tags)
 
+	routeTableNormalizers.Apply(targetCopy)
+
 	// Add the default route for fair comparison.
 	for _, val := range in.Routes {
 		if val.GatewayId != nil && *val.GatewayId == DefaultLocalGatewayID {
 			targetCopy.Routes = append([]v1beta1.RouteBeta{{
 				GatewayID:            val.GatewayId,
 				DestinationCIDRBlock: val.DestinationCidrBlock,
-			}}, target.Routes...)
+			}}, targetCopy.Routes...)
 		}
 	}
-	SortRoutes(targetCopy.Routes, in.Routes)
 
 	LateInitializeRT(currentParams, &in)
+	currentParams = currentParams.DeepCopy()
+	routeTableNormalizers.Apply(currentParams)
+	sortRouteBetas(targetCopy.Routes)
+	sortRouteBetas(currentParams.Routes)
 
 	for i := range targetCopy.Routes {
 		targetCopy.Routes[i].ClearRefSelectors()
@@ -204,13 +217,86 @@ func IsRtUpToDate(p v1beta1.RouteTableParameters, rt ec2types.RouteTable) (bool,
 
 // SortRoutes sorts array of Routes on DestinationCIDR
 func SortRoutes(route []v1beta1.RouteBeta, ec2Route []ec2types.Route) {
-	sort.Slice(route, func(i, j int) bool {
-		return (route[i].DestinationCIDRBlock != nil && *route[i].DestinationCIDRBlock < *route[j].DestinationCIDRBlock) ||
-			(route[i].DestinationIPV6CIDRBlock != nil && *route[i].DestinationIPV6CIDRBlock < *route[j].DestinationIPV6CIDRBlock)
-	})
+	sortRouteBetas(route)
 
 	sort.Slice(ec2Route, func(i, j int) bool {
-		return (ec2Route[i].DestinationCidrBlock != nil && *ec2Route[i].DestinationCidrBlock < *ec2Route[j].DestinationCidrBlock) ||
-			(ec2Route[i].DestinationIpv6CidrBlock != nil && *ec2Route[i].DestinationIpv6CidrBlock < *ec2Route[j].DestinationIpv6CidrBlock)
+		return (ec2Route[i].DestinationCidrBlock != nil && *ec2Route[i].DestinationCidrBlock < aws.ToString(ec2Route[j].DestinationCidrBlock)) ||
+			(ec2Route[i].DestinationIpv6CidrBlock != nil && *ec2Route[i].DestinationIpv6CidrBlock < aws.ToString(ec2Route[j].DestinationIpv6CidrBlock))
+	})
+}
+
+func sortRouteBetas(route []v1beta1.RouteBeta) {
+	sort.Slice(route, func(i, j int) bool {
+		return routeDestination(route[i]) < routeDestination(route[j])
 	})
 }
+
+// RouteDiff contains the route operations that are needed to bring the
+// observed routes of a route table to their desired state.
+type RouteDiff struct {
+	// Create are desired routes whose destination does not exist yet.
+	Create []v1beta1.RouteBeta
+
+	// Replace are desired routes whose destination exists but points to a
+	// different target.
+	Replace []v1beta1.RouteBeta
+
+	// Delete are observed routes whose destination is no longer desired.
+	Delete []v1beta1.RouteState
+}
+
+// DiffRoutes matches the desired and observed routes by their destination
+// and returns the operations needed to reconcile them. Routes whose target
+// changed are replaced in-place instead of being deleted and recreated, so
+// traffic to their destination is never dropped. The default local route is
+// never deleted.
+func DiffRoutes(desired []v1beta1.RouteBeta, observed []v1beta1.RouteState) RouteDiff {
+	diff := RouteDiff{}
+	existing := make(map[string]v1beta1.RouteState, len(observed))
+	for _, ob := range observed {
+		existing[routeStateDestination(ob)] = ob
+	}
+	wanted := make(map[string]struct{}, len(desired))
+	for _, ds := range desired {
+		dst := routeDestination(ds)
+		wanted[dst] = struct{}{}
+		ob, ok := existing[dst]
+		switch {
+		case !ok:
+			diff.Create = append(diff.Create, ds)
+		case !isSameRouteTarget(ds, ob):
+			diff.Replace = append(diff.Replace, ds)
+		}
+	}
+	for _, ob := range observed {
+		if _, ok := wanted[routeStateDestination(ob)]; ok || ob.GatewayID == DefaultLocalGatewayID {
+			continue
+		}
+		diff.Delete = append(diff.Delete, ob)
+	}
+	return diff
+}
+
+func routeDestination(r v1beta1.RouteBeta) string {
+	if r.DestinationCIDRBlock != nil {
+		return routeTableNormalizers.Normalize("Routes.DestinationCIDRBlock", aws.ToString(r.DestinationCIDRBlock))
+	}
+	return routeTableNormalizers.Normalize("Routes.DestinationIPV6CIDRBlock", aws.ToString(r.DestinationIPV6CIDRBlock))
+}
+
+func routeStateDestination(r v1beta1.RouteState) string {
+	if r.DestinationCIDRBlock != "" {
+		return routeTableNormalizers.Normalize("Routes.DestinationCIDRBlock", r.DestinationCIDRBlock)
+	}
+	return routeTableNormalizers.Normalize("Routes.DestinationIPV6CIDRBlock", r.DestinationIPV6CIDRBlock)
+}
+
+func isSameRouteTarget(ds v1beta1.RouteBeta, ob v1beta1.RouteState) bool {
+	return aws.ToString(ds.GatewayID) == ob.GatewayID &&
+		aws.ToString(ds.InstanceID) == ob.InstanceID &&
+		aws.ToString(ds.LocalGatewayID) == ob.LocalGatewayID &&
+		aws.ToString(ds.NatGatewayID) == ob.NatGatewayID &&
+		aws.ToString(ds.NetworkInterfaceID) == ob.NetworkInterfaceID &&
+		aws.ToString(ds.TransitGatewayID) == ob.TransitGatewayID &&
+		aws.ToString(ds.VpcPeeringConnectionID) == ob.VpcPeeringConnectionID
+}
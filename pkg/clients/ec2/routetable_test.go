@@ -66,6 +66,25 @@ func TestIsRTUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"NormalizedDestination": {
+			args: args{
+				rt: ec2types.RouteTable{
+					VpcId: aws.String(rtVPC),
+					Routes: []ec2types.Route{{
+						DestinationCidrBlock: aws.String("10.0.0.0/16"),
+						GatewayId:            aws.String("igw"),
+					}},
+				},
+				p: v1beta1.RouteTableParameters{
+					VPCID: aws.String(rtVPC),
+					Routes: []v1beta1.RouteBeta{{
+						DestinationCIDRBlock: aws.String("10.0.0.1/16"),
+						GatewayID:            aws.String("igw"),
+					}},
+				},
+			},
+			want: true,
+		},
 	}
 
 	for name, tc := range cases {
@@ -170,3 +189,81 @@ func TestCreateRTPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffRoutes(t *testing.T) {
+	type args struct {
+		desired  []v1beta1.RouteBeta
+		observed []v1beta1.RouteState
+	}
+
+	cases := map[string]struct {
+		args args
+		want RouteDiff
+	}{
+		"NoChanges": {
+			args: args{
+				desired: []v1beta1.RouteBeta{{
+					DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+					NatGatewayID:         aws.String("nat"),
+				}},
+				observed: []v1beta1.RouteState{{
+					DestinationCIDRBlock: "0.0.0.0/0",
+					NatGatewayID:         "nat",
+				}, {
+					DestinationCIDRBlock: "10.0.0.0/16",
+					GatewayID:            DefaultLocalGatewayID,
+				}},
+			},
+			want: RouteDiff{},
+		},
+		"ChangedTarget": {
+			args: args{
+				desired: []v1beta1.RouteBeta{{
+					DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+					TransitGatewayID:     aws.String("tgw"),
+				}},
+				observed: []v1beta1.RouteState{{
+					DestinationCIDRBlock: "0.0.0.0/0",
+					NatGatewayID:         "nat",
+				}},
+			},
+			want: RouteDiff{
+				Replace: []v1beta1.RouteBeta{{
+					DestinationCIDRBlock: aws.String("0.0.0.0/0"),
+					TransitGatewayID:     aws.String("tgw"),
+				}},
+			},
+		},
+		"AddedAndRemoved": {
+			args: args{
+				desired: []v1beta1.RouteBeta{{
+					DestinationCIDRBlock:   aws.String("172.16.0.0/16"),
+					VpcPeeringConnectionID: aws.String("pcx"),
+				}},
+				observed: []v1beta1.RouteState{{
+					DestinationIPV6CIDRBlock: "::/0",
+					GatewayID:                "igw",
+				}},
+			},
+			want: RouteDiff{
+				Create: []v1beta1.RouteBeta{{
+					DestinationCIDRBlock:   aws.String("172.16.0.0/16"),
+					VpcPeeringConnectionID: aws.String("pcx"),
+				}},
+				Delete: []v1beta1.RouteState{{
+					DestinationIPV6CIDRBlock: "::/0",
+					GatewayID:                "igw",
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DiffRoutes(tc.args.desired, tc.args.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
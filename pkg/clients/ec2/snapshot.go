@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// SnapshotNotFound is the code that is returned by ec2 when the given
+	// SnapshotID is not valid.
+	SnapshotNotFound = "InvalidSnapshot.NotFound"
+)
+
+// SnapshotClient is the external client used for Snapshot Custom Resource
+type SnapshotClient interface {
+	CreateSnapshot(ctx context.Context, input *ec2.CreateSnapshotInput, opts ...func(*ec2.Options)) (*ec2.CreateSnapshotOutput, error)
+	DeleteSnapshot(ctx context.Context, input *ec2.DeleteSnapshotInput, opts ...func(*ec2.Options)) (*ec2.DeleteSnapshotOutput, error)
+	DescribeSnapshots(ctx context.Context, input *ec2.DescribeSnapshotsInput, opts ...func(*ec2.Options)) (*ec2.DescribeSnapshotsOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewSnapshotClient returns a new client using AWS credentials as JSON encoded data.
+func NewSnapshotClient(cfg aws.Config) SnapshotClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsSnapshotNotFoundErr returns true if the error is because the item doesn't exist
+func IsSnapshotNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == SnapshotNotFound
+}
+
+// GenerateCreateSnapshotInput returns the input to create the Snapshot
+// described by the supplied parameters.
+func GenerateCreateSnapshotInput(p ebsv1alpha1.SnapshotParameters) *ec2.CreateSnapshotInput {
+	input := &ec2.CreateSnapshotInput{
+		VolumeId:    p.VolumeID,
+		Description: p.Description,
+		OutpostArn:  p.OutpostARN,
+	}
+	if len(p.Tags) > 0 {
+		input.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeSnapshot,
+			Tags:         manualv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return input
+}
+
+// GenerateSnapshotObservation is used to produce ebsv1alpha1.SnapshotObservation
+// from ec2types.Snapshot.
+func GenerateSnapshotObservation(s ec2types.Snapshot) ebsv1alpha1.SnapshotObservation {
+	o := ebsv1alpha1.SnapshotObservation{
+		SnapshotID:   aws.ToString(s.SnapshotId),
+		State:        string(s.State),
+		StateMessage: aws.ToString(s.StateMessage),
+		Progress:     aws.ToString(s.Progress),
+		VolumeSize:   aws.ToInt32(s.VolumeSize),
+		Encrypted:    aws.ToBool(s.Encrypted),
+		KMSKeyID:     aws.ToString(s.KmsKeyId),
+		OwnerID:      aws.ToString(s.OwnerId),
+	}
+	if s.StartTime != nil {
+		o.StartTime = &metav1.Time{Time: *s.StartTime}
+	}
+	return o
+}
+
+// IsSnapshotUpToDate returns true if the tags of the supplied Snapshot match
+// the supplied parameters. All other parameters are immutable.
+func IsSnapshotUpToDate(p ebsv1alpha1.SnapshotParameters, s ec2types.Snapshot) bool {
+	add, remove := awsclient.DiffEC2Tags(manualv1alpha1.GenerateEC2Tags(p.Tags), s.Tags)
+	return len(add) == 0 && len(remove) == 0
+}
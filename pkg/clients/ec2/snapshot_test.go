@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+)
+
+const (
+	ebsSnapshotID = "snap-0123456789"
+	ebsVolumeID   = "vol-0123456789"
+)
+
+func TestGenerateSnapshotObservation(t *testing.T) {
+	now := time.Now()
+	cases := map[string]struct {
+		in  ec2types.Snapshot
+		out ebsv1alpha1.SnapshotObservation
+	}{
+		"AllFilled": {
+			in: ec2types.Snapshot{
+				SnapshotId: aws.String(ebsSnapshotID),
+				State:      ec2types.SnapshotStateCompleted,
+				Progress:   aws.String("100%"),
+				StartTime:  &now,
+				VolumeSize: aws.Int32(8),
+				Encrypted:  aws.Bool(true),
+				KmsKeyId:   aws.String("key"),
+				OwnerId:    aws.String("123456789012"),
+			},
+			out: ebsv1alpha1.SnapshotObservation{
+				SnapshotID: ebsSnapshotID,
+				State:      ebsv1alpha1.SnapshotStateCompleted,
+				Progress:   "100%",
+				StartTime:  &metav1.Time{Time: now},
+				VolumeSize: 8,
+				Encrypted:  true,
+				KMSKeyID:   "key",
+				OwnerID:    "123456789012",
+			},
+		},
+		"Failed": {
+			in: ec2types.Snapshot{
+				SnapshotId:   aws.String(ebsSnapshotID),
+				State:        ec2types.SnapshotStateError,
+				StateMessage: aws.String("volume was deleted"),
+			},
+			out: ebsv1alpha1.SnapshotObservation{
+				SnapshotID:   ebsSnapshotID,
+				State:        ebsv1alpha1.SnapshotStateError,
+				StateMessage: "volume was deleted",
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateSnapshotObservation(tc.in)
+			if diff := cmp.Diff(tc.out, r); diff != "" {
+				t.Errorf("GenerateSnapshotObservation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSnapshotUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    ebsv1alpha1.SnapshotParameters
+		s    ec2types.Snapshot
+		want bool
+	}{
+		"SameTags": {
+			p:    ebsv1alpha1.SnapshotParameters{Tags: []manualv1alpha1.Tag{{Key: "key1", Value: "value1"}}},
+			s:    ec2types.Snapshot{Tags: []ec2types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}},
+			want: true,
+		},
+		"DifferentTags": {
+			p: ebsv1alpha1.SnapshotParameters{Tags: []manualv1alpha1.Tag{{Key: "key1", Value: "value2"}}},
+			s: ec2types.Snapshot{Tags: []ec2types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}},
+		},
+		"RemovedTags": {
+			s: ec2types.Snapshot{Tags: []ec2types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}}},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsSnapshotUpToDate(tc.p, tc.s)); diff != "" {
+				t.Errorf("IsSnapshotUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
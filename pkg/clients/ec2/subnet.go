@@ -46,6 +46,7 @@ func GenerateSubnetObservation(subnet ec2types.Subnet) v1beta1.SubnetObservation
 		AvailableIPAddressCount: aws.ToInt32(subnet.AvailableIpAddressCount),
 		DefaultForAZ:            aws.ToBool(subnet.DefaultForAz),
 		SubnetID:                aws.ToString(subnet.SubnetId),
+		SubnetARN:               aws.ToString(subnet.SubnetArn),
 		SubnetState:             string(subnet.State),
 	}
 
@@ -14,6 +14,7 @@ var (
 	cidr             = "192.18.0.0/32"
 	vpc              = "some vpc"
 	availableIPCount = 10
+	subnetARN        = "some subnet arn"
 	subnetID         = "some subnet"
 	state            = "available"
 )
@@ -84,12 +85,14 @@ func TestGenerateSubnetObservation(t *testing.T) {
 				AvailableIpAddressCount: aws.Int32(int32(availableIPCount)),
 				DefaultForAz:            aws.Bool(true),
 				SubnetId:                aws.String(subnetID),
+				SubnetArn:               aws.String(subnetARN),
 				State:                   ec2types.SubnetStateAvailable,
 			},
 			out: v1beta1.SubnetObservation{
 				AvailableIPAddressCount: int32(availableIPCount),
 				DefaultForAZ:            true,
 				SubnetID:                subnetID,
+				SubnetARN:               subnetARN,
 				SubnetState:             state,
 			},
 		},
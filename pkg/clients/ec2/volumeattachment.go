@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+)
+
+const (
+	// VolumeNotFound is the code that is returned by ec2 when the given
+	// VolumeID is not valid.
+	VolumeNotFound = "InvalidVolume.NotFound"
+
+	// AttachmentNotFound is the code that is returned by ec2 when the given
+	// volume is not attached to the given instance.
+	AttachmentNotFound = "InvalidAttachment.NotFound"
+)
+
+// VolumeAttachmentClient is the external client used for VolumeAttachment
+// Custom Resource
+type VolumeAttachmentClient interface {
+	AttachVolume(ctx context.Context, input *ec2.AttachVolumeInput, opts ...func(*ec2.Options)) (*ec2.AttachVolumeOutput, error)
+	DetachVolume(ctx context.Context, input *ec2.DetachVolumeInput, opts ...func(*ec2.Options)) (*ec2.DetachVolumeOutput, error)
+	DescribeVolumes(ctx context.Context, input *ec2.DescribeVolumesInput, opts ...func(*ec2.Options)) (*ec2.DescribeVolumesOutput, error)
+}
+
+// NewVolumeAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewVolumeAttachmentClient(cfg aws.Config) VolumeAttachmentClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsVolumeNotFoundErr returns true if the error is because the volume doesn't
+// exist
+func IsVolumeNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == VolumeNotFound
+}
+
+// IsVolumeAttachmentNotFoundErr returns true if the error is because the
+// volume, or its attachment to the instance, doesn't exist
+func IsVolumeAttachmentNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && (awsErr.ErrorCode() == VolumeNotFound || awsErr.ErrorCode() == AttachmentNotFound)
+}
+
+// FindVolumeAttachment returns the attachment of the supplied volume to the
+// supplied instance, or nil if it is not attached to it.
+func FindVolumeAttachment(v ec2types.Volume, instanceID string) *ec2types.VolumeAttachment {
+	for i := range v.Attachments {
+		if aws.ToString(v.Attachments[i].InstanceId) == instanceID {
+			return &v.Attachments[i]
+		}
+	}
+	return nil
+}
+
+// GenerateVolumeAttachmentObservation is used to produce
+// ebsv1alpha1.VolumeAttachmentObservation from ec2types.VolumeAttachment.
+func GenerateVolumeAttachmentObservation(a ec2types.VolumeAttachment) ebsv1alpha1.VolumeAttachmentObservation {
+	o := ebsv1alpha1.VolumeAttachmentObservation{
+		State:               string(a.State),
+		DeleteOnTermination: aws.ToBool(a.DeleteOnTermination),
+	}
+	if a.AttachTime != nil {
+		o.AttachTime = &metav1.Time{Time: *a.AttachTime}
+	}
+	return o
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+)
+
+func TestFindVolumeAttachment(t *testing.T) {
+	attachment := ec2types.VolumeAttachment{InstanceId: aws.String("i-2"), VolumeId: aws.String(ebsVolumeID)}
+	v := ec2types.Volume{Attachments: []ec2types.VolumeAttachment{
+		{InstanceId: aws.String("i-1"), VolumeId: aws.String(ebsVolumeID)},
+		attachment,
+	}}
+	cases := map[string]struct {
+		instanceID string
+		want       *ec2types.VolumeAttachment
+	}{
+		"Attached": {
+			instanceID: "i-2",
+			want:       &attachment,
+		},
+		"NotAttached": {
+			instanceID: "i-3",
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := FindVolumeAttachment(v, tc.instanceID)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(ec2types.VolumeAttachment{})); diff != "" {
+				t.Errorf("FindVolumeAttachment(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateVolumeAttachmentObservation(t *testing.T) {
+	now := time.Now()
+	got := GenerateVolumeAttachmentObservation(ec2types.VolumeAttachment{
+		AttachTime:          &now,
+		DeleteOnTermination: aws.Bool(true),
+		State:               ec2types.VolumeAttachmentStateAttached,
+	})
+	want := ebsv1alpha1.VolumeAttachmentObservation{
+		AttachTime:          &metav1.Time{Time: now},
+		DeleteOnTermination: true,
+		State:               ebsv1alpha1.VolumeAttachmentStateAttached,
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GenerateVolumeAttachmentObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -38,8 +38,10 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
 
 	smithyhttp "github.com/aws/smithy-go/transport/http"
 )
@@ -51,6 +53,18 @@ const (
 	v1Prefix         = "k8s-aws-v1."
 )
 
+// TransitionalClusterStates of EKS clusters, which change without the
+// provider doing anything.
+var TransitionalClusterStates = pollinterval.Transitional(
+	string(v1beta1.ClusterStatusCreating), string(v1beta1.ClusterStatusUpdating), string(v1beta1.ClusterStatusDeleting),
+)
+
+// TransitionalNodeGroupStates of EKS node groups, which change without the
+// provider doing anything.
+var TransitionalNodeGroupStates = pollinterval.Transitional(
+	string(manualv1alpha1.NodeGroupStatusCreating), string(manualv1alpha1.NodeGroupStatusUpdating), string(manualv1alpha1.NodeGroupStatusDeleting),
+)
+
 // Client defines EKS Client operations
 type Client interface {
 	CreateCluster(ctx context.Context, input *eks.CreateClusterInput, opts ...func(*eks.Options)) (*eks.CreateClusterOutput, error)
@@ -70,6 +84,7 @@ type Client interface {
 	DescribeFargateProfile(ctx context.Context, input *eks.DescribeFargateProfileInput, opts ...func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	CreateFargateProfile(ctx context.Context, input *eks.CreateFargateProfileInput, opts ...func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
 	DeleteFargateProfile(ctx context.Context, input *eks.DeleteFargateProfileInput, opts ...func(*eks.Options)) (*eks.DeleteFargateProfileOutput, error)
+	ListFargateProfiles(ctx context.Context, input *eks.ListFargateProfilesInput, opts ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
 
 	DescribeIdentityProviderConfig(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	AssociateIdentityProviderConfig(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
@@ -43,6 +43,7 @@ type MockClient struct {
 	MockDescribeFargateProfile func(ctx context.Context, input *eks.DescribeFargateProfileInput, opts []func(*eks.Options)) (*eks.DescribeFargateProfileOutput, error)
 	MockCreateFargateProfile   func(ctx context.Context, input *eks.CreateFargateProfileInput, opts []func(*eks.Options)) (*eks.CreateFargateProfileOutput, error)
 	MockDeleteFargateProfile   func(ctx context.Context, input *eks.DeleteFargateProfileInput, opts []func(*eks.Options)) (*eks.DeleteFargateProfileOutput, error)
+	MockListFargateProfiles    func(ctx context.Context, input *eks.ListFargateProfilesInput, opts []func(*eks.Options)) (*eks.ListFargateProfilesOutput, error)
 
 	MockDescribeIdentityProviderConfig     func(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error)
 	MockAssociateIdentityProviderConfig    func(ctx context.Context, input *eks.AssociateIdentityProviderConfigInput, opts []func(*eks.Options)) (*eks.AssociateIdentityProviderConfigOutput, error)
@@ -145,6 +146,12 @@ func (c *MockClient) DeleteFargateProfile(ctx context.Context, input *eks.Delete
 	return c.MockDeleteFargateProfile(ctx, input, opts)
 }
 
+// ListFargateProfiles calls the underlying MockListFargateProfiles
+// method.
+func (c *MockClient) ListFargateProfiles(ctx context.Context, input *eks.ListFargateProfilesInput, opts ...func(*eks.Options)) (*eks.ListFargateProfilesOutput, error) {
+	return c.MockListFargateProfiles(ctx, input, opts)
+}
+
 // DescribeIdentityProviderConfig calls the underlying MockDescribeIdentityProviderConfig
 // method
 func (c *MockClient) DescribeIdentityProviderConfig(ctx context.Context, input *eks.DescribeIdentityProviderConfigInput, opts ...func(*eks.Options)) (*eks.DescribeIdentityProviderConfigOutput, error) {
@@ -17,16 +17,78 @@ limitations under the License.
 package eks
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+// ReasonWaitingForClusterOperation is the reason of the Ready condition of
+// fargate profiles whose creation or deletion is queued because another
+// fargate profile of the same cluster is being created or deleted. EKS allows
+// only one such operation per cluster at a time.
+const ReasonWaitingForClusterOperation xpv1.ConditionReason = "WaitingForClusterOperation"
+
+// WaitingForClusterOperation returns a condition that indicates the fargate
+// profile is queued behind an operation on the supplied fargate profile of the
+// same cluster.
+func WaitingForClusterOperation(profile string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWaitingForClusterOperation,
+		Message:            fmt.Sprintf("waiting for fargate profile %q of the same cluster to finish creating or deleting", profile),
+	}
+}
+
+// FargateProfileInProgress returns the name of a fargate profile of the
+// supplied cluster, other than the one named self, that is currently being
+// created or deleted. It returns an empty string if there is none.
+func FargateProfileInProgress(ctx context.Context, c Client, cluster, self string) (string, error) {
+	in := &eks.ListFargateProfilesInput{ClusterName: &cluster}
+	for {
+		out, err := c.ListFargateProfiles(ctx, in)
+		if err != nil {
+			return "", err
+		}
+		for i := range out.FargateProfileNames {
+			name := out.FargateProfileNames[i]
+			if name == self {
+				continue
+			}
+			rsp, err := c.DescribeFargateProfile(ctx, &eks.DescribeFargateProfileInput{ClusterName: &cluster, FargateProfileName: &name})
+			if IsErrorNotFound(err) {
+				continue
+			}
+			if err != nil {
+				return "", err
+			}
+			if rsp.FargateProfile == nil {
+				continue
+			}
+			switch rsp.FargateProfile.Status { // nolint:exhaustive
+			case ekstypes.FargateProfileStatusCreating, ekstypes.FargateProfileStatusDeleting:
+				return name, nil
+			}
+		}
+		if out.NextToken == nil {
+			return "", nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
 // GenerateCreateFargateProfileInput from FargateProfileInputParameters.
 func GenerateCreateFargateProfileInput(name string, p v1beta1.FargateProfileParameters) *eks.CreateFargateProfileInput {
 	c := &eks.CreateFargateProfileInput{
@@ -129,6 +129,35 @@ func GenerateUpdateNodeGroupConfigInput(name string, p *manualv1alpha1.NodeGroup
 	return u
 }
 
+// GenerateUpdateNodeGroupVersionInput from NodeGroupParameters. It moves the
+// node group to the desired launch template version if that changed, and to
+// the desired Kubernetes version otherwise.
+func GenerateUpdateNodeGroupVersionInput(name string, p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) *eks.UpdateNodegroupVersionInput {
+	u := &eks.UpdateNodegroupVersionInput{
+		NodegroupName: &name,
+		ClusterName:   &p.ClusterName,
+		Version:       p.Version,
+	}
+	if !IsLaunchTemplateVersionUpToDate(p, ng) {
+		u.Version = nil
+		u.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{
+			Id:      ng.LaunchTemplate.Id,
+			Version: p.LaunchTemplate.Version,
+		}
+	}
+	return u
+}
+
+// IsLaunchTemplateVersionUpToDate checks whether the node group uses the
+// desired version of its launch template. A node group that does not specify
+// a version keeps the one it was created with.
+func IsLaunchTemplateVersionUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nodegroup) bool {
+	if p.LaunchTemplate == nil || p.LaunchTemplate.Version == nil || ng.LaunchTemplate == nil {
+		return true
+	}
+	return aws.ToString(p.LaunchTemplate.Version) == aws.ToString(ng.LaunchTemplate.Version)
+}
+
 // GenerateNodeGroupObservation is used to produce manualv1alpha1.NodeGroupObservation
 // from eks.Nodegroup.
 func GenerateNodeGroupObservation(ng *ekstypes.Nodegroup) manualv1alpha1.NodeGroupObservation { // nolint:gocyclo
@@ -231,7 +260,7 @@ func IsNodeGroupUpToDate(p *manualv1alpha1.NodeGroupParameters, ng *ekstypes.Nod
 	if !cmp.Equal(p.Tags, ng.Tags, cmpopts.EquateEmpty()) {
 		return false
 	}
-	if !cmp.Equal(p.Version, ng.Version) {
+	if !cmp.Equal(p.Version, ng.Version) || !IsLaunchTemplateVersionUpToDate(p, ng) {
 		return false
 	}
 	if !cmp.Equal(p.Labels, ng.Labels, cmpopts.EquateEmpty()) {
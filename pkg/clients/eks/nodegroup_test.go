@@ -375,6 +375,65 @@ func TestGenerateUpdateNodeGroupInput(t *testing.T) {
 	}
 }
 
+func TestGenerateUpdateNodeGroupVersionInput(t *testing.T) {
+	ltID := "lt-0123456789"
+	ltVersion := "3"
+
+	type args struct {
+		name string
+		p    *manualv1alpha1.NodeGroupParameters
+		n    *ekstypes.Nodegroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *eks.UpdateNodegroupVersionInput
+	}{
+		"KubernetesVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName: clusterName,
+					Version:     &version,
+				},
+				n: &ekstypes.Nodegroup{},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				NodegroupName: &ngName,
+				ClusterName:   &clusterName,
+				Version:       &version,
+			},
+		},
+		"LaunchTemplateVersion": {
+			args: args{
+				name: ngName,
+				p: &manualv1alpha1.NodeGroupParameters{
+					ClusterName:    clusterName,
+					Version:        &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{Version: &ltVersion},
+				},
+				n: &ekstypes.Nodegroup{
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Id: &ltID, Version: &version},
+				},
+			},
+			want: &eks.UpdateNodegroupVersionInput{
+				NodegroupName:  &ngName,
+				ClusterName:    &clusterName,
+				LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Id: &ltID, Version: &ltVersion},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := GenerateUpdateNodeGroupVersionInput(tc.args.name, tc.args.p, tc.args.n)
+			if diff := cmp.Diff(tc.want, u, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateNodeObservation(t *testing.T) {
 	ngArn := "cool:arn"
 	now := time.Now()
@@ -685,6 +744,36 @@ func TestIsNodeGroupUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"UpdateLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Tags:           map[string]string{"cool": "tag"},
+					Version:        &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{Version: &otherVersion},
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					Tags:           map[string]string{"cool": "tag"},
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Version: &version},
+				},
+			},
+			want: false,
+		},
+		"IgnoreLaunchTemplateVersion": {
+			args: args{
+				p: &manualv1alpha1.NodeGroupParameters{
+					Tags:           map[string]string{"cool": "tag"},
+					Version:        &version,
+					LaunchTemplate: &manualv1alpha1.LaunchTemplateSpecification{},
+				},
+				n: &ekstypes.Nodegroup{
+					Version:        &version,
+					Tags:           map[string]string{"cool": "tag"},
+					LaunchTemplate: &ekstypes.LaunchTemplateSpecification{Version: &version},
+				},
+			},
+			want: true,
+		},
 		"IgnoreDesiredSize": {
 			args: args{
 				p: &manualv1alpha1.NodeGroupParameters{
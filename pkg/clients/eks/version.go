@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"sort"
+	"strings"
+
+	goversion "github.com/hashicorp/go-version"
+	"github.com/pkg/errors"
+)
+
+// MaxNodeGroupVersionSkew is the number of minor versions the Kubernetes
+// version of a node group may lag behind the control plane of its cluster.
+// EKS requires node groups to match the control plane before the control plane
+// is upgraded, which leaves them exactly one minor version behind afterwards.
+const MaxNodeGroupVersionSkew = 1
+
+const (
+	errFmtParseVersion       = "cannot parse Kubernetes version %q"
+	errFmtSkipMinorVersion   = "cannot upgrade EKS cluster from Kubernetes version %s to %s: control plane can only be upgraded by one minor version at a time"
+	errFmtNodeGroupSkew      = "cannot upgrade EKS cluster to Kubernetes version %s: node groups %s would be more than %d minor version behind the control plane; upgrade them first"
+	errFmtDowngradeVersion   = "cannot downgrade EKS cluster from Kubernetes version %s to %s"
+	errFmtChangeMajorVersion = "cannot change major Kubernetes version of EKS cluster from %s to %s"
+)
+
+// ValidateVersionUpgrade returns an error if upgrading the control plane of a
+// cluster from the current to the desired Kubernetes version would be rejected
+// by EKS, or would leave any of the supplied node group versions, keyed by
+// node group name, more than MaxNodeGroupVersionSkew minor versions behind the
+// control plane.
+func ValidateVersionUpgrade(current, desired string, nodeGroups map[string]string) error {
+	c, err := goversion.NewVersion(current)
+	if err != nil {
+		return errors.Wrapf(err, errFmtParseVersion, current)
+	}
+	d, err := goversion.NewVersion(desired)
+	if err != nil {
+		return errors.Wrapf(err, errFmtParseVersion, desired)
+	}
+	cs, ds := c.Segments(), d.Segments()
+	switch {
+	case cs[0] != ds[0]:
+		return errors.Errorf(errFmtChangeMajorVersion, current, desired)
+	case ds[1] < cs[1]:
+		return errors.Errorf(errFmtDowngradeVersion, current, desired)
+	case ds[1] > cs[1]+1:
+		return errors.Errorf(errFmtSkipMinorVersion, current, desired)
+	}
+	var skewed []string
+	for name, v := range nodeGroups {
+		n, err := goversion.NewVersion(v)
+		if err != nil {
+			return errors.Wrapf(err, errFmtParseVersion, v)
+		}
+		if ns := n.Segments(); ns[0] != ds[0] || ds[1]-ns[1] > MaxNodeGroupVersionSkew {
+			skewed = append(skewed, name)
+		}
+	}
+	if len(skewed) > 0 {
+		sort.Strings(skewed)
+		return errors.Errorf(errFmtNodeGroupSkew, desired, strings.Join(skewed, ", "), MaxNodeGroupVersionSkew)
+	}
+	return nil
+}
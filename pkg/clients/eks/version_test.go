@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestValidateVersionUpgrade(t *testing.T) {
+	type args struct {
+		current    string
+		desired    string
+		nodeGroups map[string]string
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"NextMinorVersion": {
+			args: args{
+				current:    "1.21",
+				desired:    "1.22",
+				nodeGroups: map[string]string{"a": "1.21", "b": "1.21"},
+			},
+		},
+		"SameVersion": {
+			args: args{
+				current:    "1.21",
+				desired:    "1.21",
+				nodeGroups: map[string]string{"a": "1.20"},
+			},
+		},
+		"SkipMinorVersion": {
+			args: args{
+				current: "1.20",
+				desired: "1.22",
+			},
+			want: errors.Errorf(errFmtSkipMinorVersion, "1.20", "1.22"),
+		},
+		"Downgrade": {
+			args: args{
+				current: "1.21",
+				desired: "1.20",
+			},
+			want: errors.Errorf(errFmtDowngradeVersion, "1.21", "1.20"),
+		},
+		"MajorVersion": {
+			args: args{
+				current: "1.21",
+				desired: "2.0",
+			},
+			want: errors.Errorf(errFmtChangeMajorVersion, "1.21", "2.0"),
+		},
+		"NodeGroupsTooOld": {
+			args: args{
+				current:    "1.21",
+				desired:    "1.22",
+				nodeGroups: map[string]string{"c": "1.20", "a": "1.19", "b": "1.21"},
+			},
+			want: errors.Errorf(errFmtNodeGroupSkew, "1.22", "a, c", MaxNodeGroupVersionSkew),
+		},
+		"InvalidVersion": {
+			args: args{
+				current: "1.21",
+				desired: "latest",
+			},
+			want: errors.Wrapf(errors.New("Malformed version: latest"), errFmtParseVersion, "latest"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateVersionUpgrade(tc.args.current, tc.args.desired, tc.args.nodeGroups)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("ValidateVersionUpgrade(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,14 +18,21 @@ package elasticache
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"reflect"
 	"strconv"
 	"strings"
 
 	"github.com/aws/smithy-go/document"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
@@ -41,7 +48,14 @@ import (
 	clients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
-const errCheckUpToDate = "unable to determine if external resource is up to date"
+const (
+	errCheckUpToDate   = "unable to determine if external resource is up to date"
+	errGetAuthTokenSec = "cannot get auth token secret"
+)
+
+// AnnotationKeyAuthTokenUpdate is the annotation that records a hash of the
+// auth token a replication group was last requested to update to.
+const AnnotationKeyAuthTokenUpdate = "cache.aws.crossplane.io/auth-token-update"
 
 // A Client handles CRUD operations for ElastiCache resources.
 type Client interface {
@@ -149,6 +163,59 @@ func NewModifyReplicationGroupInput(g v1beta1.ReplicationGroupParameters, id str
 	}
 }
 
+// NewModifyAuthTokenInput returns ElastiCache replication group modification
+// input that immediately updates the auth token of the replication group to
+// the supplied token, using the update strategy of the supplied parameters.
+func NewModifyAuthTokenInput(g v1beta1.ReplicationGroupParameters, id, token string) *elasticache.ModifyReplicationGroupInput {
+	strategy := elasticachetypes.AuthTokenUpdateStrategyTypeRotate
+	if g.AuthTokenUpdateStrategy != nil {
+		strategy = elasticachetypes.AuthTokenUpdateStrategyType(*g.AuthTokenUpdateStrategy)
+	}
+	return &elasticache.ModifyReplicationGroupInput{
+		ReplicationGroupId:      aws.String(id),
+		ApplyImmediately:        true,
+		AuthToken:               aws.String(token),
+		AuthTokenUpdateStrategy: strategy,
+	}
+}
+
+// GetAuthToken returns the auth token referenced by the supplied selector, and
+// whether it differs from the password in the supplied connection secret.
+func GetAuthToken(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference) (token string, changed bool, err error) {
+	if in == nil {
+		return "", false, nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: in.Name, Namespace: in.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetAuthTokenSec)
+	}
+	token = string(s.Data[in.Key])
+
+	if out != nil {
+		s = &corev1.Secret{}
+		// The connection secret may not exist yet.
+		if err := kube.Get(ctx, types.NamespacedName{Name: out.Name, Namespace: out.Namespace}, s); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		changed = token != "" && token != string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey])
+	}
+	return token, changed, nil
+}
+
+// AuthTokenHash returns the hash of the supplied auth token that is recorded
+// in the AnnotationKeyAuthTokenUpdate annotation.
+func AuthTokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// AuthTokenUpdatePending returns true if the supplied replication group is
+// still updating its auth token.
+func AuthTokenUpdatePending(rg elasticachetypes.ReplicationGroup) bool {
+	return clients.StringValue(rg.Status) != v1beta1.StatusAvailable ||
+		(rg.PendingModifiedValues != nil && rg.PendingModifiedValues.AuthTokenStatus != "")
+}
+
 // NewModifyReplicationGroupShardConfigurationInput returns ElastiCache replication group
 // shard configuration modification input suitable for use with the AWS API.
 func NewModifyReplicationGroupShardConfigurationInput(g v1beta1.ReplicationGroupParameters, id string, rg elasticachetypes.ReplicationGroup) *elasticache.ModifyReplicationGroupShardConfigurationInput {
@@ -344,6 +411,9 @@ func GenerateObservation(rg elasticachetypes.ReplicationGroup) v1beta1.Replicati
 		MemberClusters:        rg.MemberClusters,
 		Status:                clients.StringValue(rg.Status),
 	}
+	if rg.AuthTokenLastModifiedDate != nil {
+		o.AuthTokenLastModifiedDate = &metav1.Time{Time: *rg.AuthTokenLastModifiedDate}
+	}
 	if len(rg.NodeGroups) != 0 {
 		o.NodeGroups = make([]v1beta1.NodeGroup, len(rg.NodeGroups))
 		for i, ng := range rg.NodeGroups {
@@ -386,6 +456,7 @@ func generateReplicationGroupPendingModifiedValues(in elasticachetypes.Replicati
 	r := v1beta1.ReplicationGroupPendingModifiedValues{
 		AutomaticFailoverStatus: string(in.AutomaticFailoverStatus),
 		PrimaryClusterID:        clients.StringValue(in.PrimaryClusterId),
+		AuthTokenStatus:         string(in.AuthTokenStatus),
 	}
 	if in.Resharding != nil && in.Resharding.SlotMigration != nil {
 		r.Resharding = v1beta1.ReshardingStatus{
@@ -572,6 +643,12 @@ func GenerateClusterObservation(c elasticachetypes.CacheCluster) cachev1alpha1.C
 		}
 		o.CacheNodes = cacheNodes
 	}
+	if c.ConfigurationEndpoint != nil {
+		o.ConfigurationEndpoint = v1alpha1.Endpoint{
+			Address: aws.ToString(c.ConfigurationEndpoint.Address),
+			Port:    int(c.ConfigurationEndpoint.Port),
+		}
+	}
 	return o
 }
 
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+)
+
+// MockReservationClient is a fake implementation of
+// elasticache.ReservationClient.
+type MockReservationClient struct {
+	MockDescribeReservedCacheNodes func(context.Context, *elasticache.DescribeReservedCacheNodesInput, []func(*elasticache.Options)) (*elasticache.DescribeReservedCacheNodesOutput, error)
+	MockDescribeCacheClusters      func(context.Context, *elasticache.DescribeCacheClustersInput, []func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+}
+
+// DescribeReservedCacheNodes calls the underlying
+// MockDescribeReservedCacheNodes method.
+func (c *MockReservationClient) DescribeReservedCacheNodes(ctx context.Context, i *elasticache.DescribeReservedCacheNodesInput, opts ...func(*elasticache.Options)) (*elasticache.DescribeReservedCacheNodesOutput, error) {
+	return c.MockDescribeReservedCacheNodes(ctx, i, opts)
+}
+
+// DescribeCacheClusters calls the underlying MockDescribeCacheClusters
+// method.
+func (c *MockReservationClient) DescribeCacheClusters(ctx context.Context, i *elasticache.DescribeCacheClustersInput, opts ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error) {
+	return c.MockDescribeCacheClusters(ctx, i, opts)
+}
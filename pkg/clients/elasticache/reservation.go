@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	elasticachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+)
+
+const (
+	reservationStateActive = "active"
+
+	clusterStatusDeleting = "deleting"
+	clusterStatusDeleted  = "deleted"
+)
+
+// A ReservationClient reads ElastiCache reserved nodes and the cache clusters
+// they may cover.
+type ReservationClient interface {
+	DescribeReservedCacheNodes(context.Context, *elasticache.DescribeReservedCacheNodesInput, ...func(*elasticache.Options)) (*elasticache.DescribeReservedCacheNodesOutput, error)
+	DescribeCacheClusters(context.Context, *elasticache.DescribeCacheClustersInput, ...func(*elasticache.Options)) (*elasticache.DescribeCacheClustersOutput, error)
+}
+
+// NewReservationClient returns a new ElastiCache ReservationClient.
+func NewReservationClient(cfg aws.Config) ReservationClient {
+	return elasticache.NewFromConfig(cfg)
+}
+
+// ListReservedCacheNodes returns all reserved cache nodes of the region.
+func ListReservedCacheNodes(ctx context.Context, c ReservationClient) ([]elasticachetypes.ReservedCacheNode, error) {
+	var nodes []elasticachetypes.ReservedCacheNode
+	input := &elasticache.DescribeReservedCacheNodesInput{}
+	for {
+		out, err := c.DescribeReservedCacheNodes(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, out.ReservedCacheNodes...)
+		if aws.ToString(out.Marker) == "" {
+			return nodes, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// ListCacheClusters returns all cache clusters of the region, including the
+// member clusters of replication groups.
+func ListCacheClusters(ctx context.Context, c ReservationClient) ([]elasticachetypes.CacheCluster, error) {
+	var clusters []elasticachetypes.CacheCluster
+	input := &elasticache.DescribeCacheClustersInput{}
+	for {
+		out, err := c.DescribeCacheClusters(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, out.CacheClusters...)
+		if aws.ToString(out.Marker) == "" {
+			return clusters, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+type cacheNodeKey struct {
+	nodeType string
+	engine   string
+}
+
+// GenerateReservedCacheNodeCoverageObservation computes the reserved capacity
+// coverage of the supplied cache clusters by the supplied reserved nodes.
+// Only active reservations and clusters that are not being deleted are taken
+// into account.
+func GenerateReservedCacheNodeCoverageObservation(p cachev1alpha1.ReservedCacheNodeCoverageParameters, reserved []elasticachetypes.ReservedCacheNode, clusters []elasticachetypes.CacheCluster) cachev1alpha1.ReservedCacheNodeCoverageObservation { // nolint:gocyclo
+	include := func(nodeType string) bool {
+		if len(p.CacheNodeTypes) == 0 {
+			return true
+		}
+		for _, t := range p.CacheNodeTypes {
+			if t == nodeType {
+				return true
+			}
+		}
+		return false
+	}
+
+	o := cachev1alpha1.ReservedCacheNodeCoverageObservation{}
+	coverage := map[cacheNodeKey]*cachev1alpha1.CacheNodeTypeCoverage{}
+	get := func(k cacheNodeKey) *cachev1alpha1.CacheNodeTypeCoverage {
+		if _, ok := coverage[k]; !ok {
+			coverage[k] = &cachev1alpha1.CacheNodeTypeCoverage{CacheNodeType: k.nodeType, ProductDescription: k.engine}
+		}
+		return coverage[k]
+	}
+
+	for _, r := range reserved {
+		if aws.ToString(r.State) != reservationStateActive || !include(aws.ToString(r.CacheNodeType)) {
+			continue
+		}
+		res := cachev1alpha1.CacheNodeReservation{
+			ReservedCacheNodeID: aws.ToString(r.ReservedCacheNodeId),
+			CacheNodeType:       aws.ToString(r.CacheNodeType),
+			CacheNodeCount:      r.CacheNodeCount,
+			ProductDescription:  aws.ToString(r.ProductDescription),
+			OfferingType:        aws.ToString(r.OfferingType),
+		}
+		if r.StartTime != nil {
+			start := metav1.NewTime(*r.StartTime)
+			end := metav1.NewTime(r.StartTime.Add(time.Duration(r.Duration) * time.Second))
+			res.StartTime, res.EndTime = &start, &end
+		}
+		o.Reservations = append(o.Reservations, res)
+		get(cacheNodeKey{nodeType: res.CacheNodeType, engine: res.ProductDescription}).ReservedNodes += r.CacheNodeCount
+	}
+
+	for _, c := range clusters {
+		status := aws.ToString(c.CacheClusterStatus)
+		if status == clusterStatusDeleting || status == clusterStatusDeleted || !include(aws.ToString(c.CacheNodeType)) {
+			continue
+		}
+		get(cacheNodeKey{nodeType: aws.ToString(c.CacheNodeType), engine: aws.ToString(c.Engine)}).RunningNodes += aws.ToInt32(c.NumCacheNodes)
+	}
+
+	for _, c := range coverage {
+		if c.RunningNodes > c.ReservedNodes {
+			c.UncoveredNodes = c.RunningNodes - c.ReservedNodes
+		}
+		o.UncoveredNodes += c.UncoveredNodes
+		o.Coverage = append(o.Coverage, *c)
+	}
+
+	sort.Slice(o.Reservations, func(i, j int) bool {
+		return o.Reservations[i].ReservedCacheNodeID < o.Reservations[j].ReservedCacheNodeID
+	})
+	sort.Slice(o.Coverage, func(i, j int) bool {
+		if o.Coverage[i].CacheNodeType != o.Coverage[j].CacheNodeType {
+			return o.Coverage[i].CacheNodeType < o.Coverage[j].CacheNodeType
+		}
+		return o.Coverage[i].ProductDescription < o.Coverage[j].ProductDescription
+	})
+	return o
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awscachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+func TestGenerateReservedCacheNodeCoverageObservation(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTime := metav1.NewTime(start)
+	endTime := metav1.NewTime(start.Add(365 * 24 * time.Hour))
+
+	type args struct {
+		p        v1alpha1.ReservedCacheNodeCoverageParameters
+		reserved []awscachetypes.ReservedCacheNode
+		clusters []awscachetypes.CacheCluster
+	}
+
+	cases := map[string]struct {
+		args args
+		want v1alpha1.ReservedCacheNodeCoverageObservation
+	}{
+		"PartiallyCovered": {
+			args: args{
+				reserved: []awscachetypes.ReservedCacheNode{
+					{
+						ReservedCacheNodeId: aws.String("ri-2"),
+						CacheNodeType:       aws.String("cache.r6g.large"),
+						CacheNodeCount:      2,
+						ProductDescription:  aws.String("redis"),
+						OfferingType:        aws.String("All Upfront"),
+						State:               aws.String("active"),
+						StartTime:           &start,
+						Duration:            365 * 24 * 60 * 60,
+					},
+					{
+						ReservedCacheNodeId: aws.String("ri-1"),
+						CacheNodeType:       aws.String("cache.r6g.large"),
+						CacheNodeCount:      4,
+						ProductDescription:  aws.String("redis"),
+						State:               aws.String("retired"),
+					},
+				},
+				clusters: []awscachetypes.CacheCluster{
+					{CacheNodeType: aws.String("cache.r6g.large"), Engine: aws.String("redis"), NumCacheNodes: aws.Int32(1), CacheClusterStatus: aws.String("available")},
+					{CacheNodeType: aws.String("cache.r6g.large"), Engine: aws.String("redis"), NumCacheNodes: aws.Int32(1), CacheClusterStatus: aws.String("available")},
+					{CacheNodeType: aws.String("cache.r6g.large"), Engine: aws.String("redis"), NumCacheNodes: aws.Int32(1), CacheClusterStatus: aws.String("modifying")},
+					{CacheNodeType: aws.String("cache.r6g.large"), Engine: aws.String("redis"), NumCacheNodes: aws.Int32(1), CacheClusterStatus: aws.String("deleting")},
+					{CacheNodeType: aws.String("cache.t3.micro"), Engine: aws.String("memcached"), NumCacheNodes: aws.Int32(2), CacheClusterStatus: aws.String("available")},
+				},
+			},
+			want: v1alpha1.ReservedCacheNodeCoverageObservation{
+				Reservations: []v1alpha1.CacheNodeReservation{{
+					ReservedCacheNodeID: "ri-2",
+					CacheNodeType:       "cache.r6g.large",
+					CacheNodeCount:      2,
+					ProductDescription:  "redis",
+					OfferingType:        "All Upfront",
+					StartTime:           &startTime,
+					EndTime:             &endTime,
+				}},
+				Coverage: []v1alpha1.CacheNodeTypeCoverage{
+					{CacheNodeType: "cache.r6g.large", ProductDescription: "redis", ReservedNodes: 2, RunningNodes: 3, UncoveredNodes: 1},
+					{CacheNodeType: "cache.t3.micro", ProductDescription: "memcached", RunningNodes: 2, UncoveredNodes: 2},
+				},
+				UncoveredNodes: 3,
+			},
+		},
+		"FilteredAndOverReserved": {
+			args: args{
+				p: v1alpha1.ReservedCacheNodeCoverageParameters{CacheNodeTypes: []string{"cache.r6g.large"}},
+				reserved: []awscachetypes.ReservedCacheNode{{
+					ReservedCacheNodeId: aws.String("ri-1"),
+					CacheNodeType:       aws.String("cache.r6g.large"),
+					CacheNodeCount:      3,
+					ProductDescription:  aws.String("redis"),
+					State:               aws.String("active"),
+				}},
+				clusters: []awscachetypes.CacheCluster{
+					{CacheNodeType: aws.String("cache.r6g.large"), Engine: aws.String("redis"), NumCacheNodes: aws.Int32(1), CacheClusterStatus: aws.String("available")},
+					{CacheNodeType: aws.String("cache.t3.micro"), Engine: aws.String("memcached"), NumCacheNodes: aws.Int32(2), CacheClusterStatus: aws.String("available")},
+				},
+			},
+			want: v1alpha1.ReservedCacheNodeCoverageObservation{
+				Reservations: []v1alpha1.CacheNodeReservation{{
+					ReservedCacheNodeID: "ri-1",
+					CacheNodeType:       "cache.r6g.large",
+					CacheNodeCount:      3,
+					ProductDescription:  "redis",
+				}},
+				Coverage: []v1alpha1.CacheNodeTypeCoverage{
+					{CacheNodeType: "cache.r6g.large", ProductDescription: "redis", ReservedNodes: 3, RunningNodes: 1},
+				},
+			},
+		},
+		"Empty": {
+			want: v1alpha1.ReservedCacheNodeCoverageObservation{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateReservedCacheNodeCoverageObservation(tc.args.p, tc.args.reserved, tc.args.clusters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListCacheClusters(t *testing.T) {
+	c := &fake.MockReservationClient{
+		MockDescribeCacheClusters: func(_ context.Context, i *awscache.DescribeCacheClustersInput, _ []func(*awscache.Options)) (*awscache.DescribeCacheClustersOutput, error) {
+			if i.Marker == nil {
+				return &awscache.DescribeCacheClustersOutput{
+					CacheClusters: []awscachetypes.CacheCluster{{CacheClusterId: aws.String("a")}},
+					Marker:        aws.String("next"),
+				}, nil
+			}
+			return &awscache.DescribeCacheClustersOutput{
+				CacheClusters: []awscachetypes.CacheCluster{{CacheClusterId: aws.String("b")}},
+			}, nil
+		},
+	}
+	got, err := ListCacheClusters(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListCacheClusters(...): unexpected error: %v", err)
+	}
+	if len(got) != 2 || aws.ToString(got[1].CacheClusterId) != "b" {
+		t.Errorf("ListCacheClusters(...): want clusters of both pages, got %d", len(got))
+	}
+}
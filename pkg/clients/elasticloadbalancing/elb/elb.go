@@ -30,6 +30,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 	clients "github.com/crossplane/provider-aws/pkg/clients"
@@ -126,6 +127,20 @@ func IsELBNotFound(err error) bool {
 	return errors.As(err, &apnf)
 }
 
+// GetConnectionDetails returns the DNS name and the Route 53 hosted zone ID
+// of the load balancer, which is all a Route 53 alias record needs to target
+// it. Classic load balancers have no ARN.
+func GetConnectionDetails(e elbtypes.LoadBalancerDescription) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if e.DNSName != nil {
+		conn[v1alpha1.ResourceCredentialsSecretDNSNameKey] = []byte(aws.ToString(e.DNSName))
+	}
+	if e.CanonicalHostedZoneNameID != nil {
+		conn[v1alpha1.ResourceCredentialsSecretHostedZoneIDKey] = []byte(aws.ToString(e.CanonicalHostedZoneNameID))
+	}
+	return conn
+}
+
 // GenerateELBObservation is used to produce v1alpha1.ELBObservation from
 // elasticLoadBalancing.LoadBalancerDescription.
 func GenerateELBObservation(e elbtypes.LoadBalancerDescription) v1alpha1.ELBObservation {
@@ -27,6 +27,8 @@ import (
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing/types"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
 	"github.com/crossplane/provider-aws/apis/elasticloadbalancing/v1alpha1"
 )
 
@@ -153,6 +155,36 @@ func TestLateInitializeELB(t *testing.T) {
 	}
 }
 
+func TestGetConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		in   elbtypes.LoadBalancerDescription
+		want managed.ConnectionDetails
+	}{
+		"Empty": {
+			want: managed.ConnectionDetails{},
+		},
+		"DNSNameAndHostedZone": {
+			in: elbtypes.LoadBalancerDescription{
+				DNSName:                   aws.String("some-elb-123.us-east-1.elb.amazonaws.com"),
+				CanonicalHostedZoneNameID: aws.String("Z35SXDOTRQ7X7K"),
+			},
+			want: managed.ConnectionDetails{
+				v1alpha1.ResourceCredentialsSecretDNSNameKey:      []byte("some-elb-123.us-east-1.elb.amazonaws.com"),
+				v1alpha1.ResourceCredentialsSecretHostedZoneIDKey: []byte("Z35SXDOTRQ7X7K"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetConnectionDetails(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetConnectionDetails(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateCreateRoleInput(t *testing.T) {
 	cases := map[string]struct {
 		in  v1alpha1.ELBParameters
@@ -0,0 +1,285 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags = "cannot list tags"
+	errTag      = "cannot tag resource"
+	errUntag    = "cannot untag resource"
+)
+
+// Client defines the EventBridge operations used by the event bus, rule and
+// target controllers.
+type Client interface {
+	CreateEventBusWithContext(context.Context, *awseventbridge.CreateEventBusInput, ...request.Option) (*awseventbridge.CreateEventBusOutput, error)
+	DescribeEventBusWithContext(context.Context, *awseventbridge.DescribeEventBusInput, ...request.Option) (*awseventbridge.DescribeEventBusOutput, error)
+	DeleteEventBusWithContext(context.Context, *awseventbridge.DeleteEventBusInput, ...request.Option) (*awseventbridge.DeleteEventBusOutput, error)
+
+	PutRuleWithContext(context.Context, *awseventbridge.PutRuleInput, ...request.Option) (*awseventbridge.PutRuleOutput, error)
+	DescribeRuleWithContext(context.Context, *awseventbridge.DescribeRuleInput, ...request.Option) (*awseventbridge.DescribeRuleOutput, error)
+	DeleteRuleWithContext(context.Context, *awseventbridge.DeleteRuleInput, ...request.Option) (*awseventbridge.DeleteRuleOutput, error)
+
+	PutTargetsWithContext(context.Context, *awseventbridge.PutTargetsInput, ...request.Option) (*awseventbridge.PutTargetsOutput, error)
+	ListTargetsByRuleWithContext(context.Context, *awseventbridge.ListTargetsByRuleInput, ...request.Option) (*awseventbridge.ListTargetsByRuleOutput, error)
+	RemoveTargetsWithContext(context.Context, *awseventbridge.RemoveTargetsInput, ...request.Option) (*awseventbridge.RemoveTargetsOutput, error)
+
+	ListTagsForResourceWithContext(context.Context, *awseventbridge.ListTagsForResourceInput, ...request.Option) (*awseventbridge.ListTagsForResourceOutput, error)
+	TagResourceWithContext(context.Context, *awseventbridge.TagResourceInput, ...request.Option) (*awseventbridge.TagResourceOutput, error)
+	UntagResourceWithContext(context.Context, *awseventbridge.UntagResourceInput, ...request.Option) (*awseventbridge.UntagResourceOutput, error)
+}
+
+// NewClient returns a new EventBridge client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awseventbridge.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the event bus, rule
+// or target does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awseventbridge.ErrCodeResourceNotFoundException
+}
+
+// GenerateRuleInput returns the input that creates or updates the rule with
+// the given name and parameters. Tags are left out since PutRule only
+// applies them when the rule is created.
+func GenerateRuleInput(name string, p v1alpha1.RuleParameters) *awseventbridge.PutRuleInput {
+	return &awseventbridge.PutRuleInput{
+		Name:               awsclient.String(name),
+		EventBusName:       p.EventBusName,
+		Description:        p.Description,
+		EventPattern:       p.EventPattern,
+		ScheduleExpression: p.ScheduleExpression,
+		State:              p.State,
+		RoleArn:            p.RoleARN,
+	}
+}
+
+// IsRuleUpToDate returns true if the observed rule matches the given
+// parameters. Event patterns are compared as JSON documents in which the
+// order of the values a field may match is irrelevant, and schedule
+// expressions are compared regardless of case and whitespace.
+func IsRuleUpToDate(p v1alpha1.RuleParameters, obs *awseventbridge.DescribeRuleOutput) bool {
+	state := awsclient.StringValue(p.State)
+	if state == "" {
+		state = awseventbridge.RuleStateEnabled
+	}
+	switch {
+	case awsclient.StringValue(p.Description) != awsclient.StringValue(obs.Description),
+		state != awsclient.StringValue(obs.State),
+		awsclient.StringValue(p.RoleARN) != awsclient.StringValue(obs.RoleArn),
+		!IsEventPatternEqual(p.EventPattern, obs.EventPattern),
+		!IsScheduleExpressionEqual(p.ScheduleExpression, obs.ScheduleExpression):
+		return false
+	}
+	return true
+}
+
+// IsEventPatternEqual returns true if the given event patterns are
+// semantically equal.
+func IsEventPatternEqual(a, b *string) bool {
+	if awsclient.StringValue(a) == "" || awsclient.StringValue(b) == "" {
+		return awsclient.StringValue(a) == awsclient.StringValue(b)
+	}
+	return awsclient.IsPolicyUpToDate(a, b)
+}
+
+var whitespace = regexp.MustCompile(`\s+`)
+
+// IsScheduleExpressionEqual returns true if the given schedule expressions
+// are semantically equal.
+func IsScheduleExpressionEqual(a, b *string) bool {
+	return normalizeScheduleExpression(awsclient.StringValue(a)) == normalizeScheduleExpression(awsclient.StringValue(b))
+}
+
+func normalizeScheduleExpression(s string) string {
+	s = whitespace.ReplaceAllString(strings.TrimSpace(s), " ")
+	s = strings.ReplaceAll(strings.ReplaceAll(s, "( ", "("), " )", ")")
+	return strings.ToLower(s)
+}
+
+// GenerateTarget returns the EventBridge target with the given ID and
+// parameters.
+func GenerateTarget(id string, p v1alpha1.TargetParameters) *awseventbridge.Target {
+	t := &awseventbridge.Target{
+		Id:        awsclient.String(id),
+		Arn:       p.ARN,
+		RoleArn:   p.RoleARN,
+		Input:     p.Input,
+		InputPath: p.InputPath,
+	}
+	if p.InputTransformer != nil {
+		t.InputTransformer = &awseventbridge.InputTransformer{
+			InputPathsMap: aws.StringMap(p.InputTransformer.InputPathsMap),
+			InputTemplate: awsclient.String(p.InputTransformer.InputTemplate),
+		}
+	}
+	if p.DeadLetterConfig != nil {
+		t.DeadLetterConfig = &awseventbridge.DeadLetterConfig{Arn: p.DeadLetterConfig.ARN}
+	}
+	if p.RetryPolicy != nil {
+		t.RetryPolicy = &awseventbridge.RetryPolicy{
+			MaximumEventAgeInSeconds: p.RetryPolicy.MaximumEventAgeInSeconds,
+			MaximumRetryAttempts:     p.RetryPolicy.MaximumRetryAttempts,
+		}
+	}
+	if p.SQSParameters != nil {
+		t.SqsParameters = &awseventbridge.SqsParameters{MessageGroupId: p.SQSParameters.MessageGroupID}
+	}
+	return t
+}
+
+// IsTargetUpToDate returns true if the observed target matches the given
+// parameters. Inputs are compared as JSON documents.
+func IsTargetUpToDate(id string, p v1alpha1.TargetParameters, obs *awseventbridge.Target) bool {
+	desired := GenerateTarget(id, p)
+	if !isJSONEqual(desired.Input, obs.Input) {
+		return false
+	}
+	return cmp.Equal(desired, obs, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(awseventbridge.Target{}, "Input"),
+		cmpopts.IgnoreUnexported(awseventbridge.Target{}, awseventbridge.InputTransformer{}, awseventbridge.DeadLetterConfig{},
+			awseventbridge.RetryPolicy{}, awseventbridge.SqsParameters{}, awseventbridge.BatchParameters{},
+			awseventbridge.EcsParameters{}, awseventbridge.HttpParameters{}, awseventbridge.KinesisParameters{},
+			awseventbridge.RedshiftDataParameters{}, awseventbridge.RunCommandParameters{},
+			awseventbridge.SageMakerPipelineParameters{}))
+}
+
+func isJSONEqual(a, b *string) bool {
+	if awsclient.StringValue(a) == awsclient.StringValue(b) {
+		return true
+	}
+	var x, y interface{}
+	if json.Unmarshal([]byte(awsclient.StringValue(a)), &x) != nil || json.Unmarshal([]byte(awsclient.StringValue(b)), &y) != nil {
+		return false
+	}
+	return cmp.Equal(x, y)
+}
+
+// FindTarget returns the target with the given ID of the given rule, or nil
+// if the rule has no such target.
+func FindTarget(ctx context.Context, c Client, rule, eventBus *string, id string) (*awseventbridge.Target, error) {
+	input := &awseventbridge.ListTargetsByRuleInput{Rule: rule, EventBusName: eventBus}
+	for {
+		out, err := c.ListTargetsByRuleWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range out.Targets {
+			if awsclient.StringValue(t.Id) == id {
+				return t, nil
+			}
+		}
+		if awsclient.StringValue(out.NextToken) == "" {
+			return nil, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// DiffTags returns the tags that need to be added to and removed from a
+// resource with the observed tags to match the desired ones. Tags whose
+// value changed are both removed and added, so removals must be applied
+// first.
+func DiffTags(desired []v1alpha1.Tag, observed []*awseventbridge.Tag) ([]*awseventbridge.Tag, []*string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	add, remove := awsclient.DiffTags(local, remote)
+	var addTags []*awseventbridge.Tag
+	for k, v := range add {
+		addTags = append(addTags, &awseventbridge.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	}
+	sort.Slice(addTags, func(i, j int) bool {
+		return *addTags[i].Key < *addTags[j].Key
+	})
+	sort.Strings(remove)
+	removeKeys := make([]*string, len(remove))
+	for i := range remove {
+		removeKeys[i] = awsclient.String(remove[i])
+	}
+	return addTags, removeKeys
+}
+
+// GenerateTags returns the given tags in the form EventBridge accepts them.
+func GenerateTags(tags []v1alpha1.Tag) []*awseventbridge.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awseventbridge.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awseventbridge.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return res
+}
+
+// IsTagsUpToDate returns true if the resource with the given ARN has the
+// desired tags.
+func IsTagsUpToDate(ctx context.Context, c Client, arn string, desired []v1alpha1.Tag) (bool, error) {
+	tags, err := c.ListTagsForResourceWithContext(ctx, &awseventbridge.ListTagsForResourceInput{ResourceARN: &arn})
+	if err != nil {
+		return false, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := DiffTags(desired, tags.Tags)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+// UpdateTags makes the tags of the resource with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, c Client, arn string, desired []v1alpha1.Tag) error {
+	tags, err := c.ListTagsForResourceWithContext(ctx, &awseventbridge.ListTagsForResourceInput{ResourceARN: &arn})
+	if err != nil {
+		return awsclient.Wrap(err, errListTags)
+	}
+	add, remove := DiffTags(desired, tags.Tags)
+	if len(remove) > 0 {
+		if _, err := c.UntagResourceWithContext(ctx, &awseventbridge.UntagResourceInput{ResourceARN: &arn, TagKeys: remove}); err != nil {
+			return awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := c.TagResourceWithContext(ctx, &awseventbridge.TagResourceInput{ResourceARN: &arn, Tags: add}); err != nil {
+			return awsclient.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbridge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge/fake"
+)
+
+var (
+	targetID = "target"
+	function = "arn:aws:lambda:us-east-1:123456789012:function:fn"
+	errBoom  = errors.New("boom")
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"ResourceNotFound": {
+			err:  errors.Wrap(awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil), "wrapped"),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New(awseventbridge.ErrCodeResourceAlreadyExistsException, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errBoom,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEventPatternEqual(t *testing.T) {
+	cases := map[string]struct {
+		a, b *string
+		want bool
+	}{
+		"BothEmpty": {
+			want: true,
+		},
+		"OneEmpty": {
+			a:    aws.String(`{"source":["aws.ec2"]}`),
+			want: false,
+		},
+		"ReorderedAndReformatted": {
+			a:    aws.String(`{"source":["aws.ec2"],"detail-type":["A","B"]}`),
+			b:    aws.String("{\n  \"detail-type\": [\"B\", \"A\"],\n  \"source\": [\"aws.ec2\"]\n}"),
+			want: true,
+		},
+		"DifferentValues": {
+			a:    aws.String(`{"source":["aws.ec2"]}`),
+			b:    aws.String(`{"source":["aws.s3"]}`),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsEventPatternEqual(tc.a, tc.b)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsScheduleExpressionEqual(t *testing.T) {
+	cases := map[string]struct {
+		a, b *string
+		want bool
+	}{
+		"BothEmpty": {
+			want: true,
+		},
+		"Whitespace": {
+			a:    aws.String("cron(0  20 * * ? *)"),
+			b:    aws.String("cron( 0 20 * * ? * )"),
+			want: true,
+		},
+		"Case": {
+			a:    aws.String("Rate(5 Minutes)"),
+			b:    aws.String("rate(5 minutes)"),
+			want: true,
+		},
+		"Different": {
+			a:    aws.String("rate(5 minutes)"),
+			b:    aws.String("rate(10 minutes)"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsScheduleExpressionEqual(tc.a, tc.b)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRuleUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.RuleParameters
+		obs  *awseventbridge.DescribeRuleOutput
+		want bool
+	}{
+		"DefaultState": {
+			p:    v1alpha1.RuleParameters{ScheduleExpression: aws.String("rate(1 hour)")},
+			obs:  &awseventbridge.DescribeRuleOutput{ScheduleExpression: aws.String("rate(1 hour)"), State: aws.String(awseventbridge.RuleStateEnabled)},
+			want: true,
+		},
+		"Disabled": {
+			p:    v1alpha1.RuleParameters{ScheduleExpression: aws.String("rate(1 hour)"), State: aws.String(awseventbridge.RuleStateDisabled)},
+			obs:  &awseventbridge.DescribeRuleOutput{ScheduleExpression: aws.String("rate(1 hour)"), State: aws.String(awseventbridge.RuleStateEnabled)},
+			want: false,
+		},
+		"PatternChanged": {
+			p:    v1alpha1.RuleParameters{EventPattern: aws.String(`{"source":["aws.s3"]}`)},
+			obs:  &awseventbridge.DescribeRuleOutput{EventPattern: aws.String(`{"source":["aws.ec2"]}`), State: aws.String(awseventbridge.RuleStateEnabled)},
+			want: false,
+		},
+		"DescriptionChanged": {
+			p:    v1alpha1.RuleParameters{Description: aws.String("new"), ScheduleExpression: aws.String("rate(1 hour)")},
+			obs:  &awseventbridge.DescribeRuleOutput{ScheduleExpression: aws.String("rate(1 hour)"), State: aws.String(awseventbridge.RuleStateEnabled)},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsRuleUpToDate(tc.p, tc.obs)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsTargetUpToDate(t *testing.T) {
+	obs := &awseventbridge.Target{
+		Id:          &targetID,
+		Arn:         &function,
+		Input:       aws.String(`{"b": 2, "a": 1}`),
+		RetryPolicy: &awseventbridge.RetryPolicy{MaximumRetryAttempts: aws.Int64(3)},
+	}
+	cases := map[string]struct {
+		p    v1alpha1.TargetParameters
+		want bool
+	}{
+		"UpToDate": {
+			p: v1alpha1.TargetParameters{
+				ARN:         &function,
+				Input:       aws.String(`{"a":1,"b":2}`),
+				RetryPolicy: &v1alpha1.RetryPolicy{MaximumRetryAttempts: aws.Int64(3)},
+			},
+			want: true,
+		},
+		"InputChanged": {
+			p: v1alpha1.TargetParameters{
+				ARN:         &function,
+				Input:       aws.String(`{"a":2,"b":2}`),
+				RetryPolicy: &v1alpha1.RetryPolicy{MaximumRetryAttempts: aws.Int64(3)},
+			},
+			want: false,
+		},
+		"RetryPolicyRemoved": {
+			p: v1alpha1.TargetParameters{
+				ARN:   &function,
+				Input: aws.String(`{"a":1,"b":2}`),
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsTargetUpToDate(targetID, tc.p, obs)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindTarget(t *testing.T) {
+	other := &awseventbridge.Target{Id: aws.String("other"), Arn: &function}
+	mine := &awseventbridge.Target{Id: &targetID, Arn: &function}
+	pages := map[string]*awseventbridge.ListTargetsByRuleOutput{
+		"":     {Targets: []*awseventbridge.Target{other}, NextToken: aws.String("next")},
+		"next": {Targets: []*awseventbridge.Target{mine}},
+	}
+	type want struct {
+		target *awseventbridge.Target
+		err    error
+	}
+	cases := map[string]struct {
+		c    *fake.MockClient
+		id   string
+		want want
+	}{
+		"FoundOnSecondPage": {
+			c: &fake.MockClient{
+				MockListTargetsByRule: func(_ context.Context, in *awseventbridge.ListTargetsByRuleInput, _ []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+					return pages[aws.StringValue(in.NextToken)], nil
+				},
+			},
+			id:   targetID,
+			want: want{target: mine},
+		},
+		"NotFound": {
+			c: &fake.MockClient{
+				MockListTargetsByRule: func(_ context.Context, in *awseventbridge.ListTargetsByRuleInput, _ []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+					return pages[aws.StringValue(in.NextToken)], nil
+				},
+			},
+			id: "missing",
+		},
+		"Failed": {
+			c: &fake.MockClient{
+				MockListTargetsByRule: func(context.Context, *awseventbridge.ListTargetsByRuleInput, []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+					return nil, errBoom
+				},
+			},
+			id:   targetID,
+			want: want{err: errBoom},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindTarget(context.Background(), tc.c, aws.String("rule"), nil, tc.id)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.target, got, cmpopts.IgnoreUnexported(awseventbridge.Target{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
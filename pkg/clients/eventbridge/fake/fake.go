@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+)
+
+// MockClient is a type that implements all the methods for the EventBridge
+// Client interface.
+type MockClient struct {
+	MockCreateEventBus      func(ctx context.Context, input *eventbridge.CreateEventBusInput, opts []request.Option) (*eventbridge.CreateEventBusOutput, error)
+	MockDescribeEventBus    func(ctx context.Context, input *eventbridge.DescribeEventBusInput, opts []request.Option) (*eventbridge.DescribeEventBusOutput, error)
+	MockDeleteEventBus      func(ctx context.Context, input *eventbridge.DeleteEventBusInput, opts []request.Option) (*eventbridge.DeleteEventBusOutput, error)
+	MockPutRule             func(ctx context.Context, input *eventbridge.PutRuleInput, opts []request.Option) (*eventbridge.PutRuleOutput, error)
+	MockDescribeRule        func(ctx context.Context, input *eventbridge.DescribeRuleInput, opts []request.Option) (*eventbridge.DescribeRuleOutput, error)
+	MockDeleteRule          func(ctx context.Context, input *eventbridge.DeleteRuleInput, opts []request.Option) (*eventbridge.DeleteRuleOutput, error)
+	MockPutTargets          func(ctx context.Context, input *eventbridge.PutTargetsInput, opts []request.Option) (*eventbridge.PutTargetsOutput, error)
+	MockListTargetsByRule   func(ctx context.Context, input *eventbridge.ListTargetsByRuleInput, opts []request.Option) (*eventbridge.ListTargetsByRuleOutput, error)
+	MockRemoveTargets       func(ctx context.Context, input *eventbridge.RemoveTargetsInput, opts []request.Option) (*eventbridge.RemoveTargetsOutput, error)
+	MockListTagsForResource func(ctx context.Context, input *eventbridge.ListTagsForResourceInput, opts []request.Option) (*eventbridge.ListTagsForResourceOutput, error)
+	MockTagResource         func(ctx context.Context, input *eventbridge.TagResourceInput, opts []request.Option) (*eventbridge.TagResourceOutput, error)
+	MockUntagResource       func(ctx context.Context, input *eventbridge.UntagResourceInput, opts []request.Option) (*eventbridge.UntagResourceOutput, error)
+}
+
+// CreateEventBusWithContext mocks CreateEventBusWithContext method
+func (m *MockClient) CreateEventBusWithContext(ctx context.Context, input *eventbridge.CreateEventBusInput, opts ...request.Option) (*eventbridge.CreateEventBusOutput, error) {
+	return m.MockCreateEventBus(ctx, input, opts)
+}
+
+// DescribeEventBusWithContext mocks DescribeEventBusWithContext method
+func (m *MockClient) DescribeEventBusWithContext(ctx context.Context, input *eventbridge.DescribeEventBusInput, opts ...request.Option) (*eventbridge.DescribeEventBusOutput, error) {
+	return m.MockDescribeEventBus(ctx, input, opts)
+}
+
+// DeleteEventBusWithContext mocks DeleteEventBusWithContext method
+func (m *MockClient) DeleteEventBusWithContext(ctx context.Context, input *eventbridge.DeleteEventBusInput, opts ...request.Option) (*eventbridge.DeleteEventBusOutput, error) {
+	return m.MockDeleteEventBus(ctx, input, opts)
+}
+
+// PutRuleWithContext mocks PutRuleWithContext method
+func (m *MockClient) PutRuleWithContext(ctx context.Context, input *eventbridge.PutRuleInput, opts ...request.Option) (*eventbridge.PutRuleOutput, error) {
+	return m.MockPutRule(ctx, input, opts)
+}
+
+// DescribeRuleWithContext mocks DescribeRuleWithContext method
+func (m *MockClient) DescribeRuleWithContext(ctx context.Context, input *eventbridge.DescribeRuleInput, opts ...request.Option) (*eventbridge.DescribeRuleOutput, error) {
+	return m.MockDescribeRule(ctx, input, opts)
+}
+
+// DeleteRuleWithContext mocks DeleteRuleWithContext method
+func (m *MockClient) DeleteRuleWithContext(ctx context.Context, input *eventbridge.DeleteRuleInput, opts ...request.Option) (*eventbridge.DeleteRuleOutput, error) {
+	return m.MockDeleteRule(ctx, input, opts)
+}
+
+// PutTargetsWithContext mocks PutTargetsWithContext method
+func (m *MockClient) PutTargetsWithContext(ctx context.Context, input *eventbridge.PutTargetsInput, opts ...request.Option) (*eventbridge.PutTargetsOutput, error) {
+	return m.MockPutTargets(ctx, input, opts)
+}
+
+// ListTargetsByRuleWithContext mocks ListTargetsByRuleWithContext method
+func (m *MockClient) ListTargetsByRuleWithContext(ctx context.Context, input *eventbridge.ListTargetsByRuleInput, opts ...request.Option) (*eventbridge.ListTargetsByRuleOutput, error) {
+	return m.MockListTargetsByRule(ctx, input, opts)
+}
+
+// RemoveTargetsWithContext mocks RemoveTargetsWithContext method
+func (m *MockClient) RemoveTargetsWithContext(ctx context.Context, input *eventbridge.RemoveTargetsInput, opts ...request.Option) (*eventbridge.RemoveTargetsOutput, error) {
+	return m.MockRemoveTargets(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *eventbridge.ListTagsForResourceInput, opts ...request.Option) (*eventbridge.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResource(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *eventbridge.TagResourceInput, opts ...request.Option) (*eventbridge.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *eventbridge.UntagResourceInput, opts ...request.Option) (*eventbridge.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalname normalizes the external names of managed resources to
+// the canonical format of their kind before they are reconciled.
+//
+// Depending on the kind, the external name of a managed resource is the name,
+// the ID or the ARN of its external resource, or an ID composed of the
+// identifiers of other resources. Users importing existing resources often
+// use another identifier of the resource than the one its controller
+// expects, e.g. the ARN of an IAM role rather than its name. Adapters accept
+// these alternative formats and rewrite the external name to the canonical
+// one, so that imports and Compositions behave the same way regardless of
+// the identifier they start with.
+package externalname
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/pkg/clients/dryrun"
+)
+
+const (
+	errFmtFormat    = "external name %q is not in the %s format"
+	errFmtARN       = "%q is not an ARN of an AWS %s resource"
+	errFmtParts     = "expected %d parts separated by %q"
+	errUpdateMR     = "cannot update managed resource with the normalized external name"
+	errFmtNormalize = "cannot normalize part %d"
+)
+
+// An Adapter converts external names to the canonical format of a kind.
+type Adapter interface {
+	// Format describes the canonical format, e.g. <role-name>.
+	Format() string
+
+	// Normalize returns the canonical form of the supplied external name. It
+	// returns an error if the external name is in none of the formats the
+	// adapter accepts.
+	Normalize(name string) (string, error)
+}
+
+type name struct {
+	format   string
+	service  string
+	resource string
+	path     bool
+}
+
+// Name returns an Adapter for kinds whose external name is the name or the
+// ID of their external resource. ARNs of the supplied service and resource
+// type are accepted and converted to the part of their resource that
+// follows the resource type, e.g. ARNs of KMS keys, whose resource is
+// key/<key-id>, are converted to the key ID. Resource types are omitted for
+// services whose ARNs have none, like S3 buckets and SQS queues.
+func Name(format, service, resourceType string) Adapter {
+	return &name{format: format, service: service, resource: resourceType}
+}
+
+// PathName returns an Adapter like Name for resources that have a path, like
+// IAM roles. The path is dropped when ARNs are converted, e.g.
+// arn:aws:iam::123456789012:role/some/path/some-role becomes some-role.
+func PathName(format, service, resourceType string) Adapter {
+	return &name{format: format, service: service, resource: resourceType, path: true}
+}
+
+func (n *name) Format() string {
+	return n.format
+}
+
+func (n *name) Normalize(s string) (string, error) {
+	if !arn.IsARN(s) {
+		return s, nil
+	}
+	a, err := arn.Parse(s)
+	if err != nil || a.Service != n.service {
+		return "", errors.Errorf(errFmtARN, s, n.service)
+	}
+	r := a.Resource
+	if n.resource != "" {
+		switch {
+		case strings.HasPrefix(r, n.resource+"/"):
+			r = strings.TrimPrefix(r, n.resource+"/")
+		case strings.HasPrefix(r, n.resource+":"):
+			r = strings.TrimPrefix(r, n.resource+":")
+		default:
+			return "", errors.Errorf(errFmtARN, s, n.service+" "+n.resource)
+		}
+	}
+	if n.path {
+		r = r[strings.LastIndex(r, "/")+1:]
+	}
+	if r == "" {
+		return "", errors.Errorf(errFmtARN, s, n.service)
+	}
+	return r, nil
+}
+
+type arnAdapter struct {
+	format  string
+	service string
+}
+
+// ARN returns an Adapter for kinds whose external name is the ARN of their
+// external resource. Only ARNs of the supplied service are accepted, since
+// names and IDs can't be converted to ARNs without knowing the account and
+// region of the resource.
+func ARN(format, service string) Adapter {
+	return &arnAdapter{format: format, service: service}
+}
+
+func (a *arnAdapter) Format() string {
+	return a.format
+}
+
+func (a *arnAdapter) Normalize(s string) (string, error) {
+	p, err := arn.Parse(s)
+	if err != nil || p.Service != a.service {
+		return "", errors.Errorf(errFmtARN, s, a.service)
+	}
+	return s, nil
+}
+
+type plain struct {
+	format string
+}
+
+// Plain returns an Adapter for kinds whose external name is an identifier
+// that doesn't appear in any ARN, like the names of Cognito user pool groups
+// or the version numbers of EC2 launch templates. External names are kept as
+// they are, but ARNs are rejected since they are never valid identifiers of
+// these kinds.
+func Plain(format string) Adapter {
+	return &plain{format: format}
+}
+
+func (p *plain) Format() string {
+	return p.format
+}
+
+func (p *plain) Normalize(s string) (string, error) {
+	if arn.IsARN(s) {
+		return "", errors.Errorf(errFmtFormat, s, p.format)
+	}
+	return s, nil
+}
+
+type composite struct {
+	sep   string
+	parts []Adapter
+}
+
+// Composite returns an Adapter for kinds whose external name is composed of
+// the identifiers of other resources, joined by the supplied separator. Each
+// part is normalized by the corresponding Adapter. The last part may
+// contain the separator, e.g. <group-name>/<policy-arn>.
+func Composite(sep string, parts ...Adapter) Adapter {
+	return &composite{sep: sep, parts: parts}
+}
+
+func (c *composite) Format() string {
+	f := make([]string, len(c.parts))
+	for i, p := range c.parts {
+		f[i] = p.Format()
+	}
+	return strings.Join(f, c.sep)
+}
+
+func (c *composite) Normalize(s string) (string, error) {
+	in := strings.SplitN(s, c.sep, len(c.parts))
+	if len(in) != len(c.parts) {
+		return "", errors.Errorf(errFmtParts, len(c.parts), c.sep)
+	}
+	out := make([]string, len(in))
+	for i, p := range c.parts {
+		n, err := p.Normalize(in[i])
+		if err != nil {
+			return "", errors.Wrapf(err, errFmtNormalize, i)
+		}
+		out[i] = n
+	}
+	return strings.Join(out, c.sep), nil
+}
+
+// A Connecter normalizes the external name of managed resources before it
+// connects to their external resources.
+type Connecter struct {
+	managed.ExternalConnecter
+	kube    client.Client
+	adapter Adapter
+}
+
+// NewConnecter returns a Connecter that normalizes external names with the
+// supplied Adapter before calling the supplied ExternalConnecter.
+func NewConnecter(kube client.Client, a Adapter, c managed.ExternalConnecter) *Connecter {
+	return &Connecter{ExternalConnecter: c, kube: kube, adapter: a}
+}
+
+// Connect normalizes the external name of the supplied managed resource and
+// stores it if it changed, then calls the wrapped ExternalConnecter. Managed
+// resources whose external name is not set yet are left as they are. The
+// normalized external name of managed resources that are reconciled in
+// dry-run mode is only used, not stored.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	en := meta.GetExternalName(mg)
+	if en == "" {
+		return c.ExternalConnecter.Connect(ctx, mg)
+	}
+	n, err := c.adapter.Normalize(en)
+	if err != nil {
+		return nil, errors.Wrapf(err, errFmtFormat, en, c.adapter.Format())
+	}
+	if n != en {
+		meta.SetExternalName(mg, n)
+		if dryrun.IsDryRun(mg) {
+			return c.ExternalConnecter.Connect(ctx, mg)
+		}
+		if err := c.kube.Update(ctx, mg); err != nil {
+			return nil, errors.Wrap(err, errUpdateMR)
+		}
+	}
+	return c.ExternalConnecter.Connect(ctx, mg)
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalname
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/pkg/clients/dryrun"
+)
+
+func TestNormalize(t *testing.T) {
+	role := PathName("<role-name>", "iam", "role")
+	policy := ARN("<policy-arn>", "iam")
+
+	type want struct {
+		name string
+		err  bool
+	}
+	cases := map[string]struct {
+		adapter Adapter
+		name    string
+		want    want
+	}{
+		"Name": {
+			adapter: role,
+			name:    "some-role",
+			want:    want{name: "some-role"},
+		},
+		"ARNWithPath": {
+			adapter: role,
+			name:    "arn:aws:iam::123456789012:role/some/path/some-role",
+			want:    want{name: "some-role"},
+		},
+		"ARNOfOtherResourceType": {
+			adapter: role,
+			name:    "arn:aws:iam::123456789012:user/some-user",
+			want:    want{err: true},
+		},
+		"ARNOfOtherService": {
+			adapter: role,
+			name:    "arn:aws:sts::123456789012:assumed-role/some-role/session",
+			want:    want{err: true},
+		},
+		"KeyARN": {
+			adapter: Name("<key-id>", "kms", "key"),
+			name:    "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			want:    want{name: "1234abcd-12ab-34cd-56ef-1234567890ab"},
+		},
+		"RepositoryARNKeepsNamespace": {
+			adapter: Name("<repository-name>", "ecr", "repository"),
+			name:    "arn:aws:ecr:us-east-1:123456789012:repository/team/app",
+			want:    want{name: "team/app"},
+		},
+		"ARNWithoutResourceType": {
+			adapter: Name("<bucket-name>", "s3", ""),
+			name:    "arn:aws:s3:::some-bucket",
+			want:    want{name: "some-bucket"},
+		},
+		"ARN": {
+			adapter: policy,
+			name:    "arn:aws:iam::aws:policy/ReadOnlyAccess",
+			want:    want{name: "arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		},
+		"NotAnARN": {
+			adapter: policy,
+			name:    "ReadOnlyAccess",
+			want:    want{err: true},
+		},
+		"Plain": {
+			adapter: Plain("<version-number>"),
+			name:    "3",
+			want:    want{name: "3"},
+		},
+		"PlainRejectsARN": {
+			adapter: Plain("<group-name>"),
+			name:    "arn:aws:cognito-idp:us-east-1:123456789012:userpool/us-east-1_abc",
+			want:    want{err: true},
+		},
+		"Composite": {
+			adapter: Composite("/", PathName("<group-name>", "iam", "group"), policy),
+			name:    "some-group/arn:aws:iam::aws:policy/ReadOnlyAccess",
+			want:    want{name: "some-group/arn:aws:iam::aws:policy/ReadOnlyAccess"},
+		},
+		"CompositeMissingPart": {
+			adapter: Composite("/", PathName("<group-name>", "iam", "group"), policy),
+			name:    "some-group",
+			want:    want{err: true},
+		},
+		"CompositeInvalidPart": {
+			adapter: Composite("/", PathName("<group-name>", "iam", "group"), policy),
+			name:    "some-group/ReadOnlyAccess",
+			want:    want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.adapter.Normalize(tc.name)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("Normalize(...): want error %t, got %v", tc.want.err, err)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("Normalize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComposite(t *testing.T) {
+	c := Composite("/", PathName("<group-name>", "iam", "group"), ARN("<policy-arn>", "iam"))
+	if diff := cmp.Diff("<group-name>/<policy-arn>", c.Format()); diff != "" {
+		t.Errorf("Format(): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	errBoom := errors.New("boom")
+	connected := managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+		return &managed.ExternalClientFns{}, nil
+	})
+
+	type want struct {
+		name    string
+		updated bool
+		err     error
+	}
+	cases := map[string]struct {
+		name   string
+		dryRun bool
+		kube   client.Client
+		want   want
+	}{
+		"NotSet": {
+			kube: &test.MockClient{},
+		},
+		"Canonical": {
+			name: "some-role",
+			kube: &test.MockClient{},
+			want: want{name: "some-role"},
+		},
+		"Normalized": {
+			name: "arn:aws:iam::123456789012:role/some-role",
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			want: want{name: "some-role", updated: true},
+		},
+		"NormalizedDryRun": {
+			name:   "arn:aws:iam::123456789012:role/some-role",
+			dryRun: true,
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want:   want{name: "some-role"},
+		},
+		"Invalid": {
+			name: "arn:aws:iam::123456789012:user/some-user",
+			kube: &test.MockClient{},
+			want: want{
+				name: "arn:aws:iam::123456789012:user/some-user",
+				err:  errors.Wrapf(errors.Errorf(errFmtARN, "arn:aws:iam::123456789012:user/some-user", "iam role"), errFmtFormat, "arn:aws:iam::123456789012:user/some-user", "<role-name>"),
+			},
+		},
+		"UpdateFailed": {
+			name: "arn:aws:iam::123456789012:role/some-role",
+			kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+			want: want{
+				name:    "some-role",
+				updated: true,
+				err:     errors.Wrap(errBoom, errUpdateMR),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			if tc.name != "" {
+				meta.SetExternalName(mg, tc.name)
+			}
+			if tc.dryRun {
+				meta.AddAnnotations(mg, map[string]string{dryrun.AnnotationKey: "true"})
+			}
+			updated := false
+			kube := tc.kube
+			if m, ok := kube.(*test.MockClient); ok && m.MockUpdate != nil {
+				update := m.MockUpdate
+				m.MockUpdate = func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+					updated = true
+					return update(ctx, obj, opts...)
+				}
+			}
+			c := NewConnecter(kube, PathName("<role-name>", "iam", "role"), connected)
+			_, err := c.Connect(context.Background(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Connect(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.name, meta.GetExternalName(mg)); diff != "" {
+				t.Errorf("Connect(...): -want external name, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Connect(...): -want updated, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/glue"
+)
+
+// MockClient is a type that implements all the methods for the Glue Client
+// interface.
+type MockClient struct {
+	MockGetTable    func(ctx context.Context, input *glue.GetTableInput, opts []request.Option) (*glue.GetTableOutput, error)
+	MockCreateTable func(ctx context.Context, input *glue.CreateTableInput, opts []request.Option) (*glue.CreateTableOutput, error)
+	MockUpdateTable func(ctx context.Context, input *glue.UpdateTableInput, opts []request.Option) (*glue.UpdateTableOutput, error)
+	MockDeleteTable func(ctx context.Context, input *glue.DeleteTableInput, opts []request.Option) (*glue.DeleteTableOutput, error)
+}
+
+// GetTableWithContext mocks GetTableWithContext method
+func (m *MockClient) GetTableWithContext(ctx context.Context, input *glue.GetTableInput, opts ...request.Option) (*glue.GetTableOutput, error) {
+	return m.MockGetTable(ctx, input, opts)
+}
+
+// CreateTableWithContext mocks CreateTableWithContext method
+func (m *MockClient) CreateTableWithContext(ctx context.Context, input *glue.CreateTableInput, opts ...request.Option) (*glue.CreateTableOutput, error) {
+	return m.MockCreateTable(ctx, input, opts)
+}
+
+// UpdateTableWithContext mocks UpdateTableWithContext method
+func (m *MockClient) UpdateTableWithContext(ctx context.Context, input *glue.UpdateTableInput, opts ...request.Option) (*glue.UpdateTableOutput, error) {
+	return m.MockUpdateTable(ctx, input, opts)
+}
+
+// DeleteTableWithContext mocks DeleteTableWithContext method
+func (m *MockClient) DeleteTableWithContext(ctx context.Context, input *glue.DeleteTableInput, opts ...request.Option) (*glue.DeleteTableOutput, error) {
+	return m.MockDeleteTable(ctx, input, opts)
+}
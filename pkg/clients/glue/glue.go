@@ -0,0 +1,233 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsglue "github.com/aws/aws-sdk-go/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the Glue operations used by the Table controller.
+type Client interface {
+	GetTableWithContext(context.Context, *awsglue.GetTableInput, ...request.Option) (*awsglue.GetTableOutput, error)
+	CreateTableWithContext(context.Context, *awsglue.CreateTableInput, ...request.Option) (*awsglue.CreateTableOutput, error)
+	UpdateTableWithContext(context.Context, *awsglue.UpdateTableInput, ...request.Option) (*awsglue.UpdateTableOutput, error)
+	DeleteTableWithContext(context.Context, *awsglue.DeleteTableInput, ...request.Option) (*awsglue.DeleteTableOutput, error)
+}
+
+// NewClient returns a new Glue client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsglue.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the table or its
+// database does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsglue.ErrCodeEntityNotFoundException
+}
+
+// GenerateTableInput returns the definition of the table with the given name
+// and parameters.
+func GenerateTableInput(name string, p v1alpha1.TableParameters) *awsglue.TableInput {
+	in := &awsglue.TableInput{
+		Name:             aws.String(name),
+		Description:      p.Description,
+		Owner:            p.Owner,
+		Parameters:       p.Parameters,
+		PartitionKeys:    generateColumns(p.PartitionKeys),
+		Retention:        p.Retention,
+		TableType:        p.TableType,
+		ViewExpandedText: p.ViewExpandedText,
+		ViewOriginalText: p.ViewOriginalText,
+	}
+	if sd := p.StorageDescriptor; sd != nil {
+		in.StorageDescriptor = &awsglue.StorageDescriptor{
+			Columns:                generateColumns(sd.Columns),
+			Compressed:             sd.Compressed,
+			InputFormat:            sd.InputFormat,
+			Location:               sd.Location,
+			NumberOfBuckets:        sd.NumberOfBuckets,
+			OutputFormat:           sd.OutputFormat,
+			Parameters:             sd.Parameters,
+			StoredAsSubDirectories: sd.StoredAsSubDirectories,
+		}
+		if len(sd.BucketColumns) != 0 {
+			in.StorageDescriptor.BucketColumns = aws.StringSlice(sd.BucketColumns)
+		}
+		if sd.SerDeInfo != nil {
+			in.StorageDescriptor.SerdeInfo = &awsglue.SerDeInfo{
+				Name:                 sd.SerDeInfo.Name,
+				Parameters:           sd.SerDeInfo.Parameters,
+				SerializationLibrary: sd.SerDeInfo.SerializationLibrary,
+			}
+		}
+		for _, c := range sd.SortColumns {
+			in.StorageDescriptor.SortColumns = append(in.StorageDescriptor.SortColumns, &awsglue.Order{
+				Column:    aws.String(c.Column),
+				SortOrder: aws.Int64(c.SortOrder),
+			})
+		}
+	}
+	return in
+}
+
+func generateColumns(cols []v1alpha1.TableColumn) []*awsglue.Column {
+	if len(cols) == 0 {
+		return nil
+	}
+	res := make([]*awsglue.Column, len(cols))
+	for i, c := range cols {
+		res[i] = &awsglue.Column{
+			Name:       aws.String(c.Name),
+			Type:       c.Type,
+			Comment:    c.Comment,
+			Parameters: c.Parameters,
+		}
+	}
+	return res
+}
+
+// GenerateTableParameters returns the parameters that describe the given
+// table. Fields that are not part of the table definition, like the region,
+// are left empty.
+func GenerateTableParameters(t *awsglue.TableData) v1alpha1.TableParameters {
+	p := v1alpha1.TableParameters{
+		CatalogID:        t.CatalogId,
+		DatabaseName:     t.DatabaseName,
+		Description:      t.Description,
+		Owner:            t.Owner,
+		Parameters:       t.Parameters,
+		PartitionKeys:    generateTableColumns(t.PartitionKeys),
+		Retention:        t.Retention,
+		TableType:        t.TableType,
+		ViewExpandedText: t.ViewExpandedText,
+		ViewOriginalText: t.ViewOriginalText,
+	}
+	if sd := t.StorageDescriptor; sd != nil {
+		p.StorageDescriptor = &v1alpha1.TableStorageDescriptor{
+			BucketColumns:          aws.StringValueSlice(sd.BucketColumns),
+			Columns:                generateTableColumns(sd.Columns),
+			Compressed:             sd.Compressed,
+			InputFormat:            sd.InputFormat,
+			Location:               sd.Location,
+			NumberOfBuckets:        sd.NumberOfBuckets,
+			OutputFormat:           sd.OutputFormat,
+			Parameters:             sd.Parameters,
+			StoredAsSubDirectories: sd.StoredAsSubDirectories,
+		}
+		if sd.SerdeInfo != nil {
+			p.StorageDescriptor.SerDeInfo = &v1alpha1.TableSerDeInfo{
+				Name:                 sd.SerdeInfo.Name,
+				Parameters:           sd.SerdeInfo.Parameters,
+				SerializationLibrary: sd.SerdeInfo.SerializationLibrary,
+			}
+		}
+		for _, o := range sd.SortColumns {
+			p.StorageDescriptor.SortColumns = append(p.StorageDescriptor.SortColumns, v1alpha1.TableSortColumn{
+				Column:    aws.StringValue(o.Column),
+				SortOrder: aws.Int64Value(o.SortOrder),
+			})
+		}
+	}
+	return p
+}
+
+func generateTableColumns(cols []*awsglue.Column) []v1alpha1.TableColumn {
+	if len(cols) == 0 {
+		return nil
+	}
+	res := make([]v1alpha1.TableColumn, len(cols))
+	for i, c := range cols {
+		res[i] = v1alpha1.TableColumn{
+			Name:       aws.StringValue(c.Name),
+			Type:       c.Type,
+			Comment:    c.Comment,
+			Parameters: c.Parameters,
+		}
+	}
+	return res
+}
+
+// LateInitializeTable fills the empty fields of the given parameters with
+// the values of the table.
+func LateInitializeTable(p *v1alpha1.TableParameters, t *awsglue.TableData) {
+	if t == nil {
+		return
+	}
+	p.CatalogID = awsclient.LateInitializeStringPtr(p.CatalogID, t.CatalogId)
+	p.Owner = awsclient.LateInitializeStringPtr(p.Owner, t.Owner)
+	p.Retention = awsclient.LateInitializeInt64Ptr(p.Retention, t.Retention)
+	p.TableType = awsclient.LateInitializeStringPtr(p.TableType, t.TableType)
+}
+
+// IsTableUpToDate returns true if the table matches the given parameters.
+// Glue and crawlers add their own table parameters, e.g. the classification
+// of the data, so only the desired ones are compared. The storage descriptor
+// is ignored if none is desired.
+func IsTableUpToDate(p v1alpha1.TableParameters, t *awsglue.TableData) bool {
+	got := GenerateTableParameters(t)
+	got.Parameters = subset(got.Parameters, p.Parameters)
+	switch {
+	case p.StorageDescriptor == nil:
+		got.StorageDescriptor = nil
+	case got.StorageDescriptor != nil:
+		got.StorageDescriptor.Parameters = subset(got.StorageDescriptor.Parameters, p.StorageDescriptor.Parameters)
+	}
+	return cmp.Equal(p, got, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreFields(v1alpha1.TableParameters{}, "Region", "CatalogID", "DatabaseName", "DatabaseNameRef", "DatabaseNameSelector"))
+}
+
+// subset returns the entries of m whose keys are in keys.
+func subset(m, keys map[string]*string) map[string]*string {
+	res := make(map[string]*string, len(keys))
+	for k := range keys {
+		if v, ok := m[k]; ok {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// GenerateTableObservation returns the observation of the given table.
+func GenerateTableObservation(t *awsglue.TableData) v1alpha1.TableObservation {
+	o := v1alpha1.TableObservation{
+		CreatedBy:                     t.CreatedBy,
+		IsRegisteredWithLakeFormation: t.IsRegisteredWithLakeFormation,
+	}
+	if t.CreateTime != nil {
+		ct := metav1.NewTime(*t.CreateTime)
+		o.CreateTime = &ct
+	}
+	if t.UpdateTime != nil {
+		ut := metav1.NewTime(*t.UpdateTime)
+		o.UpdateTime = &ut
+	}
+	return o
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package glue
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsglue "github.com/aws/aws-sdk-go/service/glue"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+)
+
+func tableParameters(m ...func(*v1alpha1.TableParameters)) v1alpha1.TableParameters {
+	p := v1alpha1.TableParameters{
+		TableType:  aws.String("EXTERNAL_TABLE"),
+		Parameters: map[string]*string{"classification": aws.String("parquet")},
+		StorageDescriptor: &v1alpha1.TableStorageDescriptor{
+			Columns:  []v1alpha1.TableColumn{{Name: "id", Type: aws.String("string")}},
+			Location: aws.String("s3://lake/events/"),
+			SerDeInfo: &v1alpha1.TableSerDeInfo{
+				SerializationLibrary: aws.String("org.apache.hadoop.hive.ql.io.parquet.serde.ParquetHiveSerDe"),
+			},
+			SortColumns: []v1alpha1.TableSortColumn{{Column: "id", SortOrder: 1}},
+		},
+		PartitionKeys: []v1alpha1.TableColumn{{Name: "day", Type: aws.String("string")}},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func tableInput() *awsglue.TableInput {
+	return &awsglue.TableInput{
+		Name:       aws.String("events"),
+		TableType:  aws.String("EXTERNAL_TABLE"),
+		Parameters: map[string]*string{"classification": aws.String("parquet")},
+		StorageDescriptor: &awsglue.StorageDescriptor{
+			Columns:  []*awsglue.Column{{Name: aws.String("id"), Type: aws.String("string")}},
+			Location: aws.String("s3://lake/events/"),
+			SerdeInfo: &awsglue.SerDeInfo{
+				SerializationLibrary: aws.String("org.apache.hadoop.hive.ql.io.parquet.serde.ParquetHiveSerDe"),
+			},
+			SortColumns: []*awsglue.Order{{Column: aws.String("id"), SortOrder: aws.Int64(1)}},
+		},
+		PartitionKeys: []*awsglue.Column{{Name: aws.String("day"), Type: aws.String("string")}},
+	}
+}
+
+func tableData(m ...func(*awsglue.TableData)) *awsglue.TableData {
+	in := tableInput()
+	t := &awsglue.TableData{
+		CatalogId:         aws.String("123456789012"),
+		DatabaseName:      aws.String("lake"),
+		Name:              in.Name,
+		TableType:         in.TableType,
+		Parameters:        map[string]*string{"classification": aws.String("parquet"), "averageRecordSize": aws.String("42")},
+		StorageDescriptor: in.StorageDescriptor,
+		PartitionKeys:     in.PartitionKeys,
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func TestGenerateTableInput(t *testing.T) {
+	got := GenerateTableInput("events", tableParameters())
+	if diff := cmp.Diff(tableInput(), got); diff != "" {
+		t.Errorf("GenerateTableInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsTableUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.TableParameters
+		t    *awsglue.TableData
+		want bool
+	}{
+		"UpToDate": {
+			p:    tableParameters(),
+			t:    tableData(),
+			want: true,
+		},
+		"StorageDescriptorNotDesired": {
+			p:    tableParameters(func(p *v1alpha1.TableParameters) { p.StorageDescriptor = nil }),
+			t:    tableData(),
+			want: true,
+		},
+		"ParameterChanged": {
+			p: tableParameters(func(p *v1alpha1.TableParameters) {
+				p.Parameters = map[string]*string{"classification": aws.String("json")}
+			}),
+			t:    tableData(),
+			want: false,
+		},
+		"ColumnAdded": {
+			p: tableParameters(func(p *v1alpha1.TableParameters) {
+				p.StorageDescriptor.Columns = append(p.StorageDescriptor.Columns, v1alpha1.TableColumn{Name: "payload", Type: aws.String("string")})
+			}),
+			t:    tableData(),
+			want: false,
+		},
+		"DescriptionChanged": {
+			p:    tableParameters(func(p *v1alpha1.TableParameters) { p.Description = aws.String("events") }),
+			t:    tableData(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsTableUpToDate(tc.p, tc.t); got != tc.want {
+				t.Errorf("IsTableUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLateInitializeTable(t *testing.T) {
+	p := tableParameters(func(p *v1alpha1.TableParameters) { p.TableType = nil })
+	LateInitializeTable(&p, tableData())
+	want := tableParameters(func(p *v1alpha1.TableParameters) { p.CatalogID = aws.String("123456789012") })
+	if diff := cmp.Diff(want, p); diff != "" {
+		t.Errorf("LateInitializeTable(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+)
+
+// MockClient is a type that implements all the methods for the GuardDuty
+// Client interface.
+type MockClient struct {
+	MockCreateDetector      func(ctx context.Context, input *guardduty.CreateDetectorInput, opts []request.Option) (*guardduty.CreateDetectorOutput, error)
+	MockGetDetector         func(ctx context.Context, input *guardduty.GetDetectorInput, opts []request.Option) (*guardduty.GetDetectorOutput, error)
+	MockUpdateDetector      func(ctx context.Context, input *guardduty.UpdateDetectorInput, opts []request.Option) (*guardduty.UpdateDetectorOutput, error)
+	MockDeleteDetector      func(ctx context.Context, input *guardduty.DeleteDetectorInput, opts []request.Option) (*guardduty.DeleteDetectorOutput, error)
+	MockCreateMembers       func(ctx context.Context, input *guardduty.CreateMembersInput, opts []request.Option) (*guardduty.CreateMembersOutput, error)
+	MockGetMembers          func(ctx context.Context, input *guardduty.GetMembersInput, opts []request.Option) (*guardduty.GetMembersOutput, error)
+	MockInviteMembers       func(ctx context.Context, input *guardduty.InviteMembersInput, opts []request.Option) (*guardduty.InviteMembersOutput, error)
+	MockDisassociateMembers func(ctx context.Context, input *guardduty.DisassociateMembersInput, opts []request.Option) (*guardduty.DisassociateMembersOutput, error)
+	MockDeleteMembers       func(ctx context.Context, input *guardduty.DeleteMembersInput, opts []request.Option) (*guardduty.DeleteMembersOutput, error)
+}
+
+// CreateDetectorWithContext mocks CreateDetectorWithContext method
+func (m *MockClient) CreateDetectorWithContext(ctx context.Context, input *guardduty.CreateDetectorInput, opts ...request.Option) (*guardduty.CreateDetectorOutput, error) {
+	return m.MockCreateDetector(ctx, input, opts)
+}
+
+// GetDetectorWithContext mocks GetDetectorWithContext method
+func (m *MockClient) GetDetectorWithContext(ctx context.Context, input *guardduty.GetDetectorInput, opts ...request.Option) (*guardduty.GetDetectorOutput, error) {
+	return m.MockGetDetector(ctx, input, opts)
+}
+
+// UpdateDetectorWithContext mocks UpdateDetectorWithContext method
+func (m *MockClient) UpdateDetectorWithContext(ctx context.Context, input *guardduty.UpdateDetectorInput, opts ...request.Option) (*guardduty.UpdateDetectorOutput, error) {
+	return m.MockUpdateDetector(ctx, input, opts)
+}
+
+// DeleteDetectorWithContext mocks DeleteDetectorWithContext method
+func (m *MockClient) DeleteDetectorWithContext(ctx context.Context, input *guardduty.DeleteDetectorInput, opts ...request.Option) (*guardduty.DeleteDetectorOutput, error) {
+	return m.MockDeleteDetector(ctx, input, opts)
+}
+
+// CreateMembersWithContext mocks CreateMembersWithContext method
+func (m *MockClient) CreateMembersWithContext(ctx context.Context, input *guardduty.CreateMembersInput, opts ...request.Option) (*guardduty.CreateMembersOutput, error) {
+	return m.MockCreateMembers(ctx, input, opts)
+}
+
+// GetMembersWithContext mocks GetMembersWithContext method
+func (m *MockClient) GetMembersWithContext(ctx context.Context, input *guardduty.GetMembersInput, opts ...request.Option) (*guardduty.GetMembersOutput, error) {
+	return m.MockGetMembers(ctx, input, opts)
+}
+
+// InviteMembersWithContext mocks InviteMembersWithContext method
+func (m *MockClient) InviteMembersWithContext(ctx context.Context, input *guardduty.InviteMembersInput, opts ...request.Option) (*guardduty.InviteMembersOutput, error) {
+	return m.MockInviteMembers(ctx, input, opts)
+}
+
+// DisassociateMembersWithContext mocks DisassociateMembersWithContext method
+func (m *MockClient) DisassociateMembersWithContext(ctx context.Context, input *guardduty.DisassociateMembersInput, opts ...request.Option) (*guardduty.DisassociateMembersOutput, error) {
+	return m.MockDisassociateMembers(ctx, input, opts)
+}
+
+// DeleteMembersWithContext mocks DeleteMembersWithContext method
+func (m *MockClient) DeleteMembersWithContext(ctx context.Context, input *guardduty.DeleteMembersInput, opts ...request.Option) (*guardduty.DeleteMembersOutput, error) {
+	return m.MockDeleteMembers(ctx, input, opts)
+}
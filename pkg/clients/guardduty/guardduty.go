@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsguardduty "github.com/aws/aws-sdk-go/service/guardduty"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// RelationshipStatusCreated is the relationship status of a member account
+// that has been associated but not invited yet.
+const RelationshipStatusCreated = "Created"
+
+// Client defines the GuardDuty operations used by the detector and member
+// controllers.
+type Client interface {
+	CreateDetectorWithContext(context.Context, *awsguardduty.CreateDetectorInput, ...request.Option) (*awsguardduty.CreateDetectorOutput, error)
+	GetDetectorWithContext(context.Context, *awsguardduty.GetDetectorInput, ...request.Option) (*awsguardduty.GetDetectorOutput, error)
+	UpdateDetectorWithContext(context.Context, *awsguardduty.UpdateDetectorInput, ...request.Option) (*awsguardduty.UpdateDetectorOutput, error)
+	DeleteDetectorWithContext(context.Context, *awsguardduty.DeleteDetectorInput, ...request.Option) (*awsguardduty.DeleteDetectorOutput, error)
+	CreateMembersWithContext(context.Context, *awsguardduty.CreateMembersInput, ...request.Option) (*awsguardduty.CreateMembersOutput, error)
+	GetMembersWithContext(context.Context, *awsguardduty.GetMembersInput, ...request.Option) (*awsguardduty.GetMembersOutput, error)
+	InviteMembersWithContext(context.Context, *awsguardduty.InviteMembersInput, ...request.Option) (*awsguardduty.InviteMembersOutput, error)
+	DisassociateMembersWithContext(context.Context, *awsguardduty.DisassociateMembersInput, ...request.Option) (*awsguardduty.DisassociateMembersOutput, error)
+	DeleteMembersWithContext(context.Context, *awsguardduty.DeleteMembersInput, ...request.Option) (*awsguardduty.DeleteMembersOutput, error)
+}
+
+// NewClient returns a new GuardDuty client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsguardduty.New(sess)
+}
+
+// IsDetectorNotFound returns true if the error indicates that the detector
+// does not exist. GuardDuty has no dedicated error code for this and rejects
+// the request instead.
+func IsDetectorNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) &&
+		awsErr.Code() == awsguardduty.ErrCodeBadRequestException &&
+		strings.Contains(awsErr.Message(), "not owned by the current account")
+}
+
+// GenerateDataSources returns the data source configuration for the given
+// parameters.
+func GenerateDataSources(p *v1alpha1.DetectorDataSources) *awsguardduty.DataSourceConfigurations {
+	if p == nil || p.S3Logs == nil {
+		return nil
+	}
+	return &awsguardduty.DataSourceConfigurations{
+		S3Logs: &awsguardduty.S3LogsConfiguration{Enable: p.S3Logs},
+	}
+}
+
+// GenerateCreateDetectorInput returns the input to create a detector with
+// the given parameters.
+func GenerateCreateDetectorInput(p v1alpha1.DetectorParameters, token string) *awsguardduty.CreateDetectorInput {
+	in := &awsguardduty.CreateDetectorInput{
+		ClientToken:                awsclient.String(token),
+		DataSources:                GenerateDataSources(p.DataSources),
+		Enable:                     aws.Bool(IsEnabled(p.Enable)),
+		FindingPublishingFrequency: p.FindingPublishingFrequency,
+	}
+	if len(p.Tags) > 0 {
+		in.Tags = make(map[string]*string, len(p.Tags))
+		for k, v := range p.Tags {
+			in.Tags[k] = awsclient.String(v)
+		}
+	}
+	return in
+}
+
+// GenerateUpdateDetectorInput returns the input to update the detector with
+// the given ID to the given parameters.
+func GenerateUpdateDetectorInput(id string, p v1alpha1.DetectorParameters) *awsguardduty.UpdateDetectorInput {
+	return &awsguardduty.UpdateDetectorInput{
+		DetectorId:                 awsclient.String(id),
+		DataSources:                GenerateDataSources(p.DataSources),
+		Enable:                     aws.Bool(IsEnabled(p.Enable)),
+		FindingPublishingFrequency: p.FindingPublishingFrequency,
+	}
+}
+
+// IsDetectorUpToDate returns true if the observed detector matches the given
+// parameters. The finding publishing frequency and data sources are only
+// compared if they are set, since GuardDuty reports its defaults otherwise.
+func IsDetectorUpToDate(p v1alpha1.DetectorParameters, observed *awsguardduty.GetDetectorOutput) bool {
+	if IsEnabled(p.Enable) != (awsclient.StringValue(observed.Status) == awsguardduty.DetectorStatusEnabled) {
+		return false
+	}
+	if p.FindingPublishingFrequency != nil && *p.FindingPublishingFrequency != awsclient.StringValue(observed.FindingPublishingFrequency) {
+		return false
+	}
+	if p.DataSources == nil || p.DataSources.S3Logs == nil {
+		return true
+	}
+	s3Logs := observed.DataSources != nil && observed.DataSources.S3Logs != nil &&
+		awsclient.StringValue(observed.DataSources.S3Logs.Status) == awsguardduty.DataSourceStatusEnabled
+	return *p.DataSources.S3Logs == s3Logs
+}
+
+// IsEnabled returns the value of an optional flag that defaults to true.
+func IsEnabled(b *bool) bool {
+	return b == nil || *b
+}
+
+// IsMemberUpToDate returns true if the member account does not have to be
+// invited (anymore).
+func IsMemberUpToDate(p v1alpha1.MemberParameters, observed *awsguardduty.Member) bool {
+	return !IsEnabled(p.Invite) || awsclient.StringValue(observed.RelationshipStatus) != RelationshipStatusCreated
+}
+
+// GenerateInviteMembersInput returns the input to invite the given member
+// account of the given detector.
+func GenerateInviteMembersInput(detectorID, accountID string, p v1alpha1.MemberParameters) *awsguardduty.InviteMembersInput {
+	return &awsguardduty.InviteMembersInput{
+		DetectorId:               awsclient.String(detectorID),
+		AccountIds:               []*string{awsclient.String(accountID)},
+		DisableEmailNotification: p.DisableEmailNotification,
+		Message:                  p.InvitationMessage,
+	}
+}
+
+// UnprocessedAccountsError returns an error describing why the given
+// accounts could not be processed, or nil if there are none.
+func UnprocessedAccountsError(accounts []*awsguardduty.UnprocessedAccount) error {
+	if len(accounts) == 0 {
+		return nil
+	}
+	results := make([]string, len(accounts))
+	for i, a := range accounts {
+		results[i] = awsclient.StringValue(a.AccountId) + ": " + awsclient.StringValue(a.Result)
+	}
+	return errors.New(strings.Join(results, ", "))
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package guardduty
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsguardduty "github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+)
+
+func TestIsDetectorNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotOwned": {
+			err:  awserr.New(awsguardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.", nil),
+			want: true,
+		},
+		"OtherBadRequest": {
+			err:  awserr.New(awsguardduty.ErrCodeBadRequestException, "The request is rejected because an invalid or out-of-range value is specified as an input parameter.", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDetectorNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateCreateDetectorInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.DetectorParameters
+		want *awsguardduty.CreateDetectorInput
+	}{
+		"Defaults": {
+			p: v1alpha1.DetectorParameters{},
+			want: &awsguardduty.CreateDetectorInput{
+				ClientToken: aws.String("token"),
+				Enable:      aws.Bool(true),
+			},
+		},
+		"Full": {
+			p: v1alpha1.DetectorParameters{
+				Enable:                     aws.Bool(false),
+				FindingPublishingFrequency: aws.String(awsguardduty.FindingPublishingFrequencyOneHour),
+				DataSources:                &v1alpha1.DetectorDataSources{S3Logs: aws.Bool(true)},
+				Tags:                       map[string]string{"team": "security"},
+			},
+			want: &awsguardduty.CreateDetectorInput{
+				ClientToken:                aws.String("token"),
+				Enable:                     aws.Bool(false),
+				FindingPublishingFrequency: aws.String(awsguardduty.FindingPublishingFrequencyOneHour),
+				DataSources: &awsguardduty.DataSourceConfigurations{
+					S3Logs: &awsguardduty.S3LogsConfiguration{Enable: aws.Bool(true)},
+				},
+				Tags: map[string]*string{"team": aws.String("security")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateCreateDetectorInput(tc.p, "token")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsDetectorUpToDate(t *testing.T) {
+	observed := &awsguardduty.GetDetectorOutput{
+		Status:                     aws.String(awsguardduty.DetectorStatusEnabled),
+		FindingPublishingFrequency: aws.String(awsguardduty.FindingPublishingFrequencySixHours),
+		DataSources: &awsguardduty.DataSourceConfigurationsResult{
+			S3Logs: &awsguardduty.S3LogsConfigurationResult{Status: aws.String(awsguardduty.DataSourceStatusDisabled)},
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.DetectorParameters
+		want bool
+	}{
+		"Defaults": {
+			p:    v1alpha1.DetectorParameters{},
+			want: true,
+		},
+		"Disabled": {
+			p:    v1alpha1.DetectorParameters{Enable: aws.Bool(false)},
+			want: false,
+		},
+		"FrequencyChanged": {
+			p:    v1alpha1.DetectorParameters{FindingPublishingFrequency: aws.String(awsguardduty.FindingPublishingFrequencyFifteenMinutes)},
+			want: false,
+		},
+		"S3LogsEnabled": {
+			p:    v1alpha1.DetectorParameters{DataSources: &v1alpha1.DetectorDataSources{S3Logs: aws.Bool(true)}},
+			want: false,
+		},
+		"S3LogsDisabled": {
+			p:    v1alpha1.DetectorParameters{DataSources: &v1alpha1.DetectorDataSources{S3Logs: aws.Bool(false)}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsDetectorUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMemberUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p      v1alpha1.MemberParameters
+		status string
+		want   bool
+	}{
+		"NotInvitedYet": {
+			status: RelationshipStatusCreated,
+			want:   false,
+		},
+		"Invited": {
+			status: "Invited",
+			want:   true,
+		},
+		"InvitationNotWanted": {
+			p:      v1alpha1.MemberParameters{Invite: aws.Bool(false)},
+			status: RelationshipStatusCreated,
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsMemberUpToDate(tc.p, &awsguardduty.Member{RelationshipStatus: aws.String(tc.status)})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUnprocessedAccountsError(t *testing.T) {
+	cases := map[string]struct {
+		accounts []*awsguardduty.UnprocessedAccount
+		want     error
+	}{
+		"None": {},
+		"Some": {
+			accounts: []*awsguardduty.UnprocessedAccount{
+				{AccountId: aws.String("111111111111"), Result: aws.String("The account is already a member.")},
+				{AccountId: aws.String("222222222222"), Result: aws.String("Invalid email.")},
+			},
+			want: errors.New("111111111111: The account is already a member., 222222222222: Invalid email."),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, UnprocessedAccountsError(tc.accounts), test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health reports open AWS Health events that affect the service and
+// region of managed resources in their status conditions, so that operators
+// can tell AWS incidents and maintenance apart from provider bugs.
+package health
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/aws-sdk-go/service/health/healthiface"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeAWSHealth is the type of the condition that reports open AWS Health
+// events affecting a managed resource.
+const TypeAWSHealth xpv1.ConditionType = "AWSHealth"
+
+// Reasons of the AWSHealth condition.
+const (
+	ReasonOpenEvent   xpv1.ConditionReason = "OpenServiceEvent"
+	ReasonNoOpenEvent xpv1.ConditionReason = "NoOpenServiceEvent"
+)
+
+// GlobalRegion is the region of AWS Health events of global services.
+const GlobalRegion = "global"
+
+// DefaultInterval at which the AWS Health events of an account are refreshed.
+const DefaultInterval = 5 * time.Minute
+
+// services maps the API groups of managed resources to the AWS Health service
+// codes, where the upper cased group doesn't match.
+var services = map[string]string{
+	"cache":                   "ELASTICACHE",
+	"database":                "RDS",
+	"elbv2":                   "ELASTICLOADBALANCING",
+	"cognitoidentityprovider": "COGNITO",
+	"cognitoidentity":         "COGNITO",
+	"prometheusservice":       "APS",
+	"notification":            "SNS",
+}
+
+// An Event is an open AWS Health event.
+type Event struct {
+	Service       string
+	Region        string
+	EventTypeCode string
+	StartTime     time.Time
+}
+
+// A ConfigFn returns a session for the AWS account and credentials of the
+// supplied managed resource.
+type ConfigFn func(ctx context.Context, mg resource.Managed) (*session.Session, error)
+
+type cached struct {
+	events  []Event
+	fetched time.Time
+}
+
+// A Watcher periodically fetches the open AWS Health events of the accounts
+// managed resources are reconciled with.
+type Watcher struct {
+	config    ConfigFn
+	newClient func(*session.Session) healthiface.HealthAPI
+	interval  time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+// An Option configures a Watcher.
+type Option func(*Watcher)
+
+// WithInterval configures the interval at which events are refreshed.
+func WithInterval(d time.Duration) Option {
+	return func(w *Watcher) { w.interval = d }
+}
+
+// WithClientFn configures how the AWS Health client is created.
+func WithClientFn(fn func(*session.Session) healthiface.HealthAPI) Option {
+	return func(w *Watcher) { w.newClient = fn }
+}
+
+// WithClock configures the clock of the Watcher.
+func WithClock(now func() time.Time) Option {
+	return func(w *Watcher) { w.now = now }
+}
+
+// NewWatcher returns a Watcher that uses the supplied function to connect to
+// AWS Health.
+func NewWatcher(config ConfigFn, o ...Option) *Watcher {
+	w := &Watcher{
+		config:    config,
+		newClient: func(s *session.Session) healthiface.HealthAPI { return svcsdk.New(s) },
+		interval:  DefaultInterval,
+		now:       time.Now,
+		cache:     map[string]cached{},
+	}
+	for _, fn := range o {
+		fn(w)
+	}
+	return w
+}
+
+// Events returns the open AWS Health events of the account of the supplied
+// managed resource. Events are fetched once per interval and ProviderConfig.
+// Fetching them fails for accounts without a Business or Enterprise support
+// plan, which is remembered for the interval too.
+func (w *Watcher) Events(ctx context.Context, mg resource.Managed) ([]Event, error) {
+	key := ""
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		key = ref.Name
+	}
+
+	w.mu.Lock()
+	c, ok := w.cache[key]
+	w.mu.Unlock()
+	if ok && w.now().Sub(c.fetched) < w.interval {
+		return c.events, nil
+	}
+
+	events, err := w.fetch(ctx, mg)
+	w.mu.Lock()
+	w.cache[key] = cached{events: events, fetched: w.now()}
+	w.mu.Unlock()
+	return events, err
+}
+
+func (w *Watcher) fetch(ctx context.Context, mg resource.Managed) ([]Event, error) {
+	s, err := w.config(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	var events []Event
+	err = w.newClient(s).DescribeEventsPagesWithContext(ctx, &svcsdk.DescribeEventsInput{
+		Filter: &svcsdk.EventFilter{
+			EventStatusCodes: aws.StringSlice([]string{svcsdk.EventStatusCodeOpen, svcsdk.EventStatusCodeUpcoming}),
+		},
+	}, func(page *svcsdk.DescribeEventsOutput, _ bool) bool {
+		for _, e := range page.Events {
+			events = append(events, Event{
+				Service:       aws.StringValue(e.Service),
+				Region:        aws.StringValue(e.Region),
+				EventTypeCode: aws.StringValue(e.EventTypeCode),
+				StartTime:     aws.TimeValue(e.StartTime),
+			})
+		}
+		return true
+	})
+	return events, err
+}
+
+// Check sets the AWSHealth condition of the supplied managed resource. The
+// condition is only added once an event affects the resource, and is set to
+// false once no event affects it anymore. A nil Watcher checks no events.
+func (w *Watcher) Check(ctx context.Context, mg resource.Managed) {
+	if w == nil {
+		return
+	}
+	events, err := w.Events(ctx, mg)
+	if err != nil {
+		// AWS Health is a best effort integration. Failing to reach it must
+		// not affect the reconciliation of managed resources.
+		return
+	}
+	if c := Condition(mg, events); c.Status == corev1.ConditionTrue || mg.GetCondition(TypeAWSHealth).Status != corev1.ConditionUnknown {
+		mg.SetConditions(c)
+	}
+}
+
+// Condition returns the AWSHealth condition of the supplied managed resource
+// given the supplied open events.
+func Condition(mg resource.Managed, events []Event) xpv1.Condition {
+	service, region := ServiceOf(mg), RegionOf(mg)
+	var msgs []string
+	for _, e := range events {
+		if e.Service != service || e.Region != region {
+			continue
+		}
+		msgs = append(msgs, fmt.Sprintf("%s in %s since %s", e.EventTypeCode, e.Region, e.StartTime.UTC().Format(time.RFC3339)))
+	}
+	if len(msgs) == 0 {
+		return xpv1.Condition{
+			Type:               TypeAWSHealth,
+			Status:             corev1.ConditionFalse,
+			LastTransitionTime: metav1.Now(),
+			Reason:             ReasonNoOpenEvent,
+		}
+	}
+	sort.Strings(msgs)
+	return xpv1.Condition{
+		Type:               TypeAWSHealth,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonOpenEvent,
+		Message:            strings.Join(msgs, "; "),
+	}
+}
+
+// ServiceOf returns the AWS Health service code of the supplied managed
+// resource, which is derived from its API group.
+func ServiceOf(mg resource.Managed) string {
+	group := mg.GetObjectKind().GroupVersionKind().Group
+	if group == "" {
+		// The path of the package of API types ends with group/version.
+		t := reflect.TypeOf(mg)
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		parts := strings.Split(t.PkgPath(), "/")
+		if len(parts) > 1 {
+			group = parts[len(parts)-2]
+		}
+	}
+	group = strings.Split(group, ".")[0]
+	if s, ok := services[group]; ok {
+		return s
+	}
+	return strings.ToUpper(group)
+}
+
+// RegionOf returns the spec.forProvider.region of the supplied managed
+// resource, or the global region if it has none.
+func RegionOf(mg resource.Managed) string {
+	v := reflect.ValueOf(mg)
+	for _, name := range []string{"Spec", "ForProvider", "Region"} {
+		for v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return GlobalRegion
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return GlobalRegion
+		}
+		if v = v.FieldByName(name); !v.IsValid() {
+			return GlobalRegion
+		}
+	}
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return GlobalRegion
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.String || v.String() == "" {
+		return GlobalRegion
+	}
+	return v.String()
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/health"
+	"github.com/aws/aws-sdk-go/service/health/healthiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/health"
+)
+
+var (
+	errBoom = errors.New("boom")
+	start   = time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+)
+
+type mockHealthClient struct {
+	healthiface.HealthAPI
+
+	calls  int
+	events []*svcsdk.Event
+	err    error
+}
+
+func (m *mockHealthClient) DescribeEventsPagesWithContext(_ aws.Context, _ *svcsdk.DescribeEventsInput, fn func(*svcsdk.DescribeEventsOutput, bool) bool, _ ...request.Option) error {
+	m.calls++
+	fn(&svcsdk.DescribeEventsOutput{Events: m.events}, true)
+	return m.err
+}
+
+func replicationGroup(region string) *v1beta1.ReplicationGroup {
+	rg := &v1beta1.ReplicationGroup{}
+	if region != "" {
+		rg.Spec.ForProvider.Region = &region
+	}
+	return rg
+}
+
+func TestServiceOf(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"MappedGroup": {
+			mg:   &v1beta1.ReplicationGroup{},
+			want: "ELASTICACHE",
+		},
+		"UpperCasedGroup": {
+			mg:   &iamv1beta1.Role{},
+			want: "IAM",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, health.ServiceOf(tc.mg)); diff != "" {
+				t.Errorf("health.ServiceOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRegionOf(t *testing.T) {
+	cases := map[string]struct {
+		mg   resource.Managed
+		want string
+	}{
+		"Regional": {
+			mg:   replicationGroup("eu-west-1"),
+			want: "eu-west-1",
+		},
+		"NoRegionSet": {
+			mg:   replicationGroup(""),
+			want: health.GlobalRegion,
+		},
+		"NoRegionField": {
+			mg:   &iamv1beta1.Role{},
+			want: health.GlobalRegion,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, health.RegionOf(tc.mg)); diff != "" {
+				t.Errorf("health.RegionOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCondition(t *testing.T) {
+	cases := map[string]struct {
+		mg     resource.Managed
+		events []health.Event
+		want   xpv1.Condition
+	}{
+		"NoEvents": {
+			mg:   replicationGroup("eu-west-1"),
+			want: xpv1.Condition{Type: health.TypeAWSHealth, Status: corev1.ConditionFalse, Reason: health.ReasonNoOpenEvent},
+		},
+		"OtherServiceOrRegion": {
+			mg: replicationGroup("eu-west-1"),
+			events: []health.Event{
+				{Service: "RDS", Region: "eu-west-1", EventTypeCode: "AWS_RDS_OPERATIONAL_ISSUE", StartTime: start},
+				{Service: "ELASTICACHE", Region: "us-east-1", EventTypeCode: "AWS_ELASTICACHE_OPERATIONAL_ISSUE", StartTime: start},
+			},
+			want: xpv1.Condition{Type: health.TypeAWSHealth, Status: corev1.ConditionFalse, Reason: health.ReasonNoOpenEvent},
+		},
+		"AffectingEvent": {
+			mg: replicationGroup("eu-west-1"),
+			events: []health.Event{
+				{Service: "ELASTICACHE", Region: "eu-west-1", EventTypeCode: "AWS_ELASTICACHE_MAINTENANCE_SCHEDULED", StartTime: start},
+			},
+			want: xpv1.Condition{
+				Type:    health.TypeAWSHealth,
+				Status:  corev1.ConditionTrue,
+				Reason:  health.ReasonOpenEvent,
+				Message: "AWS_ELASTICACHE_MAINTENANCE_SCHEDULED in eu-west-1 since 2022-03-01T12:00:00Z",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := health.Condition(tc.mg, tc.events)
+			if !tc.want.Equal(got) {
+				t.Errorf("health.Condition(...): want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestWatcherCheck(t *testing.T) {
+	type want struct {
+		conditions []xpv1.Condition
+		calls      int
+	}
+
+	cases := map[string]struct {
+		client *mockHealthClient
+		mg     *v1beta1.ReplicationGroup
+		checks int
+		want   want
+	}{
+		"AffectingEvent": {
+			client: &mockHealthClient{events: []*svcsdk.Event{{
+				Service:       aws.String("ELASTICACHE"),
+				Region:        aws.String("eu-west-1"),
+				EventTypeCode: aws.String("AWS_ELASTICACHE_OPERATIONAL_ISSUE"),
+				StartTime:     aws.Time(start),
+			}}},
+			mg:     replicationGroup("eu-west-1"),
+			checks: 2,
+			want: want{
+				conditions: []xpv1.Condition{{
+					Type:    health.TypeAWSHealth,
+					Status:  corev1.ConditionTrue,
+					Reason:  health.ReasonOpenEvent,
+					Message: "AWS_ELASTICACHE_OPERATIONAL_ISSUE in eu-west-1 since 2022-03-01T12:00:00Z",
+				}},
+				calls: 1,
+			},
+		},
+		"NoConditionWithoutEvent": {
+			client: &mockHealthClient{},
+			mg:     replicationGroup("eu-west-1"),
+			checks: 1,
+			want: want{
+				calls: 1,
+			},
+		},
+		"EventClosed": {
+			client: &mockHealthClient{},
+			mg: func() *v1beta1.ReplicationGroup {
+				rg := replicationGroup("eu-west-1")
+				rg.SetConditions(xpv1.Condition{Type: health.TypeAWSHealth, Status: corev1.ConditionTrue, Reason: health.ReasonOpenEvent})
+				return rg
+			}(),
+			checks: 1,
+			want: want{
+				conditions: []xpv1.Condition{{Type: health.TypeAWSHealth, Status: corev1.ConditionFalse, Reason: health.ReasonNoOpenEvent}},
+				calls:      1,
+			},
+		},
+		"SubscriptionRequired": {
+			client: &mockHealthClient{err: errBoom},
+			mg:     replicationGroup("eu-west-1"),
+			checks: 2,
+			want: want{
+				calls: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			w := health.NewWatcher(func(_ context.Context, _ resource.Managed) (*session.Session, error) { return nil, nil },
+				health.WithClientFn(func(_ *session.Session) healthiface.HealthAPI { return tc.client }),
+				health.WithClock(func() time.Time { return start }))
+			for i := 0; i < tc.checks; i++ {
+				w.Check(context.Background(), tc.mg)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.mg.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("Check(...): -want conditions, +got conditions:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.client.calls); diff != "" {
+				t.Errorf("Check(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNilWatcherCheck(t *testing.T) {
+	rg := replicationGroup("eu-west-1")
+	var w *health.Watcher
+	w.Check(context.Background(), rg)
+	if len(rg.Status.Conditions) != 0 {
+		t.Errorf("Check(...): want no AWSHealth condition from a nil Watcher, got %v", rg.Status.Conditions)
+	}
+}
@@ -18,11 +18,18 @@ package iam
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 )
 
+// DefaultAccessKeyGracePeriod is the time for which the previous access key
+// stays active after a rotation if no grace period is configured.
+const DefaultAccessKeyGracePeriod = 24 * time.Hour
+
 // AccessClient is the external client used for AccessKey Custom Resource
 type AccessClient interface {
 	CreateAccessKey(ctx context.Context, input *iam.CreateAccessKeyInput, opts ...func(*iam.Options)) (*iam.CreateAccessKeyOutput, error)
@@ -35,3 +42,42 @@ type AccessClient interface {
 func NewAccessClient(conf aws.Config) AccessClient {
 	return iam.NewFromConfig(conf)
 }
+
+// IsAccessKeyGracePeriodOver returns true if the grace period of the access
+// key replaced by the last rotation of the supplied AccessKey is over.
+func IsAccessKeyGracePeriodOver(cr *v1beta1.AccessKey, now time.Time) bool {
+	last := cr.Status.AtProvider.LastRotationTime
+	if last == nil {
+		return true
+	}
+	grace := DefaultAccessKeyGracePeriod
+	if r := cr.Spec.ForProvider.Rotation; r != nil && r.GracePeriod != nil {
+		grace = r.GracePeriod.Duration
+	}
+	return !now.Before(last.Add(grace))
+}
+
+// IsAccessKeyRotationDue returns true if the supplied AccessKey should be
+// rotated, either because it is older than its maximum age or because the
+// rotate annotation changed. An AccessKey is never rotated while the key it
+// replaced in the last rotation is in its grace period.
+func IsAccessKeyRotationDue(cr *v1beta1.AccessKey, now time.Time) bool {
+	o := cr.Status.AtProvider
+	if o.PreviousAccessKeyID != "" && !IsAccessKeyGracePeriodOver(cr, now) {
+		return false
+	}
+	if v := cr.GetAnnotations()[v1beta1.AnnotationKeyRotateAccessKey]; v != "" && v != o.LastRotationRequest {
+		return true
+	}
+	r := cr.Spec.ForProvider.Rotation
+	return r != nil && r.MaxAge != nil && o.CreateDate != nil && !now.Before(o.CreateDate.Add(r.MaxAge.Duration))
+}
+
+// PreviousAccessKeyPolicy returns the policy for the access key replaced by
+// the last rotation of the supplied AccessKey.
+func PreviousAccessKeyPolicy(cr *v1beta1.AccessKey) string {
+	if r := cr.Spec.ForProvider.Rotation; r != nil && r.PreviousKeyPolicy != nil {
+		return *r.PreviousKeyPolicy
+	}
+	return v1beta1.PreviousAccessKeyPolicyDelete
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+func TestIsAccessKeyRotationDue(t *testing.T) {
+	now := time.Now()
+	ago := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(-d))
+		return &t
+	}
+	maxAge := &v1beta1.AccessKeyRotation{MaxAge: &metav1.Duration{Duration: 24 * time.Hour}}
+
+	cases := map[string]struct {
+		cr   *v1beta1.AccessKey
+		want bool
+	}{
+		"NoRotation": {
+			cr:   &v1beta1.AccessKey{},
+			want: false,
+		},
+		"YoungerThanMaxAge": {
+			cr: &v1beta1.AccessKey{
+				Spec:   v1beta1.AccessKeySpec{ForProvider: v1beta1.AccessKeyParameters{Rotation: maxAge}},
+				Status: v1beta1.AccessKeyStatus{AtProvider: v1beta1.AccessKeyObservation{CreateDate: ago(time.Hour)}},
+			},
+			want: false,
+		},
+		"OlderThanMaxAge": {
+			cr: &v1beta1.AccessKey{
+				Spec:   v1beta1.AccessKeySpec{ForProvider: v1beta1.AccessKeyParameters{Rotation: maxAge}},
+				Status: v1beta1.AccessKeyStatus{AtProvider: v1beta1.AccessKeyObservation{CreateDate: ago(48 * time.Hour)}},
+			},
+			want: true,
+		},
+		"PreviousKeyInGracePeriod": {
+			cr: &v1beta1.AccessKey{
+				Spec: v1beta1.AccessKeySpec{ForProvider: v1beta1.AccessKeyParameters{Rotation: maxAge}},
+				Status: v1beta1.AccessKeyStatus{AtProvider: v1beta1.AccessKeyObservation{
+					CreateDate:          ago(48 * time.Hour),
+					LastRotationTime:    ago(time.Hour),
+					PreviousAccessKeyID: "previous",
+				}},
+			},
+			want: false,
+		},
+		"NewRotateAnnotation": {
+			cr: &v1beta1.AccessKey{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1.AnnotationKeyRotateAccessKey: "2"}},
+				Status:     v1beta1.AccessKeyStatus{AtProvider: v1beta1.AccessKeyObservation{LastRotationRequest: "1"}},
+			},
+			want: true,
+		},
+		"HandledRotateAnnotation": {
+			cr: &v1beta1.AccessKey{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{v1beta1.AnnotationKeyRotateAccessKey: "1"}},
+				Status:     v1beta1.AccessKeyStatus{AtProvider: v1beta1.AccessKeyObservation{LastRotationRequest: "1"}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccessKeyRotationDue(tc.cr, now)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsAccessKeyRotationDue(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// AccountPasswordPolicyClient is the external client used for
+// AccountPasswordPolicy Custom Resource
+type AccountPasswordPolicyClient interface {
+	GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+	UpdateAccountPasswordPolicy(ctx context.Context, input *iam.UpdateAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error)
+	DeleteAccountPasswordPolicy(ctx context.Context, input *iam.DeleteAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteAccountPasswordPolicyOutput, error)
+}
+
+// NewAccountPasswordPolicyClient returns a new client using AWS credentials
+// as JSON encoded data.
+func NewAccountPasswordPolicyClient(cfg aws.Config) AccountPasswordPolicyClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GenerateUpdateAccountPasswordPolicyInput returns the input to set the
+// password policy of an account to the supplied parameters.
+func GenerateUpdateAccountPasswordPolicyInput(p v1beta1.AccountPasswordPolicyParameters) *iam.UpdateAccountPasswordPolicyInput {
+	return &iam.UpdateAccountPasswordPolicyInput{
+		AllowUsersToChangePassword: aws.ToBool(p.AllowUsersToChangePassword),
+		HardExpiry:                 p.HardExpiry,
+		MaxPasswordAge:             nonZero(p.MaxPasswordAge),
+		MinimumPasswordLength:      p.MinimumPasswordLength,
+		PasswordReusePrevention:    nonZero(p.PasswordReusePrevention),
+		RequireLowercaseCharacters: aws.ToBool(p.RequireLowercaseCharacters),
+		RequireNumbers:             aws.ToBool(p.RequireNumbers),
+		RequireSymbols:             aws.ToBool(p.RequireSymbols),
+		RequireUppercaseCharacters: aws.ToBool(p.RequireUppercaseCharacters),
+	}
+}
+
+// nonZero returns nil for zero values, which IAM rejects for settings where
+// omitting them means zero.
+func nonZero(v *int32) *int32 {
+	if aws.ToInt32(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// LateInitializeAccountPasswordPolicy fills the empty fields of the supplied
+// parameters with the values of the observed password policy.
+func LateInitializeAccountPasswordPolicy(p *v1beta1.AccountPasswordPolicyParameters, o iamtypes.PasswordPolicy) {
+	p.AllowUsersToChangePassword = awsclient.LateInitializeBoolPtr(p.AllowUsersToChangePassword, aws.Bool(o.AllowUsersToChangePassword))
+	p.HardExpiry = awsclient.LateInitializeBoolPtr(p.HardExpiry, aws.Bool(aws.ToBool(o.HardExpiry)))
+	p.MaxPasswordAge = awsclient.LateInitializeInt32Ptr(p.MaxPasswordAge, aws.Int32(aws.ToInt32(o.MaxPasswordAge)))
+	p.MinimumPasswordLength = awsclient.LateInitializeInt32Ptr(p.MinimumPasswordLength, o.MinimumPasswordLength)
+	p.PasswordReusePrevention = awsclient.LateInitializeInt32Ptr(p.PasswordReusePrevention, aws.Int32(aws.ToInt32(o.PasswordReusePrevention)))
+	p.RequireLowercaseCharacters = awsclient.LateInitializeBoolPtr(p.RequireLowercaseCharacters, aws.Bool(o.RequireLowercaseCharacters))
+	p.RequireNumbers = awsclient.LateInitializeBoolPtr(p.RequireNumbers, aws.Bool(o.RequireNumbers))
+	p.RequireSymbols = awsclient.LateInitializeBoolPtr(p.RequireSymbols, aws.Bool(o.RequireSymbols))
+	p.RequireUppercaseCharacters = awsclient.LateInitializeBoolPtr(p.RequireUppercaseCharacters, aws.Bool(o.RequireUppercaseCharacters))
+}
+
+// IsAccountPasswordPolicyUpToDate returns true if the observed password
+// policy matches the supplied parameters. Parameters that are not set are
+// not compared.
+func IsAccountPasswordPolicyUpToDate(p v1beta1.AccountPasswordPolicyParameters, o iamtypes.PasswordPolicy) bool {
+	bools := []struct {
+		desired  *bool
+		observed bool
+	}{
+		{p.AllowUsersToChangePassword, o.AllowUsersToChangePassword},
+		{p.HardExpiry, aws.ToBool(o.HardExpiry)},
+		{p.RequireLowercaseCharacters, o.RequireLowercaseCharacters},
+		{p.RequireNumbers, o.RequireNumbers},
+		{p.RequireSymbols, o.RequireSymbols},
+		{p.RequireUppercaseCharacters, o.RequireUppercaseCharacters},
+	}
+	for _, b := range bools {
+		if b.desired != nil && *b.desired != b.observed {
+			return false
+		}
+	}
+	ints := []struct {
+		desired  *int32
+		observed *int32
+	}{
+		{p.MaxPasswordAge, o.MaxPasswordAge},
+		{p.MinimumPasswordLength, o.MinimumPasswordLength},
+		{p.PasswordReusePrevention, o.PasswordReusePrevention},
+	}
+	for _, i := range ints {
+		if i.desired != nil && *i.desired != aws.ToInt32(i.observed) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+func TestGenerateUpdateAccountPasswordPolicyInput(t *testing.T) {
+	cases := map[string]struct {
+		in   v1beta1.AccountPasswordPolicyParameters
+		want *iam.UpdateAccountPasswordPolicyInput
+	}{
+		"Empty": {
+			in:   v1beta1.AccountPasswordPolicyParameters{},
+			want: &iam.UpdateAccountPasswordPolicyInput{},
+		},
+		"ZeroMeansUnset": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				MaxPasswordAge:          aws.Int32(0),
+				PasswordReusePrevention: aws.Int32(0),
+				MinimumPasswordLength:   aws.Int32(14),
+				RequireSymbols:          aws.Bool(true),
+			},
+			want: &iam.UpdateAccountPasswordPolicyInput{
+				MinimumPasswordLength: aws.Int32(14),
+				RequireSymbols:        true,
+			},
+		},
+		"Full": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				AllowUsersToChangePassword: aws.Bool(true),
+				HardExpiry:                 aws.Bool(false),
+				MaxPasswordAge:             aws.Int32(90),
+				PasswordReusePrevention:    aws.Int32(24),
+				RequireNumbers:             aws.Bool(true),
+			},
+			want: &iam.UpdateAccountPasswordPolicyInput{
+				AllowUsersToChangePassword: true,
+				HardExpiry:                 aws.Bool(false),
+				MaxPasswordAge:             aws.Int32(90),
+				PasswordReusePrevention:    aws.Int32(24),
+				RequireNumbers:             true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateUpdateAccountPasswordPolicyInput(tc.in)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(iam.UpdateAccountPasswordPolicyInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsAccountPasswordPolicyUpToDate(t *testing.T) {
+	observed := types.PasswordPolicy{
+		MinimumPasswordLength: aws.Int32(8),
+		RequireSymbols:        true,
+	}
+
+	cases := map[string]struct {
+		in   v1beta1.AccountPasswordPolicyParameters
+		want bool
+	}{
+		"Unset": {
+			in:   v1beta1.AccountPasswordPolicyParameters{},
+			want: true,
+		},
+		"UpToDate": {
+			in: v1beta1.AccountPasswordPolicyParameters{
+				MinimumPasswordLength: aws.Int32(8),
+				MaxPasswordAge:        aws.Int32(0),
+				RequireSymbols:        aws.Bool(true),
+				RequireNumbers:        aws.Bool(false),
+			},
+			want: true,
+		},
+		"DifferentLength": {
+			in:   v1beta1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(14)},
+			want: false,
+		},
+		"DifferentBool": {
+			in:   v1beta1.AccountPasswordPolicyParameters{HardExpiry: aws.Bool(true)},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAccountPasswordPolicyUpToDate(tc.in, observed)
+			if got != tc.want {
+				t.Errorf("want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAccountPasswordPolicy(t *testing.T) {
+	in := v1beta1.AccountPasswordPolicyParameters{MinimumPasswordLength: aws.Int32(14)}
+	LateInitializeAccountPasswordPolicy(&in, types.PasswordPolicy{
+		MinimumPasswordLength:      aws.Int32(8),
+		MaxPasswordAge:             aws.Int32(90),
+		RequireUppercaseCharacters: true,
+	})
+	want := v1beta1.AccountPasswordPolicyParameters{
+		AllowUsersToChangePassword: aws.Bool(false),
+		HardExpiry:                 aws.Bool(false),
+		MaxPasswordAge:             aws.Int32(90),
+		MinimumPasswordLength:      aws.Int32(14),
+		PasswordReusePrevention:    aws.Int32(0),
+		RequireLowercaseCharacters: aws.Bool(false),
+		RequireNumbers:             aws.Bool(false),
+		RequireSymbols:             aws.Bool(false),
+		RequireUppercaseCharacters: aws.Bool(true),
+	}
+	if diff := cmp.Diff(want, in); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/iam"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountPasswordPolicyClient = (*MockAccountPasswordPolicyClient)(nil)
+
+// MockAccountPasswordPolicyClient is a type that implements all the methods for AccountPasswordPolicyClient interface
+type MockAccountPasswordPolicyClient struct {
+	MockGetAccountPasswordPolicy    func(ctx context.Context, input *iam.GetAccountPasswordPolicyInput, opts []func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error)
+	MockUpdateAccountPasswordPolicy func(ctx context.Context, input *iam.UpdateAccountPasswordPolicyInput, opts []func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error)
+	MockDeleteAccountPasswordPolicy func(ctx context.Context, input *iam.DeleteAccountPasswordPolicyInput, opts []func(*iam.Options)) (*iam.DeleteAccountPasswordPolicyOutput, error)
+}
+
+// GetAccountPasswordPolicy mocks client call.
+func (m *MockAccountPasswordPolicyClient) GetAccountPasswordPolicy(ctx context.Context, input *iam.GetAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.GetAccountPasswordPolicyOutput, error) {
+	return m.MockGetAccountPasswordPolicy(ctx, input, opts)
+}
+
+// UpdateAccountPasswordPolicy mocks client call.
+func (m *MockAccountPasswordPolicyClient) UpdateAccountPasswordPolicy(ctx context.Context, input *iam.UpdateAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.UpdateAccountPasswordPolicyOutput, error) {
+	return m.MockUpdateAccountPasswordPolicy(ctx, input, opts)
+}
+
+// DeleteAccountPasswordPolicy mocks client call.
+func (m *MockAccountPasswordPolicyClient) DeleteAccountPasswordPolicy(ctx context.Context, input *iam.DeleteAccountPasswordPolicyInput, opts ...func(*iam.Options)) (*iam.DeleteAccountPasswordPolicyOutput, error) {
+	return m.MockDeleteAccountPasswordPolicy(ctx, input, opts)
+}
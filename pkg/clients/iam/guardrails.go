@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	errGetProviderConfig      = "cannot get referenced ProviderConfig"
+	errApplyGuardrails        = "cannot apply the IAM guardrails of the ProviderConfig"
+	errFmtPathPrefix          = "path %s is not under the path prefix %s enforced by ProviderConfig %s"
+	errFmtPermissionsBoundary = "permissions boundary %s is not the permissions boundary %s enforced by ProviderConfig %s"
+)
+
+// DefaultPath returns the supplied path of an IAM resource, or the supplied
+// prefix if it has none. It returns false if the path is not under the
+// prefix.
+func DefaultPath(prefix string, path *string) (*string, bool) {
+	if path == nil {
+		return aws.String(prefix), true
+	}
+	return path, strings.HasPrefix(aws.ToString(path), prefix)
+}
+
+// DefaultPermissionsBoundary returns the supplied permissions boundary of an
+// IAM resource, or the supplied enforced one if it has none. It returns false
+// if it has a different permissions boundary.
+func DefaultPermissionsBoundary(enforced string, boundary *string) (*string, bool) {
+	if boundary == nil {
+		return aws.String(enforced), true
+	}
+	return boundary, aws.ToString(boundary) == enforced
+}
+
+// A GuardrailInitializer applies the IAM guardrails of the ProviderConfig of
+// Roles, Users and Policies before they are created.
+type GuardrailInitializer struct {
+	kube client.Client
+}
+
+// NewGuardrailInitializer returns a GuardrailInitializer that reads
+// ProviderConfigs and updates managed resources with the supplied client.
+func NewGuardrailInitializer(kube client.Client) *GuardrailInitializer {
+	return &GuardrailInitializer{kube: kube}
+}
+
+// Initialize sets the path and permissions boundary of the supplied managed
+// resource to those enforced by its ProviderConfig if it doesn't specify
+// them, and returns an error if it specifies others. Resources whose creation
+// was already attempted, or that were observed to exist, are left alone.
+func (g *GuardrailInitializer) Initialize(ctx context.Context, mg resource.Managed) error { // nolint:gocyclo
+	if !meta.GetExternalCreatePending(mg).IsZero() {
+		return nil
+	}
+	var path, boundary **string
+	switch cr := mg.(type) {
+	case *v1beta1.Role:
+		if cr.Status.AtProvider.ARN != "" {
+			return nil
+		}
+		path, boundary = &cr.Spec.ForProvider.Path, &cr.Spec.ForProvider.PermissionsBoundary
+	case *v1beta1.User:
+		if cr.Status.AtProvider.ARN != "" {
+			return nil
+		}
+		path, boundary = &cr.Spec.ForProvider.Path, &cr.Spec.ForProvider.PermissionsBoundary
+	case *v1beta1.Policy:
+		if cr.Status.AtProvider.ARN != "" {
+			return nil
+		}
+		path = &cr.Spec.ForProvider.Path
+	default:
+		return nil
+	}
+
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil
+	}
+	pc := &awsv1beta1.ProviderConfig{}
+	if err := g.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return errors.Wrap(err, errGetProviderConfig)
+	}
+	gr := pc.Spec.IAM
+	if gr == nil {
+		return nil
+	}
+
+	changed := false
+	if gr.PathPrefix != nil {
+		p, ok := DefaultPath(aws.ToString(gr.PathPrefix), *path)
+		if !ok {
+			return errors.Errorf(errFmtPathPrefix, aws.ToString(p), aws.ToString(gr.PathPrefix), pc.GetName())
+		}
+		changed = changed || *path == nil
+		*path = p
+	}
+	if gr.PermissionsBoundary != nil && boundary != nil {
+		b, ok := DefaultPermissionsBoundary(aws.ToString(gr.PermissionsBoundary), *boundary)
+		if !ok {
+			return errors.Errorf(errFmtPermissionsBoundary, aws.ToString(b), aws.ToString(gr.PermissionsBoundary), pc.GetName())
+		}
+		changed = changed || *boundary == nil
+		*boundary = b
+	}
+	if !changed {
+		return nil
+	}
+	return errors.Wrap(g.kube.Update(ctx, mg), errApplyGuardrails)
+}
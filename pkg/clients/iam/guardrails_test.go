@@ -0,0 +1,194 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var (
+	pathPrefix = "/platform/"
+	boundary   = "arn:aws:iam::123456789012:policy/platform-boundary"
+)
+
+func guardedRole(m ...func(*v1beta1.Role)) *v1beta1.Role {
+	cr := &v1beta1.Role{}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func kubeWithGuardrails(g *awsv1beta1.IAMGuardrails) *test.MockClient {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc := obj.(*awsv1beta1.ProviderConfig)
+			pc.SetName("default")
+			pc.Spec.IAM = g
+			return nil
+		},
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+}
+
+func TestGuardrailInitializer(t *testing.T) {
+	errBoom := errors.New("boom")
+	guardrails := &awsv1beta1.IAMGuardrails{PathPrefix: &pathPrefix, PermissionsBoundary: &boundary}
+
+	type want struct {
+		err error
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		mg     resource.Managed
+		want   want
+	}{
+		"Defaults": {
+			reason: "The enforced path and permissions boundary should be set if the role specifies neither.",
+			kube:   kubeWithGuardrails(guardrails),
+			mg:     guardedRole(),
+			want: want{
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					cr.Spec.ForProvider.Path = &pathPrefix
+					cr.Spec.ForProvider.PermissionsBoundary = &boundary
+				}),
+			},
+		},
+		"PathUnderPrefix": {
+			reason: "A path under the enforced prefix should be kept.",
+			kube:   kubeWithGuardrails(guardrails),
+			mg: guardedRole(func(cr *v1beta1.Role) {
+				cr.Spec.ForProvider.Path = aws.String("/platform/ci/")
+				cr.Spec.ForProvider.PermissionsBoundary = &boundary
+			}),
+			want: want{
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					cr.Spec.ForProvider.Path = aws.String("/platform/ci/")
+					cr.Spec.ForProvider.PermissionsBoundary = &boundary
+				}),
+			},
+		},
+		"PathNotUnderPrefix": {
+			reason: "A role whose path is not under the enforced prefix should be rejected.",
+			kube:   kubeWithGuardrails(guardrails),
+			mg: guardedRole(func(cr *v1beta1.Role) {
+				cr.Spec.ForProvider.Path = aws.String("/")
+			}),
+			want: want{
+				err: errors.Errorf(errFmtPathPrefix, "/", pathPrefix, "default"),
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					cr.Spec.ForProvider.Path = aws.String("/")
+				}),
+			},
+		},
+		"OtherPermissionsBoundary": {
+			reason: "A role with a different permissions boundary should be rejected.",
+			kube:   kubeWithGuardrails(guardrails),
+			mg: guardedRole(func(cr *v1beta1.Role) {
+				cr.Spec.ForProvider.PermissionsBoundary = aws.String("arn:aws:iam::aws:policy/AdministratorAccess")
+			}),
+			want: want{
+				err: errors.Errorf(errFmtPermissionsBoundary, "arn:aws:iam::aws:policy/AdministratorAccess", boundary, "default"),
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					cr.Spec.ForProvider.Path = &pathPrefix
+					cr.Spec.ForProvider.PermissionsBoundary = aws.String("arn:aws:iam::aws:policy/AdministratorAccess")
+				}),
+			},
+		},
+		"PolicyPathOnly": {
+			reason: "Policies should only be created under the enforced path prefix, since they have no permissions boundary.",
+			kube:   kubeWithGuardrails(guardrails),
+			mg:     &v1beta1.Policy{Spec: v1beta1.PolicySpec{ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}}}},
+			want: want{
+				mg: &v1beta1.Policy{Spec: v1beta1.PolicySpec{
+					ResourceSpec: xpv1.ResourceSpec{ProviderConfigReference: &xpv1.Reference{Name: "default"}},
+					ForProvider:  v1beta1.PolicyParameters{Path: &pathPrefix},
+				}},
+			},
+		},
+		"NoGuardrails": {
+			reason: "Nothing should change if the ProviderConfig enforces no guardrails.",
+			kube:   kubeWithGuardrails(nil),
+			mg:     guardedRole(),
+			want:   want{mg: guardedRole()},
+		},
+		"AlreadyCreated": {
+			reason: "Guardrails should not be applied to roles that were already created.",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: guardedRole(func(cr *v1beta1.Role) {
+				meta.SetExternalCreatePending(cr, time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC))
+			}),
+			want: want{
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					meta.SetExternalCreatePending(cr, time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC))
+				}),
+			},
+		},
+		"AlreadyObserved": {
+			reason: "Guardrails should not be applied to roles that were observed to exist.",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg: guardedRole(func(cr *v1beta1.Role) {
+				cr.Status.AtProvider.ARN = "arn:aws:iam::123456789012:role/existing"
+			}),
+			want: want{
+				mg: guardedRole(func(cr *v1beta1.Role) {
+					cr.Status.AtProvider.ARN = "arn:aws:iam::123456789012:role/existing"
+				}),
+			},
+		},
+		"GetProviderConfigError": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			kube:   &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			mg:     guardedRole(),
+			want: want{
+				err: errors.Wrap(errBoom, errGetProviderConfig),
+				mg:  guardedRole(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewGuardrailInitializer(tc.kube).Initialize(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.mg, tc.mg); diff != "" {
+				t.Errorf("\n%s\nInitialize(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
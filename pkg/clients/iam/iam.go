@@ -15,6 +15,15 @@ func IsErrorNotFound(err error) bool {
 	return errors.As(err, &notFoundError)
 }
 
+// NextMarker returns the marker of the next page of an IAM list operation, or
+// nil if the response held its last page.
+func NextMarker(truncated bool, marker *string) *string {
+	if !truncated {
+		return nil
+	}
+	return marker
+}
+
 // PolicyDocument is the structure of IAM policy document
 type PolicyDocument struct {
 	Version   string
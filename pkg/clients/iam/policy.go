@@ -2,7 +2,8 @@ package iam
 
 import (
 	"context"
-	"net/url"
+	"encoding/json"
+	"sort"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
@@ -10,11 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
-	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/normalize"
 )
 
+const errInvalidPolicyDocument = "policy document is not valid JSON"
+
 // PolicyClient is the external client used for Policy Custom Resource
 type PolicyClient interface {
 	GetPolicy(ctx context.Context, input *iam.GetPolicyInput, opts ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
@@ -43,30 +46,42 @@ func NewSTSClient(cfg aws.Config) STSClient {
 	return sts.NewFromConfig(cfg)
 }
 
+// MaxPolicyVersions is the number of versions IAM keeps for a managed policy.
+const MaxPolicyVersions = 5
+
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
+// The documents are compared semantically, i.e. ignoring formatting, the
+// order of keys and of lists of strings, and whether a single string is
+// wrapped in a list. IAM returns the document URL encoded.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
-	// The AWS API returns Policy Document as an escaped string.
-	// Due to differences in the methods to escape a string, the comparison result between
-	// the spec.Document and policy.Document can sometimes be false negative (due to spaces, line feeds).
-	// Escaping with a common method and then comparing is a safe way.
-
-	if *policy.Document == "" || in.Document == "" {
+	if aws.ToString(policy.Document) == "" || in.Document == "" {
 		return false, nil
 	}
-
-	unescapedPolicy, err := url.QueryUnescape(aws.ToString(policy.Document))
-	if err != nil {
-		return false, nil
+	if !json.Valid([]byte(in.Document)) {
+		return false, errors.New(errInvalidPolicyDocument)
 	}
+	return normalize.PolicyDocument(in.Document) == normalize.PolicyDocument(aws.ToString(policy.Document)), nil
+}
 
-	compactPolicy, err := awsclients.CompactAndEscapeJSON(unescapedPolicy)
-	if err != nil {
-		return false, err
+// PolicyVersionsToPrune returns the oldest non-default versions that have to
+// be deleted so that no more than keep versions remain. The default version
+// is never returned.
+func PolicyVersionsToPrune(versions []iamtypes.PolicyVersion, keep int) []iamtypes.PolicyVersion {
+	excess := len(versions) - keep
+	if excess <= 0 {
+		return nil
 	}
-	compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(in.Document)
-	if err != nil {
-		return false, err
+	candidates := make([]iamtypes.PolicyVersion, 0, len(versions))
+	for _, v := range versions {
+		if !v.IsDefaultVersion {
+			candidates = append(candidates, v)
+		}
 	}
-
-	return cmp.Equal(compactPolicy, compactSpecPolicy), nil
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return aws.ToTime(candidates[i].CreateDate).Before(aws.ToTime(candidates[j].CreateDate))
+	})
+	if excess > len(candidates) {
+		excess = len(candidates)
+	}
+	return candidates[:excess]
 }
@@ -1,10 +1,13 @@
 package iam
 
 import (
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
 )
@@ -35,6 +38,11 @@ var (
 		  }
 		]
 	   }`
+
+	// document1 as returned by IAM: URL encoded, with keys in a different
+	// order and the action wrapped in a list.
+	document1Observed = url.QueryEscape(`{"Statement":[{"Action":["sts:AssumeRole"],` +
+		`"Principal":{"Service":"eks.amazonaws.com"},"Effect":"Allow"}],"Version":"2012-10-17"}`)
 )
 
 func TestIsPolicyUpToDate(t *testing.T) {
@@ -58,6 +66,17 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"SemanticallyEqual": {
+			args: args{
+				p: v1beta1.PolicyParameters{
+					Document: document1,
+				},
+				version: iamtypes.PolicyVersion{
+					Document: &document1Observed,
+				},
+			},
+			want: true,
+		},
 		"DifferentFields": {
 			args: args{
 				p: v1beta1.PolicyParameters{
@@ -89,3 +108,59 @@ func TestIsPolicyUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestPolicyVersionsToPrune(t *testing.T) {
+	day := func(d int) *time.Time {
+		t := time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	version := func(id string, d int, isDefault bool) iamtypes.PolicyVersion {
+		return iamtypes.PolicyVersion{VersionId: aws.String(id), CreateDate: day(d), IsDefaultVersion: isDefault}
+	}
+
+	type args struct {
+		versions []iamtypes.PolicyVersion
+		keep     int
+	}
+
+	cases := map[string]struct {
+		args args
+		want []string
+	}{
+		"BelowLimit": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{version("v1", 1, false), version("v2", 2, true)},
+				keep:     MaxPolicyVersions - 1,
+			},
+		},
+		"PruneOldest": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{
+					version("v3", 3, false), version("v5", 5, false), version("v1", 1, true),
+					version("v4", 4, false), version("v2", 2, false),
+				},
+				keep: MaxPolicyVersions - 1,
+			},
+			want: []string{"v2"},
+		},
+		"NeverPruneDefault": {
+			args: args{
+				versions: []iamtypes.PolicyVersion{version("v1", 1, true), version("v2", 2, false)},
+				keep:     0,
+			},
+			want: []string{"v2"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			for _, v := range PolicyVersionsToPrune(tc.args.versions, tc.args.keep) {
+				got = append(got, aws.ToString(v.VersionId))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
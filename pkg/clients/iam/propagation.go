@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/pkg/errors"
+)
+
+// DefaultPropagationTimeout is how long operations that use IAM entities
+// which may not have propagated yet are retried by default.
+const DefaultPropagationTimeout = 30 * time.Second
+
+// propagationErrors are the codes, and fragments of the messages, of the
+// errors AWS services return when they are passed an IAM entity that was
+// only just created or changed, and is not visible to them yet.
+var propagationErrors = []struct {
+	code    string
+	message string
+}{
+	// IAM itself, e.g. when attaching a policy that was just created.
+	{code: "NoSuchEntity"},
+	// Lambda, when creating a function with a role that was just created.
+	{code: "InvalidParameterValueException", message: "cannot be assumed"},
+	// EKS, when creating a cluster, node group or Fargate profile with a
+	// role that was just created or had policies attached.
+	{code: "InvalidParameterException", message: "could not be assumed"},
+	{code: "InvalidParameterException", message: "role does not exist"},
+	{code: "InvalidParameterException", message: "doesn't have the Amazon EKS Managed Policies"},
+	// EC2, when launching an instance with an instance profile that was just
+	// created.
+	{code: "InvalidParameterValue", message: "Invalid IAM Instance Profile"},
+}
+
+// IsPropagationError returns true if the supplied error is likely caused by
+// an IAM entity that was only just created or changed, and has not
+// propagated to the AWS service that returned the error yet.
+func IsPropagationError(err error) bool {
+	var code, message string
+	var v1 awserr.Error
+	var v2 smithy.APIError
+	switch {
+	case errors.As(err, &v1):
+		code, message = v1.Code(), v1.Message()
+	case errors.As(err, &v2):
+		code, message = v2.ErrorCode(), v2.ErrorMessage()
+	default:
+		return false
+	}
+	for _, e := range propagationErrors {
+		if code == e.code && strings.Contains(strings.ToLower(message), strings.ToLower(e.message)) {
+			return true
+		}
+	}
+	return false
+}
+
+// propagationInterval is how long WaitForPropagation waits before the first
+// retry. It doubles with every retry.
+var propagationInterval = 1 * time.Second
+
+type propagationTimeoutKey struct{}
+
+// WithPropagationTimeout returns a copy of the supplied context in which
+// WaitForPropagation retries operations that fail because an IAM entity has
+// not propagated yet for the supplied duration. Retrying is disabled if it is
+// zero, or if the context has no propagation timeout.
+func WithPropagationTimeout(ctx context.Context, d time.Duration) context.Context {
+	return context.WithValue(ctx, propagationTimeoutKey{}, d)
+}
+
+// WaitForPropagation calls the supplied function until it returns nil or an
+// error that is not a propagation error, the propagation timeout of the
+// supplied context passed or the supplied context is done. It returns the error of the last call.
+//
+// IAM is eventually consistent. Creating a resource that uses an IAM role,
+// policy or instance profile that was created moments ago, e.g. by another
+// managed resource it references, often fails for several seconds. Waiting
+// for the IAM entity to propagate keeps that from being reported as an
+// error, and saves the reconcile backoff that would follow.
+func WaitForPropagation(ctx context.Context, fn func(ctx context.Context) error) error {
+	timeout, _ := ctx.Value(propagationTimeoutKey{}).(time.Duration)
+	deadline := time.Now().Add(timeout)
+	wait := propagationInterval
+	for {
+		err := fn(ctx)
+		if err == nil || !IsPropagationError(err) || time.Now().Add(wait).After(deadline) {
+			return err
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return err
+		case <-t.C:
+		}
+		wait *= 2
+	}
+}
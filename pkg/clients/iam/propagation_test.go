@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+var (
+	errNoSuchEntity   = &smithy.GenericAPIError{Code: "NoSuchEntity", Message: "The role with name lambda cannot be found."}
+	errRoleNotAssumed = awserr.New("InvalidParameterValueException", "The role defined for the function cannot be assumed by Lambda.", nil)
+	errAccessDenied   = &smithy.GenericAPIError{Code: "AccessDenied", Message: "User is not authorized to perform iam:PassRole"}
+)
+
+func TestIsPropagationError(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NoSuchEntity": {
+			err:  errors.Wrap(errNoSuchEntity, "cannot attach policy"),
+			want: true,
+		},
+		"LambdaRole": {
+			err:  errRoleNotAssumed,
+			want: true,
+		},
+		"EKSRole": {
+			err:  &smithy.GenericAPIError{Code: "InvalidParameterException", Message: "Role with arn: arn:aws:iam::123456789012:role/eks, could not be assumed because it does not exist or the trusted entity is not correct"},
+			want: true,
+		},
+		"OtherInvalidParameter": {
+			err: awserr.New("InvalidParameterValueException", "Unsupported runtime", nil),
+		},
+		"AccessDenied": {
+			err: errAccessDenied,
+		},
+		"NotAnAPIError": {
+			err: errors.New("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPropagationError(tc.err)); diff != "" {
+				t.Errorf("IsPropagationError(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWaitForPropagation(t *testing.T) {
+	propagationInterval = time.Millisecond
+	defer func() { propagationInterval = time.Second }()
+
+	type want struct {
+		err   error
+		calls int
+	}
+
+	cases := map[string]struct {
+		reason  string
+		timeout time.Duration
+		errs    []error
+		want    want
+	}{
+		"Propagated": {
+			reason:  "Calls should be retried until the IAM entity propagated.",
+			timeout: time.Minute,
+			errs:    []error{errNoSuchEntity, errRoleNotAssumed, nil},
+			want:    want{calls: 3},
+		},
+		"OtherError": {
+			reason:  "Errors not caused by propagation should be returned immediately.",
+			timeout: time.Minute,
+			errs:    []error{errNoSuchEntity, errAccessDenied, nil},
+			want:    want{err: errAccessDenied, calls: 2},
+		},
+		"Disabled": {
+			reason: "Calls should not be retried if the propagation timeout is zero.",
+			errs:   []error{errNoSuchEntity, nil},
+			want:   want{err: errNoSuchEntity, calls: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			err := WaitForPropagation(WithPropagationTimeout(context.Background(), tc.timeout), func(context.Context) error {
+				err := tc.errs[calls]
+				calls++
+				return err
+			})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nWaitForPropagation(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nWaitForPropagation(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
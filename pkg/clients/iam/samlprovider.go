@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+// SAMLProviderClient is the external client used for IAM SAMLProvider Custom Resource
+type SAMLProviderClient interface {
+	GetSAMLProvider(ctx context.Context, input *iam.GetSAMLProviderInput, opts ...func(*iam.Options)) (*iam.GetSAMLProviderOutput, error)
+	CreateSAMLProvider(ctx context.Context, input *iam.CreateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.CreateSAMLProviderOutput, error)
+	UpdateSAMLProvider(ctx context.Context, input *iam.UpdateSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UpdateSAMLProviderOutput, error)
+	DeleteSAMLProvider(ctx context.Context, input *iam.DeleteSAMLProviderInput, opts ...func(*iam.Options)) (*iam.DeleteSAMLProviderOutput, error)
+	TagSAMLProvider(ctx context.Context, input *iam.TagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.TagSAMLProviderOutput, error)
+	UntagSAMLProvider(ctx context.Context, input *iam.UntagSAMLProviderInput, opts ...func(*iam.Options)) (*iam.UntagSAMLProviderOutput, error)
+}
+
+// NewSAMLProviderClient returns a new client using AWS credentials as JSON encoded data.
+func NewSAMLProviderClient(cfg aws.Config) SAMLProviderClient {
+	return iam.NewFromConfig(cfg)
+}
+
+// GenerateSAMLProviderObservation is used to produce v1beta1.SAMLProviderObservation
+// from iam.GetSAMLProviderOutput
+func GenerateSAMLProviderObservation(observed iam.GetSAMLProviderOutput) v1beta1.SAMLProviderObservation {
+	o := v1beta1.SAMLProviderObservation{}
+	if observed.CreateDate != nil {
+		t := metav1.NewTime(*observed.CreateDate)
+		o.CreateDate = &t
+	}
+	if observed.ValidUntil != nil {
+		t := metav1.NewTime(*observed.ValidUntil)
+		o.ValidUntil = &t
+	}
+	return o
+}
+
+// IsSAMLMetadataDocumentUpToDate checks whether the observed SAML metadata
+// document equals the desired one, ignoring surrounding whitespace.
+func IsSAMLMetadataDocumentUpToDate(document string, observed iam.GetSAMLProviderOutput) bool {
+	return strings.TrimSpace(document) == strings.TrimSpace(aws.ToString(observed.SAMLMetadataDocument))
+}
+
+// IsSAMLProviderUpToDate checks whether there is a change in any of the modifiable fields in SAMLProvider.
+func IsSAMLProviderUpToDate(in v1beta1.SAMLProviderParameters, document string, observed iam.GetSAMLProviderOutput) bool {
+	_, _, areTagsUpToDate := DiffIAMTagsWithUpdates(in.Tags, observed.Tags)
+	return areTagsUpToDate && IsSAMLMetadataDocumentUpToDate(document, observed)
+}
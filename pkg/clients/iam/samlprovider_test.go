@@ -0,0 +1,79 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iam
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+)
+
+func TestIsSAMLProviderUpToDate(t *testing.T) {
+	type args struct {
+		input    v1beta1.SAMLProviderParameters
+		document string
+		observed iam.GetSAMLProviderOutput
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"DifferentDocument": {
+			args: args{
+				document: "<EntityDescriptor entityID=\"new\"/>",
+				observed: iam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String("<EntityDescriptor/>")},
+			},
+			want: false,
+		},
+		"DifferentTags": {
+			args: args{
+				input:    v1beta1.SAMLProviderParameters{Tags: []v1beta1.Tag{{Key: "key1", Value: "value1"}}},
+				document: "<EntityDescriptor/>",
+				observed: iam.GetSAMLProviderOutput{
+					SAMLMetadataDocument: aws.String("<EntityDescriptor/>"),
+					Tags:                 []types.Tag{{Key: aws.String("key2"), Value: aws.String("value2")}},
+				},
+			},
+			want: false,
+		},
+		"UpToDateIgnoringWhitespace": {
+			args: args{
+				input:    v1beta1.SAMLProviderParameters{Tags: []v1beta1.Tag{{Key: "key1", Value: "value1"}}},
+				document: "<EntityDescriptor/>\n",
+				observed: iam.GetSAMLProviderOutput{
+					SAMLMetadataDocument: aws.String("  <EntityDescriptor/>"),
+					Tags:                 []types.Tag{{Key: aws.String("key1"), Value: aws.String("value1")}},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := IsSAMLProviderUpToDate(tc.args.input, tc.args.document, tc.args.observed)
+			if o != tc.want {
+				t.Errorf("want %t, got %t", tc.want, o)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/account"
+)
+
+const (
+	errGetCallerIdentity   = "cannot get caller identity"
+	errParseIdentityARN    = "cannot parse caller identity ARN"
+	errGetProviderConfig   = "cannot get referenced ProviderConfig"
+	errNoProviderConfigRef = "managed resource does not reference a ProviderConfig"
+	errIdentityNotResolved = "identity of the referenced ProviderConfig has not been resolved yet"
+)
+
+// STSClient is the external client used to resolve the caller identity.
+type STSClient interface {
+	GetCallerIdentity(ctx context.Context, input *sts.GetCallerIdentityInput, opts ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error)
+}
+
+// NewSTSClient returns a new STS client using the supplied config.
+func NewSTSClient(cfg aws.Config) STSClient {
+	return sts.NewFromConfig(cfg)
+}
+
+// ResolveCallerIdentity calls STS GetCallerIdentity and returns the account
+// ID, ARN and partition of the principal the client is authenticated as.
+func ResolveCallerIdentity(ctx context.Context, c STSClient) (*v1beta1.CallerIdentity, error) {
+	resp, err := c.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, Wrap(err, errGetCallerIdentity)
+	}
+	a, err := arn.Parse(aws.ToString(resp.Arn))
+	if err != nil {
+		return nil, errors.Wrap(err, errParseIdentityARN)
+	}
+	return &v1beta1.CallerIdentity{
+		AccountID: aws.ToString(resp.Account),
+		ARN:       aws.ToString(resp.Arn),
+		Partition: a.Partition,
+		UserID:    aws.ToString(resp.UserId),
+	}, nil
+}
+
+// GetProviderConfigIdentity returns the caller identity published in the
+// status of the ProviderConfig referenced by the supplied managed resource.
+// It returns an error if the identity has not been resolved yet.
+func GetProviderConfigIdentity(ctx context.Context, c client.Client, mg resource.Managed) (*v1beta1.CallerIdentity, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return nil, errors.New(errNoProviderConfigRef)
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	if pc.Status.Identity == nil || pc.Status.Identity.AccountID == "" {
+		return nil, errors.New(errIdentityNotResolved)
+	}
+	return pc.Status.Identity, nil
+}
+
+// GetAccountID returns the ID of the AWS account that the ProviderConfig
+// referenced by the supplied managed resource resolves to.
+func GetAccountID(ctx context.Context, c client.Client, mg resource.Managed) (string, error) {
+	id, err := GetProviderConfigIdentity(ctx, c, mg)
+	if err != nil {
+		return "", err
+	}
+	return id.AccountID, nil
+}
+
+// setCallerAccountID reports the ID of the AWS account that the supplied
+// ProviderConfig resolved to in the status of the supplied managed resource,
+// if it has been resolved.
+func setCallerAccountID(mg resource.Managed, pc *v1beta1.ProviderConfig) {
+	if id := pc.Status.Identity; id != nil && id.AccountID != "" {
+		account.SetID(mg, id.AccountID)
+	}
+}
+
+// throttleKey returns the key of the AWS API request budget the requests made
+// with the credentials of the supplied ProviderConfig count against: the ID
+// of the AWS account it resolved to, which is shared with the ProviderConfigs
+// that resolve to the same account, or its name until it has been resolved.
+func throttleKey(pc *v1beta1.ProviderConfig) string {
+	if id := pc.Status.Identity; id != nil && id.AccountID != "" {
+		return id.AccountID
+	}
+	return "ProviderConfig/" + pc.GetName()
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+type mockSTSClient struct {
+	out *sts.GetCallerIdentityOutput
+	err error
+}
+
+func (m *mockSTSClient) GetCallerIdentity(_ context.Context, _ *sts.GetCallerIdentityInput, _ ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	return m.out, m.err
+}
+
+func TestResolveCallerIdentity(t *testing.T) {
+	type want struct {
+		id  *v1beta1.CallerIdentity
+		err error
+	}
+	boom := errors.New(errBoom)
+
+	cases := map[string]struct {
+		client STSClient
+		want   want
+	}{
+		"Successful": {
+			client: &mockSTSClient{out: &sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("arn:aws-cn:sts::123456789012:assumed-role/crossplane/session"),
+				UserId:  aws.String("AROAEXAMPLE:session"),
+			}},
+			want: want{
+				id: &v1beta1.CallerIdentity{
+					AccountID: "123456789012",
+					ARN:       "arn:aws-cn:sts::123456789012:assumed-role/crossplane/session",
+					Partition: "aws-cn",
+					UserID:    "AROAEXAMPLE:session",
+				},
+			},
+		},
+		"CallFailed": {
+			client: &mockSTSClient{err: boom},
+			want: want{
+				err: Wrap(boom, errGetCallerIdentity),
+			},
+		},
+		"InvalidARN": {
+			client: &mockSTSClient{out: &sts.GetCallerIdentityOutput{
+				Account: aws.String("123456789012"),
+				Arn:     aws.String("not-an-arn"),
+			}},
+			want: want{
+				err: errors.Wrap(errors.New("arn: invalid prefix"), errParseIdentityARN),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := ResolveCallerIdentity(context.Background(), tc.client)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetProviderConfigIdentity(t *testing.T) {
+	type want struct {
+		id  *v1beta1.CallerIdentity
+		err error
+	}
+	boom := errors.New(errBoom)
+	identity := &v1beta1.CallerIdentity{AccountID: "123456789012", ARN: "arn:aws:iam::123456789012:user/crossplane", Partition: "aws"}
+	withRef := &fake.Managed{ProviderConfigReferencer: fake.ProviderConfigReferencer{Ref: &xpv1.Reference{Name: "default"}}}
+
+	cases := map[string]struct {
+		kube client.Client
+		mg   *fake.Managed
+		want want
+	}{
+		"Resolved": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+				obj.(*v1beta1.ProviderConfig).Status.Identity = identity
+				return nil
+			})},
+			mg:   withRef,
+			want: want{id: identity},
+		},
+		"NotResolved": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+			mg:   withRef,
+			want: want{err: errors.New(errIdentityNotResolved)},
+		},
+		"GetFailed": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(boom)},
+			mg:   withRef,
+			want: want{err: errors.Wrap(boom, errGetProviderConfig)},
+		},
+		"NoReference": {
+			mg:   &fake.Managed{},
+			want: want{err: errors.New(errNoProviderConfigRef)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			id, err := GetProviderConfigIdentity(context.Background(), tc.kube, tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
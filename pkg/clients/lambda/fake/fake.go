@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// MockClient is a type that implements all the methods for the Lambda Client
+// interface.
+type MockClient struct {
+	MockCreateEventSourceMapping func(ctx context.Context, input *lambda.CreateEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockGetEventSourceMapping    func(ctx context.Context, input *lambda.GetEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockUpdateEventSourceMapping func(ctx context.Context, input *lambda.UpdateEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockDeleteEventSourceMapping func(ctx context.Context, input *lambda.DeleteEventSourceMappingInput, opts []request.Option) (*lambda.EventSourceMappingConfiguration, error)
+	MockAddPermission            func(ctx context.Context, input *lambda.AddPermissionInput, opts []request.Option) (*lambda.AddPermissionOutput, error)
+	MockGetPolicy                func(ctx context.Context, input *lambda.GetPolicyInput, opts []request.Option) (*lambda.GetPolicyOutput, error)
+	MockRemovePermission         func(ctx context.Context, input *lambda.RemovePermissionInput, opts []request.Option) (*lambda.RemovePermissionOutput, error)
+}
+
+// CreateEventSourceMappingWithContext mocks CreateEventSourceMappingWithContext method
+func (m *MockClient) CreateEventSourceMappingWithContext(ctx context.Context, input *lambda.CreateEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockCreateEventSourceMapping(ctx, input, opts)
+}
+
+// GetEventSourceMappingWithContext mocks GetEventSourceMappingWithContext method
+func (m *MockClient) GetEventSourceMappingWithContext(ctx context.Context, input *lambda.GetEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockGetEventSourceMapping(ctx, input, opts)
+}
+
+// UpdateEventSourceMappingWithContext mocks UpdateEventSourceMappingWithContext method
+func (m *MockClient) UpdateEventSourceMappingWithContext(ctx context.Context, input *lambda.UpdateEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockUpdateEventSourceMapping(ctx, input, opts)
+}
+
+// DeleteEventSourceMappingWithContext mocks DeleteEventSourceMappingWithContext method
+func (m *MockClient) DeleteEventSourceMappingWithContext(ctx context.Context, input *lambda.DeleteEventSourceMappingInput, opts ...request.Option) (*lambda.EventSourceMappingConfiguration, error) {
+	return m.MockDeleteEventSourceMapping(ctx, input, opts)
+}
+
+// AddPermissionWithContext mocks AddPermissionWithContext method
+func (m *MockClient) AddPermissionWithContext(ctx context.Context, input *lambda.AddPermissionInput, opts ...request.Option) (*lambda.AddPermissionOutput, error) {
+	return m.MockAddPermission(ctx, input, opts)
+}
+
+// GetPolicyWithContext mocks GetPolicyWithContext method
+func (m *MockClient) GetPolicyWithContext(ctx context.Context, input *lambda.GetPolicyInput, opts ...request.Option) (*lambda.GetPolicyOutput, error) {
+	return m.MockGetPolicy(ctx, input, opts)
+}
+
+// RemovePermissionWithContext mocks RemovePermissionWithContext method
+func (m *MockClient) RemovePermissionWithContext(ctx context.Context, input *lambda.RemovePermissionInput, opts ...request.Option) (*lambda.RemovePermissionOutput, error) {
+	return m.MockRemovePermission(ctx, input, opts)
+}
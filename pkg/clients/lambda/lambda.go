@@ -0,0 +1,320 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
+)
+
+// States of an event source mapping.
+const (
+	StateCreating  = "Creating"
+	StateEnabling  = "Enabling"
+	StateEnabled   = "Enabled"
+	StateDisabling = "Disabling"
+	StateDisabled  = "Disabled"
+	StateUpdating  = "Updating"
+	StateDeleting  = "Deleting"
+)
+
+// TransitionalStates are the states of an event source mapping that are
+// polled more often, since it can't be updated while in them.
+var TransitionalStates = pollinterval.Transitional(StateCreating, StateEnabling, StateDisabling, StateUpdating, StateDeleting)
+
+// Condition keys of the statements added by AddPermission.
+const (
+	conditionSourceARN        = "AWS:SourceArn"
+	conditionSourceAccount    = "AWS:SourceAccount"
+	conditionEventSourceToken = "lambda:EventSourceToken"
+)
+
+// Client defines the Lambda operations used by the event source mapping and
+// permission controllers.
+type Client interface {
+	CreateEventSourceMappingWithContext(context.Context, *awslambda.CreateEventSourceMappingInput, ...request.Option) (*awslambda.EventSourceMappingConfiguration, error)
+	GetEventSourceMappingWithContext(context.Context, *awslambda.GetEventSourceMappingInput, ...request.Option) (*awslambda.EventSourceMappingConfiguration, error)
+	UpdateEventSourceMappingWithContext(context.Context, *awslambda.UpdateEventSourceMappingInput, ...request.Option) (*awslambda.EventSourceMappingConfiguration, error)
+	DeleteEventSourceMappingWithContext(context.Context, *awslambda.DeleteEventSourceMappingInput, ...request.Option) (*awslambda.EventSourceMappingConfiguration, error)
+
+	AddPermissionWithContext(context.Context, *awslambda.AddPermissionInput, ...request.Option) (*awslambda.AddPermissionOutput, error)
+	GetPolicyWithContext(context.Context, *awslambda.GetPolicyInput, ...request.Option) (*awslambda.GetPolicyOutput, error)
+	RemovePermissionWithContext(context.Context, *awslambda.RemovePermissionInput, ...request.Option) (*awslambda.RemovePermissionOutput, error)
+}
+
+// NewClient returns a new Lambda client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awslambda.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the event source
+// mapping, the function or its policy does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awslambda.ErrCodeResourceNotFoundException
+}
+
+func generateDestinationConfig(p v1alpha1.EventSourceMappingParameters) *awslambda.DestinationConfig {
+	if p.OnFailure == nil {
+		return nil
+	}
+	return &awslambda.DestinationConfig{OnFailure: &awslambda.OnFailure{Destination: p.OnFailure.ARN}}
+}
+
+// GenerateCreateEventSourceMappingInput returns the input that creates an
+// event source mapping with the given parameters.
+func GenerateCreateEventSourceMappingInput(p v1alpha1.EventSourceMappingParameters) *awslambda.CreateEventSourceMappingInput {
+	in := &awslambda.CreateEventSourceMappingInput{
+		BatchSize:                      p.BatchSize,
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		DestinationConfig:              generateDestinationConfig(p),
+		Enabled:                        p.Enabled,
+		EventSourceArn:                 p.EventSourceARN,
+		FunctionName:                   p.FunctionName,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		StartingPosition:               p.StartingPosition,
+		TumblingWindowInSeconds:        p.TumblingWindowInSeconds,
+	}
+	if p.StartingPositionTimestamp != nil {
+		in.StartingPositionTimestamp = &p.StartingPositionTimestamp.Time
+	}
+	if len(p.FunctionResponseTypes) != 0 {
+		in.FunctionResponseTypes = aws.StringSlice(p.FunctionResponseTypes)
+	}
+	return in
+}
+
+// GenerateUpdateEventSourceMappingInput returns the input that updates the
+// event source mapping with the given UUID to the given parameters. The
+// event source and starting position can't be updated.
+func GenerateUpdateEventSourceMappingInput(uuid string, p v1alpha1.EventSourceMappingParameters) *awslambda.UpdateEventSourceMappingInput {
+	in := &awslambda.UpdateEventSourceMappingInput{
+		UUID:                           aws.String(uuid),
+		BatchSize:                      p.BatchSize,
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		DestinationConfig:              generateDestinationConfig(p),
+		Enabled:                        p.Enabled,
+		FunctionName:                   p.FunctionName,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		TumblingWindowInSeconds:        p.TumblingWindowInSeconds,
+	}
+	if len(p.FunctionResponseTypes) != 0 {
+		in.FunctionResponseTypes = aws.StringSlice(p.FunctionResponseTypes)
+	}
+	return in
+}
+
+// LateInitializeEventSourceMapping fills the empty fields of the given
+// parameters with the values of the event source mapping.
+func LateInitializeEventSourceMapping(p *v1alpha1.EventSourceMappingParameters, m *awslambda.EventSourceMappingConfiguration) {
+	if m == nil {
+		return
+	}
+	p.EventSourceARN = awsclient.LateInitializeStringPtr(p.EventSourceARN, m.EventSourceArn)
+	p.BatchSize = awsclient.LateInitializeInt64Ptr(p.BatchSize, m.BatchSize)
+	p.MaximumBatchingWindowInSeconds = awsclient.LateInitializeInt64Ptr(p.MaximumBatchingWindowInSeconds, m.MaximumBatchingWindowInSeconds)
+	p.ParallelizationFactor = awsclient.LateInitializeInt64Ptr(p.ParallelizationFactor, m.ParallelizationFactor)
+	p.BisectBatchOnFunctionError = awsclient.LateInitializeBoolPtr(p.BisectBatchOnFunctionError, m.BisectBatchOnFunctionError)
+	p.MaximumRecordAgeInSeconds = awsclient.LateInitializeInt64Ptr(p.MaximumRecordAgeInSeconds, m.MaximumRecordAgeInSeconds)
+	p.MaximumRetryAttempts = awsclient.LateInitializeInt64Ptr(p.MaximumRetryAttempts, m.MaximumRetryAttempts)
+	p.TumblingWindowInSeconds = awsclient.LateInitializeInt64Ptr(p.TumblingWindowInSeconds, m.TumblingWindowInSeconds)
+	p.StartingPosition = awsclient.LateInitializeStringPtr(p.StartingPosition, m.StartingPosition)
+}
+
+// IsFunction returns true if the given function name, ARN or partial ARN
+// identifies the function with the given ARN.
+func IsFunction(name, arn string) bool {
+	switch {
+	case strings.HasPrefix(name, "arn:"):
+		return name == arn
+	case strings.Contains(name, ":function:"):
+		return strings.HasSuffix(arn, ":"+name)
+	default:
+		return strings.HasSuffix(arn, ":function:"+name)
+	}
+}
+
+// IsEventSourceMappingUpToDate returns true if the event source mapping
+// matches the given parameters. A mapping that is in a transitional state
+// can't be updated, so it is considered up-to-date until it settles.
+func IsEventSourceMappingUpToDate(p v1alpha1.EventSourceMappingParameters, m *awslambda.EventSourceMappingConfiguration) bool {
+	state := aws.StringValue(m.State)
+	if TransitionalStates[strings.ToLower(state)] {
+		return true
+	}
+	if p.FunctionName != nil && !IsFunction(aws.StringValue(p.FunctionName), aws.StringValue(m.FunctionArn)) {
+		return false
+	}
+	if p.Enabled != nil && aws.BoolValue(p.Enabled) != (state == StateEnabled) {
+		return false
+	}
+	if p.OnFailure != nil {
+		var got *string
+		if m.DestinationConfig != nil && m.DestinationConfig.OnFailure != nil {
+			got = m.DestinationConfig.OnFailure.Destination
+		}
+		if aws.StringValue(p.OnFailure.ARN) != aws.StringValue(got) {
+			return false
+		}
+	}
+	got := v1alpha1.EventSourceMappingParameters{
+		BatchSize:                      m.BatchSize,
+		MaximumBatchingWindowInSeconds: m.MaximumBatchingWindowInSeconds,
+		ParallelizationFactor:          m.ParallelizationFactor,
+		BisectBatchOnFunctionError:     m.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      m.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           m.MaximumRetryAttempts,
+		TumblingWindowInSeconds:        m.TumblingWindowInSeconds,
+		FunctionResponseTypes:          aws.StringValueSlice(m.FunctionResponseTypes),
+	}
+	want := v1alpha1.EventSourceMappingParameters{
+		BatchSize:                      p.BatchSize,
+		MaximumBatchingWindowInSeconds: p.MaximumBatchingWindowInSeconds,
+		ParallelizationFactor:          p.ParallelizationFactor,
+		BisectBatchOnFunctionError:     p.BisectBatchOnFunctionError,
+		MaximumRecordAgeInSeconds:      p.MaximumRecordAgeInSeconds,
+		MaximumRetryAttempts:           p.MaximumRetryAttempts,
+		TumblingWindowInSeconds:        p.TumblingWindowInSeconds,
+		FunctionResponseTypes:          p.FunctionResponseTypes,
+	}
+	return cmp.Equal(want, got, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b string) bool { return a < b }))
+}
+
+// GenerateEventSourceMappingObservation returns the observation of the given
+// event source mapping.
+func GenerateEventSourceMappingObservation(m *awslambda.EventSourceMappingConfiguration) v1alpha1.EventSourceMappingObservation {
+	o := v1alpha1.EventSourceMappingObservation{
+		UUID:                  aws.StringValue(m.UUID),
+		FunctionARN:           aws.StringValue(m.FunctionArn),
+		State:                 aws.StringValue(m.State),
+		StateTransitionReason: aws.StringValue(m.StateTransitionReason),
+		LastProcessingResult:  aws.StringValue(m.LastProcessingResult),
+	}
+	if m.LastModified != nil {
+		t := metav1.NewTime(*m.LastModified)
+		o.LastModified = &t
+	}
+	return o
+}
+
+// GenerateAddPermissionInput returns the input that adds the statement with
+// the given ID and parameters to the policy of a function.
+func GenerateAddPermissionInput(sid string, p v1alpha1.PermissionParameters) *awslambda.AddPermissionInput {
+	return &awslambda.AddPermissionInput{
+		StatementId:      aws.String(sid),
+		Action:           aws.String(p.Action),
+		FunctionName:     p.FunctionName,
+		Qualifier:        p.Qualifier,
+		Principal:        aws.String(p.Principal),
+		SourceArn:        p.SourceARN,
+		SourceAccount:    p.SourceAccount,
+		EventSourceToken: p.EventSourceToken,
+	}
+}
+
+// A PolicyStatement is a statement of the resource-based policy of a
+// function.
+type PolicyStatement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Principal json.RawMessage              `json:"Principal"`
+	Action    string                       `json:"Action"`
+	Resource  string                       `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+type policy struct {
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+// FindStatement returns the statement with the given ID of the given policy
+// document, or nil if there is none.
+func FindStatement(doc, sid string) (*PolicyStatement, error) {
+	p := policy{}
+	if err := json.Unmarshal([]byte(doc), &p); err != nil {
+		return nil, err
+	}
+	for i := range p.Statement {
+		if p.Statement[i].Sid == sid {
+			return &p.Statement[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// principal returns the principal of the statement, which is either a
+// service principal, an ARN or *.
+func (s PolicyStatement) principal() string {
+	var str string
+	if err := json.Unmarshal(s.Principal, &str); err == nil {
+		return str
+	}
+	m := map[string]string{}
+	if err := json.Unmarshal(s.Principal, &m); err != nil {
+		return ""
+	}
+	if v, ok := m["Service"]; ok {
+		return v
+	}
+	return m["AWS"]
+}
+
+// condition returns the value of the given condition key, regardless of the
+// condition operator.
+func (s PolicyStatement) condition(key string) *string {
+	for _, c := range s.Condition {
+		if v, ok := c[key]; ok {
+			return aws.String(v)
+		}
+	}
+	return nil
+}
+
+// IsPermissionUpToDate returns true if the given statement matches the given
+// parameters. Account principals are added to the policy as the ARN of the
+// root user of the account.
+func IsPermissionUpToDate(p v1alpha1.PermissionParameters, s PolicyStatement) bool {
+	principal := s.principal()
+	if principal != p.Principal && !strings.HasSuffix(principal, ":iam::"+p.Principal+":root") {
+		return false
+	}
+	return s.Action == p.Action &&
+		aws.StringValue(s.condition(conditionSourceARN)) == aws.StringValue(p.SourceARN) &&
+		aws.StringValue(s.condition(conditionSourceAccount)) == aws.StringValue(p.SourceAccount) &&
+		aws.StringValue(s.condition(conditionEventSourceToken)) == aws.StringValue(p.EventSourceToken)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+)
+
+const (
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:handler"
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:events"
+)
+
+func TestIsFunction(t *testing.T) {
+	cases := map[string]struct {
+		name string
+		arn  string
+		want bool
+	}{
+		"Name":             {name: "handler", arn: functionARN, want: true},
+		"OtherName":        {name: "other", arn: functionARN, want: false},
+		"ARN":              {name: functionARN, arn: functionARN, want: true},
+		"PartialARN":       {name: "123456789012:function:handler", arn: functionARN, want: true},
+		"QualifiedName":    {name: "handler:live", arn: functionARN + ":live", want: true},
+		"AliasOfOtherName": {name: "live", arn: functionARN + ":live", want: false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsFunction(tc.name, tc.arn)); diff != "" {
+				t.Errorf("IsFunction(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsEventSourceMappingUpToDate(t *testing.T) {
+	mapping := func(m ...func(*awslambda.EventSourceMappingConfiguration)) *awslambda.EventSourceMappingConfiguration {
+		c := &awslambda.EventSourceMappingConfiguration{
+			UUID:           aws.String("uuid"),
+			FunctionArn:    aws.String(functionARN),
+			EventSourceArn: aws.String(queueARN),
+			State:          aws.String(StateEnabled),
+			BatchSize:      aws.Int64(10),
+		}
+		for _, f := range m {
+			f(c)
+		}
+		return c
+	}
+	cases := map[string]struct {
+		p    v1alpha1.EventSourceMappingParameters
+		m    *awslambda.EventSourceMappingConfiguration
+		want bool
+	}{
+		"UpToDate": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("handler"), Enabled: aws.Bool(true), BatchSize: aws.Int64(10)},
+			m:    mapping(),
+			want: true,
+		},
+		"DifferentBatchSize": {
+			p:    v1alpha1.EventSourceMappingParameters{BatchSize: aws.Int64(5)},
+			m:    mapping(),
+			want: false,
+		},
+		"Disabled": {
+			p:    v1alpha1.EventSourceMappingParameters{Enabled: aws.Bool(false)},
+			m:    mapping(),
+			want: false,
+		},
+		"DifferentFunction": {
+			p:    v1alpha1.EventSourceMappingParameters{FunctionName: aws.String("other")},
+			m:    mapping(),
+			want: false,
+		},
+		"DifferentOnFailure": {
+			p: v1alpha1.EventSourceMappingParameters{OnFailure: &v1alpha1.OnFailureDestination{ARN: aws.String(queueARN)}},
+			m: mapping(func(c *awslambda.EventSourceMappingConfiguration) {
+				c.DestinationConfig = &awslambda.DestinationConfig{OnFailure: &awslambda.OnFailure{Destination: aws.String("dlq")}}
+			}),
+			want: false,
+		},
+		"Transitioning": {
+			p:    v1alpha1.EventSourceMappingParameters{BatchSize: aws.Int64(5)},
+			m:    mapping(func(c *awslambda.EventSourceMappingConfiguration) { c.State = aws.String(StateUpdating) }),
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsEventSourceMappingUpToDate(tc.p, tc.m)); diff != "" {
+				t.Errorf("IsEventSourceMappingUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPermission(t *testing.T) {
+	doc := `{"Version":"2012-10-17","Id":"default","Statement":[` +
+		`{"Sid":"s3","Effect":"Allow","Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction","Resource":"` + functionARN + `",` +
+		`"Condition":{"StringEquals":{"AWS:SourceAccount":"123456789012"},"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::bucket"}}},` +
+		`{"Sid":"account","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::210987654321:root"},"Action":"lambda:GetFunction","Resource":"` + functionARN + `"}]}`
+
+	cases := map[string]struct {
+		sid      string
+		p        v1alpha1.PermissionParameters
+		found    bool
+		upToDate bool
+	}{
+		"NotFound": {
+			sid: "missing",
+		},
+		"ServiceUpToDate": {
+			sid: "s3",
+			p: v1alpha1.PermissionParameters{
+				Action:        "lambda:InvokeFunction",
+				Principal:     "s3.amazonaws.com",
+				SourceARN:     aws.String("arn:aws:s3:::bucket"),
+				SourceAccount: aws.String("123456789012"),
+			},
+			found:    true,
+			upToDate: true,
+		},
+		"DifferentSourceARN": {
+			sid: "s3",
+			p: v1alpha1.PermissionParameters{
+				Action:        "lambda:InvokeFunction",
+				Principal:     "s3.amazonaws.com",
+				SourceARN:     aws.String("arn:aws:s3:::other"),
+				SourceAccount: aws.String("123456789012"),
+			},
+			found: true,
+		},
+		"AccountUpToDate": {
+			sid:      "account",
+			p:        v1alpha1.PermissionParameters{Action: "lambda:GetFunction", Principal: "210987654321"},
+			found:    true,
+			upToDate: true,
+		},
+		"DifferentAction": {
+			sid:   "account",
+			p:     v1alpha1.PermissionParameters{Action: "lambda:InvokeFunction", Principal: "210987654321"},
+			found: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			s, err := FindStatement(doc, tc.sid)
+			if err != nil {
+				t.Fatalf("FindStatement(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.found, s != nil); diff != "" {
+				t.Fatalf("FindStatement(...): -want found, +got:\n%s", diff)
+			}
+			if s == nil {
+				return
+			}
+			if diff := cmp.Diff(tc.upToDate, IsPermissionUpToDate(tc.p, *s)); diff != "" {
+				t.Errorf("IsPermissionUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
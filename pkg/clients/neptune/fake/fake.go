@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/neptune"
+)
+
+// MockClient is a type that implements all the methods for the Neptune
+// Client interface.
+type MockClient struct {
+	MockDescribeDBInstances func(ctx context.Context, input *neptune.DescribeDBInstancesInput, opts []request.Option) (*neptune.DescribeDBInstancesOutput, error)
+	MockCreateDBInstance    func(ctx context.Context, input *neptune.CreateDBInstanceInput, opts []request.Option) (*neptune.CreateDBInstanceOutput, error)
+	MockModifyDBInstance    func(ctx context.Context, input *neptune.ModifyDBInstanceInput, opts []request.Option) (*neptune.ModifyDBInstanceOutput, error)
+	MockDeleteDBInstance    func(ctx context.Context, input *neptune.DeleteDBInstanceInput, opts []request.Option) (*neptune.DeleteDBInstanceOutput, error)
+}
+
+// DescribeDBInstancesWithContext mocks DescribeDBInstancesWithContext method
+func (m *MockClient) DescribeDBInstancesWithContext(ctx context.Context, input *neptune.DescribeDBInstancesInput, opts ...request.Option) (*neptune.DescribeDBInstancesOutput, error) {
+	return m.MockDescribeDBInstances(ctx, input, opts)
+}
+
+// CreateDBInstanceWithContext mocks CreateDBInstanceWithContext method
+func (m *MockClient) CreateDBInstanceWithContext(ctx context.Context, input *neptune.CreateDBInstanceInput, opts ...request.Option) (*neptune.CreateDBInstanceOutput, error) {
+	return m.MockCreateDBInstance(ctx, input, opts)
+}
+
+// ModifyDBInstanceWithContext mocks ModifyDBInstanceWithContext method
+func (m *MockClient) ModifyDBInstanceWithContext(ctx context.Context, input *neptune.ModifyDBInstanceInput, opts ...request.Option) (*neptune.ModifyDBInstanceOutput, error) {
+	return m.MockModifyDBInstance(ctx, input, opts)
+}
+
+// DeleteDBInstanceWithContext mocks DeleteDBInstanceWithContext method
+func (m *MockClient) DeleteDBInstanceWithContext(ctx context.Context, input *neptune.DeleteDBInstanceInput, opts ...request.Option) (*neptune.DeleteDBInstanceOutput, error) {
+	return m.MockDeleteDBInstance(ctx, input, opts)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package neptune
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsneptune "github.com/aws/aws-sdk-go/service/neptune"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the Neptune operations used by the DBInstance controller.
+type Client interface {
+	DescribeDBInstancesWithContext(context.Context, *awsneptune.DescribeDBInstancesInput, ...request.Option) (*awsneptune.DescribeDBInstancesOutput, error)
+	CreateDBInstanceWithContext(context.Context, *awsneptune.CreateDBInstanceInput, ...request.Option) (*awsneptune.CreateDBInstanceOutput, error)
+	ModifyDBInstanceWithContext(context.Context, *awsneptune.ModifyDBInstanceInput, ...request.Option) (*awsneptune.ModifyDBInstanceOutput, error)
+	DeleteDBInstanceWithContext(context.Context, *awsneptune.DeleteDBInstanceInput, ...request.Option) (*awsneptune.DeleteDBInstanceOutput, error)
+}
+
+// NewClient returns a new Neptune client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsneptune.New(sess)
+}
+
+// IsDBInstanceNotFound returns true if the error indicates that the DB
+// instance does not exist.
+func IsDBInstanceNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsneptune.ErrCodeDBInstanceNotFoundFault
+}
+
+// GenerateCreateDBInstanceInput returns the input that creates the DB
+// instance with the given identifier and parameters.
+func GenerateCreateDBInstanceInput(id string, p v1alpha1.DBInstanceParameters) *awsneptune.CreateDBInstanceInput {
+	in := &awsneptune.CreateDBInstanceInput{
+		DBInstanceIdentifier:       awsclient.String(id),
+		DBInstanceClass:            awsclient.String(p.DBInstanceClass),
+		Engine:                     awsclient.String(p.Engine),
+		DBClusterIdentifier:        p.DBClusterIdentifier,
+		AvailabilityZone:           p.AvailabilityZone,
+		AutoMinorVersionUpgrade:    p.AutoMinorVersionUpgrade,
+		DBParameterGroupName:       p.DBParameterGroupName,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		PromotionTier:              p.PromotionTier,
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, &awsneptune.Tag{Key: t.Key, Value: t.Value})
+	}
+	return in
+}
+
+// GenerateModifyDBInstanceInput returns the input that brings the mutable
+// fields of the DB instance in line with the given parameters.
+func GenerateModifyDBInstanceInput(id string, p v1alpha1.DBInstanceParameters) *awsneptune.ModifyDBInstanceInput {
+	return &awsneptune.ModifyDBInstanceInput{
+		DBInstanceIdentifier:       awsclient.String(id),
+		DBInstanceClass:            awsclient.String(p.DBInstanceClass),
+		AutoMinorVersionUpgrade:    p.AutoMinorVersionUpgrade,
+		DBParameterGroupName:       p.DBParameterGroupName,
+		PreferredMaintenanceWindow: p.PreferredMaintenanceWindow,
+		PromotionTier:              p.PromotionTier,
+		ApplyImmediately:           p.ApplyImmediately,
+	}
+}
+
+// LateInitializeDBInstance fills the empty fields of the given parameters
+// with the values of the observed DB instance.
+func LateInitializeDBInstance(p *v1alpha1.DBInstanceParameters, db *awsneptune.DBInstance) {
+	if db == nil {
+		return
+	}
+	p.Engine = awsclient.LateInitializeString(p.Engine, db.Engine)
+	p.DBClusterIdentifier = awsclient.LateInitializeStringPtr(p.DBClusterIdentifier, db.DBClusterIdentifier)
+	p.AvailabilityZone = awsclient.LateInitializeStringPtr(p.AvailabilityZone, db.AvailabilityZone)
+	p.AutoMinorVersionUpgrade = awsclient.LateInitializeBoolPtr(p.AutoMinorVersionUpgrade, db.AutoMinorVersionUpgrade)
+	p.PreferredMaintenanceWindow = awsclient.LateInitializeStringPtr(p.PreferredMaintenanceWindow, db.PreferredMaintenanceWindow)
+	p.PromotionTier = awsclient.LateInitializeInt64Ptr(p.PromotionTier, db.PromotionTier)
+	if len(db.DBParameterGroups) > 0 {
+		p.DBParameterGroupName = awsclient.LateInitializeStringPtr(p.DBParameterGroupName, db.DBParameterGroups[0].DBParameterGroupName)
+	}
+}
+
+// IsDBInstanceUpToDate returns true if the mutable fields of the observed DB
+// instance match the given parameters. Pending modifications count as
+// applied so that changes waiting for the maintenance window are not
+// requested again.
+func IsDBInstanceUpToDate(p v1alpha1.DBInstanceParameters, db *awsneptune.DBInstance) bool {
+	class := awsclient.StringValue(db.DBInstanceClass)
+	if db.PendingModifiedValues != nil && db.PendingModifiedValues.DBInstanceClass != nil {
+		class = awsclient.StringValue(db.PendingModifiedValues.DBInstanceClass)
+	}
+	if p.DBInstanceClass != class {
+		return false
+	}
+	if p.AutoMinorVersionUpgrade != nil && awsclient.BoolValue(p.AutoMinorVersionUpgrade) != awsclient.BoolValue(db.AutoMinorVersionUpgrade) {
+		return false
+	}
+	if p.PreferredMaintenanceWindow != nil && awsclient.StringValue(p.PreferredMaintenanceWindow) != awsclient.StringValue(db.PreferredMaintenanceWindow) {
+		return false
+	}
+	if p.PromotionTier != nil && awsclient.Int64Value(p.PromotionTier) != awsclient.Int64Value(db.PromotionTier) {
+		return false
+	}
+	if p.DBParameterGroupName != nil {
+		if len(db.DBParameterGroups) == 0 || awsclient.StringValue(p.DBParameterGroupName) != awsclient.StringValue(db.DBParameterGroups[0].DBParameterGroupName) {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateDBInstanceObservation returns the observation of the given DB
+// instance.
+func GenerateDBInstanceObservation(db *awsneptune.DBInstance) v1alpha1.DBInstanceObservation {
+	o := v1alpha1.DBInstanceObservation{
+		DBInstanceARN:    awsclient.StringValue(db.DBInstanceArn),
+		DBInstanceStatus: awsclient.StringValue(db.DBInstanceStatus),
+		DBIResourceID:    awsclient.StringValue(db.DbiResourceId),
+		EngineVersion:    awsclient.StringValue(db.EngineVersion),
+	}
+	if db.Endpoint != nil {
+		o.Endpoint = v1alpha1.DBInstanceEndpoint{
+			Address:      awsclient.StringValue(db.Endpoint.Address),
+			Port:         awsclient.Int64Value(db.Endpoint.Port),
+			HostedZoneID: awsclient.StringValue(db.Endpoint.HostedZoneId),
+		}
+	}
+	return o
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package normalize contains normalizers that bring values to the canonical
+// form AWS stores them in, so that cosmetic differences between the desired
+// and the observed state of a resource are not reported as drift.
+package normalize
+
+import (
+	"encoding/json"
+	"net"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// A Func returns the canonical form of a value that AWS may report in a
+// different but semantically equal representation.
+type Func func(string) string
+
+// Lowercase returns the lowercased value. Many AWS APIs accept identifiers in
+// any case but return them in lowercase.
+func Lowercase(s string) string {
+	return strings.ToLower(s)
+}
+
+// CIDR returns the network of a CIDR block, e.g. 10.0.0.1/16 becomes
+// 10.0.0.0/16 and 2001:DB8::/32 becomes 2001:db8::/32. Values that are not
+// CIDR blocks are returned unchanged.
+func CIDR(s string) string {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		return s
+	}
+	return n.String()
+}
+
+// PolicyDocument returns the compact form of a JSON policy document. Keys and
+// lists of strings are sorted, and lists holding a single string are replaced
+// by that string, which is how AWS evaluates them. URL encoded documents, as
+// returned by IAM, are decoded first. Values that aren't JSON documents are
+// returned unchanged.
+func PolicyDocument(s string) string {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(s), &doc); err != nil {
+		decoded, uerr := url.QueryUnescape(s)
+		if uerr != nil || json.Unmarshal([]byte(decoded), &doc) != nil {
+			return s
+		}
+	}
+	// encoding/json sorts map keys when marshalling.
+	out, err := json.Marshal(canonicalPolicy(doc))
+	if err != nil {
+		return s
+	}
+	return string(out)
+}
+
+func canonicalPolicy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			t[k] = canonicalPolicy(e)
+		}
+		return t
+	case []interface{}:
+		strs := make([]string, 0, len(t))
+		for i := range t {
+			t[i] = canonicalPolicy(t[i])
+			if s, ok := t[i].(string); ok {
+				strs = append(strs, s)
+			}
+		}
+		if len(strs) != len(t) {
+			return t
+		}
+		if len(strs) == 1 {
+			return strs[0]
+		}
+		sort.Strings(strs)
+		return strs
+	}
+	return v
+}
+
+// Duration returns a Func that converts a duration into a whole number of the
+// supplied unit, e.g. with time.Second both 1h and 3600 become 3600. Numbers
+// without a unit are taken to be in the supplied unit already. Values that
+// aren't durations are returned unchanged.
+func Duration(unit time.Duration) Func {
+	return func(s string) string {
+		if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return strings.TrimLeft(s, "+")
+		}
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return s
+		}
+		return strconv.FormatInt(int64(d/unit), 10)
+	}
+}
+
+// Fields maps the path of a field to the Func that normalizes it. A path
+// consists of the Go field names leading from the root of a parameters struct
+// to the field, separated by dots. Slice elements and pointers do not add to
+// the path, e.g. Routes.DestinationCIDRBlock addresses the destination of
+// every route. This is the format of cmp.Path.String.
+type Fields map[string]Func
+
+var (
+	mu       sync.RWMutex
+	registry = map[string]Fields{}
+)
+
+// Register the normalizers for the fields of the supplied kind, e.g.
+// QueueGroupKind. Normalizers are merged with those already registered for
+// the kind. The resulting normalizers are returned so that callers can keep
+// them in a package variable.
+func Register(groupKind string, f Fields) Fields {
+	mu.Lock()
+	defer mu.Unlock()
+	merged := Fields{}
+	for p, fn := range registry[groupKind] {
+		merged[p] = fn
+	}
+	for p, fn := range f {
+		merged[p] = fn
+	}
+	registry[groupKind] = merged
+	return merged
+}
+
+// For returns the normalizers registered for the supplied kind. An empty set
+// of normalizers is returned if none have been registered.
+func For(groupKind string) Fields {
+	mu.RLock()
+	defer mu.RUnlock()
+	return registry[groupKind]
+}
+
+// Normalize returns the canonical form of the value of the field at the
+// supplied path. Values of fields without a normalizer are returned as is.
+func (f Fields) Normalize(path, v string) string {
+	fn, ok := f[path]
+	if !ok {
+		return v
+	}
+	return fn(v)
+}
+
+// Equal returns true if a and b are equal once normalized as the value of
+// the field at the supplied path.
+func (f Fields) Equal(path, a, b string) bool {
+	return f.Normalize(path, a) == f.Normalize(path, b)
+}
+
+// Options returns cmp options that compare the string fields for which a
+// normalizer is registered by their canonical form.
+func (f Fields) Options() cmp.Options {
+	opts := make(cmp.Options, 0, len(f))
+	for p, fn := range f {
+		p, fn := p, fn
+		opts = append(opts, cmp.FilterPath(func(cp cmp.Path) bool {
+			return cp.String() == p
+		}, cmp.Comparer(func(a, b string) bool {
+			return fn(a) == fn(b)
+		})))
+	}
+	return opts
+}
+
+// Apply normalizes the string fields of the struct pointed to by v in place.
+// Values behind pointers are overwritten, so Apply must only be called on
+// copies that share no pointers with a managed resource, e.g. the result of
+// DeepCopy.
+func (f Fields) Apply(v interface{}) {
+	if len(f) == 0 {
+		return
+	}
+	f.apply(reflect.ValueOf(v), "")
+}
+
+func (f Fields) apply(v reflect.Value, path string) {
+	switch v.Kind() { // nolint:exhaustive
+	case reflect.Ptr:
+		if !v.IsNil() {
+			f.apply(v.Elem(), path)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			if t.Field(i).PkgPath != "" {
+				continue
+			}
+			p := t.Field(i).Name
+			if path != "" {
+				p = path + "." + p
+			}
+			f.apply(v.Field(i), p)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			f.apply(v.Index(i), path)
+		}
+	case reflect.String:
+		if fn, ok := f[path]; ok && v.CanSet() {
+			v.SetString(fn(v.String()))
+		}
+	}
+}
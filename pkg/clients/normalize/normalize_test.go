@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package normalize
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFuncs(t *testing.T) {
+	cases := map[string]struct {
+		fn   Func
+		in   string
+		want string
+	}{
+		"Lowercase": {
+			fn:   Lowercase,
+			in:   "Mon:03:00-Mon:04:00",
+			want: "mon:03:00-mon:04:00",
+		},
+		"CIDRHostBits": {
+			fn:   CIDR,
+			in:   "10.0.0.1/16",
+			want: "10.0.0.0/16",
+		},
+		"CIDRIPv6": {
+			fn:   CIDR,
+			in:   "2001:DB8:0:0::/32",
+			want: "2001:db8::/32",
+		},
+		"CIDRInvalid": {
+			fn:   CIDR,
+			in:   "pl-12345",
+			want: "pl-12345",
+		},
+		"PolicyDocument": {
+			fn: PolicyDocument,
+			in: `{
+				"Version": "2012-10-17",
+				"Statement": [{
+					"Effect": "Allow",
+					"Action": ["sqs:SendMessage"],
+					"Resource": ["b", "a"]
+				}]
+			}`,
+			want: `{"Statement":[{"Action":"sqs:SendMessage","Effect":"Allow","Resource":["a","b"]}],"Version":"2012-10-17"}`,
+		},
+		"PolicyDocumentURLEncoded": {
+			fn:   PolicyDocument,
+			in:   `%7B%22Version%22%3A%222012-10-17%22%7D`,
+			want: `{"Version":"2012-10-17"}`,
+		},
+		"PolicyDocumentInvalid": {
+			fn:   PolicyDocument,
+			in:   "not-json",
+			want: "not-json",
+		},
+		"DurationWithUnit": {
+			fn:   Duration(time.Second),
+			in:   "1h",
+			want: "3600",
+		},
+		"DurationWithoutUnit": {
+			fn:   Duration(time.Minute),
+			in:   "60",
+			want: "60",
+		},
+		"DurationInvalid": {
+			fn:   Duration(time.Second),
+			in:   "forever",
+			want: "forever",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.fn(tc.in)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type route struct {
+	Destination *string
+	Target      string
+}
+
+type params struct {
+	Name   string
+	Routes []route
+	Tags   map[string]string
+}
+
+func TestApply(t *testing.T) {
+	dst := "10.0.0.1/16"
+	f := Fields{
+		"Name":               Lowercase,
+		"Routes.Destination": CIDR,
+	}
+	got := &params{
+		Name:   "Example",
+		Routes: []route{{Destination: &dst, Target: "IGW"}},
+		Tags:   map[string]string{"Name": "Example"},
+	}
+	f.Apply(got)
+
+	want := &params{
+		Name:   "example",
+		Routes: []route{{Destination: stringPtr("10.0.0.0/16"), Target: "IGW"}},
+		Tags:   map[string]string{"Name": "Example"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	f := Fields{"Routes.Destination": CIDR}
+	a := params{Routes: []route{{Destination: stringPtr("10.0.0.1/16")}}}
+	b := params{Routes: []route{{Destination: stringPtr("10.0.0.0/16")}}}
+	if !cmp.Equal(a, b, f.Options()) {
+		t.Errorf("Equal(...): want true, got false")
+	}
+	b.Routes[0].Target = "igw"
+	if cmp.Equal(a, b, f.Options()) {
+		t.Errorf("Equal(...): want false, got true")
+	}
+}
+
+func TestRegister(t *testing.T) {
+	gk := "Example.test.crossplane.io"
+	Register(gk, Fields{"Name": Lowercase})
+	Register(gk, Fields{"Routes.Destination": CIDR})
+
+	f := For(gk)
+	if !f.Equal("Name", "A", "a") {
+		t.Errorf("Equal(Name): want true, got false")
+	}
+	if !f.Equal("Routes.Destination", "10.0.0.1/8", "10.0.0.0/8") {
+		t.Errorf("Equal(Routes.Destination): want true, got false")
+	}
+	if f.Equal("Other", "A", "a") {
+		t.Errorf("Equal(Other): want false, got true")
+	}
+}
+
+func stringPtr(s string) *string { return &s }
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package observeonly allows managed resources to be observed without the
+// provider ever changing or deleting the external resources they represent.
+package observeonly
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKey of managed resources that are observed only. Its value must
+// be "true".
+const AnnotationKey = "aws.crossplane.io/observe-only"
+
+const errNotFound = "external resource does not exist and is not created because the managed resource is observed only"
+
+// IsObserveOnly returns true if the supplied managed resource is observed
+// only.
+func IsObserveOnly(mg resource.Managed) bool {
+	return mg.GetAnnotations()[AnnotationKey] == "true"
+}
+
+// A Connecter returns ExternalClients that only observe managed resources
+// that are observed only, and that are passed through otherwise.
+type Connecter struct {
+	managed.ExternalConnecter
+}
+
+// NewConnecter returns a Connecter that wraps the supplied ExternalConnecter.
+func NewConnecter(c managed.ExternalConnecter) *Connecter {
+	return &Connecter{ExternalConnecter: c}
+}
+
+// Connect returns the ExternalClient of the wrapped ExternalConnecter, which
+// only observes the supplied managed resource if it is observed only.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil || !IsObserveOnly(mg) {
+		return e, err
+	}
+	return &external{ExternalClient: e}, nil
+}
+
+type external struct {
+	managed.ExternalClient
+}
+
+// Observe reports the external resource as up to date, so that it is never
+// updated, and as nonexistent once the managed resource was deleted, so that
+// the external resource is kept regardless of the deletion policy.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if meta.WasDeleted(mg) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil {
+		return obs, err
+	}
+	if !obs.ResourceExists {
+		return obs, errors.New(errNotFound)
+	}
+	obs.ResourceUpToDate = true
+	return obs, nil
+}
+
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, errors.New(errNotFound)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
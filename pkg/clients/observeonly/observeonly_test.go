@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package observeonly
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestObserveOnly(t *testing.T) {
+	errUnexpected := errors.New("unexpected call")
+
+	ec := &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+			return managed.ExternalObservation{ResourceExists: mg.GetName() == "exists"}, nil
+		},
+		CreateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			return managed.ExternalCreation{}, errUnexpected
+		},
+		UpdateFn: func(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+			return managed.ExternalUpdate{}, errUnexpected
+		},
+		DeleteFn: func(_ context.Context, _ resource.Managed) error {
+			return errUnexpected
+		},
+	}
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	}))
+
+	observeOnly := func(name string) *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetName(name)
+		mg.SetAnnotations(map[string]string{AnnotationKey: "true"})
+		return mg
+	}
+	deleted := observeOnly("exists")
+	now := metav1.Now()
+	deleted.SetDeletionTimestamp(&now)
+
+	type want struct {
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     resource.Managed
+		want   want
+	}{
+		"Exists": {
+			reason: "Existing external resources should be reported as up to date.",
+			mg:     observeOnly("exists"),
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DoesNotExist": {
+			reason: "External resources that don't exist should be reported as an error, since they are never created.",
+			mg:     observeOnly("missing"),
+			want: want{
+				err: errors.New(errNotFound),
+			},
+		},
+		"Deleted": {
+			reason: "External resources of deleted managed resources should be reported as nonexistent, so that they are not deleted.",
+			mg:     deleted,
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NotObserveOnly": {
+			reason: "Managed resources that aren't observed only should be passed through.",
+			mg:     &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "exists"}},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e, err := c.Connect(context.Background(), tc.mg)
+			if err != nil {
+				t.Fatalf("Connect(...): %s", err)
+			}
+			obs, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if !IsObserveOnly(tc.mg) {
+				return
+			}
+			if _, err := e.Update(context.Background(), tc.mg); err != nil {
+				t.Errorf("\n%s\nUpdate(...): %s", tc.reason, err)
+			}
+			if err := e.Delete(context.Background(), tc.mg); err != nil {
+				t.Errorf("\n%s\nDelete(...): %s", tc.reason, err)
+			}
+		})
+	}
+}
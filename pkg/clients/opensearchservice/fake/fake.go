@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
+)
+
+// MockClient is a type that implements all the methods for the OpenSearch
+// Service Client interface.
+type MockClient struct {
+	MockCreateDomain       func(ctx context.Context, input *opensearchservice.CreateDomainInput, opts []request.Option) (*opensearchservice.CreateDomainOutput, error)
+	MockDescribeDomain     func(ctx context.Context, input *opensearchservice.DescribeDomainInput, opts []request.Option) (*opensearchservice.DescribeDomainOutput, error)
+	MockUpdateDomainConfig func(ctx context.Context, input *opensearchservice.UpdateDomainConfigInput, opts []request.Option) (*opensearchservice.UpdateDomainConfigOutput, error)
+	MockDeleteDomain       func(ctx context.Context, input *opensearchservice.DeleteDomainInput, opts []request.Option) (*opensearchservice.DeleteDomainOutput, error)
+	MockListTags           func(ctx context.Context, input *opensearchservice.ListTagsInput, opts []request.Option) (*opensearchservice.ListTagsOutput, error)
+	MockAddTags            func(ctx context.Context, input *opensearchservice.AddTagsInput, opts []request.Option) (*opensearchservice.AddTagsOutput, error)
+	MockRemoveTags         func(ctx context.Context, input *opensearchservice.RemoveTagsInput, opts []request.Option) (*opensearchservice.RemoveTagsOutput, error)
+}
+
+// CreateDomainWithContext mocks CreateDomainWithContext method
+func (m *MockClient) CreateDomainWithContext(ctx context.Context, input *opensearchservice.CreateDomainInput, opts ...request.Option) (*opensearchservice.CreateDomainOutput, error) {
+	return m.MockCreateDomain(ctx, input, opts)
+}
+
+// DescribeDomainWithContext mocks DescribeDomainWithContext method
+func (m *MockClient) DescribeDomainWithContext(ctx context.Context, input *opensearchservice.DescribeDomainInput, opts ...request.Option) (*opensearchservice.DescribeDomainOutput, error) {
+	return m.MockDescribeDomain(ctx, input, opts)
+}
+
+// UpdateDomainConfigWithContext mocks UpdateDomainConfigWithContext method
+func (m *MockClient) UpdateDomainConfigWithContext(ctx context.Context, input *opensearchservice.UpdateDomainConfigInput, opts ...request.Option) (*opensearchservice.UpdateDomainConfigOutput, error) {
+	return m.MockUpdateDomainConfig(ctx, input, opts)
+}
+
+// DeleteDomainWithContext mocks DeleteDomainWithContext method
+func (m *MockClient) DeleteDomainWithContext(ctx context.Context, input *opensearchservice.DeleteDomainInput, opts ...request.Option) (*opensearchservice.DeleteDomainOutput, error) {
+	return m.MockDeleteDomain(ctx, input, opts)
+}
+
+// ListTagsWithContext mocks ListTagsWithContext method
+func (m *MockClient) ListTagsWithContext(ctx context.Context, input *opensearchservice.ListTagsInput, opts ...request.Option) (*opensearchservice.ListTagsOutput, error) {
+	return m.MockListTags(ctx, input, opts)
+}
+
+// AddTagsWithContext mocks AddTagsWithContext method
+func (m *MockClient) AddTagsWithContext(ctx context.Context, input *opensearchservice.AddTagsInput, opts ...request.Option) (*opensearchservice.AddTagsOutput, error) {
+	return m.MockAddTags(ctx, input, opts)
+}
+
+// RemoveTagsWithContext mocks RemoveTagsWithContext method
+func (m *MockClient) RemoveTagsWithContext(ctx context.Context, input *opensearchservice.RemoveTagsInput, opts ...request.Option) (*opensearchservice.RemoveTagsOutput, error) {
+	return m.MockRemoveTags(ctx, input, opts)
+}
@@ -0,0 +1,523 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opensearchservice
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsopensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags   = "cannot list tags"
+	errAddTags    = "cannot add tags"
+	errRemoveTags = "cannot remove tags"
+
+	errGetPasswordSecretFailed = "cannot get password secret"
+)
+
+// Client defines the OpenSearch Service operations used by the Domain
+// controller.
+type Client interface {
+	CreateDomainWithContext(context.Context, *awsopensearch.CreateDomainInput, ...request.Option) (*awsopensearch.CreateDomainOutput, error)
+	DescribeDomainWithContext(context.Context, *awsopensearch.DescribeDomainInput, ...request.Option) (*awsopensearch.DescribeDomainOutput, error)
+	UpdateDomainConfigWithContext(context.Context, *awsopensearch.UpdateDomainConfigInput, ...request.Option) (*awsopensearch.UpdateDomainConfigOutput, error)
+	DeleteDomainWithContext(context.Context, *awsopensearch.DeleteDomainInput, ...request.Option) (*awsopensearch.DeleteDomainOutput, error)
+	ListTagsWithContext(context.Context, *awsopensearch.ListTagsInput, ...request.Option) (*awsopensearch.ListTagsOutput, error)
+	AddTagsWithContext(context.Context, *awsopensearch.AddTagsInput, ...request.Option) (*awsopensearch.AddTagsOutput, error)
+	RemoveTagsWithContext(context.Context, *awsopensearch.RemoveTagsInput, ...request.Option) (*awsopensearch.RemoveTagsOutput, error)
+}
+
+// NewClient returns a new OpenSearch Service client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsopensearch.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the domain does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsopensearch.ErrCodeResourceNotFoundException
+}
+
+// GenerateCreateDomainInput returns the input that creates the domain with
+// the given name and parameters. The password of the master user is read
+// from a secret, so it is passed separately.
+func GenerateCreateDomainInput(name string, p v1alpha1.DomainParameters, password string) *awsopensearch.CreateDomainInput {
+	return &awsopensearch.CreateDomainInput{
+		DomainName:                  awsclient.String(name),
+		EngineVersion:               p.EngineVersion,
+		ClusterConfig:               generateClusterConfig(p.ClusterConfig),
+		EBSOptions:                  generateEBSOptions(p.EBSOptions),
+		VPCOptions:                  generateVPCOptions(p.VPCOptions),
+		AccessPolicies:              p.AccessPolicies,
+		AdvancedOptions:             aws.StringMap(p.AdvancedOptions),
+		AdvancedSecurityOptions:     generateAdvancedSecurityOptions(p.AdvancedSecurityOptions, password),
+		EncryptionAtRestOptions:     generateEncryptionAtRestOptions(p.EncryptionAtRestOptions),
+		NodeToNodeEncryptionOptions: generateNodeToNodeEncryptionOptions(p.NodeToNodeEncryptionOptions),
+		DomainEndpointOptions:       generateDomainEndpointOptions(p.DomainEndpointOptions),
+		TagList:                     GenerateTags(p.Tags),
+	}
+}
+
+// GenerateUpdateDomainConfigInput returns the input that updates the
+// settings of the domain that differ from the given parameters. Settings
+// that didn't change are left out, because each of them may trigger a
+// blue/green deployment. The master user is only updated if a password is
+// given, since AWS doesn't report it.
+func GenerateUpdateDomainConfigInput(name string, p v1alpha1.DomainParameters, obs *awsopensearch.DomainStatus, password string) *awsopensearch.UpdateDomainConfigInput {
+	in := &awsopensearch.UpdateDomainConfigInput{DomainName: awsclient.String(name)}
+	if !isClusterConfigUpToDate(p.ClusterConfig, obs.ClusterConfig) {
+		in.ClusterConfig = generateClusterConfig(p.ClusterConfig)
+	}
+	if !isEBSOptionsUpToDate(p.EBSOptions, obs.EBSOptions) {
+		in.EBSOptions = generateEBSOptions(p.EBSOptions)
+	}
+	if !isVPCOptionsUpToDate(p.VPCOptions, obs.VPCOptions) {
+		in.VPCOptions = generateVPCOptions(p.VPCOptions)
+	}
+	if !isAccessPoliciesUpToDate(p.AccessPolicies, obs.AccessPolicies) {
+		in.AccessPolicies = p.AccessPolicies
+	}
+	if !isAdvancedOptionsUpToDate(p.AdvancedOptions, obs.AdvancedOptions) {
+		in.AdvancedOptions = aws.StringMap(p.AdvancedOptions)
+	}
+	if !isAdvancedSecurityOptionsUpToDate(p.AdvancedSecurityOptions, obs.AdvancedSecurityOptions) || password != "" {
+		in.AdvancedSecurityOptions = generateAdvancedSecurityOptions(p.AdvancedSecurityOptions, password)
+	}
+	if !isEncryptionAtRestOptionsUpToDate(p.EncryptionAtRestOptions, obs.EncryptionAtRestOptions) {
+		in.EncryptionAtRestOptions = generateEncryptionAtRestOptions(p.EncryptionAtRestOptions)
+	}
+	if !isNodeToNodeEncryptionOptionsUpToDate(p.NodeToNodeEncryptionOptions, obs.NodeToNodeEncryptionOptions) {
+		in.NodeToNodeEncryptionOptions = generateNodeToNodeEncryptionOptions(p.NodeToNodeEncryptionOptions)
+	}
+	if !isDomainEndpointOptionsUpToDate(p.DomainEndpointOptions, obs.DomainEndpointOptions) {
+		in.DomainEndpointOptions = generateDomainEndpointOptions(p.DomainEndpointOptions)
+	}
+	return in
+}
+
+// IsDomainUpToDate returns true if the settings of the domain match the
+// given parameters. Settings that aren't given are not compared.
+func IsDomainUpToDate(p v1alpha1.DomainParameters, obs *awsopensearch.DomainStatus) bool {
+	return isClusterConfigUpToDate(p.ClusterConfig, obs.ClusterConfig) &&
+		isEBSOptionsUpToDate(p.EBSOptions, obs.EBSOptions) &&
+		isVPCOptionsUpToDate(p.VPCOptions, obs.VPCOptions) &&
+		isAccessPoliciesUpToDate(p.AccessPolicies, obs.AccessPolicies) &&
+		isAdvancedOptionsUpToDate(p.AdvancedOptions, obs.AdvancedOptions) &&
+		isAdvancedSecurityOptionsUpToDate(p.AdvancedSecurityOptions, obs.AdvancedSecurityOptions) &&
+		isEncryptionAtRestOptionsUpToDate(p.EncryptionAtRestOptions, obs.EncryptionAtRestOptions) &&
+		isNodeToNodeEncryptionOptionsUpToDate(p.NodeToNodeEncryptionOptions, obs.NodeToNodeEncryptionOptions) &&
+		isDomainEndpointOptionsUpToDate(p.DomainEndpointOptions, obs.DomainEndpointOptions)
+}
+
+func isClusterConfigUpToDate(want *v1alpha1.ClusterConfig, got *awsopensearch.ClusterConfig) bool {
+	if want == nil {
+		return true
+	}
+	return cmp.Equal(want, lateInitializeClusterConfig(nil, got), cmpopts.EquateEmpty())
+}
+
+func isEBSOptionsUpToDate(want *v1alpha1.EBSOptions, got *awsopensearch.EBSOptions) bool {
+	if want == nil {
+		return true
+	}
+	return cmp.Equal(want, lateInitializeEBSOptions(nil, got), cmpopts.EquateEmpty())
+}
+
+func isVPCOptionsUpToDate(want *v1alpha1.VPCOptions, got *awsopensearch.VPCDerivedInfo) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return false
+	}
+	sorted := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	return cmp.Equal(want.SubnetIDs, aws.StringValueSlice(got.SubnetIds), sorted, cmpopts.EquateEmpty()) &&
+		cmp.Equal(want.SecurityGroupIDs, aws.StringValueSlice(got.SecurityGroupIds), sorted, cmpopts.EquateEmpty())
+}
+
+// isAccessPoliciesUpToDate compares the access policies as JSON, since AWS
+// doesn't return them as they were given.
+func isAccessPoliciesUpToDate(want, got *string) bool {
+	if want == nil {
+		return true
+	}
+	return awsclient.IsPolicyUpToDate(want, got)
+}
+
+// isAdvancedOptionsUpToDate only compares the given advanced options, since
+// AWS returns the defaults of the others.
+func isAdvancedOptionsUpToDate(want map[string]string, got map[string]*string) bool {
+	for k, v := range want {
+		if g, ok := got[k]; !ok || awsclient.StringValue(g) != v {
+			return false
+		}
+	}
+	return true
+}
+
+func isAdvancedSecurityOptionsUpToDate(want *v1alpha1.AdvancedSecurityOptions, got *awsopensearch.AdvancedSecurityOptions) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return !want.Enabled
+	}
+	if want.Enabled != awsclient.BoolValue(got.Enabled) {
+		return false
+	}
+	return want.InternalUserDatabaseEnabled == nil || awsclient.BoolValue(want.InternalUserDatabaseEnabled) == awsclient.BoolValue(got.InternalUserDatabaseEnabled)
+}
+
+// isEncryptionAtRestOptionsUpToDate doesn't compare the KMS key, since AWS
+// returns its ARN even if its ID was given, and it can't be changed anyway.
+func isEncryptionAtRestOptionsUpToDate(want *v1alpha1.EncryptionAtRestOptions, got *awsopensearch.EncryptionAtRestOptions) bool {
+	if want == nil {
+		return true
+	}
+	return got != nil && want.Enabled == awsclient.BoolValue(got.Enabled)
+}
+
+func isNodeToNodeEncryptionOptionsUpToDate(want *v1alpha1.NodeToNodeEncryptionOptions, got *awsopensearch.NodeToNodeEncryptionOptions) bool {
+	if want == nil {
+		return true
+	}
+	return got != nil && want.Enabled == awsclient.BoolValue(got.Enabled)
+}
+
+func isDomainEndpointOptionsUpToDate(want *v1alpha1.DomainEndpointOptions, got *awsopensearch.DomainEndpointOptions) bool {
+	if want == nil {
+		return true
+	}
+	return cmp.Equal(want, lateInitializeDomainEndpointOptions(nil, got), cmpopts.EquateEmpty())
+}
+
+// LateInitialize fills the empty fields of the given parameters with the
+// settings AWS chose for the domain.
+func LateInitialize(p *v1alpha1.DomainParameters, obs *awsopensearch.DomainStatus) {
+	p.EngineVersion = awsclient.LateInitializeStringPtr(p.EngineVersion, obs.EngineVersion)
+	p.ClusterConfig = lateInitializeClusterConfig(p.ClusterConfig, obs.ClusterConfig)
+	p.EBSOptions = lateInitializeEBSOptions(p.EBSOptions, obs.EBSOptions)
+	p.DomainEndpointOptions = lateInitializeDomainEndpointOptions(p.DomainEndpointOptions, obs.DomainEndpointOptions)
+	if p.AdvancedOptions == nil && len(obs.AdvancedOptions) > 0 {
+		p.AdvancedOptions = aws.StringValueMap(obs.AdvancedOptions)
+	}
+	if p.EncryptionAtRestOptions == nil && obs.EncryptionAtRestOptions != nil {
+		p.EncryptionAtRestOptions = &v1alpha1.EncryptionAtRestOptions{
+			Enabled:  awsclient.BoolValue(obs.EncryptionAtRestOptions.Enabled),
+			KMSKeyID: obs.EncryptionAtRestOptions.KmsKeyId,
+		}
+	}
+	if p.NodeToNodeEncryptionOptions == nil && obs.NodeToNodeEncryptionOptions != nil {
+		p.NodeToNodeEncryptionOptions = &v1alpha1.NodeToNodeEncryptionOptions{
+			Enabled: awsclient.BoolValue(obs.NodeToNodeEncryptionOptions.Enabled),
+		}
+	}
+}
+
+func lateInitializeClusterConfig(in *v1alpha1.ClusterConfig, from *awsopensearch.ClusterConfig) *v1alpha1.ClusterConfig {
+	if from == nil {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.ClusterConfig{}
+	}
+	in.InstanceType = awsclient.LateInitializeStringPtr(in.InstanceType, from.InstanceType)
+	in.InstanceCount = awsclient.LateInitializeInt64Ptr(in.InstanceCount, from.InstanceCount)
+	in.DedicatedMasterEnabled = awsclient.LateInitializeBoolPtr(in.DedicatedMasterEnabled, from.DedicatedMasterEnabled)
+	in.DedicatedMasterType = awsclient.LateInitializeStringPtr(in.DedicatedMasterType, from.DedicatedMasterType)
+	in.DedicatedMasterCount = awsclient.LateInitializeInt64Ptr(in.DedicatedMasterCount, from.DedicatedMasterCount)
+	in.ZoneAwarenessEnabled = awsclient.LateInitializeBoolPtr(in.ZoneAwarenessEnabled, from.ZoneAwarenessEnabled)
+	if in.ZoneAwarenessConfig == nil && from.ZoneAwarenessConfig != nil {
+		in.ZoneAwarenessConfig = &v1alpha1.ZoneAwarenessConfig{AvailabilityZoneCount: from.ZoneAwarenessConfig.AvailabilityZoneCount}
+	}
+	in.WarmEnabled = awsclient.LateInitializeBoolPtr(in.WarmEnabled, from.WarmEnabled)
+	in.WarmType = awsclient.LateInitializeStringPtr(in.WarmType, from.WarmType)
+	in.WarmCount = awsclient.LateInitializeInt64Ptr(in.WarmCount, from.WarmCount)
+	if in.ColdStorageOptions == nil && from.ColdStorageOptions != nil {
+		in.ColdStorageOptions = &v1alpha1.ColdStorageOptions{Enabled: awsclient.BoolValue(from.ColdStorageOptions.Enabled)}
+	}
+	return in
+}
+
+func lateInitializeEBSOptions(in *v1alpha1.EBSOptions, from *awsopensearch.EBSOptions) *v1alpha1.EBSOptions {
+	if from == nil {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.EBSOptions{EBSEnabled: awsclient.BoolValue(from.EBSEnabled)}
+	}
+	in.VolumeType = awsclient.LateInitializeStringPtr(in.VolumeType, from.VolumeType)
+	in.VolumeSize = awsclient.LateInitializeInt64Ptr(in.VolumeSize, from.VolumeSize)
+	in.IOPS = awsclient.LateInitializeInt64Ptr(in.IOPS, from.Iops)
+	return in
+}
+
+func lateInitializeDomainEndpointOptions(in *v1alpha1.DomainEndpointOptions, from *awsopensearch.DomainEndpointOptions) *v1alpha1.DomainEndpointOptions {
+	if from == nil {
+		return in
+	}
+	if in == nil {
+		in = &v1alpha1.DomainEndpointOptions{}
+	}
+	in.EnforceHTTPS = awsclient.LateInitializeBoolPtr(in.EnforceHTTPS, from.EnforceHTTPS)
+	in.TLSSecurityPolicy = awsclient.LateInitializeStringPtr(in.TLSSecurityPolicy, from.TLSSecurityPolicy)
+	return in
+}
+
+func generateClusterConfig(c *v1alpha1.ClusterConfig) *awsopensearch.ClusterConfig {
+	if c == nil {
+		return nil
+	}
+	res := &awsopensearch.ClusterConfig{
+		InstanceType:           c.InstanceType,
+		InstanceCount:          c.InstanceCount,
+		DedicatedMasterEnabled: c.DedicatedMasterEnabled,
+		DedicatedMasterType:    c.DedicatedMasterType,
+		DedicatedMasterCount:   c.DedicatedMasterCount,
+		ZoneAwarenessEnabled:   c.ZoneAwarenessEnabled,
+		WarmEnabled:            c.WarmEnabled,
+		WarmType:               c.WarmType,
+		WarmCount:              c.WarmCount,
+	}
+	if c.ZoneAwarenessConfig != nil {
+		res.ZoneAwarenessConfig = &awsopensearch.ZoneAwarenessConfig{AvailabilityZoneCount: c.ZoneAwarenessConfig.AvailabilityZoneCount}
+	}
+	if c.ColdStorageOptions != nil {
+		res.ColdStorageOptions = &awsopensearch.ColdStorageOptions{Enabled: aws.Bool(c.ColdStorageOptions.Enabled)}
+	}
+	return res
+}
+
+func generateEBSOptions(o *v1alpha1.EBSOptions) *awsopensearch.EBSOptions {
+	if o == nil {
+		return nil
+	}
+	return &awsopensearch.EBSOptions{
+		EBSEnabled: aws.Bool(o.EBSEnabled),
+		VolumeType: o.VolumeType,
+		VolumeSize: o.VolumeSize,
+		Iops:       o.IOPS,
+	}
+}
+
+func generateVPCOptions(o *v1alpha1.VPCOptions) *awsopensearch.VPCOptions {
+	if o == nil {
+		return nil
+	}
+	return &awsopensearch.VPCOptions{
+		SubnetIds:        aws.StringSlice(o.SubnetIDs),
+		SecurityGroupIds: aws.StringSlice(o.SecurityGroupIDs),
+	}
+}
+
+func generateAdvancedSecurityOptions(o *v1alpha1.AdvancedSecurityOptions, password string) *awsopensearch.AdvancedSecurityOptionsInput_ {
+	if o == nil {
+		return nil
+	}
+	res := &awsopensearch.AdvancedSecurityOptionsInput_{
+		Enabled:                     aws.Bool(o.Enabled),
+		InternalUserDatabaseEnabled: o.InternalUserDatabaseEnabled,
+	}
+	if m := o.MasterUserOptions; m != nil {
+		res.MasterUserOptions = &awsopensearch.MasterUserOptions{
+			MasterUserARN:  m.MasterUserARN,
+			MasterUserName: m.MasterUserName,
+		}
+		if password != "" {
+			res.MasterUserOptions.MasterUserPassword = awsclient.String(password)
+		}
+	}
+	return res
+}
+
+func generateEncryptionAtRestOptions(o *v1alpha1.EncryptionAtRestOptions) *awsopensearch.EncryptionAtRestOptions {
+	if o == nil {
+		return nil
+	}
+	return &awsopensearch.EncryptionAtRestOptions{Enabled: aws.Bool(o.Enabled), KmsKeyId: o.KMSKeyID}
+}
+
+func generateNodeToNodeEncryptionOptions(o *v1alpha1.NodeToNodeEncryptionOptions) *awsopensearch.NodeToNodeEncryptionOptions {
+	if o == nil {
+		return nil
+	}
+	return &awsopensearch.NodeToNodeEncryptionOptions{Enabled: aws.Bool(o.Enabled)}
+}
+
+func generateDomainEndpointOptions(o *v1alpha1.DomainEndpointOptions) *awsopensearch.DomainEndpointOptions {
+	if o == nil {
+		return nil
+	}
+	return &awsopensearch.DomainEndpointOptions{EnforceHTTPS: o.EnforceHTTPS, TLSSecurityPolicy: o.TLSSecurityPolicy}
+}
+
+// GenerateObservation returns the observation of the given domain.
+func GenerateObservation(obs *awsopensearch.DomainStatus) v1alpha1.DomainObservation {
+	o := v1alpha1.DomainObservation{
+		ARN:               awsclient.StringValue(obs.ARN),
+		DomainID:          awsclient.StringValue(obs.DomainId),
+		Endpoint:          awsclient.StringValue(obs.Endpoint),
+		VPCEndpoint:       awsclient.StringValue(obs.Endpoints["vpc"]),
+		Processing:        awsclient.BoolValue(obs.Processing),
+		UpgradeProcessing: awsclient.BoolValue(obs.UpgradeProcessing),
+	}
+	if obs.VPCOptions != nil {
+		o.VPCID = awsclient.StringValue(obs.VPCOptions.VPCId)
+	}
+	if s := obs.ServiceSoftwareOptions; s != nil {
+		o.ServiceSoftwareOptions = &v1alpha1.ServiceSoftwareOptions{
+			CurrentVersion:  awsclient.StringValue(s.CurrentVersion),
+			NewVersion:      awsclient.StringValue(s.NewVersion),
+			UpdateAvailable: awsclient.BoolValue(s.UpdateAvailable),
+			UpdateStatus:    awsclient.StringValue(s.UpdateStatus),
+			Description:     awsclient.StringValue(s.Description),
+		}
+		if s.AutomatedUpdateDate != nil && !s.AutomatedUpdateDate.IsZero() {
+			t := metav1.NewTime(*s.AutomatedUpdateDate)
+			o.ServiceSoftwareOptions.AutomatedUpdateDate = &t
+		}
+	}
+	return o
+}
+
+// DiffTags returns the tags that need to be added to or removed from a
+// domain to match the desired ones. Tags whose value changed are both
+// removed and added, so removals must be applied first.
+func DiffTags(desired []v1alpha1.Tag, observed []*awsopensearch.Tag) ([]*awsopensearch.Tag, []*string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	add, remove := awsclient.DiffTags(local, remote)
+	var addTags []*awsopensearch.Tag
+	for k, v := range add {
+		addTags = append(addTags, &awsopensearch.Tag{Key: awsclient.String(k), Value: awsclient.String(v)})
+	}
+	sort.Slice(addTags, func(i, j int) bool {
+		return *addTags[i].Key < *addTags[j].Key
+	})
+	sort.Strings(remove)
+	return addTags, aws.StringSlice(remove)
+}
+
+// GenerateTags returns the given tags in the form OpenSearch Service
+// accepts them.
+func GenerateTags(tags []v1alpha1.Tag) []*awsopensearch.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awsopensearch.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awsopensearch.Tag{Key: awsclient.String(t.Key), Value: awsclient.String(t.Value)}
+	}
+	return res
+}
+
+// UpdateTags makes the tags of the domain with the given ARN match the
+// desired ones.
+func UpdateTags(ctx context.Context, c Client, arn string, desired []v1alpha1.Tag) error {
+	tags, err := c.ListTagsWithContext(ctx, &awsopensearch.ListTagsInput{ARN: &arn})
+	if err != nil {
+		return awsclient.Wrap(err, errListTags)
+	}
+	add, remove := DiffTags(desired, tags.TagList)
+	if len(remove) > 0 {
+		if _, err := c.RemoveTagsWithContext(ctx, &awsopensearch.RemoveTagsInput{ARN: &arn, TagKeys: remove}); err != nil {
+			return awsclient.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := c.AddTagsWithContext(ctx, &awsopensearch.AddTagsInput{ARN: &arn, TagList: add}); err != nil {
+			return awsclient.Wrap(err, errAddTags)
+		}
+	}
+	return nil
+}
+
+// GetPassword returns the password of the master user of the given domain
+// and whether it differs from the one in its connection secret.
+func GetPassword(ctx context.Context, kube client.Client, cr *v1alpha1.Domain) (pwd string, changed bool, err error) {
+	o := cr.Spec.ForProvider.AdvancedSecurityOptions
+	if o == nil || o.MasterUserOptions == nil || o.MasterUserOptions.MasterUserPasswordSecretRef == nil {
+		return "", false, nil
+	}
+	in := o.MasterUserOptions.MasterUserPasswordSecretRef
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: in.Name, Namespace: in.Namespace}, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	pwd = string(s.Data[in.Key])
+
+	out := cr.Spec.WriteConnectionSecretToReference
+	if out == nil {
+		return pwd, false, nil
+	}
+	s = &corev1.Secret{}
+	// the connection secret may not exist yet, so we can skip returning an
+	// error if the error is NotFound
+	if err := kube.Get(ctx, types.NamespacedName{Name: out.Name, Namespace: out.Namespace}, s); resource.IgnoreNotFound(err) != nil {
+		return "", false, err
+	}
+	return pwd, pwd != "" && pwd != string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey]), nil
+}
+
+// GetConnectionDetails returns the connection details of the given domain.
+// The endpoint of a domain that was placed in a VPC is its VPC endpoint.
+func GetConnectionDetails(cr *v1alpha1.Domain, pwd string) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	if e := cr.Status.AtProvider.Endpoint; e != "" {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(e)
+	}
+	if e := cr.Status.AtProvider.VPCEndpoint; e != "" {
+		cd[xpv1.ResourceCredentialsSecretEndpointKey] = []byte(e)
+	}
+	if len(cd) > 0 {
+		cd[xpv1.ResourceCredentialsSecretPortKey] = []byte("443")
+	}
+	if o := cr.Spec.ForProvider.AdvancedSecurityOptions; o != nil && o.MasterUserOptions != nil && o.MasterUserOptions.MasterUserName != nil {
+		cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(*o.MasterUserOptions.MasterUserName)
+	}
+	if pwd != "" {
+		cd[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pwd)
+	}
+	return cd
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package opensearchservice
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsopensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/google/go-cmp/cmp"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+)
+
+var (
+	domainName = "search"
+	policy     = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"*"},"Action":"es:*","Resource":"*"}]}`
+	// The same policy, as AWS returns it.
+	observedPolicy = `{"Statement":[{"Action":"es:*","Resource":"*","Effect":"Allow","Principal":{"AWS":"*"}}],"Version":"2012-10-17"}`
+)
+
+func status() *awsopensearch.DomainStatus {
+	return &awsopensearch.DomainStatus{
+		ARN:            aws.String("arn:aws:es:us-east-1:123456789012:domain/search"),
+		EngineVersion:  aws.String("OpenSearch_1.0"),
+		AccessPolicies: aws.String(observedPolicy),
+		AdvancedOptions: map[string]*string{
+			"rest.action.multi.allow_explicit_index": aws.String("true"),
+		},
+		ClusterConfig: &awsopensearch.ClusterConfig{
+			InstanceType:           aws.String("r6g.large.search"),
+			InstanceCount:          aws.Int64(3),
+			DedicatedMasterEnabled: aws.Bool(false),
+			ZoneAwarenessEnabled:   aws.Bool(false),
+		},
+		EBSOptions: &awsopensearch.EBSOptions{
+			EBSEnabled: aws.Bool(true),
+			VolumeType: aws.String("gp2"),
+			VolumeSize: aws.Int64(10),
+		},
+		VPCOptions: &awsopensearch.VPCDerivedInfo{
+			SubnetIds:        aws.StringSlice([]string{"subnet-b", "subnet-a"}),
+			SecurityGroupIds: aws.StringSlice([]string{"sg-a"}),
+			VPCId:            aws.String("vpc-a"),
+		},
+		AdvancedSecurityOptions: &awsopensearch.AdvancedSecurityOptions{
+			Enabled:                     aws.Bool(true),
+			InternalUserDatabaseEnabled: aws.Bool(true),
+		},
+		EncryptionAtRestOptions:     &awsopensearch.EncryptionAtRestOptions{Enabled: aws.Bool(true), KmsKeyId: aws.String("arn:aws:kms:us-east-1:123456789012:key/key")},
+		NodeToNodeEncryptionOptions: &awsopensearch.NodeToNodeEncryptionOptions{Enabled: aws.Bool(true)},
+		DomainEndpointOptions: &awsopensearch.DomainEndpointOptions{
+			EnforceHTTPS:      aws.Bool(true),
+			TLSSecurityPolicy: aws.String("Policy-Min-TLS-1-2-2019-07"),
+		},
+		Endpoints: map[string]*string{"vpc": aws.String("vpc-search.us-east-1.es.amazonaws.com")},
+	}
+}
+
+func parameters() v1alpha1.DomainParameters {
+	return v1alpha1.DomainParameters{
+		AccessPolicies: aws.String(policy),
+		ClusterConfig: &v1alpha1.ClusterConfig{
+			InstanceType:  aws.String("r6g.large.search"),
+			InstanceCount: aws.Int64(3),
+		},
+		EBSOptions: &v1alpha1.EBSOptions{EBSEnabled: true, VolumeSize: aws.Int64(10)},
+		VPCOptions: &v1alpha1.VPCOptions{
+			SubnetIDs:        []string{"subnet-a", "subnet-b"},
+			SecurityGroupIDs: []string{"sg-a"},
+		},
+		AdvancedSecurityOptions: &v1alpha1.AdvancedSecurityOptions{
+			Enabled: true,
+			MasterUserOptions: &v1alpha1.MasterUserOptions{
+				MasterUserName: aws.String("admin"),
+			},
+		},
+		EncryptionAtRestOptions:     &v1alpha1.EncryptionAtRestOptions{Enabled: true, KMSKeyID: aws.String("key")},
+		NodeToNodeEncryptionOptions: &v1alpha1.NodeToNodeEncryptionOptions{Enabled: true},
+	}
+}
+
+func TestIsDomainUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p    func(*v1alpha1.DomainParameters)
+		want bool
+	}{
+		"UpToDate": {
+			p:    func(*v1alpha1.DomainParameters) {},
+			want: true,
+		},
+		"Empty": {
+			p:    func(p *v1alpha1.DomainParameters) { *p = v1alpha1.DomainParameters{} },
+			want: true,
+		},
+		"InstanceCountChanged": {
+			p:    func(p *v1alpha1.DomainParameters) { p.ClusterConfig.InstanceCount = aws.Int64(6) },
+			want: false,
+		},
+		"VolumeSizeChanged": {
+			p:    func(p *v1alpha1.DomainParameters) { p.EBSOptions.VolumeSize = aws.Int64(20) },
+			want: false,
+		},
+		"SubnetsChanged": {
+			p:    func(p *v1alpha1.DomainParameters) { p.VPCOptions.SubnetIDs = []string{"subnet-a"} },
+			want: false,
+		},
+		"AccessPoliciesChanged": {
+			p: func(p *v1alpha1.DomainParameters) {
+				p.AccessPolicies = aws.String(`{"Version":"2012-10-17","Statement":[]}`)
+			},
+			want: false,
+		},
+		"AdvancedOptionAdded": {
+			p: func(p *v1alpha1.DomainParameters) {
+				p.AdvancedOptions = map[string]string{"indices.fielddata.cache.size": "20"}
+			},
+			want: false,
+		},
+		"AdvancedOptionsSubset": {
+			p: func(p *v1alpha1.DomainParameters) {
+				p.AdvancedOptions = map[string]string{"rest.action.multi.allow_explicit_index": "true"}
+			},
+			want: true,
+		},
+		"InternalUserDatabaseDisabled": {
+			p: func(p *v1alpha1.DomainParameters) {
+				p.AdvancedSecurityOptions.InternalUserDatabaseEnabled = aws.Bool(false)
+			},
+			want: false,
+		},
+		"TLSSecurityPolicyChanged": {
+			p: func(p *v1alpha1.DomainParameters) {
+				p.DomainEndpointOptions = &v1alpha1.DomainEndpointOptions{TLSSecurityPolicy: aws.String("Policy-Min-TLS-1-0-2019-07")}
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := parameters()
+			tc.p(&p)
+			LateInitialize(&p, status())
+			if diff := cmp.Diff(tc.want, IsDomainUpToDate(p, status())); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateUpdateDomainConfigInput(t *testing.T) {
+	type args struct {
+		p        func(*v1alpha1.DomainParameters)
+		password string
+	}
+
+	cases := map[string]struct {
+		args
+		want *awsopensearch.UpdateDomainConfigInput
+	}{
+		"NoChanges": {
+			args: args{p: func(*v1alpha1.DomainParameters) {}},
+			want: &awsopensearch.UpdateDomainConfigInput{DomainName: &domainName},
+		},
+		"OnlyChangedSettings": {
+			args: args{p: func(p *v1alpha1.DomainParameters) {
+				p.ClusterConfig.InstanceCount = aws.Int64(6)
+				p.AccessPolicies = aws.String(`{"Version":"2012-10-17","Statement":[]}`)
+			}},
+			want: &awsopensearch.UpdateDomainConfigInput{
+				DomainName: &domainName,
+				ClusterConfig: &awsopensearch.ClusterConfig{
+					InstanceType:           aws.String("r6g.large.search"),
+					InstanceCount:          aws.Int64(6),
+					DedicatedMasterEnabled: aws.Bool(false),
+					ZoneAwarenessEnabled:   aws.Bool(false),
+				},
+				AccessPolicies: aws.String(`{"Version":"2012-10-17","Statement":[]}`),
+			},
+		},
+		"PasswordChanged": {
+			args: args{p: func(*v1alpha1.DomainParameters) {}, password: "secret"},
+			want: &awsopensearch.UpdateDomainConfigInput{
+				DomainName: &domainName,
+				AdvancedSecurityOptions: &awsopensearch.AdvancedSecurityOptionsInput_{
+					Enabled: aws.Bool(true),
+					MasterUserOptions: &awsopensearch.MasterUserOptions{
+						MasterUserName:     aws.String("admin"),
+						MasterUserPassword: aws.String("secret"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			p := parameters()
+			tc.args.p(&p)
+			LateInitialize(&p, status())
+			got := GenerateUpdateDomainConfigInput(domainName, p, status(), tc.args.password)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	want := parameters()
+	want.EngineVersion = aws.String("OpenSearch_1.0")
+	want.AdvancedOptions = map[string]string{"rest.action.multi.allow_explicit_index": "true"}
+	want.ClusterConfig.DedicatedMasterEnabled = aws.Bool(false)
+	want.ClusterConfig.ZoneAwarenessEnabled = aws.Bool(false)
+	want.EBSOptions.VolumeType = aws.String("gp2")
+	want.DomainEndpointOptions = &v1alpha1.DomainEndpointOptions{
+		EnforceHTTPS:      aws.Bool(true),
+		TLSSecurityPolicy: aws.String("Policy-Min-TLS-1-2-2019-07"),
+	}
+
+	got := parameters()
+	LateInitialize(&got, status())
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetConnectionDetails(t *testing.T) {
+	cr := &v1alpha1.Domain{Spec: v1alpha1.DomainSpec{ForProvider: parameters()}}
+	cr.Status.AtProvider = GenerateObservation(status())
+
+	want := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte("vpc-search.us-east-1.es.amazonaws.com"),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+		xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte("secret"),
+	}
+	if diff := cmp.Diff(want, GetConnectionDetails(cr, "secret")); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
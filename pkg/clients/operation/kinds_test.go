@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation_test
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/operation"
+)
+
+// TestEveryKindHasLastOperation guards against managed resource kinds whose
+// operations would silently not be recorded.
+func TestEveryKindHasLastOperation(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	for gvk := range s.AllKnownTypes() {
+		o, err := s.New(gvk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			continue
+		}
+		operation.SetLastOperation(mg, &v1beta1.LastOperation{Operation: "CreateQueue"})
+		if operation.GetLastOperation(mg) == nil {
+			t.Errorf("%s has no status.atProvider.lastOperation", gvk)
+		}
+	}
+}
@@ -0,0 +1,386 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package operation records the AWS API operations controllers make to
+// change external resources, and reports the last of them in the
+// status.atProvider.lastOperation field of the managed resource. Errors are
+// reported with the AWS request ID of the last operation made.
+package operation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/account"
+	"github.com/crossplane/provider-aws/pkg/clients/drift"
+	"github.com/crossplane/provider-aws/pkg/clients/health"
+	"github.com/crossplane/provider-aws/pkg/clients/quota"
+	"github.com/crossplane/provider-aws/pkg/clients/requestlog"
+)
+
+// readOnly are the prefixes of the names of AWS API operations that don't
+// change anything. They're made on every poll, and recording them would
+// bury the operations worth correlating with CloudTrail.
+var readOnly = []string{"Describe", "Get", "List", "Head", "Lookup", "AssumeRole", "Validate", "Detect"}
+
+// IsReadOnly returns true if the supplied AWS API operation doesn't change
+// anything.
+func IsReadOnly(op string) bool {
+	for _, p := range readOnly {
+		if strings.HasPrefix(op, p) {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey struct{}
+
+// A Recorder records the last AWS API operation that changed something, and
+// the request ID of the last operation.
+type Recorder struct {
+	now func() time.Time
+
+	mu        sync.Mutex
+	last      *v1beta1.LastOperation
+	requestID string
+}
+
+// NewRecorder returns a Recorder that timestamps operations using the
+// supplied clock.
+func NewRecorder(now func() time.Time) *Recorder {
+	return &Recorder{now: now}
+}
+
+// WithRecorder returns a copy of the supplied context that AWS API operations
+// made with it are recorded to.
+func WithRecorder(ctx context.Context, r *Recorder) context.Context {
+	return context.WithValue(ctx, contextKey{}, r)
+}
+
+// Record the supplied AWS API operation to the Recorder of the supplied
+// context, if any. Read-only operations are only recorded by request ID.
+func Record(ctx context.Context, op, requestID string, err error) {
+	r, ok := ctx.Value(contextKey{}).(*Recorder)
+	if !ok {
+		return
+	}
+	if requestID != "" {
+		r.mu.Lock()
+		r.requestID = requestID
+		r.mu.Unlock()
+	}
+	if IsReadOnly(op) {
+		return
+	}
+	o := &v1beta1.LastOperation{
+		Operation: op,
+		RequestID: requestID,
+		Time:      metav1.NewTime(r.now()),
+		Result:    v1beta1.OperationSucceeded,
+	}
+	if err != nil {
+		o.Result = v1beta1.OperationFailed
+		o.ErrorCode = errorCode(err)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.last = o
+}
+
+// Last returns the last recorded operation, or nil if none was recorded.
+func (r *Recorder) Last() *v1beta1.LastOperation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last
+}
+
+// LastRequestID returns the AWS request ID of the last recorded operation,
+// including read-only ones, or an empty string if none was recorded.
+func (r *Recorder) LastRequestID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.requestID
+}
+
+// A requestError is an error of an ExternalClient annotated with the AWS
+// request ID of the last operation it made.
+type requestError struct {
+	error
+	requestID string
+}
+
+func (e *requestError) Error() string {
+	return fmt.Sprintf("%s (last AWS request ID: %s)", e.error.Error(), e.requestID)
+}
+
+func (e *requestError) Unwrap() error {
+	return e.error
+}
+
+// WithRequestID annotates the supplied error with the supplied AWS request
+// ID, which ends up in the message of the ReconcileError condition. Errors
+// whose message already contains it aren't annotated.
+func WithRequestID(err error, requestID string) error {
+	if err == nil || requestID == "" || strings.Contains(err.Error(), requestID) {
+		return err
+	}
+	return &requestError{error: err, requestID: requestID}
+}
+
+func errorCode(err error) string {
+	var v1 awserr.Error
+	if errors.As(err, &v1) {
+		return v1.Code()
+	}
+	var v2 smithy.APIError
+	if errors.As(err, &v2) {
+		return v2.ErrorCode()
+	}
+	return ""
+}
+
+// HandlerV1 records the operations of AWS SDK v1 requests. It must be added
+// to the Complete handlers of a session, so that it sees the outcome of the
+// request after all retries.
+var HandlerV1 = request.NamedHandler{
+	Name: "crossplane.OperationRecorder",
+	Fn: func(r *request.Request) {
+		Record(r.Context(), r.Operation.Name, r.RequestID, r.Error)
+	},
+}
+
+// AddMiddlewareV2 adds a middleware to the supplied AWS SDK v2 stack that
+// records its operations.
+func AddMiddlewareV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("crossplane.OperationRecorder",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			out, md, err := next.HandleInitialize(ctx, in)
+			id, _ := awsmiddleware.GetRequestIDMetadata(md)
+			var re *awshttp.ResponseError
+			if errors.As(err, &re) {
+				id = re.ServiceRequestID()
+			}
+			Record(ctx, awsmiddleware.GetOperationName(ctx), id, err)
+			return out, md, err
+		}), middleware.After)
+}
+
+// GetLastOperation returns the status.atProvider.lastOperation field of the
+// supplied managed resource, or nil if it has none.
+func GetLastOperation(mg resource.Managed) *v1beta1.LastOperation {
+	v, ok := lastOperation(mg)
+	if !ok {
+		return nil
+	}
+	return v.Interface().(*v1beta1.LastOperation)
+}
+
+// SetLastOperation sets the status.atProvider.lastOperation field of the
+// supplied managed resource, if it has one.
+func SetLastOperation(mg resource.Managed, o *v1beta1.LastOperation) {
+	if v, ok := lastOperation(mg); ok {
+		v.Set(reflect.ValueOf(o.DeepCopy()))
+	}
+}
+
+// lastOperation returns the status.atProvider.lastOperation field of the
+// supplied managed resource. Every managed resource of this provider has one,
+// but they're distinct types that share no interface to access it.
+func lastOperation(mg resource.Managed) (reflect.Value, bool) {
+	v := reflect.ValueOf(mg)
+	for _, name := range []string{"Status", "AtProvider", "LastOperation"} {
+		if v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		if v.Kind() != reflect.Struct {
+			return reflect.Value{}, false
+		}
+		if v = v.FieldByName(name); !v.IsValid() {
+			return reflect.Value{}, false
+		}
+	}
+	return v, v.CanSet() && v.Type() == reflect.TypeOf(&v1beta1.LastOperation{})
+}
+
+// A Connecter records the AWS API operations of the external clients of the
+// ExternalConnecter it wraps.
+type Connecter struct {
+	managed.ExternalConnecter
+	now    func() time.Time
+	quota  *quota.Checker
+	health *health.Watcher
+
+	// The status of a resource isn't always persisted after it was changed,
+	// e.g. when a successful Create is followed by an update of its external
+	// name. Operations are therefore kept until they were reported by the
+	// next Observe, which is always followed by a status update.
+	mu      sync.Mutex
+	pending map[types.UID]*v1beta1.LastOperation
+}
+
+// A ConnecterOption configures a Connecter.
+type ConnecterOption func(*Connecter)
+
+// WithClock configures the clock operations are timestamped with.
+func WithClock(now func() time.Time) ConnecterOption {
+	return func(c *Connecter) { c.now = now }
+}
+
+// WithQuotaChecker configures the Checker that checks the AWS service quotas
+// creating a resource counts against before it is created. Quotas are not
+// checked if it is nil.
+func WithQuotaChecker(qc *quota.Checker) ConnecterOption {
+	return func(c *Connecter) { c.quota = qc }
+}
+
+// WithHealthWatcher configures the Watcher that reports the open AWS Health
+// events affecting an existing resource. Events are not reported if it is
+// nil.
+func WithHealthWatcher(w *health.Watcher) ConnecterOption {
+	return func(c *Connecter) { c.health = w }
+}
+
+// NewConnecter returns a Connecter that wraps the supplied ExternalConnecter.
+func NewConnecter(c managed.ExternalConnecter, o ...ConnecterOption) *Connecter {
+	oc := &Connecter{
+		ExternalConnecter: c,
+		now:               time.Now,
+		pending:           map[types.UID]*v1beta1.LastOperation{},
+	}
+	for _, fn := range o {
+		fn(oc)
+	}
+	return oc
+}
+
+// Connect returns an ExternalClient that records the operations of the
+// ExternalClient returned by the wrapped ExternalConnecter.
+func (c *Connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	e, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: e, connecter: c}, nil
+}
+
+func (c *Connecter) setPending(mg resource.Managed, o *v1beta1.LastOperation) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[mg.GetUID()] = o
+}
+
+func (c *Connecter) popPending(mg resource.Managed) *v1beta1.LastOperation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o := c.pending[mg.GetUID()]
+	delete(c.pending, mg.GetUID())
+	return o
+}
+
+type external struct {
+	managed.ExternalClient
+	connecter *Connecter
+}
+
+func (e *external) record(ctx context.Context, mg resource.Managed, fn func(ctx context.Context) error) error {
+	r := NewRecorder(e.connecter.now)
+	err := fn(WithRecorder(withLogValues(ctx, mg), r))
+	if o := r.Last(); o != nil {
+		SetLastOperation(mg, o)
+		e.connecter.setPending(mg, o)
+	}
+	return WithRequestID(err, r.LastRequestID())
+}
+
+// withLogValues returns a copy of the supplied context whose AWS API requests
+// are logged with the kind and name of the supplied managed resource.
+func withLogValues(ctx context.Context, mg resource.Managed) context.Context {
+	return requestlog.WithValues(ctx, "kind", drift.KindOf(mg), "name", mg.GetName())
+}
+
+// Observe keeps the last operation and AWS account ID of the resource. Most
+// controllers replace the whole status.atProvider field with what they
+// observed.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	last := GetLastOperation(mg)
+	id := account.GetID(mg)
+	if o := e.connecter.popPending(mg); o != nil {
+		last = o
+	}
+	r := NewRecorder(e.connecter.now)
+	obs, err := e.ExternalClient.Observe(WithRecorder(withLogValues(ctx, mg), r), mg)
+	if o := r.Last(); o != nil {
+		last = o
+	}
+	err = WithRequestID(err, r.LastRequestID())
+	if last != nil {
+		SetLastOperation(mg, last)
+	}
+	if id != "" {
+		account.SetID(mg, id)
+	}
+	if err == nil {
+		// Every controller's connecter is wrapped by a Connecter, which makes
+		// this the one place to count drift and check AWS Health for all of
+		// them.
+		drift.Record(mg, obs)
+		if obs.ResourceExists {
+			e.connecter.health.Check(ctx, mg)
+		}
+	}
+	return obs, err
+}
+
+// Create the external resource, unless doing so would exceed an AWS service
+// quota.
+func (e *external) Create(ctx context.Context, mg resource.Managed) (cre managed.ExternalCreation, err error) {
+	if err := e.connecter.quota.Check(ctx, mg); err != nil {
+		return cre, err
+	}
+	err = e.record(ctx, mg, func(ctx context.Context) (err error) { cre, err = e.ExternalClient.Create(ctx, mg); return err })
+	return cre, err
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (upd managed.ExternalUpdate, err error) {
+	err = e.record(ctx, mg, func(ctx context.Context) (err error) { upd, err = e.ExternalClient.Update(ctx, mg); return err })
+	return upd, err
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return e.record(ctx, mg, func(ctx context.Context) error { return e.ExternalClient.Delete(ctx, mg) })
+}
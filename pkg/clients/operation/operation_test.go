@@ -0,0 +1,276 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package operation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var (
+	now     = time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock   = func() time.Time { return now }
+	errBoom = errors.New("boom")
+)
+
+func TestRecord(t *testing.T) {
+	type args struct {
+		ops []func(ctx context.Context)
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   *v1beta1.LastOperation
+	}{
+		"LastChange": {
+			reason: "The last operation that changed something should be recorded.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) { Record(ctx, "CreateQueue", "a", nil) },
+					func(ctx context.Context) { Record(ctx, "TagQueue", "b", nil) },
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "TagQueue", RequestID: "b", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded},
+		},
+		"ReadOnly": {
+			reason: "Read-only operations should not be recorded.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) { Record(ctx, "CreateQueue", "a", nil) },
+					func(ctx context.Context) { Record(ctx, "GetQueueAttributes", "b", nil) },
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "CreateQueue", RequestID: "a", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded},
+		},
+		"Validation": {
+			reason: "Validating a policy before writing it should not be recorded in place of the write.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) { Record(ctx, "ValidatePolicy", "a", nil) },
+					func(ctx context.Context) { Record(ctx, "CreatePolicy", "b", nil) },
+					func(ctx context.Context) { Record(ctx, "ValidatePolicy", "c", nil) },
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "CreatePolicy", RequestID: "b", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded},
+		},
+		"DriftDetection": {
+			reason: "Detecting the drift of a stack on observe should not be recorded in place of its last update.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) { Record(ctx, "UpdateStack", "a", nil) },
+					func(ctx context.Context) { Record(ctx, "DetectStackDrift", "b", nil) },
+					func(ctx context.Context) { Record(ctx, "DescribeStackDriftDetectionStatus", "c", nil) },
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "UpdateStack", RequestID: "a", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded},
+		},
+		"Failed": {
+			reason: "Failed operations should be recorded with their error code.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) {
+						Record(ctx, "DeleteQueue", "a", awserr.New("AccessDenied", "denied", nil))
+					},
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "DeleteQueue", RequestID: "a", Time: metav1.NewTime(now), Result: v1beta1.OperationFailed, ErrorCode: "AccessDenied"},
+		},
+		"HandlerV1": {
+			reason: "AWS SDK v1 requests should be recorded.",
+			args: args{
+				ops: []func(ctx context.Context){
+					func(ctx context.Context) {
+						r := &request.Request{
+							Operation:   &request.Operation{Name: "SetQueueAttributes"},
+							HTTPRequest: &http.Request{},
+							RequestID:   "a",
+						}
+						r.SetContext(ctx)
+						HandlerV1.Fn(r)
+					},
+				},
+			},
+			want: &v1beta1.LastOperation{Operation: "SetQueueAttributes", RequestID: "a", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRecorder(clock)
+			ctx := WithRecorder(context.Background(), r)
+			for _, op := range tc.args.ops {
+				op(ctx)
+			}
+			if diff := cmp.Diff(tc.want, r.Last()); diff != "" {
+				t.Errorf("\n%s\nLast(): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	cases := map[string]bool{
+		"DescribeDBInstances": true,
+		"GetQueueAttributes":  true,
+		"ValidatePolicy":      true,
+		"DetectStackDrift":    true,
+		"CreatePolicy":        false,
+		"PutBucketPolicy":     false,
+	}
+	for op, want := range cases {
+		t.Run(op, func(t *testing.T) {
+			if diff := cmp.Diff(want, IsReadOnly(op)); diff != "" {
+				t.Errorf("IsReadOnly(%q): -want, +got:\n%s", op, diff)
+			}
+		})
+	}
+}
+
+func TestConnecter(t *testing.T) {
+	created := &v1beta1.LastOperation{Operation: "CreateQueue", RequestID: "a", Time: metav1.NewTime(now), Result: v1beta1.OperationSucceeded}
+
+	ec := &managed.ExternalClientFns{
+		ObserveFn: func(_ context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+			// Like most controllers, replace the whole observation.
+			mg.(*sqsv1beta1.Queue).Status.AtProvider = sqsv1beta1.QueueObservation{}
+			return managed.ExternalObservation{ResourceExists: true}, nil
+		},
+		CreateFn: func(ctx context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+			Record(ctx, "CreateQueue", "a", nil)
+			return managed.ExternalCreation{}, nil
+		},
+		DeleteFn: func(ctx context.Context, _ resource.Managed) error {
+			Record(ctx, "DeleteQueue", "req-b", errBoom)
+			return errBoom
+		},
+	}
+	c := NewConnecter(managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+		return ec, nil
+	}), WithClock(clock))
+
+	cr := &sqsv1beta1.Queue{}
+	cr.SetUID("uid")
+	cr.Status.AtProvider.CallerAccountID = "123456789012"
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Fatalf("Create(...): %s", err)
+	}
+	if diff := cmp.Diff(created, cr.Status.AtProvider.LastOperation); diff != "" {
+		t.Errorf("Create(...): -want, +got:\n%s", diff)
+	}
+
+	// The status of the resource is lost, e.g. because it was not persisted
+	// after Create. Observe should report the pending operation.
+	cr.Status.AtProvider.LastOperation = nil
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(created, cr.Status.AtProvider.LastOperation); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+
+	// Observe should keep the last operation and account ID, even though the
+	// controller replaces the observation.
+	if _, err := e.Observe(context.Background(), cr); err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if diff := cmp.Diff(created, cr.Status.AtProvider.LastOperation); diff != "" {
+		t.Errorf("Observe(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("123456789012", cr.Status.AtProvider.CallerAccountID); diff != "" {
+		t.Errorf("Observe(...): -want account ID, +got account ID:\n%s", diff)
+	}
+
+	// Errors should be reported with the request ID of the last operation.
+	err = e.Delete(context.Background(), cr)
+	if diff := cmp.Diff(WithRequestID(errBoom, "req-b"), err, test.EquateErrors()); diff != "" {
+		t.Errorf("Delete(...): -want error, +got error:\n%s", diff)
+	}
+	if !errors.Is(err, errBoom) {
+		t.Errorf("Delete(...): want error that wraps %q, got %q", errBoom, err)
+	}
+	want := &v1beta1.LastOperation{Operation: "DeleteQueue", RequestID: "req-b", Time: metav1.NewTime(now), Result: v1beta1.OperationFailed}
+	if diff := cmp.Diff(want, cr.Status.AtProvider.LastOperation); diff != "" {
+		t.Errorf("Delete(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestWithRequestID(t *testing.T) {
+	type args struct {
+		err       error
+		requestID string
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   string
+	}{
+		"NoError": {
+			reason: "A nil error should stay nil.",
+			args:   args{requestID: "a"},
+		},
+		"NoRequestID": {
+			reason: "Errors should not be annotated if no operation was made.",
+			args:   args{err: errBoom},
+			want:   "boom",
+		},
+		"Annotated": {
+			reason: "Errors should be annotated with the request ID.",
+			args:   args{err: errBoom, requestID: "req-a"},
+			want:   "boom (last AWS request ID: req-a)",
+		},
+		"AlreadyContained": {
+			reason: "Errors that already contain the request ID should not be annotated again.",
+			args:   args{err: errors.New("boom, request id: req-a"), requestID: "req-a"},
+			want:   "boom, request id: req-a",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := WithRequestID(tc.args.err, tc.args.requestID)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nWithRequestID(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockOptionGroupClient)(nil)
+
+// MockOptionGroupClient is a type that implements all the methods for
+// OptionGroupClient interface
+type MockOptionGroupClient struct {
+	MockCreateOptionGroup    func(context.Context, *rds.CreateOptionGroupInput, []func(*rds.Options)) (*rds.CreateOptionGroupOutput, error)
+	MockDescribeOptionGroups func(context.Context, *rds.DescribeOptionGroupsInput, []func(*rds.Options)) (*rds.DescribeOptionGroupsOutput, error)
+	MockModifyOptionGroup    func(context.Context, *rds.ModifyOptionGroupInput, []func(*rds.Options)) (*rds.ModifyOptionGroupOutput, error)
+	MockDeleteOptionGroup    func(context.Context, *rds.DeleteOptionGroupInput, []func(*rds.Options)) (*rds.DeleteOptionGroupOutput, error)
+}
+
+// CreateOptionGroup mocks CreateOptionGroup method
+func (m *MockOptionGroupClient) CreateOptionGroup(ctx context.Context, input *rds.CreateOptionGroupInput, opts ...func(*rds.Options)) (*rds.CreateOptionGroupOutput, error) {
+	return m.MockCreateOptionGroup(ctx, input, opts)
+}
+
+// DescribeOptionGroups mocks DescribeOptionGroups method
+func (m *MockOptionGroupClient) DescribeOptionGroups(ctx context.Context, input *rds.DescribeOptionGroupsInput, opts ...func(*rds.Options)) (*rds.DescribeOptionGroupsOutput, error) {
+	return m.MockDescribeOptionGroups(ctx, input, opts)
+}
+
+// ModifyOptionGroup mocks ModifyOptionGroup method
+func (m *MockOptionGroupClient) ModifyOptionGroup(ctx context.Context, input *rds.ModifyOptionGroupInput, opts ...func(*rds.Options)) (*rds.ModifyOptionGroupOutput, error) {
+	return m.MockModifyOptionGroup(ctx, input, opts)
+}
+
+// DeleteOptionGroup mocks DeleteOptionGroup method
+func (m *MockOptionGroupClient) DeleteOptionGroup(ctx context.Context, input *rds.DeleteOptionGroupInput, opts ...func(*rds.Options)) (*rds.DeleteOptionGroupOutput, error) {
+	return m.MockDeleteOptionGroup(ctx, input, opts)
+}
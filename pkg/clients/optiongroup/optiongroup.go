@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client is the external client used for OptionGroup Custom Resource
+type Client interface {
+	CreateOptionGroup(context.Context, *rds.CreateOptionGroupInput, ...func(*rds.Options)) (*rds.CreateOptionGroupOutput, error)
+	DescribeOptionGroups(context.Context, *rds.DescribeOptionGroupsInput, ...func(*rds.Options)) (*rds.DescribeOptionGroupsOutput, error)
+	ModifyOptionGroup(context.Context, *rds.ModifyOptionGroupInput, ...func(*rds.Options)) (*rds.ModifyOptionGroupOutput, error)
+	DeleteOptionGroup(context.Context, *rds.DeleteOptionGroupInput, ...func(*rds.Options)) (*rds.DeleteOptionGroupOutput, error)
+}
+
+// NewClient returns a new client using AWS credentials as JSON encoded data.
+func NewClient(cfg aws.Config) Client {
+	return rds.NewFromConfig(cfg)
+}
+
+// IsOptionGroupNotFoundErr returns true if the error is because the item
+// doesn't exist.
+func IsOptionGroupNotFoundErr(err error) bool {
+	var nff *rdstypes.OptionGroupNotFoundFault
+	return errors.As(err, &nff)
+}
+
+// GenerateCreateOptionGroupInput returns the input to create an option group.
+// Options can't be set on creation and are added by a subsequent update.
+func GenerateCreateOptionGroupInput(name string, p v1alpha1.OptionGroupParameters) *rds.CreateOptionGroupInput {
+	return &rds.CreateOptionGroupInput{
+		OptionGroupName:        aws.String(name),
+		EngineName:             aws.String(p.EngineName),
+		MajorEngineVersion:     aws.String(p.MajorEngineVersion),
+		OptionGroupDescription: aws.String(p.Description),
+	}
+}
+
+// GenerateModifyOptionGroupInput returns the input that adds or changes the
+// desired options that differ from the observed ones and removes the
+// observed options that are no longer desired. Permanent options are never
+// removed since RDS does not allow it.
+func GenerateModifyOptionGroupInput(name string, p v1alpha1.OptionGroupParameters, og rdstypes.OptionGroup) *rds.ModifyOptionGroupInput {
+	observed := make(map[string]rdstypes.Option, len(og.Options))
+	for _, o := range og.Options {
+		observed[aws.ToString(o.OptionName)] = o
+	}
+	in := &rds.ModifyOptionGroupInput{
+		OptionGroupName:  aws.String(name),
+		ApplyImmediately: aws.ToBool(p.ApplyImmediately),
+	}
+	desired := make(map[string]bool, len(p.Options))
+	for _, d := range p.Options {
+		desired[d.OptionName] = true
+		if o, ok := observed[d.OptionName]; ok && isOptionUpToDate(d, o) {
+			continue
+		}
+		in.OptionsToInclude = append(in.OptionsToInclude, generateOptionConfiguration(d))
+	}
+	for _, o := range og.Options {
+		if desired[aws.ToString(o.OptionName)] || o.Permanent {
+			continue
+		}
+		in.OptionsToRemove = append(in.OptionsToRemove, aws.ToString(o.OptionName))
+	}
+	sort.Strings(in.OptionsToRemove)
+	return in
+}
+
+// IsOptionGroupUpToDate returns true if no option needs to be added, changed
+// or removed.
+func IsOptionGroupUpToDate(p v1alpha1.OptionGroupParameters, og rdstypes.OptionGroup) bool {
+	in := GenerateModifyOptionGroupInput("", p, og)
+	return len(in.OptionsToInclude) == 0 && len(in.OptionsToRemove) == 0
+}
+
+func generateOptionConfiguration(d v1alpha1.OptionConfiguration) rdstypes.OptionConfiguration {
+	c := rdstypes.OptionConfiguration{
+		OptionName:                  aws.String(d.OptionName),
+		OptionVersion:               d.OptionVersion,
+		Port:                        d.Port,
+		VpcSecurityGroupMemberships: d.VPCSecurityGroupMemberships,
+	}
+	for _, s := range d.OptionSettings {
+		c.OptionSettings = append(c.OptionSettings, rdstypes.OptionSetting{
+			Name:  aws.String(s.Name),
+			Value: aws.String(s.Value),
+		})
+	}
+	return c
+}
+
+// isOptionUpToDate compares only the fields that are set in the desired
+// option, since RDS fills in defaults for the others.
+func isOptionUpToDate(d v1alpha1.OptionConfiguration, o rdstypes.Option) bool {
+	if d.OptionVersion != nil && aws.ToString(d.OptionVersion) != aws.ToString(o.OptionVersion) {
+		return false
+	}
+	if d.Port != nil && aws.ToInt32(d.Port) != aws.ToInt32(o.Port) {
+		return false
+	}
+	settings := make(map[string]string, len(o.OptionSettings))
+	for _, s := range o.OptionSettings {
+		settings[aws.ToString(s.Name)] = aws.ToString(s.Value)
+	}
+	for _, s := range d.OptionSettings {
+		if v, ok := settings[s.Name]; !ok || v != s.Value {
+			return false
+		}
+	}
+	if len(d.VPCSecurityGroupMemberships) == 0 {
+		return true
+	}
+	if len(d.VPCSecurityGroupMemberships) != len(o.VpcSecurityGroupMemberships) {
+		return false
+	}
+	groups := make(map[string]bool, len(o.VpcSecurityGroupMemberships))
+	for _, m := range o.VpcSecurityGroupMemberships {
+		groups[aws.ToString(m.VpcSecurityGroupId)] = true
+	}
+	for _, id := range d.VPCSecurityGroupMemberships {
+		if !groups[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// LateInitialize fills the empty fields of the desired options with the
+// values reported by RDS.
+func LateInitialize(in *v1alpha1.OptionGroupParameters, og *rdstypes.OptionGroup) {
+	if og == nil {
+		return
+	}
+	in.Description = awsclients.LateInitializeString(in.Description, og.OptionGroupDescription)
+	observed := make(map[string]rdstypes.Option, len(og.Options))
+	for _, o := range og.Options {
+		observed[aws.ToString(o.OptionName)] = o
+	}
+	for i := range in.Options {
+		o, ok := observed[in.Options[i].OptionName]
+		if !ok {
+			continue
+		}
+		in.Options[i].OptionVersion = awsclients.LateInitializeStringPtr(in.Options[i].OptionVersion, o.OptionVersion)
+		if in.Options[i].Port == nil {
+			in.Options[i].Port = o.Port
+		}
+	}
+}
+
+// GenerateObservation is used to produce v1alpha1.OptionGroupObservation
+// from rdstypes.OptionGroup.
+func GenerateObservation(og rdstypes.OptionGroup) v1alpha1.OptionGroupObservation {
+	return v1alpha1.OptionGroupObservation{
+		ARN:                                   aws.ToString(og.OptionGroupArn),
+		VPCID:                                 aws.ToString(og.VpcId),
+		AllowsVPCAndNonVPCInstanceMemberships: og.AllowsVpcAndNonVpcInstanceMemberships,
+	}
+}
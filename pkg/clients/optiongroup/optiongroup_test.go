@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+)
+
+var (
+	groupName = "some-group"
+	audit     = "MARIADB_AUDIT_PLUGIN"
+	memcached = "MEMCACHED"
+	port      = int32(11211)
+	version   = "1.0"
+	sgID      = "sg-123"
+)
+
+func TestGenerateModifyOptionGroupInput(t *testing.T) {
+	type args struct {
+		p  v1alpha1.OptionGroupParameters
+		og rdstypes.OptionGroup
+	}
+
+	cases := map[string]struct {
+		args args
+		want *rds.ModifyOptionGroupInput
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha1.OptionGroupParameters{
+					Options: []v1alpha1.OptionConfiguration{{
+						OptionName:     audit,
+						OptionSettings: []v1alpha1.OptionSetting{{Name: "SERVER_AUDIT_EVENTS", Value: "CONNECT"}},
+					}},
+				},
+				og: rdstypes.OptionGroup{
+					Options: []rdstypes.Option{{
+						OptionName:    aws.String(audit),
+						OptionVersion: aws.String(version),
+						OptionSettings: []rdstypes.OptionSetting{
+							{Name: aws.String("SERVER_AUDIT_EVENTS"), Value: aws.String("CONNECT")},
+							{Name: aws.String("SERVER_AUDIT_FILE_ROTATIONS"), Value: aws.String("9")},
+						},
+					}},
+				},
+			},
+			want: &rds.ModifyOptionGroupInput{
+				OptionGroupName: aws.String(groupName),
+			},
+		},
+		"AddOption": {
+			args: args{
+				p: v1alpha1.OptionGroupParameters{
+					ApplyImmediately: aws.Bool(true),
+					Options: []v1alpha1.OptionConfiguration{{
+						OptionName:                  memcached,
+						Port:                        &port,
+						VPCSecurityGroupMemberships: []string{sgID},
+						OptionSettings:              []v1alpha1.OptionSetting{{Name: "CHUNK_SIZE", Value: "32"}},
+					}},
+				},
+			},
+			want: &rds.ModifyOptionGroupInput{
+				OptionGroupName:  aws.String(groupName),
+				ApplyImmediately: true,
+				OptionsToInclude: []rdstypes.OptionConfiguration{{
+					OptionName:                  aws.String(memcached),
+					Port:                        &port,
+					VpcSecurityGroupMemberships: []string{sgID},
+					OptionSettings:              []rdstypes.OptionSetting{{Name: aws.String("CHUNK_SIZE"), Value: aws.String("32")}},
+				}},
+			},
+		},
+		"ChangedOption": {
+			args: args{
+				p: v1alpha1.OptionGroupParameters{
+					Options: []v1alpha1.OptionConfiguration{{
+						OptionName:                  memcached,
+						VPCSecurityGroupMemberships: []string{sgID},
+					}},
+				},
+				og: rdstypes.OptionGroup{
+					Options: []rdstypes.Option{{
+						OptionName: aws.String(memcached),
+						Port:       &port,
+						VpcSecurityGroupMemberships: []rdstypes.VpcSecurityGroupMembership{
+							{VpcSecurityGroupId: aws.String("sg-other")},
+						},
+					}},
+				},
+			},
+			want: &rds.ModifyOptionGroupInput{
+				OptionGroupName: aws.String(groupName),
+				OptionsToInclude: []rdstypes.OptionConfiguration{{
+					OptionName:                  aws.String(memcached),
+					VpcSecurityGroupMemberships: []string{sgID},
+				}},
+			},
+		},
+		"RemoveOptions": {
+			args: args{
+				og: rdstypes.OptionGroup{
+					Options: []rdstypes.Option{
+						{OptionName: aws.String(memcached)},
+						{OptionName: aws.String(audit)},
+						{OptionName: aws.String("TDE"), Permanent: true},
+					},
+				},
+			},
+			want: &rds.ModifyOptionGroupInput{
+				OptionGroupName: aws.String(groupName),
+				OptionsToRemove: []string{audit, memcached},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyOptionGroupInput(groupName, tc.args.p, tc.args.og)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(rds.ModifyOptionGroupInput{}, rdstypes.OptionConfiguration{}, rdstypes.OptionSetting{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.OptionsToInclude == nil && tc.want.OptionsToRemove == nil, IsOptionGroupUpToDate(tc.args.p, tc.args.og)); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		in   v1alpha1.OptionGroupParameters
+		og   *rdstypes.OptionGroup
+		want v1alpha1.OptionGroupParameters
+	}{
+		"NilGroup": {
+			in:   v1alpha1.OptionGroupParameters{Description: "desc"},
+			want: v1alpha1.OptionGroupParameters{Description: "desc"},
+		},
+		"FillsOptions": {
+			in: v1alpha1.OptionGroupParameters{
+				Options: []v1alpha1.OptionConfiguration{{OptionName: memcached}, {OptionName: audit}},
+			},
+			og: &rdstypes.OptionGroup{
+				OptionGroupDescription: aws.String("desc"),
+				Options: []rdstypes.Option{
+					{OptionName: aws.String(memcached), Port: &port, OptionVersion: aws.String(version)},
+				},
+			},
+			want: v1alpha1.OptionGroupParameters{
+				Description: "desc",
+				Options: []v1alpha1.OptionConfiguration{
+					{OptionName: memcached, Port: &port, OptionVersion: aws.String(version)},
+					{OptionName: audit},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.in, tc.og)
+			if diff := cmp.Diff(tc.want, tc.in); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/organizations"
+)
+
+// MockClient is a type that implements all the methods for the AWS
+// Organizations Client interface.
+type MockClient struct {
+	MockCreateAccount                 func(ctx context.Context, input *organizations.CreateAccountInput, opts []request.Option) (*organizations.CreateAccountOutput, error)
+	MockDescribeCreateAccountStatus   func(ctx context.Context, input *organizations.DescribeCreateAccountStatusInput, opts []request.Option) (*organizations.DescribeCreateAccountStatusOutput, error)
+	MockDescribeAccount               func(ctx context.Context, input *organizations.DescribeAccountInput, opts []request.Option) (*organizations.DescribeAccountOutput, error)
+	MockListParents                   func(ctx context.Context, input *organizations.ListParentsInput, opts []request.Option) (*organizations.ListParentsOutput, error)
+	MockMoveAccount                   func(ctx context.Context, input *organizations.MoveAccountInput, opts []request.Option) (*organizations.MoveAccountOutput, error)
+	MockRemoveAccountFromOrganization func(ctx context.Context, input *organizations.RemoveAccountFromOrganizationInput, opts []request.Option) (*organizations.RemoveAccountFromOrganizationOutput, error)
+	MockCreateOrganizationalUnit      func(ctx context.Context, input *organizations.CreateOrganizationalUnitInput, opts []request.Option) (*organizations.CreateOrganizationalUnitOutput, error)
+	MockDescribeOrganizationalUnit    func(ctx context.Context, input *organizations.DescribeOrganizationalUnitInput, opts []request.Option) (*organizations.DescribeOrganizationalUnitOutput, error)
+	MockUpdateOrganizationalUnit      func(ctx context.Context, input *organizations.UpdateOrganizationalUnitInput, opts []request.Option) (*organizations.UpdateOrganizationalUnitOutput, error)
+	MockDeleteOrganizationalUnit      func(ctx context.Context, input *organizations.DeleteOrganizationalUnitInput, opts []request.Option) (*organizations.DeleteOrganizationalUnitOutput, error)
+	MockCreatePolicy                  func(ctx context.Context, input *organizations.CreatePolicyInput, opts []request.Option) (*organizations.CreatePolicyOutput, error)
+	MockDescribePolicy                func(ctx context.Context, input *organizations.DescribePolicyInput, opts []request.Option) (*organizations.DescribePolicyOutput, error)
+	MockUpdatePolicy                  func(ctx context.Context, input *organizations.UpdatePolicyInput, opts []request.Option) (*organizations.UpdatePolicyOutput, error)
+	MockDeletePolicy                  func(ctx context.Context, input *organizations.DeletePolicyInput, opts []request.Option) (*organizations.DeletePolicyOutput, error)
+	MockAttachPolicy                  func(ctx context.Context, input *organizations.AttachPolicyInput, opts []request.Option) (*organizations.AttachPolicyOutput, error)
+	MockDetachPolicy                  func(ctx context.Context, input *organizations.DetachPolicyInput, opts []request.Option) (*organizations.DetachPolicyOutput, error)
+	MockListTargetsForPolicy          func(ctx context.Context, input *organizations.ListTargetsForPolicyInput, opts []request.Option) (*organizations.ListTargetsForPolicyOutput, error)
+	MockListTagsForResource           func(ctx context.Context, input *organizations.ListTagsForResourceInput, opts []request.Option) (*organizations.ListTagsForResourceOutput, error)
+	MockTagResource                   func(ctx context.Context, input *organizations.TagResourceInput, opts []request.Option) (*organizations.TagResourceOutput, error)
+	MockUntagResource                 func(ctx context.Context, input *organizations.UntagResourceInput, opts []request.Option) (*organizations.UntagResourceOutput, error)
+}
+
+// CreateAccountWithContext mocks CreateAccountWithContext method
+func (m *MockClient) CreateAccountWithContext(ctx context.Context, input *organizations.CreateAccountInput, opts ...request.Option) (*organizations.CreateAccountOutput, error) {
+	return m.MockCreateAccount(ctx, input, opts)
+}
+
+// DescribeCreateAccountStatusWithContext mocks DescribeCreateAccountStatusWithContext method
+func (m *MockClient) DescribeCreateAccountStatusWithContext(ctx context.Context, input *organizations.DescribeCreateAccountStatusInput, opts ...request.Option) (*organizations.DescribeCreateAccountStatusOutput, error) {
+	return m.MockDescribeCreateAccountStatus(ctx, input, opts)
+}
+
+// DescribeAccountWithContext mocks DescribeAccountWithContext method
+func (m *MockClient) DescribeAccountWithContext(ctx context.Context, input *organizations.DescribeAccountInput, opts ...request.Option) (*organizations.DescribeAccountOutput, error) {
+	return m.MockDescribeAccount(ctx, input, opts)
+}
+
+// ListParentsWithContext mocks ListParentsWithContext method
+func (m *MockClient) ListParentsWithContext(ctx context.Context, input *organizations.ListParentsInput, opts ...request.Option) (*organizations.ListParentsOutput, error) {
+	return m.MockListParents(ctx, input, opts)
+}
+
+// MoveAccountWithContext mocks MoveAccountWithContext method
+func (m *MockClient) MoveAccountWithContext(ctx context.Context, input *organizations.MoveAccountInput, opts ...request.Option) (*organizations.MoveAccountOutput, error) {
+	return m.MockMoveAccount(ctx, input, opts)
+}
+
+// RemoveAccountFromOrganizationWithContext mocks RemoveAccountFromOrganizationWithContext method
+func (m *MockClient) RemoveAccountFromOrganizationWithContext(ctx context.Context, input *organizations.RemoveAccountFromOrganizationInput, opts ...request.Option) (*organizations.RemoveAccountFromOrganizationOutput, error) {
+	return m.MockRemoveAccountFromOrganization(ctx, input, opts)
+}
+
+// CreateOrganizationalUnitWithContext mocks CreateOrganizationalUnitWithContext method
+func (m *MockClient) CreateOrganizationalUnitWithContext(ctx context.Context, input *organizations.CreateOrganizationalUnitInput, opts ...request.Option) (*organizations.CreateOrganizationalUnitOutput, error) {
+	return m.MockCreateOrganizationalUnit(ctx, input, opts)
+}
+
+// DescribeOrganizationalUnitWithContext mocks DescribeOrganizationalUnitWithContext method
+func (m *MockClient) DescribeOrganizationalUnitWithContext(ctx context.Context, input *organizations.DescribeOrganizationalUnitInput, opts ...request.Option) (*organizations.DescribeOrganizationalUnitOutput, error) {
+	return m.MockDescribeOrganizationalUnit(ctx, input, opts)
+}
+
+// UpdateOrganizationalUnitWithContext mocks UpdateOrganizationalUnitWithContext method
+func (m *MockClient) UpdateOrganizationalUnitWithContext(ctx context.Context, input *organizations.UpdateOrganizationalUnitInput, opts ...request.Option) (*organizations.UpdateOrganizationalUnitOutput, error) {
+	return m.MockUpdateOrganizationalUnit(ctx, input, opts)
+}
+
+// DeleteOrganizationalUnitWithContext mocks DeleteOrganizationalUnitWithContext method
+func (m *MockClient) DeleteOrganizationalUnitWithContext(ctx context.Context, input *organizations.DeleteOrganizationalUnitInput, opts ...request.Option) (*organizations.DeleteOrganizationalUnitOutput, error) {
+	return m.MockDeleteOrganizationalUnit(ctx, input, opts)
+}
+
+// CreatePolicyWithContext mocks CreatePolicyWithContext method
+func (m *MockClient) CreatePolicyWithContext(ctx context.Context, input *organizations.CreatePolicyInput, opts ...request.Option) (*organizations.CreatePolicyOutput, error) {
+	return m.MockCreatePolicy(ctx, input, opts)
+}
+
+// DescribePolicyWithContext mocks DescribePolicyWithContext method
+func (m *MockClient) DescribePolicyWithContext(ctx context.Context, input *organizations.DescribePolicyInput, opts ...request.Option) (*organizations.DescribePolicyOutput, error) {
+	return m.MockDescribePolicy(ctx, input, opts)
+}
+
+// UpdatePolicyWithContext mocks UpdatePolicyWithContext method
+func (m *MockClient) UpdatePolicyWithContext(ctx context.Context, input *organizations.UpdatePolicyInput, opts ...request.Option) (*organizations.UpdatePolicyOutput, error) {
+	return m.MockUpdatePolicy(ctx, input, opts)
+}
+
+// DeletePolicyWithContext mocks DeletePolicyWithContext method
+func (m *MockClient) DeletePolicyWithContext(ctx context.Context, input *organizations.DeletePolicyInput, opts ...request.Option) (*organizations.DeletePolicyOutput, error) {
+	return m.MockDeletePolicy(ctx, input, opts)
+}
+
+// AttachPolicyWithContext mocks AttachPolicyWithContext method
+func (m *MockClient) AttachPolicyWithContext(ctx context.Context, input *organizations.AttachPolicyInput, opts ...request.Option) (*organizations.AttachPolicyOutput, error) {
+	return m.MockAttachPolicy(ctx, input, opts)
+}
+
+// DetachPolicyWithContext mocks DetachPolicyWithContext method
+func (m *MockClient) DetachPolicyWithContext(ctx context.Context, input *organizations.DetachPolicyInput, opts ...request.Option) (*organizations.DetachPolicyOutput, error) {
+	return m.MockDetachPolicy(ctx, input, opts)
+}
+
+// ListTargetsForPolicyWithContext mocks ListTargetsForPolicyWithContext method
+func (m *MockClient) ListTargetsForPolicyWithContext(ctx context.Context, input *organizations.ListTargetsForPolicyInput, opts ...request.Option) (*organizations.ListTargetsForPolicyOutput, error) {
+	return m.MockListTargetsForPolicy(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *organizations.ListTagsForResourceInput, opts ...request.Option) (*organizations.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResource(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *organizations.TagResourceInput, opts ...request.Option) (*organizations.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *organizations.UntagResourceInput, opts ...request.Option) (*organizations.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
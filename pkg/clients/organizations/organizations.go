@@ -0,0 +1,292 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errListTags   = "cannot list tags"
+	errTag        = "cannot tag resource"
+	errUntag      = "cannot untag resource"
+	errListParent = "cannot list parents"
+
+	// createAccountRequestIDPrefix is the prefix of the IDs of the
+	// asynchronous requests that create accounts.
+	createAccountRequestIDPrefix = "car-"
+)
+
+// Client defines the AWS Organizations operations used by the account,
+// organizational unit, policy and policy attachment controllers.
+type Client interface {
+	CreateAccountWithContext(context.Context, *awsorganizations.CreateAccountInput, ...request.Option) (*awsorganizations.CreateAccountOutput, error)
+	DescribeCreateAccountStatusWithContext(context.Context, *awsorganizations.DescribeCreateAccountStatusInput, ...request.Option) (*awsorganizations.DescribeCreateAccountStatusOutput, error)
+	DescribeAccountWithContext(context.Context, *awsorganizations.DescribeAccountInput, ...request.Option) (*awsorganizations.DescribeAccountOutput, error)
+	ListParentsWithContext(context.Context, *awsorganizations.ListParentsInput, ...request.Option) (*awsorganizations.ListParentsOutput, error)
+	MoveAccountWithContext(context.Context, *awsorganizations.MoveAccountInput, ...request.Option) (*awsorganizations.MoveAccountOutput, error)
+	RemoveAccountFromOrganizationWithContext(context.Context, *awsorganizations.RemoveAccountFromOrganizationInput, ...request.Option) (*awsorganizations.RemoveAccountFromOrganizationOutput, error)
+	CreateOrganizationalUnitWithContext(context.Context, *awsorganizations.CreateOrganizationalUnitInput, ...request.Option) (*awsorganizations.CreateOrganizationalUnitOutput, error)
+	DescribeOrganizationalUnitWithContext(context.Context, *awsorganizations.DescribeOrganizationalUnitInput, ...request.Option) (*awsorganizations.DescribeOrganizationalUnitOutput, error)
+	UpdateOrganizationalUnitWithContext(context.Context, *awsorganizations.UpdateOrganizationalUnitInput, ...request.Option) (*awsorganizations.UpdateOrganizationalUnitOutput, error)
+	DeleteOrganizationalUnitWithContext(context.Context, *awsorganizations.DeleteOrganizationalUnitInput, ...request.Option) (*awsorganizations.DeleteOrganizationalUnitOutput, error)
+	CreatePolicyWithContext(context.Context, *awsorganizations.CreatePolicyInput, ...request.Option) (*awsorganizations.CreatePolicyOutput, error)
+	DescribePolicyWithContext(context.Context, *awsorganizations.DescribePolicyInput, ...request.Option) (*awsorganizations.DescribePolicyOutput, error)
+	UpdatePolicyWithContext(context.Context, *awsorganizations.UpdatePolicyInput, ...request.Option) (*awsorganizations.UpdatePolicyOutput, error)
+	DeletePolicyWithContext(context.Context, *awsorganizations.DeletePolicyInput, ...request.Option) (*awsorganizations.DeletePolicyOutput, error)
+	AttachPolicyWithContext(context.Context, *awsorganizations.AttachPolicyInput, ...request.Option) (*awsorganizations.AttachPolicyOutput, error)
+	DetachPolicyWithContext(context.Context, *awsorganizations.DetachPolicyInput, ...request.Option) (*awsorganizations.DetachPolicyOutput, error)
+	ListTargetsForPolicyWithContext(context.Context, *awsorganizations.ListTargetsForPolicyInput, ...request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error)
+	ListTagsForResourceWithContext(context.Context, *awsorganizations.ListTagsForResourceInput, ...request.Option) (*awsorganizations.ListTagsForResourceOutput, error)
+	TagResourceWithContext(context.Context, *awsorganizations.TagResourceInput, ...request.Option) (*awsorganizations.TagResourceOutput, error)
+	UntagResourceWithContext(context.Context, *awsorganizations.UntagResourceInput, ...request.Option) (*awsorganizations.UntagResourceOutput, error)
+}
+
+// NewClient returns a new AWS Organizations client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsorganizations.New(sess)
+}
+
+func isErrorCode(err error, codes ...string) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	for _, c := range codes {
+		if awsErr.Code() == c {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAccountNotFound returns true if the error indicates that the account is
+// not part of the organization.
+func IsAccountNotFound(err error) bool {
+	return isErrorCode(err, awsorganizations.ErrCodeAccountNotFoundException)
+}
+
+// IsOrganizationalUnitNotFound returns true if the error indicates that the
+// organizational unit does not exist.
+func IsOrganizationalUnitNotFound(err error) bool {
+	return isErrorCode(err, awsorganizations.ErrCodeOrganizationalUnitNotFoundException)
+}
+
+// IsPolicyNotFound returns true if the error indicates that the policy does
+// not exist.
+func IsPolicyNotFound(err error) bool {
+	return isErrorCode(err, awsorganizations.ErrCodePolicyNotFoundException)
+}
+
+// IsPolicyNotAttached returns true if the error indicates that the policy is
+// not attached to the target, either because it never was or because the
+// policy or target no longer exist.
+func IsPolicyNotAttached(err error) bool {
+	return isErrorCode(err,
+		awsorganizations.ErrCodePolicyNotAttachedException,
+		awsorganizations.ErrCodePolicyNotFoundException,
+		awsorganizations.ErrCodeTargetNotFoundException)
+}
+
+// IsCreateAccountRequestID returns true if the given ID is the ID of a
+// request to create an account rather than the ID of an account.
+func IsCreateAccountRequestID(id string) bool {
+	return strings.HasPrefix(id, createAccountRequestIDPrefix)
+}
+
+// GenerateCreateAccountInput returns the input to create an account with the
+// given parameters.
+func GenerateCreateAccountInput(p v1alpha1.AccountParameters) *awsorganizations.CreateAccountInput {
+	return &awsorganizations.CreateAccountInput{
+		AccountName:            awsclient.String(p.Name),
+		Email:                  awsclient.String(p.Email),
+		IamUserAccessToBilling: p.IAMUserAccessToBilling,
+		RoleName:               p.RoleName,
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// IsAccountUpToDate returns true if the account is in the desired parent.
+// All other parameters of an account are immutable.
+func IsAccountUpToDate(p v1alpha1.AccountParameters, parentID string) bool {
+	return p.ParentID == nil || *p.ParentID == parentID
+}
+
+// GetParentID returns the ID of the root or organizational unit the given
+// account or organizational unit is in.
+func GetParentID(ctx context.Context, c Client, childID string) (string, error) {
+	out, err := c.ListParentsWithContext(ctx, &awsorganizations.ListParentsInput{ChildId: &childID})
+	if err != nil {
+		return "", awsclient.Wrap(err, errListParent)
+	}
+	if len(out.Parents) == 0 {
+		return "", nil
+	}
+	return awsclient.StringValue(out.Parents[0].Id), nil
+}
+
+// GeneratePolicyType returns the type of the policy, which defaults to a
+// service control policy.
+func GeneratePolicyType(p v1alpha1.PolicyParameters) *string {
+	if p.Type != nil {
+		return p.Type
+	}
+	return aws.String(awsorganizations.PolicyTypeServiceControlPolicy)
+}
+
+// GenerateCreatePolicyInput returns the input to create a policy with the
+// given parameters.
+func GenerateCreatePolicyInput(p v1alpha1.PolicyParameters) *awsorganizations.CreatePolicyInput {
+	return &awsorganizations.CreatePolicyInput{
+		Name:        awsclient.String(p.Name),
+		Description: aws.String(aws.StringValue(p.Description)),
+		Content:     awsclient.String(p.Content),
+		Type:        GeneratePolicyType(p),
+		Tags:        GenerateTags(p.Tags),
+	}
+}
+
+// IsPolicyUpToDate returns true if the observed policy matches the given
+// parameters. The content is compared as JSON.
+func IsPolicyUpToDate(p v1alpha1.PolicyParameters, observed *awsorganizations.Policy) bool {
+	if observed.PolicySummary == nil {
+		return false
+	}
+	return p.Name == awsclient.StringValue(observed.PolicySummary.Name) &&
+		aws.StringValue(p.Description) == awsclient.StringValue(observed.PolicySummary.Description) &&
+		awsclient.IsPolicyUpToDate(&p.Content, observed.Content)
+}
+
+// IsPolicyAttached returns true if the policy with the given ID is attached
+// to the given target.
+func IsPolicyAttached(ctx context.Context, c Client, policyID, targetID string) (bool, error) {
+	in := &awsorganizations.ListTargetsForPolicyInput{PolicyId: &policyID}
+	for {
+		out, err := c.ListTargetsForPolicyWithContext(ctx, in)
+		if err != nil {
+			return false, err
+		}
+		for _, t := range out.Targets {
+			if awsclient.StringValue(t.TargetId) == targetID {
+				return true, nil
+			}
+		}
+		if out.NextToken == nil {
+			return false, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// GenerateTags returns the given tags in the form the AWS Organizations API
+// expects them.
+func GenerateTags(tags []v1alpha1.Tag) []*awsorganizations.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	res := make([]*awsorganizations.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = &awsorganizations.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+	return res
+}
+
+// DiffTags returns the tags that have to be added to and the keys of the
+// tags that have to be removed from a resource so that its tags match the
+// desired ones.
+func DiffTags(desired []v1alpha1.Tag, observed []*awsorganizations.Tag) (add []*awsorganizations.Tag, remove []*string) {
+	local := make(map[string]string, len(desired))
+	for _, t := range desired {
+		local[t.Key] = t.Value
+	}
+	remote := make(map[string]string, len(observed))
+	for _, t := range observed {
+		remote[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	addMap, removeKeys := awsclient.DiffTags(local, remote)
+	keys := make([]string, 0, len(addMap))
+	for k := range addMap {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add = append(add, &awsorganizations.Tag{Key: aws.String(k), Value: aws.String(addMap[k])})
+	}
+	sort.Strings(removeKeys)
+	return add, aws.StringSlice(removeKeys)
+}
+
+// ListTags returns all tags of the resource with the given ID.
+func ListTags(ctx context.Context, c Client, id string) ([]*awsorganizations.Tag, error) {
+	var tags []*awsorganizations.Tag
+	in := &awsorganizations.ListTagsForResourceInput{ResourceId: &id}
+	for {
+		out, err := c.ListTagsForResourceWithContext(ctx, in)
+		if err != nil {
+			return nil, awsclient.Wrap(err, errListTags)
+		}
+		tags = append(tags, out.Tags...)
+		if out.NextToken == nil {
+			return tags, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+// AreTagsUpToDate returns true if the tags of the resource with the given ID
+// match the desired ones.
+func AreTagsUpToDate(ctx context.Context, c Client, id string, desired []v1alpha1.Tag) (bool, error) {
+	observed, err := ListTags(ctx, c, id)
+	if err != nil {
+		return false, err
+	}
+	add, remove := DiffTags(desired, observed)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
+// UpdateTags makes the tags of the resource with the given ID match the
+// desired ones.
+func UpdateTags(ctx context.Context, c Client, id string, desired []v1alpha1.Tag) error {
+	observed, err := ListTags(ctx, c, id)
+	if err != nil {
+		return err
+	}
+	add, remove := DiffTags(desired, observed)
+	if len(remove) > 0 {
+		if _, err := c.UntagResourceWithContext(ctx, &awsorganizations.UntagResourceInput{ResourceId: &id, TagKeys: remove}); err != nil {
+			return awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := c.TagResourceWithContext(ctx, &awsorganizations.TagResourceInput{ResourceId: &id, Tags: add}); err != nil {
+			return awsclient.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
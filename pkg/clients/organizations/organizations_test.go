@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizations
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+func TestIsPolicyUpToDate(t *testing.T) {
+	observed := &awsorganizations.Policy{
+		Content: aws.String(`{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":["s3:*","ec2:*"],"Resource":"*"}]}`),
+		PolicySummary: &awsorganizations.PolicySummary{
+			Name:        aws.String("deny"),
+			Description: aws.String(""),
+		},
+	}
+
+	cases := map[string]struct {
+		p    v1alpha1.PolicyParameters
+		want bool
+	}{
+		"SameContentDifferentFormatting": {
+			p: v1alpha1.PolicyParameters{
+				Name: "deny",
+				Content: `{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Deny", "Action": ["ec2:*", "s3:*"], "Resource": "*"}]
+}`,
+			},
+			want: true,
+		},
+		"ContentChanged": {
+			p: v1alpha1.PolicyParameters{
+				Name:    "deny",
+				Content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`,
+			},
+			want: false,
+		},
+		"DescriptionChanged": {
+			p: v1alpha1.PolicyParameters{
+				Name:        "deny",
+				Description: aws.String("deny all"),
+				Content:     aws.StringValue(observed.Content),
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsPolicyUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPolicyAttached(t *testing.T) {
+	pages := map[string]*awsorganizations.ListTargetsForPolicyOutput{
+		"": {
+			Targets:   []*awsorganizations.PolicyTargetSummary{{TargetId: aws.String("ou-1")}},
+			NextToken: aws.String("page-2"),
+		},
+		"page-2": {
+			Targets: []*awsorganizations.PolicyTargetSummary{{TargetId: aws.String("111111111111")}},
+		},
+	}
+	c := &fake.MockClient{
+		MockListTargetsForPolicy: func(_ context.Context, input *awsorganizations.ListTargetsForPolicyInput, _ []request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error) {
+			return pages[aws.StringValue(input.NextToken)], nil
+		},
+	}
+
+	cases := map[string]struct {
+		target string
+		want   bool
+	}{
+		"FirstPage":  {target: "ou-1", want: true},
+		"SecondPage": {target: "111111111111", want: true},
+		"NotFound":   {target: "ou-2", want: false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsPolicyAttached(context.Background(), c, "p-1", tc.target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	cases := map[string]struct {
+		desired    []v1alpha1.Tag
+		observed   []*awsorganizations.Tag
+		wantAdd    []*awsorganizations.Tag
+		wantRemove []*string
+	}{
+		"UpToDate": {
+			desired:    []v1alpha1.Tag{{Key: "team", Value: "platform"}},
+			observed:   []*awsorganizations.Tag{{Key: aws.String("team"), Value: aws.String("platform")}},
+			wantRemove: []*string{},
+		},
+		"Changed": {
+			desired: []v1alpha1.Tag{{Key: "team", Value: "security"}, {Key: "env", Value: "prod"}},
+			observed: []*awsorganizations.Tag{
+				{Key: aws.String("team"), Value: aws.String("platform")},
+				{Key: aws.String("old"), Value: aws.String("tag")},
+			},
+			wantAdd: []*awsorganizations.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+				{Key: aws.String("team"), Value: aws.String("security")},
+			},
+			wantRemove: []*string{aws.String("old"), aws.String("team")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.wantAdd, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+)
+
+// A ListPageFn lists the page of a list operation that starts at the
+// supplied token, which is nil for the first page. It returns the token of
+// the next page, or nil if it listed the last page, and whether it found
+// what the caller is looking for.
+type ListPageFn func(ctx context.Context, token *string) (next *string, found bool, err error)
+
+// FindInPages calls the supplied ListPageFn for each page of a list
+// operation until it finds what it is looking for or there are no more
+// pages. It returns whether it was found.
+//
+// Controllers that determine whether an external resource exists by listing
+// it, rather than describing it, must look at every page. Otherwise they
+// consider resources on later pages deleted once an account has more of them
+// than fit on the first one.
+func FindInPages(ctx context.Context, list ListPageFn) (bool, error) {
+	var token *string
+	for {
+		next, found, err := list(ctx, token)
+		if err != nil || found {
+			return found, err
+		}
+		// Some APIs return an empty rather than no token for the last
+		// page. A token that doesn't advance would never end the loop.
+		if StringValue(next) == "" || StringValue(next) == StringValue(token) {
+			return false, nil
+		}
+		token = next
+	}
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestFindInPages(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	// pages returns a ListPageFn over the supplied number of pages that finds
+	// what it looks for on the page with the supplied index, if any. It
+	// records the tokens it was called with.
+	pages := func(n, foundOn int, calls *[]string) ListPageFn {
+		return func(_ context.Context, token *string) (*string, bool, error) {
+			*calls = append(*calls, StringValue(token))
+			i := 0
+			if token != nil {
+				i, _ = strconv.Atoi(*token)
+			}
+			var next *string
+			if i+1 < n {
+				next = String(strconv.Itoa(i + 1))
+			}
+			return next, i == foundOn, nil
+		}
+	}
+
+	type want struct {
+		found bool
+		calls []string
+		err   error
+	}
+	cases := map[string]struct {
+		list func(calls *[]string) ListPageFn
+		want want
+	}{
+		"FoundOnFirstPage": {
+			list: func(calls *[]string) ListPageFn { return pages(3, 0, calls) },
+			want: want{found: true, calls: []string{""}},
+		},
+		"FoundOnLastPage": {
+			list: func(calls *[]string) ListPageFn { return pages(3, 2, calls) },
+			want: want{found: true, calls: []string{"", "1", "2"}},
+		},
+		"NotFound": {
+			list: func(calls *[]string) ListPageFn { return pages(3, -1, calls) },
+			want: want{calls: []string{"", "1", "2"}},
+		},
+		"EmptyToken": {
+			list: func(calls *[]string) ListPageFn {
+				return func(_ context.Context, token *string) (*string, bool, error) {
+					*calls = append(*calls, StringValue(token))
+					return String(""), false, nil
+				}
+			},
+			want: want{calls: []string{""}},
+		},
+		"RepeatedToken": {
+			list: func(calls *[]string) ListPageFn {
+				return func(_ context.Context, token *string) (*string, bool, error) {
+					*calls = append(*calls, StringValue(token))
+					return String("1"), false, nil
+				}
+			},
+			want: want{calls: []string{"", "1"}},
+		},
+		"Error": {
+			list: func(calls *[]string) ListPageFn {
+				return func(_ context.Context, token *string) (*string, bool, error) {
+					*calls = append(*calls, StringValue(token))
+					return String("1"), false, errBoom
+				}
+			},
+			want: want{calls: []string{""}, err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			found, err := FindInPages(context.Background(), tc.list(&calls))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("FindInPages(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.found, found); diff != "" {
+				t.Errorf("FindInPages(...): -want found, +got found:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("FindInPages(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
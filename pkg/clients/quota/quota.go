@@ -0,0 +1,299 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package quota checks the AWS service quotas that creating a managed
+// resource counts against before it is created, so that a Create that would
+// exceed a quota is reported in the QuotaExceeded condition of the managed
+// resource instead of failing with an opaque AWS error, possibly after other
+// resources it depends on were already created.
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+)
+
+// TypeQuotaExceeded is the type of the condition that reports whether
+// creating a managed resource would exceed an AWS service quota.
+const TypeQuotaExceeded xpv1.ConditionType = "QuotaExceeded"
+
+// Reasons of the QuotaExceeded condition.
+const (
+	ReasonExceeded    xpv1.ConditionReason = "QuotaExceeded"
+	ReasonWithinQuota xpv1.ConditionReason = "WithinQuota"
+)
+
+// DefaultInterval for which the value of a quota is cached.
+const DefaultInterval = 15 * time.Minute
+
+const errFmtExceeded = "creating the external resource requires quota %s of service %s (%s) to be at least %g in region %s, but it is %g; request an increase with a RequestedQuotaIncrease"
+
+// A Limit is an AWS service quota that creating a managed resource counts
+// against.
+type Limit struct {
+	ServiceCode string
+	QuotaCode   string
+	Description string
+
+	// Required returns the value the quota must at least have for the
+	// supplied managed resource to be created.
+	Required func(ctx context.Context, sess *session.Session, mg resource.Managed) (float64, error)
+}
+
+// Quotas that are commonly exhausted by managed resources.
+var (
+	VPCsPerRegion = Limit{
+		ServiceCode: "vpc",
+		QuotaCode:   "L-F678F1CE",
+		Description: "VPCs per Region",
+		Required: func(ctx context.Context, sess *session.Session, _ resource.Managed) (float64, error) {
+			n := 0
+			err := ec2.New(sess).DescribeVpcsPagesWithContext(ctx, &ec2.DescribeVpcsInput{}, func(page *ec2.DescribeVpcsOutput, _ bool) bool {
+				n += len(page.Vpcs)
+				return true
+			})
+			return float64(n + 1), err
+		},
+	}
+
+	ElasticIPs = Limit{
+		ServiceCode: "ec2",
+		QuotaCode:   "L-0263D0A3",
+		Description: "EC2-VPC Elastic IPs",
+		Required: func(ctx context.Context, sess *session.Session, _ resource.Managed) (float64, error) {
+			resp, err := ec2.New(sess).DescribeAddressesWithContext(ctx, &ec2.DescribeAddressesInput{
+				Filters: []*ec2.Filter{{Name: aws.String("domain"), Values: aws.StringSlice([]string{ec2.DomainTypeVpc})}},
+			})
+			if err != nil {
+				return 0, err
+			}
+			return float64(len(resp.Addresses) + 1), nil
+		},
+	}
+
+	RulesPerSecurityGroup = Limit{
+		ServiceCode: "vpc",
+		QuotaCode:   "L-0EA8095F",
+		Description: "Inbound or outbound rules per security group",
+		Required: func(_ context.Context, _ *session.Session, mg resource.Managed) (float64, error) {
+			sg, ok := mg.(*v1beta1.SecurityGroup)
+			if !ok {
+				return 0, nil
+			}
+			in, out := CountRules(sg.Spec.ForProvider.Ingress), CountRules(sg.Spec.ForProvider.Egress)
+			if in > out {
+				return float64(in), nil
+			}
+			return float64(out), nil
+		},
+	}
+)
+
+// LimitsFor returns the region of the supplied managed resource and the
+// quotas that creating it counts against, if any are checked.
+func LimitsFor(mg resource.Managed) (string, []Limit) {
+	switch cr := mg.(type) {
+	case *v1beta1.VPC:
+		return aws.StringValue(cr.Spec.ForProvider.Region), []Limit{VPCsPerRegion}
+	case *v1beta1.Address:
+		// Addresses of EC2-Classic count against a different quota.
+		if aws.StringValue(cr.Spec.ForProvider.Domain) == ec2.DomainTypeStandard {
+			return "", nil
+		}
+		return cr.Spec.ForProvider.Region, []Limit{ElasticIPs}
+	case *v1beta1.SecurityGroup:
+		return aws.StringValue(cr.Spec.ForProvider.Region), []Limit{RulesPerSecurityGroup}
+	}
+	return "", nil
+}
+
+// CountRules returns the number of security group rules the supplied
+// permissions amount to. AWS counts a rule per CIDR block, prefix list and
+// security group a permission allows traffic from or to.
+func CountRules(perms []v1beta1.IPPermission) int {
+	n := 0
+	for _, p := range perms {
+		c := len(p.IPRanges) + len(p.IPv6Ranges) + len(p.PrefixListIDs) + len(p.UserIDGroupPairs)
+		if c == 0 {
+			c = 1
+		}
+		n += c
+	}
+	return n
+}
+
+// A ConfigFn returns a session for the AWS account and credentials of the
+// supplied managed resource in the supplied region.
+type ConfigFn func(ctx context.Context, mg resource.Managed, region string) (*session.Session, error)
+
+type cached struct {
+	value   float64
+	fetched time.Time
+}
+
+// A Checker checks the quotas that creating managed resources counts
+// against.
+type Checker struct {
+	config    ConfigFn
+	newClient func(*session.Session) servicequotas.Client
+	limits    func(resource.Managed) (string, []Limit)
+	interval  time.Duration
+	now       func() time.Time
+
+	mu    sync.Mutex
+	cache map[string]cached
+}
+
+// An Option configures a Checker.
+type Option func(*Checker)
+
+// WithInterval configures the interval for which the value of a quota is
+// cached.
+func WithInterval(d time.Duration) Option {
+	return func(c *Checker) { c.interval = d }
+}
+
+// WithClientFn configures how the Service Quotas client is created.
+func WithClientFn(fn func(*session.Session) servicequotas.Client) Option {
+	return func(c *Checker) { c.newClient = fn }
+}
+
+// WithLimitsFn configures which quotas are checked for a managed resource.
+func WithLimitsFn(fn func(resource.Managed) (string, []Limit)) Option {
+	return func(c *Checker) { c.limits = fn }
+}
+
+// WithClock configures the clock of the Checker.
+func WithClock(now func() time.Time) Option {
+	return func(c *Checker) { c.now = now }
+}
+
+// NewChecker returns a Checker that uses the supplied function to connect to
+// AWS.
+func NewChecker(config ConfigFn, o ...Option) *Checker {
+	c := &Checker{
+		config:    config,
+		newClient: servicequotas.NewClient,
+		limits:    LimitsFor,
+		interval:  DefaultInterval,
+		now:       time.Now,
+		cache:     map[string]cached{},
+	}
+	for _, fn := range o {
+		fn(c)
+	}
+	return c
+}
+
+// value returns the value of the supplied quota. Values are fetched once per
+// interval, ProviderConfig and region.
+func (c *Checker) value(ctx context.Context, sess *session.Session, mg resource.Managed, region string, l Limit) (float64, error) {
+	key := region + "/" + l.ServiceCode + "/" + l.QuotaCode
+	if ref := mg.GetProviderConfigReference(); ref != nil {
+		key = ref.Name + "/" + key
+	}
+
+	c.mu.Lock()
+	v, ok := c.cache[key]
+	c.mu.Unlock()
+	if ok && c.now().Sub(v.fetched) < c.interval {
+		return v.value, nil
+	}
+
+	value, err := servicequotas.GetQuotaValue(ctx, c.newClient(sess), l.ServiceCode, l.QuotaCode)
+	if err != nil {
+		return 0, err
+	}
+	c.mu.Lock()
+	c.cache[key] = cached{value: value, fetched: c.now()}
+	c.mu.Unlock()
+	return value, nil
+}
+
+// Check returns an error and sets the QuotaExceeded condition of the supplied
+// managed resource if creating it would exceed a quota. The condition is only
+// added once a quota would be exceeded, and is set to false once none would
+// be anymore. A nil Checker checks no quotas.
+func (c *Checker) Check(ctx context.Context, mg resource.Managed) error {
+	if c == nil {
+		return nil
+	}
+	region, limits := c.limits(mg)
+	if region == "" || len(limits) == 0 {
+		return nil
+	}
+	sess, err := c.config(ctx, mg, region)
+	if err != nil {
+		return nil
+	}
+	for _, l := range limits {
+		// Checking quotas is a best effort. Failing to look up a quota or its
+		// usage, e.g. because the credentials may not read Service Quotas,
+		// must not prevent the managed resource from being created.
+		required, err := l.Required(ctx, sess, mg)
+		if err != nil {
+			continue
+		}
+		value, err := c.value(ctx, sess, mg, region, l)
+		if err != nil || required <= value {
+			continue
+		}
+		err = errors.Errorf(errFmtExceeded, l.QuotaCode, l.ServiceCode, l.Description, required, region, value)
+		mg.SetConditions(Exceeded(err.Error()))
+		return err
+	}
+	if mg.GetCondition(TypeQuotaExceeded).Status != corev1.ConditionUnknown {
+		mg.SetConditions(WithinQuota())
+	}
+	return nil
+}
+
+// Exceeded returns a condition that indicates creating the managed resource
+// would exceed a quota.
+func Exceeded(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonExceeded,
+		Message:            msg,
+	}
+}
+
+// WithinQuota returns a condition that indicates creating the managed
+// resource would not exceed a quota.
+func WithinQuota() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeQuotaExceeded,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWithinQuota,
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
+)
+
+var (
+	now     = time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	errBoom = errors.New("boom")
+)
+
+func vpc(region string) *v1beta1.VPC {
+	cr := &v1beta1.VPC{}
+	cr.Spec.ForProvider.Region = aws.String(region)
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	return cr
+}
+
+func TestCountRules(t *testing.T) {
+	cases := map[string]struct {
+		perms []v1beta1.IPPermission
+		want  int
+	}{
+		"None": {},
+		"ProtocolOnly": {
+			perms: []v1beta1.IPPermission{{IPProtocol: "-1"}},
+			want:  1,
+		},
+		"RulePerSource": {
+			perms: []v1beta1.IPPermission{
+				{
+					IPProtocol: "tcp",
+					IPRanges:   []v1beta1.IPRange{{CIDRIP: "10.0.0.0/16"}, {CIDRIP: "10.1.0.0/16"}},
+					IPv6Ranges: []v1beta1.IPv6Range{{CIDRIPv6: "::/0"}},
+				},
+				{
+					IPProtocol:       "udp",
+					UserIDGroupPairs: []v1beta1.UserIDGroupPair{{GroupID: aws.String("sg-123")}},
+				},
+			},
+			want: 4,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, CountRules(tc.perms)); diff != "" {
+				t.Errorf("CountRules(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLimitsFor(t *testing.T) {
+	type want struct {
+		region string
+		limits []string
+	}
+
+	cases := map[string]struct {
+		mg   resource.Managed
+		want want
+	}{
+		"VPC": {
+			mg:   vpc("eu-west-1"),
+			want: want{region: "eu-west-1", limits: []string{"L-F678F1CE"}},
+		},
+		"VPCAddress": {
+			mg:   &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "eu-west-1", Domain: aws.String("vpc")}}},
+			want: want{region: "eu-west-1", limits: []string{"L-0263D0A3"}},
+		},
+		"ClassicAddress": {
+			mg: &v1beta1.Address{Spec: v1beta1.AddressSpec{ForProvider: v1beta1.AddressParameters{Region: "eu-west-1", Domain: aws.String("standard")}}},
+		},
+		"Unchecked": {
+			mg: &v1beta1.Subnet{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			region, limits := LimitsFor(tc.mg)
+			var codes []string
+			for _, l := range limits {
+				codes = append(codes, l.QuotaCode)
+			}
+			if diff := cmp.Diff(tc.want, want{region: region, limits: codes}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("LimitsFor(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type quotaClient struct {
+	fake.MockClient
+	calls int
+}
+
+func newQuotaClient(applied *float64, err error) *quotaClient {
+	c := &quotaClient{}
+	c.MockGetServiceQuota = func(_ aws.Context, _ *svcsdk.GetServiceQuotaInput, _ []request.Option) (*svcsdk.GetServiceQuotaOutput, error) {
+		c.calls++
+		if err != nil {
+			return nil, err
+		}
+		if applied == nil {
+			return nil, awserr.New(svcsdk.ErrCodeNoSuchResourceException, "not applied", nil)
+		}
+		return &svcsdk.GetServiceQuotaOutput{Quota: &svcsdk.ServiceQuota{Value: applied}}, nil
+	}
+	c.MockGetAWSDefaultServiceQuota = func(_ aws.Context, _ *svcsdk.GetAWSDefaultServiceQuotaInput, _ []request.Option) (*svcsdk.GetAWSDefaultServiceQuotaOutput, error) {
+		return &svcsdk.GetAWSDefaultServiceQuotaOutput{Quota: &svcsdk.ServiceQuota{Value: aws.Float64(5)}}, nil
+	}
+	return c
+}
+
+func limits(required float64, err error) func(resource.Managed) (string, []Limit) {
+	return func(mg resource.Managed) (string, []Limit) {
+		region, _ := LimitsFor(mg)
+		return region, []Limit{{
+			ServiceCode: "vpc",
+			QuotaCode:   "L-F678F1CE",
+			Description: "VPCs per Region",
+			Required: func(context.Context, *session.Session, resource.Managed) (float64, error) {
+				return required, err
+			},
+		}}
+	}
+}
+
+func TestCheckerCheck(t *testing.T) {
+	type want struct {
+		err        error
+		conditions []xpv1.Condition
+		calls      int
+	}
+
+	cases := map[string]struct {
+		reason string
+		client *quotaClient
+		limits func(resource.Managed) (string, []Limit)
+		mg     *v1beta1.VPC
+		checks int
+		want   want
+	}{
+		"WithinDefaultQuota": {
+			reason: "No condition should be added if the default quota is not exceeded.",
+			client: newQuotaClient(nil, nil),
+			limits: limits(5, nil),
+			mg:     vpc("eu-west-1"),
+			checks: 1,
+			want:   want{calls: 1},
+		},
+		"Exceeded": {
+			reason: "Exceeding the applied quota should be reported, and its value cached.",
+			client: newQuotaClient(aws.Float64(10), nil),
+			limits: limits(11, nil),
+			mg:     vpc("eu-west-1"),
+			checks: 2,
+			want: want{
+				err:        errors.Errorf(errFmtExceeded, "L-F678F1CE", "vpc", "VPCs per Region", 11.0, "eu-west-1", 10.0),
+				conditions: []xpv1.Condition{Exceeded(errors.Errorf(errFmtExceeded, "L-F678F1CE", "vpc", "VPCs per Region", 11.0, "eu-west-1", 10.0).Error())},
+				calls:      1,
+			},
+		},
+		"NoLongerExceeded": {
+			reason: "A QuotaExceeded condition should be set to false once the quota is not exceeded anymore.",
+			client: newQuotaClient(aws.Float64(10), nil),
+			limits: limits(3, nil),
+			mg: func() *v1beta1.VPC {
+				cr := vpc("eu-west-1")
+				cr.SetConditions(Exceeded("exceeded"))
+				return cr
+			}(),
+			checks: 1,
+			want: want{
+				conditions: []xpv1.Condition{WithinQuota()},
+				calls:      1,
+			},
+		},
+		"QuotaUnavailable": {
+			reason: "Failing to get the quota should not prevent the resource from being created.",
+			client: newQuotaClient(nil, errBoom),
+			limits: limits(11, nil),
+			mg:     vpc("eu-west-1"),
+			checks: 1,
+			want:   want{calls: 1},
+		},
+		"UsageUnavailable": {
+			reason: "Failing to get the usage should not prevent the resource from being created.",
+			client: newQuotaClient(aws.Float64(10), nil),
+			limits: limits(0, errBoom),
+			mg:     vpc("eu-west-1"),
+			checks: 1,
+		},
+		"NoRegion": {
+			reason: "Resources without a region should not be checked.",
+			client: newQuotaClient(aws.Float64(10), nil),
+			limits: limits(11, nil),
+			mg:     &v1beta1.VPC{},
+			checks: 1,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := NewChecker(func(context.Context, resource.Managed, string) (*session.Session, error) { return nil, nil },
+				WithClientFn(func(*session.Session) servicequotas.Client { return tc.client }),
+				WithLimitsFn(tc.limits),
+				WithClock(func() time.Time { return now }))
+			var err error
+			for i := 0; i < tc.checks; i++ {
+				err = c.Check(context.Background(), tc.mg)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.mg.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.client.calls); diff != "" {
+				t.Errorf("\n%s\nCheck(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNilCheckerCheck(t *testing.T) {
+	cr := vpc("eu-west-1")
+	var c *Checker
+	if err := c.Check(context.Background(), cr); err != nil {
+		t.Errorf("Check(...): want no error from a nil Checker, got %v", err)
+	}
+	if c := cr.GetCondition(TypeQuotaExceeded); c.Status != corev1.ConditionUnknown {
+		t.Errorf("Check(...): want no QuotaExceeded condition from a nil Checker, got %v", c)
+	}
+}
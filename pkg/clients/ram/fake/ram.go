@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ram"
+)
+
+// MockClient is a type that implements all the methods for the RAM Client
+// interface.
+type MockClient struct {
+	MockGetResourceShares                   func(ctx context.Context, input *ram.GetResourceSharesInput, opts []request.Option) (*ram.GetResourceSharesOutput, error)
+	MockGetResourceShareAssociations        func(ctx context.Context, input *ram.GetResourceShareAssociationsInput, opts []request.Option) (*ram.GetResourceShareAssociationsOutput, error)
+	MockAssociateResourceShare              func(ctx context.Context, input *ram.AssociateResourceShareInput, opts []request.Option) (*ram.AssociateResourceShareOutput, error)
+	MockDisassociateResourceShare           func(ctx context.Context, input *ram.DisassociateResourceShareInput, opts []request.Option) (*ram.DisassociateResourceShareOutput, error)
+	MockListResourceSharePermissions        func(ctx context.Context, input *ram.ListResourceSharePermissionsInput, opts []request.Option) (*ram.ListResourceSharePermissionsOutput, error)
+	MockAssociateResourceSharePermission    func(ctx context.Context, input *ram.AssociateResourceSharePermissionInput, opts []request.Option) (*ram.AssociateResourceSharePermissionOutput, error)
+	MockDisassociateResourceSharePermission func(ctx context.Context, input *ram.DisassociateResourceSharePermissionInput, opts []request.Option) (*ram.DisassociateResourceSharePermissionOutput, error)
+	MockGetResourceShareInvitations         func(ctx context.Context, input *ram.GetResourceShareInvitationsInput, opts []request.Option) (*ram.GetResourceShareInvitationsOutput, error)
+	MockAcceptResourceShareInvitation       func(ctx context.Context, input *ram.AcceptResourceShareInvitationInput, opts []request.Option) (*ram.AcceptResourceShareInvitationOutput, error)
+}
+
+// GetResourceSharesWithContext mocks GetResourceSharesWithContext method
+func (m *MockClient) GetResourceSharesWithContext(ctx context.Context, input *ram.GetResourceSharesInput, opts ...request.Option) (*ram.GetResourceSharesOutput, error) {
+	return m.MockGetResourceShares(ctx, input, opts)
+}
+
+// GetResourceShareAssociationsWithContext mocks GetResourceShareAssociationsWithContext method
+func (m *MockClient) GetResourceShareAssociationsWithContext(ctx context.Context, input *ram.GetResourceShareAssociationsInput, opts ...request.Option) (*ram.GetResourceShareAssociationsOutput, error) {
+	return m.MockGetResourceShareAssociations(ctx, input, opts)
+}
+
+// AssociateResourceShareWithContext mocks AssociateResourceShareWithContext method
+func (m *MockClient) AssociateResourceShareWithContext(ctx context.Context, input *ram.AssociateResourceShareInput, opts ...request.Option) (*ram.AssociateResourceShareOutput, error) {
+	return m.MockAssociateResourceShare(ctx, input, opts)
+}
+
+// DisassociateResourceShareWithContext mocks DisassociateResourceShareWithContext method
+func (m *MockClient) DisassociateResourceShareWithContext(ctx context.Context, input *ram.DisassociateResourceShareInput, opts ...request.Option) (*ram.DisassociateResourceShareOutput, error) {
+	return m.MockDisassociateResourceShare(ctx, input, opts)
+}
+
+// ListResourceSharePermissionsWithContext mocks ListResourceSharePermissionsWithContext method
+func (m *MockClient) ListResourceSharePermissionsWithContext(ctx context.Context, input *ram.ListResourceSharePermissionsInput, opts ...request.Option) (*ram.ListResourceSharePermissionsOutput, error) {
+	return m.MockListResourceSharePermissions(ctx, input, opts)
+}
+
+// AssociateResourceSharePermissionWithContext mocks AssociateResourceSharePermissionWithContext method
+func (m *MockClient) AssociateResourceSharePermissionWithContext(ctx context.Context, input *ram.AssociateResourceSharePermissionInput, opts ...request.Option) (*ram.AssociateResourceSharePermissionOutput, error) {
+	return m.MockAssociateResourceSharePermission(ctx, input, opts)
+}
+
+// DisassociateResourceSharePermissionWithContext mocks DisassociateResourceSharePermissionWithContext method
+func (m *MockClient) DisassociateResourceSharePermissionWithContext(ctx context.Context, input *ram.DisassociateResourceSharePermissionInput, opts ...request.Option) (*ram.DisassociateResourceSharePermissionOutput, error) {
+	return m.MockDisassociateResourceSharePermission(ctx, input, opts)
+}
+
+// GetResourceShareInvitationsWithContext mocks GetResourceShareInvitationsWithContext method
+func (m *MockClient) GetResourceShareInvitationsWithContext(ctx context.Context, input *ram.GetResourceShareInvitationsInput, opts ...request.Option) (*ram.GetResourceShareInvitationsOutput, error) {
+	return m.MockGetResourceShareInvitations(ctx, input, opts)
+}
+
+// AcceptResourceShareInvitationWithContext mocks AcceptResourceShareInvitationWithContext method
+func (m *MockClient) AcceptResourceShareInvitationWithContext(ctx context.Context, input *ram.AcceptResourceShareInvitationInput, opts ...request.Option) (*ram.AcceptResourceShareInvitationOutput, error) {
+	return m.MockAcceptResourceShareInvitation(ctx, input, opts)
+}
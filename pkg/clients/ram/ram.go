@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsram "github.com/aws/aws-sdk-go/service/ram"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+)
+
+// Client defines the RAM operations used to manage the associations of a
+// resource share and to accept resource shares on the consumer side.
+type Client interface {
+	GetResourceSharesWithContext(context.Context, *awsram.GetResourceSharesInput, ...request.Option) (*awsram.GetResourceSharesOutput, error)
+	GetResourceShareAssociationsWithContext(context.Context, *awsram.GetResourceShareAssociationsInput, ...request.Option) (*awsram.GetResourceShareAssociationsOutput, error)
+	AssociateResourceShareWithContext(context.Context, *awsram.AssociateResourceShareInput, ...request.Option) (*awsram.AssociateResourceShareOutput, error)
+	DisassociateResourceShareWithContext(context.Context, *awsram.DisassociateResourceShareInput, ...request.Option) (*awsram.DisassociateResourceShareOutput, error)
+	ListResourceSharePermissionsWithContext(context.Context, *awsram.ListResourceSharePermissionsInput, ...request.Option) (*awsram.ListResourceSharePermissionsOutput, error)
+	AssociateResourceSharePermissionWithContext(context.Context, *awsram.AssociateResourceSharePermissionInput, ...request.Option) (*awsram.AssociateResourceSharePermissionOutput, error)
+	DisassociateResourceSharePermissionWithContext(context.Context, *awsram.DisassociateResourceSharePermissionInput, ...request.Option) (*awsram.DisassociateResourceSharePermissionOutput, error)
+	GetResourceShareInvitationsWithContext(context.Context, *awsram.GetResourceShareInvitationsInput, ...request.Option) (*awsram.GetResourceShareInvitationsOutput, error)
+	AcceptResourceShareInvitationWithContext(context.Context, *awsram.AcceptResourceShareInvitationInput, ...request.Option) (*awsram.AcceptResourceShareInvitationOutput, error)
+}
+
+// NewClient returns a new RAM client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsram.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the resource share or
+// invitation does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	switch awsErr.Code() {
+	case awsram.ErrCodeUnknownResourceException,
+		awsram.ErrCodeResourceArnNotFoundException,
+		awsram.ErrCodeResourceShareInvitationArnNotFoundException:
+		return true
+	}
+	return false
+}
+
+// IsInvitationAlreadyAccepted returns true if the error indicates that the
+// resource share invitation has been accepted already.
+func IsInvitationAlreadyAccepted(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awsram.ErrCodeResourceShareInvitationAlreadyAcceptedException
+}
+
+// DesiredResourceARNs returns the sorted, de-duplicated ARNs of all resources
+// that should be associated with the resource share.
+func DesiredResourceARNs(p v1alpha1.ResourceShareParameters) []string {
+	arns := make([]*string, 0, len(p.ResourceARNs)+len(p.TransitGatewayARNs)+len(p.SubnetARNs))
+	arns = append(arns, p.ResourceARNs...)
+	arns = append(arns, p.TransitGatewayARNs...)
+	arns = append(arns, p.SubnetARNs...)
+	return normalize(aws.StringValueSlice(arns))
+}
+
+// ListAssociatedEntities returns the sorted entities of the given association
+// type, i.e. principals or resource ARNs, that are associated or being
+// associated with the resource share.
+func ListAssociatedEntities(ctx context.Context, client Client, shareARN, associationType string) ([]string, error) {
+	var entities []string
+	input := &awsram.GetResourceShareAssociationsInput{
+		AssociationType:   aws.String(associationType),
+		ResourceShareArns: aws.StringSlice([]string{shareARN}),
+	}
+	for {
+		out, err := client.GetResourceShareAssociationsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range out.ResourceShareAssociations {
+			switch aws.StringValue(a.Status) {
+			case awsram.ResourceShareAssociationStatusAssociated, awsram.ResourceShareAssociationStatusAssociating:
+				entities = append(entities, aws.StringValue(a.AssociatedEntity))
+			}
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			return normalize(entities), nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// ListPermissionARNs returns the sorted ARNs of the permissions associated
+// with the resource share.
+func ListPermissionARNs(ctx context.Context, client Client, shareARN string) ([]string, error) {
+	var arns []string
+	input := &awsram.ListResourceSharePermissionsInput{ResourceShareArn: aws.String(shareARN)}
+	for {
+		out, err := client.ListResourceSharePermissionsWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range out.Permissions {
+			arns = append(arns, aws.StringValue(p.Arn))
+		}
+		if aws.StringValue(out.NextToken) == "" {
+			return normalize(arns), nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// DiffStrings returns the elements of desired that are missing in observed
+// and the elements of observed that are not desired.
+func DiffStrings(desired, observed []string) (add, remove []string) {
+	o := make(map[string]struct{}, len(observed))
+	for _, v := range observed {
+		o[v] = struct{}{}
+	}
+	d := make(map[string]struct{}, len(desired))
+	for _, v := range desired {
+		d[v] = struct{}{}
+		if _, ok := o[v]; !ok {
+			add = append(add, v)
+		}
+	}
+	for _, v := range observed {
+		if _, ok := d[v]; !ok {
+			remove = append(remove, v)
+		}
+	}
+	return add, remove
+}
+
+// LatestInvitation returns the most recent of the given invitations or nil
+// if there is none.
+func LatestInvitation(invitations []*awsram.ResourceShareInvitation) *awsram.ResourceShareInvitation {
+	var latest *awsram.ResourceShareInvitation
+	for _, i := range invitations {
+		if latest == nil || aws.TimeValue(i.InvitationTimestamp).After(aws.TimeValue(latest.InvitationTimestamp)) {
+			latest = i
+		}
+	}
+	return latest
+}
+
+func normalize(in []string) []string {
+	seen := make(map[string]struct{}, len(in))
+	out := make([]string, 0, len(in))
+	for _, v := range in {
+		if _, ok := seen[v]; ok || v == "" {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
+	}
+	sort.Strings(out)
+	return out
+}
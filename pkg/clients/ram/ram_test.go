@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ram
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awsram "github.com/aws/aws-sdk-go/service/ram"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+)
+
+func TestDesiredResourceARNs(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ResourceShareParameters
+		want []string
+	}{
+		"Empty": {
+			want: []string{},
+		},
+		"MergedAndDeduplicated": {
+			p: v1alpha1.ResourceShareParameters{
+				ResourceARNs: aws.StringSlice([]string{"arn:subnet/b", "arn:tgw/a"}),
+				CustomResourceShareParameters: v1alpha1.CustomResourceShareParameters{
+					TransitGatewayARNs: aws.StringSlice([]string{"arn:tgw/a"}),
+					SubnetARNs:         aws.StringSlice([]string{"arn:subnet/a", ""}),
+				},
+			},
+			want: []string{"arn:subnet/a", "arn:subnet/b", "arn:tgw/a"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := DesiredResourceARNs(tc.p)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("DesiredResourceARNs(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffStrings(t *testing.T) {
+	type want struct {
+		add    []string
+		remove []string
+	}
+
+	cases := map[string]struct {
+		desired  []string
+		observed []string
+		want     want
+	}{
+		"Equal": {
+			desired:  []string{"a", "b"},
+			observed: []string{"a", "b"},
+		},
+		"AddAndRemove": {
+			desired:  []string{"a", "b"},
+			observed: []string{"b", "c"},
+			want: want{
+				add:    []string{"a"},
+				remove: []string{"c"},
+			},
+		},
+		"RemoveAll": {
+			observed: []string{"a"},
+			want: want{
+				remove: []string{"a"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffStrings(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLatestInvitation(t *testing.T) {
+	now := time.Now()
+	older := &awsram.ResourceShareInvitation{
+		ResourceShareInvitationArn: aws.String("older"),
+		InvitationTimestamp:        aws.Time(now.Add(-time.Hour)),
+	}
+	newer := &awsram.ResourceShareInvitation{
+		ResourceShareInvitationArn: aws.String("newer"),
+		InvitationTimestamp:        aws.Time(now),
+	}
+
+	cases := map[string]struct {
+		in   []*awsram.ResourceShareInvitation
+		want *awsram.ResourceShareInvitation
+	}{
+		"None": {},
+		"Latest": {
+			in:   []*awsram.ResourceShareInvitation{older, newer},
+			want: newer,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := LatestInvitation(tc.in)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("LatestInvitation(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// MockReservationClient for testing.
+type MockReservationClient struct {
+	MockDescribeReservedDBInstances func(context.Context, *rds.DescribeReservedDBInstancesInput, []func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error)
+	MockDescribeDBInstances         func(context.Context, *rds.DescribeDBInstancesInput, []func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// DescribeReservedDBInstances calls the underlying
+// MockDescribeReservedDBInstances method.
+func (m *MockReservationClient) DescribeReservedDBInstances(ctx context.Context, i *rds.DescribeReservedDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error) {
+	return m.MockDescribeReservedDBInstances(ctx, i, opts)
+}
+
+// DescribeDBInstances calls the underlying MockDescribeDBInstances method.
+func (m *MockReservationClient) DescribeDBInstances(ctx context.Context, i *rds.DescribeDBInstancesInput, opts ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	return m.MockDescribeDBInstances(ctx, i, opts)
+}
@@ -39,12 +39,29 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/normalize"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
 )
 
 const (
 	errGetPasswordSecretFailed = "cannot get password secret"
 )
 
+// TransitionalStates of RDS instances, which change without the provider
+// doing anything.
+var TransitionalStates = pollinterval.Transitional(
+	"creating", "deleting", "modifying", "backing-up", "rebooting", "starting", "stopping",
+	"upgrading", "renaming", "resetting-master-credentials", "maintenance",
+	"configuring-enhanced-monitoring", "configuring-iam-database-auth", "configuring-log-exports",
+	"converting-to-vpc", "moving-to-vpc",
+)
+
+// RDS accepts these fields in any case but reports them in lowercase.
+var rdsInstanceNormalizers = normalize.Register(v1beta1.RDSInstanceGroupKind, normalize.Fields{
+	"DBParameterGroupName":       normalize.Lowercase,
+	"PreferredMaintenanceWindow": normalize.Lowercase,
+})
+
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateDBInstance(context.Context, *rds.CreateDBInstanceInput, ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error)
@@ -257,6 +274,10 @@ func GenerateRestoreDBInstanceFromSnapshotInput(name string, p *v1beta1.RDSInsta
 func CreatePatch(in *rdstypes.DBInstance, target *v1beta1.RDSInstanceParameters) (*v1beta1.RDSInstanceParameters, error) {
 	currentParams := &v1beta1.RDSInstanceParameters{}
 	LateInitialize(currentParams, in)
+	currentParams = currentParams.DeepCopy()
+	rdsInstanceNormalizers.Apply(currentParams)
+	target = target.DeepCopy()
+	rdsInstanceNormalizers.Apply(target)
 
 	// Don't attempt to scale down storage if autoscaling is enabled,
 	// and the current storage is larger than what was once
@@ -597,6 +618,7 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ReadinessProbe"),
 	) && !pwdChanged, nil
 }
 
@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+)
+
+const reservationStateActive = "active"
+
+// DB instances in these states are not billed for compute and hence do not
+// need to be covered by a reservation.
+var unbilledInstanceStatuses = map[string]bool{
+	"deleting": true,
+	"stopping": true,
+	"stopped":  true,
+}
+
+// A ReservationClient reads RDS reserved instances and the DB instances they
+// may cover.
+type ReservationClient interface {
+	DescribeReservedDBInstances(context.Context, *rds.DescribeReservedDBInstancesInput, ...func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error)
+	DescribeDBInstances(context.Context, *rds.DescribeDBInstancesInput, ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error)
+}
+
+// NewReservationClient returns a new RDS ReservationClient.
+func NewReservationClient(cfg aws.Config) ReservationClient {
+	return rds.NewFromConfig(cfg)
+}
+
+// ListReservedDBInstances returns all reserved DB instances of the region.
+func ListReservedDBInstances(ctx context.Context, c ReservationClient) ([]rdstypes.ReservedDBInstance, error) {
+	var reserved []rdstypes.ReservedDBInstance
+	input := &rds.DescribeReservedDBInstancesInput{}
+	for {
+		out, err := c.DescribeReservedDBInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		reserved = append(reserved, out.ReservedDBInstances...)
+		if aws.ToString(out.Marker) == "" {
+			return reserved, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+// ListDBInstances returns all DB instances of the region.
+func ListDBInstances(ctx context.Context, c ReservationClient) ([]rdstypes.DBInstance, error) {
+	var instances []rdstypes.DBInstance
+	input := &rds.DescribeDBInstancesInput{}
+	for {
+		out, err := c.DescribeDBInstances(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, out.DBInstances...)
+		if aws.ToString(out.Marker) == "" {
+			return instances, nil
+		}
+		input.Marker = out.Marker
+	}
+}
+
+type dbInstanceKey struct {
+	class   string
+	multiAZ bool
+}
+
+// GenerateReservedDBInstanceCoverageObservation computes the reserved
+// capacity coverage of the supplied DB instances by the supplied reserved
+// instances. Only active reservations and instances that are billed for
+// compute are taken into account. Coverage is computed per instance class
+// and deployment option; database engines and size flexibility are not
+// considered.
+func GenerateReservedDBInstanceCoverageObservation(p v1alpha1.ReservedDBInstanceCoverageParameters, reserved []rdstypes.ReservedDBInstance, instances []rdstypes.DBInstance) v1alpha1.ReservedDBInstanceCoverageObservation { // nolint:gocyclo
+	include := func(class string) bool {
+		if len(p.DBInstanceClasses) == 0 {
+			return true
+		}
+		for _, c := range p.DBInstanceClasses {
+			if c == class {
+				return true
+			}
+		}
+		return false
+	}
+
+	o := v1alpha1.ReservedDBInstanceCoverageObservation{}
+	coverage := map[dbInstanceKey]*v1alpha1.DBInstanceClassCoverage{}
+	get := func(k dbInstanceKey) *v1alpha1.DBInstanceClassCoverage {
+		if _, ok := coverage[k]; !ok {
+			coverage[k] = &v1alpha1.DBInstanceClassCoverage{DBInstanceClass: k.class, MultiAZ: k.multiAZ}
+		}
+		return coverage[k]
+	}
+
+	for _, r := range reserved {
+		if aws.ToString(r.State) != reservationStateActive || !include(aws.ToString(r.DBInstanceClass)) {
+			continue
+		}
+		res := v1alpha1.DBInstanceReservation{
+			ReservedDBInstanceID: aws.ToString(r.ReservedDBInstanceId),
+			DBInstanceClass:      aws.ToString(r.DBInstanceClass),
+			DBInstanceCount:      r.DBInstanceCount,
+			MultiAZ:              r.MultiAZ,
+			ProductDescription:   aws.ToString(r.ProductDescription),
+			OfferingType:         aws.ToString(r.OfferingType),
+		}
+		if r.StartTime != nil {
+			start := metav1.NewTime(*r.StartTime)
+			end := metav1.NewTime(r.StartTime.Add(time.Duration(r.Duration) * time.Second))
+			res.StartTime, res.EndTime = &start, &end
+		}
+		o.Reservations = append(o.Reservations, res)
+		get(dbInstanceKey{class: res.DBInstanceClass, multiAZ: res.MultiAZ}).ReservedInstances += r.DBInstanceCount
+	}
+
+	for _, i := range instances {
+		if unbilledInstanceStatuses[aws.ToString(i.DBInstanceStatus)] || !include(aws.ToString(i.DBInstanceClass)) {
+			continue
+		}
+		get(dbInstanceKey{class: aws.ToString(i.DBInstanceClass), multiAZ: i.MultiAZ}).RunningInstances++
+	}
+
+	for _, c := range coverage {
+		if c.RunningInstances > c.ReservedInstances {
+			c.UncoveredInstances = c.RunningInstances - c.ReservedInstances
+		}
+		o.UncoveredInstances += c.UncoveredInstances
+		o.Coverage = append(o.Coverage, *c)
+	}
+
+	sort.Slice(o.Reservations, func(i, j int) bool {
+		return o.Reservations[i].ReservedDBInstanceID < o.Reservations[j].ReservedDBInstanceID
+	})
+	sort.Slice(o.Coverage, func(i, j int) bool {
+		if o.Coverage[i].DBInstanceClass != o.Coverage[j].DBInstanceClass {
+			return o.Coverage[i].DBInstanceClass < o.Coverage[j].DBInstanceClass
+		}
+		return !o.Coverage[i].MultiAZ && o.Coverage[j].MultiAZ
+	})
+	return o
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+func TestGenerateReservedDBInstanceCoverageObservation(t *testing.T) {
+	type args struct {
+		p         v1alpha1.ReservedDBInstanceCoverageParameters
+		reserved  []rdstypes.ReservedDBInstance
+		instances []rdstypes.DBInstance
+	}
+
+	cases := map[string]struct {
+		args args
+		want v1alpha1.ReservedDBInstanceCoverageObservation
+	}{
+		"SeparatesMultiAZ": {
+			args: args{
+				reserved: []rdstypes.ReservedDBInstance{
+					{
+						ReservedDBInstanceId: aws.String("ri-1"),
+						DBInstanceClass:      aws.String("db.r6g.large"),
+						DBInstanceCount:      1,
+						ProductDescription:   aws.String("postgresql"),
+						State:                aws.String("active"),
+					},
+					{
+						ReservedDBInstanceId: aws.String("ri-2"),
+						DBInstanceClass:      aws.String("db.r6g.large"),
+						DBInstanceCount:      1,
+						State:                aws.String("payment-pending"),
+					},
+				},
+				instances: []rdstypes.DBInstance{
+					{DBInstanceClass: aws.String("db.r6g.large"), DBInstanceStatus: aws.String("available")},
+					{DBInstanceClass: aws.String("db.r6g.large"), DBInstanceStatus: aws.String("stopped")},
+					{DBInstanceClass: aws.String("db.r6g.large"), DBInstanceStatus: aws.String("available"), MultiAZ: true},
+				},
+			},
+			want: v1alpha1.ReservedDBInstanceCoverageObservation{
+				Reservations: []v1alpha1.DBInstanceReservation{{
+					ReservedDBInstanceID: "ri-1",
+					DBInstanceClass:      "db.r6g.large",
+					DBInstanceCount:      1,
+					ProductDescription:   "postgresql",
+				}},
+				Coverage: []v1alpha1.DBInstanceClassCoverage{
+					{DBInstanceClass: "db.r6g.large", ReservedInstances: 1, RunningInstances: 1},
+					{DBInstanceClass: "db.r6g.large", MultiAZ: true, RunningInstances: 1, UncoveredInstances: 1},
+				},
+				UncoveredInstances: 1,
+			},
+		},
+		"Filtered": {
+			args: args{
+				p: v1alpha1.ReservedDBInstanceCoverageParameters{DBInstanceClasses: []string{"db.t3.micro"}},
+				instances: []rdstypes.DBInstance{
+					{DBInstanceClass: aws.String("db.r6g.large"), DBInstanceStatus: aws.String("available")},
+					{DBInstanceClass: aws.String("db.t3.micro"), DBInstanceStatus: aws.String("available")},
+				},
+			},
+			want: v1alpha1.ReservedDBInstanceCoverageObservation{
+				Coverage: []v1alpha1.DBInstanceClassCoverage{
+					{DBInstanceClass: "db.t3.micro", RunningInstances: 1, UncoveredInstances: 1},
+				},
+				UncoveredInstances: 1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateReservedDBInstanceCoverageObservation(tc.args.p, tc.args.reserved, tc.args.instances)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestListReservedDBInstances(t *testing.T) {
+	c := &fake.MockReservationClient{
+		MockDescribeReservedDBInstances: func(_ context.Context, i *rds.DescribeReservedDBInstancesInput, _ []func(*rds.Options)) (*rds.DescribeReservedDBInstancesOutput, error) {
+			if i.Marker == nil {
+				return &rds.DescribeReservedDBInstancesOutput{
+					ReservedDBInstances: []rdstypes.ReservedDBInstance{{ReservedDBInstanceId: aws.String("a")}},
+					Marker:              aws.String("next"),
+				}, nil
+			}
+			return &rds.DescribeReservedDBInstancesOutput{
+				ReservedDBInstances: []rdstypes.ReservedDBInstance{{ReservedDBInstanceId: aws.String("b")}},
+			}, nil
+		},
+	}
+	got, err := ListReservedDBInstances(context.Background(), c)
+	if err != nil {
+		t.Fatalf("ListReservedDBInstances(...): unexpected error: %v", err)
+	}
+	if len(got) != 2 || aws.ToString(got[1].ReservedDBInstanceId) != "b" {
+		t.Errorf("ListReservedDBInstances(...): want reservations of both pages, got %d", len(got))
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package readiness checks that the endpoints of data stores accept
+// connections before the managed resources representing them are reported as
+// ready.
+package readiness
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// ReasonProbeFailed is the reason of the Ready condition of resources that
+// are available in AWS but whose endpoint failed the readiness probe.
+const ReasonProbeFailed xpv1.ConditionReason = "ReadinessProbeFailed"
+
+// DefaultTimeout of a readiness probe.
+const DefaultTimeout = 5 * time.Second
+
+const (
+	errNoEndpoint  = "resource has no endpoint to probe"
+	errResolveFmt  = "cannot resolve %s"
+	errNoAddresses = "%s resolves to no addresses"
+	errDialFmt     = "cannot connect to %s"
+)
+
+// ProbeFailed returns a condition that indicates the resource is available in
+// AWS, but its endpoint failed the readiness probe.
+func ProbeFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               xpv1.TypeReady,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonProbeFailed,
+		Message:            err.Error(),
+	}
+}
+
+// A Prober checks that a TCP endpoint accepts connections.
+type Prober interface {
+	Probe(ctx context.Context, host string, port int, timeout time.Duration) error
+}
+
+// A ProberFn is a function that satisfies the Prober interface.
+type ProberFn func(ctx context.Context, host string, port int, timeout time.Duration) error
+
+// Probe the supplied endpoint.
+func (fn ProberFn) Probe(ctx context.Context, host string, port int, timeout time.Duration) error {
+	return fn(ctx, host, port, timeout)
+}
+
+// NewTCPProber returns a Prober that resolves the host and opens a TCP
+// connection to it from the provider pod.
+func NewTCPProber() Prober {
+	return &tcpProber{resolver: net.DefaultResolver}
+}
+
+type tcpProber struct {
+	resolver *net.Resolver
+}
+
+func (p *tcpProber) Probe(ctx context.Context, host string, port int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	addrs, err := p.resolver.LookupHost(ctx, host)
+	if err != nil {
+		return errors.Wrapf(err, errResolveFmt, host)
+	}
+	if len(addrs) == 0 {
+		return errors.Errorf(errNoAddresses, host)
+	}
+	d := &net.Dialer{}
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(addrs[0], strconv.Itoa(port)))
+	if err != nil {
+		return errors.Wrapf(err, errDialFmt, net.JoinHostPort(host, strconv.Itoa(port)))
+	}
+	return conn.Close()
+}
+
+// Check runs the supplied readiness probe against the supplied endpoint and
+// returns the Ready condition of a resource that is available in AWS. The
+// resource is available if no probe is configured or if the probe succeeds.
+// The port of the probe, if any, takes precedence over the supplied one.
+func Check(ctx context.Context, p Prober, probe *v1beta1.ReadinessProbe, host string, port int) xpv1.Condition {
+	if probe == nil {
+		return xpv1.Available()
+	}
+	if probe.Port != nil {
+		port = int(*probe.Port)
+	}
+	if host == "" || port == 0 {
+		return ProbeFailed(errors.New(errNoEndpoint))
+	}
+	timeout := DefaultTimeout
+	if probe.TimeoutSeconds != nil {
+		timeout = time.Duration(*probe.TimeoutSeconds) * time.Second
+	}
+	if err := p.Probe(ctx, host, port, timeout); err != nil {
+		return ProbeFailed(err)
+	}
+	return xpv1.Available()
+}
+
+// Endpoint returns the host and port stored under the conventional endpoint
+// and port keys of the supplied connection details.
+func Endpoint(conn managed.ConnectionDetails) (string, int) {
+	port, _ := strconv.Atoi(string(conn[xpv1.ResourceCredentialsSecretPortKey]))
+	return string(conn[xpv1.ResourceCredentialsSecretEndpointKey]), port
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package readiness
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+func TestCheck(t *testing.T) {
+	errBoom := errors.New("boom")
+	port := int32(6380)
+	timeout := int32(1)
+
+	type args struct {
+		p     Prober
+		probe *v1beta1.ReadinessProbe
+		host  string
+		port  int
+	}
+
+	cases := map[string]struct {
+		args args
+		want xpv1.Condition
+	}{
+		"NoProbe": {
+			args: args{
+				p: ProberFn(func(context.Context, string, int, time.Duration) error { return errBoom }),
+			},
+			want: xpv1.Available(),
+		},
+		"ProbeSucceeded": {
+			args: args{
+				p: ProberFn(func(_ context.Context, host string, port int, timeout time.Duration) error {
+					if host != "db.example.org" || port != 5432 || timeout != DefaultTimeout {
+						return errBoom
+					}
+					return nil
+				}),
+				probe: &v1beta1.ReadinessProbe{},
+				host:  "db.example.org",
+				port:  5432,
+			},
+			want: xpv1.Available(),
+		},
+		"ProbeOverrides": {
+			args: args{
+				p: ProberFn(func(_ context.Context, _ string, port int, timeout time.Duration) error {
+					if port != 6380 || timeout != time.Second {
+						return errBoom
+					}
+					return nil
+				}),
+				probe: &v1beta1.ReadinessProbe{Port: &port, TimeoutSeconds: &timeout},
+				host:  "cache.example.org",
+				port:  6379,
+			},
+			want: xpv1.Available(),
+		},
+		"ProbeFailed": {
+			args: args{
+				p:     ProberFn(func(context.Context, string, int, time.Duration) error { return errBoom }),
+				probe: &v1beta1.ReadinessProbe{},
+				host:  "db.example.org",
+				port:  5432,
+			},
+			want: ProbeFailed(errBoom),
+		},
+		"NoEndpoint": {
+			args: args{
+				probe: &v1beta1.ReadinessProbe{},
+			},
+			want: ProbeFailed(errors.New(errNoEndpoint)),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Check(context.Background(), tc.args.p, tc.args.probe, tc.args.host, tc.args.port)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("Check(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTCPProber(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("cannot listen: %v", err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+
+	p := NewTCPProber()
+	if err := p.Probe(context.Background(), "127.0.0.1", port, time.Second); err != nil {
+		t.Errorf("Probe(...): unexpected error: %v", err)
+	}
+
+	_ = l.Close()
+	if err := p.Probe(context.Background(), "127.0.0.1", port, time.Second); err == nil {
+		t.Error("Probe(...): expected an error for a closed port")
+	}
+}
@@ -28,6 +28,9 @@ type MockRedshiftClient struct {
 	MockDescribe func(ctx context.Context, input *redshift.DescribeClustersInput, opts []func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
 	MockModify   func(ctx context.Context, input *redshift.ModifyClusterInput, opts []func(*redshift.Options)) (*redshift.ModifyClusterOutput, error)
 	MockDelete   func(ctx context.Context, input *redshift.DeleteClusterInput, opts []func(*redshift.Options)) (*redshift.DeleteClusterOutput, error)
+	MockRestore  func(ctx context.Context, input *redshift.RestoreFromClusterSnapshotInput, opts []func(*redshift.Options)) (*redshift.RestoreFromClusterSnapshotOutput, error)
+	MockPause    func(ctx context.Context, input *redshift.PauseClusterInput, opts []func(*redshift.Options)) (*redshift.PauseClusterOutput, error)
+	MockResume   func(ctx context.Context, input *redshift.ResumeClusterInput, opts []func(*redshift.Options)) (*redshift.ResumeClusterOutput, error)
 }
 
 // DescribeClusters finds Redshift Instance by name
@@ -49,3 +52,18 @@ func (m *MockRedshiftClient) ModifyCluster(ctx context.Context, input *redshift.
 func (m *MockRedshiftClient) DeleteCluster(ctx context.Context, input *redshift.DeleteClusterInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterOutput, error) {
 	return m.MockDelete(ctx, input, opts)
 }
+
+// RestoreFromClusterSnapshot restores a Redshift Instance from a snapshot
+func (m *MockRedshiftClient) RestoreFromClusterSnapshot(ctx context.Context, input *redshift.RestoreFromClusterSnapshotInput, opts ...func(*redshift.Options)) (*redshift.RestoreFromClusterSnapshotOutput, error) {
+	return m.MockRestore(ctx, input, opts)
+}
+
+// PauseCluster pauses Redshift Instance
+func (m *MockRedshiftClient) PauseCluster(ctx context.Context, input *redshift.PauseClusterInput, opts ...func(*redshift.Options)) (*redshift.PauseClusterOutput, error) {
+	return m.MockPause(ctx, input, opts)
+}
+
+// ResumeCluster resumes Redshift Instance
+func (m *MockRedshiftClient) ResumeCluster(ctx context.Context, input *redshift.ResumeClusterInput, opts ...func(*redshift.Options)) (*redshift.ResumeClusterOutput, error) {
+	return m.MockResume(ctx, input, opts)
+}
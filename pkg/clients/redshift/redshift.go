@@ -19,30 +19,41 @@ package redshift
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/redshift"
 	redshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/redshift/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+const (
+	errGetPasswordSecretFailed = "cannot get password secret"
+)
+
 // Client defines Redshift client operations
 type Client interface {
 	DescribeClusters(ctx context.Context, input *redshift.DescribeClustersInput, opts ...func(*redshift.Options)) (*redshift.DescribeClustersOutput, error)
 	CreateCluster(ctx context.Context, input *redshift.CreateClusterInput, opts ...func(*redshift.Options)) (*redshift.CreateClusterOutput, error)
 	ModifyCluster(ctx context.Context, input *redshift.ModifyClusterInput, opts ...func(*redshift.Options)) (*redshift.ModifyClusterOutput, error)
 	DeleteCluster(ctx context.Context, input *redshift.DeleteClusterInput, opts ...func(*redshift.Options)) (*redshift.DeleteClusterOutput, error)
+	RestoreFromClusterSnapshot(ctx context.Context, input *redshift.RestoreFromClusterSnapshotInput, opts ...func(*redshift.Options)) (*redshift.RestoreFromClusterSnapshotOutput, error)
+	PauseCluster(ctx context.Context, input *redshift.PauseClusterInput, opts ...func(*redshift.Options)) (*redshift.PauseClusterOutput, error)
+	ResumeCluster(ctx context.Context, input *redshift.ResumeClusterInput, opts ...func(*redshift.Options)) (*redshift.ResumeClusterOutput, error)
 }
 
 // NewClient creates new Redshift Client with provided AWS Configurations/Credentials
@@ -153,16 +164,36 @@ func IsUpToDate(p v1alpha1.ClusterParameters, cl redshifttypes.Cluster) (bool, e
 	updated := cmp.Equal(&v1alpha1.ClusterParameters{}, patch,
 		cmpopts.IgnoreTypes(&xpv1.Reference{}, &xpv1.Selector{}),
 		cmpopts.IgnoreFields(v1alpha1.ClusterParameters{}, "Region"))
-	return updated && found, nil
+	return updated && found && IsPauseUpToDate(p, cl), nil
+}
+
+// IsPauseUpToDate returns false if the cluster needs to be paused or resumed
+// to reach the desired state. Clusters in a transitional state are considered
+// up to date; they are checked again once they settle.
+func IsPauseUpToDate(p v1alpha1.ClusterParameters, cl redshifttypes.Cluster) bool {
+	if p.Paused == nil {
+		return true
+	}
+	status := aws.ToString(cl.ClusterStatus)
+	if aws.ToBool(p.Paused) {
+		return status != v1alpha1.StateAvailable
+	}
+	return status != v1alpha1.StatePaused
 }
 
-// initializeModifyandDeleteParameters fills the four v1alpha1.ClusterParameters
-// fields that aren't available in redshift.Cluster and are for Modify or Delete input.
+// initializeModifyandDeleteParameters fills the v1alpha1.ClusterParameters
+// fields that aren't available in redshift.Cluster and are for Create, Modify
+// or Delete input.
 func initializeModifyandDeleteParameters(orig *v1alpha1.ClusterParameters, new *v1alpha1.ClusterParameters) *v1alpha1.ClusterParameters {
 	new.FinalClusterSnapshotIdentifier = orig.FinalClusterSnapshotIdentifier
 	new.FinalClusterSnapshotRetentionPeriod = orig.FinalClusterSnapshotRetentionPeriod
 	new.NewClusterIdentifier = orig.NewClusterIdentifier
 	new.SkipFinalClusterSnapshot = orig.SkipFinalClusterSnapshot
+	new.MasterUserPasswordSecretRef = orig.MasterUserPasswordSecretRef
+	new.SnapshotIdentifier = orig.SnapshotIdentifier
+	new.SnapshotClusterIdentifier = orig.SnapshotClusterIdentifier
+	new.OwnerAccount = orig.OwnerAccount
+	new.Paused = orig.Paused
 	return new
 }
 
@@ -235,6 +266,37 @@ func GenerateCreateClusterInput(p *v1alpha1.ClusterParameters, cid, pw *string)
 	}
 }
 
+// GenerateRestoreFromClusterSnapshotInput from RedshiftSpec
+func GenerateRestoreFromClusterSnapshotInput(p *v1alpha1.ClusterParameters, cid *string) *redshift.RestoreFromClusterSnapshotInput {
+	return &redshift.RestoreFromClusterSnapshotInput{
+		AllowVersionUpgrade:              p.AllowVersionUpgrade,
+		AutomatedSnapshotRetentionPeriod: p.AutomatedSnapshotRetentionPeriod,
+		AvailabilityZone:                 p.AvailabilityZone,
+		ClusterIdentifier:                cid,
+		ClusterParameterGroupName:        p.ClusterParameterGroupName,
+		ClusterSecurityGroups:            p.ClusterSecurityGroups,
+		ClusterSubnetGroupName:           p.ClusterSubnetGroupName,
+		ElasticIp:                        p.ElasticIP,
+		EnhancedVpcRouting:               p.EnhancedVPCRouting,
+		HsmClientCertificateIdentifier:   p.HSMClientCertificateIdentifier,
+		HsmConfigurationIdentifier:       p.HSMConfigurationIdentifier,
+		IamRoles:                         p.IAMRoles,
+		KmsKeyId:                         p.KMSKeyID,
+		MaintenanceTrackName:             p.MaintenanceTrackName,
+		ManualSnapshotRetentionPeriod:    p.ManualSnapshotRetentionPeriod,
+		NodeType:                         &p.NodeType,
+		NumberOfNodes:                    p.NumberOfNodes,
+		OwnerAccount:                     p.OwnerAccount,
+		Port:                             p.Port,
+		PreferredMaintenanceWindow:       p.PreferredMaintenanceWindow,
+		PubliclyAccessible:               p.PubliclyAccessible,
+		SnapshotClusterIdentifier:        p.SnapshotClusterIdentifier,
+		SnapshotIdentifier:               p.SnapshotIdentifier,
+		SnapshotScheduleIdentifier:       p.SnapshotScheduleIdentifier,
+		VpcSecurityGroupIds:              p.VPCSecurityGroupIDs,
+	}
+}
+
 // GenerateModifyClusterInput from RedshiftSpec
 func GenerateModifyClusterInput(p *v1alpha1.ClusterParameters, cl redshifttypes.Cluster) *redshift.ModifyClusterInput { //nolint:gocyclo
 	patch, err := CreatePatch(p, &cl)
@@ -453,10 +515,50 @@ func GetConnectionDetails(in v1alpha1.Cluster) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
 		return nil
 	}
-	return managed.ConnectionDetails{
+	cd := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey: []byte(in.Status.AtProvider.Endpoint.Address),
 		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(int(in.Status.AtProvider.Endpoint.Port))),
 	}
+	if in.Spec.ForProvider.MasterUsername != "" {
+		cd[xpv1.ResourceCredentialsSecretUserKey] = []byte(in.Spec.ForProvider.MasterUsername)
+	}
+	return cd
+}
+
+// GetPassword fetches the password of the master user from the secret
+// referenced by in. changed is true if the password differs from the one
+// stored in the connection secret referenced by out.
+func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySelector, out *xpv1.SecretReference) (newPwd string, changed bool, err error) {
+	if in == nil {
+		return "", false, nil
+	}
+	nn := types.NamespacedName{
+		Name:      in.Name,
+		Namespace: in.Namespace,
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, nn, s); err != nil {
+		return "", false, errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	newPwd = string(s.Data[in.Key])
+
+	if out != nil {
+		nn = types.NamespacedName{
+			Name:      out.Name,
+			Namespace: out.Namespace,
+		}
+		s = &corev1.Secret{}
+		// the output secret may not exist yet, so we can skip returning an
+		// error if the error is NotFound
+		if err := kube.Get(ctx, nn, s); resource.IgnoreNotFound(err) != nil {
+			return "", false, err
+		}
+		// if newPwd was set to some value, compare value in output secret with
+		// newPwd
+		changed = newPwd != "" && newPwd != string(s.Data[xpv1.ResourceCredentialsSecretPasswordKey])
+	}
+
+	return newPwd, changed, nil
 }
 
 // isClusterParameterGroupNameUpdated check if ClusterParameterGroupName is updated or not.
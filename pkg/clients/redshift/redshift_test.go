@@ -229,6 +229,54 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"PauseRequested": {
+			args: args{
+				cl: redshifttypes.Cluster{
+					NodeType:          &nodeType,
+					ClusterIdentifier: aws.String(""),
+					ClusterStatus:     aws.String(v1alpha1.StateAvailable),
+					NumberOfNodes:     1,
+				},
+				p: v1alpha1.ClusterParameters{
+					NodeType:    nodeType,
+					ClusterType: &singleNode,
+					Paused:      aws.Bool(true),
+				},
+			},
+			want: false,
+		},
+		"Paused": {
+			args: args{
+				cl: redshifttypes.Cluster{
+					NodeType:          &nodeType,
+					ClusterIdentifier: aws.String(""),
+					ClusterStatus:     aws.String(v1alpha1.StatePaused),
+					NumberOfNodes:     1,
+				},
+				p: v1alpha1.ClusterParameters{
+					NodeType:    nodeType,
+					ClusterType: &singleNode,
+					Paused:      aws.Bool(true),
+				},
+			},
+			want: true,
+		},
+		"ResumeRequested": {
+			args: args{
+				cl: redshifttypes.Cluster{
+					NodeType:          &nodeType,
+					ClusterIdentifier: aws.String(""),
+					ClusterStatus:     aws.String(v1alpha1.StatePaused),
+					NumberOfNodes:     1,
+				},
+				p: v1alpha1.ClusterParameters{
+					NodeType:    nodeType,
+					ClusterType: &singleNode,
+					Paused:      aws.Bool(false),
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -395,6 +443,38 @@ func TestGenerateCreateClusterInput(t *testing.T) {
 	}
 }
 
+func TestGenerateRestoreFromClusterSnapshotInput(t *testing.T) {
+	cases := map[string]struct {
+		in  *v1alpha1.ClusterParameters
+		out *redshift.RestoreFromClusterSnapshotInput
+	}{
+		"MinimalSpec": {
+			in: &v1alpha1.ClusterParameters{
+				NodeType:                  "dc1.large",
+				SnapshotIdentifier:        aws.String("snap"),
+				SnapshotClusterIdentifier: aws.String("source"),
+				OwnerAccount:              aws.String("123456789012"),
+			},
+			out: &redshift.RestoreFromClusterSnapshotInput{
+				ClusterIdentifier:         aws.String("unit-test"),
+				NodeType:                  aws.String("dc1.large"),
+				OwnerAccount:              aws.String("123456789012"),
+				SnapshotClusterIdentifier: aws.String("source"),
+				SnapshotIdentifier:        aws.String("snap"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := GenerateRestoreFromClusterSnapshotInput(tc.in, aws.String("unit-test"))
+			if diff := cmp.Diff(r, tc.out, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+				t.Errorf("GenerateRestoreFromClusterSnapshotInput(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateModifyClusterInput(t *testing.T) {
 	type args struct {
 		in *v1alpha1.ClusterParameters
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package requestlog logs the AWS API requests of the provider's clients at
+// debug level, with their AWS request ID, operation and latency. The request
+// ID is what AWS support asks for first when a request misbehaved.
+package requestlog
+
+import (
+	"context"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+const msgRequest = "AWS API request"
+
+type contextKey struct{}
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of the supplied context whose AWS API requests are
+// logged to the supplied logger. Requests are not logged unless their context
+// has a logger.
+func WithLogger(ctx context.Context, l logging.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// WithValues returns a copy of the supplied context whose AWS API requests
+// are logged with the supplied key/value pairs, in addition to those of the
+// supplied context.
+func WithValues(ctx context.Context, keysAndValues ...interface{}) context.Context {
+	kv, _ := ctx.Value(contextKey{}).([]interface{})
+	return context.WithValue(ctx, contextKey{}, append(append([]interface{}{}, kv...), keysAndValues...))
+}
+
+// Log the supplied AWS API request at debug level to the logger of the
+// supplied context, with the key/value pairs of the supplied context.
+func Log(ctx context.Context, service, op, requestID string, latency time.Duration, err error) {
+	l, ok := ctx.Value(loggerKey{}).(logging.Logger)
+	if !ok {
+		return
+	}
+	kv := []interface{}{"service", service, "operation", op, "request-id", requestID, "latency", latency.String()}
+	if v, ok := ctx.Value(contextKey{}).([]interface{}); ok {
+		kv = append(kv, v...)
+	}
+	if err != nil {
+		kv = append(kv, "error", err.Error())
+	}
+	l.Debug(msgRequest, kv...)
+}
+
+// HandlerV1 logs AWS SDK v1 requests. It must be added to the Complete
+// handlers of a session, so that its latency includes the SDK's own retries.
+var HandlerV1 = request.NamedHandler{
+	Name: "crossplane.RequestLogger",
+	Fn: func(r *request.Request) {
+		Log(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, r.RequestID, time.Since(r.Time), r.Error)
+	},
+}
+
+// AddMiddlewareV2 adds a middleware to the supplied AWS SDK v2 stack that
+// logs its requests.
+func AddMiddlewareV2(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("crossplane.RequestLogger",
+		func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			start := time.Now()
+			out, md, err := next.HandleInitialize(ctx, in)
+			id, _ := awsmiddleware.GetRequestIDMetadata(md)
+			var re *awshttp.ResponseError
+			if errors.As(err, &re) {
+				id = re.ServiceRequestID()
+			}
+			Log(ctx, awsmiddleware.GetServiceID(ctx), awsmiddleware.GetOperationName(ctx), id, time.Since(start), err)
+			return out, md, err
+		}), middleware.After)
+}
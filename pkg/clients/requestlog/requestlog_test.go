@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestlog
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// A captureLogger records the key/value pairs of the debug messages it logs.
+type captureLogger struct {
+	logging.Logger
+	msgs [][]interface{}
+}
+
+func (l *captureLogger) Debug(msg string, keysAndValues ...interface{}) {
+	l.msgs = append(l.msgs, append([]interface{}{msg}, keysAndValues...))
+}
+
+func TestLog(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		log    func(ctx context.Context)
+		want   [][]interface{}
+	}{
+		"Succeeded": {
+			reason: "Requests should be logged with the key/value pairs of their context.",
+			log: func(ctx context.Context) {
+				Log(WithValues(ctx, "kind", "Queue"), "SQS", "CreateQueue", "a", time.Second, nil)
+			},
+			want: [][]interface{}{{msgRequest, "service", "SQS", "operation", "CreateQueue", "request-id", "a", "latency", "1s", "kind", "Queue"}},
+		},
+		"Failed": {
+			reason: "Failed requests should be logged with their error.",
+			log: func(ctx context.Context) {
+				Log(ctx, "SQS", "DeleteQueue", "b", time.Second, errBoom)
+			},
+			want: [][]interface{}{{msgRequest, "service", "SQS", "operation", "DeleteQueue", "request-id", "b", "latency", "1s", "error", "boom"}},
+		},
+		"HandlerV1": {
+			reason: "AWS SDK v1 requests should be logged.",
+			log: func(ctx context.Context) {
+				r := &request.Request{
+					ClientInfo:  metadata.ClientInfo{ServiceID: "SQS"},
+					Operation:   &request.Operation{Name: "SetQueueAttributes"},
+					HTTPRequest: &http.Request{},
+					RequestID:   "c",
+					Time:        time.Now(),
+				}
+				r.SetContext(ctx)
+				HandlerV1.Fn(r)
+			},
+			want: [][]interface{}{{msgRequest, "service", "SQS", "operation", "SetQueueAttributes", "request-id", "c", "latency", ""}},
+		},
+		"NoLogger": {
+			reason: "Requests should not be logged if their context has no logger.",
+			log: func(ctx context.Context) {
+				Log(context.Background(), "SQS", "CreateQueue", "d", time.Second, nil)
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := &captureLogger{}
+			tc.log(WithLogger(context.Background(), l))
+
+			// The latency of SDK requests depends on the clock.
+			ignoreLatency := cmp.FilterPath(func(p cmp.Path) bool {
+				return name == "HandlerV1" && p.Last().String() == "[8]"
+			}, cmp.Ignore())
+			if diff := cmp.Diff(tc.want, l.msgs, ignoreLatency); diff != "" {
+				t.Errorf("\n%s\nLog(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
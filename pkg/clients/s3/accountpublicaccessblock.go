@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+// AccountPublicAccessBlockClient is the external client used for
+// AccountPublicAccessBlock Custom Resource. The public access block of an
+// account is managed through S3 Control, which only the first version of the
+// AWS SDK this provider uses supports.
+type AccountPublicAccessBlockClient interface {
+	GetPublicAccessBlockWithContext(ctx aws.Context, input *s3control.GetPublicAccessBlockInput, opts ...request.Option) (*s3control.GetPublicAccessBlockOutput, error)
+	PutPublicAccessBlockWithContext(ctx aws.Context, input *s3control.PutPublicAccessBlockInput, opts ...request.Option) (*s3control.PutPublicAccessBlockOutput, error)
+	DeletePublicAccessBlockWithContext(ctx aws.Context, input *s3control.DeletePublicAccessBlockInput, opts ...request.Option) (*s3control.DeletePublicAccessBlockOutput, error)
+}
+
+// NewAccountPublicAccessBlockClient returns a new client using the supplied
+// session.
+func NewAccountPublicAccessBlockClient(sess *session.Session) AccountPublicAccessBlockClient {
+	return s3control.New(sess)
+}
+
+// AccountPublicAccessBlockNotFound returns true if the supplied error is
+// because the account has no public access block.
+func AccountPublicAccessBlockNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == s3control.ErrCodeNoSuchPublicAccessBlockConfiguration
+}
+
+// GenerateAccountPublicAccessBlockConfiguration returns the public access
+// block configuration of the supplied parameters. Parameters that are not set
+// are false.
+func GenerateAccountPublicAccessBlockConfiguration(p v1alpha3.AccountPublicAccessBlockParameters) *s3control.PublicAccessBlockConfiguration {
+	return &s3control.PublicAccessBlockConfiguration{
+		BlockPublicAcls:       aws.Bool(aws.BoolValue(p.BlockPublicAcls)),
+		BlockPublicPolicy:     aws.Bool(aws.BoolValue(p.BlockPublicPolicy)),
+		IgnorePublicAcls:      aws.Bool(aws.BoolValue(p.IgnorePublicAcls)),
+		RestrictPublicBuckets: aws.Bool(aws.BoolValue(p.RestrictPublicBuckets)),
+	}
+}
+
+// IsAccountPublicAccessBlockUpToDate returns true if the observed public
+// access block configuration matches the supplied parameters.
+func IsAccountPublicAccessBlockUpToDate(p v1alpha3.AccountPublicAccessBlockParameters, o *s3control.PublicAccessBlockConfiguration) bool {
+	if o == nil {
+		o = &s3control.PublicAccessBlockConfiguration{}
+	}
+	return aws.BoolValue(p.BlockPublicAcls) == aws.BoolValue(o.BlockPublicAcls) &&
+		aws.BoolValue(p.BlockPublicPolicy) == aws.BoolValue(o.BlockPublicPolicy) &&
+		aws.BoolValue(p.IgnorePublicAcls) == aws.BoolValue(o.IgnorePublicAcls) &&
+		aws.BoolValue(p.RestrictPublicBuckets) == aws.BoolValue(o.RestrictPublicBuckets)
+}
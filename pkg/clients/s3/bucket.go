@@ -40,6 +40,8 @@ var (
 	CORSNotFoundErrCode = "NoSuchCORSConfiguration"
 	// PublicAccessBlockNotFoundErrCode is NotFound error for PublicAccessBlock
 	PublicAccessBlockNotFoundErrCode = "NoSuchPublicAccessBlockConfiguration"
+	// OwnershipControlsNotFoundErrCode is the error code sent by AWS when the ownership controls do not exist
+	OwnershipControlsNotFoundErrCode = "OwnershipControlsNotFoundError"
 	// ReplicationNotFoundErrCode is the error code sent by AWS when the replication config does not exist
 	ReplicationNotFoundErrCode = "ReplicationConfigurationNotFoundError"
 	// LifecycleNotFoundErrCode is the error code sent by AWS when the lifecycle config does not exist
@@ -110,6 +112,10 @@ type BucketClient interface {
 	GetPublicAccessBlock(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error)
 	PutPublicAccessBlock(ctx context.Context, input *s3.PutPublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.PutPublicAccessBlockOutput, error)
 	DeletePublicAccessBlock(ctx context.Context, input *s3.DeletePublicAccessBlockInput, opts ...func(*s3.Options)) (*s3.DeletePublicAccessBlockOutput, error)
+
+	GetBucketOwnershipControls(ctx context.Context, input *s3.GetBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.GetBucketOwnershipControlsOutput, error)
+	PutBucketOwnershipControls(ctx context.Context, input *s3.PutBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.PutBucketOwnershipControlsOutput, error)
+	DeleteBucketOwnershipControls(ctx context.Context, input *s3.DeleteBucketOwnershipControlsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketOwnershipControlsOutput, error)
 }
 
 // NewClient returns a new client using AWS credentials as JSON encoded data.
@@ -172,6 +178,12 @@ func PublicAccessBlockConfigurationNotFound(err error) bool {
 	return errors.As(err, &awsErr) && awsErr.ErrorCode() == PublicAccessBlockNotFoundErrCode
 }
 
+// OwnershipControlsNotFound is parses the aws Error and validates if the ownership controls do not exist
+func OwnershipControlsNotFound(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && awsErr.ErrorCode() == OwnershipControlsNotFoundErrCode
+}
+
 // LifecycleConfigurationNotFound is parses the aws Error and validates if the lifecycle configuration does not exist
 func LifecycleConfigurationNotFound(err error) bool {
 	var awsErr smithy.APIError
@@ -27,8 +27,15 @@ import (
 	"github.com/aws/smithy-go"
 
 	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/pkg/clients/normalize"
 )
 
+// S3 returns bucket policies reformatted, regardless of whether they were
+// supplied raw or rendered from a policy body.
+var bucketPolicyNormalizers = normalize.Register(v1alpha3.BucketPolicyGroupKind, normalize.Fields{
+	"RawPolicy": normalize.PolicyDocument,
+})
+
 // BucketPolicyClient is the external client used for S3BucketPolicy Custom Resource
 type BucketPolicyClient interface {
 	GetBucketPolicy(ctx context.Context, input *s3.GetBucketPolicyInput, opts ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error)
@@ -53,6 +60,12 @@ func IsErrorBucketNotFound(err error) bool {
 	return errors.As(err, &nsb)
 }
 
+// IsBucketPolicyUpToDate returns true if the desired and the observed bucket
+// policy documents are semantically equal.
+func IsBucketPolicyUpToDate(desired, observed string) bool {
+	return bucketPolicyNormalizers.Equal("RawPolicy", desired, observed)
+}
+
 // Serialize is the custom marshaller for the BucketPolicyParameters
 func Serialize(p *v1alpha3.BucketPolicyBody) (interface{}, error) {
 	m := make(map[string]interface{})
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3control"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.AccountPublicAccessBlockClient = (*MockAccountPublicAccessBlockClient)(nil)
+
+// MockAccountPublicAccessBlockClient is a type that implements all the methods for AccountPublicAccessBlockClient interface
+type MockAccountPublicAccessBlockClient struct {
+	MockGetPublicAccessBlock    func(ctx aws.Context, input *s3control.GetPublicAccessBlockInput, opts []request.Option) (*s3control.GetPublicAccessBlockOutput, error)
+	MockPutPublicAccessBlock    func(ctx aws.Context, input *s3control.PutPublicAccessBlockInput, opts []request.Option) (*s3control.PutPublicAccessBlockOutput, error)
+	MockDeletePublicAccessBlock func(ctx aws.Context, input *s3control.DeletePublicAccessBlockInput, opts []request.Option) (*s3control.DeletePublicAccessBlockOutput, error)
+}
+
+// GetPublicAccessBlockWithContext mocks client call.
+func (m *MockAccountPublicAccessBlockClient) GetPublicAccessBlockWithContext(ctx aws.Context, input *s3control.GetPublicAccessBlockInput, opts ...request.Option) (*s3control.GetPublicAccessBlockOutput, error) {
+	return m.MockGetPublicAccessBlock(ctx, input, opts)
+}
+
+// PutPublicAccessBlockWithContext mocks client call.
+func (m *MockAccountPublicAccessBlockClient) PutPublicAccessBlockWithContext(ctx aws.Context, input *s3control.PutPublicAccessBlockInput, opts ...request.Option) (*s3control.PutPublicAccessBlockOutput, error) {
+	return m.MockPutPublicAccessBlock(ctx, input, opts)
+}
+
+// DeletePublicAccessBlockWithContext mocks client call.
+func (m *MockAccountPublicAccessBlockClient) DeletePublicAccessBlockWithContext(ctx aws.Context, input *s3control.DeletePublicAccessBlockInput, opts ...request.Option) (*s3control.DeletePublicAccessBlockOutput, error) {
+	return m.MockDeletePublicAccessBlock(ctx, input, opts)
+}
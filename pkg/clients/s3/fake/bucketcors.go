@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/s3"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.BucketCORSClient = (*MockBucketCORSClient)(nil)
+
+// MockBucketCORSClient is a type that implements all the methods for BucketCORSClient interface
+type MockBucketCORSClient struct {
+	MockGetBucketCors    func(ctx context.Context, input *s3.GetBucketCorsInput, opts []func(*s3.Options)) (*s3.GetBucketCorsOutput, error)
+	MockPutBucketCors    func(ctx context.Context, input *s3.PutBucketCorsInput, opts []func(*s3.Options)) (*s3.PutBucketCorsOutput, error)
+	MockDeleteBucketCors func(ctx context.Context, input *s3.DeleteBucketCorsInput, opts []func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error)
+}
+
+// GetBucketCors mocks GetBucketCors method
+func (m *MockBucketCORSClient) GetBucketCors(ctx context.Context, input *s3.GetBucketCorsInput, opts ...func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+	return m.MockGetBucketCors(ctx, input, opts)
+}
+
+// PutBucketCors mocks PutBucketCors method
+func (m *MockBucketCORSClient) PutBucketCors(ctx context.Context, input *s3.PutBucketCorsInput, opts ...func(*s3.Options)) (*s3.PutBucketCorsOutput, error) {
+	return m.MockPutBucketCors(ctx, input, opts)
+}
+
+// DeleteBucketCors mocks DeleteBucketCors method
+func (m *MockBucketCORSClient) DeleteBucketCors(ctx context.Context, input *s3.DeleteBucketCorsInput, opts ...func(*s3.Options)) (*s3.DeleteBucketCorsOutput, error) {
+	return m.MockDeleteBucketCors(ctx, input, opts)
+}
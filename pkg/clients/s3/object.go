@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint:gosec
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+)
+
+// MetadataKeyContentSHA256 is the key of the user-defined object metadata
+// that holds the SHA-256 checksum of the content of an Object.
+const MetadataKeyContentSHA256 = "crossplane-content-sha256"
+
+// ObjectClient is the external client used for Object Custom Resource
+type ObjectClient interface {
+	HeadObject(ctx context.Context, input *s3.HeadObjectInput, opts ...func(*s3.Options)) (*s3.HeadObjectOutput, error)
+	PutObject(ctx context.Context, input *s3.PutObjectInput, opts ...func(*s3.Options)) (*s3.PutObjectOutput, error)
+	DeleteObject(ctx context.Context, input *s3.DeleteObjectInput, opts ...func(*s3.Options)) (*s3.DeleteObjectOutput, error)
+	GetObjectTagging(ctx context.Context, input *s3.GetObjectTaggingInput, opts ...func(*s3.Options)) (*s3.GetObjectTaggingOutput, error)
+}
+
+// NewObjectClient returns a new client given an aws config
+func NewObjectClient(cfg aws.Config) ObjectClient {
+	return s3.NewFromConfig(cfg)
+}
+
+// IsErrorObjectNotFound returns true if the error indicates that the object
+// was not found
+func IsErrorObjectNotFound(err error) bool {
+	var nf *s3types.NotFound
+	var nsk *s3types.NoSuchKey
+	return errors.As(err, &nf) || errors.As(err, &nsk)
+}
+
+// ContentSHA256 returns the hex encoded SHA-256 checksum of the supplied
+// content.
+func ContentSHA256(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// GeneratePutObjectInput returns the input to upload the supplied content as
+// the Object described by the supplied parameters.
+func GeneratePutObjectInput(p v1alpha3.ObjectParameters, content []byte) *s3.PutObjectInput {
+	input := &s3.PutObjectInput{
+		Bucket:               p.BucketName,
+		Key:                  aws.String(p.Key),
+		Body:                 bytes.NewReader(content),
+		ContentType:          p.ContentType,
+		Metadata:             map[string]string{MetadataKeyContentSHA256: ContentSHA256(content)},
+		ServerSideEncryption: s3types.ServerSideEncryption(aws.ToString(p.ServerSideEncryption)),
+		SSEKMSKeyId:          p.SSEKMSKeyID,
+	}
+	if len(p.Tags) != 0 {
+		v := url.Values{}
+		for _, t := range p.Tags {
+			v.Set(t.Key, t.Value)
+		}
+		input.Tagging = aws.String(v.Encode())
+	}
+	return input
+}
+
+// IsObjectUpToDate returns true if the observed object holds the supplied
+// content with the desired settings.
+func IsObjectUpToDate(p v1alpha3.ObjectParameters, content []byte, head *s3.HeadObjectOutput, tags []s3types.Tag) bool {
+	if !isContentUpToDate(content, head) {
+		return false
+	}
+	if p.ContentType != nil && aws.ToString(p.ContentType) != aws.ToString(head.ContentType) {
+		return false
+	}
+	if p.ServerSideEncryption != nil && aws.ToString(p.ServerSideEncryption) != string(head.ServerSideEncryption) {
+		return false
+	}
+	if p.SSEKMSKeyID != nil && aws.ToString(p.SSEKMSKeyID) != aws.ToString(head.SSEKMSKeyId) {
+		return false
+	}
+	if len(p.Tags) != len(tags) {
+		return false
+	}
+	observed := make(map[string]string, len(tags))
+	for _, t := range tags {
+		observed[aws.ToString(t.Key)] = aws.ToString(t.Value)
+	}
+	for _, t := range p.Tags {
+		if v, ok := observed[t.Key]; !ok || v != t.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// isContentUpToDate compares the checksum stored in the metadata of the
+// object, falling back to its ETag for objects that were uploaded without it.
+// The ETag is the MD5 digest of the content unless the object was uploaded in
+// parts or encrypted with SSE-KMS.
+func isContentUpToDate(content []byte, head *s3.HeadObjectOutput) bool {
+	for k, v := range head.Metadata {
+		if strings.EqualFold(k, MetadataKeyContentSHA256) {
+			return v == ContentSHA256(content)
+		}
+	}
+	sum := md5.Sum(content) // nolint:gosec
+	return strings.Trim(aws.ToString(head.ETag), `"`) == hex.EncodeToString(sum[:])
+}
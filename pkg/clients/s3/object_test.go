@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+var (
+	objectContent = []byte("hello")
+	// sha256 and md5 of objectContent
+	objectSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	objectMD5    = "5d41402abc4b2a76b9719d911017c592"
+)
+
+func TestGeneratePutObjectInput(t *testing.T) {
+	p := v1alpha3.ObjectParameters{
+		BucketName:           aws.String("bucket"),
+		Key:                  "key",
+		ContentType:          aws.String("text/plain"),
+		ServerSideEncryption: aws.String("aws:kms"),
+		SSEKMSKeyID:          aws.String("kms"),
+		Tags:                 []v1beta1.Tag{{Key: "b", Value: "2"}, {Key: "a", Value: "1 1"}},
+	}
+	got := GeneratePutObjectInput(p, objectContent)
+	body, err := io.ReadAll(got.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(objectContent, body); diff != "" {
+		t.Errorf("Body: -want, +got:\n%s", diff)
+	}
+	got.Body = nil
+	want := &s3.PutObjectInput{
+		Bucket:               aws.String("bucket"),
+		Key:                  aws.String("key"),
+		ContentType:          aws.String("text/plain"),
+		Metadata:             map[string]string{MetadataKeyContentSHA256: objectSHA256},
+		ServerSideEncryption: s3types.ServerSideEncryptionAwsKms,
+		SSEKMSKeyId:          aws.String("kms"),
+		Tagging:              aws.String("a=1+1&b=2"),
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(s3.PutObjectInput{})); diff != "" {
+		t.Errorf("GeneratePutObjectInput(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestIsObjectUpToDate(t *testing.T) {
+	type args struct {
+		p    v1alpha3.ObjectParameters
+		head *s3.HeadObjectOutput
+		tags []s3types.Tag
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: v1alpha3.ObjectParameters{
+					ContentType: aws.String("text/plain"),
+					Tags:        []v1beta1.Tag{{Key: "a", Value: "1"}},
+				},
+				head: &s3.HeadObjectOutput{
+					ContentType: aws.String("text/plain"),
+					Metadata:    map[string]string{MetadataKeyContentSHA256: objectSHA256},
+				},
+				tags: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			},
+			want: true,
+		},
+		"ContentChanged": {
+			args: args{
+				head: &s3.HeadObjectOutput{
+					Metadata: map[string]string{MetadataKeyContentSHA256: "other"},
+					ETag:     aws.String(`"` + objectMD5 + `"`),
+				},
+			},
+			want: false,
+		},
+		"ETagMatchesWithoutChecksum": {
+			args: args{
+				head: &s3.HeadObjectOutput{
+					ETag: aws.String(`"` + objectMD5 + `"`),
+				},
+			},
+			want: true,
+		},
+		"ETagDiffers": {
+			args: args{
+				head: &s3.HeadObjectOutput{
+					ETag: aws.String(`"other"`),
+				},
+			},
+			want: false,
+		},
+		"ContentTypeChanged": {
+			args: args{
+				p: v1alpha3.ObjectParameters{
+					ContentType: aws.String("application/json"),
+				},
+				head: &s3.HeadObjectOutput{
+					ContentType: aws.String("text/plain"),
+					Metadata:    map[string]string{MetadataKeyContentSHA256: objectSHA256},
+				},
+			},
+			want: false,
+		},
+		"EncryptionChanged": {
+			args: args{
+				p: v1alpha3.ObjectParameters{
+					ServerSideEncryption: aws.String("aws:kms"),
+				},
+				head: &s3.HeadObjectOutput{
+					ServerSideEncryption: s3types.ServerSideEncryptionAes256,
+					Metadata:             map[string]string{MetadataKeyContentSHA256: objectSHA256},
+				},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: v1alpha3.ObjectParameters{
+					Tags: []v1beta1.Tag{{Key: "a", Value: "2"}},
+				},
+				head: &s3.HeadObjectOutput{
+					Metadata: map[string]string{MetadataKeyContentSHA256: objectSHA256},
+				},
+				tags: []s3types.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsObjectUpToDate(tc.args.p, objectContent, tc.args.head, tc.args.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsObjectUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/securityhub"
+)
+
+// MockClient is a type that implements all the methods for the Security Hub
+// Client interface.
+type MockClient struct {
+	MockEnableSecurityHub              func(ctx context.Context, input *securityhub.EnableSecurityHubInput, opts []request.Option) (*securityhub.EnableSecurityHubOutput, error)
+	MockDescribeHub                    func(ctx context.Context, input *securityhub.DescribeHubInput, opts []request.Option) (*securityhub.DescribeHubOutput, error)
+	MockUpdateSecurityHubConfiguration func(ctx context.Context, input *securityhub.UpdateSecurityHubConfigurationInput, opts []request.Option) (*securityhub.UpdateSecurityHubConfigurationOutput, error)
+	MockDisableSecurityHub             func(ctx context.Context, input *securityhub.DisableSecurityHubInput, opts []request.Option) (*securityhub.DisableSecurityHubOutput, error)
+	MockListTagsForResource            func(ctx context.Context, input *securityhub.ListTagsForResourceInput, opts []request.Option) (*securityhub.ListTagsForResourceOutput, error)
+	MockTagResource                    func(ctx context.Context, input *securityhub.TagResourceInput, opts []request.Option) (*securityhub.TagResourceOutput, error)
+	MockUntagResource                  func(ctx context.Context, input *securityhub.UntagResourceInput, opts []request.Option) (*securityhub.UntagResourceOutput, error)
+	MockBatchEnableStandards           func(ctx context.Context, input *securityhub.BatchEnableStandardsInput, opts []request.Option) (*securityhub.BatchEnableStandardsOutput, error)
+	MockGetEnabledStandards            func(ctx context.Context, input *securityhub.GetEnabledStandardsInput, opts []request.Option) (*securityhub.GetEnabledStandardsOutput, error)
+	MockBatchDisableStandards          func(ctx context.Context, input *securityhub.BatchDisableStandardsInput, opts []request.Option) (*securityhub.BatchDisableStandardsOutput, error)
+}
+
+// EnableSecurityHubWithContext mocks EnableSecurityHubWithContext method
+func (m *MockClient) EnableSecurityHubWithContext(ctx context.Context, input *securityhub.EnableSecurityHubInput, opts ...request.Option) (*securityhub.EnableSecurityHubOutput, error) {
+	return m.MockEnableSecurityHub(ctx, input, opts)
+}
+
+// DescribeHubWithContext mocks DescribeHubWithContext method
+func (m *MockClient) DescribeHubWithContext(ctx context.Context, input *securityhub.DescribeHubInput, opts ...request.Option) (*securityhub.DescribeHubOutput, error) {
+	return m.MockDescribeHub(ctx, input, opts)
+}
+
+// UpdateSecurityHubConfigurationWithContext mocks UpdateSecurityHubConfigurationWithContext method
+func (m *MockClient) UpdateSecurityHubConfigurationWithContext(ctx context.Context, input *securityhub.UpdateSecurityHubConfigurationInput, opts ...request.Option) (*securityhub.UpdateSecurityHubConfigurationOutput, error) {
+	return m.MockUpdateSecurityHubConfiguration(ctx, input, opts)
+}
+
+// DisableSecurityHubWithContext mocks DisableSecurityHubWithContext method
+func (m *MockClient) DisableSecurityHubWithContext(ctx context.Context, input *securityhub.DisableSecurityHubInput, opts ...request.Option) (*securityhub.DisableSecurityHubOutput, error) {
+	return m.MockDisableSecurityHub(ctx, input, opts)
+}
+
+// ListTagsForResourceWithContext mocks ListTagsForResourceWithContext method
+func (m *MockClient) ListTagsForResourceWithContext(ctx context.Context, input *securityhub.ListTagsForResourceInput, opts ...request.Option) (*securityhub.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResource(ctx, input, opts)
+}
+
+// TagResourceWithContext mocks TagResourceWithContext method
+func (m *MockClient) TagResourceWithContext(ctx context.Context, input *securityhub.TagResourceInput, opts ...request.Option) (*securityhub.TagResourceOutput, error) {
+	return m.MockTagResource(ctx, input, opts)
+}
+
+// UntagResourceWithContext mocks UntagResourceWithContext method
+func (m *MockClient) UntagResourceWithContext(ctx context.Context, input *securityhub.UntagResourceInput, opts ...request.Option) (*securityhub.UntagResourceOutput, error) {
+	return m.MockUntagResource(ctx, input, opts)
+}
+
+// BatchEnableStandardsWithContext mocks BatchEnableStandardsWithContext method
+func (m *MockClient) BatchEnableStandardsWithContext(ctx context.Context, input *securityhub.BatchEnableStandardsInput, opts ...request.Option) (*securityhub.BatchEnableStandardsOutput, error) {
+	return m.MockBatchEnableStandards(ctx, input, opts)
+}
+
+// GetEnabledStandardsWithContext mocks GetEnabledStandardsWithContext method
+func (m *MockClient) GetEnabledStandardsWithContext(ctx context.Context, input *securityhub.GetEnabledStandardsInput, opts ...request.Option) (*securityhub.GetEnabledStandardsOutput, error) {
+	return m.MockGetEnabledStandards(ctx, input, opts)
+}
+
+// BatchDisableStandardsWithContext mocks BatchDisableStandardsWithContext method
+func (m *MockClient) BatchDisableStandardsWithContext(ctx context.Context, input *securityhub.BatchDisableStandardsInput, opts ...request.Option) (*securityhub.BatchDisableStandardsOutput, error) {
+	return m.MockBatchDisableStandards(ctx, input, opts)
+}
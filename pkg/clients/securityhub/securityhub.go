@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityhub
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the Security Hub operations used by the hub and standards
+// subscription controllers.
+type Client interface {
+	EnableSecurityHubWithContext(context.Context, *awssecurityhub.EnableSecurityHubInput, ...request.Option) (*awssecurityhub.EnableSecurityHubOutput, error)
+	DescribeHubWithContext(context.Context, *awssecurityhub.DescribeHubInput, ...request.Option) (*awssecurityhub.DescribeHubOutput, error)
+	UpdateSecurityHubConfigurationWithContext(context.Context, *awssecurityhub.UpdateSecurityHubConfigurationInput, ...request.Option) (*awssecurityhub.UpdateSecurityHubConfigurationOutput, error)
+	DisableSecurityHubWithContext(context.Context, *awssecurityhub.DisableSecurityHubInput, ...request.Option) (*awssecurityhub.DisableSecurityHubOutput, error)
+	ListTagsForResourceWithContext(context.Context, *awssecurityhub.ListTagsForResourceInput, ...request.Option) (*awssecurityhub.ListTagsForResourceOutput, error)
+	TagResourceWithContext(context.Context, *awssecurityhub.TagResourceInput, ...request.Option) (*awssecurityhub.TagResourceOutput, error)
+	UntagResourceWithContext(context.Context, *awssecurityhub.UntagResourceInput, ...request.Option) (*awssecurityhub.UntagResourceOutput, error)
+	BatchEnableStandardsWithContext(context.Context, *awssecurityhub.BatchEnableStandardsInput, ...request.Option) (*awssecurityhub.BatchEnableStandardsOutput, error)
+	GetEnabledStandardsWithContext(context.Context, *awssecurityhub.GetEnabledStandardsInput, ...request.Option) (*awssecurityhub.GetEnabledStandardsOutput, error)
+	BatchDisableStandardsWithContext(context.Context, *awssecurityhub.BatchDisableStandardsInput, ...request.Option) (*awssecurityhub.BatchDisableStandardsOutput, error)
+}
+
+// NewClient returns a new Security Hub client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awssecurityhub.New(sess)
+}
+
+// IsHubNotFound returns true if the error indicates that Security Hub is not
+// enabled in the account and region.
+func IsHubNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && (awsErr.Code() == awssecurityhub.ErrCodeInvalidAccessException ||
+		awsErr.Code() == awssecurityhub.ErrCodeResourceNotFoundException)
+}
+
+// IsNotFound returns true if the error indicates that the resource does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awssecurityhub.ErrCodeResourceNotFoundException
+}
+
+// GenerateEnableSecurityHubInput returns the input to enable Security Hub
+// with the given parameters.
+func GenerateEnableSecurityHubInput(p v1alpha1.HubParameters) *awssecurityhub.EnableSecurityHubInput {
+	return &awssecurityhub.EnableSecurityHubInput{
+		EnableDefaultStandards: p.EnableDefaultStandards,
+		Tags:                   GenerateTags(p.Tags),
+	}
+}
+
+// IsHubUpToDate returns true if the observed hub matches the given
+// parameters. New controls are enabled automatically unless it is explicitly
+// disabled.
+func IsHubUpToDate(p v1alpha1.HubParameters, observed *awssecurityhub.DescribeHubOutput) bool {
+	return (p.AutoEnableControls == nil || *p.AutoEnableControls) == awsclient.BoolValue(observed.AutoEnableControls)
+}
+
+// GenerateTags returns the given tags in the form the Security Hub API
+// expects them.
+func GenerateTags(tags map[string]string) map[string]*string {
+	if len(tags) == 0 {
+		return nil
+	}
+	return aws.StringMap(tags)
+}
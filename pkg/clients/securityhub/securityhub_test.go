@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package securityhub
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+)
+
+func TestIsHubNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotSubscribed": {
+			err:  awserr.New(awssecurityhub.ErrCodeInvalidAccessException, "Account is not subscribed to AWS Security Hub", nil),
+			want: true,
+		},
+		"NotFound": {
+			err:  awserr.New(awssecurityhub.ErrCodeResourceNotFoundException, "", nil),
+			want: true,
+		},
+		"OtherError": {
+			err:  awserr.New(awssecurityhub.ErrCodeLimitExceededException, "", nil),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsHubNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateEnableSecurityHubInput(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.HubParameters
+		want *awssecurityhub.EnableSecurityHubInput
+	}{
+		"Defaults": {
+			p:    v1alpha1.HubParameters{},
+			want: &awssecurityhub.EnableSecurityHubInput{},
+		},
+		"Full": {
+			p: v1alpha1.HubParameters{
+				EnableDefaultStandards: aws.Bool(false),
+				Tags:                   map[string]string{"team": "security"},
+			},
+			want: &awssecurityhub.EnableSecurityHubInput{
+				EnableDefaultStandards: aws.Bool(false),
+				Tags:                   map[string]*string{"team": aws.String("security")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateEnableSecurityHubInput(tc.p)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsHubUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.HubParameters
+		observed bool
+		want     bool
+	}{
+		"DefaultEnabled": {
+			observed: true,
+			want:     true,
+		},
+		"DefaultDisabled": {
+			observed: false,
+			want:     false,
+		},
+		"ExplicitlyDisabled": {
+			p:        v1alpha1.HubParameters{AutoEnableControls: aws.Bool(false)},
+			observed: false,
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsHubUpToDate(tc.p, &awssecurityhub.DescribeHubOutput{AutoEnableControls: aws.Bool(tc.observed)})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
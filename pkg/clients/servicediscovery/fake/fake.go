@@ -36,6 +36,20 @@ type MockServicediscoveryClient struct {
 	MockCreateHTTPNamespaceRequest func(*svcsdk.CreateHttpNamespaceInput) (*request.Request, *svcsdk.CreateHttpNamespaceOutput)
 	// MockDeleteNamespaceRequest is a function pointer
 	MockDeleteNamespaceRequest func(*svcsdk.DeleteNamespaceInput) (*request.Request, *svcsdk.DeleteNamespaceOutput)
+	// MockGetService is a function pointer
+	MockGetService func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error)
+	// MockCreateService is a function pointer
+	MockCreateService func(*svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error)
+	// MockUpdateService is a function pointer
+	MockUpdateService func(*svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error)
+	// MockDeleteService is a function pointer
+	MockDeleteService func(*svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error)
+	// MockListTagsForResource is a function pointer
+	MockListTagsForResource func(*svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error)
+	// MockTagResource is a function pointer
+	MockTagResource func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+	// MockUntagResource is a function pointer
+	MockUntagResource func(*svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error)
 }
 
 // CreatePrivateDnsNamespace is the interface function to call the mock function pointer
@@ -215,3 +229,66 @@ func (m *MockServicediscoveryClient) DeleteNamespaceRequest(input *svcsdk.Delete
 	}
 	return m.MockDeleteNamespaceRequest(input)
 }
+
+// GetServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) GetServiceWithContext(_ context.Context, input *svcsdk.GetServiceInput, _ ...request.Option) (*svcsdk.GetServiceOutput, error) {
+	if m.MockGetService == nil {
+		fmt.Println(".MockGetService == nil")
+		return &svcsdk.GetServiceOutput{}, nil
+	}
+	return m.MockGetService(input)
+}
+
+// CreateServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) CreateServiceWithContext(_ context.Context, input *svcsdk.CreateServiceInput, _ ...request.Option) (*svcsdk.CreateServiceOutput, error) {
+	if m.MockCreateService == nil {
+		fmt.Println(".MockCreateService == nil")
+		return &svcsdk.CreateServiceOutput{}, nil
+	}
+	return m.MockCreateService(input)
+}
+
+// UpdateServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) UpdateServiceWithContext(_ context.Context, input *svcsdk.UpdateServiceInput, _ ...request.Option) (*svcsdk.UpdateServiceOutput, error) {
+	if m.MockUpdateService == nil {
+		fmt.Println(".MockUpdateService == nil")
+		return &svcsdk.UpdateServiceOutput{}, nil
+	}
+	return m.MockUpdateService(input)
+}
+
+// DeleteServiceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) DeleteServiceWithContext(_ context.Context, input *svcsdk.DeleteServiceInput, _ ...request.Option) (*svcsdk.DeleteServiceOutput, error) {
+	if m.MockDeleteService == nil {
+		fmt.Println(".MockDeleteService == nil")
+		return &svcsdk.DeleteServiceOutput{}, nil
+	}
+	return m.MockDeleteService(input)
+}
+
+// ListTagsForResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) ListTagsForResourceWithContext(_ context.Context, input *svcsdk.ListTagsForResourceInput, _ ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	if m.MockListTagsForResource == nil {
+		fmt.Println(".MockListTagsForResource == nil")
+		return &svcsdk.ListTagsForResourceOutput{}, nil
+	}
+	return m.MockListTagsForResource(input)
+}
+
+// TagResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) TagResourceWithContext(_ context.Context, input *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	if m.MockTagResource == nil {
+		fmt.Println(".MockTagResource == nil")
+		return &svcsdk.TagResourceOutput{}, nil
+	}
+	return m.MockTagResource(input)
+}
+
+// UntagResourceWithContext is the interface function to call the mock function pointer
+func (m *MockServicediscoveryClient) UntagResourceWithContext(_ context.Context, input *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	if m.MockUntagResource == nil {
+		fmt.Println(".MockUntagResource == nil")
+		return &svcsdk.UntagResourceOutput{}, nil
+	}
+	return m.MockUntagResource(input)
+}
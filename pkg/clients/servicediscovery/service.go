@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// IsServiceNotFound returns true if the error indicates that the Cloud Map
+// service does not exist.
+func IsServiceNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == svcsdk.ErrCodeServiceNotFound
+}
+
+// GenerateCreateServiceInput returns the input to create a Cloud Map service
+// with the given parameters.
+func GenerateCreateServiceInput(p v1alpha1.ServiceParameters, creatorRequestID string) *svcsdk.CreateServiceInput {
+	in := &svcsdk.CreateServiceInput{
+		Name:              awsclient.String(p.Name),
+		NamespaceId:       p.NamespaceID,
+		Description:       p.Description,
+		CreatorRequestId:  awsclient.String(creatorRequestID),
+		HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+	}
+	if p.DNSConfig != nil {
+		in.DnsConfig = &svcsdk.DnsConfig{
+			RoutingPolicy: p.DNSConfig.RoutingPolicy,
+			DnsRecords:    generateDNSRecords(p.DNSConfig.DNSRecords),
+		}
+	}
+	if p.HealthCheckCustomConfig != nil {
+		in.HealthCheckCustomConfig = &svcsdk.HealthCheckCustomConfig{
+			FailureThreshold: p.HealthCheckCustomConfig.FailureThreshold,
+		}
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, &svcsdk.Tag{Key: t.Key, Value: t.Value})
+	}
+	return in
+}
+
+// GenerateServiceChange returns the updatable part of the given parameters.
+// Cloud Map replaces the description, DNS records and health check
+// configuration of a service as a whole on every update.
+func GenerateServiceChange(p v1alpha1.ServiceParameters) *svcsdk.ServiceChange {
+	change := &svcsdk.ServiceChange{
+		Description:       p.Description,
+		HealthCheckConfig: generateHealthCheckConfig(p.HealthCheckConfig),
+	}
+	if p.DNSConfig != nil {
+		change.DnsConfig = &svcsdk.DnsConfigChange{
+			DnsRecords: generateDNSRecords(p.DNSConfig.DNSRecords),
+		}
+	}
+	return change
+}
+
+// IsServiceUpToDate returns true if the observed service matches the given
+// parameters. Only fields that can be updated are compared.
+func IsServiceUpToDate(p v1alpha1.ServiceParameters, observed *svcsdk.Service) bool {
+	if awsclient.StringValue(p.Description) != awsclient.StringValue(observed.Description) {
+		return false
+	}
+	var current []*svcsdk.DnsRecord
+	if observed.DnsConfig != nil {
+		current = observed.DnsConfig.DnsRecords
+	}
+	var desired []*svcsdk.DnsRecord
+	if p.DNSConfig != nil {
+		desired = generateDNSRecords(p.DNSConfig.DNSRecords)
+	}
+	if !cmp.Equal(desired, current, cmpopts.EquateEmpty()) {
+		return false
+	}
+	return isHealthCheckConfigUpToDate(p.HealthCheckConfig, observed.HealthCheckConfig)
+}
+
+// isHealthCheckConfigUpToDate compares health check configurations. Cloud Map
+// defaults the resource path and failure threshold, so they are only compared
+// if they are set in the parameters.
+func isHealthCheckConfigUpToDate(desired *v1alpha1.ServiceHealthCheckConfig, observed *svcsdk.HealthCheckConfig) bool {
+	if desired == nil || observed == nil {
+		return desired == nil && observed == nil
+	}
+	switch {
+	case desired.Type != awsclient.StringValue(observed.Type):
+		return false
+	case desired.ResourcePath != nil && *desired.ResourcePath != awsclient.StringValue(observed.ResourcePath):
+		return false
+	case desired.FailureThreshold != nil && *desired.FailureThreshold != aws.Int64Value(observed.FailureThreshold):
+		return false
+	}
+	return true
+}
+
+// TagsToMap converts the tags of a service into a map.
+func TagsToMap(tags []v1alpha1.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	return m
+}
+
+// SDKTagsToMap converts the tags returned by Cloud Map into a map.
+func SDKTagsToMap(tags []*svcsdk.Tag) map[string]string {
+	m := make(map[string]string, len(tags))
+	for _, t := range tags {
+		m[awsclient.StringValue(t.Key)] = awsclient.StringValue(t.Value)
+	}
+	return m
+}
+
+func generateDNSRecords(records []v1alpha1.ServiceDNSRecord) []*svcsdk.DnsRecord {
+	res := make([]*svcsdk.DnsRecord, len(records))
+	for i, r := range records {
+		res[i] = &svcsdk.DnsRecord{
+			Type: awsclient.String(r.Type),
+			TTL:  aws.Int64(r.TTL),
+		}
+	}
+	return res
+}
+
+func generateHealthCheckConfig(c *v1alpha1.ServiceHealthCheckConfig) *svcsdk.HealthCheckConfig {
+	if c == nil {
+		return nil
+	}
+	return &svcsdk.HealthCheckConfig{
+		Type:             awsclient.String(c.Type),
+		ResourcePath:     c.ResourcePath,
+		FailureThreshold: c.FailureThreshold,
+	}
+}
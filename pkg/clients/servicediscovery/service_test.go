@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicediscovery
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+)
+
+func TestIsServiceUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.ServiceParameters
+		observed *svcsdk.Service
+		want     bool
+	}{
+		"Empty": {
+			observed: &svcsdk.Service{},
+			want:     true,
+		},
+		"DescriptionChanged": {
+			p:        v1alpha1.ServiceParameters{Description: aws.String("new")},
+			observed: &svcsdk.Service{Description: aws.String("old")},
+			want:     false,
+		},
+		"DNSRecordsMatch": {
+			p: v1alpha1.ServiceParameters{DNSConfig: &v1alpha1.ServiceDNSConfig{
+				DNSRecords: []v1alpha1.ServiceDNSRecord{{Type: "SRV", TTL: 10}},
+			}},
+			observed: &svcsdk.Service{DnsConfig: &svcsdk.DnsConfig{
+				RoutingPolicy: aws.String("MULTIVALUE"),
+				DnsRecords:    []*svcsdk.DnsRecord{{Type: aws.String("SRV"), TTL: aws.Int64(10)}},
+			}},
+			want: true,
+		},
+		"HealthCheckDefaultsIgnored": {
+			p: v1alpha1.ServiceParameters{HealthCheckConfig: &v1alpha1.ServiceHealthCheckConfig{Type: "HTTP"}},
+			observed: &svcsdk.Service{HealthCheckConfig: &svcsdk.HealthCheckConfig{
+				Type:             aws.String("HTTP"),
+				ResourcePath:     aws.String("/"),
+				FailureThreshold: aws.Int64(1),
+			}},
+			want: true,
+		},
+		"HealthCheckThresholdChanged": {
+			p: v1alpha1.ServiceParameters{HealthCheckConfig: &v1alpha1.ServiceHealthCheckConfig{Type: "HTTP", FailureThreshold: aws.Int64(3)}},
+			observed: &svcsdk.Service{HealthCheckConfig: &svcsdk.HealthCheckConfig{
+				Type:             aws.String("HTTP"),
+				FailureThreshold: aws.Int64(1),
+			}},
+			want: false,
+		},
+		"HealthCheckRemoved": {
+			observed: &svcsdk.Service{HealthCheckConfig: &svcsdk.HealthCheckConfig{Type: aws.String("TCP")}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsServiceUpToDate(tc.p, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsServiceUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package servicediscovery
 
 import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
 	"github.com/aws/aws-sdk-go/service/servicediscovery/servicediscoveryiface"
 )
 
@@ -8,3 +10,8 @@ import (
 type Client interface {
 	servicediscoveryiface.ServiceDiscoveryAPI
 }
+
+// NewClient returns a new Cloud Map client for the given session.
+func NewClient(sess *session.Session) Client {
+	return svcsdk.New(sess)
+}
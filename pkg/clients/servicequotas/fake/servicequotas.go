@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+
+	clientset "github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.Client = (*MockClient)(nil)
+
+// MockClient is a type that implements all the methods for Client interface
+type MockClient struct {
+	MockGetServiceQuota                func(ctx aws.Context, input *servicequotas.GetServiceQuotaInput, opts []request.Option) (*servicequotas.GetServiceQuotaOutput, error)
+	MockGetAWSDefaultServiceQuota      func(ctx aws.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, opts []request.Option) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+	MockRequestServiceQuotaIncrease    func(ctx aws.Context, input *servicequotas.RequestServiceQuotaIncreaseInput, opts []request.Option) (*servicequotas.RequestServiceQuotaIncreaseOutput, error)
+	MockGetRequestedServiceQuotaChange func(ctx aws.Context, input *servicequotas.GetRequestedServiceQuotaChangeInput, opts []request.Option) (*servicequotas.GetRequestedServiceQuotaChangeOutput, error)
+}
+
+// GetServiceQuotaWithContext mocks client call.
+func (m *MockClient) GetServiceQuotaWithContext(ctx aws.Context, input *servicequotas.GetServiceQuotaInput, opts ...request.Option) (*servicequotas.GetServiceQuotaOutput, error) {
+	return m.MockGetServiceQuota(ctx, input, opts)
+}
+
+// GetAWSDefaultServiceQuotaWithContext mocks client call.
+func (m *MockClient) GetAWSDefaultServiceQuotaWithContext(ctx aws.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, opts ...request.Option) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error) {
+	return m.MockGetAWSDefaultServiceQuota(ctx, input, opts)
+}
+
+// RequestServiceQuotaIncreaseWithContext mocks client call.
+func (m *MockClient) RequestServiceQuotaIncreaseWithContext(ctx aws.Context, input *servicequotas.RequestServiceQuotaIncreaseInput, opts ...request.Option) (*servicequotas.RequestServiceQuotaIncreaseOutput, error) {
+	return m.MockRequestServiceQuotaIncrease(ctx, input, opts)
+}
+
+// GetRequestedServiceQuotaChangeWithContext mocks client call.
+func (m *MockClient) GetRequestedServiceQuotaChangeWithContext(ctx aws.Context, input *servicequotas.GetRequestedServiceQuotaChangeInput, opts ...request.Option) (*servicequotas.GetRequestedServiceQuotaChangeOutput, error) {
+	return m.MockGetRequestedServiceQuotaChange(ctx, input, opts)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package servicequotas
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+)
+
+// Client is the external client used for AWS Service Quotas. Service Quotas
+// is only supported by the first version of the AWS SDK this provider uses.
+type Client interface {
+	GetServiceQuotaWithContext(ctx aws.Context, input *servicequotas.GetServiceQuotaInput, opts ...request.Option) (*servicequotas.GetServiceQuotaOutput, error)
+	GetAWSDefaultServiceQuotaWithContext(ctx aws.Context, input *servicequotas.GetAWSDefaultServiceQuotaInput, opts ...request.Option) (*servicequotas.GetAWSDefaultServiceQuotaOutput, error)
+	RequestServiceQuotaIncreaseWithContext(ctx aws.Context, input *servicequotas.RequestServiceQuotaIncreaseInput, opts ...request.Option) (*servicequotas.RequestServiceQuotaIncreaseOutput, error)
+	GetRequestedServiceQuotaChangeWithContext(ctx aws.Context, input *servicequotas.GetRequestedServiceQuotaChangeInput, opts ...request.Option) (*servicequotas.GetRequestedServiceQuotaChangeOutput, error)
+}
+
+// NewClient returns a new client using the supplied session.
+func NewClient(sess *session.Session) Client {
+	return servicequotas.New(sess)
+}
+
+// IsNotFound returns true if the supplied error is because a quota or a
+// request to increase it does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == servicequotas.ErrCodeNoSuchResourceException
+}
+
+// GetQuotaValue returns the value of the supplied quota that applies to the
+// account of the supplied client. Quotas that were never increased may only
+// have an AWS default value.
+func GetQuotaValue(ctx context.Context, c Client, serviceCode, quotaCode string) (float64, error) {
+	resp, err := c.GetServiceQuotaWithContext(ctx, &servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err == nil && resp.Quota != nil && resp.Quota.Value != nil {
+		return aws.Float64Value(resp.Quota.Value), nil
+	}
+	if err != nil && !IsNotFound(err) {
+		return 0, err
+	}
+	def, err := c.GetAWSDefaultServiceQuotaWithContext(ctx, &servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(serviceCode),
+		QuotaCode:   aws.String(quotaCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	if def.Quota == nil {
+		return 0, errors.Errorf("quota %s of service %s has no value", quotaCode, serviceCode)
+	}
+	return aws.Float64Value(def.Quota.Value), nil
+}
+
+// GenerateRequestServiceQuotaIncreaseInput returns the input to request the
+// supplied quota increase.
+func GenerateRequestServiceQuotaIncreaseInput(p v1alpha1.RequestedQuotaIncreaseParameters) *servicequotas.RequestServiceQuotaIncreaseInput {
+	return &servicequotas.RequestServiceQuotaIncreaseInput{
+		ServiceCode:  aws.String(p.ServiceCode),
+		QuotaCode:    aws.String(p.QuotaCode),
+		DesiredValue: aws.Float64(p.DesiredValue),
+	}
+}
+
+// GenerateRequestedQuotaIncreaseObservation returns the observation of the
+// supplied request to increase a quota.
+func GenerateRequestedQuotaIncreaseObservation(r *servicequotas.RequestedServiceQuotaChange) v1alpha1.RequestedQuotaIncreaseObservation {
+	if r == nil {
+		return v1alpha1.RequestedQuotaIncreaseObservation{}
+	}
+	return v1alpha1.RequestedQuotaIncreaseObservation{
+		Status:    aws.StringValue(r.Status),
+		CaseID:    aws.StringValue(r.CaseId),
+		QuotaName: aws.StringValue(r.QuotaName),
+		QuotaARN:  aws.StringValue(r.QuotaArn),
+	}
+}
+
+// IsRequestApproved returns true if the supplied status of a request means
+// the quota was increased.
+func IsRequestApproved(status string) bool {
+	return status == servicequotas.RequestStatusApproved || status == servicequotas.RequestStatusCaseClosed
+}
+
+// IsRequestDenied returns true if the supplied status of a request means
+// the quota will not be increased.
+func IsRequestDenied(status string) bool {
+	return status == servicequotas.RequestStatusDenied
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ses"
+)
+
+// MockClient is a type that implements all the methods for the SES Client
+// interface.
+type MockClient struct {
+	MockCreateReceiptRuleSet                           func(ctx context.Context, input *ses.CreateReceiptRuleSetInput, opts []request.Option) (*ses.CreateReceiptRuleSetOutput, error)
+	MockDescribeReceiptRuleSet                         func(ctx context.Context, input *ses.DescribeReceiptRuleSetInput, opts []request.Option) (*ses.DescribeReceiptRuleSetOutput, error)
+	MockDeleteReceiptRuleSet                           func(ctx context.Context, input *ses.DeleteReceiptRuleSetInput, opts []request.Option) (*ses.DeleteReceiptRuleSetOutput, error)
+	MockDescribeActiveReceiptRuleSet                   func(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput, opts []request.Option) (*ses.DescribeActiveReceiptRuleSetOutput, error)
+	MockSetActiveReceiptRuleSet                        func(ctx context.Context, input *ses.SetActiveReceiptRuleSetInput, opts []request.Option) (*ses.SetActiveReceiptRuleSetOutput, error)
+	MockCreateReceiptRule                              func(ctx context.Context, input *ses.CreateReceiptRuleInput, opts []request.Option) (*ses.CreateReceiptRuleOutput, error)
+	MockDescribeReceiptRule                            func(ctx context.Context, input *ses.DescribeReceiptRuleInput, opts []request.Option) (*ses.DescribeReceiptRuleOutput, error)
+	MockUpdateReceiptRule                              func(ctx context.Context, input *ses.UpdateReceiptRuleInput, opts []request.Option) (*ses.UpdateReceiptRuleOutput, error)
+	MockSetReceiptRulePosition                         func(ctx context.Context, input *ses.SetReceiptRulePositionInput, opts []request.Option) (*ses.SetReceiptRulePositionOutput, error)
+	MockDeleteReceiptRule                              func(ctx context.Context, input *ses.DeleteReceiptRuleInput, opts []request.Option) (*ses.DeleteReceiptRuleOutput, error)
+	MockGetIdentityMailFromDomainAttributes            func(ctx context.Context, input *ses.GetIdentityMailFromDomainAttributesInput, opts []request.Option) (*ses.GetIdentityMailFromDomainAttributesOutput, error)
+	MockSetIdentityMailFromDomain                      func(ctx context.Context, input *ses.SetIdentityMailFromDomainInput, opts []request.Option) (*ses.SetIdentityMailFromDomainOutput, error)
+	MockVerifyEmailIdentity                            func(ctx context.Context, input *ses.VerifyEmailIdentityInput, opts []request.Option) (*ses.VerifyEmailIdentityOutput, error)
+	MockVerifyDomainIdentity                           func(ctx context.Context, input *ses.VerifyDomainIdentityInput, opts []request.Option) (*ses.VerifyDomainIdentityOutput, error)
+	MockVerifyDomainDkim                               func(ctx context.Context, input *ses.VerifyDomainDkimInput, opts []request.Option) (*ses.VerifyDomainDkimOutput, error)
+	MockGetIdentityVerificationAttributes              func(ctx context.Context, input *ses.GetIdentityVerificationAttributesInput, opts []request.Option) (*ses.GetIdentityVerificationAttributesOutput, error)
+	MockGetIdentityDkimAttributes                      func(ctx context.Context, input *ses.GetIdentityDkimAttributesInput, opts []request.Option) (*ses.GetIdentityDkimAttributesOutput, error)
+	MockSetIdentityDkimEnabled                         func(ctx context.Context, input *ses.SetIdentityDkimEnabledInput, opts []request.Option) (*ses.SetIdentityDkimEnabledOutput, error)
+	MockDeleteIdentity                                 func(ctx context.Context, input *ses.DeleteIdentityInput, opts []request.Option) (*ses.DeleteIdentityOutput, error)
+	MockCreateConfigurationSet                         func(ctx context.Context, input *ses.CreateConfigurationSetInput, opts []request.Option) (*ses.CreateConfigurationSetOutput, error)
+	MockDescribeConfigurationSet                       func(ctx context.Context, input *ses.DescribeConfigurationSetInput, opts []request.Option) (*ses.DescribeConfigurationSetOutput, error)
+	MockDeleteConfigurationSet                         func(ctx context.Context, input *ses.DeleteConfigurationSetInput, opts []request.Option) (*ses.DeleteConfigurationSetOutput, error)
+	MockUpdateConfigurationSetReputationMetricsEnabled func(ctx context.Context, input *ses.UpdateConfigurationSetReputationMetricsEnabledInput, opts []request.Option) (*ses.UpdateConfigurationSetReputationMetricsEnabledOutput, error)
+	MockUpdateConfigurationSetSendingEnabled           func(ctx context.Context, input *ses.UpdateConfigurationSetSendingEnabledInput, opts []request.Option) (*ses.UpdateConfigurationSetSendingEnabledOutput, error)
+	MockPutConfigurationSetDeliveryOptions             func(ctx context.Context, input *ses.PutConfigurationSetDeliveryOptionsInput, opts []request.Option) (*ses.PutConfigurationSetDeliveryOptionsOutput, error)
+}
+
+// CreateReceiptRuleSetWithContext mocks CreateReceiptRuleSetWithContext method
+func (m *MockClient) CreateReceiptRuleSetWithContext(ctx context.Context, input *ses.CreateReceiptRuleSetInput, opts ...request.Option) (*ses.CreateReceiptRuleSetOutput, error) {
+	return m.MockCreateReceiptRuleSet(ctx, input, opts)
+}
+
+// DescribeReceiptRuleSetWithContext mocks DescribeReceiptRuleSetWithContext method
+func (m *MockClient) DescribeReceiptRuleSetWithContext(ctx context.Context, input *ses.DescribeReceiptRuleSetInput, opts ...request.Option) (*ses.DescribeReceiptRuleSetOutput, error) {
+	return m.MockDescribeReceiptRuleSet(ctx, input, opts)
+}
+
+// DeleteReceiptRuleSetWithContext mocks DeleteReceiptRuleSetWithContext method
+func (m *MockClient) DeleteReceiptRuleSetWithContext(ctx context.Context, input *ses.DeleteReceiptRuleSetInput, opts ...request.Option) (*ses.DeleteReceiptRuleSetOutput, error) {
+	return m.MockDeleteReceiptRuleSet(ctx, input, opts)
+}
+
+// DescribeActiveReceiptRuleSetWithContext mocks DescribeActiveReceiptRuleSetWithContext method
+func (m *MockClient) DescribeActiveReceiptRuleSetWithContext(ctx context.Context, input *ses.DescribeActiveReceiptRuleSetInput, opts ...request.Option) (*ses.DescribeActiveReceiptRuleSetOutput, error) {
+	return m.MockDescribeActiveReceiptRuleSet(ctx, input, opts)
+}
+
+// SetActiveReceiptRuleSetWithContext mocks SetActiveReceiptRuleSetWithContext method
+func (m *MockClient) SetActiveReceiptRuleSetWithContext(ctx context.Context, input *ses.SetActiveReceiptRuleSetInput, opts ...request.Option) (*ses.SetActiveReceiptRuleSetOutput, error) {
+	return m.MockSetActiveReceiptRuleSet(ctx, input, opts)
+}
+
+// CreateReceiptRuleWithContext mocks CreateReceiptRuleWithContext method
+func (m *MockClient) CreateReceiptRuleWithContext(ctx context.Context, input *ses.CreateReceiptRuleInput, opts ...request.Option) (*ses.CreateReceiptRuleOutput, error) {
+	return m.MockCreateReceiptRule(ctx, input, opts)
+}
+
+// DescribeReceiptRuleWithContext mocks DescribeReceiptRuleWithContext method
+func (m *MockClient) DescribeReceiptRuleWithContext(ctx context.Context, input *ses.DescribeReceiptRuleInput, opts ...request.Option) (*ses.DescribeReceiptRuleOutput, error) {
+	return m.MockDescribeReceiptRule(ctx, input, opts)
+}
+
+// UpdateReceiptRuleWithContext mocks UpdateReceiptRuleWithContext method
+func (m *MockClient) UpdateReceiptRuleWithContext(ctx context.Context, input *ses.UpdateReceiptRuleInput, opts ...request.Option) (*ses.UpdateReceiptRuleOutput, error) {
+	return m.MockUpdateReceiptRule(ctx, input, opts)
+}
+
+// SetReceiptRulePositionWithContext mocks SetReceiptRulePositionWithContext method
+func (m *MockClient) SetReceiptRulePositionWithContext(ctx context.Context, input *ses.SetReceiptRulePositionInput, opts ...request.Option) (*ses.SetReceiptRulePositionOutput, error) {
+	return m.MockSetReceiptRulePosition(ctx, input, opts)
+}
+
+// DeleteReceiptRuleWithContext mocks DeleteReceiptRuleWithContext method
+func (m *MockClient) DeleteReceiptRuleWithContext(ctx context.Context, input *ses.DeleteReceiptRuleInput, opts ...request.Option) (*ses.DeleteReceiptRuleOutput, error) {
+	return m.MockDeleteReceiptRule(ctx, input, opts)
+}
+
+// GetIdentityMailFromDomainAttributesWithContext mocks GetIdentityMailFromDomainAttributesWithContext method
+func (m *MockClient) GetIdentityMailFromDomainAttributesWithContext(ctx context.Context, input *ses.GetIdentityMailFromDomainAttributesInput, opts ...request.Option) (*ses.GetIdentityMailFromDomainAttributesOutput, error) {
+	return m.MockGetIdentityMailFromDomainAttributes(ctx, input, opts)
+}
+
+// SetIdentityMailFromDomainWithContext mocks SetIdentityMailFromDomainWithContext method
+func (m *MockClient) SetIdentityMailFromDomainWithContext(ctx context.Context, input *ses.SetIdentityMailFromDomainInput, opts ...request.Option) (*ses.SetIdentityMailFromDomainOutput, error) {
+	return m.MockSetIdentityMailFromDomain(ctx, input, opts)
+}
+
+// VerifyEmailIdentityWithContext mocks VerifyEmailIdentityWithContext method
+func (m *MockClient) VerifyEmailIdentityWithContext(ctx context.Context, input *ses.VerifyEmailIdentityInput, opts ...request.Option) (*ses.VerifyEmailIdentityOutput, error) {
+	return m.MockVerifyEmailIdentity(ctx, input, opts)
+}
+
+// VerifyDomainIdentityWithContext mocks VerifyDomainIdentityWithContext method
+func (m *MockClient) VerifyDomainIdentityWithContext(ctx context.Context, input *ses.VerifyDomainIdentityInput, opts ...request.Option) (*ses.VerifyDomainIdentityOutput, error) {
+	return m.MockVerifyDomainIdentity(ctx, input, opts)
+}
+
+// VerifyDomainDkimWithContext mocks VerifyDomainDkimWithContext method
+func (m *MockClient) VerifyDomainDkimWithContext(ctx context.Context, input *ses.VerifyDomainDkimInput, opts ...request.Option) (*ses.VerifyDomainDkimOutput, error) {
+	return m.MockVerifyDomainDkim(ctx, input, opts)
+}
+
+// GetIdentityVerificationAttributesWithContext mocks GetIdentityVerificationAttributesWithContext method
+func (m *MockClient) GetIdentityVerificationAttributesWithContext(ctx context.Context, input *ses.GetIdentityVerificationAttributesInput, opts ...request.Option) (*ses.GetIdentityVerificationAttributesOutput, error) {
+	return m.MockGetIdentityVerificationAttributes(ctx, input, opts)
+}
+
+// GetIdentityDkimAttributesWithContext mocks GetIdentityDkimAttributesWithContext method
+func (m *MockClient) GetIdentityDkimAttributesWithContext(ctx context.Context, input *ses.GetIdentityDkimAttributesInput, opts ...request.Option) (*ses.GetIdentityDkimAttributesOutput, error) {
+	return m.MockGetIdentityDkimAttributes(ctx, input, opts)
+}
+
+// SetIdentityDkimEnabledWithContext mocks SetIdentityDkimEnabledWithContext method
+func (m *MockClient) SetIdentityDkimEnabledWithContext(ctx context.Context, input *ses.SetIdentityDkimEnabledInput, opts ...request.Option) (*ses.SetIdentityDkimEnabledOutput, error) {
+	return m.MockSetIdentityDkimEnabled(ctx, input, opts)
+}
+
+// DeleteIdentityWithContext mocks DeleteIdentityWithContext method
+func (m *MockClient) DeleteIdentityWithContext(ctx context.Context, input *ses.DeleteIdentityInput, opts ...request.Option) (*ses.DeleteIdentityOutput, error) {
+	return m.MockDeleteIdentity(ctx, input, opts)
+}
+
+// CreateConfigurationSetWithContext mocks CreateConfigurationSetWithContext method
+func (m *MockClient) CreateConfigurationSetWithContext(ctx context.Context, input *ses.CreateConfigurationSetInput, opts ...request.Option) (*ses.CreateConfigurationSetOutput, error) {
+	return m.MockCreateConfigurationSet(ctx, input, opts)
+}
+
+// DescribeConfigurationSetWithContext mocks DescribeConfigurationSetWithContext method
+func (m *MockClient) DescribeConfigurationSetWithContext(ctx context.Context, input *ses.DescribeConfigurationSetInput, opts ...request.Option) (*ses.DescribeConfigurationSetOutput, error) {
+	return m.MockDescribeConfigurationSet(ctx, input, opts)
+}
+
+// DeleteConfigurationSetWithContext mocks DeleteConfigurationSetWithContext method
+func (m *MockClient) DeleteConfigurationSetWithContext(ctx context.Context, input *ses.DeleteConfigurationSetInput, opts ...request.Option) (*ses.DeleteConfigurationSetOutput, error) {
+	return m.MockDeleteConfigurationSet(ctx, input, opts)
+}
+
+// UpdateConfigurationSetReputationMetricsEnabledWithContext mocks UpdateConfigurationSetReputationMetricsEnabledWithContext method
+func (m *MockClient) UpdateConfigurationSetReputationMetricsEnabledWithContext(ctx context.Context, input *ses.UpdateConfigurationSetReputationMetricsEnabledInput, opts ...request.Option) (*ses.UpdateConfigurationSetReputationMetricsEnabledOutput, error) {
+	return m.MockUpdateConfigurationSetReputationMetricsEnabled(ctx, input, opts)
+}
+
+// UpdateConfigurationSetSendingEnabledWithContext mocks UpdateConfigurationSetSendingEnabledWithContext method
+func (m *MockClient) UpdateConfigurationSetSendingEnabledWithContext(ctx context.Context, input *ses.UpdateConfigurationSetSendingEnabledInput, opts ...request.Option) (*ses.UpdateConfigurationSetSendingEnabledOutput, error) {
+	return m.MockUpdateConfigurationSetSendingEnabled(ctx, input, opts)
+}
+
+// PutConfigurationSetDeliveryOptionsWithContext mocks PutConfigurationSetDeliveryOptionsWithContext method
+func (m *MockClient) PutConfigurationSetDeliveryOptionsWithContext(ctx context.Context, input *ses.PutConfigurationSetDeliveryOptionsInput, opts ...request.Option) (*ses.PutConfigurationSetDeliveryOptionsOutput, error) {
+	return m.MockPutConfigurationSetDeliveryOptions(ctx, input, opts)
+}
@@ -0,0 +1,305 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DefaultTLSPolicy is the TLS policy of receipt rules that do not
+	// specify one.
+	DefaultTLSPolicy = awsses.TlsPolicyOptional
+	// DefaultInvocationType is the invocation type of Lambda actions that
+	// do not specify one.
+	DefaultInvocationType = awsses.InvocationTypeEvent
+	// DefaultBehaviorOnMXFailure is the behavior of MAIL FROM domains that
+	// do not specify one.
+	DefaultBehaviorOnMXFailure = awsses.BehaviorOnMXFailureUseDefaultValue
+
+	// MailFromRecordTTL is the TTL of the MX and SPF records maintained for
+	// MAIL FROM domains.
+	MailFromRecordTTL = 300
+	// MailFromSPFValue is the SPF record value that authorizes SES to send
+	// mail for a MAIL FROM domain.
+	MailFromSPFValue = `"v=spf1 include:amazonses.com ~all"`
+
+	// DomainIdentityRecordTTL is the TTL of the verification and DKIM
+	// records maintained for domain identities.
+	DomainIdentityRecordTTL = 1800
+)
+
+// Client defines the SES operations used by the receipt rule, MAIL FROM
+// domain, identity and configuration set controllers.
+type Client interface {
+	CreateReceiptRuleSetWithContext(context.Context, *awsses.CreateReceiptRuleSetInput, ...request.Option) (*awsses.CreateReceiptRuleSetOutput, error)
+	DescribeReceiptRuleSetWithContext(context.Context, *awsses.DescribeReceiptRuleSetInput, ...request.Option) (*awsses.DescribeReceiptRuleSetOutput, error)
+	DeleteReceiptRuleSetWithContext(context.Context, *awsses.DeleteReceiptRuleSetInput, ...request.Option) (*awsses.DeleteReceiptRuleSetOutput, error)
+	DescribeActiveReceiptRuleSetWithContext(context.Context, *awsses.DescribeActiveReceiptRuleSetInput, ...request.Option) (*awsses.DescribeActiveReceiptRuleSetOutput, error)
+	SetActiveReceiptRuleSetWithContext(context.Context, *awsses.SetActiveReceiptRuleSetInput, ...request.Option) (*awsses.SetActiveReceiptRuleSetOutput, error)
+
+	CreateReceiptRuleWithContext(context.Context, *awsses.CreateReceiptRuleInput, ...request.Option) (*awsses.CreateReceiptRuleOutput, error)
+	DescribeReceiptRuleWithContext(context.Context, *awsses.DescribeReceiptRuleInput, ...request.Option) (*awsses.DescribeReceiptRuleOutput, error)
+	UpdateReceiptRuleWithContext(context.Context, *awsses.UpdateReceiptRuleInput, ...request.Option) (*awsses.UpdateReceiptRuleOutput, error)
+	SetReceiptRulePositionWithContext(context.Context, *awsses.SetReceiptRulePositionInput, ...request.Option) (*awsses.SetReceiptRulePositionOutput, error)
+	DeleteReceiptRuleWithContext(context.Context, *awsses.DeleteReceiptRuleInput, ...request.Option) (*awsses.DeleteReceiptRuleOutput, error)
+
+	GetIdentityMailFromDomainAttributesWithContext(context.Context, *awsses.GetIdentityMailFromDomainAttributesInput, ...request.Option) (*awsses.GetIdentityMailFromDomainAttributesOutput, error)
+	SetIdentityMailFromDomainWithContext(context.Context, *awsses.SetIdentityMailFromDomainInput, ...request.Option) (*awsses.SetIdentityMailFromDomainOutput, error)
+
+	VerifyEmailIdentityWithContext(context.Context, *awsses.VerifyEmailIdentityInput, ...request.Option) (*awsses.VerifyEmailIdentityOutput, error)
+	VerifyDomainIdentityWithContext(context.Context, *awsses.VerifyDomainIdentityInput, ...request.Option) (*awsses.VerifyDomainIdentityOutput, error)
+	VerifyDomainDkimWithContext(context.Context, *awsses.VerifyDomainDkimInput, ...request.Option) (*awsses.VerifyDomainDkimOutput, error)
+	GetIdentityVerificationAttributesWithContext(context.Context, *awsses.GetIdentityVerificationAttributesInput, ...request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error)
+	GetIdentityDkimAttributesWithContext(context.Context, *awsses.GetIdentityDkimAttributesInput, ...request.Option) (*awsses.GetIdentityDkimAttributesOutput, error)
+	SetIdentityDkimEnabledWithContext(context.Context, *awsses.SetIdentityDkimEnabledInput, ...request.Option) (*awsses.SetIdentityDkimEnabledOutput, error)
+	DeleteIdentityWithContext(context.Context, *awsses.DeleteIdentityInput, ...request.Option) (*awsses.DeleteIdentityOutput, error)
+
+	CreateConfigurationSetWithContext(context.Context, *awsses.CreateConfigurationSetInput, ...request.Option) (*awsses.CreateConfigurationSetOutput, error)
+	DescribeConfigurationSetWithContext(context.Context, *awsses.DescribeConfigurationSetInput, ...request.Option) (*awsses.DescribeConfigurationSetOutput, error)
+	DeleteConfigurationSetWithContext(context.Context, *awsses.DeleteConfigurationSetInput, ...request.Option) (*awsses.DeleteConfigurationSetOutput, error)
+	UpdateConfigurationSetReputationMetricsEnabledWithContext(context.Context, *awsses.UpdateConfigurationSetReputationMetricsEnabledInput, ...request.Option) (*awsses.UpdateConfigurationSetReputationMetricsEnabledOutput, error)
+	UpdateConfigurationSetSendingEnabledWithContext(context.Context, *awsses.UpdateConfigurationSetSendingEnabledInput, ...request.Option) (*awsses.UpdateConfigurationSetSendingEnabledOutput, error)
+	PutConfigurationSetDeliveryOptionsWithContext(context.Context, *awsses.PutConfigurationSetDeliveryOptionsInput, ...request.Option) (*awsses.PutConfigurationSetDeliveryOptionsOutput, error)
+}
+
+// NewClient returns a new SES client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awsses.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the receipt rule set,
+// receipt rule or configuration set does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	return awsErr.Code() == awsses.ErrCodeRuleSetDoesNotExistException ||
+		awsErr.Code() == awsses.ErrCodeRuleDoesNotExistException ||
+		awsErr.Code() == awsses.ErrCodeConfigurationSetDoesNotExistException
+}
+
+// GenerateReceiptRule returns the SES receipt rule with the given name and
+// parameters. Unset fields are filled with the defaults SES reports for
+// them.
+func GenerateReceiptRule(name string, p v1alpha1.ReceiptRuleParameters) *awsses.ReceiptRule {
+	r := &awsses.ReceiptRule{
+		Name:        awsclient.String(name),
+		Enabled:     awsclient.Bool(awsclient.BoolValue(p.Enabled), awsclient.FieldRequired),
+		ScanEnabled: awsclient.Bool(awsclient.BoolValue(p.ScanEnabled), awsclient.FieldRequired),
+		TlsPolicy:   awsclient.String(DefaultTLSPolicy),
+	}
+	if p.TLSPolicy != nil {
+		r.TlsPolicy = p.TLSPolicy
+	}
+	for i := range p.Recipients {
+		r.Recipients = append(r.Recipients, awsclient.String(p.Recipients[i]))
+	}
+	for _, a := range p.Actions {
+		r.Actions = append(r.Actions, generateReceiptAction(a))
+	}
+	return r
+}
+
+func generateReceiptAction(a v1alpha1.ReceiptAction) *awsses.ReceiptAction {
+	res := &awsses.ReceiptAction{}
+	if a.S3Action != nil {
+		res.S3Action = &awsses.S3Action{
+			BucketName:      a.S3Action.BucketName,
+			ObjectKeyPrefix: a.S3Action.ObjectKeyPrefix,
+			KmsKeyArn:       a.S3Action.KMSKeyARN,
+			TopicArn:        a.S3Action.TopicARN,
+		}
+	}
+	if a.LambdaAction != nil {
+		res.LambdaAction = &awsses.LambdaAction{
+			FunctionArn:    a.LambdaAction.FunctionARN,
+			InvocationType: awsclient.String(DefaultInvocationType),
+			TopicArn:       a.LambdaAction.TopicARN,
+		}
+		if a.LambdaAction.InvocationType != nil {
+			res.LambdaAction.InvocationType = a.LambdaAction.InvocationType
+		}
+	}
+	if a.StopAction != nil {
+		res.StopAction = &awsses.StopAction{
+			Scope:    awsclient.String(a.StopAction.Scope),
+			TopicArn: a.StopAction.TopicARN,
+		}
+	}
+	return res
+}
+
+// IsReceiptRuleUpToDate returns true if the observed receipt rule matches
+// the given parameters.
+func IsReceiptRuleUpToDate(name string, p v1alpha1.ReceiptRuleParameters, observed *awsses.ReceiptRule) bool {
+	if observed == nil {
+		return false
+	}
+	obs := *observed
+	if obs.Enabled == nil {
+		obs.Enabled = awsclient.Bool(false, awsclient.FieldRequired)
+	}
+	if obs.ScanEnabled == nil {
+		obs.ScanEnabled = awsclient.Bool(false, awsclient.FieldRequired)
+	}
+	if awsclient.StringValue(obs.TlsPolicy) == "" {
+		obs.TlsPolicy = awsclient.String(DefaultTLSPolicy)
+	}
+	obs.Actions = make([]*awsses.ReceiptAction, len(observed.Actions))
+	for i, a := range observed.Actions {
+		c := *a
+		if c.LambdaAction != nil && awsclient.StringValue(c.LambdaAction.InvocationType) == "" {
+			l := *c.LambdaAction
+			l.InvocationType = awsclient.String(DefaultInvocationType)
+			c.LambdaAction = &l
+		}
+		obs.Actions[i] = &c
+	}
+	return cmp.Equal(GenerateReceiptRule(name, p), &obs, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(awsses.ReceiptRule{}, awsses.ReceiptAction{}, awsses.S3Action{},
+			awsses.LambdaAction{}, awsses.StopAction{}, awsses.AddHeaderAction{}, awsses.BounceAction{},
+			awsses.SNSAction{}, awsses.WorkmailAction{}))
+}
+
+// IsReceiptRuleInPosition returns true if the rule with the given name is
+// placed right after the rule named after in the given rules, or first if
+// after is nil.
+func IsReceiptRuleInPosition(name string, after *string, rules []*awsses.ReceiptRule) bool {
+	for i, r := range rules {
+		if awsclient.StringValue(r.Name) != name {
+			continue
+		}
+		if after == nil {
+			return i == 0
+		}
+		return i > 0 && awsclient.StringValue(rules[i-1].Name) == *after
+	}
+	return false
+}
+
+// GenerateMailFromRecordChanges returns the Route53 changes that maintain
+// the MX and SPF records SES requires for the given MAIL FROM domain.
+func GenerateMailFromRecordChanges(action route53types.ChangeAction, region, mailFromDomain string) []route53types.Change {
+	return []route53types.Change{
+		{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: aws.String(mailFromDomain),
+				Type: route53types.RRTypeMx,
+				TTL:  aws.Int64(MailFromRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{
+					{Value: aws.String(fmt.Sprintf("10 feedback-smtp.%s.amazonses.com", region))},
+				},
+			},
+		},
+		{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: aws.String(mailFromDomain),
+				Type: route53types.RRTypeTxt,
+				TTL:  aws.Int64(MailFromRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{
+					{Value: aws.String(MailFromSPFValue)},
+				},
+			},
+		},
+	}
+}
+
+// GenerateDomainIdentityRecordChanges returns the Route53 changes that
+// maintain the TXT record SES verifies the given domain with and the CNAME
+// records of its DKIM tokens.
+func GenerateDomainIdentityRecordChanges(action route53types.ChangeAction, domain, verificationToken string, dkimTokens []string) []route53types.Change {
+	var changes []route53types.Change
+	if verificationToken != "" {
+		changes = append(changes, route53types.Change{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: aws.String("_amazonses." + domain),
+				Type: route53types.RRTypeTxt,
+				TTL:  aws.Int64(DomainIdentityRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{
+					{Value: aws.String(fmt.Sprintf("%q", verificationToken))},
+				},
+			},
+		})
+	}
+	for _, t := range dkimTokens {
+		changes = append(changes, route53types.Change{
+			Action: action,
+			ResourceRecordSet: &route53types.ResourceRecordSet{
+				Name: aws.String(fmt.Sprintf("%s._domainkey.%s", t, domain)),
+				Type: route53types.RRTypeCname,
+				TTL:  aws.Int64(DomainIdentityRecordTTL),
+				ResourceRecords: []route53types.ResourceRecord{
+					{Value: aws.String(t + ".dkim.amazonses.com")},
+				},
+			},
+		})
+	}
+	return changes
+}
+
+// ConfigurationSetSendingEnabled returns whether sending is enabled for a
+// configuration set with the given parameters. SES enables it by default.
+func ConfigurationSetSendingEnabled(p v1alpha1.ConfigurationSetParameters) bool {
+	if p.SendingEnabled != nil {
+		return *p.SendingEnabled
+	}
+	return true
+}
+
+// ConfigurationSetTLSPolicy returns the TLS policy of a configuration set
+// with the given parameters, or the SES default if none is given.
+func ConfigurationSetTLSPolicy(p v1alpha1.ConfigurationSetParameters) string {
+	if p.TLSPolicy != nil {
+		return *p.TLSPolicy
+	}
+	return DefaultTLSPolicy
+}
+
+// IsConfigurationSetUpToDate returns true if the observed reputation and
+// delivery options of a configuration set match the given parameters.
+func IsConfigurationSetUpToDate(p v1alpha1.ConfigurationSetParameters, observed *awsses.DescribeConfigurationSetOutput) bool {
+	reputation := &awsses.ReputationOptions{}
+	if observed.ReputationOptions != nil {
+		reputation = observed.ReputationOptions
+	}
+	tlsPolicy := DefaultTLSPolicy
+	if observed.DeliveryOptions != nil && awsclient.StringValue(observed.DeliveryOptions.TlsPolicy) != "" {
+		tlsPolicy = awsclient.StringValue(observed.DeliveryOptions.TlsPolicy)
+	}
+	return awsclient.BoolValue(p.ReputationMetricsEnabled) == awsclient.BoolValue(reputation.ReputationMetricsEnabled) &&
+		ConfigurationSetSendingEnabled(p) == awsclient.BoolValue(reputation.SendingEnabled) &&
+		ConfigurationSetTLSPolicy(p) == tlsPolicy
+}
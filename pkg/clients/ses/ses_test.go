@@ -0,0 +1,304 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ses
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	ruleName  = "rule"
+	bucket    = "bucket"
+	function  = "arn:aws:lambda:us-east-1:123456789012:function:fn"
+	recipient = "info@example.com"
+)
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"RuleSetDoesNotExist": {
+			err:  awserr.New(awsses.ErrCodeRuleSetDoesNotExistException, "", nil),
+			want: true,
+		},
+		"RuleDoesNotExist": {
+			err:  errors.Wrap(awserr.New(awsses.ErrCodeRuleDoesNotExistException, "", nil), "wrapped"),
+			want: true,
+		},
+		"ConfigurationSetDoesNotExist": {
+			err:  awserr.New(awsses.ErrCodeConfigurationSetDoesNotExistException, "", nil),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New(awsses.ErrCodeAlreadyExistsException, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateReceiptRule(t *testing.T) {
+	cases := map[string]struct {
+		p    v1alpha1.ReceiptRuleParameters
+		want *awsses.ReceiptRule
+	}{
+		"Defaults": {
+			p: v1alpha1.ReceiptRuleParameters{},
+			want: &awsses.ReceiptRule{
+				Name:        &ruleName,
+				Enabled:     awsclient.Bool(false, awsclient.FieldRequired),
+				ScanEnabled: awsclient.Bool(false, awsclient.FieldRequired),
+				TlsPolicy:   awsclient.String(DefaultTLSPolicy),
+			},
+		},
+		"Full": {
+			p: v1alpha1.ReceiptRuleParameters{
+				Enabled:    awsclient.Bool(true),
+				Recipients: []string{recipient},
+				TLSPolicy:  awsclient.String(awsses.TlsPolicyRequire),
+				Actions: []v1alpha1.ReceiptAction{
+					{S3Action: &v1alpha1.S3Action{BucketName: &bucket}},
+					{LambdaAction: &v1alpha1.LambdaAction{FunctionARN: &function}},
+					{StopAction: &v1alpha1.StopAction{Scope: awsses.StopScopeRuleSet}},
+				},
+			},
+			want: &awsses.ReceiptRule{
+				Name:        &ruleName,
+				Enabled:     awsclient.Bool(true),
+				ScanEnabled: awsclient.Bool(false, awsclient.FieldRequired),
+				TlsPolicy:   awsclient.String(awsses.TlsPolicyRequire),
+				Recipients:  []*string{&recipient},
+				Actions: []*awsses.ReceiptAction{
+					{S3Action: &awsses.S3Action{BucketName: &bucket}},
+					{LambdaAction: &awsses.LambdaAction{FunctionArn: &function, InvocationType: awsclient.String(DefaultInvocationType)}},
+					{StopAction: &awsses.StopAction{Scope: awsclient.String(awsses.StopScopeRuleSet)}},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateReceiptRule(ruleName, tc.p)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(awsses.ReceiptRule{}, awsses.ReceiptAction{},
+				awsses.S3Action{}, awsses.LambdaAction{}, awsses.StopAction{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReceiptRuleUpToDate(t *testing.T) {
+	p := v1alpha1.ReceiptRuleParameters{
+		Enabled: awsclient.Bool(true),
+		Actions: []v1alpha1.ReceiptAction{
+			{LambdaAction: &v1alpha1.LambdaAction{FunctionARN: &function}},
+		},
+	}
+	cases := map[string]struct {
+		p        v1alpha1.ReceiptRuleParameters
+		observed *awsses.ReceiptRule
+		want     bool
+	}{
+		"UpToDateWithServerDefaults": {
+			p: p,
+			observed: &awsses.ReceiptRule{
+				Name:    &ruleName,
+				Enabled: awsclient.Bool(true),
+				Actions: []*awsses.ReceiptAction{
+					{LambdaAction: &awsses.LambdaAction{FunctionArn: &function}},
+				},
+			},
+			want: true,
+		},
+		"ActionChanged": {
+			p: p,
+			observed: &awsses.ReceiptRule{
+				Name:    &ruleName,
+				Enabled: awsclient.Bool(true),
+				Actions: []*awsses.ReceiptAction{
+					{S3Action: &awsses.S3Action{BucketName: &bucket}},
+				},
+			},
+			want: false,
+		},
+		"Disabled": {
+			p: p,
+			observed: &awsses.ReceiptRule{
+				Name: &ruleName,
+				Actions: []*awsses.ReceiptAction{
+					{LambdaAction: &awsses.LambdaAction{FunctionArn: &function}},
+				},
+			},
+			want: false,
+		},
+		"NotObserved": {
+			p:    p,
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsReceiptRuleUpToDate(ruleName, tc.p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsReceiptRuleInPosition(t *testing.T) {
+	rules := []*awsses.ReceiptRule{
+		{Name: awsclient.String("first")},
+		{Name: awsclient.String(ruleName)},
+	}
+	cases := map[string]struct {
+		name  string
+		after *string
+		want  bool
+	}{
+		"First": {
+			name: "first",
+			want: true,
+		},
+		"After": {
+			name:  ruleName,
+			after: awsclient.String("first"),
+			want:  true,
+		},
+		"NotFirst": {
+			name: ruleName,
+			want: false,
+		},
+		"Missing": {
+			name: "missing",
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsReceiptRuleInPosition(tc.name, tc.after, rules)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateDomainIdentityRecordChanges(t *testing.T) {
+	cases := map[string]struct {
+		token string
+		dkim  []string
+		want  []route53types.Change
+	}{
+		"Empty": {},
+		"VerificationAndDKIM": {
+			token: "token",
+			dkim:  []string{"dkim"},
+			want: []route53types.Change{
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("_amazonses.example.com"),
+						Type:            route53types.RRTypeTxt,
+						TTL:             aws.Int64(DomainIdentityRecordTTL),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String(`"token"`)}},
+					},
+				},
+				{
+					Action: route53types.ChangeActionUpsert,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name:            aws.String("dkim._domainkey.example.com"),
+						Type:            route53types.RRTypeCname,
+						TTL:             aws.Int64(DomainIdentityRecordTTL),
+						ResourceRecords: []route53types.ResourceRecord{{Value: aws.String("dkim.dkim.amazonses.com")}},
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateDomainIdentityRecordChanges(route53types.ChangeActionUpsert, "example.com", tc.token, tc.dkim)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(route53types.Change{}, route53types.ResourceRecordSet{}, route53types.ResourceRecord{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConfigurationSetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.ConfigurationSetParameters
+		observed *awsses.DescribeConfigurationSetOutput
+		want     bool
+	}{
+		"Defaults": {
+			observed: &awsses.DescribeConfigurationSetOutput{
+				ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(true)},
+			},
+			want: true,
+		},
+		"SendingDisabled": {
+			observed: &awsses.DescribeConfigurationSetOutput{
+				ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(false, awsclient.FieldRequired)},
+			},
+			want: false,
+		},
+		"ReputationMetricsDiffer": {
+			p: v1alpha1.ConfigurationSetParameters{ReputationMetricsEnabled: awsclient.Bool(true)},
+			observed: &awsses.DescribeConfigurationSetOutput{
+				ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(true)},
+			},
+			want: false,
+		},
+		"TLSPolicyDiffers": {
+			p: v1alpha1.ConfigurationSetParameters{TLSPolicy: awsclient.String(awsses.TlsPolicyRequire)},
+			observed: &awsses.DescribeConfigurationSetOutput{
+				ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(true)},
+				DeliveryOptions:   &awsses.DeliveryOptions{TlsPolicy: awsclient.String(awsses.TlsPolicyOptional)},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsConfigurationSetUpToDate(tc.p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
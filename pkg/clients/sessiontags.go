@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// Keys of the session tags that identify the managed resource on whose behalf
+// a role is assumed.
+const (
+	SessionTagKind           = "crossplane:kind"
+	SessionTagName           = "crossplane:name"
+	SessionTagNamespace      = "crossplane:namespace"
+	SessionTagProviderConfig = "crossplane:providerconfig"
+)
+
+// The source identity of a role session may be at most 64 characters long.
+const maxSourceIdentityLength = 64
+
+const errGetResourceKind = "cannot determine the kind of the managed resource"
+
+type resourceIdentityKey struct{}
+
+type resourceIdentity struct {
+	kind      string
+	name      string
+	namespace string
+}
+
+func tagResourceIdentity(pc *v1beta1.ProviderConfig) bool {
+	return pc.Spec.AssumeRole != nil && BoolValue(pc.Spec.AssumeRole.TagResourceIdentity)
+}
+
+// withResourceIdentity returns a copy of the supplied context that carries the
+// identity of the supplied managed resource, if the supplied ProviderConfig
+// tags role sessions with it.
+func withResourceIdentity(ctx context.Context, c client.Client, mg resource.Managed, pc *v1beta1.ProviderConfig) (context.Context, error) {
+	if !tagResourceIdentity(pc) {
+		return ctx, nil
+	}
+	// Typed objects read through the client usually have no type metadata.
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	if gvk.Kind == "" {
+		var err error
+		if gvk, err = apiutil.GVKForObject(mg, c.Scheme()); err != nil {
+			return nil, errors.Wrap(err, errGetResourceKind)
+		}
+	}
+	return context.WithValue(ctx, resourceIdentityKey{}, resourceIdentity{
+		kind:      gvk.GroupKind().String(),
+		name:      mg.GetName(),
+		namespace: mg.GetNamespace(),
+	}), nil
+}
+
+// SetResourceIdentityOptions adds the identity of the managed resource carried
+// by the supplied context and the name of the supplied ProviderConfig to the
+// sessions of roles assumed using it, if the ProviderConfig asks for it.
+func SetResourceIdentityOptions(ctx context.Context, pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleOptions) {
+	if !tagResourceIdentity(pc) {
+		return func(opt *stscreds.AssumeRoleOptions) {}
+	}
+	id, ok := ctx.Value(resourceIdentityKey{}).(resourceIdentity)
+	return func(opt *stscreds.AssumeRoleOptions) {
+		opt.Tags = append(opt.Tags, ststypes.Tag{Key: String(SessionTagProviderConfig), Value: String(pc.GetName())})
+		if !ok {
+			return
+		}
+		opt.Tags = append(opt.Tags,
+			ststypes.Tag{Key: String(SessionTagKind), Value: String(id.kind)},
+			ststypes.Tag{Key: String(SessionTagName), Value: String(id.name)},
+		)
+		if id.namespace != "" {
+			opt.Tags = append(opt.Tags, ststypes.Tag{Key: String(SessionTagNamespace), Value: String(id.namespace)})
+		}
+		// The version of stscreds we use doesn't support source identities,
+		// so we set it on the AssumeRole calls of the client instead.
+		opt.Client = &sourceIdentityClient{AssumeRoleAPIClient: opt.Client, sourceIdentity: sourceIdentity(id)}
+	}
+}
+
+// A sourceIdentityClient sets the source identity of the sessions of the roles
+// it assumes.
+type sourceIdentityClient struct {
+	stscreds.AssumeRoleAPIClient
+	sourceIdentity string
+}
+
+func (c *sourceIdentityClient) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	in := *params
+	in.SourceIdentity = String(c.sourceIdentity)
+	return c.AssumeRoleAPIClient.AssumeRole(ctx, &in, optFns...)
+}
+
+// sourceIdentity returns the source identity of sessions assumed on behalf of
+// the supplied managed resource, e.g. bucket.s3.aws.crossplane.io.my-bucket.
+// Session tags carry the full identity in case it must be truncated.
+func sourceIdentity(id resourceIdentity) string {
+	s := strings.ToLower(id.kind) + "." + id.name
+	if len(s) > maxSourceIdentityLength {
+		s = s[:maxSourceIdentityLength]
+	}
+	return s
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+type fakeAssumeRoleClient struct {
+	in *sts.AssumeRoleInput
+}
+
+func (c *fakeAssumeRoleClient) AssumeRole(_ context.Context, in *sts.AssumeRoleInput, _ ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	c.in = in
+	return &sts.AssumeRoleOutput{}, nil
+}
+
+func TestSetResourceIdentityOptions(t *testing.T) {
+	s := runtime.NewScheme()
+	s.AddKnownTypeWithName(schema.GroupVersionKind{Group: "s3.aws.crossplane.io", Version: "v1beta1", Kind: "Bucket"}, &fake.Managed{})
+	kube := &test.MockClient{MockScheme: test.NewMockSchemeFn(s)}
+
+	tagged := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "sandbox"},
+		Spec:       v1beta1.ProviderConfigSpec{AssumeRole: &v1beta1.AssumeRoleOptions{TagResourceIdentity: Bool(true)}},
+	}
+
+	type want struct {
+		tags           []ststypes.Tag
+		sourceIdentity *string
+	}
+	cases := map[string]struct {
+		pc   *v1beta1.ProviderConfig
+		mg   resource.Managed
+		want want
+	}{
+		"NotTagged": {
+			pc: &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{AssumeRole: &v1beta1.AssumeRoleOptions{}}},
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "my-bucket"}},
+		},
+		"NoManagedResource": {
+			pc: tagged,
+			want: want{
+				tags: []ststypes.Tag{{Key: String(SessionTagProviderConfig), Value: String("sandbox")}},
+			},
+		},
+		"ClusterScoped": {
+			pc: tagged,
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "my-bucket"}},
+			want: want{
+				tags: []ststypes.Tag{
+					{Key: String(SessionTagProviderConfig), Value: String("sandbox")},
+					{Key: String(SessionTagKind), Value: String("Bucket.s3.aws.crossplane.io")},
+					{Key: String(SessionTagName), Value: String("my-bucket")},
+				},
+				sourceIdentity: String("bucket.s3.aws.crossplane.io.my-bucket"),
+			},
+		},
+		"Namespaced": {
+			pc: tagged,
+			mg: &fake.Managed{ObjectMeta: metav1.ObjectMeta{Namespace: "team", Name: "a-bucket-with-a-name-long-enough-to-be-truncated"}},
+			want: want{
+				tags: []ststypes.Tag{
+					{Key: String(SessionTagProviderConfig), Value: String("sandbox")},
+					{Key: String(SessionTagKind), Value: String("Bucket.s3.aws.crossplane.io")},
+					{Key: String(SessionTagName), Value: String("a-bucket-with-a-name-long-enough-to-be-truncated")},
+					{Key: String(SessionTagNamespace), Value: String("team")},
+				},
+				sourceIdentity: String("bucket.s3.aws.crossplane.io.a-bucket-with-a-name-long-enough-to-"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.mg != nil {
+				var err error
+				if ctx, err = withResourceIdentity(ctx, kube, tc.mg, tc.pc); err != nil {
+					t.Fatalf("withResourceIdentity(...): %s", err)
+				}
+			}
+			client := &fakeAssumeRoleClient{}
+			opt := &stscreds.AssumeRoleOptions{Client: client}
+			SetResourceIdentityOptions(ctx, tc.pc)(opt)
+			if diff := cmp.Diff(tc.want.tags, opt.Tags, cmpopts.IgnoreUnexported(ststypes.Tag{})); diff != "" {
+				t.Errorf("SetResourceIdentityOptions(...): -want tags, +got:\n%s", diff)
+			}
+			if _, err := opt.Client.AssumeRole(ctx, &sts.AssumeRoleInput{}); err != nil {
+				t.Fatalf("AssumeRole(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.sourceIdentity, client.in.SourceIdentity); diff != "" {
+				t.Errorf("SetResourceIdentityOptions(...): -want source identity, +got:\n%s", diff)
+			}
+		})
+	}
+}
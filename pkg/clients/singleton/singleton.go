@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package singleton supports managed resources for settings that an AWS
+// account has exactly one of, like its password policy or its alias. The
+// external name of such a managed resource is the ID of the account whose
+// setting it manages, and only one managed resource of a kind may manage the
+// setting of an account. Otherwise two of them would overwrite each other's
+// changes forever, and deleting either would reset the setting of both.
+package singleton
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errList              = "cannot list managed resources of the same kind"
+	errFmtWrongAccount   = "external name %q is not the ID of account %s that the ProviderConfig resolves to"
+	errFmtAlreadyManaged = "the setting of account %s is already managed by %s"
+)
+
+// A ConflictError is returned when the setting of an account is already
+// managed by another managed resource of the same kind.
+type ConflictError struct {
+	AccountID string
+	Owner     string
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf(errFmtAlreadyManaged, e.AccountID, e.Owner)
+}
+
+// IsConflict returns true if the supplied error is a ConflictError.
+func IsConflict(err error) bool {
+	var c *ConflictError
+	return errors.As(err, &c)
+}
+
+// Guard binds the supplied managed resource to the account that its
+// ProviderConfig resolves to, by setting its external name to the ID of the
+// account. It returns true if it set the external name, which the caller must
+// persist. A ConflictError is returned if another managed resource in the
+// supplied list, which is filled with all managed resources of the same kind,
+// was bound to the same account first.
+func Guard(ctx context.Context, kube client.Client, mg resource.Managed, list resource.ManagedList) (bool, error) {
+	id, err := awsclient.GetAccountID(ctx, kube, mg)
+	if err != nil {
+		return false, err
+	}
+	bound := false
+	switch meta.GetExternalName(mg) {
+	case id:
+	case "":
+		meta.SetExternalName(mg, id)
+		bound = true
+	default:
+		return false, errors.Errorf(errFmtWrongAccount, meta.GetExternalName(mg), id)
+	}
+
+	if err := kube.List(ctx, list); err != nil {
+		return bound, errors.Wrap(err, errList)
+	}
+	for _, o := range list.GetItems() {
+		if o.GetUID() == mg.GetUID() || meta.GetExternalName(o) != id {
+			continue
+		}
+		if before(o, mg) {
+			return bound, &ConflictError{AccountID: id, Owner: o.GetName()}
+		}
+	}
+	return bound, nil
+}
+
+// before returns true if managed resource a was created before b. Managed
+// resources created within the same second are ordered by name, so that
+// exactly one of them wins.
+func before(a, b resource.Managed) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.GetName() < b.GetName()
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package singleton
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const account = "123456789012"
+
+var errBoom = errors.New("boom")
+
+func alias(name, uid, externalName string, created time.Time) *v1beta1.AccountAlias {
+	cr := &v1beta1.AccountAlias{}
+	cr.SetName(name)
+	cr.SetUID(types.UID(uid))
+	cr.SetCreationTimestamp(metav1.NewTime(created))
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	if externalName != "" {
+		meta.SetExternalName(cr, externalName)
+	}
+	return cr
+}
+
+func kube(others ...v1beta1.AccountAlias) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc := obj.(*awsv1beta1.ProviderConfig)
+			pc.Status.Identity = &awsv1beta1.CallerIdentity{AccountID: account}
+			return nil
+		},
+		MockList: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			list.(*v1beta1.AccountAliasList).Items = others
+			return nil
+		},
+	}
+}
+
+func TestGuard(t *testing.T) {
+	now := time.Now()
+	earlier := now.Add(-time.Hour)
+
+	type want struct {
+		bound        bool
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		cr     *v1beta1.AccountAlias
+		want   want
+	}{
+		"Bind": {
+			reason: "A managed resource without an external name should be bound to its account.",
+			kube:   kube(),
+			cr:     alias("a", "a", "", now),
+			want:   want{bound: true, externalName: account},
+		},
+		"AlreadyBound": {
+			reason: "A managed resource that is bound to its account should be left alone.",
+			kube:   kube(*alias("a", "a", account, now)),
+			cr:     alias("a", "a", account, now),
+			want:   want{externalName: account},
+		},
+		"WrongAccount": {
+			reason: "A managed resource bound to another account should be rejected.",
+			kube:   kube(),
+			cr:     alias("a", "a", "210987654321", now),
+			want: want{
+				externalName: "210987654321",
+				err:          errors.Errorf(errFmtWrongAccount, "210987654321", account),
+			},
+		},
+		"Conflict": {
+			reason: "A managed resource should conflict with one that was bound to the same account first.",
+			kube:   kube(*alias("b", "b", account, earlier)),
+			cr:     alias("a", "a", "", now),
+			want: want{
+				bound:        true,
+				externalName: account,
+				err:          &ConflictError{AccountID: account, Owner: "b"},
+			},
+		},
+		"ConflictSameSecond": {
+			reason: "Managed resources created at the same time should be ordered by name.",
+			kube:   kube(*alias("a", "a", account, now)),
+			cr:     alias("b", "b", account, now),
+			want: want{
+				externalName: account,
+				err:          &ConflictError{AccountID: account, Owner: "a"},
+			},
+		},
+		"Owner": {
+			reason: "A managed resource should not conflict with one created after it.",
+			kube:   kube(*alias("b", "b", account, now)),
+			cr:     alias("a", "a", account, earlier),
+			want:   want{externalName: account},
+		},
+		"OtherAccount": {
+			reason: "A managed resource should not conflict with one bound to another account.",
+			kube:   kube(*alias("b", "b", "210987654321", earlier)),
+			cr:     alias("a", "a", account, now),
+			want:   want{externalName: account},
+		},
+		"ListError": {
+			reason: "Errors listing managed resources should be returned.",
+			kube: &test.MockClient{
+				MockGet: kube().(*test.MockClient).MockGet,
+				MockList: func(context.Context, client.ObjectList, ...client.ListOption) error {
+					return errBoom
+				},
+			},
+			cr:   alias("a", "a", account, now),
+			want: want{externalName: account, err: errors.Wrap(errBoom, errList)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			bound, err := Guard(context.Background(), tc.kube, tc.cr, &v1beta1.AccountAliasList{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.bound, bound); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want bound, +got bound:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want external name, +got external name:\n%s", tc.reason, diff)
+			}
+			if IsConflict(err) != IsConflict(tc.want.err) {
+				t.Errorf("\n%s\nIsConflict(...): want %t", tc.reason, IsConflict(tc.want.err))
+			}
+		})
+	}
+}
@@ -36,6 +36,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/normalize"
 )
 
 const (
@@ -43,6 +44,11 @@ const (
 	QueueNotFound = "AWS.SimpleQueueService.NonExistentQueue"
 )
 
+// SQS returns the queue policy reformatted.
+var queueNormalizers = normalize.Register(v1beta1.QueueGroupKind, normalize.Fields{
+	"Policy": normalize.PolicyDocument,
+})
+
 // Client defines Queue client operations
 type Client interface {
 	CreateQueue(ctx context.Context, input *sqs.CreateQueueInput, opts ...func(*sqs.Options)) (*sqs.CreateQueueOutput, error)
@@ -205,7 +211,7 @@ func IsUpToDate(p v1beta1.QueueParameters, attributes map[string]string, tags ma
 	if !cmp.Equal(aws.ToString(p.KMSMasterKeyID), attributes[v1beta1.AttributeKmsMasterKeyID]) {
 		return false
 	}
-	if !cmp.Equal(aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
+	if !queueNormalizers.Equal("Policy", aws.ToString(p.Policy), attributes[v1beta1.AttributePolicy]) {
 		return false
 	}
 	if attributes[v1beta1.AttributeContentBasedDeduplication] != "" && strconv.FormatBool(aws.ToBool(p.ContentBasedDeduplication)) != attributes[v1beta1.AttributeContentBasedDeduplication] {
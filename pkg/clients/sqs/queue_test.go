@@ -158,6 +158,20 @@ func TestIsUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"ReformattedPolicy": {
+			args: args{
+				p: v1beta1.QueueParameters{
+					Policy: aws.String(`{
+  "Version": "2012-10-17",
+  "Statement": [{"Effect": "Allow", "Action": ["sqs:SendMessage"]}]
+}`),
+				},
+				attributes: map[string]string{
+					v1beta1.AttributePolicy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sqs:SendMessage"}]}`,
+				},
+			},
+			want: true,
+		},
 		"Tags": {
 			args: args{
 				p: v1beta1.QueueParameters{
@@ -0,0 +1,218 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+	"github.com/aws/aws-sdk-go/service/ssooidc/ssooidciface"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	// Tokens and role credentials are refreshed this long before they expire,
+	// so that they don't expire while in use.
+	ssoExpiryWindow = 5 * time.Minute
+
+	errSSONotConfigured  = "sso must be configured if the credentials source is SSO"
+	errGetSSOSecret      = "cannot get SSO token Secret"
+	errParseSSOToken     = "cannot parse SSO token cache entry"
+	errSSORegion         = "cannot determine the region of the SSO token, set it in the sso configuration"
+	errSSOTokenExpired   = "SSO access token expired and cannot be refreshed without a refresh token and client registration, log in again and update the Secret"
+	errRefreshSSOToken   = "cannot refresh SSO access token"
+	errUpdateSSOSecret   = "cannot write refreshed SSO token to Secret"
+	errSSOClients        = "cannot create SSO clients"
+	errGetSSORoleCreds   = "cannot get SSO role credentials"
+	errEmptySSORoleCreds = "SSO returned no role credentials"
+)
+
+// An ssoToken is an SSO token cache entry, as written by the AWS CLI.
+type ssoToken struct {
+	StartURL              string `json:"startUrl,omitempty"`
+	Region                string `json:"region,omitempty"`
+	AccessToken           string `json:"accessToken"`
+	ExpiresAt             string `json:"expiresAt"`
+	ClientID              string `json:"clientId,omitempty"`
+	ClientSecret          string `json:"clientSecret,omitempty"`
+	RegistrationExpiresAt string `json:"registrationExpiresAt,omitempty"`
+	RefreshToken          string `json:"refreshToken,omitempty"`
+}
+
+// expiresBefore returns true if the access token of the entry expires before
+// the supplied time, or if its expiry can't be determined.
+func (t ssoToken) expiresBefore(tm time.Time) bool {
+	// Older versions of the AWS CLI write the UTC suffix instead of Z.
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05UTC"} {
+		if exp, err := time.Parse(layout, t.ExpiresAt); err == nil {
+			return exp.Before(tm)
+		}
+	}
+	return true
+}
+
+func (t ssoToken) refreshable() bool {
+	return t.RefreshToken != "" && t.ClientID != "" && t.ClientSecret != ""
+}
+
+type ssoCacheKey struct {
+	secret    types.NamespacedName
+	key       string
+	accountID string
+	roleName  string
+}
+
+// An ssoResolver resolves SSO configurations to temporary role credentials,
+// which are cached until they're about to expire.
+type ssoResolver struct {
+	newClients func(region string) (ssoiface.SSOAPI, ssooidciface.SSOOIDCAPI, error)
+	now        func() time.Time
+
+	mu    sync.Mutex
+	cache map[ssoCacheKey]*sso.RoleCredentials
+}
+
+// newSSOClients returns unauthenticated SSO clients; their APIs are
+// authorized by the SSO access token instead.
+func newSSOClients(region string) (ssoiface.SSOAPI, ssooidciface.SSOOIDCAPI, error) {
+	sess, err := session.NewSession(awsv1.NewConfig().WithRegion(region).WithCredentials(credentialsv1.AnonymousCredentials))
+	if err != nil {
+		return nil, nil, err
+	}
+	sess.Handlers.Build.PushBackNamed(userAgentV1)
+	return sso.New(sess), ssooidc.New(sess), nil
+}
+
+var defaultSSOResolver = &ssoResolver{
+	newClients: newSSOClients,
+	now:        time.Now,
+	cache:      map[ssoCacheKey]*sso.RoleCredentials{},
+}
+
+// UseSSO returns the temporary role credentials configured by the SSO
+// configuration of the supplied ProviderConfig, in the format of an AWS
+// credentials file.
+func UseSSO(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	return defaultSSOResolver.Resolve(ctx, c, pc)
+}
+
+// Resolve the SSO configuration of the supplied ProviderConfig.
+func (r *ssoResolver) Resolve(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) { // nolint:gocyclo
+	cfg := pc.Spec.Credentials.SSO
+	if cfg == nil {
+		return nil, errors.New(errSSONotConfigured)
+	}
+	ref := cfg.TokenSecretRef
+	key := ssoCacheKey{
+		secret:    types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name},
+		key:       ref.Key,
+		accountID: cfg.AccountID,
+		roleName:  cfg.RoleName,
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if rc, ok := r.cache[key]; ok && time.UnixMilli(awsv1.Int64Value(rc.Expiration)).After(r.now().Add(ssoExpiryWindow)) {
+		return ssoCredentialsFile(rc), nil
+	}
+
+	s := &corev1.Secret{}
+	if err := c.Get(ctx, key.secret, s); err != nil {
+		return nil, errors.Wrap(err, errGetSSOSecret)
+	}
+	t := ssoToken{}
+	if err := json.Unmarshal(s.Data[ref.Key], &t); err != nil {
+		return nil, errors.Wrap(err, errParseSSOToken)
+	}
+	region := StringValue(cfg.Region)
+	if region == "" {
+		region = t.Region
+	}
+	if region == "" {
+		return nil, errors.New(errSSORegion)
+	}
+
+	ssoClient, oidcClient, err := r.newClients(region)
+	if err != nil {
+		return nil, errors.Wrap(err, errSSOClients)
+	}
+
+	if t.expiresBefore(r.now().Add(ssoExpiryWindow)) {
+		if !t.refreshable() {
+			return nil, errors.New(errSSOTokenExpired)
+		}
+		out, err := oidcClient.CreateTokenWithContext(ctx, &ssooidc.CreateTokenInput{
+			ClientId:     awsv1.String(t.ClientID),
+			ClientSecret: awsv1.String(t.ClientSecret),
+			GrantType:    awsv1.String("refresh_token"),
+			RefreshToken: awsv1.String(t.RefreshToken),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, errRefreshSSOToken)
+		}
+		t.AccessToken = awsv1.StringValue(out.AccessToken)
+		t.ExpiresAt = r.now().Add(time.Duration(awsv1.Int64Value(out.ExpiresIn)) * time.Second).UTC().Format(time.RFC3339)
+		if out.RefreshToken != nil {
+			t.RefreshToken = awsv1.StringValue(out.RefreshToken)
+		}
+
+		// Write the refreshed token back, so that it survives restarts and
+		// the previous refresh token, which may be single use, isn't reused.
+		data, err := json.Marshal(t)
+		if err != nil {
+			return nil, errors.Wrap(err, errUpdateSSOSecret)
+		}
+		s.Data[ref.Key] = data
+		if err := c.Update(ctx, s); err != nil {
+			return nil, errors.Wrap(err, errUpdateSSOSecret)
+		}
+	}
+
+	out, err := ssoClient.GetRoleCredentialsWithContext(ctx, &sso.GetRoleCredentialsInput{
+		AccessToken: awsv1.String(t.AccessToken),
+		AccountId:   awsv1.String(cfg.AccountID),
+		RoleName:    awsv1.String(cfg.RoleName),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetSSORoleCreds)
+	}
+	if out.RoleCredentials == nil {
+		return nil, errors.New(errEmptySSORoleCreds)
+	}
+	r.cache[key] = out.RoleCredentials
+	return ssoCredentialsFile(out.RoleCredentials), nil
+}
+
+func ssoCredentialsFile(rc *sso.RoleCredentials) []byte {
+	return []byte(fmt.Sprintf("[%s]\naws_access_key_id = %s\naws_secret_access_key = %s\naws_session_token = %s\n",
+		DefaultSection, awsv1.StringValue(rc.AccessKeyId), awsv1.StringValue(rc.SecretAccessKey), awsv1.StringValue(rc.SessionToken)))
+}
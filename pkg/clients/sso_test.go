@@ -0,0 +1,218 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sso"
+	"github.com/aws/aws-sdk-go/service/sso/ssoiface"
+	"github.com/aws/aws-sdk-go/service/ssooidc"
+	"github.com/aws/aws-sdk-go/service/ssooidc/ssooidciface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+type fakeSSO struct {
+	ssoiface.SSOAPI
+	getRoleCredentials func(*sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error)
+}
+
+func (f *fakeSSO) GetRoleCredentialsWithContext(_ context.Context, in *sso.GetRoleCredentialsInput, _ ...request.Option) (*sso.GetRoleCredentialsOutput, error) {
+	return f.getRoleCredentials(in)
+}
+
+type fakeSSOOIDC struct {
+	ssooidciface.SSOOIDCAPI
+	createToken func(*ssooidc.CreateTokenInput) (*ssooidc.CreateTokenOutput, error)
+}
+
+func (f *fakeSSOOIDC) CreateTokenWithContext(_ context.Context, in *ssooidc.CreateTokenInput, _ ...request.Option) (*ssooidc.CreateTokenOutput, error) {
+	return f.createToken(in)
+}
+
+func TestSSOResolve(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	valid := ssoToken{Region: "us-east-1", AccessToken: "token", ExpiresAt: "2022-03-01T13:00:00UTC"}
+	expired := ssoToken{Region: "us-east-1", AccessToken: "old", ExpiresAt: "2022-03-01T12:01:00Z"}
+	refreshable := expired
+	refreshable.ClientID = "id"
+	refreshable.ClientSecret = "secret"
+	refreshable.RefreshToken = "refresh"
+
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+		Source: v1beta1.CredentialsSourceSSO,
+		SSO: &v1beta1.SSOCredentials{
+			AccountID:      "123456789012",
+			RoleName:       "Sandbox",
+			TokenSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: "ns", Name: "sso"}, Key: "token"},
+		},
+	}}}
+	roleCreds := func(in *sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error) {
+		if awsv1.StringValue(in.AccessToken) != "token" || awsv1.StringValue(in.AccountId) != "123456789012" || awsv1.StringValue(in.RoleName) != "Sandbox" {
+			return nil, errors.New("unexpected input")
+		}
+		return &sso.GetRoleCredentialsOutput{RoleCredentials: &sso.RoleCredentials{
+			AccessKeyId:     awsv1.String("AKID"),
+			SecretAccessKey: awsv1.String("SECRET"),
+			SessionToken:    awsv1.String("SESSION"),
+			Expiration:      awsv1.Int64(now.Add(time.Hour).UnixMilli()),
+		}}, nil
+	}
+	getToken := func(tk ssoToken) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			data, _ := json.Marshal(tk)
+			obj.(*corev1.Secret).Data = map[string][]byte{"token": data}
+			return nil
+		})
+	}
+	creds := []byte("[DEFAULT]\naws_access_key_id = AKID\naws_secret_access_key = SECRET\naws_session_token = SESSION\n")
+
+	type want struct {
+		data    []byte
+		err     error
+		updated *ssoToken
+	}
+	cases := map[string]struct {
+		kube   *test.MockClient
+		pc     *v1beta1.ProviderConfig
+		create func(*ssooidc.CreateTokenInput) (*ssooidc.CreateTokenOutput, error)
+		want   want
+	}{
+		"NotConfigured": {
+			kube: &test.MockClient{},
+			pc:   &v1beta1.ProviderConfig{},
+			want: want{err: errors.New(errSSONotConfigured)},
+		},
+		"GetSecretError": {
+			kube: &test.MockClient{MockGet: test.NewMockGetFn(errors.New(errBoom))},
+			pc:   pc,
+			want: want{err: errors.Wrap(errors.New(errBoom), errGetSSOSecret)},
+		},
+		"ValidToken": {
+			kube: &test.MockClient{MockGet: getToken(valid)},
+			pc:   pc,
+			want: want{data: creds},
+		},
+		"ExpiredWithoutRefreshToken": {
+			kube: &test.MockClient{MockGet: getToken(expired)},
+			pc:   pc,
+			want: want{err: errors.New(errSSOTokenExpired)},
+		},
+		"RefreshError": {
+			kube: &test.MockClient{MockGet: getToken(refreshable)},
+			pc:   pc,
+			create: func(*ssooidc.CreateTokenInput) (*ssooidc.CreateTokenOutput, error) {
+				return nil, errors.New(errBoom)
+			},
+			want: want{err: errors.Wrap(errors.New(errBoom), errRefreshSSOToken)},
+		},
+		"Refreshed": {
+			kube: &test.MockClient{MockGet: getToken(refreshable)},
+			pc:   pc,
+			create: func(in *ssooidc.CreateTokenInput) (*ssooidc.CreateTokenOutput, error) {
+				if awsv1.StringValue(in.RefreshToken) != "refresh" || awsv1.StringValue(in.GrantType) != "refresh_token" {
+					return nil, errors.New("unexpected input")
+				}
+				return &ssooidc.CreateTokenOutput{AccessToken: awsv1.String("token"), ExpiresIn: awsv1.Int64(3600), RefreshToken: awsv1.String("refresh2")}, nil
+			},
+			want: want{
+				data: creds,
+				updated: &ssoToken{
+					Region:       "us-east-1",
+					AccessToken:  "token",
+					ExpiresAt:    "2022-03-01T13:00:00Z",
+					ClientID:     "id",
+					ClientSecret: "secret",
+					RefreshToken: "refresh2",
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *ssoToken
+			tc.kube.MockUpdate = func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				updated = &ssoToken{}
+				return json.Unmarshal(obj.(*corev1.Secret).Data["token"], updated)
+			}
+			r := &ssoResolver{
+				newClients: func(region string) (ssoiface.SSOAPI, ssooidciface.SSOOIDCAPI, error) {
+					return &fakeSSO{getRoleCredentials: roleCreds}, &fakeSSOOIDC{createToken: tc.create}, nil
+				},
+				now:   func() time.Time { return now },
+				cache: map[ssoCacheKey]*sso.RoleCredentials{},
+			}
+			data, err := r.Resolve(context.Background(), tc.kube, tc.pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Resolve(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(string(tc.want.data), string(data)); diff != "" {
+				t.Errorf("Resolve(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.updated, updated); diff != "" {
+				t.Errorf("Resolve(...): -want updated token, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSSOResolveCached(t *testing.T) {
+	calls := 0
+	r := &ssoResolver{
+		newClients: func(region string) (ssoiface.SSOAPI, ssooidciface.SSOOIDCAPI, error) {
+			return &fakeSSO{getRoleCredentials: func(*sso.GetRoleCredentialsInput) (*sso.GetRoleCredentialsOutput, error) {
+				calls++
+				return &sso.GetRoleCredentialsOutput{RoleCredentials: &sso.RoleCredentials{
+					Expiration: awsv1.Int64(time.Now().Add(time.Hour).UnixMilli()),
+				}}, nil
+			}}, nil, nil
+		},
+		now:   time.Now,
+		cache: map[ssoCacheKey]*sso.RoleCredentials{},
+	}
+	data, _ := json.Marshal(ssoToken{Region: "us-east-1", AccessToken: "token", ExpiresAt: time.Now().Add(time.Hour).Format(time.RFC3339)})
+	kube := &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+		obj.(*corev1.Secret).Data = map[string][]byte{"token": data}
+		return nil
+	})}
+	pc := &v1beta1.ProviderConfig{Spec: v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{
+		Source: v1beta1.CredentialsSourceSSO,
+		SSO:    &v1beta1.SSOCredentials{AccountID: "123456789012", RoleName: "Sandbox", TokenSecretRef: xpv1.SecretKeySelector{Key: "token"}},
+	}}}
+	for i := 0; i < 2; i++ {
+		if _, err := r.Resolve(context.Background(), kube, pc); err != nil {
+			t.Fatalf("Resolve(...): %s", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Resolve(...): want 1 GetRoleCredentials call, got %d", calls)
+	}
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package usage contains a Kubernetes client that reads ProviderConfigUsages
+// from a cache of their metadata only. There is a usage for every managed
+// resource, so caching them in full uses a lot of memory in large
+// deployments, while all of their spec can be derived from their metadata.
+package usage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+// WrapNewClientFunc returns a cluster.NewClientFunc that wraps the clients
+// returned by the supplied one with a Client.
+func WrapNewClientFunc(fn cluster.NewClientFunc) cluster.NewClientFunc {
+	return func(c cache.Cache, config *rest.Config, o client.Options, uncached ...client.Object) (client.Client, error) {
+		kube, err := fn(c, config, o, uncached...)
+		if err != nil {
+			return nil, err
+		}
+		return NewClient(kube), nil
+	}
+}
+
+// A Client reads ProviderConfigUsages as metadata, and derives their spec
+// from it. A usage is labelled with the name of the ProviderConfig it
+// references, and controlled by the managed resource it tracks. Other objects
+// are read as usual.
+type Client struct {
+	client.Client
+}
+
+// NewClient returns a Client that wraps the supplied client.
+func NewClient(c client.Client) *Client {
+	return &Client{Client: c}
+}
+
+// Get the supplied object.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	u, ok := obj.(*v1beta1.ProviderConfigUsage)
+	if !ok {
+		return c.Client.Get(ctx, key, obj)
+	}
+	m := &metav1.PartialObjectMetadata{}
+	m.SetGroupVersionKind(v1beta1.ProviderConfigUsageGroupVersionKind)
+	if err := c.Client.Get(ctx, key, m); err != nil {
+		return err
+	}
+	FromMetadata(m, u)
+	return nil
+}
+
+// List the supplied objects.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	ul, ok := list.(*v1beta1.ProviderConfigUsageList)
+	if !ok {
+		return c.Client.List(ctx, list, opts...)
+	}
+	ml := &metav1.PartialObjectMetadataList{}
+	ml.SetGroupVersionKind(v1beta1.ProviderConfigUsageListGroupVersionKind)
+	if err := c.Client.List(ctx, ml, opts...); err != nil {
+		return err
+	}
+	ul.ListMeta = ml.ListMeta
+	ul.Items = make([]v1beta1.ProviderConfigUsage, len(ml.Items))
+	for i := range ml.Items {
+		FromMetadata(&ml.Items[i], &ul.Items[i])
+	}
+	return nil
+}
+
+// FromMetadata sets the supplied usage to the one described by the supplied
+// metadata.
+func FromMetadata(m *metav1.PartialObjectMetadata, u *v1beta1.ProviderConfigUsage) {
+	*u = v1beta1.ProviderConfigUsage{ObjectMeta: *m.ObjectMeta.DeepCopy()}
+	u.SetGroupVersionKind(v1beta1.ProviderConfigUsageGroupVersionKind)
+	u.ProviderConfigReference = xpv1.Reference{Name: m.GetLabels()[xpv1.LabelKeyProviderName]}
+	if ref := metav1.GetControllerOf(m); ref != nil {
+		u.ResourceReference = xpv1.TypedReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name}
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+var errBoom = errors.New("boom")
+
+func metadata() metav1.PartialObjectMetadata {
+	ctrl := true
+	return metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+		Name:   "uid",
+		Labels: map[string]string{xpv1.LabelKeyProviderName: "default"},
+		OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: "sqs.aws.crossplane.io/v1beta1",
+			Kind:       "Queue",
+			Name:       "example",
+			Controller: &ctrl,
+		}},
+	}}
+}
+
+func usage() v1beta1.ProviderConfigUsage {
+	m := metadata()
+	u := v1beta1.ProviderConfigUsage{ObjectMeta: m.ObjectMeta}
+	u.SetGroupVersionKind(v1beta1.ProviderConfigUsageGroupVersionKind)
+	u.ProviderConfigReference = xpv1.Reference{Name: "default"}
+	u.ResourceReference = xpv1.TypedReference{APIVersion: "sqs.aws.crossplane.io/v1beta1", Kind: "Queue", Name: "example"}
+	return u
+}
+
+func TestGet(t *testing.T) {
+	type want struct {
+		obj client.Object
+		err error
+	}
+
+	cases := map[string]struct {
+		kube client.Client
+		obj  client.Object
+		want want
+	}{
+		"Usage": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					m, ok := obj.(*metav1.PartialObjectMetadata)
+					if !ok {
+						t.Errorf("Get(...): want *metav1.PartialObjectMetadata, got %T", obj)
+						return nil
+					}
+					if m.GroupVersionKind() != v1beta1.ProviderConfigUsageGroupVersionKind {
+						t.Errorf("Get(...): want %s, got %s", v1beta1.ProviderConfigUsageGroupVersionKind, m.GroupVersionKind())
+					}
+					*m = metadata()
+					return nil
+				}),
+			},
+			obj: &v1beta1.ProviderConfigUsage{},
+			want: want{
+				obj: func() client.Object { u := usage(); return &u }(),
+			},
+		},
+		"UsageError": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			obj: &v1beta1.ProviderConfigUsage{},
+			want: want{
+				obj: &v1beta1.ProviderConfigUsage{},
+				err: errBoom,
+			},
+		},
+		"OtherObject": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.SetName("secret")
+					return nil
+				}),
+			},
+			obj: &corev1.Secret{},
+			want: want{
+				obj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "secret"}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := NewClient(tc.kube).Get(context.Background(), client.ObjectKey{Name: "uid"}, tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("Get(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, tc.obj); diff != "" {
+				t.Errorf("Get(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	kube := &test.MockClient{
+		MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+			ml, ok := obj.(*metav1.PartialObjectMetadataList)
+			if !ok {
+				t.Errorf("List(...): want *metav1.PartialObjectMetadataList, got %T", obj)
+				return nil
+			}
+			ml.Items = []metav1.PartialObjectMetadata{metadata()}
+			return nil
+		}),
+	}
+
+	l := &v1beta1.ProviderConfigUsageList{}
+	if err := NewClient(kube).List(context.Background(), l, client.MatchingLabels{xpv1.LabelKeyProviderName: "default"}); err != nil {
+		t.Fatalf("List(...): %v", err)
+	}
+	want := &v1beta1.ProviderConfigUsageList{Items: []v1beta1.ProviderConfigUsage{usage()}}
+	if diff := cmp.Diff(want, l); diff != "" {
+		t.Errorf("List(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+// MockClient is a type that implements all the methods for the WAFv2 Client
+// interface.
+type MockClient struct {
+	MockCreateIPSet          func(ctx context.Context, input *wafv2.CreateIPSetInput, opts []request.Option) (*wafv2.CreateIPSetOutput, error)
+	MockGetIPSet             func(ctx context.Context, input *wafv2.GetIPSetInput, opts []request.Option) (*wafv2.GetIPSetOutput, error)
+	MockUpdateIPSet          func(ctx context.Context, input *wafv2.UpdateIPSetInput, opts []request.Option) (*wafv2.UpdateIPSetOutput, error)
+	MockDeleteIPSet          func(ctx context.Context, input *wafv2.DeleteIPSetInput, opts []request.Option) (*wafv2.DeleteIPSetOutput, error)
+	MockCreateRuleGroup      func(ctx context.Context, input *wafv2.CreateRuleGroupInput, opts []request.Option) (*wafv2.CreateRuleGroupOutput, error)
+	MockGetRuleGroup         func(ctx context.Context, input *wafv2.GetRuleGroupInput, opts []request.Option) (*wafv2.GetRuleGroupOutput, error)
+	MockUpdateRuleGroup      func(ctx context.Context, input *wafv2.UpdateRuleGroupInput, opts []request.Option) (*wafv2.UpdateRuleGroupOutput, error)
+	MockDeleteRuleGroup      func(ctx context.Context, input *wafv2.DeleteRuleGroupInput, opts []request.Option) (*wafv2.DeleteRuleGroupOutput, error)
+	MockCreateWebACL         func(ctx context.Context, input *wafv2.CreateWebACLInput, opts []request.Option) (*wafv2.CreateWebACLOutput, error)
+	MockGetWebACL            func(ctx context.Context, input *wafv2.GetWebACLInput, opts []request.Option) (*wafv2.GetWebACLOutput, error)
+	MockUpdateWebACL         func(ctx context.Context, input *wafv2.UpdateWebACLInput, opts []request.Option) (*wafv2.UpdateWebACLOutput, error)
+	MockDeleteWebACL         func(ctx context.Context, input *wafv2.DeleteWebACLInput, opts []request.Option) (*wafv2.DeleteWebACLOutput, error)
+	MockAssociateWebACL      func(ctx context.Context, input *wafv2.AssociateWebACLInput, opts []request.Option) (*wafv2.AssociateWebACLOutput, error)
+	MockDisassociateWebACL   func(ctx context.Context, input *wafv2.DisassociateWebACLInput, opts []request.Option) (*wafv2.DisassociateWebACLOutput, error)
+	MockGetWebACLForResource func(ctx context.Context, input *wafv2.GetWebACLForResourceInput, opts []request.Option) (*wafv2.GetWebACLForResourceOutput, error)
+}
+
+// CreateIPSetWithContext mocks CreateIPSetWithContext method
+func (m *MockClient) CreateIPSetWithContext(ctx context.Context, input *wafv2.CreateIPSetInput, opts ...request.Option) (*wafv2.CreateIPSetOutput, error) {
+	return m.MockCreateIPSet(ctx, input, opts)
+}
+
+// GetIPSetWithContext mocks GetIPSetWithContext method
+func (m *MockClient) GetIPSetWithContext(ctx context.Context, input *wafv2.GetIPSetInput, opts ...request.Option) (*wafv2.GetIPSetOutput, error) {
+	return m.MockGetIPSet(ctx, input, opts)
+}
+
+// UpdateIPSetWithContext mocks UpdateIPSetWithContext method
+func (m *MockClient) UpdateIPSetWithContext(ctx context.Context, input *wafv2.UpdateIPSetInput, opts ...request.Option) (*wafv2.UpdateIPSetOutput, error) {
+	return m.MockUpdateIPSet(ctx, input, opts)
+}
+
+// DeleteIPSetWithContext mocks DeleteIPSetWithContext method
+func (m *MockClient) DeleteIPSetWithContext(ctx context.Context, input *wafv2.DeleteIPSetInput, opts ...request.Option) (*wafv2.DeleteIPSetOutput, error) {
+	return m.MockDeleteIPSet(ctx, input, opts)
+}
+
+// CreateRuleGroupWithContext mocks CreateRuleGroupWithContext method
+func (m *MockClient) CreateRuleGroupWithContext(ctx context.Context, input *wafv2.CreateRuleGroupInput, opts ...request.Option) (*wafv2.CreateRuleGroupOutput, error) {
+	return m.MockCreateRuleGroup(ctx, input, opts)
+}
+
+// GetRuleGroupWithContext mocks GetRuleGroupWithContext method
+func (m *MockClient) GetRuleGroupWithContext(ctx context.Context, input *wafv2.GetRuleGroupInput, opts ...request.Option) (*wafv2.GetRuleGroupOutput, error) {
+	return m.MockGetRuleGroup(ctx, input, opts)
+}
+
+// UpdateRuleGroupWithContext mocks UpdateRuleGroupWithContext method
+func (m *MockClient) UpdateRuleGroupWithContext(ctx context.Context, input *wafv2.UpdateRuleGroupInput, opts ...request.Option) (*wafv2.UpdateRuleGroupOutput, error) {
+	return m.MockUpdateRuleGroup(ctx, input, opts)
+}
+
+// DeleteRuleGroupWithContext mocks DeleteRuleGroupWithContext method
+func (m *MockClient) DeleteRuleGroupWithContext(ctx context.Context, input *wafv2.DeleteRuleGroupInput, opts ...request.Option) (*wafv2.DeleteRuleGroupOutput, error) {
+	return m.MockDeleteRuleGroup(ctx, input, opts)
+}
+
+// CreateWebACLWithContext mocks CreateWebACLWithContext method
+func (m *MockClient) CreateWebACLWithContext(ctx context.Context, input *wafv2.CreateWebACLInput, opts ...request.Option) (*wafv2.CreateWebACLOutput, error) {
+	return m.MockCreateWebACL(ctx, input, opts)
+}
+
+// GetWebACLWithContext mocks GetWebACLWithContext method
+func (m *MockClient) GetWebACLWithContext(ctx context.Context, input *wafv2.GetWebACLInput, opts ...request.Option) (*wafv2.GetWebACLOutput, error) {
+	return m.MockGetWebACL(ctx, input, opts)
+}
+
+// UpdateWebACLWithContext mocks UpdateWebACLWithContext method
+func (m *MockClient) UpdateWebACLWithContext(ctx context.Context, input *wafv2.UpdateWebACLInput, opts ...request.Option) (*wafv2.UpdateWebACLOutput, error) {
+	return m.MockUpdateWebACL(ctx, input, opts)
+}
+
+// DeleteWebACLWithContext mocks DeleteWebACLWithContext method
+func (m *MockClient) DeleteWebACLWithContext(ctx context.Context, input *wafv2.DeleteWebACLInput, opts ...request.Option) (*wafv2.DeleteWebACLOutput, error) {
+	return m.MockDeleteWebACL(ctx, input, opts)
+}
+
+// AssociateWebACLWithContext mocks AssociateWebACLWithContext method
+func (m *MockClient) AssociateWebACLWithContext(ctx context.Context, input *wafv2.AssociateWebACLInput, opts ...request.Option) (*wafv2.AssociateWebACLOutput, error) {
+	return m.MockAssociateWebACL(ctx, input, opts)
+}
+
+// DisassociateWebACLWithContext mocks DisassociateWebACLWithContext method
+func (m *MockClient) DisassociateWebACLWithContext(ctx context.Context, input *wafv2.DisassociateWebACLInput, opts ...request.Option) (*wafv2.DisassociateWebACLOutput, error) {
+	return m.MockDisassociateWebACL(ctx, input, opts)
+}
+
+// GetWebACLForResourceWithContext mocks GetWebACLForResourceWithContext method
+func (m *MockClient) GetWebACLForResourceWithContext(ctx context.Context, input *wafv2.GetWebACLForResourceInput, opts ...request.Option) (*wafv2.GetWebACLForResourceOutput, error) {
+	return m.MockGetWebACLForResource(ctx, input, opts)
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// Client defines the WAFv2 operations used by the IP set, rule group, web
+// ACL and web ACL association controllers.
+type Client interface {
+	CreateIPSetWithContext(context.Context, *awswafv2.CreateIPSetInput, ...request.Option) (*awswafv2.CreateIPSetOutput, error)
+	GetIPSetWithContext(context.Context, *awswafv2.GetIPSetInput, ...request.Option) (*awswafv2.GetIPSetOutput, error)
+	UpdateIPSetWithContext(context.Context, *awswafv2.UpdateIPSetInput, ...request.Option) (*awswafv2.UpdateIPSetOutput, error)
+	DeleteIPSetWithContext(context.Context, *awswafv2.DeleteIPSetInput, ...request.Option) (*awswafv2.DeleteIPSetOutput, error)
+
+	CreateRuleGroupWithContext(context.Context, *awswafv2.CreateRuleGroupInput, ...request.Option) (*awswafv2.CreateRuleGroupOutput, error)
+	GetRuleGroupWithContext(context.Context, *awswafv2.GetRuleGroupInput, ...request.Option) (*awswafv2.GetRuleGroupOutput, error)
+	UpdateRuleGroupWithContext(context.Context, *awswafv2.UpdateRuleGroupInput, ...request.Option) (*awswafv2.UpdateRuleGroupOutput, error)
+	DeleteRuleGroupWithContext(context.Context, *awswafv2.DeleteRuleGroupInput, ...request.Option) (*awswafv2.DeleteRuleGroupOutput, error)
+
+	CreateWebACLWithContext(context.Context, *awswafv2.CreateWebACLInput, ...request.Option) (*awswafv2.CreateWebACLOutput, error)
+	GetWebACLWithContext(context.Context, *awswafv2.GetWebACLInput, ...request.Option) (*awswafv2.GetWebACLOutput, error)
+	UpdateWebACLWithContext(context.Context, *awswafv2.UpdateWebACLInput, ...request.Option) (*awswafv2.UpdateWebACLOutput, error)
+	DeleteWebACLWithContext(context.Context, *awswafv2.DeleteWebACLInput, ...request.Option) (*awswafv2.DeleteWebACLOutput, error)
+
+	AssociateWebACLWithContext(context.Context, *awswafv2.AssociateWebACLInput, ...request.Option) (*awswafv2.AssociateWebACLOutput, error)
+	DisassociateWebACLWithContext(context.Context, *awswafv2.DisassociateWebACLInput, ...request.Option) (*awswafv2.DisassociateWebACLOutput, error)
+	GetWebACLForResourceWithContext(context.Context, *awswafv2.GetWebACLForResourceInput, ...request.Option) (*awswafv2.GetWebACLForResourceOutput, error)
+}
+
+// NewClient returns a new WAFv2 client for the given session.
+func NewClient(sess *session.Session) Client {
+	return awswafv2.New(sess)
+}
+
+// IsNotFound returns true if the error indicates that the resource does not
+// exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == awswafv2.ErrCodeWAFNonexistentItemException
+}
+
+// GenerateVisibilityConfig returns the WAFv2 representation of the given
+// visibility config.
+func GenerateVisibilityConfig(v v1alpha1.VisibilityConfig) *awswafv2.VisibilityConfig {
+	return &awswafv2.VisibilityConfig{
+		CloudWatchMetricsEnabled: aws.Bool(v.CloudWatchMetricsEnabled),
+		MetricName:               aws.String(v.MetricName),
+		SampledRequestsEnabled:   aws.Bool(v.SampledRequestsEnabled),
+	}
+}
+
+// IsVisibilityConfigUpToDate returns true if the observed visibility config
+// matches the given one.
+func IsVisibilityConfigUpToDate(v v1alpha1.VisibilityConfig, observed *awswafv2.VisibilityConfig) bool {
+	if observed == nil {
+		return false
+	}
+	return v.CloudWatchMetricsEnabled == aws.BoolValue(observed.CloudWatchMetricsEnabled) &&
+		v.MetricName == aws.StringValue(observed.MetricName) &&
+		v.SampledRequestsEnabled == aws.BoolValue(observed.SampledRequestsEnabled)
+}
+
+// GenerateDefaultAction returns the WAFv2 representation of the given
+// default action of a web ACL.
+func GenerateDefaultAction(action string) *awswafv2.DefaultAction {
+	if action == v1alpha1.DefaultActionBlock {
+		return &awswafv2.DefaultAction{Block: &awswafv2.BlockAction{}}
+	}
+	return &awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}}
+}
+
+// DefaultAction returns the default action of the given observed default
+// action of a web ACL.
+func DefaultAction(observed *awswafv2.DefaultAction) string {
+	if observed != nil && observed.Block != nil {
+		return v1alpha1.DefaultActionBlock
+	}
+	return v1alpha1.DefaultActionAllow
+}
+
+// GenerateTags returns the WAFv2 tags of the given tag map, sorted by key.
+func GenerateTags(tags map[string]string) []*awswafv2.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	res := make([]*awswafv2.Tag, len(keys))
+	for i, k := range keys {
+		res[i] = &awswafv2.Tag{Key: aws.String(k), Value: aws.String(tags[k])}
+	}
+	return res
+}
+
+// ParseRules parses the given JSON array of rules in the format of the
+// WAFv2 API. An empty document yields no rules.
+func ParseRules(rules string) ([]*awswafv2.Rule, error) {
+	if rules == "" {
+		return nil, nil
+	}
+	var res []*awswafv2.Rule
+	if err := json.Unmarshal([]byte(rules), &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// IsRulesUpToDate returns true if the given JSON array of rules is
+// semantically equal to the observed rules, regardless of formatting, key
+// order, rule order and unset fields.
+func IsRulesUpToDate(rules string, observed []*awswafv2.Rule) (bool, error) {
+	desired, err := ParseRules(rules)
+	if err != nil {
+		return false, err
+	}
+	d, err := normalizeRules(desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := normalizeRules(observed)
+	if err != nil {
+		return false, err
+	}
+	return reflect.DeepEqual(d, o), nil
+}
+
+// normalizeRules returns the generic JSON representation of the given rules
+// ordered by priority, without the fields that are unset.
+func normalizeRules(rules []*awswafv2.Rule) (interface{}, error) {
+	sorted := make([]*awswafv2.Rule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return aws.Int64Value(sorted[i].Priority) < aws.Int64Value(sorted[j].Priority)
+	})
+	b, err := json.Marshal(sorted)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return nil, err
+	}
+	return prune(res), nil
+}
+
+// prune removes null values and empty arrays from the given generic JSON
+// value. Empty objects are kept since they are meaningful in WAFv2, e.g.
+// the {} of an Allow action.
+func prune(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, e := range t {
+			p := prune(e)
+			if p == nil {
+				delete(t, k)
+				continue
+			}
+			t[k] = p
+		}
+		return t
+	case []interface{}:
+		if len(t) == 0 {
+			return nil
+		}
+		for i, e := range t {
+			t[i] = prune(e)
+		}
+		return t
+	default:
+		return v
+	}
+}
+
+// GenerateCreateIPSetInput returns the input that creates an IP set with the
+// given parameters.
+func GenerateCreateIPSetInput(p v1alpha1.IPSetParameters) *awswafv2.CreateIPSetInput {
+	return &awswafv2.CreateIPSetInput{
+		Name:             aws.String(p.Name),
+		Scope:            aws.String(p.Scope),
+		Description:      p.Description,
+		IPAddressVersion: aws.String(p.IPAddressVersion),
+		Addresses:        aws.StringSlice(p.Addresses),
+		Tags:             GenerateTags(p.Tags),
+	}
+}
+
+// IsIPSetUpToDate returns true if the observed IP set matches the given
+// parameters.
+func IsIPSetUpToDate(p v1alpha1.IPSetParameters, observed *awswafv2.IPSet) bool {
+	if awsclient.StringValue(p.Description) != aws.StringValue(observed.Description) {
+		return false
+	}
+	desired := append([]string{}, p.Addresses...)
+	current := aws.StringValueSlice(observed.Addresses)
+	sort.Strings(desired)
+	sort.Strings(current)
+	if len(desired) != len(current) {
+		return false
+	}
+	for i := range desired {
+		if desired[i] != current[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateCreateRuleGroupInput returns the input that creates a rule group
+// with the given parameters.
+func GenerateCreateRuleGroupInput(p v1alpha1.RuleGroupParameters) (*awswafv2.CreateRuleGroupInput, error) {
+	rules, err := ParseRules(p.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &awswafv2.CreateRuleGroupInput{
+		Name:             aws.String(p.Name),
+		Scope:            aws.String(p.Scope),
+		Capacity:         aws.Int64(p.Capacity),
+		Description:      p.Description,
+		Rules:            rules,
+		VisibilityConfig: GenerateVisibilityConfig(p.VisibilityConfig),
+		Tags:             GenerateTags(p.Tags),
+	}, nil
+}
+
+// IsRuleGroupUpToDate returns true if the observed rule group matches the
+// given parameters.
+func IsRuleGroupUpToDate(p v1alpha1.RuleGroupParameters, observed *awswafv2.RuleGroup) (bool, error) {
+	if awsclient.StringValue(p.Description) != aws.StringValue(observed.Description) ||
+		!IsVisibilityConfigUpToDate(p.VisibilityConfig, observed.VisibilityConfig) {
+		return false, nil
+	}
+	return IsRulesUpToDate(p.Rules, observed.Rules)
+}
+
+// GenerateCreateWebACLInput returns the input that creates a web ACL with
+// the given parameters.
+func GenerateCreateWebACLInput(p v1alpha1.WebACLParameters) (*awswafv2.CreateWebACLInput, error) {
+	rules, err := ParseRules(p.Rules)
+	if err != nil {
+		return nil, err
+	}
+	return &awswafv2.CreateWebACLInput{
+		Name:             aws.String(p.Name),
+		Scope:            aws.String(p.Scope),
+		Description:      p.Description,
+		DefaultAction:    GenerateDefaultAction(p.DefaultAction),
+		Rules:            rules,
+		VisibilityConfig: GenerateVisibilityConfig(p.VisibilityConfig),
+		Tags:             GenerateTags(p.Tags),
+	}, nil
+}
+
+// IsWebACLUpToDate returns true if the observed web ACL matches the given
+// parameters.
+func IsWebACLUpToDate(p v1alpha1.WebACLParameters, observed *awswafv2.WebACL) (bool, error) {
+	if awsclient.StringValue(p.Description) != aws.StringValue(observed.Description) ||
+		p.DefaultAction != DefaultAction(observed.DefaultAction) ||
+		!IsVisibilityConfigUpToDate(p.VisibilityConfig, observed.VisibilityConfig) {
+		return false, nil
+	}
+	return IsRulesUpToDate(p.Rules, observed.Rules)
+}
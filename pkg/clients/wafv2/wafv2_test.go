@@ -0,0 +1,260 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wafv2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+)
+
+const rules = `[
+  {
+    "Name": "block-bad-ips",
+    "Priority": 0,
+    "Action": {"Block": {}},
+    "Statement": {"IPSetReferenceStatement": {"ARN": "arn:aws:wafv2:us-east-1:123456789012:regional/ipset/bad/abc"}},
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": true, "MetricName": "bad-ips", "SampledRequestsEnabled": false}
+  },
+  {
+    "Name": "rate-limit",
+    "Priority": 1,
+    "Action": {"Block": {}},
+    "Statement": {"RateBasedStatement": {"Limit": 1000, "AggregateKeyType": "IP"}},
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": true, "MetricName": "rate-limit", "SampledRequestsEnabled": true}
+  }
+]`
+
+func observedRules() []*awswafv2.Rule {
+	return []*awswafv2.Rule{
+		{
+			Name:     aws.String("rate-limit"),
+			Priority: aws.Int64(1),
+			Action:   &awswafv2.RuleAction{Block: &awswafv2.BlockAction{}},
+			Statement: &awswafv2.Statement{RateBasedStatement: &awswafv2.RateBasedStatement{
+				Limit:            aws.Int64(1000),
+				AggregateKeyType: aws.String("IP"),
+			}},
+			RuleLabels: []*awswafv2.Label{},
+			VisibilityConfig: &awswafv2.VisibilityConfig{
+				CloudWatchMetricsEnabled: aws.Bool(true),
+				MetricName:               aws.String("rate-limit"),
+				SampledRequestsEnabled:   aws.Bool(true),
+			},
+		},
+		{
+			Name:     aws.String("block-bad-ips"),
+			Priority: aws.Int64(0),
+			Action:   &awswafv2.RuleAction{Block: &awswafv2.BlockAction{}},
+			Statement: &awswafv2.Statement{IPSetReferenceStatement: &awswafv2.IPSetReferenceStatement{
+				ARN: aws.String("arn:aws:wafv2:us-east-1:123456789012:regional/ipset/bad/abc"),
+			}},
+			VisibilityConfig: &awswafv2.VisibilityConfig{
+				CloudWatchMetricsEnabled: aws.Bool(true),
+				MetricName:               aws.String("bad-ips"),
+				SampledRequestsEnabled:   aws.Bool(false),
+			},
+		},
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"NotFound": {
+			err:  errors.Wrap(awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil), "wrapped"),
+			want: true,
+		},
+		"OtherAWSError": {
+			err:  awserr.New(awswafv2.ErrCodeWAFOptimisticLockException, "", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsNotFound(tc.err)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRulesUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		rules    string
+		observed []*awswafv2.Rule
+		want     bool
+		wantErr  bool
+	}{
+		"Same": {
+			rules:    rules,
+			observed: observedRules(),
+			want:     true,
+		},
+		"Compact": {
+			rules:    `[{"Priority":1,"Name":"rate-limit","Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":1000}},"Action":{"Block":{}},"VisibilityConfig":{"MetricName":"rate-limit","SampledRequestsEnabled":true,"CloudWatchMetricsEnabled":true}},{"Name":"block-bad-ips","Priority":0,"Action":{"Block":{}},"Statement":{"IPSetReferenceStatement":{"ARN":"arn:aws:wafv2:us-east-1:123456789012:regional/ipset/bad/abc"}},"VisibilityConfig":{"CloudWatchMetricsEnabled":true,"MetricName":"bad-ips","SampledRequestsEnabled":false}}]`,
+			observed: observedRules(),
+			want:     true,
+		},
+		"DifferentAction": {
+			rules: rules,
+			observed: func() []*awswafv2.Rule {
+				r := observedRules()
+				r[0].Action = &awswafv2.RuleAction{Count: &awswafv2.CountAction{}}
+				return r
+			}(),
+			want: false,
+		},
+		"DifferentLimit": {
+			rules: rules,
+			observed: func() []*awswafv2.Rule {
+				r := observedRules()
+				r[0].Statement.RateBasedStatement.Limit = aws.Int64(2000)
+				return r
+			}(),
+			want: false,
+		},
+		"MissingRule": {
+			rules:    rules,
+			observed: observedRules()[:1],
+			want:     false,
+		},
+		"NoRules": {
+			rules: "",
+			want:  true,
+		},
+		"InvalidJSON": {
+			rules:    "[{",
+			observed: observedRules(),
+			wantErr:  true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsRulesUpToDate(tc.rules, tc.observed)
+			if diff := cmp.Diff(tc.wantErr, err != nil); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsIPSetUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		p        v1alpha1.IPSetParameters
+		observed *awswafv2.IPSet
+		want     bool
+	}{
+		"SameAddressesInOtherOrder": {
+			p:        v1alpha1.IPSetParameters{Addresses: []string{"192.0.2.0/24", "198.51.100.0/24"}},
+			observed: &awswafv2.IPSet{Addresses: aws.StringSlice([]string{"198.51.100.0/24", "192.0.2.0/24"})},
+			want:     true,
+		},
+		"AddressAdded": {
+			p:        v1alpha1.IPSetParameters{Addresses: []string{"192.0.2.0/24", "198.51.100.0/24"}},
+			observed: &awswafv2.IPSet{Addresses: aws.StringSlice([]string{"192.0.2.0/24"})},
+			want:     false,
+		},
+		"DescriptionChanged": {
+			p:        v1alpha1.IPSetParameters{Description: aws.String("new")},
+			observed: &awswafv2.IPSet{Description: aws.String("old")},
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsIPSetUpToDate(tc.p, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWebACLUpToDate(t *testing.T) {
+	visibility := v1alpha1.VisibilityConfig{CloudWatchMetricsEnabled: true, MetricName: "acl"}
+	observed := func() *awswafv2.WebACL {
+		return &awswafv2.WebACL{
+			DefaultAction:    &awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}},
+			Rules:            observedRules(),
+			VisibilityConfig: GenerateVisibilityConfig(visibility),
+		}
+	}
+	cases := map[string]struct {
+		p        v1alpha1.WebACLParameters
+		observed *awswafv2.WebACL
+		want     bool
+	}{
+		"UpToDate": {
+			p:        v1alpha1.WebACLParameters{DefaultAction: v1alpha1.DefaultActionAllow, Rules: rules, VisibilityConfig: visibility},
+			observed: observed(),
+			want:     true,
+		},
+		"DefaultActionChanged": {
+			p:        v1alpha1.WebACLParameters{DefaultAction: v1alpha1.DefaultActionBlock, Rules: rules, VisibilityConfig: visibility},
+			observed: observed(),
+			want:     false,
+		},
+		"VisibilityChanged": {
+			p:        v1alpha1.WebACLParameters{DefaultAction: v1alpha1.DefaultActionAllow, Rules: rules, VisibilityConfig: v1alpha1.VisibilityConfig{MetricName: "acl"}},
+			observed: observed(),
+			want:     false,
+		},
+		"RulesRemoved": {
+			p:        v1alpha1.WebACLParameters{DefaultAction: v1alpha1.DefaultActionAllow, VisibilityConfig: visibility},
+			observed: observed(),
+			want:     false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsWebACLUpToDate(tc.p, tc.observed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateTags(t *testing.T) {
+	got := GenerateTags(map[string]string{"b": "2", "a": "1"})
+	want := []*awswafv2.Tag{
+		{Key: aws.String("a"), Value: aws.String("1")},
+		{Key: aws.String("b"), Value: aws.String("2")},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(awswafv2.Tag{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package concurrency configures how many managed resources of an API group
+// or kind are reconciled concurrently.
+package concurrency
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const controllerPrefix = "managed/"
+
+// Overrides of the number of concurrent reconciles, keyed by lower cased API
+// group or <kind>.<group>.
+type Overrides map[string]int
+
+// Parse parses overrides of the form <group>=<n> or <kind>.<group>=<n>, for
+// example ec2.aws.crossplane.io=20 or Bucket.s3.aws.crossplane.io=50.
+func Parse(specs []string) (Overrides, error) {
+	o := Overrides{}
+	for _, s := range specs {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid concurrency %q: must be <kind>.<group>=<n> or <group>=<n>", s)
+		}
+		n, err := strconv.Atoi(kv[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid concurrency %q", s)
+		}
+		if n <= 0 {
+			return nil, errors.Errorf("invalid concurrency %q: must be positive", s)
+		}
+		o[strings.ToLower(kv[0])] = n
+	}
+	return o, nil
+}
+
+// For returns the number of concurrent reconciles of the controller with the
+// supplied name, as returned by managed.ControllerName. An override of the
+// kind takes precedence over one of its API group. def is returned if
+// neither is overridden.
+func (o Overrides) For(name string, def int) int {
+	gk := strings.ToLower(strings.TrimPrefix(name, controllerPrefix))
+	if n, ok := o[gk]; ok {
+		return n
+	}
+	if i := strings.Index(gk, "."); i >= 0 {
+		if n, ok := o[gk[i+1:]]; ok {
+			return n
+		}
+	}
+	return def
+}
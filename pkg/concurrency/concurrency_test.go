@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package concurrency
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParse(t *testing.T) {
+	type want struct {
+		o   Overrides
+		err bool
+	}
+	cases := map[string]struct {
+		specs []string
+		want  want
+	}{
+		"None": {
+			want: want{o: Overrides{}},
+		},
+		"GroupAndKind": {
+			specs: []string{"ec2.aws.crossplane.io=20", "Bucket.s3.aws.crossplane.io=50"},
+			want: want{o: Overrides{
+				"ec2.aws.crossplane.io":       20,
+				"bucket.s3.aws.crossplane.io": 50,
+			}},
+		},
+		"MissingNumber": {
+			specs: []string{"ec2.aws.crossplane.io"},
+			want:  want{err: true},
+		},
+		"MissingKey": {
+			specs: []string{"=5"},
+			want:  want{err: true},
+		},
+		"InvalidNumber": {
+			specs: []string{"ec2.aws.crossplane.io=many"},
+			want:  want{err: true},
+		},
+		"NotPositive": {
+			specs: []string{"ec2.aws.crossplane.io=0"},
+			want:  want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := Parse(tc.specs)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Parse(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Parse(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFor(t *testing.T) {
+	o := Overrides{
+		"ec2.aws.crossplane.io":               20,
+		"securitygroup.ec2.aws.crossplane.io": 50,
+	}
+	cases := map[string]struct {
+		name string
+		want int
+	}{
+		"Group":              {name: "managed/vpc.ec2.aws.crossplane.io", want: 20},
+		"KindOverridesGroup": {name: "managed/securitygroup.ec2.aws.crossplane.io", want: 50},
+		"NotOverridden":      {name: "managed/bucket.s3.aws.crossplane.io", want: 10},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, o.For(tc.name, 10)); diff != "" {
+				t.Errorf("For(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
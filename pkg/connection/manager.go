@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package connection publishes connection details to the secret store
+// configured by a StoreConfig, including secret stores in AWS.
+package connection
+
+import (
+	"context"
+
+	svcsecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
+	svcssm "github.com/aws/aws-sdk-go/service/ssm"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/connection/store/awsstore"
+)
+
+// Error strings.
+const (
+	errConnectStore      = "cannot connect to secret store"
+	errWriteStore        = "cannot write to secret store"
+	errReadStore         = "cannot read from secret store"
+	errDeleteFromStore   = "cannot delete from secret store"
+	errGetStoreConfig    = "cannot get store config"
+	errGetProviderConfig = "cannot get referenced ProviderConfig"
+	errNoProviderConfig  = "providerConfigRef of the AWS secret store cannot be empty"
+
+	errFmtUnknownAWSSecretStore = "unknown AWS secret store service: %q"
+)
+
+// A StoreConfig configures a secret store that may be in AWS.
+type StoreConfig interface {
+	connection.StoreConfig
+
+	GetAWSStoreConfig() *v1alpha1.AWSSecretStoreConfig
+}
+
+// An AWSStoreBuilderFn builds and returns the Store configured by the
+// supplied AWS secret store config.
+type AWSStoreBuilderFn func(ctx context.Context, local client.Client, defaultScope string, cfg *v1alpha1.AWSSecretStoreConfig) (connection.Store, error)
+
+// A DetailsManagerOption configures a DetailsManager.
+type DetailsManagerOption func(*DetailsManager)
+
+// WithAWSStoreBuilder configures the AWSStoreBuilder to use.
+func WithAWSStoreBuilder(sb AWSStoreBuilderFn) DetailsManagerOption {
+	return func(m *DetailsManager) {
+		m.awsStoreBuilder = sb
+	}
+}
+
+// WithRuntimeDetailsManagerOptions configures the DetailsManager that
+// publishes connection details to secret stores that are not in AWS.
+func WithRuntimeDetailsManagerOptions(o ...connection.DetailsManagerOption) DetailsManagerOption {
+	return func(m *DetailsManager) {
+		m.runtimeOptions = append(m.runtimeOptions, o...)
+	}
+}
+
+// A DetailsManager publishes connection details to AWS Secrets Manager or
+// SSM Parameter Store if the StoreConfig a resource publishes to configures
+// an AWS secret store, and to the secret stores supported by
+// crossplane-runtime otherwise.
+type DetailsManager struct {
+	client          client.Client
+	newConfig       func() StoreConfig
+	awsStoreBuilder AWSStoreBuilderFn
+	runtimeOptions  []connection.DetailsManagerOption
+	runtime         *connection.DetailsManager
+}
+
+// NewDetailsManager returns a new connection DetailsManager.
+func NewDetailsManager(c client.Client, of schema.GroupVersionKind, o ...DetailsManagerOption) *DetailsManager {
+	nc := func() StoreConfig {
+		return resource.MustCreateObject(of, c.Scheme()).(StoreConfig)
+	}
+
+	// Panic early if we've been asked to use a StoreConfig kind that has
+	// not been registered with our controller manager's scheme.
+	_ = nc()
+
+	m := &DetailsManager{
+		client:          c,
+		newConfig:       nc,
+		awsStoreBuilder: AWSStoreBuilder,
+	}
+
+	for _, mo := range o {
+		mo(m)
+	}
+	m.runtime = connection.NewDetailsManager(c, of, m.runtimeOptions...)
+
+	return m
+}
+
+// PublishConnection publishes the supplied ConnectionDetails to a secret on
+// the configured connection Store.
+func (m *DetailsManager) PublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, conn managed.ConnectionDetails) (bool, error) {
+	// This resource does not want to expose a connection secret.
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return false, nil
+	}
+
+	ss, err := m.connectAWSStore(ctx, p)
+	if err != nil {
+		return false, errors.Wrap(err, errConnectStore)
+	}
+	if ss == nil {
+		return m.runtime.PublishConnection(ctx, so, conn)
+	}
+
+	changed, err := ss.WriteKeyValues(ctx, store.NewSecret(so, store.KeyValues(conn)), connection.SecretToWriteMustBeOwnedBy(so))
+	return changed, errors.Wrap(err, errWriteStore)
+}
+
+// UnpublishConnection deletes connection details secret to the configured
+// connection Store.
+func (m *DetailsManager) UnpublishConnection(ctx context.Context, so resource.ConnectionSecretOwner, conn managed.ConnectionDetails) error {
+	// This resource didn't expose a connection secret.
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return nil
+	}
+
+	ss, err := m.connectAWSStore(ctx, p)
+	if err != nil {
+		return errors.Wrap(err, errConnectStore)
+	}
+	if ss == nil {
+		return m.runtime.UnpublishConnection(ctx, so, conn)
+	}
+
+	return errors.Wrap(ss.DeleteKeyValues(ctx, store.NewSecret(so, store.KeyValues(conn)), connection.SecretToDeleteMustBeOwnedBy(so)), errDeleteFromStore)
+}
+
+// FetchConnection fetches connection details of a given ConnectionSecretOwner.
+func (m *DetailsManager) FetchConnection(ctx context.Context, so resource.ConnectionSecretOwner) (managed.ConnectionDetails, error) {
+	// This resource does not want to expose a connection secret.
+	p := so.GetPublishConnectionDetailsTo()
+	if p == nil {
+		return nil, nil
+	}
+
+	ss, err := m.connectAWSStore(ctx, p)
+	if err != nil {
+		return nil, errors.Wrap(err, errConnectStore)
+	}
+	if ss == nil {
+		return m.runtime.FetchConnection(ctx, so)
+	}
+
+	s := &store.Secret{}
+	return managed.ConnectionDetails(s.Data), errors.Wrap(ss.ReadKeyValues(ctx, store.ScopedName{Name: p.Name, Scope: so.GetNamespace()}, s), errReadStore)
+}
+
+// connectAWSStore returns the AWS secret store the supplied StoreConfig
+// configures, or nil if it configures a store that is not in AWS.
+func (m *DetailsManager) connectAWSStore(ctx context.Context, p *xpv1.PublishConnectionDetailsTo) (connection.Store, error) {
+	sc := m.newConfig()
+	if err := m.client.Get(ctx, types.NamespacedName{Name: p.SecretStoreConfigRef.Name}, sc); err != nil {
+		return nil, errors.Wrap(err, errGetStoreConfig)
+	}
+	cfg := sc.GetAWSStoreConfig()
+	if cfg == nil {
+		return nil, nil
+	}
+	return m.awsStoreBuilder(ctx, m.client, sc.GetStoreConfig().DefaultScope, cfg)
+}
+
+// AWSStoreBuilder builds and returns a Secrets Manager or Parameter Store
+// Store that uses the credentials of the ProviderConfig referenced by the
+// supplied config.
+func AWSStoreBuilder(ctx context.Context, local client.Client, defaultScope string, cfg *v1alpha1.AWSSecretStoreConfig) (connection.Store, error) {
+	if cfg.ProviderConfigReference == nil {
+		return nil, errors.New(errNoProviderConfig)
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := local.Get(ctx, types.NamespacedName{Name: cfg.ProviderConfigReference.Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetProviderConfig)
+	}
+	sess, err := awsclients.UseProviderConfigCredentialsV1(ctx, local, pc, cfg.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	switch cfg.Service {
+	case v1alpha1.AWSSecretStoreSecretsManager, "":
+		return awsstore.NewSecretsManagerStore(svcsecretsmanager.New(sess), defaultScope, cfg.KMSKeyID), nil
+	case v1alpha1.AWSSecretStoreParameterStore:
+		return awsstore.NewParameterStore(svcssm.New(sess), defaultScope, cfg.KMSKeyID), nil
+	}
+	return nil, errors.Errorf(errFmtUnknownAWSSecretStore, cfg.Service)
+}
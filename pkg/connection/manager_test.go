@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	resourcefake "github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+)
+
+var errBoom = errors.New("boom")
+
+func TestPublishConnection(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	// written returns a store that records the name of the store each
+	// connection secret was written to.
+	written := func(to *string, storeName string) *fake.SecretStore {
+		return &fake.SecretStore{
+			WriteKeyValuesFn: func(_ context.Context, _ *store.Secret, _ ...store.WriteOption) (bool, error) {
+				*to = storeName
+				return true, nil
+			},
+		}
+	}
+
+	type want struct {
+		store     string
+		published bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		spec v1alpha1.StoreConfigSpec
+		get  error
+		want want
+	}{
+		"AWS": {
+			spec: v1alpha1.StoreConfigSpec{
+				AWS: &v1alpha1.AWSSecretStoreConfig{Service: v1alpha1.AWSSecretStoreSecretsManager, Region: "us-east-1"},
+			},
+			want: want{store: "aws", published: true},
+		},
+		"Runtime": {
+			spec: v1alpha1.StoreConfigSpec{
+				SecretStoreConfig: xpv1.SecretStoreConfig{Type: secretStoreType(xpv1.SecretStoreVault)},
+			},
+			want: want{store: "runtime", published: true},
+		},
+		"GetStoreConfigError": {
+			get:  errBoom,
+			want: want{err: errors.Wrap(errors.Wrap(errBoom, errGetStoreConfig), errConnectStore)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					if tc.get != nil {
+						return tc.get
+					}
+					obj.(*v1alpha1.StoreConfig).Spec = tc.spec
+					return nil
+				},
+				MockScheme: test.NewMockSchemeFn(s),
+			}
+
+			got := ""
+			m := NewDetailsManager(kube, v1alpha1.StoreConfigGroupVersionKind,
+				WithAWSStoreBuilder(func(_ context.Context, _ client.Client, _ string, _ *v1alpha1.AWSSecretStoreConfig) (connection.Store, error) {
+					return written(&got, "aws"), nil
+				}),
+				WithRuntimeDetailsManagerOptions(connection.WithStoreBuilder(func(_ context.Context, _ client.Client, _ xpv1.SecretStoreConfig) (connection.Store, error) {
+					return written(&got, "runtime"), nil
+				})),
+			)
+
+			mg := &resourcefake.Managed{}
+			mg.SetPublishConnectionDetailsTo(&xpv1.PublishConnectionDetailsTo{Name: "db", SecretStoreConfigRef: &xpv1.Reference{Name: "default"}})
+
+			published, err := m.PublishConnection(context.Background(), mg, managed.ConnectionDetails{"password": []byte("secret")})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("PublishConnection(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.published, published); diff != "" {
+				t.Errorf("PublishConnection(...): -want published, +got published:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.store, got); diff != "" {
+				t.Errorf("PublishConnection(...): -want store, +got store:\n%s", diff)
+			}
+		})
+	}
+}
+
+func secretStoreType(t xpv1.SecretStoreType) *xpv1.SecretStoreType {
+	return &t
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsstore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errGetParameter    = "cannot get parameter"
+	errListTags        = "cannot list parameter tags"
+	errPutParameter    = "cannot put parameter"
+	errTagParameter    = "cannot tag parameter"
+	errUntagParameter  = "cannot untag parameter"
+	errDeleteParameter = "cannot delete parameter"
+)
+
+// A ParameterStoreClient is the subset of the SSM API used by a
+// ParameterStore.
+type ParameterStoreClient interface {
+	GetParameterWithContext(ctx aws.Context, input *svcsdk.GetParameterInput, opts ...request.Option) (*svcsdk.GetParameterOutput, error)
+	ListTagsForResourceWithContext(ctx aws.Context, input *svcsdk.ListTagsForResourceInput, opts ...request.Option) (*svcsdk.ListTagsForResourceOutput, error)
+	PutParameterWithContext(ctx aws.Context, input *svcsdk.PutParameterInput, opts ...request.Option) (*svcsdk.PutParameterOutput, error)
+	AddTagsToResourceWithContext(ctx aws.Context, input *svcsdk.AddTagsToResourceInput, opts ...request.Option) (*svcsdk.AddTagsToResourceOutput, error)
+	RemoveTagsFromResourceWithContext(ctx aws.Context, input *svcsdk.RemoveTagsFromResourceInput, opts ...request.Option) (*svcsdk.RemoveTagsFromResourceOutput, error)
+	DeleteParameterWithContext(ctx aws.Context, input *svcsdk.DeleteParameterInput, opts ...request.Option) (*svcsdk.DeleteParameterOutput, error)
+}
+
+// A ParameterStore stores each connection secret as an SSM Parameter Store
+// SecureString parameter whose value is a JSON object of the connection
+// details, and whose tags are the labels of the connection secret. Parameters
+// use the Intelligent-Tiering tier, so that connection details larger than
+// the standard tier allows are stored as advanced parameters.
+type ParameterStore struct {
+	client       ParameterStoreClient
+	defaultScope string
+	kmsKeyID     *string
+}
+
+// NewParameterStore returns a ParameterStore that scopes the parameters of
+// cluster scoped resources by the supplied default scope, and encrypts
+// parameters with the supplied KMS key, if any.
+func NewParameterStore(c ParameterStoreClient, defaultScope string, kmsKeyID *string) *ParameterStore {
+	return &ParameterStore{client: c, defaultScope: defaultScope, kmsKeyID: kmsKeyID}
+}
+
+// ReadKeyValues reads the supplied secret from Parameter Store. Parameters
+// that do not exist are read as empty.
+func (ps *ParameterStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	current, err := ps.get(ctx, ps.name(n))
+	if err != nil {
+		return err
+	}
+	s.ScopedName = n
+	if current != nil {
+		s.Data = current.Data
+		s.Metadata = current.Metadata
+	}
+	return nil
+}
+
+// WriteKeyValues writes the supplied secret to Parameter Store, creating the
+// parameter if it does not exist.
+func (ps *ParameterStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) { // nolint:gocyclo
+	n := ps.name(s.ScopedName)
+	current, err := ps.get(ctx, n)
+	if err != nil {
+		return false, err
+	}
+	if current == nil {
+		value, err := encode(s.Data)
+		if err != nil {
+			return false, err
+		}
+		// Tags cannot be supplied when overwriting a parameter.
+		_, err = ps.client.PutParameterWithContext(ctx, ps.putInput(n, value, parameterTags(s.GetLabels()), false))
+		return err == nil, errors.Wrap(err, errPutParameter)
+	}
+
+	for _, o := range wo {
+		if err := o(ctx, current, s); err != nil {
+			return false, err
+		}
+	}
+
+	changed := false
+	if !cmp.Equal(current.Data, s.Data, cmpopts.EquateEmpty()) {
+		value, err := encode(s.Data)
+		if err != nil {
+			return false, err
+		}
+		if _, err := ps.client.PutParameterWithContext(ctx, ps.putInput(n, value, nil, true)); err != nil {
+			return false, errors.Wrap(err, errPutParameter)
+		}
+		changed = true
+	}
+
+	add, remove := diffLabels(current.GetLabels(), s.GetLabels())
+	if len(add) > 0 {
+		if _, err := ps.client.AddTagsToResourceWithContext(ctx, &svcsdk.AddTagsToResourceInput{
+			ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(n),
+			Tags:         parameterTags(add),
+		}); err != nil {
+			return false, errors.Wrap(err, errTagParameter)
+		}
+		changed = true
+	}
+	if len(remove) > 0 {
+		if _, err := ps.client.RemoveTagsFromResourceWithContext(ctx, &svcsdk.RemoveTagsFromResourceInput{
+			ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+			ResourceId:   aws.String(n),
+			TagKeys:      aws.StringSlice(remove),
+		}); err != nil {
+			return false, errors.Wrap(err, errUntagParameter)
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// DeleteKeyValues deletes the keys of the supplied secret from Parameter
+// Store. The whole parameter is deleted if no keys are supplied, or if no keys
+// are left.
+func (ps *ParameterStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	n := ps.name(s.ScopedName)
+	current, err := ps.get(ctx, n)
+	if err != nil || current == nil {
+		return err
+	}
+
+	for _, o := range do {
+		if err := o(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for k := range s.Data {
+		delete(current.Data, k)
+	}
+	if len(s.Data) == 0 || len(current.Data) == 0 {
+		_, err := ps.client.DeleteParameterWithContext(ctx, &svcsdk.DeleteParameterInput{Name: aws.String(n)})
+		return errors.Wrap(resource.Ignore(isParameterNotFound, err), errDeleteParameter)
+	}
+	value, err := encode(current.Data)
+	if err != nil {
+		return err
+	}
+	_, err = ps.client.PutParameterWithContext(ctx, ps.putInput(n, value, nil, true))
+	return errors.Wrap(err, errPutParameter)
+}
+
+// name returns the fully qualified name of the parameter of the supplied
+// secret.
+func (ps *ParameterStore) name(n store.ScopedName) string {
+	return "/" + name(n, ps.defaultScope)
+}
+
+// get returns the named parameter, or nil if it does not exist.
+func (ps *ParameterStore) get(ctx context.Context, n string) (*store.Secret, error) {
+	p, err := ps.client.GetParameterWithContext(ctx, &svcsdk.GetParameterInput{Name: aws.String(n), WithDecryption: aws.Bool(true)})
+	if isParameterNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, errGetParameter)
+	}
+
+	t, err := ps.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceType: aws.String(svcsdk.ResourceTypeForTaggingParameter),
+		ResourceId:   aws.String(n),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListTags)
+	}
+
+	s := &store.Secret{}
+	if len(t.TagList) > 0 {
+		s.Metadata = &v1.ConnectionSecretMetadata{Labels: make(map[string]string, len(t.TagList))}
+		for _, tag := range t.TagList {
+			s.Metadata.Labels[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+	}
+	if p.Parameter != nil {
+		s.Data, err = decode(aws.StringValue(p.Parameter.Value))
+	}
+	return s, err
+}
+
+func (ps *ParameterStore) putInput(n, value string, tags []*svcsdk.Tag, overwrite bool) *svcsdk.PutParameterInput {
+	in := &svcsdk.PutParameterInput{
+		Name:  aws.String(n),
+		Value: aws.String(value),
+		Type:  aws.String(svcsdk.ParameterTypeSecureString),
+		Tier:  aws.String(svcsdk.ParameterTierIntelligentTiering),
+		KeyId: ps.kmsKeyID,
+		Tags:  tags,
+	}
+	if overwrite {
+		in.Overwrite = aws.Bool(true)
+	}
+	return in
+}
+
+func parameterTags(labels map[string]string) []*svcsdk.Tag {
+	if len(labels) == 0 {
+		return nil
+	}
+	tags := make([]*svcsdk.Tag, 0, len(labels))
+	for _, k := range sortedKeys(labels) {
+		tags = append(tags, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(labels[k])})
+	}
+	return tags
+}
+
+func isParameterNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == svcsdk.ErrCodeParameterNotFound
+}
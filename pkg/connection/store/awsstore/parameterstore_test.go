@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type mockParameterStore struct {
+	ParameterStoreClient
+
+	value *string
+	tags  []*svcsdk.Tag
+	calls []string
+	puts  []*svcsdk.PutParameterInput
+}
+
+func (m *mockParameterStore) GetParameterWithContext(_ aws.Context, in *svcsdk.GetParameterInput, _ ...request.Option) (*svcsdk.GetParameterOutput, error) {
+	if m.value == nil {
+		return nil, awserr.New(svcsdk.ErrCodeParameterNotFound, "", nil)
+	}
+	return &svcsdk.GetParameterOutput{Parameter: &svcsdk.Parameter{Name: in.Name, Value: m.value}}, nil
+}
+
+func (m *mockParameterStore) ListTagsForResourceWithContext(_ aws.Context, _ *svcsdk.ListTagsForResourceInput, _ ...request.Option) (*svcsdk.ListTagsForResourceOutput, error) {
+	return &svcsdk.ListTagsForResourceOutput{TagList: m.tags}, nil
+}
+
+func (m *mockParameterStore) PutParameterWithContext(_ aws.Context, in *svcsdk.PutParameterInput, _ ...request.Option) (*svcsdk.PutParameterOutput, error) {
+	m.calls = append(m.calls, "PutParameter")
+	m.puts = append(m.puts, in)
+	return &svcsdk.PutParameterOutput{}, nil
+}
+
+func (m *mockParameterStore) AddTagsToResourceWithContext(_ aws.Context, _ *svcsdk.AddTagsToResourceInput, _ ...request.Option) (*svcsdk.AddTagsToResourceOutput, error) {
+	m.calls = append(m.calls, "AddTagsToResource")
+	return &svcsdk.AddTagsToResourceOutput{}, nil
+}
+
+func (m *mockParameterStore) RemoveTagsFromResourceWithContext(_ aws.Context, _ *svcsdk.RemoveTagsFromResourceInput, _ ...request.Option) (*svcsdk.RemoveTagsFromResourceOutput, error) {
+	m.calls = append(m.calls, "RemoveTagsFromResource")
+	return &svcsdk.RemoveTagsFromResourceOutput{}, nil
+}
+
+func (m *mockParameterStore) DeleteParameterWithContext(_ aws.Context, _ *svcsdk.DeleteParameterInput, _ ...request.Option) (*svcsdk.DeleteParameterOutput, error) {
+	m.calls = append(m.calls, "DeleteParameter")
+	return &svcsdk.DeleteParameterOutput{}, nil
+}
+
+func TestParameterStoreWriteKeyValues(t *testing.T) {
+	ownerTags := []*svcsdk.Tag{{Key: aws.String(ownerLabel), Value: aws.String(owner)}}
+
+	type want struct {
+		changed bool
+		calls   []string
+		puts    []*svcsdk.PutParameterInput
+		err     error
+	}
+
+	cases := map[string]struct {
+		client *mockParameterStore
+		secret *store.Secret
+		want   want
+	}{
+		"Create": {
+			client: &mockParameterStore{},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				changed: true,
+				calls:   []string{"PutParameter"},
+				puts: []*svcsdk.PutParameterInput{{
+					Name:  aws.String("/crossplane-system/db"),
+					Value: aws.String(`{"password":"secret"}`),
+					Type:  aws.String(svcsdk.ParameterTypeSecureString),
+					Tier:  aws.String(svcsdk.ParameterTierIntelligentTiering),
+					KeyId: aws.String("alias/connection"),
+					Tags:  ownerTags,
+				}},
+			},
+		},
+		"Unchanged": {
+			client: &mockParameterStore{value: aws.String(`{"password":"secret"}`), tags: ownerTags},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{},
+		},
+		"Update": {
+			client: &mockParameterStore{value: aws.String(`{"password":"old"}`), tags: ownerTags},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				changed: true,
+				calls:   []string{"PutParameter"},
+				puts: []*svcsdk.PutParameterInput{{
+					Name:      aws.String("/crossplane-system/db"),
+					Value:     aws.String(`{"password":"secret"}`),
+					Type:      aws.String(svcsdk.ParameterTypeSecureString),
+					Tier:      aws.String(svcsdk.ParameterTierIntelligentTiering),
+					KeyId:     aws.String("alias/connection"),
+					Overwrite: aws.Bool(true),
+				}},
+			},
+		},
+		"NotOwned": {
+			client: &mockParameterStore{value: aws.String(`{"password":"old"}`)},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ps := NewParameterStore(tc.client, "crossplane-system", aws.String("alias/connection"))
+			changed, err := ps.WriteKeyValues(context.Background(), tc.secret, ownedBy(owner))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.client.calls, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want calls, +got calls:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.puts, tc.client.puts, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(svcsdk.PutParameterInput{}, svcsdk.Tag{})); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want input, +got input:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParameterStoreDeleteKeyValues(t *testing.T) {
+	cases := map[string]struct {
+		client *mockParameterStore
+		secret *store.Secret
+		want   []string
+	}{
+		"NotFound": {
+			client: &mockParameterStore{},
+			secret: ownedSecret(nil),
+		},
+		"Delete": {
+			client: &mockParameterStore{value: aws.String(`{"password":"secret"}`)},
+			secret: ownedSecret(nil),
+			want:   []string{"DeleteParameter"},
+		},
+		"DeleteKeys": {
+			client: &mockParameterStore{value: aws.String(`{"password":"secret","username":"admin"}`)},
+			secret: ownedSecret(store.KeyValues{"password": nil}),
+			want:   []string{"PutParameter"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ps := NewParameterStore(tc.client, "crossplane-system", nil)
+			if err := ps.DeleteKeyValues(context.Background(), tc.secret); err != nil {
+				t.Errorf("DeleteKeyValues(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want, tc.client.calls, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("DeleteKeyValues(...): -want calls, +got calls:\n%s", diff)
+			}
+		})
+	}
+}
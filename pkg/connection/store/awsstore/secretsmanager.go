@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsstore
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Error strings.
+const (
+	errDescribeSecret = "cannot describe secret"
+	errGetSecretValue = "cannot get secret value"
+	errCreateSecret   = "cannot create secret"
+	errRestoreSecret  = "cannot restore secret that is scheduled for deletion"
+	errPutSecretValue = "cannot put secret value"
+	errTagSecret      = "cannot tag secret"
+	errUntagSecret    = "cannot untag secret"
+	errDeleteSecret   = "cannot delete secret"
+)
+
+// A SecretsManagerClient is the subset of the Secrets Manager API used by a
+// SecretsManagerStore.
+type SecretsManagerClient interface {
+	DescribeSecretWithContext(ctx aws.Context, input *svcsdk.DescribeSecretInput, opts ...request.Option) (*svcsdk.DescribeSecretOutput, error)
+	GetSecretValueWithContext(ctx aws.Context, input *svcsdk.GetSecretValueInput, opts ...request.Option) (*svcsdk.GetSecretValueOutput, error)
+	CreateSecretWithContext(ctx aws.Context, input *svcsdk.CreateSecretInput, opts ...request.Option) (*svcsdk.CreateSecretOutput, error)
+	RestoreSecretWithContext(ctx aws.Context, input *svcsdk.RestoreSecretInput, opts ...request.Option) (*svcsdk.RestoreSecretOutput, error)
+	PutSecretValueWithContext(ctx aws.Context, input *svcsdk.PutSecretValueInput, opts ...request.Option) (*svcsdk.PutSecretValueOutput, error)
+	TagResourceWithContext(ctx aws.Context, input *svcsdk.TagResourceInput, opts ...request.Option) (*svcsdk.TagResourceOutput, error)
+	UntagResourceWithContext(ctx aws.Context, input *svcsdk.UntagResourceInput, opts ...request.Option) (*svcsdk.UntagResourceOutput, error)
+	DeleteSecretWithContext(ctx aws.Context, input *svcsdk.DeleteSecretInput, opts ...request.Option) (*svcsdk.DeleteSecretOutput, error)
+}
+
+// A SecretsManagerStore stores each connection secret as a Secrets Manager
+// secret whose value is a JSON object of the connection details, and whose
+// tags are the labels of the connection secret.
+type SecretsManagerStore struct {
+	client       SecretsManagerClient
+	defaultScope string
+	kmsKeyID     *string
+}
+
+// NewSecretsManagerStore returns a SecretsManagerStore that scopes the
+// secrets of cluster scoped resources by the supplied default scope, and
+// encrypts secrets it creates with the supplied KMS key, if any.
+func NewSecretsManagerStore(c SecretsManagerClient, defaultScope string, kmsKeyID *string) *SecretsManagerStore {
+	return &SecretsManagerStore{client: c, defaultScope: defaultScope, kmsKeyID: kmsKeyID}
+}
+
+// ReadKeyValues reads the supplied secret from Secrets Manager. Secrets that
+// do not exist or are scheduled for deletion are read as empty.
+func (ss *SecretsManagerStore) ReadKeyValues(ctx context.Context, n store.ScopedName, s *store.Secret) error {
+	current, _, err := ss.get(ctx, name(n, ss.defaultScope))
+	if err != nil {
+		return err
+	}
+	s.ScopedName = n
+	if current != nil {
+		s.Data = current.Data
+		s.Metadata = current.Metadata
+	}
+	return nil
+}
+
+// WriteKeyValues writes the supplied secret to Secrets Manager, creating it
+// if it does not exist and restoring it if it is scheduled for deletion.
+func (ss *SecretsManagerStore) WriteKeyValues(ctx context.Context, s *store.Secret, wo ...store.WriteOption) (bool, error) { // nolint:gocyclo
+	n := name(s.ScopedName, ss.defaultScope)
+	current, deleted, err := ss.get(ctx, n)
+	if err != nil {
+		return false, err
+	}
+	if current == nil {
+		value, err := encode(s.Data)
+		if err != nil {
+			return false, err
+		}
+		_, err = ss.client.CreateSecretWithContext(ctx, &svcsdk.CreateSecretInput{
+			Name:         aws.String(n),
+			SecretString: aws.String(value),
+			KmsKeyId:     ss.kmsKeyID,
+			Tags:         secretTags(s.GetLabels()),
+		})
+		return err == nil, errors.Wrap(err, errCreateSecret)
+	}
+
+	for _, o := range wo {
+		if err := o(ctx, current, s); err != nil {
+			return false, err
+		}
+	}
+
+	changed := false
+	if deleted {
+		if _, err := ss.client.RestoreSecretWithContext(ctx, &svcsdk.RestoreSecretInput{SecretId: aws.String(n)}); err != nil {
+			return false, errors.Wrap(err, errRestoreSecret)
+		}
+		changed = true
+	}
+	if deleted || !cmp.Equal(current.Data, s.Data, cmpopts.EquateEmpty()) {
+		value, err := encode(s.Data)
+		if err != nil {
+			return false, err
+		}
+		if _, err := ss.client.PutSecretValueWithContext(ctx, &svcsdk.PutSecretValueInput{SecretId: aws.String(n), SecretString: aws.String(value)}); err != nil {
+			return false, errors.Wrap(err, errPutSecretValue)
+		}
+		changed = true
+	}
+
+	add, remove := diffLabels(current.GetLabels(), s.GetLabels())
+	if len(add) > 0 {
+		if _, err := ss.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{SecretId: aws.String(n), Tags: secretTags(add)}); err != nil {
+			return false, errors.Wrap(err, errTagSecret)
+		}
+		changed = true
+	}
+	if len(remove) > 0 {
+		if _, err := ss.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{SecretId: aws.String(n), TagKeys: aws.StringSlice(remove)}); err != nil {
+			return false, errors.Wrap(err, errUntagSecret)
+		}
+		changed = true
+	}
+	return changed, nil
+}
+
+// DeleteKeyValues deletes the keys of the supplied secret from Secrets
+// Manager. The whole secret is deleted if no keys are supplied, or if no keys
+// are left. Secrets are deleted without a recovery window, so that they can be
+// recreated immediately.
+func (ss *SecretsManagerStore) DeleteKeyValues(ctx context.Context, s *store.Secret, do ...store.DeleteOption) error {
+	n := name(s.ScopedName, ss.defaultScope)
+	current, deleted, err := ss.get(ctx, n)
+	if err != nil {
+		return err
+	}
+	if current == nil || deleted {
+		return nil
+	}
+
+	for _, o := range do {
+		if err := o(ctx, current); err != nil {
+			return err
+		}
+	}
+
+	for k := range s.Data {
+		delete(current.Data, k)
+	}
+	if len(s.Data) == 0 || len(current.Data) == 0 {
+		_, err := ss.client.DeleteSecretWithContext(ctx, &svcsdk.DeleteSecretInput{SecretId: aws.String(n), ForceDeleteWithoutRecovery: aws.Bool(true)})
+		return errors.Wrap(resource.Ignore(isSecretNotFound, err), errDeleteSecret)
+	}
+	value, err := encode(current.Data)
+	if err != nil {
+		return err
+	}
+	_, err = ss.client.PutSecretValueWithContext(ctx, &svcsdk.PutSecretValueInput{SecretId: aws.String(n), SecretString: aws.String(value)})
+	return errors.Wrap(err, errPutSecretValue)
+}
+
+// get returns the named secret and whether it is scheduled for deletion, or
+// nil if it does not exist.
+func (ss *SecretsManagerStore) get(ctx context.Context, n string) (*store.Secret, bool, error) {
+	d, err := ss.client.DescribeSecretWithContext(ctx, &svcsdk.DescribeSecretInput{SecretId: aws.String(n)})
+	if isSecretNotFound(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, errDescribeSecret)
+	}
+
+	s := &store.Secret{}
+	if len(d.Tags) > 0 {
+		s.Metadata = &v1.ConnectionSecretMetadata{Labels: make(map[string]string, len(d.Tags))}
+		for _, t := range d.Tags {
+			s.Metadata.Labels[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+		}
+	}
+	if d.DeletedDate != nil {
+		return s, true, nil
+	}
+
+	v, err := ss.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{SecretId: aws.String(n)})
+	if isSecretNotFound(err) {
+		// The secret has no value yet.
+		return s, false, nil
+	}
+	if err != nil {
+		return nil, false, errors.Wrap(err, errGetSecretValue)
+	}
+	s.Data, err = decode(aws.StringValue(v.SecretString))
+	return s, false, err
+}
+
+func secretTags(labels map[string]string) []*svcsdk.Tag {
+	if len(labels) == 0 {
+		return nil
+	}
+	tags := make([]*svcsdk.Tag, 0, len(labels))
+	for _, k := range sortedKeys(labels) {
+		tags = append(tags, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(labels[k])})
+	}
+	return tags
+}
+
+func isSecretNotFound(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,308 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsstore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+const (
+	ownerLabel = "secret.crossplane.io/owner-uid"
+	owner      = "owner"
+)
+
+var errBoom = errors.New("boom")
+
+type mockSecretsManager struct {
+	SecretsManagerClient
+
+	describe *svcsdk.DescribeSecretOutput
+	value    *string
+	calls    []string
+	puts     []string
+}
+
+func (m *mockSecretsManager) DescribeSecretWithContext(_ aws.Context, _ *svcsdk.DescribeSecretInput, _ ...request.Option) (*svcsdk.DescribeSecretOutput, error) {
+	if m.describe == nil {
+		return nil, awserr.New(svcsdk.ErrCodeResourceNotFoundException, "", nil)
+	}
+	return m.describe, nil
+}
+
+func (m *mockSecretsManager) GetSecretValueWithContext(_ aws.Context, _ *svcsdk.GetSecretValueInput, _ ...request.Option) (*svcsdk.GetSecretValueOutput, error) {
+	return &svcsdk.GetSecretValueOutput{SecretString: m.value}, nil
+}
+
+func (m *mockSecretsManager) CreateSecretWithContext(_ aws.Context, in *svcsdk.CreateSecretInput, _ ...request.Option) (*svcsdk.CreateSecretOutput, error) {
+	m.calls = append(m.calls, "CreateSecret")
+	m.puts = append(m.puts, aws.StringValue(in.SecretString))
+	return &svcsdk.CreateSecretOutput{}, nil
+}
+
+func (m *mockSecretsManager) RestoreSecretWithContext(_ aws.Context, _ *svcsdk.RestoreSecretInput, _ ...request.Option) (*svcsdk.RestoreSecretOutput, error) {
+	m.calls = append(m.calls, "RestoreSecret")
+	return &svcsdk.RestoreSecretOutput{}, nil
+}
+
+func (m *mockSecretsManager) PutSecretValueWithContext(_ aws.Context, in *svcsdk.PutSecretValueInput, _ ...request.Option) (*svcsdk.PutSecretValueOutput, error) {
+	m.calls = append(m.calls, "PutSecretValue")
+	m.puts = append(m.puts, aws.StringValue(in.SecretString))
+	return &svcsdk.PutSecretValueOutput{}, nil
+}
+
+func (m *mockSecretsManager) TagResourceWithContext(_ aws.Context, _ *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	m.calls = append(m.calls, "TagResource")
+	return &svcsdk.TagResourceOutput{}, nil
+}
+
+func (m *mockSecretsManager) UntagResourceWithContext(_ aws.Context, _ *svcsdk.UntagResourceInput, _ ...request.Option) (*svcsdk.UntagResourceOutput, error) {
+	m.calls = append(m.calls, "UntagResource")
+	return &svcsdk.UntagResourceOutput{}, nil
+}
+
+func (m *mockSecretsManager) DeleteSecretWithContext(_ aws.Context, _ *svcsdk.DeleteSecretInput, _ ...request.Option) (*svcsdk.DeleteSecretOutput, error) {
+	m.calls = append(m.calls, "DeleteSecret")
+	return &svcsdk.DeleteSecretOutput{}, nil
+}
+
+func ownedSecret(data store.KeyValues) *store.Secret {
+	return &store.Secret{
+		ScopedName: store.ScopedName{Name: "db"},
+		Metadata:   &v1.ConnectionSecretMetadata{Labels: map[string]string{ownerLabel: owner}},
+		Data:       data,
+	}
+}
+
+func ownedBy(uid string) store.WriteOption {
+	return func(_ context.Context, current, _ *store.Secret) error {
+		if current.GetOwner() != uid {
+			return errBoom
+		}
+		return nil
+	}
+}
+
+func TestSecretsManagerWriteKeyValues(t *testing.T) {
+	ownerTags := []*svcsdk.Tag{{Key: aws.String(ownerLabel), Value: aws.String(owner)}}
+
+	type want struct {
+		changed bool
+		calls   []string
+		puts    []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		client *mockSecretsManager
+		secret *store.Secret
+		want   want
+	}{
+		"Create": {
+			client: &mockSecretsManager{},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				changed: true,
+				calls:   []string{"CreateSecret"},
+				puts:    []string{`{"password":"secret"}`},
+			},
+		},
+		"Unchanged": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{Tags: ownerTags},
+				value:    aws.String(`{"password":"secret"}`),
+			},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want:   want{},
+		},
+		"Update": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{Tags: ownerTags},
+				value:    aws.String(`{"password":"old"}`),
+			},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				changed: true,
+				calls:   []string{"PutSecretValue"},
+				puts:    []string{`{"password":"secret"}`},
+			},
+		},
+		"Restore": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{Tags: ownerTags, DeletedDate: aws.Time(time.Now())},
+			},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				changed: true,
+				calls:   []string{"RestoreSecret", "PutSecretValue"},
+				puts:    []string{`{"password":"secret"}`},
+			},
+		},
+		"Tags": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{Tags: append(ownerTags, &svcsdk.Tag{Key: aws.String("stale"), Value: aws.String("true")})},
+				value:    aws.String(`{"password":"secret"}`),
+			},
+			secret: &store.Secret{
+				ScopedName: store.ScopedName{Name: "db"},
+				Metadata:   &v1.ConnectionSecretMetadata{Labels: map[string]string{ownerLabel: owner, "team": "data"}},
+				Data:       store.KeyValues{"password": []byte("secret")},
+			},
+			want: want{
+				changed: true,
+				calls:   []string{"TagResource", "UntagResource"},
+			},
+		},
+		"NotOwned": {
+			client: &mockSecretsManager{
+				value:    aws.String(`{"password":"secret"}`),
+				describe: &svcsdk.DescribeSecretOutput{},
+			},
+			secret: ownedSecret(store.KeyValues{"password": []byte("secret")}),
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss := NewSecretsManagerStore(tc.client, "crossplane-system", nil)
+			changed, err := ss.WriteKeyValues(context.Background(), tc.secret, ownedBy(owner))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changed, changed); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want changed, +got changed:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.client.calls, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want calls, +got calls:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.puts, tc.client.puts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("WriteKeyValues(...): -want values, +got values:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretsManagerDeleteKeyValues(t *testing.T) {
+	type want struct {
+		calls []string
+		puts  []string
+	}
+
+	cases := map[string]struct {
+		client *mockSecretsManager
+		secret *store.Secret
+		want   want
+	}{
+		"NotFound": {
+			client: &mockSecretsManager{},
+			secret: ownedSecret(nil),
+			want:   want{},
+		},
+		"Delete": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{},
+				value:    aws.String(`{"password":"secret"}`),
+			},
+			secret: ownedSecret(nil),
+			want: want{
+				calls: []string{"DeleteSecret"},
+			},
+		},
+		"DeleteKeys": {
+			client: &mockSecretsManager{
+				describe: &svcsdk.DescribeSecretOutput{},
+				value:    aws.String(`{"password":"secret","username":"admin"}`),
+			},
+			secret: ownedSecret(store.KeyValues{"password": nil}),
+			want: want{
+				calls: []string{"PutSecretValue"},
+				puts:  []string{`{"username":"admin"}`},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ss := NewSecretsManagerStore(tc.client, "crossplane-system", nil)
+			if err := ss.DeleteKeyValues(context.Background(), tc.secret); err != nil {
+				t.Errorf("DeleteKeyValues(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.calls, tc.client.calls, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("DeleteKeyValues(...): -want calls, +got calls:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.puts, tc.client.puts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("DeleteKeyValues(...): -want values, +got values:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSecretsManagerReadKeyValues(t *testing.T) {
+	ss := NewSecretsManagerStore(&mockSecretsManager{
+		describe: &svcsdk.DescribeSecretOutput{Tags: []*svcsdk.Tag{{Key: aws.String(ownerLabel), Value: aws.String(owner)}}},
+		value:    aws.String(`{"password":"secret"}`),
+	}, "crossplane-system", nil)
+
+	got := &store.Secret{}
+	if err := ss.ReadKeyValues(context.Background(), store.ScopedName{Name: "db"}, got); err != nil {
+		t.Fatalf("ReadKeyValues(...): unexpected error: %s", err)
+	}
+	want := ownedSecret(store.KeyValues{"password": []byte("secret")})
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ReadKeyValues(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestName(t *testing.T) {
+	cases := map[string]struct {
+		n    store.ScopedName
+		want string
+	}{
+		"DefaultScope": {
+			n:    store.ScopedName{Name: "db"},
+			want: "crossplane-system/db",
+		},
+		"Scoped": {
+			n:    store.ScopedName{Name: "db", Scope: "team-a"},
+			want: "team-a/db",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, name(tc.n, "crossplane-system")); diff != "" {
+				t.Errorf("name(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
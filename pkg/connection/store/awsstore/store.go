@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awsstore implements connection secret stores that are backed by AWS
+// Secrets Manager and SSM Parameter Store.
+package awsstore
+
+import (
+	"encoding/json"
+	"path"
+	"sort"
+
+	"github.com/crossplane/crossplane-runtime/pkg/connection/store"
+	"github.com/crossplane/crossplane-runtime/pkg/errors"
+)
+
+// Error strings.
+const (
+	errEncode = "cannot encode connection details"
+	errDecode = "cannot decode connection details"
+)
+
+// name returns the name of the AWS secret or parameter that stores the
+// supplied connection secret. Secrets of cluster scoped resources are scoped
+// by the supplied default scope.
+func name(n store.ScopedName, defaultScope string) string {
+	if n.Scope != "" {
+		return path.Join(n.Scope, n.Name)
+	}
+	return path.Join(defaultScope, n.Name)
+}
+
+// encode returns the supplied key values as a JSON object.
+func encode(kv store.KeyValues) (string, error) {
+	m := make(map[string]string, len(kv))
+	for k, v := range kv {
+		m[k] = string(v)
+	}
+	b, err := json.Marshal(m)
+	return string(b), errors.Wrap(err, errEncode)
+}
+
+// decode returns the key values of the supplied JSON object.
+func decode(s string) (store.KeyValues, error) {
+	m := map[string]string{}
+	if err := json.Unmarshal([]byte(s), &m); err != nil {
+		return nil, errors.Wrap(err, errDecode)
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	kv := make(store.KeyValues, len(m))
+	for k, v := range m {
+		kv[k] = []byte(v)
+	}
+	return kv, nil
+}
+
+// diffLabels returns the labels that must be added to or updated in, and the
+// keys of the labels that must be removed from the current labels to match
+// the desired labels.
+func diffLabels(current, desired map[string]string) (map[string]string, []string) {
+	add := map[string]string{}
+	for k, v := range desired {
+		if cv, ok := current[k]; !ok || cv != v {
+			add[k] = v
+		}
+	}
+	var remove []string
+	for k := range current {
+		if _, ok := desired[k]; !ok {
+			remove = append(remove, k)
+		}
+	}
+	sort.Strings(remove)
+	return add, remove
+}
+
+// sortedKeys returns the keys of the supplied labels in order, so that tags
+// are sent to AWS deterministically.
+func sortedKeys(labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
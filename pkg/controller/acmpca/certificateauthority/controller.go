@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -39,7 +36,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -58,8 +57,11 @@ const (
 )
 
 // SetupCertificateAuthority adds a controller that reconciles ACMPCA.
-func SetupCertificateAuthority(mgr ctrl.Manager, o controller.Options) error {
+func SetupCertificateAuthority(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.CertificateAuthorityGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -68,16 +70,12 @@ func SetupCertificateAuthority(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.CertificateAuthority{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.CertificateAuthorityGroupVersionKind),
+			&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient},
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
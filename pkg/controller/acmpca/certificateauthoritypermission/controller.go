@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -39,7 +36,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/acmpca"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -50,8 +49,11 @@ const (
 )
 
 // SetupCertificateAuthorityPermission adds a controller that reconciles ACMPCA.
-func SetupCertificateAuthorityPermission(mgr ctrl.Manager, o controller.Options) error {
+func SetupCertificateAuthorityPermission(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.CertificateAuthorityPermissionGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -60,17 +62,12 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, o controller.Options)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.CertificateAuthorityPermission{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.CertificateAuthorityPermissionGroupVersionKind),
+			&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient},
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -113,22 +110,26 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 	principal, caARN := nn[0], nn[1]
 
-	response, err := e.client.ListPermissions(ctx, &awsacmpca.ListPermissionsInput{
-		CertificateAuthorityArn: &caARN,
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, token *string) (*string, bool, error) {
+		response, err := e.client.ListPermissions(ctx, &awsacmpca.ListPermissionsInput{
+			CertificateAuthorityArn: &caARN,
+			NextToken:               token,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i := range response.Permissions {
+			if awsclient.StringValue(response.Permissions[i].Principal) == principal {
+				return nil, true, nil
+			}
+		}
+		return response.NextToken, false, nil
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(acmpca.IsErrorNotFound, err), errGet)
 	}
 
-	var attachedPermission *awsacmpcatypes.Permission
-	for i := range response.Permissions {
-		if awsclient.StringValue(response.Permissions[i].Principal) == principal {
-			attachedPermission = &response.Permissions[i]
-			break
-		}
-	}
-
-	if attachedPermission == nil {
+	if !found {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -125,6 +125,40 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PermissionOnLaterPage": {
+			args: args{
+				acmpca: &fake.MockCertificateAuthorityPermissionClient{
+					MockListPermissions: func(ctx context.Context, input *awsacmpca.ListPermissionsInput, opts []func(*awsacmpca.Options)) (*awsacmpca.ListPermissionsOutput, error) {
+						if input.NextToken == nil {
+							return &awsacmpca.ListPermissionsOutput{
+								NextToken:   aws.String(nextToken),
+								Permissions: []awsacmpcatypes.Permission{{Principal: aws.String("other")}},
+							}, nil
+						}
+						return &awsacmpca.ListPermissionsOutput{
+							Permissions: []awsacmpcatypes.Permission{{Principal: &principal}},
+						}, nil
+					},
+				},
+				cr: certificateAuthorityPermission(
+					withExternalName(principal+"/"+arn),
+					withPrincipal(principal),
+					withCertificateAuthorityARN(arn),
+				),
+			},
+			want: want{
+				cr: certificateAuthorityPermission(
+					withExternalName(principal+"/"+arn),
+					withPrincipal(principal),
+					withCertificateAuthorityARN(arn),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InvalidInput": {
 			args: args{
 				cr: unexpectedItem,
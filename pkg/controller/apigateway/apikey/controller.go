@@ -0,0 +1,192 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+// ConnectionDetailValue is the connection detail key of the value of the API
+// key.
+const ConnectionDetailValue = "value"
+
+const (
+	errUnexpectedObject = "managed resource is not an APIKey resource"
+	errCreateSession    = "cannot create a new session"
+	errGetValue         = "cannot get the value of the APIKey from the given secret"
+	errGet              = "failed to get APIKey"
+	errCreate           = "failed to create APIKey"
+	errUpdate           = "failed to update APIKey"
+	errDelete           = "failed to delete APIKey"
+)
+
+// SetupAPIKey adds a controller that reconciles APIKeys.
+func SetupAPIKey(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.APIKeyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.APIKey{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.APIKeyGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client apigateway.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The API key ID is assigned by AWS, so there is nothing to observe
+	// until it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.client.GetApiKeyWithContext(ctx, &awsapigateway.GetApiKeyInput{
+		ApiKey:       awsclient.String(meta.GetExternalName(cr)),
+		IncludeValue: awsclient.Bool(true),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+	cr.Status.AtProvider.CreatedDate = awsclient.LateInitializeTimePtr(nil, resp.CreatedDate)
+	cr.Status.AtProvider.LastUpdatedDate = awsclient.LateInitializeTimePtr(nil, resp.LastUpdatedDate)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  len(apigateway.GenerateAPIKeyPatch(cr.Spec.ForProvider, resp)) == 0,
+		ConnectionDetails: managed.ConnectionDetails{ConnectionDetailValue: []byte(awsclient.StringValue(resp.Value))},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	value, err := e.getValue(ctx, cr.Spec.ForProvider.ValueSecretRef)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetValue)
+	}
+	resp, err := e.client.CreateApiKeyWithContext(ctx, apigateway.GenerateCreateAPIKeyInput(cr.Spec.ForProvider, value))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.Id))
+	return managed.ExternalCreation{
+		ExternalNameAssigned: true,
+		ConnectionDetails:    managed.ConnectionDetails{ConnectionDetailValue: []byte(awsclient.StringValue(resp.Value))},
+	}, nil
+}
+
+// getValue returns the value of the API key held by the given secret key,
+// or an empty value if no secret key is given.
+func (e *external) getValue(ctx context.Context, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", nil
+	}
+	s := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", err
+	}
+	return string(s.Data[ref.Key]), nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetApiKeyWithContext(ctx, &awsapigateway.GetApiKeyInput{
+		ApiKey: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	_, err = e.client.UpdateApiKeyWithContext(ctx, &awsapigateway.UpdateApiKeyInput{
+		ApiKey:          awsclient.String(meta.GetExternalName(cr)),
+		PatchOperations: apigateway.GenerateAPIKeyPatch(cr.Spec.ForProvider, resp),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.APIKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteApiKeyWithContext(ctx, &awsapigateway.DeleteApiKeyInput{
+		ApiKey: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
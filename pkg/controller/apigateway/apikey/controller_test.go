@@ -0,0 +1,424 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	keyID    = "abc123"
+	keyName  = "key"
+	keyValue = "secretvalue"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	kube   client.Client
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type keyModifier func(*v1alpha1.APIKey)
+
+func withExternalName(n string) keyModifier {
+	return func(r *v1alpha1.APIKey) { meta.SetExternalName(r, n) }
+}
+
+func withEnabled(b bool) keyModifier {
+	return func(r *v1alpha1.APIKey) { r.Spec.ForProvider.Enabled = &b }
+}
+
+func withValueSecretRef() keyModifier {
+	return func(r *v1alpha1.APIKey) {
+		r.Spec.ForProvider.ValueSecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Name: "key", Namespace: "default"},
+			Key:             "value",
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) keyModifier {
+	return func(r *v1alpha1.APIKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func apiKey(m ...keyModifier) *v1alpha1.APIKey {
+	cr := &v1alpha1.APIKey{}
+	cr.Spec.ForProvider.Name = keyName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     apiKey(),
+			},
+			want: want{
+				cr: apiKey(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return nil, errBoom
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr:  apiKey(withExternalName(keyID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(_ context.Context, input *awsapigateway.GetApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+						if !awsclient.BoolValue(input.IncludeValue) {
+							return nil, errBoom
+						}
+						return &awsapigateway.ApiKey{
+							Id:      &keyID,
+							Name:    &keyName,
+							Enabled: awsclient.Bool(true),
+							Value:   &keyValue,
+						}, nil
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionDetailValue: []byte(keyValue)},
+				},
+			},
+		},
+		"Disabled": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return &awsapigateway.ApiKey{
+							Id:      &keyID,
+							Name:    &keyName,
+							Enabled: awsclient.Bool(true),
+							Value:   &keyValue,
+						}, nil
+					},
+				},
+				cr: apiKey(withExternalName(keyID), withEnabled(false)),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID), withEnabled(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{ConnectionDetailValue: []byte(keyValue)},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateApiKey: func(_ context.Context, input *awsapigateway.CreateApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+						if input.Value != nil {
+							return nil, errBoom
+						}
+						return &awsapigateway.ApiKey{Id: &keyID, Value: &keyValue}, nil
+					},
+				},
+				cr: apiKey(),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{ConnectionDetailValue: []byte(keyValue)},
+				},
+			},
+		},
+		"ValueFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						s := obj.(*corev1.Secret)
+						s.Data = map[string][]byte{"value": []byte(keyValue)}
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockCreateApiKey: func(_ context.Context, input *awsapigateway.CreateApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+						if awsclient.StringValue(input.Value) != keyValue {
+							return nil, errBoom
+						}
+						return &awsapigateway.ApiKey{Id: &keyID, Value: input.Value}, nil
+					},
+				},
+				cr: apiKey(withValueSecretRef()),
+			},
+			want: want{
+				cr: apiKey(withValueSecretRef(), withExternalName(keyID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+					ConnectionDetails:    managed.ConnectionDetails{ConnectionDetailValue: []byte(keyValue)},
+				},
+			},
+		},
+		"SecretError": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: &fake.MockClient{},
+				cr:     apiKey(withValueSecretRef()),
+			},
+			want: want{
+				cr:  apiKey(withValueSecretRef(), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errBoom, errGetValue),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateApiKey: func(context.Context, *awsapigateway.CreateApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return nil, errBoom
+					},
+				},
+				cr: apiKey(),
+			},
+			want: want{
+				cr:  apiKey(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return &awsapigateway.ApiKey{Id: &keyID, Name: &keyName, Enabled: awsclient.Bool(true)}, nil
+					},
+					MockUpdateApiKey: func(_ context.Context, input *awsapigateway.UpdateApiKeyInput, _ []request.Option) (*awsapigateway.ApiKey, error) {
+						if len(input.PatchOperations) != 1 {
+							return nil, errBoom
+						}
+						return &awsapigateway.ApiKey{}, nil
+					},
+				},
+				cr: apiKey(withExternalName(keyID), withEnabled(false)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetApiKey: func(context.Context, *awsapigateway.GetApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return &awsapigateway.ApiKey{Id: &keyID, Name: &keyName, Enabled: awsclient.Bool(true)}, nil
+					},
+					MockUpdateApiKey: func(context.Context, *awsapigateway.UpdateApiKeyInput, []request.Option) (*awsapigateway.ApiKey, error) {
+						return nil, errBoom
+					},
+				},
+				cr: apiKey(withExternalName(keyID), withEnabled(false)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteApiKey: func(context.Context, *awsapigateway.DeleteApiKeyInput, []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+						return &awsapigateway.DeleteApiKeyOutput{}, nil
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteApiKey: func(context.Context, *awsapigateway.DeleteApiKeyInput, []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr: apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteApiKey: func(context.Context, *awsapigateway.DeleteApiKeyInput, []request.Option) (*awsapigateway.DeleteApiKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: apiKey(withExternalName(keyID)),
+			},
+			want: want{
+				cr:  apiKey(withExternalName(keyID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
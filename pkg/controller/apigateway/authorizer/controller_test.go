@@ -0,0 +1,308 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authorizer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	authorizerID   = "auth123"
+	authorizerName = "authorizer"
+	authorizerType = "TOKEN"
+	restAPIID      = "api123"
+	region         = "us-east-1"
+	functionARN    = "arn:aws:lambda:us-east-1:123456789012:function:auth"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type authorizerModifier func(*v1alpha1.Authorizer)
+
+func withExternalName(n string) authorizerModifier {
+	return func(r *v1alpha1.Authorizer) { meta.SetExternalName(r, n) }
+}
+
+func withIdentitySource(s string) authorizerModifier {
+	return func(r *v1alpha1.Authorizer) { r.Spec.ForProvider.IdentitySource = &s }
+}
+
+func withAuthorizerURI(u string) authorizerModifier {
+	return func(r *v1alpha1.Authorizer) { r.Status.AtProvider.AuthorizerURI = u }
+}
+
+func withConditions(c ...xpv1.Condition) authorizerModifier {
+	return func(r *v1alpha1.Authorizer) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func authorizer(m ...authorizerModifier) *v1alpha1.Authorizer {
+	cr := &v1alpha1.Authorizer{}
+	cr.Spec.ForProvider.Region = region
+	cr.Spec.ForProvider.RestAPIID = restAPIID
+	cr.Spec.ForProvider.Name = authorizerName
+	cr.Spec.ForProvider.Type = authorizerType
+	cr.Spec.ForProvider.FunctionARN = &functionARN
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awsapigateway.Authorizer {
+	return &awsapigateway.Authorizer{
+		Id:            &authorizerID,
+		Name:          &authorizerName,
+		Type:          &authorizerType,
+		AuthorizerUri: awsclient.String(apigateway.AuthorizerURI(region, functionARN)),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     authorizer(),
+			},
+			want: want{
+				cr: authorizer(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAuthorizer: func(context.Context, *awsapigateway.GetAuthorizerInput, []request.Option) (*awsapigateway.Authorizer, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+			want: want{
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAuthorizer: func(context.Context, *awsapigateway.GetAuthorizerInput, []request.Option) (*awsapigateway.Authorizer, error) {
+						return nil, errBoom
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+			want: want{
+				cr:  authorizer(withExternalName(authorizerID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAuthorizer: func(context.Context, *awsapigateway.GetAuthorizerInput, []request.Option) (*awsapigateway.Authorizer, error) {
+						return observed(), nil
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+			want: want{
+				cr: authorizer(withExternalName(authorizerID), withAuthorizerURI(apigateway.AuthorizerURI(region, functionARN)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"IdentitySourceChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetAuthorizer: func(context.Context, *awsapigateway.GetAuthorizerInput, []request.Option) (*awsapigateway.Authorizer, error) {
+						return observed(), nil
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID), withIdentitySource("method.request.header.Auth")),
+			},
+			want: want{
+				cr: authorizer(withExternalName(authorizerID), withIdentitySource("method.request.header.Auth"), withAuthorizerURI(apigateway.AuthorizerURI(region, functionARN)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAuthorizer: func(_ context.Context, input *awsapigateway.CreateAuthorizerInput, _ []request.Option) (*awsapigateway.Authorizer, error) {
+						if awsclient.StringValue(input.AuthorizerUri) != apigateway.AuthorizerURI(region, functionARN) {
+							return nil, errBoom
+						}
+						return &awsapigateway.Authorizer{Id: &authorizerID}, nil
+					},
+				},
+				cr: authorizer(),
+			},
+			want: want{
+				cr:     authorizer(withExternalName(authorizerID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAuthorizer: func(context.Context, *awsapigateway.CreateAuthorizerInput, []request.Option) (*awsapigateway.Authorizer, error) {
+						return nil, errBoom
+					},
+				},
+				cr: authorizer(),
+			},
+			want: want{
+				cr:  authorizer(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAuthorizer: func(context.Context, *awsapigateway.DeleteAuthorizerInput, []request.Option) (*awsapigateway.DeleteAuthorizerOutput, error) {
+						return &awsapigateway.DeleteAuthorizerOutput{}, nil
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+			want: want{
+				cr: authorizer(withExternalName(authorizerID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAuthorizer: func(context.Context, *awsapigateway.DeleteAuthorizerInput, []request.Option) (*awsapigateway.DeleteAuthorizerOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: authorizer(withExternalName(authorizerID)),
+			},
+			want: want{
+				cr:  authorizer(withExternalName(authorizerID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
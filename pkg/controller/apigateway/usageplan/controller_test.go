@@ -0,0 +1,283 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplan
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	planID   = "plan123"
+	planName = "plan"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type planModifier func(*v1alpha1.UsagePlan)
+
+func withExternalName(n string) planModifier {
+	return func(r *v1alpha1.UsagePlan) { meta.SetExternalName(r, n) }
+}
+
+func withAPIStages(s ...v1alpha1.APIStage) planModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Spec.ForProvider.APIStages = s }
+}
+
+func withConditions(c ...xpv1.Condition) planModifier {
+	return func(r *v1alpha1.UsagePlan) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func usagePlan(m ...planModifier) *v1alpha1.UsagePlan {
+	cr := &v1alpha1.UsagePlan{}
+	cr.Spec.ForProvider.Name = planName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     usagePlan(),
+			},
+			want: want{
+				cr: usagePlan(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlan: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: usagePlan(withExternalName(planID)),
+			},
+			want: want{
+				cr: usagePlan(withExternalName(planID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlan: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlan(withExternalName(planID)),
+			},
+			want: want{
+				cr:  usagePlan(withExternalName(planID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlan: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return &awsapigateway.UsagePlan{Id: &planID, Name: &planName}, nil
+					},
+				},
+				cr: usagePlan(withExternalName(planID)),
+			},
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"StageMissing": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlan: func(context.Context, *awsapigateway.GetUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return &awsapigateway.UsagePlan{Id: &planID, Name: &planName}, nil
+					},
+				},
+				cr: usagePlan(withExternalName(planID), withAPIStages(v1alpha1.APIStage{APIID: "api", Stage: "prod"})),
+			},
+			want: want{
+				cr: usagePlan(withExternalName(planID), withAPIStages(v1alpha1.APIStage{APIID: "api", Stage: "prod"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUsagePlan: func(context.Context, *awsapigateway.CreateUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return &awsapigateway.UsagePlan{Id: &planID}, nil
+					},
+				},
+				cr: usagePlan(),
+			},
+			want: want{
+				cr:     usagePlan(withExternalName(planID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUsagePlan: func(context.Context, *awsapigateway.CreateUsagePlanInput, []request.Option) (*awsapigateway.UsagePlan, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlan(),
+			},
+			want: want{
+				cr:  usagePlan(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteUsagePlan: func(context.Context, *awsapigateway.DeleteUsagePlanInput, []request.Option) (*awsapigateway.DeleteUsagePlanOutput, error) {
+						return &awsapigateway.DeleteUsagePlanOutput{}, nil
+					},
+				},
+				cr: usagePlan(withExternalName(planID)),
+			},
+			want: want{
+				cr: usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteUsagePlan: func(context.Context, *awsapigateway.DeleteUsagePlanInput, []request.Option) (*awsapigateway.DeleteUsagePlanOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlan(withExternalName(planID)),
+			},
+			want: want{
+				cr:  usagePlan(withExternalName(planID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplankey
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a UsagePlanKey resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get UsagePlanKey"
+	errCreate           = "failed to create UsagePlanKey"
+	errDelete           = "failed to delete UsagePlanKey"
+)
+
+// SetupUsagePlanKey adds a controller that reconciles UsagePlanKeys.
+func SetupUsagePlanKey(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.UsagePlanKeyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.UsagePlanKey{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.UsagePlanKeyGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: apigateway.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) apigateway.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.UsagePlanKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client apigateway.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.UsagePlanKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetUsagePlanKeyWithContext(ctx, &awsapigateway.GetUsagePlanKeyInput{
+		UsagePlanId: cr.Spec.ForProvider.UsagePlanID,
+		KeyId:       cr.Spec.ForProvider.KeyID,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errGet)
+	}
+	cr.Status.AtProvider.Name = awsclient.StringValue(resp.Name)
+	cr.SetConditions(xpv1.Available())
+
+	// A usage plan key is an association without any mutable fields, so it
+	// is always up to date once it exists.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.UsagePlanKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateUsagePlanKeyWithContext(ctx, &awsapigateway.CreateUsagePlanKeyInput{
+		UsagePlanId: cr.Spec.ForProvider.UsagePlanID,
+		KeyId:       cr.Spec.ForProvider.KeyID,
+		KeyType:     awsclient.String(apigateway.KeyTypeAPIKey),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.UsagePlanKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteUsagePlanKeyWithContext(ctx, &awsapigateway.DeleteUsagePlanKeyInput{
+		UsagePlanId: cr.Spec.ForProvider.UsagePlanID,
+		KeyId:       cr.Spec.ForProvider.KeyID,
+	})
+	return awsclient.Wrap(resource.Ignore(apigateway.IsNotFound, err), errDelete)
+}
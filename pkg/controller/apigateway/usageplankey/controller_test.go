@@ -0,0 +1,269 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usageplankey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsapigateway "github.com/aws/aws-sdk-go/service/apigateway"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/apigateway/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane/provider-aws/pkg/clients/apigateway/fake"
+)
+
+var (
+	planID  = "plan123"
+	keyID   = "key123"
+	keyName = "key"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type keyModifier func(*v1alpha1.UsagePlanKey)
+
+func withName(n string) keyModifier {
+	return func(r *v1alpha1.UsagePlanKey) { r.Status.AtProvider.Name = n }
+}
+
+func withConditions(c ...xpv1.Condition) keyModifier {
+	return func(r *v1alpha1.UsagePlanKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func usagePlanKey(m ...keyModifier) *v1alpha1.UsagePlanKey {
+	cr := &v1alpha1.UsagePlanKey{}
+	cr.Spec.ForProvider.UsagePlanID = &planID
+	cr.Spec.ForProvider.KeyID = &keyID
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlanKey: func(context.Context, *awsapigateway.GetUsagePlanKeyInput, []request.Option) (*awsapigateway.UsagePlanKey, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr: usagePlanKey(),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlanKey: func(context.Context, *awsapigateway.GetUsagePlanKeyInput, []request.Option) (*awsapigateway.UsagePlanKey, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr:  usagePlanKey(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"Exists": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetUsagePlanKey: func(_ context.Context, input *awsapigateway.GetUsagePlanKeyInput, _ []request.Option) (*awsapigateway.UsagePlanKey, error) {
+						if awsclient.StringValue(input.UsagePlanId) != planID || awsclient.StringValue(input.KeyId) != keyID {
+							return nil, errBoom
+						}
+						return &awsapigateway.UsagePlanKey{Id: &keyID, Name: &keyName}, nil
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr: usagePlanKey(withName(keyName), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUsagePlanKey: func(_ context.Context, input *awsapigateway.CreateUsagePlanKeyInput, _ []request.Option) (*awsapigateway.UsagePlanKey, error) {
+						if awsclient.StringValue(input.KeyType) != apigateway.KeyTypeAPIKey {
+							return nil, errBoom
+						}
+						return &awsapigateway.UsagePlanKey{}, nil
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr: usagePlanKey(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateUsagePlanKey: func(context.Context, *awsapigateway.CreateUsagePlanKeyInput, []request.Option) (*awsapigateway.UsagePlanKey, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr:  usagePlanKey(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteUsagePlanKey: func(context.Context, *awsapigateway.DeleteUsagePlanKeyInput, []request.Option) (*awsapigateway.DeleteUsagePlanKeyOutput, error) {
+						return &awsapigateway.DeleteUsagePlanKeyOutput{}, nil
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr: usagePlanKey(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteUsagePlanKey: func(context.Context, *awsapigateway.DeleteUsagePlanKeyInput, []request.Option) (*awsapigateway.DeleteUsagePlanKeyOutput, error) {
+						return nil, awserr.New(awsapigateway.ErrCodeNotFoundException, "", nil)
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr: usagePlanKey(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteUsagePlanKey: func(context.Context, *awsapigateway.DeleteUsagePlanKeyInput, []request.Option) (*awsapigateway.DeleteUsagePlanKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: usagePlanKey(),
+			},
+			want: want{
+				cr:  usagePlanKey(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,9 +23,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -33,12 +30,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupAuthorizer adds a controller that reconciles Authorizer.
-func SetupAuthorizer(mgr ctrl.Manager, o controller.Options) error {
+func SetupAuthorizer(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.AuthorizerGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
@@ -56,15 +59,11 @@ func SetupAuthorizer(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Authorizer{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.AuthorizerGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.AuthorizerGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.PathName("<authorizer-id>", "apigateway", "/apis"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
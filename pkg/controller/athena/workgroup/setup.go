@@ -17,12 +17,9 @@ import (
 	"context"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/athena"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,12 +27,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupWorkGroup adds a controller that reconciles WorkGroup.
-func SetupWorkGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupWorkGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.WorkGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
@@ -53,13 +56,10 @@ func SetupWorkGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.WorkGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.WorkGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.WorkGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<workgroup-name>", "athena", "workgroup"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
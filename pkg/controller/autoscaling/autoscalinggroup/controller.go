@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsas "github.com/aws/aws-sdk-go/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject     = "managed resource is not an AutoScalingGroup resource"
+	errCreateSession        = "cannot create a new session"
+	errDescribe             = "failed to describe AutoScalingGroup"
+	errDescribeRefreshes    = "failed to describe instance refreshes of AutoScalingGroup"
+	errDescribeTemplate     = "failed to describe launch template of AutoScalingGroup"
+	errNoLaunchTemplate     = "launch template of AutoScalingGroup does not exist"
+	errCreate               = "failed to create AutoScalingGroup"
+	errUpdate               = "failed to update AutoScalingGroup"
+	errAttachTargetGroups   = "failed to attach target groups to AutoScalingGroup"
+	errDetachTargetGroups   = "failed to detach target groups from AutoScalingGroup"
+	errSuspendProcesses     = "failed to suspend processes of AutoScalingGroup"
+	errResumeProcesses      = "failed to resume processes of AutoScalingGroup"
+	errCreateOrUpdateTags   = "failed to create or update tags of AutoScalingGroup"
+	errDeleteTags           = "failed to delete tags of AutoScalingGroup"
+	errStartInstanceRefresh = "failed to start instance refresh of AutoScalingGroup"
+	errDelete               = "failed to delete AutoScalingGroup"
+)
+
+// SetupAutoScalingGroup adds a controller that reconciles AutoScalingGroups.
+func SetupAutoScalingGroup(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.AutoScalingGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.AutoScalingGroup{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.AutoScalingGroupGroupVersionKind),
+			&connector{
+				kube:                      mgr.GetClient(),
+				newClientFn:               autoscaling.NewClient,
+				newLaunchTemplateClientFn: autoscaling.NewLaunchTemplateClient,
+			},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube                      client.Client
+	newClientFn               func(*session.Session) autoscaling.Client
+	newLaunchTemplateClientFn func(*session.Session) autoscaling.LaunchTemplateClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess), launchTemplates: c.newLaunchTemplateClientFn(sess)}, nil
+}
+
+type external struct {
+	kube            client.Client
+	client          autoscaling.Client
+	launchTemplates autoscaling.LaunchTemplateClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	g, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil || g == nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errDescribe)
+	}
+
+	obs := autoscaling.GenerateObservation(g)
+	obs.CallerAccountID = cr.Status.AtProvider.CallerAccountID
+	cr.Status.AtProvider = obs
+
+	// A group that is being deleted keeps being described until all of its
+	// instances are terminated, but it cannot be changed anymore.
+	if awsclient.StringValue(g.Status) == autoscaling.StatusDeleteInProgress {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	upToDate := autoscaling.IsUpToDate(meta.GetExternalName(cr), cr.Spec.ForProvider, g)
+	if upToDate && cr.Spec.ForProvider.InstanceRefresh != nil {
+		if upToDate, err = e.isRefreshed(ctx, cr, g); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: upToDate,
+	}, nil
+}
+
+// describe returns the group with the given name, or nil if it does not
+// exist.
+func (e *external) describe(ctx context.Context, name string) (*awsas.Group, error) {
+	resp, err := e.client.DescribeAutoScalingGroupsWithContext(ctx, &awsas.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{awsclient.String(name)},
+	})
+	if err != nil || len(resp.AutoScalingGroups) == 0 {
+		return nil, err
+	}
+	return resp.AutoScalingGroups[0], nil
+}
+
+// isRefreshed returns true if all instances of the given group run the
+// desired version of its launch template, or if an instance refresh that
+// replaces them is still in progress. It records the resolved version and
+// the last instance refresh in the status of the group.
+func (e *external) isRefreshed(ctx context.Context, cr *v1alpha1.AutoScalingGroup, g *awsas.Group) (bool, error) {
+	refreshes, err := e.client.DescribeInstanceRefreshesWithContext(ctx, &awsas.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: awsclient.String(meta.GetExternalName(cr)),
+		MaxRecords:           awsclient.Int64(1),
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, errDescribeRefreshes)
+	}
+	if len(refreshes.InstanceRefreshes) > 0 {
+		r := refreshes.InstanceRefreshes[0]
+		cr.Status.AtProvider.InstanceRefreshID = awsclient.StringValue(r.InstanceRefreshId)
+		cr.Status.AtProvider.InstanceRefreshStatus = awsclient.StringValue(r.Status)
+		// Instance refreshes cannot overlap. The next one is started once
+		// this one finished, if instances are still outdated by then.
+		if autoscaling.IsInstanceRefreshInProgress(awsclient.StringValue(r.Status)) {
+			return true, nil
+		}
+	}
+
+	lt := autoscaling.GenerateLaunchTemplate(cr.Spec.ForProvider)
+	resp, err := e.launchTemplates.DescribeLaunchTemplatesWithContext(ctx, &awsec2.DescribeLaunchTemplatesInput{
+		LaunchTemplateNames: []*string{lt.LaunchTemplateName},
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, errDescribeTemplate)
+	}
+	if len(resp.LaunchTemplates) == 0 {
+		return false, errors.New(errNoLaunchTemplate)
+	}
+	version := autoscaling.ResolveLaunchTemplateVersion(awsclient.StringValue(lt.Version), resp.LaunchTemplates[0])
+	cr.Status.AtProvider.LaunchTemplateVersion = version
+	return !autoscaling.HasOutdatedInstances(g, awsclient.StringValue(lt.LaunchTemplateName), version), nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateAutoScalingGroupWithContext(ctx, autoscaling.GenerateCreateAutoScalingGroupInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	g, err := e.describe(ctx, name)
+	if err != nil || g == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if _, err := e.client.UpdateAutoScalingGroupWithContext(ctx, autoscaling.GenerateUpdateAutoScalingGroupInput(name, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+
+	attach, detach := autoscaling.DiffTargetGroups(cr.Spec.ForProvider, g)
+	if len(attach) > 0 {
+		if _, err := e.client.AttachLoadBalancerTargetGroupsWithContext(ctx, &awsas.AttachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: awsclient.String(name),
+			TargetGroupARNs:      attach,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAttachTargetGroups)
+		}
+	}
+	if len(detach) > 0 {
+		if _, err := e.client.DetachLoadBalancerTargetGroupsWithContext(ctx, &awsas.DetachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: awsclient.String(name),
+			TargetGroupARNs:      detach,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDetachTargetGroups)
+		}
+	}
+
+	suspend, resume := autoscaling.DiffSuspendedProcesses(cr.Spec.ForProvider, g)
+	if len(suspend) > 0 {
+		if _, err := e.client.SuspendProcessesWithContext(ctx, &awsas.ScalingProcessQuery{
+			AutoScalingGroupName: awsclient.String(name),
+			ScalingProcesses:     suspend,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errSuspendProcesses)
+		}
+	}
+	if len(resume) > 0 {
+		if _, err := e.client.ResumeProcessesWithContext(ctx, &awsas.ScalingProcessQuery{
+			AutoScalingGroupName: awsclient.String(name),
+			ScalingProcesses:     resume,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errResumeProcesses)
+		}
+	}
+
+	upsert, remove := autoscaling.DiffTags(name, cr.Spec.ForProvider, g)
+	if len(upsert) > 0 {
+		if _, err := e.client.CreateOrUpdateTagsWithContext(ctx, &awsas.CreateOrUpdateTagsInput{Tags: upsert}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateOrUpdateTags)
+		}
+	}
+	if len(remove) > 0 {
+		if _, err := e.client.DeleteTagsWithContext(ctx, &awsas.DeleteTagsInput{Tags: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+
+	p := cr.Spec.ForProvider.InstanceRefresh
+	if p == nil {
+		return managed.ExternalUpdate{}, nil
+	}
+	refreshed, err := e.isRefreshed(ctx, cr, g)
+	if err != nil || refreshed {
+		return managed.ExternalUpdate{}, err
+	}
+	resp, err := e.client.StartInstanceRefreshWithContext(ctx, autoscaling.GenerateStartInstanceRefreshInput(name, *p))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errStartInstanceRefresh)
+	}
+	cr.Status.AtProvider.InstanceRefreshID = awsclient.StringValue(resp.InstanceRefreshId)
+	cr.Status.AtProvider.InstanceRefreshStatus = awsas.InstanceRefreshStatusPending
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.Status == autoscaling.StatusDeleteInProgress {
+		return nil
+	}
+	// Groups with instances can only be deleted by force, which terminates
+	// the instances along with the group.
+	_, err := e.client.DeleteAutoScalingGroupWithContext(ctx, &awsas.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: awsclient.String(meta.GetExternalName(cr)),
+		ForceDelete:          awsclient.Bool(true),
+	})
+	return awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errDelete)
+}
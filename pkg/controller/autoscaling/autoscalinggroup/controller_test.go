@@ -0,0 +1,576 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsas "github.com/aws/aws-sdk-go/service/autoscaling"
+	awsec2 "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/autoscaling/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	groupName    = "workers"
+	groupARN     = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:abc:autoScalingGroupName/workers"
+	templateName = "workers"
+	refreshID    = "refresh-1"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	client          *fake.MockClient
+	launchTemplates *fake.MockLaunchTemplateClient
+	cr              resource.Managed
+}
+
+type groupModifier func(*v1alpha1.AutoScalingGroup)
+
+func withExternalName(n string) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { meta.SetExternalName(r, n) }
+}
+
+func withDesiredCapacity(c int64) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider.DesiredCapacity = &c }
+}
+
+func withIgnoreDesiredCapacity() groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider.IgnoreDesiredCapacity = true }
+}
+
+func withSuspendedProcesses(p ...v1alpha1.ScalingProcess) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider.SuspendedProcesses = p }
+}
+
+func withTargetGroups(arns ...string) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Spec.ForProvider.TargetGroupARNs = arns }
+}
+
+func withInstanceRefresh() groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) {
+		r.Spec.ForProvider.LaunchTemplate.Version = aws.String(autoscaling.LatestLaunchTemplateVersion)
+		r.Spec.ForProvider.InstanceRefresh = &v1alpha1.InstanceRefreshPolicy{MinHealthyPercentage: aws.Int64(50)}
+	}
+}
+
+func withObservation(o v1alpha1.AutoScalingGroupObservation) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func group(m ...groupModifier) *v1alpha1.AutoScalingGroup {
+	cr := &v1alpha1.AutoScalingGroup{}
+	cr.Spec.ForProvider.LaunchTemplate.LaunchTemplateName = &templateName
+	cr.Spec.ForProvider.MinSize = 1
+	cr.Spec.ForProvider.MaxSize = 5
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type asgModifier func(*awsas.Group)
+
+func withInstances(versions ...string) asgModifier {
+	return func(g *awsas.Group) {
+		for _, v := range versions {
+			g.Instances = append(g.Instances, &awsas.Instance{
+				LaunchTemplate: &awsas.LaunchTemplateSpecification{LaunchTemplateName: &templateName, Version: aws.String(v)},
+			})
+		}
+	}
+}
+
+func asg(m ...asgModifier) *awsas.Group {
+	g := &awsas.Group{
+		AutoScalingGroupARN:  &groupARN,
+		AutoScalingGroupName: &groupName,
+		LaunchTemplate:       &awsas.LaunchTemplateSpecification{LaunchTemplateName: &templateName, Version: aws.String(autoscaling.DefaultLaunchTemplateVersion)},
+		MinSize:              aws.Int64(1),
+		MaxSize:              aws.Int64(5),
+		DesiredCapacity:      aws.Int64(2),
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func describeGroups(g *awsas.Group) func(context.Context, *awsas.DescribeAutoScalingGroupsInput, []request.Option) (*awsas.DescribeAutoScalingGroupsOutput, error) {
+	return func(_ context.Context, in *awsas.DescribeAutoScalingGroupsInput, _ []request.Option) (*awsas.DescribeAutoScalingGroupsOutput, error) {
+		if len(in.AutoScalingGroupNames) != 1 || aws.StringValue(in.AutoScalingGroupNames[0]) != groupName {
+			return nil, errBoom
+		}
+		return &awsas.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*awsas.Group{g}}, nil
+	}
+}
+
+func describeRefreshes(status ...string) func(context.Context, *awsas.DescribeInstanceRefreshesInput, []request.Option) (*awsas.DescribeInstanceRefreshesOutput, error) {
+	return func(context.Context, *awsas.DescribeInstanceRefreshesInput, []request.Option) (*awsas.DescribeInstanceRefreshesOutput, error) {
+		out := &awsas.DescribeInstanceRefreshesOutput{}
+		for _, s := range status {
+			out.InstanceRefreshes = append(out.InstanceRefreshes, &awsas.InstanceRefresh{InstanceRefreshId: &refreshID, Status: aws.String(s)})
+		}
+		return out, nil
+	}
+}
+
+func describeTemplates(latest int64) *fake.MockLaunchTemplateClient {
+	return &fake.MockLaunchTemplateClient{
+		MockDescribeLaunchTemplates: func(context.Context, *awsec2.DescribeLaunchTemplatesInput, []request.Option) (*awsec2.DescribeLaunchTemplatesOutput, error) {
+			return &awsec2.DescribeLaunchTemplatesOutput{LaunchTemplates: []*awsec2.LaunchTemplate{{
+				LaunchTemplateName:   &templateName,
+				DefaultVersionNumber: aws.Int64(1),
+				LatestVersionNumber:  aws.Int64(latest),
+			}}}, nil
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: func(context.Context, *awsas.DescribeAutoScalingGroupsInput, []request.Option) (*awsas.DescribeAutoScalingGroupsOutput, error) {
+						return &awsas.DescribeAutoScalingGroupsOutput{}, nil
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: func(context.Context, *awsas.DescribeAutoScalingGroupsInput, []request.Option) (*awsas.DescribeAutoScalingGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"DeleteInProgress": {
+			args: args{
+				client: &fake.MockClient{MockDescribeAutoScalingGroups: describeGroups(asg(func(g *awsas.Group) {
+					g.Status = aws.String(autoscaling.StatusDeleteInProgress)
+				}))},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName),
+					withObservation(v1alpha1.AutoScalingGroupObservation{AutoScalingGroupARN: groupARN, DesiredCapacity: 2, Status: autoscaling.StatusDeleteInProgress}),
+					withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{MockDescribeAutoScalingGroups: describeGroups(asg())},
+				cr:     group(withExternalName(groupName), withDesiredCapacity(2)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withDesiredCapacity(2),
+					withObservation(v1alpha1.AutoScalingGroupObservation{AutoScalingGroupARN: groupARN, DesiredCapacity: 2}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DesiredCapacityChanged": {
+			args: args{
+				client: &fake.MockClient{MockDescribeAutoScalingGroups: describeGroups(asg())},
+				cr:     group(withExternalName(groupName), withDesiredCapacity(3)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withDesiredCapacity(3),
+					withObservation(v1alpha1.AutoScalingGroupObservation{AutoScalingGroupARN: groupARN, DesiredCapacity: 2}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"DesiredCapacityIgnored": {
+			args: args{
+				client: &fake.MockClient{MockDescribeAutoScalingGroups: describeGroups(asg())},
+				cr:     group(withExternalName(groupName), withDesiredCapacity(3), withIgnoreDesiredCapacity()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withDesiredCapacity(3), withIgnoreDesiredCapacity(),
+					withObservation(v1alpha1.AutoScalingGroupObservation{AutoScalingGroupARN: groupARN, DesiredCapacity: 2}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"InstancesOutdated": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg(withInstances("2", "3"), func(g *awsas.Group) {
+						g.LaunchTemplate.Version = aws.String(autoscaling.LatestLaunchTemplateVersion)
+					})),
+					MockDescribeInstanceRefreshes: describeRefreshes(awsas.InstanceRefreshStatusSuccessful),
+				},
+				launchTemplates: describeTemplates(3),
+				cr:              group(withExternalName(groupName), withInstanceRefresh()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withInstanceRefresh(),
+					withObservation(v1alpha1.AutoScalingGroupObservation{
+						AutoScalingGroupARN:   groupARN,
+						DesiredCapacity:       2,
+						Instances:             2,
+						LaunchTemplateVersion: 3,
+						InstanceRefreshID:     refreshID,
+						InstanceRefreshStatus: awsas.InstanceRefreshStatusSuccessful,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"InstanceRefreshInProgress": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg(withInstances("2", "3"), func(g *awsas.Group) {
+						g.LaunchTemplate.Version = aws.String(autoscaling.LatestLaunchTemplateVersion)
+					})),
+					MockDescribeInstanceRefreshes: describeRefreshes(awsas.InstanceRefreshStatusInProgress),
+				},
+				cr: group(withExternalName(groupName), withInstanceRefresh()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withInstanceRefresh(),
+					withObservation(v1alpha1.AutoScalingGroupObservation{
+						AutoScalingGroupARN:   groupARN,
+						DesiredCapacity:       2,
+						Instances:             2,
+						InstanceRefreshID:     refreshID,
+						InstanceRefreshStatus: awsas.InstanceRefreshStatusInProgress,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, launchTemplates: tc.launchTemplates}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAutoScalingGroup: func(_ context.Context, in *awsas.CreateAutoScalingGroupInput, _ []request.Option) (*awsas.CreateAutoScalingGroupOutput, error) {
+						if aws.StringValue(in.AutoScalingGroupName) != groupName || aws.Int64Value(in.DesiredCapacity) != 2 {
+							return nil, errBoom
+						}
+						return &awsas.CreateAutoScalingGroupOutput{}, nil
+					},
+				},
+				cr: group(withExternalName(groupName), withDesiredCapacity(2), withIgnoreDesiredCapacity()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withDesiredCapacity(2), withIgnoreDesiredCapacity(), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateAutoScalingGroup: func(context.Context, *awsas.CreateAutoScalingGroupInput, []request.Option) (*awsas.CreateAutoScalingGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupName), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	updateGroup := func(_ context.Context, in *awsas.UpdateAutoScalingGroupInput, _ []request.Option) (*awsas.UpdateAutoScalingGroupOutput, error) {
+		if in.DesiredCapacity != nil {
+			return nil, errBoom
+		}
+		return &awsas.UpdateAutoScalingGroupOutput{}, nil
+	}
+
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ProcessesAndTargetGroups": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg(func(g *awsas.Group) {
+						g.TargetGroupARNs = aws.StringSlice([]string{"arn:old"})
+						g.SuspendedProcesses = []*awsas.SuspendedProcess{{ProcessName: aws.String("Launch")}}
+					})),
+					MockUpdateAutoScalingGroup: updateGroup,
+					MockAttachLoadBalancerTargetGroups: func(_ context.Context, in *awsas.AttachLoadBalancerTargetGroupsInput, _ []request.Option) (*awsas.AttachLoadBalancerTargetGroupsOutput, error) {
+						if diff := cmp.Diff([]string{"arn:new"}, aws.StringValueSlice(in.TargetGroupARNs)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsas.AttachLoadBalancerTargetGroupsOutput{}, nil
+					},
+					MockDetachLoadBalancerTargetGroups: func(_ context.Context, in *awsas.DetachLoadBalancerTargetGroupsInput, _ []request.Option) (*awsas.DetachLoadBalancerTargetGroupsOutput, error) {
+						if diff := cmp.Diff([]string{"arn:old"}, aws.StringValueSlice(in.TargetGroupARNs)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsas.DetachLoadBalancerTargetGroupsOutput{}, nil
+					},
+					MockSuspendProcesses: func(_ context.Context, in *awsas.ScalingProcessQuery, _ []request.Option) (*awsas.SuspendProcessesOutput, error) {
+						if diff := cmp.Diff([]string{"AZRebalance"}, aws.StringValueSlice(in.ScalingProcesses)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsas.SuspendProcessesOutput{}, nil
+					},
+					MockResumeProcesses: func(_ context.Context, in *awsas.ScalingProcessQuery, _ []request.Option) (*awsas.ResumeProcessesOutput, error) {
+						if diff := cmp.Diff([]string{"Launch"}, aws.StringValueSlice(in.ScalingProcesses)); diff != "" {
+							return nil, errors.New(diff)
+						}
+						return &awsas.ResumeProcessesOutput{}, nil
+					},
+				},
+				cr: group(withExternalName(groupName), withTargetGroups("arn:new"), withSuspendedProcesses("AZRebalance")),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withTargetGroups("arn:new"), withSuspendedProcesses("AZRebalance")),
+			},
+		},
+		"StartInstanceRefresh": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg(withInstances("2"))),
+					MockUpdateAutoScalingGroup:    updateGroup,
+					MockDescribeInstanceRefreshes: describeRefreshes(),
+					MockStartInstanceRefresh: func(_ context.Context, in *awsas.StartInstanceRefreshInput, _ []request.Option) (*awsas.StartInstanceRefreshOutput, error) {
+						if aws.Int64Value(in.Preferences.MinHealthyPercentage) != 50 {
+							return nil, errBoom
+						}
+						return &awsas.StartInstanceRefreshOutput{InstanceRefreshId: &refreshID}, nil
+					},
+				},
+				launchTemplates: describeTemplates(3),
+				cr:              group(withExternalName(groupName), withInstanceRefresh()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withInstanceRefresh(), withObservation(v1alpha1.AutoScalingGroupObservation{
+					LaunchTemplateVersion: 3,
+					InstanceRefreshID:     refreshID,
+					InstanceRefreshStatus: awsas.InstanceRefreshStatusPending,
+				})),
+			},
+		},
+		"InstancesCurrent": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg(withInstances("3"))),
+					MockUpdateAutoScalingGroup:    updateGroup,
+					MockDescribeInstanceRefreshes: describeRefreshes(),
+				},
+				launchTemplates: describeTemplates(3),
+				cr:              group(withExternalName(groupName), withInstanceRefresh()),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withInstanceRefresh(), withObservation(v1alpha1.AutoScalingGroupObservation{LaunchTemplateVersion: 3})),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeAutoScalingGroups: describeGroups(asg()),
+					MockUpdateAutoScalingGroup: func(context.Context, *awsas.UpdateAutoScalingGroupInput, []request.Option) (*awsas.UpdateAutoScalingGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupName)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, launchTemplates: tc.launchTemplates}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAutoScalingGroup: func(_ context.Context, in *awsas.DeleteAutoScalingGroupInput, _ []request.Option) (*awsas.DeleteAutoScalingGroupOutput, error) {
+						if !aws.BoolValue(in.ForceDelete) {
+							return nil, errBoom
+						}
+						return &awsas.DeleteAutoScalingGroupOutput{}, nil
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteInProgress": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     group(withExternalName(groupName), withObservation(v1alpha1.AutoScalingGroupObservation{Status: autoscaling.StatusDeleteInProgress})),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withObservation(v1alpha1.AutoScalingGroupObservation{Status: autoscaling.StatusDeleteInProgress}),
+					withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAutoScalingGroup: func(context.Context, *awsas.DeleteAutoScalingGroupInput, []request.Option) (*awsas.DeleteAutoScalingGroupOutput, error) {
+						return nil, awserr.New("ValidationError", "AutoScalingGroup name not found - AutoScalingGroup 'workers' not found", nil)
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr: group(withExternalName(groupName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteAutoScalingGroup: func(context.Context, *awsas.DeleteAutoScalingGroupInput, []request.Option) (*awsas.DeleteAutoScalingGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: group(withExternalName(groupName)),
+			},
+			want: want{
+				cr:  group(withExternalName(groupName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,11 +19,13 @@ package controller
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-
 	"github.com/crossplane/provider-aws/pkg/controller/acm"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
+	apigatewayapikey "github.com/crossplane/provider-aws/pkg/controller/apigateway/apikey"
+	apigatewayauthorizer "github.com/crossplane/provider-aws/pkg/controller/apigateway/authorizer"
+	apigatewayusageplan "github.com/crossplane/provider-aws/pkg/controller/apigateway/usageplan"
+	apigatewayusageplankey "github.com/crossplane/provider-aws/pkg/controller/apigateway/usageplankey"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
@@ -37,23 +39,36 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
 	athenaworkgroup "github.com/crossplane/provider-aws/pkg/controller/athena/workgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/autoscaling/autoscalinggroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane/provider-aws/pkg/controller/cache/cluster"
+	"github.com/crossplane/provider-aws/pkg/controller/cache/reservedcachenodecoverage"
+	cloudformationstack "github.com/crossplane/provider-aws/pkg/controller/cloudformation/stack"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/cachepolicy"
 	cloudfrontorginaccessidentity "github.com/crossplane/provider-aws/pkg/controller/cloudfront/cloudfrontoriginaccessidentity"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	cloudfrontkeygroup "github.com/crossplane/provider-aws/pkg/controller/cloudfront/keygroup"
+	cloudfrontpublickey "github.com/crossplane/provider-aws/pkg/controller/cloudfront/publickey"
 	cloudfrontresponseheaderspolicy "github.com/crossplane/provider-aws/pkg/controller/cloudfront/responseheaderspolicy"
 	domain "github.com/crossplane/provider-aws/pkg/controller/cloudsearch/domain"
+	cloudtrailtrail "github.com/crossplane/provider-aws/pkg/controller/cloudtrail/trail"
+	cwcompositealarm "github.com/crossplane/provider-aws/pkg/controller/cloudwatch/compositealarm"
+	cwmetricalarm "github.com/crossplane/provider-aws/pkg/controller/cloudwatch/metricalarm"
 	cwloggroup "github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
+	cognitoidentitypool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentity/identitypool"
+	cognitoidentitypoolroleattachment "github.com/crossplane/provider-aws/pkg/controller/cognitoidentity/identitypoolroleattachment"
 	cognitogroup "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/group"
 	cognitoidentityprovider "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/identityprovider"
 	cognitouserpool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpool"
 	cognitouserpoolclient "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpoolclient"
 	cognitouserpooldomain "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpooldomain"
 	"github.com/crossplane/provider-aws/pkg/controller/config"
+	configrecorder "github.com/crossplane/provider-aws/pkg/controller/configservice/configurationrecorder"
+	configdeliverychannel "github.com/crossplane/provider-aws/pkg/controller/configservice/deliverychannel"
 	"github.com/crossplane/provider-aws/pkg/controller/database"
 	"github.com/crossplane/provider-aws/pkg/controller/database/dbsubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/database/optiongroup"
 	docdbcluster "github.com/crossplane/provider-aws/pkg/controller/docdb/dbcluster"
 	docdbclusterparametergroup "github.com/crossplane/provider-aws/pkg/controller/docdb/dbclusterparametergroup"
 	docdbinstance "github.com/crossplane/provider-aws/pkg/controller/docdb/dbinstance"
@@ -70,12 +85,14 @@ import (
 	ec2route "github.com/crossplane/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/routetable"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/securitygroup"
+	ec2snapshot "github.com/crossplane/provider-aws/pkg/controller/ec2/snapshot"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/subnet"
 	transitgateway "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
 	transitgatewayroute "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroute"
 	transitgatewayroutetable "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
 	transitgatewayvpcattachment "github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/volume"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/volumeattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpc"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpccidrblock"
 	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
@@ -96,13 +113,22 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
 	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	ebeventbus "github.com/crossplane/provider-aws/pkg/controller/eventbridge/eventbus"
+	ebrule "github.com/crossplane/provider-aws/pkg/controller/eventbridge/rule"
+	ebtarget "github.com/crossplane/provider-aws/pkg/controller/eventbridge/target"
+	"github.com/crossplane/provider-aws/pkg/controller/fleet"
 	glueclassifier "github.com/crossplane/provider-aws/pkg/controller/glue/classifier"
 	glueconnection "github.com/crossplane/provider-aws/pkg/controller/glue/connection"
 	gluecrawler "github.com/crossplane/provider-aws/pkg/controller/glue/crawler"
 	glueDatabase "github.com/crossplane/provider-aws/pkg/controller/glue/database"
 	gluejob "github.com/crossplane/provider-aws/pkg/controller/glue/job"
 	gluesecurityconfiguration "github.com/crossplane/provider-aws/pkg/controller/glue/securityconfiguration"
+	gluetable "github.com/crossplane/provider-aws/pkg/controller/glue/table"
+	guarddutydetector "github.com/crossplane/provider-aws/pkg/controller/guardduty/detector"
+	guarddutymember "github.com/crossplane/provider-aws/pkg/controller/guardduty/member"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/accesskey"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/accountalias"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/accountpasswordpolicy"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/group"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/grouppolicyattachment"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/groupusermembership"
@@ -111,8 +137,10 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/iam/policy"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/role"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/rolepolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/samlprovider"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/user"
 	"github.com/crossplane/provider-aws/pkg/controller/iam/userpolicyattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/importer/imports"
 	iotpolicy "github.com/crossplane/provider-aws/pkg/controller/iot/policy"
 	"github.com/crossplane/provider-aws/pkg/controller/iot/thing"
 	kafkacluster "github.com/crossplane/provider-aws/pkg/controller/kafka/cluster"
@@ -120,20 +148,30 @@ import (
 	kinesisstream "github.com/crossplane/provider-aws/pkg/controller/kinesis/stream"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
 	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
+	lambdaeventsourcemapping "github.com/crossplane/provider-aws/pkg/controller/lambda/eventsourcemapping"
 	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
+	lambdapermission "github.com/crossplane/provider-aws/pkg/controller/lambda/permission"
 	mqbroker "github.com/crossplane/provider-aws/pkg/controller/mq/broker"
 	mquser "github.com/crossplane/provider-aws/pkg/controller/mq/user"
 	neptunecluster "github.com/crossplane/provider-aws/pkg/controller/neptune/dbcluster"
+	neptuneinstance "github.com/crossplane/provider-aws/pkg/controller/neptune/dbinstance"
 	notsubscription "github.com/crossplane/provider-aws/pkg/controller/notification/snssubscription"
 	nottopic "github.com/crossplane/provider-aws/pkg/controller/notification/snstopic"
+	opensearchdomain "github.com/crossplane/provider-aws/pkg/controller/opensearchservice/domain"
+	orgaccount "github.com/crossplane/provider-aws/pkg/controller/organizations/account"
+	orgou "github.com/crossplane/provider-aws/pkg/controller/organizations/organizationalunit"
+	orgpolicy "github.com/crossplane/provider-aws/pkg/controller/organizations/policy"
+	orgpolicyattachment "github.com/crossplane/provider-aws/pkg/controller/organizations/policyattachment"
 	prometheusserviceworkspace "github.com/crossplane/provider-aws/pkg/controller/prometheusservice/workspace"
 	resourceshare "github.com/crossplane/provider-aws/pkg/controller/ram/resourceshare"
+	resourceshareaccepter "github.com/crossplane/provider-aws/pkg/controller/ram/resourceshareaccepter"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbcluster"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbclusterparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbinstance"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbinstanceroleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/dbparametergroup"
 	"github.com/crossplane/provider-aws/pkg/controller/rds/globalcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/reserveddbinstancecoverage"
 	"github.com/crossplane/provider-aws/pkg/controller/redshift"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/hostedzone"
 	"github.com/crossplane/provider-aws/pkg/controller/route53/resourcerecordset"
@@ -141,11 +179,24 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
 	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverruleassociation"
 	"github.com/crossplane/provider-aws/pkg/controller/s3"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/accountpublicaccessblock"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketcorsconfiguration"
 	"github.com/crossplane/provider-aws/pkg/controller/s3/bucketpolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/object"
 	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
+	securityhubhub "github.com/crossplane/provider-aws/pkg/controller/securityhub/hub"
+	securityhubstandards "github.com/crossplane/provider-aws/pkg/controller/securityhub/standardssubscription"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
 	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/publicdnsnamespace"
+	sdservice "github.com/crossplane/provider-aws/pkg/controller/servicediscovery/service"
+	"github.com/crossplane/provider-aws/pkg/controller/servicequotas/requestedquotaincrease"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/configurationset"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/domainidentity"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/emailidentity"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/mailfromdomain"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/receiptrule"
+	"github.com/crossplane/provider-aws/pkg/controller/ses/receiptruleset"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/activity"
 	"github.com/crossplane/provider-aws/pkg/controller/sfn/statemachine"
 	"github.com/crossplane/provider-aws/pkg/controller/sns/subscription"
@@ -153,14 +204,20 @@ import (
 	"github.com/crossplane/provider-aws/pkg/controller/sqs/queue"
 	transferserver "github.com/crossplane/provider-aws/pkg/controller/transfer/server"
 	transferuser "github.com/crossplane/provider-aws/pkg/controller/transfer/user"
+	wafv2ipset "github.com/crossplane/provider-aws/pkg/controller/wafv2/ipset"
+	wafv2rulegroup "github.com/crossplane/provider-aws/pkg/controller/wafv2/rulegroup"
+	wafv2webacl "github.com/crossplane/provider-aws/pkg/controller/wafv2/webacl"
+	wafv2webaclassociation "github.com/crossplane/provider-aws/pkg/controller/wafv2/webaclassociation"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
 // the supplied manager.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
-	for _, setup := range []func(ctrl.Manager, controller.Options) error{
+func Setup(mgr ctrl.Manager, o setup.Options) error {
+	for _, fn := range []func(ctrl.Manager, setup.Options) error{
 		cache.SetupReplicationGroup,
 		cachesubnetgroup.SetupCacheSubnetGroup,
+		reservedcachenodecoverage.SetupReservedCacheNodeCoverage,
 		cacheparametergroup.SetupCacheParameterGroup,
 		cluster.SetupCacheCluster,
 		database.SetupRDSInstance,
@@ -169,6 +226,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		docdbcluster.SetupDBCluster,
 		docdbclusterparametergroup.SetupDBClusterParameterGroup,
 		docdbsubnetgroup.SetupDBSubnetGroup,
+		optiongroup.SetupOptionGroup,
 		eks.SetupCluster,
 		eksaddon.SetupAddon,
 		identityproviderconfig.SetupIdentityProviderConfig,
@@ -178,6 +236,15 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		nodegroup.SetupNodeGroup,
 		s3.SetupBucket,
 		bucketpolicy.SetupBucketPolicy,
+		bucketcorsconfiguration.SetupBucketCORSConfiguration,
+		object.SetupObject,
+		accountpublicaccessblock.SetupAccountPublicAccessBlock,
+		receiptruleset.SetupReceiptRuleSet,
+		receiptrule.SetupReceiptRule,
+		mailfromdomain.SetupMailFromDomain,
+		emailidentity.SetupEmailIdentity,
+		domainidentity.SetupDomainIdentity,
+		configurationset.SetupConfigurationSet,
 		accesskey.SetupAccessKey,
 		user.SetupUser,
 		group.SetupGroup,
@@ -221,6 +288,16 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		apimapping.SetupAPIMapping,
 		routeresponse.SetupRouteResponse,
 		vpclink.SetupVPCLink,
+		apigatewayapikey.SetupAPIKey,
+		apigatewayusageplan.SetupUsagePlan,
+		apigatewayusageplankey.SetupUsagePlanKey,
+		apigatewayauthorizer.SetupAuthorizer,
+		wafv2ipset.SetupIPSet,
+		wafv2rulegroup.SetupRuleGroup,
+		wafv2webacl.SetupWebACL,
+		wafv2webaclassociation.SetupWebACLAssociation,
+		cloudformationstack.SetupStack,
+		autoscalinggroup.SetupAutoScalingGroup,
 		fargateprofile.SetupFargateProfile,
 		activity.SetupActivity,
 		statemachine.SetupStateMachine,
@@ -236,16 +313,26 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		dbinstanceroleassociation.SetupDBInstanceRoleAssociation,
 		dbparametergroup.SetupDBParameterGroup,
 		globalcluster.SetupGlobalCluster,
+		reserveddbinstancecoverage.SetupReservedDBInstanceCoverage,
 		vpccidrblock.SetupVPCCIDRBlock,
 		privatednsnamespace.SetupPrivateDNSNamespace,
 		publicdnsnamespace.SetupPublicDNSNamespace,
 		httpnamespace.SetupHTTPNamespace,
+		sdservice.SetupService,
+		requestedquotaincrease.SetupRequestedQuotaIncrease,
 		function.SetupFunction,
+		lambdaeventsourcemapping.SetupEventSourceMapping,
+		lambdapermission.SetupPermission,
 		openidconnectprovider.SetupOpenIDConnectProvider,
+		samlprovider.SetupSAMLProvider,
+		accountalias.SetupAccountAlias,
+		accountpasswordpolicy.SetupAccountPasswordPolicy,
 		distribution.SetupDistribution,
 		cachepolicy.SetupCachePolicy,
 		cloudfrontorginaccessidentity.SetupCloudFrontOriginAccessIdentity,
 		cloudfrontresponseheaderspolicy.SetupResponseHeadersPolicy,
+		cloudfrontpublickey.SetupPublicKey,
+		cloudfrontkeygroup.SetupKeyGroup,
 		resolverendpoint.SetupResolverEndpoint,
 		resolverrule.SetupResolverRule,
 		vpcpeeringconnection.SetupVPCPeeringConnection,
@@ -261,10 +348,30 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		glueDatabase.SetupDatabase,
 		gluecrawler.SetupCrawler,
 		glueclassifier.SetupClassifier,
+		gluetable.SetupTable,
 		mqbroker.SetupBroker,
 		mquser.SetupUser,
 		cwloggroup.SetupLogGroup,
+		cwmetricalarm.SetupMetricAlarm,
+		cwcompositealarm.SetupCompositeAlarm,
+		cloudtrailtrail.SetupTrail,
+		configrecorder.SetupConfigurationRecorder,
+		configdeliverychannel.SetupDeliveryChannel,
+		guarddutydetector.SetupDetector,
+		guarddutymember.SetupMember,
+		securityhubhub.SetupHub,
+		securityhubstandards.SetupStandardsSubscription,
+		orgaccount.SetupAccount,
+		orgou.SetupOrganizationalUnit,
+		orgpolicy.SetupPolicy,
+		orgpolicyattachment.SetupPolicyAttachment,
+		ebeventbus.SetupEventBus,
+		ebrule.SetupRule,
+		ebtarget.SetupTarget,
+		opensearchdomain.SetupDomain,
 		volume.SetupVolume,
+		ec2snapshot.SetupSnapshot,
+		volumeattachment.SetupVolumeAttachment,
 		transitgateway.SetupTransitGateway,
 		transitgatewayvpcattachment.SetupTransitGatewayVPCAttachment,
 		thing.SetupThing,
@@ -272,6 +379,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		ec2route.SetupRoute,
 		athenaworkgroup.SetupWorkGroup,
 		resourceshare.SetupResourceShare,
+		resourceshareaccepter.SetupResourceShareAccepter,
 		kafkaconfiguration.SetupConfiguration,
 		listener.SetupListener,
 		loadbalancer.SetupLoadBalancer,
@@ -286,14 +394,19 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cognitogroup.SetupGroup,
 		cognitouserpoolclient.SetupUserPoolClient,
 		cognitoidentityprovider.SetupIdentityProvider,
+		cognitoidentitypool.SetupIdentityPool,
+		cognitoidentitypoolroleattachment.SetupIdentityPoolRoleAttachment,
 		neptunecluster.SetupDBCluster,
+		neptuneinstance.SetupDBInstance,
 		topic.SetupSNSTopic,
 		subscription.SetupSubscription,
 		nottopic.SetupSNSTopic,
 		notsubscription.SetupSubscription,
 		prometheusserviceworkspace.SetupWorkspace,
+		fleet.SetupFleet,
+		imports.SetupImport,
 	} {
-		if err := setup(mgr, o); err != nil {
+		if err := fn(mgr, o); err != nil {
 			return err
 		}
 	}
@@ -19,6 +19,7 @@ package cluster
 import (
 	"context"
 	"reflect"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
@@ -27,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,7 +36,10 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/readiness"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // Error strings.
@@ -52,8 +53,11 @@ const (
 )
 
 // SetupCacheCluster adds a controller that reconciles CacheCluster.
-func SetupCacheCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupCacheCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(cachev1alpha1.CacheClusterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -62,15 +66,10 @@ func SetupCacheCluster(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&cachev1alpha1.CacheCluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(cachev1alpha1.CacheClusterGroupVersionKind),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(cachev1alpha1.CacheClusterGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient},
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -88,12 +87,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, prober: readiness.NewTCPProber()}, nil
 }
 
 type external struct {
 	client elasticache.Client
 	kube   client.Client
+	prober readiness.Prober
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
@@ -120,7 +120,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	switch cr.Status.AtProvider.CacheClusterStatus {
 	case cachev1alpha1.StatusAvailable:
-		cr.Status.SetConditions(xpv1.Available())
+		host, port := clusterEndpoint(cr.Status.AtProvider)
+		cr.Status.SetConditions(readiness.Check(ctx, e.prober, cr.Spec.ForProvider.ReadinessProbe, host, port))
 	case cachev1alpha1.StatusCreating:
 		cr.Status.SetConditions(xpv1.Creating())
 	case cachev1alpha1.StatusDeleting:
@@ -135,11 +136,39 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: upToDate,
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: connectionDetails(cr.Status.AtProvider),
 	}, nil
 }
 
+// connectionDetails returns the endpoint clients of the cluster connect to,
+// if it has one yet.
+func connectionDetails(o cachev1alpha1.CacheClusterObservation) managed.ConnectionDetails {
+	host, port := clusterEndpoint(o)
+	if host == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(host),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.Itoa(port)),
+	}
+}
+
+// clusterEndpoint returns the configuration endpoint of Memcached clusters, or
+// the endpoint of the first node of Redis clusters.
+func clusterEndpoint(o cachev1alpha1.CacheClusterObservation) (string, int) {
+	if o.ConfigurationEndpoint.Address != "" {
+		return o.ConfigurationEndpoint.Address, o.ConfigurationEndpoint.Port
+	}
+	for _, n := range o.CacheNodes {
+		if n.Endpoint != nil && n.Endpoint.Address != "" {
+			return n.Endpoint.Address, n.Endpoint.Port
+		}
+	}
+	return "", 0
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*cachev1alpha1.CacheCluster)
 	if !ok {
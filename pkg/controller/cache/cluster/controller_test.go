@@ -158,6 +158,48 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"PublishesEndpoint": {
+			args: args{
+				cache: &fake.MockClient{
+					MockDescribeCacheClusters: func(ctx context.Context, input *awscache.DescribeCacheClustersInput, opts []func(*awscache.Options)) (*awscache.DescribeCacheClustersOutput, error) {
+						return &awscache.DescribeCacheClustersOutput{
+							CacheClusters: []awscachetypes.CacheCluster{{
+								CacheClusterStatus:    aws.String(v1alpha1.StatusAvailable),
+								CacheNodeType:         aws.String(nodeType),
+								NumCacheNodes:         aws.Int32(2),
+								CacheClusterId:        aws.String(externalName),
+								ConfigurationEndpoint: &awscachetypes.Endpoint{Address: aws.String("somecluster.cache.amazonaws.com"), Port: 11211},
+							}},
+						}, nil
+					},
+				},
+				cr: cluster(withExternalName(),
+					withSpec(v1alpha1.CacheClusterParameters{
+						CacheNodeType: nodeType,
+						NumCacheNodes: 2,
+					})),
+			},
+			want: want{
+				cr: cluster(withConditions(xpv1.Available()),
+					withExternalName(),
+					withSpec(v1alpha1.CacheClusterParameters{
+						CacheNodeType: nodeType,
+						NumCacheNodes: 2,
+					}),
+					withStatus(v1alpha1.CacheClusterObservation{
+						CacheClusterStatus:    v1alpha1.StatusAvailable,
+						ConfigurationEndpoint: v1alpha1.Endpoint{Address: "somecluster.cache.amazonaws.com", Port: 11211},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte("somecluster.cache.amazonaws.com"),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("11211"),
+					},
+				},
+			},
+		},
 		"DescribeFail": {
 			args: args{
 				cache: &fake.MockClient{
@@ -29,9 +29,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -41,7 +38,10 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/clients/readiness"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // Error strings.
@@ -51,6 +51,8 @@ const (
 	errNotReplicationGroup      = "managed resource is not an ElastiCache replication group"
 	errDescribeReplicationGroup = "cannot describe ElastiCache replication group"
 	errGenerateAuthToken        = "cannot generate ElastiCache auth token"
+	errGetAuthToken             = "cannot get ElastiCache auth token"
+	errModifyAuthToken          = "cannot update ElastiCache replication group auth token"
 	errCreateReplicationGroup   = "cannot create ElastiCache replication group"
 	errModifyReplicationGroup   = "cannot modify ElastiCache replication group"
 	errDeleteReplicationGroup   = "cannot delete ElastiCache replication group"
@@ -58,8 +60,11 @@ const (
 )
 
 // SetupReplicationGroup adds a controller that reconciles ReplicationGroups.
-func SetupReplicationGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupReplicationGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.ReplicationGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -68,16 +73,11 @@ func SetupReplicationGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.ReplicationGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.ReplicationGroupGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewClient},
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -95,12 +95,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, prober: readiness.NewTCPProber()}, nil
 }
 
 type external struct {
 	client elasticache.Client
 	kube   client.Client
+	prober readiness.Prober
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -135,10 +136,31 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		}
 	}
 	cr.Status.AtProvider = elasticache.GenerateObservation(rg)
+	conn := elasticache.ConnectionEndpoint(rg)
+
+	// The connection secret is updated with a changed auth token only once
+	// the replication group has been updated to use it.
+	authTokenUpToDate := true
+	token, changed, err := elasticache.GetAuthToken(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetAuthToken)
+	}
+	if changed {
+		switch {
+		case cr.GetAnnotations()[elasticache.AnnotationKeyAuthTokenUpdate] != elasticache.AuthTokenHash(token):
+			authTokenUpToDate = false
+		case !elasticache.AuthTokenUpdatePending(rg):
+			if conn == nil {
+				conn = managed.ConnectionDetails{}
+			}
+			conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(token)
+		}
+	}
 
 	switch cr.Status.AtProvider.Status {
 	case v1beta1.StatusAvailable:
-		cr.Status.SetConditions(xpv1.Available())
+		host, port := readiness.Endpoint(conn)
+		cr.Status.SetConditions(readiness.Check(ctx, e.prober, cr.Spec.ForProvider.ReadinessProbe, host, port))
 	case v1beta1.StatusCreating:
 		cr.Status.SetConditions(xpv1.Creating())
 	case v1beta1.StatusDeleting:
@@ -149,8 +171,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList) && !elasticache.ReplicationGroupShardConfigurationNeedsUpdate(cr.Spec.ForProvider, rg),
-		ConnectionDetails: elasticache.ConnectionEndpoint(rg),
+		ResourceUpToDate:  authTokenUpToDate && !elasticache.ReplicationGroupNeedsUpdate(cr.Spec.ForProvider, rg, ccList) && !elasticache.ReplicationGroupShardConfigurationNeedsUpdate(cr.Spec.ForProvider, rg),
+		ConnectionDetails: conn,
 	}, nil
 }
 
@@ -167,7 +189,14 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	// with an explanatory message from AWS explaining that transit encryption
 	// is required.
 	var token *string
-	if aws.ToBool(cr.Spec.ForProvider.AuthEnabled) {
+	switch {
+	case cr.Spec.ForProvider.AuthTokenSecretRef != nil:
+		t, _, err := elasticache.GetAuthToken(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, nil)
+		if err != nil {
+			return managed.ExternalCreation{}, errors.Wrap(err, errGetAuthToken)
+		}
+		token = &t
+	case aws.ToBool(cr.Spec.ForProvider.AuthEnabled):
 		t, err := password.Generate()
 		if err != nil {
 			return managed.ExternalCreation{}, awsclient.Wrap(err, errGenerateAuthToken)
@@ -214,6 +243,20 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, nil
 	}
 
+	token, changed, err := elasticache.GetAuthToken(ctx, e.kube, cr.Spec.ForProvider.AuthTokenSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetAuthToken)
+	}
+	if h := elasticache.AuthTokenHash(token); changed && cr.GetAnnotations()[elasticache.AnnotationKeyAuthTokenUpdate] != h {
+		if _, err := e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyAuthTokenInput(cr.Spec.ForProvider, meta.GetExternalName(cr), token)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyAuthToken)
+		}
+		// Record the update, so that the connection secret is updated
+		// once it is complete rather than the update being requested again.
+		meta.AddAnnotations(cr, map[string]string{elasticache.AnnotationKeyAuthTokenUpdate: h})
+		return managed.ExternalUpdate{}, errors.Wrap(e.kube.Update(ctx, cr), errUpdateReplicationGroupCR)
+	}
+
 	_, err = e.client.ModifyReplicationGroup(ctx, elasticache.NewModifyReplicationGroupInput(cr.Spec.ForProvider, meta.GetExternalName(cr)))
 	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyReplicationGroup)
 }
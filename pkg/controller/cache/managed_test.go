@@ -19,6 +19,7 @@ package cache
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
@@ -26,6 +27,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -36,8 +38,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/cache/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	elasticacheclient "github.com/crossplane/provider-aws/pkg/clients/elasticache"
 	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/readiness"
 )
 
 const (
@@ -121,6 +126,10 @@ func withNumNodeGroups(n int) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.NumNodeGroups = &n }
 }
 
+func withReadinessProbe(p *awsv1beta1.ReadinessProbe) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) { r.Spec.ForProvider.ReadinessProbe = p }
+}
+
 func replicationGroup(rm ...replicationGroupModifier) *v1beta1.ReplicationGroup {
 	r := &v1beta1.ReplicationGroup{
 		ObjectMeta: objectMeta,
@@ -281,6 +290,43 @@ func TestObserve(t *testing.T) {
 			),
 			tokenCreated: true,
 		},
+		{
+			name: "FailedReadinessProbeAfterCreationCompleted",
+			e: &external{
+				client: &fake.MockClient{
+					MockDescribeReplicationGroups: func(ctx context.Context, _ *elasticache.DescribeReplicationGroupsInput, opts []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+						return &elasticache.DescribeReplicationGroupsOutput{
+							ReplicationGroups: []types.ReplicationGroup{{
+								ClusterEnabled:        aws.Bool(true),
+								Status:                aws.String(v1beta1.StatusAvailable),
+								ConfigurationEndpoint: &types.Endpoint{Address: aws.String(host), Port: int32(port)},
+							}},
+						}, nil
+					},
+				},
+				prober: readiness.ProberFn(func(_ context.Context, h string, p int, _ time.Duration) error {
+					if h != host || p != port {
+						return errors.Errorf("unexpected endpoint %s:%d", h, p)
+					}
+					return errorBoom
+				}),
+			},
+			r: replicationGroup(
+				withReplicationGroupID(name),
+				withReadinessProbe(&awsv1beta1.ReadinessProbe{}),
+				withClusterEnabled(true),
+			),
+			want: replicationGroup(
+				withReplicationGroupID(name),
+				withReadinessProbe(&awsv1beta1.ReadinessProbe{}),
+				withProviderStatus(v1beta1.StatusAvailable),
+				withConditions(readiness.ProbeFailed(errorBoom)),
+				withEndpoint(host),
+				withPort(port),
+				withClusterEnabled(true),
+			),
+			tokenCreated: true,
+		},
 		{
 			name: "SuccessfulObserveLateInitialized",
 			e: &external{
@@ -637,3 +683,110 @@ func TestInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthTokenUpdate(t *testing.T) {
+	kube := &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			switch key.Name {
+			case "token":
+				s.Data = map[string][]byte{"token": []byte("new")}
+			case "conn":
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte("old")}
+			}
+			return nil
+		},
+		MockUpdate: test.NewMockUpdateFn(nil),
+	}
+	withAuthTokenSecret := func(r *v1beta1.ReplicationGroup) {
+		r.Spec.ForProvider.AuthTokenSecretRef = &xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "token"}, Key: "token"}
+		r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "conn"}
+	}
+	withUpdateRequested := func(r *v1beta1.ReplicationGroup) {
+		meta.AddAnnotations(r, map[string]string{elasticacheclient.AnnotationKeyAuthTokenUpdate: elasticacheclient.AuthTokenHash("new")})
+	}
+	describe := func(status string, pending *types.ReplicationGroupPendingModifiedValues) func(context.Context, *elasticache.DescribeReplicationGroupsInput, []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+		return func(context.Context, *elasticache.DescribeReplicationGroupsInput, []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error) {
+			return &elasticache.DescribeReplicationGroupsOutput{
+				ReplicationGroups: []types.ReplicationGroup{{
+					Status:                 aws.String(status),
+					AutomaticFailover:      types.AutomaticFailoverStatusEnabled,
+					CacheNodeType:          aws.String(cacheNodeType),
+					SnapshotRetentionLimit: aws.Int32(int32(snapshotRetentionLimit)),
+					SnapshotWindow:         aws.String(snapshotWindow),
+					PendingModifiedValues:  pending,
+				}},
+			}, nil
+		}
+	}
+
+	type want struct {
+		upToDate bool
+		password string
+	}
+
+	cases := map[string]struct {
+		describe func(context.Context, *elasticache.DescribeReplicationGroupsInput, []func(*elasticache.Options)) (*elasticache.DescribeReplicationGroupsOutput, error)
+		r        *v1beta1.ReplicationGroup
+		want     want
+	}{
+		"UpdateRequired": {
+			describe: describe(v1beta1.StatusAvailable, nil),
+			r:        replicationGroup(withAuthTokenSecret),
+			want:     want{upToDate: false},
+		},
+		"UpdatePending": {
+			describe: describe(v1beta1.StatusModifying, &types.ReplicationGroupPendingModifiedValues{AuthTokenStatus: types.AuthTokenUpdateStatusRotating}),
+			r:        replicationGroup(withAuthTokenSecret, withUpdateRequested),
+			want:     want{upToDate: true},
+		},
+		"UpdateComplete": {
+			describe: describe(v1beta1.StatusAvailable, nil),
+			r:        replicationGroup(withAuthTokenSecret, withUpdateRequested),
+			want:     want{upToDate: true, password: "new"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: &fake.MockClient{MockDescribeReplicationGroups: tc.describe}, kube: kube, prober: readiness.NewTCPProber()}
+			o, err := e.Observe(ctx, tc.r)
+			if err != nil {
+				t.Fatalf("e.Observe(...): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, o.ResourceUpToDate); diff != "" {
+				t.Errorf("e.Observe(...): -want up to date, +got up to date:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.password, string(o.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey])); diff != "" {
+				t.Errorf("e.Observe(...): -want password, +got password:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("RequestsUpdate", func(t *testing.T) {
+		var modified *elasticache.ModifyReplicationGroupInput
+		e := &external{client: &fake.MockClient{
+			MockDescribeReplicationGroups: describe(v1beta1.StatusAvailable, nil),
+			MockModifyReplicationGroup: func(_ context.Context, in *elasticache.ModifyReplicationGroupInput, _ []func(*elasticache.Options)) (*elasticache.ModifyReplicationGroupOutput, error) {
+				modified = in
+				return &elasticache.ModifyReplicationGroupOutput{}, nil
+			},
+		}, kube: kube}
+		cr := replicationGroup(withAuthTokenSecret, withProviderStatus(v1beta1.StatusAvailable))
+		if _, err := e.Update(ctx, cr); err != nil {
+			t.Fatalf("e.Update(...): unexpected error: %s", err)
+		}
+		want := &elasticache.ModifyReplicationGroupInput{
+			ReplicationGroupId:      aws.String(name),
+			ApplyImmediately:        true,
+			AuthToken:               aws.String("new"),
+			AuthTokenUpdateStrategy: types.AuthTokenUpdateStrategyTypeRotate,
+		}
+		if diff := cmp.Diff(want, modified, cmpopts.IgnoreUnexported(elasticache.ModifyReplicationGroupInput{})); diff != "" {
+			t.Errorf("e.Update(...): -want input, +got input:\n%s", diff)
+		}
+		if diff := cmp.Diff(elasticacheclient.AuthTokenHash("new"), cr.GetAnnotations()[elasticacheclient.AnnotationKeyAuthTokenUpdate]); diff != "" {
+			t.Errorf("e.Update(...): -want annotation, +got annotation:\n%s", diff)
+		}
+	})
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservedcachenodecoverage
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+// Error strings.
+const (
+	errNotCoverage       = "managed resource is not a ReservedCacheNodeCoverage"
+	errListReservedNodes = "cannot list reserved cache nodes"
+	errListCacheClusters = "cannot list cache clusters"
+)
+
+// SetupReservedCacheNodeCoverage adds a controller that reconciles
+// ReservedCacheNodeCoverages.
+func SetupReservedCacheNodeCoverage(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(cachev1alpha1.ReservedCacheNodeCoverageGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&cachev1alpha1.ReservedCacheNodeCoverage{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(cachev1alpha1.ReservedCacheNodeCoverageGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: elasticache.NewReservationClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) elasticache.ReservationClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*cachev1alpha1.ReservedCacheNodeCoverage)
+	if !ok {
+		return nil, errors.New(errNotCoverage)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+// external only ever reads from AWS. The resource is reported as existing
+// and up to date until it is deleted, at which point it is reported as gone
+// so that the managed reconciler can remove its finalizer.
+type external struct {
+	client elasticache.ReservationClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*cachev1alpha1.ReservedCacheNodeCoverage)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCoverage)
+	}
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	reserved, err := elasticache.ListReservedCacheNodes(ctx, e.client)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListReservedNodes)
+	}
+	clusters, err := elasticache.ListCacheClusters(ctx, e.client)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListCacheClusters)
+	}
+
+	cr.Status.AtProvider = elasticache.GenerateReservedCacheNodeCoverageObservation(cr.Spec.ForProvider, reserved, clusters)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	return nil
+}
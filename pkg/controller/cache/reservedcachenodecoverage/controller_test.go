@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reservedcachenodecoverage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awscache "github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awscachetypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	cachev1alpha1 "github.com/crossplane/provider-aws/apis/cache/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/elasticache/fake"
+)
+
+var errBoom = errors.New("boom")
+
+type coverageModifier func(*cachev1alpha1.ReservedCacheNodeCoverage)
+
+func withConditions(c ...xpv1.Condition) coverageModifier {
+	return func(r *cachev1alpha1.ReservedCacheNodeCoverage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAtProvider(o cachev1alpha1.ReservedCacheNodeCoverageObservation) coverageModifier {
+	return func(r *cachev1alpha1.ReservedCacheNodeCoverage) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() coverageModifier {
+	return func(r *cachev1alpha1.ReservedCacheNodeCoverage) {
+		now := metav1.Now()
+		r.SetDeletionTimestamp(&now)
+	}
+}
+
+func coverage(m ...coverageModifier) *cachev1alpha1.ReservedCacheNodeCoverage {
+	cr := &cachev1alpha1.ReservedCacheNodeCoverage{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	deleted := coverage(withDeletionTimestamp())
+
+	type args struct {
+		client *fake.MockReservationClient
+		cr     resource.Managed
+	}
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedCacheNodes: func(context.Context, *awscache.DescribeReservedCacheNodesInput, []func(*awscache.Options)) (*awscache.DescribeReservedCacheNodesOutput, error) {
+						return &awscache.DescribeReservedCacheNodesOutput{}, nil
+					},
+					MockDescribeCacheClusters: func(context.Context, *awscache.DescribeCacheClustersInput, []func(*awscache.Options)) (*awscache.DescribeCacheClustersOutput, error) {
+						return &awscache.DescribeCacheClustersOutput{CacheClusters: []awscachetypes.CacheCluster{{
+							CacheNodeType:      aws.String("cache.t3.micro"),
+							Engine:             aws.String("redis"),
+							NumCacheNodes:      aws.Int32(1),
+							CacheClusterStatus: aws.String("available"),
+						}}}, nil
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr: coverage(withConditions(xpv1.Available()), withAtProvider(cachev1alpha1.ReservedCacheNodeCoverageObservation{
+					Coverage: []cachev1alpha1.CacheNodeTypeCoverage{{
+						CacheNodeType:      "cache.t3.micro",
+						ProductDescription: "redis",
+						RunningNodes:       1,
+						UncoveredNodes:     1,
+					}},
+					UncoveredNodes: 1,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockReservationClient{},
+				cr:     deleted,
+			},
+			want: want{
+				cr: deleted,
+			},
+		},
+		"ListReservedNodesError": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedCacheNodes: func(context.Context, *awscache.DescribeReservedCacheNodesInput, []func(*awscache.Options)) (*awscache.DescribeReservedCacheNodesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr:  coverage(),
+				err: awsclient.Wrap(errBoom, errListReservedNodes),
+			},
+		},
+		"ListCacheClustersError": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedCacheNodes: func(context.Context, *awscache.DescribeReservedCacheNodesInput, []func(*awscache.Options)) (*awscache.DescribeReservedCacheNodesOutput, error) {
+						return &awscache.DescribeReservedCacheNodesOutput{}, nil
+					},
+					MockDescribeCacheClusters: func(context.Context, *awscache.DescribeCacheClustersInput, []func(*awscache.Options)) (*awscache.DescribeCacheClustersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr:  coverage(),
+				err: awsclient.Wrap(errBoom, errListCacheClusters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscf "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudformation/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudformation"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Stack resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe Stack"
+	errGetTemplate      = "failed to get template of Stack"
+	errDetectDrift      = "failed to detect drift of Stack"
+	errCreate           = "failed to create Stack"
+	errUpdate           = "failed to update Stack"
+	errDelete           = "failed to delete Stack"
+	errGetConfigMap     = "cannot get template ConfigMap"
+	errKeyNotFoundFmt   = "key %s not found in ConfigMap %s/%s"
+	errUpdateCR         = "cannot update Stack custom resource"
+)
+
+// SetupStack adds a controller that reconciles Stacks.
+func SetupStack(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.StackGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Stack{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.StackGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: cloudformation.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) cloudformation.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudformation.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	if err := cloudformation.IsTemplateSourceValid(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	resp, err := e.client.DescribeStacksWithContext(ctx, &awscf.DescribeStacksInput{
+		StackName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudformation.IsNotFound, err), errDescribe)
+	}
+	if len(resp.Stacks) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	s := resp.Stacks[0]
+
+	obs := cloudformation.GenerateObservation(s)
+	obs.CallerAccountID = cr.Status.AtProvider.CallerAccountID
+	cr.Status.AtProvider = obs
+
+	switch status := awsclient.StringValue(s.StackStatus); status {
+	case awscf.StackStatusCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awscf.StackStatusDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awscf.StackStatusCreateComplete, awscf.StackStatusUpdateComplete, awscf.StackStatusImportComplete,
+		awscf.StackStatusUpdateRollbackComplete, awscf.StackStatusImportRollbackComplete:
+		cr.SetConditions(xpv1.Available())
+	case awscf.StackStatusUpdateInProgress, awscf.StackStatusUpdateCompleteCleanupInProgress,
+		awscf.StackStatusUpdateRollbackInProgress, awscf.StackStatusUpdateRollbackCompleteCleanupInProgress:
+		// The stack keeps serving its resources while it is being
+		// updated, so it stays available.
+		cr.SetConditions(xpv1.Available())
+	default:
+		// Failed and rolled back stacks, e.g. ROLLBACK_COMPLETE after a
+		// failed creation, cannot be used and can mostly only be deleted.
+		cr.SetConditions(xpv1.Unavailable().WithMessage(status + ": " + obs.StackStatusReason))
+	}
+
+	obsv := managed.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: cloudformation.GenerateConnectionDetails(s),
+	}
+	// CloudFormation rejects updates and drift detections of stacks that are
+	// in progress, so both wait until the stack settled.
+	if cloudformation.IsInProgress(awsclient.StringValue(s.StackStatus)) {
+		return obsv, nil
+	}
+
+	upToDate, err := e.isUpToDate(ctx, cr, s)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	obsv.ResourceUpToDate = upToDate
+
+	// Drift is only reported in the status of the stack. It does not
+	// trigger an update, since the template itself did not change.
+	if cloudformation.IsDriftDetectionDue(cr.Spec.ForProvider, s, time.Now()) {
+		if _, err := e.client.DetectStackDriftWithContext(ctx, &awscf.DetectStackDriftInput{
+			StackName: awsclient.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDetectDrift)
+		}
+	}
+	return obsv, nil
+}
+
+func (e *external) isUpToDate(ctx context.Context, cr *v1alpha1.Stack, s *awscf.Stack) (bool, error) {
+	if !cloudformation.IsStackUpToDate(cr.Spec.ForProvider, s) {
+		return false, nil
+	}
+	if cr.Spec.ForProvider.TemplateURL != nil {
+		return cloudformation.IsTemplateURLUpToDate(cr), nil
+	}
+	desired, err := e.getTemplateBody(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	resp, err := e.client.GetTemplateWithContext(ctx, &awscf.GetTemplateInput{
+		StackName:     awsclient.String(meta.GetExternalName(cr)),
+		TemplateStage: awsclient.String(awscf.TemplateStageOriginal),
+	})
+	if err != nil {
+		return false, awsclient.Wrap(err, errGetTemplate)
+	}
+	return cloudformation.IsTemplateUpToDate(desired, awsclient.StringValue(resp.TemplateBody)), nil
+}
+
+// getTemplateBody returns the inline template or the template held by the
+// referenced ConfigMap key. It returns an empty template if the stack uses a
+// template URL.
+func (e *external) getTemplateBody(ctx context.Context, p v1alpha1.StackParameters) (string, error) {
+	if p.TemplateBodyFrom == nil {
+		return awsclient.StringValue(p.TemplateBody), nil
+	}
+	ref := p.TemplateBodyFrom.ConfigMapKeyRef
+	cm := &corev1.ConfigMap{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+		return "", errors.Wrap(err, errGetConfigMap)
+	}
+	body, ok := cm.Data[ref.Key]
+	if !ok {
+		return "", errors.Errorf(errKeyNotFoundFmt, ref.Key, ref.Namespace, ref.Name)
+	}
+	return body, nil
+}
+
+// recordTemplateURL records the template URL the stack was created or updated
+// with, so that changes of the desired URL can be detected.
+func (e *external) recordTemplateURL(ctx context.Context, cr *v1alpha1.Stack) error {
+	url := awsclient.StringValue(cr.Spec.ForProvider.TemplateURL)
+	if url == "" || cr.GetAnnotations()[cloudformation.AnnotationKeyTemplateURL] == url {
+		return nil
+	}
+	meta.AddAnnotations(cr, map[string]string{cloudformation.AnnotationKeyTemplateURL: url})
+	return errors.Wrap(e.kube.Update(ctx, cr), errUpdateCR)
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	if err := cloudformation.IsTemplateSourceValid(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	cr.SetConditions(xpv1.Creating())
+	body, err := e.getTemplateBody(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if _, err := e.client.CreateStackWithContext(ctx, cloudformation.GenerateCreateStackInput(meta.GetExternalName(cr), cr.Spec.ForProvider, body)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{}, e.recordTemplateURL(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	body, err := e.getTemplateBody(ctx, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err = e.client.UpdateStackWithContext(ctx, cloudformation.GenerateUpdateStackInput(meta.GetExternalName(cr), cr.Spec.ForProvider, body))
+	if err != nil && !cloudformation.IsNoUpdates(err) {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, e.recordTemplateURL(ctx, cr)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Stack)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteStackWithContext(ctx, &awscf.DeleteStackInput{
+		StackName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(cloudformation.IsNotFound, err), errDelete)
+}
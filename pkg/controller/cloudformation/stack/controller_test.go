@@ -0,0 +1,541 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscf "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudformation/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudformation"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudformation/fake"
+)
+
+var (
+	stackName   = "network"
+	stackID     = "arn:aws:cloudformation:us-east-1:123456789012:stack/network/abc123"
+	template    = `{"Resources":{"Topic":{"Type":"AWS::SNS::Topic"}}}`
+	templateURL = "https://bucket.s3.amazonaws.com/network.yaml"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	kube   client.Client
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type stackModifier func(*v1alpha1.Stack)
+
+func withExternalName(n string) stackModifier {
+	return func(r *v1alpha1.Stack) { meta.SetExternalName(r, n) }
+}
+
+func withTemplateURL(u string) stackModifier {
+	return func(r *v1alpha1.Stack) {
+		r.Spec.ForProvider.TemplateBody = nil
+		r.Spec.ForProvider.TemplateURL = &u
+	}
+}
+
+func withTemplateFrom() stackModifier {
+	return func(r *v1alpha1.Stack) {
+		r.Spec.ForProvider.TemplateBody = nil
+		r.Spec.ForProvider.TemplateBodyFrom = &v1alpha1.TemplateSource{
+			ConfigMapKeyRef: v1alpha1.ConfigMapKeySelector{Name: "templates", Namespace: "default", Key: "network"},
+		}
+	}
+}
+
+func withParameter(k, v string) stackModifier {
+	return func(r *v1alpha1.Stack) {
+		r.Spec.ForProvider.Parameters = append(r.Spec.ForProvider.Parameters, v1alpha1.StackParameter{Key: k, Value: v})
+	}
+}
+
+func withDriftDetectionInterval(d time.Duration) stackModifier {
+	return func(r *v1alpha1.Stack) { r.Spec.ForProvider.DriftDetectionInterval = &metav1.Duration{Duration: d} }
+}
+
+func withAnnotations(a map[string]string) stackModifier {
+	return func(r *v1alpha1.Stack) { meta.AddAnnotations(r, a) }
+}
+
+func withObservation(o v1alpha1.StackObservation) stackModifier {
+	return func(r *v1alpha1.Stack) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) stackModifier {
+	return func(r *v1alpha1.Stack) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func stack(m ...stackModifier) *v1alpha1.Stack {
+	cr := &v1alpha1.Stack{}
+	cr.Spec.ForProvider.TemplateBody = &template
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeStacks(status string, drift *awscf.StackDriftInformation, params ...*awscf.Parameter) func(context.Context, *awscf.DescribeStacksInput, []request.Option) (*awscf.DescribeStacksOutput, error) {
+	return func(context.Context, *awscf.DescribeStacksInput, []request.Option) (*awscf.DescribeStacksOutput, error) {
+		return &awscf.DescribeStacksOutput{Stacks: []*awscf.Stack{{
+			StackId:          &stackID,
+			StackName:        &stackName,
+			StackStatus:      aws.String(status),
+			DriftInformation: drift,
+			Parameters:       params,
+			Outputs:          []*awscf.Output{{OutputKey: aws.String("TopicArn"), OutputValue: aws.String("arn")}},
+		}}}, nil
+	}
+}
+
+func getTemplate(body string) func(context.Context, *awscf.GetTemplateInput, []request.Option) (*awscf.GetTemplateOutput, error) {
+	return func(context.Context, *awscf.GetTemplateInput, []request.Option) (*awscf.GetTemplateOutput, error) {
+		return &awscf.GetTemplateOutput{TemplateBody: &body}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	checked := time.Now().Add(-time.Minute).Truncate(time.Second)
+	inSync := &awscf.StackDriftInformation{StackDriftStatus: aws.String(awscf.StackDriftStatusInSync), LastCheckTimestamp: &checked}
+	connection := managed.ConnectionDetails{"TopicArn": []byte("arn")}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: func(context.Context, *awscf.DescribeStacksInput, []request.Option) (*awscf.DescribeStacksOutput, error) {
+						return nil, awserr.New("ValidationError", "Stack with id network does not exist", nil)
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName)),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: func(context.Context, *awscf.DescribeStacksInput, []request.Option) (*awscf.DescribeStacksOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr:  stack(withExternalName(stackName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"InvalidTemplateSource": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     stack(withExternalName(stackName), withTemplateURL(templateURL), func(r *v1alpha1.Stack) { r.Spec.ForProvider.TemplateBody = &template }),
+			},
+			want: want{
+				cr:  stack(withExternalName(stackName), withTemplateURL(templateURL), func(r *v1alpha1.Stack) { r.Spec.ForProvider.TemplateBody = &template }),
+				err: cloudformation.IsTemplateSourceValid(v1alpha1.StackParameters{TemplateBody: &template, TemplateURL: &templateURL}),
+			},
+		},
+		"CreateInProgress": {
+			args: args{
+				client: &fake.MockClient{MockDescribeStacks: describeStacks(awscf.StackStatusCreateInProgress, nil)},
+				cr:     stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateInProgress}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connection},
+			},
+		},
+		"RolledBack": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusRollbackComplete, inSync),
+					MockGetTemplate:    getTemplate(template),
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusRollbackComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Unavailable().WithMessage(awscf.StackStatusRollbackComplete+": "))),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connection},
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusCreateComplete, inSync),
+					MockGetTemplate:    getTemplate(`{"Resources": {"Topic": {"Type": "AWS::SNS::Topic"}}}`),
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connection},
+			},
+		},
+		"ParameterChanged": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusUpdateComplete, inSync,
+						&awscf.Parameter{ParameterKey: aws.String("Env"), ParameterValue: aws.String("dev")}),
+				},
+				cr: stack(withExternalName(stackName), withParameter("Env", "prod")),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withParameter("Env", "prod"),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusUpdateComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: connection},
+			},
+		},
+		"TemplateFromConfigMapChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"network": `{"Resources":{}}`}
+						return nil
+					},
+				},
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusCreateComplete, inSync),
+					MockGetTemplate:    getTemplate(template),
+				},
+				cr: stack(withExternalName(stackName), withTemplateFrom()),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withTemplateFrom(),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: connection},
+			},
+		},
+		"TemplateURLChanged": {
+			args: args{
+				client: &fake.MockClient{MockDescribeStacks: describeStacks(awscf.StackStatusCreateComplete, inSync)},
+				cr: stack(withExternalName(stackName), withTemplateURL(templateURL),
+					withAnnotations(map[string]string{cloudformation.AnnotationKeyTemplateURL: "https://bucket.s3.amazonaws.com/old.yaml"})),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withTemplateURL(templateURL),
+					withAnnotations(map[string]string{cloudformation.AnnotationKeyTemplateURL: "https://bucket.s3.amazonaws.com/old.yaml"}),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: connection},
+			},
+		},
+		"DriftDetectionDue": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusCreateComplete, inSync),
+					MockGetTemplate:    getTemplate(template),
+					MockDetectStackDrift: func(_ context.Context, in *awscf.DetectStackDriftInput, _ []request.Option) (*awscf.DetectStackDriftOutput, error) {
+						if aws.StringValue(in.StackName) != stackName {
+							return nil, errBoom
+						}
+						return &awscf.DetectStackDriftOutput{}, nil
+					},
+				},
+				cr: stack(withExternalName(stackName), withDriftDetectionInterval(time.Second)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withDriftDetectionInterval(time.Second),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateComplete, DriftStatus: awscf.StackDriftStatusInSync, LastDriftCheckTime: &metav1.Time{Time: checked}}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: connection},
+			},
+		},
+		"DetectDriftError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeStacks: describeStacks(awscf.StackStatusCreateComplete, nil),
+					MockGetTemplate:    getTemplate(template),
+					MockDetectStackDrift: func(context.Context, *awscf.DetectStackDriftInput, []request.Option) (*awscf.DetectStackDriftOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName),
+					withObservation(v1alpha1.StackObservation{StackID: stackID, StackStatus: awscf.StackStatusCreateComplete}),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errDetectDrift),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateStack: func(_ context.Context, in *awscf.CreateStackInput, _ []request.Option) (*awscf.CreateStackOutput, error) {
+						if aws.StringValue(in.StackName) != stackName || aws.StringValue(in.TemplateBody) != template {
+							return nil, errBoom
+						}
+						return &awscf.CreateStackOutput{StackId: &stackID}, nil
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withConditions(xpv1.Creating())),
+			},
+		},
+		"TemplateURLRecorded": {
+			args: args{
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				client: &fake.MockClient{
+					MockCreateStack: func(_ context.Context, in *awscf.CreateStackInput, _ []request.Option) (*awscf.CreateStackOutput, error) {
+						if aws.StringValue(in.TemplateURL) != templateURL || in.TemplateBody != nil {
+							return nil, errBoom
+						}
+						return &awscf.CreateStackOutput{StackId: &stackID}, nil
+					},
+				},
+				cr: stack(withExternalName(stackName), withTemplateURL(templateURL)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withTemplateURL(templateURL), withConditions(xpv1.Creating()),
+					withAnnotations(map[string]string{cloudformation.AnnotationKeyTemplateURL: templateURL})),
+			},
+		},
+		"ConfigMapKeyNotFound": {
+			args: args{
+				kube:   &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				client: &fake.MockClient{},
+				cr:     stack(withExternalName(stackName), withTemplateFrom()),
+			},
+			want: want{
+				cr:  stack(withExternalName(stackName), withTemplateFrom(), withConditions(xpv1.Creating())),
+				err: errors.Errorf(errKeyNotFoundFmt, "network", "default", "templates"),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateStack: func(context.Context, *awscf.CreateStackInput, []request.Option) (*awscf.CreateStackOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr:  stack(withExternalName(stackName), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateStack: func(_ context.Context, in *awscf.UpdateStackInput, _ []request.Option) (*awscf.UpdateStackOutput, error) {
+						if len(in.Parameters) != 1 || aws.StringValue(in.Parameters[0].ParameterValue) != "prod" {
+							return nil, errBoom
+						}
+						return &awscf.UpdateStackOutput{StackId: &stackID}, nil
+					},
+				},
+				cr: stack(withExternalName(stackName), withParameter("Env", "prod")),
+			},
+		},
+		"NoUpdates": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateStack: func(context.Context, *awscf.UpdateStackInput, []request.Option) (*awscf.UpdateStackOutput, error) {
+						return nil, awserr.New("ValidationError", "No updates are to be performed.", nil)
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockUpdateStack: func(context.Context, *awscf.UpdateStackInput, []request.Option) (*awscf.UpdateStackOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteStack: func(context.Context, *awscf.DeleteStackInput, []request.Option) (*awscf.DeleteStackOutput, error) {
+						return &awscf.DeleteStackOutput{}, nil
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteStack: func(context.Context, *awscf.DeleteStackInput, []request.Option) (*awscf.DeleteStackOutput, error) {
+						return nil, awserr.New("ValidationError", "Stack with id network does not exist", nil)
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr: stack(withExternalName(stackName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteStack: func(context.Context, *awscf.DeleteStackInput, []request.Option) (*awscf.DeleteStackOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: stack(withExternalName(stackName)),
+			},
+			want: want{
+				cr:  stack(withExternalName(stackName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
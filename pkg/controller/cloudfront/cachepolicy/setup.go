@@ -21,9 +21,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,13 +29,19 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupCachePolicy adds a controller that reconciles CachePolicy.
-func SetupCachePolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupCachePolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.CachePolicyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -47,11 +50,10 @@ func SetupCachePolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.CachePolicy{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.CachePolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.CachePolicyGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<cache-policy-id>", "cloudfront", "cache-policy"), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -65,9 +67,6 @@ func SetupCachePolicy(mgr ctrl.Manager, o controller.Options) error {
 					},
 				},
 			}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
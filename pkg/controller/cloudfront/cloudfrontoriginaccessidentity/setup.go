@@ -21,9 +21,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -33,12 +30,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupCloudFrontOriginAccessIdentity adds a controller that reconciles CloudFrontOriginAccessIdentity .
-func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, o controller.Options) error {
+func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.CloudFrontOriginAccessIdentityGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -47,11 +50,10 @@ func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, o controller.Options)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.CloudFrontOriginAccessIdentity{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.CloudFrontOriginAccessIdentityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.CloudFrontOriginAccessIdentityGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<origin-access-identity-id>", "cloudfront", "origin-access-identity"), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -65,9 +67,6 @@ func SetupCloudFrontOriginAccessIdentity(mgr ctrl.Manager, o controller.Options)
 					},
 				},
 			}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
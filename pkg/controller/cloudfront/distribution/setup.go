@@ -26,9 +26,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,18 +34,29 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // TODO: Aren't these defined as an API constant somewhere in aws-sdk-go?
 // Generated zz_enums.go seems not to contain it either
 const (
-	stateDeployed = "Deployed"
+	stateDeployed   = "Deployed"
+	stateInProgress = "InProgress"
 )
 
+// Distributions are deploying while they're in progress, which takes minutes.
+var transitionalStates = pollinterval.Transitional(stateInProgress)
+
 // SetupDistribution adds a controller that reconciles Distribution.
-func SetupDistribution(mgr ctrl.Manager, o controller.Options) error {
+func SetupDistribution(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.DistributionGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -57,11 +65,10 @@ func SetupDistribution(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Distribution{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.DistributionGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<distribution-id>", "cloudfront", "distribution"), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -78,9 +85,6 @@ func SetupDistribution(mgr ctrl.Manager, o controller.Options) error {
 					},
 				},
 			}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -295,12 +299,14 @@ func isUpToDate(cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput)
 	), nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput,
+func postObserve(ctx context.Context, cr *svcapitypes.Distribution, gdo *svcsdk.GetDistributionOutput,
 	eo managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
+	transitionalStates.Hint(ctx, awsclients.StringValue(gdo.Distribution.Status))
+
 	if awsclients.StringValue(gdo.Distribution.Status) == stateDeployed &&
 		awsclients.BoolValue(gdo.Distribution.DistributionConfig.Enabled) {
 		cr.SetConditions(xpv1.Available())
@@ -0,0 +1,331 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keygroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	groupID = "G123"
+	etag    = "E1"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	cf *fake.MockClient
+	cr resource.Managed
+}
+
+type keyGroupModifier func(*svcapitypes.KeyGroup)
+
+func withPublicKeyIDs(ids ...string) keyGroupModifier {
+	return func(r *svcapitypes.KeyGroup) { r.Spec.ForProvider.PublicKeyIDs = ids }
+}
+
+func withExternalName(n string) keyGroupModifier {
+	return func(r *svcapitypes.KeyGroup) { meta.SetExternalName(r, n) }
+}
+
+func withETag(e string) keyGroupModifier {
+	return func(r *svcapitypes.KeyGroup) { r.Status.AtProvider.ETag = e }
+}
+
+func withConditions(c ...xpv1.Condition) keyGroupModifier {
+	return func(r *svcapitypes.KeyGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func keyGroup(m ...keyGroupModifier) *svcapitypes.KeyGroup {
+	cr := &svcapitypes.KeyGroup{
+		Spec: svcapitypes.KeyGroupSpec{
+			ForProvider: svcapitypes.KeyGroupParameters{
+				Name: "group",
+			},
+		},
+	}
+	meta.SetExternalName(cr, groupID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getKeyGroup(ids ...string) func(context.Context, *svcsdk.GetKeyGroupInput, []request.Option) (*svcsdk.GetKeyGroupOutput, error) {
+	return func(context.Context, *svcsdk.GetKeyGroupInput, []request.Option) (*svcsdk.GetKeyGroupOutput, error) {
+		cfg := &svcsdk.KeyGroupConfig{Name: awsclient.String("group")}
+		for i := range ids {
+			cfg.Items = append(cfg.Items, &ids[i])
+		}
+		return &svcsdk.GetKeyGroupOutput{
+			ETag:     &etag,
+			KeyGroup: &svcsdk.KeyGroup{Id: &groupID, KeyGroupConfig: cfg},
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetKeyGroup: func(context.Context, *svcsdk.GetKeyGroupInput, []request.Option) (*svcsdk.GetKeyGroupOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeNoSuchResource, "", nil)
+					},
+				},
+				cr: keyGroup(),
+			},
+			want: want{
+				cr: keyGroup(),
+			},
+		},
+		"GetError": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetKeyGroup: func(context.Context, *svcsdk.GetKeyGroupInput, []request.Option) (*svcsdk.GetKeyGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: keyGroup(),
+			},
+			want: want{
+				cr:  keyGroup(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetKeyGroup: getKeyGroup("b", "a"),
+				},
+				cr: keyGroup(withPublicKeyIDs("a", "b")),
+			},
+			want: want{
+				cr: keyGroup(withPublicKeyIDs("a", "b"), withETag(etag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"KeyRotated": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetKeyGroup: getKeyGroup("a"),
+				},
+				cr: keyGroup(withPublicKeyIDs("a", "b")),
+			},
+			want: want{
+				cr: keyGroup(withPublicKeyIDs("a", "b"), withETag(etag), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cf}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cf: &fake.MockClient{
+					MockCreateKeyGroup: func(_ context.Context, input *svcsdk.CreateKeyGroupInput, _ []request.Option) (*svcsdk.CreateKeyGroupOutput, error) {
+						if len(input.KeyGroupConfig.Items) != 1 {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateKeyGroupOutput{KeyGroup: &svcsdk.KeyGroup{Id: &groupID}}, nil
+					},
+				},
+				cr: keyGroup(withExternalName(""), withPublicKeyIDs("a")),
+			},
+			want: want{
+				cr: keyGroup(withPublicKeyIDs("a"), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				cf: &fake.MockClient{
+					MockCreateKeyGroup: func(context.Context, *svcsdk.CreateKeyGroupInput, []request.Option) (*svcsdk.CreateKeyGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: keyGroup(withExternalName("")),
+			},
+			want: want{
+				cr:  keyGroup(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cf}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cf: &fake.MockClient{
+					MockUpdateKeyGroup: func(_ context.Context, input *svcsdk.UpdateKeyGroupInput, _ []request.Option) (*svcsdk.UpdateKeyGroupOutput, error) {
+						if awsclient.StringValue(input.IfMatch) != etag || awsclient.StringValue(input.Id) != groupID {
+							return nil, errBoom
+						}
+						return &svcsdk.UpdateKeyGroupOutput{}, nil
+					},
+				},
+				cr: keyGroup(withETag(etag), withPublicKeyIDs("a", "b")),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				cf: &fake.MockClient{
+					MockUpdateKeyGroup: func(context.Context, *svcsdk.UpdateKeyGroupInput, []request.Option) (*svcsdk.UpdateKeyGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: keyGroup(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cf}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				cf: &fake.MockClient{
+					MockDeleteKeyGroup: func(context.Context, *svcsdk.DeleteKeyGroupInput, []request.Option) (*svcsdk.DeleteKeyGroupOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeNoSuchResource, "", nil)
+					},
+				},
+				cr: keyGroup(),
+			},
+		},
+		"InUse": {
+			args: args{
+				cf: &fake.MockClient{
+					MockDeleteKeyGroup: func(context.Context, *svcsdk.DeleteKeyGroupInput, []request.Option) (*svcsdk.DeleteKeyGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: keyGroup(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cf}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publickey
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a PublicKey resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get PublicKey"
+	errCreate           = "failed to create PublicKey"
+	errUpdate           = "failed to update PublicKey"
+	errDelete           = "failed to delete PublicKey"
+	errKeyChanged       = "the encoded key of a PublicKey cannot be changed, create a new PublicKey instead"
+	errNoEncodedKey     = "either encodedKey or encodedKeyFrom must be specified"
+	errGetConfigMap     = "cannot get encoded key ConfigMap"
+	errGetSecret        = "cannot get encoded key Secret"
+	errKeyNotFoundFmt   = "key %q not found"
+)
+
+// SetupPublicKey adds a controller that reconciles PublicKeys.
+func SetupPublicKey(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(svcapitypes.PublicKeyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&svcapitypes.PublicKey{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.PublicKeyGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: cloudfront.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) cloudfront.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.PublicKey)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client cloudfront.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.PublicKey)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetPublicKeyWithContext(ctx, &svcsdk.GetPublicKeyInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errGet)
+	}
+	key, err := getEncodedKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.ETag = awsclient.StringValue(resp.ETag)
+	if resp.PublicKey.CreatedTime != nil {
+		t := metav1.NewTime(*resp.PublicKey.CreatedTime)
+		cr.Status.AtProvider.CreatedTime = &t
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudfront.IsPublicKeyUpToDate(cr.Spec.ForProvider, key, resp.PublicKey.PublicKeyConfig),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.PublicKey)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	key, err := getEncodedKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	resp, err := e.client.CreatePublicKeyWithContext(ctx, &svcsdk.CreatePublicKeyInput{
+		PublicKeyConfig: cloudfront.GeneratePublicKeyConfig(string(cr.UID), cr.Spec.ForProvider, key),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.PublicKey.Id))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.PublicKey)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetPublicKeyWithContext(ctx, &svcsdk.GetPublicKeyInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	key, err := getEncodedKey(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	observed := resp.PublicKey.PublicKeyConfig
+	// Only the comment of a public key can be updated.
+	if !cloudfront.IsPublicKeyEncodedKeyUpToDate(key, observed) {
+		return managed.ExternalUpdate{}, errors.New(errKeyChanged)
+	}
+	observed.Comment = cr.Spec.ForProvider.Comment
+	_, err = e.client.UpdatePublicKeyWithContext(ctx, &svcsdk.UpdatePublicKeyInput{
+		Id:              awsclient.String(meta.GetExternalName(cr)),
+		IfMatch:         resp.ETag,
+		PublicKeyConfig: observed,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.PublicKey)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeletePublicKeyWithContext(ctx, &svcsdk.DeletePublicKeyInput{
+		Id:      awsclient.String(meta.GetExternalName(cr)),
+		IfMatch: awsclient.String(cr.Status.AtProvider.ETag),
+	})
+	return awsclient.Wrap(resource.Ignore(cloudfront.IsNotFound, err), errDelete)
+}
+
+// getEncodedKey returns the PEM encoded public key, which is either
+// specified inline or read from the referenced ConfigMap or Secret.
+func getEncodedKey(ctx context.Context, kube client.Client, p svcapitypes.PublicKeyParameters) (string, error) {
+	switch {
+	case p.EncodedKey != nil:
+		return *p.EncodedKey, nil
+	case p.EncodedKeyFrom != nil && p.EncodedKeyFrom.ConfigMapKeyRef != nil:
+		ref := p.EncodedKeyFrom.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.Wrap(errors.Errorf(errKeyNotFoundFmt, ref.Key), errGetConfigMap)
+		}
+		return v, nil
+	case p.EncodedKeyFrom != nil && p.EncodedKeyFrom.SecretKeyRef != nil:
+		ref := p.EncodedKeyFrom.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return "", errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return "", errors.Wrap(errors.Errorf(errKeyNotFoundFmt, ref.Key), errGetSecret)
+		}
+		return string(v), nil
+	}
+	return "", errors.New(errNoEncodedKey)
+}
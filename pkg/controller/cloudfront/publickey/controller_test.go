@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package publickey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cloudfront"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudfront/fake"
+)
+
+var (
+	keyID      = "K123"
+	etag       = "E1"
+	encodedKey = "-----BEGIN PUBLIC KEY-----\nMIIB\n-----END PUBLIC KEY-----"
+	comment    = "signing key"
+	created    = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	kube client.Client
+	cf   *fake.MockClient
+	cr   resource.Managed
+}
+
+type publicKeyModifier func(*svcapitypes.PublicKey)
+
+func withComment(c string) publicKeyModifier {
+	return func(r *svcapitypes.PublicKey) { r.Spec.ForProvider.Comment = &c }
+}
+
+func withSecretKeyRef() publicKeyModifier {
+	return func(r *svcapitypes.PublicKey) {
+		r.Spec.ForProvider.EncodedKey = nil
+		r.Spec.ForProvider.EncodedKeyFrom = &svcapitypes.KeySource{
+			SecretKeyRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: "keys", Namespace: "default"},
+				Key:             "public.pem",
+			},
+		}
+	}
+}
+
+func withExternalName(n string) publicKeyModifier {
+	return func(r *svcapitypes.PublicKey) { meta.SetExternalName(r, n) }
+}
+
+func withObservation(o svcapitypes.PublicKeyObservation) publicKeyModifier {
+	return func(r *svcapitypes.PublicKey) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) publicKeyModifier {
+	return func(r *svcapitypes.PublicKey) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func publicKey(m ...publicKeyModifier) *svcapitypes.PublicKey {
+	cr := &svcapitypes.PublicKey{
+		Spec: svcapitypes.PublicKeySpec{
+			ForProvider: svcapitypes.PublicKeyParameters{
+				Name:       "key",
+				EncodedKey: &encodedKey,
+			},
+		},
+	}
+	meta.SetExternalName(cr, keyID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPublicKey(c *string) func(context.Context, *svcsdk.GetPublicKeyInput, []request.Option) (*svcsdk.GetPublicKeyOutput, error) {
+	return func(context.Context, *svcsdk.GetPublicKeyInput, []request.Option) (*svcsdk.GetPublicKeyOutput, error) {
+		return &svcsdk.GetPublicKeyOutput{
+			ETag: &etag,
+			PublicKey: &svcsdk.PublicKey{
+				Id:          &keyID,
+				CreatedTime: &created,
+				PublicKeyConfig: &svcsdk.PublicKeyConfig{
+					Name:       awsclient.String("key"),
+					Comment:    c,
+					EncodedKey: &encodedKey,
+				},
+			},
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	createdTime := metav1.NewTime(created)
+	observation := svcapitypes.PublicKeyObservation{ETag: etag, CreatedTime: &createdTime}
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetPublicKey: func(context.Context, *svcsdk.GetPublicKeyInput, []request.Option) (*svcsdk.GetPublicKeyOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeNoSuchPublicKey, "", nil)
+					},
+				},
+				cr: publicKey(),
+			},
+			want: want{
+				cr: publicKey(),
+			},
+		},
+		"GetError": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetPublicKey: func(context.Context, *svcsdk.GetPublicKeyInput, []request.Option) (*svcsdk.GetPublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: publicKey(),
+			},
+			want: want{
+				cr:  publicKey(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDateFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						s := obj.(*corev1.Secret)
+						s.Data = map[string][]byte{"public.pem": []byte(encodedKey + "\n")}
+						return nil
+					},
+				},
+				cf: &fake.MockClient{
+					MockGetPublicKey: getPublicKey(&comment),
+				},
+				cr: publicKey(withSecretKeyRef(), withComment(comment)),
+			},
+			want: want{
+				cr: publicKey(withSecretKeyRef(), withComment(comment),
+					withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CommentChanged": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetPublicKey: getPublicKey(nil),
+				},
+				cr: publicKey(withComment(comment)),
+			},
+			want: want{
+				cr: publicKey(withComment(comment), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"SecretKeyMissing": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cf: &fake.MockClient{
+					MockGetPublicKey: getPublicKey(nil),
+				},
+				cr: publicKey(withSecretKeyRef()),
+			},
+			want: want{
+				cr:  publicKey(withSecretKeyRef()),
+				err: errors.Wrap(errors.Errorf(errKeyNotFoundFmt, "public.pem"), errGetSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cf}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cf: &fake.MockClient{
+					MockCreatePublicKey: func(_ context.Context, input *svcsdk.CreatePublicKeyInput, _ []request.Option) (*svcsdk.CreatePublicKeyOutput, error) {
+						if awsclient.StringValue(input.PublicKeyConfig.EncodedKey) != encodedKey {
+							return nil, errBoom
+						}
+						return &svcsdk.CreatePublicKeyOutput{PublicKey: &svcsdk.PublicKey{Id: &keyID}}, nil
+					},
+				},
+				cr: publicKey(withExternalName("")),
+			},
+			want: want{
+				cr: publicKey(withConditions(xpv1.Creating())),
+			},
+		},
+		"NoEncodedKey": {
+			args: args{
+				cr: publicKey(withExternalName(""), func(r *svcapitypes.PublicKey) { r.Spec.ForProvider.EncodedKey = nil }),
+			},
+			want: want{
+				cr: publicKey(withExternalName(""), func(r *svcapitypes.PublicKey) { r.Spec.ForProvider.EncodedKey = nil },
+					withConditions(xpv1.Creating())),
+				err: errors.New(errNoEncodedKey),
+			},
+		},
+		"CreateError": {
+			args: args{
+				cf: &fake.MockClient{
+					MockCreatePublicKey: func(context.Context, *svcsdk.CreatePublicKeyInput, []request.Option) (*svcsdk.CreatePublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: publicKey(withExternalName("")),
+			},
+			want: want{
+				cr:  publicKey(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cf}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateComment": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetPublicKey: getPublicKey(nil),
+					MockUpdatePublicKey: func(_ context.Context, input *svcsdk.UpdatePublicKeyInput, _ []request.Option) (*svcsdk.UpdatePublicKeyOutput, error) {
+						if awsclient.StringValue(input.IfMatch) != etag || awsclient.StringValue(input.PublicKeyConfig.Comment) != comment {
+							return nil, errBoom
+						}
+						return &svcsdk.UpdatePublicKeyOutput{}, nil
+					},
+				},
+				cr: publicKey(withComment(comment)),
+			},
+		},
+		"KeyChanged": {
+			args: args{
+				cf: &fake.MockClient{
+					MockGetPublicKey: getPublicKey(nil),
+				},
+				cr: publicKey(func(r *svcapitypes.PublicKey) { r.Spec.ForProvider.EncodedKey = awsclient.String("other") }),
+			},
+			want: want{
+				err: errors.New(errKeyChanged),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cf}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cf: &fake.MockClient{
+					MockDeletePublicKey: func(_ context.Context, input *svcsdk.DeletePublicKeyInput, _ []request.Option) (*svcsdk.DeletePublicKeyOutput, error) {
+						if awsclient.StringValue(input.IfMatch) != etag {
+							return nil, errBoom
+						}
+						return &svcsdk.DeletePublicKeyOutput{}, nil
+					},
+				},
+				cr: publicKey(withObservation(svcapitypes.PublicKeyObservation{ETag: etag})),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				cf: &fake.MockClient{
+					MockDeletePublicKey: func(context.Context, *svcsdk.DeletePublicKeyInput, []request.Option) (*svcsdk.DeletePublicKeyOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeNoSuchPublicKey, "", nil)
+					},
+				},
+				cr: publicKey(),
+			},
+		},
+		"InUse": {
+			args: args{
+				cf: &fake.MockClient{
+					MockDeletePublicKey: func(context.Context, *svcsdk.DeletePublicKeyInput, []request.Option) (*svcsdk.DeletePublicKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: publicKey(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.cf}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,9 +23,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -33,13 +30,19 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/controller/cloudfront"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupResponseHeadersPolicy adds a controller that reconciles ResponseHeadersPolicy.
-func SetupResponseHeadersPolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupResponseHeadersPolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.ResponseHeadersPolicyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -48,11 +51,10 @@ func SetupResponseHeadersPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.ResponseHeadersPolicy{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.ResponseHeadersPolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.ResponseHeadersPolicyGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<response-headers-policy-id>", "cloudfront", "response-headers-policy"), &connector{
 				kube: mgr.GetClient(),
 				opts: []option{
 					func(e *external) {
@@ -69,9 +71,6 @@ func SetupResponseHeadersPolicy(mgr ctrl.Manager, o controller.Options) error {
 					},
 				},
 			}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
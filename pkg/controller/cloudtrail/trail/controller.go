@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trail
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudtrail"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject  = "managed resource is not a Trail resource"
+	errCreateSession     = "cannot create a new session"
+	errGet               = "failed to get Trail"
+	errGetStatus         = "failed to get status of Trail"
+	errGetEventSelectors = "failed to get event selectors of Trail"
+	errCreate            = "failed to create Trail"
+	errUpdate            = "failed to update Trail"
+	errPutEventSelectors = "failed to put event selectors of Trail"
+	errStartLogging      = "failed to start logging of Trail"
+	errStopLogging       = "failed to stop logging of Trail"
+	errUpdateTags        = "failed to update tags of Trail"
+	errDelete            = "failed to delete Trail"
+)
+
+// SetupTrail adds a controller that reconciles Trails.
+func SetupTrail(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TrailGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Trail{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.TrailGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: cloudtrail.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) cloudtrail.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client cloudtrail.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	name := awsclient.String(meta.GetExternalName(cr))
+	out, err := e.client.GetTrailWithContext(ctx, &awscloudtrail.GetTrailInput{Name: name})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(cloudtrail.IsNotFound, err), errGet)
+	}
+	status, err := e.client.GetTrailStatusWithContext(ctx, &awscloudtrail.GetTrailStatusInput{Name: name})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetStatus)
+	}
+	cr.Status.AtProvider = v1alpha1.TrailObservation{
+		TrailARN:            awsclient.StringValue(out.Trail.TrailARN),
+		HomeRegion:          awsclient.StringValue(out.Trail.HomeRegion),
+		IsLogging:           awsclient.BoolValue(status.IsLogging),
+		LatestDeliveryError: awsclient.StringValue(status.LatestDeliveryError),
+	}
+	selectors, err := e.client.GetEventSelectorsWithContext(ctx, &awscloudtrail.GetEventSelectorsInput{TrailName: name})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetEventSelectors)
+	}
+	tags, err := cloudtrail.ListTags(ctx, e.client, cr.Status.AtProvider.TrailARN)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	add, remove := cloudtrail.DiffTags(cr.Spec.ForProvider.Tags, tags)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: cloudtrail.IsTrailUpToDate(cr.Spec.ForProvider, out.Trail) &&
+			cloudtrail.AreEventSelectorsUpToDate(cr.Spec.ForProvider.EventSelectors, selectors.EventSelectors) &&
+			cloudtrail.IsLoggingUpToDate(cr.Spec.ForProvider.EnableLogging, cr.Status.AtProvider.IsLogging) &&
+			len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.CreateTrailWithContext(ctx, cloudtrail.GenerateCreateTrailInput(name, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// A new trail does not log anything until logging is started, so do it
+	// right away instead of waiting for the next update.
+	return managed.ExternalCreation{}, e.updateSelectorsAndLogging(ctx, name, cr.Spec.ForProvider, false)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateTrailWithContext(ctx, cloudtrail.GenerateUpdateTrailInput(name, cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	if err := e.updateSelectorsAndLogging(ctx, name, cr.Spec.ForProvider, cr.Status.AtProvider.IsLogging); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	err := cloudtrail.UpdateTags(ctx, e.client, cr.Status.AtProvider.TrailARN, cr.Spec.ForProvider.Tags)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateTags)
+}
+
+func (e *external) updateSelectorsAndLogging(ctx context.Context, name string, p v1alpha1.TrailParameters, isLogging bool) error {
+	if len(p.EventSelectors) > 0 {
+		if _, err := e.client.PutEventSelectorsWithContext(ctx, &awscloudtrail.PutEventSelectorsInput{
+			TrailName:      &name,
+			EventSelectors: cloudtrail.GenerateEventSelectors(p.EventSelectors),
+		}); err != nil {
+			return awsclient.Wrap(err, errPutEventSelectors)
+		}
+	}
+	if cloudtrail.IsLoggingUpToDate(p.EnableLogging, isLogging) {
+		return nil
+	}
+	if isLogging {
+		_, err := e.client.StopLoggingWithContext(ctx, &awscloudtrail.StopLoggingInput{Name: &name})
+		return awsclient.Wrap(err, errStopLogging)
+	}
+	_, err := e.client.StartLoggingWithContext(ctx, &awscloudtrail.StartLoggingInput{Name: &name})
+	return awsclient.Wrap(err, errStartLogging)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Trail)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteTrailWithContext(ctx, &awscloudtrail.DeleteTrailInput{
+		Name: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(cloudtrail.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trail
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudtrail/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudtrail/fake"
+)
+
+var (
+	trailName = "audit"
+	trailARN  = "arn:aws:cloudtrail:us-east-1:123456789012:trail/audit"
+	bucket    = "audit-logs"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	ct *fake.MockClient
+	cr resource.Managed
+}
+
+type trailModifier func(*v1alpha1.Trail)
+
+func withEnableLogging(b bool) trailModifier {
+	return func(r *v1alpha1.Trail) { r.Spec.ForProvider.EnableLogging = &b }
+}
+
+func withEventSelectors(s ...v1alpha1.EventSelector) trailModifier {
+	return func(r *v1alpha1.Trail) { r.Spec.ForProvider.EventSelectors = s }
+}
+
+func withObservation(logging bool) trailModifier {
+	return func(r *v1alpha1.Trail) {
+		r.Status.AtProvider = v1alpha1.TrailObservation{TrailARN: trailARN, HomeRegion: "us-east-1", IsLogging: logging}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) trailModifier {
+	return func(r *v1alpha1.Trail) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func trail(m ...trailModifier) *v1alpha1.Trail {
+	cr := &v1alpha1.Trail{
+		Spec: v1alpha1.TrailSpec{
+			ForProvider: v1alpha1.TrailParameters{
+				Region:       "us-east-1",
+				S3BucketName: &bucket,
+			},
+		},
+	}
+	meta.SetExternalName(cr, trailName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getTrail(context.Context, *awscloudtrail.GetTrailInput, []request.Option) (*awscloudtrail.GetTrailOutput, error) {
+	return &awscloudtrail.GetTrailOutput{Trail: &awscloudtrail.Trail{
+		Name:         &trailName,
+		TrailARN:     &trailARN,
+		HomeRegion:   aws.String("us-east-1"),
+		S3BucketName: &bucket,
+	}}, nil
+}
+
+func getTrailStatus(logging bool) func(context.Context, *awscloudtrail.GetTrailStatusInput, []request.Option) (*awscloudtrail.GetTrailStatusOutput, error) {
+	return func(context.Context, *awscloudtrail.GetTrailStatusInput, []request.Option) (*awscloudtrail.GetTrailStatusOutput, error) {
+		return &awscloudtrail.GetTrailStatusOutput{IsLogging: &logging}, nil
+	}
+}
+
+func getEventSelectors(context.Context, *awscloudtrail.GetEventSelectorsInput, []request.Option) (*awscloudtrail.GetEventSelectorsOutput, error) {
+	return &awscloudtrail.GetEventSelectorsOutput{EventSelectors: []*awscloudtrail.EventSelector{{
+		ReadWriteType:           aws.String("All"),
+		IncludeManagementEvents: aws.Bool(true),
+	}}}, nil
+}
+
+func listTags(context.Context, *awscloudtrail.ListTagsInput, []request.Option) (*awscloudtrail.ListTagsOutput, error) {
+	return &awscloudtrail.ListTagsOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				ct: &fake.MockClient{
+					MockGetTrail: func(context.Context, *awscloudtrail.GetTrailInput, []request.Option) (*awscloudtrail.GetTrailOutput, error) {
+						return nil, awserr.New(awscloudtrail.ErrCodeTrailNotFoundException, "", nil)
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(),
+			},
+		},
+		"GetError": {
+			args: args{
+				ct: &fake.MockClient{
+					MockGetTrail: func(context.Context, *awscloudtrail.GetTrailInput, []request.Option) (*awscloudtrail.GetTrailOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				ct: &fake.MockClient{
+					MockGetTrail:          getTrail,
+					MockGetTrailStatus:    getTrailStatus(true),
+					MockGetEventSelectors: getEventSelectors,
+					MockListTags:          listTags,
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withObservation(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotLogging": {
+			args: args{
+				ct: &fake.MockClient{
+					MockGetTrail:          getTrail,
+					MockGetTrailStatus:    getTrailStatus(false),
+					MockGetEventSelectors: getEventSelectors,
+					MockListTags:          listTags,
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withObservation(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"EventSelectorsChanged": {
+			args: args{
+				ct: &fake.MockClient{
+					MockGetTrail:          getTrail,
+					MockGetTrailStatus:    getTrailStatus(true),
+					MockGetEventSelectors: getEventSelectors,
+					MockListTags:          listTags,
+				},
+				cr: trail(withEventSelectors(v1alpha1.EventSelector{ReadWriteType: aws.String("WriteOnly")})),
+			},
+			want: want{
+				cr: trail(withEventSelectors(v1alpha1.EventSelector{ReadWriteType: aws.String("WriteOnly")}), withObservation(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ct}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StartsLogging": {
+			args: args{
+				ct: &fake.MockClient{
+					MockCreateTrail: func(_ context.Context, input *awscloudtrail.CreateTrailInput, _ []request.Option) (*awscloudtrail.CreateTrailOutput, error) {
+						if awsclient.StringValue(input.Name) != trailName || awsclient.StringValue(input.S3BucketName) != bucket {
+							return nil, errBoom
+						}
+						return &awscloudtrail.CreateTrailOutput{}, nil
+					},
+					MockStartLogging: func(context.Context, *awscloudtrail.StartLoggingInput, []request.Option) (*awscloudtrail.StartLoggingOutput, error) {
+						return &awscloudtrail.StartLoggingOutput{}, nil
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr: trail(withConditions(xpv1.Creating())),
+			},
+		},
+		"LoggingDisabled": {
+			args: args{
+				ct: &fake.MockClient{
+					MockCreateTrail: func(context.Context, *awscloudtrail.CreateTrailInput, []request.Option) (*awscloudtrail.CreateTrailOutput, error) {
+						return &awscloudtrail.CreateTrailOutput{}, nil
+					},
+				},
+				cr: trail(withEnableLogging(false)),
+			},
+			want: want{
+				cr: trail(withEnableLogging(false), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				ct: &fake.MockClient{
+					MockCreateTrail: func(context.Context, *awscloudtrail.CreateTrailInput, []request.Option) (*awscloudtrail.CreateTrailOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				cr:  trail(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ct}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StopLoggingAndPutSelectors": {
+			args: args{
+				ct: &fake.MockClient{
+					MockUpdateTrail: func(context.Context, *awscloudtrail.UpdateTrailInput, []request.Option) (*awscloudtrail.UpdateTrailOutput, error) {
+						return &awscloudtrail.UpdateTrailOutput{}, nil
+					},
+					MockPutEventSelectors: func(_ context.Context, input *awscloudtrail.PutEventSelectorsInput, _ []request.Option) (*awscloudtrail.PutEventSelectorsOutput, error) {
+						if len(input.EventSelectors) != 1 || awsclient.StringValue(input.EventSelectors[0].ReadWriteType) != "WriteOnly" {
+							return nil, errBoom
+						}
+						return &awscloudtrail.PutEventSelectorsOutput{}, nil
+					},
+					MockStopLogging: func(context.Context, *awscloudtrail.StopLoggingInput, []request.Option) (*awscloudtrail.StopLoggingOutput, error) {
+						return &awscloudtrail.StopLoggingOutput{}, nil
+					},
+					MockListTags: listTags,
+				},
+				cr: trail(withEnableLogging(false), withEventSelectors(v1alpha1.EventSelector{ReadWriteType: aws.String("WriteOnly")}), withObservation(true)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				ct: &fake.MockClient{
+					MockUpdateTrail: func(context.Context, *awscloudtrail.UpdateTrailInput, []request.Option) (*awscloudtrail.UpdateTrailOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: trail(withObservation(true)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"StartLoggingError": {
+			args: args{
+				ct: &fake.MockClient{
+					MockUpdateTrail: func(context.Context, *awscloudtrail.UpdateTrailInput, []request.Option) (*awscloudtrail.UpdateTrailOutput, error) {
+						return &awscloudtrail.UpdateTrailOutput{}, nil
+					},
+					MockStartLogging: func(context.Context, *awscloudtrail.StartLoggingInput, []request.Option) (*awscloudtrail.StartLoggingOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: trail(withObservation(false)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errStartLogging),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ct}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				ct: &fake.MockClient{
+					MockDeleteTrail: func(context.Context, *awscloudtrail.DeleteTrailInput, []request.Option) (*awscloudtrail.DeleteTrailOutput, error) {
+						return nil, awserr.New(awscloudtrail.ErrCodeTrailNotFoundException, "", nil)
+					},
+				},
+				cr: trail(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ct: &fake.MockClient{
+					MockDeleteTrail: func(context.Context, *awscloudtrail.DeleteTrailInput, []request.Option) (*awscloudtrail.DeleteTrailOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: trail(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ct}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compositealarm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+var (
+	alarmName = "alarm"
+	alarmARN  = "arn:aws:cloudwatch:us-east-1:123456789012:alarm:alarm"
+	topic     = "arn:aws:sns:us-east-1:123456789012:topic"
+	rule      = `ALARM("cpu") OR ALARM("memory")`
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	cw *fake.MockClient
+	cr resource.Managed
+}
+
+type alarmModifier func(*v1alpha1.CompositeAlarm)
+
+func withRule(r string) alarmModifier {
+	return func(a *v1alpha1.CompositeAlarm) { a.Spec.ForProvider.AlarmRule = r }
+}
+
+func withObservation(o v1alpha1.AlarmObservation) alarmModifier {
+	return func(a *v1alpha1.CompositeAlarm) { a.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) alarmModifier {
+	return func(a *v1alpha1.CompositeAlarm) { a.Status.ConditionedStatus.Conditions = c }
+}
+
+func alarm(m ...alarmModifier) *v1alpha1.CompositeAlarm {
+	cr := &v1alpha1.CompositeAlarm{
+		Spec: v1alpha1.CompositeAlarmSpec{
+			ForProvider: v1alpha1.CompositeAlarmParameters{
+				AlarmActionParameters: v1alpha1.AlarmActionParameters{AlarmActions: []string{topic}},
+				AlarmRule:             rule,
+			},
+		},
+	}
+	meta.SetExternalName(cr, alarmName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(alarms ...*awscloudwatch.CompositeAlarm) func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+	return func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+		return &awscloudwatch.DescribeAlarmsOutput{CompositeAlarms: alarms}, nil
+	}
+}
+
+func listTags(context.Context, *awscloudwatch.ListTagsForResourceInput, []request.Option) (*awscloudwatch.ListTagsForResourceOutput, error) {
+	return &awscloudwatch.ListTagsForResourceOutput{}, nil
+}
+
+var (
+	observed = &awscloudwatch.CompositeAlarm{
+		AlarmName:      &alarmName,
+		AlarmArn:       &alarmARN,
+		ActionsEnabled: awsclient.Bool(true),
+		AlarmActions:   []*string{&topic},
+		AlarmRule:      &rule,
+		StateValue:     awsclient.String(awscloudwatch.StateValueAlarm),
+	}
+	observation = v1alpha1.AlarmObservation{
+		AlarmARN:   alarmARN,
+		StateValue: awscloudwatch.StateValueAlarm,
+	}
+)
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cw: &fake.MockClient{MockDescribeAlarms: describe()},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms: func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms:      describe(observed),
+					MockListTagsForResource: listTags,
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RuleChanged": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms:      describe(observed),
+					MockListTagsForResource: listTags,
+				},
+				cr: alarm(withRule(`ALARM("cpu")`)),
+			},
+			want: want{
+				cr: alarm(withRule(`ALARM("cpu")`), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var input *awscloudwatch.PutCompositeAlarmInput
+	e := &external{client: &fake.MockClient{
+		MockPutCompositeAlarm: func(_ context.Context, in *awscloudwatch.PutCompositeAlarmInput, _ []request.Option) (*awscloudwatch.PutCompositeAlarmOutput, error) {
+			input = in
+			return &awscloudwatch.PutCompositeAlarmOutput{}, nil
+		},
+	}}
+	cr := alarm()
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	want := &awscloudwatch.PutCompositeAlarmInput{
+		AlarmName:      &alarmName,
+		ActionsEnabled: awsclient.Bool(true),
+		AlarmActions:   []*string{&topic},
+		AlarmRule:      &rule,
+	}
+	if diff := cmp.Diff(want, input, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(awscloudwatch.PutCompositeAlarmInput{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(alarm(withConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyGone": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDeleteAlarms: func(context.Context, *awscloudwatch.DeleteAlarmsInput, []request.Option) (*awscloudwatch.DeleteAlarmsOutput, error) {
+						return nil, awserr.New(awscloudwatch.ErrCodeResourceNotFound, "", nil)
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDeleteAlarms: func(context.Context, *awscloudwatch.DeleteAlarmsInput, []request.Option) (*awscloudwatch.DeleteAlarmsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cw}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricalarm
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a MetricAlarm resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe MetricAlarm"
+	errListTags         = "failed to list tags of MetricAlarm"
+	errPut              = "failed to put MetricAlarm"
+	errUpdateTags       = "failed to update tags of MetricAlarm"
+	errDelete           = "failed to delete MetricAlarm"
+)
+
+// SetupMetricAlarm adds a controller that reconciles MetricAlarms.
+func SetupMetricAlarm(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.MetricAlarmGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.MetricAlarm{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.MetricAlarmGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: cloudwatch.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) cloudwatch.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.MetricAlarm)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client cloudwatch.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MetricAlarm)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	out, err := e.client.DescribeAlarmsWithContext(ctx, &awscloudwatch.DescribeAlarmsInput{
+		AlarmNames: []*string{&name},
+		AlarmTypes: []*string{awsclient.String(awscloudwatch.AlarmTypeMetricAlarm)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribe)
+	}
+	if len(out.MetricAlarms) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	obs := out.MetricAlarms[0]
+	cr.Status.AtProvider = v1alpha1.AlarmObservation{
+		AlarmARN:    awsclient.StringValue(obs.AlarmArn),
+		StateValue:  awsclient.StringValue(obs.StateValue),
+		StateReason: awsclient.StringValue(obs.StateReason),
+	}
+	tags, err := e.client.ListTagsForResourceWithContext(ctx, &awscloudwatch.ListTagsForResourceInput{ResourceARN: obs.AlarmArn})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := cloudwatch.DiffTags(cr.Spec.ForProvider.Tags, tags.Tags)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cloudwatch.IsMetricAlarmUpToDate(name, cr.Spec.ForProvider, obs) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MetricAlarm)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	input := cloudwatch.GenerateMetricAlarmInput(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	input.Tags = cloudwatch.GenerateTags(cr.Spec.ForProvider.Tags)
+	_, err := e.client.PutMetricAlarmWithContext(ctx, input)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MetricAlarm)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// PutMetricAlarm overwrites the whole configuration of an existing
+	// alarm but leaves its tags untouched.
+	if _, err := e.client.PutMetricAlarmWithContext(ctx, cloudwatch.GenerateMetricAlarmInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+	}
+	err := cloudwatch.UpdateTags(ctx, e.client, cr.Status.AtProvider.AlarmARN, cr.Spec.ForProvider.Tags)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MetricAlarm)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteAlarmsWithContext(ctx, &awscloudwatch.DeleteAlarmsInput{
+		AlarmNames: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	return awsclient.Wrap(resource.Ignore(cloudwatch.IsNotFound, err), errDelete)
+}
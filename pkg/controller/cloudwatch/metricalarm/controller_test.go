@@ -0,0 +1,458 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metricalarm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awscloudwatch "github.com/aws/aws-sdk-go/service/cloudwatch"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/cloudwatch/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/cloudwatch/fake"
+)
+
+var (
+	alarmName = "alarm"
+	alarmARN  = "arn:aws:cloudwatch:us-east-1:123456789012:alarm:alarm"
+	topic     = "arn:aws:sns:us-east-1:123456789012:topic"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	cw *fake.MockClient
+	cr resource.Managed
+}
+
+type alarmModifier func(*v1alpha1.MetricAlarm)
+
+func withThreshold(v float64) alarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Spec.ForProvider.Threshold = &v }
+}
+
+func withTags(t ...v1alpha1.Tag) alarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o v1alpha1.AlarmObservation) alarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) alarmModifier {
+	return func(r *v1alpha1.MetricAlarm) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func alarm(m ...alarmModifier) *v1alpha1.MetricAlarm {
+	cr := &v1alpha1.MetricAlarm{
+		Spec: v1alpha1.MetricAlarmSpec{
+			ForProvider: v1alpha1.MetricAlarmParameters{
+				AlarmActionParameters: v1alpha1.AlarmActionParameters{AlarmActions: []string{topic}},
+				ComparisonOperator:    awscloudwatch.ComparisonOperatorGreaterThanThreshold,
+				EvaluationPeriods:     1,
+				MetricName:            awsclient.String("Errors"),
+				Namespace:             awsclient.String("AWS/Lambda"),
+				Statistic:             awsclient.String(awscloudwatch.StatisticSum),
+				Period:                awsclient.Int64(60),
+			},
+		},
+	}
+	meta.SetExternalName(cr, alarmName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observed() *awscloudwatch.MetricAlarm {
+	return &awscloudwatch.MetricAlarm{
+		AlarmName:          &alarmName,
+		AlarmArn:           &alarmARN,
+		ActionsEnabled:     awsclient.Bool(true),
+		AlarmActions:       []*string{&topic},
+		ComparisonOperator: awsclient.String(awscloudwatch.ComparisonOperatorGreaterThanThreshold),
+		EvaluationPeriods:  awsclient.Int64(1),
+		MetricName:         awsclient.String("Errors"),
+		Namespace:          awsclient.String("AWS/Lambda"),
+		Statistic:          awsclient.String(awscloudwatch.StatisticSum),
+		Period:             awsclient.Int64(60),
+		StateValue:         awsclient.String(awscloudwatch.StateValueOk),
+		StateReason:        awsclient.String("reason"),
+	}
+}
+
+func describe(alarms ...*awscloudwatch.MetricAlarm) func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+	return func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+		return &awscloudwatch.DescribeAlarmsOutput{MetricAlarms: alarms}, nil
+	}
+}
+
+func listTags(tags ...*awscloudwatch.Tag) func(context.Context, *awscloudwatch.ListTagsForResourceInput, []request.Option) (*awscloudwatch.ListTagsForResourceOutput, error) {
+	return func(context.Context, *awscloudwatch.ListTagsForResourceInput, []request.Option) (*awscloudwatch.ListTagsForResourceOutput, error) {
+		return &awscloudwatch.ListTagsForResourceOutput{Tags: tags}, nil
+	}
+}
+
+var observation = v1alpha1.AlarmObservation{
+	AlarmARN:    alarmARN,
+	StateValue:  awscloudwatch.StateValueOk,
+	StateReason: "reason",
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cw: &fake.MockClient{MockDescribeAlarms: describe()},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms: func(context.Context, *awscloudwatch.DescribeAlarmsInput, []request.Option) (*awscloudwatch.DescribeAlarmsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ListTagsError": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms: describe(observed()),
+					MockListTagsForResource: func(context.Context, *awscloudwatch.ListTagsForResourceInput, []request.Option) (*awscloudwatch.ListTagsForResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(withObservation(observation)),
+				err: awsclient.Wrap(errBoom, errListTags),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms:      describe(observed()),
+					MockListTagsForResource: listTags(),
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ThresholdChanged": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms:      describe(observed()),
+					MockListTagsForResource: listTags(),
+				},
+				cr: alarm(withThreshold(5)),
+			},
+			want: want{
+				cr: alarm(withThreshold(5), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDescribeAlarms:      describe(observed()),
+					MockListTagsForResource: listTags(),
+				},
+				cr: alarm(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: alarm(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cw}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *awscloudwatch.PutMetricAlarmInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr: alarm(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: alarm(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withConditions(xpv1.Creating())),
+				input: &awscloudwatch.PutMetricAlarmInput{
+					AlarmName:          &alarmName,
+					ActionsEnabled:     awsclient.Bool(true),
+					AlarmActions:       []*string{&topic},
+					ComparisonOperator: awsclient.String(awscloudwatch.ComparisonOperatorGreaterThanThreshold),
+					EvaluationPeriods:  awsclient.Int64(1),
+					MetricName:         awsclient.String("Errors"),
+					Namespace:          awsclient.String("AWS/Lambda"),
+					Statistic:          awsclient.String(awscloudwatch.StatisticSum),
+					Period:             awsclient.Int64(60),
+					Tags:               []*awscloudwatch.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awscloudwatch.PutMetricAlarmInput
+			e := &external{client: &fake.MockClient{
+				MockPutMetricAlarm: func(_ context.Context, in *awscloudwatch.PutMetricAlarmInput, _ []request.Option) (*awscloudwatch.PutMetricAlarmOutput, error) {
+					if tc.want.err != nil {
+						return nil, errBoom
+					}
+					input = in
+					return &awscloudwatch.PutMetricAlarmOutput{}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(awscloudwatch.PutMetricAlarmInput{}, awscloudwatch.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		untagged []*string
+		tagged   []*awscloudwatch.Tag
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulWithTags": {
+			args: args{
+				cw: &fake.MockClient{
+					MockListTagsForResource: listTags(&awscloudwatch.Tag{Key: awsclient.String("old"), Value: awsclient.String("v")}),
+				},
+				cr: alarm(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(observation)),
+			},
+			want: want{
+				untagged: []*string{awsclient.String("old")},
+				tagged:   []*awscloudwatch.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+			},
+		},
+		"FailedPut": {
+			args: args{
+				cw: &fake.MockClient{
+					MockPutMetricAlarm: func(context.Context, *awscloudwatch.PutMetricAlarmInput, []request.Option) (*awscloudwatch.PutMetricAlarmOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(withObservation(observation)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+		"FailedListTags": {
+			args: args{
+				cw: &fake.MockClient{
+					MockListTagsForResource: func(context.Context, *awscloudwatch.ListTagsForResourceInput, []request.Option) (*awscloudwatch.ListTagsForResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(withObservation(observation)),
+			},
+			want: want{
+				err: awsclient.Wrap(awsclient.Wrap(errBoom, "cannot list tags"), errUpdateTags),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var untagged []*string
+			var tagged []*awscloudwatch.Tag
+			if tc.cw.MockPutMetricAlarm == nil {
+				tc.cw.MockPutMetricAlarm = func(context.Context, *awscloudwatch.PutMetricAlarmInput, []request.Option) (*awscloudwatch.PutMetricAlarmOutput, error) {
+					return &awscloudwatch.PutMetricAlarmOutput{}, nil
+				}
+			}
+			tc.cw.MockUntagResource = func(_ context.Context, in *awscloudwatch.UntagResourceInput, _ []request.Option) (*awscloudwatch.UntagResourceOutput, error) {
+				untagged = in.TagKeys
+				return &awscloudwatch.UntagResourceOutput{}, nil
+			}
+			tc.cw.MockTagResource = func(_ context.Context, in *awscloudwatch.TagResourceInput, _ []request.Option) (*awscloudwatch.TagResourceOutput, error) {
+				tagged = in.Tags
+				return &awscloudwatch.TagResourceOutput{}, nil
+			}
+			e := &external{client: tc.cw}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.untagged, untagged); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged, cmpopts.IgnoreUnexported(awscloudwatch.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDeleteAlarms: func(context.Context, *awscloudwatch.DeleteAlarmsInput, []request.Option) (*awscloudwatch.DeleteAlarmsOutput, error) {
+						return &awscloudwatch.DeleteAlarmsOutput{}, nil
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyGone": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDeleteAlarms: func(context.Context, *awscloudwatch.DeleteAlarmsInput, []request.Option) (*awscloudwatch.DeleteAlarmsOutput, error) {
+						return nil, awserr.New(awscloudwatch.ErrCodeResourceNotFound, "", nil)
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr: alarm(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				cw: &fake.MockClient{
+					MockDeleteAlarms: func(context.Context, *awscloudwatch.DeleteAlarmsInput, []request.Option) (*awscloudwatch.DeleteAlarmsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: alarm(),
+			},
+			want: want{
+				cr:  alarm(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cw}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -22,9 +22,6 @@ import (
 	svcsdk "github.com/aws/aws-sdk-go/service/cloudwatchlogs"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,18 +29,26 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
-	errListTags      = "cannot list tags"
-	errTagResource   = "cannot tag resource"
-	errUntagResource = "cannot untag resource"
+	errListTags        = "cannot list tags"
+	errTagResource     = "cannot tag resource"
+	errUntagResource   = "cannot untag resource"
+	errAssociateKMS    = "cannot associate KMS key"
+	errDisassociateKMS = "cannot disassociate KMS key"
 )
 
 // SetupLogGroup adds a controller that reconciles LogGroup.
-func SetupLogGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupLogGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.LogGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.postObserve = postObserve
@@ -63,15 +68,11 @@ func SetupLogGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.LogGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.LogGroupGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.LogGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<log-group-name>"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -116,6 +117,9 @@ func (u *updater) isUpToDate(cr *svcapitypes.LogGroup, obj *svcsdk.DescribeLogGr
 	if awsclients.Int64Value(cr.Spec.ForProvider.RetentionInDays) != awsclients.Int64Value(obj.LogGroups[0].RetentionInDays) {
 		return false, nil
 	}
+	if awsclients.StringValue(cr.Spec.ForProvider.KMSKeyID) != awsclients.StringValue(obj.LogGroups[0].KmsKeyId) {
+		return false, nil
+	}
 
 	tags, err := u.client.ListTagsLogGroup(&svcsdk.ListTagsLogGroupInput{
 		LogGroupName: awsclients.String(meta.GetExternalName(cr)),
@@ -187,5 +191,25 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		}
 	}
 
-	return managed.ExternalUpdate{}, nil
+	return managed.ExternalUpdate{}, u.updateKMSKey(ctx, cr, obj.LogGroups[0])
+}
+
+// updateKMSKey associates the desired KMS key with the log group, or
+// disassociates the current one if no key is desired.
+func (u *updater) updateKMSKey(ctx context.Context, cr *svcapitypes.LogGroup, obs *svcsdk.LogGroup) error {
+	desired := awsclients.StringValue(cr.Spec.ForProvider.KMSKeyID)
+	if desired == awsclients.StringValue(obs.KmsKeyId) {
+		return nil
+	}
+	if desired == "" {
+		_, err := u.client.DisassociateKmsKeyWithContext(ctx, &svcsdk.DisassociateKmsKeyInput{
+			LogGroupName: awsclients.String(meta.GetExternalName(cr)),
+		})
+		return awsclients.Wrap(err, errDisassociateKMS)
+	}
+	_, err := u.client.AssociateKmsKeyWithContext(ctx, &svcsdk.AssociateKmsKeyInput{
+		LogGroupName: awsclients.String(meta.GetExternalName(cr)),
+		KmsKeyId:     awsclients.String(desired),
+	})
+	return awsclients.Wrap(err, errAssociateKMS)
 }
@@ -0,0 +1,179 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identitypool
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+// SetupIdentityPool adds a controller that reconciles IdentityPool.
+func SetupIdentityPool(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(svcapitypes.IdentityPoolGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	opts := []option{
+		func(e *external) {
+			e.preObserve = preObserve
+			e.postObserve = postObserve
+			e.preCreate = preCreate
+			e.postCreate = postCreate
+			e.preUpdate = preUpdate
+			e.preDelete = preDelete
+			e.isUpToDate = isUpToDate
+		},
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&svcapitypes.IdentityPool{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.IdentityPoolGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Name("<identity-pool-id>", "cognito-identity", "identitypool"), &connector{kube: mgr.GetClient(), opts: opts})),
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+func preObserve(_ context.Context, cr *svcapitypes.IdentityPool, obj *svcsdk.DescribeIdentityPoolInput) error {
+	obj.IdentityPoolId = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func postObserve(_ context.Context, cr *svcapitypes.IdentityPool, _ *svcsdk.IdentityPool, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.SetConditions(xpv1.Available())
+	return obs, nil
+}
+
+func preCreate(_ context.Context, cr *svcapitypes.IdentityPool, obj *svcsdk.CreateIdentityPoolInput) error {
+	obj.CognitoIdentityProviders = generateCognitoIdentityProviders(cr.Spec.ForProvider.CognitoIdentityProviders)
+	obj.OpenIdConnectProviderARNs = cr.Spec.ForProvider.OpenIDConnectProviderARNs
+	return nil
+}
+
+func postCreate(_ context.Context, cr *svcapitypes.IdentityPool, obj *svcsdk.IdentityPool, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	meta.SetExternalName(cr, awsclients.StringValue(obj.IdentityPoolId))
+	return cre, nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.IdentityPool, obj *svcsdk.IdentityPool) error {
+	obj.IdentityPoolId = awsclients.String(meta.GetExternalName(cr))
+	obj.CognitoIdentityProviders = generateCognitoIdentityProviders(cr.Spec.ForProvider.CognitoIdentityProviders)
+	obj.OpenIdConnectProviderARNs = cr.Spec.ForProvider.OpenIDConnectProviderARNs
+	return nil
+}
+
+func preDelete(_ context.Context, cr *svcapitypes.IdentityPool, obj *svcsdk.DeleteIdentityPoolInput) (bool, error) {
+	obj.IdentityPoolId = awsclients.String(meta.GetExternalName(cr))
+	return false, nil
+}
+
+func isUpToDate(cr *svcapitypes.IdentityPool, resp *svcsdk.IdentityPool) (bool, error) {
+	p := cr.Spec.ForProvider
+	switch {
+	case awsclients.BoolValue(p.AllowClassicFlow) != awsclients.BoolValue(resp.AllowClassicFlow),
+		awsclients.BoolValue(p.AllowUnauthenticatedIdentities) != awsclients.BoolValue(resp.AllowUnauthenticatedIdentities),
+		awsclients.StringValue(p.DeveloperProviderName) != awsclients.StringValue(resp.DeveloperProviderName),
+		awsclients.StringValue(p.IdentityPoolName) != awsclients.StringValue(resp.IdentityPoolName):
+		return false, nil
+	}
+
+	sortStrings := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	switch {
+	case !cmp.Equal(aws.StringValueSlice(p.SamlProviderARNs), aws.StringValueSlice(resp.SamlProviderARNs), sortStrings, cmpopts.EquateEmpty()),
+		!cmp.Equal(aws.StringValueSlice(p.OpenIDConnectProviderARNs), aws.StringValueSlice(resp.OpenIdConnectProviderARNs), sortStrings, cmpopts.EquateEmpty()),
+		!cmp.Equal(aws.StringValueMap(p.SupportedLoginProviders), aws.StringValueMap(resp.SupportedLoginProviders), cmpopts.EquateEmpty()),
+		!cmp.Equal(aws.StringValueMap(p.IdentityPoolTags), aws.StringValueMap(resp.IdentityPoolTags), cmpopts.EquateEmpty()):
+		return false, nil
+	}
+
+	return areCognitoIdentityProvidersEqual(generateCognitoIdentityProviders(p.CognitoIdentityProviders), resp.CognitoIdentityProviders), nil
+}
+
+func generateCognitoIdentityProviders(in []*svcapitypes.CognitoIdentityProviderParameters) []*svcsdk.Provider {
+	if in == nil {
+		return nil
+	}
+	res := make([]*svcsdk.Provider, 0, len(in))
+	for _, p := range in {
+		if p == nil {
+			continue
+		}
+		res = append(res, &svcsdk.Provider{
+			ClientId:             p.ClientID,
+			ProviderName:         p.ProviderName,
+			ServerSideTokenCheck: p.ServerSideTokenCheck,
+		})
+	}
+	return res
+}
+
+// areCognitoIdentityProvidersEqual compares the providers regardless of
+// their order, which the API doesn't preserve.
+func areCognitoIdentityProvidersEqual(spec, current []*svcsdk.Provider) bool {
+	if len(spec) != len(current) {
+		return false
+	}
+	key := func(p *svcsdk.Provider) string {
+		return awsclients.StringValue(p.ProviderName) + "/" + awsclients.StringValue(p.ClientId)
+	}
+	s := make([]*svcsdk.Provider, len(spec))
+	c := make([]*svcsdk.Provider, len(current))
+	copy(s, spec)
+	copy(c, current)
+	sort.Slice(s, func(i, j int) bool { return key(s[i]) < key(s[j]) })
+	sort.Slice(c, func(i, j int) bool { return key(c[i]) < key(c[j]) })
+	for i := range s {
+		if key(s[i]) != key(c[i]) ||
+			awsclients.BoolValue(s[i].ServerSideTokenCheck) != awsclients.BoolValue(c[i].ServerSideTokenCheck) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identitypool
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+type functionModifier func(*svcapitypes.IdentityPool)
+
+func withSpec(p svcapitypes.IdentityPoolParameters) functionModifier {
+	return func(r *svcapitypes.IdentityPool) { r.Spec.ForProvider = p }
+}
+
+func identityPool(m ...functionModifier) *svcapitypes.IdentityPool {
+	cr := &svcapitypes.IdentityPool{}
+	cr.Name = "test-identity-pool-name"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type args struct {
+	cr   *svcapitypes.IdentityPool
+	resp *svcsdk.IdentityPool
+}
+
+var (
+	testPoolName      = "pool"
+	testClientID      = "client"
+	testOtherClientID = "other-client"
+	testProviderName  = "cognito-idp.us-east-1.amazonaws.com/us-east-1_123456789"
+	testOIDCARN       = "arn:aws:iam::123456789012:oidc-provider/example.com"
+	testOtherOIDCARN  = "arn:aws:iam::123456789012:oidc-provider/example.org"
+)
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		result bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				cr: identityPool(withSpec(svcapitypes.IdentityPoolParameters{
+					IdentityPoolName:               &testPoolName,
+					AllowUnauthenticatedIdentities: awsclients.Bool(false),
+					CustomIdentityPoolParameters: svcapitypes.CustomIdentityPoolParameters{
+						CognitoIdentityProviders: []*svcapitypes.CognitoIdentityProviderParameters{
+							{ClientID: &testClientID, ProviderName: &testProviderName},
+							{ClientID: &testOtherClientID, ProviderName: &testProviderName},
+						},
+						OpenIDConnectProviderARNs: []*string{&testOIDCARN, &testOtherOIDCARN},
+					},
+				})),
+				resp: &svcsdk.IdentityPool{
+					IdentityPoolName:               &testPoolName,
+					AllowUnauthenticatedIdentities: awsclients.Bool(false),
+					CognitoIdentityProviders: []*svcsdk.Provider{
+						{ClientId: &testOtherClientID, ProviderName: &testProviderName, ServerSideTokenCheck: awsclients.Bool(false)},
+						{ClientId: &testClientID, ProviderName: &testProviderName},
+					},
+					OpenIdConnectProviderARNs: []*string{&testOtherOIDCARN, &testOIDCARN},
+					SupportedLoginProviders:   map[string]*string{},
+				},
+			},
+			want: want{
+				result: true,
+			},
+		},
+		"ChangedAllowUnauthenticatedIdentities": {
+			args: args{
+				cr: identityPool(withSpec(svcapitypes.IdentityPoolParameters{
+					IdentityPoolName:               &testPoolName,
+					AllowUnauthenticatedIdentities: awsclients.Bool(true),
+				})),
+				resp: &svcsdk.IdentityPool{
+					IdentityPoolName:               &testPoolName,
+					AllowUnauthenticatedIdentities: awsclients.Bool(false),
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"ChangedCognitoIdentityProvider": {
+			args: args{
+				cr: identityPool(withSpec(svcapitypes.IdentityPoolParameters{
+					CustomIdentityPoolParameters: svcapitypes.CustomIdentityPoolParameters{
+						CognitoIdentityProviders: []*svcapitypes.CognitoIdentityProviderParameters{
+							{ClientID: &testClientID, ProviderName: &testProviderName, ServerSideTokenCheck: awsclients.Bool(true)},
+						},
+					},
+				})),
+				resp: &svcsdk.IdentityPool{
+					CognitoIdentityProviders: []*svcsdk.Provider{
+						{ClientId: &testClientID, ProviderName: &testProviderName},
+					},
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+		"RemovedOpenIDConnectProvider": {
+			args: args{
+				cr: identityPool(withSpec(svcapitypes.IdentityPoolParameters{
+					CustomIdentityPoolParameters: svcapitypes.CustomIdentityPoolParameters{
+						OpenIDConnectProviderARNs: []*string{&testOIDCARN},
+					},
+				})),
+				resp: &svcsdk.IdentityPool{
+					OpenIdConnectProviderARNs: []*string{&testOIDCARN, &testOtherOIDCARN},
+				},
+			},
+			want: want{
+				result: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Act
+			result, err := isUpToDate(tc.args.cr, tc.args.resp)
+
+			// Assert
+			if diff := cmp.Diff(tc.want.result, result, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identitypoolroleattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentity"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an IdentityPoolRoleAttachment resource"
+	errCreateSession    = "cannot create a new session"
+	errNoIdentityPoolID = "identity pool ID is not set"
+	errGet              = "cannot get roles of identity pool"
+	errSet              = "cannot set roles of identity pool"
+	errUnset            = "cannot remove roles of identity pool"
+
+	roleAuthenticated   = "authenticated"
+	roleUnauthenticated = "unauthenticated"
+)
+
+// SetupIdentityPoolRoleAttachment adds a controller that reconciles
+// IdentityPoolRoleAttachments.
+func SetupIdentityPoolRoleAttachment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(svcapitypes.IdentityPoolRoleAttachmentGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&svcapitypes.IdentityPoolRoleAttachment{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.IdentityPoolRoleAttachmentGroupVersionKind),
+			&connector{kube: mgr.GetClient()},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube client.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.IdentityPoolRoleAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: svcsdk.New(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.CognitoIdentityAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.IdentityPoolRoleAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.IdentityPoolID == nil {
+		return managed.ExternalObservation{}, errors.New(errNoIdentityPoolID)
+	}
+
+	resp, err := e.client.GetIdentityPoolRolesWithContext(ctx, &svcsdk.GetIdentityPoolRolesInput{
+		IdentityPoolId: cr.Spec.ForProvider.IdentityPoolID,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(IsNotFound, err), errGet)
+	}
+	if len(resp.Roles) == 0 && len(resp.RoleMappings) == 0 {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, resp),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.IdentityPoolRoleAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.SetIdentityPoolRolesWithContext(ctx, GenerateSetIdentityPoolRolesInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errSet)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.IdentityPoolRoleAttachment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.SetIdentityPoolRolesWithContext(ctx, GenerateSetIdentityPoolRolesInput(cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errSet)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.IdentityPoolRoleAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// An identity pool always has a set of roles, so detaching them means
+	// setting an empty one.
+	_, err := e.client.SetIdentityPoolRolesWithContext(ctx, &svcsdk.SetIdentityPoolRolesInput{
+		IdentityPoolId: cr.Spec.ForProvider.IdentityPoolID,
+		Roles:          map[string]*string{},
+	})
+	return awsclient.Wrap(resource.Ignore(IsNotFound, err), errUnset)
+}
+
+// GenerateSetIdentityPoolRolesInput returns the input that sets the roles of
+// the supplied parameters.
+func GenerateSetIdentityPoolRolesInput(p svcapitypes.IdentityPoolRoleAttachmentParameters) *svcsdk.SetIdentityPoolRolesInput {
+	res := &svcsdk.SetIdentityPoolRolesInput{
+		IdentityPoolId: p.IdentityPoolID,
+		Roles:          map[string]*string{},
+	}
+	if p.Roles.Authenticated != nil {
+		res.Roles[roleAuthenticated] = p.Roles.Authenticated
+	}
+	if p.Roles.Unauthenticated != nil {
+		res.Roles[roleUnauthenticated] = p.Roles.Unauthenticated
+	}
+	if len(p.RoleMappings) == 0 {
+		return res
+	}
+
+	res.RoleMappings = make(map[string]*svcsdk.RoleMapping, len(p.RoleMappings))
+	for _, m := range p.RoleMappings {
+		rm := &svcsdk.RoleMapping{
+			Type:                    aws.String(m.Type),
+			AmbiguousRoleResolution: m.AmbiguousRoleResolution,
+		}
+		if len(m.Rules) > 0 {
+			rm.RulesConfiguration = &svcsdk.RulesConfigurationType{
+				Rules: make([]*svcsdk.MappingRule, len(m.Rules)),
+			}
+			for i, r := range m.Rules {
+				rm.RulesConfiguration.Rules[i] = &svcsdk.MappingRule{
+					Claim:     aws.String(r.Claim),
+					MatchType: aws.String(r.MatchType),
+					RoleARN:   r.RoleARN,
+					Value:     aws.String(r.Value),
+				}
+			}
+		}
+		res.RoleMappings[m.IdentityProvider] = rm
+	}
+	return res
+}
+
+func isUpToDate(p svcapitypes.IdentityPoolRoleAttachmentParameters, resp *svcsdk.GetIdentityPoolRolesOutput) bool {
+	desired := GenerateSetIdentityPoolRolesInput(p)
+	if !cmp.Equal(aws.StringValueMap(desired.Roles), aws.StringValueMap(resp.Roles), cmpopts.EquateEmpty()) {
+		return false
+	}
+	// The order of the rules matters, the first matching rule wins.
+	return cmp.Equal(desired.RoleMappings, resp.RoleMappings, cmpopts.EquateEmpty(),
+		cmpopts.IgnoreUnexported(svcsdk.RoleMapping{}, svcsdk.RulesConfigurationType{}, svcsdk.MappingRule{}))
+}
+
+// IsNotFound returns whether the given error is of type NotFound or not.
+func IsNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == svcsdk.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,394 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identitypoolroleattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentity"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	poolID       = "us-east-1:00000000-0000-0000-0000-000000000000"
+	authRole     = "arn:aws:iam::123456789012:role/auth"
+	unauthRole   = "arn:aws:iam::123456789012:role/unauth"
+	adminRole    = "arn:aws:iam::123456789012:role/admin"
+	userPoolName = "cognito-idp.us-east-1.amazonaws.com/us-east-1_123456789:client"
+
+	errBoom = errors.New("boom")
+)
+
+type mockClient struct {
+	svcsdkapi.CognitoIdentityAPI
+
+	MockGetIdentityPoolRoles func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error)
+	MockSetIdentityPoolRoles func(*svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error)
+}
+
+func (m *mockClient) GetIdentityPoolRolesWithContext(_ context.Context, in *svcsdk.GetIdentityPoolRolesInput, _ ...request.Option) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+	return m.MockGetIdentityPoolRoles(in)
+}
+
+func (m *mockClient) SetIdentityPoolRolesWithContext(_ context.Context, in *svcsdk.SetIdentityPoolRolesInput, _ ...request.Option) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+	return m.MockSetIdentityPoolRoles(in)
+}
+
+type attachmentModifier func(*svcapitypes.IdentityPoolRoleAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *svcapitypes.IdentityPoolRoleAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRoleMappings(m ...svcapitypes.RoleMappingParameters) attachmentModifier {
+	return func(r *svcapitypes.IdentityPoolRoleAttachment) { r.Spec.ForProvider.RoleMappings = m }
+}
+
+func withoutIdentityPoolID() attachmentModifier {
+	return func(r *svcapitypes.IdentityPoolRoleAttachment) { r.Spec.ForProvider.IdentityPoolID = nil }
+}
+
+func attachment(m ...attachmentModifier) *svcapitypes.IdentityPoolRoleAttachment {
+	cr := &svcapitypes.IdentityPoolRoleAttachment{
+		Spec: svcapitypes.IdentityPoolRoleAttachmentSpec{
+			ForProvider: svcapitypes.IdentityPoolRoleAttachmentParameters{
+				Region:         "us-east-1",
+				IdentityPoolID: &poolID,
+				Roles: svcapitypes.IdentityPoolRoles{
+					Authenticated:   &authRole,
+					Unauthenticated: &unauthRole,
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+var adminMapping = svcapitypes.RoleMappingParameters{
+	IdentityProvider:        userPoolName,
+	Type:                    svcsdk.RoleMappingTypeRules,
+	AmbiguousRoleResolution: awsclient.String(svcsdk.AmbiguousRoleResolutionTypeDeny),
+	Rules: []svcapitypes.MappingRuleParameters{{
+		Claim:     "cognito:groups",
+		MatchType: svcsdk.MappingRuleMatchTypeContains,
+		Value:     "admin",
+		RoleARN:   &adminRole,
+	}},
+}
+
+func adminRoleMappings() map[string]*svcsdk.RoleMapping {
+	return map[string]*svcsdk.RoleMapping{
+		userPoolName: {
+			Type:                    awsclient.String(svcsdk.RoleMappingTypeRules),
+			AmbiguousRoleResolution: awsclient.String(svcsdk.AmbiguousRoleResolutionTypeDeny),
+			RulesConfiguration: &svcsdk.RulesConfigurationType{Rules: []*svcsdk.MappingRule{{
+				Claim:     awsclient.String("cognito:groups"),
+				MatchType: awsclient.String(svcsdk.MappingRuleMatchTypeContains),
+				Value:     awsclient.String("admin"),
+				RoleARN:   &adminRole,
+			}}},
+		},
+	}
+}
+
+type args struct {
+	client svcsdkapi.CognitoIdentityAPI
+	cr     resource.Managed
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return &svcsdk.GetIdentityPoolRolesOutput{
+							IdentityPoolId: &poolID,
+							Roles:          map[string]*string{roleAuthenticated: &authRole, roleUnauthenticated: &unauthRole},
+							RoleMappings:   adminRoleMappings(),
+						}, nil
+					},
+				},
+				cr: attachment(withRoleMappings(adminMapping)),
+			},
+			want: want{
+				cr:     attachment(withRoleMappings(adminMapping), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RoleChanged": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return &svcsdk.GetIdentityPoolRolesOutput{
+							Roles: map[string]*string{roleAuthenticated: &adminRole, roleUnauthenticated: &unauthRole},
+						}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:     attachment(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"RoleMappingRemoved": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return &svcsdk.GetIdentityPoolRolesOutput{
+							Roles:        map[string]*string{roleAuthenticated: &authRole, roleUnauthenticated: &unauthRole},
+							RoleMappings: adminRoleMappings(),
+						}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:     attachment(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NoRoles": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return &svcsdk.GetIdentityPoolRolesOutput{IdentityPoolId: &poolID}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:     attachment(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"IdentityPoolNotFound": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:     attachment(),
+				result: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoIdentityPoolID": {
+			args: args{
+				client: &mockClient{},
+				cr:     attachment(withoutIdentityPoolID()),
+			},
+			want: want{
+				cr:  attachment(withoutIdentityPoolID()),
+				err: errors.New(errNoIdentityPoolID),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &mockClient{
+					MockGetIdentityPoolRoles: func(*svcsdk.GetIdentityPoolRolesInput) (*svcsdk.GetIdentityPoolRolesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &mockClient{
+					MockSetIdentityPoolRoles: func(in *svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+						want := &svcsdk.SetIdentityPoolRolesInput{
+							IdentityPoolId: &poolID,
+							Roles:          map[string]*string{roleAuthenticated: &authRole, roleUnauthenticated: &unauthRole},
+							RoleMappings:   adminRoleMappings(),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("SetIdentityPoolRoles(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.SetIdentityPoolRolesOutput{}, nil
+					},
+				},
+				cr: attachment(withRoleMappings(adminMapping)),
+			},
+			want: want{
+				cr: attachment(withRoleMappings(adminMapping), withConditions(xpv1.Creating())),
+			},
+		},
+		"SetError": {
+			args: args{
+				client: &mockClient{
+					MockSetIdentityPoolRoles: func(*svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errSet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &mockClient{
+					MockSetIdentityPoolRoles: func(in *svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+						want := &svcsdk.SetIdentityPoolRolesInput{IdentityPoolId: &poolID, Roles: map[string]*string{}}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("SetIdentityPoolRoles(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.SetIdentityPoolRolesOutput{}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Deleting())),
+			},
+		},
+		"IdentityPoolNotFound": {
+			args: args{
+				client: &mockClient{
+					MockSetIdentityPoolRoles: func(*svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Deleting())),
+			},
+		},
+		"SetError": {
+			args: args{
+				client: &mockClient{
+					MockSetIdentityPoolRoles: func(*svcsdk.SetIdentityPoolRolesInput) (*svcsdk.SetIdentityPoolRolesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errUnset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,9 +20,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,12 +27,19 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupGroup adds a controller that reconciles Group.
-func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.GroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	opts := []option{
 		func(e *external) {
@@ -55,15 +59,11 @@ func SetupGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Group{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.GroupGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.GroupGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<group-name>"), &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -23,9 +23,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -34,12 +31,19 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/cognitoidentityprovider"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupIdentityProvider adds a controller that reconciles IdentityProvider.
-func SetupIdentityProvider(mgr ctrl.Manager, o controller.Options) error {
+func SetupIdentityProvider(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.IdentityProviderGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	opts := []option{
 		func(e *external) {
@@ -61,15 +65,11 @@ func SetupIdentityProvider(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.IdentityProvider{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.IdentityProviderGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.IdentityProviderGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<provider-name>"), &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -18,12 +18,11 @@ import (
 	"reflect"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -31,20 +30,30 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupUserPool adds a controller that reconciles UserPool.
-func SetupUserPool(mgr ctrl.Manager, o controller.Options) error {
+func SetupUserPool(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.UserPoolGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	opts := []option{
 		func(e *external) {
+			c := &custom{client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = c.postObserve
+			e.preCreate = preCreate
 			e.preUpdate = preUpdate
+			e.postUpdate = c.postUpdate
 			e.preDelete = preDelete
-			e.postCreate = postCreate
+			e.postCreate = c.postCreate
 			e.isUpToDate = isUpToDate
 			e.lateInitialize = lateInitialize
 		},
@@ -57,18 +66,25 @@ func SetupUserPool(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.UserPool{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.UserPoolGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.UserPoolGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Name("<user-pool-id>", "cognito-idp", "userpool"), &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+const (
+	customAttributePrefix = "custom:"
+
+	errGetMFAConfig = "cannot get MFA configuration of user pool"
+	errSetMFAConfig = "cannot set MFA configuration of user pool"
+)
+
+type custom struct {
+	client svcsdkapi.CognitoIdentityProviderAPI
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.DescribeUserPoolInput) error {
 	if meta.GetExternalName(cr) != "" {
 		obj.UserPoolId = awsclients.String(meta.GetExternalName(cr))
@@ -76,33 +92,162 @@ func preObserve(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.Describ
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.DescribeUserPoolOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.UserPool, obj *svcsdk.DescribeUserPoolOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
 	cr.SetConditions(xpv1.Available())
 
+	// The MFA configuration is not part of DescribeUserPool, so it is only
+	// fetched if the rest of the user pool is up to date and the MFA methods
+	// are managed.
+	if !obs.ResourceUpToDate || !hasMFAConfig(cr) {
+		return obs, nil
+	}
+	mfa, err := e.client.GetUserPoolMfaConfigWithContext(ctx, &svcsdk.GetUserPoolMfaConfigInput{
+		UserPoolId: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclients.Wrap(err, errGetMFAConfig)
+	}
+	obs.ResourceUpToDate = isMFAConfigUpToDate(cr.Spec.ForProvider, mfa)
+
 	return obs, nil
 }
 
+func preCreate(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.CreateUserPoolInput) error {
+	obj.LambdaConfig = generateLambdaConfig(cr.Spec.ForProvider.LambdaConfig)
+
+	// CreateUserPool can only turn on MFA together with an SMS configuration.
+	// Pools that use software tokens only are created without MFA, which is
+	// turned on by SetUserPoolMfaConfig in postCreate.
+	if cr.Spec.ForProvider.SoftwareTokenMFAConfiguration != nil && cr.Spec.ForProvider.SmsMFAConfiguration == nil &&
+		awsclients.StringValue(cr.Spec.ForProvider.MFAConfiguration) != svcsdk.UserPoolMfaTypeOff {
+		obj.MfaConfiguration = awsclients.String(svcsdk.UserPoolMfaTypeOff)
+	}
+	return nil
+}
+
 func preUpdate(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.UpdateUserPoolInput) error {
 	obj.UserPoolId = awsclients.String(meta.GetExternalName(cr))
+	obj.LambdaConfig = generateLambdaConfig(cr.Spec.ForProvider.LambdaConfig)
 	return nil
 }
 
+func (e *custom) postUpdate(ctx context.Context, cr *svcapitypes.UserPool, obj *svcsdk.UpdateUserPoolOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	return upd, e.setMFAConfig(ctx, cr)
+}
+
 func preDelete(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.DeleteUserPoolInput) (bool, error) {
 	obj.UserPoolId = awsclients.String(meta.GetExternalName(cr))
 	return false, nil
 }
 
-func postCreate(_ context.Context, cr *svcapitypes.UserPool, obj *svcsdk.CreateUserPoolOutput, obs managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
+func (e *custom) postCreate(ctx context.Context, cr *svcapitypes.UserPool, obj *svcsdk.CreateUserPoolOutput, obs managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
 	meta.SetExternalName(cr, awsclients.StringValue(obj.UserPool.Id))
-	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+	return managed.ExternalCreation{ExternalNameAssigned: true}, e.setMFAConfig(ctx, cr)
+}
+
+// setMFAConfig applies the MFA methods of the user pool, which can only be
+// configured with SetUserPoolMfaConfig.
+func (e *custom) setMFAConfig(ctx context.Context, cr *svcapitypes.UserPool) error {
+	if !hasMFAConfig(cr) {
+		return nil
+	}
+	p := cr.Spec.ForProvider
+	in := &svcsdk.SetUserPoolMfaConfigInput{
+		MfaConfiguration: p.MFAConfiguration,
+		UserPoolId:       awsclients.String(meta.GetExternalName(cr)),
+	}
+	if p.SmsMFAConfiguration != nil {
+		in.SmsMfaConfiguration = &svcsdk.SmsMfaConfigType{
+			SmsAuthenticationMessage: p.SmsMFAConfiguration.SmsAuthenticationMessage,
+		}
+		if c := p.SmsMFAConfiguration.SmsConfiguration; c != nil {
+			in.SmsMfaConfiguration.SmsConfiguration = &svcsdk.SmsConfigurationType{
+				ExternalId:   c.ExternalID,
+				SnsCallerArn: c.SnsCallerARN,
+			}
+		}
+	}
+	if p.SoftwareTokenMFAConfiguration != nil {
+		in.SoftwareTokenMfaConfiguration = &svcsdk.SoftwareTokenMfaConfigType{
+			Enabled: p.SoftwareTokenMFAConfiguration.Enabled,
+		}
+	}
+	_, err := e.client.SetUserPoolMfaConfigWithContext(ctx, in)
+	return errors.Wrap(err, errSetMFAConfig)
+}
+
+func hasMFAConfig(cr *svcapitypes.UserPool) bool {
+	return cr.Spec.ForProvider.SmsMFAConfiguration != nil || cr.Spec.ForProvider.SoftwareTokenMFAConfiguration != nil
+}
+
+func isMFAConfigUpToDate(spec svcapitypes.UserPoolParameters, current *svcsdk.GetUserPoolMfaConfigOutput) bool {
+	if spec.MFAConfiguration != nil && awsclients.StringValue(spec.MFAConfiguration) != awsclients.StringValue(current.MfaConfiguration) {
+		return false
+	}
+	if s := spec.SoftwareTokenMFAConfiguration; s != nil {
+		if current.SoftwareTokenMfaConfiguration == nil ||
+			awsclients.BoolValue(s.Enabled) != awsclients.BoolValue(current.SoftwareTokenMfaConfiguration.Enabled) {
+			return false
+		}
+	}
+	if s := spec.SmsMFAConfiguration; s != nil {
+		c := current.SmsMfaConfiguration
+		if c == nil {
+			return false
+		}
+		if awsclients.StringValue(s.SmsAuthenticationMessage) != awsclients.StringValue(c.SmsAuthenticationMessage) {
+			return false
+		}
+		if s.SmsConfiguration != nil && (c.SmsConfiguration == nil ||
+			awsclients.StringValue(s.SmsConfiguration.ExternalID) != awsclients.StringValue(c.SmsConfiguration.ExternalId) ||
+			awsclients.StringValue(s.SmsConfiguration.SnsCallerARN) != awsclients.StringValue(c.SmsConfiguration.SnsCallerArn)) {
+			return false
+		}
+	}
+	return true
+}
+
+func generateLambdaConfig(p *svcapitypes.LambdaConfigParameters) *svcsdk.LambdaConfigType {
+	if p == nil {
+		return nil
+	}
+	c := &svcsdk.LambdaConfigType{
+		CreateAuthChallenge:         p.CreateAuthChallenge,
+		CustomMessage:               p.CustomMessage,
+		DefineAuthChallenge:         p.DefineAuthChallenge,
+		KMSKeyID:                    p.KMSKeyID,
+		PostAuthentication:          p.PostAuthentication,
+		PostConfirmation:            p.PostConfirmation,
+		PreAuthentication:           p.PreAuthentication,
+		PreSignUp:                   p.PreSignUp,
+		PreTokenGeneration:          p.PreTokenGeneration,
+		UserMigration:               p.UserMigration,
+		VerifyAuthChallengeResponse: p.VerifyAuthChallengeResponse,
+	}
+	if p.CustomEmailSender != nil {
+		c.CustomEmailSender = &svcsdk.CustomEmailLambdaVersionConfigType{
+			LambdaArn:     p.CustomEmailSender.LambdaARN,
+			LambdaVersion: p.CustomEmailSender.LambdaVersion,
+		}
+	}
+	if p.CustomSMSSender != nil {
+		c.CustomSMSSender = &svcsdk.CustomSMSLambdaVersionConfigType{
+			LambdaArn:     p.CustomSMSSender.LambdaARN,
+			LambdaVersion: p.CustomSMSSender.LambdaVersion,
+		}
+	}
+	return c
 }
 
 func isUpToDate(cr *svcapitypes.UserPool, resp *svcsdk.DescribeUserPoolOutput) (bool, error) {
@@ -190,70 +335,121 @@ func areEmailConfigurationEqual(spec *svcapitypes.EmailConfigurationType, curren
 	return true
 }
 
-func areLambdaConfigEqual(spec *svcapitypes.LambdaConfigType, current *svcsdk.LambdaConfigType) bool {
-	if spec != nil && current != nil {
-		switch {
-		case awsclients.StringValue(spec.CreateAuthChallenge) != awsclients.StringValue(current.CreateAuthChallenge),
-			awsclients.StringValue(spec.CustomEmailSender.LambdaARN) != awsclients.StringValue(current.CustomEmailSender.LambdaArn),
-			awsclients.StringValue(spec.CustomEmailSender.LambdaVersion) != awsclients.StringValue(current.CustomEmailSender.LambdaVersion),
-			awsclients.StringValue(spec.CustomMessage) != awsclients.StringValue(current.CustomMessage),
-			awsclients.StringValue(spec.CustomSMSSender.LambdaARN) != awsclients.StringValue(current.CustomSMSSender.LambdaArn),
-			awsclients.StringValue(spec.CustomSMSSender.LambdaVersion) != awsclients.StringValue(current.CustomSMSSender.LambdaVersion),
-			awsclients.StringValue(spec.DefineAuthChallenge) != awsclients.StringValue(current.DefineAuthChallenge),
-			awsclients.StringValue(spec.KMSKeyID) != awsclients.StringValue(current.KMSKeyID),
-			awsclients.StringValue(spec.PostAuthentication) != awsclients.StringValue(current.PostAuthentication),
-			awsclients.StringValue(spec.PostConfirmation) != awsclients.StringValue(current.PostConfirmation),
-			awsclients.StringValue(spec.PreAuthentication) != awsclients.StringValue(current.PreAuthentication),
-			awsclients.StringValue(spec.PreSignUp) != awsclients.StringValue(current.PreSignUp),
-			awsclients.StringValue(spec.PreTokenGeneration) != awsclients.StringValue(current.PreTokenGeneration),
-			awsclients.StringValue(spec.UserMigration) != awsclients.StringValue(current.UserMigration),
-			awsclients.StringValue(spec.VerifyAuthChallengeResponse) != awsclients.StringValue(current.VerifyAuthChallengeResponse):
-			return false
-		}
+func areLambdaConfigEqual(spec *svcapitypes.LambdaConfigParameters, current *svcsdk.LambdaConfigType) bool {
+	if spec == nil {
+		return true
+	}
+	if current == nil {
+		current = &svcsdk.LambdaConfigType{}
+	}
+	switch {
+	case awsclients.StringValue(spec.CreateAuthChallenge) != awsclients.StringValue(current.CreateAuthChallenge),
+		!areCustomEmailSenderEqual(spec.CustomEmailSender, current.CustomEmailSender),
+		awsclients.StringValue(spec.CustomMessage) != awsclients.StringValue(current.CustomMessage),
+		!areCustomSMSSenderEqual(spec.CustomSMSSender, current.CustomSMSSender),
+		awsclients.StringValue(spec.DefineAuthChallenge) != awsclients.StringValue(current.DefineAuthChallenge),
+		awsclients.StringValue(spec.KMSKeyID) != awsclients.StringValue(current.KMSKeyID),
+		awsclients.StringValue(spec.PostAuthentication) != awsclients.StringValue(current.PostAuthentication),
+		awsclients.StringValue(spec.PostConfirmation) != awsclients.StringValue(current.PostConfirmation),
+		awsclients.StringValue(spec.PreAuthentication) != awsclients.StringValue(current.PreAuthentication),
+		awsclients.StringValue(spec.PreSignUp) != awsclients.StringValue(current.PreSignUp),
+		awsclients.StringValue(spec.PreTokenGeneration) != awsclients.StringValue(current.PreTokenGeneration),
+		awsclients.StringValue(spec.UserMigration) != awsclients.StringValue(current.UserMigration),
+		awsclients.StringValue(spec.VerifyAuthChallengeResponse) != awsclients.StringValue(current.VerifyAuthChallengeResponse):
+		return false
 	}
 	return true
 }
 
+func areCustomEmailSenderEqual(spec *svcapitypes.CustomLambdaVersionConfig, current *svcsdk.CustomEmailLambdaVersionConfigType) bool {
+	if spec == nil || current == nil {
+		return spec == nil && current == nil
+	}
+	return awsclients.StringValue(spec.LambdaARN) == awsclients.StringValue(current.LambdaArn) &&
+		awsclients.StringValue(spec.LambdaVersion) == awsclients.StringValue(current.LambdaVersion)
+}
+
+func areCustomSMSSenderEqual(spec *svcapitypes.CustomLambdaVersionConfig, current *svcsdk.CustomSMSLambdaVersionConfigType) bool {
+	if spec == nil || current == nil {
+		return spec == nil && current == nil
+	}
+	return awsclients.StringValue(spec.LambdaARN) == awsclients.StringValue(current.LambdaArn) &&
+		awsclients.StringValue(spec.LambdaVersion) == awsclients.StringValue(current.LambdaVersion)
+}
+
 func arePoliciesEqual(spec *svcapitypes.UserPoolPolicyType, current *svcsdk.UserPoolPolicyType) bool {
-	if spec != nil && current != nil {
-		switch {
-		case awsclients.Int64Value(spec.PasswordPolicy.MinimumLength) != awsclients.Int64Value(current.PasswordPolicy.MinimumLength),
-			awsclients.BoolValue(spec.PasswordPolicy.RequireLowercase) != awsclients.BoolValue(current.PasswordPolicy.RequireLowercase),
-			awsclients.BoolValue(spec.PasswordPolicy.RequireNumbers) != awsclients.BoolValue(current.PasswordPolicy.RequireNumbers),
-			awsclients.BoolValue(spec.PasswordPolicy.RequireSymbols) != awsclients.BoolValue(current.PasswordPolicy.RequireSymbols),
-			awsclients.BoolValue(spec.PasswordPolicy.RequireUppercase) != awsclients.BoolValue(current.PasswordPolicy.RequireUppercase),
-			awsclients.Int64Value(spec.PasswordPolicy.TemporaryPasswordValidityDays) != awsclients.Int64Value(current.PasswordPolicy.TemporaryPasswordValidityDays):
-			return false
-		}
+	if spec == nil || spec.PasswordPolicy == nil {
+		return true
+	}
+	if current == nil || current.PasswordPolicy == nil {
+		return false
+	}
+	switch {
+	case awsclients.Int64Value(spec.PasswordPolicy.MinimumLength) != awsclients.Int64Value(current.PasswordPolicy.MinimumLength),
+		awsclients.BoolValue(spec.PasswordPolicy.RequireLowercase) != awsclients.BoolValue(current.PasswordPolicy.RequireLowercase),
+		awsclients.BoolValue(spec.PasswordPolicy.RequireNumbers) != awsclients.BoolValue(current.PasswordPolicy.RequireNumbers),
+		awsclients.BoolValue(spec.PasswordPolicy.RequireSymbols) != awsclients.BoolValue(current.PasswordPolicy.RequireSymbols),
+		awsclients.BoolValue(spec.PasswordPolicy.RequireUppercase) != awsclients.BoolValue(current.PasswordPolicy.RequireUppercase),
+		awsclients.Int64Value(spec.PasswordPolicy.TemporaryPasswordValidityDays) != awsclients.Int64Value(current.PasswordPolicy.TemporaryPasswordValidityDays):
+		return false
 	}
 	return true
 }
 
+// areSchemaEqual reports whether every schema attribute in spec exists in
+// current. AWS reports the standard attributes along with the custom ones,
+// and custom attributes are returned with a "custom:" prefix, so attributes
+// are matched by name rather than by position.
 func areSchemaEqual(spec []*svcapitypes.SchemaAttributeType, current []*svcsdk.SchemaAttributeType) bool {
-	if spec != nil && current != nil {
-		if len(spec) > 0 && len(spec) != len(current) {
+	observed := make(map[string]*svcsdk.SchemaAttributeType, len(current))
+	for _, c := range current {
+		observed[awsclients.StringValue(c.Name)] = c
+	}
+
+	for _, s := range spec {
+		c, ok := observed[awsclients.StringValue(s.Name)]
+		if !ok {
+			c, ok = observed[customAttributePrefix+awsclients.StringValue(s.Name)]
+		}
+		if !ok {
 			return false
 		}
-
-		for i, s := range spec {
-			switch {
-			case awsclients.StringValue(s.AttributeDataType) != awsclients.StringValue(current[i].AttributeDataType),
-				awsclients.BoolValue(s.DeveloperOnlyAttribute) != awsclients.BoolValue(current[i].DeveloperOnlyAttribute),
-				awsclients.BoolValue(s.Mutable) != awsclients.BoolValue(current[i].Mutable),
-				awsclients.StringValue(s.Name) != awsclients.StringValue(current[i].Name),
-				awsclients.StringValue(s.NumberAttributeConstraints.MaxValue) != awsclients.StringValue(current[i].NumberAttributeConstraints.MaxValue),
-				awsclients.StringValue(s.NumberAttributeConstraints.MinValue) != awsclients.StringValue(current[i].NumberAttributeConstraints.MinValue),
-				awsclients.BoolValue(s.Required) != awsclients.BoolValue(current[i].Required),
-				awsclients.StringValue(s.StringAttributeConstraints.MaxLength) != awsclients.StringValue(current[i].StringAttributeConstraints.MaxLength),
-				awsclients.StringValue(s.StringAttributeConstraints.MinLength) != awsclients.StringValue(current[i].StringAttributeConstraints.MinLength):
-				return false
-			}
+		switch {
+		case awsclients.StringValue(s.AttributeDataType) != awsclients.StringValue(c.AttributeDataType),
+			awsclients.BoolValue(s.DeveloperOnlyAttribute) != awsclients.BoolValue(c.DeveloperOnlyAttribute),
+			s.Mutable != nil && awsclients.BoolValue(s.Mutable) != awsclients.BoolValue(c.Mutable),
+			awsclients.BoolValue(s.Required) != awsclients.BoolValue(c.Required),
+			!areNumberAttributeConstraintsEqual(s.NumberAttributeConstraints, c.NumberAttributeConstraints),
+			!areStringAttributeConstraintsEqual(s.StringAttributeConstraints, c.StringAttributeConstraints):
+			return false
 		}
 	}
 
 	return true
 }
 
+func areNumberAttributeConstraintsEqual(spec *svcapitypes.NumberAttributeConstraintsType, current *svcsdk.NumberAttributeConstraintsType) bool {
+	if spec == nil {
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	return awsclients.StringValue(spec.MaxValue) == awsclients.StringValue(current.MaxValue) &&
+		awsclients.StringValue(spec.MinValue) == awsclients.StringValue(current.MinValue)
+}
+
+func areStringAttributeConstraintsEqual(spec *svcapitypes.StringAttributeConstraintsType, current *svcsdk.StringAttributeConstraintsType) bool {
+	if spec == nil {
+		return true
+	}
+	if current == nil {
+		return false
+	}
+	return awsclients.StringValue(spec.MaxLength) == awsclients.StringValue(current.MaxLength) &&
+		awsclients.StringValue(spec.MinLength) == awsclients.StringValue(current.MinLength)
+}
+
 func areSmsConfigurationEqual(spec *svcapitypes.SmsConfigurationType, current *svcsdk.SmsConfigurationType) bool {
 	if spec != nil && current != nil {
 		switch {
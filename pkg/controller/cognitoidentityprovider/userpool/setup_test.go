@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
@@ -12,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
 type functionModifier func(*svcapitypes.UserPool)
@@ -50,6 +54,21 @@ var (
 	testBool2         bool   = false
 )
 
+type mockCognitoClient struct {
+	svcsdkapi.CognitoIdentityProviderAPI
+
+	GetUserPoolMfaConfigWithContextFunc func(aws.Context, *svcsdk.GetUserPoolMfaConfigInput, ...request.Option) (*svcsdk.GetUserPoolMfaConfigOutput, error)
+	SetUserPoolMfaConfigWithContextFunc func(aws.Context, *svcsdk.SetUserPoolMfaConfigInput, ...request.Option) (*svcsdk.SetUserPoolMfaConfigOutput, error)
+}
+
+func (m *mockCognitoClient) GetUserPoolMfaConfigWithContext(ctx aws.Context, in *svcsdk.GetUserPoolMfaConfigInput, opts ...request.Option) (*svcsdk.GetUserPoolMfaConfigOutput, error) {
+	return m.GetUserPoolMfaConfigWithContextFunc(ctx, in, opts...)
+}
+
+func (m *mockCognitoClient) SetUserPoolMfaConfigWithContext(ctx aws.Context, in *svcsdk.SetUserPoolMfaConfigInput, opts ...request.Option) (*svcsdk.SetUserPoolMfaConfigOutput, error) {
+	return m.SetUserPoolMfaConfigWithContextFunc(ctx, in, opts...)
+}
+
 func TestIsUpToDate(t *testing.T) {
 	type args struct {
 		cr   *svcapitypes.UserPool
@@ -218,9 +237,11 @@ func TestIsUpToDate(t *testing.T) {
 		"ChangedLambdaConfig": {
 			args: args{
 				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
-					LambdaConfig: &svcapitypes.LambdaConfigType{
-						CustomEmailSender: &svcapitypes.CustomEmailLambdaVersionConfigType{
-							LambdaARN: &testString1,
+					CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+						LambdaConfig: &svcapitypes.LambdaConfigParameters{
+							CustomEmailSender: &svcapitypes.CustomLambdaVersionConfig{
+								LambdaARN: &testString1,
+							},
 						},
 					},
 				})),
@@ -237,6 +258,42 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"MissingLambdaConfig": {
+			args: args{
+				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
+					CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+						LambdaConfig: &svcapitypes.LambdaConfigParameters{
+							PreSignUp: &testString1,
+						},
+					},
+				})),
+				resp: &svcsdk.DescribeUserPoolOutput{UserPool: &svcsdk.UserPoolType{}},
+			},
+			want: want{
+				result: false,
+				err:    nil,
+			},
+		},
+		"UnchangedLambdaConfigWithoutSenders": {
+			args: args{
+				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
+					CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+						LambdaConfig: &svcapitypes.LambdaConfigParameters{
+							PreSignUp: &testString1,
+						},
+					},
+				})),
+				resp: &svcsdk.DescribeUserPoolOutput{UserPool: &svcsdk.UserPoolType{
+					LambdaConfig: &svcsdk.LambdaConfigType{
+						PreSignUp: &testString1,
+					},
+				}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 		"ChangedMFAConfiguration": {
 			args: args{
 				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
@@ -273,6 +330,52 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"MissingPasswordPolicy": {
+			args: args{
+				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
+					Policies: &svcapitypes.UserPoolPolicyType{
+						PasswordPolicy: &svcapitypes.PasswordPolicyType{
+							MinimumLength: &testNumber,
+						},
+					},
+				})),
+				resp: &svcsdk.DescribeUserPoolOutput{UserPool: &svcsdk.UserPoolType{}},
+			},
+			want: want{
+				result: false,
+				err:    nil,
+			},
+		},
+		"UnchangedSchemaWithStandardAttributes": {
+			args: args{
+				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
+					Schema: []*svcapitypes.SchemaAttributeType{
+						{
+							Name:              &testString1,
+							AttributeDataType: &testString2,
+						},
+					},
+				})),
+				resp: &svcsdk.DescribeUserPoolOutput{UserPool: &svcsdk.UserPoolType{
+					SchemaAttributes: []*svcsdk.SchemaAttributeType{
+						{
+							Name:              awsclients.String("sub"),
+							AttributeDataType: awsclients.String("String"),
+							Required:          &testBool1,
+						},
+						{
+							Name:                       awsclients.String("custom:" + testString1),
+							AttributeDataType:          &testString2,
+							StringAttributeConstraints: &svcsdk.StringAttributeConstraintsType{},
+						},
+					},
+				}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 		"ChangedSchema": {
 			args: args{
 				cr: userPool(withSpec(svcapitypes.UserPoolParameters{
@@ -499,9 +602,10 @@ func TestLateInitialize(t *testing.T) {
 
 func TestPostCreate(t *testing.T) {
 	type args struct {
-		cr  *svcapitypes.UserPool
-		obj *svcsdk.CreateUserPoolOutput
-		err error
+		client svcsdkapi.CognitoIdentityProviderAPI
+		cr     *svcapitypes.UserPool
+		obj    *svcsdk.CreateUserPoolOutput
+		err    error
 	}
 
 	type want struct {
@@ -543,6 +647,85 @@ func TestPostCreate(t *testing.T) {
 				err: nil,
 			},
 		},
+		"SetMFAConfig": {
+			args: args{
+				client: &mockCognitoClient{
+					SetUserPoolMfaConfigWithContextFunc: func(_ aws.Context, in *svcsdk.SetUserPoolMfaConfigInput, _ ...request.Option) (*svcsdk.SetUserPoolMfaConfigOutput, error) {
+						want := &svcsdk.SetUserPoolMfaConfigInput{
+							MfaConfiguration:              awsclients.String(svcsdk.UserPoolMfaTypeOn),
+							SoftwareTokenMfaConfiguration: &svcsdk.SoftwareTokenMfaConfigType{Enabled: &testBool1},
+							UserPoolId:                    &testString1,
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.SetUserPoolMfaConfigOutput{}, nil
+					},
+				},
+				cr: userPool(
+					withSpec(svcapitypes.UserPoolParameters{
+						MFAConfiguration: awsclients.String(svcsdk.UserPoolMfaTypeOn),
+						CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+							SoftwareTokenMFAConfiguration: &svcapitypes.SoftwareTokenMFAConfigType{Enabled: &testBool1},
+						},
+					}),
+				),
+				obj: &svcsdk.CreateUserPoolOutput{
+					UserPool: &svcsdk.UserPoolType{
+						Id: &testString1,
+					},
+				},
+			},
+			want: want{
+				cr: userPool(
+					withSpec(svcapitypes.UserPoolParameters{
+						MFAConfiguration: awsclients.String(svcsdk.UserPoolMfaTypeOn),
+						CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+							SoftwareTokenMFAConfiguration: &svcapitypes.SoftwareTokenMFAConfigType{Enabled: &testBool1},
+						},
+					}),
+					withExternalName(testString1),
+				),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+				},
+			},
+		},
+		"FailedSetMFAConfig": {
+			args: args{
+				client: &mockCognitoClient{
+					SetUserPoolMfaConfigWithContextFunc: func(_ aws.Context, _ *svcsdk.SetUserPoolMfaConfigInput, _ ...request.Option) (*svcsdk.SetUserPoolMfaConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userPool(
+					withSpec(svcapitypes.UserPoolParameters{
+						CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+							SoftwareTokenMFAConfiguration: &svcapitypes.SoftwareTokenMFAConfigType{Enabled: &testBool1},
+						},
+					}),
+				),
+				obj: &svcsdk.CreateUserPoolOutput{
+					UserPool: &svcsdk.UserPoolType{
+						Id: &testString1,
+					},
+				},
+			},
+			want: want{
+				cr: userPool(
+					withSpec(svcapitypes.UserPoolParameters{
+						CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+							SoftwareTokenMFAConfiguration: &svcapitypes.SoftwareTokenMFAConfigType{Enabled: &testBool1},
+						},
+					}),
+					withExternalName(testString1),
+				),
+				result: managed.ExternalCreation{
+					ExternalNameAssigned: true,
+				},
+				err: errors.Wrap(errBoom, errSetMFAConfig),
+			},
+		},
 		"FailedCreation": {
 			args: args{
 				cr: userPool(
@@ -564,7 +747,8 @@ func TestPostCreate(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			// Act
-			result, err := postCreate(context.Background(), tc.args.cr, tc.args.obj, managed.ExternalCreation{}, tc.args.err)
+			e := &custom{client: tc.args.client}
+			result, err := e.postCreate(context.Background(), tc.args.cr, tc.args.obj, managed.ExternalCreation{}, tc.args.err)
 
 			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
@@ -578,3 +762,100 @@ func TestPostCreate(t *testing.T) {
 		})
 	}
 }
+
+func TestPostObserve(t *testing.T) {
+	type args struct {
+		client svcsdkapi.CognitoIdentityProviderAPI
+		cr     *svcapitypes.UserPool
+		obs    managed.ExternalObservation
+		err    error
+	}
+
+	type want struct {
+		result managed.ExternalObservation
+		err    error
+	}
+
+	mfa := svcapitypes.UserPoolParameters{
+		MFAConfiguration: awsclients.String(svcsdk.UserPoolMfaTypeOptional),
+		CustomUserPoolParameters: svcapitypes.CustomUserPoolParameters{
+			SoftwareTokenMFAConfiguration: &svcapitypes.SoftwareTokenMFAConfigType{Enabled: &testBool1},
+		},
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoMFAConfig": {
+			args: args{
+				cr:  userPool(withSpec(svcapitypes.UserPoolParameters{})),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MFAConfigUpToDate": {
+			args: args{
+				client: &mockCognitoClient{
+					GetUserPoolMfaConfigWithContextFunc: func(_ aws.Context, _ *svcsdk.GetUserPoolMfaConfigInput, _ ...request.Option) (*svcsdk.GetUserPoolMfaConfigOutput, error) {
+						return &svcsdk.GetUserPoolMfaConfigOutput{
+							MfaConfiguration:              awsclients.String(svcsdk.UserPoolMfaTypeOptional),
+							SoftwareTokenMfaConfiguration: &svcsdk.SoftwareTokenMfaConfigType{Enabled: &testBool1},
+						}, nil
+					},
+				},
+				cr:  userPool(withSpec(mfa), withExternalName(testString1)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"MFAConfigChanged": {
+			args: args{
+				client: &mockCognitoClient{
+					GetUserPoolMfaConfigWithContextFunc: func(_ aws.Context, _ *svcsdk.GetUserPoolMfaConfigInput, _ ...request.Option) (*svcsdk.GetUserPoolMfaConfigOutput, error) {
+						return &svcsdk.GetUserPoolMfaConfigOutput{
+							MfaConfiguration: awsclients.String(svcsdk.UserPoolMfaTypeOff),
+						}, nil
+					},
+				},
+				cr:  userPool(withSpec(mfa), withExternalName(testString1)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"FailedGetMFAConfig": {
+			args: args{
+				client: &mockCognitoClient{
+					GetUserPoolMfaConfigWithContextFunc: func(_ aws.Context, _ *svcsdk.GetUserPoolMfaConfigInput, _ ...request.Option) (*svcsdk.GetUserPoolMfaConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr:  userPool(withSpec(mfa), withExternalName(testString1)),
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				err: awsclients.Wrap(errBoom, errGetMFAConfig),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &custom{client: tc.args.client}
+			result, err := e.postObserve(context.Background(), tc.args.cr, nil, tc.args.obs, tc.args.err)
+
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -260,65 +260,6 @@ func (e *external) Create(ctx context.Context, mg cpresource.Managed) (managed.E
 	} else {
 		cr.Status.AtProvider.ID = nil
 	}
-	if resp.UserPool.LambdaConfig != nil {
-		f15 := &svcapitypes.LambdaConfigType{}
-		if resp.UserPool.LambdaConfig.CreateAuthChallenge != nil {
-			f15.CreateAuthChallenge = resp.UserPool.LambdaConfig.CreateAuthChallenge
-		}
-		if resp.UserPool.LambdaConfig.CustomEmailSender != nil {
-			f15f1 := &svcapitypes.CustomEmailLambdaVersionConfigType{}
-			if resp.UserPool.LambdaConfig.CustomEmailSender.LambdaArn != nil {
-				f15f1.LambdaARN = resp.UserPool.LambdaConfig.CustomEmailSender.LambdaArn
-			}
-			if resp.UserPool.LambdaConfig.CustomEmailSender.LambdaVersion != nil {
-				f15f1.LambdaVersion = resp.UserPool.LambdaConfig.CustomEmailSender.LambdaVersion
-			}
-			f15.CustomEmailSender = f15f1
-		}
-		if resp.UserPool.LambdaConfig.CustomMessage != nil {
-			f15.CustomMessage = resp.UserPool.LambdaConfig.CustomMessage
-		}
-		if resp.UserPool.LambdaConfig.CustomSMSSender != nil {
-			f15f3 := &svcapitypes.CustomSMSLambdaVersionConfigType{}
-			if resp.UserPool.LambdaConfig.CustomSMSSender.LambdaArn != nil {
-				f15f3.LambdaARN = resp.UserPool.LambdaConfig.CustomSMSSender.LambdaArn
-			}
-			if resp.UserPool.LambdaConfig.CustomSMSSender.LambdaVersion != nil {
-				f15f3.LambdaVersion = resp.UserPool.LambdaConfig.CustomSMSSender.LambdaVersion
-			}
-			f15.CustomSMSSender = f15f3
-		}
-		if resp.UserPool.LambdaConfig.DefineAuthChallenge != nil {
-			f15.DefineAuthChallenge = resp.UserPool.LambdaConfig.DefineAuthChallenge
-		}
-		if resp.UserPool.LambdaConfig.KMSKeyID != nil {
-			f15.KMSKeyID = resp.UserPool.LambdaConfig.KMSKeyID
-		}
-		if resp.UserPool.LambdaConfig.PostAuthentication != nil {
-			f15.PostAuthentication = resp.UserPool.LambdaConfig.PostAuthentication
-		}
-		if resp.UserPool.LambdaConfig.PostConfirmation != nil {
-			f15.PostConfirmation = resp.UserPool.LambdaConfig.PostConfirmation
-		}
-		if resp.UserPool.LambdaConfig.PreAuthentication != nil {
-			f15.PreAuthentication = resp.UserPool.LambdaConfig.PreAuthentication
-		}
-		if resp.UserPool.LambdaConfig.PreSignUp != nil {
-			f15.PreSignUp = resp.UserPool.LambdaConfig.PreSignUp
-		}
-		if resp.UserPool.LambdaConfig.PreTokenGeneration != nil {
-			f15.PreTokenGeneration = resp.UserPool.LambdaConfig.PreTokenGeneration
-		}
-		if resp.UserPool.LambdaConfig.UserMigration != nil {
-			f15.UserMigration = resp.UserPool.LambdaConfig.UserMigration
-		}
-		if resp.UserPool.LambdaConfig.VerifyAuthChallengeResponse != nil {
-			f15.VerifyAuthChallengeResponse = resp.UserPool.LambdaConfig.VerifyAuthChallengeResponse
-		}
-		cr.Spec.ForProvider.LambdaConfig = f15
-	} else {
-		cr.Spec.ForProvider.LambdaConfig = nil
-	}
 	if resp.UserPool.LastModifiedDate != nil {
 		cr.Status.AtProvider.LastModifiedDate = &metav1.Time{*resp.UserPool.LastModifiedDate}
 	} else {
@@ -186,65 +186,6 @@ func GenerateUserPool(resp *svcsdk.DescribeUserPoolOutput) *svcapitypes.UserPool
 	} else {
 		cr.Status.AtProvider.ID = nil
 	}
-	if resp.UserPool.LambdaConfig != nil {
-		f15 := &svcapitypes.LambdaConfigType{}
-		if resp.UserPool.LambdaConfig.CreateAuthChallenge != nil {
-			f15.CreateAuthChallenge = resp.UserPool.LambdaConfig.CreateAuthChallenge
-		}
-		if resp.UserPool.LambdaConfig.CustomEmailSender != nil {
-			f15f1 := &svcapitypes.CustomEmailLambdaVersionConfigType{}
-			if resp.UserPool.LambdaConfig.CustomEmailSender.LambdaArn != nil {
-				f15f1.LambdaARN = resp.UserPool.LambdaConfig.CustomEmailSender.LambdaArn
-			}
-			if resp.UserPool.LambdaConfig.CustomEmailSender.LambdaVersion != nil {
-				f15f1.LambdaVersion = resp.UserPool.LambdaConfig.CustomEmailSender.LambdaVersion
-			}
-			f15.CustomEmailSender = f15f1
-		}
-		if resp.UserPool.LambdaConfig.CustomMessage != nil {
-			f15.CustomMessage = resp.UserPool.LambdaConfig.CustomMessage
-		}
-		if resp.UserPool.LambdaConfig.CustomSMSSender != nil {
-			f15f3 := &svcapitypes.CustomSMSLambdaVersionConfigType{}
-			if resp.UserPool.LambdaConfig.CustomSMSSender.LambdaArn != nil {
-				f15f3.LambdaARN = resp.UserPool.LambdaConfig.CustomSMSSender.LambdaArn
-			}
-			if resp.UserPool.LambdaConfig.CustomSMSSender.LambdaVersion != nil {
-				f15f3.LambdaVersion = resp.UserPool.LambdaConfig.CustomSMSSender.LambdaVersion
-			}
-			f15.CustomSMSSender = f15f3
-		}
-		if resp.UserPool.LambdaConfig.DefineAuthChallenge != nil {
-			f15.DefineAuthChallenge = resp.UserPool.LambdaConfig.DefineAuthChallenge
-		}
-		if resp.UserPool.LambdaConfig.KMSKeyID != nil {
-			f15.KMSKeyID = resp.UserPool.LambdaConfig.KMSKeyID
-		}
-		if resp.UserPool.LambdaConfig.PostAuthentication != nil {
-			f15.PostAuthentication = resp.UserPool.LambdaConfig.PostAuthentication
-		}
-		if resp.UserPool.LambdaConfig.PostConfirmation != nil {
-			f15.PostConfirmation = resp.UserPool.LambdaConfig.PostConfirmation
-		}
-		if resp.UserPool.LambdaConfig.PreAuthentication != nil {
-			f15.PreAuthentication = resp.UserPool.LambdaConfig.PreAuthentication
-		}
-		if resp.UserPool.LambdaConfig.PreSignUp != nil {
-			f15.PreSignUp = resp.UserPool.LambdaConfig.PreSignUp
-		}
-		if resp.UserPool.LambdaConfig.PreTokenGeneration != nil {
-			f15.PreTokenGeneration = resp.UserPool.LambdaConfig.PreTokenGeneration
-		}
-		if resp.UserPool.LambdaConfig.UserMigration != nil {
-			f15.UserMigration = resp.UserPool.LambdaConfig.UserMigration
-		}
-		if resp.UserPool.LambdaConfig.VerifyAuthChallengeResponse != nil {
-			f15.VerifyAuthChallengeResponse = resp.UserPool.LambdaConfig.VerifyAuthChallengeResponse
-		}
-		cr.Spec.ForProvider.LambdaConfig = f15
-	} else {
-		cr.Spec.ForProvider.LambdaConfig = nil
-	}
 	if resp.UserPool.LastModifiedDate != nil {
 		cr.Status.AtProvider.LastModifiedDate = &metav1.Time{*resp.UserPool.LastModifiedDate}
 	} else {
@@ -531,63 +472,6 @@ func GenerateCreateUserPoolInput(cr *svcapitypes.UserPool) *svcsdk.CreateUserPoo
 	if cr.Spec.ForProvider.EmailVerificationSubject != nil {
 		res.SetEmailVerificationSubject(*cr.Spec.ForProvider.EmailVerificationSubject)
 	}
-	if cr.Spec.ForProvider.LambdaConfig != nil {
-		f8 := &svcsdk.LambdaConfigType{}
-		if cr.Spec.ForProvider.LambdaConfig.CreateAuthChallenge != nil {
-			f8.SetCreateAuthChallenge(*cr.Spec.ForProvider.LambdaConfig.CreateAuthChallenge)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender != nil {
-			f8f1 := &svcsdk.CustomEmailLambdaVersionConfigType{}
-			if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaARN != nil {
-				f8f1.SetLambdaArn(*cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaARN)
-			}
-			if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaVersion != nil {
-				f8f1.SetLambdaVersion(*cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaVersion)
-			}
-			f8.SetCustomEmailSender(f8f1)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomMessage != nil {
-			f8.SetCustomMessage(*cr.Spec.ForProvider.LambdaConfig.CustomMessage)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender != nil {
-			f8f3 := &svcsdk.CustomSMSLambdaVersionConfigType{}
-			if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaARN != nil {
-				f8f3.SetLambdaArn(*cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaARN)
-			}
-			if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaVersion != nil {
-				f8f3.SetLambdaVersion(*cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaVersion)
-			}
-			f8.SetCustomSMSSender(f8f3)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.DefineAuthChallenge != nil {
-			f8.SetDefineAuthChallenge(*cr.Spec.ForProvider.LambdaConfig.DefineAuthChallenge)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.KMSKeyID != nil {
-			f8.SetKMSKeyID(*cr.Spec.ForProvider.LambdaConfig.KMSKeyID)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PostAuthentication != nil {
-			f8.SetPostAuthentication(*cr.Spec.ForProvider.LambdaConfig.PostAuthentication)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PostConfirmation != nil {
-			f8.SetPostConfirmation(*cr.Spec.ForProvider.LambdaConfig.PostConfirmation)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreAuthentication != nil {
-			f8.SetPreAuthentication(*cr.Spec.ForProvider.LambdaConfig.PreAuthentication)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreSignUp != nil {
-			f8.SetPreSignUp(*cr.Spec.ForProvider.LambdaConfig.PreSignUp)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreTokenGeneration != nil {
-			f8.SetPreTokenGeneration(*cr.Spec.ForProvider.LambdaConfig.PreTokenGeneration)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.UserMigration != nil {
-			f8.SetUserMigration(*cr.Spec.ForProvider.LambdaConfig.UserMigration)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.VerifyAuthChallengeResponse != nil {
-			f8.SetVerifyAuthChallengeResponse(*cr.Spec.ForProvider.LambdaConfig.VerifyAuthChallengeResponse)
-		}
-		res.SetLambdaConfig(f8)
-	}
 	if cr.Spec.ForProvider.MFAConfiguration != nil {
 		res.SetMfaConfiguration(*cr.Spec.ForProvider.MFAConfiguration)
 	}
@@ -826,63 +710,6 @@ func GenerateUpdateUserPoolInput(cr *svcapitypes.UserPool) *svcsdk.UpdateUserPoo
 	if cr.Spec.ForProvider.EmailVerificationSubject != nil {
 		res.SetEmailVerificationSubject(*cr.Spec.ForProvider.EmailVerificationSubject)
 	}
-	if cr.Spec.ForProvider.LambdaConfig != nil {
-		f7 := &svcsdk.LambdaConfigType{}
-		if cr.Spec.ForProvider.LambdaConfig.CreateAuthChallenge != nil {
-			f7.SetCreateAuthChallenge(*cr.Spec.ForProvider.LambdaConfig.CreateAuthChallenge)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender != nil {
-			f7f1 := &svcsdk.CustomEmailLambdaVersionConfigType{}
-			if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaARN != nil {
-				f7f1.SetLambdaArn(*cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaARN)
-			}
-			if cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaVersion != nil {
-				f7f1.SetLambdaVersion(*cr.Spec.ForProvider.LambdaConfig.CustomEmailSender.LambdaVersion)
-			}
-			f7.SetCustomEmailSender(f7f1)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomMessage != nil {
-			f7.SetCustomMessage(*cr.Spec.ForProvider.LambdaConfig.CustomMessage)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender != nil {
-			f7f3 := &svcsdk.CustomSMSLambdaVersionConfigType{}
-			if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaARN != nil {
-				f7f3.SetLambdaArn(*cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaARN)
-			}
-			if cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaVersion != nil {
-				f7f3.SetLambdaVersion(*cr.Spec.ForProvider.LambdaConfig.CustomSMSSender.LambdaVersion)
-			}
-			f7.SetCustomSMSSender(f7f3)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.DefineAuthChallenge != nil {
-			f7.SetDefineAuthChallenge(*cr.Spec.ForProvider.LambdaConfig.DefineAuthChallenge)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.KMSKeyID != nil {
-			f7.SetKMSKeyID(*cr.Spec.ForProvider.LambdaConfig.KMSKeyID)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PostAuthentication != nil {
-			f7.SetPostAuthentication(*cr.Spec.ForProvider.LambdaConfig.PostAuthentication)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PostConfirmation != nil {
-			f7.SetPostConfirmation(*cr.Spec.ForProvider.LambdaConfig.PostConfirmation)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreAuthentication != nil {
-			f7.SetPreAuthentication(*cr.Spec.ForProvider.LambdaConfig.PreAuthentication)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreSignUp != nil {
-			f7.SetPreSignUp(*cr.Spec.ForProvider.LambdaConfig.PreSignUp)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.PreTokenGeneration != nil {
-			f7.SetPreTokenGeneration(*cr.Spec.ForProvider.LambdaConfig.PreTokenGeneration)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.UserMigration != nil {
-			f7.SetUserMigration(*cr.Spec.ForProvider.LambdaConfig.UserMigration)
-		}
-		if cr.Spec.ForProvider.LambdaConfig.VerifyAuthChallengeResponse != nil {
-			f7.SetVerifyAuthChallengeResponse(*cr.Spec.ForProvider.LambdaConfig.VerifyAuthChallengeResponse)
-		}
-		res.SetLambdaConfig(f7)
-	}
 	if cr.Spec.ForProvider.MFAConfiguration != nil {
 		res.SetMfaConfiguration(*cr.Spec.ForProvider.MFAConfiguration)
 	}
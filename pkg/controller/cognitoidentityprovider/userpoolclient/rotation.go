@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package userpoolclient
+
+import (
+	"context"
+	"fmt"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	errRotate            = "cannot create the UserPoolClient that replaces the rotated one"
+	errPersistRotation   = "cannot persist the ID of the UserPoolClient that replaces the rotated one"
+	errRetire            = "cannot delete the UserPoolClient replaced by the last secret rotation"
+	errPersistRetirement = "cannot persist the deletion of the UserPoolClient replaced by the last secret rotation"
+
+	reasonRotatedSecret event.Reason = "RotatedClientSecret"
+)
+
+// isRotationDue returns true if the secret of the supplied UserPoolClient
+// should be rotated, i.e. the rotate-secret annotation changed since the last
+// rotation and the observed client has a secret to rotate.
+func isRotationDue(cr *svcapitypes.UserPoolClient, observed *svcsdk.UserPoolClientType) bool {
+	v := cr.GetAnnotations()[svcapitypes.AnnotationKeyRotateClientSecret]
+	return v != "" && v != cr.GetAnnotations()[svcapitypes.AnnotationKeyLastSecretRotation] &&
+		observed != nil && observed.ClientSecret != nil
+}
+
+// hasPreviousClient returns true if the client that was replaced by the last
+// secret rotation of the supplied UserPoolClient is yet to be deleted.
+func hasPreviousClient(cr *svcapitypes.UserPoolClient) bool {
+	return cr.GetAnnotations()[svcapitypes.AnnotationKeyPreviousClientID] != ""
+}
+
+// rotate replaces the supplied observed client with a new one of the same
+// configuration, for which Cognito generates a new secret. The ID of the new
+// client is persisted right away because the managed reconciler does not
+// persist the external name after an update. The previous client is only
+// deleted by the next update, after the connection details of the new one
+// were published.
+func (e *custom) rotate(ctx context.Context, cr *svcapitypes.UserPoolClient, observed *svcsdk.UserPoolClientType) error {
+	resp, err := e.client.CreateUserPoolClientWithContext(ctx, replacementInput(cr, observed))
+	if err != nil {
+		return awsclients.Wrap(err, errRotate)
+	}
+
+	previous := meta.GetExternalName(cr)
+	current := awsclients.StringValue(resp.UserPoolClient.ClientId)
+	meta.SetExternalName(cr, current)
+	meta.AddAnnotations(cr, map[string]string{
+		svcapitypes.AnnotationKeyLastSecretRotation: cr.GetAnnotations()[svcapitypes.AnnotationKeyRotateClientSecret],
+		svcapitypes.AnnotationKeyPreviousClientID:   previous,
+	})
+	if err := e.kube.Update(ctx, cr); err != nil {
+		// Clean up the client we can no longer keep track of.
+		_, _ = e.client.DeleteUserPoolClientWithContext(ctx, &svcsdk.DeleteUserPoolClientInput{
+			ClientId:   resp.UserPoolClient.ClientId,
+			UserPoolId: cr.Spec.ForProvider.UserPoolID,
+		})
+		meta.SetExternalName(cr, previous)
+		return errors.Wrap(err, errPersistRotation)
+	}
+
+	// Updating the object overwrote its status with the one in the API
+	// server, so we record the new client only now.
+	cr.Status.AtProvider.ClientID = resp.UserPoolClient.ClientId
+	cr.Status.AtProvider.ClientSecret = resp.UserPoolClient.ClientSecret
+	e.record.Event(cr, event.Normal(reasonRotatedSecret, fmt.Sprintf("Replaced client %s with client %s to rotate the client secret", previous, current)))
+	return nil
+}
+
+// retire deletes the client that was replaced by the last secret rotation, if
+// any, and forgets about it.
+func (e *custom) retire(ctx context.Context, cr *svcapitypes.UserPoolClient) error {
+	if !hasPreviousClient(cr) {
+		return nil
+	}
+	if err := e.deletePrevious(ctx, cr); err != nil {
+		return err
+	}
+	meta.RemoveAnnotations(cr, svcapitypes.AnnotationKeyPreviousClientID)
+	return errors.Wrap(e.kube.Update(ctx, cr), errPersistRetirement)
+}
+
+func (e *custom) deletePrevious(ctx context.Context, cr *svcapitypes.UserPoolClient) error {
+	_, err := e.client.DeleteUserPoolClientWithContext(ctx, &svcsdk.DeleteUserPoolClientInput{
+		ClientId:   awsclients.String(cr.GetAnnotations()[svcapitypes.AnnotationKeyPreviousClientID]),
+		UserPoolId: cr.Spec.ForProvider.UserPoolID,
+	})
+	return awsclients.Wrap(resource.Ignore(IsNotFound, err), errRetire)
+}
+
+// replacementInput returns the input to create a client of the same
+// configuration as the supplied observed one, with a new secret.
+func replacementInput(cr *svcapitypes.UserPoolClient, observed *svcsdk.UserPoolClientType) *svcsdk.CreateUserPoolClientInput {
+	return &svcsdk.CreateUserPoolClientInput{
+		AccessTokenValidity:             observed.AccessTokenValidity,
+		AllowedOAuthFlows:               observed.AllowedOAuthFlows,
+		AllowedOAuthFlowsUserPoolClient: observed.AllowedOAuthFlowsUserPoolClient,
+		AllowedOAuthScopes:              observed.AllowedOAuthScopes,
+		AnalyticsConfiguration:          observed.AnalyticsConfiguration,
+		CallbackURLs:                    observed.CallbackURLs,
+		ClientName:                      observed.ClientName,
+		DefaultRedirectURI:              observed.DefaultRedirectURI,
+		EnableTokenRevocation:           observed.EnableTokenRevocation,
+		ExplicitAuthFlows:               observed.ExplicitAuthFlows,
+		GenerateSecret:                  awsclients.Bool(true),
+		IdTokenValidity:                 observed.IdTokenValidity,
+		LogoutURLs:                      observed.LogoutURLs,
+		PreventUserExistenceErrors:      observed.PreventUserExistenceErrors,
+		ReadAttributes:                  observed.ReadAttributes,
+		RefreshTokenValidity:            observed.RefreshTokenValidity,
+		SupportedIdentityProviders:      observed.SupportedIdentityProviders,
+		TokenValidityUnits:              observed.TokenValidityUnits,
+		UserPoolId:                      cr.Spec.ForProvider.UserPoolID,
+		WriteAttributes:                 observed.WriteAttributes,
+	}
+}
@@ -16,35 +16,63 @@ package userpoolclient
 import (
 	"context"
 	"reflect"
+	"strings"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errOAuthFlowsRequired          = "allowedOAuthFlows must be set when allowedOAuthFlowsUserPoolClient is true"
+	errOAuthScopesRequired         = "allowedOAuthScopes must be set when allowedOAuthFlowsUserPoolClient is true"
+	errCallbackURLsRequired        = "callbackURLs must be set when the code or implicit OAuth flow is allowed"
+	errClientCredentialsCombined   = "the client_credentials OAuth flow cannot be combined with the code or implicit OAuth flows"
+	errClientCredentialsSecret     = "the client_credentials OAuth flow requires generateSecret to be true"
+	errClientCredentialsScopeFmt   = "the client_credentials OAuth flow only allows custom scopes, but %q is not one"
+	errDefaultRedirectURINotInList = "defaultRedirectURI %q must be one of the callbackURLs"
+
+	oauthFlowCode              = "code"
+	oauthFlowImplicit          = "implicit"
+	oauthFlowClientCredentials = "client_credentials"
 )
 
 // SetupUserPoolClient adds a controller that reconciles UserPoolClient.
-func SetupUserPoolClient(mgr ctrl.Manager, o controller.Options) error {
+func SetupUserPoolClient(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.UserPoolClientGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
 	opts := []option{
 		func(e *external) {
+			c := &custom{client: e.client, kube: e.kube, record: recorder}
 			e.postObserve = postObserve
 			e.preObserve = preObserve
-			e.preDelete = preDelete
+			e.preDelete = c.preDelete
 			e.preCreate = preCreate
 			e.postCreate = postCreate
+			e.preUpdate = c.preUpdate
 			e.postUpdate = postUpdate
 			e.isUpToDate = isUpToDate
 			e.lateInitialize = lateInitialize
@@ -58,18 +86,21 @@ func SetupUserPoolClient(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.UserPoolClient{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.UserPoolClientGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.UserPoolClientGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<client-id>"), &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithRecorder(recorder),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+type custom struct {
+	client svcsdkapi.CognitoIdentityProviderAPI
+	kube   client.Client
+	record event.Recorder
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.DescribeUserPoolClientInput) error {
 	if meta.GetExternalName(cr) != "" {
 		obj.ClientId = awsclients.String(meta.GetExternalName(cr))
@@ -78,9 +109,12 @@ func preObserve(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.D
 	return nil
 }
 
-func preDelete(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.DeleteUserPoolClientInput) (bool, error) {
+func (e *custom) preDelete(ctx context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.DeleteUserPoolClientInput) (bool, error) {
 	obj.ClientId = awsclients.String(meta.GetExternalName(cr))
 	obj.UserPoolId = cr.Spec.ForProvider.UserPoolID
+	if hasPreviousClient(cr) {
+		return false, e.deletePrevious(ctx, cr)
+	}
 	return false, nil
 }
 
@@ -96,7 +130,14 @@ func postObserve(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.
 
 func preCreate(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.CreateUserPoolClientInput) error {
 	obj.UserPoolId = cr.Spec.ForProvider.UserPoolID
-	return nil
+	return oauthConfiguration{
+		allowed:            awsclients.BoolValue(obj.AllowedOAuthFlowsUserPoolClient),
+		flows:              obj.AllowedOAuthFlows,
+		scopes:             obj.AllowedOAuthScopes,
+		callbackURLs:       obj.CallbackURLs,
+		defaultRedirectURI: obj.DefaultRedirectURI,
+		secret:             awsclients.BoolValue(obj.GenerateSecret),
+	}.validate()
 }
 
 func postCreate(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.CreateUserPoolClientOutput, obs managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
@@ -116,6 +157,121 @@ func postCreate(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.C
 	}, nil
 }
 
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.UpdateUserPoolClientInput) error {
+	obj.ClientId = awsclients.String(meta.GetExternalName(cr))
+	obj.UserPoolId = cr.Spec.ForProvider.UserPoolID
+
+	// UpdateUserPoolClient replaces the whole client, resetting every field
+	// that is omitted to its default. The fields that are not set in the
+	// spec therefore keep their observed values.
+	resp, err := e.client.DescribeUserPoolClientWithContext(ctx, &svcsdk.DescribeUserPoolClientInput{
+		ClientId:   obj.ClientId,
+		UserPoolId: obj.UserPoolId,
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errDescribe)
+	}
+	if err := e.retire(ctx, cr); err != nil {
+		return err
+	}
+	if isRotationDue(cr, resp.UserPoolClient) {
+		if err := e.rotate(ctx, cr, resp.UserPoolClient); err != nil {
+			return err
+		}
+		obj.ClientId = awsclients.String(meta.GetExternalName(cr))
+	}
+	mergeObserved(obj, resp.UserPoolClient)
+	return oauthConfiguration{
+		allowed:            awsclients.BoolValue(obj.AllowedOAuthFlowsUserPoolClient),
+		flows:              obj.AllowedOAuthFlows,
+		scopes:             obj.AllowedOAuthScopes,
+		callbackURLs:       obj.CallbackURLs,
+		defaultRedirectURI: obj.DefaultRedirectURI,
+		secret:             resp.UserPoolClient != nil && resp.UserPoolClient.ClientSecret != nil,
+	}.validate()
+}
+
+// oauthConfiguration holds the settings of a UserPoolClient that AWS only
+// validates in combination, which it reports with rather vague errors.
+type oauthConfiguration struct {
+	allowed            bool
+	flows              []*string
+	scopes             []*string
+	callbackURLs       []*string
+	defaultRedirectURI *string
+	secret             bool
+}
+
+// validate returns an error describing the first invalid combination of the
+// OAuth settings, if any.
+func (c oauthConfiguration) validate() error { // nolint:gocyclo
+	flows := map[string]bool{}
+	for _, f := range c.flows {
+		flows[awsclients.StringValue(f)] = true
+	}
+	switch {
+	case !c.allowed:
+		return nil
+	case len(c.flows) == 0:
+		return errors.New(errOAuthFlowsRequired)
+	case len(c.scopes) == 0:
+		return errors.New(errOAuthScopesRequired)
+	case (flows[oauthFlowCode] || flows[oauthFlowImplicit]) && len(c.callbackURLs) == 0:
+		return errors.New(errCallbackURLsRequired)
+	case flows[oauthFlowClientCredentials] && (flows[oauthFlowCode] || flows[oauthFlowImplicit]):
+		return errors.New(errClientCredentialsCombined)
+	case flows[oauthFlowClientCredentials] && !c.secret:
+		return errors.New(errClientCredentialsSecret)
+	}
+	if flows[oauthFlowClientCredentials] {
+		// Custom scopes are always formatted as resourceServerIdentifier/scopeName.
+		for _, s := range c.scopes {
+			if !strings.Contains(awsclients.StringValue(s), "/") {
+				return errors.Errorf(errClientCredentialsScopeFmt, awsclients.StringValue(s))
+			}
+		}
+	}
+	if c.defaultRedirectURI == nil {
+		return nil
+	}
+	for _, u := range c.callbackURLs {
+		if awsclients.StringValue(u) == *c.defaultRedirectURI {
+			return nil
+		}
+	}
+	return errors.Errorf(errDefaultRedirectURINotInList, *c.defaultRedirectURI)
+}
+
+// mergeObserved sets the fields of the supplied update input that are not set
+// to their observed values.
+func mergeObserved(obj *svcsdk.UpdateUserPoolClientInput, observed *svcsdk.UserPoolClientType) {
+	if observed == nil {
+		return
+	}
+	obj.AccessTokenValidity = awsclients.LateInitializeInt64Ptr(obj.AccessTokenValidity, observed.AccessTokenValidity)
+	obj.AllowedOAuthFlows = awsclients.LateInitializeStringPtrSlice(obj.AllowedOAuthFlows, observed.AllowedOAuthFlows)
+	obj.AllowedOAuthFlowsUserPoolClient = awsclients.LateInitializeBoolPtr(obj.AllowedOAuthFlowsUserPoolClient, observed.AllowedOAuthFlowsUserPoolClient)
+	obj.AllowedOAuthScopes = awsclients.LateInitializeStringPtrSlice(obj.AllowedOAuthScopes, observed.AllowedOAuthScopes)
+	if obj.AnalyticsConfiguration == nil {
+		obj.AnalyticsConfiguration = observed.AnalyticsConfiguration
+	}
+	obj.CallbackURLs = awsclients.LateInitializeStringPtrSlice(obj.CallbackURLs, observed.CallbackURLs)
+	obj.ClientName = awsclients.LateInitializeStringPtr(obj.ClientName, observed.ClientName)
+	obj.DefaultRedirectURI = awsclients.LateInitializeStringPtr(obj.DefaultRedirectURI, observed.DefaultRedirectURI)
+	obj.EnableTokenRevocation = awsclients.LateInitializeBoolPtr(obj.EnableTokenRevocation, observed.EnableTokenRevocation)
+	obj.ExplicitAuthFlows = awsclients.LateInitializeStringPtrSlice(obj.ExplicitAuthFlows, observed.ExplicitAuthFlows)
+	obj.IdTokenValidity = awsclients.LateInitializeInt64Ptr(obj.IdTokenValidity, observed.IdTokenValidity)
+	obj.LogoutURLs = awsclients.LateInitializeStringPtrSlice(obj.LogoutURLs, observed.LogoutURLs)
+	obj.PreventUserExistenceErrors = awsclients.LateInitializeStringPtr(obj.PreventUserExistenceErrors, observed.PreventUserExistenceErrors)
+	obj.ReadAttributes = awsclients.LateInitializeStringPtrSlice(obj.ReadAttributes, observed.ReadAttributes)
+	obj.RefreshTokenValidity = awsclients.LateInitializeInt64Ptr(obj.RefreshTokenValidity, observed.RefreshTokenValidity)
+	obj.SupportedIdentityProviders = awsclients.LateInitializeStringPtrSlice(obj.SupportedIdentityProviders, observed.SupportedIdentityProviders)
+	if obj.TokenValidityUnits == nil {
+		obj.TokenValidityUnits = observed.TokenValidityUnits
+	}
+	obj.WriteAttributes = awsclients.LateInitializeStringPtrSlice(obj.WriteAttributes, observed.WriteAttributes)
+}
+
 func postUpdate(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.UpdateUserPoolClientOutput, obs managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
 	if err != nil {
 		return managed.ExternalUpdate{}, err
@@ -133,16 +289,19 @@ func postUpdate(_ context.Context, cr *svcapitypes.UserPoolClient, obj *svcsdk.U
 
 func isUpToDate(cr *svcapitypes.UserPoolClient, resp *svcsdk.DescribeUserPoolClientOutput) (bool, error) {
 	client := resp.UserPoolClient
+	if isRotationDue(cr, client) || hasPreviousClient(cr) {
+		return false, nil
+	}
 
 	switch {
 	case awsclients.Int64Value(cr.Spec.ForProvider.AccessTokenValidity) != awsclients.Int64Value(client.AccessTokenValidity),
 		!reflect.DeepEqual(cr.Spec.ForProvider.AllowedOAuthFlows, client.AllowedOAuthFlows),
 		awsclients.BoolValue(cr.Spec.ForProvider.AllowedOAuthFlowsUserPoolClient) != awsclients.BoolValue(client.AllowedOAuthFlowsUserPoolClient),
-		!reflect.DeepEqual(cr.Spec.ForProvider.AllowedOAuthScopes, client.AllowedOAuthScopes),
+		!areStringSetsEqual(cr.Spec.ForProvider.AllowedOAuthScopes, client.AllowedOAuthScopes),
 		!areAnalyticsConfigurationEqual(cr.Spec.ForProvider.AnalyticsConfiguration, client.AnalyticsConfiguration),
-		!reflect.DeepEqual(cr.Spec.ForProvider.CallbackURLs, client.CallbackURLs),
+		!areStringSetsEqual(cr.Spec.ForProvider.CallbackURLs, client.CallbackURLs),
 		awsclients.StringValue(cr.Spec.ForProvider.DefaultRedirectURI) != awsclients.StringValue(client.DefaultRedirectURI),
-		!reflect.DeepEqual(cr.Spec.ForProvider.ExplicitAuthFlows, client.ExplicitAuthFlows),
+		!areStringSetsEqual(cr.Spec.ForProvider.ExplicitAuthFlows, client.ExplicitAuthFlows),
 		awsclients.Int64Value(cr.Spec.ForProvider.IDTokenValidity) != awsclients.Int64Value(client.IdTokenValidity),
 		!reflect.DeepEqual(cr.Spec.ForProvider.LogoutURLs, client.LogoutURLs),
 		awsclients.StringValue(cr.Spec.ForProvider.PreventUserExistenceErrors) != awsclients.StringValue(client.PreventUserExistenceErrors),
@@ -156,6 +315,14 @@ func isUpToDate(cr *svcapitypes.UserPoolClient, resp *svcsdk.DescribeUserPoolCli
 	return true, nil
 }
 
+// areStringSetsEqual compares lists whose order is not significant to AWS,
+// which may return them in a different order than they were specified in.
+func areStringSetsEqual(spec, current []*string) bool {
+	return cmp.Equal(spec, current, cmpopts.EquateEmpty(), cmpopts.SortSlices(func(a, b *string) bool {
+		return awsclients.StringValue(a) < awsclients.StringValue(b)
+	}))
+}
+
 func areAnalyticsConfigurationEqual(spec *svcapitypes.AnalyticsConfigurationType, current *svcsdk.AnalyticsConfigurationType) bool {
 	if spec != nil && current != nil {
 		switch {
@@ -4,12 +4,17 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
 )
@@ -24,6 +29,10 @@ func withObservation(s svcapitypes.UserPoolClientObservation) functionModifier {
 	return func(r *svcapitypes.UserPoolClient) { r.Status.AtProvider = s }
 }
 
+func withAnnotations(a map[string]string) functionModifier {
+	return func(r *svcapitypes.UserPoolClient) { meta.AddAnnotations(r, a) }
+}
+
 func withExternalName(v string) functionModifier {
 	return func(r *svcapitypes.UserPoolClient) {
 		meta.SetExternalName(r, v)
@@ -48,8 +57,32 @@ var (
 	testNumberChanged int64  = 2
 	testBool1         bool   = true
 	testBool2         bool   = false
+
+	testScope                 = "resource-server/read"
+	testFlowCode              = "code"
+	testFlowClientCredentials = "client_credentials"
 )
 
+type mockCognitoClient struct {
+	svcsdkapi.CognitoIdentityProviderAPI
+
+	DescribeUserPoolClientWithContextFunc func(aws.Context, *svcsdk.DescribeUserPoolClientInput, ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error)
+	CreateUserPoolClientWithContextFunc   func(aws.Context, *svcsdk.CreateUserPoolClientInput, ...request.Option) (*svcsdk.CreateUserPoolClientOutput, error)
+	DeleteUserPoolClientWithContextFunc   func(aws.Context, *svcsdk.DeleteUserPoolClientInput, ...request.Option) (*svcsdk.DeleteUserPoolClientOutput, error)
+}
+
+func (m *mockCognitoClient) DescribeUserPoolClientWithContext(ctx aws.Context, in *svcsdk.DescribeUserPoolClientInput, opts ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+	return m.DescribeUserPoolClientWithContextFunc(ctx, in, opts...)
+}
+
+func (m *mockCognitoClient) CreateUserPoolClientWithContext(ctx aws.Context, in *svcsdk.CreateUserPoolClientInput, opts ...request.Option) (*svcsdk.CreateUserPoolClientOutput, error) {
+	return m.CreateUserPoolClientWithContextFunc(ctx, in, opts...)
+}
+
+func (m *mockCognitoClient) DeleteUserPoolClientWithContext(ctx aws.Context, in *svcsdk.DeleteUserPoolClientInput, opts ...request.Option) (*svcsdk.DeleteUserPoolClientOutput, error) {
+	return m.DeleteUserPoolClientWithContextFunc(ctx, in, opts...)
+}
+
 func TestIsUpToDate(t *testing.T) {
 	type args struct {
 		cr   *svcapitypes.UserPoolClient
@@ -75,6 +108,30 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"RotationDue": {
+			args: args{
+				cr: userPoolClient(withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyRotateClientSecret: "1",
+				})),
+				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{ClientSecret: &testString1}},
+			},
+			want: want{
+				result: false,
+				err:    nil,
+			},
+		},
+		"PreviousClientNotDeleted": {
+			args: args{
+				cr: userPoolClient(withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyPreviousClientID: testString1,
+				})),
+				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{}},
+			},
+			want: want{
+				result: false,
+				err:    nil,
+			},
+		},
 		"ChangedAccessTokenValidity": {
 			args: args{
 				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
@@ -123,7 +180,7 @@ func TestIsUpToDate(t *testing.T) {
 					AllowedOAuthScopes: []*string{&testString1, &testString2},
 				})),
 				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
-					AllowedOAuthScopes: []*string{&testString2, &testString1},
+					AllowedOAuthScopes: []*string{&testString1},
 				}},
 			},
 			want: want{
@@ -131,6 +188,20 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"ReorderedAllowedOAuthScopes": {
+			args: args{
+				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
+					AllowedOAuthScopes: []*string{&testString1, &testString2},
+				})),
+				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+					AllowedOAuthScopes: []*string{&testString2, &testString1},
+				}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 		"ChangedAnalyticsConfiguration": {
 			args: args{
 				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
@@ -155,7 +226,7 @@ func TestIsUpToDate(t *testing.T) {
 					CallbackURLs: []*string{&testString1, &testString2},
 				})),
 				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
-					CallbackURLs: []*string{&testString2, &testString1},
+					CallbackURLs: []*string{&testString1},
 				}},
 			},
 			want: want{
@@ -163,6 +234,20 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"ReorderedCallbackURLs": {
+			args: args{
+				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
+					CallbackURLs: []*string{&testString1, &testString2},
+				})),
+				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+					CallbackURLs: []*string{&testString2, &testString1},
+				}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 		"ChangedDefaultRedirectURI": {
 			args: args{
 				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
@@ -183,7 +268,7 @@ func TestIsUpToDate(t *testing.T) {
 					ExplicitAuthFlows: []*string{&testString1, &testString2},
 				})),
 				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
-					ExplicitAuthFlows: []*string{&testString2, &testString1},
+					ExplicitAuthFlows: []*string{&testString1},
 				}},
 			},
 			want: want{
@@ -191,6 +276,20 @@ func TestIsUpToDate(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"ReorderedExplicitAuthFlows": {
+			args: args{
+				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
+					ExplicitAuthFlows: []*string{&testString1, &testString2},
+				})),
+				resp: &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+					ExplicitAuthFlows: []*string{&testString2, &testString1},
+				}},
+			},
+			want: want{
+				result: true,
+				err:    nil,
+			},
+		},
 		"ChangedIDTokenValidity": {
 			args: args{
 				cr: userPoolClient(withSpec(svcapitypes.UserPoolClientParameters{
@@ -578,3 +677,439 @@ func TestPostUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestPreUpdate(t *testing.T) {
+	type args struct {
+		client svcsdkapi.CognitoIdentityProviderAPI
+		cr     *svcapitypes.UserPoolClient
+		obj    *svcsdk.UpdateUserPoolClientInput
+	}
+
+	type want struct {
+		obj *svcsdk.UpdateUserPoolClientInput
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"KeepUnsetFields": {
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, in *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						if diff := cmp.Diff(&svcsdk.DescribeUserPoolClientInput{ClientId: &testString1, UserPoolId: &testString2}, in); diff != "" {
+							t.Errorf("DescribeUserPoolClient(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+							AccessTokenValidity: &testNumberChanged,
+							CallbackURLs:        []*string{&testString2},
+							LogoutURLs:          []*string{&testString2},
+							TokenValidityUnits:  &svcsdk.TokenValidityUnitsType{AccessToken: &testString2},
+						}}, nil
+					},
+				},
+				cr: userPoolClient(
+					withSpec(svcapitypes.UserPoolClientParameters{
+						CustomUserPoolClientParameters: svcapitypes.CustomUserPoolClientParameters{
+							UserPoolID: &testString2,
+						},
+					}),
+					withExternalName(testString1),
+				),
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					AccessTokenValidity: &testNumber,
+					CallbackURLs:        []*string{&testString1},
+				},
+			},
+			want: want{
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					AccessTokenValidity: &testNumber,
+					CallbackURLs:        []*string{&testString1},
+					ClientId:            &testString1,
+					LogoutURLs:          []*string{&testString2},
+					TokenValidityUnits:  &svcsdk.TokenValidityUnitsType{AccessToken: &testString2},
+					UserPoolId:          &testString2,
+				},
+			},
+		},
+		"ClearFieldSetToEmpty": {
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+							CallbackURLs: []*string{&testString2},
+						}}, nil
+					},
+				},
+				cr: userPoolClient(withExternalName(testString1)),
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					CallbackURLs: []*string{},
+				},
+			},
+			want: want{
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					CallbackURLs: []*string{},
+					ClientId:     &testString1,
+				},
+			},
+		},
+		"InvalidOAuthConfiguration": {
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+							AllowedOAuthScopes: []*string{&testScope},
+						}}, nil
+					},
+				},
+				cr: userPoolClient(withExternalName(testString1)),
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					AllowedOAuthFlowsUserPoolClient: &testBool1,
+					AllowedOAuthFlows:               []*string{&testFlowClientCredentials},
+				},
+			},
+			want: want{
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					AllowedOAuthFlowsUserPoolClient: &testBool1,
+					AllowedOAuthFlows:               []*string{&testFlowClientCredentials},
+					AllowedOAuthScopes:              []*string{&testScope},
+					ClientId:                        &testString1,
+				},
+				err: errors.New(errClientCredentialsSecret),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr:  userPoolClient(withExternalName(testString1)),
+				obj: &svcsdk.UpdateUserPoolClientInput{},
+			},
+			want: want{
+				obj: &svcsdk.UpdateUserPoolClientInput{
+					ClientId: &testString1,
+				},
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.args.client}
+			err := c.preUpdate(context.Background(), tc.args.cr, tc.args.obj)
+
+			if diff := cmp.Diff(tc.want.obj, tc.args.obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreCreate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		obj *svcsdk.CreateUserPoolClientInput
+		want
+	}{
+		"OAuthNotAllowed": {
+			obj: &svcsdk.CreateUserPoolClientInput{},
+		},
+		"Valid": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowCode},
+				AllowedOAuthScopes:              []*string{&testScope},
+				CallbackURLs:                    []*string{&testString1, &testString2},
+				DefaultRedirectURI:              &testString2,
+			},
+		},
+		"ValidClientCredentials": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowClientCredentials},
+				AllowedOAuthScopes:              []*string{&testScope},
+				GenerateSecret:                  &testBool1,
+			},
+		},
+		"FlowsRequired": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthScopes:              []*string{&testScope},
+			},
+			want: want{
+				err: errors.New(errOAuthFlowsRequired),
+			},
+		},
+		"ScopesRequired": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowCode},
+				CallbackURLs:                    []*string{&testString1},
+			},
+			want: want{
+				err: errors.New(errOAuthScopesRequired),
+			},
+		},
+		"CallbackURLsRequired": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowCode},
+				AllowedOAuthScopes:              []*string{&testScope},
+			},
+			want: want{
+				err: errors.New(errCallbackURLsRequired),
+			},
+		},
+		"ClientCredentialsCombined": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowCode, &testFlowClientCredentials},
+				AllowedOAuthScopes:              []*string{&testScope},
+				CallbackURLs:                    []*string{&testString1},
+				GenerateSecret:                  &testBool1,
+			},
+			want: want{
+				err: errors.New(errClientCredentialsCombined),
+			},
+		},
+		"ClientCredentialsStandardScope": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowClientCredentials},
+				AllowedOAuthScopes:              []*string{&testScope, &testString1},
+				GenerateSecret:                  &testBool1,
+			},
+			want: want{
+				err: errors.Errorf(errClientCredentialsScopeFmt, testString1),
+			},
+		},
+		"DefaultRedirectURINotInList": {
+			obj: &svcsdk.CreateUserPoolClientInput{
+				AllowedOAuthFlowsUserPoolClient: &testBool1,
+				AllowedOAuthFlows:               []*string{&testFlowCode},
+				AllowedOAuthScopes:              []*string{&testScope},
+				CallbackURLs:                    []*string{&testString1},
+				DefaultRedirectURI:              &testString2,
+			},
+			want: want{
+				err: errors.Errorf(errDefaultRedirectURINotInList, testString2),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := preCreate(context.Background(), userPoolClient(), tc.obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRotation(t *testing.T) {
+	rotate := map[string]string{svcapitypes.AnnotationKeyRotateClientSecret: "1"}
+	observed := &svcsdk.UserPoolClientType{ClientName: &testString1, ClientSecret: &testString2}
+
+	type args struct {
+		client svcsdkapi.CognitoIdentityProviderAPI
+		kube   client.Client
+		cr     *svcapitypes.UserPoolClient
+	}
+
+	type want struct {
+		cr       *svcapitypes.UserPoolClient
+		clientID *string
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args
+		want
+	}{
+		"NotRequested": {
+			reason: "The client should not be replaced if no rotation was requested.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: observed}, nil
+					},
+				},
+				cr: userPoolClient(withExternalName("old")),
+			},
+			want: want{
+				cr:       userPoolClient(withExternalName("old")),
+				clientID: aws.String("old"),
+			},
+		},
+		"Rotate": {
+			reason: "A new client of the same configuration should replace the current one, which is kept until the next update.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: observed}, nil
+					},
+					CreateUserPoolClientWithContextFunc: func(_ aws.Context, in *svcsdk.CreateUserPoolClientInput, _ ...request.Option) (*svcsdk.CreateUserPoolClientOutput, error) {
+						want := &svcsdk.CreateUserPoolClientInput{ClientName: &testString1, GenerateSecret: &testBool1}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("CreateUserPoolClient(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.CreateUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+							ClientId:     aws.String("new"),
+							ClientSecret: aws.String("new-secret"),
+						}}, nil
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr:   userPoolClient(withExternalName("old"), withAnnotations(rotate)),
+			},
+			want: want{
+				cr: userPoolClient(
+					withExternalName("new"),
+					withAnnotations(rotate),
+					withAnnotations(map[string]string{
+						svcapitypes.AnnotationKeyLastSecretRotation: "1",
+						svcapitypes.AnnotationKeyPreviousClientID:   "old",
+					}),
+					withObservation(svcapitypes.UserPoolClientObservation{
+						ClientID:     aws.String("new"),
+						ClientSecret: aws.String("new-secret"),
+					}),
+				),
+				clientID: aws.String("new"),
+			},
+		},
+		"RotateWithoutSecret": {
+			reason: "Clients without a secret should not be replaced.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{}}, nil
+					},
+				},
+				cr: userPoolClient(withExternalName("old"), withAnnotations(rotate)),
+			},
+			want: want{
+				cr:       userPoolClient(withExternalName("old"), withAnnotations(rotate)),
+				clientID: aws.String("old"),
+			},
+		},
+		"PersistError": {
+			reason: "The new client should be deleted again if its ID cannot be persisted.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: observed}, nil
+					},
+					CreateUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.CreateUserPoolClientInput, _ ...request.Option) (*svcsdk.CreateUserPoolClientOutput, error) {
+						return &svcsdk.CreateUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{ClientId: aws.String("new")}}, nil
+					},
+					DeleteUserPoolClientWithContextFunc: func(_ aws.Context, in *svcsdk.DeleteUserPoolClientInput, _ ...request.Option) (*svcsdk.DeleteUserPoolClientOutput, error) {
+						if diff := cmp.Diff(aws.String("new"), in.ClientId); diff != "" {
+							t.Errorf("DeleteUserPoolClient(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.DeleteUserPoolClientOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(errBoom)},
+				cr:   userPoolClient(withExternalName("old"), withAnnotations(rotate)),
+			},
+			want: want{
+				cr: userPoolClient(
+					withExternalName("old"),
+					withAnnotations(rotate),
+					withAnnotations(map[string]string{
+						svcapitypes.AnnotationKeyLastSecretRotation: "1",
+						svcapitypes.AnnotationKeyPreviousClientID:   "old",
+					}),
+				),
+				clientID: aws.String("old"),
+				err:      errors.Wrap(errBoom, errPersistRotation),
+			},
+		},
+		"RetirePrevious": {
+			reason: "The client replaced by the last rotation should be deleted and forgotten.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: observed}, nil
+					},
+					DeleteUserPoolClientWithContextFunc: func(_ aws.Context, in *svcsdk.DeleteUserPoolClientInput, _ ...request.Option) (*svcsdk.DeleteUserPoolClientOutput, error) {
+						if diff := cmp.Diff(aws.String("old"), in.ClientId); diff != "" {
+							t.Errorf("DeleteUserPoolClient(...): -want, +got:\n%s", diff)
+						}
+						return &svcsdk.DeleteUserPoolClientOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+				cr: userPoolClient(withExternalName("new"), withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyRotateClientSecret: "1",
+					svcapitypes.AnnotationKeyLastSecretRotation: "1",
+					svcapitypes.AnnotationKeyPreviousClientID:   "old",
+				})),
+			},
+			want: want{
+				cr: userPoolClient(withExternalName("new"), withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyRotateClientSecret: "1",
+					svcapitypes.AnnotationKeyLastSecretRotation: "1",
+				})),
+				clientID: aws.String("new"),
+			},
+		},
+		"RetireError": {
+			reason: "Errors deleting the previous client should be returned.",
+			args: args{
+				client: &mockCognitoClient{
+					DescribeUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+						return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: observed}, nil
+					},
+					DeleteUserPoolClientWithContextFunc: func(_ aws.Context, _ *svcsdk.DeleteUserPoolClientInput, _ ...request.Option) (*svcsdk.DeleteUserPoolClientOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: userPoolClient(withExternalName("new"), withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyPreviousClientID: "old",
+				})),
+			},
+			want: want{
+				cr: userPoolClient(withExternalName("new"), withAnnotations(map[string]string{
+					svcapitypes.AnnotationKeyPreviousClientID: "old",
+				})),
+				clientID: aws.String("new"),
+				err:      errors.Wrap(errBoom, errRetire),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.args.client, kube: tc.args.kube, record: event.NewNopRecorder()}
+			obj := &svcsdk.UpdateUserPoolClientInput{}
+			err := c.preUpdate(context.Background(), tc.args.cr, obj)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\npreUpdate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("\n%s\npreUpdate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if err == nil {
+				if diff := cmp.Diff(tc.want.clientID, obj.ClientId); diff != "" {
+					t.Errorf("\n%s\npreUpdate(...): -want client ID, +got client ID:\n%s", tc.reason, diff)
+				}
+			}
+		})
+	}
+}
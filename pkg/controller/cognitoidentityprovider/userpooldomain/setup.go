@@ -20,9 +20,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,12 +27,19 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupUserPoolDomain adds a controller that reconciles User.
-func SetupUserPoolDomain(mgr ctrl.Manager, o controller.Options) error {
+func SetupUserPoolDomain(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.UserPoolDomainGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	opts := []option{
 		func(e *external) {
@@ -43,6 +47,8 @@ func SetupUserPoolDomain(mgr ctrl.Manager, o controller.Options) error {
 			e.preObserve = preObserve
 			e.preDelete = preDelete
 			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.isUpToDate = isUpToDate
 		},
 	}
 
@@ -53,13 +59,11 @@ func SetupUserPoolDomain(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.UserPoolDomain{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.UserPoolDomainGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.UserPoolDomainGroupVersionKind),
+			observeonly.NewConnecter(externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<domain>"), &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -78,11 +82,24 @@ func postObserve(_ context.Context, cr *svcapitypes.UserPoolDomain, obj *svcsdk.
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
-	if obj.DomainDescription.UserPoolId == nil {
+	if obj.DomainDescription == nil || obj.DomainDescription.UserPoolId == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 
-	cr.SetConditions(xpv1.Available())
+	// The CloudFront distribution is the target of the alias record users
+	// need to create for a custom domain.
+	cr.Status.AtProvider.CloudFrontDomain = obj.DomainDescription.CloudFrontDistribution
+
+	switch awsclients.StringValue(obj.DomainDescription.Status) {
+	case svcsdk.DomainStatusTypeCreating, svcsdk.DomainStatusTypeUpdating:
+		cr.SetConditions(xpv1.Creating())
+	case svcsdk.DomainStatusTypeDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	case svcsdk.DomainStatusTypeFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
 
 	return obs, nil
 }
@@ -90,5 +107,34 @@ func postObserve(_ context.Context, cr *svcapitypes.UserPoolDomain, obj *svcsdk.
 func preCreate(_ context.Context, cr *svcapitypes.UserPoolDomain, obj *svcsdk.CreateUserPoolDomainInput) error {
 	obj.Domain = awsclients.String(meta.GetExternalName(cr))
 	obj.UserPoolId = cr.Spec.ForProvider.UserPoolID
+	obj.CustomDomainConfig = generateCustomDomainConfig(cr.Spec.ForProvider.CustomDomainConfig)
+	return nil
+}
+
+func preUpdate(_ context.Context, cr *svcapitypes.UserPoolDomain, obj *svcsdk.UpdateUserPoolDomainInput) error {
+	obj.Domain = awsclients.String(meta.GetExternalName(cr))
+	obj.UserPoolId = cr.Spec.ForProvider.UserPoolID
+	obj.CustomDomainConfig = generateCustomDomainConfig(cr.Spec.ForProvider.CustomDomainConfig)
 	return nil
 }
+
+// isUpToDate checks the certificate of a custom domain, which is the only
+// thing about a domain that can be updated.
+func isUpToDate(cr *svcapitypes.UserPoolDomain, obj *svcsdk.DescribeUserPoolDomainOutput) (bool, error) {
+	c := cr.Spec.ForProvider.CustomDomainConfig
+	if c == nil || obj.DomainDescription == nil {
+		return true, nil
+	}
+	var observed *string
+	if obj.DomainDescription.CustomDomainConfig != nil {
+		observed = obj.DomainDescription.CustomDomainConfig.CertificateArn
+	}
+	return awsclients.StringValue(c.CertificateARN) == awsclients.StringValue(observed), nil
+}
+
+func generateCustomDomainConfig(p *svcapitypes.CustomDomainConfigParameters) *svcsdk.CustomDomainConfigType {
+	if p == nil {
+		return nil
+	}
+	return &svcsdk.CustomDomainConfigType{CertificateArn: p.CertificateARN}
+}
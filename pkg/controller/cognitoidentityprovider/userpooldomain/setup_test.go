@@ -5,19 +5,54 @@ import (
 	"testing"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
 )
 
+var (
+	testUserPoolID   = "us-east-1_example"
+	testCloudFront   = "d111111abcdef8.cloudfront.net"
+	testCertificate1 = "arn:aws:acm:us-east-1:123456789012:certificate/1"
+	testCertificate2 = "arn:aws:acm:us-east-1:123456789012:certificate/2"
+)
+
+type domainModifier func(*svcapitypes.UserPoolDomain)
+
+func withCertificate(arn string) domainModifier {
+	return func(r *svcapitypes.UserPoolDomain) {
+		r.Spec.ForProvider.CustomDomainConfig = &svcapitypes.CustomDomainConfigParameters{CertificateARN: &arn}
+	}
+}
+
+func withCloudFrontDomain(d string) domainModifier {
+	return func(r *svcapitypes.UserPoolDomain) { r.Status.AtProvider.CloudFrontDomain = &d }
+}
+
+func withConditions(c ...xpv1.Condition) domainModifier {
+	return func(r *svcapitypes.UserPoolDomain) { r.Status.SetConditions(c...) }
+}
+
+func userPoolDomain(m ...domainModifier) *svcapitypes.UserPoolDomain {
+	cr := &svcapitypes.UserPoolDomain{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
 func TestPostObserve(t *testing.T) {
 	type args struct {
+		cr  *svcapitypes.UserPoolDomain
 		obj *svcsdk.DescribeUserPoolDomainOutput
 		err error
 	}
 	type want struct {
+		cr     *svcapitypes.UserPoolDomain
 		result managed.ExternalObservation
 		err    error
 	}
@@ -28,26 +63,92 @@ func TestPostObserve(t *testing.T) {
 	}{
 		"DetectNonExistentResource": {
 			args: args{
+				cr: userPoolDomain(),
 				obj: &svcsdk.DescribeUserPoolDomainOutput{
 					DomainDescription: &svcsdk.DomainDescriptionType{},
 				},
 				err: nil,
 			},
 			want: want{
+				cr: userPoolDomain(),
 				result: managed.ExternalObservation{
 					ResourceExists: false,
 				},
 				err: nil,
 			},
 		},
+		"ActiveCustomDomain": {
+			args: args{
+				cr: userPoolDomain(withCertificate(testCertificate1)),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{
+					DomainDescription: &svcsdk.DomainDescriptionType{
+						CloudFrontDistribution: &testCloudFront,
+						Status:                 awsclients.String(svcsdk.DomainStatusTypeActive),
+						UserPoolId:             &testUserPoolID,
+					},
+				},
+			},
+			want: want{
+				cr: userPoolDomain(
+					withCertificate(testCertificate1),
+					withCloudFrontDomain(testCloudFront),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreatingCustomDomain": {
+			args: args{
+				cr: userPoolDomain(withCertificate(testCertificate1)),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{
+					DomainDescription: &svcsdk.DomainDescriptionType{
+						Status:     awsclients.String(svcsdk.DomainStatusTypeCreating),
+						UserPoolId: &testUserPoolID,
+					},
+				},
+			},
+			want: want{
+				cr: userPoolDomain(
+					withCertificate(testCertificate1),
+					withConditions(xpv1.Creating()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedDomain": {
+			args: args{
+				cr: userPoolDomain(),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{
+					DomainDescription: &svcsdk.DomainDescriptionType{
+						Status:     awsclients.String(svcsdk.DomainStatusTypeFailed),
+						UserPoolId: &testUserPoolID,
+					},
+				},
+			},
+			want: want{
+				cr: userPoolDomain(withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			// Act
-			cr := svcapitypes.UserPoolDomain{}
-			result, err := postObserve(context.Background(), &cr, tc.args.obj, managed.ExternalObservation{}, tc.args.err)
+			result, err := postObserve(context.Background(), tc.args.cr, tc.args.obj, managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, tc.args.err)
 
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
 			if diff := cmp.Diff(tc.want.result, result, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
@@ -57,3 +158,53 @@ func TestPostObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr  *svcapitypes.UserPoolDomain
+		obj *svcsdk.DescribeUserPoolDomainOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"PrefixDomain": {
+			args: args{
+				cr:  userPoolDomain(),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{DomainDescription: &svcsdk.DomainDescriptionType{}},
+			},
+			want: true,
+		},
+		"SameCertificate": {
+			args: args{
+				cr: userPoolDomain(withCertificate(testCertificate1)),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{DomainDescription: &svcsdk.DomainDescriptionType{
+					CustomDomainConfig: &svcsdk.CustomDomainConfigType{CertificateArn: &testCertificate1},
+				}},
+			},
+			want: true,
+		},
+		"ChangedCertificate": {
+			args: args{
+				cr: userPoolDomain(withCertificate(testCertificate2)),
+				obj: &svcsdk.DescribeUserPoolDomainOutput{DomainDescription: &svcsdk.DomainDescriptionType{
+					CustomDomainConfig: &svcsdk.CustomDomainConfigType{CertificateArn: &testCertificate1},
+				}},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.args.cr, tc.args.obj)
+			if err != nil {
+				t.Errorf("isUpToDate(...): unexpected error %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
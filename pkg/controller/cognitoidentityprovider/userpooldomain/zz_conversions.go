@@ -40,16 +40,6 @@ func GenerateDescribeUserPoolDomainInput(cr *svcapitypes.UserPoolDomain) *svcsdk
 func GenerateUserPoolDomain(resp *svcsdk.DescribeUserPoolDomainOutput) *svcapitypes.UserPoolDomain {
 	cr := &svcapitypes.UserPoolDomain{}
 
-	if resp.DomainDescription.CustomDomainConfig != nil {
-		f2 := &svcapitypes.CustomDomainConfigType{}
-		if resp.DomainDescription.CustomDomainConfig.CertificateArn != nil {
-			f2.CertificateARN = resp.DomainDescription.CustomDomainConfig.CertificateArn
-		}
-		cr.Spec.ForProvider.CustomDomainConfig = f2
-	} else {
-		cr.Spec.ForProvider.CustomDomainConfig = nil
-	}
-
 	return cr
 }
 
@@ -57,14 +47,6 @@ func GenerateUserPoolDomain(resp *svcsdk.DescribeUserPoolDomainOutput) *svcapity
 func GenerateCreateUserPoolDomainInput(cr *svcapitypes.UserPoolDomain) *svcsdk.CreateUserPoolDomainInput {
 	res := &svcsdk.CreateUserPoolDomainInput{}
 
-	if cr.Spec.ForProvider.CustomDomainConfig != nil {
-		f0 := &svcsdk.CustomDomainConfigType{}
-		if cr.Spec.ForProvider.CustomDomainConfig.CertificateARN != nil {
-			f0.SetCertificateArn(*cr.Spec.ForProvider.CustomDomainConfig.CertificateARN)
-		}
-		res.SetCustomDomainConfig(f0)
-	}
-
 	return res
 }
 
@@ -72,14 +54,6 @@ func GenerateCreateUserPoolDomainInput(cr *svcapitypes.UserPoolDomain) *svcsdk.C
 func GenerateUpdateUserPoolDomainInput(cr *svcapitypes.UserPoolDomain) *svcsdk.UpdateUserPoolDomainInput {
 	res := &svcsdk.UpdateUserPoolDomainInput{}
 
-	if cr.Spec.ForProvider.CustomDomainConfig != nil {
-		f0 := &svcsdk.CustomDomainConfigType{}
-		if cr.Spec.ForProvider.CustomDomainConfig.CertificateARN != nil {
-			f0.SetCertificateArn(*cr.Spec.ForProvider.CustomDomainConfig.CertificateARN)
-		}
-		res.SetCustomDomainConfig(f0)
-	}
-
 	return res
 }
 
@@ -17,20 +17,37 @@ limitations under the License.
 package config
 
 import (
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/providerconfig"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // Setup adds a controller that reconciles ProviderConfigs by accounting for
-// their current usage.
-func Setup(mgr ctrl.Manager, o controller.Options) error {
+// their current usage, a controller that publishes the AWS identity of their
+// credentials, and a controller that protects their credentials Secrets while
+// they're in use.
+func Setup(mgr ctrl.Manager, o setup.Options) error {
+	if err := SetupIdentity(mgr, o); err != nil {
+		return err
+	}
+	if err := SetupCredentials(mgr, o); err != nil {
+		return err
+	}
+
 	name := providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
 
 	of := resource.ProviderConfigKinds{
@@ -42,8 +59,56 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
 		For(&v1beta1.ProviderConfig{}).
-		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, &resource.EnqueueRequestForProviderConfig{}).
+		Watches(&source.Kind{Type: &v1beta1.ProviderConfigUsage{}}, handler.EnqueueRequestsFromMapFunc(providerConfigOfUsage), builder.OnlyMetadata).
 		Complete(providerconfig.NewReconciler(mgr, of,
 			providerconfig.WithLogger(o.Logger.WithValues("controller", name)),
 			providerconfig.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name)))))
 }
+
+// providerConfigOfUsage enqueues the ProviderConfig a usage is labelled with.
+// Usages are watched as metadata only, see package usage.
+func providerConfigOfUsage(o client.Object) []reconcile.Request {
+	name := o.GetLabels()[xpv1.LabelKeyProviderName]
+	if name == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name}}}
+}
+
+// SetupIdentity adds a controller that resolves the AWS caller identity of
+// ProviderConfigs and publishes it in their status.
+func SetupIdentity(mgr ctrl.Manager, o setup.Options) error {
+	name := "identity/" + providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
+
+	r := &identityReconciler{
+		kube:           mgr.GetClient(),
+		newConfigFn:    awsclient.UseProviderConfigCredentials,
+		newSTSClientFn: awsclient.NewSTSClient,
+		log:            o.Logger.WithValues("controller", name),
+		record:         event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// SetupCredentials adds a controller that blocks the deletion of the
+// credentials Secrets of ProviderConfigs that are in use.
+func SetupCredentials(mgr ctrl.Manager, o setup.Options) error {
+	name := "credentials/" + providerconfig.ControllerName(v1beta1.ProviderConfigGroupKind)
+
+	r := &credentialsReconciler{
+		kube: mgr.GetClient(),
+		log:  o.Logger.WithValues("controller", name),
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1beta1.ProviderConfig{}).
+		Complete(r)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	credentialsReconcileTimeout = 1 * time.Minute
+
+	// CredentialsFinalizer blocks the deletion of a Secret that contains the
+	// credentials of a ProviderConfig that is in use.
+	CredentialsFinalizer = "in-use.providerconfig.aws.crossplane.io"
+
+	// protectionFinalizer blocks the deletion of a ProviderConfig until the
+	// credentials Secret it protects was released.
+	protectionFinalizer = "credentials.providerconfig.aws.crossplane.io"
+
+	// AnnotationKeyProtectedSecret records the Secret protected on behalf of
+	// a ProviderConfig, so that it can be released once the ProviderConfig
+	// is no longer used or references another Secret.
+	AnnotationKeyProtectedSecret = "aws.crossplane.io/protected-credentials-secret"
+
+	errListPCUs       = "cannot list ProviderConfigUsages"
+	errListPCs        = "cannot list ProviderConfigs"
+	errGetSecret      = "cannot get credentials Secret"
+	errProtectSecret  = "cannot add finalizer to credentials Secret"
+	errReleaseSecret  = "cannot remove finalizer from credentials Secret"
+	errUpdateProtects = "cannot record protected credentials Secret of ProviderConfig"
+)
+
+// A credentialsReconciler blocks the deletion of the credentials Secret of a
+// ProviderConfig for as long as the ProviderConfig is used by any managed
+// resource, just like the deletion of the ProviderConfig itself is blocked.
+// Otherwise the credentials of live managed resources could be deleted from
+// under them.
+type credentialsReconciler struct {
+	kube client.Client
+	log  logging.Logger
+}
+
+// credentialsSecret returns the credentials Secret of the supplied
+// ProviderConfig, if any.
+func credentialsSecret(pc *v1beta1.ProviderConfig) *types.NamespacedName {
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
+	case xpv1.CredentialsSourceSecret:
+		if ref := pc.Spec.Credentials.SecretRef; ref != nil {
+			return &types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}
+		}
+	case v1beta1.CredentialsSourceSSO:
+		if sso := pc.Spec.Credentials.SSO; sso != nil {
+			return &types.NamespacedName{Namespace: sso.TokenSecretRef.Namespace, Name: sso.TokenSecretRef.Name}
+		}
+	}
+	return nil
+}
+
+// protectedSecret returns the Secret recorded as protected on behalf of the
+// supplied ProviderConfig, if any.
+func protectedSecret(pc *v1beta1.ProviderConfig) *types.NamespacedName {
+	nn := strings.SplitN(pc.GetAnnotations()[AnnotationKeyProtectedSecret], "/", 2)
+	if len(nn) != 2 {
+		return nil
+	}
+	return &types.NamespacedName{Namespace: nn[0], Name: nn[1]}
+}
+
+// Reconcile a ProviderConfig by protecting its credentials Secret while it is
+// in use, and releasing it once it isn't.
+func (r *credentialsReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, credentialsReconcileTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+
+	l := &v1beta1.ProviderConfigUsageList{}
+	if err := r.kube.List(ctx, l, client.MatchingLabels{xpv1.LabelKeyProviderName: pc.GetName()}); err != nil {
+		return reconcile.Result{}, errors.Wrap(err, errListPCUs)
+	}
+	var want *types.NamespacedName
+	if len(l.Items) > 0 {
+		want = credentialsSecret(pc)
+	}
+
+	if prev := protectedSecret(pc); prev != nil && (want == nil || *prev != *want) {
+		if err := r.release(ctx, pc, *prev); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if want == nil {
+		if !meta.FinalizerExists(pc, protectionFinalizer) {
+			return reconcile.Result{}, nil
+		}
+		meta.RemoveAnnotations(pc, AnnotationKeyProtectedSecret)
+		meta.RemoveFinalizer(pc, protectionFinalizer)
+		return reconcile.Result{}, errors.Wrap(r.kube.Update(ctx, pc), errUpdateProtects)
+	}
+
+	// No finalizers may be added to an object that is being deleted, so a
+	// ProviderConfig that is deleted before we got to protect its Secret
+	// can't protect it anymore.
+	if meta.WasDeleted(pc) && !meta.FinalizerExists(pc, protectionFinalizer) {
+		return reconcile.Result{}, nil
+	}
+
+	s := &corev1.Secret{}
+	if err := r.kube.Get(ctx, *want, s); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	if !meta.FinalizerExists(s, CredentialsFinalizer) {
+		meta.AddFinalizer(s, CredentialsFinalizer)
+		if err := r.kube.Update(ctx, s); err != nil {
+			return reconcile.Result{}, errors.Wrap(err, errProtectSecret)
+		}
+		log.Debug("Protecting credentials Secret", "secret", want.String())
+	}
+
+	if meta.FinalizerExists(pc, protectionFinalizer) && pc.GetAnnotations()[AnnotationKeyProtectedSecret] == want.String() {
+		return reconcile.Result{}, nil
+	}
+	meta.AddFinalizer(pc, protectionFinalizer)
+	meta.AddAnnotations(pc, map[string]string{AnnotationKeyProtectedSecret: want.String()})
+	return reconcile.Result{}, errors.Wrap(r.kube.Update(ctx, pc), errUpdateProtects)
+}
+
+// release the supplied Secret, unless it is protected on behalf of another
+// ProviderConfig.
+func (r *credentialsReconciler) release(ctx context.Context, pc *v1beta1.ProviderConfig, key types.NamespacedName) error {
+	l := &v1beta1.ProviderConfigList{}
+	if err := r.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPCs)
+	}
+	for i := range l.Items {
+		o := &l.Items[i]
+		if o.GetName() == pc.GetName() {
+			continue
+		}
+		if p := protectedSecret(o); p != nil && *p == key {
+			return nil
+		}
+	}
+	s := &corev1.Secret{}
+	if err := r.kube.Get(ctx, key, s); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errGetSecret)
+	}
+	if !meta.FinalizerExists(s, CredentialsFinalizer) {
+		return nil
+	}
+	meta.RemoveFinalizer(s, CredentialsFinalizer)
+	r.log.Debug("Releasing credentials Secret", "secret", key.String())
+	return errors.Wrap(r.kube.Update(ctx, s), errReleaseSecret)
+}
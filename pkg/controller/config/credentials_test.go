@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+type pcModifier func(*v1beta1.ProviderConfig)
+
+func withSecret(name string) pcModifier {
+	return func(pc *v1beta1.ProviderConfig) {
+		pc.Spec.Credentials.Source = xpv1.CredentialsSourceSecret
+		pc.Spec.Credentials.SecretRef = &xpv1.SecretKeySelector{
+			SecretReference: xpv1.SecretReference{Namespace: "crossplane-system", Name: name},
+			Key:             "credentials",
+		}
+	}
+}
+
+func withProtected(name string) pcModifier {
+	return func(pc *v1beta1.ProviderConfig) {
+		pc.SetFinalizers([]string{protectionFinalizer})
+		pc.SetAnnotations(map[string]string{AnnotationKeyProtectedSecret: "crossplane-system/" + name})
+	}
+}
+
+func pcWith(name string, m ...pcModifier) *v1beta1.ProviderConfig {
+	pc := &v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for _, fn := range m {
+		fn(pc)
+	}
+	return pc
+}
+
+// A credentialsKube serves a ProviderConfig, its usages, other ProviderConfigs
+// and Secrets, and records the objects that were updated.
+type credentialsKube struct {
+	pc      *v1beta1.ProviderConfig
+	usages  int
+	others  []v1beta1.ProviderConfig
+	secrets map[string]*corev1.Secret
+
+	updated map[string][]string
+}
+
+func (k *credentialsKube) client() client.Client {
+	k.updated = map[string][]string{}
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			switch o := obj.(type) {
+			case *v1beta1.ProviderConfig:
+				k.pc.DeepCopyInto(o)
+			case *corev1.Secret:
+				s, ok := k.secrets[key.Name]
+				if !ok {
+					return kerrors.NewNotFound(schema.GroupResource{}, key.Name)
+				}
+				s.DeepCopyInto(o)
+			}
+			return nil
+		},
+		MockList: func(_ context.Context, obj client.ObjectList, _ ...client.ListOption) error {
+			switch l := obj.(type) {
+			case *v1beta1.ProviderConfigUsageList:
+				l.Items = make([]v1beta1.ProviderConfigUsage, k.usages)
+			case *v1beta1.ProviderConfigList:
+				l.Items = append([]v1beta1.ProviderConfig{*k.pc}, k.others...)
+			}
+			return nil
+		},
+		MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+			key := obj.GetName()
+			if _, ok := obj.(*corev1.Secret); ok {
+				key = "secret/" + key
+			}
+			k.updated[key] = obj.GetFinalizers()
+			return nil
+		},
+	}
+}
+
+func secret(name string, finalizers ...string) *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: name, Finalizers: finalizers}}
+}
+
+func TestCredentialsReconcile(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		kube    *credentialsKube
+		want    map[string][]string
+		wantErr error
+	}{
+		"ProtectSecretInUse": {
+			reason: "The Secret of a ProviderConfig in use should be protected, and recorded on the ProviderConfig.",
+			kube: &credentialsKube{
+				pc:      pcWith("default", withSecret("aws-creds")),
+				usages:  1,
+				secrets: map[string]*corev1.Secret{"aws-creds": secret("aws-creds")},
+			},
+			want: map[string][]string{
+				"secret/aws-creds": {CredentialsFinalizer},
+				"default":          {protectionFinalizer},
+			},
+		},
+		"AlreadyProtected": {
+			reason: "Nothing should be updated if the Secret is already protected.",
+			kube: &credentialsKube{
+				pc:      pcWith("default", withSecret("aws-creds"), withProtected("aws-creds")),
+				usages:  1,
+				secrets: map[string]*corev1.Secret{"aws-creds": secret("aws-creds", CredentialsFinalizer)},
+			},
+			want: map[string][]string{},
+		},
+		"ReleaseSecretNoLongerInUse": {
+			reason: "The Secret of a ProviderConfig that is no longer used should be released.",
+			kube: &credentialsKube{
+				pc:      pcWith("default", withSecret("aws-creds"), withProtected("aws-creds")),
+				secrets: map[string]*corev1.Secret{"aws-creds": secret("aws-creds", CredentialsFinalizer)},
+			},
+			want: map[string][]string{
+				"secret/aws-creds": {},
+				"default":          {},
+			},
+		},
+		"KeepSecretProtectedForOthers": {
+			reason: "A Secret that is protected on behalf of another ProviderConfig should not be released.",
+			kube: &credentialsKube{
+				pc:      pcWith("default", withSecret("aws-creds"), withProtected("aws-creds")),
+				others:  []v1beta1.ProviderConfig{*pcWith("other", withSecret("aws-creds"), withProtected("aws-creds"))},
+				secrets: map[string]*corev1.Secret{"aws-creds": secret("aws-creds", CredentialsFinalizer)},
+			},
+			want: map[string][]string{
+				"default": {},
+			},
+		},
+		"SwitchSecret": {
+			reason: "The previous Secret should be released and the new one protected if the ProviderConfig references another Secret.",
+			kube: &credentialsKube{
+				pc:     pcWith("default", withSecret("new"), withProtected("old")),
+				usages: 1,
+				secrets: map[string]*corev1.Secret{
+					"old": secret("old", CredentialsFinalizer),
+					"new": secret("new"),
+				},
+			},
+			want: map[string][]string{
+				"secret/old": {},
+				"secret/new": {CredentialsFinalizer},
+				"default":    {protectionFinalizer},
+			},
+		},
+		"NoSecretSource": {
+			reason: "Nothing should be protected for a ProviderConfig that doesn't use a Secret.",
+			kube: &credentialsKube{
+				pc:     pcWith("default"),
+				usages: 1,
+			},
+			want: map[string][]string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &credentialsReconciler{kube: tc.kube.client(), log: logging.NewNopLogger()}
+			_, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: tc.kube.pc.GetName()}})
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.kube.updated, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want updated, +got updated:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	identityReconcileTimeout = 1 * time.Minute
+
+	// Identities rarely change, so there is no need to ask STS often. The
+	// ProviderConfig is reconciled immediately whenever its spec changes.
+	identityPollInterval = 1 * time.Hour
+
+	errGetPC          = "cannot get ProviderConfig"
+	errGetAWSConfig   = "cannot get AWS config for ProviderConfig"
+	errResolve        = "cannot resolve identity of ProviderConfig credentials"
+	errUpdateIdentity = "cannot update ProviderConfig identity status"
+
+	reasonResolveIdentity event.Reason = "ResolveIdentity"
+)
+
+// An identityReconciler publishes the AWS caller identity of the credentials
+// configured by a ProviderConfig in its status, so that controllers can
+// construct ARNs without calling STS on every reconcile.
+type identityReconciler struct {
+	kube           client.Client
+	newConfigFn    func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error)
+	newSTSClientFn func(cfg aws.Config) awsclient.STSClient
+	log            logging.Logger
+	record         event.Recorder
+}
+
+// Reconcile a ProviderConfig by resolving its caller identity.
+func (r *identityReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, identityReconcileTimeout)
+	defer cancel()
+
+	pc := &v1beta1.ProviderConfig{}
+	if err := r.kube.Get(ctx, req.NamespacedName, pc); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetPC)
+	}
+	if meta.WasDeleted(pc) {
+		return reconcile.Result{}, nil
+	}
+
+	cfg, err := r.newConfigFn(ctx, r.kube, pc, awsclient.GlobalRegion)
+	if err != nil {
+		log.Debug(errGetAWSConfig, "error", err)
+		r.record.Event(pc, event.Warning(reasonResolveIdentity, errors.Wrap(err, errGetAWSConfig)))
+		return reconcile.Result{}, errors.Wrap(err, errGetAWSConfig)
+	}
+
+	id, err := awsclient.ResolveCallerIdentity(ctx, r.newSTSClientFn(*cfg))
+	if err != nil {
+		log.Debug(errResolve, "error", err)
+		r.record.Event(pc, event.Warning(reasonResolveIdentity, errors.Wrap(err, errResolve)))
+		return reconcile.Result{}, errors.Wrap(err, errResolve)
+	}
+
+	if cmp.Equal(pc.Status.Identity, id) {
+		return reconcile.Result{RequeueAfter: identityPollInterval}, nil
+	}
+	pc.Status.Identity = id
+	r.record.Event(pc, event.Normal(reasonResolveIdentity, "Resolved AWS caller identity", "account", id.AccountID, "arn", id.ARN))
+	return reconcile.Result{RequeueAfter: identityPollInterval}, errors.Wrap(r.kube.Status().Update(ctx, pc), errUpdateIdentity)
+}
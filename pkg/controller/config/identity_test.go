@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	errBoom     = errors.New("boom")
+	testAccount = "123456789012"
+	testARN     = "arn:aws:iam::123456789012:user/crossplane"
+)
+
+type mockSTSClient struct {
+	out *sts.GetCallerIdentityOutput
+	err error
+}
+
+func (m *mockSTSClient) GetCallerIdentity(_ context.Context, _ *sts.GetCallerIdentityInput, _ ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	return m.out, m.err
+}
+
+func withConfig(err error) func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, error) {
+	return func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &aws.Config{}, nil
+	}
+}
+
+func withSTS(c awsclient.STSClient) func(aws.Config) awsclient.STSClient {
+	return func(aws.Config) awsclient.STSClient { return c }
+}
+
+func TestIdentityReconcile(t *testing.T) {
+	type args struct {
+		kube        client.Client
+		newConfigFn func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, error)
+		sts         awsclient.STSClient
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "")
+	resolved := &sts.GetCallerIdentityOutput{Account: aws.String(testAccount), Arn: aws.String(testARN)}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"NotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(notFound)},
+			},
+			want: want{result: reconcile.Result{}},
+		},
+		"ConfigFailed": {
+			args: args{
+				kube:        &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				newConfigFn: withConfig(errBoom),
+			},
+			want: want{err: errors.Wrap(errBoom, errGetAWSConfig)},
+		},
+		"ResolveFailed": {
+			args: args{
+				kube:        &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				newConfigFn: withConfig(nil),
+				sts:         &mockSTSClient{err: errBoom},
+			},
+			want: want{err: errors.Wrap(awsclient.Wrap(errBoom, "cannot get caller identity"), errResolve)},
+		},
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					obj.(*v1beta1.ProviderConfig).Status.Identity = &v1beta1.CallerIdentity{AccountID: testAccount, ARN: testARN, Partition: "aws"}
+					return nil
+				})},
+				newConfigFn: withConfig(nil),
+				sts:         &mockSTSClient{out: resolved},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: identityPollInterval}},
+		},
+		"Updated": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						want := &v1beta1.CallerIdentity{AccountID: testAccount, ARN: testARN, Partition: "aws"}
+						if diff := cmp.Diff(want, obj.(*v1beta1.ProviderConfig).Status.Identity); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return nil
+					},
+				},
+				newConfigFn: withConfig(nil),
+				sts:         &mockSTSClient{out: resolved},
+			},
+			want: want{result: reconcile.Result{RequeueAfter: identityPollInterval}},
+		},
+		"UpdateFailed": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:          test.NewMockGetFn(nil),
+					MockStatusUpdate: test.NewMockStatusUpdateFn(errBoom),
+				},
+				newConfigFn: withConfig(nil),
+				sts:         &mockSTSClient{out: resolved},
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: identityPollInterval},
+				err:    errors.Wrap(errBoom, errUpdateIdentity),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &identityReconciler{
+				kube:           tc.args.kube,
+				newConfigFn:    tc.args.newConfigFn,
+				newSTSClientFn: withSTS(tc.args.sts),
+				log:            logging.NewNopLogger(),
+				record:         event.NewNopRecorder(),
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "default"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationrecorder
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsconfigservice "github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ConfigurationRecorder resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe ConfigurationRecorder"
+	errDescribeStatus   = "failed to describe status of ConfigurationRecorder"
+	errPut              = "failed to put ConfigurationRecorder"
+	errStart            = "failed to start ConfigurationRecorder"
+	errStop             = "failed to stop ConfigurationRecorder"
+	errDelete           = "failed to delete ConfigurationRecorder"
+)
+
+// SetupConfigurationRecorder adds a controller that reconciles ConfigurationRecorders.
+func SetupConfigurationRecorder(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ConfigurationRecorderGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.ConfigurationRecorder{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ConfigurationRecorderGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: configservice.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) configservice.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client configservice.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	out, err := e.client.DescribeConfigurationRecordersWithContext(ctx, &awsconfigservice.DescribeConfigurationRecordersInput{
+		ConfigurationRecorderNames: []*string{&name},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(configservice.IsConfigurationRecorderNotFound, err), errDescribe)
+	}
+	if len(out.ConfigurationRecorders) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	status, err := e.client.DescribeConfigurationRecorderStatusWithContext(ctx, &awsconfigservice.DescribeConfigurationRecorderStatusInput{
+		ConfigurationRecorderNames: []*string{&name},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeStatus)
+	}
+	cr.Status.AtProvider = v1alpha1.ConfigurationRecorderObservation{}
+	if len(status.ConfigurationRecordersStatus) > 0 {
+		s := status.ConfigurationRecordersStatus[0]
+		cr.Status.AtProvider = v1alpha1.ConfigurationRecorderObservation{
+			Recording:        awsclient.BoolValue(s.Recording),
+			LastStatus:       awsclient.StringValue(s.LastStatus),
+			LastErrorMessage: awsclient.StringValue(s.LastErrorMessage),
+		}
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: configservice.IsConfigurationRecorderUpToDate(name, cr.Spec.ForProvider, out.ConfigurationRecorders[0]) &&
+			configservice.IsRecordingUpToDate(cr.Spec.ForProvider.Recording, cr.Status.AtProvider.Recording),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	// Recording is started by the first update since it requires a delivery
+	// channel, which usually does not exist yet when the recorder is created.
+	_, err := e.client.PutConfigurationRecorderWithContext(ctx, &awsconfigservice.PutConfigurationRecorderInput{
+		ConfigurationRecorder: configservice.GenerateConfigurationRecorder(meta.GetExternalName(cr), cr.Spec.ForProvider),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.PutConfigurationRecorderWithContext(ctx, &awsconfigservice.PutConfigurationRecorderInput{
+		ConfigurationRecorder: configservice.GenerateConfigurationRecorder(name, cr.Spec.ForProvider),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+	}
+	if configservice.IsRecordingUpToDate(cr.Spec.ForProvider.Recording, cr.Status.AtProvider.Recording) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if cr.Status.AtProvider.Recording {
+		_, err := e.client.StopConfigurationRecorderWithContext(ctx, &awsconfigservice.StopConfigurationRecorderInput{ConfigurationRecorderName: &name})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errStop)
+	}
+	_, err := e.client.StartConfigurationRecorderWithContext(ctx, &awsconfigservice.StartConfigurationRecorderInput{ConfigurationRecorderName: &name})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errStart)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConfigurationRecorder)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteConfigurationRecorderWithContext(ctx, &awsconfigservice.DeleteConfigurationRecorderInput{
+		ConfigurationRecorderName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(configservice.IsConfigurationRecorderNotFound, err), errDelete)
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationrecorder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsconfigservice "github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice/fake"
+)
+
+var (
+	recorderName = "default"
+	roleARN      = "arn:aws:iam::123456789012:role/config"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	cs *fake.MockClient
+	cr resource.Managed
+}
+
+type recorderModifier func(*v1alpha1.ConfigurationRecorder)
+
+func withRecording(b bool) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) { r.Spec.ForProvider.Recording = &b }
+}
+
+func withObservation(recording bool) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) {
+		r.Status.AtProvider = v1alpha1.ConfigurationRecorderObservation{Recording: recording, LastStatus: "SUCCESS"}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) recorderModifier {
+	return func(r *v1alpha1.ConfigurationRecorder) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func recorder(m ...recorderModifier) *v1alpha1.ConfigurationRecorder {
+	cr := &v1alpha1.ConfigurationRecorder{
+		Spec: v1alpha1.ConfigurationRecorderSpec{
+			ForProvider: v1alpha1.ConfigurationRecorderParameters{
+				Region:  "us-east-1",
+				RoleARN: &roleARN,
+			},
+		},
+	}
+	meta.SetExternalName(cr, recorderName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRecorders(context.Context, *awsconfigservice.DescribeConfigurationRecordersInput, []request.Option) (*awsconfigservice.DescribeConfigurationRecordersOutput, error) {
+	return &awsconfigservice.DescribeConfigurationRecordersOutput{ConfigurationRecorders: []*awsconfigservice.ConfigurationRecorder{{
+		Name:    &recorderName,
+		RoleARN: &roleARN,
+		RecordingGroup: &awsconfigservice.RecordingGroup{
+			AllSupported:               aws.Bool(true),
+			IncludeGlobalResourceTypes: aws.Bool(false),
+		},
+	}}}, nil
+}
+
+func describeStatus(recording bool) func(context.Context, *awsconfigservice.DescribeConfigurationRecorderStatusInput, []request.Option) (*awsconfigservice.DescribeConfigurationRecorderStatusOutput, error) {
+	return func(context.Context, *awsconfigservice.DescribeConfigurationRecorderStatusInput, []request.Option) (*awsconfigservice.DescribeConfigurationRecorderStatusOutput, error) {
+		return &awsconfigservice.DescribeConfigurationRecorderStatusOutput{ConfigurationRecordersStatus: []*awsconfigservice.ConfigurationRecorderStatus{{
+			Name:       &recorderName,
+			Recording:  &recording,
+			LastStatus: aws.String("SUCCESS"),
+		}}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeConfigurationRecorders: func(context.Context, *awsconfigservice.DescribeConfigurationRecordersInput, []request.Option) (*awsconfigservice.DescribeConfigurationRecordersOutput, error) {
+						return nil, awserr.New(awsconfigservice.ErrCodeNoSuchConfigurationRecorderException, "", nil)
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeConfigurationRecorders: func(context.Context, *awsconfigservice.DescribeConfigurationRecordersInput, []request.Option) (*awsconfigservice.DescribeConfigurationRecordersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeConfigurationRecorders:      describeRecorders,
+					MockDescribeConfigurationRecorderStatus: describeStatus(true),
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withObservation(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotRecording": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeConfigurationRecorders:      describeRecorders,
+					MockDescribeConfigurationRecorderStatus: describeStatus(false),
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withObservation(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutConfigurationRecorder: func(_ context.Context, input *awsconfigservice.PutConfigurationRecorderInput, _ []request.Option) (*awsconfigservice.PutConfigurationRecorderOutput, error) {
+						if awsclient.StringValue(input.ConfigurationRecorder.Name) != recorderName {
+							return nil, errBoom
+						}
+						return &awsconfigservice.PutConfigurationRecorderOutput{}, nil
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr: recorder(withConditions(xpv1.Creating())),
+			},
+		},
+		"PutError": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutConfigurationRecorder: func(context.Context, *awsconfigservice.PutConfigurationRecorderInput, []request.Option) (*awsconfigservice.PutConfigurationRecorderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				cr:  recorder(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	put := func(context.Context, *awsconfigservice.PutConfigurationRecorderInput, []request.Option) (*awsconfigservice.PutConfigurationRecorderOutput, error) {
+		return &awsconfigservice.PutConfigurationRecorderOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"StartRecording": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutConfigurationRecorder: put,
+					MockStartConfigurationRecorder: func(_ context.Context, input *awsconfigservice.StartConfigurationRecorderInput, _ []request.Option) (*awsconfigservice.StartConfigurationRecorderOutput, error) {
+						if awsclient.StringValue(input.ConfigurationRecorderName) != recorderName {
+							return nil, errBoom
+						}
+						return &awsconfigservice.StartConfigurationRecorderOutput{}, nil
+					},
+				},
+				cr: recorder(withObservation(false)),
+			},
+		},
+		"StopRecording": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutConfigurationRecorder: put,
+					MockStopConfigurationRecorder: func(context.Context, *awsconfigservice.StopConfigurationRecorderInput, []request.Option) (*awsconfigservice.StopConfigurationRecorderOutput, error) {
+						return &awsconfigservice.StopConfigurationRecorderOutput{}, nil
+					},
+				},
+				cr: recorder(withRecording(false), withObservation(true)),
+			},
+		},
+		"NoDeliveryChannel": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutConfigurationRecorder: put,
+					MockStartConfigurationRecorder: func(context.Context, *awsconfigservice.StartConfigurationRecorderInput, []request.Option) (*awsconfigservice.StartConfigurationRecorderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: recorder(withObservation(false)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDeleteConfigurationRecorder: func(context.Context, *awsconfigservice.DeleteConfigurationRecorderInput, []request.Option) (*awsconfigservice.DeleteConfigurationRecorderOutput, error) {
+						return nil, awserr.New(awsconfigservice.ErrCodeNoSuchConfigurationRecorderException, "", nil)
+					},
+				},
+				cr: recorder(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDeleteConfigurationRecorder: func(context.Context, *awsconfigservice.DeleteConfigurationRecorderInput, []request.Option) (*awsconfigservice.DeleteConfigurationRecorderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: recorder(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
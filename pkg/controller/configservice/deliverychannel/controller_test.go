@@ -0,0 +1,270 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deliverychannel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsconfigservice "github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/configservice/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/configservice/fake"
+)
+
+var (
+	channelName = "default"
+	bucket      = "config-history"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	cs *fake.MockClient
+	cr resource.Managed
+}
+
+type channelModifier func(*v1alpha1.DeliveryChannel)
+
+func withDeliveryFrequency(f string) channelModifier {
+	return func(r *v1alpha1.DeliveryChannel) { r.Spec.ForProvider.DeliveryFrequency = &f }
+}
+
+func withConditions(c ...xpv1.Condition) channelModifier {
+	return func(r *v1alpha1.DeliveryChannel) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func channel(m ...channelModifier) *v1alpha1.DeliveryChannel {
+	cr := &v1alpha1.DeliveryChannel{
+		Spec: v1alpha1.DeliveryChannelSpec{
+			ForProvider: v1alpha1.DeliveryChannelParameters{
+				Region:       "us-east-1",
+				S3BucketName: &bucket,
+			},
+		},
+	}
+	meta.SetExternalName(cr, channelName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeChannels(context.Context, *awsconfigservice.DescribeDeliveryChannelsInput, []request.Option) (*awsconfigservice.DescribeDeliveryChannelsOutput, error) {
+	return &awsconfigservice.DescribeDeliveryChannelsOutput{DeliveryChannels: []*awsconfigservice.DeliveryChannel{{
+		Name:         &channelName,
+		S3BucketName: &bucket,
+		ConfigSnapshotDeliveryProperties: &awsconfigservice.ConfigSnapshotDeliveryProperties{
+			DeliveryFrequency: aws.String("TwentyFour_Hours"),
+		},
+	}}}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeDeliveryChannels: func(context.Context, *awsconfigservice.DescribeDeliveryChannelsInput, []request.Option) (*awsconfigservice.DescribeDeliveryChannelsOutput, error) {
+						return nil, awserr.New(awsconfigservice.ErrCodeNoSuchDeliveryChannelException, "", nil)
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDescribeDeliveryChannels: func(context.Context, *awsconfigservice.DescribeDeliveryChannelsInput, []request.Option) (*awsconfigservice.DescribeDeliveryChannelsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				cs: &fake.MockClient{MockDescribeDeliveryChannels: describeChannels},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FrequencyChanged": {
+			args: args{
+				cs: &fake.MockClient{MockDescribeDeliveryChannels: describeChannels},
+				cr: channel(withDeliveryFrequency("One_Hour")),
+			},
+			want: want{
+				cr: channel(withDeliveryFrequency("One_Hour"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutDeliveryChannel: func(_ context.Context, input *awsconfigservice.PutDeliveryChannelInput, _ []request.Option) (*awsconfigservice.PutDeliveryChannelOutput, error) {
+						if awsclient.StringValue(input.DeliveryChannel.S3BucketName) != bucket {
+							return nil, errBoom
+						}
+						return &awsconfigservice.PutDeliveryChannelOutput{}, nil
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr: channel(withConditions(xpv1.Creating())),
+			},
+		},
+		"PutError": {
+			args: args{
+				cs: &fake.MockClient{
+					MockPutDeliveryChannel: func(context.Context, *awsconfigservice.PutDeliveryChannelInput, []request.Option) (*awsconfigservice.PutDeliveryChannelOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				cr:  channel(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDeleteDeliveryChannel: func(context.Context, *awsconfigservice.DeleteDeliveryChannelInput, []request.Option) (*awsconfigservice.DeleteDeliveryChannelOutput, error) {
+						return nil, awserr.New(awsconfigservice.ErrCodeNoSuchDeliveryChannelException, "", nil)
+					},
+				},
+				cr: channel(),
+			},
+		},
+		"RecorderRunning": {
+			args: args{
+				cs: &fake.MockClient{
+					MockDeleteDeliveryChannel: func(context.Context, *awsconfigservice.DeleteDeliveryChannelInput, []request.Option) (*awsconfigservice.DeleteDeliveryChannelOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: channel(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.cs}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,401 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package optiongroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	awsrdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/database/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	og "github.com/crossplane/provider-aws/pkg/clients/optiongroup"
+	"github.com/crossplane/provider-aws/pkg/clients/optiongroup/fake"
+)
+
+var (
+	groupName   = "some-group"
+	description = "some description"
+	arn         = "arn:aws:rds:us-east-1:123456789012:og:some-group"
+	memcached   = "MEMCACHED"
+	port        = int32(11211)
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client og.Client
+	cr     *v1alpha1.OptionGroup
+}
+
+type optionGroupModifier func(*v1alpha1.OptionGroup)
+
+func withConditions(c ...xpv1.Condition) optionGroupModifier {
+	return func(o *v1alpha1.OptionGroup) { o.Status.ConditionedStatus.Conditions = c }
+}
+
+func withDescription(s string) optionGroupModifier {
+	return func(o *v1alpha1.OptionGroup) { o.Spec.ForProvider.Description = s }
+}
+
+func withOptions(opts ...v1alpha1.OptionConfiguration) optionGroupModifier {
+	return func(o *v1alpha1.OptionGroup) { o.Spec.ForProvider.Options = opts }
+}
+
+func withARN(s string) optionGroupModifier {
+	return func(o *v1alpha1.OptionGroup) { o.Status.AtProvider.ARN = s }
+}
+
+func optionGroup(m ...optionGroupModifier) *v1alpha1.OptionGroup {
+	cr := &v1alpha1.OptionGroup{}
+	meta.SetExternalName(cr, groupName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeOptionGroups(groups ...awsrdstypes.OptionGroup) func(context.Context, *awsrds.DescribeOptionGroupsInput, []func(*awsrds.Options)) (*awsrds.DescribeOptionGroupsOutput, error) {
+	return func(context.Context, *awsrds.DescribeOptionGroupsInput, []func(*awsrds.Options)) (*awsrds.DescribeOptionGroupsOutput, error) {
+		return &awsrds.DescribeOptionGroupsOutput{OptionGroupsList: groups}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.OptionGroup
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SuccessfulAvailable": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describeOptionGroups(awsrdstypes.OptionGroup{
+						OptionGroupArn:         aws.String(arn),
+						OptionGroupDescription: aws.String(description),
+					}),
+				},
+				cr: optionGroup(withDescription(description)),
+			},
+			want: want{
+				cr: optionGroup(
+					withDescription(description),
+					withARN(arn),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describeOptionGroups(awsrdstypes.OptionGroup{
+						OptionGroupDescription: aws.String(description),
+						Options:                []awsrdstypes.Option{{OptionName: aws.String(memcached), Port: &port}},
+					}),
+				},
+				cr: optionGroup(withOptions(v1alpha1.OptionConfiguration{OptionName: memcached})),
+			},
+			want: want{
+				cr: optionGroup(
+					withDescription(description),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: memcached, Port: &port}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"MissingOption": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describeOptionGroups(awsrdstypes.OptionGroup{
+						OptionGroupDescription: aws.String(description),
+					}),
+				},
+				cr: optionGroup(withDescription(description), withOptions(v1alpha1.OptionConfiguration{OptionName: memcached})),
+			},
+			want: want{
+				cr: optionGroup(
+					withDescription(description),
+					withOptions(v1alpha1.OptionConfiguration{OptionName: memcached}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: func(context.Context, *awsrds.DescribeOptionGroupsInput, []func(*awsrds.Options)) (*awsrds.DescribeOptionGroupsOutput, error) {
+						return nil, &awsrdstypes.OptionGroupNotFoundFault{}
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr: optionGroup(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: func(context.Context, *awsrds.DescribeOptionGroupsInput, []func(*awsrds.Options)) (*awsrds.DescribeOptionGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr:  optionGroup(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.OptionGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreateOptionGroup: func(_ context.Context, input *awsrds.CreateOptionGroupInput, _ []func(*awsrds.Options)) (*awsrds.CreateOptionGroupOutput, error) {
+						if aws.ToString(input.OptionGroupName) != groupName {
+							return nil, errBoom
+						}
+						return &awsrds.CreateOptionGroupOutput{}, nil
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr: optionGroup(withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockCreateOptionGroup: func(context.Context, *awsrds.CreateOptionGroupInput, []func(*awsrds.Options)) (*awsrds.CreateOptionGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr:  optionGroup(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describeOptionGroups(awsrdstypes.OptionGroup{
+						Options: []awsrdstypes.Option{{OptionName: aws.String("OBSOLETE")}},
+					}),
+					MockModifyOptionGroup: func(_ context.Context, input *awsrds.ModifyOptionGroupInput, _ []func(*awsrds.Options)) (*awsrds.ModifyOptionGroupOutput, error) {
+						if len(input.OptionsToInclude) != 1 || len(input.OptionsToRemove) != 1 {
+							return nil, errBoom
+						}
+						return &awsrds.ModifyOptionGroupOutput{}, nil
+					},
+				},
+				cr: optionGroup(withOptions(v1alpha1.OptionConfiguration{OptionName: memcached})),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: func(context.Context, *awsrds.DescribeOptionGroupsInput, []func(*awsrds.Options)) (*awsrds.DescribeOptionGroupsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"FailedModify": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDescribeOptionGroups: describeOptionGroups(awsrdstypes.OptionGroup{}),
+					MockModifyOptionGroup: func(context.Context, *awsrds.ModifyOptionGroupInput, []func(*awsrds.Options)) (*awsrds.ModifyOptionGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.OptionGroup
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(context.Context, *awsrds.DeleteOptionGroupInput, []func(*awsrds.Options)) (*awsrds.DeleteOptionGroupOutput, error) {
+						return &awsrds.DeleteOptionGroupOutput{}, nil
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr: optionGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(context.Context, *awsrds.DeleteOptionGroupInput, []func(*awsrds.Options)) (*awsrds.DeleteOptionGroupOutput, error) {
+						return nil, &awsrdstypes.OptionGroupNotFoundFault{}
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr: optionGroup(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockOptionGroupClient{
+					MockDeleteOptionGroup: func(context.Context, *awsrds.DeleteOptionGroupInput, []func(*awsrds.Options)) (*awsrds.DeleteOptionGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: optionGroup(),
+			},
+			want: want{
+				cr:  optionGroup(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package database
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"k8s.io/apimachinery/pkg/types"
@@ -37,9 +38,11 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane/provider-aws/apis/database/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/rds"
 	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/readiness"
 )
 
 const (
@@ -72,9 +75,10 @@ var (
 )
 
 type args struct {
-	rds  rds.Client
-	kube client.Client
-	cr   *v1beta1.RDSInstance
+	rds    rds.Client
+	kube   client.Client
+	prober readiness.Prober
+	cr     *v1beta1.RDSInstance
 }
 
 type rdsModifier func(*v1beta1.RDSInstance)
@@ -125,6 +129,20 @@ func withPasswordSecretRef(s xpv1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withReadinessProbe(p *awsv1beta1.ReadinessProbe) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.ReadinessProbe = p }
+}
+
+func withPort(p int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.Port = &p }
+}
+
+func withEndpoint(address string, port int) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Status.AtProvider.Endpoint = v1beta1.Endpoint{Address: address, Port: port}
+	}
+}
+
 func instance(m ...rdsModifier) *v1beta1.RDSInstance {
 	falseFlag := false
 	cr := &v1beta1.RDSInstance{
@@ -187,6 +205,39 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ReadinessProbeFailed": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(ctx context.Context, input *awsrds.DescribeDBInstancesInput, opts []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{
+							DBInstances: []awsrdstypes.DBInstance{
+								{
+									DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									Endpoint:         &awsrdstypes.Endpoint{Address: aws.String("db.example.org"), Port: 5432},
+								},
+							},
+						}, nil
+					},
+				},
+				prober: readiness.ProberFn(func(_ context.Context, _ string, _ int, _ time.Duration) error {
+					return errBoom
+				}),
+				cr: instance(withPort(5432), withReadinessProbe(&awsv1beta1.ReadinessProbe{})),
+			},
+			want: want{
+				cr: instance(
+					withPort(5432),
+					withReadinessProbe(&awsv1beta1.ReadinessProbe{}),
+					withConditions(readiness.ProbeFailed(errBoom)),
+					withEndpoint("db.example.org", 5432),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: rds.GetConnectionDetails(*instance(withEndpoint("db.example.org", 5432))),
+				},
+			},
+		},
 		"AutoscaledStorageIsUpToDate": { // if aws scales storage up, we should still consider it up to date, even if initial storage size was provided
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -331,7 +382,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, prober: tc.prober}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,8 +35,11 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	svcutils "github.com/crossplane/provider-aws/pkg/controller/docdb"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -51,8 +51,11 @@ const (
 )
 
 // SetupDBClusterParameterGroup adds a controller that reconciles a DBClusterParameterGroup.
-func SetupDBClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupDBClusterParameterGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterParameterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{setupExternal}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -63,15 +66,10 @@ func SetupDBClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&svcapitypes.DBClusterParameterGroup{}).
-		WithOptions(o.ForControllerRuntime()).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		WithOptions(o.ForController(name)).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<db-cluster-parameter-group-name>", "rds", "cluster-pg"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
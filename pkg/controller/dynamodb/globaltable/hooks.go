@@ -27,9 +27,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,12 +34,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupGlobalTable adds a controller that reconciles GlobalTable.
-func SetupGlobalTable(mgr ctrl.Manager, o controller.Options) error {
+func SetupGlobalTable(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.GlobalTableGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.preObserve = preObserve
@@ -63,14 +66,10 @@ func SetupGlobalTable(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.GlobalTable{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.GlobalTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.GlobalTableGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<global-table-name>", "dynamodb", "global-table"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
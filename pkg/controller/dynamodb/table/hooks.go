@@ -22,6 +22,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
@@ -30,9 +31,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -40,22 +38,37 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errDescribeTimeToLive       = "cannot describe time to live of Table"
+	errUpdateTimeToLive         = "cannot update time to live of Table"
+	errDescribeContinuousBackup = "cannot describe continuous backups of Table"
+	errUpdateContinuousBackup   = "cannot update continuous backups of Table"
 )
 
 // SetupTable adds a controller that reconciles Table.
-func SetupTable(mgr ctrl.Manager, o controller.Options) error {
+func SetupTable(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.TableGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
+			e.client = &tableClient{DynamoDBAPI: e.client}
+			s := &settingsClient{client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = s.postObserve
 			e.preCreate = preCreate
 			e.preDelete = preDelete
 			e.postDelete = postDelete
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = isUpToDate
-			u := &updateClient{client: e.client}
+			u := &updateClient{client: e.client, settings: s}
 			e.preUpdate = u.preUpdate
 		},
 	}
@@ -67,18 +80,14 @@ func SetupTable(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Table{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.TableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.TableGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<table-name>", "dynamodb", "table"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(
 				managed.NewNameAsExternalName(mgr.GetClient()),
 				managed.NewDefaultProviderConfig(mgr.GetClient()),
 				&tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -218,6 +227,9 @@ func lateInitialize(in *svcapitypes.TableParameters, t *svcsdk.DescribeTableOutp
 			in.SSESpecification.SSEType = t.Table.SSEDescription.SSEType
 		}
 	}
+	if in.Replicas == nil && len(t.Table.Replicas) != 0 {
+		in.Replicas = buildReplicas(t.Table.Replicas)
+	}
 	if in.StreamSpecification == nil {
 		// NOTE(negz): We late initialize StreamEnabled to false to
 		// avoid IsUpToDate thinking it needs to explicitly make an
@@ -249,6 +261,17 @@ func buildAlphaKeyElements(keys []*svcsdk.KeySchemaElement) []*svcapitypes.KeySc
 	return keyElements
 }
 
+func buildReplicas(replicas []*svcsdk.ReplicaDescription) []*svcapitypes.TableReplica {
+	res := make([]*svcapitypes.TableReplica, len(replicas))
+	for i, r := range replicas {
+		res[i] = &svcapitypes.TableReplica{
+			RegionName:     aws.StringValue(r.RegionName),
+			KMSMasterKeyID: r.KMSMasterKeyId,
+		}
+	}
+	return res
+}
+
 func buildAttributeDefinitions(attributes []*svcsdk.AttributeDefinition) []*svcapitypes.AttributeDefinition {
 	if len(attributes) == 0 {
 		return nil
@@ -366,12 +389,15 @@ func isUpToDate(cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput) (bool,
 		return false, nil
 	case len(diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), resp.Table.GlobalSecondaryIndexes)) != 0:
 		return false, nil
+	case len(diffReplicas(cr.Spec.ForProvider.Replicas, resp.Table.Replicas)) != 0:
+		return false, nil
 	}
 	return true, nil
 }
 
 type updateClient struct {
-	client svcsdkapi.DynamoDBAPI
+	client   svcsdkapi.DynamoDBAPI
+	settings *settingsClient
 }
 
 func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *svcsdk.UpdateTableInput) error {
@@ -402,6 +428,7 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		return err
 	}
 	gsiUpdates := diffGlobalSecondaryIndexes(GenerateGlobalSecondaryIndexDescriptions(cr.Spec.ForProvider.GlobalSecondaryIndexes), out.Table.GlobalSecondaryIndexes)
+	replicaUpdates := diffReplicas(cr.Spec.ForProvider.Replicas, out.Table.Replicas)
 	switch {
 	case p.BillingMode != nil:
 		filtered.BillingMode = u.BillingMode
@@ -431,6 +458,16 @@ func (e *updateClient) preUpdate(ctx context.Context, cr *svcapitypes.Table, u *
 		}
 	case len(gsiUpdates) != 0:
 		filtered.SetGlobalSecondaryIndexUpdates(gsiUpdates)
+	case len(replicaUpdates) != 0:
+		filtered.SetReplicaUpdates(replicaUpdates)
+	default:
+		// The time to live and point in time recovery settings have
+		// their own update APIs. We only update them once the table
+		// itself is up-to-date, and leave filtered without updates so
+		// that tableClient skips the UpdateTable call.
+		if err := e.settings.update(ctx, cr); err != nil {
+			return err
+		}
 	}
 
 	*u = *filtered
@@ -513,6 +550,48 @@ func diffGlobalSecondaryIndexes(spec []*svcsdk.GlobalSecondaryIndexDescription,
 	return nil
 }
 
+// diffReplicas returns the next replica to create or delete in order to get
+// from the observed to the desired replicas. Like global secondary indexes,
+// only a single replica can be created or deleted with one UpdateTable call.
+// Replicas are not managed if none are desired.
+func diffReplicas(spec []*svcapitypes.TableReplica, obs []*svcsdk.ReplicaDescription) []*svcsdk.ReplicationGroupUpdate {
+	if spec == nil {
+		return nil
+	}
+	existing := map[string]bool{}
+	for _, r := range obs {
+		existing[aws.StringValue(r.RegionName)] = true
+	}
+	desired := map[string]bool{}
+	for _, r := range spec {
+		desired[r.RegionName] = true
+		if existing[r.RegionName] {
+			continue
+		}
+		return []*svcsdk.ReplicationGroupUpdate{
+			{
+				Create: &svcsdk.CreateReplicationGroupMemberAction{
+					RegionName:     aws.String(r.RegionName),
+					KMSMasterKeyId: r.KMSMasterKeyID,
+				},
+			},
+		}
+	}
+	for _, r := range obs {
+		if desired[aws.StringValue(r.RegionName)] || aws.StringValue(r.ReplicaStatus) == svcsdk.ReplicaStatusDeleting {
+			continue
+		}
+		return []*svcsdk.ReplicationGroupUpdate{
+			{
+				Delete: &svcsdk.DeleteReplicationGroupMemberAction{
+					RegionName: r.RegionName,
+				},
+			},
+		}
+	}
+	return nil
+}
+
 // GenerateGlobalSecondaryIndexDescriptions generates an array of GlobalSecondaryIndexDescriptions.
 func GenerateGlobalSecondaryIndexDescriptions(p []*svcapitypes.GlobalSecondaryIndex) []*svcsdk.GlobalSecondaryIndexDescription { // nolint:gocyclo
 	// Linter is disabled because this is a copy-paste from generated code and
@@ -566,3 +645,155 @@ func GenerateGlobalSecondaryIndexDescriptions(p []*svcapitypes.GlobalSecondaryIn
 	}
 	return result
 }
+
+// tableClient skips UpdateTable calls that would not update anything, which
+// the API would otherwise reject. This happens when only settings that have
+// their own update APIs need to be updated.
+type tableClient struct {
+	svcsdkapi.DynamoDBAPI
+}
+
+func (c *tableClient) UpdateTableWithContext(ctx context.Context, in *svcsdk.UpdateTableInput, opts ...request.Option) (*svcsdk.UpdateTableOutput, error) {
+	if in.BillingMode == nil && in.ProvisionedThroughput == nil && in.StreamSpecification == nil &&
+		in.SSESpecification == nil && len(in.GlobalSecondaryIndexUpdates) == 0 && len(in.ReplicaUpdates) == 0 {
+		return &svcsdk.UpdateTableOutput{}, nil
+	}
+	return c.DynamoDBAPI.UpdateTableWithContext(ctx, in, opts...)
+}
+
+// settingsClient manages the time to live and point in time recovery
+// settings of a table, which are not part of DescribeTable and UpdateTable.
+type settingsClient struct {
+	client svcsdkapi.DynamoDBAPI
+}
+
+// tableSettings are the observed time to live and point in time recovery
+// settings of a table.
+type tableSettings struct {
+	ttl  *svcsdk.TimeToLiveDescription
+	pitr *svcsdk.PointInTimeRecoveryDescription
+}
+
+func (s *settingsClient) describe(ctx context.Context, cr *svcapitypes.Table) (tableSettings, error) {
+	name := aws.String(meta.GetExternalName(cr))
+	ttl, err := s.client.DescribeTimeToLiveWithContext(ctx, &svcsdk.DescribeTimeToLiveInput{TableName: name})
+	if err != nil {
+		return tableSettings{}, aws.Wrap(err, errDescribeTimeToLive)
+	}
+	cb, err := s.client.DescribeContinuousBackupsWithContext(ctx, &svcsdk.DescribeContinuousBackupsInput{TableName: name})
+	if err != nil {
+		return tableSettings{}, aws.Wrap(err, errDescribeContinuousBackup)
+	}
+	ts := tableSettings{ttl: ttl.TimeToLiveDescription}
+	if cb.ContinuousBackupsDescription != nil {
+		ts.pitr = cb.ContinuousBackupsDescription.PointInTimeRecoveryDescription
+	}
+	return ts, nil
+}
+
+func (s *settingsClient) postObserve(ctx context.Context, cr *svcapitypes.Table, resp *svcsdk.DescribeTableOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	obs, err = postObserve(ctx, cr, resp, obs, err)
+	if err != nil {
+		return obs, err
+	}
+	// Time to live and point in time recovery can only be described and
+	// updated once the table is active.
+	if aws.StringValue(resp.Table.TableStatus) != string(svcapitypes.TableStatus_SDK_ACTIVE) {
+		return obs, nil
+	}
+	ts, err := s.describe(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if lateInitializeSettings(&cr.Spec.ForProvider, ts) {
+		obs.ResourceLateInitialized = true
+	}
+	if obs.ResourceUpToDate {
+		obs.ResourceUpToDate = isTimeToLiveUpToDate(cr.Spec.ForProvider.TimeToLive, ts.ttl) &&
+			isPointInTimeRecoveryUpToDate(cr.Spec.ForProvider.PointInTimeRecoveryEnabled, ts.pitr)
+	}
+	return obs, nil
+}
+
+func (s *settingsClient) update(ctx context.Context, cr *svcapitypes.Table) error {
+	ts, err := s.describe(ctx, cr)
+	if err != nil {
+		return err
+	}
+	name := aws.String(meta.GetExternalName(cr))
+	if spec := cr.Spec.ForProvider.TimeToLive; !isTimeToLiveUpToDate(spec, ts.ttl) {
+		in := &svcsdk.UpdateTimeToLiveInput{
+			TableName: name,
+			TimeToLiveSpecification: &svcsdk.TimeToLiveSpecification{
+				Enabled:       aws.Bool(spec.Enabled, aws.FieldRequired),
+				AttributeName: aws.String(spec.AttributeName),
+			},
+		}
+		// The attribute name can't be changed while time to live is
+		// enabled, and disabling it requires the current attribute
+		// name. We disable it first and enable it with the new
+		// attribute name on a later reconcile.
+		if timeToLiveEnabled(ts.ttl) {
+			in.TimeToLiveSpecification.Enabled = aws.Bool(false, aws.FieldRequired)
+			in.TimeToLiveSpecification.AttributeName = ts.ttl.AttributeName
+		}
+		if _, err := s.client.UpdateTimeToLiveWithContext(ctx, in); err != nil {
+			return aws.Wrap(err, errUpdateTimeToLive)
+		}
+	}
+	if spec := cr.Spec.ForProvider.PointInTimeRecoveryEnabled; !isPointInTimeRecoveryUpToDate(spec, ts.pitr) {
+		_, err := s.client.UpdateContinuousBackupsWithContext(ctx, &svcsdk.UpdateContinuousBackupsInput{
+			TableName:                        name,
+			PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{PointInTimeRecoveryEnabled: spec},
+		})
+		if err != nil {
+			return aws.Wrap(err, errUpdateContinuousBackup)
+		}
+	}
+	return nil
+}
+
+func timeToLiveEnabled(ttl *svcsdk.TimeToLiveDescription) bool {
+	return ttl != nil && aws.StringValue(ttl.TimeToLiveStatus) == svcsdk.TimeToLiveStatusEnabled
+}
+
+func lateInitializeSettings(in *svcapitypes.TableParameters, ts tableSettings) bool {
+	li := false
+	if in.TimeToLive == nil && ts.ttl != nil {
+		in.TimeToLive = &svcapitypes.TableTimeToLive{}
+		if timeToLiveEnabled(ts.ttl) {
+			in.TimeToLive.Enabled = true
+			in.TimeToLive.AttributeName = aws.StringValue(ts.ttl.AttributeName)
+		}
+		li = true
+	}
+	if in.PointInTimeRecoveryEnabled == nil && ts.pitr != nil {
+		in.PointInTimeRecoveryEnabled = aws.Bool(aws.StringValue(ts.pitr.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled, aws.FieldRequired)
+		li = true
+	}
+	return li
+}
+
+func isTimeToLiveUpToDate(spec *svcapitypes.TableTimeToLive, ttl *svcsdk.TimeToLiveDescription) bool {
+	if spec == nil || ttl == nil {
+		return true
+	}
+	// Time to live can't be updated while it is being enabled or disabled,
+	// so we temporarily consider it to be up-to-date.
+	switch aws.StringValue(ttl.TimeToLiveStatus) {
+	case svcsdk.TimeToLiveStatusEnabling, svcsdk.TimeToLiveStatusDisabling:
+		return true
+	}
+	if spec.Enabled != timeToLiveEnabled(ttl) {
+		return false
+	}
+	return !spec.Enabled || spec.AttributeName == aws.StringValue(ttl.AttributeName)
+}
+
+func isPointInTimeRecoveryUpToDate(spec *bool, pitr *svcsdk.PointInTimeRecoveryDescription) bool {
+	if spec == nil {
+		return true
+	}
+	enabled := pitr != nil && aws.StringValue(pitr.PointInTimeRecoveryStatus) == svcsdk.PointInTimeRecoveryStatusEnabled
+	return aws.BoolValue(spec) == enabled
+}
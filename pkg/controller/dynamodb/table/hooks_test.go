@@ -17,12 +17,17 @@ limitations under the License.
 package table
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/dynamodb"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
 	"github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 	svcapitypes "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
 )
@@ -618,3 +623,242 @@ func TestDiffGlobalSecondaryIndexes(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTimeToLiveUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec *svcapitypes.TableTimeToLive
+		ttl  *svcsdk.TimeToLiveDescription
+		want bool
+	}{
+		"NotSpecified": {
+			ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled)},
+			want: true,
+		},
+		"Transitioning": {
+			spec: &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+			ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabling)},
+			want: true,
+		},
+		"Disabled": {
+			spec: &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+			ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+			want: false,
+		},
+		"DifferentAttributeName": {
+			spec: &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+			ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled), AttributeName: aws.String("ttl")},
+			want: false,
+		},
+		"UpToDate": {
+			spec: &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+			ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled), AttributeName: aws.String("expires")},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isTimeToLiveUpToDate(tc.spec, tc.ttl)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeSettings(t *testing.T) {
+	type want struct {
+		params *svcapitypes.TableParameters
+		li     bool
+	}
+	cases := map[string]struct {
+		params *svcapitypes.TableParameters
+		ts     tableSettings
+		want   want
+	}{
+		"AllFilled": {
+			params: &svcapitypes.TableParameters{},
+			ts: tableSettings{
+				ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled), AttributeName: aws.String("expires")},
+				pitr: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled)},
+			},
+			want: want{
+				params: &svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+					TimeToLive:                 &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+					PointInTimeRecoveryEnabled: aws.Bool(false),
+				}},
+				li: true,
+			},
+		},
+		"NoOverride": {
+			params: &svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+				TimeToLive:                 &svcapitypes.TableTimeToLive{Enabled: false},
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			}},
+			ts: tableSettings{
+				ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled), AttributeName: aws.String("expires")},
+				pitr: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled)},
+			},
+			want: want{
+				params: &svcapitypes.TableParameters{CustomTableParameters: svcapitypes.CustomTableParameters{
+					TimeToLive:                 &svcapitypes.TableTimeToLive{Enabled: false},
+					PointInTimeRecoveryEnabled: aws.Bool(true),
+				}},
+				li: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			li := lateInitializeSettings(tc.params, tc.ts)
+			if diff := cmp.Diff(tc.want.li, li); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.params, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockDynamoDBClient struct {
+	svcsdkapi.DynamoDBAPI
+
+	ttl  *svcsdk.TimeToLiveDescription
+	pitr *svcsdk.PointInTimeRecoveryDescription
+
+	updateTimeToLive        *svcsdk.UpdateTimeToLiveInput
+	updateContinuousBackups *svcsdk.UpdateContinuousBackupsInput
+}
+
+func (m *mockDynamoDBClient) DescribeTimeToLiveWithContext(_ context.Context, _ *svcsdk.DescribeTimeToLiveInput, _ ...request.Option) (*svcsdk.DescribeTimeToLiveOutput, error) {
+	return &svcsdk.DescribeTimeToLiveOutput{TimeToLiveDescription: m.ttl}, nil
+}
+
+func (m *mockDynamoDBClient) DescribeContinuousBackupsWithContext(_ context.Context, _ *svcsdk.DescribeContinuousBackupsInput, _ ...request.Option) (*svcsdk.DescribeContinuousBackupsOutput, error) {
+	return &svcsdk.DescribeContinuousBackupsOutput{ContinuousBackupsDescription: &svcsdk.ContinuousBackupsDescription{PointInTimeRecoveryDescription: m.pitr}}, nil
+}
+
+func (m *mockDynamoDBClient) UpdateTimeToLiveWithContext(_ context.Context, in *svcsdk.UpdateTimeToLiveInput, _ ...request.Option) (*svcsdk.UpdateTimeToLiveOutput, error) {
+	m.updateTimeToLive = in
+	return &svcsdk.UpdateTimeToLiveOutput{}, nil
+}
+
+func (m *mockDynamoDBClient) UpdateContinuousBackupsWithContext(_ context.Context, in *svcsdk.UpdateContinuousBackupsInput, _ ...request.Option) (*svcsdk.UpdateContinuousBackupsOutput, error) {
+	m.updateContinuousBackups = in
+	return &svcsdk.UpdateContinuousBackupsOutput{}, nil
+}
+
+func TestSettingsUpdate(t *testing.T) {
+	type want struct {
+		ttl  *svcsdk.UpdateTimeToLiveInput
+		pitr *svcsdk.UpdateContinuousBackupsInput
+	}
+	cases := map[string]struct {
+		params svcapitypes.CustomTableParameters
+		client *mockDynamoDBClient
+		want   want
+	}{
+		"UpToDate": {
+			params: svcapitypes.CustomTableParameters{
+				TimeToLive:                 &svcapitypes.TableTimeToLive{Enabled: false},
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			},
+			client: &mockDynamoDBClient{
+				ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+				pitr: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusEnabled)},
+			},
+		},
+		"EnableBoth": {
+			params: svcapitypes.CustomTableParameters{
+				TimeToLive:                 &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+				PointInTimeRecoveryEnabled: aws.Bool(true),
+			},
+			client: &mockDynamoDBClient{
+				ttl:  &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusDisabled)},
+				pitr: &svcsdk.PointInTimeRecoveryDescription{PointInTimeRecoveryStatus: aws.String(svcsdk.PointInTimeRecoveryStatusDisabled)},
+			},
+			want: want{
+				ttl: &svcsdk.UpdateTimeToLiveInput{
+					TableName:               aws.String("table"),
+					TimeToLiveSpecification: &svcsdk.TimeToLiveSpecification{Enabled: aws.Bool(true), AttributeName: aws.String("expires")},
+				},
+				pitr: &svcsdk.UpdateContinuousBackupsInput{
+					TableName:                        aws.String("table"),
+					PointInTimeRecoverySpecification: &svcsdk.PointInTimeRecoverySpecification{PointInTimeRecoveryEnabled: aws.Bool(true)},
+				},
+			},
+		},
+		"ChangeAttributeName": {
+			params: svcapitypes.CustomTableParameters{
+				TimeToLive: &svcapitypes.TableTimeToLive{Enabled: true, AttributeName: "expires"},
+			},
+			client: &mockDynamoDBClient{
+				ttl: &svcsdk.TimeToLiveDescription{TimeToLiveStatus: aws.String(svcsdk.TimeToLiveStatusEnabled), AttributeName: aws.String("ttl")},
+			},
+			want: want{
+				ttl: &svcsdk.UpdateTimeToLiveInput{
+					TableName:               aws.String("table"),
+					TimeToLiveSpecification: &svcsdk.TimeToLiveSpecification{Enabled: aws.Bool(false), AttributeName: aws.String("ttl")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Table{Spec: svcapitypes.TableSpec{ForProvider: svcapitypes.TableParameters{CustomTableParameters: tc.params}}}
+			meta.SetExternalName(cr, "table")
+			s := &settingsClient{client: tc.client}
+			if err := s.update(context.Background(), cr); err != nil {
+				t.Fatalf("update(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.ttl, tc.client.updateTimeToLive); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pitr, tc.client.updateContinuousBackups); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffReplicas(t *testing.T) {
+	cases := map[string]struct {
+		spec []*svcapitypes.TableReplica
+		obs  []*svcsdk.ReplicaDescription
+		want []*svcsdk.ReplicationGroupUpdate
+	}{
+		"NotManaged": {
+			obs: []*svcsdk.ReplicaDescription{{RegionName: aws.String("us-west-2")}},
+		},
+		"UpToDate": {
+			spec: []*svcapitypes.TableReplica{{RegionName: "us-west-2"}},
+			obs:  []*svcsdk.ReplicaDescription{{RegionName: aws.String("us-west-2")}},
+		},
+		"Create": {
+			spec: []*svcapitypes.TableReplica{{RegionName: "us-west-2"}, {RegionName: "eu-west-1", KMSMasterKeyID: aws.String("key")}},
+			obs:  []*svcsdk.ReplicaDescription{{RegionName: aws.String("us-west-2")}},
+			want: []*svcsdk.ReplicationGroupUpdate{{Create: &svcsdk.CreateReplicationGroupMemberAction{RegionName: aws.String("eu-west-1"), KMSMasterKeyId: aws.String("key")}}},
+		},
+		"Delete": {
+			spec: []*svcapitypes.TableReplica{},
+			obs:  []*svcsdk.ReplicaDescription{{RegionName: aws.String("us-west-2")}},
+			want: []*svcsdk.ReplicationGroupUpdate{{Delete: &svcsdk.DeleteReplicationGroupMemberAction{RegionName: aws.String("us-west-2")}}},
+		},
+		"AlreadyDeleting": {
+			spec: []*svcapitypes.TableReplica{},
+			obs:  []*svcsdk.ReplicaDescription{{RegionName: aws.String("us-west-2"), ReplicaStatus: aws.String(svcsdk.ReplicaStatusDeleting)}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := diffReplicas(tc.spec, tc.obs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
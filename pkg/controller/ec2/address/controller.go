@@ -30,9 +30,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -41,7 +38,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -53,12 +52,16 @@ const (
 	errCreate        = "failed to create the Address resource"
 	errCreateTags    = "failed to create tags for the Address resource"
 	errDelete        = "failed to delete the Address resource"
+	errDeleteInUse   = "cannot release the Address while it is still associated, waiting for dependent resources such as NATGateways to be deleted"
 	errStatusUpdate  = "cannot update status of Address custom resource"
 )
 
 // SetupAddress adds a controller that reconciles Address.
-func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
+func SetupAddress(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.AddressGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -67,18 +70,13 @@ func SetupAddress(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Address{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.AddressGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.AddressGroupVersionKind),
+			&connector{kube: mgr.GetClient()},
 			managed.WithCreationGracePeriod(3*time.Minute),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -153,6 +151,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		ResourceExists:          true,
 		ResourceUpToDate:        ec2.IsAddressUpToDate(cr.Spec.ForProvider, observed),
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       ec2.GetAddressConnectionDetails(cr.Status.AtProvider),
 	}, nil
 }
 
@@ -222,6 +221,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 		})
 	}
 
+	// An Address that is still associated with a NAT gateway cannot be
+	// released until the NAT gateway is gone, so we surface a clear error and
+	// let the reconciler retry instead of reporting a generic failure.
+	if ec2.IsAddressInUseErr(err) {
+		return errors.Wrap(err, errDeleteInUse)
+	}
 	return awsclient.Wrap(resource.Ignore(ec2.IsAddressNotFoundErr, err), errDelete)
 }
 
@@ -27,6 +27,7 @@ import (
 
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -46,6 +47,7 @@ var (
 	domainStandard = "standard"
 	publicIP       = "1.1.1.1"
 	errBoom        = errors.New("boom")
+	errInUse       = &smithy.GenericAPIError{Code: ec2.AddressInUse}
 )
 
 type args struct {
@@ -135,6 +137,45 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulWithPublicIP": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				address: &fake.MockAddressClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeAddressesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeAddressesOutput, error) {
+						return &awsec2.DescribeAddressesOutput{
+							Addresses: []awsec2types.Address{{
+								AllocationId: &allocationID,
+								PublicIp:     &publicIP,
+							}},
+						}, nil
+					},
+				},
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain:  &domainVpc,
+					Address: &publicIP,
+				}), withExternalName(allocationID)),
+			},
+			want: want{
+				cr: address(withSpec(v1beta1.AddressParameters{
+					Domain:  &domainVpc,
+					Address: &publicIP,
+				}), withStatus(v1beta1.AddressObservation{
+					AllocationID: allocationID,
+					PublicIP:     publicIP,
+				}), withExternalName(allocationID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1beta1.ResourceCredentialsSecretPublicIPKey:     []byte(publicIP),
+						v1beta1.ResourceCredentialsSecretAllocationIDKey: []byte(allocationID),
+					},
+				},
+			},
+		},
 		"MultipleAddresses": {
 			args: args{
 				kube: &test.MockClient{
@@ -418,6 +459,20 @@ func TestRelease(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDelete),
 			},
 		},
+		"DeleteInUse": {
+			args: args{
+				address: &fake.MockAddressClient{
+					MockRelease: func(ctx context.Context, input *awsec2.ReleaseAddressInput, opts []func(*awsec2.Options)) (*awsec2.ReleaseAddressOutput, error) {
+						return nil, errInUse
+					},
+				},
+				cr: address(),
+			},
+			want: want{
+				cr:  address(withConditions(xpv1.Deleting())),
+				err: errors.Wrap(errInUse, errDeleteInUse),
+			},
+		},
 	}
 
 	for name, tc := range cases {
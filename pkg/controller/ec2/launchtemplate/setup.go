@@ -2,17 +2,22 @@ package launchtemplate
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
 	"sort"
+	"strconv"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -20,21 +25,19 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupLaunchTemplate adds a controller that reconciles LaunchTemplate.
-func SetupLaunchTemplate(mgr ctrl.Manager, o controller.Options) error {
+func SetupLaunchTemplate(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.LaunchTemplateGroupKind)
-	opts := []option{
-		func(e *external) {
-			e.preObserve = preObserve
-			e.preUpdate = preUpdate
-			e.preDelete = preDelete
-			e.postCreate = postCreate
-			e.postObserve = postObserve
-		},
+	if !o.Enabled(name) {
+		return nil
 	}
+	opts := []option{setupExternal}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -43,25 +46,77 @@ func SetupLaunchTemplate(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.LaunchTemplate{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.LaunchTemplateGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<launch-template-name>"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+func setupExternal(e *external) {
+	h := &hooks{client: e.client}
+	e.preObserve = preObserve
+	e.isUpToDate = h.isUpToDate
+	e.preCreate = preCreate
+	e.preUpdate = h.preUpdate
+	e.preDelete = preDelete
+	e.postCreate = postCreate
+	e.postObserve = postObserve
+}
+
+type hooks struct {
+	client svcsdkapi.EC2API
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.DescribeLaunchTemplatesInput) error {
 	obj.LaunchTemplateNames = append(obj.LaunchTemplateNames, aws.String(meta.GetExternalName(cr)))
 	return nil
 }
 
-func preUpdate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateInput) error {
+func preCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.CreateLaunchTemplateInput) error {
+	if obj.LaunchTemplateData == nil {
+		obj.LaunchTemplateData = &svcsdk.RequestLaunchTemplateData{}
+	}
+	setLaunchTemplateDataReferences(cr.Spec.ForProvider.CustomLaunchTemplateParameters, obj.LaunchTemplateData)
+	return nil
+}
+
+// preUpdate creates a new version of the launch template if its data drifted
+// from the latest version and then sets the default version the
+// ModifyLaunchTemplate call pins.
+func (h *hooks) preUpdate(ctx context.Context, cr *svcapitypes.LaunchTemplate, obj *svcsdk.ModifyLaunchTemplateInput) error {
 	obj.LaunchTemplateName = aws.String(meta.GetExternalName(cr))
+
+	latest, err := h.describeLatestVersion(ctx, cr)
+	if err != nil {
+		return err
+	}
+	desired := generateLaunchTemplateData(cr)
+	upToDate, err := isLaunchTemplateDataUpToDate(desired, latest.LaunchTemplateData)
+	if err != nil {
+		return err
+	}
+	latestVersion := aws.Int64Value(latest.VersionNumber)
+	if !upToDate {
+		resp, err := h.client.CreateLaunchTemplateVersionWithContext(ctx, &svcsdk.CreateLaunchTemplateVersionInput{
+			LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+			LaunchTemplateData: desired,
+		})
+		if err != nil {
+			return aws.Wrap(err, errCreateVersion)
+		}
+		latestVersion = aws.Int64Value(resp.LaunchTemplateVersion.VersionNumber)
+	}
+
+	defaultVersion := aws.Int64Value(latest.VersionNumber)
+	if cr.Status.AtProvider.LaunchTemplate != nil {
+		defaultVersion = aws.Int64Value(cr.Status.AtProvider.LaunchTemplate.DefaultVersionNumber)
+	}
+	if v, ok := desiredDefaultVersion(cr.Spec.ForProvider.DefaultVersion, latestVersion); ok {
+		defaultVersion = v
+	}
+	obj.DefaultVersion = aws.String(strconv.FormatInt(defaultVersion, 10))
 	return nil
 }
 
@@ -78,16 +133,182 @@ func postCreate(_ context.Context, cr *svcapitypes.LaunchTemplate, resp *svcsdk.
 	return cre, nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, _ *svcsdk.DescribeLaunchTemplatesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func postObserve(_ context.Context, cr *svcapitypes.LaunchTemplate, resp *svcsdk.DescribeLaunchTemplatesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
+	lt := resp.LaunchTemplates[0]
+	cr.Status.AtProvider.LaunchTemplate = &svcapitypes.LaunchTemplate_SDK{
+		CreatedBy:            lt.CreatedBy,
+		DefaultVersionNumber: lt.DefaultVersionNumber,
+		LatestVersionNumber:  lt.LatestVersionNumber,
+		LaunchTemplateID:     lt.LaunchTemplateId,
+		LaunchTemplateName:   lt.LaunchTemplateName,
+	}
+	if lt.CreateTime != nil {
+		cr.Status.AtProvider.LaunchTemplate.CreateTime = &metav1.Time{Time: *lt.CreateTime}
+	}
+	for _, t := range lt.Tags {
+		cr.Status.AtProvider.LaunchTemplate.Tags = append(cr.Status.AtProvider.LaunchTemplate.Tags, &svcapitypes.Tag{Key: t.Key, Value: t.Value})
+	}
 	cr.SetConditions(xpv1.Available())
 	return obs, nil
 }
 
+// isUpToDate reports whether the default version of the launch template is
+// the pinned one and whether its latest version holds the desired data.
+func (h *hooks) isUpToDate(cr *svcapitypes.LaunchTemplate, resp *svcsdk.DescribeLaunchTemplatesOutput) (bool, error) {
+	lt := resp.LaunchTemplates[0]
+	if v, ok := desiredDefaultVersion(cr.Spec.ForProvider.DefaultVersion, aws.Int64Value(lt.LatestVersionNumber)); ok && v != aws.Int64Value(lt.DefaultVersionNumber) {
+		return false, nil
+	}
+	latest, err := h.describeLatestVersion(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	return isLaunchTemplateDataUpToDate(generateLaunchTemplateData(cr), latest.LaunchTemplateData)
+}
+
+func (h *hooks) describeLatestVersion(ctx context.Context, cr *svcapitypes.LaunchTemplate) (*svcsdk.LaunchTemplateVersion, error) {
+	resp, err := h.client.DescribeLaunchTemplateVersionsWithContext(ctx, &svcsdk.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateName: aws.String(meta.GetExternalName(cr)),
+		Versions:           []*string{aws.String(latestVersion)},
+	})
+	if err != nil {
+		return nil, aws.Wrap(err, errDescribeVersions)
+	}
+	if len(resp.LaunchTemplateVersions) == 0 {
+		return nil, errors.New(errNoLatestVersion)
+	}
+	return resp.LaunchTemplateVersions[0], nil
+}
+
+// desiredDefaultVersion returns the version number the default version of the
+// launch template is pinned to, if it is pinned at all.
+func desiredDefaultVersion(pin *string, latest int64) (int64, bool) {
+	switch {
+	case pin == nil:
+		return 0, false
+	case *pin == latestVersion:
+		return latest, true
+	}
+	v, err := strconv.ParseInt(*pin, 10, 64)
+	return v, err == nil
+}
+
+// generateLaunchTemplateData returns the launch template data of the spec,
+// including the resolved security group and subnet references.
+func generateLaunchTemplateData(cr *svcapitypes.LaunchTemplate) *svcsdk.RequestLaunchTemplateData {
+	data := GenerateCreateLaunchTemplateInput(cr).LaunchTemplateData
+	if data == nil {
+		data = &svcsdk.RequestLaunchTemplateData{}
+	}
+	setLaunchTemplateDataReferences(cr.Spec.ForProvider.CustomLaunchTemplateParameters, data)
+	return data
+}
+
+// setLaunchTemplateDataReferences adds the subnet and security groups of p to
+// data. EC2 rejects launch templates that specify security groups both on the
+// instance and on its network interfaces, so the security groups go to the
+// first network interface once there is one.
+func setLaunchTemplateDataReferences(p svcapitypes.CustomLaunchTemplateParameters, data *svcsdk.RequestLaunchTemplateData) {
+	if p.SubnetID != nil && len(data.NetworkInterfaces) == 0 {
+		data.NetworkInterfaces = []*svcsdk.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{{DeviceIndex: aws.Int64(0, aws.FieldRequired)}}
+	}
+	if len(data.NetworkInterfaces) == 0 {
+		data.SecurityGroupIds = append(data.SecurityGroupIds, p.SecurityGroupIDs...)
+		return
+	}
+	ni := data.NetworkInterfaces[0]
+	if p.SubnetID != nil {
+		ni.SubnetId = p.SubnetID
+	}
+	ni.Groups = append(ni.Groups, p.SecurityGroupIDs...)
+}
+
+// isLaunchTemplateDataUpToDate reports whether every field set in the desired
+// launch template data has the same value in the observed one. The request
+// and response shapes of the EC2 API share their field names, so both are
+// compared in their JSON form.
+func isLaunchTemplateDataUpToDate(desired *svcsdk.RequestLaunchTemplateData, observed *svcsdk.ResponseLaunchTemplateData) (bool, error) {
+	d, err := toJSONValue(desired)
+	if err != nil {
+		return false, err
+	}
+	o, err := toJSONValue(observed)
+	if err != nil {
+		return false, err
+	}
+	return isSubset(d, o), nil
+}
+
+func toJSONValue(in interface{}) (interface{}, error) {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, errMarshalData)
+	}
+	var out interface{}
+	return out, errors.Wrap(json.Unmarshal(b, &out), errMarshalData)
+}
+
+// isSubset reports whether observed contains desired. Fields that are unset or
+// zero in desired match anything that is missing in observed, and lists of
+// scalars are compared regardless of their order since EC2 does not keep it.
+func isSubset(desired, observed interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		o, _ := observed.(map[string]interface{})
+		for k, v := range d {
+			if !isSubset(v, o[k]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		o, _ := observed.([]interface{})
+		if len(d) == 0 {
+			return true
+		}
+		if len(d) != len(o) {
+			return false
+		}
+		if isScalar(d[0]) {
+			return cmp.Equal(d, o, cmpopts.SortSlices(func(a, b interface{}) bool {
+				return fmt.Sprint(a) < fmt.Sprint(b)
+			}))
+		}
+		for i := range d {
+			if !isSubset(d[i], o[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if observed == nil {
+		return reflect.ValueOf(desired).IsZero()
+	}
+	return desired == observed
+}
+
+func isScalar(v interface{}) bool {
+	switch v.(type) {
+	case map[string]interface{}, []interface{}:
+		return false
+	}
+	return true
+}
+
 const (
 	errKubeUpdateFailed = "cannot update LaunchTemplate custom resource"
+	errDescribeVersions = "cannot describe the latest LaunchTemplate version"
+	errNoLatestVersion  = "cannot find the latest LaunchTemplate version"
+	errCreateVersion    = "cannot create LaunchTemplate version"
+	errMarshalData      = "cannot marshal LaunchTemplate data"
+
+	// latestVersion refers to the latest version of a launch template.
+	latestVersion = "$Latest"
 )
 
 type tagger struct {
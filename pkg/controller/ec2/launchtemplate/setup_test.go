@@ -0,0 +1,413 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package launchtemplate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	testLaunchTemplateName = "some-launch-template"
+	testImageID            = "ami-0123456789"
+	testSubnetID           = "subnet-0123456789"
+	testSecurityGroupID    = "sg-0123456789"
+)
+
+var errBoom = errors.New("boom")
+
+type mockEC2Client struct {
+	ec2iface.EC2API
+
+	MockDescribeLaunchTemplateVersions func(*svcsdk.DescribeLaunchTemplateVersionsInput) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error)
+	MockCreateLaunchTemplateVersion    func(*svcsdk.CreateLaunchTemplateVersionInput) (*svcsdk.CreateLaunchTemplateVersionOutput, error)
+}
+
+func (m *mockEC2Client) DescribeLaunchTemplateVersionsWithContext(_ aws.Context, in *svcsdk.DescribeLaunchTemplateVersionsInput, _ ...request.Option) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+	return m.MockDescribeLaunchTemplateVersions(in)
+}
+
+func (m *mockEC2Client) CreateLaunchTemplateVersionWithContext(_ aws.Context, in *svcsdk.CreateLaunchTemplateVersionInput, _ ...request.Option) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+	return m.MockCreateLaunchTemplateVersion(in)
+}
+
+type launchTemplateModifier func(*svcapitypes.LaunchTemplate)
+
+func launchTemplate(m ...launchTemplateModifier) *svcapitypes.LaunchTemplate {
+	cr := &svcapitypes.LaunchTemplate{}
+	meta.SetExternalName(cr, testLaunchTemplateName)
+	cr.Spec.ForProvider.LaunchTemplateData = &svcapitypes.RequestLaunchTemplateData{
+		ImageID:      awsclient.String(testImageID),
+		InstanceType: awsclient.String("t3.small"),
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withDefaultVersion(v string) launchTemplateModifier {
+	return func(cr *svcapitypes.LaunchTemplate) {
+		cr.Spec.ForProvider.DefaultVersion = awsclient.String(v)
+	}
+}
+
+func withObservedDefaultVersion(v int64) launchTemplateModifier {
+	return func(cr *svcapitypes.LaunchTemplate) {
+		cr.Status.AtProvider.LaunchTemplate = &svcapitypes.LaunchTemplate_SDK{DefaultVersionNumber: aws.Int64(v)}
+	}
+}
+
+func describeLatestVersion(version int64, instanceType string) func(*svcsdk.DescribeLaunchTemplateVersionsInput) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+	return func(*svcsdk.DescribeLaunchTemplateVersionsInput) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+		return &svcsdk.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []*svcsdk.LaunchTemplateVersion{{
+				VersionNumber: aws.Int64(version),
+				LaunchTemplateData: &svcsdk.ResponseLaunchTemplateData{
+					ImageId:      aws.String(testImageID),
+					InstanceType: aws.String(instanceType),
+					MetadataOptions: &svcsdk.LaunchTemplateInstanceMetadataOptions{
+						HttpTokens: aws.String("optional"),
+					},
+				},
+			}},
+		}, nil
+	}
+}
+
+func describeLaunchTemplates(defaultVersion, latestVersion int64) *svcsdk.DescribeLaunchTemplatesOutput {
+	return &svcsdk.DescribeLaunchTemplatesOutput{
+		LaunchTemplates: []*svcsdk.LaunchTemplate{{
+			LaunchTemplateName:   aws.String(testLaunchTemplateName),
+			DefaultVersionNumber: aws.Int64(defaultVersion),
+			LatestVersionNumber:  aws.Int64(latestVersion),
+		}},
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		client *mockEC2Client
+		cr     *svcapitypes.LaunchTemplate
+		resp   *svcsdk.DescribeLaunchTemplatesOutput
+	}
+	type want struct {
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				client: &mockEC2Client{MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.small")},
+				cr:     launchTemplate(),
+				resp:   describeLaunchTemplates(1, 2),
+			},
+			want: want{upToDate: true},
+		},
+		"DataDrifted": {
+			args: args{
+				client: &mockEC2Client{MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.large")},
+				cr:     launchTemplate(),
+				resp:   describeLaunchTemplates(1, 2),
+			},
+			want: want{upToDate: false},
+		},
+		"DefaultVersionNotLatest": {
+			args: args{
+				client: &mockEC2Client{MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.small")},
+				cr:     launchTemplate(withDefaultVersion(latestVersion)),
+				resp:   describeLaunchTemplates(1, 2),
+			},
+			want: want{upToDate: false},
+		},
+		"DefaultVersionPinned": {
+			args: args{
+				client: &mockEC2Client{MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.small")},
+				cr:     launchTemplate(withDefaultVersion("1")),
+				resp:   describeLaunchTemplates(1, 2),
+			},
+			want: want{upToDate: true},
+		},
+		"DescribeVersionsFailed": {
+			args: args{
+				client: &mockEC2Client{
+					MockDescribeLaunchTemplateVersions: func(*svcsdk.DescribeLaunchTemplateVersionsInput) (*svcsdk.DescribeLaunchTemplateVersionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr:   launchTemplate(),
+				resp: describeLaunchTemplates(1, 2),
+			},
+			want: want{err: awsclient.Wrap(errBoom, errDescribeVersions)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client}
+			upToDate, err := h.isUpToDate(tc.args.cr, tc.args.resp)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	type args struct {
+		client *mockEC2Client
+		cr     *svcapitypes.LaunchTemplate
+	}
+	type want struct {
+		obj *svcsdk.ModifyLaunchTemplateInput
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NewVersionBecomesDefault": {
+			args: args{
+				client: &mockEC2Client{
+					MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.large"),
+					MockCreateLaunchTemplateVersion: func(in *svcsdk.CreateLaunchTemplateVersionInput) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+						if aws.StringValue(in.LaunchTemplateData.InstanceType) != "t3.small" {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateLaunchTemplateVersionOutput{
+							LaunchTemplateVersion: &svcsdk.LaunchTemplateVersion{VersionNumber: aws.Int64(3)},
+						}, nil
+					},
+				},
+				cr: launchTemplate(withDefaultVersion(latestVersion), withObservedDefaultVersion(2)),
+			},
+			want: want{
+				obj: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testLaunchTemplateName),
+					DefaultVersion:     aws.String("3"),
+				},
+			},
+		},
+		"NewVersionKeepsDefault": {
+			args: args{
+				client: &mockEC2Client{
+					MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.large"),
+					MockCreateLaunchTemplateVersion: func(*svcsdk.CreateLaunchTemplateVersionInput) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+						return &svcsdk.CreateLaunchTemplateVersionOutput{
+							LaunchTemplateVersion: &svcsdk.LaunchTemplateVersion{VersionNumber: aws.Int64(3)},
+						}, nil
+					},
+				},
+				cr: launchTemplate(withObservedDefaultVersion(1)),
+			},
+			want: want{
+				obj: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testLaunchTemplateName),
+					DefaultVersion:     aws.String("1"),
+				},
+			},
+		},
+		"PinDefaultVersion": {
+			args: args{
+				client: &mockEC2Client{MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.small")},
+				cr:     launchTemplate(withDefaultVersion("2"), withObservedDefaultVersion(1)),
+			},
+			want: want{
+				obj: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testLaunchTemplateName),
+					DefaultVersion:     aws.String("2"),
+				},
+			},
+		},
+		"CreateVersionFailed": {
+			args: args{
+				client: &mockEC2Client{
+					MockDescribeLaunchTemplateVersions: describeLatestVersion(2, "t3.large"),
+					MockCreateLaunchTemplateVersion: func(*svcsdk.CreateLaunchTemplateVersionInput) (*svcsdk.CreateLaunchTemplateVersionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: launchTemplate(),
+			},
+			want: want{
+				obj: &svcsdk.ModifyLaunchTemplateInput{
+					LaunchTemplateName: aws.String(testLaunchTemplateName),
+				},
+				err: awsclient.Wrap(errBoom, errCreateVersion),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client}
+			obj := &svcsdk.ModifyLaunchTemplateInput{}
+			err := h.preUpdate(context.Background(), tc.args.cr, obj)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obj, obj); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetLaunchTemplateDataReferences(t *testing.T) {
+	cases := map[string]struct {
+		p    svcapitypes.CustomLaunchTemplateParameters
+		data *svcsdk.RequestLaunchTemplateData
+		want *svcsdk.RequestLaunchTemplateData
+	}{
+		"SecurityGroupsOnInstance": {
+			p:    svcapitypes.CustomLaunchTemplateParameters{SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)}},
+			data: &svcsdk.RequestLaunchTemplateData{},
+			want: &svcsdk.RequestLaunchTemplateData{SecurityGroupIds: []*string{aws.String(testSecurityGroupID)}},
+		},
+		"SubnetAddsNetworkInterface": {
+			p: svcapitypes.CustomLaunchTemplateParameters{
+				SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+				SubnetID:         aws.String(testSubnetID),
+			},
+			data: &svcsdk.RequestLaunchTemplateData{},
+			want: &svcsdk.RequestLaunchTemplateData{
+				NetworkInterfaces: []*svcsdk.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{{
+					DeviceIndex: aws.Int64(0),
+					SubnetId:    aws.String(testSubnetID),
+					Groups:      []*string{aws.String(testSecurityGroupID)},
+				}},
+			},
+		},
+		"SecurityGroupsOnExistingNetworkInterface": {
+			p: svcapitypes.CustomLaunchTemplateParameters{SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)}},
+			data: &svcsdk.RequestLaunchTemplateData{
+				NetworkInterfaces: []*svcsdk.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{{
+					AssociatePublicIpAddress: aws.Bool(true),
+				}},
+			},
+			want: &svcsdk.RequestLaunchTemplateData{
+				NetworkInterfaces: []*svcsdk.LaunchTemplateInstanceNetworkInterfaceSpecificationRequest{{
+					AssociatePublicIpAddress: aws.Bool(true),
+					Groups:                   []*string{aws.String(testSecurityGroupID)},
+				}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			setLaunchTemplateDataReferences(tc.p, tc.data)
+			if diff := cmp.Diff(tc.want, tc.data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLaunchTemplateDataUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		desired  *svcsdk.RequestLaunchTemplateData
+		observed *svcsdk.ResponseLaunchTemplateData
+		want     bool
+	}{
+		"Equal": {
+			desired: &svcsdk.RequestLaunchTemplateData{
+				ImageId: aws.String(testImageID),
+				BlockDeviceMappings: []*svcsdk.LaunchTemplateBlockDeviceMappingRequest{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &svcsdk.LaunchTemplateEbsBlockDeviceRequest{VolumeSize: aws.Int64(20)},
+				}},
+				SecurityGroupIds: []*string{aws.String("sg-1"), aws.String("sg-2")},
+			},
+			observed: &svcsdk.ResponseLaunchTemplateData{
+				ImageId: aws.String(testImageID),
+				BlockDeviceMappings: []*svcsdk.LaunchTemplateBlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &svcsdk.LaunchTemplateEbsBlockDevice{VolumeSize: aws.Int64(20), VolumeType: aws.String("gp3")},
+				}},
+				SecurityGroupIds: []*string{aws.String("sg-2"), aws.String("sg-1")},
+			},
+			want: true,
+		},
+		"ZeroValueMatchesMissing": {
+			desired: &svcsdk.RequestLaunchTemplateData{
+				ImageId:               aws.String(testImageID),
+				DisableApiTermination: aws.Bool(false),
+			},
+			observed: &svcsdk.ResponseLaunchTemplateData{ImageId: aws.String(testImageID)},
+			want:     true,
+		},
+		"BlockDeviceChanged": {
+			desired: &svcsdk.RequestLaunchTemplateData{
+				BlockDeviceMappings: []*svcsdk.LaunchTemplateBlockDeviceMappingRequest{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &svcsdk.LaunchTemplateEbsBlockDeviceRequest{VolumeSize: aws.Int64(40)},
+				}},
+			},
+			observed: &svcsdk.ResponseLaunchTemplateData{
+				BlockDeviceMappings: []*svcsdk.LaunchTemplateBlockDeviceMapping{{
+					DeviceName: aws.String("/dev/xvda"),
+					Ebs:        &svcsdk.LaunchTemplateEbsBlockDevice{VolumeSize: aws.Int64(20)},
+				}},
+			},
+			want: false,
+		},
+		"UserDataChanged": {
+			desired:  &svcsdk.RequestLaunchTemplateData{UserData: aws.String("ZWNobyBuZXc=")},
+			observed: &svcsdk.ResponseLaunchTemplateData{UserData: aws.String("ZWNobyBvbGQ=")},
+			want:     false,
+		},
+		"SecurityGroupRemoved": {
+			desired:  &svcsdk.RequestLaunchTemplateData{SecurityGroupIds: []*string{aws.String("sg-1")}},
+			observed: &svcsdk.ResponseLaunchTemplateData{SecurityGroupIds: []*string{aws.String("sg-1"), aws.String("sg-2")}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isLaunchTemplateDataUpToDate(tc.desired, tc.observed)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -131,6 +131,14 @@ func specNatPublicSpec() v1beta1.NATGatewayParameters {
 	}
 }
 
+func natConnectionDetails() managed.ConnectionDetails {
+	return managed.ConnectionDetails{
+		v1beta1.ResourceCredentialsSecretPublicIPKey:     []byte(natPublicIP),
+		v1beta1.ResourceCredentialsSecretPrivateIPKey:    []byte(natPrivateIP),
+		v1beta1.ResourceCredentialsSecretAllocationIDKey: []byte(natAllocationID),
+	}
+}
+
 func natTags() []awsec2types.Tag {
 	return []awsec2types.Tag{
 		{
@@ -283,8 +291,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Unavailable()),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: natConnectionDetails(),
 				},
 				err: nil,
 			},
@@ -307,8 +316,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Unavailable().WithMessage(natFailureMessage)),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: natConnectionDetails(),
 				},
 				err: nil,
 			},
@@ -331,8 +341,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Available()),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: natConnectionDetails(),
 				},
 				err: nil,
 			},
@@ -355,8 +366,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Deleting()),
 				),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: natConnectionDetails(),
 				},
 				err: nil,
 			},
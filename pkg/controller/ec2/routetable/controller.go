@@ -29,9 +29,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -39,8 +36,11 @@ import (
 	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
 	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -54,6 +54,7 @@ const (
 	errDelete             = "failed to delete the RouteTable resource"
 	errCreateRoute        = "failed to create a route in the RouteTable resource"
 	errDeleteRoute        = "failed to delete a route in the RouteTable resource"
+	errReplaceRoute       = "failed to replace a route in the RouteTable resource"
 	errAssociateSubnet    = "failed to associate subnet to the RouteTable resource"
 	errDisassociateSubnet = "failed to disassociate subnet from the RouteTable resource"
 	errCreateTags         = "failed to create tags for the RouteTable resource"
@@ -61,8 +62,11 @@ const (
 )
 
 // SetupRouteTable adds a controller that reconciles RouteTables.
-func SetupRouteTable(mgr ctrl.Manager, o controller.Options) error {
+func SetupRouteTable(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.RouteTableGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -71,18 +75,14 @@ func SetupRouteTable(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.RouteTable{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.RouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.RouteTableGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient},
 			managed.WithCreationGracePeriod(3*time.Minute),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), &referenceResolver{client: mgr.GetClient()})),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -262,94 +262,76 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(ec2.IsRouteTableNotFoundErr, err), errDelete)
 }
 
-func (e *external) deleteRoutes(ctx context.Context, tableID string, desired []v1beta1.RouteBeta, observed []v1beta1.RouteState) error { // nolint:gocyclo
-	for _, rt := range observed {
-		found := false
-		for _, ds := range desired {
-			if aws.ToString(ds.DestinationCIDRBlock) == rt.DestinationCIDRBlock && (aws.ToString(ds.GatewayID) == rt.GatewayID &&
-				aws.ToString(ds.InstanceID) == rt.InstanceID &&
-				aws.ToString(ds.LocalGatewayID) == rt.LocalGatewayID &&
-				aws.ToString(ds.NatGatewayID) == rt.NatGatewayID &&
-				aws.ToString(ds.NetworkInterfaceID) == rt.NetworkInterfaceID &&
-				aws.ToString(ds.TransitGatewayID) == rt.TransitGatewayID &&
-				aws.ToString(ds.VpcPeeringConnectionID) == rt.VpcPeeringConnectionID) {
-
-				found = true
-				break
-			}
+func (e *external) deleteRoutes(ctx context.Context, tableID string, routes []v1beta1.RouteState) error {
+	for _, rt := range routes {
+		input := &awsec2.DeleteRouteInput{RouteTableId: aws.String(tableID)}
+		if rt.DestinationCIDRBlock != "" {
+			input.DestinationCidrBlock = aws.String(rt.DestinationCIDRBlock)
+		} else {
+			input.DestinationIpv6CidrBlock = aws.String(rt.DestinationIPV6CIDRBlock)
 		}
-		if !found && rt.GatewayID != ec2.DefaultLocalGatewayID {
-			if rt.DestinationCIDRBlock != "" {
-				_, err := e.client.DeleteRoute(ctx, &awsec2.DeleteRouteInput{
-					RouteTableId:         aws.String(tableID),
-					DestinationCidrBlock: aws.String(rt.DestinationCIDRBlock),
-				})
-
-				if err != nil {
-					return err
-				}
-			} else {
-				_, err := e.client.DeleteRoute(ctx, &awsec2.DeleteRouteInput{
-					RouteTableId:             aws.String(tableID),
-					DestinationIpv6CidrBlock: aws.String(rt.DestinationIPV6CIDRBlock),
-				})
-
-				if err != nil {
-					return err
-				}
-			}
+		if _, err := e.client.DeleteRoute(ctx, input); resource.Ignore(ec2.IsRouteNotFoundErr, err) != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (e *external) createRoutes(ctx context.Context, tableID string, desired []v1beta1.RouteBeta, observed []v1beta1.RouteState) error { // nolint:gocyclo
-	for _, rt := range desired {
-		isObserved := false
-		for _, ob := range observed {
-			if ob.DestinationCIDRBlock == aws.ToString(rt.DestinationCIDRBlock) && (ob.GatewayID == aws.ToString(rt.GatewayID) &&
-				ob.InstanceID == aws.ToString(rt.InstanceID) &&
-				ob.LocalGatewayID == aws.ToString(rt.LocalGatewayID) &&
-				ob.NatGatewayID == aws.ToString(rt.NatGatewayID) &&
-				ob.NetworkInterfaceID == aws.ToString(rt.NetworkInterfaceID) &&
-				ob.TransitGatewayID == aws.ToString(rt.TransitGatewayID) &&
-				ob.VpcPeeringConnectionID == aws.ToString(rt.VpcPeeringConnectionID)) {
-				isObserved = true
-				break
-			}
+func (e *external) replaceRoutes(ctx context.Context, tableID string, routes []v1beta1.RouteBeta) error {
+	for _, rt := range routes {
+		_, err := e.client.ReplaceRoute(ctx, &awsec2.ReplaceRouteInput{
+			RouteTableId:             aws.String(tableID),
+			DestinationCidrBlock:     rt.DestinationCIDRBlock,
+			DestinationIpv6CidrBlock: rt.DestinationIPV6CIDRBlock,
+			GatewayId:                rt.GatewayID,
+			InstanceId:               rt.InstanceID,
+			LocalGatewayId:           rt.LocalGatewayID,
+			NatGatewayId:             rt.NatGatewayID,
+			NetworkInterfaceId:       rt.NetworkInterfaceID,
+			TransitGatewayId:         rt.TransitGatewayID,
+			VpcPeeringConnectionId:   rt.VpcPeeringConnectionID,
+		})
+		if err != nil {
+			return err
 		}
-		// if the route is already created, skip it
-		if !isObserved {
-			_, err := e.client.CreateRoute(ctx, &awsec2.CreateRouteInput{
-				RouteTableId:             aws.String(tableID),
-				DestinationCidrBlock:     rt.DestinationCIDRBlock,
-				GatewayId:                rt.GatewayID,
-				DestinationIpv6CidrBlock: rt.DestinationIPV6CIDRBlock,
-				InstanceId:               rt.InstanceID,
-				LocalGatewayId:           rt.LocalGatewayID,
-				NatGatewayId:             rt.NatGatewayID,
-				NetworkInterfaceId:       rt.NetworkInterfaceID,
-				TransitGatewayId:         rt.TransitGatewayID,
-				VpcPeeringConnectionId:   rt.VpcPeeringConnectionID,
-			})
+	}
+	return nil
+}
 
-			if err != nil {
-				return err
-			}
+func (e *external) createRoutes(ctx context.Context, tableID string, routes []v1beta1.RouteBeta) error {
+	for _, rt := range routes {
+		_, err := e.client.CreateRoute(ctx, &awsec2.CreateRouteInput{
+			RouteTableId:             aws.String(tableID),
+			DestinationCidrBlock:     rt.DestinationCIDRBlock,
+			GatewayId:                rt.GatewayID,
+			DestinationIpv6CidrBlock: rt.DestinationIPV6CIDRBlock,
+			InstanceId:               rt.InstanceID,
+			LocalGatewayId:           rt.LocalGatewayID,
+			NatGatewayId:             rt.NatGatewayID,
+			NetworkInterfaceId:       rt.NetworkInterfaceID,
+			TransitGatewayId:         rt.TransitGatewayID,
+			VpcPeeringConnectionId:   rt.VpcPeeringConnectionID,
+		})
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// reconcileRoutes only touches the routes whose destination or target
+// changed, leaving all other routes of the table in place.
 func (e *external) reconcileRoutes(ctx context.Context, tableID string, desired []v1beta1.RouteBeta, observed []v1beta1.RouteState) error {
-
-	if err := e.deleteRoutes(ctx, tableID, desired, observed); err != nil {
+	diff := ec2.DiffRoutes(desired, observed)
+	if err := e.deleteRoutes(ctx, tableID, diff.Delete); err != nil {
 		return awsclient.Wrap(err, errDeleteRoute)
 	}
-	if err := e.createRoutes(ctx, tableID, desired, observed); err != nil {
+	if err := e.replaceRoutes(ctx, tableID, diff.Replace); err != nil {
+		return awsclient.Wrap(err, errReplaceRoute)
+	}
+	if err := e.createRoutes(ctx, tableID, diff.Create); err != nil {
 		return awsclient.Wrap(err, errCreateRoute)
 	}
-
 	return nil
 }
 
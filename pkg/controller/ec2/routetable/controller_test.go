@@ -671,6 +671,58 @@ func TestUpdate(t *testing.T) {
 					})),
 			},
 		},
+		"SuccessfulReplaceRoute": {
+			args: args{
+				rt: &fake.MockRouteTableClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeRouteTablesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeRouteTablesOutput, error) {
+						return &awsec2.DescribeRouteTablesOutput{
+							RouteTables: []awsec2types.RouteTable{{
+								Routes: []awsec2types.Route{
+									{
+										DestinationCidrBlock: aws.String(CIDR),
+										GatewayId:            aws.String(igID),
+									},
+								},
+							}},
+						}, nil
+					},
+					MockReplaceRoute: func(ctx context.Context, input *awsec2.ReplaceRouteInput, opts []func(*awsec2.Options)) (*awsec2.ReplaceRouteOutput, error) {
+						if diff := cmp.Diff(instanceID, aws.ToString(input.InstanceId)); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.ReplaceRouteOutput{}, nil
+					},
+				},
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					Routes: []v1beta1.RouteBeta{{
+						DestinationCIDRBlock: aws.String(CIDR),
+						InstanceID:           aws.String(instanceID),
+					}},
+				}),
+					withStatus(v1beta1.RouteTableObservation{
+						RouteTableID: rtID,
+						Routes: []v1beta1.RouteState{{
+							DestinationCIDRBlock: CIDR,
+							GatewayID:            igID,
+						}},
+					})),
+			},
+			want: want{
+				cr: rt(withSpec(v1beta1.RouteTableParameters{
+					Routes: []v1beta1.RouteBeta{{
+						DestinationCIDRBlock: aws.String(CIDR),
+						InstanceID:           aws.String(instanceID),
+					}},
+				}),
+					withStatus(v1beta1.RouteTableObservation{
+						RouteTableID: rtID,
+						Routes: []v1beta1.RouteState{{
+							DestinationCIDRBlock: CIDR,
+							GatewayID:            igID,
+						}},
+					})),
+			},
+		},
 		"CreateRouteFail": {
 			args: args{
 				rt: &fake.MockRouteTableClient{
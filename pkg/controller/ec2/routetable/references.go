@@ -0,0 +1,117 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package routetable
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/v1beta1"
+)
+
+const (
+	errResolveReferences = "cannot resolve references"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// referenceResolver resolves the references of a RouteTable. Routes may
+// target TransitGateways, VPCPeeringConnections and Instances, whose API
+// packages import v1beta1 themselves. Those references therefore cannot be
+// resolved by RouteTable.ResolveReferences and are resolved here instead.
+type referenceResolver struct {
+	client client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.RouteTable)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	existing := cr.DeepCopy()
+	if err := cr.ResolveReferences(ctx, r.client); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if err := resolveRouteTargets(ctx, r.client, cr); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.client.Update(ctx, cr), errUpdateManaged)
+}
+
+func resolveRouteTargets(ctx context.Context, c client.Reader, cr *v1beta1.RouteTable) error {
+	r := reference.NewAPIResolver(c, cr)
+
+	for i := range cr.Spec.ForProvider.Routes {
+		route := &cr.Spec.ForProvider.Routes[i]
+
+		// Resolve spec.forProvider.routes[].instanceId
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(route.InstanceID),
+			Reference:    route.InstanceIDRef,
+			Selector:     route.InstanceIDSelector,
+			To:           reference.To{Managed: &manualv1alpha1.Instance{}, List: &manualv1alpha1.InstanceList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].instanceId", i)
+		}
+		route.InstanceID = reference.ToPtrValue(rsp.ResolvedValue)
+		route.InstanceIDRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.routes[].transitGatewayId
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(route.TransitGatewayID),
+			Reference:    route.TransitGatewayIDRef,
+			Selector:     route.TransitGatewayIDSelector,
+			To:           reference.To{Managed: &v1alpha1.TransitGateway{}, List: &v1alpha1.TransitGatewayList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].transitGatewayId", i)
+		}
+		route.TransitGatewayID = reference.ToPtrValue(rsp.ResolvedValue)
+		route.TransitGatewayIDRef = rsp.ResolvedReference
+
+		// Resolve spec.forProvider.routes[].vpcPeeringConnectionId
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: reference.FromPtrValue(route.VpcPeeringConnectionID),
+			Reference:    route.VpcPeeringConnectionIDRef,
+			Selector:     route.VpcPeeringConnectionIDSelector,
+			To:           reference.To{Managed: &v1alpha1.VPCPeeringConnection{}, List: &v1alpha1.VPCPeeringConnectionList{}},
+			Extract:      reference.ExternalName(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.routes[%d].vpcPeeringConnectionId", i)
+		}
+		route.VpcPeeringConnectionID = reference.ToPtrValue(rsp.ResolvedValue)
+		route.VpcPeeringConnectionIDRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+	"github.com/crossplane/provider-aws/apis/ec2/manualv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	snapshotID   = "snap-0123456789"
+	volumeID     = "vol-0123456789"
+	errBoom      = errors.New("snapshot boomed")
+	errNotFound  = &smithy.GenericAPIError{Code: ec2.SnapshotNotFound}
+	stateMessage = "volume was deleted"
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type snapshotModifier func(*ebsv1alpha1.Snapshot)
+
+func withExternalName(name string) snapshotModifier {
+	return func(r *ebsv1alpha1.Snapshot) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) snapshotModifier {
+	return func(r *ebsv1alpha1.Snapshot) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withTags(tags ...manualv1alpha1.Tag) snapshotModifier {
+	return func(r *ebsv1alpha1.Snapshot) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withStatus(s ebsv1alpha1.SnapshotObservation) snapshotModifier {
+	return func(r *ebsv1alpha1.Snapshot) { r.Status.AtProvider = s }
+}
+
+func snapshot(m ...snapshotModifier) *ebsv1alpha1.Snapshot {
+	cr := &ebsv1alpha1.Snapshot{}
+	cr.Spec.ForProvider.VolumeID = aws.String(volumeID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(state awsec2types.SnapshotState, tags ...awsec2types.Tag) func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+	return func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+		s := awsec2types.Snapshot{SnapshotId: aws.String(snapshotID), VolumeId: aws.String(volumeID), State: state, Tags: tags}
+		if state == awsec2types.SnapshotStateError {
+			s.StateMessage = aws.String(stateMessage)
+		}
+		return &awsec2.DescribeSnapshotsOutput{Snapshots: []awsec2types.Snapshot{s}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *ebsv1alpha1.Snapshot
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSnapshotClient
+		cr     *ebsv1alpha1.Snapshot
+		want   want
+	}{
+		"Pending": {
+			client: &fake.MockSnapshotClient{MockDescribe: describe(awsec2types.SnapshotStatePending)},
+			cr:     snapshot(withExternalName(snapshotID)),
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withConditions(xpv1.Creating()),
+					withStatus(ebsv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: ebsv1alpha1.SnapshotStatePending})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Completed": {
+			client: &fake.MockSnapshotClient{MockDescribe: describe(awsec2types.SnapshotStateCompleted)},
+			cr:     snapshot(withExternalName(snapshotID)),
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withConditions(xpv1.Available()),
+					withStatus(ebsv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: ebsv1alpha1.SnapshotStateCompleted})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			client: &fake.MockSnapshotClient{MockDescribe: describe(awsec2types.SnapshotStateError)},
+			cr:     snapshot(withExternalName(snapshotID)),
+			want: want{
+				cr: snapshot(withExternalName(snapshotID),
+					withConditions(xpv1.Unavailable().WithMessage(stateMessage)),
+					withStatus(ebsv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: ebsv1alpha1.SnapshotStateError, StateMessage: stateMessage})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"TagsChanged": {
+			client: &fake.MockSnapshotClient{MockDescribe: describe(awsec2types.SnapshotStateCompleted)},
+			cr:     snapshot(withExternalName(snapshotID), withTags(manualv1alpha1.Tag{Key: "key", Value: "value"})),
+			want: want{
+				cr: snapshot(withExternalName(snapshotID), withTags(manualv1alpha1.Tag{Key: "key", Value: "value"}),
+					withConditions(xpv1.Available()),
+					withStatus(ebsv1alpha1.SnapshotObservation{SnapshotID: snapshotID, State: ebsv1alpha1.SnapshotStateCompleted})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockSnapshotClient{},
+			cr:     snapshot(),
+			want: want{
+				cr: snapshot(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockSnapshotClient{MockDescribe: func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+				return nil, errNotFound
+			}},
+			cr: snapshot(withExternalName(snapshotID)),
+			want: want{
+				cr: snapshot(withExternalName(snapshotID)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockSnapshotClient{MockDescribe: func(context.Context, *awsec2.DescribeSnapshotsInput, []func(*awsec2.Options)) (*awsec2.DescribeSnapshotsOutput, error) {
+				return nil, errBoom
+			}},
+			cr: snapshot(withExternalName(snapshotID)),
+			want: want{
+				cr:  snapshot(withExternalName(snapshotID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *ebsv1alpha1.Snapshot
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockSnapshotClient
+		cr     *ebsv1alpha1.Snapshot
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockSnapshotClient{MockCreate: func(_ context.Context, input *awsec2.CreateSnapshotInput, _ []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+				if diff := cmp.Diff(volumeID, aws.ToString(input.VolumeId)); diff != "" {
+					t.Errorf("CreateSnapshot: -want volume, +got volume:\n%s", diff)
+				}
+				if diff := cmp.Diff(awsec2types.ResourceTypeSnapshot, input.TagSpecifications[0].ResourceType); diff != "" {
+					t.Errorf("CreateSnapshot: -want resource type, +got resource type:\n%s", diff)
+				}
+				return &awsec2.CreateSnapshotOutput{SnapshotId: aws.String(snapshotID)}, nil
+			}},
+			cr: snapshot(withTags(manualv1alpha1.Tag{Key: "key", Value: "value"})),
+			want: want{
+				cr:     snapshot(withTags(manualv1alpha1.Tag{Key: "key", Value: "value"}), withExternalName(snapshotID)),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockSnapshotClient{MockCreate: func(context.Context, *awsec2.CreateSnapshotInput, []func(*awsec2.Options)) (*awsec2.CreateSnapshotOutput, error) {
+				return nil, errBoom
+			}},
+			cr: snapshot(),
+			want: want{
+				cr:  snapshot(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var added, removed []awsec2types.Tag
+	client := &fake.MockSnapshotClient{
+		MockDescribe: describe(awsec2types.SnapshotStateCompleted,
+			awsec2types.Tag{Key: aws.String("stale"), Value: aws.String("value")},
+			awsec2types.Tag{Key: aws.String("kept"), Value: aws.String("value")}),
+		MockCreateTags: func(_ context.Context, input *awsec2.CreateTagsInput, _ []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+			added = input.Tags
+			return &awsec2.CreateTagsOutput{}, nil
+		},
+		MockDeleteTags: func(_ context.Context, input *awsec2.DeleteTagsInput, _ []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+			removed = input.Tags
+			return &awsec2.DeleteTagsOutput{}, nil
+		},
+	}
+	cr := snapshot(withExternalName(snapshotID), withTags(
+		manualv1alpha1.Tag{Key: "kept", Value: "value"},
+		manualv1alpha1.Tag{Key: "new", Value: "value"},
+	))
+
+	e := &external{client: client}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): unexpected error: %v", err)
+	}
+	opts := cmp.AllowUnexported(awsec2types.Tag{})
+	if diff := cmp.Diff([]awsec2types.Tag{{Key: aws.String("new"), Value: aws.String("value")}}, added, opts); diff != "" {
+		t.Errorf("Update(...): -want added tags, +got added tags:\n%s", diff)
+	}
+	if diff := cmp.Diff([]awsec2types.Tag{{Key: aws.String("stale")}}, removed, opts); diff != "" {
+		t.Errorf("Update(...): -want removed tags, +got removed tags:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockSnapshotClient
+		want   error
+	}{
+		"Successful": {
+			client: &fake.MockSnapshotClient{MockDelete: func(context.Context, *awsec2.DeleteSnapshotInput, []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+				return &awsec2.DeleteSnapshotOutput{}, nil
+			}},
+		},
+		"AlreadyDeleted": {
+			client: &fake.MockSnapshotClient{MockDelete: func(context.Context, *awsec2.DeleteSnapshotInput, []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+				return nil, errNotFound
+			}},
+		},
+		"DeleteFailed": {
+			client: &fake.MockSnapshotClient{MockDelete: func(context.Context, *awsec2.DeleteSnapshotInput, []func(*awsec2.Options)) (*awsec2.DeleteSnapshotOutput, error) {
+				return nil, errBoom
+			}},
+			want: awsclient.Wrap(errBoom, errDelete),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), snapshot(withExternalName(snapshotID)))
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
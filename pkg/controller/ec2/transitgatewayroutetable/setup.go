@@ -12,9 +12,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -22,7 +19,10 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -30,8 +30,11 @@ const (
 )
 
 // SetupTransitGatewayRouteTable adds a controller that reconciles TransitGatewayRouteTable.
-func SetupTransitGatewayRouteTable(mgr ctrl.Manager, o controller.Options) error {
+func SetupTransitGatewayRouteTable(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.RouteGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			c := &custom{client: e.client, kube: e.kube}
@@ -50,15 +53,11 @@ func SetupTransitGatewayRouteTable(mgr ctrl.Manager, o controller.Options) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.TransitGatewayRouteTable{}).
-		Complete(managed.NewReconciler(mgr,
-			cpresource.ManagedKind(svcapitypes.TransitGatewayRouteTableGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
+		Complete(o.NewReconciler(mgr, name, cpresource.ManagedKind(svcapitypes.TransitGatewayRouteTableGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<transit-gateway-route-table-id>", "ec2", "transit-gateway-route-table"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -20,9 +20,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,17 +27,24 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupVolume adds a controller that reconciles Volume.
-func SetupVolume(mgr ctrl.Manager, o controller.Options) error {
+func SetupVolume(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.VolumeGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.preCreate = preCreate
 			e.postCreate = postCreate
 			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
 			e.filterList = filterList
 		},
 	}
@@ -52,15 +56,11 @@ func SetupVolume(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Volume{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.VolumeGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.VolumeGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<volume-id>", "ec2", "volume"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -111,3 +111,20 @@ func postObserve(_ context.Context, cr *svcapitypes.Volume, obj *svcsdk.Describe
 	}
 	return obs, nil
 }
+
+// isUpToDate returns false if the size, type, IOPS, throughput or multi-attach
+// setting of the volume differ from those in the spec. These are the
+// attributes that can be changed with ModifyVolume.
+func isUpToDate(cr *svcapitypes.Volume, obj *svcsdk.DescribeVolumesOutput) (bool, error) {
+	in := cr.Spec.ForProvider
+	v := obj.Volumes[0]
+	switch {
+	case in.Size != nil && awsclients.Int64Value(in.Size) != awsclients.Int64Value(v.Size),
+		in.VolumeType != nil && awsclients.StringValue(in.VolumeType) != awsclients.StringValue(v.VolumeType),
+		in.IOPS != nil && awsclients.Int64Value(in.IOPS) != awsclients.Int64Value(v.Iops),
+		in.Throughput != nil && awsclients.Int64Value(in.Throughput) != awsclients.Int64Value(v.Throughput),
+		in.MultiAttachEnabled != nil && awsclients.BoolValue(in.MultiAttachEnabled) != awsclients.BoolValue(v.MultiAttachEnabled):
+		return false, nil
+	}
+	return true, nil
+}
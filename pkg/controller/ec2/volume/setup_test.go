@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volume
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/google/go-cmp/cmp"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func observedVolume() *svcsdk.DescribeVolumesOutput {
+	return &svcsdk.DescribeVolumesOutput{Volumes: []*svcsdk.Volume{{
+		Size:               awsclients.Int64(100),
+		VolumeType:         awsclients.String("gp3"),
+		Iops:               awsclients.Int64(3000),
+		Throughput:         awsclients.Int64(125),
+		MultiAttachEnabled: awsclients.Bool(false),
+	}}}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params svcapitypes.VolumeParameters
+		want   bool
+	}{
+		"UpToDate": {
+			reason: "A volume that matches the spec should be up to date.",
+			params: svcapitypes.VolumeParameters{
+				Size:       awsclients.Int64(100),
+				VolumeType: awsclients.String("gp3"),
+				IOPS:       awsclients.Int64(3000),
+				Throughput: awsclients.Int64(125),
+			},
+			want: true,
+		},
+		"Unspecified": {
+			reason: "Attributes the spec does not specify should not be compared.",
+			want:   true,
+		},
+		"SizeChanged": {
+			reason: "A volume should be resized if its size differs from the spec.",
+			params: svcapitypes.VolumeParameters{Size: awsclients.Int64(200)},
+		},
+		"TypeChanged": {
+			reason: "A volume should be modified if its type differs from the spec.",
+			params: svcapitypes.VolumeParameters{VolumeType: awsclients.String("io2")},
+		},
+		"IOPSChanged": {
+			reason: "A volume should be modified if its IOPS differ from the spec.",
+			params: svcapitypes.VolumeParameters{IOPS: awsclients.Int64(6000)},
+		},
+		"ThroughputChanged": {
+			reason: "A volume should be modified if its throughput differs from the spec.",
+			params: svcapitypes.VolumeParameters{Throughput: awsclients.Int64(250)},
+		},
+		"MultiAttachChanged": {
+			reason: "A volume should be modified if multi-attach was enabled in the spec.",
+			params: svcapitypes.VolumeParameters{MultiAttachEnabled: awsclients.Bool(true)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &svcapitypes.Volume{Spec: svcapitypes.VolumeSpec{ForProvider: tc.params}}
+			got, _ := isUpToDate(cr, observedVolume())
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nisUpToDate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
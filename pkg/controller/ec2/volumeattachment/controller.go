@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VolumeAttachment resource"
+	errDescribe         = "failed to describe the Volume of the VolumeAttachment"
+	errNotSingleItem    = "either no or multiple Volumes retrieved for the given volumeId"
+	errAttach           = "failed to attach the Volume"
+	errDetach           = "failed to detach the Volume"
+)
+
+// SetupVolumeAttachment adds a controller that reconciles VolumeAttachments.
+func SetupVolumeAttachment(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(ebsv1alpha1.VolumeAttachmentGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&ebsv1alpha1.VolumeAttachment{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(ebsv1alpha1.VolumeAttachmentGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ec2.NewVolumeAttachmentClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.VolumeAttachmentClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*ebsv1alpha1.VolumeAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client ec2.VolumeAttachmentClient
+}
+
+// Observe looks the attachment up in the attachments of its volume, since
+// attachments have no identifier of their own.
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*ebsv1alpha1.VolumeAttachment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	response, err := e.client.DescribeVolumes(ctx, &awsec2.DescribeVolumesInput{
+		VolumeIds: []string{aws.ToString(cr.Spec.ForProvider.VolumeID)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsVolumeNotFoundErr, err), errDescribe)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Volumes) != 1 {
+		return managed.ExternalObservation{}, errors.New(errNotSingleItem)
+	}
+
+	attachment := ec2.FindVolumeAttachment(response.Volumes[0], aws.ToString(cr.Spec.ForProvider.InstanceID))
+	if attachment == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.Status.AtProvider = ec2.GenerateVolumeAttachmentObservation(*attachment)
+
+	switch cr.Status.AtProvider.State {
+	case ebsv1alpha1.VolumeAttachmentStateAttaching:
+		cr.SetConditions(xpv1.Creating())
+	case ebsv1alpha1.VolumeAttachmentStateAttached, ebsv1alpha1.VolumeAttachmentStateBusy:
+		cr.SetConditions(xpv1.Available())
+	case ebsv1alpha1.VolumeAttachmentStateDetaching:
+		cr.SetConditions(xpv1.Deleting())
+	case ebsv1alpha1.VolumeAttachmentStateDetached:
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// All parameters of an attachment are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*ebsv1alpha1.VolumeAttachment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.AttachVolume(ctx, &awsec2.AttachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errAttach)
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*ebsv1alpha1.VolumeAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == ebsv1alpha1.VolumeAttachmentStateDetaching {
+		return nil
+	}
+
+	_, err := e.client.DetachVolume(ctx, &awsec2.DetachVolumeInput{
+		Device:     aws.String(cr.Spec.ForProvider.Device),
+		InstanceId: cr.Spec.ForProvider.InstanceID,
+		VolumeId:   cr.Spec.ForProvider.VolumeID,
+		Force:      cr.Spec.ForProvider.ForceDetach,
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsVolumeAttachmentNotFoundErr, err), errDetach)
+}
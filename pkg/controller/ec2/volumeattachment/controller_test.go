@@ -0,0 +1,238 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumeattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ec2/ebsv1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	volumeID   = "vol-0123456789"
+	instanceID = "i-0123456789"
+	device     = "/dev/sdh"
+	errBoom    = errors.New("attachment boomed")
+)
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+type attachmentModifier func(*ebsv1alpha1.VolumeAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *ebsv1alpha1.VolumeAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withState(s string) attachmentModifier {
+	return func(r *ebsv1alpha1.VolumeAttachment) { r.Status.AtProvider.State = s }
+}
+
+func attachment(m ...attachmentModifier) *ebsv1alpha1.VolumeAttachment {
+	cr := &ebsv1alpha1.VolumeAttachment{}
+	cr.Spec.ForProvider.VolumeID = aws.String(volumeID)
+	cr.Spec.ForProvider.InstanceID = aws.String(instanceID)
+	cr.Spec.ForProvider.Device = device
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(attachments ...awsec2types.VolumeAttachment) func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+	return func(_ context.Context, input *awsec2.DescribeVolumesInput, _ []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+		return &awsec2.DescribeVolumesOutput{Volumes: []awsec2types.Volume{{VolumeId: aws.String(input.VolumeIds[0]), Attachments: attachments}}}, nil
+	}
+}
+
+func attachedTo(instance string, state awsec2types.VolumeAttachmentState) awsec2types.VolumeAttachment {
+	return awsec2types.VolumeAttachment{InstanceId: aws.String(instance), VolumeId: aws.String(volumeID), Device: aws.String(device), State: state}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *ebsv1alpha1.VolumeAttachment
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockVolumeAttachmentClient
+		want   want
+	}{
+		"Attaching": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: describe(attachedTo(instanceID, awsec2types.VolumeAttachmentStateAttaching))},
+			want: want{
+				cr:     attachment(withState(ebsv1alpha1.VolumeAttachmentStateAttaching), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Attached": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: describe(
+				attachedTo("i-other", awsec2types.VolumeAttachmentStateAttached),
+				attachedTo(instanceID, awsec2types.VolumeAttachmentStateAttached),
+			)},
+			want: want{
+				cr:     attachment(withState(ebsv1alpha1.VolumeAttachmentStateAttached), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Detaching": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: describe(attachedTo(instanceID, awsec2types.VolumeAttachmentStateDetaching))},
+			want: want{
+				cr:     attachment(withState(ebsv1alpha1.VolumeAttachmentStateDetaching), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Detached": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: describe(attachedTo(instanceID, awsec2types.VolumeAttachmentStateDetached))},
+			want: want{
+				cr: attachment(withState(ebsv1alpha1.VolumeAttachmentStateDetached)),
+			},
+		},
+		"AttachedToOtherInstance": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: describe(attachedTo("i-other", awsec2types.VolumeAttachmentStateAttached))},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"VolumeNotFound": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: ec2.VolumeNotFound}
+			}},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockVolumeAttachmentClient{MockDescribe: func(context.Context, *awsec2.DescribeVolumesInput, []func(*awsec2.Options)) (*awsec2.DescribeVolumesOutput, error) {
+				return nil, errBoom
+			}},
+			want: want{
+				cr:  attachment(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			cr := attachment()
+			o, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockVolumeAttachmentClient
+		want   error
+	}{
+		"Successful": {
+			client: &fake.MockVolumeAttachmentClient{MockAttach: func(_ context.Context, input *awsec2.AttachVolumeInput, _ []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+				want := &awsec2.AttachVolumeInput{Device: aws.String(device), InstanceId: aws.String(instanceID), VolumeId: aws.String(volumeID)}
+				if diff := cmp.Diff(want, input, cmp.AllowUnexported(awsec2.AttachVolumeInput{})); diff != "" {
+					t.Errorf("AttachVolume: -want, +got:\n%s", diff)
+				}
+				return &awsec2.AttachVolumeOutput{}, nil
+			}},
+		},
+		"AttachFailed": {
+			client: &fake.MockVolumeAttachmentClient{MockAttach: func(context.Context, *awsec2.AttachVolumeInput, []func(*awsec2.Options)) (*awsec2.AttachVolumeOutput, error) {
+				return nil, errBoom
+			}},
+			want: awsclient.Wrap(errBoom, errAttach),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), attachment())
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		client *fake.MockVolumeAttachmentClient
+		cr     *ebsv1alpha1.VolumeAttachment
+		want   error
+	}{
+		"Successful": {
+			client: &fake.MockVolumeAttachmentClient{MockDetach: func(context.Context, *awsec2.DetachVolumeInput, []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+				return &awsec2.DetachVolumeOutput{}, nil
+			}},
+			cr: attachment(withState(ebsv1alpha1.VolumeAttachmentStateAttached)),
+		},
+		"AlreadyDetaching": {
+			client: &fake.MockVolumeAttachmentClient{},
+			cr:     attachment(withState(ebsv1alpha1.VolumeAttachmentStateDetaching)),
+		},
+		"AlreadyDetached": {
+			client: &fake.MockVolumeAttachmentClient{MockDetach: func(context.Context, *awsec2.DetachVolumeInput, []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+				return nil, &smithy.GenericAPIError{Code: ec2.AttachmentNotFound}
+			}},
+			cr: attachment(withState(ebsv1alpha1.VolumeAttachmentStateAttached)),
+		},
+		"DetachFailed": {
+			client: &fake.MockVolumeAttachmentClient{MockDetach: func(context.Context, *awsec2.DetachVolumeInput, []func(*awsec2.Options)) (*awsec2.DetachVolumeOutput, error) {
+				return nil, errBoom
+			}},
+			cr:   attachment(withState(ebsv1alpha1.VolumeAttachmentStateAttached)),
+			want: awsclient.Wrap(errBoom, errDetach),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
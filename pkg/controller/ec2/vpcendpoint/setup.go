@@ -14,9 +14,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -24,12 +21,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupVPCEndpoint adds a controller that reconciles VPCEndpoint.
-func SetupVPCEndpoint(mgr ctrl.Manager, o controller.Options) error {
+func SetupVPCEndpoint(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.VPCEndpointGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{setupExternal}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -39,15 +42,11 @@ func SetupVPCEndpoint(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.VPCEndpoint{}).
-		Complete(managed.NewReconciler(mgr,
-			cpresource.ManagedKind(svcapitypes.VPCEndpointGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
+		Complete(o.NewReconciler(mgr, name, cpresource.ManagedKind(svcapitypes.VPCEndpointGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<vpc-endpoint-id>", "ec2", "vpc-endpoint"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -124,6 +123,12 @@ func postObserve(_ context.Context, cr *svcapitypes.VPCEndpoint, resp *svcsdk.De
 		cr.SetConditions(xpv1.Creating())
 	case "deleted":
 		cr.SetConditions(xpv1.Unavailable())
+	case "failed", "rejected", "expired":
+		cond := xpv1.Unavailable()
+		if le := resp.VpcEndpoints[0].LastError; le != nil {
+			cond = cond.WithMessage(awsclients.StringValue(le.Message))
+		}
+		cr.SetConditions(cond)
 	case "deleting":
 		cr.SetConditions(xpv1.Deleting())
 	}
@@ -160,6 +165,13 @@ sgCompare:
 		return false, nil
 	}
 
+	// Check private DNS, which is only applicable to Interface endpoints and
+	// defaults to true for them.
+	if cr.Spec.ForProvider.PrivateDNSEnabled != nil && isInterfaceEndpoint(cr) &&
+		aws.BoolValue(cr.Spec.ForProvider.PrivateDNSEnabled) != aws.BoolValue(obj.VpcEndpoints[0].PrivateDnsEnabled) {
+		return false, nil
+	}
+
 	// Check policyDocument
 	defaultPolicyEndpoint := aws.String("{\"Statement\":[{\"Action\":\"*\",\"Effect\": \"Allow\",\"Principal\":\"*\",\"Resource\":\"*\"}]}")
 	defaultPolicyGateway := aws.String("{\"Version\":\"2008-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":\"*\",\"Action\":\"*\",\"Resource\":\"*\"}]}")
@@ -182,6 +194,14 @@ func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.VPCEndpoint, obj
 	obj.SetAddSubnetIds(cr.Spec.ForProvider.SubnetIDs)
 	obj.SetAddRouteTableIds(cr.Spec.ForProvider.RouteTableIDs)
 	obj.SetPolicyDocument(aws.StringValue(cr.Spec.ForProvider.PolicyDocument))
+	if strings.TrimSpace(aws.StringValue(cr.Spec.ForProvider.PolicyDocument)) == "" {
+		// Without a declared policy the endpoint falls back to the default
+		// policy that allows full access to the service.
+		obj.SetResetPolicy(true)
+	}
+	if isInterfaceEndpoint(cr) {
+		obj.PrivateDnsEnabled = cr.Spec.ForProvider.PrivateDNSEnabled
+	}
 
 	// Remove fields from upstream AWS
 	upstream, err := e.client.DescribeVpcEndpoints(&svcsdk.DescribeVpcEndpointsInput{VpcEndpointIds: []*string{obj.VpcEndpointId}})
@@ -250,11 +270,22 @@ func filterList(cr *svcapitypes.VPCEndpoint, obj *svcsdk.DescribeVpcEndpointsOut
 }
 
 func generateVPCEndpointObservation(vpcEndpoint *svcsdk.VpcEndpoint) svcapitypes.VPCEndpointObservation {
-	vpcEndpointObservation := svcapitypes.VPCEndpointObservation{}
+	vpcEndpointObservation := svcapitypes.VPCEndpointObservation{
+		NetworkInterfaceIDs: vpcEndpoint.NetworkInterfaceIds,
+		OwnerID:             vpcEndpoint.OwnerId,
+		RequesterManaged:    vpcEndpoint.RequesterManaged,
+		RouteTableIDs:       vpcEndpoint.RouteTableIds,
+		State:               vpcEndpoint.State,
+		SubnetIDs:           vpcEndpoint.SubnetIds,
+		VPCEndpointID:       vpcEndpoint.VpcEndpointId,
+		VPCID:               vpcEndpoint.VpcId,
+	}
 
 	// Mapping vpcEndpoint -> vpcEndpoint_SDK
-	vpcEndpointObservation.CreationTimestamp = &v1.Time{
-		Time: *vpcEndpoint.CreationTimestamp,
+	if vpcEndpoint.CreationTimestamp != nil {
+		vpcEndpointObservation.CreationTimestamp = &v1.Time{
+			Time: *vpcEndpoint.CreationTimestamp,
+		}
 	}
 	vpcEndpointObservation.DNSEntries = []*svcapitypes.DNSEntry{}
 	for _, dnsEntry := range vpcEndpoint.DnsEntries {
@@ -264,11 +295,34 @@ func generateVPCEndpointObservation(vpcEndpoint *svcsdk.VpcEndpoint) svcapitypes
 		}
 		vpcEndpointObservation.DNSEntries = append(vpcEndpointObservation.DNSEntries, &dnsEntry)
 	}
-	vpcEndpointObservation.State = vpcEndpoint.State
+	for _, group := range vpcEndpoint.Groups {
+		vpcEndpointObservation.Groups = append(vpcEndpointObservation.Groups, &svcapitypes.SecurityGroupIdentifier{
+			GroupID:   group.GroupId,
+			GroupName: group.GroupName,
+		})
+	}
+	for _, tag := range vpcEndpoint.Tags {
+		vpcEndpointObservation.Tags = append(vpcEndpointObservation.Tags, &svcapitypes.Tag{
+			Key:   tag.Key,
+			Value: tag.Value,
+		})
+	}
+	if vpcEndpoint.LastError != nil {
+		vpcEndpointObservation.LastError = &svcapitypes.LastError{
+			Code:    vpcEndpoint.LastError.Code,
+			Message: vpcEndpoint.LastError.Message,
+		}
+	}
 
 	return vpcEndpointObservation
 }
 
+// isInterfaceEndpoint returns true if the VPCEndpoint is of type Interface.
+// Endpoints without a type are Gateway endpoints.
+func isInterfaceEndpoint(cr *svcapitypes.VPCEndpoint) bool {
+	return strings.EqualFold(aws.StringValue(cr.Spec.ForProvider.VPCEndpointType), svcsdk.VpcEndpointTypeInterface)
+}
+
 // formatModifyVpcEndpointInput takes in a ModifyVpcEndpointInput, and sets
 // fields containing an empty list to nil
 func formatModifyVpcEndpointInput(obj *svcsdk.ModifyVpcEndpointInput) {
@@ -299,10 +353,11 @@ func formatModifyVpcEndpointInput(obj *svcsdk.ModifyVpcEndpointInput) {
 // ([]*string) "base", "subtract", and returns a "result" list
 // of string pointers where "result" = "base" - "subtract".
 // Comparisons of the underlying string is done
-//  Example:
-//  "base": ["a", "b", "g", "x"]
-//  "subtract": ["b", "x", "y"]
-//  "result": ["a", "g"]
+//
+//	Example:
+//	"base": ["a", "b", "g", "x"]
+//	"subtract": ["b", "x", "y"]
+//	"result": ["a", "g"]
 func listSubtractFromStringPtr(base, subtract []*string) []*string {
 	result := []*string{}
 
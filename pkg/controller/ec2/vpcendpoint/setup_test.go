@@ -30,6 +30,11 @@ const (
 	testSubnetID1       = "subnet-id-1"
 	testSubnetID2       = "subnet-id-2"
 
+	testNetworkInterfaceID = "eni-id"
+	testDNSName            = "vpce-id.s3.us-east-1.vpce.amazonaws.com"
+	testHostedZoneID       = "zone-id"
+	testLastErrorMessage   = "endpoint service is not available"
+
 	testErrCreateVPCEndpointFailed   = "CreateVPCEndpoint failed"
 	testErrDeleteVPCEndpointFailed   = "DeleteVPCEndpoint failed"
 	testErrDescribeVPCEndpointFailed = "DescribeVPCEndpoint failed"
@@ -291,6 +296,39 @@ func TestModify(t *testing.T) {
 				),
 			},
 		},
+		"SuccessfulModify_InterfaceEndpoint": {
+			args: args{
+				vpcendpoint: &fake.MockVPCEndpointClient{
+					MockModifyVpcEndpointWithContext: func(ctx context.Context, input *ec2.ModifyVpcEndpointInput, req ...request.Option) (*ec2.ModifyVpcEndpointOutput, error) {
+						if diff := cmp.Diff(true, aws.BoolValue(input.ResetPolicy)); diff != "" {
+							t.Errorf("ResetPolicy: -want, +got:\n%s", diff)
+						}
+						if diff := cmp.Diff(false, aws.BoolValue(input.PrivateDnsEnabled)); diff != "" {
+							t.Errorf("PrivateDnsEnabled: -want, +got:\n%s", diff)
+						}
+						return &ec2.ModifyVpcEndpointOutput{}, nil
+					},
+					MockDescribeVpcEndpoints: func(*ec2.DescribeVpcEndpointsInput) (*ec2.DescribeVpcEndpointsOutput, error) {
+						return &ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{{VpcEndpointId: aws.String(testVPCEndpointID)}},
+						}, nil
+					},
+				},
+				cr: vpcEndpoint(withSpec(v1alpha1.VPCEndpointParameters{
+					VPCEndpointType:   aws.String("Interface"),
+					PrivateDNSEnabled: aws.Bool(false),
+				})),
+			},
+			want: want{
+				cr: vpcEndpoint(
+					withSpec(v1alpha1.VPCEndpointParameters{
+						VPCEndpointType:   aws.String("Interface"),
+						PrivateDNSEnabled: aws.Bool(false),
+					}),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
 		"ErrModify_DescribeEndpointOutput": {
 			args: args{
 				vpcendpoint: &fake.MockVPCEndpointClient{
@@ -408,6 +446,7 @@ func TestObserve(t *testing.T) {
 					withStatusAtProvider(v1alpha1.VPCEndpointObservation{
 						CreationTimestamp: &v1.Time{},
 						DNSEntries:        []*v1alpha1.DNSEntry{},
+						VPCEndpointID:     aws.String(testVPCEndpointID),
 					}),
 				),
 				result: managed.ExternalObservation{
@@ -452,6 +491,7 @@ func TestObserve(t *testing.T) {
 					withStatusAtProvider(v1alpha1.VPCEndpointObservation{
 						CreationTimestamp: &v1.Time{},
 						DNSEntries:        []*v1alpha1.DNSEntry{},
+						VPCEndpointID:     aws.String(testVPCEndpointID),
 						State:             aws.String("deleting"),
 					}),
 				),
@@ -497,6 +537,7 @@ func TestObserve(t *testing.T) {
 					withStatusAtProvider(v1alpha1.VPCEndpointObservation{
 						CreationTimestamp: &v1.Time{},
 						DNSEntries:        []*v1alpha1.DNSEntry{},
+						VPCEndpointID:     aws.String(testVPCEndpointID),
 						State:             aws.String("available"),
 					}),
 				),
@@ -505,6 +546,107 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"InterfaceEndpoint_with_NetworkInterfaces": {
+			args: args{
+				vpcendpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpointsWithContext: func(context.Context, *ec2.DescribeVpcEndpointsInput, ...request.Option) (*ec2.DescribeVpcEndpointsOutput, error) {
+						return &ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId:       aws.String(testVPCEndpointID),
+									VpcId:               aws.String(testVPCID),
+									CreationTimestamp:   &time.Time{},
+									State:               aws.String("available"),
+									PrivateDnsEnabled:   aws.Bool(false),
+									SubnetIds:           []*string{aws.String(testSubnetID1)},
+									NetworkInterfaceIds: []*string{aws.String(testNetworkInterfaceID)},
+									Groups:              []*ec2.SecurityGroupIdentifier{{GroupId: aws.String(testSecurityGroupID)}},
+									DnsEntries:          []*ec2.DnsEntry{{DnsName: aws.String(testDNSName), HostedZoneId: aws.String(testHostedZoneID)}},
+								},
+							},
+						}, nil
+					},
+				},
+				cr: vpcEndpoint(
+					withExternalName(testVPCEndpointID),
+					withSpec(v1alpha1.VPCEndpointParameters{
+						VPCEndpointType:   aws.String("Interface"),
+						PrivateDNSEnabled: aws.Bool(true),
+						CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+							VPCID:            aws.String(testVPCID),
+							SubnetIDs:        []*string{aws.String(testSubnetID1)},
+							SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+						},
+					}),
+				),
+			},
+			want: want{
+				cr: vpcEndpoint(
+					withExternalName(testVPCEndpointID),
+					withSpec(v1alpha1.VPCEndpointParameters{
+						VPCEndpointType:   aws.String("Interface"),
+						PrivateDNSEnabled: aws.Bool(true),
+						CustomVPCEndpointParameters: v1alpha1.CustomVPCEndpointParameters{
+							VPCID:            aws.String(testVPCID),
+							SubnetIDs:        []*string{aws.String(testSubnetID1)},
+							SecurityGroupIDs: []*string{aws.String(testSecurityGroupID)},
+						},
+					}),
+					withConditions(xpv1.Available()),
+					withStatusAtProvider(v1alpha1.VPCEndpointObservation{
+						CreationTimestamp:   &v1.Time{},
+						DNSEntries:          []*v1alpha1.DNSEntry{{DNSName: aws.String(testDNSName), HostedZoneID: aws.String(testHostedZoneID)}},
+						Groups:              []*v1alpha1.SecurityGroupIdentifier{{GroupID: aws.String(testSecurityGroupID)}},
+						NetworkInterfaceIDs: []*string{aws.String(testNetworkInterfaceID)},
+						State:               aws.String("available"),
+						SubnetIDs:           []*string{aws.String(testSubnetID1)},
+						VPCEndpointID:       aws.String(testVPCEndpointID),
+						VPCID:               aws.String(testVPCID),
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(testDNSName),
+					},
+				},
+			},
+		},
+		"FailedState": {
+			args: args{
+				vpcendpoint: &fake.MockVPCEndpointClient{
+					MockDescribeVpcEndpointsWithContext: func(context.Context, *ec2.DescribeVpcEndpointsInput, ...request.Option) (*ec2.DescribeVpcEndpointsOutput, error) {
+						return &ec2.DescribeVpcEndpointsOutput{
+							VpcEndpoints: []*ec2.VpcEndpoint{
+								{
+									VpcEndpointId:     aws.String(testVPCEndpointID),
+									CreationTimestamp: &time.Time{},
+									State:             aws.String("failed"),
+									LastError:         &ec2.LastError{Code: aws.String("Failed"), Message: aws.String(testLastErrorMessage)},
+								},
+							},
+						}, nil
+					},
+				},
+				cr: vpcEndpoint(withExternalName(testVPCEndpointID)),
+			},
+			want: want{
+				cr: vpcEndpoint(
+					withExternalName(testVPCEndpointID),
+					withConditions(xpv1.Unavailable().WithMessage(testLastErrorMessage)),
+					withStatusAtProvider(v1alpha1.VPCEndpointObservation{
+						CreationTimestamp: &v1.Time{},
+						DNSEntries:        []*v1alpha1.DNSEntry{},
+						LastError:         &v1alpha1.LastError{Code: aws.String("Failed"), Message: aws.String(testLastErrorMessage)},
+						State:             aws.String("failed"),
+						VPCEndpointID:     aws.String(testVPCEndpointID),
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
 		"ErrInDescribing": {
 			args: args{
 				vpcendpoint: &fake.MockVPCEndpointClient{
@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,8 +35,12 @@ import (
 	"github.com/crossplane/provider-aws/apis/ecr/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
 	"github.com/crossplane/provider-aws/pkg/clients/ecr"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -61,8 +62,11 @@ const (
 )
 
 // SetupRepository adds a controller that reconciles ECR.
-func SetupRepository(mgr ctrl.Manager, o controller.Options) error {
+func SetupRepository(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.RepositoryGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -71,22 +75,18 @@ func SetupRepository(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Repository{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.RepositoryGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.RepositoryGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<repository-name>", "ecr", "repository"), &connector{kube: mgr.GetClient(), caps: capability.NewDetector()}),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
 type connector struct {
 	kube client.Client
+	caps *capability.Detector
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -98,12 +98,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: awsecr.NewFromConfig(*cfg), kube: c.kube}, nil
+	return &external{client: awsecr.NewFromConfig(*cfg), kube: c.kube, caps: c.caps}, nil
 }
 
 type external struct {
 	kube   client.Client
 	client ecr.RepositoryClient
+	caps   *capability.Detector
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -131,9 +132,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	observed := response.Repositories[0]
-	tagsResp, err := e.client.ListTagsForResource(ctx, &awsecr.ListTagsForResourceInput{
-		ResourceArn: observed.RepositoryArn,
-	})
+	tags, err := e.observeTags(ctx, cr, observed.RepositoryArn)
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ecr.IsRepoNotFoundErr, err), errListTags)
 	}
@@ -147,15 +146,34 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 
 	cr.SetConditions(xpv1.Available())
+	e.caps.SetConditions(cr, capability.Tagging)
 
 	cr.Status.AtProvider = ecr.GenerateRepositoryObservation(observed)
 
 	return managed.ExternalObservation{
 		ResourceExists:   true,
-		ResourceUpToDate: ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tagsResp.Tags, &observed),
+		ResourceUpToDate: ecr.IsRepositoryUpToDate(&cr.Spec.ForProvider, tags, &observed),
 	}, nil
 }
 
+// observeTags returns the tags of the repository. The desired tags are
+// returned if the provider isn't permitted to list them, so that skipped
+// tags are not reported as drift.
+func (e *external) observeTags(ctx context.Context, cr *v1beta1.Repository, arn *string) ([]awsecrtypes.Tag, error) {
+	if !e.caps.Available(cr, capability.Tagging) {
+		desired, _ := ecr.DiffTags(cr.Spec.ForProvider.Tags, nil)
+		return desired, nil
+	}
+	resp, err := e.client.ListTagsForResource(ctx, &awsecr.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+	if err != nil {
+		desired, _ := ecr.DiffTags(cr.Spec.ForProvider.Tags, nil)
+		return desired, e.caps.Check(cr, capability.Tagging, err)
+	}
+	return resp.Tags, nil
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mgd.(*v1beta1.Repository)
 	if !ok {
@@ -180,9 +198,10 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	err := e.updateTags(ctx, cr)
-	if err != nil {
-		return managed.ExternalUpdate{}, err
+	if e.caps.Available(cr, capability.Tagging) {
+		if err := e.updateTags(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 	}
 
 	response, err := e.client.DescribeRepositories(ctx, &awsecr.DescribeRepositoriesInput{
@@ -269,20 +288,22 @@ func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
 	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
 }
 
+// updateTags updates the tags of the repository. Access denied errors skip
+// tagging rather than failing the update.
 func (e *external) updateTags(ctx context.Context, repo *v1beta1.Repository) error {
 	resp, err := e.client.ListTagsForResource(ctx, &awsecr.ListTagsForResourceInput{ResourceArn: &repo.Status.AtProvider.RepositoryArn})
 	if err != nil {
-		return awsclient.Wrap(err, errListTags)
+		return awsclient.Wrap(e.caps.Check(repo, capability.Tagging, err), errListTags)
 	}
 	add, remove := ecr.DiffTags(repo.Spec.ForProvider.Tags, resp.Tags)
 	if len(remove) != 0 {
 		if _, err := e.client.UntagResource(ctx, &awsecr.UntagResourceInput{ResourceArn: &repo.Status.AtProvider.RepositoryArn, TagKeys: remove}); err != nil {
-			return awsclient.Wrap(err, errRemoveTags)
+			return awsclient.Wrap(e.caps.Check(repo, capability.Tagging, err), errRemoveTags)
 		}
 	}
 	if len(add) != 0 {
 		if _, err := e.client.TagResource(ctx, &awsecr.TagResourceInput{ResourceArn: &repo.Status.AtProvider.RepositoryArn, Tags: add}); err != nil {
-			return awsclient.Wrap(err, errCreateTags)
+			return awsclient.Wrap(e.caps.Check(repo, capability.Tagging, err), errCreateTags)
 		}
 	}
 	return nil
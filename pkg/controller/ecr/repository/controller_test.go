@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	awsecrtypes "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/smithy-go"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
@@ -39,6 +40,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
 	ecr "github.com/crossplane/provider-aws/pkg/clients/ecr"
 	"github.com/crossplane/provider-aws/pkg/clients/ecr/fake"
 )
@@ -51,6 +53,7 @@ var (
 	testECRTag          = awsecrtypes.Tag{Key: &tagKey, Value: &tagValue}
 	testTag             = v1beta1.Tag{Key: "test", Value: "value"}
 	errBoom             = errors.New("boom")
+	errDenied           = &smithy.GenericAPIError{Code: "AccessDeniedException"}
 	imageScanConfigTrue = v1beta1.ImageScanningConfiguration{
 		ScanOnPush: true,
 	}
@@ -237,11 +240,49 @@ func TestObserve(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errListTags),
 			},
 		},
+		"ListTagsDenied": {
+			args: args{
+				kube: &test.MockClient{
+					MockUpdate: test.NewMockClient().Update,
+				},
+				repository: &fake.MockRepositoryClient{
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:      &testARN,
+								RepositoryName:     &repoName,
+								ImageTagMutability: awsecrtypes.ImageTagMutabilityMutable,
+							}},
+						}, nil
+					},
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return nil, errDenied
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					Tags: []v1beta1.Tag{testTag},
+				}), withExternalName(repoName)),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					ImageTagMutability: aws.String(string(awsecrtypes.ImageTagMutabilityMutable)),
+					Tags:               []v1beta1.Tag{testTag},
+				}), withStatus(v1beta1.RepositoryObservation{
+					RepositoryName: repoName,
+					RepositoryArn:  testARN,
+				}), withExternalName(repoName),
+					withConditions(xpv1.Available(), capability.Degraded(capability.Tagging))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.repository}
+			e := &external{kube: tc.kube, client: tc.repository, caps: capability.NewDetector()}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -313,7 +354,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.repository}
+			e := &external{kube: tc.kube, client: tc.repository, caps: capability.NewDetector()}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -416,6 +457,34 @@ func TestUpdate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreateTags),
 			},
 		},
+		"TaggingDenied": {
+			args: args{
+				repository: &fake.MockRepositoryClient{
+					MockTag: func(ctx context.Context, input *awsecr.TagResourceInput, opts []func(*awsecr.Options)) (*awsecr.TagResourceOutput, error) {
+						return nil, errDenied
+					},
+					MockListTags: func(ctx context.Context, input *awsecr.ListTagsForResourceInput, opts []func(*awsecr.Options)) (*awsecr.ListTagsForResourceOutput, error) {
+						return &awsecr.ListTagsForResourceOutput{}, nil
+					},
+					MockDescribe: func(ctx context.Context, input *awsecr.DescribeRepositoriesInput, opts []func(*awsecr.Options)) (*awsecr.DescribeRepositoriesOutput, error) {
+						return &awsecr.DescribeRepositoriesOutput{
+							Repositories: []awsecrtypes.Repository{{
+								RepositoryArn:  &testARN,
+								RepositoryName: &repoName,
+							}},
+						}, nil
+					},
+				},
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					Tags: []v1beta1.Tag{testTag},
+				})),
+			},
+			want: want{
+				cr: repository(withSpec(v1beta1.RepositoryParameters{
+					Tags: []v1beta1.Tag{testTag},
+				})),
+			},
+		},
 		"SuccessfulImageMutate": {
 			args: args{
 				repository: &fake.MockRepositoryClient{
@@ -538,7 +607,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.repository}
+			e := &external{kube: tc.kube, client: tc.repository, caps: capability.NewDetector()}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -616,7 +685,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.repository}
+			e := &external{kube: tc.kube, client: tc.repository, caps: capability.NewDetector()}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -21,14 +21,12 @@ import (
 
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/hashicorp/go-version"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -36,7 +34,10 @@ import (
 	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -44,11 +45,17 @@ const (
 	errKubeUpdateFailed = "cannot update EKS cluster custom resource"
 	errTagResource      = "cannot tag resource"
 	errUntagResource    = "cannot untag resource"
+	errDescribeCluster  = "cannot describe EKS cluster of the add-on"
+	errDescribeVersions = "cannot describe add-on versions"
+	errNoVersion        = "no add-on version is compatible with the Kubernetes version of the cluster"
 )
 
 // SetupAddon adds a controller that reconciles Clusters.
-func SetupAddon(mgr ctrl.Manager, o controller.Options) error {
+func SetupAddon(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(eksv1alpha1.AddonGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		setupHooks,
 	}
@@ -60,28 +67,23 @@ func SetupAddon(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&eksv1alpha1.Addon{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(eksv1alpha1.AddonGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(eksv1alpha1.AddonGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.ARN("<addon-arn>", "eks"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
 func setupHooks(e *external) {
+	h := &hooks{client: e.client, kube: e.kube}
 	e.preObserve = preObserve
-	e.postObserve = postObserve
+	e.postObserve = h.postObserve
 	e.lateInitialize = lateInitialize
-	h := &hooks{client: e.client, kube: e.kube}
 	e.isUpToDate = h.isUpToDate
-	e.preUpdate = preUpdate
+	e.preUpdate = h.preUpdate
 	e.postUpdate = h.postUpdate
-	e.preCreate = preCreate
+	e.preCreate = h.preCreate
 	e.postCreate = postCreate
 	e.preDelete = preDelete
 }
@@ -96,11 +98,22 @@ func preObserve(_ context.Context, cr *eksv1alpha1.Addon, obj *awseks.DescribeAd
 	return nil
 }
 
-func postObserve(_ context.Context, cr *eksv1alpha1.Addon, _ *awseks.DescribeAddonOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (h *hooks) postObserve(ctx context.Context, cr *eksv1alpha1.Addon, resp *awseks.DescribeAddonOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
+	// An add-on tracking the latest version is outdated as soon as a newer
+	// version becomes compatible with its cluster.
+	if obs.ResourceUpToDate && awsclients.StringValue(cr.Spec.ForProvider.AddonVersion) == eksv1alpha1.AddonVersionLatest &&
+		awsclients.StringValue(cr.Status.AtProvider.Status) == awseks.AddonStatusActive && resp.Addon != nil {
+		latest, err := h.latestVersion(ctx, cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		obs.ResourceUpToDate = latest == awsclients.StringValue(resp.Addon.AddonVersion)
+	}
+
 	switch awsclients.StringValue(cr.Status.AtProvider.Status) {
 	case awseks.AddonStatusCreating:
 		cr.SetConditions(xpv1.Creating())
@@ -128,7 +141,8 @@ func lateInitialize(spec *eksv1alpha1.AddonParameters, resp *awseks.DescribeAddo
 func (h *hooks) isUpToDate(cr *eksv1alpha1.Addon, resp *awseks.DescribeAddonOutput) (bool, error) {
 	switch {
 	case resp.Addon == nil,
-		cr.Spec.ForProvider.AddonVersion != nil && awsclients.StringValue(cr.Spec.ForProvider.AddonVersion) != awsclients.StringValue(resp.Addon.AddonVersion),
+		cr.Spec.ForProvider.AddonVersion != nil && awsclients.StringValue(cr.Spec.ForProvider.AddonVersion) != eksv1alpha1.AddonVersionLatest &&
+			awsclients.StringValue(cr.Spec.ForProvider.AddonVersion) != awsclients.StringValue(resp.Addon.AddonVersion),
 		cr.Spec.ForProvider.ServiceAccountRoleARN != nil && awsclients.StringValue(cr.Spec.ForProvider.ServiceAccountRoleARN) != awsclients.StringValue(resp.Addon.ServiceAccountRoleArn):
 		return false, nil
 	}
@@ -137,9 +151,15 @@ func (h *hooks) isUpToDate(cr *eksv1alpha1.Addon, resp *awseks.DescribeAddonOutp
 	return len(add) == 0 && len(remove) == 0, nil
 }
 
-func preUpdate(_ context.Context, cr *eksv1alpha1.Addon, obj *awseks.UpdateAddonInput) error {
+func (h *hooks) preUpdate(ctx context.Context, cr *eksv1alpha1.Addon, obj *awseks.UpdateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
-	return nil
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
+	if awsclients.StringValue(obj.AddonVersion) != eksv1alpha1.AddonVersionLatest {
+		return nil
+	}
+	latest, err := h.latestVersion(ctx, cr)
+	obj.AddonVersion = &latest
+	return err
 }
 
 func (h *hooks) postUpdate(ctx context.Context, cr *eksv1alpha1.Addon, resp *awseks.UpdateAddonOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
@@ -179,9 +199,66 @@ func (h *hooks) postUpdate(ctx context.Context, cr *eksv1alpha1.Addon, resp *aws
 	return managed.ExternalUpdate{}, nil
 }
 
-func preCreate(_ context.Context, cr *eksv1alpha1.Addon, obj *awseks.CreateAddonInput) error {
+func (h *hooks) preCreate(ctx context.Context, cr *eksv1alpha1.Addon, obj *awseks.CreateAddonInput) error {
 	obj.ClusterName = cr.Spec.ForProvider.ClusterName
-	return nil
+	obj.ServiceAccountRoleArn = cr.Spec.ForProvider.ServiceAccountRoleARN
+	if awsclients.StringValue(obj.AddonVersion) != eksv1alpha1.AddonVersionLatest {
+		return nil
+	}
+	latest, err := h.latestVersion(ctx, cr)
+	obj.AddonVersion = &latest
+	return err
+}
+
+// latestVersion returns the newest version of the add-on that is compatible
+// with the Kubernetes version of its cluster.
+func (h *hooks) latestVersion(ctx context.Context, cr *eksv1alpha1.Addon) (string, error) {
+	cluster, err := h.client.DescribeClusterWithContext(ctx, &awseks.DescribeClusterInput{Name: cr.Spec.ForProvider.ClusterName})
+	if err != nil {
+		return "", awsclients.Wrap(err, errDescribeCluster)
+	}
+	var kubernetesVersion *string
+	if cluster.Cluster != nil {
+		kubernetesVersion = cluster.Cluster.Version
+	}
+	var candidates []string
+	err = h.client.DescribeAddonVersionsPagesWithContext(ctx, &awseks.DescribeAddonVersionsInput{
+		AddonName:         cr.Spec.ForProvider.AddonName,
+		KubernetesVersion: kubernetesVersion,
+	}, func(page *awseks.DescribeAddonVersionsOutput, _ bool) bool {
+		for _, a := range page.Addons {
+			for _, v := range a.AddonVersions {
+				candidates = append(candidates, awsclients.StringValue(v.AddonVersion))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", awsclients.Wrap(err, errDescribeVersions)
+	}
+	latest := newestVersion(candidates)
+	if latest == "" {
+		return "", errors.New(errNoVersion)
+	}
+	return latest, nil
+}
+
+// newestVersion returns the highest of the supplied add-on versions, e.g.
+// v1.10.1-eksbuild.2 over v1.10.1-eksbuild.1. Versions that cannot be parsed
+// are ignored.
+func newestVersion(vs []string) string {
+	var newest *version.Version
+	var raw string
+	for _, v := range vs {
+		p, err := version.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if newest == nil || p.GreaterThan(newest) {
+			newest, raw = p, v
+		}
+	}
+	return raw
 }
 
 func postCreate(_ context.Context, cr *eksv1alpha1.Addon, res *awseks.CreateAddonOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
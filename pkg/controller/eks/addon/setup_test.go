@@ -24,6 +24,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -48,8 +49,30 @@ var (
 	testOtherTagKey           = "test-other-key"
 	testOtherTagValue         = "test-other-value"
 	errBoom                   = errors.New("boom")
+
+	testLatest            = v1alpha1.AddonVersionLatest
+	testKubernetesVersion = "1.21"
+	testNewerAddonVersion = "v0.0.1-eksbuild.2"
 )
 
+// expectLatestVersion makes the mock resolve the versions of the test add-on
+// to the supplied ones.
+func expectLatestVersion(me *mockeksiface.MockEKSAPI, versions ...string) {
+	me.EXPECT().
+		DescribeClusterWithContext(context.Background(), &awseks.DescribeClusterInput{Name: &testClusterName}).
+		Return(&awseks.DescribeClusterOutput{Cluster: &awseks.Cluster{Version: &testKubernetesVersion}}, nil)
+	page := &awseks.DescribeAddonVersionsOutput{Addons: []*awseks.AddonInfo{{AddonName: &testAddonName}}}
+	for i := range versions {
+		page.Addons[0].AddonVersions = append(page.Addons[0].AddonVersions, &awseks.AddonVersionInfo{AddonVersion: &versions[i]})
+	}
+	me.EXPECT().
+		DescribeAddonVersionsPagesWithContext(context.Background(), &awseks.DescribeAddonVersionsInput{AddonName: &testAddonName, KubernetesVersion: &testKubernetesVersion}, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *awseks.DescribeAddonVersionsInput, fn func(*awseks.DescribeAddonVersionsOutput, bool) bool, _ ...request.Option) error {
+			fn(page, true)
+			return nil
+		})
+}
+
 type mockClientFn func(t *testing.T) *mockeksiface.MockEKSAPI
 
 type args struct {
@@ -184,7 +207,9 @@ func TestObserve(t *testing.T) {
 					withConditions(xpv1.Available()),
 					withSpec(
 						v1alpha1.AddonParameters{
-							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							CustomAddonParameters: v1alpha1.CustomAddonParameters{
+								ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							},
 						},
 					),
 					withStatus(v1alpha1.AddonObservation{
@@ -198,6 +223,94 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"LatestVersionOutdated": {
+			args: args{
+				eks: mockClient(func(me *mockeksiface.MockEKSAPI) {
+					me.EXPECT().
+						DescribeAddonWithContext(
+							context.Background(),
+							&awseks.DescribeAddonInput{AddonName: &testAddonName, ClusterName: &testClusterName},
+						).
+						Return(&awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &testAddonVersion,
+								Status:       awsclient.String(awseks.AddonStatusActive),
+							},
+						}, nil)
+					expectLatestVersion(me, testAddonVersion, testNewerAddonVersion)
+				}),
+				cr: addon(
+					withExternalName(testExternalName),
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+				),
+			},
+			want: want{
+				cr: addon(
+					withExternalName(testExternalName),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+					withStatus(v1alpha1.AddonObservation{
+						Status: awsclient.String(awseks.AddonStatusActive),
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"LatestVersionUpToDate": {
+			args: args{
+				eks: mockClient(func(me *mockeksiface.MockEKSAPI) {
+					me.EXPECT().
+						DescribeAddonWithContext(
+							context.Background(),
+							&awseks.DescribeAddonInput{AddonName: &testAddonName, ClusterName: &testClusterName},
+						).
+						Return(&awseks.DescribeAddonOutput{
+							Addon: &awseks.Addon{
+								AddonVersion: &testNewerAddonVersion,
+								Status:       awsclient.String(awseks.AddonStatusActive),
+							},
+						}, nil)
+					expectLatestVersion(me, testAddonVersion, testNewerAddonVersion)
+				}),
+				cr: addon(
+					withExternalName(testExternalName),
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+				),
+			},
+			want: want{
+				cr: addon(
+					withExternalName(testExternalName),
+					withConditions(xpv1.Available()),
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+					withStatus(v1alpha1.AddonObservation{
+						Status: awsclient.String(awseks.AddonStatusActive),
+					}),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -254,12 +367,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -268,12 +381,12 @@ func TestCreate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 					withStatus(
@@ -302,12 +415,12 @@ func TestCreate(t *testing.T) {
 				}),
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -315,12 +428,12 @@ func TestCreate(t *testing.T) {
 			want: want{
 				cr: addon(
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 					withConditions(xpv1.Creating()),
@@ -328,6 +441,75 @@ func TestCreate(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errCreate),
 			},
 		},
+		"SuccessfulLatestVersion": {
+			args: args{
+				eks: mockClient(func(me *mockeksiface.MockEKSAPI) {
+					expectLatestVersion(me, testNewerAddonVersion, testAddonVersion, "not-a-version")
+					me.EXPECT().
+						CreateAddonWithContext(
+							context.Background(),
+							&awseks.CreateAddonInput{
+								AddonName:    &testAddonName,
+								AddonVersion: &testNewerAddonVersion,
+								ClusterName:  &testClusterName,
+							},
+						).
+						Return(&awseks.CreateAddonOutput{
+							Addon: &awseks.Addon{
+								AddonArn:     &testExternalName,
+								AddonName:    &testAddonName,
+								AddonVersion: &testNewerAddonVersion,
+							},
+						}, nil)
+				}),
+				cr: addon(
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+				),
+			},
+			want: want{
+				cr: addon(
+					withExternalName(testExternalName),
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testNewerAddonVersion,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+					withStatus(v1alpha1.AddonObservation{AddonARN: &testExternalName}),
+					withConditions(xpv1.Creating()),
+				),
+			},
+		},
+		"FailedLatestVersion": {
+			args: args{
+				eks: mockClient(func(me *mockeksiface.MockEKSAPI) {
+					me.EXPECT().
+						DescribeClusterWithContext(context.Background(), &awseks.DescribeClusterInput{Name: &testClusterName}).
+						Return(nil, errBoom)
+				}),
+				cr: addon(
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+				),
+			},
+			want: want{
+				cr: addon(
+					withSpec(v1alpha1.AddonParameters{
+						AddonName:             &testAddonName,
+						AddonVersion:          &testLatest,
+						CustomAddonParameters: v1alpha1.CustomAddonParameters{ClusterName: &testClusterName},
+					}),
+					withConditions(xpv1.Creating()),
+				),
+				err: errors.Wrap(awsclient.Wrap(errBoom, errDescribeCluster), "pre-create failed"),
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -411,12 +593,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -431,12 +613,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -468,12 +650,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -482,12 +664,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -522,12 +704,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -536,12 +718,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 					}),
 				),
@@ -592,12 +774,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -612,12 +794,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -682,12 +864,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -702,12 +884,12 @@ func TestUpdate(t *testing.T) {
 				cr: addon(
 					withExternalName(testExternalName),
 					withSpec(v1alpha1.AddonParameters{
-						AddonName:             &testAddonName,
-						AddonVersion:          &testAddonVersion,
-						ResolveConflicts:      &testResolveConflict,
-						ServiceAccountRoleARN: &testServiceAccountRoleArn,
+						AddonName:        &testAddonName,
+						AddonVersion:     &testAddonVersion,
+						ResolveConflicts: &testResolveConflict,
 						CustomAddonParameters: v1alpha1.CustomAddonParameters{
-							ClusterName: &testClusterName,
+							ServiceAccountRoleARN: &testServiceAccountRoleArn,
+							ClusterName:           &testClusterName,
 						},
 						Tags: map[string]*string{
 							testTagKey: &testTagValue,
@@ -835,3 +1017,32 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestNewestVersion(t *testing.T) {
+	cases := map[string]struct {
+		versions []string
+		want     string
+	}{
+		"Empty": {},
+		"HighestPatch": {
+			versions: []string{"v1.9.3-eksbuild.1", "v1.10.1-eksbuild.1", "v1.10.0-eksbuild.3"},
+			want:     "v1.10.1-eksbuild.1",
+		},
+		"HighestBuild": {
+			versions: []string{"v1.10.1-eksbuild.1", "v1.10.1-eksbuild.2"},
+			want:     "v1.10.1-eksbuild.2",
+		},
+		"IgnoresInvalid": {
+			versions: []string{"latest", "v1.8.0-eksbuild.1"},
+			want:     "v1.8.0-eksbuild.1",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, newestVersion(tc.versions)); diff != "" {
+				t.Errorf("newestVersion(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
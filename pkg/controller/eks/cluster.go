@@ -27,18 +27,19 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -53,11 +54,15 @@ const (
 	errDescribeFailed      = "cannot describe EKS cluster"
 	errPatchCreationFailed = "cannot create a patch object"
 	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errListNodeGroups      = "cannot list node groups of EKS cluster"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
-func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.ClusterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -66,16 +71,11 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient},
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -123,6 +123,7 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.AtProvider = eks.GenerateObservation(rsp.Cluster)
+	eks.TransitionalClusterStates.Hint(ctx, string(cr.Status.AtProvider.Status))
 	switch cr.Status.AtProvider.Status { //nolint:exhaustive
 	case v1beta1.ClusterStatusActive:
 		cr.Status.SetConditions(xpv1.Available())
@@ -154,7 +155,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status == v1beta1.ClusterStatusCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	_, err := e.client.CreateCluster(ctx, eks.GenerateCreateClusterInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	err := iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		_, err := e.client.CreateCluster(ctx, eks.GenerateCreateClusterInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+		return err
+	})
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
 }
 
@@ -190,6 +194,9 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPatchCreationFailed)
 	}
 	if patch.Version != nil {
+		if err := e.validateVersionUpgrade(ctx, cr, aws.ToString(rsp.Cluster.Version), aws.ToString(patch.Version)); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 		_, err := e.client.UpdateClusterVersion(ctx, &awseks.UpdateClusterVersionInput{Name: awsclient.String(meta.GetExternalName(cr)), Version: patch.Version})
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateVersionFailed)
 	}
@@ -197,6 +204,28 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(eks.IsErrorInUse, err), errUpdateConfigFailed)
 }
 
+// validateVersionUpgrade checks the Kubernetes version skew between the
+// desired control plane version and the NodeGroups of the cluster before the
+// upgrade is requested, rather than letting EKS reject it.
+func (e *external) validateVersionUpgrade(ctx context.Context, cr *v1beta1.Cluster, current, desired string) error {
+	if current == "" {
+		return nil
+	}
+	l := &manualv1alpha1.NodeGroupList{}
+	if err := e.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListNodeGroups)
+	}
+	nodeGroups := map[string]string{}
+	for _, ng := range l.Items {
+		p := ng.Spec.ForProvider
+		if p.ClusterName != meta.GetExternalName(cr) || p.Region != aws.ToString(cr.Spec.ForProvider.Region) || p.Version == nil {
+			continue
+		}
+		nodeGroups[ng.GetName()] = aws.ToString(p.Version)
+	}
+	return eks.ValidateVersionUpgrade(current, desired, nodeGroups)
+}
+
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1beta1.Cluster)
 	if !ok {
@@ -27,10 +27,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
+	"github.com/crossplane/provider-aws/apis/eks/manualv1alpha1"
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
@@ -38,7 +40,8 @@ import (
 )
 
 var (
-	version = "1.16"
+	version     = "1.16"
+	prevVersion = "1.15"
 
 	errBoom = errors.New("boom")
 )
@@ -77,6 +80,25 @@ func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
 
+func withExternalName(n string) clusterModifier {
+	return func(r *v1beta1.Cluster) { meta.SetExternalName(r, n) }
+}
+
+func nodeGroup(name, clusterName, version string) manualv1alpha1.NodeGroup {
+	ng := manualv1alpha1.NodeGroup{}
+	ng.SetName(name)
+	ng.Spec.ForProvider.ClusterName = clusterName
+	ng.Spec.ForProvider.Version = &version
+	return ng
+}
+
+func listNodeGroups(ngs ...manualv1alpha1.NodeGroup) test.MockListFn {
+	return func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+		list.(*manualv1alpha1.NodeGroupList).Items = ngs
+		return nil
+	}
+}
+
 func cluster(m ...clusterModifier) *v1beta1.Cluster {
 	cr := &v1beta1.Cluster{}
 	for _, f := range m {
@@ -414,6 +436,70 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withVersion(&version)),
 			},
 		},
+		"SuccessfulUpdateVersionWithinSkew": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: listNodeGroups(
+						nodeGroup("current", "cluster", prevVersion),
+						nodeGroup("other-cluster", "other", "1.13"),
+					),
+				},
+				eks: &fake.MockClient{
+					MockUpdateClusterVersion: func(ctx context.Context, input *awseks.UpdateClusterVersionInput, opts []func(*awseks.Options)) (*awseks.UpdateClusterVersionOutput, error) {
+						return &awseks.UpdateClusterVersionOutput{}, nil
+					},
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{Version: &prevVersion},
+						}, nil
+					},
+				},
+				cr: cluster(withExternalName("cluster"), withVersion(&version)),
+			},
+			want: want{
+				cr: cluster(withExternalName("cluster"), withVersion(&version)),
+			},
+		},
+		"FailedUpdateVersionSkew": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: listNodeGroups(
+						nodeGroup("outdated", "cluster", "1.14"),
+					),
+				},
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{Version: &prevVersion},
+						}, nil
+					},
+				},
+				cr: cluster(withExternalName("cluster"), withVersion(&version)),
+			},
+			want: want{
+				cr:  cluster(withExternalName("cluster"), withVersion(&version)),
+				err: errors.Errorf("cannot upgrade EKS cluster to Kubernetes version %s: node groups %s would be more than %d minor version behind the control plane; upgrade them first", version, "outdated", eks.MaxNodeGroupVersionSkew),
+			},
+		},
+		"FailedListNodeGroups": {
+			args: args{
+				kube: &test.MockClient{
+					MockList: test.NewMockListFn(errBoom),
+				},
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{Version: &prevVersion},
+						}, nil
+					},
+				},
+				cr: cluster(withVersion(&version)),
+			},
+			want: want{
+				cr:  cluster(withVersion(&version)),
+				err: errors.Wrap(errBoom, errListNodeGroups),
+			},
+		},
 		"SuccessfulUpdateCluster": {
 			args: args{
 				eks: &fake.MockClient{
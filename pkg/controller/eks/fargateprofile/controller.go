@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,7 +35,10 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/eks"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -48,11 +48,16 @@ const (
 	errAddTagsFailed        = "cannot add tags to EKS fargate profile"
 	errDeleteFailed         = "cannot delete EKS fargate profile"
 	errDescribeFailed       = "cannot describe EKS fargate profile"
+	errListFailed           = "cannot list EKS fargate profiles of the cluster"
+	errFmtWaitingForCluster = "cannot delete EKS fargate profile while fargate profile %q of the same cluster is being created or deleted"
 )
 
 // SetupFargateProfile adds a controller that reconciles FargateProfiles.
-func SetupFargateProfile(mgr ctrl.Manager, o controller.Options) error {
+func SetupFargateProfile(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.FargateProfileKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -61,16 +66,11 @@ func SetupFargateProfile(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.FargateProfile{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.FargateProfileGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.FargateProfileGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newEKSClientFn: eks.NewEKSClient},
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -103,6 +103,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	rsp, err := e.client.DescribeFargateProfile(ctx, &awseks.DescribeFargateProfileInput{FargateProfileName: aws.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
+	if eks.IsErrorNotFound(err) && !meta.WasDeleted(cr) {
+		return e.queue(ctx, cr)
+	}
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDescribeFailed)
 	}
@@ -131,6 +134,22 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, nil
 }
 
+// queue reports a fargate profile that does not exist yet as existing while
+// another fargate profile of the same cluster is being created or deleted, so
+// that its creation is deferred until EKS accepts it. The Ready condition
+// tells which fargate profile it is waiting for.
+func (e *external) queue(ctx context.Context, cr *v1beta1.FargateProfile) (managed.ExternalObservation, error) {
+	busy, err := eks.FargateProfileInProgress(ctx, e.client, cr.Spec.ForProvider.ClusterName, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListFailed)
+	}
+	if busy == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	cr.SetConditions(eks.WaitingForClusterOperation(busy))
+	return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.FargateProfile)
 	if !ok {
@@ -140,7 +159,10 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.Status == v1beta1.FargateProfileStatusCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	_, err := e.client.CreateFargateProfile(ctx, eks.GenerateCreateFargateProfileInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	err := iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		_, err := e.client.CreateFargateProfile(ctx, eks.GenerateCreateFargateProfileInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+		return err
+	})
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateFailed)
 }
 
@@ -179,7 +201,17 @@ func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
 	if cr.Status.AtProvider.Status == v1beta1.FargateProfileStatusDeleting {
 		return nil
 	}
-	_, err := e.client.DeleteFargateProfile(ctx, &awseks.DeleteFargateProfileInput{FargateProfileName: awsclient.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
+	// EKS rejects the deletion while any other fargate profile
+	// of the cluster is being created or deleted, so we back off until it is
+	// done.
+	busy, err := eks.FargateProfileInProgress(ctx, e.client, cr.Spec.ForProvider.ClusterName, meta.GetExternalName(cr))
+	if err != nil {
+		return awsclient.Wrap(err, errListFailed)
+	}
+	if busy != "" {
+		return errors.Errorf(errFmtWaitingForCluster, busy)
+	}
+	_, err = e.client.DeleteFargateProfile(ctx, &awseks.DeleteFargateProfileInput{FargateProfileName: awsclient.String(meta.GetExternalName(cr)), ClusterName: &cr.Spec.ForProvider.ClusterName})
 	return awsclient.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errDeleteFailed)
 }
 
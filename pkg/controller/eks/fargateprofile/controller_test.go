@@ -24,6 +24,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/eks/v1beta1"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 
@@ -46,6 +47,12 @@ var (
 	errBoom = errors.New("boom")
 )
 
+func listProfiles(names ...string) func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+	return func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+		return &awseks.ListFargateProfilesOutput{FargateProfileNames: names}, nil
+	}
+}
+
 type args struct {
 	eks  eks.Client
 	kube client.Client
@@ -173,6 +180,7 @@ func TestObserve(t *testing.T) {
 					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
 						return nil, &awsekstypes.ResourceNotFoundException{}
 					},
+					MockListFargateProfiles: listProfiles(),
 				},
 				cr: fargateProfile(),
 			},
@@ -180,6 +188,65 @@ func TestObserve(t *testing.T) {
 				cr: fargateProfile(),
 			},
 		},
+		"NotFoundOtherProfileActive": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						if aws.ToString(input.FargateProfileName) == "other" {
+							return &awseks.DescribeFargateProfileOutput{
+								FargateProfile: &awsekstypes.FargateProfile{Status: awsekstypes.FargateProfileStatusActive},
+							}, nil
+						}
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+					MockListFargateProfiles: listProfiles("other"),
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr: fargateProfile(),
+			},
+		},
+		"NotFoundQueuedBehindOtherProfile": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						if aws.ToString(input.FargateProfileName) == "other" {
+							return &awseks.DescribeFargateProfileOutput{
+								FargateProfile: &awsekstypes.FargateProfile{Status: awsekstypes.FargateProfileStatusCreating},
+							}, nil
+						}
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+					MockListFargateProfiles: listProfiles("name", "other"),
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr: fargateProfile(withConditions(eks.WaitingForClusterOperation("other"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotFoundFailedList": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return nil, &awsekstypes.ResourceNotFoundException{}
+					},
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr:  fargateProfile(),
+				err: awsclient.Wrap(errBoom, errListFailed),
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -424,6 +491,7 @@ func TestDelete(t *testing.T) {
 					MockDeleteFargateProfile: func(ctx context.Context, input *awseks.DeleteFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DeleteFargateProfileOutput, error) {
 						return &awseks.DeleteFargateProfileOutput{}, nil
 					},
+					MockListFargateProfiles: listProfiles(),
 				},
 				cr: fargateProfile(),
 			},
@@ -446,6 +514,7 @@ func TestDelete(t *testing.T) {
 					MockDeleteFargateProfile: func(ctx context.Context, input *awseks.DeleteFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DeleteFargateProfileOutput, error) {
 						return nil, &awsekstypes.ResourceNotFoundException{}
 					},
+					MockListFargateProfiles: listProfiles(),
 				},
 				cr: fargateProfile(),
 			},
@@ -459,6 +528,7 @@ func TestDelete(t *testing.T) {
 					MockDeleteFargateProfile: func(ctx context.Context, input *awseks.DeleteFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DeleteFargateProfileOutput, error) {
 						return nil, errBoom
 					},
+					MockListFargateProfiles: listProfiles(),
 				},
 				cr: fargateProfile(),
 			},
@@ -467,6 +537,37 @@ func TestDelete(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errDeleteFailed),
 			},
 		},
+		"WaitingForOtherProfile": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeFargateProfile: func(ctx context.Context, input *awseks.DescribeFargateProfileInput, opts []func(*awseks.Options)) (*awseks.DescribeFargateProfileOutput, error) {
+						return &awseks.DescribeFargateProfileOutput{
+							FargateProfile: &awsekstypes.FargateProfile{Status: awsekstypes.FargateProfileStatusDeleting},
+						}, nil
+					},
+					MockListFargateProfiles: listProfiles("other"),
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr:  fargateProfile(withConditions(xpv1.Deleting())),
+				err: errors.Errorf(errFmtWaitingForCluster, "other"),
+			},
+		},
+		"FailedList": {
+			args: args{
+				eks: &fake.MockClient{
+					MockListFargateProfiles: func(ctx context.Context, input *awseks.ListFargateProfilesInput, opts []func(*awseks.Options)) (*awseks.ListFargateProfilesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: fargateProfile(),
+			},
+			want: want{
+				cr:  fargateProfile(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errListFailed),
+			},
+		},
 	}
 
 	for name, tc := range cases {
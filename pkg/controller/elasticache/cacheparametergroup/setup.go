@@ -18,18 +18,16 @@ package cacheparametergroup
 
 import (
 	"context"
+	"sort"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/aws/aws-sdk-go/service/elasticache/elasticacheiface"
-	"github.com/google/go-cmp/cmp"
-	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,12 +35,18 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupCacheParameterGroup adds a controller that reconciles a CacheParameterGroup.
-func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupCacheParameterGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.CacheParameterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{setupExternal}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
@@ -53,22 +57,28 @@ func SetupCacheParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		For(&svcapitypes.CacheParameterGroup{}).
-		WithOptions(o.ForControllerRuntime()).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.CacheParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		WithOptions(o.ForController(name)).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.CacheParameterGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<cache-parameter-group-name>", "elasticache", "parametergroup"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+// maxParametersPerRequest is the number of parameters ElastiCache accepts in
+// a single modify or reset call.
+const maxParametersPerRequest = 20
+
+const (
+	errDescribeParameters = "cannot describe cache parameters"
+	errResetParameters    = "cannot reset cache parameters"
+)
+
 func setupExternal(e *external) {
+	e.client = &parameterGroupClient{ElastiCacheAPI: e.client}
 	e.preObserve = preObserve
 	e.postObserve = postObserve
 	h := &hooks{client: e.client, kube: e.kube}
 	e.isUpToDate = h.isUpToDate
-	e.preUpdate = preUpdate
+	e.preUpdate = h.preUpdate
 	e.postUpdate = h.postUpdate
 	e.preCreate = preCreate
 	e.preDelete = preDelete
@@ -79,6 +89,20 @@ type hooks struct {
 	kube   client.Client
 }
 
+// parameterGroupClient skips ModifyCacheParameterGroup calls that carry no
+// parameters, which happens when an update only resets parameters to their
+// engine defaults.
+type parameterGroupClient struct {
+	elasticacheiface.ElastiCacheAPI
+}
+
+func (c *parameterGroupClient) ModifyCacheParameterGroupWithContext(ctx context.Context, input *svcsdk.ModifyCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+	if len(input.ParameterNameValues) == 0 {
+		return &svcsdk.CacheParameterGroupNameMessage{CacheParameterGroupName: input.CacheParameterGroupName}, nil
+	}
+	return c.ElastiCacheAPI.ModifyCacheParameterGroupWithContext(ctx, input, opts...)
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.DescribeCacheParameterGroupsInput) error {
 	obj.CacheParameterGroupName = awsclient.String(meta.GetExternalName(cr))
 	return nil
@@ -93,9 +117,7 @@ func postObserve(_ context.Context, cr *svcapitypes.CacheParameterGroup, resp *s
 	return obs, nil
 }
 
-func (e *hooks) isUpToDate(cr *svcapitypes.CacheParameterGroup, resp *svcsdk.DescribeCacheParameterGroupsOutput) (bool, error) {
-	ctx := context.TODO()
-
+func (e *hooks) describeParameters(ctx context.Context, cr *svcapitypes.CacheParameterGroup) ([]*svcsdk.Parameter, error) {
 	input := &svcsdk.DescribeCacheParametersInput{
 		CacheParameterGroupName: awsclient.String(meta.GetExternalName(cr)),
 	}
@@ -104,39 +126,84 @@ func (e *hooks) isUpToDate(cr *svcapitypes.CacheParameterGroup, resp *svcsdk.Des
 		results = append(results, page.Parameters...)
 		return !lastPage
 	})
-	if err != nil {
-		return false, err
-	}
+	return results, errors.Wrap(err, errDescribeParameters)
+}
 
-	var observed []svcapitypes.ParameterNameValue
-	for _, v := range results {
-		if svcapitypes.SourceType(awsclient.StringValue(v.Source)) == svcapitypes.SourceType_user {
-			observed = append(observed, svcapitypes.ParameterNameValue{
-				ParameterName:  v.ParameterName,
-				ParameterValue: v.ParameterValue,
-			})
+// diffParameters returns the parameters that must be modified to match the
+// desired ones and the user-set parameters that are no longer desired and
+// must be reset to their engine defaults. A desired parameter whose value
+// already matches the observed one is left alone, even when that value is
+// the engine default.
+func diffParameters(desired []svcapitypes.ParameterNameValue, observed []*svcsdk.Parameter) (modify, reset []*svcsdk.ParameterNameValue) {
+	current := make(map[string]*svcsdk.Parameter, len(observed))
+	for _, p := range observed {
+		current[awsclient.StringValue(p.ParameterName)] = p
+	}
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		name := awsclient.StringValue(d.ParameterName)
+		wanted[name] = true
+		if p, ok := current[name]; ok && awsclient.StringValue(p.ParameterValue) == awsclient.StringValue(d.ParameterValue) {
+			continue
 		}
+		modify = append(modify, &svcsdk.ParameterNameValue{
+			ParameterName:  d.ParameterName,
+			ParameterValue: d.ParameterValue,
+		})
 	}
+	for _, p := range observed {
+		name := awsclient.StringValue(p.ParameterName)
+		if wanted[name] || awsclient.StringValue(p.Source) != svcsdk.SourceTypeUser {
+			continue
+		}
+		reset = append(reset, &svcsdk.ParameterNameValue{ParameterName: p.ParameterName})
+	}
+	sortByName := func(s []*svcsdk.ParameterNameValue) {
+		sort.Slice(s, func(i, j int) bool {
+			return awsclient.StringValue(s[i].ParameterName) < awsclient.StringValue(s[j].ParameterName)
+		})
+	}
+	sortByName(modify)
+	sortByName(reset)
+	return modify, reset
+}
 
-	diff := cmp.Diff(observed, cr.Spec.ForProvider.ParameterNameValues, cmpopts.SortSlices(func(a, b svcapitypes.ParameterNameValue) bool {
-		return awsclient.StringValue(a.ParameterName) < awsclient.StringValue(b.ParameterName)
-	}))
-
-	// TODO: We should be able to return the diff to crossplane-runtime here
-	return diff == "", nil
+func (e *hooks) isUpToDate(cr *svcapitypes.CacheParameterGroup, resp *svcsdk.DescribeCacheParameterGroupsOutput) (bool, error) {
+	observed, err := e.describeParameters(context.TODO(), cr)
+	if err != nil {
+		return false, err
+	}
+	modify, reset := diffParameters(cr.Spec.ForProvider.ParameterNameValues, observed)
+	return len(modify) == 0 && len(reset) == 0, nil
 }
 
-func preUpdate(ctx context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.ModifyCacheParameterGroupInput) error {
+func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.CacheParameterGroup, obj *svcsdk.ModifyCacheParameterGroupInput) error {
 	obj.CacheParameterGroupName = awsclient.String(meta.GetExternalName(cr))
-	obj.ParameterNameValues = make([]*svcsdk.ParameterNameValue, len(cr.Spec.ForProvider.ParameterNameValues))
 
-	for i, v := range cr.Spec.ForProvider.ParameterNameValues {
-		obj.ParameterNameValues[i] = &svcsdk.ParameterNameValue{
-			ParameterName:  v.ParameterName,
-			ParameterValue: v.ParameterValue,
-		}
+	observed, err := e.describeParameters(ctx, cr)
+	if err != nil {
+		return err
 	}
+	modify, reset := diffParameters(cr.Spec.ForProvider.ParameterNameValues, observed)
 
+	// Anything beyond the per-request limit is picked up by the next
+	// reconcile, since the group will still be reported as out of date.
+	if len(reset) > maxParametersPerRequest {
+		reset = reset[:maxParametersPerRequest]
+	}
+	if len(reset) > 0 {
+		_, err := e.client.ResetCacheParameterGroupWithContext(ctx, &svcsdk.ResetCacheParameterGroupInput{
+			CacheParameterGroupName: obj.CacheParameterGroupName,
+			ParameterNameValues:     reset,
+		})
+		if err != nil {
+			return awsclient.Wrap(err, errResetParameters)
+		}
+	}
+	if len(modify) > maxParametersPerRequest {
+		modify = modify[:maxParametersPerRequest]
+	}
+	obj.ParameterNameValues = modify
 	return nil
 }
 
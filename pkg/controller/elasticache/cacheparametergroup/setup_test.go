@@ -17,6 +17,7 @@ limitations under the License.
 package cacheparametergroup
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -26,8 +27,10 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
@@ -73,12 +76,32 @@ type mockElastiCacheClient struct {
 	elasticacheiface.ElastiCacheAPI
 
 	DescribeCacheParametersPagesWithContextFunc func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error
+	ResetCacheParameterGroupWithContextFunc     func(_ aws.Context, _ *svcsdk.ResetCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error)
 }
 
 func (m *mockElastiCacheClient) DescribeCacheParametersPagesWithContext(ctx aws.Context, in *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, opts ...request.Option) error {
 	return m.DescribeCacheParametersPagesWithContextFunc(ctx, in, cb, opts...)
 }
 
+func (m *mockElastiCacheClient) ResetCacheParameterGroupWithContext(ctx aws.Context, in *svcsdk.ResetCacheParameterGroupInput, opts ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+	return m.ResetCacheParameterGroupWithContextFunc(ctx, in, opts...)
+}
+
+func describeParameters(params ...*svcsdk.Parameter) func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error {
+	return func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error {
+		cb(&svcsdk.DescribeCacheParametersOutput{Parameters: params}, true)
+		return nil
+	}
+}
+
+func parameter(source, k, v string) *svcsdk.Parameter {
+	return &svcsdk.Parameter{
+		Source:         awsclient.String(source),
+		ParameterName:  awsclient.String(k),
+		ParameterValue: awsclient.String(v),
+	}
+}
+
 func TestIsUpToDate(t *testing.T) {
 	type want struct {
 		upToDate bool
@@ -170,6 +193,41 @@ func TestIsUpToDate(t *testing.T) {
 				upToDate: false,
 			},
 		},
+		"upToDateEngineDefault": {
+			args: args{
+				elasticache: &mockElastiCacheClient{
+					DescribeCacheParametersPagesWithContextFunc: describeParameters(
+						parameter(svcsdk.SourceTypeUser, "a", "val1"),
+						parameter("system", "maxmemory-policy", "volatile-lru"),
+					),
+				},
+				cr: cacheParameterGroup(
+					withExternalName(testCacheParameterGroupName),
+					withParameter("a", "val1"),
+					withParameter("maxmemory-policy", "volatile-lru"),
+				),
+			},
+			want: want{
+				upToDate: true,
+			},
+		},
+		"NotUpToDateRemovedParameter": {
+			args: args{
+				elasticache: &mockElastiCacheClient{
+					DescribeCacheParametersPagesWithContextFunc: describeParameters(
+						parameter(svcsdk.SourceTypeUser, "a", "val1"),
+						parameter(svcsdk.SourceTypeUser, "b", "val2"),
+					),
+				},
+				cr: cacheParameterGroup(
+					withExternalName(testCacheParameterGroupName),
+					withParameter("a", "val1"),
+				),
+			},
+			want: want{
+				upToDate: false,
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -188,3 +246,145 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffParameters(t *testing.T) {
+	type want struct {
+		modify []*svcsdk.ParameterNameValue
+		reset  []*svcsdk.ParameterNameValue
+	}
+
+	cases := map[string]struct {
+		desired  []svcapitypes.ParameterNameValue
+		observed []*svcsdk.Parameter
+		want     want
+	}{
+		"NoChanges": {
+			desired: cacheParameterGroup(withParameter("a", "val1")).Spec.ForProvider.ParameterNameValues,
+			observed: []*svcsdk.Parameter{
+				parameter(svcsdk.SourceTypeUser, "a", "val1"),
+				parameter("system", "b", "default"),
+			},
+		},
+		"ModifyChangedAndNew": {
+			desired: cacheParameterGroup(withParameter("b", "val2"), withParameter("a", "valx")).Spec.ForProvider.ParameterNameValues,
+			observed: []*svcsdk.Parameter{
+				parameter(svcsdk.SourceTypeUser, "a", "val1"),
+				parameter("system", "b", "default"),
+			},
+			want: want{
+				modify: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsclient.String("a"), ParameterValue: awsclient.String("valx")},
+					{ParameterName: awsclient.String("b"), ParameterValue: awsclient.String("val2")},
+				},
+			},
+		},
+		"ResetRemoved": {
+			observed: []*svcsdk.Parameter{
+				parameter(svcsdk.SourceTypeUser, "a", "val1"),
+				parameter("system", "b", "default"),
+			},
+			want: want{
+				reset: []*svcsdk.ParameterNameValue{
+					{ParameterName: awsclient.String("a")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			modify, reset := diffParameters(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want.modify, modify); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		input *svcsdk.ModifyCacheParameterGroupInput
+		reset *svcsdk.ResetCacheParameterGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		describe func(_ aws.Context, _ *svcsdk.DescribeCacheParametersInput, cb func(*svcsdk.DescribeCacheParametersOutput, bool) bool, _ ...request.Option) error
+		resetErr error
+		cr       *svcapitypes.CacheParameterGroup
+		want     want
+	}{
+		"ModifyAndReset": {
+			describe: describeParameters(
+				parameter(svcsdk.SourceTypeUser, "a", "val1"),
+				parameter(svcsdk.SourceTypeUser, "b", "val2"),
+			),
+			cr: cacheParameterGroup(
+				withExternalName(testCacheParameterGroupName),
+				withParameter("a", "valx"),
+			),
+			want: want{
+				input: &svcsdk.ModifyCacheParameterGroupInput{
+					CacheParameterGroupName: awsclient.String(testCacheParameterGroupName),
+					ParameterNameValues: []*svcsdk.ParameterNameValue{
+						{ParameterName: awsclient.String("a"), ParameterValue: awsclient.String("valx")},
+					},
+				},
+				reset: &svcsdk.ResetCacheParameterGroupInput{
+					CacheParameterGroupName: awsclient.String(testCacheParameterGroupName),
+					ParameterNameValues: []*svcsdk.ParameterNameValue{
+						{ParameterName: awsclient.String("b")},
+					},
+				},
+			},
+		},
+		"ResetError": {
+			describe: describeParameters(
+				parameter(svcsdk.SourceTypeUser, "b", "val2"),
+			),
+			resetErr: errBoom,
+			cr:       cacheParameterGroup(withExternalName(testCacheParameterGroupName)),
+			want: want{
+				input: &svcsdk.ModifyCacheParameterGroupInput{
+					CacheParameterGroupName: awsclient.String(testCacheParameterGroupName),
+				},
+				reset: &svcsdk.ResetCacheParameterGroupInput{
+					CacheParameterGroupName: awsclient.String(testCacheParameterGroupName),
+					ParameterNameValues: []*svcsdk.ParameterNameValue{
+						{ParameterName: awsclient.String("b")},
+					},
+				},
+				err: awsclient.Wrap(errBoom, errResetParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var reset *svcsdk.ResetCacheParameterGroupInput
+			h := &hooks{client: &mockElastiCacheClient{
+				DescribeCacheParametersPagesWithContextFunc: tc.describe,
+				ResetCacheParameterGroupWithContextFunc: func(_ aws.Context, in *svcsdk.ResetCacheParameterGroupInput, _ ...request.Option) (*svcsdk.CacheParameterGroupNameMessage, error) {
+					reset = in
+					return &svcsdk.CacheParameterGroupNameMessage{}, tc.resetErr
+				},
+			}}
+			input := &svcsdk.ModifyCacheParameterGroupInput{}
+			err := h.preUpdate(context.Background(), tc.cr, input)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -128,8 +128,9 @@ func TestObserve(t *testing.T) {
 					withExternalName(elbName),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: true,
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -228,8 +229,9 @@ func TestObserve(t *testing.T) {
 					}),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
-					ResourceExists:   true,
-					ResourceUpToDate: false,
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -8,21 +8,24 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	svcapitypes "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupLoadBalancer adds a controller that reconciles LoadBalancer.
-func SetupLoadBalancer(mgr ctrl.Manager, o controller.Options) error {
+func SetupLoadBalancer(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.LoadBalancerGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.postObserve = postObserve
@@ -39,15 +42,11 @@ func SetupLoadBalancer(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.LoadBalancer{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.LoadBalancerGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.LoadBalancerGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.ARN("<load-balancer-arn>", "elasticloadbalancing"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -61,9 +60,27 @@ func postObserve(_ context.Context, cr *svcapitypes.LoadBalancer, resp *svcsdk.D
 	case string(svcapitypes.LoadBalancerStateEnum_provisioning):
 		cr.SetConditions(xpv1.Creating())
 	}
+	obs.ConnectionDetails = getConnectionDetails(resp.LoadBalancers[0])
 	return obs, nil
 }
 
+// getConnectionDetails returns the DNS name, Route 53 hosted zone ID and ARN
+// of the load balancer so that alias records and other consumers can target
+// it without looking it up.
+func getConnectionDetails(lb *svcsdk.LoadBalancer) managed.ConnectionDetails {
+	conn := managed.ConnectionDetails{}
+	if lb.DNSName != nil {
+		conn[svcapitypes.ResourceCredentialsSecretDNSNameKey] = []byte(aws.StringValue(lb.DNSName))
+	}
+	if lb.CanonicalHostedZoneId != nil {
+		conn[svcapitypes.ResourceCredentialsSecretHostedZoneIDKey] = []byte(aws.StringValue(lb.CanonicalHostedZoneId))
+	}
+	if lb.LoadBalancerArn != nil {
+		conn[svcapitypes.ResourceCredentialsSecretARNKey] = []byte(aws.StringValue(lb.LoadBalancerArn))
+	}
+	return conn
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.LoadBalancer, resp *svcsdk.CreateLoadBalancerOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
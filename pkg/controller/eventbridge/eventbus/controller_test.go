@@ -0,0 +1,241 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventbus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge/fake"
+)
+
+var (
+	busName = "bus"
+	busARN  = "arn:aws:events:us-east-1:123456789012:event-bus/bus"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	eb *fake.MockClient
+	cr resource.Managed
+}
+
+type busModifier func(*v1alpha1.EventBus)
+
+func withTags(t ...v1alpha1.Tag) busModifier {
+	return func(b *v1alpha1.EventBus) { b.Spec.ForProvider.Tags = t }
+}
+
+func withARN(arn string) busModifier {
+	return func(b *v1alpha1.EventBus) { b.Status.AtProvider.ARN = arn }
+}
+
+func withConditions(c ...xpv1.Condition) busModifier {
+	return func(b *v1alpha1.EventBus) { b.Status.ConditionedStatus.Conditions = c }
+}
+
+func bus(m ...busModifier) *v1alpha1.EventBus {
+	cr := &v1alpha1.EventBus{}
+	meta.SetExternalName(cr, busName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(context.Context, *awseventbridge.DescribeEventBusInput, []request.Option) (*awseventbridge.DescribeEventBusOutput, error) {
+	return &awseventbridge.DescribeEventBusOutput{Name: &busName, Arn: &busARN}, nil
+}
+
+func listTags(context.Context, *awseventbridge.ListTagsForResourceInput, []request.Option) (*awseventbridge.ListTagsForResourceOutput, error) {
+	return &awseventbridge.ListTagsForResourceOutput{
+		Tags: []*awseventbridge.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+	}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeEventBus: func(context.Context, *awseventbridge.DescribeEventBusInput, []request.Option) (*awseventbridge.DescribeEventBusOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: bus(),
+			},
+			want: want{
+				cr: bus(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeEventBus: func(context.Context, *awseventbridge.DescribeEventBusInput, []request.Option) (*awseventbridge.DescribeEventBusOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bus(),
+			},
+			want: want{
+				cr:  bus(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeEventBus:    describe,
+					MockListTagsForResource: listTags,
+				},
+				cr: bus(withTags(v1alpha1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: bus(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withARN(busARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeEventBus:    describe,
+					MockListTagsForResource: listTags,
+				},
+				cr: bus(),
+			},
+			want: want{
+				cr: bus(withARN(busARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var removed []*string
+	e := &external{client: &fake.MockClient{
+		MockListTagsForResource: listTags,
+		MockUntagResource: func(_ context.Context, in *awseventbridge.UntagResourceInput, _ []request.Option) (*awseventbridge.UntagResourceOutput, error) {
+			removed = in.TagKeys
+			return &awseventbridge.UntagResourceOutput{}, nil
+		},
+	}}
+	if _, err := e.Update(context.Background(), bus(withARN(busARN))); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff([]*string{awsclient.String("k")}, removed); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyGone": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDeleteEventBus: func(context.Context, *awseventbridge.DeleteEventBusInput, []request.Option) (*awseventbridge.DeleteEventBusOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: bus(),
+			},
+			want: want{
+				cr: bus(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDeleteEventBus: func(context.Context, *awseventbridge.DeleteEventBusInput, []request.Option) (*awseventbridge.DeleteEventBusOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: bus(),
+			},
+			want: want{
+				cr:  bus(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
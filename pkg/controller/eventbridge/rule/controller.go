@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Rule resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe Rule"
+	errTags             = "failed to observe tags of Rule"
+	errPut              = "failed to put Rule"
+	errUpdateTags       = "failed to update tags of Rule"
+	errDelete           = "failed to delete Rule"
+)
+
+// SetupRule adds a controller that reconciles Rules.
+func SetupRule(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.RuleGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Rule{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.RuleGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: eventbridge.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) eventbridge.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client eventbridge.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	out, err := e.client.DescribeRuleWithContext(ctx, &awseventbridge.DescribeRuleInput{
+		Name:         awsclient.String(meta.GetExternalName(cr)),
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eventbridge.IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = v1alpha1.RuleObservation{
+		ARN:   awsclient.StringValue(out.Arn),
+		State: awsclient.StringValue(out.State),
+	}
+	tagsUpToDate, err := eventbridge.IsTagsUpToDate(ctx, e.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errTags)
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: tagsUpToDate && eventbridge.IsRuleUpToDate(cr.Spec.ForProvider, out),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	input := eventbridge.GenerateRuleInput(meta.GetExternalName(cr), cr.Spec.ForProvider)
+	input.Tags = eventbridge.GenerateTags(cr.Spec.ForProvider.Tags)
+	_, err := e.client.PutRuleWithContext(ctx, input)
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// PutRule overwrites the whole configuration of an existing rule but
+	// leaves its tags untouched.
+	if _, err := e.client.PutRuleWithContext(ctx, eventbridge.GenerateRuleInput(meta.GetExternalName(cr), cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+	}
+	err := eventbridge.UpdateTags(ctx, e.client, cr.Status.AtProvider.ARN, cr.Spec.ForProvider.Tags)
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Rule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// A rule cannot be deleted while it has targets. Deletion is retried
+	// until the Targets of the rule have been deleted.
+	_, err := e.client.DeleteRuleWithContext(ctx, &awseventbridge.DeleteRuleInput{
+		Name:         awsclient.String(meta.GetExternalName(cr)),
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+	})
+	return awsclient.Wrap(resource.Ignore(eventbridge.IsNotFound, err), errDelete)
+}
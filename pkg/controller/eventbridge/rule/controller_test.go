@@ -0,0 +1,352 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge/fake"
+)
+
+var (
+	ruleName = "rule"
+	ruleARN  = "arn:aws:events:us-east-1:123456789012:rule/rule"
+	schedule = "rate(5 minutes)"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	eb *fake.MockClient
+	cr resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.Rule)
+
+func withSchedule(s string) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Spec.ForProvider.ScheduleExpression = &s }
+}
+
+func withTags(t ...v1alpha1.Tag) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o v1alpha1.RuleObservation) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.Rule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.Rule {
+	cr := &v1alpha1.Rule{
+		Spec: v1alpha1.RuleSpec{
+			ForProvider: v1alpha1.RuleParameters{ScheduleExpression: &schedule},
+		},
+	}
+	meta.SetExternalName(cr, ruleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(context.Context, *awseventbridge.DescribeRuleInput, []request.Option) (*awseventbridge.DescribeRuleOutput, error) {
+	return &awseventbridge.DescribeRuleOutput{
+		Name:               &ruleName,
+		Arn:                &ruleARN,
+		ScheduleExpression: awsclient.String("rate(5  minutes)"),
+		State:              awsclient.String(awseventbridge.RuleStateEnabled),
+	}, nil
+}
+
+func listTags(tags ...*awseventbridge.Tag) func(context.Context, *awseventbridge.ListTagsForResourceInput, []request.Option) (*awseventbridge.ListTagsForResourceOutput, error) {
+	return func(context.Context, *awseventbridge.ListTagsForResourceInput, []request.Option) (*awseventbridge.ListTagsForResourceOutput, error) {
+		return &awseventbridge.ListTagsForResourceOutput{Tags: tags}, nil
+	}
+}
+
+var observation = v1alpha1.RuleObservation{ARN: ruleARN, State: awseventbridge.RuleStateEnabled}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeRule: func(context.Context, *awseventbridge.DescribeRuleInput, []request.Option) (*awseventbridge.DescribeRuleOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeRule: func(context.Context, *awseventbridge.DescribeRuleInput, []request.Option) (*awseventbridge.DescribeRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeRule:        describe,
+					MockListTagsForResource: listTags(),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ScheduleChanged": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeRule:        describe,
+					MockListTagsForResource: listTags(),
+				},
+				cr: rule(withSchedule("rate(1 hour)")),
+			},
+			want: want{
+				cr: rule(withSchedule("rate(1 hour)"), withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDescribeRule:        describe,
+					MockListTagsForResource: listTags(&awseventbridge.Tag{Key: awsclient.String("k"), Value: awsclient.String("v")}),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withObservation(observation), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	var input *awseventbridge.PutRuleInput
+	e := &external{client: &fake.MockClient{
+		MockPutRule: func(_ context.Context, in *awseventbridge.PutRuleInput, _ []request.Option) (*awseventbridge.PutRuleOutput, error) {
+			input = in
+			return &awseventbridge.PutRuleOutput{}, nil
+		},
+	}}
+	cr := rule(withTags(v1alpha1.Tag{Key: "k", Value: "v"}))
+	if _, err := e.Create(context.Background(), cr); err != nil {
+		t.Errorf("r: unexpected error: %v", err)
+	}
+	want := &awseventbridge.PutRuleInput{
+		Name:               &ruleName,
+		ScheduleExpression: &schedule,
+		Tags:               []*awseventbridge.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+	}
+	if diff := cmp.Diff(want, input, cmpopts.IgnoreUnexported(awseventbridge.PutRuleInput{}, awseventbridge.Tag{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(rule(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withConditions(xpv1.Creating())), cr, test.EquateConditions()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		put    bool
+		tagged []*awseventbridge.Tag
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eb: &fake.MockClient{
+					MockPutRule: func(context.Context, *awseventbridge.PutRuleInput, []request.Option) (*awseventbridge.PutRuleOutput, error) {
+						return &awseventbridge.PutRuleOutput{}, nil
+					},
+					MockListTagsForResource: listTags(),
+				},
+				cr: rule(withTags(v1alpha1.Tag{Key: "k", Value: "v"}), withObservation(observation)),
+			},
+			want: want{
+				put:    true,
+				tagged: []*awseventbridge.Tag{{Key: awsclient.String("k"), Value: awsclient.String("v")}},
+			},
+		},
+		"FailedPut": {
+			args: args{
+				eb: &fake.MockClient{
+					MockPutRule: func(context.Context, *awseventbridge.PutRuleInput, []request.Option) (*awseventbridge.PutRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(withObservation(observation)),
+			},
+			want: want{
+				put: true,
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put := false
+			var tagged []*awseventbridge.Tag
+			mockPut := tc.eb.MockPutRule
+			tc.eb.MockPutRule = func(ctx context.Context, in *awseventbridge.PutRuleInput, opts []request.Option) (*awseventbridge.PutRuleOutput, error) {
+				put = true
+				return mockPut(ctx, in, opts)
+			}
+			tc.eb.MockTagResource = func(_ context.Context, in *awseventbridge.TagResourceInput, _ []request.Option) (*awseventbridge.TagResourceOutput, error) {
+				tagged = in.Tags
+				return &awseventbridge.TagResourceOutput{}, nil
+			}
+			e := &external{client: tc.eb}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.tagged, tagged, cmpopts.IgnoreUnexported(awseventbridge.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyGone": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDeleteRule: func(context.Context, *awseventbridge.DeleteRuleInput, []request.Option) (*awseventbridge.DeleteRuleOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				eb: &fake.MockClient{
+					MockDeleteRule: func(context.Context, *awseventbridge.DeleteRuleInput, []request.Option) (*awseventbridge.DeleteRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
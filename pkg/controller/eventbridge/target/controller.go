@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Target resource"
+	errCreateSession    = "cannot create a new session"
+	errList             = "failed to list the targets of the Rule"
+	errPut              = "failed to put Target"
+	errRemove           = "failed to remove Target"
+)
+
+// SetupTarget adds a controller that reconciles Targets.
+func SetupTarget(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TargetGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Target{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.TargetGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: eventbridge.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) eventbridge.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Target)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client eventbridge.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Target)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	id := meta.GetExternalName(cr)
+	t, err := eventbridge.FindTarget(ctx, e.client, cr.Spec.ForProvider.Rule, cr.Spec.ForProvider.EventBusName, id)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(eventbridge.IsNotFound, err), errList)
+	}
+	if t == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: eventbridge.IsTargetUpToDate(id, cr.Spec.ForProvider, t),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Target)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Target)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+// put creates or replaces the target. PutTargets reports failures of single
+// targets as failed entries rather than as an error.
+func (e *external) put(ctx context.Context, cr *v1alpha1.Target) error {
+	out, err := e.client.PutTargetsWithContext(ctx, &awseventbridge.PutTargetsInput{
+		Rule:         cr.Spec.ForProvider.Rule,
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Targets:      []*awseventbridge.Target{eventbridge.GenerateTarget(meta.GetExternalName(cr), cr.Spec.ForProvider)},
+	})
+	if err != nil {
+		return err
+	}
+	if len(out.FailedEntries) > 0 {
+		return awserr.New(awsclient.StringValue(out.FailedEntries[0].ErrorCode), awsclient.StringValue(out.FailedEntries[0].ErrorMessage), nil)
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Target)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	out, err := e.client.RemoveTargetsWithContext(ctx, &awseventbridge.RemoveTargetsInput{
+		Rule:         cr.Spec.ForProvider.Rule,
+		EventBusName: cr.Spec.ForProvider.EventBusName,
+		Ids:          []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err == nil && len(out.FailedEntries) > 0 {
+		err = awserr.New(awsclient.StringValue(out.FailedEntries[0].ErrorCode), awsclient.StringValue(out.FailedEntries[0].ErrorMessage), nil)
+	}
+	return awsclient.Wrap(resource.Ignore(eventbridge.IsNotFound, err), errRemove)
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package target
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awseventbridge "github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/eventbridge/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/eventbridge/fake"
+)
+
+var (
+	targetID = "target"
+	ruleName = "rule"
+	queue    = "arn:aws:sqs:us-east-1:123456789012:queue"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	eb *fake.MockClient
+	cr resource.Managed
+}
+
+type targetModifier func(*v1alpha1.Target)
+
+func withInputPath(p string) targetModifier {
+	return func(t *v1alpha1.Target) { t.Spec.ForProvider.InputPath = &p }
+}
+
+func withConditions(c ...xpv1.Condition) targetModifier {
+	return func(t *v1alpha1.Target) { t.Status.ConditionedStatus.Conditions = c }
+}
+
+func target(m ...targetModifier) *v1alpha1.Target {
+	cr := &v1alpha1.Target{
+		Spec: v1alpha1.TargetSpec{
+			ForProvider: v1alpha1.TargetParameters{
+				Rule: &ruleName,
+				ARN:  &queue,
+			},
+		},
+	}
+	meta.SetExternalName(cr, targetID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listTargets(targets ...*awseventbridge.Target) func(context.Context, *awseventbridge.ListTargetsByRuleInput, []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+	return func(context.Context, *awseventbridge.ListTargetsByRuleInput, []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+		return &awseventbridge.ListTargetsByRuleOutput{Targets: targets}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RuleNotFound": {
+			args: args{
+				eb: &fake.MockClient{
+					MockListTargetsByRule: func(context.Context, *awseventbridge.ListTargetsByRuleInput, []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(),
+			},
+		},
+		"TargetNotFound": {
+			args: args{
+				eb: &fake.MockClient{
+					MockListTargetsByRule: listTargets(&awseventbridge.Target{Id: awsclient.String("other"), Arn: &queue}),
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(),
+			},
+		},
+		"ListError": {
+			args: args{
+				eb: &fake.MockClient{
+					MockListTargetsByRule: func(context.Context, *awseventbridge.ListTargetsByRuleInput, []request.Option) (*awseventbridge.ListTargetsByRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr:  target(),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				eb: &fake.MockClient{
+					MockListTargetsByRule: listTargets(&awseventbridge.Target{Id: &targetID, Arn: &queue}),
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"InputPathChanged": {
+			args: args{
+				eb: &fake.MockClient{
+					MockListTargetsByRule: listTargets(&awseventbridge.Target{Id: &targetID, Arn: &queue}),
+				},
+				cr: target(withInputPath("$.detail")),
+			},
+			want: want{
+				cr: target(withInputPath("$.detail"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eb: &fake.MockClient{
+					MockPutTargets: func(_ context.Context, in *awseventbridge.PutTargetsInput, _ []request.Option) (*awseventbridge.PutTargetsOutput, error) {
+						if awsclient.StringValue(in.Rule) != ruleName || awsclient.StringValue(in.Targets[0].Id) != targetID {
+							return nil, errBoom
+						}
+						return &awseventbridge.PutTargetsOutput{FailedEntryCount: awsclient.Int64(0)}, nil
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedEntry": {
+			args: args{
+				eb: &fake.MockClient{
+					MockPutTargets: func(context.Context, *awseventbridge.PutTargetsInput, []request.Option) (*awseventbridge.PutTargetsOutput, error) {
+						return &awseventbridge.PutTargetsOutput{
+							FailedEntryCount: awsclient.Int64(1),
+							FailedEntries: []*awseventbridge.PutTargetsResultEntry{{
+								TargetId:     &targetID,
+								ErrorCode:    awsclient.String("ValidationException"),
+								ErrorMessage: awsclient.String("invalid"),
+							}},
+						}, nil
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr:  target(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(awserr.New("ValidationException", "invalid", nil), errPut),
+			},
+		},
+		"Failed": {
+			args: args{
+				eb: &fake.MockClient{
+					MockPutTargets: func(context.Context, *awseventbridge.PutTargetsInput, []request.Option) (*awseventbridge.PutTargetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr:  target(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				eb: &fake.MockClient{
+					MockRemoveTargets: func(context.Context, *awseventbridge.RemoveTargetsInput, []request.Option) (*awseventbridge.RemoveTargetsOutput, error) {
+						return &awseventbridge.RemoveTargetsOutput{}, nil
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(withConditions(xpv1.Deleting())),
+			},
+		},
+		"RuleGone": {
+			args: args{
+				eb: &fake.MockClient{
+					MockRemoveTargets: func(context.Context, *awseventbridge.RemoveTargetsInput, []request.Option) (*awseventbridge.RemoveTargetsOutput, error) {
+						return nil, awserr.New(awseventbridge.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr: target(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedEntry": {
+			args: args{
+				eb: &fake.MockClient{
+					MockRemoveTargets: func(context.Context, *awseventbridge.RemoveTargetsInput, []request.Option) (*awseventbridge.RemoveTargetsOutput, error) {
+						return &awseventbridge.RemoveTargetsOutput{
+							FailedEntryCount: awsclient.Int64(1),
+							FailedEntries: []*awseventbridge.RemoveTargetsResultEntry{{
+								TargetId:     &targetID,
+								ErrorCode:    awsclient.String("InternalException"),
+								ErrorMessage: awsclient.String("boom"),
+							}},
+						}, nil
+					},
+				},
+				cr: target(),
+			},
+			want: want{
+				cr:  target(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(awserr.New("InternalException", "boom", nil), errRemove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.eb}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,412 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fleet contains the controller that stamps out the template of a
+// Fleet for every selected ProviderConfig.
+package fleet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	reconcileTimeout = 2 * time.Minute
+
+	errGetFleet            = "cannot get Fleet"
+	errParseTemplate       = "cannot parse Fleet template"
+	errTemplateKind        = "Fleet template must specify apiVersion and kind"
+	errTemplateSpec        = "Fleet template must specify a spec"
+	errTemplateNotManaged  = "Fleet template must be a managed resource of this provider"
+	errSelector            = "cannot parse ProviderConfig selector"
+	errListProviderConfigs = "cannot list ProviderConfigs"
+	errGetMember           = "cannot get Fleet member"
+	errApplyMember         = "cannot apply Fleet member"
+	errNotControlled       = "refusing to adopt existing resource that is not controlled by this Fleet"
+	errDeleteMember        = "cannot delete stale Fleet member"
+	errUpdateStatus        = "cannot update Fleet status"
+
+	reasonApplyMember  event.Reason = "ApplyMember"
+	reasonDeleteMember event.Reason = "DeleteMember"
+)
+
+// SetupFleet adds a controller that reconciles Fleets.
+func SetupFleet(mgr ctrl.Manager, o setup.Options) error {
+	name := "fleet/" + strings.ToLower(v1alpha1.FleetGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	r := &reconciler{
+		kube:         mgr.GetClient(),
+		scheme:       mgr.GetScheme(),
+		log:          o.Logger.WithValues("controller", name),
+		record:       event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		pollInterval: o.PollInterval,
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		For(&v1alpha1.Fleet{}).
+		Watches(&source.Kind{Type: &v1beta1.ProviderConfig{}}, handler.EnqueueRequestsFromMapFunc(r.fleetsForProviderConfig)).
+		Complete(r)
+}
+
+type reconciler struct {
+	kube         client.Client
+	scheme       *runtime.Scheme
+	log          logging.Logger
+	record       event.Recorder
+	pollInterval time.Duration
+}
+
+// fleetsForProviderConfig enqueues every Fleet whenever a ProviderConfig
+// changes, since any of them may select it.
+func (r *reconciler) fleetsForProviderConfig(_ client.Object) []reconcile.Request {
+	l := &v1alpha1.FleetList{}
+	if err := r.kube.List(context.Background(), l); err != nil {
+		r.log.Debug("Cannot list Fleets", "error", err)
+		return nil
+	}
+	reqs := make([]reconcile.Request, len(l.Items))
+	for i := range l.Items {
+		reqs[i] = reconcile.Request{NamespacedName: types.NamespacedName{Name: l.Items[i].GetName()}}
+	}
+	return reqs
+}
+
+// Reconcile a Fleet by stamping out its template for every selected
+// ProviderConfig and deleting members whose ProviderConfig is no longer
+// selected.
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) { // nolint:gocyclo
+	log := r.log.WithValues("request", req)
+	log.Debug("Reconciling")
+
+	ctx, cancel := context.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+
+	f := &v1alpha1.Fleet{}
+	if err := r.kube.Get(ctx, req.NamespacedName, f); err != nil {
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetFleet)
+	}
+
+	// Members are controlled by the Fleet and are garbage collected by the
+	// API server once it is gone.
+	if meta.WasDeleted(f) {
+		return reconcile.Result{}, nil
+	}
+
+	tmpl, err := parseTemplate(f, r.scheme)
+	if err != nil {
+		// There is no point in retrying until the template is fixed, which
+		// will trigger a new reconcile.
+		f.SetConditions(xpv1.ReconcileError(err))
+		return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, f), errUpdateStatus)
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(&f.Spec.ProviderConfigSelector)
+	if err != nil {
+		f.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errSelector)))
+		return reconcile.Result{}, errors.Wrap(r.kube.Status().Update(ctx, f), errUpdateStatus)
+	}
+
+	pcs := &v1beta1.ProviderConfigList{}
+	if err := r.kube.List(ctx, pcs, client.MatchingLabelsSelector{Selector: sel}); err != nil {
+		f.SetConditions(xpv1.ReconcileError(errors.Wrap(err, errListProviderConfigs)))
+		return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.kube.Status().Update(ctx, f), errUpdateStatus)
+	}
+
+	members := make([]v1alpha1.FleetMember, 0, len(pcs.Items))
+	desired := map[string]bool{}
+	var applyErr error
+	for i := range pcs.Items {
+		pc := pcs.Items[i]
+		if meta.WasDeleted(&pc) {
+			continue
+		}
+		m := newMember(f, tmpl, pc.GetName())
+		desired[memberKey(m.GetAPIVersion(), m.GetKind(), m.GetName())] = true
+		if err := r.apply(ctx, f, m); err != nil {
+			log.Debug(errApplyMember, "error", err, "member", m.GetName())
+			r.record.Event(f, event.Warning(reasonApplyMember, err, "member", m.GetName()))
+			applyErr = err
+			continue
+		}
+		members = append(members, v1alpha1.FleetMember{
+			ProviderConfigName: pc.GetName(),
+			ResourceRef: xpv1.TypedReference{
+				APIVersion: m.GetAPIVersion(),
+				Kind:       m.GetKind(),
+				Name:       m.GetName(),
+				UID:        m.GetUID(),
+			},
+			Ready: isReady(m),
+		})
+	}
+
+	for _, old := range f.Status.Members {
+		ref := old.ResourceRef
+		if desired[memberKey(ref.APIVersion, ref.Kind, ref.Name)] {
+			continue
+		}
+		if err := r.delete(ctx, f, ref); err != nil {
+			log.Debug(errDeleteMember, "error", err, "member", ref.Name)
+			r.record.Event(f, event.Warning(reasonDeleteMember, err, "member", ref.Name))
+			applyErr = err
+			// Keep tracking the member so that we try to delete it again.
+			members = append(members, old)
+			continue
+		}
+		r.record.Event(f, event.Normal(reasonDeleteMember, "Deleted stale Fleet member", "member", ref.Name))
+	}
+
+	f.Status.Members = members
+	f.SetConditions(readiness(members))
+	if applyErr != nil {
+		f.SetConditions(xpv1.ReconcileError(applyErr))
+	} else {
+		f.SetConditions(xpv1.ReconcileSuccess())
+	}
+	return reconcile.Result{RequeueAfter: r.pollInterval}, errors.Wrap(r.kube.Status().Update(ctx, f), errUpdateStatus)
+}
+
+// apply creates the supplied member, or updates it to match the template if
+// it already exists. Fields that were stamped out from the template before
+// but are no longer in it are removed. The supplied member is updated with
+// the state observed from the API server.
+func (r *reconciler) apply(ctx context.Context, f *v1alpha1.Fleet, m *unstructured.Unstructured) error {
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(m.GroupVersionKind())
+	err := r.kube.Get(ctx, types.NamespacedName{Name: m.GetName()}, current)
+	if kerrors.IsNotFound(err) {
+		return errors.Wrap(r.kube.Create(ctx, m), errApplyMember)
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetMember)
+	}
+	if !metav1.IsControlledBy(current, f) {
+		return errors.Errorf("%s: %s", errNotControlled, m.GetName())
+	}
+
+	last := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	_ = json.Unmarshal([]byte(current.GetAnnotations()[v1alpha1.AnnotationKeyFleetTemplate]), &last.Object)
+
+	desired := current.DeepCopy()
+	meta.RemoveLabels(desired, removed(last.GetLabels(), m.GetLabels())...)
+	meta.AddLabels(desired, m.GetLabels())
+	meta.RemoveAnnotations(desired, removed(last.GetAnnotations(), m.GetAnnotations())...)
+	meta.AddAnnotations(desired, m.GetAnnotations())
+	spec, _, _ := unstructured.NestedMap(desired.Object, "spec")
+	tspec, _, _ := unstructured.NestedMap(m.Object, "spec")
+	lspec, _, _ := unstructured.NestedMap(last.Object, "spec")
+	if err := unstructured.SetNestedMap(desired.Object, merge(spec, tspec, lspec), "spec"); err != nil {
+		return errors.Wrap(err, errApplyMember)
+	}
+
+	if cmp.Equal(current.Object, desired.Object) {
+		*m = *current
+		return nil
+	}
+	if err := r.kube.Update(ctx, desired); err != nil {
+		return errors.Wrap(err, errApplyMember)
+	}
+	*m = *desired
+	return nil
+}
+
+func (r *reconciler) delete(ctx context.Context, f *v1alpha1.Fleet, ref xpv1.TypedReference) error {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	if err := r.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, u); err != nil {
+		return errors.Wrap(resource.IgnoreNotFound(err), errDeleteMember)
+	}
+	// Never delete something we did not create.
+	if !metav1.IsControlledBy(u, f) {
+		return nil
+	}
+	return errors.Wrap(resource.IgnoreNotFound(r.kube.Delete(ctx, u)), errDeleteMember)
+}
+
+// parseTemplate returns the managed resource template of the supplied Fleet.
+// The template must be of a managed resource kind of this provider that is
+// registered with the supplied scheme.
+func parseTemplate(f *v1alpha1.Fleet, s *runtime.Scheme) (*unstructured.Unstructured, error) {
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(f.Spec.Template.Raw, &u.Object); err != nil {
+		return nil, errors.Wrap(err, errParseTemplate)
+	}
+	if u.GetAPIVersion() == "" || u.GetKind() == "" {
+		return nil, errors.New(errTemplateKind)
+	}
+	gvk := u.GroupVersionKind()
+	if !strings.HasSuffix(gvk.Group, ".aws.crossplane.io") {
+		return nil, errors.Errorf("%s: %s", errTemplateNotManaged, gvk)
+	}
+	if o, err := s.New(gvk); err != nil {
+		return nil, errors.Errorf("%s: %s", errTemplateNotManaged, gvk)
+	} else if _, ok := o.(resource.Managed); !ok {
+		return nil, errors.Errorf("%s: %s", errTemplateNotManaged, gvk)
+	}
+	if _, ok, _ := unstructured.NestedMap(u.Object, "spec"); !ok {
+		return nil, errors.New(errTemplateSpec)
+	}
+	return u, nil
+}
+
+// newMember renders the member of the supplied Fleet for the named
+// ProviderConfig. Like its name, the name of its connection secret is
+// suffixed with the name of the ProviderConfig so that members don't
+// overwrite each other's secrets.
+func newMember(f *v1alpha1.Fleet, tmpl *unstructured.Unstructured, pc string) *unstructured.Unstructured {
+	m := &unstructured.Unstructured{}
+	m.SetAPIVersion(tmpl.GetAPIVersion())
+	m.SetKind(tmpl.GetKind())
+	m.SetName(fmt.Sprintf("%s-%s", f.GetName(), pc))
+	m.SetLabels(tmpl.GetLabels())
+	m.SetAnnotations(tmpl.GetAnnotations())
+	meta.AddLabels(m, map[string]string{
+		v1alpha1.LabelKeyFleet:               f.GetName(),
+		v1alpha1.LabelKeyFleetProviderConfig: pc,
+	})
+	meta.AddOwnerReference(m, meta.AsController(meta.TypedReferenceTo(f, v1alpha1.FleetGroupVersionKind)))
+
+	spec, _, _ := unstructured.NestedMap(tmpl.Object, "spec")
+	_ = unstructured.SetNestedMap(m.Object, spec, "spec")
+	_ = unstructured.SetNestedField(m.Object, pc, "spec", "providerConfigRef", "name")
+	if n, ok, _ := unstructured.NestedString(m.Object, "spec", "writeConnectionSecretToRef", "name"); ok && n != "" {
+		_ = unstructured.SetNestedField(m.Object, fmt.Sprintf("%s-%s", n, pc), "spec", "writeConnectionSecretToRef", "name")
+	}
+
+	// Record what was stamped out, so that fields that are later removed
+	// from the template can be removed from the member.
+	stamped, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": m.GetLabels(), "annotations": m.GetAnnotations()},
+		"spec":     m.Object["spec"],
+	})
+	meta.AddAnnotations(m, map[string]string{v1alpha1.AnnotationKeyFleetTemplate: string(stamped)})
+	return m
+}
+
+// merge returns dst with every field of src set on it, and every field of
+// last that is not in src removed from it. Nested objects are merged
+// recursively so that fields that were late-initialized by the member
+// controller are preserved.
+func merge(dst, src, last map[string]interface{}) map[string]interface{} {
+	if dst == nil {
+		dst = map[string]interface{}{}
+	}
+	for k, v := range last {
+		if _, ok := src[k]; !ok {
+			delete(dst, k)
+			continue
+		}
+		lv, lok := v.(map[string]interface{})
+		sv, sok := src[k].(map[string]interface{})
+		dv, dok := dst[k].(map[string]interface{})
+		if lok && sok && dok {
+			dst[k] = merge(dv, sv, lv)
+		}
+	}
+	for k, v := range src {
+		sv, sok := v.(map[string]interface{})
+		dv, dok := dst[k].(map[string]interface{})
+		if sok && dok {
+			dst[k] = merge(dv, sv, nil)
+			continue
+		}
+		dst[k] = v
+	}
+	return dst
+}
+
+// removed returns the keys of last that are not in current.
+func removed(last, current map[string]string) []string {
+	var keys []string
+	for k := range last {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
+
+// isReady returns true if the supplied managed resource has a Ready condition
+// with status True.
+func isReady(u *unstructured.Unstructured) bool {
+	conditioned := xpv1.ConditionedStatus{}
+	if err := fromUnstructuredStatus(u, &conditioned); err != nil {
+		return false
+	}
+	return conditioned.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue
+}
+
+func fromUnstructuredStatus(u *unstructured.Unstructured, into interface{}) error {
+	status, ok, err := unstructured.NestedMap(u.Object, "status")
+	if err != nil || !ok {
+		return errors.New("no status")
+	}
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, into)
+}
+
+func readiness(members []v1alpha1.FleetMember) xpv1.Condition {
+	ready := 0
+	for _, m := range members {
+		if m.Ready {
+			ready++
+		}
+	}
+	if len(members) > 0 && ready == len(members) {
+		return xpv1.Available()
+	}
+	return xpv1.Unavailable().WithMessage(fmt.Sprintf("%d of %d members are ready", ready, len(members)))
+}
+
+func memberKey(apiVersion, kind, name string) string {
+	return strings.Join([]string{apiVersion, kind, name}, "/")
+}
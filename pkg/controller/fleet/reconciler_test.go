@@ -0,0 +1,389 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fleet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	iamv1beta1 "github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+)
+
+const (
+	fleetName   = "baseline"
+	pollingTime = time.Minute
+	tmplJSON    = `{"apiVersion":"iam.aws.crossplane.io/v1beta1","kind":"Role","spec":{"forProvider":{"assumeRolePolicyDocument":"{}"}}}`
+)
+
+var errBoom = errors.New("boom")
+
+func scheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := iamv1beta1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.SchemeBuilder.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func fleet(members ...v1alpha1.FleetMember) *v1alpha1.Fleet {
+	return &v1alpha1.Fleet{
+		ObjectMeta: metav1.ObjectMeta{Name: fleetName, UID: "fleet-uid"},
+		Spec: v1alpha1.FleetSpec{
+			Template: runtime.RawExtension{Raw: []byte(tmplJSON)},
+		},
+		Status: v1alpha1.FleetStatus{Members: members},
+	}
+}
+
+func member(pc string) v1alpha1.FleetMember {
+	return v1alpha1.FleetMember{
+		ProviderConfigName: pc,
+		ResourceRef: xpv1.TypedReference{
+			APIVersion: "iam.aws.crossplane.io/v1beta1",
+			Kind:       "Role",
+			Name:       fleetName + "-" + pc,
+		},
+	}
+}
+
+func providerConfigs(names ...string) func(client.ObjectList) error {
+	return func(obj client.ObjectList) error {
+		l, ok := obj.(*v1beta1.ProviderConfigList)
+		if !ok {
+			return nil
+		}
+		for _, n := range names {
+			l.Items = append(l.Items, v1beta1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: n}})
+		}
+		return nil
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	type want struct {
+		result  reconcile.Result
+		err     error
+		fleet   *v1alpha1.Fleet
+		created []string
+		deleted []string
+	}
+
+	notFound := kerrors.NewNotFound(schema.GroupResource{}, "")
+
+	cases := map[string]struct {
+		kube     func(created, deleted *[]string, status **v1alpha1.Fleet) client.Client
+		want     want
+		noStatus bool
+	}{
+		"FleetNotFound": {
+			kube: func(_, _ *[]string, _ **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{MockGet: test.NewMockGetFn(notFound)}
+			},
+			want:     want{},
+			noStatus: true,
+		},
+		"InvalidTemplate": {
+			kube: func(_, _ *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						f := fleet()
+						f.Spec.Template.Raw = []byte(`{"spec":{}}`)
+						f.DeepCopyInto(obj.(*v1alpha1.Fleet))
+						return nil
+					}),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				fleet: func() *v1alpha1.Fleet {
+					f := fleet()
+					f.Spec.Template.Raw = []byte(`{"spec":{}}`)
+					f.SetConditions(xpv1.ReconcileError(errors.New(errTemplateKind)))
+					return f
+				}(),
+			},
+		},
+		"TemplateNotManaged": {
+			kube: func(_, _ *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						f := fleet()
+						f.Spec.Template.Raw = []byte(`{"apiVersion":"aws.crossplane.io/v1alpha1","kind":"Fleet","spec":{}}`)
+						f.DeepCopyInto(obj.(*v1alpha1.Fleet))
+						return nil
+					}),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				fleet: func() *v1alpha1.Fleet {
+					f := fleet()
+					f.Spec.Template.Raw = []byte(`{"apiVersion":"aws.crossplane.io/v1alpha1","kind":"Fleet","spec":{}}`)
+					f.SetConditions(xpv1.ReconcileError(errors.Errorf("%s: %s", errTemplateNotManaged, v1alpha1.FleetGroupVersionKind)))
+					return f
+				}(),
+			},
+		},
+		"CreateMembers": {
+			kube: func(created, _ *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						if f, ok := obj.(*v1alpha1.Fleet); ok {
+							fleet().DeepCopyInto(f)
+							return nil
+						}
+						return notFound
+					},
+					MockList: test.NewMockListFn(nil, providerConfigs("dev", "prod")),
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						u := obj.(*unstructured.Unstructured)
+						if got, _, _ := unstructured.NestedString(u.Object, "spec", "providerConfigRef", "name"); got != u.GetLabels()[v1alpha1.LabelKeyFleetProviderConfig] {
+							t.Errorf("member %s uses ProviderConfig %q", u.GetName(), got)
+						}
+						if !metav1.IsControlledBy(u, fleet()) {
+							t.Errorf("member %s is not controlled by the Fleet", u.GetName())
+						}
+						*created = append(*created, u.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollingTime},
+				fleet: func() *v1alpha1.Fleet {
+					f := fleet(member("dev"), member("prod"))
+					f.SetConditions(xpv1.Unavailable().WithMessage("0 of 2 members are ready"), xpv1.ReconcileSuccess())
+					return f
+				}(),
+				created: []string{fleetName + "-dev", fleetName + "-prod"},
+			},
+		},
+		"DeleteStaleMember": {
+			kube: func(_, deleted *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if f, ok := obj.(*v1alpha1.Fleet); ok {
+							fleet(member("dev"), member("gone")).DeepCopyInto(f)
+							return nil
+						}
+						u := obj.(*unstructured.Unstructured)
+						u.SetName(key.Name)
+						meta.AddOwnerReference(u, meta.AsController(meta.TypedReferenceTo(fleet(), v1alpha1.FleetGroupVersionKind)))
+						_ = unstructured.SetNestedField(u.Object, []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}}, "status", "conditions")
+						return nil
+					},
+					MockList: test.NewMockListFn(nil, providerConfigs("dev")),
+					MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						return nil
+					},
+					MockDelete: func(_ context.Context, obj client.Object, _ ...client.DeleteOption) error {
+						*deleted = append(*deleted, obj.GetName())
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollingTime},
+				fleet: func() *v1alpha1.Fleet {
+					m := member("dev")
+					m.Ready = true
+					f := fleet(m)
+					f.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+					return f
+				}(),
+				deleted: []string{fleetName + "-gone"},
+			},
+		},
+		"NotControlled": {
+			kube: func(_, _ *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+						if f, ok := obj.(*v1alpha1.Fleet); ok {
+							fleet().DeepCopyInto(f)
+							return nil
+						}
+						obj.SetName(key.Name)
+						return nil
+					},
+					MockList: test.NewMockListFn(nil, providerConfigs("dev")),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollingTime},
+				fleet: func() *v1alpha1.Fleet {
+					f := fleet()
+					f.Status.Members = []v1alpha1.FleetMember{}
+					f.SetConditions(xpv1.Unavailable().WithMessage("0 of 0 members are ready"),
+						xpv1.ReconcileError(errors.Errorf("%s: %s", errNotControlled, fleetName+"-dev")))
+					return f
+				}(),
+			},
+		},
+		"ListFailed": {
+			kube: func(_, _ *[]string, status **v1alpha1.Fleet) client.Client {
+				return &test.MockClient{
+					MockGet:  test.NewMockGetFn(nil, func(obj client.Object) error { fleet().DeepCopyInto(obj.(*v1alpha1.Fleet)); return nil }),
+					MockList: test.NewMockListFn(errBoom),
+					MockStatusUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						*status = obj.(*v1alpha1.Fleet).DeepCopy()
+						return nil
+					},
+				}
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: pollingTime},
+				fleet: func() *v1alpha1.Fleet {
+					f := fleet()
+					f.SetConditions(xpv1.ReconcileError(errors.Wrap(errBoom, errListProviderConfigs)))
+					return f
+				}(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, deleted []string
+			var status *v1alpha1.Fleet
+			r := &reconciler{
+				kube:         tc.kube(&created, &deleted, &status),
+				scheme:       scheme(t),
+				log:          logging.NewNopLogger(),
+				record:       event.NewNopRecorder(),
+				pollInterval: pollingTime,
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: fleetName}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if tc.noStatus {
+				return
+			}
+			if diff := cmp.Diff(tc.want.fleet, status, test.EquateConditions()); diff != "" {
+				t.Errorf("status: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMerge(t *testing.T) {
+	cases := map[string]struct {
+		dst  map[string]interface{}
+		src  map[string]interface{}
+		last map[string]interface{}
+		want map[string]interface{}
+	}{
+		"NilDestination": {
+			src:  map[string]interface{}{"a": "b"},
+			want: map[string]interface{}{"a": "b"},
+		},
+		"PreserveLateInitialized": {
+			dst:  map[string]interface{}{"forProvider": map[string]interface{}{"region": "us-east-1", "path": "/"}},
+			src:  map[string]interface{}{"forProvider": map[string]interface{}{"region": "eu-west-1"}},
+			want: map[string]interface{}{"forProvider": map[string]interface{}{"region": "eu-west-1", "path": "/"}},
+		},
+		"RemoveFromTemplate": {
+			dst:  map[string]interface{}{"forProvider": map[string]interface{}{"region": "us-east-1", "path": "/", "description": "d"}, "deletionPolicy": "Orphan"},
+			src:  map[string]interface{}{"forProvider": map[string]interface{}{"region": "us-east-1"}},
+			last: map[string]interface{}{"forProvider": map[string]interface{}{"region": "us-east-1", "description": "d"}, "deletionPolicy": "Orphan"},
+			want: map[string]interface{}{"forProvider": map[string]interface{}{"region": "us-east-1", "path": "/"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, merge(tc.dst, tc.src, tc.last)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewMember(t *testing.T) {
+	f := fleet()
+	f.Spec.Template.Raw = []byte(`{"apiVersion":"iam.aws.crossplane.io/v1beta1","kind":"Role","metadata":{"labels":{"team":"a"}},` +
+		`"spec":{"forProvider":{"assumeRolePolicyDocument":"{}"},"writeConnectionSecretToRef":{"name":"role","namespace":"default"}}}`)
+	tmpl, err := parseTemplate(f, scheme(t))
+	if err != nil {
+		t.Fatalf("parseTemplate(...): %s", err)
+	}
+	m := newMember(f, tmpl, "dev")
+
+	if diff := cmp.Diff(fleetName+"-dev", m.GetName()); diff != "" {
+		t.Errorf("name: -want, +got:\n%s", diff)
+	}
+	got, _, _ := unstructured.NestedString(m.Object, "spec", "writeConnectionSecretToRef", "name")
+	if diff := cmp.Diff("role-dev", got); diff != "" {
+		t.Errorf("connection secret name: -want, +got:\n%s", diff)
+	}
+	want := `{"metadata":{"annotations":null,"labels":{"aws.crossplane.io/fleet":"baseline","aws.crossplane.io/fleet-provider-config":"dev","team":"a"}},` +
+		`"spec":{"forProvider":{"assumeRolePolicyDocument":"{}"},"providerConfigRef":{"name":"dev"},"writeConnectionSecretToRef":{"name":"role-dev","namespace":"default"}}}`
+	if diff := cmp.Diff(want, m.GetAnnotations()[v1alpha1.AnnotationKeyFleetTemplate]); diff != "" {
+		t.Errorf("template annotation: -want, +got:\n%s", diff)
+	}
+}
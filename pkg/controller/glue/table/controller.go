@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsglue "github.com/aws/aws-sdk-go/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/glue"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Table resource"
+	errCreateSession    = "cannot create a new session"
+	errNoDatabaseName   = "databaseName is not set"
+	errGet              = "failed to get Table"
+	errCreate           = "failed to create Table"
+	errUpdate           = "failed to update Table"
+	errDelete           = "failed to delete Table"
+)
+
+// SetupTable adds a controller that reconciles Tables.
+func SetupTable(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.TableGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Table{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.TableGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: glue.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) glue.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client glue.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.DatabaseName == nil {
+		return managed.ExternalObservation{}, errors.New(errNoDatabaseName)
+	}
+	out, err := e.client.GetTableWithContext(ctx, &awsglue.GetTableInput{
+		CatalogId:    cr.Spec.ForProvider.CatalogID,
+		DatabaseName: cr.Spec.ForProvider.DatabaseName,
+		Name:         awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(glue.IsNotFound, err), errGet)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	glue.LateInitializeTable(&cr.Spec.ForProvider, out.Table)
+
+	cr.Status.AtProvider = glue.GenerateTableObservation(out.Table)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        glue.IsTableUpToDate(cr.Spec.ForProvider, out.Table),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateTableWithContext(ctx, &awsglue.CreateTableInput{
+		CatalogId:    cr.Spec.ForProvider.CatalogID,
+		DatabaseName: cr.Spec.ForProvider.DatabaseName,
+		TableInput:   glue.GenerateTableInput(meta.GetExternalName(cr), cr.Spec.ForProvider),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateTableWithContext(ctx, &awsglue.UpdateTableInput{
+		CatalogId:    cr.Spec.ForProvider.CatalogID,
+		DatabaseName: cr.Spec.ForProvider.DatabaseName,
+		TableInput:   glue.GenerateTableInput(meta.GetExternalName(cr), cr.Spec.ForProvider),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Table)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteTableWithContext(ctx, &awsglue.DeleteTableInput{
+		CatalogId:    cr.Spec.ForProvider.CatalogID,
+		DatabaseName: cr.Spec.ForProvider.DatabaseName,
+		Name:         awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(glue.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,383 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsglue "github.com/aws/aws-sdk-go/service/glue"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/glue/fake"
+)
+
+var (
+	tableName    = "events"
+	databaseName = "lake"
+	catalogID    = "123456789012"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	glue *fake.MockClient
+	cr   resource.Managed
+}
+
+type tableModifier func(*v1alpha1.Table)
+
+func withDescription(d string) tableModifier {
+	return func(t *v1alpha1.Table) { t.Spec.ForProvider.Description = &d }
+}
+
+func withCatalogID(id string) tableModifier {
+	return func(t *v1alpha1.Table) { t.Spec.ForProvider.CatalogID = &id }
+}
+
+func withConditions(c ...xpv1.Condition) tableModifier {
+	return func(t *v1alpha1.Table) { t.Status.ConditionedStatus.Conditions = c }
+}
+
+func table(m ...tableModifier) *v1alpha1.Table {
+	cr := &v1alpha1.Table{
+		Spec: v1alpha1.TableSpec{
+			ForProvider: v1alpha1.TableParameters{
+				DatabaseName: &databaseName,
+				TableType:    awsclient.String("EXTERNAL_TABLE"),
+				StorageDescriptor: &v1alpha1.TableStorageDescriptor{
+					Columns:  []v1alpha1.TableColumn{{Name: "id", Type: awsclient.String("string")}},
+					Location: awsclient.String("s3://lake/events/"),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, tableName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func tableData(m ...func(*awsglue.TableData)) *awsglue.TableData {
+	t := &awsglue.TableData{
+		CatalogId:    &catalogID,
+		DatabaseName: &databaseName,
+		Name:         &tableName,
+		TableType:    awsclient.String("EXTERNAL_TABLE"),
+		Parameters:   map[string]*string{"classification": awsclient.String("json")},
+		StorageDescriptor: &awsglue.StorageDescriptor{
+			Columns:  []*awsglue.Column{{Name: awsclient.String("id"), Type: awsclient.String("string")}},
+			Location: awsclient.String("s3://lake/events/"),
+		},
+	}
+	for _, f := range m {
+		f(t)
+	}
+	return t
+}
+
+func getTable(t *awsglue.TableData) func(context.Context, *awsglue.GetTableInput, []request.Option) (*awsglue.GetTableOutput, error) {
+	return func(context.Context, *awsglue.GetTableInput, []request.Option) (*awsglue.GetTableOutput, error) {
+		return &awsglue.GetTableOutput{Table: t}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoDatabaseName": {
+			args: args{
+				cr: table(func(t *v1alpha1.Table) { t.Spec.ForProvider.DatabaseName = nil }),
+			},
+			want: want{
+				cr:  table(func(t *v1alpha1.Table) { t.Spec.ForProvider.DatabaseName = nil }),
+				err: errors.New(errNoDatabaseName),
+			},
+		},
+		"NotFound": {
+			args: args{
+				glue: &fake.MockClient{
+					MockGetTable: func(context.Context, *awsglue.GetTableInput, []request.Option) (*awsglue.GetTableOutput, error) {
+						return nil, awserr.New(awsglue.ErrCodeEntityNotFoundException, "", nil)
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr: table(),
+			},
+		},
+		"GetError": {
+			args: args{
+				glue: &fake.MockClient{
+					MockGetTable: func(context.Context, *awsglue.GetTableInput, []request.Option) (*awsglue.GetTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr:  table(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				glue: &fake.MockClient{
+					MockGetTable: getTable(tableData()),
+				},
+				cr: table(),
+			},
+			want: want{
+				cr: table(
+					withCatalogID(catalogID),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				glue: &fake.MockClient{
+					MockGetTable: getTable(tableData()),
+				},
+				cr: table(withCatalogID(catalogID), withDescription("events")),
+			},
+			want: want{
+				cr: table(
+					withCatalogID(catalogID),
+					withDescription("events"),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.glue}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockClient{
+					MockCreateTable: func(_ context.Context, in *awsglue.CreateTableInput, _ []request.Option) (*awsglue.CreateTableOutput, error) {
+						if awsclient.StringValue(in.DatabaseName) != databaseName || awsclient.StringValue(in.TableInput.Name) != tableName {
+							return nil, errBoom
+						}
+						return &awsglue.CreateTableOutput{}, nil
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr: table(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				glue: &fake.MockClient{
+					MockCreateTable: func(context.Context, *awsglue.CreateTableInput, []request.Option) (*awsglue.CreateTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr:  table(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.glue}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockClient{
+					MockUpdateTable: func(_ context.Context, in *awsglue.UpdateTableInput, _ []request.Option) (*awsglue.UpdateTableOutput, error) {
+						if awsclient.StringValue(in.TableInput.Description) != "events" {
+							return nil, errBoom
+						}
+						return &awsglue.UpdateTableOutput{}, nil
+					},
+				},
+				cr: table(withDescription("events")),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				glue: &fake.MockClient{
+					MockUpdateTable: func(context.Context, *awsglue.UpdateTableInput, []request.Option) (*awsglue.UpdateTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.glue}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				glue: &fake.MockClient{
+					MockDeleteTable: func(context.Context, *awsglue.DeleteTableInput, []request.Option) (*awsglue.DeleteTableOutput, error) {
+						return &awsglue.DeleteTableOutput{}, nil
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr: table(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				glue: &fake.MockClient{
+					MockDeleteTable: func(context.Context, *awsglue.DeleteTableInput, []request.Option) (*awsglue.DeleteTableOutput, error) {
+						return nil, awserr.New(awsglue.ErrCodeEntityNotFoundException, "", nil)
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr: table(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				glue: &fake.MockClient{
+					MockDeleteTable: func(context.Context, *awsglue.DeleteTableInput, []request.Option) (*awsglue.DeleteTableOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: table(),
+			},
+			want: want{
+				cr:  table(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.glue}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package detector
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsguardduty "github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty/fake"
+)
+
+var (
+	detectorID  = "12abc34d567e8fa901bc2d34e56789f0"
+	serviceRole = "arn:aws:iam::123456789012:role/aws-service-role/guardduty.amazonaws.com/AWSServiceRoleForAmazonGuardDuty"
+	errBoom     = errors.New("boom")
+	errNotOwned = awserr.New(awsguardduty.ErrCodeBadRequestException, "The request is rejected because the input detectorId is not owned by the current account.", nil)
+)
+
+type args struct {
+	gd *fake.MockClient
+	cr resource.Managed
+}
+
+type detectorModifier func(*v1alpha1.Detector)
+
+func withEnable(b bool) detectorModifier {
+	return func(r *v1alpha1.Detector) { r.Spec.ForProvider.Enable = &b }
+}
+
+func withExternalName(n string) detectorModifier {
+	return func(r *v1alpha1.Detector) { meta.SetExternalName(r, n) }
+}
+
+func withStatus(s string) detectorModifier {
+	return func(r *v1alpha1.Detector) {
+		r.Status.AtProvider = v1alpha1.DetectorObservation{ServiceRole: serviceRole, Status: s}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) detectorModifier {
+	return func(r *v1alpha1.Detector) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func detector(m ...detectorModifier) *v1alpha1.Detector {
+	cr := &v1alpha1.Detector{
+		Spec: v1alpha1.DetectorSpec{
+			ForProvider: v1alpha1.DetectorParameters{
+				Region: "us-east-1",
+			},
+		},
+	}
+	meta.SetExternalName(cr, detectorID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getDetector(status string) func(context.Context, *awsguardduty.GetDetectorInput, []request.Option) (*awsguardduty.GetDetectorOutput, error) {
+	return func(context.Context, *awsguardduty.GetDetectorInput, []request.Option) (*awsguardduty.GetDetectorOutput, error) {
+		return &awsguardduty.GetDetectorOutput{
+			ServiceRole:                aws.String(serviceRole),
+			Status:                     aws.String(status),
+			FindingPublishingFrequency: aws.String(awsguardduty.FindingPublishingFrequencySixHours),
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				gd: &fake.MockClient{},
+				cr: detector(withExternalName("")),
+			},
+			want: want{
+				cr: detector(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				gd: &fake.MockClient{
+					MockGetDetector: func(context.Context, *awsguardduty.GetDetectorInput, []request.Option) (*awsguardduty.GetDetectorOutput, error) {
+						return nil, errNotOwned
+					},
+				},
+				cr: detector(),
+			},
+			want: want{
+				cr: detector(),
+			},
+		},
+		"GetError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockGetDetector: func(context.Context, *awsguardduty.GetDetectorInput, []request.Option) (*awsguardduty.GetDetectorOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: detector(),
+			},
+			want: want{
+				cr:  detector(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				gd: &fake.MockClient{MockGetDetector: getDetector(awsguardduty.DetectorStatusEnabled)},
+				cr: detector(),
+			},
+			want: want{
+				cr: detector(withStatus(awsguardduty.DetectorStatusEnabled), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Disabled": {
+			args: args{
+				gd: &fake.MockClient{MockGetDetector: getDetector(awsguardduty.DetectorStatusEnabled)},
+				cr: detector(withEnable(false)),
+			},
+			want: want{
+				cr: detector(withEnable(false), withStatus(awsguardduty.DetectorStatusEnabled), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gd: &fake.MockClient{
+					MockCreateDetector: func(_ context.Context, input *awsguardduty.CreateDetectorInput, _ []request.Option) (*awsguardduty.CreateDetectorOutput, error) {
+						if !aws.BoolValue(input.Enable) {
+							return nil, errBoom
+						}
+						return &awsguardduty.CreateDetectorOutput{DetectorId: &detectorID}, nil
+					},
+				},
+				cr: detector(withExternalName("")),
+			},
+			want: want{
+				cr:     detector(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockCreateDetector: func(context.Context, *awsguardduty.CreateDetectorInput, []request.Option) (*awsguardduty.CreateDetectorOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: detector(withExternalName("")),
+			},
+			want: want{
+				cr:  detector(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gd: &fake.MockClient{
+					MockUpdateDetector: func(_ context.Context, input *awsguardduty.UpdateDetectorInput, _ []request.Option) (*awsguardduty.UpdateDetectorOutput, error) {
+						if aws.StringValue(input.DetectorId) != detectorID || aws.BoolValue(input.Enable) {
+							return nil, errBoom
+						}
+						return &awsguardduty.UpdateDetectorOutput{}, nil
+					},
+				},
+				cr: detector(withEnable(false)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockUpdateDetector: func(context.Context, *awsguardduty.UpdateDetectorInput, []request.Option) (*awsguardduty.UpdateDetectorOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: detector(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				gd: &fake.MockClient{
+					MockDeleteDetector: func(context.Context, *awsguardduty.DeleteDetectorInput, []request.Option) (*awsguardduty.DeleteDetectorOutput, error) {
+						return nil, errNotOwned
+					},
+				},
+				cr: detector(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockDeleteDetector: func(context.Context, *awsguardduty.DeleteDetectorInput, []request.Option) (*awsguardduty.DeleteDetectorOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: detector(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsguardduty "github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Member resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get Member"
+	errCreate           = "failed to create Member"
+	errInvite           = "failed to invite Member"
+	errDisassociate     = "failed to disassociate Member"
+	errDelete           = "failed to delete Member"
+)
+
+// SetupMember adds a controller that reconciles GuardDuty Members.
+func SetupMember(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.MemberGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Member{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.MemberGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: guardduty.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) guardduty.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client guardduty.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The external name is the ID of the member account, which is only
+	// set once the account has been associated.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.client.GetMembersWithContext(ctx, &awsguardduty.GetMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+	if len(resp.Members) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	m := resp.Members[0]
+	cr.Status.AtProvider = v1alpha1.MemberObservation{
+		RelationshipStatus: awsclient.StringValue(m.RelationshipStatus),
+		InvitedAt:          awsclient.StringValue(m.InvitedAt),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: guardduty.IsMemberUpToDate(cr.Spec.ForProvider, m),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateMembersWithContext(ctx, &awsguardduty.CreateMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountDetails: []*awsguardduty.AccountDetail{{
+			AccountId: awsclient.String(cr.Spec.ForProvider.AccountID),
+			Email:     awsclient.String(cr.Spec.ForProvider.Email),
+		}},
+	})
+	if err == nil {
+		err = guardduty.UnprocessedAccountsError(resp.UnprocessedAccounts)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	// The invitation is sent by the next update, since a new member is
+	// reported as not being invited yet.
+	meta.SetExternalName(cr, cr.Spec.ForProvider.AccountID)
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.InviteMembersWithContext(ctx, guardduty.GenerateInviteMembersInput(
+		awsclient.StringValue(cr.Spec.ForProvider.DetectorID), meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err == nil {
+		err = guardduty.UnprocessedAccountsError(resp.UnprocessedAccounts)
+	}
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errInvite)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Member)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	accountIDs := []*string{awsclient.String(meta.GetExternalName(cr))}
+	if _, err := e.client.DisassociateMembersWithContext(ctx, &awsguardduty.DisassociateMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: accountIDs,
+	}); err != nil {
+		return awsclient.Wrap(resource.Ignore(guardduty.IsDetectorNotFound, err), errDisassociate)
+	}
+	_, err := e.client.DeleteMembersWithContext(ctx, &awsguardduty.DeleteMembersInput{
+		DetectorId: cr.Spec.ForProvider.DetectorID,
+		AccountIds: accountIDs,
+	})
+	return awsclient.Wrap(resource.Ignore(guardduty.IsDetectorNotFound, err), errDelete)
+}
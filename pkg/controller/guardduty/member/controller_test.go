@@ -0,0 +1,359 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package member
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsguardduty "github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/guardduty/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty"
+	"github.com/crossplane/provider-aws/pkg/clients/guardduty/fake"
+)
+
+var (
+	detectorID = "12abc34d567e8fa901bc2d34e56789f0"
+	accountID  = "111111111111"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	gd *fake.MockClient
+	cr resource.Managed
+}
+
+type memberModifier func(*v1alpha1.Member)
+
+func withExternalName(n string) memberModifier {
+	return func(r *v1alpha1.Member) { meta.SetExternalName(r, n) }
+}
+
+func withRelationshipStatus(s string) memberModifier {
+	return func(r *v1alpha1.Member) { r.Status.AtProvider.RelationshipStatus = s }
+}
+
+func withConditions(c ...xpv1.Condition) memberModifier {
+	return func(r *v1alpha1.Member) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func member(m ...memberModifier) *v1alpha1.Member {
+	cr := &v1alpha1.Member{
+		Spec: v1alpha1.MemberSpec{
+			ForProvider: v1alpha1.MemberParameters{
+				Region:     "us-east-1",
+				DetectorID: &detectorID,
+				AccountID:  accountID,
+				Email:      "security@example.com",
+			},
+		},
+	}
+	meta.SetExternalName(cr, accountID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getMembers(status string) func(context.Context, *awsguardduty.GetMembersInput, []request.Option) (*awsguardduty.GetMembersOutput, error) {
+	return func(context.Context, *awsguardduty.GetMembersInput, []request.Option) (*awsguardduty.GetMembersOutput, error) {
+		return &awsguardduty.GetMembersOutput{Members: []*awsguardduty.Member{{
+			AccountId:          &accountID,
+			RelationshipStatus: aws.String(status),
+		}}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				gd: &fake.MockClient{},
+				cr: member(withExternalName("")),
+			},
+			want: want{
+				cr: member(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				gd: &fake.MockClient{
+					MockGetMembers: func(context.Context, *awsguardduty.GetMembersInput, []request.Option) (*awsguardduty.GetMembersOutput, error) {
+						return &awsguardduty.GetMembersOutput{UnprocessedAccounts: []*awsguardduty.UnprocessedAccount{{AccountId: &accountID}}}, nil
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr: member(),
+			},
+		},
+		"GetError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockGetMembers: func(context.Context, *awsguardduty.GetMembersInput, []request.Option) (*awsguardduty.GetMembersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				cr:  member(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"NotInvitedYet": {
+			args: args{
+				gd: &fake.MockClient{MockGetMembers: getMembers(guardduty.RelationshipStatusCreated)},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withRelationshipStatus(guardduty.RelationshipStatusCreated), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Enabled": {
+			args: args{
+				gd: &fake.MockClient{MockGetMembers: getMembers("Enabled")},
+				cr: member(),
+			},
+			want: want{
+				cr: member(withRelationshipStatus("Enabled"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gd: &fake.MockClient{
+					MockCreateMembers: func(_ context.Context, input *awsguardduty.CreateMembersInput, _ []request.Option) (*awsguardduty.CreateMembersOutput, error) {
+						if aws.StringValue(input.AccountDetails[0].AccountId) != accountID {
+							return nil, errBoom
+						}
+						return &awsguardduty.CreateMembersOutput{}, nil
+					},
+				},
+				cr: member(withExternalName("")),
+			},
+			want: want{
+				cr:     member(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"Unprocessed": {
+			args: args{
+				gd: &fake.MockClient{
+					MockCreateMembers: func(context.Context, *awsguardduty.CreateMembersInput, []request.Option) (*awsguardduty.CreateMembersOutput, error) {
+						return &awsguardduty.CreateMembersOutput{UnprocessedAccounts: []*awsguardduty.UnprocessedAccount{{
+							AccountId: &accountID,
+							Result:    aws.String("boom"),
+						}}}, nil
+					},
+				},
+				cr: member(withExternalName("")),
+			},
+			want: want{
+				cr:  member(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errors.New(accountID+": boom"), errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Invited": {
+			args: args{
+				gd: &fake.MockClient{
+					MockInviteMembers: func(_ context.Context, input *awsguardduty.InviteMembersInput, _ []request.Option) (*awsguardduty.InviteMembersOutput, error) {
+						if aws.StringValue(input.AccountIds[0]) != accountID {
+							return nil, errBoom
+						}
+						return &awsguardduty.InviteMembersOutput{}, nil
+					},
+				},
+				cr: member(),
+			},
+		},
+		"InviteError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockInviteMembers: func(context.Context, *awsguardduty.InviteMembersInput, []request.Option) (*awsguardduty.InviteMembersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errInvite),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				gd: &fake.MockClient{
+					MockDisassociateMembers: func(context.Context, *awsguardduty.DisassociateMembersInput, []request.Option) (*awsguardduty.DisassociateMembersOutput, error) {
+						return &awsguardduty.DisassociateMembersOutput{}, nil
+					},
+					MockDeleteMembers: func(context.Context, *awsguardduty.DeleteMembersInput, []request.Option) (*awsguardduty.DeleteMembersOutput, error) {
+						return &awsguardduty.DeleteMembersOutput{}, nil
+					},
+				},
+				cr: member(),
+			},
+		},
+		"DisassociateError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockDisassociateMembers: func(context.Context, *awsguardduty.DisassociateMembersInput, []request.Option) (*awsguardduty.DisassociateMembersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDisassociate),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				gd: &fake.MockClient{
+					MockDisassociateMembers: func(context.Context, *awsguardduty.DisassociateMembersInput, []request.Option) (*awsguardduty.DisassociateMembersOutput, error) {
+						return &awsguardduty.DisassociateMembersOutput{}, nil
+					},
+					MockDeleteMembers: func(context.Context, *awsguardduty.DeleteMembersInput, []request.Option) (*awsguardduty.DeleteMembersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: member(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.gd}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -18,18 +18,17 @@ package accesskey
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,7 +37,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -47,11 +48,16 @@ const (
 	errCreate           = "failed to create the AccessKey resource"
 	errDelete           = "failed to delete the AccessKey resource"
 	errUpdate           = "failed to update the AccessKey resource"
+	errRotate           = "failed to create the rotated AccessKey"
+	errRetire           = "failed to retire the previous AccessKey"
 )
 
 // SetupAccessKey adds a controller that reconciles AccessKeys.
-func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
+func SetupAccessKey(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.AccessKeyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -60,15 +66,10 @@ func SetupAccessKey(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.AccessKey{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.AccessKeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.AccessKeyGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccessClient},
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -100,19 +101,30 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, nil
 	}
 
+	// The managed reconciler does not persist the external name after an
+	// update, so the ID of a rotated key is only recorded in the status. We
+	// adopt it here and report it as late initialized to have it persisted.
+	rotated := cr.Status.AtProvider.AccessKeyID != "" &&
+		cr.Status.AtProvider.AccessKeyID != meta.GetExternalName(cr) &&
+		cr.Status.AtProvider.PreviousAccessKeyID == meta.GetExternalName(cr)
+	if rotated {
+		meta.SetExternalName(cr, cr.Status.AtProvider.AccessKeyID)
+	}
+
 	keys, err := e.client.ListAccessKeys(ctx, &awsiam.ListAccessKeysInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
 	if err != nil || len(keys.AccessKeyMetadata) == 0 {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errList)
 	}
-	found := false
-	var accessKey awsiamtypes.AccessKeyMetadata
-	for _, key := range keys.AccessKeyMetadata {
-		if aws.ToString(key.AccessKeyId) == meta.GetExternalName(cr) {
-			found = true
-			accessKey = key
+	var accessKey, previous *awsiamtypes.AccessKeyMetadata
+	for i, key := range keys.AccessKeyMetadata {
+		switch aws.ToString(key.AccessKeyId) {
+		case meta.GetExternalName(cr):
+			accessKey = &keys.AccessKeyMetadata[i]
+		case cr.Status.AtProvider.PreviousAccessKeyID:
+			previous = &keys.AccessKeyMetadata[i]
 		}
 	}
-	if !found {
+	if accessKey == nil {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 	switch accessKey.Status {
@@ -121,12 +133,21 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	case awsiamtypes.StatusTypeInactive:
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	cr.Status.AtProvider.AccessKeyID = aws.ToString(accessKey.AccessKeyId)
+	cr.Status.AtProvider.CreateDate = awsclient.LateInitializeTimePtr(nil, accessKey.CreateDate)
+	if previous == nil {
+		cr.Status.AtProvider.PreviousAccessKeyID = ""
+	}
+	now := time.Now()
+	retire := previous != nil && iam.IsAccessKeyGracePeriodOver(cr, now) &&
+		(iam.PreviousAccessKeyPolicy(cr) == v1beta1.PreviousAccessKeyPolicyDelete || previous.Status == awsiamtypes.StatusTypeActive)
+
 	current := cr.Spec.ForProvider.Status
 	cr.Spec.ForProvider.Status = awsclient.LateInitializeString(cr.Spec.ForProvider.Status, aws.String(string(accessKey.Status)))
 	return managed.ExternalObservation{
 		ResourceExists:          true,
-		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status,
-		ResourceLateInitialized: current != cr.Spec.ForProvider.Status,
+		ResourceUpToDate:        string(accessKey.Status) == cr.Spec.ForProvider.Status && !retire && !iam.IsAccessKeyRotationDue(cr, now),
+		ResourceLateInitialized: rotated || current != cr.Spec.ForProvider.Status,
 	}, nil
 }
 
@@ -158,13 +179,78 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	now := time.Now()
+	rotate := iam.IsAccessKeyRotationDue(cr, now)
+
+	// An IAM user can have at most two access keys, so the previous one must
+	// be deleted before we can rotate again.
+	if cr.Status.AtProvider.PreviousAccessKeyID != "" && iam.IsAccessKeyGracePeriodOver(cr, now) {
+		if err := e.retire(ctx, cr, rotate); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRetire)
+		}
+	}
+
+	var conn managed.ConnectionDetails
+	if rotate {
+		var err error
+		if conn, err = e.rotate(ctx, cr, now); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	_, err := e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
 		Status:      awsiamtypes.StatusType(cr.Spec.ForProvider.Status),
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 	})
 
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	return managed.ExternalUpdate{ConnectionDetails: conn}, awsclient.Wrap(err, errUpdate)
+}
+
+// retire deactivates or deletes the access key that was replaced by the last
+// rotation, depending on its policy. It is always deleted if force is true.
+func (e *external) retire(ctx context.Context, cr *v1beta1.AccessKey, force bool) error {
+	id := aws.String(cr.Status.AtProvider.PreviousAccessKeyID)
+	if !force && iam.PreviousAccessKeyPolicy(cr) == v1beta1.PreviousAccessKeyPolicyDeactivate {
+		_, err := e.client.UpdateAccessKey(ctx, &awsiam.UpdateAccessKeyInput{
+			AccessKeyId: id,
+			Status:      awsiamtypes.StatusTypeInactive,
+			UserName:    aws.String(cr.Spec.ForProvider.Username),
+		})
+		return err
+	}
+	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
+		AccessKeyId: id,
+		UserName:    aws.String(cr.Spec.ForProvider.Username),
+	})
+	if resource.Ignore(iam.IsErrorNotFound, err) != nil {
+		return err
+	}
+	cr.Status.AtProvider.PreviousAccessKeyID = ""
+	return nil
+}
+
+// rotate creates a new access key and makes it the current one. The ID of the
+// new key is recorded in the status, from where Observe adopts it as the
+// external name.
+func (e *external) rotate(ctx context.Context, cr *v1beta1.AccessKey, now time.Time) (managed.ConnectionDetails, error) {
+	response, err := e.client.CreateAccessKey(ctx, &awsiam.CreateAccessKeyInput{UserName: aws.String(cr.Spec.ForProvider.Username)})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errRotate)
+	}
+
+	t := metav1.NewTime(now)
+	cr.Status.AtProvider.AccessKeyID = aws.ToString(response.AccessKey.AccessKeyId)
+	cr.Status.AtProvider.PreviousAccessKeyID = meta.GetExternalName(cr)
+	cr.Status.AtProvider.LastRotationTime = &t
+	cr.Status.AtProvider.LastRotationRequest = cr.GetAnnotations()[v1beta1.AnnotationKeyRotateAccessKey]
+	cr.Status.AtProvider.CreateDate = awsclient.LateInitializeTimePtr(nil, response.AccessKey.CreateDate)
+	meta.SetExternalName(cr, cr.Status.AtProvider.AccessKeyID)
+
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.ToString(response.AccessKey.AccessKeyId)),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(aws.ToString(response.AccessKey.SecretAccessKey)),
+	}, nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -175,6 +261,12 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 
 	cr.Status.SetConditions(xpv1.Deleting())
 
+	if cr.Status.AtProvider.PreviousAccessKeyID != "" {
+		if err := e.retire(ctx, cr, true); err != nil {
+			return awsclient.Wrap(err, errRetire)
+		}
+	}
+
 	_, err := e.client.DeleteAccessKey(ctx, &awsiam.DeleteAccessKeyInput{
 		UserName:    aws.String(cr.Spec.ForProvider.Username),
 		AccessKeyId: aws.String(meta.GetExternalName(cr)),
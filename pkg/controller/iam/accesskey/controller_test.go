@@ -19,12 +19,15 @@ package accesskey
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	awsiamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -35,6 +38,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/dryrun"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
 	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
 )
@@ -47,6 +51,8 @@ var (
 	inactiveStatus = awsiamtypes.StatusTypeInactive
 	accessKeyID    = "accessKeyID"
 	secretKeyID    = "secretKeyID"
+	newAccessKeyID = "newAccessKeyID"
+	rotateRequest  = "2022-03-01"
 
 	errBoom = errors.New("boom")
 )
@@ -81,6 +87,45 @@ func withAccessKey(keyid string) accessModifier {
 	}
 }
 
+func withRotation(r *v1beta1.AccessKeyRotation) accessModifier {
+	return func(r2 *v1beta1.AccessKey) {
+		r2.Spec.ForProvider.Rotation = r
+	}
+}
+
+func withRotateAnnotation(v string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		meta.AddAnnotations(r, map[string]string{v1beta1.AnnotationKeyRotateAccessKey: v})
+	}
+}
+
+func withCreateDate(t time.Time) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		d := metav1.NewTime(t)
+		r.Status.AtProvider.CreateDate = &d
+	}
+}
+
+func withPreviousAccessKey(id string, rotated time.Time) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		t := metav1.NewTime(rotated)
+		r.Status.AtProvider.PreviousAccessKeyID = id
+		r.Status.AtProvider.LastRotationTime = &t
+	}
+}
+
+func withAccessKeyID(id string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider.AccessKeyID = id
+	}
+}
+
+func withLastRotationRequest(v string) accessModifier {
+	return func(r *v1beta1.AccessKey) {
+		r.Status.AtProvider.LastRotationRequest = v
+	}
+}
+
 func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 	cr := &v1beta1.AccessKey{}
 	for _, f := range m {
@@ -90,6 +135,8 @@ func accesskey(m ...accessModifier) *v1beta1.AccessKey {
 }
 
 func TestObserve(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+
 	type want struct {
 		cr     resource.Managed
 		result managed.ExternalObservation
@@ -118,6 +165,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
+					withAccessKeyID(accessKeyID),
 					withStatus(string(activeStatus)),
 					withConditions(xpv1.Available())),
 				result: managed.ExternalObservation{
@@ -144,6 +192,7 @@ func TestObserve(t *testing.T) {
 			want: want{
 				cr: accesskey(withUsername(userName),
 					withAccessKey(accessKeyID),
+					withAccessKeyID(accessKeyID),
 					withStatus(string(activeStatus)),
 					withConditions(xpv1.Unavailable())),
 				result: managed.ExternalObservation{
@@ -152,6 +201,103 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"RotationDue": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+								CreateDate:  &created,
+							}},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withRotation(&v1beta1.AccessKeyRotation{MaxAge: &metav1.Duration{Duration: time.Hour}})),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withAccessKeyID(accessKeyID),
+					withStatus(string(activeStatus)),
+					withRotation(&v1beta1.AccessKeyRotation{MaxAge: &metav1.Duration{Duration: time.Hour}}),
+					withCreateDate(created),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"PreviousKeyGone": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+								AccessKeyId: aws.String(accessKeyID),
+								Status:      activeStatus,
+								UserName:    aws.String(userName),
+							}},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withPreviousAccessKey(newAccessKeyID, created)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(accessKeyID),
+					withAccessKeyID(accessKeyID),
+					withStatus(string(activeStatus)),
+					withPreviousAccessKey("", created),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RotatedKeyAdopted": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+						return &awsiam.ListAccessKeysOutput{
+							AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{
+								{
+									AccessKeyId: aws.String(accessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								},
+								{
+									AccessKeyId: aws.String(newAccessKeyID),
+									Status:      activeStatus,
+									UserName:    aws.String(userName),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+					withAccessKeyID(newAccessKeyID), withPreviousAccessKey(accessKeyID, created)),
+			},
+			want: want{
+				cr: accesskey(withUsername(userName),
+					withAccessKey(newAccessKeyID),
+					withAccessKeyID(newAccessKeyID),
+					withStatus(string(activeStatus)),
+					withPreviousAccessKey(accessKeyID, created),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
 		"ValidInputNotExists": {
 			args: args{
 				iam: &fake.MockAccessClient{
@@ -404,6 +550,76 @@ func TestUpdate(t *testing.T) {
 				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus))),
 			},
 		},
+		"Rotate": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+						return &awsiam.CreateAccessKeyOutput{AccessKey: &awsiamtypes.AccessKey{
+							AccessKeyId:     aws.String(newAccessKeyID),
+							SecretAccessKey: aws.String(secretKeyID),
+						}}, nil
+					},
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != newAccessKeyID {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotateAnnotation(rotateRequest)),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(newAccessKeyID), withUsername(userName), withStatus(string(activeStatus)), withRotateAnnotation(rotateRequest),
+					withAccessKeyID(newAccessKeyID), withPreviousAccessKey(accessKeyID, time.Time{}), withLastRotationRequest(rotateRequest)),
+				update: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(newAccessKeyID),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(secretKeyID),
+					},
+				},
+			},
+		},
+		"RetirePreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockDeleteAccessKey: func(ctx context.Context, input *awsiam.DeleteAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.DeleteAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) != newAccessKeyID {
+							return nil, errBoom
+						}
+						return &awsiam.DeleteAccessKeyOutput{}, nil
+					},
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withPreviousAccessKey(newAccessKeyID, time.Now().Add(-48*time.Hour))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withPreviousAccessKey("", time.Time{})),
+			},
+		},
+		"DeactivatePreviousKey": {
+			args: args{
+				iam: &fake.MockAccessClient{
+					MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+						if aws.ToString(input.AccessKeyId) == newAccessKeyID && input.Status != inactiveStatus {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateAccessKeyOutput{}, nil
+					},
+				},
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(&v1beta1.AccessKeyRotation{PreviousKeyPolicy: aws.String(v1beta1.PreviousAccessKeyPolicyDeactivate)}),
+					withPreviousAccessKey(newAccessKeyID, time.Now().Add(-48*time.Hour))),
+			},
+			want: want{
+				cr: accesskey(withAccessKey(accessKeyID), withUsername(userName), withStatus(string(activeStatus)),
+					withRotation(&v1beta1.AccessKeyRotation{PreviousKeyPolicy: aws.String(v1beta1.PreviousAccessKeyPolicyDeactivate)}),
+					withPreviousAccessKey(newAccessKeyID, time.Time{})),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -431,7 +647,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.iam}
+			e := &external{client: tc.iam, kube: tc.kube}
 			update, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -440,9 +656,69 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.update, update, test.EquateConditions()); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+			// The time of a rotation is not known in advance.
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions(), cmpopts.IgnoreFields(v1beta1.AccessKeyObservation{}, "LastRotationTime")); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestDryRunRotate(t *testing.T) {
+	created := time.Now().Add(-2 * time.Hour)
+	write := func(context.Context, client.Object, ...client.UpdateOption) error {
+		t.Error("unexpected write to the API server in dry-run mode")
+		return nil
+	}
+	iamc := &fake.MockAccessClient{
+		MockListAccessKeys: func(ctx context.Context, input *awsiam.ListAccessKeysInput, opts []func(*awsiam.Options)) (*awsiam.ListAccessKeysOutput, error) {
+			return &awsiam.ListAccessKeysOutput{
+				AccessKeyMetadata: []awsiamtypes.AccessKeyMetadata{{
+					AccessKeyId: aws.String(accessKeyID),
+					Status:      activeStatus,
+					UserName:    aws.String(userName),
+					CreateDate:  &created,
+				}},
+			}, nil
+		},
+		MockCreateAccessKey: func(ctx context.Context, input *awsiam.CreateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.CreateAccessKeyOutput, error) {
+			return &awsiam.CreateAccessKeyOutput{AccessKey: &awsiamtypes.AccessKey{
+				AccessKeyId:     aws.String(newAccessKeyID),
+				SecretAccessKey: aws.String(secretKeyID),
+			}}, nil
+		},
+		MockUpdateAccessKey: func(ctx context.Context, input *awsiam.UpdateAccessKeyInput, opts []func(*awsiam.Options)) (*awsiam.UpdateAccessKeyOutput, error) {
+			return &awsiam.UpdateAccessKeyOutput{}, nil
+		},
+	}
+	c := dryrun.NewConnecter(managed.ExternalConnectorFn(func(context.Context, resource.Managed) (managed.ExternalClient, error) {
+		return &external{client: iamc, kube: &test.MockClient{MockUpdate: write}}, nil
+	}))
+	cr := accesskey(withUsername(userName), withAccessKey(accessKeyID), withStatus(string(activeStatus)),
+		withRotation(&v1beta1.AccessKeyRotation{MaxAge: &metav1.Duration{Duration: time.Hour}}))
+	meta.AddAnnotations(cr, map[string]string{dryrun.AnnotationKey: "true"})
+
+	e, err := c.Connect(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Connect(...): %s", err)
+	}
+	o, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("Observe(...): %s", err)
+	}
+	if !o.ResourceUpToDate {
+		t.Errorf("Observe(...): a rotation that is due must not be made in dry-run mode")
+	}
+	if diff := cmp.Diff(dryrun.ReasonWouldUpdate, cr.GetCondition(dryrun.TypeDryRun).Reason); diff != "" {
+		t.Errorf("Observe(...): -want reason, +got:\n%s", diff)
+	}
+	if _, err := e.Update(context.Background(), cr); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if diff := cmp.Diff(accessKeyID, meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("Update(...): -want external name, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(v1beta1.AccessKeyObservation{AccessKeyID: accessKeyID, CreateDate: &metav1.Time{Time: created}}, cr.Status.AtProvider); diff != "" {
+		t.Errorf("Update(...): -want status, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/singleton"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccountAlias resource"
+
+	errList   = "cannot list account aliases in AWS"
+	errCreate = "cannot create account alias in AWS"
+	errDelete = "cannot delete account alias in AWS"
+)
+
+// SetupAccountAlias adds a controller that reconciles AccountAlias.
+func SetupAccountAlias(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1beta1.AccountAliasGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1beta1.AccountAlias{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.AccountAliasGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: iam.NewAccountAliasClient},
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.AccountAliasClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client iam.AccountAliasClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	bound, err := singleton.Guard(ctx, e.kube, cr, &v1beta1.AccountAliasList{})
+	if singleton.IsConflict(err) && meta.WasDeleted(cr) {
+		// The alias is managed by another AccountAlias, so deleting this
+		// one must leave it alone.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	resp, err := e.client.ListAccountAliases(ctx, &awsiam.ListAccountAliasesInput{})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errList)
+	}
+	// An account has at most one alias.
+	if len(resp.AccountAliases) == 0 {
+		cr.Status.AtProvider = v1beta1.AccountAliasObservation{}
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.AccountAlias = resp.AccountAliases[0]
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cr.Status.AtProvider.AccountAlias == cr.Spec.ForProvider.AccountAlias,
+		ResourceLateInitialized: bound,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+// Update replaces the alias of the account, which cannot be changed in place.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if err := e.delete(ctx, cr.Status.AtProvider.AccountAlias); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	_, err := e.client.CreateAccountAlias(ctx, &awsiam.CreateAccountAliasInput{
+		AccountAlias: aws.String(cr.Spec.ForProvider.AccountAlias),
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.AccountAlias)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return e.delete(ctx, cr.Status.AtProvider.AccountAlias)
+}
+
+func (e *external) delete(ctx context.Context, alias string) error {
+	_, err := e.client.DeleteAccountAlias(ctx, &awsiam.DeleteAccountAliasInput{
+		AccountAlias: aws.String(alias),
+	})
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountalias
+
+import (
+	"context"
+	"testing"
+
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/singleton"
+)
+
+var (
+	unexpectedItem resource.Managed
+	account        = "123456789012"
+	aliasName      = "example"
+	otherAlias     = "other"
+	deleted        = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockAccountAliasClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type accountAliasModifier func(*v1beta1.AccountAlias)
+
+func withConditions(c ...xpv1.Condition) accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { meta.SetExternalName(r, name) }
+}
+
+func withObservedAlias(a string) accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { r.Status.AtProvider.AccountAlias = a }
+}
+
+func withDeletionTimestamp() accountAliasModifier {
+	return func(r *v1beta1.AccountAlias) { r.SetDeletionTimestamp(&deleted) }
+}
+
+func accountAlias(m ...accountAliasModifier) *v1beta1.AccountAlias {
+	cr := &v1beta1.AccountAlias{}
+	cr.SetName("mine")
+	cr.SetUID("mine")
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	cr.Spec.ForProvider.AccountAlias = aliasName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// kube returns a client whose ProviderConfig resolves to the test account and
+// whose list of AccountAliases contains the supplied others.
+func kube(others ...v1beta1.AccountAlias) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc := obj.(*awsv1beta1.ProviderConfig)
+			pc.Status.Identity = &awsv1beta1.CallerIdentity{AccountID: account}
+			return nil
+		},
+		MockList: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+			list.(*v1beta1.AccountAliasList).Items = others
+			return nil
+		},
+	}
+}
+
+// owner returns an AccountAlias that was bound to the test account before the
+// one under test, since it was created at the same time but sorts first.
+func owner() v1beta1.AccountAlias {
+	o := accountAlias(withExternalName(account))
+	o.SetName("first")
+	o.SetUID("first")
+	return *o
+}
+
+func listAliases(aliases ...string) func(context.Context, *awsiam.ListAccountAliasesInput, []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+	return func(context.Context, *awsiam.ListAccountAliasesInput, []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+		return &awsiam.ListAccountAliasesOutput{AccountAliases: aliases}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"BindsToAccount": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{MockListAccountAliases: listAliases(aliasName)},
+				kube: kube(),
+				cr:   accountAlias(),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(account), withObservedAlias(aliasName),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"AliasChanged": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{MockListAccountAliases: listAliases(otherAlias)},
+				kube: kube(),
+				cr:   accountAlias(withExternalName(account)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(account), withObservedAlias(otherAlias),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoAlias": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{MockListAccountAliases: listAliases()},
+				kube: kube(),
+				cr:   accountAlias(withExternalName(account), withObservedAlias(otherAlias)),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(account)),
+			},
+		},
+		"AlreadyManaged": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{},
+				kube: kube(owner()),
+				cr:   accountAlias(withExternalName(account)),
+			},
+			want: want{
+				cr:  accountAlias(withExternalName(account)),
+				err: &singleton.ConflictError{AccountID: account, Owner: "first"},
+			},
+		},
+		"AlreadyManagedWhileDeleting": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{},
+				kube: kube(owner()),
+				cr:   accountAlias(withExternalName(account), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: accountAlias(withExternalName(account), withDeletionTimestamp()),
+			},
+		},
+		"WrongAccount": {
+			args: args{
+				iam:  &fake.MockAccountAliasClient{},
+				kube: kube(),
+				cr:   accountAlias(withExternalName("210987654321")),
+			},
+			want: want{
+				cr:  accountAlias(withExternalName("210987654321")),
+				err: errors.New(`external name "210987654321" is not the ID of account 123456789012 that the ProviderConfig resolves to`),
+			},
+		},
+		"ListError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockListAccountAliases: func(context.Context, *awsiam.ListAccountAliasesInput, []func(*awsiam.Options)) (*awsiam.ListAccountAliasesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				kube: kube(),
+				cr:   accountAlias(withExternalName(account)),
+			},
+			want: want{
+				cr:  accountAlias(withExternalName(account)),
+				err: awsclient.Wrap(errBoom, errList),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		deleted string
+		created string
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ReplacesAlias": {
+			args: args{
+				cr: accountAlias(withExternalName(account), withObservedAlias(otherAlias)),
+			},
+			want: want{
+				deleted: otherAlias,
+				created: aliasName,
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deleted, created string
+			client := &fake.MockAccountAliasClient{
+				MockDeleteAccountAlias: func(_ context.Context, input *awsiam.DeleteAccountAliasInput, _ []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+					deleted = *input.AccountAlias
+					return &awsiam.DeleteAccountAliasOutput{}, nil
+				},
+				MockCreateAccountAlias: func(_ context.Context, input *awsiam.CreateAccountAliasInput, _ []func(*awsiam.Options)) (*awsiam.CreateAccountAliasOutput, error) {
+					created = *input.AccountAlias
+					return &awsiam.CreateAccountAliasOutput{}, nil
+				},
+			}
+			e := &external{client: client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockDeleteAccountAlias: func(context.Context, *awsiam.DeleteAccountAliasInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return &awsiam.DeleteAccountAliasOutput{}, nil
+					},
+				},
+				cr: accountAlias(withObservedAlias(aliasName)),
+			},
+			want: want{
+				cr: accountAlias(withObservedAlias(aliasName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockDeleteAccountAlias: func(context.Context, *awsiam.DeleteAccountAliasInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: accountAlias(withObservedAlias(aliasName)),
+			},
+			want: want{
+				cr: accountAlias(withObservedAlias(aliasName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				iam: &fake.MockAccountAliasClient{
+					MockDeleteAccountAlias: func(context.Context, *awsiam.DeleteAccountAliasInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountAliasOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accountAlias(withObservedAlias(aliasName)),
+			},
+			want: want{
+				cr:  accountAlias(withObservedAlias(aliasName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
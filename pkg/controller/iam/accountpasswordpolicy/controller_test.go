@@ -0,0 +1,380 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpasswordpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	account        = "123456789012"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockAccountPasswordPolicyClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type policyModifier func(*v1beta1.AccountPasswordPolicy)
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) policyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { meta.SetExternalName(r, name) }
+}
+
+func withSpec(p v1beta1.AccountPasswordPolicyParameters) policyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Spec.ForProvider = p }
+}
+
+func withExpirePasswords(e bool) policyModifier {
+	return func(r *v1beta1.AccountPasswordPolicy) { r.Status.AtProvider.ExpirePasswords = e }
+}
+
+func policy(m ...policyModifier) *v1beta1.AccountPasswordPolicy {
+	cr := &v1beta1.AccountPasswordPolicy{}
+	cr.SetName("mine")
+	cr.SetUID("mine")
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "default"})
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+// kube returns a client whose ProviderConfig resolves to the test account and
+// that lists no other AccountPasswordPolicies.
+func kube() client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			pc := obj.(*awsv1beta1.ProviderConfig)
+			pc.Status.Identity = &awsv1beta1.CallerIdentity{AccountID: account}
+			return nil
+		},
+		MockList: test.NewMockListFn(nil),
+	}
+}
+
+func observed() *iamtypes.PasswordPolicy {
+	return &iamtypes.PasswordPolicy{
+		AllowUsersToChangePassword: true,
+		ExpirePasswords:            true,
+		HardExpiry:                 aws.Bool(false),
+		MaxPasswordAge:             aws.Int32(90),
+		MinimumPasswordLength:      aws.Int32(14),
+		PasswordReusePrevention:    aws.Int32(24),
+		RequireLowercaseCharacters: true,
+		RequireNumbers:             true,
+		RequireSymbols:             true,
+		RequireUppercaseCharacters: true,
+	}
+}
+
+func params() v1beta1.AccountPasswordPolicyParameters {
+	return v1beta1.AccountPasswordPolicyParameters{
+		AllowUsersToChangePassword: aws.Bool(true),
+		HardExpiry:                 aws.Bool(false),
+		MaxPasswordAge:             aws.Int32(90),
+		MinimumPasswordLength:      aws.Int32(14),
+		PasswordReusePrevention:    aws.Int32(24),
+		RequireLowercaseCharacters: aws.Bool(true),
+		RequireNumbers:             aws.Bool(true),
+		RequireSymbols:             aws.Bool(true),
+		RequireUppercaseCharacters: aws.Bool(true),
+	}
+}
+
+func getPolicy(p *iamtypes.PasswordPolicy, err error) func(context.Context, *awsiam.GetAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+	return func(context.Context, *awsiam.GetAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.GetAccountPasswordPolicyOutput, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &awsiam.GetAccountPasswordPolicyOutput{PasswordPolicy: p}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	shorter := params()
+	shorter.MinimumPasswordLength = aws.Int32(8)
+
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam:  &fake.MockAccountPasswordPolicyClient{MockGetAccountPasswordPolicy: getPolicy(observed(), nil)},
+				kube: kube(),
+				cr:   policy(withExternalName(account), withSpec(params())),
+			},
+			want: want{
+				cr: policy(withExternalName(account), withSpec(params()), withExpirePasswords(true),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialized": {
+			args: args{
+				iam:  &fake.MockAccountPasswordPolicyClient{MockGetAccountPasswordPolicy: getPolicy(observed(), nil)},
+				kube: kube(),
+				cr:   policy(),
+			},
+			want: want{
+				cr: policy(withExternalName(account), withSpec(params()), withExpirePasswords(true),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"Outdated": {
+			args: args{
+				iam:  &fake.MockAccountPasswordPolicyClient{MockGetAccountPasswordPolicy: getPolicy(observed(), nil)},
+				kube: kube(),
+				cr:   policy(withExternalName(account), withSpec(shorter)),
+			},
+			want: want{
+				cr: policy(withExternalName(account), withSpec(shorter), withExpirePasswords(true),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				iam:  &fake.MockAccountPasswordPolicyClient{MockGetAccountPasswordPolicy: getPolicy(nil, &iamtypes.NoSuchEntityException{})},
+				kube: kube(),
+				cr:   policy(withExternalName(account)),
+			},
+			want: want{
+				cr: policy(withExternalName(account)),
+			},
+		},
+		"GetError": {
+			args: args{
+				iam:  &fake.MockAccountPasswordPolicyClient{MockGetAccountPasswordPolicy: getPolicy(nil, errBoom)},
+				kube: kube(),
+				cr:   policy(withExternalName(account)),
+			},
+			want: want{
+				cr:  policy(withExternalName(account)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.iam}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		input *awsiam.UpdateAccountPasswordPolicyInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				cr: policy(withSpec(v1beta1.AccountPasswordPolicyParameters{
+					MinimumPasswordLength: aws.Int32(14),
+					MaxPasswordAge:        aws.Int32(0),
+				})),
+			},
+			want: want{
+				input: &awsiam.UpdateAccountPasswordPolicyInput{MinimumPasswordLength: aws.Int32(14)},
+			},
+		},
+		"UpdateError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(context.Context, *awsiam.UpdateAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var input *awsiam.UpdateAccountPasswordPolicyInput
+			client := tc.iam
+			if client == nil {
+				client = &fake.MockAccountPasswordPolicyClient{
+					MockUpdateAccountPasswordPolicy: func(_ context.Context, in *awsiam.UpdateAccountPasswordPolicyInput, _ []func(*awsiam.Options)) (*awsiam.UpdateAccountPasswordPolicyOutput, error) {
+						input = in
+						return &awsiam.UpdateAccountPasswordPolicyOutput{}, nil
+					},
+				}
+			}
+			e := &external{client: client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input, cmpopts.IgnoreUnexported(awsiam.UpdateAccountPasswordPolicyInput{})); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(context.Context, *awsiam.DeleteAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return &awsiam.DeleteAccountPasswordPolicyOutput{}, nil
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(context.Context, *awsiam.DeleteAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				iam: &fake.MockAccountPasswordPolicyClient{
+					MockDeleteAccountPasswordPolicy: func(context.Context, *awsiam.DeleteAccountPasswordPolicyInput, []func(*awsiam.Options)) (*awsiam.DeleteAccountPasswordPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr:  policy(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,8 +35,11 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -52,8 +52,11 @@ const (
 
 // SetupGroupPolicyAttachment adds a controller that reconciles
 // GroupPolicyAttachments.
-func SetupGroupPolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
+func SetupGroupPolicyAttachment(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.GroupPolicyAttachmentGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -62,17 +65,12 @@ func SetupGroupPolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.GroupPolicyAttachment{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.GroupPolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupPolicyAttachmentClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.GroupPolicyAttachmentGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Composite("/", externalname.PathName("<group-name>", "iam", "group"), externalname.ARN("<policy-arn>", "iam")), &connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupPolicyAttachmentClient}),
 			managed.WithConnectionPublishers(),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -111,22 +109,28 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 	groupName, policyARN := nn[0], nn[1]
 
-	observed, err := e.client.ListAttachedGroupPolicies(ctx, &awsiam.ListAttachedGroupPoliciesInput{
-		GroupName: &groupName,
+	var attachedPolicyObject *awsiamtypes.AttachedPolicy
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, marker *string) (*string, bool, error) {
+		observed, err := e.client.ListAttachedGroupPolicies(ctx, &awsiam.ListAttachedGroupPoliciesInput{
+			GroupName: &groupName,
+			Marker:    marker,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i, policy := range observed.AttachedPolicies {
+			if policyARN == aws.ToString(policy.PolicyArn) {
+				attachedPolicyObject = &observed.AttachedPolicies[i]
+				return nil, true, nil
+			}
+		}
+		return iam.NextMarker(observed.IsTruncated, observed.Marker), false, nil
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
 
-	var attachedPolicyObject *awsiamtypes.AttachedPolicy
-	for i, policy := range observed.AttachedPolicies {
-		if policyARN == aws.ToString(policy.PolicyArn) {
-			attachedPolicyObject = &observed.AttachedPolicies[i]
-			break
-		}
-	}
-
-	if attachedPolicyObject == nil {
+	if !found {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -123,6 +123,39 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AttachedOnLaterPage": {
+			args: args{
+				iam: &fake.MockGroupPolicyAttachmentClient{
+					MockListAttachedGroupPolicies: func(ctx context.Context, input *awsiam.ListAttachedGroupPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedGroupPoliciesOutput, error) {
+						if input.Marker == nil {
+							return &awsiam.ListAttachedGroupPoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: awsclient.String("other")}},
+								IsTruncated:      true,
+								Marker:           awsclient.String("next"),
+							}, nil
+						}
+						return &awsiam.ListAttachedGroupPoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: &policyArn}},
+						}, nil
+					},
+				},
+				cr: groupPolicy(withSpecGroupName(groupName),
+					withExternalName(groupName+"/"+policyArn),
+					withSpecPolicyArn(policyArn)),
+			},
+			want: want{
+				cr: groupPolicy(
+					withExternalName(groupName+"/"+policyArn),
+					withSpecGroupName(groupName),
+					withSpecPolicyArn(policyArn),
+					withConditions(xpv1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -28,9 +28,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,8 +35,11 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -52,8 +52,11 @@ const (
 
 // SetupGroupUserMembership adds a controller that reconciles
 // GroupUserMemberships.
-func SetupGroupUserMembership(mgr ctrl.Manager, o controller.Options) error {
+func SetupGroupUserMembership(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.GroupUserMembershipGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -62,17 +65,12 @@ func SetupGroupUserMembership(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.GroupUserMembership{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.GroupUserMembershipGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.GroupUserMembershipGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Composite("/", externalname.PathName("<group-name>", "iam", "group"), externalname.PathName("<user-name>", "iam", "user")), &connector{kube: mgr.GetClient(), newClientFn: iam.NewGroupUserMembershipClient}),
 			managed.WithConnectionPublishers(),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -111,22 +109,28 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	}
 	groupName, userName := nn[0], nn[1]
 
-	observed, err := e.client.ListGroupsForUser(ctx, &awsiam.ListGroupsForUserInput{
-		UserName: &userName,
+	var attachedGroupObject *awsiamtypes.Group
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, marker *string) (*string, bool, error) {
+		observed, err := e.client.ListGroupsForUser(ctx, &awsiam.ListGroupsForUserInput{
+			UserName: &userName,
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i, group := range observed.Groups {
+			if groupName == aws.ToString(group.GroupName) {
+				attachedGroupObject = &observed.Groups[i]
+				return nil, true, nil
+			}
+		}
+		return iam.NextMarker(observed.IsTruncated, observed.Marker), false, nil
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
 
-	var attachedGroupObject *awsiamtypes.Group
-	for i, group := range observed.Groups {
-		if groupName == aws.ToString(group.GroupName) {
-			attachedGroupObject = &observed.Groups[i]
-			break
-		}
-	}
-
-	if attachedGroupObject == nil {
+	if !found {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
@@ -120,6 +120,35 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"MemberOnLaterPage": {
+			args: args{
+				iam: &fake.MockGroupUserMembershipClient{
+					MockListGroupsForUser: func(ctx context.Context, input *awsiam.ListGroupsForUserInput, opts []func(*awsiam.Options)) (*awsiam.ListGroupsForUserOutput, error) {
+						if input.Marker == nil {
+							return &awsiam.ListGroupsForUserOutput{
+								Groups:      []awsiamtypes.Group{{GroupName: awsclient.String("other")}},
+								IsTruncated: true,
+								Marker:      awsclient.String("next"),
+							}, nil
+						}
+						return &awsiam.ListGroupsForUserOutput{
+							Groups: []awsiamtypes.Group{{Arn: &groupArn, GroupName: &groupName}},
+						}, nil
+					},
+				},
+				cr: userGroup(withExternalName(groupName + "/" + userName)),
+			},
+			want: want{
+				cr: userGroup(
+					withExternalName(groupName+"/"+userName),
+					withConditions(xpv1.Available()),
+					withStatusGroupArn(groupArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InvalidInput": {
 			args: args{
 				cr: unexpectedItem,
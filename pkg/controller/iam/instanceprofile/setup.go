@@ -21,12 +21,10 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/iam"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -34,17 +32,31 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errAddRole    = "cannot add role to instance profile"
+	errRemoveRole = "cannot remove role from instance profile"
 )
 
 // SetupInstanceProfile adds a controller that reconciles InstanceProfile.
-func SetupInstanceProfile(mgr ctrl.Manager, o controller.Options) error {
+func SetupInstanceProfile(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.InstanceProfileGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			u := &updater{client: e.client}
 			e.preObserve = preObserve
 			e.postObserve = postObserve
+			e.isUpToDate = isUpToDate
+			e.update = u.update
 			e.preCreate = preCreate
 			e.postCreate = u.postCreate
 			e.preDelete = u.preDelete
@@ -58,14 +70,10 @@ func SetupInstanceProfile(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.InstanceProfile{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.InstanceProfileGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.InstanceProfileGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.PathName("<instance-profile-name>", "iam", "instance-profile"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -87,13 +95,72 @@ func preCreate(_ context.Context, cr *svcapitypes.InstanceProfile, obj *svcsdk.C
 	return nil
 }
 
+// isUpToDate is called after the observation has been copied to the status.
+func isUpToDate(cr *svcapitypes.InstanceProfile, _ *svcsdk.GetInstanceProfileOutput) (bool, error) {
+	add, remove := diffRoles(aws.StringValue(cr.Spec.ForProvider.Role), roleNames(cr.Status.AtProvider.Roles))
+	return add == "" && len(remove) == 0, nil
+}
+
+func roleNames(roles []*svcapitypes.Role) []string {
+	names := make([]string, len(roles))
+	for i, r := range roles {
+		names[i] = aws.StringValue(r.RoleName)
+	}
+	return names
+}
+
+// diffRoles returns the role that needs to be added to an instance profile,
+// if any, and the roles that need to be removed from it so that the desired
+// role is the only one attached.
+func diffRoles(desired string, observed []string) (string, []string) {
+	add := desired
+	var remove []string
+	for _, name := range observed {
+		if name == desired {
+			add = ""
+			continue
+		}
+		remove = append(remove, name)
+	}
+	return add, remove
+}
+
 type updater struct {
 	client svcsdkapi.IAMAPI
 }
 
+// An instance profile can contain only a single role, so the observed one has
+// to be removed before the desired one can be added.
+func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.InstanceProfile)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	add, remove := diffRoles(aws.StringValue(cr.Spec.ForProvider.Role), roleNames(cr.Status.AtProvider.Roles))
+	for _, r := range remove {
+		if _, err := u.client.RemoveRoleFromInstanceProfileWithContext(ctx, &svcsdk.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		}); resource.Ignore(IsNotFound, err) != nil {
+			return managed.ExternalUpdate{}, aws.Wrap(err, errRemoveRole)
+		}
+	}
+	if add == "" {
+		return managed.ExternalUpdate{}, nil
+	}
+	err := iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		_, err := u.client.AddRoleToInstanceProfileWithContext(ctx, &svcsdk.AddRoleToInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(add),
+		})
+		return err
+	})
+	return managed.ExternalUpdate{}, aws.Wrap(err, errAddRole)
+}
+
 func (u *updater) postCreate(ctx context.Context, cr *svcapitypes.InstanceProfile, resp *svcsdk.CreateInstanceProfileOutput, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
-	if err != nil {
-		return managed.ExternalCreation{}, err
+	if err != nil || cr.Spec.ForProvider.Role == nil {
+		return cre, err
 	}
 
 	input := &svcsdk.AddRoleToInstanceProfileInput{
@@ -101,17 +168,23 @@ func (u *updater) postCreate(ctx context.Context, cr *svcapitypes.InstanceProfil
 		RoleName:            cr.Spec.ForProvider.Role,
 	}
 
-	_, err = u.client.AddRoleToInstanceProfileWithContext(ctx, input)
-	return cre, err
+	err = iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		_, err := u.client.AddRoleToInstanceProfileWithContext(ctx, input)
+		return err
+	})
+	return cre, aws.Wrap(err, errAddRole)
 }
 
+// An instance profile cannot be deleted while it contains a role.
 func (u *updater) preDelete(ctx context.Context, cr *svcapitypes.InstanceProfile, obj *svcsdk.DeleteInstanceProfileInput) (bool, error) {
 	obj.InstanceProfileName = aws.String(meta.GetExternalName(cr))
-	input := &svcsdk.RemoveRoleFromInstanceProfileInput{
-		InstanceProfileName: aws.String(meta.GetExternalName(cr)),
-		RoleName:            cr.Spec.ForProvider.Role,
+	for _, r := range roleNames(cr.Status.AtProvider.Roles) {
+		if _, err := u.client.RemoveRoleFromInstanceProfileWithContext(ctx, &svcsdk.RemoveRoleFromInstanceProfileInput{
+			InstanceProfileName: aws.String(meta.GetExternalName(cr)),
+			RoleName:            aws.String(r),
+		}); resource.Ignore(IsNotFound, err) != nil {
+			return false, aws.Wrap(err, errRemoveRole)
+		}
 	}
-
-	_, err := u.client.RemoveRoleFromInstanceProfileWithContext(ctx, input)
-	return false, err
+	return false, nil
 }
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package instanceprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var (
+	errBoom = errors.New("boom")
+)
+
+type mockIAMClient struct {
+	iamiface.IAMAPI
+
+	AddRoleToInstanceProfileWithContextFunc      func(context.Context, *svcsdk.AddRoleToInstanceProfileInput, ...request.Option) (*svcsdk.AddRoleToInstanceProfileOutput, error)
+	RemoveRoleFromInstanceProfileWithContextFunc func(context.Context, *svcsdk.RemoveRoleFromInstanceProfileInput, ...request.Option) (*svcsdk.RemoveRoleFromInstanceProfileOutput, error)
+}
+
+func (m *mockIAMClient) AddRoleToInstanceProfileWithContext(ctx context.Context, in *svcsdk.AddRoleToInstanceProfileInput, opts ...request.Option) (*svcsdk.AddRoleToInstanceProfileOutput, error) {
+	return m.AddRoleToInstanceProfileWithContextFunc(ctx, in, opts...)
+}
+
+func (m *mockIAMClient) RemoveRoleFromInstanceProfileWithContext(ctx context.Context, in *svcsdk.RemoveRoleFromInstanceProfileInput, opts ...request.Option) (*svcsdk.RemoveRoleFromInstanceProfileOutput, error) {
+	return m.RemoveRoleFromInstanceProfileWithContextFunc(ctx, in, opts...)
+}
+
+type instanceProfileModifier func(*svcapitypes.InstanceProfile)
+
+func withRole(r string) instanceProfileModifier {
+	return func(o *svcapitypes.InstanceProfile) { o.Spec.ForProvider.Role = awsclient.String(r) }
+}
+
+func withObservedRoles(roles ...string) instanceProfileModifier {
+	return func(o *svcapitypes.InstanceProfile) {
+		for _, r := range roles {
+			o.Status.AtProvider.Roles = append(o.Status.AtProvider.Roles, &svcapitypes.Role{RoleName: awsclient.String(r)})
+		}
+	}
+}
+
+func instanceProfile(m ...instanceProfileModifier) *svcapitypes.InstanceProfile {
+	cr := &svcapitypes.InstanceProfile{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.InstanceProfile
+		want bool
+	}{
+		"SameRole": {
+			cr:   instanceProfile(withRole("a"), withObservedRoles("a")),
+			want: true,
+		},
+		"NoRole": {
+			cr:   instanceProfile(),
+			want: true,
+		},
+		"MissingRole": {
+			cr:   instanceProfile(withRole("a")),
+			want: false,
+		},
+		"DifferentRole": {
+			cr:   instanceProfile(withRole("a"), withObservedRoles("b")),
+			want: false,
+		},
+		"UnwantedRole": {
+			cr:   instanceProfile(withObservedRoles("b")),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.cr, &svcsdk.GetInstanceProfileOutput{})
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		added   []string
+		removed []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		cr        *svcapitypes.InstanceProfile
+		addErr    error
+		removeErr error
+		want      want
+	}{
+		"ReplaceRole": {
+			cr: instanceProfile(withRole("a"), withObservedRoles("b")),
+			want: want{
+				added:   []string{"a"},
+				removed: []string{"b"},
+			},
+		},
+		"RemoveRole": {
+			cr: instanceProfile(withObservedRoles("b")),
+			want: want{
+				removed: []string{"b"},
+			},
+		},
+		"RoleAlreadyRemoved": {
+			cr:        instanceProfile(withRole("a"), withObservedRoles("b")),
+			removeErr: awserr.New(svcsdk.ErrCodeNoSuchEntityException, "", nil),
+			want: want{
+				added:   []string{"a"},
+				removed: []string{"b"},
+			},
+		},
+		"RemoveFailed": {
+			cr:        instanceProfile(withRole("a"), withObservedRoles("b")),
+			removeErr: errBoom,
+			want: want{
+				removed: []string{"b"},
+				err:     awsclient.Wrap(errBoom, errRemoveRole),
+			},
+		},
+		"AddFailed": {
+			cr:     instanceProfile(withRole("a")),
+			addErr: errBoom,
+			want: want{
+				added: []string{"a"},
+				err:   awsclient.Wrap(errBoom, errAddRole),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added, removed []string
+			u := &updater{client: &mockIAMClient{
+				AddRoleToInstanceProfileWithContextFunc: func(_ context.Context, in *svcsdk.AddRoleToInstanceProfileInput, _ ...request.Option) (*svcsdk.AddRoleToInstanceProfileOutput, error) {
+					added = append(added, awsclient.StringValue(in.RoleName))
+					return &svcsdk.AddRoleToInstanceProfileOutput{}, tc.addErr
+				},
+				RemoveRoleFromInstanceProfileWithContextFunc: func(_ context.Context, in *svcsdk.RemoveRoleFromInstanceProfileInput, _ ...request.Option) (*svcsdk.RemoveRoleFromInstanceProfileOutput, error) {
+					removed = append(removed, awsclient.StringValue(in.RoleName))
+					return &svcsdk.RemoveRoleFromInstanceProfileOutput{}, tc.removeErr
+				},
+			}}
+			_, err := u.update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.added, added); diff != "" {
+				t.Errorf("added: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("removed: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -29,18 +29,20 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsv1beta1 "github.com/crossplane/provider-aws/apis/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/accessanalyzer"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -60,8 +62,11 @@ const (
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
-func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
+func SetupPolicy(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.PolicyGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -70,16 +75,12 @@ func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Policy{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
-			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.ARN("<policy-arn>", "iam"), &connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient, validator: o.PolicyValidator}),
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}, iam.NewGuardrailInitializer(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -87,6 +88,7 @@ type connector struct {
 	kube           client.Client
 	newClientFn    func(config aws.Config) iam.PolicyClient
 	newSTSClientFn func(config aws.Config) iam.STSClient
+	validator      *accessanalyzer.Validator
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -94,13 +96,18 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	// The identity published by the ProviderConfig saves us an STS call. It
+	// may not have been resolved yet, in which case we ask STS ourselves.
+	id, _ := awsclient.GetProviderConfigIdentity(ctx, c.kube, mg)
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube, identity: id, validator: c.validator}, nil
 }
 
 type external struct {
-	client iam.PolicyClient
-	sts    iam.STSClient
-	kube   client.Client
+	client    iam.PolicyClient
+	sts       iam.STSClient
+	kube      client.Client
+	identity  *awsv1beta1.CallerIdentity
+	validator *accessanalyzer.Validator
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -177,6 +184,10 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.validator.Validate(ctx, cr, policyDocument(cr)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	tags := cr.Spec.ForProvider.Tags
 	inputPolicyTags := make([]awsiamtypes.Tag, len(tags))
 	for i := range tags {
@@ -209,31 +220,45 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
-	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
-	// The new version is set as default.
-
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
-	}
-
-	_, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
-		PolicyArn:      aws.String(meta.GetExternalName(cr)),
-		PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
-		SetAsDefault:   true,
+	observed, err := e.client.GetPolicy(ctx, &awsiam.GetPolicyInput{
+		PolicyArn: aws.String(meta.GetExternalName(cr)),
 	})
-
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if observed.Policy == nil {
+		return managed.ExternalUpdate{}, errors.New(errEmptyPolicy)
 	}
 
-	observed, err := e.client.GetPolicy(ctx, &awsiam.GetPolicyInput{
+	versionRsp, err := e.client.GetPolicyVersion(ctx, &awsiam.GetPolicyVersionInput{
 		PolicyArn: aws.String(meta.GetExternalName(cr)),
+		VersionId: observed.Policy.DefaultVersionId,
 	})
-
+	if err != nil || versionRsp.PolicyVersion == nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPolicyVersion)
+	}
+	upToDate, err := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpToDate)
+	}
+
+	// An update to AWS Policy is a new version of that policy, which is set
+	// as default. A maximum of 5 versions are allowed, so the oldest
+	// non-default versions are pruned first to make room for the new one.
+	if !upToDate {
+		if err := e.validator.Validate(ctx, cr, policyDocument(cr)); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if err := e.pruneVersions(ctx, meta.GetExternalName(cr)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+		if _, err := e.client.CreatePolicyVersion(ctx, &awsiam.CreatePolicyVersionInput{
+			PolicyArn:      aws.String(meta.GetExternalName(cr)),
+			PolicyDocument: aws.String(cr.Spec.ForProvider.Document),
+			SetAsDefault:   true,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
 	}
 
 	add, remove, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, observed.Policy.Tags)
@@ -277,7 +302,16 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// policyDocument returns the document of the supplied policy for validation by
+// IAM Access Analyzer.
+func policyDocument(cr *v1beta1.Policy) accessanalyzer.Document {
+	return accessanalyzer.Document{Name: "policy document", Type: accessanalyzer.IdentityPolicy, Policy: cr.Spec.ForProvider.Document}
+}
+
 func (e *external) getCallerIdentityArn(ctx context.Context) (arn.ARN, error) {
+	if e.identity != nil {
+		return arn.Parse(e.identity.ARN)
+	}
 	resp, err := e.sts.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return arn.ARN{}, err
@@ -286,46 +320,41 @@ func (e *external) getCallerIdentityArn(ctx context.Context) (arn.ARN, error) {
 }
 
 func (e *external) listPolicyVersions(ctx context.Context, policyArn string) ([]awsiamtypes.PolicyVersion, error) {
-	resp, err := e.client.ListPolicyVersions(ctx, &awsiam.ListPolicyVersionsInput{
+	var versions []awsiamtypes.PolicyVersion
+	input := &awsiam.ListPolicyVersionsInput{
 		PolicyArn: aws.String(policyArn),
-	})
-
-	if err != nil || resp.Versions == nil {
-		return nil, err
 	}
-
-	return resp.Versions, nil
+	for {
+		resp, err := e.client.ListPolicyVersions(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, resp.Versions...)
+		if !resp.IsTruncated {
+			return versions, nil
+		}
+		input.Marker = resp.Marker
+	}
 }
 
-func (e *external) deleteOldestVersion(ctx context.Context, arn string) error {
+// pruneVersions deletes the oldest non-default versions of the policy so that
+// a new version can be created without exceeding the version limit.
+func (e *external) pruneVersions(ctx context.Context, arn string) error {
 	allVersions, err := e.listPolicyVersions(ctx, arn)
 	if err != nil {
 		return err
 	}
 
-	if len(allVersions) < 5 {
-		return nil
-	}
-
-	var oldestVersion awsiamtypes.PolicyVersion
-
-	// loop through all version to find the oldest version.
-	for _, version := range allVersions {
-		if version.IsDefaultVersion {
-			continue
-		}
-		if oldestVersion.CreateDate == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+	for _, version := range iam.PolicyVersionsToPrune(allVersions, iam.MaxPolicyVersions-1) {
+		if _, err := e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(arn),
+			VersionId: version.VersionId,
+		}); err != nil {
+			return err
 		}
 	}
 
-	_, err = e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
-		PolicyArn: aws.String(arn),
-		VersionId: oldestVersion.VersionId,
-	})
-
-	return err
+	return nil
 }
 
 func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn string) error {
@@ -18,8 +18,9 @@ package policy
 
 import (
 	"context"
-
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -528,9 +529,28 @@ func TestCreate(t *testing.T) {
 func TestUpdate(t *testing.T) {
 
 	type want struct {
-		cr     resource.Managed
-		result managed.ExternalUpdate
-		err    error
+		cr      resource.Managed
+		result  managed.ExternalUpdate
+		err     error
+		created bool
+		deleted []string
+	}
+
+	getPolicy := func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+		return &awsiam.GetPolicyOutput{
+			Policy: &awsiamtypes.Policy{DefaultVersionId: aws.String("v5")},
+		}, nil
+	}
+	getPolicyVersion := func(doc string) func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+		return func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+			return &awsiam.GetPolicyVersionOutput{
+				PolicyVersion: &awsiamtypes.PolicyVersion{Document: aws.String(doc)},
+			}, nil
+		}
+	}
+	day := func(d int) *time.Time {
+		t := time.Date(2022, 1, d, 0, 0, 0, 0, time.UTC)
+		return &t
 	}
 
 	cases := map[string]struct {
@@ -540,25 +560,53 @@ func TestUpdate(t *testing.T) {
 		"Successful": {
 			args: args{
 				iam: &fake.MockPolicyClient{
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(`{"Statement":[]}`),
 					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
 						return &awsiam.ListPolicyVersionsOutput{}, nil
 					},
-					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
-						return &awsiam.DeletePolicyVersionOutput{}, nil
-					},
-					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
-						return &awsiam.CreatePolicyVersionOutput{}, nil
-					},
-					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
-						return &awsiam.GetPolicyOutput{
-							Policy: &awsiamtypes.Policy{},
-						}, nil
+				},
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+			},
+			want: want{
+				cr:      policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+				created: true,
+			},
+		},
+		"SemanticallyEqualDocument": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(url.QueryEscape(`{"Statement":[{"Resource":"*","Action":["elastic-inference:Connect"],` +
+						`"Effect":"Allow","Sid":"VisualEditor0"}],"Version":"2012-10-17"}`)),
+				},
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+			},
+		},
+		"PruneOldestVersions": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(`{"Statement":[]}`),
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{Versions: []awsiamtypes.PolicyVersion{
+							{VersionId: aws.String("v5"), CreateDate: day(5), IsDefaultVersion: true},
+							{VersionId: aws.String("v3"), CreateDate: day(3)},
+							{VersionId: aws.String("v1"), CreateDate: day(1)},
+							{VersionId: aws.String("v4"), CreateDate: day(4)},
+							{VersionId: aws.String("v2"), CreateDate: day(2)},
+						}}, nil
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
 			},
 			want: want{
-				cr: policy(withExternalName(policyArn)),
+				cr:      policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+				created: true,
+				deleted: []string{"v1"},
 			},
 		},
 		"InValidInput": {
@@ -570,10 +618,10 @@ func TestUpdate(t *testing.T) {
 				err: errors.New(errUnexpectedObject),
 			},
 		},
-		"ListVersionsError": {
+		"GetPolicyError": {
 			args: args{
 				iam: &fake.MockPolicyClient{
-					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
 						return nil, errBoom
 					},
 				},
@@ -581,33 +629,67 @@ func TestUpdate(t *testing.T) {
 			},
 			want: want{
 				cr:  policy(withExternalName(policyArn)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ListVersionsError": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(`{"Statement":[]}`),
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+			},
+			want: want{
+				cr:  policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
 				err: awsclient.Wrap(errBoom, errUpdate),
 			},
 		},
 		"CreateVersionError": {
 			args: args{
 				iam: &fake.MockPolicyClient{
+					MockGetPolicy:        getPolicy,
+					MockGetPolicyVersion: getPolicyVersion(`{"Statement":[]}`),
 					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
 						return &awsiam.ListPolicyVersionsOutput{}, nil
 					},
-					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
-						return &awsiam.DeletePolicyVersionOutput{}, nil
-					},
 					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
 						return nil, errBoom
 					},
 				},
-				cr: policy(withExternalName(policyArn)),
+				cr: policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
 			},
 			want: want{
-				cr:  policy(withExternalName(policyArn)),
-				err: awsclient.Wrap(errBoom, errUpdate),
+				cr:      policy(withExternalName(policyArn), withSpec(v1beta1.PolicyParameters{Name: name, Document: document})),
+				err:     awsclient.Wrap(errBoom, errUpdate),
+				created: true,
 			},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
+			created := false
+			var deleted []string
+			if tc.iam != nil {
+				if tc.iam.MockCreatePolicyVersion == nil {
+					tc.iam.MockCreatePolicyVersion = func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return &awsiam.CreatePolicyVersionOutput{}, nil
+					}
+				}
+				createFn := tc.iam.MockCreatePolicyVersion
+				tc.iam.MockCreatePolicyVersion = func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+					created = true
+					return createFn(ctx, input, opts)
+				}
+				tc.iam.MockDeletePolicyVersion = func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+					deleted = append(deleted, aws.StringValue(input.VersionId))
+					return &awsiam.DeletePolicyVersionOutput{}, nil
+				}
+			}
 			e := &external{client: tc.iam}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
@@ -620,6 +702,12 @@ func TestUpdate(t *testing.T) {
 			if diff := cmp.Diff(tc.want.result, o); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("created: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, deleted); diff != "" {
+				t.Errorf("deleted: -want, +got:\n%s", diff)
+			}
 		})
 	}
 }
@@ -642,6 +730,10 @@ func TestUpdate_Tags(t *testing.T) {
 		return &awsiam.DeletePolicyVersionOutput{}, nil
 	}
 
+	getPolicyVersion := func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+		return &awsiam.GetPolicyVersionOutput{PolicyVersion: &awsiamtypes.PolicyVersion{}}, nil
+	}
+
 	createPolicyVersion := func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
 		return &awsiam.CreatePolicyVersionOutput{}, nil
 	}
@@ -820,6 +912,7 @@ func TestUpdate_Tags(t *testing.T) {
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
 			tc.iam.MockListPolicyVersions = listPolicyVersions
+			tc.iam.MockGetPolicyVersion = getPolicyVersion
 			tc.iam.MockDeletePolicyVersion = deletePolicyVersions
 			tc.iam.MockCreatePolicyVersion = createPolicyVersion
 
@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,8 +34,12 @@ import (
 	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/accessanalyzer"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -55,8 +56,11 @@ const (
 )
 
 // SetupRole adds a controller that reconciles Roles.
-func SetupRole(mgr ctrl.Manager, o controller.Options) error {
+func SetupRole(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.RoleGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -65,23 +69,19 @@ func SetupRole(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Role{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.RoleGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.RoleGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.PathName("<role-name>", "iam", "role"), &connector{kube: mgr.GetClient(), newClientFn: iam.NewRoleClient, validator: o.PolicyValidator}),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithInitializers(managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}, iam.NewGuardrailInitializer(mgr.GetClient())),
 			managed.WithConnectionPublishers(cps...)))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(config aws.Config) iam.RoleClient
+	validator   *accessanalyzer.Validator
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -89,12 +89,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, validator: c.validator}, nil
 }
 
 type external struct {
-	client iam.RoleClient
-	kube   client.Client
+	client    iam.RoleClient
+	kube      client.Client
+	validator *accessanalyzer.Validator
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
@@ -146,6 +147,10 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	if err := e.validator.Validate(ctx, cr, trustPolicy(cr)); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
 	cr.Status.SetConditions(xpv1.Creating())
 
 	_, err := e.client.CreateRole(ctx, iam.GenerateCreateRoleInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
@@ -210,6 +215,9 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	if patch.AssumeRolePolicyDocument != "" {
+		if err := e.validator.Validate(ctx, cr, trustPolicy(cr)); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 		_, err = e.client.UpdateAssumeRolePolicy(ctx, &awsiam.UpdateAssumeRolePolicyInput{
 			PolicyDocument: &cr.Spec.ForProvider.AssumeRolePolicyDocument,
 			RoleName:       aws.String(meta.GetExternalName(cr)),
@@ -236,6 +244,13 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
 }
 
+// trustPolicy returns the assume role policy document of the supplied role
+// for validation by IAM Access Analyzer, which validates trust policies as
+// resource policies.
+func trustPolicy(cr *v1beta1.Role) accessanalyzer.Document {
+	return accessanalyzer.Document{Name: "assume role policy document", Type: accessanalyzer.ResourcePolicy, Policy: cr.Spec.ForProvider.AssumeRolePolicyDocument}
+}
+
 type tagger struct {
 	kube client.Client
 }
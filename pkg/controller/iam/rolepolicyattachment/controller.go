@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -37,7 +34,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -49,8 +48,11 @@ const (
 
 // SetupRolePolicyAttachment adds a controller that reconciles
 // RolePolicyAttachments.
-func SetupRolePolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
+func SetupRolePolicyAttachment(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.RolePolicyAttachmentGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -59,16 +61,11 @@ func SetupRolePolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.RolePolicyAttachment{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.RolePolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewRolePolicyAttachmentClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.RolePolicyAttachmentGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: iam.NewRolePolicyAttachmentClient},
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -96,21 +93,27 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
-	observed, err := e.client.ListAttachedRolePolicies(ctx, &awsiam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(cr.Spec.ForProvider.RoleName),
+	var attachedPolicyObject *awsiamtypes.AttachedPolicy
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, marker *string) (*string, bool, error) {
+		observed, err := e.client.ListAttachedRolePolicies(ctx, &awsiam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(cr.Spec.ForProvider.RoleName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i, policy := range observed.AttachedPolicies {
+			if cr.Spec.ForProvider.PolicyARN == aws.ToString(policy.PolicyArn) {
+				attachedPolicyObject = &observed.AttachedPolicies[i]
+				return nil, true, nil
+			}
+		}
+		return iam.NextMarker(observed.IsTruncated, observed.Marker), false, nil
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
-
-	var attachedPolicyObject *awsiamtypes.AttachedPolicy
-	for i, policy := range observed.AttachedPolicies {
-		if cr.Spec.ForProvider.PolicyARN == aws.ToString(policy.PolicyArn) {
-			attachedPolicyObject = &observed.AttachedPolicies[i]
-			break
-		}
-	}
-	if attachedPolicyObject == nil {
+	if !found {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 	cr.SetConditions(xpv1.Available())
@@ -126,9 +129,12 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
-	_, err := e.client.AttachRolePolicy(ctx, &awsiam.AttachRolePolicyInput{
-		PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
-		RoleName:  aws.String(cr.Spec.ForProvider.RoleName),
+	err := iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		_, err := e.client.AttachRolePolicy(ctx, &awsiam.AttachRolePolicyInput{
+			PolicyArn: aws.String(cr.Spec.ForProvider.PolicyARN),
+			RoleName:  aws.String(cr.Spec.ForProvider.RoleName),
+		})
+		return err
 	})
 	return managed.ExternalCreation{}, awsclient.Wrap(err, errAttach)
 }
@@ -113,6 +113,34 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AttachedOnLaterPage": {
+			args: args{
+				iam: &fake.MockRolePolicyAttachmentClient{
+					MockListAttachedRolePolicies: func(ctx context.Context, input *awsiam.ListAttachedRolePoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedRolePoliciesOutput, error) {
+						if input.Marker == nil {
+							return &awsiam.ListAttachedRolePoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: awsclient.String("other")}},
+								IsTruncated:      true,
+								Marker:           awsclient.String("next"),
+							}, nil
+						}
+						return &awsiam.ListAttachedRolePoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: &specPolicyArn}},
+						}, nil
+					},
+				},
+				cr: rolePolicy(withSpecPolicyArn(&specPolicyArn)),
+			},
+			want: want{
+				cr: rolePolicy(withSpecPolicyArn(&specPolicyArn),
+					withConditions(xpv1.Available()),
+					withStatusPolicyArn(&specPolicyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
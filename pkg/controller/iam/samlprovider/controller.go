@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a SAMLProvider resource"
+
+	errGet              = "cannot get SAMLProvider in AWS"
+	errCreate           = "cannot create SAMLProvider in AWS"
+	errUpdate           = "cannot update SAMLProvider metadata document in AWS"
+	errDelete           = "failed to delete SAMLProvider"
+	errSDK              = "empty SAMLProvider received from IAM API"
+	errAddTags          = "cannot add tags to SAMLProvider in AWS"
+	errRemoveTags       = "cannot remove tags to SAMLProvider in AWS"
+	errKubeUpdateFailed = "cannot update SAMLProvider instance custom resource"
+
+	errNoDocument     = "either samlMetadataDocument or samlMetadataDocumentFrom must be specified"
+	errGetConfigMap   = "cannot get SAML metadata document ConfigMap"
+	errGetSecret      = "cannot get SAML metadata document Secret"
+	errKeyNotFoundFmt = "key %q not found"
+)
+
+// SetupSAMLProvider adds a controller that reconciles SAMLProvider.
+func SetupSAMLProvider(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1beta1.SAMLProviderGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1beta1.SAMLProvider{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.SAMLProviderGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: iam.NewSAMLProviderClient},
+			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) iam.SAMLProviderClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{
+		kube:   c.kube,
+		client: c.newClientFn(*cfg),
+	}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client iam.SAMLProviderClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
+	}
+	if observed == nil {
+		return managed.ExternalObservation{}, errors.New(errSDK)
+	}
+	document, err := getDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	cr.SetConditions(xpv1.Available())
+	cr.Status.AtProvider = iam.GenerateSAMLProviderObservation(*observed)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: iam.IsSAMLProviderUpToDate(cr.Spec.ForProvider, document, *observed),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	document, err := getDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	iamTags := make([]iamtypes.Tag, len(cr.Spec.ForProvider.Tags))
+	for i := range cr.Spec.ForProvider.Tags {
+		iamTags[i] = iamtypes.Tag{Key: aws.String(cr.Spec.ForProvider.Tags[i].Key), Value: aws.String(cr.Spec.ForProvider.Tags[i].Value)}
+	}
+
+	observed, err := e.client.CreateSAMLProvider(ctx, &awsiam.CreateSAMLProviderInput{
+		Name:                 aws.String(cr.Spec.ForProvider.Name),
+		SAMLMetadataDocument: aws.String(document),
+		Tags:                 iamTags,
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+
+	meta.SetExternalName(cr, aws.ToString(observed.SAMLProviderArn))
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	arn := aws.String(meta.GetExternalName(cr))
+	observed, err := e.client.GetSAMLProvider(ctx, &awsiam.GetSAMLProviderInput{
+		SAMLProviderArn: arn,
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if observed == nil {
+		return managed.ExternalUpdate{}, errors.New(errSDK)
+	}
+	document, err := getDocument(ctx, e.kube, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	if !iam.IsSAMLMetadataDocumentUpToDate(document, *observed) {
+		if _, err := e.client.UpdateSAMLProvider(ctx, &awsiam.UpdateSAMLProviderInput{
+			SAMLProviderArn:      arn,
+			SAMLMetadataDocument: aws.String(document),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	addTags, removeTags, _ := iam.DiffIAMTagsWithUpdates(cr.Spec.ForProvider.Tags, observed.Tags)
+	if len(addTags) > 0 {
+		if _, err := e.client.TagSAMLProvider(ctx, &awsiam.TagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			Tags:            addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTags)
+		}
+	}
+	if len(removeTags) > 0 {
+		if _, err := e.client.UntagSAMLProvider(ctx, &awsiam.UntagSAMLProviderInput{
+			SAMLProviderArn: arn,
+			TagKeys:         removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTags)
+		}
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.DeleteSAMLProvider(ctx, &awsiam.DeleteSAMLProviderInput{
+		SAMLProviderArn: aws.String(meta.GetExternalName(cr)),
+	})
+
+	return awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errDelete)
+}
+
+// getDocument returns the SAML metadata document, which is either specified
+// inline or read from the referenced ConfigMap or Secret.
+func getDocument(ctx context.Context, kube client.Client, p v1beta1.SAMLProviderParameters) (string, error) {
+	switch {
+	case p.SAMLMetadataDocument != nil:
+		return *p.SAMLMetadataDocument, nil
+	case p.SAMLMetadataDocumentFrom != nil && p.SAMLMetadataDocumentFrom.ConfigMapKeyRef != nil:
+		ref := p.SAMLMetadataDocumentFrom.ConfigMapKeyRef
+		cm := &corev1.ConfigMap{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, cm); err != nil {
+			return "", errors.Wrap(err, errGetConfigMap)
+		}
+		v, ok := cm.Data[ref.Key]
+		if !ok {
+			return "", errors.Wrap(errors.Errorf(errKeyNotFoundFmt, ref.Key), errGetConfigMap)
+		}
+		return v, nil
+	case p.SAMLMetadataDocumentFrom != nil && p.SAMLMetadataDocumentFrom.SecretKeyRef != nil:
+		ref := p.SAMLMetadataDocumentFrom.SecretKeyRef
+		s := &corev1.Secret{}
+		if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+			return "", errors.Wrap(err, errGetSecret)
+		}
+		v, ok := s.Data[ref.Key]
+		if !ok {
+			return "", errors.Wrap(errors.Errorf(errKeyNotFoundFmt, ref.Key), errGetSecret)
+		}
+		return string(v), nil
+	}
+	return "", errors.New(errNoDocument)
+}
+
+type tagger struct {
+	kube client.Client
+}
+
+func (t *tagger) Initialize(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1beta1.SAMLProvider)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	added := false
+	tagMap := map[string]string{}
+	for _, t := range cr.Spec.ForProvider.Tags {
+		tagMap[t.Key] = t.Value
+	}
+	for k, v := range resource.GetExternalTags(mgd) {
+		if p, ok := tagMap[k]; !ok || v != p {
+			cr.Spec.ForProvider.Tags = append(cr.Spec.ForProvider.Tags, v1beta1.Tag{Key: k, Value: v})
+			added = true
+		}
+	}
+	if !added {
+		return nil
+	}
+	return errors.Wrap(t.kube.Update(ctx, cr), errKubeUpdateFailed)
+}
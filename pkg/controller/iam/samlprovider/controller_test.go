@@ -0,0 +1,511 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package samlprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/iam/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/iam/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	providerArn    = "arn:aws:iam::123456789012:saml-provider/example"
+	providerName   = "example"
+	document       = "<EntityDescriptor/>"
+	newDocument    = "<EntityDescriptor entityID=\"new\"/>"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	iam  *fake.MockSAMLProviderClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type samlProviderModifier func(*v1beta1.SAMLProvider)
+
+func withConditions(c ...xpv1.Condition) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { meta.SetExternalName(r, name) }
+}
+
+func withDocument(d string) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { r.Spec.ForProvider.SAMLMetadataDocument = &d }
+}
+
+func withDocumentFromSecret(name, key string) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) {
+		r.Spec.ForProvider.SAMLMetadataDocumentFrom = &v1beta1.SAMLMetadataDocumentSource{
+			SecretKeyRef: &xpv1.SecretKeySelector{
+				SecretReference: xpv1.SecretReference{Name: name, Namespace: "default"},
+				Key:             key,
+			},
+		}
+	}
+}
+
+func withTags(tags ...v1beta1.Tag) samlProviderModifier {
+	return func(r *v1beta1.SAMLProvider) { r.Spec.ForProvider.Tags = tags }
+}
+
+func samlProvider(m ...samlProviderModifier) *v1beta1.SAMLProvider {
+	cr := &v1beta1.SAMLProvider{}
+	cr.Spec.ForProvider.Name = providerName
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(document + "\n")}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocument(document),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DocumentFromSecretChanged": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(document)}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						s := obj.(*corev1.Secret)
+						s.Data = map[string][]byte{"metadata.xml": []byte(newDocument)}
+						return nil
+					}),
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocumentFromSecret("idp", "metadata.xml")),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocumentFromSecret("idp", "metadata.xml"),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsOutdated": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(document)}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(document),
+					withTags(v1beta1.Tag{Key: "k", Value: "v"})),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocument(document),
+					withTags(v1beta1.Tag{Key: "k", Value: "v"}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NoExternalName": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{},
+				cr:  samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocument(document)),
+			},
+		},
+		"SecretKeyMissing": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocumentFromSecret("idp", "metadata.xml")),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn), withDocumentFromSecret("idp", "metadata.xml")),
+				err: errors.Wrap(errors.Errorf(errKeyNotFoundFmt, "metadata.xml"), errGetSecret),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						if aws.ToString(input.Name) != providerName || aws.ToString(input.SAMLMetadataDocument) != document {
+							return nil, errBoom
+						}
+						return &awsiam.CreateSAMLProviderOutput{SAMLProviderArn: &providerArn}, nil
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withDocument(document), withExternalName(providerArn)),
+			},
+		},
+		"NoDocument": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{},
+				cr:  samlProvider(),
+			},
+			want: want{
+				cr:  samlProvider(),
+				err: errors.New(errNoDocument),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockCreateSAMLProvider: func(ctx context.Context, input *awsiam.CreateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.CreateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withDocument(document)),
+			},
+			want: want{
+				cr:  samlProvider(withDocument(document)),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalUpdate
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateDocumentAndTags": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{
+							SAMLMetadataDocument: aws.String(document),
+							Tags:                 []iamtypes.Tag{{Key: aws.String("old"), Value: aws.String("v")}},
+						}, nil
+					},
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						if aws.ToString(input.SAMLMetadataDocument) != newDocument {
+							return nil, errBoom
+						}
+						return &awsiam.UpdateSAMLProviderOutput{}, nil
+					},
+					MockTagSAMLProvider: func(ctx context.Context, input *awsiam.TagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.TagSAMLProviderOutput, error) {
+						if len(input.Tags) != 1 || aws.ToString(input.Tags[0].Key) != "new" {
+							return nil, errBoom
+						}
+						return &awsiam.TagSAMLProviderOutput{}, nil
+					},
+					MockUntagSAMLProvider: func(ctx context.Context, input *awsiam.UntagSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UntagSAMLProviderOutput, error) {
+						if len(input.TagKeys) != 1 || input.TagKeys[0] != "old" {
+							return nil, errBoom
+						}
+						return &awsiam.UntagSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(newDocument),
+					withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocument(newDocument),
+					withTags(v1beta1.Tag{Key: "new", Value: "v"})),
+			},
+		},
+		"DocumentUpToDate": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(document)}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(document)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn), withDocument(document)),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockGetSAMLProvider: func(ctx context.Context, input *awsiam.GetSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.GetSAMLProviderOutput, error) {
+						return &awsiam.GetSAMLProviderOutput{SAMLMetadataDocument: aws.String(document)}, nil
+					},
+					MockUpdateSAMLProvider: func(ctx context.Context, input *awsiam.UpdateSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.UpdateSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn), withDocument(newDocument)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn), withDocument(newDocument)),
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam, kube: tc.kube}
+			o, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return &awsiam.DeleteSAMLProviderOutput{}, nil
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, &iamtypes.NoSuchEntityException{}
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				iam: &fake.MockSAMLProviderClient{
+					MockDeleteSAMLProvider: func(ctx context.Context, input *awsiam.DeleteSAMLProviderInput, opts []func(*awsiam.Options)) (*awsiam.DeleteSAMLProviderOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: samlProvider(withExternalName(providerArn)),
+			},
+			want: want{
+				cr:  samlProvider(withExternalName(providerArn)),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.iam}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -37,7 +34,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -50,8 +49,11 @@ const (
 
 // SetupUserPolicyAttachment adds a controller that reconciles
 // UserPolicyAttachments.
-func SetupUserPolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
+func SetupUserPolicyAttachment(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.UserPolicyAttachmentGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -60,16 +62,11 @@ func SetupUserPolicyAttachment(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.UserPolicyAttachment{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.UserPolicyAttachmentGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient}),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.UserPolicyAttachmentGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: iam.NewUserPolicyAttachmentClient},
 			managed.WithConnectionPublishers(),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -97,22 +94,28 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
-	observed, err := e.client.ListAttachedUserPolicies(ctx, &awsiam.ListAttachedUserPoliciesInput{
-		UserName: aws.String(cr.Spec.ForProvider.UserName),
+	var attachedPolicyObject *awsiamtypes.AttachedPolicy
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, marker *string) (*string, bool, error) {
+		observed, err := e.client.ListAttachedUserPolicies(ctx, &awsiam.ListAttachedUserPoliciesInput{
+			UserName: aws.String(cr.Spec.ForProvider.UserName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		for i, policy := range observed.AttachedPolicies {
+			if cr.Spec.ForProvider.PolicyARN == aws.ToString(policy.PolicyArn) {
+				attachedPolicyObject = &observed.AttachedPolicies[i]
+				return nil, true, nil
+			}
+		}
+		return iam.NextMarker(observed.IsTruncated, observed.Marker), false, nil
 	})
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(iam.IsErrorNotFound, err), errGet)
 	}
 
-	var attachedPolicyObject *awsiamtypes.AttachedPolicy
-	for i, policy := range observed.AttachedPolicies {
-		if cr.Spec.ForProvider.PolicyARN == aws.ToString(policy.PolicyArn) {
-			attachedPolicyObject = &observed.AttachedPolicies[i]
-			break
-		}
-	}
-
-	if attachedPolicyObject == nil {
+	if !found {
 		return managed.ExternalObservation{
 			ResourceExists: false,
 		}, nil
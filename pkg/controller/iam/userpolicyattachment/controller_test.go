@@ -115,6 +115,36 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"AttachedOnLaterPage": {
+			args: args{
+				iam: &fake.MockUserPolicyAttachmentClient{
+					MockListAttachedUserPolicies: func(ictx context.Context, input *awsiam.ListAttachedUserPoliciesInput, opts []func(*awsiam.Options)) (*awsiam.ListAttachedUserPoliciesOutput, error) {
+						if input.Marker == nil {
+							return &awsiam.ListAttachedUserPoliciesOutput{
+								AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: awsclient.String("other")}},
+								IsTruncated:      true,
+								Marker:           awsclient.String("next"),
+							}, nil
+						}
+						return &awsiam.ListAttachedUserPoliciesOutput{
+							AttachedPolicies: []awsiamtypes.AttachedPolicy{{PolicyArn: &policyArn}},
+						}, nil
+					},
+				},
+				cr: userPolicy(withUserName(userName),
+					withSpecPolicyArn(policyArn)),
+			},
+			want: want{
+				cr: userPolicy(withUserName(userName),
+					withSpecPolicyArn(policyArn),
+					withConditions(xpv1.Available()),
+					withStatusPolicyArn(policyArn)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -0,0 +1,189 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imports
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/importer/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/importer"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Import custom resource"
+	errFmtUnsupported   = "cannot import %s: only the resources of %s can be imported"
+	errNameFilter       = "cannot compile name filter"
+	errNew              = "cannot create managed resource object"
+	errGet              = "cannot get managed resource"
+	errCreate           = "cannot create managed resource"
+)
+
+// SetupImport adds a controller that reconciles Imports.
+func SetupImport(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ImportGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Import{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ImportGroupVersionKind),
+			&connector{kube: mgr.GetClient(), scheme: mgr.GetScheme()},
+			managed.WithInitializers()))
+}
+
+type connector struct {
+	kube   client.Client
+	scheme *runtime.Scheme
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Import)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, scheme: c.scheme, clients: importer.NewClients(sess)}, nil
+}
+
+type external struct {
+	kube    client.Client
+	scheme  *runtime.Scheme
+	clients *importer.Clients
+
+	// The importer and AWS resources without managed resources found by
+	// Observe, which are created by Update.
+	importer importer.Importer
+	missing  []v1alpha1.ImportedResource
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Import)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// An Import has no external resource of its own. The managed resources
+	// it created are kept when it is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	kind := p.ResourceType + "." + p.Service + ".aws.crossplane.io"
+	i, err := importer.For(kind)
+	if err != nil || i.List == nil {
+		// Only the AWS resources of kinds that can be enumerated can be
+		// imported. Retrying won't help until the spec of the Import changes.
+		cr.Status.AtProvider.Resources = nil
+		cr.SetConditions(v1alpha1.Unsupported(errors.Errorf(errFmtUnsupported, kind, strings.Join(importer.Listable(), ", ")).Error()))
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+	f := importer.Filter{Tags: p.TagFilter}
+	if p.NameFilter != nil {
+		if f.Name, err = regexp.Compile(*p.NameFilter); err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errNameFilter)
+		}
+	}
+	ids, err := i.List(ctx, e.clients, f)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	e.importer = i
+	e.missing = nil
+	cr.Status.AtProvider.Resources = make([]v1alpha1.ImportedResource, len(ids))
+	for n, id := range ids {
+		parts := strings.Split(id, "/")
+		r := v1alpha1.ImportedResource{ID: id, Name: importer.NameFor(parts[len(parts)-1])}
+		cr.Status.AtProvider.Resources[n] = r
+
+		// A managed resource of the same name is assumed to represent the
+		// AWS resource, even if it wasn't created by this Import.
+		o, err := e.scheme.New(i.Kind)
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errNew)
+		}
+		err = e.kube.Get(ctx, types.NamespacedName{Name: r.Name}, o.(client.Object))
+		if kerrors.IsNotFound(err) {
+			e.missing = append(e.missing, r)
+			continue
+		}
+		if err != nil {
+			return managed.ExternalObservation{}, errors.Wrap(err, errGet)
+		}
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(e.missing) == 0,
+	}, nil
+}
+
+func (e *external) Create(_ context.Context, _ resource.Managed) (managed.ExternalCreation, error) {
+	return managed.ExternalCreation{}, nil
+}
+
+// Update creates the managed resources of the AWS resources that don't have
+// one yet. They are observed only, and their AWS resources are kept when they
+// are deleted.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Import)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	for _, r := range e.missing {
+		res, err := e.importer.Resource(ctx, e.clients, cr.Spec.ForProvider.Region, r.ID, r.Name)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		res.SetProviderConfigReference(cr.GetProviderConfigReference())
+		res.SetDeletionPolicy(xpv1.DeletionOrphan)
+		meta.AddAnnotations(res, map[string]string{observeonly.AnnotationKey: "true"})
+		meta.AddLabels(res, map[string]string{v1alpha1.LabelKeyImport: cr.GetName()})
+		if err := e.kube.Create(ctx, res); resource.Ignore(kerrors.IsAlreadyExists, err) != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errCreate)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(_ context.Context, _ resource.Managed) error {
+	return nil
+}
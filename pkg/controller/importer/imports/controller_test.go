@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imports
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	cognitoidentityproviderv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/importer/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/clients/observeonly"
+	"github.com/crossplane/provider-aws/pkg/importer"
+)
+
+var errBoom = errors.New("boom")
+
+type mockCognitoClient struct {
+	cognitoidentityprovideriface.CognitoIdentityProviderAPI
+}
+
+func (m *mockCognitoClient) ListUserPoolsPagesWithContext(_ aws.Context, _ *svcsdk.ListUserPoolsInput, fn func(*svcsdk.ListUserPoolsOutput, bool) bool, _ ...request.Option) error {
+	fn(&svcsdk.ListUserPoolsOutput{UserPools: []*svcsdk.UserPoolDescriptionType{
+		{Id: aws.String("us-east-1_Prod"), Name: aws.String("prod")},
+		{Id: aws.String("us-east-1_Staging"), Name: aws.String("staging")},
+	}}, true)
+	return nil
+}
+
+func (m *mockCognitoClient) DescribeUserPoolWithContext(_ aws.Context, in *svcsdk.DescribeUserPoolInput, _ ...request.Option) (*svcsdk.DescribeUserPoolOutput, error) {
+	return &svcsdk.DescribeUserPoolOutput{UserPool: &svcsdk.UserPoolType{
+		Id:               in.UserPoolId,
+		Name:             aws.String("prod"),
+		MfaConfiguration: aws.String(svcsdk.UserPoolMfaTypeOff),
+	}}, nil
+}
+
+func importCR() *v1alpha1.Import {
+	cr := &v1alpha1.Import{}
+	cr.SetName("pools")
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: "example"})
+	cr.Spec.ForProvider = v1alpha1.ImportParameters{
+		Region:       "us-east-1",
+		Service:      "cognitoidentityprovider",
+		ResourceType: "UserPool",
+		NameFilter:   aws.String("^prod$"),
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := cognitoidentityproviderv1alpha1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	prod := v1alpha1.ImportedResource{ID: "us-east-1_Prod", Name: "us-east-1-prod"}
+	listable := "IdentityPool.cognitoidentity.aws.crossplane.io, UserPool.cognitoidentityprovider.aws.crossplane.io"
+
+	type want struct {
+		obs        managed.ExternalObservation
+		missing    []v1alpha1.ImportedResource
+		conditions []xpv1.Condition
+		err        error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		cr     *v1alpha1.Import
+		want   want
+	}{
+		"Missing": {
+			reason: "AWS resources that pass the filter and have no managed resource should be missing.",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, "")),
+			},
+			cr: importCR(),
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				missing:    []v1alpha1.ImportedResource{prod},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"Imported": {
+			reason: "The Import should be up to date if all AWS resources have a managed resource.",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil),
+			},
+			cr: importCR(),
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"GetError": {
+			reason: "Errors getting managed resources should be returned.",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: importCR(),
+			want: want{
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"NotListable": {
+			reason: "Kinds whose AWS resources can't be enumerated should be rejected with a condition rather than retried.",
+			cr: func() *v1alpha1.Import {
+				cr := importCR()
+				cr.Spec.ForProvider.ResourceType = "UserPoolClient"
+				return cr
+			}(),
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{v1alpha1.Unsupported(errors.Errorf(errFmtUnsupported, "UserPoolClient.cognitoidentityprovider.aws.crossplane.io", listable).Error())},
+			},
+		},
+		"UnknownKind": {
+			reason: "Kinds that can't be imported at all should be rejected with a condition rather than retried.",
+			cr: func() *v1alpha1.Import {
+				cr := importCR()
+				cr.Spec.ForProvider.Service = "sqs"
+				cr.Spec.ForProvider.ResourceType = "Queue"
+				return cr
+			}(),
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{v1alpha1.Unsupported(errors.Errorf(errFmtUnsupported, "Queue.sqs.aws.crossplane.io", listable).Error())},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, scheme: s, clients: &importer.Clients{CognitoIdentityProvider: &mockCognitoClient{}}}
+			obs, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.missing, e.missing); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want missing, +got missing:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	i, err := importer.For("UserPool.cognitoidentityprovider.aws.crossplane.io")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var created *cognitoidentityproviderv1alpha1.UserPool
+	e := &external{
+		kube: &test.MockClient{
+			MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+				created = obj.(*cognitoidentityproviderv1alpha1.UserPool)
+				return nil
+			},
+		},
+		clients:  &importer.Clients{CognitoIdentityProvider: &mockCognitoClient{}},
+		importer: i,
+		missing:  []v1alpha1.ImportedResource{{ID: "us-east-1_Prod", Name: "us-east-1-prod"}},
+	}
+	if _, err := e.Update(context.Background(), importCR()); err != nil {
+		t.Fatalf("Update(...): %s", err)
+	}
+	if created == nil {
+		t.Fatal("Update(...): no managed resource was created")
+	}
+
+	if diff := cmp.Diff("us-east-1-prod", created.GetName()); diff != "" {
+		t.Errorf("Update(...): -want name, +got name:\n%s", diff)
+	}
+	if diff := cmp.Diff("us-east-1_Prod", meta.GetExternalName(created)); diff != "" {
+		t.Errorf("Update(...): -want external name, +got external name:\n%s", diff)
+	}
+	if !observeonly.IsObserveOnly(created) {
+		t.Errorf("Update(...): managed resource is not observed only")
+	}
+	if diff := cmp.Diff(xpv1.DeletionOrphan, created.GetDeletionPolicy()); diff != "" {
+		t.Errorf("Update(...): -want deletion policy, +got deletion policy:\n%s", diff)
+	}
+	if diff := cmp.Diff(&xpv1.Reference{Name: "example"}, created.GetProviderConfigReference()); diff != "" {
+		t.Errorf("Update(...): -want provider config, +got provider config:\n%s", diff)
+	}
+	if diff := cmp.Diff("pools", created.GetLabels()[v1alpha1.LabelKeyImport]); diff != "" {
+		t.Errorf("Update(...): -want label, +got label:\n%s", diff)
+	}
+}
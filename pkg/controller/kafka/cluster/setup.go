@@ -15,15 +15,17 @@ package cluster
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"strconv"
 	"strings"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -31,16 +33,41 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/readiness"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errGetBootstrapBrokers = "cannot get bootstrap brokers"
+	errDescribeOperation   = "cannot describe cluster operation"
+	errUpdateMonitoring    = "cannot update cluster monitoring"
+	errUpdateBrokerCount   = "cannot update cluster broker count"
+	errDescribeForUpdate   = "cannot describe cluster for update"
+)
+
+// Connection detail keys of a Cluster.
+const (
+	ConnectionDetailBootstrapBrokerString          = "bootstrapBrokerString"
+	ConnectionDetailBootstrapBrokerStringTLS       = "bootstrapBrokerStringTls"
+	ConnectionDetailBootstrapBrokerStringSASLIAM   = "bootstrapBrokerStringSaslIam"
+	ConnectionDetailBootstrapBrokerStringSASLSCRAM = "bootstrapBrokerStringSaslScram"
 )
 
 // SetupCluster adds a controller that reconciles Cluster.
-func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.ClusterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
+			h := &hooks{client: e.client, prober: readiness.NewTCPProber()}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = h.postObserve
+			e.isUpToDate = isUpToDate
+			e.update = h.update
 			e.preDelete = preDelete
 			e.postDelete = postDelete
 			e.preCreate = preCreate
@@ -56,14 +83,10 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.ClusterGroupVersionKind),
+			&connector{kube: mgr.GetClient(), opts: opts},
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -88,34 +111,130 @@ func preObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.Describe
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+type hooks struct {
+	client svcsdkapi.KafkaAPI
+	prober readiness.Prober
+}
+
+func (h *hooks) postObserve(ctx context.Context, cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
-	switch awsclients.StringValue(obj.ClusterInfo.State) {
-	case string(svcapitypes.ClusterState_ACTIVE):
-		cr.SetConditions(xpv1.Available())
+	obs.ConnectionDetails = managed.ConnectionDetails{
+		"zookeeperEndpointPlain": []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString)),
+		"zookeeperEndpointTls":   []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectStringTls)),
+	}
+
+	var c xpv1.Condition
+	switch state := awsclients.StringValue(obj.ClusterInfo.State); state {
 	case string(svcapitypes.ClusterState_CREATING):
-		cr.SetConditions(xpv1.Creating())
-	case string(svcapitypes.ClusterState_FAILED), string(svcapitypes.ClusterState_MAINTENANCE), string(svcapitypes.ClusterState_UPDATING):
-		cr.SetConditions(xpv1.Unavailable())
+		c = xpv1.Creating()
 	case string(svcapitypes.ClusterState_DELETING):
-		cr.SetConditions(xpv1.Deleting())
+		c = xpv1.Deleting()
+	case string(svcapitypes.ClusterState_FAILED):
+		c = xpv1.Unavailable()
+		if i := obj.ClusterInfo.StateInfo; i != nil {
+			c = c.WithMessage(fmt.Sprintf("%s: %s", awsclients.StringValue(i.Code), awsclients.StringValue(i.Message)))
+		}
+	default:
+		// DescribeCluster does not return the broker endpoints, but they
+		// exist in every other state, including while the cluster is being
+		// updated.
+		rsp, err := h.client.GetBootstrapBrokersWithContext(ctx, &svcsdk.GetBootstrapBrokersInput{ClusterArn: obj.ClusterInfo.ClusterArn})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclients.Wrap(err, errGetBootstrapBrokers)
+		}
+		for k, v := range bootstrapBrokers(rsp) {
+			obs.ConnectionDetails[k] = v
+		}
+		switch {
+		case state != string(svcapitypes.ClusterState_ACTIVE):
+			c = xpv1.Unavailable()
+		case cr.Spec.ForProvider.ReadinessProbe == nil:
+			c = xpv1.Available()
+		default:
+			host, port := firstBroker(rsp)
+			c = readiness.Check(ctx, h.prober, cr.Spec.ForProvider.ReadinessProbe, host, port)
+		}
 	}
 
-	obs.ConnectionDetails = managed.ConnectionDetails{
-		// see: https://docs.aws.amazon.com/msk/latest/developerguide/client-access.html
-		// no endpoint informations available in DescribeClusterOutput only endpoints for zookeeperPlain/Tls
-		"zookeeperEndpointPlain": []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString)),
-		"zookeeperEndpointTls":   []byte(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectStringTls)),
-		"clusterEndpointPlain":   []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9092")),
-		"clusterEndpointTls":     []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9094")),
-		"clusterEndpointIAM":     []byte(strings.ReplaceAll(awsclients.StringValue(obj.ClusterInfo.ZookeeperConnectString), "2181", "9098")),
+	// Creating and updating a cluster are long-running operations. Report
+	// the progress of the one that is running, if any.
+	if obj.ClusterInfo.ActiveOperationArn != nil {
+		rsp, err := h.client.DescribeClusterOperationWithContext(ctx, &svcsdk.DescribeClusterOperationInput{ClusterOperationArn: obj.ClusterInfo.ActiveOperationArn})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclients.Wrap(err, errDescribeOperation)
+		}
+		c = c.WithMessage(operationMessage(rsp.ClusterOperationInfo))
 	}
+	cr.SetConditions(c)
 
 	return obs, nil
 }
 
+// bootstrapBrokers returns the non-empty bootstrap broker strings as
+// connection details. The clusterEndpoint keys predate the bootstrapBroker
+// keys and are kept for compatibility.
+func bootstrapBrokers(obj *svcsdk.GetBootstrapBrokersOutput) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for _, b := range []struct {
+		keys    []string
+		brokers *string
+	}{
+		{keys: []string{ConnectionDetailBootstrapBrokerString, "clusterEndpointPlain"}, brokers: obj.BootstrapBrokerString},
+		{keys: []string{ConnectionDetailBootstrapBrokerStringTLS, "clusterEndpointTls"}, brokers: obj.BootstrapBrokerStringTls},
+		{keys: []string{ConnectionDetailBootstrapBrokerStringSASLIAM, "clusterEndpointIAM"}, brokers: obj.BootstrapBrokerStringSaslIam},
+		{keys: []string{ConnectionDetailBootstrapBrokerStringSASLSCRAM}, brokers: obj.BootstrapBrokerStringSaslScram},
+	} {
+		if awsclients.StringValue(b.brokers) == "" {
+			continue
+		}
+		for _, k := range b.keys {
+			cd[k] = []byte(awsclients.StringValue(b.brokers))
+		}
+	}
+	return cd
+}
+
+// operationMessage describes the state of the supplied cluster operation and
+// of its latest step.
+func operationMessage(op *svcsdk.ClusterOperationInfo) string {
+	if op == nil {
+		return ""
+	}
+	msg := fmt.Sprintf("operation %s is %s", awsclients.StringValue(op.OperationType), awsclients.StringValue(op.OperationState))
+	if n := len(op.OperationSteps); n > 0 && op.OperationSteps[n-1].StepInfo != nil {
+		s := op.OperationSteps[n-1]
+		msg += fmt.Sprintf(", step %s is %s", awsclients.StringValue(s.StepName), awsclients.StringValue(s.StepInfo.StepStatus))
+	}
+	if op.ErrorInfo != nil {
+		msg += fmt.Sprintf(": %s: %s", awsclients.StringValue(op.ErrorInfo.ErrorCode), awsclients.StringValue(op.ErrorInfo.ErrorString))
+	}
+	return msg
+}
+
+// firstBroker returns the host and port of the first broker of the first
+// non-empty bootstrap broker string.
+func firstBroker(obj *svcsdk.GetBootstrapBrokersOutput) (string, int) {
+	for _, brokers := range []*string{
+		obj.BootstrapBrokerStringTls,
+		obj.BootstrapBrokerString,
+		obj.BootstrapBrokerStringSaslIam,
+		obj.BootstrapBrokerStringSaslScram,
+	} {
+		if awsclients.StringValue(brokers) == "" {
+			continue
+		}
+		host, p, err := net.SplitHostPort(strings.Split(awsclients.StringValue(brokers), ",")[0])
+		if err != nil {
+			continue
+		}
+		port, _ := strconv.Atoi(p)
+		return host, port
+	}
+	return "", 0
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateClusterInput) error {
 	obj.ClusterName = awsclients.String(meta.GetExternalName(cr))
 	obj.BrokerNodeGroupInfo = &svcsdk.BrokerNodeGroupInfo{
@@ -143,6 +262,134 @@ func postCreate(_ context.Context, cr *svcapitypes.Cluster, obj *svcsdk.CreateCl
 	return managed.ExternalCreation{}, nil
 }
 
+// isUpToDate returns true if the monitoring settings and the number of broker
+// nodes of the cluster are up to date. MSK runs one operation at a time and
+// rejects updates of clusters that are not ACTIVE, so those are considered up
+// to date until the running operation completed.
+func isUpToDate(cr *svcapitypes.Cluster, obj *svcsdk.DescribeClusterOutput) (bool, error) {
+	if awsclients.StringValue(obj.ClusterInfo.State) != string(svcapitypes.ClusterState_ACTIVE) {
+		return true, nil
+	}
+	want := GenerateCreateClusterInput(cr)
+	return isMonitoringUpToDate(want, obj.ClusterInfo) && isBrokerCountUpToDate(want, obj.ClusterInfo), nil
+}
+
+func isMonitoringUpToDate(want *svcsdk.CreateClusterInput, got *svcsdk.ClusterInfo) bool {
+	if want.EnhancedMonitoring != nil && awsclients.StringValue(want.EnhancedMonitoring) != awsclients.StringValue(got.EnhancedMonitoring) {
+		return false
+	}
+	if want.OpenMonitoring != nil && openMonitoringExporters(want.OpenMonitoring.Prometheus) != openMonitoringExportersOf(got.OpenMonitoring) {
+		return false
+	}
+	return want.LoggingInfo == nil || flattenBrokerLogs(want.LoggingInfo) == flattenBrokerLogs(got.LoggingInfo)
+}
+
+func isBrokerCountUpToDate(want *svcsdk.CreateClusterInput, got *svcsdk.ClusterInfo) bool {
+	return want.NumberOfBrokerNodes == nil || awsclients.Int64Value(want.NumberOfBrokerNodes) == awsclients.Int64Value(got.NumberOfBrokerNodes)
+}
+
+// exporters are the Prometheus exporters enabled in the brokers.
+type exporters struct {
+	jmx  bool
+	node bool
+}
+
+func openMonitoringExporters(p *svcsdk.PrometheusInfo) exporters {
+	e := exporters{}
+	if p == nil {
+		return e
+	}
+	if p.JmxExporter != nil {
+		e.jmx = awsclients.BoolValue(p.JmxExporter.EnabledInBroker)
+	}
+	if p.NodeExporter != nil {
+		e.node = awsclients.BoolValue(p.NodeExporter.EnabledInBroker)
+	}
+	return e
+}
+
+func openMonitoringExportersOf(o *svcsdk.OpenMonitoring) exporters {
+	e := exporters{}
+	if o == nil || o.Prometheus == nil {
+		return e
+	}
+	if o.Prometheus.JmxExporter != nil {
+		e.jmx = awsclients.BoolValue(o.Prometheus.JmxExporter.EnabledInBroker)
+	}
+	if o.Prometheus.NodeExporter != nil {
+		e.node = awsclients.BoolValue(o.Prometheus.NodeExporter.EnabledInBroker)
+	}
+	return e
+}
+
+// brokerLogs are the destinations of the broker logs. The settings of a
+// destination are only compared while it is enabled.
+type brokerLogs struct {
+	cloudWatchLogs         bool
+	cloudWatchLogGroup     string
+	firehose               bool
+	firehoseDeliveryStream string
+	s3                     bool
+	s3Bucket               string
+	s3Prefix               string
+}
+
+func flattenBrokerLogs(l *svcsdk.LoggingInfo) brokerLogs {
+	b := brokerLogs{}
+	if l == nil || l.BrokerLogs == nil {
+		return b
+	}
+	if c := l.BrokerLogs.CloudWatchLogs; c != nil && awsclients.BoolValue(c.Enabled) {
+		b.cloudWatchLogs = true
+		b.cloudWatchLogGroup = awsclients.StringValue(c.LogGroup)
+	}
+	if f := l.BrokerLogs.Firehose; f != nil && awsclients.BoolValue(f.Enabled) {
+		b.firehose = true
+		b.firehoseDeliveryStream = awsclients.StringValue(f.DeliveryStream)
+	}
+	if s := l.BrokerLogs.S3; s != nil && awsclients.BoolValue(s.Enabled) {
+		b.s3 = true
+		b.s3Bucket = awsclients.StringValue(s.Bucket)
+		b.s3Prefix = awsclients.StringValue(s.Prefix)
+	}
+	return b
+}
+
+// update starts at most one operation per reconcile, because MSK rejects
+// updates while another operation is running.
+func (h *hooks) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Cluster)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Updates must name the current version of the cluster, which isn't
+	// part of its status.
+	obj, err := h.client.DescribeClusterWithContext(ctx, &svcsdk.DescribeClusterInput{ClusterArn: awsclients.String(meta.GetExternalName(cr))})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errDescribeForUpdate)
+	}
+	want := GenerateCreateClusterInput(cr)
+	switch {
+	case !isMonitoringUpToDate(want, obj.ClusterInfo):
+		_, err = h.client.UpdateMonitoringWithContext(ctx, &svcsdk.UpdateMonitoringInput{
+			ClusterArn:         obj.ClusterInfo.ClusterArn,
+			CurrentVersion:     obj.ClusterInfo.CurrentVersion,
+			EnhancedMonitoring: want.EnhancedMonitoring,
+			OpenMonitoring:     want.OpenMonitoring,
+			LoggingInfo:        want.LoggingInfo,
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateMonitoring)
+	case !isBrokerCountUpToDate(want, obj.ClusterInfo):
+		_, err = h.client.UpdateBrokerCountWithContext(ctx, &svcsdk.UpdateBrokerCountInput{
+			ClusterArn:                obj.ClusterInfo.ClusterArn,
+			CurrentVersion:            obj.ClusterInfo.CurrentVersion,
+			TargetNumberOfBrokerNodes: want.NumberOfBrokerNodes,
+		})
+		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdateBrokerCount)
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
 // LateInitialize fills the empty fields in *svcapitypes.ClusterParameters with
 // the values seen in svcsdk.DescribeClusterOutput.
 // nolint:gocyclo
@@ -0,0 +1,430 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kafka/kafkaiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	testARN        = "arn:aws:kafka:us-east-1:123456789012:cluster/test/1"
+	testVersion    = "K3AEGXETSR30VB"
+	testBrokers    = "b-1.test:9092,b-2.test:9092"
+	testBrokersTLS = "b-1.test:9094,b-2.test:9094"
+	testZookeeper  = "z-1.test:2181"
+	testOperation  = "arn:aws:kafka:us-east-1:123456789012:cluster-operation/test/1/2"
+)
+
+var errBoom = errors.New("boom")
+
+type mockKafkaClient struct {
+	svcsdkapi.KafkaAPI
+
+	MockDescribeCluster          func(*svcsdk.DescribeClusterInput) (*svcsdk.DescribeClusterOutput, error)
+	MockDescribeClusterOperation func(*svcsdk.DescribeClusterOperationInput) (*svcsdk.DescribeClusterOperationOutput, error)
+	MockGetBootstrapBrokers      func(*svcsdk.GetBootstrapBrokersInput) (*svcsdk.GetBootstrapBrokersOutput, error)
+	MockUpdateMonitoring         func(*svcsdk.UpdateMonitoringInput) (*svcsdk.UpdateMonitoringOutput, error)
+	MockUpdateBrokerCount        func(*svcsdk.UpdateBrokerCountInput) (*svcsdk.UpdateBrokerCountOutput, error)
+}
+
+func (m *mockKafkaClient) DescribeClusterWithContext(_ aws.Context, in *svcsdk.DescribeClusterInput, _ ...request.Option) (*svcsdk.DescribeClusterOutput, error) {
+	return m.MockDescribeCluster(in)
+}
+
+func (m *mockKafkaClient) DescribeClusterOperationWithContext(_ aws.Context, in *svcsdk.DescribeClusterOperationInput, _ ...request.Option) (*svcsdk.DescribeClusterOperationOutput, error) {
+	return m.MockDescribeClusterOperation(in)
+}
+
+func (m *mockKafkaClient) GetBootstrapBrokersWithContext(_ aws.Context, in *svcsdk.GetBootstrapBrokersInput, _ ...request.Option) (*svcsdk.GetBootstrapBrokersOutput, error) {
+	return m.MockGetBootstrapBrokers(in)
+}
+
+func (m *mockKafkaClient) UpdateMonitoringWithContext(_ aws.Context, in *svcsdk.UpdateMonitoringInput, _ ...request.Option) (*svcsdk.UpdateMonitoringOutput, error) {
+	return m.MockUpdateMonitoring(in)
+}
+
+func (m *mockKafkaClient) UpdateBrokerCountWithContext(_ aws.Context, in *svcsdk.UpdateBrokerCountInput, _ ...request.Option) (*svcsdk.UpdateBrokerCountOutput, error) {
+	return m.MockUpdateBrokerCount(in)
+}
+
+type clusterModifier func(*svcapitypes.Cluster)
+
+func withSpec(p svcapitypes.ClusterParameters) clusterModifier {
+	return func(r *svcapitypes.Cluster) { r.Spec.ForProvider = p }
+}
+
+func withConditions(c ...xpv1.Condition) clusterModifier {
+	return func(r *svcapitypes.Cluster) { r.Status.SetConditions(c...) }
+}
+
+func cluster(m ...clusterModifier) *svcapitypes.Cluster {
+	cr := &svcapitypes.Cluster{}
+	meta.SetExternalName(cr, testARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func clusterInfo(state svcapitypes.ClusterState, m ...func(*svcsdk.ClusterInfo)) *svcsdk.DescribeClusterOutput {
+	i := &svcsdk.ClusterInfo{
+		ClusterArn:             aws.String(testARN),
+		CurrentVersion:         aws.String(testVersion),
+		State:                  aws.String(string(state)),
+		ZookeeperConnectString: aws.String(testZookeeper),
+		EnhancedMonitoring:     aws.String(svcsdk.EnhancedMonitoringDefault),
+		NumberOfBrokerNodes:    aws.Int64(3),
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return &svcsdk.DescribeClusterOutput{ClusterInfo: i}
+}
+
+func TestPostObserve(t *testing.T) {
+	brokers := func(*svcsdk.GetBootstrapBrokersInput) (*svcsdk.GetBootstrapBrokersOutput, error) {
+		return &svcsdk.GetBootstrapBrokersOutput{
+			BootstrapBrokerString:    aws.String(testBrokers),
+			BootstrapBrokerStringTls: aws.String(testBrokersTLS),
+		}, nil
+	}
+	brokerDetails := managed.ConnectionDetails{
+		"zookeeperEndpointPlain":                 []byte(testZookeeper),
+		"zookeeperEndpointTls":                   []byte(""),
+		ConnectionDetailBootstrapBrokerString:    []byte(testBrokers),
+		"clusterEndpointPlain":                   []byte(testBrokers),
+		ConnectionDetailBootstrapBrokerStringTLS: []byte(testBrokersTLS),
+		"clusterEndpointTls":                     []byte(testBrokersTLS),
+	}
+	zookeeperDetails := managed.ConnectionDetails{
+		"zookeeperEndpointPlain": []byte(testZookeeper),
+		"zookeeperEndpointTls":   []byte(""),
+	}
+
+	type args struct {
+		client svcsdkapi.KafkaAPI
+		cr     *svcapitypes.Cluster
+		obj    *svcsdk.DescribeClusterOutput
+	}
+	type want struct {
+		cr  *svcapitypes.Cluster
+		obs managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Active": {
+			args: args{
+				client: &mockKafkaClient{MockGetBootstrapBrokers: brokers},
+				cr:     cluster(),
+				obj:    clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Available())),
+				obs: managed.ExternalObservation{ConnectionDetails: brokerDetails},
+			},
+		},
+		"Creating": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeClusterOperation: func(*svcsdk.DescribeClusterOperationInput) (*svcsdk.DescribeClusterOperationOutput, error) {
+						return &svcsdk.DescribeClusterOperationOutput{ClusterOperationInfo: &svcsdk.ClusterOperationInfo{
+							OperationType:  aws.String("CREATE"),
+							OperationState: aws.String("PENDING"),
+						}}, nil
+					},
+				},
+				cr: cluster(),
+				obj: clusterInfo(svcapitypes.ClusterState_CREATING, func(i *svcsdk.ClusterInfo) {
+					i.ActiveOperationArn = aws.String(testOperation)
+				}),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Creating().WithMessage("operation CREATE is PENDING"))),
+				obs: managed.ExternalObservation{ConnectionDetails: zookeeperDetails},
+			},
+		},
+		"Updating": {
+			args: args{
+				client: &mockKafkaClient{
+					MockGetBootstrapBrokers: brokers,
+					MockDescribeClusterOperation: func(*svcsdk.DescribeClusterOperationInput) (*svcsdk.DescribeClusterOperationOutput, error) {
+						return &svcsdk.DescribeClusterOperationOutput{ClusterOperationInfo: &svcsdk.ClusterOperationInfo{
+							OperationType:  aws.String("INCREASE_BROKER_COUNT"),
+							OperationState: aws.String("UPDATE_IN_PROGRESS"),
+							OperationSteps: []*svcsdk.ClusterOperationStep{
+								{StepName: aws.String("INITIALIZE_UPDATE"), StepInfo: &svcsdk.ClusterOperationStepInfo{StepStatus: aws.String("COMPLETED")}},
+								{StepName: aws.String("UPDATE_APPLY"), StepInfo: &svcsdk.ClusterOperationStepInfo{StepStatus: aws.String("IN_PROGRESS")}},
+							},
+						}}, nil
+					},
+				},
+				cr: cluster(),
+				obj: clusterInfo(svcapitypes.ClusterState_UPDATING, func(i *svcsdk.ClusterInfo) {
+					i.ActiveOperationArn = aws.String(testOperation)
+				}),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Unavailable().WithMessage("operation INCREASE_BROKER_COUNT is UPDATE_IN_PROGRESS, step UPDATE_APPLY is IN_PROGRESS"))),
+				obs: managed.ExternalObservation{ConnectionDetails: brokerDetails},
+			},
+		},
+		"Failed": {
+			args: args{
+				client: &mockKafkaClient{},
+				cr:     cluster(),
+				obj: clusterInfo(svcapitypes.ClusterState_FAILED, func(i *svcsdk.ClusterInfo) {
+					i.StateInfo = &svcsdk.StateInfo{Code: aws.String("InsufficientCapacity"), Message: aws.String("no capacity")}
+				}),
+			},
+			want: want{
+				cr:  cluster(withConditions(xpv1.Unavailable().WithMessage("InsufficientCapacity: no capacity"))),
+				obs: managed.ExternalObservation{ConnectionDetails: zookeeperDetails},
+			},
+		},
+		"GetBootstrapBrokersError": {
+			args: args{
+				client: &mockKafkaClient{
+					MockGetBootstrapBrokers: func(*svcsdk.GetBootstrapBrokersInput) (*svcsdk.GetBootstrapBrokersOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr:  cluster(),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: want{
+				cr:  cluster(),
+				err: awsclients.Wrap(errBoom, errGetBootstrapBrokers),
+			},
+		},
+		"DescribeOperationError": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeClusterOperation: func(*svcsdk.DescribeClusterOperationInput) (*svcsdk.DescribeClusterOperationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(),
+				obj: clusterInfo(svcapitypes.ClusterState_CREATING, func(i *svcsdk.ClusterInfo) {
+					i.ActiveOperationArn = aws.String(testOperation)
+				}),
+			},
+			want: want{
+				cr:  cluster(),
+				err: awsclients.Wrap(errBoom, errDescribeOperation),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client}
+			obs, err := h.postObserve(context.Background(), tc.args.cr, tc.args.obj, managed.ExternalObservation{}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type args struct {
+		cr  *svcapitypes.Cluster
+		obj *svcsdk.DescribeClusterOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{
+					EnhancedMonitoring:  aws.String(svcsdk.EnhancedMonitoringDefault),
+					NumberOfBrokerNodes: aws.Int64(3),
+					OpenMonitoring: &svcapitypes.OpenMonitoringInfo{Prometheus: &svcapitypes.PrometheusInfo{
+						JmxExporter: &svcapitypes.JmxExporterInfo{EnabledInBroker: aws.Bool(false)},
+					}},
+					LoggingInfo: &svcapitypes.LoggingInfo{BrokerLogs: &svcapitypes.BrokerLogs{
+						CloudWatchLogs: &svcapitypes.CloudWatchLogs{Enabled: aws.Bool(true), LogGroup: aws.String("msk")},
+						S3:             &svcapitypes.S3{Enabled: aws.Bool(false), Bucket: aws.String("logs")},
+					}},
+				})),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE, func(i *svcsdk.ClusterInfo) {
+					i.LoggingInfo = &svcsdk.LoggingInfo{BrokerLogs: &svcsdk.BrokerLogs{
+						CloudWatchLogs: &svcsdk.CloudWatchLogs{Enabled: aws.Bool(true), LogGroup: aws.String("msk")},
+					}}
+				}),
+			},
+			want: true,
+		},
+		"EnhancedMonitoringChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{
+					EnhancedMonitoring: aws.String(svcsdk.EnhancedMonitoringPerBroker),
+				})),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: false,
+		},
+		"OpenMonitoringChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{
+					OpenMonitoring: &svcapitypes.OpenMonitoringInfo{Prometheus: &svcapitypes.PrometheusInfo{
+						NodeExporter: &svcapitypes.NodeExporterInfo{EnabledInBroker: aws.Bool(true)},
+					}},
+				})),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: false,
+		},
+		"LoggingChanged": {
+			args: args{
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{
+					LoggingInfo: &svcapitypes.LoggingInfo{BrokerLogs: &svcapitypes.BrokerLogs{
+						Firehose: &svcapitypes.Firehose{Enabled: aws.Bool(true), DeliveryStream: aws.String("msk")},
+					}},
+				})),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: false,
+		},
+		"BrokerCountChanged": {
+			args: args{
+				cr:  cluster(withSpec(svcapitypes.ClusterParameters{NumberOfBrokerNodes: aws.Int64(6)})),
+				obj: clusterInfo(svcapitypes.ClusterState_ACTIVE),
+			},
+			want: false,
+		},
+		"NotActive": {
+			args: args{
+				cr:  cluster(withSpec(svcapitypes.ClusterParameters{NumberOfBrokerNodes: aws.Int64(6)})),
+				obj: clusterInfo(svcapitypes.ClusterState_UPDATING),
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, _ := isUpToDate(tc.args.cr, tc.args.obj)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	describe := func(*svcsdk.DescribeClusterInput) (*svcsdk.DescribeClusterOutput, error) {
+		return clusterInfo(svcapitypes.ClusterState_ACTIVE), nil
+	}
+
+	type args struct {
+		client svcsdkapi.KafkaAPI
+		cr     *svcapitypes.Cluster
+	}
+
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"UpdateMonitoring": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeCluster: describe,
+					MockUpdateMonitoring: func(in *svcsdk.UpdateMonitoringInput) (*svcsdk.UpdateMonitoringOutput, error) {
+						want := &svcsdk.UpdateMonitoringInput{
+							ClusterArn:         aws.String(testARN),
+							CurrentVersion:     aws.String(testVersion),
+							EnhancedMonitoring: aws.String(svcsdk.EnhancedMonitoringPerBroker),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.UpdateMonitoringOutput{}, nil
+					},
+				},
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{
+					EnhancedMonitoring:  aws.String(svcsdk.EnhancedMonitoringPerBroker),
+					NumberOfBrokerNodes: aws.Int64(6),
+				})),
+			},
+		},
+		"UpdateBrokerCount": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeCluster: describe,
+					MockUpdateBrokerCount: func(in *svcsdk.UpdateBrokerCountInput) (*svcsdk.UpdateBrokerCountOutput, error) {
+						want := &svcsdk.UpdateBrokerCountInput{
+							ClusterArn:                aws.String(testARN),
+							CurrentVersion:            aws.String(testVersion),
+							TargetNumberOfBrokerNodes: aws.Int64(6),
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.UpdateBrokerCountOutput{}, nil
+					},
+				},
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{NumberOfBrokerNodes: aws.Int64(6)})),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeCluster: func(*svcsdk.DescribeClusterInput) (*svcsdk.DescribeClusterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: awsclients.Wrap(errBoom, errDescribeForUpdate),
+		},
+		"UpdateBrokerCountError": {
+			args: args{
+				client: &mockKafkaClient{
+					MockDescribeCluster: describe,
+					MockUpdateBrokerCount: func(*svcsdk.UpdateBrokerCountInput) (*svcsdk.UpdateBrokerCountOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withSpec(svcapitypes.ClusterParameters{NumberOfBrokerNodes: aws.Int64(6)})),
+			},
+			want: awsclients.Wrap(errBoom, errUpdateBrokerCount),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client}
+			_, err := h.update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
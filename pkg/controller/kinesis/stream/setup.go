@@ -22,9 +22,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -32,20 +29,28 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupStream adds a controller that reconciles Stream.
-func SetupStream(mgr ctrl.Manager, o controller.Options) error {
+func SetupStream(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.StreamGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+	caps := capability.NewDetector()
 	opts := []option{
 		func(e *external) {
+			u := &updater{client: e.client, caps: caps}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = u.postObserve
 			e.preDelete = preDelete
 			e.postCreate = postCreate
 			e.preCreate = preCreate
-			u := &updater{client: e.client}
 			e.update = u.update
 			e.isUpToDate = u.isUpToDate
 		},
@@ -58,14 +63,10 @@ func SetupStream(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Stream{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.StreamGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.StreamGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<stream-name>", "kinesis", "stream"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -80,7 +81,7 @@ func preObserve(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.DescribeS
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (u *updater) postObserve(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -100,6 +101,7 @@ func postObserve(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.Describe
 		"arn":  []byte(awsclients.StringValue(obj.StreamDescription.StreamARN)),
 		"name": []byte(meta.GetExternalName(cr)),
 	}
+	u.caps.SetConditions(cr, capability.Tagging, capability.EnhancedMonitoring)
 
 	return obs, nil
 }
@@ -119,6 +121,7 @@ func postCreate(_ context.Context, cr *svcapitypes.Stream, obj *svcsdk.CreateStr
 
 type updater struct {
 	client svcsdkapi.KinesisAPI
+	caps   *capability.Detector
 }
 
 func (u *updater) isUpToDate(cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamOutput) (bool, error) { // nolint:gocyclo
@@ -143,20 +146,26 @@ func (u *updater) isUpToDate(cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamO
 			return false, nil
 		}
 
-		createKey, deleteKey := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
-		if len(createKey) != 0 || len(deleteKey) != 0 {
-			return false, nil
-		}
-
-		objTags, err := u.ListTags(cr)
-		if err != nil &&
-			objTags == nil {
-			return false, err
+		// Optional features the provider isn't permitted to manage are
+		// skipped rather than reported as drift.
+		if u.caps.Available(cr, capability.EnhancedMonitoring) {
+			createKey, deleteKey := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
+			if len(createKey) != 0 || len(deleteKey) != 0 {
+				return false, nil
+			}
 		}
-		addTags, removeTags := DiffTags(cr.Spec.ForProvider.Tags, objTags.Tags)
 
-		if len(addTags) != 0 || len(removeTags) != 0 {
-			return false, nil
+		if u.caps.Available(cr, capability.Tagging) {
+			objTags, err := u.ListTags(cr)
+			if err := u.caps.Check(cr, capability.Tagging, err); err != nil {
+				return false, err
+			}
+			if objTags != nil {
+				addTags, removeTags := DiffTags(cr.Spec.ForProvider.Tags, objTags.Tags)
+				if len(addTags) != 0 || len(removeTags) != 0 {
+					return false, nil
+				}
+			}
 		}
 
 	}
@@ -258,46 +267,61 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, nil
 	}
 
-	enableMetrics, disableMetrics := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
-	if len(enableMetrics) != 0 &&
-		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
+	if u.caps.Available(cr, capability.EnhancedMonitoring) {
+		enableMetrics, disableMetrics := DifferenceShardLevelMetrics(cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics, obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics)
+		if len(enableMetrics) != 0 &&
+			awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
 
-		if _, err := u.client.EnableEnhancedMonitoringWithContext(ctx, &svcsdk.EnableEnhancedMonitoringInput{
-			ShardLevelMetrics: cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics,
-			StreamName:        awsclients.String(meta.GetExternalName(cr)),
-		}); err != nil {
-			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+			_, err := u.client.EnableEnhancedMonitoringWithContext(ctx, &svcsdk.EnableEnhancedMonitoringInput{
+				ShardLevelMetrics: cr.Spec.ForProvider.EnhancedMetrics[0].ShardLevelMetrics,
+				StreamName:        awsclients.String(meta.GetExternalName(cr)),
+			})
+			if err := u.caps.Check(cr, capability.EnhancedMonitoring, err); err != nil {
+				return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+			}
+			// You can't make other updates to the data stream while it is being updated.
+			return managed.ExternalUpdate{}, nil
 		}
-		// You can't make other updates to the data stream while it is being updated.
-		return managed.ExternalUpdate{}, nil
-	}
 
-	if len(disableMetrics) != 0 &&
-		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
+		if len(disableMetrics) != 0 &&
+			awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
 
-		if _, err := u.client.DisableEnhancedMonitoringWithContext(ctx, &svcsdk.DisableEnhancedMonitoringInput{
-			ShardLevelMetrics: obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics,
-			StreamName:        awsclients.String(meta.GetExternalName(cr)),
-		}); err != nil {
-			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+			_, err := u.client.DisableEnhancedMonitoringWithContext(ctx, &svcsdk.DisableEnhancedMonitoringInput{
+				ShardLevelMetrics: obj.StreamDescription.EnhancedMonitoring[0].ShardLevelMetrics,
+				StreamName:        awsclients.String(meta.GetExternalName(cr)),
+			})
+			if err := u.caps.Check(cr, capability.EnhancedMonitoring, err); err != nil {
+				return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+			}
+			// You can't make other updates to the data stream while it is being updated.
+			return managed.ExternalUpdate{}, nil
 		}
-		// You can't make other updates to the data stream while it is being updated.
-		return managed.ExternalUpdate{}, nil
 	}
 
+	if u.caps.Available(cr, capability.Tagging) {
+		return u.updateTags(ctx, cr, obj)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (u *updater) updateTags(ctx context.Context, cr *svcapitypes.Stream, obj *svcsdk.DescribeStreamOutput) (managed.ExternalUpdate, error) {
 	objTags, err := u.ListTags(cr)
-	if err != nil &&
-		objTags == nil {
+	if err := u.caps.Check(cr, capability.Tagging, err); err != nil {
 		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 	}
+	if objTags == nil {
+		return managed.ExternalUpdate{}, nil
+	}
 	addTags, removeTags := DiffTags(cr.Spec.ForProvider.Tags, objTags.Tags)
 
 	if len(addTags) != 0 &&
 		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
-		if _, err := u.client.AddTagsToStreamWithContext(ctx, &svcsdk.AddTagsToStreamInput{
+		_, err := u.client.AddTagsToStreamWithContext(ctx, &svcsdk.AddTagsToStreamInput{
 			StreamName: awsclients.String(meta.GetExternalName(cr)),
 			Tags:       addTags,
-		}); err != nil {
+		})
+		if err := u.caps.Check(cr, capability.Tagging, err); err != nil {
 			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 		}
 		// You can't make other updates to the data stream while it is being updated.
@@ -306,14 +330,13 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 
 	if len(removeTags) != 0 &&
 		awsclients.StringValue(obj.StreamDescription.StreamStatus) == svcsdk.StreamStatusActive {
-		if _, err := u.client.RemoveTagsFromStreamWithContext(ctx, &svcsdk.RemoveTagsFromStreamInput{
+		_, err := u.client.RemoveTagsFromStreamWithContext(ctx, &svcsdk.RemoveTagsFromStreamInput{
 			StreamName: awsclients.String(meta.GetExternalName(cr)),
 			TagKeys:    removeTags,
-		}); err != nil {
+		})
+		if err := u.caps.Check(cr, capability.Tagging, err); err != nil {
 			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 		}
-		// You can't make other updates to the data stream while it is being updated.
-		return managed.ExternalUpdate{}, nil
 	}
 
 	return managed.ExternalUpdate{}, nil
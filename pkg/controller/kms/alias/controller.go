@@ -79,12 +79,23 @@ func (e *external) Observe(ctx context.Context, mg cpresource.Managed) (managed.
 	if err := e.preObserve(ctx, cr, input); err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, "pre-observe failed")
 	}
-	resp, err := e.client.ListAliasesWithContext(ctx, input)
+	var resp *svcsdk.ListAliasesOutput
+	found, err := awsclient.FindInPages(ctx, func(ctx context.Context, marker *string) (*string, bool, error) {
+		input.Marker = marker
+		page, err := e.client.ListAliasesWithContext(ctx, input)
+		if err != nil {
+			return nil, false, err
+		}
+		resp = e.filterList(cr, page)
+		if !awsclient.BoolValue(page.Truncated) {
+			return nil, len(resp.Aliases) > 0, nil
+		}
+		return page.NextMarker, len(resp.Aliases) > 0, nil
+	})
 	if err != nil {
 		return managed.ExternalObservation{ResourceExists: false}, awsclient.Wrap(cpresource.Ignore(IsNotFound, err), errDescribe)
 	}
-	resp = e.filterList(cr, resp)
-	if len(resp.Aliases) == 0 {
+	if !found {
 		return managed.ExternalObservation{ResourceExists: false}, nil
 	}
 	currentSpec := cr.Spec.ForProvider.DeepCopy()
@@ -0,0 +1,197 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsourcemapping
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/arnref"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an EventSourceMapping resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get EventSourceMapping"
+	errCreate           = "failed to create EventSourceMapping"
+	errUpdate           = "failed to update EventSourceMapping"
+	errDelete           = "failed to delete EventSourceMapping"
+)
+
+// arnChecks validate that an EventSourceMapping reads from a kind of event
+// source Lambda supports and sends failures to a queue or a topic.
+var arnChecks = []arnref.Check{
+	{
+		Field: "spec.forProvider.eventSourceArn",
+		ARN: func(mg resource.Managed) *string {
+			cr, ok := mg.(*v1alpha1.EventSourceMapping)
+			if !ok {
+				return nil
+			}
+			return cr.Spec.ForProvider.EventSourceARN
+		},
+		Kinds: []arnref.Kind{arnref.SQSQueue, arnref.KinesisStream, arnref.DynamoDBStream, arnref.MSKCluster, arnref.MQBroker},
+	},
+	{
+		Field: "spec.forProvider.onFailure.arn",
+		ARN: func(mg resource.Managed) *string {
+			cr, ok := mg.(*v1alpha1.EventSourceMapping)
+			if !ok || cr.Spec.ForProvider.OnFailure == nil {
+				return nil
+			}
+			return cr.Spec.ForProvider.OnFailure.ARN
+		},
+		Kinds: []arnref.Kind{arnref.SQSQueue, arnref.SNSTopic},
+	},
+}
+
+// SetupEventSourceMapping adds a controller that reconciles
+// EventSourceMappings.
+func SetupEventSourceMapping(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.EventSourceMappingGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.EventSourceMapping{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.EventSourceMappingGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: lambda.NewClient},
+			managed.WithReferenceResolver(arnref.NewReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), managed.NewAPISimpleReferenceResolver(mgr.GetClient())), arnChecks...)),
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) lambda.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EventSourceMapping)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client lambda.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EventSourceMapping)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	out, err := e.client.GetEventSourceMappingWithContext(ctx, &awslambda.GetEventSourceMappingInput{
+		UUID: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errGet)
+	}
+	current := cr.Spec.ForProvider.DeepCopy()
+	lambda.LateInitializeEventSourceMapping(&cr.Spec.ForProvider, out)
+
+	cr.Status.AtProvider = lambda.GenerateEventSourceMappingObservation(out)
+	lambda.TransitionalStates.Hint(ctx, cr.Status.AtProvider.State)
+	switch cr.Status.AtProvider.State {
+	case lambda.StateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case lambda.StateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        lambda.IsEventSourceMappingUpToDate(cr.Spec.ForProvider, out),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EventSourceMapping)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	out, err := e.client.CreateEventSourceMappingWithContext(ctx, lambda.GenerateCreateEventSourceMappingInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(out.UUID))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EventSourceMapping)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.UpdateEventSourceMappingWithContext(ctx, lambda.GenerateUpdateEventSourceMappingInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EventSourceMapping)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == lambda.StateDeleting {
+		return nil
+	}
+	_, err := e.client.DeleteEventSourceMappingWithContext(ctx, &awslambda.DeleteEventSourceMappingInput{
+		UUID: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(lambda.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,347 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventsourcemapping
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	uuid        = "a1b2c3d4"
+	functionARN = "arn:aws:lambda:us-east-1:123456789012:function:handler"
+	queueARN    = "arn:aws:sqs:us-east-1:123456789012:events"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	lambda *fake.MockClient
+	cr     resource.Managed
+}
+
+type mappingModifier func(*v1alpha1.EventSourceMapping)
+
+func withExternalName(n string) mappingModifier {
+	return func(m *v1alpha1.EventSourceMapping) { meta.SetExternalName(m, n) }
+}
+
+func withBatchSize(s int64) mappingModifier {
+	return func(m *v1alpha1.EventSourceMapping) { m.Spec.ForProvider.BatchSize = &s }
+}
+
+func withObservation(o v1alpha1.EventSourceMappingObservation) mappingModifier {
+	return func(m *v1alpha1.EventSourceMapping) { m.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) mappingModifier {
+	return func(m *v1alpha1.EventSourceMapping) { m.Status.ConditionedStatus.Conditions = c }
+}
+
+func mapping(m ...mappingModifier) *v1alpha1.EventSourceMapping {
+	cr := &v1alpha1.EventSourceMapping{
+		Spec: v1alpha1.EventSourceMappingSpec{
+			ForProvider: v1alpha1.EventSourceMappingParameters{
+				FunctionName:   awsclient.String("handler"),
+				EventSourceARN: awsclient.String(queueARN),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func configuration(state string) *awslambda.EventSourceMappingConfiguration {
+	return &awslambda.EventSourceMappingConfiguration{
+		UUID:           &uuid,
+		FunctionArn:    &functionARN,
+		EventSourceArn: &queueARN,
+		State:          &state,
+		BatchSize:      awsclient.Int64(10),
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				cr: mapping(),
+			},
+			want: want{
+				cr: mapping(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetEventSourceMapping: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr: mapping(withExternalName(uuid)),
+			},
+		},
+		"GetError": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetEventSourceMapping: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr:  mapping(withExternalName(uuid)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"Available": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetEventSourceMapping: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return configuration(lambda.StateEnabled), nil
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr: mapping(
+					withExternalName(uuid),
+					withBatchSize(10),
+					withObservation(v1alpha1.EventSourceMappingObservation{UUID: uuid, FunctionARN: functionARN, State: lambda.StateEnabled}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetEventSourceMapping: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return configuration(lambda.StateEnabled), nil
+					},
+				},
+				cr: mapping(withExternalName(uuid), withBatchSize(5)),
+			},
+			want: want{
+				cr: mapping(
+					withExternalName(uuid),
+					withBatchSize(5),
+					withObservation(v1alpha1.EventSourceMappingObservation{UUID: uuid, FunctionARN: functionARN, State: lambda.StateEnabled}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"Creating": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetEventSourceMapping: func(context.Context, *awslambda.GetEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return configuration(lambda.StateCreating), nil
+					},
+				},
+				cr: mapping(withExternalName(uuid), withBatchSize(10)),
+			},
+			want: want{
+				cr: mapping(
+					withExternalName(uuid),
+					withBatchSize(10),
+					withObservation(v1alpha1.EventSourceMappingObservation{UUID: uuid, FunctionARN: functionARN, State: lambda.StateCreating}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lambda}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockCreateEventSourceMapping: func(_ context.Context, in *awslambda.CreateEventSourceMappingInput, _ []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						if awsclient.StringValue(in.EventSourceArn) != queueARN {
+							return nil, errBoom
+						}
+						return configuration(lambda.StateCreating), nil
+					},
+				},
+				cr: mapping(),
+			},
+			want: want{
+				cr:     mapping(withExternalName(uuid), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockCreateEventSourceMapping: func(context.Context, *awslambda.CreateEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mapping(),
+			},
+			want: want{
+				cr:  mapping(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lambda}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockDeleteEventSourceMapping: func(context.Context, *awslambda.DeleteEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return configuration(lambda.StateDeleting), nil
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr: mapping(withExternalName(uuid), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				cr: mapping(withExternalName(uuid), withObservation(v1alpha1.EventSourceMappingObservation{State: lambda.StateDeleting})),
+			},
+			want: want{
+				cr: mapping(withExternalName(uuid), withObservation(v1alpha1.EventSourceMappingObservation{State: lambda.StateDeleting}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockDeleteEventSourceMapping: func(context.Context, *awslambda.DeleteEventSourceMappingInput, []request.Option) (*awslambda.EventSourceMappingConfiguration, error) {
+						return nil, errBoom
+					},
+				},
+				cr: mapping(withExternalName(uuid)),
+			},
+			want: want{
+				cr:  mapping(withExternalName(uuid), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lambda}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package function
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/google/go-cmp/cmp"
@@ -11,9 +12,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -21,21 +19,47 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/arnref"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
+// arnChecks validate that a Function's dead-letter target is a queue or a
+// topic, the only targets Lambda can send failed events to.
+var arnChecks = []arnref.Check{{
+	Field: "spec.forProvider.deadLetterConfig.targetARN",
+	ARN: func(mg resource.Managed) *string {
+		cr, ok := mg.(*svcapitypes.Function)
+		if !ok || cr.Spec.ForProvider.DeadLetterConfig == nil {
+			return nil
+		}
+		return cr.Spec.ForProvider.DeadLetterConfig.TargetARN
+	},
+	Kinds: []arnref.Kind{arnref.SQSQueue, arnref.SNSTopic},
+}}
+
 // SetupFunction adds a controller that reconciles Function.
-func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
+func SetupFunction(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.FunctionGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+	caps := capability.NewDetector()
 	opts := []option{
 		func(e *external) {
+			e.client = &propagationClient{LambdaAPI: e.client}
+			u := &updater{client: e.client, caps: caps}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = u.postObserve
 			e.preDelete = preDelete
 			e.preCreate = preCreate
-			e.isUpToDate = isUpToDate
+			e.isUpToDate = u.isUpToDate
 			e.lateInitialize = LateInitialize
-			u := &updater{client: e.client}
 			e.update = u.update
 		},
 	}
@@ -47,14 +71,11 @@ func SetupFunction(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Function{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.FunctionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.FunctionGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<function-name>", "lambda", "function"), &connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithReferenceResolver(arnref.NewReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), managed.NewAPISimpleReferenceResolver(mgr.GetClient())), arnChecks...)),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -93,7 +114,7 @@ func preObserve(_ context.Context, cr *svcapitypes.Function, obj *svcsdk.GetFunc
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFunctionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (u *updater) postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFunctionOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -105,6 +126,7 @@ func postObserve(_ context.Context, cr *svcapitypes.Function, resp *svcsdk.GetFu
 	case string(svcapitypes.State_Failed), string(svcapitypes.State_Inactive):
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	u.caps.SetConditions(cr, capability.Tagging)
 	return obs, nil
 }
 
@@ -174,13 +196,13 @@ func isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool,
 		return false, nil
 	}
 
-	if !isUpToDateSecurityGroupIDs(cr, obj) {
-		return false, nil
-	}
+	return isUpToDateSecurityGroupIDs(cr, obj), nil
+}
 
+// isUpToDateTags checks if the tags of the function are up to date
+func isUpToDateTags(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) bool {
 	addTags, removeTags := aws.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, obj.Tags)
-	return len(addTags) == 0 && len(removeTags) == 0, nil
-
+	return len(addTags) == 0 && len(removeTags) == 0
 }
 
 // isUpToDateEnvironment checks if FunctionConfiguration EnvironmentResponse Variables are up to date
@@ -266,8 +288,45 @@ func isUpToDateSecurityGroupIDs(cr *svcapitypes.Function, obj *svcsdk.GetFunctio
 	return cmp.Equal(securityGroupIDs, awsSecurityGroupIDs, sortCmp, cmpopts.EquateEmpty())
 }
 
+// A propagationClient waits for the execution role of a function to
+// propagate when creating it or changing its role, since the role is often
+// created moments before by another managed resource.
+type propagationClient struct {
+	svcsdkapi.LambdaAPI
+}
+
+func (c *propagationClient) CreateFunctionWithContext(ctx context.Context, in *svcsdk.CreateFunctionInput, opts ...request.Option) (out *svcsdk.FunctionConfiguration, err error) {
+	err = iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		out, err = c.LambdaAPI.CreateFunctionWithContext(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
+func (c *propagationClient) UpdateFunctionConfigurationWithContext(ctx context.Context, in *svcsdk.UpdateFunctionConfigurationInput, opts ...request.Option) (out *svcsdk.FunctionConfiguration, err error) {
+	err = iam.WaitForPropagation(ctx, func(ctx context.Context) error {
+		out, err = c.LambdaAPI.UpdateFunctionConfigurationWithContext(ctx, in, opts...)
+		return err
+	})
+	return out, err
+}
+
 type updater struct {
 	client svcsdkapi.LambdaAPI
+	caps   *capability.Detector
+}
+
+// isUpToDate checks the function, and its tags unless the provider isn't
+// permitted to manage them.
+func (u *updater) isUpToDate(cr *svcapitypes.Function, obj *svcsdk.GetFunctionOutput) (bool, error) {
+	upToDate, err := isUpToDate(cr, obj)
+	if err != nil || !upToDate {
+		return upToDate, err
+	}
+	if !u.caps.Available(cr, capability.Tagging) {
+		return true, nil
+	}
+	return isUpToDateTags(cr, obj), nil
 }
 
 func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
@@ -295,41 +354,51 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
 	}
 
-	// Tags
+	if u.caps.Available(cr, capability.Tagging) {
+		if err := u.updateTags(ctx, cr, functionConfiguration.FunctionArn); err != nil {
+			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		}
+	}
+
+	//	if _, err := u.client.UpdateFunctionEventInvokeConfigWithContext(ctx, &svcsdk.UpdateFunctionEventInvokeConfigInput{
+	//		FunctionName:       aws.String(meta.GetExternalName(cr)),
+	//		DestinationConfig : cr.Spec.ForProvider.DestinationConfig .,
+	//	}); err != nil {
+	//		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
+	//	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateTags updates the tags of the function. Access denied errors skip
+// tagging rather than failing the update.
+func (u *updater) updateTags(ctx context.Context, cr *svcapitypes.Function, arn *string) error {
 	tags, err := u.client.ListTagsWithContext(ctx, &svcsdk.ListTagsInput{
-		Resource: functionConfiguration.FunctionArn,
+		Resource: arn,
 	})
 	if err != nil {
-		return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		return u.caps.Check(cr, capability.Tagging, err)
 	}
 
 	addTags, removeTags := aws.DiffTagsMapPtr(cr.Spec.ForProvider.Tags, tags.Tags)
 	// Remove old tags before adding new tags in case values change for keys
 	if len(removeTags) > 0 {
-		if _, err := u.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
-			Resource: functionConfiguration.FunctionArn,
+		_, err := u.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			Resource: arn,
 			TagKeys:  removeTags,
-		}); err != nil {
-			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
+		})
+		if err != nil {
+			return u.caps.Check(cr, capability.Tagging, err)
 		}
 	}
 	if len(addTags) > 0 {
-		if _, err := u.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
-			Resource: functionConfiguration.FunctionArn,
+		_, err := u.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			Resource: arn,
 			Tags:     addTags,
-		}); err != nil {
-			return managed.ExternalUpdate{}, aws.Wrap(err, errUpdate)
-		}
+		})
+		return u.caps.Check(cr, capability.Tagging, err)
 	}
-
-	//	if _, err := u.client.UpdateFunctionEventInvokeConfigWithContext(ctx, &svcsdk.UpdateFunctionEventInvokeConfigInput{
-	//		FunctionName:       aws.String(meta.GetExternalName(cr)),
-	//		DestinationConfig : cr.Spec.ForProvider.DestinationConfig .,
-	//	}); err != nil {
-	//		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdate)
-	//	}
-
-	return managed.ExternalUpdate{}, nil
+	return nil
 }
 
 // GenerateUpdateFunctionCodeInput is similar to GenerateCreateFunctionConfigurationInput
@@ -1,15 +1,21 @@
 package function
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/lambda"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
 )
 
 type args struct {
@@ -521,6 +527,99 @@ func TestIsUpToDateSecurityGroupIDs(t *testing.T) {
 	}
 }
 
+type mockLambdaClient struct {
+	svcsdkapi.LambdaAPI
+
+	listTags    func(*svcsdk.ListTagsInput) (*svcsdk.ListTagsOutput, error)
+	tagResource func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error)
+}
+
+func (m *mockLambdaClient) ListTagsWithContext(_ context.Context, in *svcsdk.ListTagsInput, _ ...request.Option) (*svcsdk.ListTagsOutput, error) {
+	return m.listTags(in)
+}
+
+func (m *mockLambdaClient) TagResourceWithContext(_ context.Context, in *svcsdk.TagResourceInput, _ ...request.Option) (*svcsdk.TagResourceOutput, error) {
+	return m.tagResource(in)
+}
+
+func TestUpdateTags(t *testing.T) {
+	errBoom := errors.New("boom")
+	errDenied := awserr.New("AccessDeniedException", "", nil)
+	tagged := function(withSpec(v1beta1.FunctionParameters{
+		Tags:          map[string]*string{"k": aws.String("v")},
+		TracingConfig: &v1beta1.TracingConfig{},
+	}))
+
+	type want struct {
+		err       error
+		available bool
+	}
+
+	cases := map[string]struct {
+		client svcsdkapi.LambdaAPI
+		want
+	}{
+		"Tagged": {
+			client: &mockLambdaClient{
+				listTags: func(*svcsdk.ListTagsInput) (*svcsdk.ListTagsOutput, error) {
+					return &svcsdk.ListTagsOutput{}, nil
+				},
+				tagResource: func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+					return &svcsdk.TagResourceOutput{}, nil
+				},
+			},
+			want: want{available: true},
+		},
+		"TaggingDenied": {
+			client: &mockLambdaClient{
+				listTags: func(*svcsdk.ListTagsInput) (*svcsdk.ListTagsOutput, error) {
+					return &svcsdk.ListTagsOutput{}, nil
+				},
+				tagResource: func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+					return nil, errDenied
+				},
+			},
+			want: want{available: false},
+		},
+		"ListTagsDenied": {
+			client: &mockLambdaClient{
+				listTags: func(*svcsdk.ListTagsInput) (*svcsdk.ListTagsOutput, error) {
+					return nil, errDenied
+				},
+			},
+			want: want{available: false},
+		},
+		"OtherError": {
+			client: &mockLambdaClient{
+				listTags: func(*svcsdk.ListTagsInput) (*svcsdk.ListTagsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			want: want{err: errBoom, available: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &updater{client: tc.client, caps: capability.NewDetector()}
+			err := u.updateTags(context.Background(), tagged, aws.String("arn"))
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("updateTags(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.available, u.caps.Available(tagged, capability.Tagging)); diff != "" {
+				t.Errorf("Available(...): -want, +got:\n%s", diff)
+			}
+			// Skipped tags must not be reported as drift.
+			upToDate, _ := u.isUpToDate(tagged, &svcsdk.GetFunctionOutput{Configuration: &svcsdk.FunctionConfiguration{
+				TracingConfig: &svcsdk.TracingConfigResponse{},
+			}})
+			if diff := cmp.Diff(!tc.want.available, upToDate); diff != "" {
+				t.Errorf("isUpToDate(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateUpdateFunctionCodeInput(t *testing.T) {
 	type args struct {
 		cr *v1beta1.Function
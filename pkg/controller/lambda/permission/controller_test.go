@@ -0,0 +1,246 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permission
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awslambda "github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/lambda/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/lambda/fake"
+)
+
+var (
+	sid     = "allow-s3"
+	errBoom = errors.New("boom")
+
+	policy = `{"Version":"2012-10-17","Statement":[{"Sid":"allow-s3","Effect":"Allow",` +
+		`"Principal":{"Service":"s3.amazonaws.com"},"Action":"lambda:InvokeFunction",` +
+		`"Resource":"arn:aws:lambda:us-east-1:123456789012:function:handler",` +
+		`"Condition":{"ArnLike":{"AWS:SourceArn":"arn:aws:s3:::bucket"}}}]}`
+)
+
+type args struct {
+	lambda *fake.MockClient
+	cr     resource.Managed
+}
+
+type permissionModifier func(*v1alpha1.Permission)
+
+func withSourceARN(a string) permissionModifier {
+	return func(p *v1alpha1.Permission) { p.Spec.ForProvider.SourceARN = &a }
+}
+
+func withConditions(c ...xpv1.Condition) permissionModifier {
+	return func(p *v1alpha1.Permission) { p.Status.ConditionedStatus.Conditions = c }
+}
+
+func permission(m ...permissionModifier) *v1alpha1.Permission {
+	cr := &v1alpha1.Permission{
+		Spec: v1alpha1.PermissionSpec{
+			ForProvider: v1alpha1.PermissionParameters{
+				FunctionName: awsclient.String("handler"),
+				Action:       "lambda:InvokeFunction",
+				Principal:    "s3.amazonaws.com",
+			},
+		},
+	}
+	meta.SetExternalName(cr, sid)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getPolicy(doc string) func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+	return func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+		return &awslambda.GetPolicyOutput{Policy: &doc}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoFunctionName": {
+			args: args{
+				cr: permission(func(p *v1alpha1.Permission) { p.Spec.ForProvider.FunctionName = nil }),
+			},
+			want: want{
+				cr:  permission(func(p *v1alpha1.Permission) { p.Spec.ForProvider.FunctionName = nil }),
+				err: errors.New(errNoFunctionName),
+			},
+		},
+		"NoPolicy": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetPolicy: func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+						return nil, awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: permission(),
+			},
+			want: want{
+				cr: permission(),
+			},
+		},
+		"GetPolicyError": {
+			args: args{
+				lambda: &fake.MockClient{
+					MockGetPolicy: func(context.Context, *awslambda.GetPolicyInput, []request.Option) (*awslambda.GetPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: permission(),
+			},
+			want: want{
+				cr:  permission(),
+				err: awsclient.Wrap(errBoom, errGetPolicy),
+			},
+		},
+		"NoStatement": {
+			args: args{
+				lambda: &fake.MockClient{MockGetPolicy: getPolicy(`{"Statement":[]}`)},
+				cr:     permission(),
+			},
+			want: want{
+				cr: permission(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				lambda: &fake.MockClient{MockGetPolicy: getPolicy(policy)},
+				cr:     permission(withSourceARN("arn:aws:s3:::bucket")),
+			},
+			want: want{
+				cr: permission(withSourceARN("arn:aws:s3:::bucket"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				lambda: &fake.MockClient{MockGetPolicy: getPolicy(policy)},
+				cr:     permission(withSourceARN("arn:aws:s3:::other")),
+			},
+			want: want{
+				cr: permission(withSourceARN("arn:aws:s3:::other"), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.lambda}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		removeErr error
+		addErr    error
+		want
+	}{
+		"Successful": {
+			want: want{calls: []string{"remove", "add"}},
+		},
+		"AlreadyRemoved": {
+			removeErr: awserr.New(awslambda.ErrCodeResourceNotFoundException, "", nil),
+			want:      want{calls: []string{"remove", "add"}},
+		},
+		"RemoveError": {
+			removeErr: errBoom,
+			want: want{
+				calls: []string{"remove"},
+				err:   awsclient.Wrap(errBoom, errRemove),
+			},
+		},
+		"AddError": {
+			addErr: errBoom,
+			want: want{
+				calls: []string{"remove", "add"},
+				err:   awsclient.Wrap(errBoom, errAdd),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockRemovePermission: func(_ context.Context, in *awslambda.RemovePermissionInput, _ []request.Option) (*awslambda.RemovePermissionOutput, error) {
+					calls = append(calls, "remove")
+					return &awslambda.RemovePermissionOutput{}, tc.removeErr
+				},
+				MockAddPermission: func(_ context.Context, in *awslambda.AddPermissionInput, _ []request.Option) (*awslambda.AddPermissionOutput, error) {
+					calls = append(calls, "add")
+					return &awslambda.AddPermissionOutput{}, tc.addErr
+				},
+			}}
+			_, err := e.Update(context.Background(), permission())
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
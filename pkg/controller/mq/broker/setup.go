@@ -2,17 +2,18 @@ package broker
 
 import (
 	"context"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/mq"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/mq/mqiface"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -20,13 +21,42 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/mq"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
+// Connection detail keys of the broker endpoints. Brokers with more than one
+// instance publish the endpoints of the instances after the first with the
+// index of the instance appended to the key, e.g. AMQPEndpoint1.
+const (
+	ConnectionDetailConsoleURL       = "ConsoleURL"
+	ConnectionDetailOpenWireEndpoint = "OpenWireEndpoint"
+	ConnectionDetailAMQPEndpoint     = "AMQPEndpoint"
+	ConnectionDetailSTOMPEndpoint    = "STOMPEndpoint"
+	ConnectionDetailMQTTEndpoint     = "MQTTEndpoint"
+	ConnectionDetailWSSEndpoint      = "WSSEndpoint"
+)
+
+// endpointKeys maps the protocols of the broker endpoints to the connection
+// detail keys they are published with.
+var endpointKeys = map[string]string{
+	"ssl":       ConnectionDetailOpenWireEndpoint,
+	"amqp+ssl":  ConnectionDetailAMQPEndpoint,
+	"amqps":     ConnectionDetailAMQPEndpoint,
+	"stomp+ssl": ConnectionDetailSTOMPEndpoint,
+	"mqtt+ssl":  ConnectionDetailMQTTEndpoint,
+	"wss":       ConnectionDetailWSSEndpoint,
+}
+
 // SetupBroker adds a controller that reconciles Broker.
-func SetupBroker(mgr ctrl.Manager, o controller.Options) error {
+func SetupBroker(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.BrokerGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			c := &custom{client: e.client, kube: e.kube, external: e}
@@ -34,7 +64,9 @@ func SetupBroker(mgr ctrl.Manager, o controller.Options) error {
 			e.postCreate = postCreate
 			e.preObserve = preObserve
 			e.preDelete = preDelete
+			e.preUpdate = preUpdate
 			e.postObserve = c.postObserve
+			e.isUpToDate = isUpToDate
 			e.lateInitialize = LateInitialize
 		},
 	}
@@ -46,15 +78,11 @@ func SetupBroker(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.Broker{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.BrokerGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.BrokerGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Plain("<broker-id>"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -86,18 +114,24 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.Broker, obj *s
 		cr.SetConditions(xpv1.Unavailable())
 	}
 
-	pw, _, err := mq.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.CustomUsers[0].PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil || pw == "" {
-		return obs, errors.Wrap(err, "cannot get password from the given secret")
-	}
-
 	obs.ConnectionDetails = managed.ConnectionDetails{
 		"BrokerID": []byte(awsclients.StringValue(cr.Status.AtProvider.BrokerID)),
 		"Region":   []byte(awsclients.StringValue(&cr.Spec.ForProvider.Region)),
-		"Username": []byte(awsclients.StringValue(cr.Spec.ForProvider.CustomUsers[0].Username)),
-		"Password": []byte(pw),
+	}
+	for k, v := range instanceConnectionDetails(obj.BrokerInstances) {
+		obs.ConnectionDetails[k] = v
+	}
+	if len(cr.Spec.ForProvider.CustomUsers) == 0 {
+		return obs, nil
 	}
 
+	pw, _, err := mq.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.CustomUsers[0].PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if resource.IgnoreNotFound(err) != nil || pw == "" {
+		return obs, errors.Wrap(err, "cannot get password from the given secret")
+	}
+	obs.ConnectionDetails["Username"] = []byte(awsclients.StringValue(cr.Spec.ForProvider.CustomUsers[0].Username))
+	obs.ConnectionDetails["Password"] = []byte(pw)
+
 	return obs, nil
 
 }
@@ -111,23 +145,29 @@ func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.Broker, obj *svc
 
 	obj.BrokerName = awsclients.String(cr.Name)
 
-	pw, _, err := mq.GetPassword(ctx, e.kube, &cr.Spec.ForProvider.CustomUsers[0].PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil || pw == "" {
-		return errors.Wrap(err, "cannot get password from the given secret")
-	}
-
-	obj.Users = []*svcsdk.User{
-		{
-			Username:      cr.Spec.ForProvider.CustomUsers[0].Username,
+	obj.Users = make([]*svcsdk.User, len(cr.Spec.ForProvider.CustomUsers))
+	for i, u := range cr.Spec.ForProvider.CustomUsers {
+		pw, _, err := mq.GetPassword(ctx, e.kube, &u.PasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if resource.IgnoreNotFound(err) != nil || pw == "" {
+			return errors.Wrap(err, "cannot get password from the given secret")
+		}
+		obj.Users[i] = &svcsdk.User{
+			Username:      u.Username,
 			Password:      awsclients.String(pw),
-			ConsoleAccess: cr.Spec.ForProvider.CustomUsers[0].ConsoleAccess,
-			Groups:        cr.Spec.ForProvider.CustomUsers[0].Groups,
-		},
+			ConsoleAccess: u.ConsoleAccess,
+			Groups:        u.Groups,
+		}
 	}
 
 	return nil
 }
 
+func preUpdate(_ context.Context, cr *svcapitypes.Broker, obj *svcsdk.UpdateBrokerRequest) error {
+	obj.BrokerId = awsclients.String(meta.GetExternalName(cr))
+	obj.SecurityGroups = cr.Spec.ForProvider.SecurityGroups
+	return nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.Broker, obj *svcsdk.CreateBrokerResponse, cre managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
@@ -151,3 +191,121 @@ func LateInitialize(cr *svcapitypes.BrokerParameters, obj *svcsdk.DescribeBroker
 
 	return nil
 }
+
+// isUpToDate returns whether the settings of the broker that can be updated
+// match the desired ones. Changes that are pending until the next
+// maintenance window count as applied. Brokers that are not running cannot
+// be updated and are considered up to date.
+func isUpToDate(cr *svcapitypes.Broker, obj *svcsdk.DescribeBrokerResponse) (bool, error) {
+	if awsclients.StringValue(obj.BrokerState) != string(svcapitypes.BrokerState_RUNNING) {
+		return true, nil
+	}
+	p := cr.Spec.ForProvider
+	switch {
+	case p.AutoMinorVersionUpgrade != nil && *p.AutoMinorVersionUpgrade != awsclients.BoolValue(obj.AutoMinorVersionUpgrade):
+		return false, nil
+	case p.EngineVersion != nil && isVersionNewer(*p.EngineVersion, pending(obj.PendingEngineVersion, obj.EngineVersion)):
+		return false, nil
+	case p.HostInstanceType != nil && *p.HostInstanceType != pending(obj.PendingHostInstanceType, obj.HostInstanceType):
+		return false, nil
+	case !isMaintenanceWindowUpToDate(p.MaintenanceWindowStartTime, obj.MaintenanceWindowStartTime):
+		return false, nil
+	case !isLogsUpToDate(p.Logs, obj.Logs):
+		return false, nil
+	}
+	if len(p.SecurityGroups) == 0 {
+		return true, nil
+	}
+	observed := obj.SecurityGroups
+	if len(obj.PendingSecurityGroups) > 0 {
+		observed = obj.PendingSecurityGroups
+	}
+	return sortedEqual(aws.StringValueSlice(p.SecurityGroups), aws.StringValueSlice(observed)), nil
+}
+
+// pending returns the pending value of a setting if there is one, otherwise
+// its current value.
+func pending(p, current *string) string {
+	if awsclients.StringValue(p) != "" {
+		return *p
+	}
+	return awsclients.StringValue(current)
+}
+
+// isVersionNewer returns true if the dotted version want is newer than got.
+// Brokers cannot be downgraded, and those with automatic minor version
+// upgrades enabled are upgraded past the version they were created with.
+func isVersionNewer(want, got string) bool {
+	w, g := strings.Split(want, "."), strings.Split(got, ".")
+	for i := 0; i < len(w) && i < len(g); i++ {
+		wi, werr := strconv.Atoi(w[i])
+		gi, gerr := strconv.Atoi(g[i])
+		if werr != nil || gerr != nil {
+			return want != got
+		}
+		if wi != gi {
+			return wi > gi
+		}
+	}
+	return len(w) > len(g)
+}
+
+func isMaintenanceWindowUpToDate(want *svcapitypes.WeeklyStartTime, got *svcsdk.WeeklyStartTime) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		return false
+	}
+	return awsclients.StringValue(want.DayOfWeek) == awsclients.StringValue(got.DayOfWeek) &&
+		awsclients.StringValue(want.TimeOfDay) == awsclients.StringValue(got.TimeOfDay) &&
+		(want.TimeZone == nil || *want.TimeZone == awsclients.StringValue(got.TimeZone))
+}
+
+func isLogsUpToDate(want *svcapitypes.Logs, got *svcsdk.LogsSummary) bool {
+	if want == nil {
+		return true
+	}
+	if got == nil {
+		got = &svcsdk.LogsSummary{}
+	}
+	return (want.Audit == nil || *want.Audit == awsclients.BoolValue(got.Audit)) &&
+		(want.General == nil || *want.General == awsclients.BoolValue(got.General))
+}
+
+func sortedEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a, b = append([]string{}, a...), append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// instanceConnectionDetails returns the console URL and the endpoints of the
+// given broker instances keyed by their protocol.
+func instanceConnectionDetails(instances []*svcsdk.BrokerInstance) managed.ConnectionDetails {
+	cd := managed.ConnectionDetails{}
+	for i, in := range instances {
+		suffix := ""
+		if i > 0 {
+			suffix = strconv.Itoa(i)
+		}
+		if in.ConsoleURL != nil {
+			cd[ConnectionDetailConsoleURL+suffix] = []byte(*in.ConsoleURL)
+		}
+		for _, ep := range in.Endpoints {
+			scheme := strings.SplitN(awsclients.StringValue(ep), "://", 2)[0]
+			if k, ok := endpointKeys[scheme]; ok {
+				cd[k+suffix] = []byte(*ep)
+			}
+		}
+	}
+	return cd
+}
@@ -0,0 +1,148 @@
+package broker
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	svcsdk "github.com/aws/aws-sdk-go/service/mq"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/mq/v1alpha1"
+)
+
+func running(m ...func(*svcsdk.DescribeBrokerResponse)) *svcsdk.DescribeBrokerResponse {
+	r := &svcsdk.DescribeBrokerResponse{
+		BrokerState:             aws.String(svcsdk.BrokerStateRunning),
+		AutoMinorVersionUpgrade: aws.Bool(true),
+		EngineVersion:           aws.String("5.16.3"),
+		HostInstanceType:        aws.String("mq.t3.micro"),
+		MaintenanceWindowStartTime: &svcsdk.WeeklyStartTime{
+			DayOfWeek: aws.String(svcsdk.DayOfWeekSunday),
+			TimeOfDay: aws.String("02:00"),
+			TimeZone:  aws.String("UTC"),
+		},
+		SecurityGroups: aws.StringSlice([]string{"sg-1", "sg-2"}),
+	}
+	for _, f := range m {
+		f(r)
+	}
+	return r
+}
+
+func broker(p svcapitypes.BrokerParameters) *svcapitypes.Broker {
+	return &svcapitypes.Broker{Spec: svcapitypes.BrokerSpec{ForProvider: p}}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		cr   *svcapitypes.Broker
+		obj  *svcsdk.DescribeBrokerResponse
+		want bool
+	}{
+		"UpToDate": {
+			cr: broker(svcapitypes.BrokerParameters{
+				AutoMinorVersionUpgrade: aws.Bool(true),
+				EngineVersion:           aws.String("5.16.3"),
+				HostInstanceType:        aws.String("mq.t3.micro"),
+				MaintenanceWindowStartTime: &svcapitypes.WeeklyStartTime{
+					DayOfWeek: aws.String(svcsdk.DayOfWeekSunday),
+					TimeOfDay: aws.String("02:00"),
+				},
+				CustomBrokerParameters: svcapitypes.CustomBrokerParameters{
+					SecurityGroups: aws.StringSlice([]string{"sg-2", "sg-1"}),
+				},
+			}),
+			obj:  running(),
+			want: true,
+		},
+		"NotRunning": {
+			cr: broker(svcapitypes.BrokerParameters{HostInstanceType: aws.String("mq.m5.large")}),
+			obj: running(func(r *svcsdk.DescribeBrokerResponse) {
+				r.BrokerState = aws.String(svcsdk.BrokerStateCreationInProgress)
+			}),
+			want: true,
+		},
+		"MinorVersionUpgraded": {
+			cr:   broker(svcapitypes.BrokerParameters{EngineVersion: aws.String("5.16.2")}),
+			obj:  running(),
+			want: true,
+		},
+		"EngineVersionUpgrade": {
+			cr:   broker(svcapitypes.BrokerParameters{EngineVersion: aws.String("5.17.1")}),
+			obj:  running(),
+			want: false,
+		},
+		"HostInstanceTypePending": {
+			cr: broker(svcapitypes.BrokerParameters{HostInstanceType: aws.String("mq.m5.large")}),
+			obj: running(func(r *svcsdk.DescribeBrokerResponse) {
+				r.PendingHostInstanceType = aws.String("mq.m5.large")
+			}),
+			want: true,
+		},
+		"MaintenanceWindowChanged": {
+			cr: broker(svcapitypes.BrokerParameters{MaintenanceWindowStartTime: &svcapitypes.WeeklyStartTime{
+				DayOfWeek: aws.String(svcsdk.DayOfWeekMonday),
+				TimeOfDay: aws.String("02:00"),
+			}}),
+			obj:  running(),
+			want: false,
+		},
+		"LogsChanged": {
+			cr:   broker(svcapitypes.BrokerParameters{Logs: &svcapitypes.Logs{General: aws.Bool(true)}}),
+			obj:  running(),
+			want: false,
+		},
+		"SecurityGroupsChanged": {
+			cr: broker(svcapitypes.BrokerParameters{CustomBrokerParameters: svcapitypes.CustomBrokerParameters{
+				SecurityGroups: aws.StringSlice([]string{"sg-3"}),
+			}}),
+			obj:  running(),
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := isUpToDate(tc.cr, tc.obj)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInstanceConnectionDetails(t *testing.T) {
+	instances := []*svcsdk.BrokerInstance{
+		{
+			ConsoleURL: aws.String("https://b-1-1.mq.us-east-1.amazonaws.com:8162"),
+			Endpoints: aws.StringSlice([]string{
+				"ssl://b-1-1.mq.us-east-1.amazonaws.com:61617",
+				"amqp+ssl://b-1-1.mq.us-east-1.amazonaws.com:5671",
+				"stomp+ssl://b-1-1.mq.us-east-1.amazonaws.com:61614",
+				"mqtt+ssl://b-1-1.mq.us-east-1.amazonaws.com:8883",
+				"wss://b-1-1.mq.us-east-1.amazonaws.com:61619",
+			}),
+		},
+		{
+			ConsoleURL: aws.String("https://b-1-2.mq.us-east-1.amazonaws.com:8162"),
+			Endpoints:  aws.StringSlice([]string{"amqp+ssl://b-1-2.mq.us-east-1.amazonaws.com:5671"}),
+		},
+	}
+	want := managed.ConnectionDetails{
+		ConnectionDetailConsoleURL:         []byte("https://b-1-1.mq.us-east-1.amazonaws.com:8162"),
+		ConnectionDetailOpenWireEndpoint:   []byte("ssl://b-1-1.mq.us-east-1.amazonaws.com:61617"),
+		ConnectionDetailAMQPEndpoint:       []byte("amqp+ssl://b-1-1.mq.us-east-1.amazonaws.com:5671"),
+		ConnectionDetailSTOMPEndpoint:      []byte("stomp+ssl://b-1-1.mq.us-east-1.amazonaws.com:61614"),
+		ConnectionDetailMQTTEndpoint:       []byte("mqtt+ssl://b-1-1.mq.us-east-1.amazonaws.com:8883"),
+		ConnectionDetailWSSEndpoint:        []byte("wss://b-1-1.mq.us-east-1.amazonaws.com:61619"),
+		ConnectionDetailConsoleURL + "1":   []byte("https://b-1-2.mq.us-east-1.amazonaws.com:8162"),
+		ConnectionDetailAMQPEndpoint + "1": []byte("amqp+ssl://b-1-2.mq.us-east-1.amazonaws.com:5671"),
+	}
+	if diff := cmp.Diff(want, instanceConnectionDetails(instances)); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
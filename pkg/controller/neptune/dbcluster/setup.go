@@ -15,6 +15,7 @@ package dbcluster
 
 import (
 	"context"
+	"strconv"
 
 	svcsdk "github.com/aws/aws-sdk-go/service/neptune"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/neptune/neptuneiface"
@@ -23,9 +24,6 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -33,7 +31,10 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 type dbClusterStatus string
@@ -46,8 +47,11 @@ const (
 )
 
 // SetupDBCluster adds a controller that reconciles DB Cluster.
-func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupDBCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
 			e.lateInitialize = lateInitialize
@@ -68,14 +72,10 @@ func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.DBCluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<db-cluster-identifier>", "rds", "cluster"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -237,6 +237,22 @@ func postObserve(_ context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.Desc
 	case string(statusDeleted):
 		cr.SetConditions(xpv1.Unavailable())
 	}
+	obs.ConnectionDetails = getConnectionDetails(cr)
 
 	return obs, nil
 }
+
+func getConnectionDetails(cr *svcapitypes.DBCluster) managed.ConnectionDetails {
+	if aws.StringValue(cr.Status.AtProvider.Endpoint) == "" {
+		return nil
+	}
+	conn := managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(aws.StringValue(cr.Status.AtProvider.Endpoint)),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(aws.Int64Value(cr.Spec.ForProvider.Port), 10)),
+		"readerEndpoint":                          []byte(aws.StringValue(cr.Status.AtProvider.ReaderEndpoint)),
+	}
+	if cr.Spec.ForProvider.MasterUsername != nil {
+		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername))
+	}
+	return conn
+}
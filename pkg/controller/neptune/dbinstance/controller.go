@@ -0,0 +1,183 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsneptune "github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Neptune DBInstance resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe DBInstance"
+	errCreate           = "failed to create DBInstance"
+	errModify           = "failed to modify DBInstance"
+	errDelete           = "failed to delete DBInstance"
+)
+
+// SetupDBInstance adds a controller that reconciles Neptune DBInstances.
+func SetupDBInstance(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DBInstanceGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.DBInstance{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.DBInstanceGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: neptune.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) neptune.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client neptune.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	out, err := e.client.DescribeDBInstancesWithContext(ctx, &awsneptune.DescribeDBInstancesInput{
+		DBInstanceIdentifier: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(neptune.IsDBInstanceNotFound, err), errDescribe)
+	}
+	if len(out.DBInstances) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	db := out.DBInstances[0]
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	neptune.LateInitializeDBInstance(&cr.Spec.ForProvider, db)
+	cr.Status.AtProvider = neptune.GenerateDBInstanceObservation(db)
+
+	upToDate := true
+	switch cr.Status.AtProvider.DBInstanceStatus {
+	case v1alpha1.DBInstanceStateAvailable:
+		cr.SetConditions(xpv1.Available())
+		upToDate = neptune.IsDBInstanceUpToDate(cr.Spec.ForProvider, db)
+	case v1alpha1.DBInstanceStateCreating:
+		cr.SetConditions(xpv1.Creating())
+	case v1alpha1.DBInstanceStateDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		// The instance is being modified, rebooted or upgraded. Changes
+		// are only requested once it is available again.
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       getConnectionDetails(cr),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateDBInstanceWithContext(ctx, neptune.GenerateCreateDBInstanceInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	_, err := e.client.ModifyDBInstanceWithContext(ctx, neptune.GenerateModifyDBInstanceInput(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DBInstance)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.DBInstanceStatus == v1alpha1.DBInstanceStateDeleting {
+		return nil
+	}
+	// Neptune instances have no storage of their own, so snapshots are
+	// taken of the cluster instead.
+	_, err := e.client.DeleteDBInstanceWithContext(ctx, &awsneptune.DeleteDBInstanceInput{
+		DBInstanceIdentifier: awsclient.String(meta.GetExternalName(cr)),
+		SkipFinalSnapshot:    awsclient.Bool(true),
+	})
+	return awsclient.Wrap(resource.Ignore(neptune.IsDBInstanceNotFound, err), errDelete)
+}
+
+func getConnectionDetails(cr *v1alpha1.DBInstance) managed.ConnectionDetails {
+	if cr.Status.AtProvider.Endpoint.Address == "" {
+		return nil
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretEndpointKey: []byte(cr.Status.AtProvider.Endpoint.Address),
+		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(cr.Status.AtProvider.Endpoint.Port, 10)),
+	}
+}
@@ -0,0 +1,418 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbinstance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsneptune "github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune"
+	"github.com/crossplane/provider-aws/pkg/clients/neptune/fake"
+)
+
+var (
+	instanceName = "some-instance"
+	clusterName  = "some-cluster"
+	class        = "db.r5.large"
+	address      = "some-instance.abc.eu-central-1.neptune.amazonaws.com"
+	errBoom      = errors.New("boom")
+)
+
+type args struct {
+	client neptune.Client
+	cr     *v1alpha1.DBInstance
+}
+
+type instanceModifier func(*v1alpha1.DBInstance)
+
+func withConditions(c ...xpv1.Condition) instanceModifier {
+	return func(i *v1alpha1.DBInstance) { i.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p v1alpha1.DBInstanceParameters) instanceModifier {
+	return func(i *v1alpha1.DBInstance) { i.Spec.ForProvider = p }
+}
+
+func withObservation(o v1alpha1.DBInstanceObservation) instanceModifier {
+	return func(i *v1alpha1.DBInstance) { i.Status.AtProvider = o }
+}
+
+func instance(m ...instanceModifier) *v1alpha1.DBInstance {
+	cr := &v1alpha1.DBInstance{}
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describe(db *awsneptune.DBInstance) func(context.Context, *awsneptune.DescribeDBInstancesInput, []request.Option) (*awsneptune.DescribeDBInstancesOutput, error) {
+	return func(context.Context, *awsneptune.DescribeDBInstancesInput, []request.Option) (*awsneptune.DescribeDBInstancesOutput, error) {
+		return &awsneptune.DescribeDBInstancesOutput{DBInstances: []*awsneptune.DBInstance{db}}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *v1alpha1.DBInstance
+		result managed.ExternalObservation
+		err    error
+	}
+
+	full := v1alpha1.DBInstanceParameters{
+		DBInstanceClass:            class,
+		Engine:                     "neptune",
+		DBClusterIdentifier:        awsclient.String(clusterName),
+		AvailabilityZone:           awsclient.String("eu-central-1a"),
+		AutoMinorVersionUpgrade:    awsclient.Bool(true),
+		DBParameterGroupName:       awsclient.String("default.neptune1"),
+		PreferredMaintenanceWindow: awsclient.String("sun:05:00-sun:06:00"),
+		PromotionTier:              awsclient.Int64(1),
+	}
+	observed := func(status string) *awsneptune.DBInstance {
+		return &awsneptune.DBInstance{
+			DBInstanceStatus:           awsclient.String(status),
+			DBInstanceClass:            awsclient.String(class),
+			Engine:                     awsclient.String("neptune"),
+			DBClusterIdentifier:        awsclient.String(clusterName),
+			AvailabilityZone:           awsclient.String("eu-central-1a"),
+			AutoMinorVersionUpgrade:    awsclient.Bool(true),
+			DBParameterGroups:          []*awsneptune.DBParameterGroupStatus{{DBParameterGroupName: awsclient.String("default.neptune1")}},
+			PreferredMaintenanceWindow: awsclient.String("sun:05:00-sun:06:00"),
+			PromotionTier:              awsclient.Int64(1),
+			Endpoint:                   &awsneptune.Endpoint{Address: awsclient.String(address), Port: awsclient.Int64(8182)},
+		}
+	}
+	changed := full
+	changed.DBInstanceClass = "db.r5.xlarge"
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Available": {
+			args: args{
+				client: &fake.MockClient{MockDescribeDBInstances: describe(observed(v1alpha1.DBInstanceStateAvailable))},
+				cr:     instance(withSpec(full)),
+			},
+			want: want{
+				cr: instance(
+					withSpec(full),
+					withObservation(v1alpha1.DBInstanceObservation{
+						DBInstanceStatus: v1alpha1.DBInstanceStateAvailable,
+						Endpoint:         v1alpha1.DBInstanceEndpoint{Address: address, Port: 8182},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+					},
+				},
+			},
+		},
+		"LateInitializedAndChanged": {
+			args: args{
+				client: &fake.MockClient{MockDescribeDBInstances: describe(observed(v1alpha1.DBInstanceStateAvailable))},
+				cr:     instance(withSpec(v1alpha1.DBInstanceParameters{DBInstanceClass: "db.r5.xlarge"})),
+			},
+			want: want{
+				cr: instance(
+					withSpec(changed),
+					withObservation(v1alpha1.DBInstanceObservation{
+						DBInstanceStatus: v1alpha1.DBInstanceStateAvailable,
+						Endpoint:         v1alpha1.DBInstanceEndpoint{Address: address, Port: 8182},
+					}),
+					withConditions(xpv1.Available()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+					},
+				},
+			},
+		},
+		"ModifyingIsUpToDate": {
+			args: args{
+				client: &fake.MockClient{MockDescribeDBInstances: describe(observed("modifying"))},
+				cr:     instance(withSpec(changed)),
+			},
+			want: want{
+				cr: instance(
+					withSpec(changed),
+					withObservation(v1alpha1.DBInstanceObservation{
+						DBInstanceStatus: "modifying",
+						Endpoint:         v1alpha1.DBInstanceEndpoint{Address: address, Port: 8182},
+					}),
+					withConditions(xpv1.Unavailable()),
+				),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(address),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("8182"),
+					},
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeDBInstances: func(context.Context, *awsneptune.DescribeDBInstancesInput, []request.Option) (*awsneptune.DescribeDBInstancesOutput, error) {
+						return nil, awserr.New(awsneptune.ErrCodeDBInstanceNotFoundFault, "", nil)
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(),
+			},
+		},
+		"FailedDescribe": {
+			args: args{
+				client: &fake.MockClient{
+					MockDescribeDBInstances: func(context.Context, *awsneptune.DescribeDBInstancesInput, []request.Option) (*awsneptune.DescribeDBInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DBInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateDBInstance: func(_ context.Context, input *awsneptune.CreateDBInstanceInput, _ []request.Option) (*awsneptune.CreateDBInstanceOutput, error) {
+						if awsclient.StringValue(input.DBInstanceIdentifier) != instanceName || awsclient.StringValue(input.DBClusterIdentifier) != clusterName {
+							return nil, errBoom
+						}
+						return &awsneptune.CreateDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(v1alpha1.DBInstanceParameters{DBInstanceClass: class, DBClusterIdentifier: awsclient.String(clusterName)})),
+			},
+			want: want{
+				cr: instance(withSpec(v1alpha1.DBInstanceParameters{DBInstanceClass: class, DBClusterIdentifier: awsclient.String(clusterName)}), withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateDBInstance: func(context.Context, *awsneptune.CreateDBInstanceInput, []request.Option) (*awsneptune.CreateDBInstanceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockModifyDBInstance: func(_ context.Context, input *awsneptune.ModifyDBInstanceInput, _ []request.Option) (*awsneptune.ModifyDBInstanceOutput, error) {
+						if awsclient.StringValue(input.DBInstanceClass) != class || !awsclient.BoolValue(input.ApplyImmediately) {
+							return nil, errBoom
+						}
+						return &awsneptune.ModifyDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(v1alpha1.DBInstanceParameters{DBInstanceClass: class, ApplyImmediately: awsclient.Bool(true)})),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockModifyDBInstance: func(context.Context, *awsneptune.ModifyDBInstanceInput, []request.Option) (*awsneptune.ModifyDBInstanceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: awsclient.Wrap(errBoom, errModify),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *v1alpha1.DBInstance
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDBInstance: func(_ context.Context, input *awsneptune.DeleteDBInstanceInput, _ []request.Option) (*awsneptune.DeleteDBInstanceOutput, error) {
+						if !awsclient.BoolValue(input.SkipFinalSnapshot) {
+							return nil, errBoom
+						}
+						return &awsneptune.DeleteDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     instance(withObservation(v1alpha1.DBInstanceObservation{DBInstanceStatus: v1alpha1.DBInstanceStateDeleting})),
+			},
+			want: want{
+				cr: instance(withObservation(v1alpha1.DBInstanceObservation{DBInstanceStatus: v1alpha1.DBInstanceStateDeleting}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDBInstance: func(context.Context, *awsneptune.DeleteDBInstanceInput, []request.Option) (*awsneptune.DeleteDBInstanceOutput, error) {
+						return nil, awserr.New(awsneptune.ErrCodeDBInstanceNotFoundFault, "", nil)
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr: instance(withConditions(xpv1.Deleting())),
+			},
+		},
+		"FailedRequest": {
+			args: args{
+				client: &fake.MockClient{
+					MockDeleteDBInstance: func(context.Context, *awsneptune.DeleteDBInstanceInput, []request.Option) (*awsneptune.DeleteDBInstanceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(),
+			},
+			want: want{
+				cr:  instance(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
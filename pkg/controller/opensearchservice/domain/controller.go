@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsopensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/opensearchservice"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Domain resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe Domain"
+	errListTags         = "failed to list tags of Domain"
+	errGetPassword      = "failed to get master user password of Domain"
+	errCreate           = "failed to create Domain"
+	errUpdate           = "failed to update Domain"
+	errUpdateTags       = "failed to update tags of Domain"
+	errDelete           = "failed to delete Domain"
+
+	msgProcessing        = "A configuration change is being applied with a blue/green deployment"
+	msgUpgradeProcessing = "The engine version is being upgraded"
+)
+
+// SetupDomain adds a controller that reconciles Domains.
+func SetupDomain(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DomainGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Domain{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.DomainGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: opensearchservice.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) opensearchservice.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client opensearchservice.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	out, err := e.client.DescribeDomainWithContext(ctx, &awsopensearch.DescribeDomainInput{
+		DomainName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(opensearchservice.IsNotFound, err), errDescribe)
+	}
+	obs := out.DomainStatus
+	cr.Status.AtProvider = opensearchservice.GenerateObservation(obs)
+
+	// A deleted domain is still described until AWS removed it.
+	if awsclient.BoolValue(obs.Deleted) {
+		cr.SetConditions(xpv1.Deleting())
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	opensearchservice.LateInitialize(&cr.Spec.ForProvider, obs)
+
+	switch {
+	case !awsclient.BoolValue(obs.Created):
+		cr.SetConditions(xpv1.Creating())
+	case awsclient.BoolValue(obs.UpgradeProcessing):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgUpgradeProcessing))
+	case awsclient.BoolValue(obs.Processing):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(msgProcessing))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	pwd, pwdChanged, err := opensearchservice.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
+	tags, err := e.client.ListTagsWithContext(ctx, &awsopensearch.ListTagsInput{ARN: obs.ARN})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := opensearchservice.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		// AWS rejects changes while another one is being applied, so the
+		// domain is considered up to date until that finished.
+		ResourceUpToDate: awsclient.BoolValue(obs.Processing) || awsclient.BoolValue(obs.UpgradeProcessing) ||
+			opensearchservice.IsDomainUpToDate(cr.Spec.ForProvider, obs) && !pwdChanged && len(add) == 0 && len(remove) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails:       opensearchservice.GetConnectionDetails(cr, pwd),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	pwd, _, err := opensearchservice.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	_, err = e.client.CreateDomainWithContext(ctx, opensearchservice.GenerateCreateDomainInput(meta.GetExternalName(cr), cr.Spec.ForProvider, pwd))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{ConnectionDetails: opensearchservice.GetConnectionDetails(cr, pwd)}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Only the changed settings are sent, so we need the current ones.
+	out, err := e.client.DescribeDomainWithContext(ctx, &awsopensearch.DescribeDomainInput{
+		DomainName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	pwd, pwdChanged, err := opensearchservice.GetPassword(ctx, e.kube, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if !pwdChanged {
+		pwd = ""
+	}
+	if !opensearchservice.IsDomainUpToDate(cr.Spec.ForProvider, out.DomainStatus) || pwdChanged {
+		in := opensearchservice.GenerateUpdateDomainConfigInput(meta.GetExternalName(cr), cr.Spec.ForProvider, out.DomainStatus, pwd)
+		if _, err := e.client.UpdateDomainConfigWithContext(ctx, in); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+	if err := opensearchservice.UpdateTags(ctx, e.client, awsclient.StringValue(out.DomainStatus.ARN), cr.Spec.ForProvider.Tags); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateTags)
+	}
+	return managed.ExternalUpdate{ConnectionDetails: opensearchservice.GetConnectionDetails(cr, pwd)}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Domain)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteDomainWithContext(ctx, &awsopensearch.DeleteDomainInput{
+		DomainName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(opensearchservice.IsNotFound, err), errDelete)
+}
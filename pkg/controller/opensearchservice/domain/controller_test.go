@@ -0,0 +1,570 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsopensearch "github.com/aws/aws-sdk-go/service/opensearchservice"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/opensearchservice/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/opensearchservice/fake"
+)
+
+var (
+	domainName = "search"
+	domainARN  = "arn:aws:es:us-east-1:123456789012:domain/search"
+	endpoint   = "search.us-east-1.es.amazonaws.com"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	kube client.Client
+	os   *fake.MockClient
+	cr   resource.Managed
+}
+
+type domainModifier func(*v1alpha1.Domain)
+
+func withInstanceCount(n int64) domainModifier {
+	return func(d *v1alpha1.Domain) { d.Spec.ForProvider.ClusterConfig.InstanceCount = &n }
+}
+
+func withTags(tags ...v1alpha1.Tag) domainModifier {
+	return func(d *v1alpha1.Domain) { d.Spec.ForProvider.Tags = tags }
+}
+
+func withMasterUser(name string) domainModifier {
+	return func(d *v1alpha1.Domain) {
+		d.Spec.ForProvider.AdvancedSecurityOptions = &v1alpha1.AdvancedSecurityOptions{
+			Enabled:                     true,
+			InternalUserDatabaseEnabled: awsclient.Bool(true),
+			MasterUserOptions: &v1alpha1.MasterUserOptions{
+				MasterUserName:              &name,
+				MasterUserPasswordSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+			},
+		}
+		d.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "conn"}
+	}
+}
+
+func withObservation(o v1alpha1.DomainObservation) domainModifier {
+	return func(d *v1alpha1.Domain) { d.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) domainModifier {
+	return func(d *v1alpha1.Domain) { d.Status.ConditionedStatus.Conditions = c }
+}
+
+func domain(m ...domainModifier) *v1alpha1.Domain {
+	cr := &v1alpha1.Domain{
+		Spec: v1alpha1.DomainSpec{
+			ForProvider: v1alpha1.DomainParameters{
+				EngineVersion: awsclient.String("OpenSearch_1.0"),
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					InstanceType:  awsclient.String("r6g.large.search"),
+					InstanceCount: awsclient.Int64(3),
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, domainName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+type statusModifier func(*awsopensearch.DomainStatus)
+
+func status(m ...statusModifier) *awsopensearch.DomainStatus {
+	s := &awsopensearch.DomainStatus{
+		ARN:           &domainARN,
+		DomainName:    &domainName,
+		Created:       awsclient.Bool(true),
+		Endpoint:      &endpoint,
+		EngineVersion: awsclient.String("OpenSearch_1.0"),
+		ClusterConfig: &awsopensearch.ClusterConfig{
+			InstanceType:  awsclient.String("r6g.large.search"),
+			InstanceCount: awsclient.Int64(3),
+		},
+	}
+	for _, f := range m {
+		f(s)
+	}
+	return s
+}
+
+func describe(s *awsopensearch.DomainStatus) func(context.Context, *awsopensearch.DescribeDomainInput, []request.Option) (*awsopensearch.DescribeDomainOutput, error) {
+	return func(context.Context, *awsopensearch.DescribeDomainInput, []request.Option) (*awsopensearch.DescribeDomainOutput, error) {
+		return &awsopensearch.DescribeDomainOutput{DomainStatus: s}, nil
+	}
+}
+
+func listTags(tags ...*awsopensearch.Tag) func(context.Context, *awsopensearch.ListTagsInput, []request.Option) (*awsopensearch.ListTagsOutput, error) {
+	return func(context.Context, *awsopensearch.ListTagsInput, []request.Option) (*awsopensearch.ListTagsOutput, error) {
+		return &awsopensearch.ListTagsOutput{TagList: tags}, nil
+	}
+}
+
+// secrets returns a kube client whose password secret contains the given
+// password and whose connection secret contains the published one.
+func secrets(password, published string) client.Client {
+	return &test.MockClient{
+		MockGet: func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+			s := obj.(*corev1.Secret)
+			if key.Name == "conn" {
+				s.Data = map[string][]byte{xpv1.ResourceCredentialsSecretPasswordKey: []byte(published)}
+				return nil
+			}
+			s.Data = map[string][]byte{"password": []byte(password)}
+			return nil
+		},
+	}
+}
+
+var observation = v1alpha1.DomainObservation{
+	ARN:      domainARN,
+	Endpoint: endpoint,
+}
+
+var connectionDetails = managed.ConnectionDetails{
+	xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+	xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: func(context.Context, *awsopensearch.DescribeDomainInput, []request.Option) (*awsopensearch.DescribeDomainOutput, error) {
+						return nil, awserr.New(awsopensearch.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: func(context.Context, *awsopensearch.DescribeDomainInput, []request.Option) (*awsopensearch.DescribeDomainOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"Creating": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status(func(s *awsopensearch.DomainStatus) {
+						s.Created = awsclient.Bool(false)
+						s.Endpoint = nil
+					})),
+					MockListTags: listTags(),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withObservation(v1alpha1.DomainObservation{ARN: domainARN}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"Available": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockListTags:       listTags(),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"BlueGreenDeployment": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status(func(s *awsopensearch.DomainStatus) {
+						s.Processing = awsclient.Bool(true)
+					})),
+					MockListTags: listTags(),
+				},
+				cr: domain(withInstanceCount(6)),
+			},
+			want: want{
+				cr: domain(
+					withInstanceCount(6),
+					withObservation(v1alpha1.DomainObservation{ARN: domainARN, Endpoint: endpoint, Processing: true}),
+					withConditions(xpv1.Unavailable().WithMessage(msgProcessing))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"Deleted": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status(func(s *awsopensearch.DomainStatus) {
+						s.Deleted = awsclient.Bool(true)
+					})),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(
+					withObservation(observation),
+					withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ClusterConfigChanged": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockListTags:       listTags(),
+				},
+				cr: domain(withInstanceCount(6)),
+			},
+			want: want{
+				cr: domain(
+					withInstanceCount(6),
+					withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockListTags:       listTags(&awsopensearch.Tag{Key: awsclient.String("k"), Value: awsclient.String("old")}),
+				},
+				cr: domain(withTags(v1alpha1.Tag{Key: "k", Value: "new"})),
+			},
+			want: want{
+				cr: domain(
+					withTags(v1alpha1.Tag{Key: "k", Value: "new"}),
+					withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: connectionDetails,
+				},
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				kube: secrets("new", "old"),
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status(func(s *awsopensearch.DomainStatus) {
+						s.AdvancedSecurityOptions = &awsopensearch.AdvancedSecurityOptions{
+							Enabled:                     awsclient.Bool(true),
+							InternalUserDatabaseEnabled: awsclient.Bool(true),
+						}
+					})),
+					MockListTags: listTags(),
+				},
+				cr: domain(withMasterUser("admin")),
+			},
+			want: want{
+				cr: domain(
+					withMasterUser("admin"),
+					withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
+						xpv1.ResourceCredentialsSecretPortKey:     []byte("443"),
+						xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.os}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: secrets("secret", ""),
+				os: &fake.MockClient{
+					MockCreateDomain: func(_ context.Context, in *awsopensearch.CreateDomainInput, _ []request.Option) (*awsopensearch.CreateDomainOutput, error) {
+						if awsclient.StringValue(in.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword) != "secret" {
+							return nil, errors.New("password not set")
+						}
+						return &awsopensearch.CreateDomainOutput{}, nil
+					},
+				},
+				cr: domain(withMasterUser("admin")),
+			},
+			want: want{
+				cr: domain(withMasterUser("admin"), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("secret"),
+				}},
+			},
+		},
+		"CreateError": {
+			args: args{
+				os: &fake.MockClient{
+					MockCreateDomain: func(context.Context, *awsopensearch.CreateDomainInput, []request.Option) (*awsopensearch.CreateDomainOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.os}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ClusterConfigChanged": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockUpdateDomainConfig: func(_ context.Context, in *awsopensearch.UpdateDomainConfigInput, _ []request.Option) (*awsopensearch.UpdateDomainConfigOutput, error) {
+						if awsclient.Int64Value(in.ClusterConfig.InstanceCount) != 6 {
+							return nil, errors.New("instance count not updated")
+						}
+						return &awsopensearch.UpdateDomainConfigOutput{}, nil
+					},
+					MockListTags: listTags(),
+				},
+				cr: domain(withInstanceCount(6)),
+			},
+		},
+		"OnlyTagsChanged": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockListTags:       listTags(&awsopensearch.Tag{Key: awsclient.String("k"), Value: awsclient.String("v")}),
+					MockRemoveTags: func(_ context.Context, in *awsopensearch.RemoveTagsInput, _ []request.Option) (*awsopensearch.RemoveTagsOutput, error) {
+						return &awsopensearch.RemoveTagsOutput{}, nil
+					},
+				},
+				cr: domain(),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				os: &fake.MockClient{
+					MockDescribeDomain: describe(status()),
+					MockUpdateDomainConfig: func(context.Context, *awsopensearch.UpdateDomainConfigInput, []request.Option) (*awsopensearch.UpdateDomainConfigOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(withInstanceCount(6)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.os}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				os: &fake.MockClient{
+					MockDeleteDomain: func(context.Context, *awsopensearch.DeleteDomainInput, []request.Option) (*awsopensearch.DeleteDomainOutput, error) {
+						return &awsopensearch.DeleteDomainOutput{}, nil
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				os: &fake.MockClient{
+					MockDeleteDomain: func(context.Context, *awsopensearch.DeleteDomainInput, []request.Option) (*awsopensearch.DeleteDomainOutput, error) {
+						return nil, awserr.New(awsopensearch.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				os: &fake.MockClient{
+					MockDeleteDomain: func(context.Context, *awsopensearch.DeleteDomainInput, []request.Option) (*awsopensearch.DeleteDomainOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{kube: tc.kube, client: tc.os}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an Account resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribeStatus   = "failed to describe creation status of Account"
+	errCreateFailed     = "failed to create Account"
+	errDescribe         = "failed to describe Account"
+	errCreate           = "failed to create Account"
+	errMove             = "failed to move Account"
+	errRemove           = "failed to remove Account from organization"
+)
+
+// SetupAccount adds a controller that reconciles Accounts.
+func SetupAccount(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.AccountGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Account{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.AccountGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: organizations.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) organizations.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.Account); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client organizations.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	// Accounts are created asynchronously. Until the creation has finished
+	// the external name is the ID of the creation request, which is then
+	// replaced by the ID of the account.
+	lateInitialized := false
+	if organizations.IsCreateAccountRequestID(meta.GetExternalName(cr)) {
+		resp, err := e.client.DescribeCreateAccountStatusWithContext(ctx, &awsorganizations.DescribeCreateAccountStatusInput{
+			CreateAccountRequestId: awsclient.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeStatus)
+		}
+		switch awsclient.StringValue(resp.CreateAccountStatus.State) {
+		case awsorganizations.CreateAccountStateInProgress:
+			cr.SetConditions(xpv1.Creating())
+			return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+		case awsorganizations.CreateAccountStateFailed:
+			cr.SetConditions(xpv1.Unavailable())
+			return managed.ExternalObservation{}, errors.Errorf("%s: %s", errCreateFailed, awsclient.StringValue(resp.CreateAccountStatus.FailureReason))
+		}
+		meta.SetExternalName(cr, awsclient.StringValue(resp.CreateAccountStatus.AccountId))
+		lateInitialized = true
+	}
+
+	resp, err := e.client.DescribeAccountWithContext(ctx, &awsorganizations.DescribeAccountInput{
+		AccountId: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(organizations.IsAccountNotFound, err), errDescribe)
+	}
+	parentID, err := organizations.GetParentID(ctx, e.client, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider = v1alpha1.AccountObservation{
+		AccountID: awsclient.StringValue(resp.Account.Id),
+		ARN:       awsclient.StringValue(resp.Account.Arn),
+		Status:    awsclient.StringValue(resp.Account.Status),
+		ParentID:  parentID,
+	}
+	if cr.Status.AtProvider.Status == awsorganizations.AccountStatusActive {
+		cr.SetConditions(xpv1.Available())
+	} else {
+		cr.SetConditions(xpv1.Unavailable())
+	}
+	tagsUpToDate, err := organizations.AreTagsUpToDate(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        organizations.IsAccountUpToDate(cr.Spec.ForProvider, parentID) && tagsUpToDate,
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateAccountWithContext(ctx, organizations.GenerateCreateAccountInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.CreateAccountStatus.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Nothing can be changed while the account is still being created.
+	if organizations.IsCreateAccountRequestID(meta.GetExternalName(cr)) {
+		return managed.ExternalUpdate{}, nil
+	}
+	if !organizations.IsAccountUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider.ParentID) {
+		if _, err := e.client.MoveAccountWithContext(ctx, &awsorganizations.MoveAccountInput{
+			AccountId:           awsclient.String(meta.GetExternalName(cr)),
+			SourceParentId:      awsclient.String(cr.Status.AtProvider.ParentID),
+			DestinationParentId: cr.Spec.ForProvider.ParentID,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errMove)
+		}
+	}
+	return managed.ExternalUpdate{}, organizations.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Account)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// The account can only be removed once it has been created.
+	if organizations.IsCreateAccountRequestID(meta.GetExternalName(cr)) {
+		return nil
+	}
+	_, err := e.client.RemoveAccountFromOrganizationWithContext(ctx, &awsorganizations.RemoveAccountFromOrganizationInput{
+		AccountId: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(organizations.IsAccountNotFound, err), errRemove)
+}
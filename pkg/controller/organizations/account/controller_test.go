@@ -0,0 +1,417 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	accountID  = "111111111111"
+	accountARN = "arn:aws:organizations::123456789012:account/o-abc/111111111111"
+	requestID  = "car-0123456789abcdef"
+	rootID     = "r-abcd"
+	ouID       = "ou-abcd-12345678"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	org *fake.MockClient
+	cr  resource.Managed
+}
+
+type accountModifier func(*v1alpha1.Account)
+
+func withExternalName(n string) accountModifier {
+	return func(r *v1alpha1.Account) { meta.SetExternalName(r, n) }
+}
+
+func withParentID(id string) accountModifier {
+	return func(r *v1alpha1.Account) { r.Spec.ForProvider.ParentID = &id }
+}
+
+func withObservation(parentID string) accountModifier {
+	return func(r *v1alpha1.Account) {
+		r.Status.AtProvider = v1alpha1.AccountObservation{
+			AccountID: accountID,
+			ARN:       accountARN,
+			Status:    awsorganizations.AccountStatusActive,
+			ParentID:  parentID,
+		}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) accountModifier {
+	return func(r *v1alpha1.Account) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func account(m ...accountModifier) *v1alpha1.Account {
+	cr := &v1alpha1.Account{
+		Spec: v1alpha1.AccountSpec{
+			ForProvider: v1alpha1.AccountParameters{
+				Name:  "workload",
+				Email: "aws-workload@example.com",
+			},
+		},
+	}
+	meta.SetExternalName(cr, accountID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func createAccountStatus(state string) func(context.Context, *awsorganizations.DescribeCreateAccountStatusInput, []request.Option) (*awsorganizations.DescribeCreateAccountStatusOutput, error) {
+	return func(context.Context, *awsorganizations.DescribeCreateAccountStatusInput, []request.Option) (*awsorganizations.DescribeCreateAccountStatusOutput, error) {
+		s := &awsorganizations.CreateAccountStatus{Id: &requestID, State: aws.String(state)}
+		switch state {
+		case awsorganizations.CreateAccountStateSucceeded:
+			s.AccountId = &accountID
+		case awsorganizations.CreateAccountStateFailed:
+			s.FailureReason = aws.String(awsorganizations.CreateAccountFailureReasonEmailAlreadyExists)
+		}
+		return &awsorganizations.DescribeCreateAccountStatusOutput{CreateAccountStatus: s}, nil
+	}
+}
+
+func describeAccount(context.Context, *awsorganizations.DescribeAccountInput, []request.Option) (*awsorganizations.DescribeAccountOutput, error) {
+	return &awsorganizations.DescribeAccountOutput{Account: &awsorganizations.Account{
+		Id:     &accountID,
+		Arn:    &accountARN,
+		Status: aws.String(awsorganizations.AccountStatusActive),
+	}}, nil
+}
+
+func listParents(id string) func(context.Context, *awsorganizations.ListParentsInput, []request.Option) (*awsorganizations.ListParentsOutput, error) {
+	return func(context.Context, *awsorganizations.ListParentsInput, []request.Option) (*awsorganizations.ListParentsOutput, error) {
+		return &awsorganizations.ListParentsOutput{Parents: []*awsorganizations.Parent{{Id: &id}}}, nil
+	}
+}
+
+func listTags(context.Context, *awsorganizations.ListTagsForResourceInput, []request.Option) (*awsorganizations.ListTagsForResourceOutput, error) {
+	return &awsorganizations.ListTagsForResourceOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				org: &fake.MockClient{},
+				cr:  account(withExternalName("")),
+			},
+			want: want{
+				cr: account(withExternalName("")),
+			},
+		},
+		"CreationInProgress": {
+			args: args{
+				org: &fake.MockClient{MockDescribeCreateAccountStatus: createAccountStatus(awsorganizations.CreateAccountStateInProgress)},
+				cr:  account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withExternalName(requestID), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreationFailed": {
+			args: args{
+				org: &fake.MockClient{MockDescribeCreateAccountStatus: createAccountStatus(awsorganizations.CreateAccountStateFailed)},
+				cr:  account(withExternalName(requestID)),
+			},
+			want: want{
+				cr:  account(withExternalName(requestID), withConditions(xpv1.Unavailable())),
+				err: errors.Errorf("%s: %s", errCreateFailed, awsorganizations.CreateAccountFailureReasonEmailAlreadyExists),
+			},
+		},
+		"CreationSucceeded": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeCreateAccountStatus: createAccountStatus(awsorganizations.CreateAccountStateSucceeded),
+					MockDescribeAccount:             describeAccount,
+					MockListParents:                 listParents(rootID),
+					MockListTagsForResource:         listTags,
+				},
+				cr: account(withExternalName(requestID)),
+			},
+			want: want{
+				cr: account(withObservation(rootID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotFound": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeAccount: func(context.Context, *awsorganizations.DescribeAccountInput, []request.Option) (*awsorganizations.DescribeAccountOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodeAccountNotFoundException, "", nil)
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr: account(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeAccount: func(context.Context, *awsorganizations.DescribeAccountInput, []request.Option) (*awsorganizations.DescribeAccountOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				cr:  account(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ParentChanged": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeAccount:     describeAccount,
+					MockListParents:         listParents(rootID),
+					MockListTagsForResource: listTags,
+				},
+				cr: account(withParentID(ouID)),
+			},
+			want: want{
+				cr: account(withParentID(ouID), withObservation(rootID), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreateAccount: func(_ context.Context, input *awsorganizations.CreateAccountInput, _ []request.Option) (*awsorganizations.CreateAccountOutput, error) {
+						if aws.StringValue(input.AccountName) != "workload" {
+							return nil, errBoom
+						}
+						return &awsorganizations.CreateAccountOutput{CreateAccountStatus: &awsorganizations.CreateAccountStatus{Id: &requestID}}, nil
+					},
+				},
+				cr: account(withExternalName("")),
+			},
+			want: want{
+				cr:     account(withExternalName(requestID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreateAccount: func(context.Context, *awsorganizations.CreateAccountInput, []request.Option) (*awsorganizations.CreateAccountOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: account(withExternalName("")),
+			},
+			want: want{
+				cr:  account(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreationInProgress": {
+			args: args{
+				org: &fake.MockClient{},
+				cr:  account(withExternalName(requestID)),
+			},
+		},
+		"Moved": {
+			args: args{
+				org: &fake.MockClient{
+					MockMoveAccount: func(_ context.Context, input *awsorganizations.MoveAccountInput, _ []request.Option) (*awsorganizations.MoveAccountOutput, error) {
+						if aws.StringValue(input.SourceParentId) != rootID || aws.StringValue(input.DestinationParentId) != ouID {
+							return nil, errBoom
+						}
+						return &awsorganizations.MoveAccountOutput{}, nil
+					},
+					MockListTagsForResource: listTags,
+				},
+				cr: account(withParentID(ouID), withObservation(rootID)),
+			},
+		},
+		"MoveError": {
+			args: args{
+				org: &fake.MockClient{
+					MockMoveAccount: func(context.Context, *awsorganizations.MoveAccountInput, []request.Option) (*awsorganizations.MoveAccountOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: account(withParentID(ouID), withObservation(rootID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errMove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreationInProgress": {
+			args: args{
+				org: &fake.MockClient{},
+				cr:  account(withExternalName(requestID)),
+			},
+		},
+		"AlreadyRemoved": {
+			args: args{
+				org: &fake.MockClient{
+					MockRemoveAccountFromOrganization: func(context.Context, *awsorganizations.RemoveAccountFromOrganizationInput, []request.Option) (*awsorganizations.RemoveAccountFromOrganizationOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodeAccountNotFoundException, "", nil)
+					},
+				},
+				cr: account(),
+			},
+		},
+		"RemoveError": {
+			args: args{
+				org: &fake.MockClient{
+					MockRemoveAccountFromOrganization: func(context.Context, *awsorganizations.RemoveAccountFromOrganizationInput, []request.Option) (*awsorganizations.RemoveAccountFromOrganizationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: account(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errRemove),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
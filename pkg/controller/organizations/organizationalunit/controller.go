@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationalunit
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an OrganizationalUnit resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe OrganizationalUnit"
+	errCreate           = "failed to create OrganizationalUnit"
+	errUpdate           = "failed to update OrganizationalUnit"
+	errDelete           = "failed to delete OrganizationalUnit"
+)
+
+// SetupOrganizationalUnit adds a controller that reconciles OrganizationalUnits.
+func SetupOrganizationalUnit(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationalUnitGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.OrganizationalUnit{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.OrganizationalUnitGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: organizations.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) organizations.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.OrganizationalUnit); !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client organizations.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The ID of the organizational unit is assigned by AWS, so there is
+	// nothing to observe until it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.client.DescribeOrganizationalUnitWithContext(ctx, &awsorganizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(organizations.IsOrganizationalUnitNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = v1alpha1.OrganizationalUnitObservation{
+		ARN: awsclient.StringValue(resp.OrganizationalUnit.Arn),
+	}
+	cr.SetConditions(xpv1.Available())
+	tagsUpToDate, err := organizations.AreTagsUpToDate(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cr.Spec.ForProvider.Name == awsclient.StringValue(resp.OrganizationalUnit.Name) && tagsUpToDate,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateOrganizationalUnitWithContext(ctx, &awsorganizations.CreateOrganizationalUnitInput{
+		Name:     awsclient.String(cr.Spec.ForProvider.Name),
+		ParentId: cr.Spec.ForProvider.ParentID,
+		Tags:     organizations.GenerateTags(cr.Spec.ForProvider.Tags),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.OrganizationalUnit.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if _, err := e.client.UpdateOrganizationalUnitWithContext(ctx, &awsorganizations.UpdateOrganizationalUnitInput{
+		OrganizationalUnitId: awsclient.String(meta.GetExternalName(cr)),
+		Name:                 awsclient.String(cr.Spec.ForProvider.Name),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, organizations.UpdateTags(ctx, e.client, meta.GetExternalName(cr), cr.Spec.ForProvider.Tags)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationalUnit)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteOrganizationalUnitWithContext(ctx, &awsorganizations.DeleteOrganizationalUnitInput{
+		OrganizationalUnitId: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(organizations.IsOrganizationalUnitNotFound, err), errDelete)
+}
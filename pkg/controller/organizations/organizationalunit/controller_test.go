@@ -0,0 +1,350 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationalunit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	ouID    = "ou-abcd-12345678"
+	ouARN   = "arn:aws:organizations::123456789012:ou/o-abc/ou-abcd-12345678"
+	rootID  = "r-abcd"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	org *fake.MockClient
+	cr  resource.Managed
+}
+
+type ouModifier func(*v1alpha1.OrganizationalUnit)
+
+func withName(n string) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Spec.ForProvider.Name = n }
+}
+
+func withExternalName(n string) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Status.AtProvider.ARN = ouARN }
+}
+
+func withConditions(c ...xpv1.Condition) ouModifier {
+	return func(r *v1alpha1.OrganizationalUnit) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func ou(m ...ouModifier) *v1alpha1.OrganizationalUnit {
+	cr := &v1alpha1.OrganizationalUnit{
+		Spec: v1alpha1.OrganizationalUnitSpec{
+			ForProvider: v1alpha1.OrganizationalUnitParameters{
+				Name:     "workloads",
+				ParentID: &rootID,
+			},
+		},
+	}
+	meta.SetExternalName(cr, ouID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeOU(context.Context, *awsorganizations.DescribeOrganizationalUnitInput, []request.Option) (*awsorganizations.DescribeOrganizationalUnitOutput, error) {
+	return &awsorganizations.DescribeOrganizationalUnitOutput{OrganizationalUnit: &awsorganizations.OrganizationalUnit{
+		Id:   &ouID,
+		Arn:  &ouARN,
+		Name: aws.String("workloads"),
+	}}, nil
+}
+
+func listTags(context.Context, *awsorganizations.ListTagsForResourceInput, []request.Option) (*awsorganizations.ListTagsForResourceOutput, error) {
+	return &awsorganizations.ListTagsForResourceOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				org: &fake.MockClient{},
+				cr:  ou(withExternalName("")),
+			},
+			want: want{
+				cr: ou(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeOrganizationalUnit: func(context.Context, *awsorganizations.DescribeOrganizationalUnitInput, []request.Option) (*awsorganizations.DescribeOrganizationalUnitOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodeOrganizationalUnitNotFoundException, "", nil)
+					},
+				},
+				cr: ou(),
+			},
+			want: want{
+				cr: ou(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeOrganizationalUnit: func(context.Context, *awsorganizations.DescribeOrganizationalUnitInput, []request.Option) (*awsorganizations.DescribeOrganizationalUnitOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ou(),
+			},
+			want: want{
+				cr:  ou(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeOrganizationalUnit: describeOU,
+					MockListTagsForResource:        listTags,
+				},
+				cr: ou(),
+			},
+			want: want{
+				cr: ou(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NameChanged": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribeOrganizationalUnit: describeOU,
+					MockListTagsForResource:        listTags,
+				},
+				cr: ou(withName("production")),
+			},
+			want: want{
+				cr: ou(withName("production"), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreateOrganizationalUnit: func(_ context.Context, input *awsorganizations.CreateOrganizationalUnitInput, _ []request.Option) (*awsorganizations.CreateOrganizationalUnitOutput, error) {
+						if aws.StringValue(input.ParentId) != rootID {
+							return nil, errBoom
+						}
+						return &awsorganizations.CreateOrganizationalUnitOutput{OrganizationalUnit: &awsorganizations.OrganizationalUnit{Id: &ouID}}, nil
+					},
+				},
+				cr: ou(withExternalName("")),
+			},
+			want: want{
+				cr:     ou(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreateOrganizationalUnit: func(context.Context, *awsorganizations.CreateOrganizationalUnitInput, []request.Option) (*awsorganizations.CreateOrganizationalUnitOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ou(withExternalName("")),
+			},
+			want: want{
+				cr:  ou(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockUpdateOrganizationalUnit: func(_ context.Context, input *awsorganizations.UpdateOrganizationalUnitInput, _ []request.Option) (*awsorganizations.UpdateOrganizationalUnitOutput, error) {
+						if aws.StringValue(input.Name) != "production" {
+							return nil, errBoom
+						}
+						return &awsorganizations.UpdateOrganizationalUnitOutput{}, nil
+					},
+					MockListTagsForResource: listTags,
+				},
+				cr: ou(withName("production")),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				org: &fake.MockClient{
+					MockUpdateOrganizationalUnit: func(context.Context, *awsorganizations.UpdateOrganizationalUnitInput, []request.Option) (*awsorganizations.UpdateOrganizationalUnitOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ou(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				org: &fake.MockClient{
+					MockDeleteOrganizationalUnit: func(context.Context, *awsorganizations.DeleteOrganizationalUnitInput, []request.Option) (*awsorganizations.DeleteOrganizationalUnitOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodeOrganizationalUnitNotFoundException, "", nil)
+					},
+				},
+				cr: ou(),
+			},
+		},
+		"NotEmpty": {
+			args: args{
+				org: &fake.MockClient{
+					MockDeleteOrganizationalUnit: func(context.Context, *awsorganizations.DeleteOrganizationalUnitInput, []request.Option) (*awsorganizations.DeleteOrganizationalUnitOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ou(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
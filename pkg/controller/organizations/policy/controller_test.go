@@ -0,0 +1,342 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	policyID  = "p-12345678"
+	policyARN = "arn:aws:organizations::123456789012:policy/o-abc/service_control_policy/p-12345678"
+	content   = `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"}]}`
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	org *fake.MockClient
+	cr  resource.Managed
+}
+
+type policyModifier func(*v1alpha1.Policy)
+
+func withContent(c string) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Spec.ForProvider.Content = c }
+}
+
+func withExternalName(n string) policyModifier {
+	return func(r *v1alpha1.Policy) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.AtProvider.ARN = policyARN }
+}
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha1.Policy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func policy(m ...policyModifier) *v1alpha1.Policy {
+	cr := &v1alpha1.Policy{
+		Spec: v1alpha1.PolicySpec{
+			ForProvider: v1alpha1.PolicyParameters{
+				Name:    "deny-leave",
+				Content: content,
+			},
+		},
+	}
+	meta.SetExternalName(cr, policyID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describePolicy(context.Context, *awsorganizations.DescribePolicyInput, []request.Option) (*awsorganizations.DescribePolicyOutput, error) {
+	return &awsorganizations.DescribePolicyOutput{Policy: &awsorganizations.Policy{
+		Content: &content,
+		PolicySummary: &awsorganizations.PolicySummary{
+			Id:          &policyID,
+			Arn:         &policyARN,
+			Name:        aws.String("deny-leave"),
+			Description: aws.String(""),
+		},
+	}}, nil
+}
+
+func listTags(context.Context, *awsorganizations.ListTagsForResourceInput, []request.Option) (*awsorganizations.ListTagsForResourceOutput, error) {
+	return &awsorganizations.ListTagsForResourceOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				org: &fake.MockClient{},
+				cr:  policy(withExternalName("")),
+			},
+			want: want{
+				cr: policy(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribePolicy: func(context.Context, *awsorganizations.DescribePolicyInput, []request.Option) (*awsorganizations.DescribePolicyOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodePolicyNotFoundException, "", nil)
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribePolicy:      describePolicy,
+					MockListTagsForResource: listTags,
+				},
+				cr: policy(),
+			},
+			want: want{
+				cr: policy(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ContentChanged": {
+			args: args{
+				org: &fake.MockClient{
+					MockDescribePolicy:      describePolicy,
+					MockListTagsForResource: listTags,
+				},
+				cr: policy(withContent(`{"Version":"2012-10-17","Statement":[]}`)),
+			},
+			want: want{
+				cr: policy(withContent(`{"Version":"2012-10-17","Statement":[]}`), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreatePolicy: func(_ context.Context, input *awsorganizations.CreatePolicyInput, _ []request.Option) (*awsorganizations.CreatePolicyOutput, error) {
+						if aws.StringValue(input.Type) != awsorganizations.PolicyTypeServiceControlPolicy {
+							return nil, errBoom
+						}
+						return &awsorganizations.CreatePolicyOutput{Policy: &awsorganizations.Policy{
+							PolicySummary: &awsorganizations.PolicySummary{Id: &policyID},
+						}}, nil
+					},
+				},
+				cr: policy(withExternalName("")),
+			},
+			want: want{
+				cr:     policy(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				org: &fake.MockClient{
+					MockCreatePolicy: func(context.Context, *awsorganizations.CreatePolicyInput, []request.Option) (*awsorganizations.CreatePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName("")),
+			},
+			want: want{
+				cr:  policy(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockUpdatePolicy: func(_ context.Context, input *awsorganizations.UpdatePolicyInput, _ []request.Option) (*awsorganizations.UpdatePolicyOutput, error) {
+						if aws.StringValue(input.PolicyId) != policyID {
+							return nil, errBoom
+						}
+						return &awsorganizations.UpdatePolicyOutput{}, nil
+					},
+					MockListTagsForResource: listTags,
+				},
+				cr: policy(),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				org: &fake.MockClient{
+					MockUpdatePolicy: func(context.Context, *awsorganizations.UpdatePolicyInput, []request.Option) (*awsorganizations.UpdatePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				org: &fake.MockClient{
+					MockDeletePolicy: func(context.Context, *awsorganizations.DeletePolicyInput, []request.Option) (*awsorganizations.DeletePolicyOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodePolicyNotFoundException, "", nil)
+					},
+				},
+				cr: policy(),
+			},
+		},
+		"StillAttached": {
+			args: args{
+				org: &fake.MockClient{
+					MockDeletePolicy: func(context.Context, *awsorganizations.DeletePolicyInput, []request.Option) (*awsorganizations.DeletePolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policyattachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsorganizations "github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/organizations/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/organizations/fake"
+)
+
+var (
+	policyID = "p-12345678"
+	ouID     = "ou-abcd-12345678"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	org *fake.MockClient
+	cr  resource.Managed
+}
+
+type attachmentModifier func(*v1alpha1.PolicyAttachment)
+
+func withConditions(c ...xpv1.Condition) attachmentModifier {
+	return func(r *v1alpha1.PolicyAttachment) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func attachment(m ...attachmentModifier) *v1alpha1.PolicyAttachment {
+	cr := &v1alpha1.PolicyAttachment{
+		Spec: v1alpha1.PolicyAttachmentSpec{
+			ForProvider: v1alpha1.PolicyAttachmentParameters{
+				PolicyID: &policyID,
+				TargetID: &ouID,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func listTargets(ids ...string) func(context.Context, *awsorganizations.ListTargetsForPolicyInput, []request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error) {
+	return func(context.Context, *awsorganizations.ListTargetsForPolicyInput, []request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error) {
+		out := &awsorganizations.ListTargetsForPolicyOutput{}
+		for i := range ids {
+			out.Targets = append(out.Targets, &awsorganizations.PolicyTargetSummary{TargetId: &ids[i]})
+		}
+		return out, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotAttached": {
+			args: args{
+				org: &fake.MockClient{MockListTargetsForPolicy: listTargets("ou-other")},
+				cr:  attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"PolicyNotFound": {
+			args: args{
+				org: &fake.MockClient{
+					MockListTargetsForPolicy: func(context.Context, *awsorganizations.ListTargetsForPolicyInput, []request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodePolicyNotFoundException, "", nil)
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(),
+			},
+		},
+		"ListError": {
+			args: args{
+				org: &fake.MockClient{
+					MockListTargetsForPolicy: func(context.Context, *awsorganizations.ListTargetsForPolicyInput, []request.Option) (*awsorganizations.ListTargetsForPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(),
+				err: awsclient.Wrap(errBoom, errListTargets),
+			},
+		},
+		"Attached": {
+			args: args{
+				org: &fake.MockClient{MockListTargetsForPolicy: listTargets("ou-other", ouID)},
+				cr:  attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				org: &fake.MockClient{
+					MockAttachPolicy: func(context.Context, *awsorganizations.AttachPolicyInput, []request.Option) (*awsorganizations.AttachPolicyOutput, error) {
+						return &awsorganizations.AttachPolicyOutput{}, nil
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr: attachment(withConditions(xpv1.Creating())),
+			},
+		},
+		"AttachError": {
+			args: args{
+				org: &fake.MockClient{
+					MockAttachPolicy: func(context.Context, *awsorganizations.AttachPolicyInput, []request.Option) (*awsorganizations.AttachPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				cr:  attachment(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAttach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDetached": {
+			args: args{
+				org: &fake.MockClient{
+					MockDetachPolicy: func(context.Context, *awsorganizations.DetachPolicyInput, []request.Option) (*awsorganizations.DetachPolicyOutput, error) {
+						return nil, awserr.New(awsorganizations.ErrCodePolicyNotAttachedException, "", nil)
+					},
+				},
+				cr: attachment(),
+			},
+		},
+		"DetachError": {
+			args: args{
+				org: &fake.MockClient{
+					MockDetachPolicy: func(context.Context, *awsorganizations.DetachPolicyInput, []request.Option) (*awsorganizations.DetachPolicyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: attachment(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDetach),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.org}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -16,13 +16,13 @@ package resourceshare
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/ram"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -30,19 +30,40 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/clients/ram"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errListAssociations       = "cannot list resource share associations"
+	errListPermissions        = "cannot list resource share permissions"
+	errAssociate              = "cannot associate resources or principals with resource share"
+	errDisassociate           = "cannot disassociate resources or principals from resource share"
+	errAssociatePermission    = "cannot associate permission with resource share"
+	errDisassociatePermission = "cannot disassociate permission from resource share"
 )
 
 // SetupResourceShare adds a controller that reconciles ResourceShare.
-func SetupResourceShare(mgr ctrl.Manager, o controller.Options) error {
+func SetupResourceShare(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.ResourceShareGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
+			c := &custom{client: e.client}
 			e.preObserve = preObserve
-			e.postObserve = postObserve
+			e.postObserve = c.postObserve
+			e.filterList = filterList
+			e.isUpToDate = isUpToDate
 			e.preDelete = preDelete
 			e.postCreate = postCreate
 			e.preCreate = preCreate
+			e.preUpdate = preUpdate
+			e.postUpdate = c.postUpdate
 		},
 	}
 
@@ -53,15 +74,11 @@ func SetupResourceShare(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.ResourceShare{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.ResourceShareGroupVersionKind),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.ResourceShareGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.ARN("<resource-share-arn>", "ram"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -77,10 +94,38 @@ func preDelete(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.Del
 func preObserve(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.GetResourceSharesInput) error {
 	obj.MaxResults = awsclients.Int64(100)
 	obj.ResourceOwner = awsclients.String(svcsdk.ResourceOwnerSelf)
+	obj.ResourceShareArns = []*string{awsclients.String(meta.GetExternalName(cr))}
 	return nil
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.GetResourceSharesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func filterList(cr *svcapitypes.ResourceShare, obj *svcsdk.GetResourceSharesOutput) *svcsdk.GetResourceSharesOutput {
+	resp := &svcsdk.GetResourceSharesOutput{}
+	for _, resourceShare := range obj.ResourceShares {
+		if awsclients.StringValue(resourceShare.ResourceShareArn) == meta.GetExternalName(cr) {
+			resp.ResourceShares = append(resp.ResourceShares, resourceShare)
+			break
+		}
+	}
+	return resp
+}
+
+func isUpToDate(cr *svcapitypes.ResourceShare, obj *svcsdk.GetResourceSharesOutput) (bool, error) {
+	rs := obj.ResourceShares[0]
+	if awsclients.StringValue(cr.Spec.ForProvider.Name) != awsclients.StringValue(rs.Name) {
+		return false, nil
+	}
+	if cr.Spec.ForProvider.AllowExternalPrincipals != nil &&
+		aws.BoolValue(cr.Spec.ForProvider.AllowExternalPrincipals) != aws.BoolValue(rs.AllowExternalPrincipals) {
+		return false, nil
+	}
+	return true, nil
+}
+
+type custom struct {
+	client ram.Client
+}
+
+func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.GetResourceSharesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -90,6 +135,14 @@ func postObserve(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.G
 			switch awsclients.StringValue(resourceShare.Status) {
 			case string(svcapitypes.ResourceShareStatus_SDK_ACTIVE):
 				cr.SetConditions(xpv1.Available())
+				if !obs.ResourceUpToDate {
+					return obs, nil
+				}
+				synced, err := e.areAssociationsUpToDate(ctx, cr)
+				if err != nil {
+					return managed.ExternalObservation{}, err
+				}
+				obs.ResourceUpToDate = synced
 			case string(svcapitypes.ResourceShareStatus_SDK_PENDING):
 				cr.SetConditions(xpv1.Creating())
 			case string(svcapitypes.ResourceShareStatus_SDK_FAILED):
@@ -109,11 +162,113 @@ func postObserve(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.G
 	return obs, nil
 }
 
+func (e *custom) areAssociationsUpToDate(ctx context.Context, cr *svcapitypes.ResourceShare) (bool, error) {
+	arn := meta.GetExternalName(cr)
+	resources, err := ram.ListAssociatedEntities(ctx, e.client, arn, svcsdk.ResourceShareAssociationTypeResource)
+	if err != nil {
+		return false, errors.Wrap(err, errListAssociations)
+	}
+	if add, remove := ram.DiffStrings(ram.DesiredResourceARNs(cr.Spec.ForProvider), resources); len(add) > 0 || len(remove) > 0 {
+		return false, nil
+	}
+	principals, err := ram.ListAssociatedEntities(ctx, e.client, arn, svcsdk.ResourceShareAssociationTypePrincipal)
+	if err != nil {
+		return false, errors.Wrap(err, errListAssociations)
+	}
+	if add, remove := ram.DiffStrings(aws.StringValueSlice(cr.Spec.ForProvider.Principals), principals); len(add) > 0 || len(remove) > 0 {
+		return false, nil
+	}
+	// AWS associates the default permission of each resource type if none
+	// is given, so permissions are only managed once they are specified.
+	if len(cr.Spec.ForProvider.PermissionARNs) == 0 {
+		return true, nil
+	}
+	permissions, err := ram.ListPermissionARNs(ctx, e.client, arn)
+	if err != nil {
+		return false, errors.Wrap(err, errListPermissions)
+	}
+	add, remove := ram.DiffStrings(aws.StringValueSlice(cr.Spec.ForProvider.PermissionARNs), permissions)
+	return len(add) == 0 && len(remove) == 0, nil
+}
+
 func preCreate(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.CreateResourceShareInput) error {
 	obj.ClientToken = awsclients.String(string(cr.UID))
+	obj.ResourceArns = aws.StringSlice(ram.DesiredResourceARNs(cr.Spec.ForProvider))
 	return nil
 }
 
+func preUpdate(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.UpdateResourceShareInput) error {
+	// The client token of the create request must not be reused for requests
+	// with different parameters.
+	obj.ClientToken = nil
+	obj.ResourceShareArn = awsclients.String(meta.GetExternalName(cr))
+	return nil
+}
+
+func (e *custom) postUpdate(ctx context.Context, cr *svcapitypes.ResourceShare, _ *svcsdk.UpdateResourceShareOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	arn := meta.GetExternalName(cr)
+
+	resources, err := ram.ListAssociatedEntities(ctx, e.client, arn, svcsdk.ResourceShareAssociationTypeResource)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListAssociations)
+	}
+	principals, err := ram.ListAssociatedEntities(ctx, e.client, arn, svcsdk.ResourceShareAssociationTypePrincipal)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListAssociations)
+	}
+	addResources, removeResources := ram.DiffStrings(ram.DesiredResourceARNs(cr.Spec.ForProvider), resources)
+	addPrincipals, removePrincipals := ram.DiffStrings(aws.StringValueSlice(cr.Spec.ForProvider.Principals), principals)
+
+	if len(addResources) > 0 || len(addPrincipals) > 0 {
+		if _, err := e.client.AssociateResourceShareWithContext(ctx, &svcsdk.AssociateResourceShareInput{
+			ResourceShareArn: aws.String(arn),
+			ResourceArns:     aws.StringSlice(addResources),
+			Principals:       aws.StringSlice(addPrincipals),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errAssociate)
+		}
+	}
+	if len(removeResources) > 0 || len(removePrincipals) > 0 {
+		if _, err := e.client.DisassociateResourceShareWithContext(ctx, &svcsdk.DisassociateResourceShareInput{
+			ResourceShareArn: aws.String(arn),
+			ResourceArns:     aws.StringSlice(removeResources),
+			Principals:       aws.StringSlice(removePrincipals),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errDisassociate)
+		}
+	}
+
+	if len(cr.Spec.ForProvider.PermissionARNs) == 0 {
+		return upd, nil
+	}
+	permissions, err := ram.ListPermissionARNs(ctx, e.client, arn)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errListPermissions)
+	}
+	addPermissions, removePermissions := ram.DiffStrings(aws.StringValueSlice(cr.Spec.ForProvider.PermissionARNs), permissions)
+	for _, p := range addPermissions {
+		if _, err := e.client.AssociateResourceSharePermissionWithContext(ctx, &svcsdk.AssociateResourceSharePermissionInput{
+			ResourceShareArn: aws.String(arn),
+			PermissionArn:    aws.String(p),
+			Replace:          aws.Bool(true),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errAssociatePermission)
+		}
+	}
+	for _, p := range removePermissions {
+		if _, err := e.client.DisassociateResourceSharePermissionWithContext(ctx, &svcsdk.DisassociateResourceSharePermissionInput{
+			ResourceShareArn: aws.String(arn),
+			PermissionArn:    aws.String(p),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errDisassociatePermission)
+		}
+	}
+	return upd, nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.ResourceShare, obj *svcsdk.CreateResourceShareOutput, _ managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return managed.ExternalCreation{}, err
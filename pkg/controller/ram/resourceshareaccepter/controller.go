@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceshareaccepter
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsram "github.com/aws/aws-sdk-go/service/ram"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ram"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject    = "managed resource is not a ResourceShareAccepter resource"
+	errCreateSession       = "cannot create a new session"
+	errNoResourceShareARN  = "resourceShareARN is not set"
+	errGetInvitations      = "failed to get resource share invitations"
+	errGetResourceShares   = "failed to get resource shares"
+	errNoPendingInvitation = "no pending invitation for the resource share"
+	errAccept              = "failed to accept resource share invitation"
+	errInvitationPrefix    = "resource share invitation is "
+)
+
+// SetupResourceShareAccepter adds a controller that reconciles
+// ResourceShareAccepters.
+func SetupResourceShareAccepter(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ResourceShareAccepterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.ResourceShareAccepter{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ResourceShareAccepterGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ram.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) ram.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ResourceShareAccepter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client ram.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceShareAccepter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	arn := awsclient.StringValue(cr.Spec.ForProvider.ResourceShareARN)
+	if arn == "" {
+		return managed.ExternalObservation{}, errors.New(errNoResourceShareARN)
+	}
+
+	inv, err := e.invitation(ctx, arn)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if inv == nil {
+		// Shares within an AWS Organization that has sharing enabled are
+		// accepted automatically and come without an invitation.
+		return e.observeResourceShare(ctx, cr, arn)
+	}
+
+	cr.Status.AtProvider = v1alpha1.ResourceShareAccepterObservation{
+		InvitationARN:     aws.StringValue(inv.ResourceShareInvitationArn),
+		Status:            aws.StringValue(inv.Status),
+		SenderAccountID:   aws.StringValue(inv.SenderAccountId),
+		ResourceShareName: aws.StringValue(inv.ResourceShareName),
+	}
+	switch aws.StringValue(inv.Status) {
+	case awsram.ResourceShareInvitationStatusPending:
+		return managed.ExternalObservation{}, nil
+	case awsram.ResourceShareInvitationStatusAccepted:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errInvitationPrefix + aws.StringValue(inv.Status)))
+	}
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) observeResourceShare(ctx context.Context, cr *v1alpha1.ResourceShareAccepter, arn string) (managed.ExternalObservation, error) {
+	out, err := e.client.GetResourceSharesWithContext(ctx, &awsram.GetResourceSharesInput{
+		ResourceOwner:     aws.String(awsram.ResourceOwnerOtherAccounts),
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ram.IsNotFound, err), errGetResourceShares)
+	}
+	if len(out.ResourceShares) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	rs := out.ResourceShares[0]
+	cr.Status.AtProvider = v1alpha1.ResourceShareAccepterObservation{
+		Status:            aws.StringValue(rs.Status),
+		SenderAccountID:   aws.StringValue(rs.OwningAccountId),
+		ResourceShareName: aws.StringValue(rs.Name),
+	}
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) invitation(ctx context.Context, arn string) (*awsram.ResourceShareInvitation, error) {
+	out, err := e.client.GetResourceShareInvitationsWithContext(ctx, &awsram.GetResourceShareInvitationsInput{
+		ResourceShareArns: aws.StringSlice([]string{arn}),
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(resource.Ignore(ram.IsNotFound, err), errGetInvitations)
+	}
+	return ram.LatestInvitation(out.ResourceShareInvitations), nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ResourceShareAccepter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	inv, err := e.invitation(ctx, awsclient.StringValue(cr.Spec.ForProvider.ResourceShareARN))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	if inv == nil || aws.StringValue(inv.Status) != awsram.ResourceShareInvitationStatusPending {
+		return managed.ExternalCreation{}, errors.New(errNoPendingInvitation)
+	}
+	_, err = e.client.AcceptResourceShareInvitationWithContext(ctx, &awsram.AcceptResourceShareInvitationInput{
+		ClientToken:                awsclient.String(string(cr.UID)),
+		ResourceShareInvitationArn: inv.ResourceShareInvitationArn,
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(resource.Ignore(ram.IsInvitationAlreadyAccepted, err), errAccept)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	// An accepted invitation has nothing to update.
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	// Deleting the accepter leaves the resource share in place. Its owner
+	// controls which principals it is shared with.
+	cr, ok := mg.(*v1alpha1.ResourceShareAccepter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	return nil
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceshareaccepter
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsram "github.com/aws/aws-sdk-go/service/ram"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ram/fake"
+)
+
+var (
+	shareARN      = "arn:aws:ram:us-east-1:111111111111:resource-share/abc"
+	invitationARN = "arn:aws:ram:us-east-1:111111111111:resource-share-invitation/def"
+	sender        = "111111111111"
+	errBoom       = errors.New("boom")
+)
+
+type args struct {
+	ram *fake.MockClient
+	cr  resource.Managed
+}
+
+type accepterModifier func(*v1alpha1.ResourceShareAccepter)
+
+func withObservation(o v1alpha1.ResourceShareAccepterObservation) accepterModifier {
+	return func(r *v1alpha1.ResourceShareAccepter) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) accepterModifier {
+	return func(r *v1alpha1.ResourceShareAccepter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func accepter(m ...accepterModifier) *v1alpha1.ResourceShareAccepter {
+	cr := &v1alpha1.ResourceShareAccepter{
+		Spec: v1alpha1.ResourceShareAccepterSpec{
+			ForProvider: v1alpha1.ResourceShareAccepterParameters{
+				ResourceShareARN: &shareARN,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func invitations(status string) func(context.Context, *awsram.GetResourceShareInvitationsInput, []request.Option) (*awsram.GetResourceShareInvitationsOutput, error) {
+	return func(context.Context, *awsram.GetResourceShareInvitationsInput, []request.Option) (*awsram.GetResourceShareInvitationsOutput, error) {
+		return &awsram.GetResourceShareInvitationsOutput{ResourceShareInvitations: []*awsram.ResourceShareInvitation{{
+			ResourceShareArn:           &shareARN,
+			ResourceShareInvitationArn: &invitationARN,
+			ResourceShareName:          aws.String("tgw"),
+			SenderAccountId:            &sender,
+			Status:                     aws.String(status),
+		}}}, nil
+	}
+}
+
+func noInvitations(context.Context, *awsram.GetResourceShareInvitationsInput, []request.Option) (*awsram.GetResourceShareInvitationsOutput, error) {
+	return &awsram.GetResourceShareInvitationsOutput{}, nil
+}
+
+func observation(status string) v1alpha1.ResourceShareAccepterObservation {
+	return v1alpha1.ResourceShareAccepterObservation{
+		InvitationARN:     invitationARN,
+		Status:            status,
+		SenderAccountID:   sender,
+		ResourceShareName: "tgw",
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Pending": {
+			args: args{
+				ram: &fake.MockClient{MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusPending)},
+				cr:  accepter(),
+			},
+			want: want{
+				cr: accepter(withObservation(observation(awsram.ResourceShareInvitationStatusPending))),
+			},
+		},
+		"Accepted": {
+			args: args{
+				ram: &fake.MockClient{MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusAccepted)},
+				cr:  accepter(),
+			},
+			want: want{
+				cr: accepter(withObservation(observation(awsram.ResourceShareInvitationStatusAccepted)), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Expired": {
+			args: args{
+				ram: &fake.MockClient{MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusExpired)},
+				cr:  accepter(),
+			},
+			want: want{
+				cr: accepter(withObservation(observation(awsram.ResourceShareInvitationStatusExpired)),
+					withConditions(xpv1.Unavailable().WithMessage(errInvitationPrefix+awsram.ResourceShareInvitationStatusExpired))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SharedWithinOrganization": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: noInvitations,
+					MockGetResourceShares: func(_ context.Context, input *awsram.GetResourceSharesInput, _ []request.Option) (*awsram.GetResourceSharesOutput, error) {
+						if aws.StringValue(input.ResourceOwner) != awsram.ResourceOwnerOtherAccounts {
+							return nil, errBoom
+						}
+						return &awsram.GetResourceSharesOutput{ResourceShares: []*awsram.ResourceShare{{
+							Name:            aws.String("tgw"),
+							OwningAccountId: &sender,
+							Status:          aws.String(awsram.ResourceShareStatusActive),
+						}}}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withObservation(v1alpha1.ResourceShareAccepterObservation{
+					Status:            awsram.ResourceShareStatusActive,
+					SenderAccountID:   sender,
+					ResourceShareName: "tgw",
+				}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotShared": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: noInvitations,
+					MockGetResourceShares: func(context.Context, *awsram.GetResourceSharesInput, []request.Option) (*awsram.GetResourceSharesOutput, error) {
+						return &awsram.GetResourceSharesOutput{}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(),
+			},
+		},
+		"InvitationsError": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: func(context.Context, *awsram.GetResourceShareInvitationsInput, []request.Option) (*awsram.GetResourceShareInvitationsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(),
+				err: awsclient.Wrap(errBoom, errGetInvitations),
+			},
+		},
+		"NoResourceShareARN": {
+			args: args{
+				ram: &fake.MockClient{},
+				cr:  &v1alpha1.ResourceShareAccepter{},
+			},
+			want: want{
+				cr:  &v1alpha1.ResourceShareAccepter{},
+				err: errors.New(errNoResourceShareARN),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Accepted": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusPending),
+					MockAcceptResourceShareInvitation: func(_ context.Context, input *awsram.AcceptResourceShareInvitationInput, _ []request.Option) (*awsram.AcceptResourceShareInvitationOutput, error) {
+						if aws.StringValue(input.ResourceShareInvitationArn) != invitationARN {
+							return nil, errBoom
+						}
+						return &awsram.AcceptResourceShareInvitationOutput{}, nil
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withConditions(xpv1.Creating())),
+			},
+		},
+		"AlreadyAccepted": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusPending),
+					MockAcceptResourceShareInvitation: func(context.Context, *awsram.AcceptResourceShareInvitationInput, []request.Option) (*awsram.AcceptResourceShareInvitationOutput, error) {
+						return nil, awserr.New(awsram.ErrCodeResourceShareInvitationAlreadyAcceptedException, "", nil)
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr: accepter(withConditions(xpv1.Creating())),
+			},
+		},
+		"NoPendingInvitation": {
+			args: args{
+				ram: &fake.MockClient{MockGetResourceShareInvitations: noInvitations},
+				cr:  accepter(),
+			},
+			want: want{
+				cr:  accepter(withConditions(xpv1.Creating())),
+				err: errors.New(errNoPendingInvitation),
+			},
+		},
+		"AcceptError": {
+			args: args{
+				ram: &fake.MockClient{
+					MockGetResourceShareInvitations: invitations(awsram.ResourceShareInvitationStatusPending),
+					MockAcceptResourceShareInvitation: func(context.Context, *awsram.AcceptResourceShareInvitationInput, []request.Option) (*awsram.AcceptResourceShareInvitationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: accepter(),
+			},
+			want: want{
+				cr:  accepter(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAccept),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ram}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbcluster
+
+import (
+	"context"
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	aws "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+func TestCreateHooks(t *testing.T) {
+	type args struct {
+		kube client.Client
+		cr   *svcapitypes.DBCluster
+		err  error
+	}
+	type want struct {
+		password  *string
+		generated bool
+		cre       managed.ExternalCreation
+		err       error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"PasswordFromSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("secret")}
+						return nil
+					},
+				},
+				cr: &svcapitypes.DBCluster{
+					Spec: svcapitypes.DBClusterSpec{
+						ForProvider: svcapitypes.DBClusterParameters{
+							CustomDBClusterParameters: svcapitypes.CustomDBClusterParameters{
+								AutogeneratePassword:        true,
+								MasterUserPasswordSecretRef: &xpv1.SecretKeySelector{Key: "password"},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				password: aws.String("secret"),
+			},
+		},
+		"GeneratedPassword": {
+			args: args{
+				cr: &svcapitypes.DBCluster{
+					Spec: svcapitypes.DBClusterSpec{
+						ForProvider: svcapitypes.DBClusterParameters{
+							CustomDBClusterParameters: svcapitypes.CustomDBClusterParameters{
+								AutogeneratePassword: true,
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				generated: true,
+			},
+		},
+		"NoPassword": {
+			args: args{
+				cr: &svcapitypes.DBCluster{},
+			},
+		},
+		"FailedCreate": {
+			args: args{
+				cr: &svcapitypes.DBCluster{
+					Spec: svcapitypes.DBClusterSpec{
+						ForProvider: svcapitypes.DBClusterParameters{
+							CustomDBClusterParameters: svcapitypes.CustomDBClusterParameters{
+								AutogeneratePassword: true,
+							},
+						},
+					},
+				},
+				err: errBoom,
+			},
+			want: want{
+				generated: true,
+				err:       errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{kube: tc.args.kube}
+			in := &svcsdk.CreateDBClusterInput{}
+			if err := c.preCreate(context.Background(), tc.args.cr, in); err != nil {
+				t.Fatalf("preCreate: %v", err)
+			}
+			if tc.want.generated {
+				tc.want.password = aws.String(c.generatedPassword)
+				if c.generatedPassword == "" {
+					t.Errorf("preCreate: expected a generated password")
+				}
+				if tc.args.err == nil {
+					tc.want.cre = managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(c.generatedPassword),
+					}}
+				}
+			}
+			if diff := cmp.Diff(tc.want.password, in.MasterUserPassword); diff != "" {
+				t.Errorf("password: -want, +got:\n%s", diff)
+			}
+			cre, err := c.postCreate(context.Background(), tc.args.cr, &svcsdk.CreateDBClusterOutput{}, managed.ExternalCreation{}, tc.args.err)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("postCreate err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cre, cre); diff != "" {
+				t.Errorf("postCreate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
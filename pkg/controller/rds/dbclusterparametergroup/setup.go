@@ -2,17 +2,15 @@ package dbclusterparametergroup
 
 import (
 	"context"
-	"errors"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -20,21 +18,29 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	svcutils "github.com/crossplane/provider-aws/pkg/controller/rds"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupDBClusterParameterGroup adds a controller that reconciles DBClusterParameterGroup.
-func SetupDBClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupDBClusterParameterGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterParameterGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
+			e.client = &parameterGroupClient{RDSAPI: e.client}
 			e.preCreate = preCreate
 			e.preObserve = preObserve
-			e.preUpdate = preUpdate
 			e.preDelete = preDelete
 			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
 			e.isUpToDate = c.isUpToDate
+			e.preUpdate = c.preUpdate
 		},
 	}
 
@@ -45,22 +51,37 @@ func SetupDBClusterParameterGroup(mgr ctrl.Manager, o controller.Options) error
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.DBClusterParameterGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.DBClusterParameterGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<db-cluster-parameter-group-name>", "rds", "cluster-pg"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+const (
+	errDescribeParameters = "cannot describe parameters of DBClusterParameterGroup"
+	errResetParameters    = "cannot reset parameters of DBClusterParameterGroup"
+)
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
 }
 
+// parameterGroupClient skips ModifyDBClusterParameterGroup calls that carry
+// no parameters, which happens when an update only resets parameters to
+// their engine defaults.
+type parameterGroupClient struct {
+	svcsdkapi.RDSAPI
+}
+
+func (c *parameterGroupClient) ModifyDBClusterParameterGroupWithContext(ctx context.Context, input *svcsdk.ModifyDBClusterParameterGroupInput, opts ...request.Option) (*svcsdk.DBClusterParameterGroupNameMessage, error) {
+	if len(input.Parameters) == 0 {
+		return &svcsdk.DBClusterParameterGroupNameMessage{DBClusterParameterGroupName: input.DBClusterParameterGroupName}, nil
+	}
+	return c.RDSAPI.ModifyDBClusterParameterGroupWithContext(ctx, input, opts...)
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.DBClusterParameterGroup, obj *svcsdk.DescribeDBClusterParameterGroupsInput) error {
 	obj.DBClusterParameterGroupName = awsclients.String(meta.GetExternalName(cr))
 	return nil
@@ -79,29 +100,22 @@ func preCreate(_ context.Context, cr *svcapitypes.DBClusterParameterGroup, obj *
 	return nil
 }
 
-func preUpdate(_ context.Context, cr *svcapitypes.DBClusterParameterGroup, obj *svcsdk.ModifyDBClusterParameterGroupInput) error {
+func (c *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBClusterParameterGroup, obj *svcsdk.ModifyDBClusterParameterGroupInput) error {
 	obj.DBClusterParameterGroupName = awsclients.String(meta.GetExternalName(cr))
-	obj.Parameters = make([]*svcsdk.Parameter, len(cr.Spec.ForProvider.Parameters))
-
-	for i, v := range cr.Spec.ForProvider.Parameters {
-		// check if mandatory parameters are set (ApplyMethod, ParameterName, ParameterValue)
-		if (v.ApplyMethod == nil) || (v.ParameterName == nil) || (v.ParameterValue == nil) {
-			return errors.New("ApplyMethod, ParameterName and ParameterValue are mandatory fields and can not be nil")
-		}
-		obj.Parameters[i] = &svcsdk.Parameter{
-			AllowedValues:        v.AllowedValues,
-			ApplyMethod:          v.ApplyMethod,
-			ApplyType:            v.ApplyType,
-			DataType:             v.DataType,
-			Description:          v.Description,
-			IsModifiable:         v.IsModifiable,
-			MinimumEngineVersion: v.MinimumEngineVersion,
-			ParameterName:        v.ParameterName,
-			ParameterValue:       v.ParameterValue,
-			Source:               v.Source,
-			SupportedEngineModes: v.SupportedEngineModes,
+	diff, err := c.diff(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if reset := svcutils.LimitParameters(diff.Reset); len(reset) > 0 {
+		_, err := c.client.ResetDBClusterParameterGroupWithContext(ctx, &svcsdk.ResetDBClusterParameterGroupInput{
+			DBClusterParameterGroupName: obj.DBClusterParameterGroupName,
+			Parameters:                  reset,
+		})
+		if err != nil {
+			return awsclients.Wrap(err, errResetParameters)
 		}
 	}
+	obj.Parameters = svcutils.LimitParameters(diff.Modify)
 	return nil
 }
 
@@ -113,34 +127,29 @@ func preDelete(_ context.Context, cr *svcapitypes.DBClusterParameterGroup, obj *
 func (c *custom) isUpToDate(cr *svcapitypes.DBClusterParameterGroup, _ *svcsdk.DescribeDBClusterParameterGroupsOutput) (bool, error) {
 	// TODO(armsnyder): We need isUpToDate to have context.
 	ctx := context.TODO()
-	results, err := c.getCurrentDBClusterParameters(ctx, cr)
+	diff, err := c.diff(ctx, cr)
 	if err != nil {
 		return false, err
 	}
-	observed := make(map[string]svcsdk.Parameter, len(results))
-	for _, p := range results {
-		observed[awsclients.StringValue(p.ParameterName)] = *p
-	}
-	// compare CR with currently set Parameters
-	for _, v := range cr.Spec.ForProvider.Parameters {
-		existing, ok := observed[awsclients.StringValue(v.ParameterName)]
-		if !ok {
-			return false, nil
-		}
-		switch {
-		case awsclients.StringValue(existing.ParameterValue) != awsclients.StringValue(v.ParameterValue):
-			return false, nil
-		case awsclients.StringValue(existing.ApplyMethod) != awsclients.StringValue(v.ApplyMethod):
-			return false, nil
-		}
+	cr.Status.AtProvider.PendingRebootParameters = diff.PendingReboot
+	return diff.UpToDate(), nil
+}
+
+func (c *custom) diff(ctx context.Context, cr *svcapitypes.DBClusterParameterGroup) (svcutils.ParameterDiff, error) {
+	overrides, err := c.getCurrentDBClusterParameters(ctx, cr)
+	if err != nil {
+		return svcutils.ParameterDiff{}, errors.Wrap(err, errDescribeParameters)
 	}
-	return true, err
+	return svcutils.DiffParameters(cr.Spec.ForProvider.Parameters, overrides, func() ([]*svcsdk.Parameter, error) {
+		return c.getEngineDefaultClusterParameters(ctx, cr)
+	})
 }
 
 func (c *custom) getCurrentDBClusterParameters(ctx context.Context, cr *svcapitypes.DBClusterParameterGroup) ([]*svcsdk.Parameter, error) {
 	input := &svcsdk.DescribeDBClusterParametersInput{
 		DBClusterParameterGroupName: awsclients.String(meta.GetExternalName(cr)),
 		MaxRecords:                  awsclients.Int64(100),
+		Source:                      awsclients.String(svcutils.SourceUser),
 	}
 	var results []*svcsdk.Parameter
 	err := c.client.DescribeDBClusterParametersPagesWithContext(ctx, input, func(page *svcsdk.DescribeDBClusterParametersOutput, lastPage bool) bool {
@@ -152,3 +161,25 @@ func (c *custom) getCurrentDBClusterParameters(ctx context.Context, cr *svcapity
 	}
 	return results, nil
 }
+
+func (c *custom) getEngineDefaultClusterParameters(ctx context.Context, cr *svcapitypes.DBClusterParameterGroup) ([]*svcsdk.Parameter, error) {
+	input := &svcsdk.DescribeEngineDefaultClusterParametersInput{
+		DBParameterGroupFamily: cr.Spec.ForProvider.DBParameterGroupFamily,
+		MaxRecords:             awsclients.Int64(100),
+	}
+	var results []*svcsdk.Parameter
+	for {
+		resp, err := c.client.DescribeEngineDefaultClusterParametersWithContext(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+		if resp.EngineDefaults == nil {
+			return results, nil
+		}
+		results = append(results, resp.EngineDefaults.Parameters...)
+		if resp.EngineDefaults.Marker == nil {
+			return results, nil
+		}
+		input.Marker = resp.EngineDefaults.Marker
+	}
+}
@@ -3,6 +3,7 @@ package dbparametergroup
 import (
 	"context"
 
+	"github.com/aws/aws-sdk-go/aws/request"
 	svcsdk "github.com/aws/aws-sdk-go/service/rds"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
 	"github.com/pkg/errors"
@@ -10,9 +11,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -20,21 +18,29 @@ import (
 	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	svcutils "github.com/crossplane/provider-aws/pkg/controller/rds"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 // SetupDBParameterGroup adds a controller that reconciles DBParametergroup.
-func SetupDBParameterGroup(mgr ctrl.Manager, o controller.Options) error {
+func SetupDBParameterGroup(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(svcapitypes.DBParameterGroupGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 	opts := []option{
 		func(e *external) {
+			e.client = &parameterGroupClient{RDSAPI: e.client}
 			e.preCreate = preCreate
 			e.preObserve = preObserve
-			e.preUpdate = preUpdate
 			e.preDelete = preDelete
 			e.postObserve = postObserve
 			c := &custom{client: e.client, kube: e.kube}
 			e.isUpToDate = c.isUpToDate
+			e.preUpdate = c.preUpdate
 		},
 	}
 
@@ -45,22 +51,37 @@ func SetupDBParameterGroup(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&svcapitypes.DBParameterGroup{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(svcapitypes.DBParameterGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.DBParameterGroupGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<db-parameter-group-name>", "rds", "pg"), &connector{kube: mgr.GetClient(), opts: opts}),
 			managed.WithConnectionPublishers(cps...)))
 }
 
+const (
+	errDescribeParameters = "cannot describe parameters of DBParameterGroup"
+	errResetParameters    = "cannot reset parameters of DBParameterGroup"
+)
+
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
 }
 
+// parameterGroupClient skips ModifyDBParameterGroup calls that carry no
+// parameters, which happens when an update only resets parameters to their
+// engine defaults.
+type parameterGroupClient struct {
+	svcsdkapi.RDSAPI
+}
+
+func (c *parameterGroupClient) ModifyDBParameterGroupWithContext(ctx context.Context, input *svcsdk.ModifyDBParameterGroupInput, opts ...request.Option) (*svcsdk.DBParameterGroupNameMessage, error) {
+	if len(input.Parameters) == 0 {
+		return &svcsdk.DBParameterGroupNameMessage{DBParameterGroupName: input.DBParameterGroupName}, nil
+	}
+	return c.RDSAPI.ModifyDBParameterGroupWithContext(ctx, input, opts...)
+}
+
 func preObserve(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.DescribeDBParameterGroupsInput) error {
 	obj.DBParameterGroupName = awsclients.String(meta.GetExternalName(cr))
 	return nil
@@ -79,29 +100,22 @@ func preCreate(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.
 	return nil
 }
 
-func preUpdate(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.ModifyDBParameterGroupInput) error {
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.ModifyDBParameterGroupInput) error {
 	obj.DBParameterGroupName = awsclients.String(meta.GetExternalName(cr))
-	obj.Parameters = make([]*svcsdk.Parameter, len(cr.Spec.ForProvider.Parameters))
-
-	for i, v := range cr.Spec.ForProvider.Parameters {
-		// check if mandatory parameters are set (ApplyMethod, ParameterName, ParameterValue)
-		if (v.ApplyMethod == nil) || (v.ParameterName == nil) || (v.ParameterValue == nil) {
-			return errors.New("ApplyMethod, ParameterName and ParameterValue are mandatory fields and can not be nil")
-		}
-		obj.Parameters[i] = &svcsdk.Parameter{
-			AllowedValues:        v.AllowedValues,
-			ApplyMethod:          v.ApplyMethod,
-			ApplyType:            v.ApplyType,
-			DataType:             v.DataType,
-			Description:          v.Description,
-			IsModifiable:         v.IsModifiable,
-			MinimumEngineVersion: v.MinimumEngineVersion,
-			ParameterName:        v.ParameterName,
-			ParameterValue:       v.ParameterValue,
-			Source:               v.Source,
-			SupportedEngineModes: v.SupportedEngineModes,
+	diff, err := e.diff(ctx, cr)
+	if err != nil {
+		return err
+	}
+	if reset := svcutils.LimitParameters(diff.Reset); len(reset) > 0 {
+		_, err := e.client.ResetDBParameterGroupWithContext(ctx, &svcsdk.ResetDBParameterGroupInput{
+			DBParameterGroupName: obj.DBParameterGroupName,
+			Parameters:           reset,
+		})
+		if err != nil {
+			return awsclients.Wrap(err, errResetParameters)
 		}
 	}
+	obj.Parameters = svcutils.LimitParameters(diff.Modify)
 	return nil
 }
 
@@ -113,34 +127,29 @@ func preDelete(_ context.Context, cr *svcapitypes.DBParameterGroup, obj *svcsdk.
 func (e *custom) isUpToDate(cr *svcapitypes.DBParameterGroup, obj *svcsdk.DescribeDBParameterGroupsOutput) (bool, error) {
 	// TODO(Dkaykay): We need isUpToDate to have context.
 	ctx := context.TODO()
-	results, err := e.getCurrentDBParameters(ctx, cr)
+	diff, err := e.diff(ctx, cr)
 	if err != nil {
 		return false, err
 	}
-	observed := make(map[string]svcsdk.Parameter, len(results))
-	for _, p := range results {
-		observed[awsclients.StringValue(p.ParameterName)] = *p
-	}
-	// compare CR with currently set Parameters
-	for _, v := range cr.Spec.ForProvider.Parameters {
-		existing, ok := observed[awsclients.StringValue(v.ParameterName)]
-		if !ok {
-			return false, nil
-		}
-		switch {
-		case awsclients.StringValue(existing.ParameterValue) != awsclients.StringValue(v.ParameterValue):
-			return false, nil
-		case awsclients.StringValue(existing.ApplyMethod) != awsclients.StringValue(v.ApplyMethod):
-			return false, nil
-		}
+	cr.Status.AtProvider.PendingRebootParameters = diff.PendingReboot
+	return diff.UpToDate(), nil
+}
+
+func (e *custom) diff(ctx context.Context, cr *svcapitypes.DBParameterGroup) (svcutils.ParameterDiff, error) {
+	overrides, err := e.getCurrentDBParameters(ctx, cr)
+	if err != nil {
+		return svcutils.ParameterDiff{}, errors.Wrap(err, errDescribeParameters)
 	}
-	return true, err
+	return svcutils.DiffParameters(cr.Spec.ForProvider.Parameters, overrides, func() ([]*svcsdk.Parameter, error) {
+		return e.getEngineDefaultParameters(ctx, cr)
+	})
 }
 
 func (e *custom) getCurrentDBParameters(ctx context.Context, cr *svcapitypes.DBParameterGroup) ([]*svcsdk.Parameter, error) {
 	input := &svcsdk.DescribeDBParametersInput{
 		DBParameterGroupName: awsclients.String(meta.GetExternalName(cr)),
 		MaxRecords:           awsclients.Int64(100),
+		Source:               awsclients.String(svcutils.SourceUser),
 	}
 	var results []*svcsdk.Parameter
 	err := e.client.DescribeDBParametersPagesWithContext(ctx, input, func(page *svcsdk.DescribeDBParametersOutput, lastPage bool) bool {
@@ -152,3 +161,18 @@ func (e *custom) getCurrentDBParameters(ctx context.Context, cr *svcapitypes.DBP
 	}
 	return results, nil
 }
+
+func (e *custom) getEngineDefaultParameters(ctx context.Context, cr *svcapitypes.DBParameterGroup) ([]*svcsdk.Parameter, error) {
+	input := &svcsdk.DescribeEngineDefaultParametersInput{
+		DBParameterGroupFamily: cr.Spec.ForProvider.DBParameterGroupFamily,
+		MaxRecords:             awsclients.Int64(100),
+	}
+	var results []*svcsdk.Parameter
+	err := e.client.DescribeEngineDefaultParametersPagesWithContext(ctx, input, func(page *svcsdk.DescribeEngineDefaultParametersOutput, lastPage bool) bool {
+		if page.EngineDefaults != nil {
+			results = append(results, page.EngineDefaults.Parameters...)
+		}
+		return !lastPage
+	})
+	return results, err
+}
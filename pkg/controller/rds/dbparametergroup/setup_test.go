@@ -0,0 +1,227 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dbparametergroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/rds/rdsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const testGroupName = "some-group"
+
+type mockRDSClient struct {
+	svcsdkapi.RDSAPI
+
+	parameters []*svcsdk.Parameter
+	defaults   []*svcsdk.Parameter
+	resetErr   error
+	reset      *svcsdk.ResetDBParameterGroupInput
+}
+
+func (m *mockRDSClient) DescribeDBParametersPagesWithContext(_ aws.Context, _ *svcsdk.DescribeDBParametersInput, cb func(*svcsdk.DescribeDBParametersOutput, bool) bool, _ ...request.Option) error {
+	cb(&svcsdk.DescribeDBParametersOutput{Parameters: m.parameters}, true)
+	return nil
+}
+
+func (m *mockRDSClient) DescribeEngineDefaultParametersPagesWithContext(_ aws.Context, _ *svcsdk.DescribeEngineDefaultParametersInput, cb func(*svcsdk.DescribeEngineDefaultParametersOutput, bool) bool, _ ...request.Option) error {
+	cb(&svcsdk.DescribeEngineDefaultParametersOutput{EngineDefaults: &svcsdk.EngineDefaults{Parameters: m.defaults}}, true)
+	return nil
+}
+
+func (m *mockRDSClient) ResetDBParameterGroupWithContext(_ aws.Context, in *svcsdk.ResetDBParameterGroupInput, _ ...request.Option) (*svcsdk.DBParameterGroupNameMessage, error) {
+	m.reset = in
+	return &svcsdk.DBParameterGroupNameMessage{}, m.resetErr
+}
+
+func group(params ...svcapitypes.Parameter) *svcapitypes.DBParameterGroup {
+	cr := &svcapitypes.DBParameterGroup{}
+	meta.SetExternalName(cr, testGroupName)
+	cr.Spec.ForProvider.DBParameterGroupFamily = awsclients.String("mysql8.0")
+	cr.Spec.ForProvider.Parameters = params
+	return cr
+}
+
+func parameter(name, value string) svcapitypes.Parameter {
+	return svcapitypes.Parameter{ParameterName: awsclients.String(name), ParameterValue: awsclients.String(value)}
+}
+
+func TestIsUpToDate(t *testing.T) {
+	type want struct {
+		upToDate      bool
+		pendingReboot []*string
+		err           error
+	}
+
+	cases := map[string]struct {
+		client *mockRDSClient
+		cr     *svcapitypes.DBParameterGroup
+		want   want
+	}{
+		"UpToDate": {
+			client: &mockRDSClient{
+				parameters: []*svcsdk.Parameter{{
+					Source:         awsclients.String("user"),
+					ParameterName:  awsclients.String("innodb_buffer_pool_size"),
+					ParameterValue: awsclients.String("1024"),
+					ApplyMethod:    awsclients.String("pending-reboot"),
+				}},
+				defaults: []*svcsdk.Parameter{{
+					ParameterName:  awsclients.String("autocommit"),
+					ParameterValue: awsclients.String("1"),
+				}},
+			},
+			cr: group(parameter("innodb_buffer_pool_size", "1024"), parameter("autocommit", "1")),
+			want: want{
+				upToDate:      true,
+				pendingReboot: []*string{awsclients.String("innodb_buffer_pool_size")},
+			},
+		},
+		"RemovedParameter": {
+			client: &mockRDSClient{
+				parameters: []*svcsdk.Parameter{{
+					Source:         awsclients.String("user"),
+					ParameterName:  awsclients.String("max_connections"),
+					ParameterValue: awsclients.String("100"),
+					ApplyMethod:    awsclients.String("immediate"),
+				}},
+			},
+			cr:   group(),
+			want: want{upToDate: false},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.client}
+			upToDate, err := c.isUpToDate(tc.cr, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, upToDate); diff != "" {
+				t.Errorf("upToDate: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.pendingReboot, tc.cr.Status.AtProvider.PendingRebootParameters); diff != "" {
+				t.Errorf("pendingReboot: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPreUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type want struct {
+		input *svcsdk.ModifyDBParameterGroupInput
+		reset *svcsdk.ResetDBParameterGroupInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		client *mockRDSClient
+		cr     *svcapitypes.DBParameterGroup
+		want   want
+	}{
+		"ModifyAndReset": {
+			client: &mockRDSClient{
+				parameters: []*svcsdk.Parameter{{
+					Source:         awsclients.String("user"),
+					ParameterName:  awsclients.String("max_connections"),
+					ParameterValue: awsclients.String("100"),
+					ApplyMethod:    awsclients.String("immediate"),
+					ApplyType:      awsclients.String("dynamic"),
+				}},
+				defaults: []*svcsdk.Parameter{{
+					ParameterName:  awsclients.String("autocommit"),
+					ParameterValue: awsclients.String("1"),
+					ApplyType:      awsclients.String("dynamic"),
+				}},
+			},
+			cr: group(parameter("autocommit", "0")),
+			want: want{
+				input: &svcsdk.ModifyDBParameterGroupInput{
+					DBParameterGroupName: awsclients.String(testGroupName),
+					Parameters: []*svcsdk.Parameter{{
+						ParameterName:  awsclients.String("autocommit"),
+						ParameterValue: awsclients.String("0"),
+						ApplyMethod:    awsclients.String("immediate"),
+					}},
+				},
+				reset: &svcsdk.ResetDBParameterGroupInput{
+					DBParameterGroupName: awsclients.String(testGroupName),
+					Parameters: []*svcsdk.Parameter{{
+						ParameterName: awsclients.String("max_connections"),
+						ApplyMethod:   awsclients.String("immediate"),
+					}},
+				},
+			},
+		},
+		"ResetError": {
+			client: &mockRDSClient{
+				parameters: []*svcsdk.Parameter{{
+					Source:         awsclients.String("user"),
+					ParameterName:  awsclients.String("max_connections"),
+					ParameterValue: awsclients.String("100"),
+				}},
+				resetErr: errBoom,
+			},
+			cr: group(),
+			want: want{
+				input: &svcsdk.ModifyDBParameterGroupInput{
+					DBParameterGroupName: awsclients.String(testGroupName),
+				},
+				reset: &svcsdk.ResetDBParameterGroupInput{
+					DBParameterGroupName: awsclients.String(testGroupName),
+					Parameters: []*svcsdk.Parameter{{
+						ParameterName: awsclients.String("max_connections"),
+						ApplyMethod:   awsclients.String("pending-reboot"),
+					}},
+				},
+				err: awsclients.Wrap(errBoom, errResetParameters),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &custom{client: tc.client}
+			input := &svcsdk.ModifyDBParameterGroupInput{}
+			err := c.preUpdate(context.Background(), tc.cr, input)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, input); diff != "" {
+				t.Errorf("input: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.reset, tc.client.reset); diff != "" {
+				t.Errorf("reset: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
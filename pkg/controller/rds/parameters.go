@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"sort"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+// MaxParametersPerRequest is the number of parameters RDS accepts in a single
+// modify or reset call.
+const MaxParametersPerRequest = 20
+
+// SourceUser is the source RDS reports for parameters that were overridden
+// in a parameter group.
+const SourceUser = "user"
+
+const (
+	applyMethodImmediate     = "immediate"
+	applyMethodPendingReboot = "pending-reboot"
+	applyTypeDynamic         = "dynamic"
+
+	errParameterFields     = "parameterName and parameterValue are mandatory fields and can not be nil"
+	errEngineDefaultParams = "cannot describe engine default parameters"
+)
+
+// ParameterDiff is the difference between the desired parameters of a
+// parameter group and the ones RDS reports for it.
+type ParameterDiff struct {
+	// Modify holds the desired parameters whose value differs from the
+	// effective one, i.e. the user override or the engine default.
+	Modify []*svcsdk.Parameter
+
+	// Reset holds the user overrides that are no longer desired and must be
+	// reset to their engine defaults.
+	Reset []*svcsdk.Parameter
+
+	// PendingReboot holds the names of the user overrides that were last
+	// applied with the pending-reboot apply method.
+	PendingReboot []*string
+}
+
+// UpToDate returns true if no parameter needs to be modified or reset.
+func (d ParameterDiff) UpToDate() bool {
+	return len(d.Modify) == 0 && len(d.Reset) == 0
+}
+
+// DiffParameters compares the desired parameters with the user overrides of a
+// parameter group. Desired parameters that are not overridden are compared
+// with the engine defaults, which are only fetched when needed, so that
+// setting a parameter to its default value does not show up as drift.
+// Desired parameters without an apply method get one based on their apply
+// type.
+func DiffParameters(desired []svcapitypes.Parameter, overrides []*svcsdk.Parameter, defaults func() ([]*svcsdk.Parameter, error)) (ParameterDiff, error) { // nolint:gocyclo
+	diff := ParameterDiff{}
+
+	current := make(map[string]*svcsdk.Parameter, len(overrides))
+	for _, p := range overrides {
+		current[awsclient.StringValue(p.ParameterName)] = p
+	}
+
+	var engine map[string]*svcsdk.Parameter
+	wanted := make(map[string]bool, len(desired))
+	for _, d := range desired {
+		if d.ParameterName == nil || d.ParameterValue == nil {
+			return ParameterDiff{}, errors.New(errParameterFields)
+		}
+		name := awsclient.StringValue(d.ParameterName)
+		wanted[name] = true
+
+		known, overridden := current[name]
+		if overridden && awsclient.StringValue(known.ParameterValue) == awsclient.StringValue(d.ParameterValue) &&
+			(d.ApplyMethod == nil || awsclient.StringValue(d.ApplyMethod) == awsclient.StringValue(known.ApplyMethod)) {
+			continue
+		}
+		if !overridden {
+			if engine == nil {
+				params, err := defaults()
+				if err != nil {
+					return ParameterDiff{}, errors.Wrap(err, errEngineDefaultParams)
+				}
+				engine = make(map[string]*svcsdk.Parameter, len(params))
+				for _, p := range params {
+					engine[awsclient.StringValue(p.ParameterName)] = p
+				}
+			}
+			known = engine[name]
+			if known != nil && awsclient.StringValue(known.ParameterValue) == awsclient.StringValue(d.ParameterValue) {
+				continue
+			}
+		}
+		diff.Modify = append(diff.Modify, &svcsdk.Parameter{
+			ParameterName:  d.ParameterName,
+			ParameterValue: d.ParameterValue,
+			ApplyMethod:    applyMethod(d.ApplyMethod, known),
+		})
+	}
+
+	for _, p := range overrides {
+		if awsclient.StringValue(p.Source) != SourceUser {
+			continue
+		}
+		if awsclient.StringValue(p.ApplyMethod) == applyMethodPendingReboot {
+			diff.PendingReboot = append(diff.PendingReboot, p.ParameterName)
+		}
+		if wanted[awsclient.StringValue(p.ParameterName)] {
+			continue
+		}
+		diff.Reset = append(diff.Reset, &svcsdk.Parameter{
+			ParameterName: p.ParameterName,
+			ApplyMethod:   applyMethod(nil, p),
+		})
+	}
+
+	sortParameters(diff.Modify)
+	sortParameters(diff.Reset)
+	sort.Slice(diff.PendingReboot, func(i, j int) bool {
+		return awsclient.StringValue(diff.PendingReboot[i]) < awsclient.StringValue(diff.PendingReboot[j])
+	})
+	return diff, nil
+}
+
+// applyMethod returns the desired apply method, if any. Otherwise dynamic
+// parameters are applied immediately and all others on the next reboot.
+func applyMethod(desired *string, known *svcsdk.Parameter) *string {
+	switch {
+	case desired != nil:
+		return desired
+	case known != nil && awsclient.StringValue(known.ApplyType) == applyTypeDynamic:
+		return awsclient.String(applyMethodImmediate)
+	default:
+		return awsclient.String(applyMethodPendingReboot)
+	}
+}
+
+func sortParameters(p []*svcsdk.Parameter) {
+	sort.Slice(p, func(i, j int) bool {
+		return awsclient.StringValue(p[i].ParameterName) < awsclient.StringValue(p[j].ParameterName)
+	})
+}
+
+// LimitParameters returns at most MaxParametersPerRequest parameters. The
+// rest is picked up by the next reconcile since the parameter group is still
+// reported as out of date.
+func LimitParameters(p []*svcsdk.Parameter) []*svcsdk.Parameter {
+	if len(p) > MaxParametersPerRequest {
+		return p[:MaxParametersPerRequest]
+	}
+	return p
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+
+	svcsdk "github.com/aws/aws-sdk-go/service/rds"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+func desired(name, value string, method *string) svcapitypes.Parameter {
+	return svcapitypes.Parameter{
+		ParameterName:  awsclient.String(name),
+		ParameterValue: awsclient.String(value),
+		ApplyMethod:    method,
+	}
+}
+
+func observed(source, name, value, method, applyType string) *svcsdk.Parameter {
+	return &svcsdk.Parameter{
+		Source:         awsclient.String(source),
+		ParameterName:  awsclient.String(name),
+		ParameterValue: awsclient.String(value),
+		ApplyMethod:    awsclient.String(method),
+		ApplyType:      awsclient.String(applyType),
+	}
+}
+
+func TestDiffParameters(t *testing.T) {
+	errBoom := errors.New("boom")
+	defaults := func(p ...*svcsdk.Parameter) func() ([]*svcsdk.Parameter, error) {
+		return func() ([]*svcsdk.Parameter, error) { return p, nil }
+	}
+	noDefaults := func() ([]*svcsdk.Parameter, error) {
+		return nil, errors.New("engine defaults should not be fetched")
+	}
+
+	type args struct {
+		desired   []svcapitypes.Parameter
+		overrides []*svcsdk.Parameter
+		defaults  func() ([]*svcsdk.Parameter, error)
+	}
+	type want struct {
+		diff     ParameterDiff
+		upToDate bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDateOverrides": {
+			args: args{
+				desired:   []svcapitypes.Parameter{desired("max_connections", "100", nil)},
+				overrides: []*svcsdk.Parameter{observed(SourceUser, "max_connections", "100", applyMethodImmediate, applyTypeDynamic)},
+				defaults:  noDefaults,
+			},
+			want: want{upToDate: true},
+		},
+		"UpToDateEngineDefault": {
+			args: args{
+				desired:  []svcapitypes.Parameter{desired("autocommit", "1", nil)},
+				defaults: defaults(observed("engine-default", "autocommit", "1", "", applyTypeDynamic)),
+			},
+			want: want{upToDate: true},
+		},
+		"ModifyWithDefaultedApplyMethod": {
+			args: args{
+				desired: []svcapitypes.Parameter{
+					desired("max_connections", "200", nil),
+					desired("autocommit", "0", nil),
+					desired("innodb_buffer_pool_size", "1024", nil),
+				},
+				overrides: []*svcsdk.Parameter{observed(SourceUser, "max_connections", "100", applyMethodImmediate, applyTypeDynamic)},
+				defaults: defaults(
+					observed("engine-default", "autocommit", "1", "", applyTypeDynamic),
+					observed("engine-default", "innodb_buffer_pool_size", "512", "", "static"),
+				),
+			},
+			want: want{diff: ParameterDiff{
+				Modify: []*svcsdk.Parameter{
+					{ParameterName: awsclient.String("autocommit"), ParameterValue: awsclient.String("0"), ApplyMethod: awsclient.String(applyMethodImmediate)},
+					{ParameterName: awsclient.String("innodb_buffer_pool_size"), ParameterValue: awsclient.String("1024"), ApplyMethod: awsclient.String(applyMethodPendingReboot)},
+					{ParameterName: awsclient.String("max_connections"), ParameterValue: awsclient.String("200"), ApplyMethod: awsclient.String(applyMethodImmediate)},
+				},
+			}},
+		},
+		"ModifyApplyMethod": {
+			args: args{
+				desired:   []svcapitypes.Parameter{desired("max_connections", "100", awsclient.String(applyMethodPendingReboot))},
+				overrides: []*svcsdk.Parameter{observed(SourceUser, "max_connections", "100", applyMethodImmediate, applyTypeDynamic)},
+				defaults:  noDefaults,
+			},
+			want: want{diff: ParameterDiff{
+				Modify: []*svcsdk.Parameter{
+					{ParameterName: awsclient.String("max_connections"), ParameterValue: awsclient.String("100"), ApplyMethod: awsclient.String(applyMethodPendingReboot)},
+				},
+			}},
+		},
+		"ResetRemovedAndReportPendingReboot": {
+			args: args{
+				desired: []svcapitypes.Parameter{desired("innodb_buffer_pool_size", "1024", nil)},
+				overrides: []*svcsdk.Parameter{
+					observed(SourceUser, "max_connections", "100", applyMethodImmediate, applyTypeDynamic),
+					observed(SourceUser, "innodb_buffer_pool_size", "1024", applyMethodPendingReboot, "static"),
+				},
+				defaults: noDefaults,
+			},
+			want: want{diff: ParameterDiff{
+				Reset: []*svcsdk.Parameter{
+					{ParameterName: awsclient.String("max_connections"), ApplyMethod: awsclient.String(applyMethodImmediate)},
+				},
+				PendingReboot: []*string{awsclient.String("innodb_buffer_pool_size")},
+			}},
+		},
+		"MissingValue": {
+			args: args{
+				desired: []svcapitypes.Parameter{{ParameterName: awsclient.String("max_connections")}},
+			},
+			want: want{err: errors.New(errParameterFields)},
+		},
+		"EngineDefaultsError": {
+			args: args{
+				desired:  []svcapitypes.Parameter{desired("autocommit", "1", nil)},
+				defaults: func() ([]*svcsdk.Parameter, error) { return nil, errBoom },
+			},
+			want: want{err: errors.Wrap(errBoom, errEngineDefaultParams)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := DiffParameters(tc.args.desired, tc.args.overrides, tc.args.defaults)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("err: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.diff, diff); diff != "" {
+				t.Errorf("diff: -want, +got:\n%s", diff)
+			}
+			if tc.want.err == nil {
+				if diff := cmp.Diff(tc.want.upToDate, diff.UpToDate()); diff != "" {
+					t.Errorf("upToDate: -want, +got:\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reserveddbinstancecoverage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/rds/fake"
+)
+
+var errBoom = errors.New("boom")
+
+type coverageModifier func(*svcapitypes.ReservedDBInstanceCoverage)
+
+func withConditions(c ...xpv1.Condition) coverageModifier {
+	return func(r *svcapitypes.ReservedDBInstanceCoverage) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withAtProvider(o svcapitypes.ReservedDBInstanceCoverageObservation) coverageModifier {
+	return func(r *svcapitypes.ReservedDBInstanceCoverage) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() coverageModifier {
+	return func(r *svcapitypes.ReservedDBInstanceCoverage) {
+		now := metav1.Now()
+		r.SetDeletionTimestamp(&now)
+	}
+}
+
+func coverage(m ...coverageModifier) *svcapitypes.ReservedDBInstanceCoverage {
+	cr := &svcapitypes.ReservedDBInstanceCoverage{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	deleted := coverage(withDeletionTimestamp())
+
+	type args struct {
+		client *fake.MockReservationClient
+		cr     resource.Managed
+	}
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedDBInstances: func(context.Context, *awsrds.DescribeReservedDBInstancesInput, []func(*awsrds.Options)) (*awsrds.DescribeReservedDBInstancesOutput, error) {
+						return &awsrds.DescribeReservedDBInstancesOutput{}, nil
+					},
+					MockDescribeDBInstances: func(context.Context, *awsrds.DescribeDBInstancesInput, []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{DBInstances: []rdstypes.DBInstance{{
+							DBInstanceClass:  aws.String("db.t3.micro"),
+							DBInstanceStatus: aws.String("available"),
+						}}}, nil
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr: coverage(withConditions(xpv1.Available()), withAtProvider(svcapitypes.ReservedDBInstanceCoverageObservation{
+					Coverage: []svcapitypes.DBInstanceClassCoverage{{
+						DBInstanceClass:    "db.t3.micro",
+						RunningInstances:   1,
+						UncoveredInstances: 1,
+					}},
+					UncoveredInstances: 1,
+				})),
+				result: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockReservationClient{},
+				cr:     deleted,
+			},
+			want: want{
+				cr: deleted,
+			},
+		},
+		"ListReservedInstancesError": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedDBInstances: func(context.Context, *awsrds.DescribeReservedDBInstancesInput, []func(*awsrds.Options)) (*awsrds.DescribeReservedDBInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr:  coverage(),
+				err: awsclient.Wrap(errBoom, errListReservedInstances),
+			},
+		},
+		"ListDBInstancesError": {
+			args: args{
+				client: &fake.MockReservationClient{
+					MockDescribeReservedDBInstances: func(context.Context, *awsrds.DescribeReservedDBInstancesInput, []func(*awsrds.Options)) (*awsrds.DescribeReservedDBInstancesOutput, error) {
+						return &awsrds.DescribeReservedDBInstancesOutput{}, nil
+					},
+					MockDescribeDBInstances: func(context.Context, *awsrds.DescribeDBInstancesInput, []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: coverage(),
+			},
+			want: want{
+				cr:  coverage(),
+				err: awsclient.Wrap(errBoom, errListDBInstances),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.args.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -39,7 +36,9 @@ import (
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	"github.com/crossplane/provider-aws/pkg/clients/redshift"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -51,11 +50,18 @@ const (
 	errDeleteFailed     = "cannot delete Redshift cluster"
 	errDescribeFailed   = "cannot describe Redshift cluster"
 	errUpToDateFailed   = "cannot check whether object is up-to-date"
+	errRestoreFailed    = "cannot restore Redshift cluster from snapshot"
+	errPauseFailed      = "cannot pause Redshift cluster"
+	errResumeFailed     = "cannot resume Redshift cluster"
+	errGetPassword      = "cannot get master user password"
 )
 
 // SetupCluster adds a controller that reconciles Redshift clusters.
-func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
+func SetupCluster(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(redshiftv1alpha1.ClusterGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -64,15 +70,10 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&redshiftv1alpha1.Cluster{}).
-		Complete(managed.NewReconciler(
-			mgr, resource.ManagedKind(redshiftv1alpha1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(redshiftv1alpha1.ClusterGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: redshift.NewClient},
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -134,6 +135,8 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		cr.Status.SetConditions(xpv1.Creating())
 	case redshiftv1alpha1.StateDeleting:
 		cr.Status.SetConditions(xpv1.Deleting())
+	case redshiftv1alpha1.StatePaused:
+		cr.Status.SetConditions(xpv1.Unavailable().WithMessage("cluster is paused"))
 	default:
 		cr.Status.SetConditions(xpv1.Unavailable())
 	}
@@ -142,9 +145,13 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if err != nil {
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
+	_, pwdChanged, err := redshift.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetPassword)
+	}
 
 	return managed.ExternalObservation{
-		ResourceUpToDate:  updated,
+		ResourceUpToDate:  updated && !pwdChanged,
 		ResourceExists:    true,
 		ConnectionDetails: redshift.GetConnectionDetails(*cr),
 	}, nil
@@ -159,9 +166,21 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.ClusterStatus == redshiftv1alpha1.StateCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	pw, err := password.Generate()
+	// A restored cluster keeps the master credentials of the snapshot. If a
+	// password secret is referenced, it is applied by the first Update.
+	if cr.Spec.ForProvider.SnapshotIdentifier != nil {
+		_, err := e.client.RestoreFromClusterSnapshot(ctx, redshift.GenerateRestoreFromClusterSnapshotInput(&cr.Spec.ForProvider, aws.String(meta.GetExternalName(cr))))
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errRestoreFailed)
+	}
+	pw, _, err := redshift.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
-		return managed.ExternalCreation{}, err
+		return managed.ExternalCreation{}, errors.Wrap(err, errGetPassword)
+	}
+	if pw == "" {
+		pw, err = password.Generate()
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
 	}
 	input := redshift.GenerateCreateClusterInput(&cr.Spec.ForProvider, aws.String(meta.GetExternalName(cr)), aws.String(pw))
 	_, err = e.client.CreateCluster(ctx, input)
@@ -193,8 +212,44 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(resource.Ignore(redshift.IsNotFound, err), errDescribeFailed)
 	}
+	cl := rsp.Clusters[0]
+	id := aws.String(meta.GetExternalName(cr))
+
+	// A paused cluster cannot be modified, so it is resumed before anything
+	// else and paused only once all other changes are applied.
+	if aws.ToString(cl.ClusterStatus) == redshiftv1alpha1.StatePaused && !redshift.IsPauseUpToDate(cr.Spec.ForProvider, cl) {
+		_, err := e.client.ResumeCluster(ctx, &awsredshift.ResumeClusterInput{ClusterIdentifier: id})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errResumeFailed)
+	}
+
+	pw, pwdChanged, err := redshift.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errGetPassword)
+	}
+	if pwdChanged {
+		if _, err := e.client.ModifyCluster(ctx, &awsredshift.ModifyClusterInput{ClusterIdentifier: id, MasterUserPassword: aws.String(pw)}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
+		}
+		return managed.ExternalUpdate{ConnectionDetails: managed.ConnectionDetails{
+			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+		}}, nil
+	}
+
+	p := cr.Spec.ForProvider.DeepCopy()
+	p.Paused = nil
+	upToDate, err := redshift.IsUpToDate(*p, cl)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpToDateFailed)
+	}
+	if upToDate {
+		if redshift.IsPauseUpToDate(cr.Spec.ForProvider, cl) {
+			return managed.ExternalUpdate{}, nil
+		}
+		_, err := e.client.PauseCluster(ctx, &awsredshift.PauseClusterInput{ClusterIdentifier: id})
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errPauseFailed)
+	}
 
-	_, err = e.client.ModifyCluster(ctx, redshift.GenerateModifyClusterInput(&cr.Spec.ForProvider, rsp.Clusters[0]))
+	_, err = e.client.ModifyCluster(ctx, redshift.GenerateModifyClusterInput(&cr.Spec.ForProvider, cl))
 
 	if err == nil && aws.ToString(cr.Spec.ForProvider.NewClusterIdentifier) != meta.GetExternalName(cr) {
 		meta.SetExternalName(cr, aws.ToString(cr.Spec.ForProvider.NewClusterIdentifier))
@@ -24,6 +24,7 @@ import (
 	awsredshifttypes "github.com/aws/aws-sdk-go-v2/service/redshift/types"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -45,8 +46,23 @@ var (
 	singleNode        = "single-node"
 	name              = "redshift-test"
 	vpcSecurityGroups = []awsredshifttypes.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("id-sg")}}
+	pwdSecretRef      = xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{Name: "pwd", Namespace: "default"},
+		Key:             "password",
+	}
 )
 
+func upToDateCluster(status string) awsredshifttypes.Cluster {
+	return awsredshifttypes.Cluster{
+		ClusterStatus:     aws.String(status),
+		NumberOfNodes:     1,
+		ClusterIdentifier: &name,
+		MasterUsername:    &masterUsername,
+		NodeType:          &nodeType,
+		VpcSecurityGroups: vpcSecurityGroups,
+	}
+}
+
 type args struct {
 	redshift redshift.Client
 	kube     client.Client
@@ -75,6 +91,18 @@ func withNewExternalName(s string) redshiftModifier {
 	return func(r *v1alpha1.Cluster) { meta.SetExternalName(r, s) }
 }
 
+func withPaused(b bool) redshiftModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.Paused = aws.Bool(b) }
+}
+
+func withSnapshotIdentifier(s string) redshiftModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.SnapshotIdentifier = aws.String(s) }
+}
+
+func withPasswordSecretRef(s xpv1.SecretKeySelector) redshiftModifier {
+	return func(r *v1alpha1.Cluster) { r.Spec.ForProvider.MasterUserPasswordSecretRef = &s }
+}
+
 func cluster(m ...redshiftModifier) *v1alpha1.Cluster {
 	cr := &v1alpha1.Cluster{
 		Spec: v1alpha1.ClusterSpec{
@@ -321,6 +349,70 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulWithPasswordSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("secret")}
+						return nil
+					},
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockCreate: func(ctx context.Context, input *awsredshift.CreateClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.CreateClusterOutput, error) {
+						if aws.ToString(input.MasterUserPassword) != "secret" {
+							return nil, errBoom
+						}
+						return &awsredshift.CreateClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withPasswordSecretRef(pwdSecretRef)),
+			},
+			want: want{
+				cr: cluster(
+					withPasswordSecretRef(pwdSecretRef),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("secret"),
+					},
+				},
+			},
+		},
+		"SuccessfulRestore": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockRestore: func(ctx context.Context, input *awsredshift.RestoreFromClusterSnapshotInput, opts []func(*awsredshift.Options)) (*awsredshift.RestoreFromClusterSnapshotOutput, error) {
+						if aws.ToString(input.SnapshotIdentifier) != "snap" {
+							return nil, errBoom
+						}
+						return &awsredshift.RestoreFromClusterSnapshotOutput{}, nil
+					},
+				},
+				cr: cluster(withSnapshotIdentifier("snap")),
+			},
+			want: want{
+				cr: cluster(
+					withSnapshotIdentifier("snap"),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"FailedRestore": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockRestore: func(ctx context.Context, input *awsredshift.RestoreFromClusterSnapshotInput, opts []func(*awsredshift.Options)) (*awsredshift.RestoreFromClusterSnapshotOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withSnapshotIdentifier("snap")),
+			},
+			want: want{
+				cr: cluster(
+					withSnapshotIdentifier("snap"),
+					withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errRestoreFailed),
+			},
+		},
 		"SuccessfulNoNeedForCreate": {
 			args: args{
 				cr: cluster(withClusterStatus(v1alpha1.StateCreating)),
@@ -401,6 +493,99 @@ func TestUpdate(t *testing.T) {
 				cr: cluster(withNewClusterIdentifier("update"), withNewExternalName("update")),
 			},
 		},
+		"Pause": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{upToDateCluster(v1alpha1.StateAvailable)},
+						}, nil
+					},
+					MockPause: func(ctx context.Context, input *awsredshift.PauseClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.PauseClusterOutput, error) {
+						return &awsredshift.PauseClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withPaused(true)),
+			},
+			want: want{
+				cr: cluster(withPaused(true)),
+			},
+		},
+		"FailedPause": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{upToDateCluster(v1alpha1.StateAvailable)},
+						}, nil
+					},
+					MockPause: func(ctx context.Context, input *awsredshift.PauseClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.PauseClusterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(withPaused(true)),
+			},
+			want: want{
+				cr:  cluster(withPaused(true)),
+				err: awsclient.Wrap(errBoom, errPauseFailed),
+			},
+		},
+		"Resume": {
+			args: args{
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{upToDateCluster(v1alpha1.StatePaused)},
+						}, nil
+					},
+					MockResume: func(ctx context.Context, input *awsredshift.ResumeClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.ResumeClusterOutput, error) {
+						return &awsredshift.ResumeClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withPaused(false)),
+			},
+			want: want{
+				cr: cluster(withPaused(false)),
+			},
+		},
+		"PasswordChanged": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+						if key.Name == pwdSecretRef.Name {
+							obj.(*corev1.Secret).Data = map[string][]byte{"password": []byte("new")}
+						}
+						return nil
+					},
+				},
+				redshift: &fake.MockRedshiftClient{
+					MockDescribe: func(ctx context.Context, input *awsredshift.DescribeClustersInput, opts []func(*awsredshift.Options)) (*awsredshift.DescribeClustersOutput, error) {
+						return &awsredshift.DescribeClustersOutput{
+							Clusters: []awsredshifttypes.Cluster{upToDateCluster(v1alpha1.StateAvailable)},
+						}, nil
+					},
+					MockModify: func(ctx context.Context, input *awsredshift.ModifyClusterInput, opts []func(*awsredshift.Options)) (*awsredshift.ModifyClusterOutput, error) {
+						if aws.ToString(input.MasterUserPassword) != "new" {
+							return nil, errBoom
+						}
+						return &awsredshift.ModifyClusterOutput{}, nil
+					},
+				},
+				cr: cluster(withPasswordSecretRef(pwdSecretRef), func(r *v1alpha1.Cluster) {
+					r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "conn", Namespace: "default"}
+				}),
+			},
+			want: want{
+				cr: cluster(withPasswordSecretRef(pwdSecretRef), func(r *v1alpha1.Cluster) {
+					r.Spec.WriteConnectionSecretToReference = &xpv1.SecretReference{Name: "conn", Namespace: "default"}
+				}),
+				result: managed.ExternalUpdate{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte("new"),
+					},
+				},
+			},
+		},
 		"AlreadyModifying": {
 			args: args{
 				cr: cluster(withClusterStatus(v1alpha1.StateModifying)),
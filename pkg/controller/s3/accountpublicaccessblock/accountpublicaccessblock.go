@@ -0,0 +1,162 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountpublicaccessblock
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3control"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/singleton"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an AccountPublicAccessBlock resource"
+
+	errGet    = "cannot get account public access block in AWS"
+	errPut    = "cannot put account public access block in AWS"
+	errDelete = "cannot delete account public access block in AWS"
+)
+
+// SetupAccountPublicAccessBlock adds a controller that reconciles
+// AccountPublicAccessBlock.
+func SetupAccountPublicAccessBlock(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha3.AccountPublicAccessBlockGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha3.AccountPublicAccessBlock{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha3.AccountPublicAccessBlockGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: s3.NewAccountPublicAccessBlockClient},
+			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient())),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) s3.AccountPublicAccessBlockClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.AccountPublicAccessBlock)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{kube: c.kube, client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	kube   client.Client
+	client s3.AccountPublicAccessBlockClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha3.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	bound, err := singleton.Guard(ctx, e.kube, cr, &v1alpha3.AccountPublicAccessBlockList{})
+	if singleton.IsConflict(err) && meta.WasDeleted(cr) {
+		// The public access block is managed by another
+		// AccountPublicAccessBlock, so deleting this one must leave it alone.
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	resp, err := e.client.GetPublicAccessBlockWithContext(ctx, &s3control.GetPublicAccessBlockInput{
+		AccountId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(s3.AccountPublicAccessBlockNotFound, err), errGet)
+	}
+
+	cr.SetConditions(xpv1.Available())
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        s3.IsAccountPublicAccessBlockUpToDate(cr.Spec.ForProvider, resp.PublicAccessBlockConfiguration),
+		ResourceLateInitialized: bound,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha3.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalCreation{}, e.put(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha3.AccountPublicAccessBlock)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.put(ctx, cr)
+}
+
+func (e *external) put(ctx context.Context, cr *v1alpha3.AccountPublicAccessBlock) error {
+	_, err := e.client.PutPublicAccessBlockWithContext(ctx, &s3control.PutPublicAccessBlockInput{
+		AccountId:                      aws.String(meta.GetExternalName(cr)),
+		PublicAccessBlockConfiguration: s3.GenerateAccountPublicAccessBlockConfiguration(cr.Spec.ForProvider),
+	})
+	return awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha3.AccountPublicAccessBlock)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeletePublicAccessBlockWithContext(ctx, &s3control.DeletePublicAccessBlockInput{
+		AccountId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(s3.AccountPublicAccessBlockNotFound, err), errDelete)
+}
@@ -45,14 +45,16 @@ func NewCORSConfigurationClient(client s3.BucketClient) *CORSConfigurationClient
 
 // Observe checks if the resource exists and if it matches the local configuration
 func (in *CORSConfigurationClient) Observe(ctx context.Context, bucket *v1beta1.Bucket) (ResourceStatus, error) {
+	// The CORS configuration may be managed by a standalone
+	// BucketCORSConfiguration, so we leave it alone if it isn't specified.
+	if bucket.Spec.ForProvider.CORSConfiguration == nil {
+		return Updated, nil
+	}
 	result, err := in.client.GetBucketCors(ctx, &awss3.GetBucketCorsInput{Bucket: awsclient.String(meta.GetExternalName(bucket))})
 	if resource.Ignore(s3.CORSConfigurationNotFound, err) != nil {
 		return NeedsUpdate, awsclient.Wrap(err, corsGetFailed)
 	}
-	var local []v1beta1.CORSRule
-	if bucket.Spec.ForProvider.CORSConfiguration != nil {
-		local = bucket.Spec.ForProvider.CORSConfiguration.CORSRules
-	}
+	local := bucket.Spec.ForProvider.CORSConfiguration.CORSRules
 	var external []types.CORSRule
 	if result != nil {
 		external = result.CORSRules
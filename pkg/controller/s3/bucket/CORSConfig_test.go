@@ -106,7 +106,21 @@ func TestCORSObserve(t *testing.T) {
 		},
 		"NeedsDelete": {
 			args: args{
-				b: s3testing.Bucket(s3testing.WithCORSConfig(nil)),
+				b: s3testing.Bucket(s3testing.WithCORSConfig(&v1beta1.CORSConfiguration{})),
+				cl: NewCORSConfigurationClient(fake.MockBucketClient{
+					MockGetBucketCors: func(ctx context.Context, input *s3.GetBucketCorsInput, opts []func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+						return &s3.GetBucketCorsOutput{CORSRules: generateAWSCORS().CORSRules}, nil
+					},
+				}),
+			},
+			want: want{
+				status: NeedsDeletion,
+				err:    nil,
+			},
+		},
+		"NeedsDeleteEmptyRules": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithCORSConfig(&v1beta1.CORSConfiguration{CORSRules: []v1beta1.CORSRule{}})),
 				cl: NewCORSConfigurationClient(fake.MockBucketClient{
 					MockGetBucketCors: func(ctx context.Context, input *s3.GetBucketCorsInput, opts []func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
 						return &s3.GetBucketCorsOutput{CORSRules: generateAWSCORS().CORSRules}, nil
@@ -118,6 +132,20 @@ func TestCORSObserve(t *testing.T) {
 				err:    nil,
 			},
 		},
+		"NotSpecified": {
+			args: args{
+				b: s3testing.Bucket(s3testing.WithCORSConfig(nil)),
+				cl: NewCORSConfigurationClient(fake.MockBucketClient{
+					MockGetBucketCors: func(ctx context.Context, input *s3.GetBucketCorsInput, opts []func(*s3.Options)) (*s3.GetBucketCorsOutput, error) {
+						return &s3.GetBucketCorsOutput{CORSRules: generateAWSCORS().CORSRules}, nil
+					},
+				}),
+			},
+			want: want{
+				status: Updated,
+				err:    nil,
+			},
+		},
 		"NoUpdateNotExists": {
 			args: args{
 				b: s3testing.Bucket(s3testing.WithCORSConfig(nil)),
@@ -19,6 +19,7 @@ package bucket
 import (
 	"context"
 	"sort"
+	"strings"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -59,74 +60,74 @@ func (in *NotificationConfigurationClient) Observe(ctx context.Context, bucket *
 	return IsNotificationConfigurationUpToDate(bucket.Spec.ForProvider.NotificationConfiguration, external)
 }
 
-// IsNotificationConfigurationUpToDate determines whether a notification configuration needs to be updated
-func IsNotificationConfigurationUpToDate(cr *v1beta1.NotificationConfiguration, external *awss3.GetBucketNotificationConfigurationOutput) (ResourceStatus, error) { // nolint:gocyclo
+// IsNotificationConfigurationUpToDate determines whether a notification
+// configuration needs to be updated. AWS replaces the whole notification
+// configuration document of a bucket on every put, so the desired document is
+// compared with the observed one as a whole. The order of configurations,
+// events and filter rules is not significant, and neither are the IDs AWS
+// assigns to configurations.
+func IsNotificationConfigurationUpToDate(cr *v1beta1.NotificationConfiguration, external *awss3.GetBucketNotificationConfigurationOutput) (ResourceStatus, error) {
 	// Note - aws API treats nil configuration different than empty configuration
-	// As such, we can't prealloc this due to the API
 	// If no configuration is defined but there is one in aws, we must delete it
-	if cr == nil && (len(external.QueueConfigurations) != 0 || len(external.LambdaFunctionConfigurations) != 0 || len(external.TopicConfigurations) != 0) {
-		return NeedsDeletion, nil
-	}
-	// We can't prealloc this for the API but we can to make comparison easier
 	if cr == nil {
-		cr = &v1beta1.NotificationConfiguration{
-			LambdaFunctionConfigurations: []v1beta1.LambdaFunctionConfiguration{},
-			QueueConfigurations:          []v1beta1.QueueConfiguration{},
-			TopicConfigurations:          []v1beta1.TopicConfiguration{},
+		if emptyConfiguration(external) {
+			return Updated, nil
 		}
-	}
-	// If any of the lengths in aws are different then there is something to delete
-	if len(cr.LambdaFunctionConfigurations) < len(external.LambdaFunctionConfigurations) || len(cr.QueueConfigurations) < len(external.QueueConfigurations) || len(cr.TopicConfigurations) < len(external.TopicConfigurations) {
 		return NeedsDeletion, nil
 	}
 
-	// Convert to a comparable object
-	generated := GenerateConfiguration(cr)
-
-	// Sort everything
-	sortLambda(generated.LambdaFunctionConfigurations)
-	sortLambda(external.LambdaFunctionConfigurations)
-
-	sortQueue(generated.QueueConfigurations)
-	sortQueue(external.QueueConfigurations)
-
-	sortTopic(generated.TopicConfigurations)
-	sortTopic(external.TopicConfigurations)
-
-	if cmp.Equal(external.LambdaFunctionConfigurations, generated.LambdaFunctionConfigurations, cmpopts.IgnoreTypes(document.NoSerde{}, types.LambdaFunctionConfiguration{}.Id), cmpopts.EquateEmpty()) &&
-		cmp.Equal(external.QueueConfigurations, generated.QueueConfigurations, cmpopts.IgnoreTypes(document.NoSerde{}, types.QueueConfiguration{}.Id), cmpopts.EquateEmpty()) &&
-		cmp.Equal(external.TopicConfigurations, generated.TopicConfigurations, cmpopts.IgnoreTypes(document.NoSerde{}, types.TopicConfiguration{}.Id), cmpopts.EquateEmpty()) {
+	observed := &types.NotificationConfiguration{
+		LambdaFunctionConfigurations: external.LambdaFunctionConfigurations,
+		QueueConfigurations:          external.QueueConfigurations,
+		TopicConfigurations:          external.TopicConfigurations,
+	}
+	if cmp.Equal(GenerateConfiguration(cr), observed,
+		cmpopts.IgnoreTypes(document.NoSerde{}),
+		cmpopts.IgnoreFields(types.LambdaFunctionConfiguration{}, "Id"),
+		cmpopts.IgnoreFields(types.QueueConfiguration{}, "Id"),
+		cmpopts.IgnoreFields(types.TopicConfiguration{}, "Id"),
+		cmpopts.EquateEmpty(),
+		// AWS returns the names of filter rules capitalized.
+		cmp.Comparer(func(a, b types.FilterRuleName) bool { return strings.EqualFold(string(a), string(b)) }),
+		cmpopts.SortSlices(func(a, b types.Event) bool { return a < b }),
+		cmpopts.SortSlices(func(a, b types.FilterRule) bool { return filterRuleKey(a) < filterRuleKey(b) }),
+		cmpopts.SortSlices(func(a, b types.LambdaFunctionConfiguration) bool {
+			return notificationKey(a.LambdaFunctionArn, a.Events, a.Filter) < notificationKey(b.LambdaFunctionArn, b.Events, b.Filter)
+		}),
+		cmpopts.SortSlices(func(a, b types.QueueConfiguration) bool {
+			return notificationKey(a.QueueArn, a.Events, a.Filter) < notificationKey(b.QueueArn, b.Events, b.Filter)
+		}),
+		cmpopts.SortSlices(func(a, b types.TopicConfiguration) bool {
+			return notificationKey(a.TopicArn, a.Events, a.Filter) < notificationKey(b.TopicArn, b.Events, b.Filter)
+		}),
+	) {
 		return Updated, nil
 	}
 
 	return NeedsUpdate, nil
 }
 
-func sortLambda(configs []types.LambdaFunctionConfiguration) {
-	sort.Slice(configs, func(i, j int) bool {
-		if a, b := configs[i].LambdaFunctionArn, configs[j].LambdaFunctionArn; a != b {
-			return aws.ToString(a) < aws.ToString(b)
-		}
-		return true
-	})
-}
-
-func sortQueue(configs []types.QueueConfiguration) {
-	sort.Slice(configs, func(i, j int) bool {
-		if a, b := configs[i].QueueArn, configs[j].QueueArn; a != b {
-			return aws.ToString(a) < aws.ToString(b)
-		}
-		return true
-	})
+func filterRuleKey(r types.FilterRule) string {
+	return strings.ToLower(string(r.Name)) + "=" + aws.ToString(r.Value)
 }
 
-func sortTopic(configs []types.TopicConfiguration) {
-	sort.Slice(configs, func(i, j int) bool {
-		if a, b := configs[i].TopicArn, configs[j].TopicArn; a != b {
-			return aws.ToString(a) < aws.ToString(b)
+// notificationKey returns a key that orders notification configurations by
+// their destination, and configurations of the same destination by their
+// events and filter rules.
+func notificationKey(arn *string, events []types.Event, filter *types.NotificationConfigurationFilter) string {
+	e := make([]string, len(events))
+	for i, v := range events {
+		e[i] = string(v)
+	}
+	sort.Strings(e)
+	var f []string
+	if filter != nil && filter.Key != nil {
+		for _, r := range filter.Key.FilterRules {
+			f = append(f, filterRuleKey(r))
 		}
-		return true
-	})
+	}
+	sort.Strings(f)
+	return strings.Join([]string{aws.ToString(arn), strings.Join(e, ","), strings.Join(f, ",")}, "|")
 }
 
 // GenerateLambdaConfiguration creates []awss3.LambdaFunctionConfiguration from the local NotificationConfiguration
@@ -581,7 +581,81 @@ func TestIsNotificationConfigurationUpToDate(t *testing.T) {
 				isUpToDate: 1,
 			},
 		},
-		"IsUpToDateExtraNeedsDeletion": {
+		"IsUpToDateEventsAndFiltersOutOfOrder": {
+			args: args{
+				cr: &v1beta1.NotificationConfiguration{
+					QueueConfigurations: []v1beta1.QueueConfiguration{{
+						Events: []string{"s3:ObjectCreated:*", "s3:ObjectRemoved:*"},
+						Filter: &v1beta1.NotificationConfigurationFilter{Key: &v1beta1.S3KeyFilter{FilterRules: []v1beta1.FilterRule{
+							{Name: "prefix", Value: awsclient.String("images/")},
+							{Name: "suffix", Value: awsclient.String(".jpg")},
+						}}},
+						QueueArn: awsclient.String(queueArn),
+					}},
+				},
+				b: &s3.GetBucketNotificationConfigurationOutput{
+					QueueConfigurations: []s3types.QueueConfiguration{{
+						Events: []s3types.Event{"s3:ObjectRemoved:*", "s3:ObjectCreated:*"},
+						Filter: &s3types.NotificationConfigurationFilter{Key: &s3types.S3KeyFilter{FilterRules: []s3types.FilterRule{
+							{Name: "Suffix", Value: awsclient.String(".jpg")},
+							{Name: "Prefix", Value: awsclient.String("images/")},
+						}}},
+						Id:       &id,
+						QueueArn: &queueArn,
+					}},
+				},
+			},
+			want: want{
+				isUpToDate: Updated,
+			},
+		},
+		"IsUpToDateFalseFilterChanged": {
+			args: args{
+				cr: &v1beta1.NotificationConfiguration{
+					TopicConfigurations: []v1beta1.TopicConfiguration{{
+						Events: generateNotificationEvents(),
+						Filter: &v1beta1.NotificationConfigurationFilter{Key: &v1beta1.S3KeyFilter{FilterRules: []v1beta1.FilterRule{
+							{Name: "suffix", Value: awsclient.String(".png")},
+						}}},
+						TopicArn: &topicArn,
+					}},
+				},
+				b: &s3.GetBucketNotificationConfigurationOutput{
+					TopicConfigurations: []s3types.TopicConfiguration{{
+						Events: generateNotificationAWSEvents(),
+						Filter: &s3types.NotificationConfigurationFilter{Key: &s3types.S3KeyFilter{FilterRules: []s3types.FilterRule{
+							{Name: "Suffix", Value: awsclient.String(".jpg")},
+						}}},
+						TopicArn: &topicArn,
+					}},
+				},
+			},
+			want: want{
+				isUpToDate: NeedsUpdate,
+			},
+		},
+		"IsUpToDateFalseSameDestinationDifferentEvents": {
+			args: args{
+				cr: &v1beta1.NotificationConfiguration{
+					LambdaFunctionConfigurations: []v1beta1.LambdaFunctionConfiguration{
+						{Events: []string{"s3:ObjectCreated:*"}, LambdaFunctionArn: lambdaArn},
+						{Events: []string{"s3:ObjectRemoved:*"}, LambdaFunctionArn: lambdaArn},
+					},
+				},
+				b: &s3.GetBucketNotificationConfigurationOutput{
+					LambdaFunctionConfigurations: []s3types.LambdaFunctionConfiguration{
+						{Events: []s3types.Event{"s3:ObjectRemoved:*"}, LambdaFunctionArn: &lambdaArn},
+						{Events: []s3types.Event{"s3:ObjectRestore:*"}, LambdaFunctionArn: &lambdaArn},
+					},
+				},
+			},
+			want: want{
+				isUpToDate: NeedsUpdate,
+			},
+		},
+		// The whole document is put on update, which removes the extra
+		// configurations.
+		"IsUpToDateExtraNeedsUpdate": {
 			args: args{
 				cr: &v1beta1.NotificationConfiguration{},
 				b: &s3.GetBucketNotificationConfigurationOutput{
@@ -594,7 +668,7 @@ func TestIsNotificationConfigurationUpToDate(t *testing.T) {
 				},
 			},
 			want: want{
-				isUpToDate: 2,
+				isUpToDate: 1,
 			},
 		},
 	}
@@ -100,6 +100,10 @@ func (in *PublicAccessBlockClient) Delete(ctx context.Context, cr *v1beta1.Bucke
 
 // LateInitialize is responsible for initializing the resource based on the external value
 func (in *PublicAccessBlockClient) LateInitialize(ctx context.Context, cr *v1beta1.Bucket) error {
+	if awsclient.BoolValue(cr.Spec.ForProvider.EnforceSecureDefaults) {
+		enforceSecureDefaults(cr)
+		return nil
+	}
 	external, err := in.client.GetPublicAccessBlock(ctx, &awss3.GetPublicAccessBlockInput{Bucket: awsclient.String(meta.GetExternalName(cr))})
 	if err != nil {
 		return awsclient.Wrap(resource.Ignore(s3.PublicAccessBlockConfigurationNotFound, err), publicAccessBlockGetFailed)
@@ -120,5 +124,18 @@ func (in *PublicAccessBlockClient) LateInitialize(ctx context.Context, cr *v1bet
 
 // SubresourceExists checks if the subresource this controller manages currently exists
 func (in *PublicAccessBlockClient) SubresourceExists(cr *v1beta1.Bucket) bool {
-	return cr.Spec.ForProvider.PublicAccessBlockConfiguration != nil
+	return cr.Spec.ForProvider.PublicAccessBlockConfiguration != nil || awsclient.BoolValue(cr.Spec.ForProvider.EnforceSecureDefaults)
+}
+
+// enforceSecureDefaults blocks every kind of public access that is not
+// explicitly configured in the public access block of the bucket.
+func enforceSecureDefaults(cr *v1beta1.Bucket) {
+	if cr.Spec.ForProvider.PublicAccessBlockConfiguration == nil {
+		cr.Spec.ForProvider.PublicAccessBlockConfiguration = &v1beta1.PublicAccessBlockConfiguration{}
+	}
+	c := cr.Spec.ForProvider.PublicAccessBlockConfiguration
+	c.BlockPublicAcls = awsclient.LateInitializeBoolPtr(c.BlockPublicAcls, awsclient.Bool(true))
+	c.BlockPublicPolicy = awsclient.LateInitializeBoolPtr(c.BlockPublicPolicy, awsclient.Bool(true))
+	c.RestrictPublicBuckets = awsclient.LateInitializeBoolPtr(c.RestrictPublicBuckets, awsclient.Bool(true))
+	c.IgnorePublicAcls = awsclient.LateInitializeBoolPtr(c.IgnorePublicAcls, awsclient.Bool(true))
 }
@@ -15,6 +15,7 @@ import (
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
 	clients3 "github.com/crossplane/provider-aws/pkg/clients/s3"
 	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+	s3testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
 )
 
 func TestPublicAccessBlockClient_Observe(t *testing.T) {
@@ -308,3 +309,76 @@ func TestPublicAccessBlockClient_LateInitialize(t *testing.T) {
 		})
 	}
 }
+
+func TestPublicAccessBlockClient_EnforceSecureDefaults(t *testing.T) {
+	type args struct {
+		cl *PublicAccessBlockClient
+		cr *v1beta1.Bucket
+	}
+
+	type want struct {
+		cr  *v1beta1.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"BlockAll": {
+			args: args{
+				cr: s3testing.Bucket(s3testing.WithEnforceSecureDefaults(true)),
+				cl: NewPublicAccessBlockClient(fake.MockBucketClient{
+					MockGetPublicAccessBlock: func(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+						return &s3.GetPublicAccessBlockOutput{}, &smithy.GenericAPIError{Code: clients3.PublicAccessBlockNotFoundErrCode}
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithEnforceSecureDefaults(true), s3testing.WithPublicAccessBlockConfig(&v1beta1.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       awsclient.Bool(true),
+					BlockPublicPolicy:     awsclient.Bool(true),
+					RestrictPublicBuckets: awsclient.Bool(true),
+					IgnorePublicAcls:      awsclient.Bool(true),
+				})),
+			},
+		},
+		"KeepExplicitSettings": {
+			args: args{
+				cr: s3testing.Bucket(s3testing.WithEnforceSecureDefaults(true), s3testing.WithPublicAccessBlockConfig(&v1beta1.PublicAccessBlockConfiguration{
+					BlockPublicPolicy: awsclient.Bool(false, awsclient.FieldRequired),
+				})),
+				cl: NewPublicAccessBlockClient(fake.MockBucketClient{
+					MockGetPublicAccessBlock: func(ctx context.Context, input *s3.GetPublicAccessBlockInput, opts []func(*s3.Options)) (*s3.GetPublicAccessBlockOutput, error) {
+						return &s3.GetPublicAccessBlockOutput{
+							PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{},
+						}, nil
+					},
+				}),
+			},
+			want: want{
+				cr: s3testing.Bucket(s3testing.WithEnforceSecureDefaults(true), s3testing.WithPublicAccessBlockConfig(&v1beta1.PublicAccessBlockConfiguration{
+					BlockPublicAcls:       awsclient.Bool(true),
+					BlockPublicPolicy:     awsclient.Bool(false, awsclient.FieldRequired),
+					RestrictPublicBuckets: awsclient.Bool(true),
+					IgnorePublicAcls:      awsclient.Bool(true),
+				})),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.args.cl.LateInitialize(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if !tc.args.cl.SubresourceExists(tc.args.cr) {
+				t.Errorf("SubresourceExists: expected true")
+			}
+		})
+	}
+}
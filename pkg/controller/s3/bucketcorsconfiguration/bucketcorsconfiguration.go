@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketcorsconfiguration
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/controller/s3/bucket"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a BucketCORSConfiguration resource"
+	errGet              = "failed to get the CORS configuration of bucket"
+	errPut              = "failed to put the CORS configuration of bucket"
+	errDelete           = "failed to delete the CORS configuration of bucket"
+)
+
+// SetupBucketCORSConfiguration adds a controller that reconciles
+// BucketCORSConfigurations.
+func SetupBucketCORSConfiguration(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha3.BucketCORSConfigurationGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha3.BucketCORSConfiguration{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha3.BucketCORSConfigurationGroupVersionKind),
+			&connector{kube: mgr.GetClient(),
+				newClientFn: s3.NewBucketCORSClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) s3.BucketCORSClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha3.BucketCORSConfiguration)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.Parameters.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client s3.BucketCORSClient
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha3.BucketCORSConfiguration)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	resp, err := e.client.GetBucketCors(ctx, &awss3.GetBucketCorsInput{
+		Bucket: cr.Spec.Parameters.BucketName,
+	})
+	if s3.IsErrorBucketNotFound(err) || s3.CORSConfigurationNotFound(err) {
+		return managed.ExternalObservation{}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: bucket.CompareCORS(cr.Spec.Parameters.CORSRules, resp.CORSRules) == bucket.Updated,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha3.BucketCORSConfiguration)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha3.BucketCORSConfiguration)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, awsclient.Wrap(e.put(ctx, cr), errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha3.BucketCORSConfiguration)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteBucketCors(ctx, &awss3.DeleteBucketCorsInput{Bucket: cr.Spec.Parameters.BucketName})
+	if s3.IsErrorBucketNotFound(err) {
+		return nil
+	}
+	return awsclient.Wrap(resource.Ignore(s3.CORSConfigurationNotFound, err), errDelete)
+}
+
+func (e *external) put(ctx context.Context, cr *v1alpha3.BucketCORSConfiguration) error {
+	input := bucket.GeneratePutBucketCorsInput(aws.ToString(cr.Spec.Parameters.BucketName), &v1beta1.CORSConfiguration{CORSRules: cr.Spec.Parameters.CORSRules})
+	_, err := e.client.PutBucketCors(ctx, input)
+	return err
+}
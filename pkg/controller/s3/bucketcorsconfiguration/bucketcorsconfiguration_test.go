@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bucketcorsconfiguration
+
+import (
+	"context"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+
+	rules = []v1beta1.CORSRule{{
+		AllowedMethods: []string{"GET"},
+		AllowedOrigins: []string{"https://example.com"},
+		MaxAgeSeconds:  3000,
+	}}
+	awsRules = []s3types.CORSRule{{
+		AllowedMethods: []string{"GET"},
+		AllowedOrigins: []string{"https://example.com"},
+		MaxAgeSeconds:  3000,
+	}}
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3 s3.BucketCORSClient
+	cr resource.Managed
+}
+
+type corsModifier func(*v1alpha3.BucketCORSConfiguration)
+
+func withConditions(c ...xpv1.Condition) corsModifier {
+	return func(r *v1alpha3.BucketCORSConfiguration) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func corsConfiguration(m ...corsModifier) *v1alpha3.BucketCORSConfiguration {
+	cr := &v1alpha3.BucketCORSConfiguration{
+		Spec: v1alpha3.BucketCORSConfigurationSpec{
+			Parameters: v1alpha3.BucketCORSConfigurationParameters{
+				BucketName: &bucketName,
+				CORSRules:  rules,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockGetBucketCors: func(ctx context.Context, input *awss3.GetBucketCorsInput, opts []func(*awss3.Options)) (*awss3.GetBucketCorsOutput, error) {
+						return &awss3.GetBucketCorsOutput{CORSRules: awsRules}, nil
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockGetBucketCors: func(ctx context.Context, input *awss3.GetBucketCorsInput, opts []func(*awss3.Options)) (*awss3.GetBucketCorsOutput, error) {
+						return &awss3.GetBucketCorsOutput{CORSRules: []s3types.CORSRule{{AllowedMethods: []string{"PUT"}}}}, nil
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockGetBucketCors: func(ctx context.Context, input *awss3.GetBucketCorsInput, opts []func(*awss3.Options)) (*awss3.GetBucketCorsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr:  corsConfiguration(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockGetBucketCors: func(ctx context.Context, input *awss3.GetBucketCorsInput, opts []func(*awss3.Options)) (*awss3.GetBucketCorsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: s3.CORSNotFoundErrCode}
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockPutBucketCors: func(ctx context.Context, input *awss3.PutBucketCorsInput, opts []func(*awss3.Options)) (*awss3.PutBucketCorsOutput, error) {
+						if diff := cmp.Diff(awsRules, input.CORSConfiguration.CORSRules, cmpopts.IgnoreUnexported(s3types.CORSRule{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutBucketCorsOutput{}, nil
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(withConditions(xpv1.Creating())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockPutBucketCors: func(ctx context.Context, input *awss3.PutBucketCorsInput, opts []func(*awss3.Options)) (*awss3.PutBucketCorsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr:  corsConfiguration(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockDeleteBucketCors: func(ctx context.Context, input *awss3.DeleteBucketCorsInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketCorsOutput, error) {
+						return &awss3.DeleteBucketCorsOutput{}, nil
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockDeleteBucketCors: func(ctx context.Context, input *awss3.DeleteBucketCorsInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketCorsOutput, error) {
+						return nil, &s3types.NoSuchBucket{}
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr: corsConfiguration(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockBucketCORSClient{
+					MockDeleteBucketCors: func(ctx context.Context, input *awss3.DeleteBucketCorsInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketCorsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: corsConfiguration(),
+			},
+			want: want{
+				cr:  corsConfiguration(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
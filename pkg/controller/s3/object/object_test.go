@@ -0,0 +1,354 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package object
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/s3/v1alpha3"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/s3"
+	"github.com/crossplane/provider-aws/pkg/clients/s3/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	bucketName     = "test.s3.crossplane.com"
+	content        = "hello"
+	contentSHA256  = s3.ContentSHA256([]byte(content))
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	s3   s3.ObjectClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type objectModifier func(*v1alpha3.Object)
+
+func withConditions(c ...xpv1.Condition) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withContentFrom(s *v1alpha3.ContentSource) objectModifier {
+	return func(r *v1alpha3.Object) {
+		r.Spec.ForProvider.Content = nil
+		r.Spec.ForProvider.ContentFrom = s
+	}
+}
+
+func withObservation(o v1alpha3.ObjectObservation) objectModifier {
+	return func(r *v1alpha3.Object) { r.Status.AtProvider = o }
+}
+
+func object(m ...objectModifier) *v1alpha3.Object {
+	cr := &v1alpha3.Object{
+		Spec: v1alpha3.ObjectSpec{
+			ForProvider: v1alpha3.ObjectParameters{
+				BucketName: &bucketName,
+				Key:        "key",
+				Content:    &content,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func headOutput(sum string) func(context.Context, *awss3.HeadObjectInput, []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+	return func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+		return &awss3.HeadObjectOutput{ETag: aws.String("etag"), Metadata: map[string]string{s3.MetadataKeyContentSHA256: sum}}, nil
+	}
+}
+
+func noTags(_ context.Context, _ *awss3.GetObjectTaggingInput, _ []func(*awss3.Options)) (*awss3.GetObjectTaggingOutput, error) {
+	return &awss3.GetObjectTaggingOutput{}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headOutput(contentSHA256),
+					MockGetObjectTagging: noTags,
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(
+					withObservation(v1alpha3.ObjectObservation{ETag: "etag", ContentSHA256: contentSHA256}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SecretContentChanged": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headOutput(contentSHA256),
+					MockGetObjectTagging: noTags,
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.Secret).Data = map[string][]byte{"file": []byte("changed")}
+						return nil
+					},
+				},
+				cr: object(withContentFrom(&v1alpha3.ContentSource{SecretKeyRef: &xpv1.SecretKeySelector{Key: "file"}})),
+			},
+			want: want{
+				cr: object(
+					withContentFrom(&v1alpha3.ContentSource{SecretKeyRef: &xpv1.SecretKeySelector{Key: "file"}}),
+					withObservation(v1alpha3.ObjectObservation{ETag: "etag", ContentSHA256: contentSHA256}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ConfigMapKeyMissing": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject:       headOutput(contentSHA256),
+					MockGetObjectTagging: noTags,
+				},
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil),
+				},
+				cr: object(withContentFrom(&v1alpha3.ContentSource{ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Key: "file"}})),
+			},
+			want: want{
+				cr:  object(withContentFrom(&v1alpha3.ContentSource{ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Key: "file"}})),
+				err: errors.Wrap(errors.Errorf(errKeyNotFoundFmt, "file"), errGetConfigMap),
+			},
+		},
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(),
+				err: awsclient.Wrap(errBoom, errHead),
+			},
+		},
+		"ResourceDoesNotExist": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockHeadObject: func(_ context.Context, _ *awss3.HeadObjectInput, _ []func(*awss3.Options)) (*awss3.HeadObjectOutput, error) {
+						return nil, &s3types.NotFound{}
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ContentFromConfigMap": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(_ context.Context, input *awss3.PutObjectInput, _ []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						body, _ := io.ReadAll(input.Body)
+						if diff := cmp.Diff(content, string(body)); diff != "" {
+							t.Errorf("Body: -want, +got:\n%s", diff)
+						}
+						return &awss3.PutObjectOutput{}, nil
+					},
+				},
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						obj.(*corev1.ConfigMap).Data = map[string]string{"file": content}
+						return nil
+					},
+				},
+				cr: object(withContentFrom(&v1alpha3.ContentSource{ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Key: "file"}})),
+			},
+			want: want{
+				cr: object(
+					withContentFrom(&v1alpha3.ContentSource{ConfigMapKeyRef: &v1alpha3.ConfigMapKeySelector{Key: "file"}}),
+					withConditions(xpv1.Creating())),
+			},
+		},
+		"NoContent": {
+			args: args{
+				cr: object(withContentFrom(nil)),
+			},
+			want: want{
+				cr:  object(withContentFrom(nil), withConditions(xpv1.Creating())),
+				err: errors.New(errNoContent),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockPutObject: func(_ context.Context, _ *awss3.PutObjectInput, _ []func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ValidInput": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(_ context.Context, _ *awss3.DeleteObjectInput, _ []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return &awss3.DeleteObjectOutput{}, nil
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr: object(withConditions(xpv1.Deleting())),
+			},
+		},
+		"ClientError": {
+			args: args{
+				s3: &fake.MockObjectClient{
+					MockDeleteObject: func(_ context.Context, _ *awss3.DeleteObjectInput, _ []func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: object(),
+			},
+			want: want{
+				cr:  object(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.s3}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+)
+
+const (
+	errResolveReferences = "cannot resolve references"
+	errUpdateManaged     = "cannot update managed resource"
+)
+
+// referenceResolver resolves the references of a Bucket. Notifications may
+// invoke Lambda Functions, whose API package imports v1beta1 itself. Those
+// references therefore cannot be resolved by Bucket.ResolveReferences and are
+// resolved here instead.
+type referenceResolver struct {
+	client client.Client
+}
+
+func (r *referenceResolver) ResolveReferences(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1beta1.Bucket)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	existing := cr.DeepCopy()
+	if err := cr.ResolveReferences(ctx, r.client); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+	if err := resolveLambdaFunctions(ctx, r.client, cr); err != nil {
+		return errors.Wrap(err, errResolveReferences)
+	}
+
+	if cmp.Equal(existing, cr) {
+		return nil
+	}
+	return errors.Wrap(r.client.Update(ctx, cr), errUpdateManaged)
+}
+
+func resolveLambdaFunctions(ctx context.Context, c client.Reader, cr *v1beta1.Bucket) error {
+	if cr.Spec.ForProvider.NotificationConfiguration == nil {
+		return nil
+	}
+	r := reference.NewAPIResolver(c, cr)
+
+	for i := range cr.Spec.ForProvider.NotificationConfiguration.LambdaFunctionConfigurations {
+		conf := &cr.Spec.ForProvider.NotificationConfiguration.LambdaFunctionConfigurations[i]
+
+		// Resolve spec.forProvider.notificationConfiguration.lambdaFunctionConfigurations[].lambdaFunctionArn
+		rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: conf.LambdaFunctionArn,
+			Reference:    conf.LambdaFunctionArnRef,
+			Selector:     conf.LambdaFunctionArnSelector,
+			To:           reference.To{Managed: &lambdav1beta1.Function{}, List: &lambdav1beta1.FunctionList{}},
+			Extract:      lambdav1beta1.FunctionARN(),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "spec.forProvider.notificationConfiguration.lambdaFunctionConfigurations[%d].lambdaFunctionArn", i)
+		}
+		conf.LambdaFunctionArn = rsp.ResolvedValue
+		conf.LambdaFunctionArnRef = rsp.ResolvedReference
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	"github.com/crossplane/provider-aws/apis/s3/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	s3Testing "github.com/crossplane/provider-aws/pkg/controller/s3/testing"
+)
+
+func TestResolveReferences(t *testing.T) {
+	functionARN := "arn:aws:lambda:us-east-1:123456789012:function:example"
+
+	withLambda := func(arn string, ref *xpv1.Reference) s3Testing.BucketModifier {
+		return s3Testing.WithNotificationConfig(&v1beta1.NotificationConfiguration{
+			LambdaFunctionConfigurations: []v1beta1.LambdaFunctionConfiguration{{
+				Events:               []string{"s3:ObjectCreated:*"},
+				LambdaFunctionArn:    arn,
+				LambdaFunctionArnRef: ref,
+			}},
+		})
+	}
+
+	type want struct {
+		cr  *v1beta1.Bucket
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Client
+		cr     *v1beta1.Bucket
+		want   want
+	}{
+		"ResolvedFunction": {
+			reason: "The ARN of a referenced Lambda Function should be resolved.",
+			kube: &test.MockClient{
+				MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+					f := obj.(*lambdav1beta1.Function)
+					f.Status.AtProvider.FunctionARN = awsclient.String(functionARN)
+					f.SetConditions(xpv1.Available())
+					return nil
+				},
+				MockUpdate: test.NewMockUpdateFn(nil),
+			},
+			cr: s3Testing.Bucket(withLambda("", &xpv1.Reference{Name: "example"})),
+			want: want{
+				cr: s3Testing.Bucket(withLambda(functionARN, &xpv1.Reference{Name: "example"})),
+			},
+		},
+		"FunctionNotFound": {
+			reason: "Errors resolving a referenced Lambda Function should be returned.",
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			cr: s3Testing.Bucket(withLambda("", &xpv1.Reference{Name: "example"})),
+			want: want{
+				cr: s3Testing.Bucket(withLambda("", &xpv1.Reference{Name: "example"})),
+				err: errors.Wrap(errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"),
+					"spec.forProvider.notificationConfiguration.lambdaFunctionConfigurations[0].lambdaFunctionArn"), errResolveReferences),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &referenceResolver{client: tc.kube}
+			err := r.ResolveReferences(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr); diff != "" {
+				t.Errorf("\n%s\nResolveReferences(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -66,6 +66,15 @@ func Client(m ...ClientModifier) *fake.MockBucketClient {
 		MockDeletePublicAccessBlock: func(ctx context.Context, input *awss3.DeletePublicAccessBlockInput, opts []func(*awss3.Options)) (*awss3.DeletePublicAccessBlockOutput, error) {
 			return &awss3.DeletePublicAccessBlockOutput{}, nil
 		},
+		MockGetBucketOwnershipControls: func(ctx context.Context, input *awss3.GetBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.GetBucketOwnershipControlsOutput, error) {
+			return &awss3.GetBucketOwnershipControlsOutput{}, nil
+		},
+		MockPutBucketOwnershipControls: func(ctx context.Context, input *awss3.PutBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.PutBucketOwnershipControlsOutput, error) {
+			return &awss3.PutBucketOwnershipControlsOutput{}, nil
+		},
+		MockDeleteBucketOwnershipControls: func(ctx context.Context, input *awss3.DeleteBucketOwnershipControlsInput, opts []func(*awss3.Options)) (*awss3.DeleteBucketOwnershipControlsOutput, error) {
+			return &awss3.DeleteBucketOwnershipControlsOutput{}, nil
+		},
 	}
 	for _, v := range m {
 		v(client)
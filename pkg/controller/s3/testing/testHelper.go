@@ -108,6 +108,21 @@ func WithNotificationConfig(s *v1beta1.NotificationConfiguration) BucketModifier
 	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.NotificationConfiguration = s }
 }
 
+// WithPublicAccessBlockConfig sets the PublicAccessBlockConfiguration for an S3 Bucket
+func WithPublicAccessBlockConfig(s *v1beta1.PublicAccessBlockConfiguration) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.PublicAccessBlockConfiguration = s }
+}
+
+// WithOwnershipControls sets the OwnershipControls for an S3 Bucket
+func WithOwnershipControls(s *v1beta1.OwnershipControls) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.OwnershipControls = s }
+}
+
+// WithEnforceSecureDefaults sets EnforceSecureDefaults for an S3 Bucket
+func WithEnforceSecureDefaults(b bool) BucketModifier { //nolint
+	return func(r *v1beta1.Bucket) { r.Spec.ForProvider.EnforceSecureDefaults = &b }
+}
+
 // Bucket creates a v1beta1 Bucket for use in testing
 func Bucket(m ...BucketModifier) *v1beta1.Bucket {
 	cr := &v1beta1.Bucket{
@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hub
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Hub resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe Hub"
+	errListTags         = "failed to list tags of Hub"
+	errEnable           = "failed to enable Security Hub"
+	errUpdate           = "failed to update Hub"
+	errTag              = "failed to tag Hub"
+	errUntag            = "failed to untag Hub"
+	errDisable          = "failed to disable Security Hub"
+)
+
+// SetupHub adds a controller that reconciles Hubs.
+func SetupHub(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.HubGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.Hub{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.HubGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: securityhub.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) securityhub.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client securityhub.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// There is at most one hub per account and region, so it is looked up
+	// without its ARN.
+	resp, err := e.client.DescribeHubWithContext(ctx, &awssecurityhub.DescribeHubInput{})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(securityhub.IsHubNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider = v1alpha1.HubObservation{
+		HubARN:       awsclient.StringValue(resp.HubArn),
+		SubscribedAt: awsclient.StringValue(resp.SubscribedAt),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	tags, err := e.client.ListTagsForResourceWithContext(ctx, &awssecurityhub.ListTagsForResourceInput{
+		ResourceArn: resp.HubArn,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := awsclient.DiffTagsMapPtr(securityhub.GenerateTags(cr.Spec.ForProvider.Tags), tags.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: securityhub.IsHubUpToDate(cr.Spec.ForProvider, resp) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.EnableSecurityHubWithContext(ctx, securityhub.GenerateEnableSecurityHubInput(cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errEnable)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	if _, err := e.client.UpdateSecurityHubConfigurationWithContext(ctx, &awssecurityhub.UpdateSecurityHubConfigurationInput{
+		AutoEnableControls: aws.Bool(cr.Spec.ForProvider.AutoEnableControls == nil || *cr.Spec.ForProvider.AutoEnableControls),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *v1alpha1.Hub) error {
+	arn := awsclient.String(cr.Status.AtProvider.HubARN)
+	resp, err := e.client.ListTagsForResourceWithContext(ctx, &awssecurityhub.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errListTags)
+	}
+	add, remove := awsclient.DiffTagsMapPtr(securityhub.GenerateTags(cr.Spec.ForProvider.Tags), resp.Tags)
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &awssecurityhub.UntagResourceInput{
+			ResourceArn: arn,
+			TagKeys:     remove,
+		}); err != nil {
+			return awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := e.client.TagResourceWithContext(ctx, &awssecurityhub.TagResourceInput{
+			ResourceArn: arn,
+			Tags:        add,
+		}); err != nil {
+			return awsclient.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Hub)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DisableSecurityHubWithContext(ctx, &awssecurityhub.DisableSecurityHubInput{})
+	return awsclient.Wrap(resource.Ignore(securityhub.IsHubNotFound, err), errDisable)
+}
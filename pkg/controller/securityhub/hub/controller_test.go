@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hub
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub/fake"
+)
+
+var (
+	hubARN       = "arn:aws:securityhub:us-east-1:123456789012:hub/default"
+	errBoom      = errors.New("boom")
+	errNotActive = awserr.New(awssecurityhub.ErrCodeInvalidAccessException, "Account 123456789012 is not subscribed to AWS Security Hub", nil)
+)
+
+type args struct {
+	sh *fake.MockClient
+	cr resource.Managed
+}
+
+type hubModifier func(*v1alpha1.Hub)
+
+func withAutoEnableControls(b bool) hubModifier {
+	return func(r *v1alpha1.Hub) { r.Spec.ForProvider.AutoEnableControls = &b }
+}
+
+func withTags(tags map[string]string) hubModifier {
+	return func(r *v1alpha1.Hub) { r.Spec.ForProvider.Tags = tags }
+}
+
+func withObservation() hubModifier {
+	return func(r *v1alpha1.Hub) { r.Status.AtProvider.HubARN = hubARN }
+}
+
+func withConditions(c ...xpv1.Condition) hubModifier {
+	return func(r *v1alpha1.Hub) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func hub(m ...hubModifier) *v1alpha1.Hub {
+	cr := &v1alpha1.Hub{
+		Spec: v1alpha1.HubSpec{
+			ForProvider: v1alpha1.HubParameters{
+				Region: "us-east-1",
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeHub(context.Context, *awssecurityhub.DescribeHubInput, []request.Option) (*awssecurityhub.DescribeHubOutput, error) {
+	return &awssecurityhub.DescribeHubOutput{HubArn: &hubARN, AutoEnableControls: aws.Bool(true)}, nil
+}
+
+func listTags(tags map[string]string) func(context.Context, *awssecurityhub.ListTagsForResourceInput, []request.Option) (*awssecurityhub.ListTagsForResourceOutput, error) {
+	return func(context.Context, *awssecurityhub.ListTagsForResourceInput, []request.Option) (*awssecurityhub.ListTagsForResourceOutput, error) {
+		return &awssecurityhub.ListTagsForResourceOutput{Tags: aws.StringMap(tags)}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotEnabled": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDescribeHub: func(context.Context, *awssecurityhub.DescribeHubInput, []request.Option) (*awssecurityhub.DescribeHubOutput, error) {
+						return nil, errNotActive
+					},
+				},
+				cr: hub(),
+			},
+			want: want{
+				cr: hub(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDescribeHub: func(context.Context, *awssecurityhub.DescribeHubInput, []request.Option) (*awssecurityhub.DescribeHubOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: hub(),
+			},
+			want: want{
+				cr:  hub(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDescribeHub:         describeHub,
+					MockListTagsForResource: listTags(map[string]string{"team": "security"}),
+				},
+				cr: hub(withTags(map[string]string{"team": "security"})),
+			},
+			want: want{
+				cr: hub(withTags(map[string]string{"team": "security"}), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AutoEnableControlsChanged": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDescribeHub:         describeHub,
+					MockListTagsForResource: listTags(nil),
+				},
+				cr: hub(withAutoEnableControls(false)),
+			},
+			want: want{
+				cr: hub(withAutoEnableControls(false), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDescribeHub:         describeHub,
+					MockListTagsForResource: listTags(map[string]string{"team": "platform"}),
+				},
+				cr: hub(withTags(map[string]string{"team": "security"})),
+			},
+			want: want{
+				cr: hub(withTags(map[string]string{"team": "security"}), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sh: &fake.MockClient{
+					MockEnableSecurityHub: func(context.Context, *awssecurityhub.EnableSecurityHubInput, []request.Option) (*awssecurityhub.EnableSecurityHubOutput, error) {
+						return &awssecurityhub.EnableSecurityHubOutput{}, nil
+					},
+				},
+				cr: hub(),
+			},
+			want: want{
+				cr: hub(withConditions(xpv1.Creating())),
+			},
+		},
+		"EnableError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockEnableSecurityHub: func(context.Context, *awssecurityhub.EnableSecurityHubInput, []request.Option) (*awssecurityhub.EnableSecurityHubOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: hub(),
+			},
+			want: want{
+				cr:  hub(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errEnable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sh: &fake.MockClient{
+					MockUpdateSecurityHubConfiguration: func(_ context.Context, input *awssecurityhub.UpdateSecurityHubConfigurationInput, _ []request.Option) (*awssecurityhub.UpdateSecurityHubConfigurationOutput, error) {
+						if input.AutoEnableControls == nil || *input.AutoEnableControls {
+							return nil, errBoom
+						}
+						return &awssecurityhub.UpdateSecurityHubConfigurationOutput{}, nil
+					},
+					MockListTagsForResource: listTags(map[string]string{"team": "platform", "old": "tag"}),
+					MockUntagResource: func(_ context.Context, input *awssecurityhub.UntagResourceInput, _ []request.Option) (*awssecurityhub.UntagResourceOutput, error) {
+						if len(input.TagKeys) != 2 {
+							return nil, errBoom
+						}
+						return &awssecurityhub.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(_ context.Context, input *awssecurityhub.TagResourceInput, _ []request.Option) (*awssecurityhub.TagResourceOutput, error) {
+						if aws.StringValue(input.Tags["team"]) != "security" {
+							return nil, errBoom
+						}
+						return &awssecurityhub.TagResourceOutput{}, nil
+					},
+				},
+				cr: hub(withAutoEnableControls(false), withTags(map[string]string{"team": "security"}), withObservation()),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockUpdateSecurityHubConfiguration: func(context.Context, *awssecurityhub.UpdateSecurityHubConfigurationInput, []request.Option) (*awssecurityhub.UpdateSecurityHubConfigurationOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: hub(withObservation()),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"TagError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockUpdateSecurityHubConfiguration: func(context.Context, *awssecurityhub.UpdateSecurityHubConfigurationInput, []request.Option) (*awssecurityhub.UpdateSecurityHubConfigurationOutput, error) {
+						return &awssecurityhub.UpdateSecurityHubConfigurationOutput{}, nil
+					},
+					MockListTagsForResource: listTags(nil),
+					MockTagResource: func(context.Context, *awssecurityhub.TagResourceInput, []request.Option) (*awssecurityhub.TagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: hub(withTags(map[string]string{"team": "security"}), withObservation()),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errTag),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDisabled": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDisableSecurityHub: func(context.Context, *awssecurityhub.DisableSecurityHubInput, []request.Option) (*awssecurityhub.DisableSecurityHubOutput, error) {
+						return nil, errNotActive
+					},
+				},
+				cr: hub(),
+			},
+		},
+		"DisableError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockDisableSecurityHub: func(context.Context, *awssecurityhub.DisableSecurityHubInput, []request.Option) (*awssecurityhub.DisableSecurityHubOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: hub(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDisable),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
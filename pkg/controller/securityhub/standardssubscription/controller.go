@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standardssubscription
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a StandardsSubscription resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get StandardsSubscription"
+	errCreate           = "failed to create StandardsSubscription"
+	errDelete           = "failed to delete StandardsSubscription"
+)
+
+// SetupStandardsSubscription adds a controller that reconciles
+// StandardsSubscriptions.
+func SetupStandardsSubscription(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.StandardsSubscriptionGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.StandardsSubscription{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.StandardsSubscriptionGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: securityhub.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) securityhub.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.StandardsSubscription)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client securityhub.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.StandardsSubscription)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The subscription ARN is assigned by AWS, so there is nothing to
+	// observe until it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.client.GetEnabledStandardsWithContext(ctx, &awssecurityhub.GetEnabledStandardsInput{
+		StandardsSubscriptionArns: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(securityhub.IsNotFound, err), errGet)
+	}
+	if len(resp.StandardsSubscriptions) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+	status := awsclient.StringValue(resp.StandardsSubscriptions[0].StandardsStatus)
+	cr.Status.AtProvider = v1alpha1.StandardsSubscriptionObservation{StandardsStatus: status}
+
+	switch status {
+	case awssecurityhub.StandardsStatusReady:
+		cr.SetConditions(xpv1.Available())
+	case awssecurityhub.StandardsStatusPending:
+		cr.SetConditions(xpv1.Creating())
+	case awssecurityhub.StandardsStatusDeleting:
+		cr.SetConditions(xpv1.Deleting())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// All parameters are immutable, so an existing subscription is always
+	// up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.StandardsSubscription)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.BatchEnableStandardsWithContext(ctx, &awssecurityhub.BatchEnableStandardsInput{
+		StandardsSubscriptionRequests: []*awssecurityhub.StandardsSubscriptionRequest{{
+			StandardsArn:   awsclient.String(cr.Spec.ForProvider.StandardsARN),
+			StandardsInput: aws.StringMap(cr.Spec.ForProvider.StandardsInput),
+		}},
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if len(resp.StandardsSubscriptions) == 0 {
+		return managed.ExternalCreation{}, errors.New(errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.StandardsSubscriptions[0].StandardsSubscriptionArn))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.StandardsSubscription)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.BatchDisableStandardsWithContext(ctx, &awssecurityhub.BatchDisableStandardsInput{
+		StandardsSubscriptionArns: []*string{awsclient.String(meta.GetExternalName(cr))},
+	})
+	return awsclient.Wrap(resource.Ignore(securityhub.IsNotFound, err), errDelete)
+}
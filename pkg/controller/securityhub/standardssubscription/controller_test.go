@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package standardssubscription
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awssecurityhub "github.com/aws/aws-sdk-go/service/securityhub"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/securityhub/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/securityhub/fake"
+)
+
+var (
+	standardsARN    = "arn:aws:securityhub:us-east-1::standards/aws-foundational-security-best-practices/v/1.0.0"
+	subscriptionARN = "arn:aws:securityhub:us-east-1:123456789012:subscription/aws-foundational-security-best-practices/v/1.0.0"
+	errBoom         = errors.New("boom")
+)
+
+type args struct {
+	sh *fake.MockClient
+	cr resource.Managed
+}
+
+type subscriptionModifier func(*v1alpha1.StandardsSubscription)
+
+func withExternalName(n string) subscriptionModifier {
+	return func(r *v1alpha1.StandardsSubscription) { meta.SetExternalName(r, n) }
+}
+
+func withStandardsStatus(s string) subscriptionModifier {
+	return func(r *v1alpha1.StandardsSubscription) { r.Status.AtProvider.StandardsStatus = s }
+}
+
+func withConditions(c ...xpv1.Condition) subscriptionModifier {
+	return func(r *v1alpha1.StandardsSubscription) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func subscription(m ...subscriptionModifier) *v1alpha1.StandardsSubscription {
+	cr := &v1alpha1.StandardsSubscription{
+		Spec: v1alpha1.StandardsSubscriptionSpec{
+			ForProvider: v1alpha1.StandardsSubscriptionParameters{
+				Region:       "us-east-1",
+				StandardsARN: standardsARN,
+			},
+		},
+	}
+	meta.SetExternalName(cr, subscriptionARN)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getEnabledStandards(status string) func(context.Context, *awssecurityhub.GetEnabledStandardsInput, []request.Option) (*awssecurityhub.GetEnabledStandardsOutput, error) {
+	return func(context.Context, *awssecurityhub.GetEnabledStandardsInput, []request.Option) (*awssecurityhub.GetEnabledStandardsOutput, error) {
+		return &awssecurityhub.GetEnabledStandardsOutput{StandardsSubscriptions: []*awssecurityhub.StandardsSubscription{{
+			StandardsArn:             &standardsARN,
+			StandardsSubscriptionArn: &subscriptionARN,
+			StandardsStatus:          aws.String(status),
+		}}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				sh: &fake.MockClient{},
+				cr: subscription(withExternalName("")),
+			},
+			want: want{
+				cr: subscription(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sh: &fake.MockClient{
+					MockGetEnabledStandards: func(context.Context, *awssecurityhub.GetEnabledStandardsInput, []request.Option) (*awssecurityhub.GetEnabledStandardsOutput, error) {
+						return &awssecurityhub.GetEnabledStandardsOutput{}, nil
+					},
+				},
+				cr: subscription(),
+			},
+			want: want{
+				cr: subscription(),
+			},
+		},
+		"GetError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockGetEnabledStandards: func(context.Context, *awssecurityhub.GetEnabledStandardsInput, []request.Option) (*awssecurityhub.GetEnabledStandardsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subscription(),
+			},
+			want: want{
+				cr:  subscription(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"Pending": {
+			args: args{
+				sh: &fake.MockClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusPending)},
+				cr: subscription(),
+			},
+			want: want{
+				cr: subscription(withStandardsStatus(awssecurityhub.StandardsStatusPending), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Ready": {
+			args: args{
+				sh: &fake.MockClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusReady)},
+				cr: subscription(),
+			},
+			want: want{
+				cr: subscription(withStandardsStatus(awssecurityhub.StandardsStatusReady), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				sh: &fake.MockClient{MockGetEnabledStandards: getEnabledStandards(awssecurityhub.StandardsStatusFailed)},
+				cr: subscription(),
+			},
+			want: want{
+				cr: subscription(withStandardsStatus(awssecurityhub.StandardsStatusFailed), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sh: &fake.MockClient{
+					MockBatchEnableStandards: func(_ context.Context, input *awssecurityhub.BatchEnableStandardsInput, _ []request.Option) (*awssecurityhub.BatchEnableStandardsOutput, error) {
+						if aws.StringValue(input.StandardsSubscriptionRequests[0].StandardsArn) != standardsARN {
+							return nil, errBoom
+						}
+						return &awssecurityhub.BatchEnableStandardsOutput{StandardsSubscriptions: []*awssecurityhub.StandardsSubscription{{
+							StandardsSubscriptionArn: &subscriptionARN,
+						}}}, nil
+					},
+				},
+				cr: subscription(withExternalName("")),
+			},
+			want: want{
+				cr:     subscription(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"EnableError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockBatchEnableStandards: func(context.Context, *awssecurityhub.BatchEnableStandardsInput, []request.Option) (*awssecurityhub.BatchEnableStandardsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subscription(withExternalName("")),
+			},
+			want: want{
+				cr:  subscription(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				sh: &fake.MockClient{
+					MockBatchDisableStandards: func(context.Context, *awssecurityhub.BatchDisableStandardsInput, []request.Option) (*awssecurityhub.BatchDisableStandardsOutput, error) {
+						return nil, awserr.New(awssecurityhub.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: subscription(),
+			},
+		},
+		"DisableError": {
+			args: args{
+				sh: &fake.MockClient{
+					MockBatchDisableStandards: func(context.Context, *awssecurityhub.BatchDisableStandardsInput, []request.Option) (*awssecurityhub.BatchDisableStandardsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subscription(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sh}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	svcclient "github.com/crossplane/provider-aws/pkg/clients/servicediscovery"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Service resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get Service"
+	errCreate           = "failed to create Service"
+	errUpdate           = "failed to update Service"
+	errDelete           = "failed to delete Service"
+	errListTags         = "failed to list tags of Service"
+	errTag              = "failed to tag Service"
+	errUntag            = "failed to untag Service"
+)
+
+// SetupService adds a controller that reconciles Cloud Map Services.
+func SetupService(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(svcapitypes.ServiceGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&svcapitypes.Service{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(svcapitypes.ServiceGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: svcclient.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) svcclient.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Service)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client svcclient.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Service)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The service ID is assigned by AWS, so there is nothing to observe
+	// until it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.client.GetServiceWithContext(ctx, &svcsdk.GetServiceInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(svcclient.IsServiceNotFound, err), errGet)
+	}
+	cr.Status.AtProvider = svcapitypes.ServiceObservation{
+		ARN:           awsclient.StringValue(resp.Service.Arn),
+		ID:            awsclient.StringValue(resp.Service.Id),
+		InstanceCount: aws.Int64Value(resp.Service.InstanceCount),
+	}
+	cr.SetConditions(xpv1.Available())
+
+	tags, err := e.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: resp.Service.Arn,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := awsclient.DiffTags(svcclient.TagsToMap(cr.Spec.ForProvider.Tags), svcclient.SDKTagsToMap(tags.Tags))
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: svcclient.IsServiceUpToDate(cr.Spec.ForProvider, resp.Service) && len(add) == 0 && len(remove) == 0,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Service)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateServiceWithContext(ctx, svcclient.GenerateCreateServiceInput(cr.Spec.ForProvider, string(cr.UID)))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.Service.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*svcapitypes.Service)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetServiceWithContext(ctx, &svcsdk.GetServiceInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	if !svcclient.IsServiceUpToDate(cr.Spec.ForProvider, resp.Service) {
+		if _, err := e.client.UpdateServiceWithContext(ctx, &svcsdk.UpdateServiceInput{
+			Id:      resp.Service.Id,
+			Service: svcclient.GenerateServiceChange(cr.Spec.ForProvider),
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+	return managed.ExternalUpdate{}, e.updateTags(ctx, cr, resp.Service.Arn)
+}
+
+func (e *external) updateTags(ctx context.Context, cr *svcapitypes.Service, arn *string) error {
+	resp, err := e.client.ListTagsForResourceWithContext(ctx, &svcsdk.ListTagsForResourceInput{
+		ResourceARN: arn,
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errListTags)
+	}
+	add, remove := awsclient.DiffTags(svcclient.TagsToMap(cr.Spec.ForProvider.Tags), svcclient.SDKTagsToMap(resp.Tags))
+	if len(remove) > 0 {
+		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
+			ResourceARN: arn,
+			TagKeys:     aws.StringSlice(remove),
+		}); err != nil {
+			return awsclient.Wrap(err, errUntag)
+		}
+	}
+	if len(add) > 0 {
+		tags := make([]*svcsdk.Tag, 0, len(add))
+		for k, v := range add {
+			tags = append(tags, &svcsdk.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		if _, err := e.client.TagResourceWithContext(ctx, &svcsdk.TagResourceInput{
+			ResourceARN: arn,
+			Tags:        tags,
+		}); err != nil {
+			return awsclient.Wrap(err, errTag)
+		}
+	}
+	return nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Service)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteServiceWithContext(ctx, &svcsdk.DeleteServiceInput{
+		Id: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(svcclient.IsServiceNotFound, err), errDelete)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicediscovery"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicediscovery/fake"
+)
+
+var (
+	serviceID  = "srv-123"
+	serviceARN = "arn:aws:servicediscovery:us-east-1:123456789012:service/srv-123"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	sd *fake.MockServicediscoveryClient
+	cr resource.Managed
+}
+
+type serviceModifier func(*svcapitypes.Service)
+
+func withDescription(d string) serviceModifier {
+	return func(r *svcapitypes.Service) { r.Spec.ForProvider.Description = &d }
+}
+
+func withTags(kv ...string) serviceModifier {
+	return func(r *svcapitypes.Service) {
+		for i := 0; i < len(kv); i += 2 {
+			r.Spec.ForProvider.Tags = append(r.Spec.ForProvider.Tags, svcapitypes.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+	}
+}
+
+func withExternalName(n string) serviceModifier {
+	return func(r *svcapitypes.Service) { meta.SetExternalName(r, n) }
+}
+
+func withObservation() serviceModifier {
+	return func(r *svcapitypes.Service) {
+		r.Status.AtProvider = svcapitypes.ServiceObservation{ARN: serviceARN, ID: serviceID}
+	}
+}
+
+func withConditions(c ...xpv1.Condition) serviceModifier {
+	return func(r *svcapitypes.Service) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func service(m ...serviceModifier) *svcapitypes.Service {
+	cr := &svcapitypes.Service{
+		Spec: svcapitypes.ServiceSpec{
+			ForProvider: svcapitypes.ServiceParameters{
+				Name:        "backend",
+				NamespaceID: aws.String("ns-123"),
+				DNSConfig: &svcapitypes.ServiceDNSConfig{
+					DNSRecords: []svcapitypes.ServiceDNSRecord{{Type: "A", TTL: 60}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, serviceID)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getService(description string, ttl int64) func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+	return func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+		return &svcsdk.GetServiceOutput{Service: &svcsdk.Service{
+			Id:          &serviceID,
+			Arn:         &serviceARN,
+			Description: awsclient.String(description),
+			DnsConfig: &svcsdk.DnsConfig{
+				DnsRecords: []*svcsdk.DnsRecord{{Type: aws.String("A"), TTL: aws.Int64(ttl)}},
+			},
+		}}, nil
+	}
+}
+
+func listTags(kv ...string) func(*svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error) {
+	return func(*svcsdk.ListTagsForResourceInput) (*svcsdk.ListTagsForResourceOutput, error) {
+		out := &svcsdk.ListTagsForResourceOutput{}
+		for i := 0; i < len(kv); i += 2 {
+			out.Tags = append(out.Tags, &svcsdk.Tag{Key: aws.String(kv[i]), Value: aws.String(kv[i+1])})
+		}
+		return out, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{},
+				cr: service(withExternalName("")),
+			},
+			want: want{
+				cr: service(withExternalName("")),
+			},
+		},
+		"NotFound": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService: func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeServiceNotFound, "", nil)
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr: service(),
+			},
+		},
+		"GetError": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService: func(*svcsdk.GetServiceInput) (*svcsdk.GetServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				cr:  service(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService:          getService("desc", 60),
+					MockListTagsForResource: listTags("team", "a"),
+				},
+				cr: service(withDescription("desc"), withTags("team", "a")),
+			},
+			want: want{
+				cr: service(withDescription("desc"), withTags("team", "a"), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TTLChanged": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService:          getService("", 300),
+					MockListTagsForResource: listTags(),
+				},
+				cr: service(),
+			},
+			want: want{
+				cr: service(withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService:          getService("", 60),
+					MockListTagsForResource: listTags("team", "b"),
+				},
+				cr: service(withTags("team", "a")),
+			},
+			want: want{
+				cr: service(withTags("team", "a"), withObservation(), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockCreateService: func(input *svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error) {
+						if awsclient.StringValue(input.NamespaceId) != "ns-123" || len(input.DnsConfig.DnsRecords) != 1 {
+							return nil, errBoom
+						}
+						return &svcsdk.CreateServiceOutput{Service: &svcsdk.Service{Id: &serviceID}}, nil
+					},
+				},
+				cr: service(withExternalName("")),
+			},
+			want: want{
+				cr:     service(withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockCreateService: func(*svcsdk.CreateServiceInput) (*svcsdk.CreateServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(withExternalName("")),
+			},
+			want: want{
+				cr:  service(withExternalName(""), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpdateServiceAndTags": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService: getService("", 300),
+					MockUpdateService: func(input *svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error) {
+						if aws.Int64Value(input.Service.DnsConfig.DnsRecords[0].TTL) != 60 {
+							return nil, errBoom
+						}
+						return &svcsdk.UpdateServiceOutput{}, nil
+					},
+					MockListTagsForResource: listTags("old", "x"),
+					MockUntagResource: func(input *svcsdk.UntagResourceInput) (*svcsdk.UntagResourceOutput, error) {
+						if diff := cmp.Diff([]string{"old"}, aws.StringValueSlice(input.TagKeys)); diff != "" {
+							return nil, errBoom
+						}
+						return &svcsdk.UntagResourceOutput{}, nil
+					},
+					MockTagResource: func(input *svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						if len(input.Tags) != 1 || aws.StringValue(input.Tags[0].Key) != "team" {
+							return nil, errBoom
+						}
+						return &svcsdk.TagResourceOutput{}, nil
+					},
+				},
+				cr: service(withTags("team", "a")),
+			},
+		},
+		"ServiceUpToDate": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService: getService("", 60),
+					MockUpdateService: func(*svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error) {
+						return nil, errBoom
+					},
+					MockListTagsForResource: listTags(),
+				},
+				cr: service(),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService: getService("", 300),
+					MockUpdateService: func(*svcsdk.UpdateServiceInput) (*svcsdk.UpdateServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"TagError": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockGetService:          getService("", 60),
+					MockListTagsForResource: listTags(),
+					MockTagResource: func(*svcsdk.TagResourceInput) (*svcsdk.TagResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(withTags("team", "a")),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errTag),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockDeleteService: func(*svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeServiceNotFound, "", nil)
+					},
+				},
+				cr: service(),
+			},
+		},
+		"InstancesRegistered": {
+			args: args{
+				sd: &fake.MockServicediscoveryClient{
+					MockDeleteService: func(*svcsdk.DeleteServiceInput) (*svcsdk.DeleteServiceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: service(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.sd}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestedquotaincrease
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a RequestedQuotaIncrease resource"
+
+	errGet          = "cannot get requested service quota change in AWS"
+	errRequest      = "cannot request service quota increase in AWS"
+	errFmtDenied    = "the request to increase quota %s of service %s was denied"
+	errFmtImmutable = "request %s cannot be changed once it was made, create another RequestedQuotaIncrease instead"
+)
+
+// SetupRequestedQuotaIncrease adds a controller that reconciles
+// RequestedQuotaIncrease.
+func SetupRequestedQuotaIncrease(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.RequestedQuotaIncreaseGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.RequestedQuotaIncrease{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.RequestedQuotaIncreaseGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: servicequotas.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(sess *session.Session) servicequotas.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RequestedQuotaIncrease)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+// external requests a quota increase once, and reports the status of the
+// request until the RequestedQuotaIncrease is deleted. Requests cannot be
+// withdrawn, so it is reported as gone once it was deleted.
+type external struct {
+	client servicequotas.Client
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha1.RequestedQuotaIncrease)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.WasDeleted(cr) || meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	resp, err := e.client.GetRequestedServiceQuotaChangeWithContext(ctx, &svcsdk.GetRequestedServiceQuotaChangeInput{
+		RequestId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(servicequotas.IsNotFound, err), errGet)
+	}
+
+	cr.Status.AtProvider = servicequotas.GenerateRequestedQuotaIncreaseObservation(resp.RequestedQuota)
+	switch s := cr.Status.AtProvider.Status; {
+	case servicequotas.IsRequestApproved(s):
+		cr.SetConditions(xpv1.Available())
+	case servicequotas.IsRequestDenied(s):
+		cr.SetConditions(xpv1.Unavailable().WithMessage(errors.Errorf(errFmtDenied, cr.Spec.ForProvider.QuotaCode, cr.Spec.ForProvider.ServiceCode).Error()))
+	default:
+		cr.SetConditions(xpv1.Creating())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(cr.Spec.ForProvider, resp.RequestedQuota),
+	}, nil
+}
+
+// isUpToDate returns true if the supplied request is for the supplied quota
+// increase.
+func isUpToDate(p v1alpha1.RequestedQuotaIncreaseParameters, r *svcsdk.RequestedServiceQuotaChange) bool {
+	if r == nil {
+		return true
+	}
+	return aws.StringValue(r.ServiceCode) == p.ServiceCode &&
+		aws.StringValue(r.QuotaCode) == p.QuotaCode &&
+		aws.Float64Value(r.DesiredValue) == p.DesiredValue
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha1.RequestedQuotaIncrease)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.RequestServiceQuotaIncreaseWithContext(ctx, servicequotas.GenerateRequestServiceQuotaIncreaseInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errRequest)
+	}
+	if resp.RequestedQuota != nil {
+		meta.SetExternalName(cr, aws.StringValue(resp.RequestedQuota.Id))
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+// Update reports that the request cannot be changed. A new
+// RequestedQuotaIncrease must be created to request a different increase.
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha1.RequestedQuotaIncrease)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, errors.Errorf(errFmtImmutable, meta.GetExternalName(cr))
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	return nil
+}
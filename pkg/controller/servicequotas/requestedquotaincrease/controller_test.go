@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestedquotaincrease
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/servicequotas/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/servicequotas/fake"
+)
+
+var (
+	unexpectedItem resource.Managed
+	requestID      = "d8ab0a2f"
+	deleted        = metav1.Now()
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type increaseModifier func(*v1alpha1.RequestedQuotaIncrease)
+
+func withConditions(c ...xpv1.Condition) increaseModifier {
+	return func(r *v1alpha1.RequestedQuotaIncrease) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withExternalName(name string) increaseModifier {
+	return func(r *v1alpha1.RequestedQuotaIncrease) { meta.SetExternalName(r, name) }
+}
+
+func withStatus(s string) increaseModifier {
+	return func(r *v1alpha1.RequestedQuotaIncrease) {
+		r.Status.AtProvider = v1alpha1.RequestedQuotaIncreaseObservation{Status: s, QuotaName: "VPCs per Region"}
+	}
+}
+
+func withDesiredValue(v float64) increaseModifier {
+	return func(r *v1alpha1.RequestedQuotaIncrease) { r.Spec.ForProvider.DesiredValue = v }
+}
+
+func withDeletionTimestamp() increaseModifier {
+	return func(r *v1alpha1.RequestedQuotaIncrease) { r.SetDeletionTimestamp(&deleted) }
+}
+
+func increase(m ...increaseModifier) *v1alpha1.RequestedQuotaIncrease {
+	cr := &v1alpha1.RequestedQuotaIncrease{}
+	cr.Spec.ForProvider = v1alpha1.RequestedQuotaIncreaseParameters{
+		Region:       "eu-west-1",
+		ServiceCode:  "vpc",
+		QuotaCode:    "L-F678F1CE",
+		DesiredValue: 10,
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func requested(status string) func(aws.Context, *svcsdk.GetRequestedServiceQuotaChangeInput, []request.Option) (*svcsdk.GetRequestedServiceQuotaChangeOutput, error) {
+	return func(aws.Context, *svcsdk.GetRequestedServiceQuotaChangeInput, []request.Option) (*svcsdk.GetRequestedServiceQuotaChangeOutput, error) {
+		return &svcsdk.GetRequestedServiceQuotaChangeOutput{RequestedQuota: &svcsdk.RequestedServiceQuotaChange{
+			Id:           aws.String(requestID),
+			ServiceCode:  aws.String("vpc"),
+			QuotaCode:    aws.String("L-F678F1CE"),
+			QuotaName:    aws.String("VPCs per Region"),
+			DesiredValue: aws.Float64(10),
+			Status:       aws.String(status),
+		}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Approved": {
+			args: args{
+				client: &fake.MockClient{MockGetRequestedServiceQuotaChange: requested(svcsdk.RequestStatusApproved)},
+				cr:     increase(withExternalName(requestID)),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID), withStatus(svcsdk.RequestStatusApproved),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				client: &fake.MockClient{MockGetRequestedServiceQuotaChange: requested(svcsdk.RequestStatusCaseOpened)},
+				cr:     increase(withExternalName(requestID)),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID), withStatus(svcsdk.RequestStatusCaseOpened),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Denied": {
+			args: args{
+				client: &fake.MockClient{MockGetRequestedServiceQuotaChange: requested(svcsdk.RequestStatusDenied)},
+				cr:     increase(withExternalName(requestID)),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID), withStatus(svcsdk.RequestStatusDenied),
+					withConditions(xpv1.Unavailable().WithMessage("the request to increase quota L-F678F1CE of service vpc was denied"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DesiredValueChanged": {
+			args: args{
+				client: &fake.MockClient{MockGetRequestedServiceQuotaChange: requested(svcsdk.RequestStatusPending)},
+				cr:     increase(withExternalName(requestID), withDesiredValue(20)),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID), withDesiredValue(20), withStatus(svcsdk.RequestStatusPending),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"NotRequested": {
+			args: args{
+				cr: increase(),
+			},
+			want: want{
+				cr: increase(),
+			},
+		},
+		"Deleted": {
+			args: args{
+				cr: increase(withExternalName(requestID), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID), withDeletionTimestamp()),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRequestedServiceQuotaChange: func(aws.Context, *svcsdk.GetRequestedServiceQuotaChangeInput, []request.Option) (*svcsdk.GetRequestedServiceQuotaChangeOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeNoSuchResourceException, "", nil)
+					},
+				},
+				cr: increase(withExternalName(requestID)),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRequestedServiceQuotaChange: func(aws.Context, *svcsdk.GetRequestedServiceQuotaChangeInput, []request.Option) (*svcsdk.GetRequestedServiceQuotaChangeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: increase(withExternalName(requestID)),
+			},
+			want: want{
+				cr:  increase(withExternalName(requestID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockRequestServiceQuotaIncrease: func(_ aws.Context, input *svcsdk.RequestServiceQuotaIncreaseInput, _ []request.Option) (*svcsdk.RequestServiceQuotaIncreaseOutput, error) {
+						if aws.Float64Value(input.DesiredValue) != 10 {
+							return nil, errBoom
+						}
+						return &svcsdk.RequestServiceQuotaIncreaseOutput{RequestedQuota: &svcsdk.RequestedServiceQuotaChange{Id: aws.String(requestID)}}, nil
+					},
+				},
+				cr: increase(),
+			},
+			want: want{
+				cr: increase(withExternalName(requestID)),
+			},
+		},
+		"RequestError": {
+			args: args{
+				client: &fake.MockClient{
+					MockRequestServiceQuotaIncrease: func(aws.Context, *svcsdk.RequestServiceQuotaIncreaseInput, []request.Option) (*svcsdk.RequestServiceQuotaIncreaseOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: increase(),
+			},
+			want: want{
+				cr:  increase(),
+				err: awsclient.Wrap(errBoom, errRequest),
+			},
+		},
+		"InvalidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationset
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject   = "managed resource is not a ConfigurationSet resource"
+	errCreateSession      = "cannot create a new session"
+	errDescribe           = "failed to describe ConfigurationSet"
+	errCreate             = "failed to create ConfigurationSet"
+	errUpdateReputation   = "failed to update the reputation metrics of the ConfigurationSet"
+	errUpdateSending      = "failed to update whether sending is enabled for the ConfigurationSet"
+	errPutDeliveryOptions = "failed to put the delivery options of the ConfigurationSet"
+	errDelete             = "failed to delete ConfigurationSet"
+)
+
+// SetupConfigurationSet adds a controller that reconciles ConfigurationSets.
+func SetupConfigurationSet(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ConfigurationSetGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.ConfigurationSet{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ConfigurationSetGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ses.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) ses.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationSet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client ses.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.DescribeConfigurationSetWithContext(ctx, &awsses.DescribeConfigurationSetInput{
+		ConfigurationSetName: awsclient.String(meta.GetExternalName(cr)),
+		ConfigurationSetAttributeNames: aws.StringSlice([]string{
+			awsses.ConfigurationSetAttributeReputationOptions,
+			awsses.ConfigurationSetAttributeDeliveryOptions,
+		}),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDescribe)
+	}
+	cr.Status.AtProvider.LastFreshStart = nil
+	if resp.ReputationOptions != nil && resp.ReputationOptions.LastFreshStart != nil {
+		t := metav1.NewTime(*resp.ReputationOptions.LastFreshStart)
+		cr.Status.AtProvider.LastFreshStart = &t
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: ses.IsConfigurationSetUpToDate(cr.Spec.ForProvider, resp),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	if _, err := e.client.CreateConfigurationSetWithContext(ctx, &awsses.CreateConfigurationSetInput{
+		ConfigurationSet: &awsses.ConfigurationSet{Name: awsclient.String(meta.GetExternalName(cr))},
+	}); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	return managed.ExternalCreation{}, e.update(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ConfigurationSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.update(ctx, cr)
+}
+
+// update sets the reputation and delivery options of the configuration set.
+// SES has no operation that sets them at once.
+func (e *external) update(ctx context.Context, cr *v1alpha1.ConfigurationSet) error {
+	name := awsclient.String(meta.GetExternalName(cr))
+	p := cr.Spec.ForProvider
+	if _, err := e.client.UpdateConfigurationSetReputationMetricsEnabledWithContext(ctx, &awsses.UpdateConfigurationSetReputationMetricsEnabledInput{
+		ConfigurationSetName: name,
+		Enabled:              aws.Bool(awsclient.BoolValue(p.ReputationMetricsEnabled)),
+	}); err != nil {
+		return awsclient.Wrap(err, errUpdateReputation)
+	}
+	if _, err := e.client.UpdateConfigurationSetSendingEnabledWithContext(ctx, &awsses.UpdateConfigurationSetSendingEnabledInput{
+		ConfigurationSetName: name,
+		Enabled:              aws.Bool(ses.ConfigurationSetSendingEnabled(p)),
+	}); err != nil {
+		return awsclient.Wrap(err, errUpdateSending)
+	}
+	_, err := e.client.PutConfigurationSetDeliveryOptionsWithContext(ctx, &awsses.PutConfigurationSetDeliveryOptionsInput{
+		ConfigurationSetName: name,
+		DeliveryOptions:      &awsses.DeliveryOptions{TlsPolicy: awsclient.String(ses.ConfigurationSetTLSPolicy(p))},
+	})
+	return awsclient.Wrap(err, errPutDeliveryOptions)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ConfigurationSet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteConfigurationSetWithContext(ctx, &awsses.DeleteConfigurationSetInput{
+		ConfigurationSetName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package configurationset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	setName = "set"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ses *fake.MockClient
+	cr  resource.Managed
+}
+
+type setModifier func(*v1alpha1.ConfigurationSet)
+
+func withSendingEnabled(b bool) setModifier {
+	return func(r *v1alpha1.ConfigurationSet) { r.Spec.ForProvider.SendingEnabled = &b }
+}
+
+func withConditions(c ...xpv1.Condition) setModifier {
+	return func(r *v1alpha1.ConfigurationSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func configurationSet(m ...setModifier) *v1alpha1.ConfigurationSet {
+	cr := &v1alpha1.ConfigurationSet{}
+	meta.SetExternalName(cr, setName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeConfigurationSet: func(context.Context, *awsses.DescribeConfigurationSetInput, []request.Option) (*awsses.DescribeConfigurationSetOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeConfigurationSetDoesNotExistException, "", nil)
+					},
+				},
+				cr: configurationSet(),
+			},
+			want: want{
+				cr: configurationSet(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeConfigurationSet: func(context.Context, *awsses.DescribeConfigurationSetInput, []request.Option) (*awsses.DescribeConfigurationSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: configurationSet(),
+			},
+			want: want{
+				cr:  configurationSet(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeConfigurationSet: func(context.Context, *awsses.DescribeConfigurationSetInput, []request.Option) (*awsses.DescribeConfigurationSetOutput, error) {
+						return &awsses.DescribeConfigurationSetOutput{
+							ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(true)},
+						}, nil
+					},
+				},
+				cr: configurationSet(),
+			},
+			want: want{
+				cr: configurationSet(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"SendingDisabled": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeConfigurationSet: func(context.Context, *awsses.DescribeConfigurationSetInput, []request.Option) (*awsses.DescribeConfigurationSetOutput, error) {
+						return &awsses.DescribeConfigurationSetOutput{
+							ReputationOptions: &awsses.ReputationOptions{SendingEnabled: awsclient.Bool(true)},
+						}, nil
+					},
+				},
+				cr: configurationSet(withSendingEnabled(false)),
+			},
+			want: want{
+				cr: configurationSet(withSendingEnabled(false), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func updatedClient() *fake.MockClient {
+	return &fake.MockClient{
+		MockCreateConfigurationSet: func(context.Context, *awsses.CreateConfigurationSetInput, []request.Option) (*awsses.CreateConfigurationSetOutput, error) {
+			return &awsses.CreateConfigurationSetOutput{}, nil
+		},
+		MockUpdateConfigurationSetReputationMetricsEnabled: func(context.Context, *awsses.UpdateConfigurationSetReputationMetricsEnabledInput, []request.Option) (*awsses.UpdateConfigurationSetReputationMetricsEnabledOutput, error) {
+			return &awsses.UpdateConfigurationSetReputationMetricsEnabledOutput{}, nil
+		},
+		MockUpdateConfigurationSetSendingEnabled: func(_ context.Context, input *awsses.UpdateConfigurationSetSendingEnabledInput, _ []request.Option) (*awsses.UpdateConfigurationSetSendingEnabledOutput, error) {
+			if !awsclient.BoolValue(input.Enabled) {
+				return nil, errBoom
+			}
+			return &awsses.UpdateConfigurationSetSendingEnabledOutput{}, nil
+		},
+		MockPutConfigurationSetDeliveryOptions: func(context.Context, *awsses.PutConfigurationSetDeliveryOptionsInput, []request.Option) (*awsses.PutConfigurationSetDeliveryOptionsOutput, error) {
+			return &awsses.PutConfigurationSetDeliveryOptionsOutput{}, nil
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: updatedClient(),
+				cr:  configurationSet(),
+			},
+			want: want{
+				cr: configurationSet(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockCreateConfigurationSet: func(context.Context, *awsses.CreateConfigurationSetInput, []request.Option) (*awsses.CreateConfigurationSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: configurationSet(),
+			},
+			want: want{
+				cr:  configurationSet(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: updatedClient(),
+				cr:  configurationSet(),
+			},
+		},
+		"UpdateSendingError": {
+			args: args{
+				ses: updatedClient(),
+				cr:  configurationSet(withSendingEnabled(false)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdateSending),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"AlreadyDeleted": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteConfigurationSet: func(context.Context, *awsses.DeleteConfigurationSetInput, []request.Option) (*awsses.DeleteConfigurationSetOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeConfigurationSetDoesNotExistException, "", nil)
+					},
+				},
+				cr: configurationSet(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteConfigurationSet: func(context.Context, *awsses.DeleteConfigurationSetInput, []request.Option) (*awsses.DeleteConfigurationSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: configurationSet(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainidentity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a DomainIdentity resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the verification attributes of the DomainIdentity"
+	errGetDKIM          = "failed to get the DKIM attributes of the DomainIdentity"
+	errVerify           = "failed to verify the DomainIdentity"
+	errVerifyDKIM       = "failed to generate the DKIM tokens of the DomainIdentity"
+	errSetDKIM          = "failed to set whether DKIM signing is enabled for the DomainIdentity"
+	errDelete           = "failed to delete the DomainIdentity"
+	errUpsertRecords    = "failed to upsert the verification and DKIM records of the DomainIdentity"
+	errDeleteRecords    = "failed to delete the verification and DKIM records of the DomainIdentity"
+)
+
+// SetupDomainIdentity adds a controller that reconciles DomainIdentities.
+func SetupDomainIdentity(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.DomainIdentityGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.DomainIdentity{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.DomainIdentityGroupVersionKind),
+			&connector{
+				kube:              mgr.GetClient(),
+				newClientFn:       ses.NewClient,
+				newRecordClientFn: resourcerecordset.NewClient,
+			},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube              client.Client
+	newClientFn       func(*session.Session) ses.Client
+	newRecordClientFn func(aws.Config) resourcerecordset.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.DomainIdentity)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), records: c.newRecordClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client  ses.Client
+	records resourcerecordset.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.DomainIdentity)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	domain := cr.Spec.ForProvider.Domain
+	resp, err := e.client.GetIdentityVerificationAttributesWithContext(ctx, &awsses.GetIdentityVerificationAttributesInput{
+		Identities: []*string{awsclient.String(domain)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+	attrs := resp.VerificationAttributes[domain]
+	if attrs == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	dkim, err := e.client.GetIdentityDkimAttributesWithContext(ctx, &awsses.GetIdentityDkimAttributesInput{
+		Identities: []*string{awsclient.String(domain)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetDKIM)
+	}
+
+	obs := &cr.Status.AtProvider
+	obs.VerificationStatus = awsclient.StringValue(attrs.VerificationStatus)
+	obs.VerificationToken = awsclient.StringValue(attrs.VerificationToken)
+	obs.DKIMEnabled = false
+	obs.DKIMVerificationStatus = ""
+	obs.DKIMTokens = nil
+	if d := dkim.DkimAttributes[domain]; d != nil {
+		obs.DKIMEnabled = awsclient.BoolValue(d.DkimEnabled)
+		obs.DKIMVerificationStatus = awsclient.StringValue(d.DkimVerificationStatus)
+		if len(d.DkimTokens) > 0 {
+			obs.DKIMTokens = awsgo.StringValueSlice(d.DkimTokens)
+		}
+	}
+	switch obs.VerificationStatus {
+	case awsses.VerificationStatusSuccess:
+		cr.SetConditions(xpv1.Available())
+	case awsses.VerificationStatusPending:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// A failed verification is retried by verifying the domain again.
+	wantDKIM := awsclient.BoolValue(cr.Spec.ForProvider.DKIMEnabled)
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: !isVerificationFailed(obs.VerificationStatus) &&
+			obs.DKIMEnabled == wantDKIM && (!wantDKIM || len(obs.DKIMTokens) > 0),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.DomainIdentity)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.set(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.DomainIdentity)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.set(ctx, cr)
+}
+
+// set verifies the domain, enables or disables DKIM signing for it and, if
+// a hosted zone is given, upserts the records SES verifies the domain and
+// its DKIM tokens with. Verifying a domain again returns its existing
+// verification token.
+func (e *external) set(ctx context.Context, cr *v1alpha1.DomainIdentity) error {
+	p := cr.Spec.ForProvider
+	resp, err := e.client.VerifyDomainIdentityWithContext(ctx, &awsses.VerifyDomainIdentityInput{
+		Domain: awsclient.String(p.Domain),
+	})
+	if err != nil {
+		return awsclient.Wrap(err, errVerify)
+	}
+
+	var tokens []string
+	wantDKIM := awsclient.BoolValue(p.DKIMEnabled)
+	switch {
+	case wantDKIM && len(cr.Status.AtProvider.DKIMTokens) == 0:
+		// Generating DKIM tokens enables DKIM signing once they are
+		// verified.
+		dkim, err := e.client.VerifyDomainDkimWithContext(ctx, &awsses.VerifyDomainDkimInput{
+			Domain: awsclient.String(p.Domain),
+		})
+		if err != nil {
+			return awsclient.Wrap(err, errVerifyDKIM)
+		}
+		tokens = awsgo.StringValueSlice(dkim.DkimTokens)
+	case cr.Status.AtProvider.DKIMEnabled != wantDKIM:
+		if _, err := e.client.SetIdentityDkimEnabledWithContext(ctx, &awsses.SetIdentityDkimEnabledInput{
+			Identity:    awsclient.String(p.Domain),
+			DkimEnabled: aws.Bool(wantDKIM),
+		}); err != nil {
+			return awsclient.Wrap(err, errSetDKIM)
+		}
+	}
+	if wantDKIM && tokens == nil {
+		tokens = cr.Status.AtProvider.DKIMTokens
+	}
+
+	if p.HostedZoneID == nil {
+		return nil
+	}
+	_, err = e.records.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: p.HostedZoneID,
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: ses.GenerateDomainIdentityRecordChanges(route53types.ChangeActionUpsert, p.Domain, awsclient.StringValue(resp.VerificationToken), tokens),
+		},
+	})
+	return awsclient.Wrap(err, errUpsertRecords)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.DomainIdentity)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	p := cr.Spec.ForProvider
+	if _, err := e.client.DeleteIdentityWithContext(ctx, &awsses.DeleteIdentityInput{
+		Identity: awsclient.String(p.Domain),
+	}); err != nil {
+		return awsclient.Wrap(err, errDelete)
+	}
+	if p.HostedZoneID == nil {
+		return nil
+	}
+	// The verification and DKIM records are deleted in separate batches,
+	// so that the verification record is deleted even if DKIM records were
+	// never created.
+	obs := cr.Status.AtProvider
+	for _, changes := range [][]route53types.Change{
+		ses.GenerateDomainIdentityRecordChanges(route53types.ChangeActionDelete, p.Domain, obs.VerificationToken, nil),
+		ses.GenerateDomainIdentityRecordChanges(route53types.ChangeActionDelete, p.Domain, "", obs.DKIMTokens),
+	} {
+		if len(changes) == 0 {
+			continue
+		}
+		_, err := e.records.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: p.HostedZoneID,
+			ChangeBatch:  &route53types.ChangeBatch{Changes: changes},
+		})
+		// Deleting records that no longer exist fails with an invalid
+		// change batch.
+		if err := resource.Ignore(isInvalidChangeBatch, err); err != nil {
+			return awsclient.Wrap(err, errDeleteRecords)
+		}
+	}
+	return nil
+}
+
+func isVerificationFailed(status string) bool {
+	return status == awsses.VerificationStatusFailed || status == awsses.VerificationStatusTemporaryFailure
+}
+
+func isInvalidChangeBatch(err error) bool {
+	var icb *route53types.InvalidChangeBatch
+	return errors.As(err, &icb)
+}
@@ -0,0 +1,429 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package domainidentity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	awsgo "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	rrsfake "github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	domainName = "example.com"
+	token      = "token"
+	dkimTokens = []string{"a", "b", "c"}
+	zoneID     = "Z123"
+	errBoom    = errors.New("boom")
+)
+
+type args struct {
+	ses     *fake.MockClient
+	records *rrsfake.MockResourceRecordSetClient
+	cr      resource.Managed
+}
+
+type identityModifier func(*v1alpha1.DomainIdentity)
+
+func withDKIM(b bool) identityModifier {
+	return func(r *v1alpha1.DomainIdentity) { r.Spec.ForProvider.DKIMEnabled = &b }
+}
+
+func withHostedZoneID(s string) identityModifier {
+	return func(r *v1alpha1.DomainIdentity) { r.Spec.ForProvider.HostedZoneID = &s }
+}
+
+func withObservation(o v1alpha1.DomainIdentityObservation) identityModifier {
+	return func(r *v1alpha1.DomainIdentity) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) identityModifier {
+	return func(r *v1alpha1.DomainIdentity) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func identity(m ...identityModifier) *v1alpha1.DomainIdentity {
+	cr := &v1alpha1.DomainIdentity{
+		Spec: v1alpha1.DomainIdentitySpec{
+			ForProvider: v1alpha1.DomainIdentityParameters{
+				Region: "us-east-1",
+				Domain: domainName,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func attributes(a *awsses.IdentityVerificationAttributes) func(context.Context, *awsses.GetIdentityVerificationAttributesInput, []request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error) {
+	return func(context.Context, *awsses.GetIdentityVerificationAttributesInput, []request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error) {
+		out := &awsses.GetIdentityVerificationAttributesOutput{VerificationAttributes: map[string]*awsses.IdentityVerificationAttributes{}}
+		if a != nil {
+			out.VerificationAttributes[domainName] = a
+		}
+		return out, nil
+	}
+}
+
+func dkimAttributes(a *awsses.IdentityDkimAttributes) func(context.Context, *awsses.GetIdentityDkimAttributesInput, []request.Option) (*awsses.GetIdentityDkimAttributesOutput, error) {
+	return func(context.Context, *awsses.GetIdentityDkimAttributesInput, []request.Option) (*awsses.GetIdentityDkimAttributesOutput, error) {
+		return &awsses.GetIdentityDkimAttributesOutput{DkimAttributes: map[string]*awsses.IdentityDkimAttributes{domainName: a}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(nil),
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(),
+			},
+		},
+		"GetDKIMError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusSuccess),
+					}),
+					MockGetIdentityDkimAttributes: func(context.Context, *awsses.GetIdentityDkimAttributesInput, []request.Option) (*awsses.GetIdentityDkimAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr:  identity(),
+				err: awsclient.Wrap(errBoom, errGetDKIM),
+			},
+		},
+		"VerifiedUpToDate": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusSuccess),
+						VerificationToken:  &token,
+					}),
+					MockGetIdentityDkimAttributes: dkimAttributes(&awsses.IdentityDkimAttributes{
+						DkimEnabled:            awsclient.Bool(true),
+						DkimVerificationStatus: awsclient.String(awsses.VerificationStatusSuccess),
+						DkimTokens:             awsgo.StringSlice(dkimTokens),
+					}),
+				},
+				cr: identity(withDKIM(true)),
+			},
+			want: want{
+				cr: identity(withDKIM(true), withConditions(xpv1.Available()), withObservation(v1alpha1.DomainIdentityObservation{
+					VerificationStatus:     awsses.VerificationStatusSuccess,
+					VerificationToken:      token,
+					DKIMEnabled:            true,
+					DKIMVerificationStatus: awsses.VerificationStatusSuccess,
+					DKIMTokens:             dkimTokens,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DKIMNotGenerated": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusPending),
+						VerificationToken:  &token,
+					}),
+					MockGetIdentityDkimAttributes: dkimAttributes(&awsses.IdentityDkimAttributes{}),
+				},
+				cr: identity(withDKIM(true)),
+			},
+			want: want{
+				cr: identity(withDKIM(true), withConditions(xpv1.Creating()), withObservation(v1alpha1.DomainIdentityObservation{
+					VerificationStatus: awsses.VerificationStatusPending,
+					VerificationToken:  token,
+				})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses, records: tc.records}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func verify(context.Context, *awsses.VerifyDomainIdentityInput, []request.Option) (*awsses.VerifyDomainIdentityOutput, error) {
+	return &awsses.VerifyDomainIdentityOutput{VerificationToken: &token}, nil
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		changes int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"WithoutHostedZone": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: verify,
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withConditions(xpv1.Creating())),
+			},
+		},
+		"WithHostedZoneAndDKIM": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: verify,
+					MockVerifyDomainDkim: func(context.Context, *awsses.VerifyDomainDkimInput, []request.Option) (*awsses.VerifyDomainDkimOutput, error) {
+						return &awsses.VerifyDomainDkimOutput{DkimTokens: awsgo.StringSlice(dkimTokens)}, nil
+					},
+				},
+				cr: identity(withDKIM(true), withHostedZoneID(zoneID)),
+			},
+			want: want{
+				cr:      identity(withDKIM(true), withHostedZoneID(zoneID), withConditions(xpv1.Creating())),
+				changes: 4,
+			},
+		},
+		"VerifyError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: func(context.Context, *awsses.VerifyDomainIdentityInput, []request.Option) (*awsses.VerifyDomainIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr:  identity(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errVerify),
+			},
+		},
+		"VerifyDKIMError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: verify,
+					MockVerifyDomainDkim: func(context.Context, *awsses.VerifyDomainDkimInput, []request.Option) (*awsses.VerifyDomainDkimOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(withDKIM(true)),
+			},
+			want: want{
+				cr:  identity(withDKIM(true), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errVerifyDKIM),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var changes int
+			e := &external{client: tc.ses, records: &rrsfake.MockResourceRecordSetClient{
+				MockChangeResourceRecordSets: func(_ context.Context, input *route53.ChangeResourceRecordSetsInput, _ []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					for _, c := range input.ChangeBatch.Changes {
+						if c.Action == route53types.ChangeActionUpsert {
+							changes++
+						}
+					}
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changes, changes); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DisableDKIM": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: verify,
+					MockSetIdentityDkimEnabled: func(_ context.Context, input *awsses.SetIdentityDkimEnabledInput, _ []request.Option) (*awsses.SetIdentityDkimEnabledOutput, error) {
+						if awsclient.BoolValue(input.DkimEnabled) {
+							return nil, errBoom
+						}
+						return &awsses.SetIdentityDkimEnabledOutput{}, nil
+					},
+				},
+				cr: identity(withDKIM(false), withObservation(v1alpha1.DomainIdentityObservation{DKIMEnabled: true, DKIMTokens: dkimTokens})),
+			},
+		},
+		"SetDKIMError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyDomainIdentity: verify,
+					MockSetIdentityDkimEnabled: func(context.Context, *awsses.SetIdentityDkimEnabledInput, []request.Option) (*awsses.SetIdentityDkimEnabledOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(withDKIM(true), withObservation(v1alpha1.DomainIdentityObservation{DKIMTokens: dkimTokens})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errSetDKIM),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses, records: tc.records}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RecordsAlreadyDeleted": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteIdentity: func(context.Context, *awsses.DeleteIdentityInput, []request.Option) (*awsses.DeleteIdentityOutput, error) {
+						return &awsses.DeleteIdentityOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(context.Context, *route53.ChangeResourceRecordSetsInput, []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, &route53types.InvalidChangeBatch{}
+					},
+				},
+				cr: identity(withHostedZoneID(zoneID), withObservation(v1alpha1.DomainIdentityObservation{VerificationToken: token, DKIMTokens: dkimTokens})),
+			},
+		},
+		"DeleteRecordsError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteIdentity: func(context.Context, *awsses.DeleteIdentityInput, []request.Option) (*awsses.DeleteIdentityOutput, error) {
+						return &awsses.DeleteIdentityOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(context.Context, *route53.ChangeResourceRecordSetsInput, []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(withHostedZoneID(zoneID), withObservation(v1alpha1.DomainIdentityObservation{VerificationToken: token})),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDeleteRecords),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteIdentity: func(context.Context, *awsses.DeleteIdentityInput, []request.Option) (*awsses.DeleteIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses, records: tc.records}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
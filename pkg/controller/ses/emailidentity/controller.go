@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailidentity
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an EmailIdentity resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the verification attributes of the EmailIdentity"
+	errVerify           = "failed to verify the EmailIdentity"
+	errDelete           = "failed to delete the EmailIdentity"
+)
+
+// SetupEmailIdentity adds a controller that reconciles EmailIdentities.
+func SetupEmailIdentity(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.EmailIdentityGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.EmailIdentity{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.EmailIdentityGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ses.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) ses.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.EmailIdentity)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client ses.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.EmailIdentity)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	email := cr.Spec.ForProvider.EmailAddress
+	resp, err := e.client.GetIdentityVerificationAttributesWithContext(ctx, &awsses.GetIdentityVerificationAttributesInput{
+		Identities: []*string{awsclient.String(email)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+	attrs := resp.VerificationAttributes[email]
+	if attrs == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider.VerificationStatus = awsclient.StringValue(attrs.VerificationStatus)
+	switch cr.Status.AtProvider.VerificationStatus {
+	case awsses.VerificationStatusSuccess:
+		cr.SetConditions(xpv1.Available())
+	case awsses.VerificationStatusPending:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	// A failed verification is retried by sending the verification email
+	// again.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !isVerificationFailed(cr.Status.AtProvider.VerificationStatus),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.EmailIdentity)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.verify(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.EmailIdentity)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.verify(ctx, cr)
+}
+
+// verify adds the email address to the identities of the account and sends
+// it a verification email.
+func (e *external) verify(ctx context.Context, cr *v1alpha1.EmailIdentity) error {
+	_, err := e.client.VerifyEmailIdentityWithContext(ctx, &awsses.VerifyEmailIdentityInput{
+		EmailAddress: awsclient.String(cr.Spec.ForProvider.EmailAddress),
+	})
+	return awsclient.Wrap(err, errVerify)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.EmailIdentity)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteIdentityWithContext(ctx, &awsses.DeleteIdentityInput{
+		Identity: awsclient.String(cr.Spec.ForProvider.EmailAddress),
+	})
+	return awsclient.Wrap(err, errDelete)
+}
+
+func isVerificationFailed(status string) bool {
+	return status == awsses.VerificationStatusFailed || status == awsses.VerificationStatusTemporaryFailure
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package emailidentity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	email   = "info@example.com"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ses *fake.MockClient
+	cr  resource.Managed
+}
+
+type identityModifier func(*v1alpha1.EmailIdentity)
+
+func withStatus(s string) identityModifier {
+	return func(r *v1alpha1.EmailIdentity) { r.Status.AtProvider.VerificationStatus = s }
+}
+
+func withConditions(c ...xpv1.Condition) identityModifier {
+	return func(r *v1alpha1.EmailIdentity) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func identity(m ...identityModifier) *v1alpha1.EmailIdentity {
+	cr := &v1alpha1.EmailIdentity{
+		Spec: v1alpha1.EmailIdentitySpec{
+			ForProvider: v1alpha1.EmailIdentityParameters{
+				Region:       "us-east-1",
+				EmailAddress: email,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func attributes(a *awsses.IdentityVerificationAttributes) func(context.Context, *awsses.GetIdentityVerificationAttributesInput, []request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error) {
+	return func(context.Context, *awsses.GetIdentityVerificationAttributesInput, []request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error) {
+		out := &awsses.GetIdentityVerificationAttributesOutput{VerificationAttributes: map[string]*awsses.IdentityVerificationAttributes{}}
+		if a != nil {
+			out.VerificationAttributes[email] = a
+		}
+		return out, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(nil),
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(),
+			},
+		},
+		"GetError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: func(context.Context, *awsses.GetIdentityVerificationAttributesInput, []request.Option) (*awsses.GetIdentityVerificationAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr:  identity(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"Verified": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusSuccess),
+					}),
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withStatus(awsses.VerificationStatusSuccess), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Pending": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusPending),
+					}),
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withStatus(awsses.VerificationStatusPending), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"Failed": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityVerificationAttributes: attributes(&awsses.IdentityVerificationAttributes{
+						VerificationStatus: awsclient.String(awsses.VerificationStatusFailed),
+					}),
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withStatus(awsses.VerificationStatusFailed), withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyEmailIdentity: func(_ context.Context, input *awsses.VerifyEmailIdentityInput, _ []request.Option) (*awsses.VerifyEmailIdentityOutput, error) {
+						if awsclient.StringValue(input.EmailAddress) != email {
+							return nil, errBoom
+						}
+						return &awsses.VerifyEmailIdentityOutput{}, nil
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr: identity(withConditions(xpv1.Creating())),
+			},
+		},
+		"VerifyError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockVerifyEmailIdentity: func(context.Context, *awsses.VerifyEmailIdentityInput, []request.Option) (*awsses.VerifyEmailIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				cr:  identity(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errVerify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteIdentity: func(context.Context, *awsses.DeleteIdentityInput, []request.Option) (*awsses.DeleteIdentityOutput, error) {
+						return &awsses.DeleteIdentityOutput{}, nil
+					},
+				},
+				cr: identity(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteIdentity: func(context.Context, *awsses.DeleteIdentityInput, []request.Option) (*awsses.DeleteIdentityOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: identity(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
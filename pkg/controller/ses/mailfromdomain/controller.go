@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mailfromdomain
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/resourcerecordset"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a MailFromDomain resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the MAIL FROM domain attributes of the identity"
+	errSet              = "failed to set the MAIL FROM domain of the identity"
+	errUnset            = "failed to unset the MAIL FROM domain of the identity"
+	errUpsertRecords    = "failed to upsert the MX and SPF records of the MAIL FROM domain"
+	errDeleteRecords    = "failed to delete the MX and SPF records of the MAIL FROM domain"
+)
+
+// SetupMailFromDomain adds a controller that reconciles MailFromDomains.
+func SetupMailFromDomain(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.MailFromDomainGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.MailFromDomain{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.MailFromDomainGroupVersionKind),
+			&connector{
+				kube:              mgr.GetClient(),
+				newClientFn:       ses.NewClient,
+				newRecordClientFn: resourcerecordset.NewClient,
+			},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube              client.Client
+	newClientFn       func(*session.Session) ses.Client
+	newRecordClientFn func(aws.Config) resourcerecordset.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.MailFromDomain)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, awsclient.GlobalRegion)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(sess), records: c.newRecordClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client  ses.Client
+	records resourcerecordset.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MailFromDomain)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	p := cr.Spec.ForProvider
+	resp, err := e.client.GetIdentityMailFromDomainAttributesWithContext(ctx, &awsses.GetIdentityMailFromDomainAttributesInput{
+		Identities: []*string{awsclient.String(p.Identity)},
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGet)
+	}
+	attrs := resp.MailFromDomainAttributes[p.Identity]
+	if attrs == nil || awsclient.StringValue(attrs.MailFromDomain) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.Status.AtProvider.MailFromDomainStatus = awsclient.StringValue(attrs.MailFromDomainStatus)
+	switch cr.Status.AtProvider.MailFromDomainStatus {
+	case awsses.CustomMailFromStatusSuccess:
+		cr.SetConditions(xpv1.Available())
+	case awsses.CustomMailFromStatusPending:
+		cr.SetConditions(xpv1.Creating())
+	default:
+		cr.SetConditions(xpv1.Unavailable())
+	}
+
+	behavior := behaviorOnMXFailure(p)
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: awsclient.StringValue(attrs.MailFromDomain) == p.MailFromDomain &&
+			awsclient.StringValue(attrs.BehaviorOnMXFailure) == behavior,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.MailFromDomain)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.set(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.MailFromDomain)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.set(ctx, cr)
+}
+
+// set configures the MAIL FROM domain of the identity and, if a hosted zone
+// is given, the MX and SPF records SES uses to verify it.
+func (e *external) set(ctx context.Context, cr *v1alpha1.MailFromDomain) error {
+	p := cr.Spec.ForProvider
+	if p.HostedZoneID != nil {
+		if _, err := e.records.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+			HostedZoneId: p.HostedZoneID,
+			ChangeBatch: &route53types.ChangeBatch{
+				Changes: ses.GenerateMailFromRecordChanges(route53types.ChangeActionUpsert, p.Region, p.MailFromDomain),
+			},
+		}); err != nil {
+			return awsclient.Wrap(err, errUpsertRecords)
+		}
+	}
+	_, err := e.client.SetIdentityMailFromDomainWithContext(ctx, &awsses.SetIdentityMailFromDomainInput{
+		Identity:            awsclient.String(p.Identity),
+		MailFromDomain:      awsclient.String(p.MailFromDomain),
+		BehaviorOnMXFailure: awsclient.String(behaviorOnMXFailure(p)),
+	})
+	return awsclient.Wrap(err, errSet)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MailFromDomain)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	p := cr.Spec.ForProvider
+	if _, err := e.client.SetIdentityMailFromDomainWithContext(ctx, &awsses.SetIdentityMailFromDomainInput{
+		Identity: awsclient.String(p.Identity),
+	}); err != nil {
+		return awsclient.Wrap(err, errUnset)
+	}
+	if p.HostedZoneID == nil {
+		return nil
+	}
+	_, err := e.records.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: p.HostedZoneID,
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: ses.GenerateMailFromRecordChanges(route53types.ChangeActionDelete, p.Region, p.MailFromDomain),
+		},
+	})
+	// Deleting records that no longer exist fails with an invalid change
+	// batch.
+	return awsclient.Wrap(resource.Ignore(isInvalidChangeBatch, err), errDeleteRecords)
+}
+
+// behaviorOnMXFailure returns the behavior on MX failure of the given
+// parameters, or the SES default if none is given.
+func behaviorOnMXFailure(p v1alpha1.MailFromDomainParameters) string {
+	if p.BehaviorOnMXFailure != nil {
+		return *p.BehaviorOnMXFailure
+	}
+	return ses.DefaultBehaviorOnMXFailure
+}
+
+func isInvalidChangeBatch(err error) bool {
+	var icb *route53types.InvalidChangeBatch
+	return errors.As(err, &icb)
+}
@@ -0,0 +1,340 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mailfromdomain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	rrsfake "github.com/crossplane/provider-aws/pkg/clients/resourcerecordset/fake"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	identity       = "example.com"
+	mailFromDomain = "mail.example.com"
+	zoneID         = "Z123"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	ses     *fake.MockClient
+	records *rrsfake.MockResourceRecordSetClient
+	cr      resource.Managed
+}
+
+type domainModifier func(*v1alpha1.MailFromDomain)
+
+func withHostedZoneID(s string) domainModifier {
+	return func(r *v1alpha1.MailFromDomain) { r.Spec.ForProvider.HostedZoneID = &s }
+}
+
+func withStatus(s string) domainModifier {
+	return func(r *v1alpha1.MailFromDomain) { r.Status.AtProvider.MailFromDomainStatus = s }
+}
+
+func withConditions(c ...xpv1.Condition) domainModifier {
+	return func(r *v1alpha1.MailFromDomain) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func domain(m ...domainModifier) *v1alpha1.MailFromDomain {
+	cr := &v1alpha1.MailFromDomain{
+		Spec: v1alpha1.MailFromDomainSpec{
+			ForProvider: v1alpha1.MailFromDomainParameters{
+				Region:         "us-east-1",
+				Identity:       identity,
+				MailFromDomain: mailFromDomain,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func attributes(a *awsses.IdentityMailFromDomainAttributes) func(context.Context, *awsses.GetIdentityMailFromDomainAttributesInput, []request.Option) (*awsses.GetIdentityMailFromDomainAttributesOutput, error) {
+	return func(context.Context, *awsses.GetIdentityMailFromDomainAttributesInput, []request.Option) (*awsses.GetIdentityMailFromDomainAttributesOutput, error) {
+		out := &awsses.GetIdentityMailFromDomainAttributesOutput{MailFromDomainAttributes: map[string]*awsses.IdentityMailFromDomainAttributes{}}
+		if a != nil {
+			out.MailFromDomainAttributes[identity] = a
+		}
+		return out, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotConfigured": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityMailFromDomainAttributes: attributes(nil),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(),
+			},
+		},
+		"GetError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityMailFromDomainAttributes: func(context.Context, *awsses.GetIdentityMailFromDomainAttributesInput, []request.Option) (*awsses.GetIdentityMailFromDomainAttributesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"VerifiedUpToDate": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityMailFromDomainAttributes: attributes(&awsses.IdentityMailFromDomainAttributes{
+						MailFromDomain:       &mailFromDomain,
+						MailFromDomainStatus: awsclient.String(awsses.CustomMailFromStatusSuccess),
+						BehaviorOnMXFailure:  awsclient.String(awsses.BehaviorOnMXFailureUseDefaultValue),
+					}),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withStatus(awsses.CustomMailFromStatusSuccess), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingOtherDomain": {
+			args: args{
+				ses: &fake.MockClient{
+					MockGetIdentityMailFromDomainAttributes: attributes(&awsses.IdentityMailFromDomainAttributes{
+						MailFromDomain:       awsclient.String("bounce.example.com"),
+						MailFromDomainStatus: awsclient.String(awsses.CustomMailFromStatusPending),
+						BehaviorOnMXFailure:  awsclient.String(awsses.BehaviorOnMXFailureUseDefaultValue),
+					}),
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withStatus(awsses.CustomMailFromStatusPending), withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses, records: tc.records}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      resource.Managed
+		changes int
+		err     error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"WithoutHostedZone": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(context.Context, *awsses.SetIdentityMailFromDomainInput, []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						return &awsses.SetIdentityMailFromDomainOutput{}, nil
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr: domain(withConditions(xpv1.Creating())),
+			},
+		},
+		"WithHostedZone": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(_ context.Context, input *awsses.SetIdentityMailFromDomainInput, _ []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						if awsclient.StringValue(input.BehaviorOnMXFailure) != awsses.BehaviorOnMXFailureUseDefaultValue {
+							return nil, errBoom
+						}
+						return &awsses.SetIdentityMailFromDomainOutput{}, nil
+					},
+				},
+				cr: domain(withHostedZoneID(zoneID)),
+			},
+			want: want{
+				cr:      domain(withHostedZoneID(zoneID), withConditions(xpv1.Creating())),
+				changes: 2,
+			},
+		},
+		"SetError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(context.Context, *awsses.SetIdentityMailFromDomainInput, []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				cr:  domain(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errSet),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var changes int
+			e := &external{client: tc.ses, records: &rrsfake.MockResourceRecordSetClient{
+				MockChangeResourceRecordSets: func(_ context.Context, input *route53.ChangeResourceRecordSetsInput, _ []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+					for _, c := range input.ChangeBatch.Changes {
+						if c.Action == route53types.ChangeActionUpsert {
+							changes++
+						}
+					}
+					return &route53.ChangeResourceRecordSetsOutput{}, nil
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.changes, changes); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"RecordsAlreadyDeleted": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(_ context.Context, input *awsses.SetIdentityMailFromDomainInput, _ []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						if input.MailFromDomain != nil {
+							return nil, errBoom
+						}
+						return &awsses.SetIdentityMailFromDomainOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(context.Context, *route53.ChangeResourceRecordSetsInput, []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, &route53types.InvalidChangeBatch{}
+					},
+				},
+				cr: domain(withHostedZoneID(zoneID)),
+			},
+		},
+		"DeleteRecordsError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(context.Context, *awsses.SetIdentityMailFromDomainInput, []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						return &awsses.SetIdentityMailFromDomainOutput{}, nil
+					},
+				},
+				records: &rrsfake.MockResourceRecordSetClient{
+					MockChangeResourceRecordSets: func(context.Context, *route53.ChangeResourceRecordSetsInput, []func(*route53.Options)) (*route53.ChangeResourceRecordSetsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(withHostedZoneID(zoneID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDeleteRecords),
+			},
+		},
+		"UnsetError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockSetIdentityMailFromDomain: func(context.Context, *awsses.SetIdentityMailFromDomainInput, []request.Option) (*awsses.SetIdentityMailFromDomainOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: domain(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUnset),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses, records: tc.records}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
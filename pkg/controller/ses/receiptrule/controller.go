@@ -0,0 +1,172 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiptrule
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ReceiptRule resource"
+	errCreateSession    = "cannot create a new session"
+	errNoRuleSetName    = "ruleSetName must be set"
+	errDescribe         = "failed to describe ReceiptRule"
+	errDescribeRuleSet  = "failed to describe the ReceiptRuleSet of the ReceiptRule"
+	errCreate           = "failed to create ReceiptRule"
+	errUpdate           = "failed to update ReceiptRule"
+	errSetPosition      = "failed to set the position of ReceiptRule"
+	errDelete           = "failed to delete ReceiptRule"
+)
+
+// SetupReceiptRule adds a controller that reconciles ReceiptRules.
+func SetupReceiptRule(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ReceiptRuleGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.ReceiptRule{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ReceiptRuleGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ses.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) ses.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRule)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client ses.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if cr.Spec.ForProvider.RuleSetName == nil {
+		return managed.ExternalObservation{}, errors.New(errNoRuleSetName)
+	}
+	name := meta.GetExternalName(cr)
+	rule, err := e.client.DescribeReceiptRuleWithContext(ctx, &awsses.DescribeReceiptRuleInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+		RuleName:    &name,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDescribe)
+	}
+	set, err := e.client.DescribeReceiptRuleSetWithContext(ctx, &awsses.DescribeReceiptRuleSetInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeRuleSet)
+	}
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: ses.IsReceiptRuleUpToDate(name, cr.Spec.ForProvider, rule.Rule) &&
+			ses.IsReceiptRuleInPosition(name, cr.Spec.ForProvider.After, set.Rules),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	_, err := e.client.CreateReceiptRuleWithContext(ctx, &awsses.CreateReceiptRuleInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+		After:       cr.Spec.ForProvider.After,
+		Rule:        ses.GenerateReceiptRule(meta.GetExternalName(cr), cr.Spec.ForProvider),
+	})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.UpdateReceiptRuleWithContext(ctx, &awsses.UpdateReceiptRuleInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+		Rule:        ses.GenerateReceiptRule(name, cr.Spec.ForProvider),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
+	_, err := e.client.SetReceiptRulePositionWithContext(ctx, &awsses.SetReceiptRulePositionInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+		RuleName:    &name,
+		After:       cr.Spec.ForProvider.After,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errSetPosition)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ReceiptRule)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DeleteReceiptRuleWithContext(ctx, &awsses.DeleteReceiptRuleInput{
+		RuleSetName: cr.Spec.ForProvider.RuleSetName,
+		RuleName:    awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDelete)
+}
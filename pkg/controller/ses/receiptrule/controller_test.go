@@ -0,0 +1,393 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiptrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	setName  = "set"
+	ruleName = "rule"
+	first    = "first"
+	bucket   = "bucket"
+	errBoom  = errors.New("boom")
+)
+
+type args struct {
+	ses *fake.MockClient
+	cr  resource.Managed
+}
+
+type ruleModifier func(*v1alpha1.ReceiptRule)
+
+func withAfter(s string) ruleModifier {
+	return func(r *v1alpha1.ReceiptRule) { r.Spec.ForProvider.After = &s }
+}
+
+func withRuleSetName(s *string) ruleModifier {
+	return func(r *v1alpha1.ReceiptRule) { r.Spec.ForProvider.RuleSetName = s }
+}
+
+func withConditions(c ...xpv1.Condition) ruleModifier {
+	return func(r *v1alpha1.ReceiptRule) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func rule(m ...ruleModifier) *v1alpha1.ReceiptRule {
+	cr := &v1alpha1.ReceiptRule{
+		Spec: v1alpha1.ReceiptRuleSpec{
+			ForProvider: v1alpha1.ReceiptRuleParameters{
+				RuleSetName: &setName,
+				Enabled:     awsclient.Bool(true),
+				Actions: []v1alpha1.ReceiptAction{
+					{S3Action: &v1alpha1.S3Action{BucketName: &bucket}},
+				},
+			},
+		},
+	}
+	meta.SetExternalName(cr, ruleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeRule(r *awsses.ReceiptRule) func(context.Context, *awsses.DescribeReceiptRuleInput, []request.Option) (*awsses.DescribeReceiptRuleOutput, error) {
+	return func(context.Context, *awsses.DescribeReceiptRuleInput, []request.Option) (*awsses.DescribeReceiptRuleOutput, error) {
+		return &awsses.DescribeReceiptRuleOutput{Rule: r}, nil
+	}
+}
+
+func describeSet(names ...string) func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+	return func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+		out := &awsses.DescribeReceiptRuleSetOutput{}
+		for i := range names {
+			out.Rules = append(out.Rules, &awsses.ReceiptRule{Name: &names[i]})
+		}
+		return out, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoRuleSetName": {
+			args: args{
+				cr: rule(withRuleSetName(nil)),
+			},
+			want: want{
+				cr:  rule(withRuleSetName(nil)),
+				err: errors.New(errNoRuleSetName),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRule: func(context.Context, *awsses.DescribeReceiptRuleInput, []request.Option) (*awsses.DescribeReceiptRuleOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeRuleDoesNotExistException, "", nil)
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRule: func(context.Context, *awsses.DescribeReceiptRuleInput, []request.Option) (*awsses.DescribeReceiptRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRule:    describeRule(ses.GenerateReceiptRule(ruleName, rule().Spec.ForProvider)),
+					MockDescribeReceiptRuleSet: describeSet(first, ruleName),
+				},
+				cr: rule(withAfter(first)),
+			},
+			want: want{
+				cr: rule(withAfter(first), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"WrongPosition": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRule:    describeRule(ses.GenerateReceiptRule(ruleName, rule().Spec.ForProvider)),
+					MockDescribeReceiptRuleSet: describeSet(ruleName, first),
+				},
+				cr: rule(withAfter(first)),
+			},
+			want: want{
+				cr: rule(withAfter(first), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"RuleChanged": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRule:    describeRule(&awsses.ReceiptRule{Name: &ruleName}),
+					MockDescribeReceiptRuleSet: describeSet(ruleName),
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr: rule(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: &fake.MockClient{
+					MockCreateReceiptRule: func(_ context.Context, input *awsses.CreateReceiptRuleInput, _ []request.Option) (*awsses.CreateReceiptRuleOutput, error) {
+						if awsclient.StringValue(input.After) != first || awsclient.StringValue(input.Rule.Name) != ruleName {
+							return nil, errBoom
+						}
+						return &awsses.CreateReceiptRuleOutput{}, nil
+					},
+				},
+				cr: rule(withAfter(first)),
+			},
+			want: want{
+				cr: rule(withAfter(first), withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockCreateReceiptRule: func(context.Context, *awsses.CreateReceiptRuleInput, []request.Option) (*awsses.CreateReceiptRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				cr:  rule(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: &fake.MockClient{
+					MockUpdateReceiptRule: func(context.Context, *awsses.UpdateReceiptRuleInput, []request.Option) (*awsses.UpdateReceiptRuleOutput, error) {
+						return &awsses.UpdateReceiptRuleOutput{}, nil
+					},
+					MockSetReceiptRulePosition: func(_ context.Context, input *awsses.SetReceiptRulePositionInput, _ []request.Option) (*awsses.SetReceiptRulePositionOutput, error) {
+						if awsclient.StringValue(input.After) != first {
+							return nil, errBoom
+						}
+						return &awsses.SetReceiptRulePositionOutput{}, nil
+					},
+				},
+				cr: rule(withAfter(first)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockUpdateReceiptRule: func(context.Context, *awsses.UpdateReceiptRuleInput, []request.Option) (*awsses.UpdateReceiptRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+		"SetPositionError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockUpdateReceiptRule: func(context.Context, *awsses.UpdateReceiptRuleInput, []request.Option) (*awsses.UpdateReceiptRuleOutput, error) {
+						return &awsses.UpdateReceiptRuleOutput{}, nil
+					},
+					MockSetReceiptRulePosition: func(context.Context, *awsses.SetReceiptRulePositionInput, []request.Option) (*awsses.SetReceiptRulePositionOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errSetPosition),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRule: func(context.Context, *awsses.DeleteReceiptRuleInput, []request.Option) (*awsses.DeleteReceiptRuleOutput, error) {
+						return &awsses.DeleteReceiptRuleOutput{}, nil
+					},
+				},
+				cr: rule(),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRule: func(context.Context, *awsses.DeleteReceiptRuleInput, []request.Option) (*awsses.DeleteReceiptRuleOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeRuleSetDoesNotExistException, "", nil)
+					},
+				},
+				cr: rule(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRule: func(context.Context, *awsses.DeleteReceiptRuleInput, []request.Option) (*awsses.DeleteReceiptRuleOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: rule(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
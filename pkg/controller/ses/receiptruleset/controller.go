@@ -0,0 +1,168 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiptruleset
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a ReceiptRuleSet resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "failed to describe ReceiptRuleSet"
+	errDescribeActive   = "failed to describe the active ReceiptRuleSet"
+	errCreate           = "failed to create ReceiptRuleSet"
+	errSetActive        = "failed to set the active ReceiptRuleSet"
+	errDelete           = "failed to delete ReceiptRuleSet"
+)
+
+// SetupReceiptRuleSet adds a controller that reconciles ReceiptRuleSets.
+func SetupReceiptRuleSet(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.ReceiptRuleSetGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.ReceiptRuleSet{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.ReceiptRuleSetGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: ses.NewClient},
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) ses.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRuleSet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client ses.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRuleSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.DescribeReceiptRuleSetWithContext(ctx, &awsses.DescribeReceiptRuleSetInput{RuleSetName: &name}); err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDescribe)
+	}
+	active, err := e.client.DescribeActiveReceiptRuleSetWithContext(ctx, &awsses.DescribeActiveReceiptRuleSetInput{})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errDescribeActive)
+	}
+	cr.Status.AtProvider.Active = active.Metadata != nil && awsclient.StringValue(active.Metadata.Name) == name
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: cr.Spec.ForProvider.Active == nil || *cr.Spec.ForProvider.Active == cr.Status.AtProvider.Active,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRuleSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	name := meta.GetExternalName(cr)
+	if _, err := e.client.CreateReceiptRuleSetWithContext(ctx, &awsses.CreateReceiptRuleSetInput{RuleSetName: &name}); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if !awsclient.BoolValue(cr.Spec.ForProvider.Active) {
+		return managed.ExternalCreation{}, nil
+	}
+	_, err := e.client.SetActiveReceiptRuleSetWithContext(ctx, &awsses.SetActiveReceiptRuleSetInput{RuleSetName: &name})
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errSetActive)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ReceiptRuleSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Only one rule set can be active at a time. Deactivation is only done
+	// by the rule set that is currently active, so that it does not
+	// deactivate another one.
+	input := &awsses.SetActiveReceiptRuleSetInput{}
+	switch {
+	case awsclient.BoolValue(cr.Spec.ForProvider.Active):
+		input.RuleSetName = awsclient.String(meta.GetExternalName(cr))
+	case !cr.Status.AtProvider.Active:
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err := e.client.SetActiveReceiptRuleSetWithContext(ctx, input)
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errSetActive)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ReceiptRuleSet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	// The active rule set cannot be deleted.
+	if cr.Status.AtProvider.Active {
+		if _, err := e.client.SetActiveReceiptRuleSetWithContext(ctx, &awsses.SetActiveReceiptRuleSetInput{}); err != nil {
+			return awsclient.Wrap(err, errSetActive)
+		}
+	}
+	_, err := e.client.DeleteReceiptRuleSetWithContext(ctx, &awsses.DeleteReceiptRuleSetInput{
+		RuleSetName: awsclient.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ses.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiptruleset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awsses "github.com/aws/aws-sdk-go/service/ses"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/ses/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/ses/fake"
+)
+
+var (
+	setName = "set"
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	ses *fake.MockClient
+	cr  resource.Managed
+}
+
+type ruleSetModifier func(*v1alpha1.ReceiptRuleSet)
+
+func withActive(b bool) ruleSetModifier {
+	return func(r *v1alpha1.ReceiptRuleSet) { r.Spec.ForProvider.Active = &b }
+}
+
+func withObservedActive(b bool) ruleSetModifier {
+	return func(r *v1alpha1.ReceiptRuleSet) { r.Status.AtProvider.Active = b }
+}
+
+func withConditions(c ...xpv1.Condition) ruleSetModifier {
+	return func(r *v1alpha1.ReceiptRuleSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func ruleSet(m ...ruleSetModifier) *v1alpha1.ReceiptRuleSet {
+	cr := &v1alpha1.ReceiptRuleSet{}
+	meta.SetExternalName(cr, setName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func activeSet(name string) func(context.Context, *awsses.DescribeActiveReceiptRuleSetInput, []request.Option) (*awsses.DescribeActiveReceiptRuleSetOutput, error) {
+	return func(context.Context, *awsses.DescribeActiveReceiptRuleSetInput, []request.Option) (*awsses.DescribeActiveReceiptRuleSetOutput, error) {
+		if name == "" {
+			return &awsses.DescribeActiveReceiptRuleSetOutput{}, nil
+		}
+		return &awsses.DescribeActiveReceiptRuleSetOutput{Metadata: &awsses.ReceiptRuleSetMetadata{Name: &name}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRuleSet: func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeRuleSetDoesNotExistException, "", nil)
+					},
+				},
+				cr: ruleSet(),
+			},
+			want: want{
+				cr: ruleSet(),
+			},
+		},
+		"DescribeError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRuleSet: func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ruleSet(),
+			},
+			want: want{
+				cr:  ruleSet(),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"ActiveUpToDate": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRuleSet: func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+						return &awsses.DescribeReceiptRuleSetOutput{}, nil
+					},
+					MockDescribeActiveReceiptRuleSet: activeSet(setName),
+				},
+				cr: ruleSet(withActive(true)),
+			},
+			want: want{
+				cr: ruleSet(withActive(true), withObservedActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AnotherSetActive": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRuleSet: func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+						return &awsses.DescribeReceiptRuleSetOutput{}, nil
+					},
+					MockDescribeActiveReceiptRuleSet: activeSet("other"),
+				},
+				cr: ruleSet(withActive(true)),
+			},
+			want: want{
+				cr: ruleSet(withActive(true), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"ActiveNotSpecified": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDescribeReceiptRuleSet: func(context.Context, *awsses.DescribeReceiptRuleSetInput, []request.Option) (*awsses.DescribeReceiptRuleSetOutput, error) {
+						return &awsses.DescribeReceiptRuleSetOutput{}, nil
+					},
+					MockDescribeActiveReceiptRuleSet: activeSet(""),
+				},
+				cr: ruleSet(),
+			},
+			want: want{
+				cr: ruleSet(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ses}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		active *string
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreateAndActivate": {
+			args: args{
+				cr: ruleSet(withActive(true)),
+			},
+			want: want{
+				cr:     ruleSet(withActive(true), withConditions(xpv1.Creating())),
+				active: &setName,
+			},
+		},
+		"CreateOnly": {
+			args: args{
+				cr: ruleSet(),
+			},
+			want: want{
+				cr: ruleSet(withConditions(xpv1.Creating())),
+			},
+		},
+		"CreateError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockCreateReceiptRuleSet: func(context.Context, *awsses.CreateReceiptRuleSetInput, []request.Option) (*awsses.CreateReceiptRuleSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ruleSet(withActive(true)),
+			},
+			want: want{
+				cr:  ruleSet(withActive(true), withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var active *string
+			client := tc.ses
+			if client == nil {
+				client = &fake.MockClient{
+					MockCreateReceiptRuleSet: func(context.Context, *awsses.CreateReceiptRuleSetInput, []request.Option) (*awsses.CreateReceiptRuleSetOutput, error) {
+						return &awsses.CreateReceiptRuleSetOutput{}, nil
+					},
+				}
+			}
+			client.MockSetActiveReceiptRuleSet = func(_ context.Context, input *awsses.SetActiveReceiptRuleSetInput, _ []request.Option) (*awsses.SetActiveReceiptRuleSetOutput, error) {
+				active = input.RuleSetName
+				return &awsses.SetActiveReceiptRuleSetOutput{}, nil
+			}
+			e := &external{client: client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.active, active); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		called bool
+		active *string
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Activate": {
+			args: args{
+				cr: ruleSet(withActive(true)),
+			},
+			want: want{
+				called: true,
+				active: &setName,
+			},
+		},
+		"Deactivate": {
+			args: args{
+				cr: ruleSet(withActive(false), withObservedActive(true)),
+			},
+			want: want{
+				called: true,
+			},
+		},
+		"DoNotDeactivateOtherSet": {
+			args: args{
+				cr: ruleSet(withActive(false)),
+			},
+			want: want{
+				called: false,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var called bool
+			var active *string
+			e := &external{client: &fake.MockClient{
+				MockSetActiveReceiptRuleSet: func(_ context.Context, input *awsses.SetActiveReceiptRuleSetInput, _ []request.Option) (*awsses.SetActiveReceiptRuleSetOutput, error) {
+					called = true
+					active = input.RuleSetName
+					return &awsses.SetActiveReceiptRuleSetOutput{}, nil
+				},
+			}}
+			if _, err := e.Update(context.Background(), tc.args.cr); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.active, active); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		deactivated bool
+		err         error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DeactivateAndDelete": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRuleSet: func(context.Context, *awsses.DeleteReceiptRuleSetInput, []request.Option) (*awsses.DeleteReceiptRuleSetOutput, error) {
+						return &awsses.DeleteReceiptRuleSetOutput{}, nil
+					},
+				},
+				cr: ruleSet(withObservedActive(true)),
+			},
+			want: want{
+				deactivated: true,
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRuleSet: func(context.Context, *awsses.DeleteReceiptRuleSetInput, []request.Option) (*awsses.DeleteReceiptRuleSetOutput, error) {
+						return nil, awserr.New(awsses.ErrCodeRuleSetDoesNotExistException, "", nil)
+					},
+				},
+				cr: ruleSet(),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				ses: &fake.MockClient{
+					MockDeleteReceiptRuleSet: func(context.Context, *awsses.DeleteReceiptRuleSetInput, []request.Option) (*awsses.DeleteReceiptRuleSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ruleSet(),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var deactivated bool
+			tc.ses.MockSetActiveReceiptRuleSet = func(_ context.Context, input *awsses.SetActiveReceiptRuleSetInput, _ []request.Option) (*awsses.SetActiveReceiptRuleSetOutput, error) {
+				deactivated = input.RuleSetName == nil
+				return &awsses.SetActiveReceiptRuleSetOutput{}, nil
+			}
+			e := &external{client: tc.ses}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deactivated, deactivated); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -27,9 +27,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -37,9 +34,13 @@ import (
 	"github.com/crossplane/provider-aws/apis/sns/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/arnref"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
 	"github.com/crossplane/provider-aws/pkg/clients/sns"
 	snsclient "github.com/crossplane/provider-aws/pkg/clients/sns"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -50,9 +51,38 @@ const (
 	errUpdate              = "failed to update the SNS Subscription"
 )
 
+// arnChecks validate that the endpoint of a Subscription is of the kind its
+// protocol delivers to. Only protocols that deliver to AWS resources are
+// checked.
+var arnChecks = []arnref.Check{
+	{
+		Field: "spec.forProvider.endpoint",
+		ARN:   endpointFor("sqs"),
+		Kinds: []arnref.Kind{arnref.SQSQueue},
+	},
+	{
+		Field: "spec.forProvider.endpoint",
+		ARN:   endpointFor("lambda"),
+		Kinds: []arnref.Kind{arnref.LambdaFunction},
+	},
+}
+
+func endpointFor(protocol string) func(mg resource.Managed) *string {
+	return func(mg resource.Managed) *string {
+		cr, ok := mg.(*v1beta1.Subscription)
+		if !ok || cr.Spec.ForProvider.Protocol != protocol {
+			return nil
+		}
+		return &cr.Spec.ForProvider.Endpoint
+	}
+}
+
 // SetupSubscription adds a controller than reconciles Subscription
-func SetupSubscription(mgr ctrl.Manager, o controller.Options) error {
+func SetupSubscription(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.SubscriptionGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -61,17 +91,13 @@ func SetupSubscription(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Subscription{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.SubscriptionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sns.NewSubscriptionClient}),
-			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.SubscriptionGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: sns.NewSubscriptionClient},
+			managed.WithReferenceResolver(arnref.NewReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), managed.NewAPISimpleReferenceResolver(mgr.GetClient())), arnChecks...)),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithConnectionPublishers(cps...)))
 }
 
@@ -19,6 +19,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/arnref"
 	"github.com/crossplane/provider-aws/pkg/clients/sns"
 	"github.com/crossplane/provider-aws/pkg/clients/sns/fake"
 )
@@ -384,3 +385,49 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestARNChecks(t *testing.T) {
+	queueARN := "arn:aws:sqs:ap-south-1:862356124505:queue"
+	cases := map[string]struct {
+		reason   string
+		protocol string
+		endpoint string
+		want     error
+	}{
+		"QueueForSQS": {
+			reason:   "An SQS subscription to a queue should be valid.",
+			protocol: "sqs",
+			endpoint: queueARN,
+		},
+		"TopicForSQS": {
+			reason:   "An SQS subscription to a topic should be invalid.",
+			protocol: "sqs",
+			endpoint: makeARN("topic"),
+			want:     errors.Errorf("spec.forProvider.endpoint: %q is not the ARN of an SQS queue", makeARN("topic")),
+		},
+		"QueueForLambda": {
+			reason:   "A Lambda subscription to a queue should be invalid.",
+			protocol: "lambda",
+			endpoint: queueARN,
+			want:     errors.Errorf("spec.forProvider.endpoint: %q is not the ARN of a Lambda function", queueARN),
+		},
+		"HTTPS": {
+			reason:   "Endpoints of protocols that don't deliver to AWS resources should not be validated.",
+			protocol: "https",
+			endpoint: "https://example.org",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := subscription(func(s *v1beta1.Subscription) {
+				s.Spec.ForProvider.Protocol = tc.protocol
+				s.Spec.ForProvider.Endpoint = tc.endpoint
+			})
+			err := arnref.Validate(cr, arnChecks...)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -28,9 +28,6 @@ import (
 	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
 	awssqstypes "github.com/aws/aws-sdk-go-v2/service/sqs/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -38,8 +35,14 @@ import (
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	"github.com/crossplane/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/arnref"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
+	"github.com/crossplane/provider-aws/pkg/clients/externalname"
 	"github.com/crossplane/provider-aws/pkg/clients/sqs"
+	"github.com/crossplane/provider-aws/pkg/connection"
 	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
 )
 
 const (
@@ -54,9 +57,26 @@ const (
 	errUpdateFailed             = "failed to update the Queue resource"
 )
 
+// arnChecks validate that a Queue's dead-letter target is another queue;
+// AWS otherwise accepts the redrive policy and silently drops messages.
+var arnChecks = []arnref.Check{{
+	Field: "spec.forProvider.redrivePolicy.deadLetterTargetArn",
+	ARN: func(mg resource.Managed) *string {
+		cr, ok := mg.(*v1beta1.Queue)
+		if !ok || cr.Spec.ForProvider.RedrivePolicy == nil {
+			return nil
+		}
+		return cr.Spec.ForProvider.RedrivePolicy.DeadLetterTargetARN
+	},
+	Kinds: []arnref.Kind{arnref.SQSQueue},
+}}
+
 // SetupQueue adds a controller that reconciles Queue.
-func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
+func SetupQueue(mgr ctrl.Manager, o setup.Options) error {
 	name := managed.ControllerName(v1beta1.QueueGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
 
 	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
 	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
@@ -65,20 +85,18 @@ func SetupQueue(mgr ctrl.Manager, o controller.Options) error {
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
+		WithOptions(o.ForController(name)).
 		For(&v1beta1.Queue{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.QueueGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient}),
-			managed.WithPollInterval(o.PollInterval),
-			managed.WithLogger(o.Logger.WithValues("controller", name)),
-			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1beta1.QueueGroupVersionKind),
+			externalname.NewConnecter(mgr.GetClient(), externalname.Name("<queue-name>", "sqs", ""), &connector{kube: mgr.GetClient(), newClientFn: sqs.NewClient, caps: capability.NewDetector()}),
+			managed.WithReferenceResolver(arnref.NewReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), managed.NewAPISimpleReferenceResolver(mgr.GetClient())), arnChecks...)),
 			managed.WithConnectionPublishers(cps...)))
 }
 
 type connector struct {
 	kube        client.Client
 	newClientFn func(aws.Config) sqs.Client
+	caps        *capability.Detector
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -90,12 +108,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(*cfg), c.kube}, nil
+	return &external{client: c.newClientFn(*cfg), kube: c.kube, caps: c.caps}, nil
 }
 
 type external struct {
 	client sqs.Client
 	kube   client.Client
+	caps   *capability.Detector
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -121,14 +140,12 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(sqs.IsNotFound, err), errGetQueueAttributesFailed)
 	}
 
-	resTags, err := e.client.ListQueueTags(ctx, &awssqs.ListQueueTagsInput{
-		QueueUrl: getURLOutput.QueueUrl,
-	})
+	tags, err := e.observeTags(ctx, cr, getURLOutput.QueueUrl)
 	if err != nil {
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errListQueueTagsFailed)
 	}
 
-	sqs.LateInitialize(&cr.Spec.ForProvider, resAttributes.Attributes, resTags.Tags)
+	sqs.LateInitialize(&cr.Spec.ForProvider, resAttributes.Attributes, tags)
 	current := cr.Spec.ForProvider.DeepCopy()
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
 		if err := e.kube.Update(ctx, cr); err != nil {
@@ -137,16 +154,33 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}
 
 	cr.Status.SetConditions(xpv1.Available())
+	e.caps.SetConditions(cr, capability.Tagging)
 
 	cr.Status.AtProvider = sqs.GenerateQueueObservation(*getURLOutput.QueueUrl, resAttributes.Attributes)
 
 	return managed.ExternalObservation{
 		ResourceExists:    true,
-		ResourceUpToDate:  sqs.IsUpToDate(cr.Spec.ForProvider, resAttributes.Attributes, resTags.Tags),
+		ResourceUpToDate:  sqs.IsUpToDate(cr.Spec.ForProvider, resAttributes.Attributes, tags),
 		ConnectionDetails: sqs.GetConnectionDetails(*cr),
 	}, nil
 }
 
+// observeTags returns the tags of the queue. The desired tags are returned
+// if the provider isn't permitted to list them, so that skipped tags are not
+// reported as drift.
+func (e *external) observeTags(ctx context.Context, cr *v1beta1.Queue, url *string) (map[string]string, error) {
+	if !e.caps.Available(cr, capability.Tagging) {
+		return cr.Spec.ForProvider.Tags, nil
+	}
+	resTags, err := e.client.ListQueueTags(ctx, &awssqs.ListQueueTagsInput{
+		QueueUrl: url,
+	})
+	if err != nil {
+		return cr.Spec.ForProvider.Tags, e.caps.Check(cr, capability.Tagging, err)
+	}
+	return resTags.Tags, nil
+}
+
 func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
 	cr, ok := mg.(*v1beta1.Queue)
 	if !ok {
@@ -187,11 +221,22 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
 	}
 
+	if e.caps.Available(cr, capability.Tagging) {
+		if err := e.updateTags(ctx, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+// updateTags updates the tags of the queue. Access denied errors skip
+// tagging rather than failing the update.
+func (e *external) updateTags(ctx context.Context, cr *v1beta1.Queue) error {
 	resTags, err := e.client.ListQueueTags(ctx, &awssqs.ListQueueTagsInput{
 		QueueUrl: aws.String(cr.Status.AtProvider.URL),
 	})
 	if err != nil {
-		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListQueueTagsFailed)
+		return awsclient.Wrap(e.caps.Check(cr, capability.Tagging, err), errListQueueTagsFailed)
 	}
 
 	removedTags, addedTags := sqs.TagsDiff(resTags.Tags, cr.Spec.ForProvider.Tags)
@@ -207,7 +252,7 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			TagKeys:  removedKeys,
 		})
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdateFailed)
+			return awsclient.Wrap(e.caps.Check(cr, capability.Tagging, err), errUpdateFailed)
 		}
 	}
 
@@ -217,10 +262,10 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 			Tags:     addedTags,
 		})
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errTag)
+			return awsclient.Wrap(e.caps.Check(cr, capability.Tagging, err), errTag)
 		}
 	}
-	return managed.ExternalUpdate{}, nil
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,7 @@ import (
 
 	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
 	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/capability"
 	"github.com/crossplane/provider-aws/pkg/clients/sqs"
 	"github.com/crossplane/provider-aws/pkg/clients/sqs/fake"
 )
@@ -41,7 +43,8 @@ var (
 	queueName  = "some-name"
 
 	// replaceMe = "replace-me!"
-	errBoom = errors.New("boom")
+	errBoom   = errors.New("boom")
+	errDenied = &smithy.GenericAPIError{Code: "AccessDenied"}
 )
 
 type args struct {
@@ -169,11 +172,47 @@ func TestObserve(t *testing.T) {
 				err: awsclient.Wrap(errBoom, errListQueueTagsFailed),
 			},
 		},
+		"ListTagsDenied": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockGetQueueURL: func(ctx context.Context, input *awssqs.GetQueueUrlInput, opts []func(*awssqs.Options)) (*awssqs.GetQueueUrlOutput, error) {
+						return &awssqs.GetQueueUrlOutput{
+							QueueUrl: &queueURL,
+						}, nil
+					},
+					MockGetQueueAttributes: func(ctx context.Context, input *awssqs.GetQueueAttributesInput, opts []func(*awssqs.Options)) (*awssqs.GetQueueAttributesOutput, error) {
+						return &awssqs.GetQueueAttributesOutput{
+							Attributes: attributes,
+						}, nil
+					},
+					MockListQueueTags: func(ctx context.Context, input *awssqs.ListQueueTagsInput, opts []func(*awssqs.Options)) (*awssqs.ListQueueTagsOutput, error) {
+						return nil, errDenied
+					},
+				},
+				cr: queue(withExternalName(queueName),
+					withSpec(v1beta1.QueueParameters{Tags: map[string]string{"k": "v"}})),
+			},
+			want: want{
+				cr: queue(withExternalName(queueName),
+					withSpec(v1beta1.QueueParameters{Tags: map[string]string{"k": "v"}}),
+					withConditions(xpv1.Available(), capability.Degraded(capability.Tagging)),
+					withStatus(v1beta1.QueueObservation{
+						URL: queueURL,
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretEndpointKey: []byte(queueURL),
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.sqs}
+			e := &external{client: tc.sqs, caps: capability.NewDetector()}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -244,7 +283,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.sqs}
+			e := &external{kube: tc.kube, client: tc.sqs, caps: capability.NewDetector()}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -332,6 +371,33 @@ func TestUpdate(t *testing.T) {
 				})),
 			},
 		},
+		"TagsUpdateDenied": {
+			args: args{
+				sqs: &fake.MockSQSClient{
+					MockSetQueueAttributes: func(ctx context.Context, input *awssqs.SetQueueAttributesInput, opts []func(*awssqs.Options)) (*awssqs.SetQueueAttributesOutput, error) {
+						return &awssqs.SetQueueAttributesOutput{}, nil
+					},
+					MockListQueueTags: func(ctx context.Context, input *awssqs.ListQueueTagsInput, opts []func(*awssqs.Options)) (*awssqs.ListQueueTagsOutput, error) {
+						return &awssqs.ListQueueTagsOutput{}, nil
+					},
+					MockTagQueue: func(ctx context.Context, input *awssqs.TagQueueInput, opts []func(*awssqs.Options)) (*awssqs.TagQueueOutput, error) {
+						return nil, errDenied
+					},
+				},
+				cr: queue(withSpec(v1beta1.QueueParameters{
+					Tags: map[string]string{"k": "v"},
+				}), withStatus(v1beta1.QueueObservation{
+					URL: queueURL,
+				})),
+			},
+			want: want{
+				cr: queue(withSpec(v1beta1.QueueParameters{
+					Tags: map[string]string{"k": "v"},
+				}), withStatus(v1beta1.QueueObservation{
+					URL: queueURL,
+				})),
+			},
+		},
 		"UpdateFailure": {
 			args: args{
 				sqs: &fake.MockSQSClient{
@@ -354,7 +420,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.sqs}
+			e := &external{client: tc.sqs, caps: capability.NewDetector()}
 			o, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -423,7 +489,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{client: tc.sqs}
+			e := &external{client: tc.sqs, caps: capability.NewDetector()}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipset
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not an IPSet resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get IPSet"
+	errCreate           = "failed to create IPSet"
+	errUpdate           = "failed to update IPSet"
+	errDelete           = "failed to delete IPSet"
+)
+
+// SetupIPSet adds a controller that reconciles IPSets.
+func SetupIPSet(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.IPSetGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.IPSet{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.IPSetGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: wafv2.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) wafv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.IPSet)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client wafv2.Client
+}
+
+func (e *external) get(ctx context.Context, cr *v1alpha1.IPSet) (*awswafv2.GetIPSetOutput, error) {
+	return e.client.GetIPSetWithContext(ctx, &awswafv2.GetIPSetInput{
+		Id:    awsclient.String(meta.GetExternalName(cr)),
+		Name:  awsclient.String(cr.Spec.ForProvider.Name),
+		Scope: awsclient.String(cr.Spec.ForProvider.Scope),
+	})
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.IPSet)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	// The IP set ID is assigned by AWS, so there is nothing to observe until
+	// it has been created.
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+	resp, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errGet)
+	}
+	cr.Status.AtProvider.ARN = awsclient.StringValue(resp.IPSet.ARN)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: wafv2.IsIPSetUpToDate(cr.Spec.ForProvider, resp.IPSet),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.IPSet)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	resp, err := e.client.CreateIPSetWithContext(ctx, wafv2.GenerateCreateIPSetInput(cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	meta.SetExternalName(cr, awsclient.StringValue(resp.Summary.Id))
+	return managed.ExternalCreation{ExternalNameAssigned: true}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.IPSet)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	// Updates require the lock token of the current state of the IP set.
+	resp, err := e.get(ctx, cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGet)
+	}
+	_, err = e.client.UpdateIPSetWithContext(ctx, &awswafv2.UpdateIPSetInput{
+		Id:          awsclient.String(meta.GetExternalName(cr)),
+		Name:        awsclient.String(cr.Spec.ForProvider.Name),
+		Scope:       awsclient.String(cr.Spec.ForProvider.Scope),
+		Description: cr.Spec.ForProvider.Description,
+		Addresses:   aws.StringSlice(cr.Spec.ForProvider.Addresses),
+		LockToken:   resp.LockToken,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.IPSet)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	resp, err := e.get(ctx, cr)
+	if err != nil {
+		return awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errGet)
+	}
+	_, err = e.client.DeleteIPSetWithContext(ctx, &awswafv2.DeleteIPSetInput{
+		Id:        awsclient.String(meta.GetExternalName(cr)),
+		Name:      awsclient.String(cr.Spec.ForProvider.Name),
+		Scope:     awsclient.String(cr.Spec.ForProvider.Scope),
+		LockToken: resp.LockToken,
+	})
+	return awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errDelete)
+}
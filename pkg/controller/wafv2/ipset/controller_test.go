@@ -0,0 +1,377 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipset
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	setID     = "abc123"
+	setARN    = "arn:aws:wafv2:us-east-1:123456789012:regional/ipset/blocked/abc123"
+	lockToken = "token"
+	address   = "192.0.2.0/24"
+	errBoom   = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type setModifier func(*v1alpha1.IPSet)
+
+func withExternalName(n string) setModifier {
+	return func(r *v1alpha1.IPSet) { meta.SetExternalName(r, n) }
+}
+
+func withAddresses(a ...string) setModifier {
+	return func(r *v1alpha1.IPSet) { r.Spec.ForProvider.Addresses = a }
+}
+
+func withARN(arn string) setModifier {
+	return func(r *v1alpha1.IPSet) { r.Status.AtProvider.ARN = arn }
+}
+
+func withConditions(c ...xpv1.Condition) setModifier {
+	return func(r *v1alpha1.IPSet) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func ipSet(m ...setModifier) *v1alpha1.IPSet {
+	cr := &v1alpha1.IPSet{}
+	cr.Spec.ForProvider.Name = "blocked"
+	cr.Spec.ForProvider.Scope = v1alpha1.ScopeRegional
+	cr.Spec.ForProvider.IPAddressVersion = "IPV4"
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getIPSet(addresses ...string) func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+	return func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+		return &awswafv2.GetIPSetOutput{
+			IPSet:     &awswafv2.IPSet{Id: &setID, ARN: &setARN, Addresses: aws.StringSlice(addresses)},
+			LockToken: &lockToken,
+		}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     ipSet(),
+			},
+			want: want{
+				cr: ipSet(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+						return nil, awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil)
+					},
+				},
+				cr: ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr: ipSet(withExternalName(setID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr:  ipSet(withExternalName(setID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{MockGetIPSet: getIPSet(address)},
+				cr:     ipSet(withExternalName(setID), withAddresses(address)),
+			},
+			want: want{
+				cr: ipSet(withExternalName(setID), withAddresses(address), withARN(setARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AddressRemoved": {
+			args: args{
+				client: &fake.MockClient{MockGetIPSet: getIPSet(address)},
+				cr:     ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr: ipSet(withExternalName(setID), withARN(setARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateIPSet: func(_ context.Context, input *awswafv2.CreateIPSetInput, _ []request.Option) (*awswafv2.CreateIPSetOutput, error) {
+						if input.Addresses == nil {
+							return nil, errBoom
+						}
+						return &awswafv2.CreateIPSetOutput{Summary: &awswafv2.IPSetSummary{Id: &setID}}, nil
+					},
+				},
+				cr: ipSet(),
+			},
+			want: want{
+				cr:     ipSet(withExternalName(setID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateIPSet: func(context.Context, *awswafv2.CreateIPSetInput, []request.Option) (*awswafv2.CreateIPSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipSet(),
+			},
+			want: want{
+				cr:  ipSet(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: getIPSet(),
+					MockUpdateIPSet: func(_ context.Context, input *awswafv2.UpdateIPSetInput, _ []request.Option) (*awswafv2.UpdateIPSetOutput, error) {
+						if awsclient.StringValue(input.LockToken) != lockToken || len(input.Addresses) != 1 {
+							return nil, errBoom
+						}
+						return &awswafv2.UpdateIPSetOutput{}, nil
+					},
+				},
+				cr: ipSet(withExternalName(setID), withAddresses(address)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipSet(withExternalName(setID), withAddresses(address)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: getIPSet(),
+					MockUpdateIPSet: func(context.Context, *awswafv2.UpdateIPSetInput, []request.Option) (*awswafv2.UpdateIPSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipSet(withExternalName(setID), withAddresses(address)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: getIPSet(),
+					MockDeleteIPSet: func(_ context.Context, input *awswafv2.DeleteIPSetInput, _ []request.Option) (*awswafv2.DeleteIPSetOutput, error) {
+						if awsclient.StringValue(input.LockToken) != lockToken {
+							return nil, errBoom
+						}
+						return &awswafv2.DeleteIPSetOutput{}, nil
+					},
+				},
+				cr: ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr: ipSet(withExternalName(setID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: func(context.Context, *awswafv2.GetIPSetInput, []request.Option) (*awswafv2.GetIPSetOutput, error) {
+						return nil, awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil)
+					},
+				},
+				cr: ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr: ipSet(withExternalName(setID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetIPSet: getIPSet(),
+					MockDeleteIPSet: func(context.Context, *awswafv2.DeleteIPSetInput, []request.Option) (*awswafv2.DeleteIPSetOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipSet(withExternalName(setID)),
+			},
+			want: want{
+				cr:  ipSet(withExternalName(setID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
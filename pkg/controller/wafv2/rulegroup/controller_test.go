@@ -0,0 +1,302 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulegroup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	groupID    = "abc123"
+	groupARN   = "arn:aws:wafv2:us-east-1:123456789012:regional/rulegroup/geo/abc123"
+	lockToken  = "token"
+	visibility = v1alpha1.VisibilityConfig{MetricName: "geo"}
+	rules      = `[
+  {
+    "Name": "block-countries",
+    "Priority": 1,
+    "Action": {"Block": {}},
+    "Statement": {"GeoMatchStatement": {"CountryCodes": ["AQ"]}},
+    "VisibilityConfig": {"CloudWatchMetricsEnabled": false, "MetricName": "countries", "SampledRequestsEnabled": false}
+  }
+]`
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type groupModifier func(*v1alpha1.RuleGroup)
+
+func withExternalName(n string) groupModifier {
+	return func(r *v1alpha1.RuleGroup) { meta.SetExternalName(r, n) }
+}
+
+func withRules(rules string) groupModifier {
+	return func(r *v1alpha1.RuleGroup) { r.Spec.ForProvider.Rules = rules }
+}
+
+func withARN(arn string) groupModifier {
+	return func(r *v1alpha1.RuleGroup) { r.Status.AtProvider.ARN = arn }
+}
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1alpha1.RuleGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func ruleGroup(m ...groupModifier) *v1alpha1.RuleGroup {
+	cr := &v1alpha1.RuleGroup{}
+	cr.Spec.ForProvider.Name = "geo"
+	cr.Spec.ForProvider.Scope = v1alpha1.ScopeRegional
+	cr.Spec.ForProvider.Capacity = 10
+	cr.Spec.ForProvider.VisibilityConfig = visibility
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getRuleGroup(context.Context, *awswafv2.GetRuleGroupInput, []request.Option) (*awswafv2.GetRuleGroupOutput, error) {
+	return &awswafv2.GetRuleGroupOutput{
+		RuleGroup: &awswafv2.RuleGroup{
+			Id:  &groupID,
+			ARN: &groupARN,
+			Rules: []*awswafv2.Rule{{
+				Name:      aws.String("block-countries"),
+				Priority:  aws.Int64(1),
+				Action:    &awswafv2.RuleAction{Block: &awswafv2.BlockAction{}},
+				Statement: &awswafv2.Statement{GeoMatchStatement: &awswafv2.GeoMatchStatement{CountryCodes: aws.StringSlice([]string{"AQ"})}},
+				VisibilityConfig: &awswafv2.VisibilityConfig{
+					CloudWatchMetricsEnabled: aws.Bool(false),
+					MetricName:               aws.String("countries"),
+					SampledRequestsEnabled:   aws.Bool(false),
+				},
+			}},
+			VisibilityConfig: &awswafv2.VisibilityConfig{
+				CloudWatchMetricsEnabled: aws.Bool(false),
+				MetricName:               aws.String("geo"),
+				SampledRequestsEnabled:   aws.Bool(false),
+			},
+		},
+		LockToken: &lockToken,
+	}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRuleGroup: func(context.Context, *awswafv2.GetRuleGroupInput, []request.Option) (*awswafv2.GetRuleGroupOutput, error) {
+						return nil, awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil)
+					},
+				},
+				cr: ruleGroup(withExternalName(groupID)),
+			},
+			want: want{
+				cr: ruleGroup(withExternalName(groupID)),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{MockGetRuleGroup: getRuleGroup},
+				cr:     ruleGroup(withExternalName(groupID), withRules(rules)),
+			},
+			want: want{
+				cr: ruleGroup(withExternalName(groupID), withRules(rules), withARN(groupARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"RulesRemoved": {
+			args: args{
+				client: &fake.MockClient{MockGetRuleGroup: getRuleGroup},
+				cr:     ruleGroup(withExternalName(groupID)),
+			},
+			want: want{
+				cr: ruleGroup(withExternalName(groupID), withARN(groupARN), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRuleGroup: func(_ context.Context, input *awswafv2.CreateRuleGroupInput, _ []request.Option) (*awswafv2.CreateRuleGroupOutput, error) {
+						if aws.Int64Value(input.Capacity) != 10 || len(input.Rules) != 1 {
+							return nil, errBoom
+						}
+						return &awswafv2.CreateRuleGroupOutput{Summary: &awswafv2.RuleGroupSummary{Id: &groupID}}, nil
+					},
+				},
+				cr: ruleGroup(withRules(rules)),
+			},
+			want: want{
+				cr:     ruleGroup(withRules(rules), withExternalName(groupID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"InvalidRules": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     ruleGroup(withRules("{")),
+			},
+			want: want{
+				cr:  ruleGroup(withRules("{"), withConditions(xpv1.Creating())),
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), errRules),
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateRuleGroup: func(context.Context, *awswafv2.CreateRuleGroupInput, []request.Option) (*awswafv2.CreateRuleGroupOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ruleGroup(),
+			},
+			want: want{
+				cr:  ruleGroup(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetRuleGroup: getRuleGroup,
+					MockUpdateRuleGroup: func(_ context.Context, input *awswafv2.UpdateRuleGroupInput, _ []request.Option) (*awswafv2.UpdateRuleGroupOutput, error) {
+						if awsclient.StringValue(input.LockToken) != lockToken || len(input.Rules) != 0 {
+							return nil, errBoom
+						}
+						return &awswafv2.UpdateRuleGroupOutput{}, nil
+					},
+				},
+				cr: ruleGroup(withExternalName(groupID)),
+			},
+		},
+		"InvalidRules": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     ruleGroup(withExternalName(groupID), withRules("{")),
+			},
+			want: want{
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), errRules),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
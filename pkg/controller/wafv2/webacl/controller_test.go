@@ -0,0 +1,391 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webacl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	aclID      = "abc123"
+	aclARN     = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/main/abc123"
+	lockToken  = "token"
+	visibility = v1alpha1.VisibilityConfig{CloudWatchMetricsEnabled: true, MetricName: "main", SampledRequestsEnabled: true}
+	rules      = `[{"Name": "common", "Priority": 0, "OverrideAction": {"None": {}},
+  "Statement": {"ManagedRuleGroupStatement": {"VendorName": "AWS", "Name": "AWSManagedRulesCommonRuleSet"}},
+  "VisibilityConfig": {"CloudWatchMetricsEnabled": true, "MetricName": "common", "SampledRequestsEnabled": true}}]`
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type aclModifier func(*v1alpha1.WebACL)
+
+func withExternalName(n string) aclModifier {
+	return func(r *v1alpha1.WebACL) { meta.SetExternalName(r, n) }
+}
+
+func withRules(rules string) aclModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.Rules = rules }
+}
+
+func withDefaultAction(a string) aclModifier {
+	return func(r *v1alpha1.WebACL) { r.Spec.ForProvider.DefaultAction = a }
+}
+
+func withStatus(arn string, capacity int64) aclModifier {
+	return func(r *v1alpha1.WebACL) {
+		r.Status.AtProvider.ARN = arn
+		r.Status.AtProvider.Capacity = capacity
+	}
+}
+
+func withConditions(c ...xpv1.Condition) aclModifier {
+	return func(r *v1alpha1.WebACL) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func webACL(m ...aclModifier) *v1alpha1.WebACL {
+	cr := &v1alpha1.WebACL{}
+	cr.Spec.ForProvider.Name = "main"
+	cr.Spec.ForProvider.Scope = v1alpha1.ScopeRegional
+	cr.Spec.ForProvider.DefaultAction = v1alpha1.DefaultActionAllow
+	cr.Spec.ForProvider.VisibilityConfig = visibility
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getWebACL(context.Context, *awswafv2.GetWebACLInput, []request.Option) (*awswafv2.GetWebACLOutput, error) {
+	return &awswafv2.GetWebACLOutput{
+		WebACL: &awswafv2.WebACL{
+			Id:            &aclID,
+			ARN:           &aclARN,
+			Capacity:      aws.Int64(700),
+			DefaultAction: &awswafv2.DefaultAction{Allow: &awswafv2.AllowAction{}},
+			Rules: []*awswafv2.Rule{{
+				Name:           aws.String("common"),
+				Priority:       aws.Int64(0),
+				OverrideAction: &awswafv2.OverrideAction{None: &awswafv2.NoneAction{}},
+				Statement: &awswafv2.Statement{ManagedRuleGroupStatement: &awswafv2.ManagedRuleGroupStatement{
+					VendorName: aws.String("AWS"),
+					Name:       aws.String("AWSManagedRulesCommonRuleSet"),
+				}},
+				VisibilityConfig: &awswafv2.VisibilityConfig{
+					CloudWatchMetricsEnabled: aws.Bool(true),
+					MetricName:               aws.String("common"),
+					SampledRequestsEnabled:   aws.Bool(true),
+				},
+			}},
+			VisibilityConfig: &awswafv2.VisibilityConfig{
+				CloudWatchMetricsEnabled: aws.Bool(true),
+				MetricName:               aws.String("main"),
+				SampledRequestsEnabled:   aws.Bool(true),
+			},
+		},
+		LockToken: &lockToken,
+	}, nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockClient{},
+				cr:     webACL(),
+			},
+			want: want{
+				cr: webACL(),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: func(context.Context, *awswafv2.GetWebACLInput, []request.Option) (*awswafv2.GetWebACLOutput, error) {
+						return nil, awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil)
+					},
+				},
+				cr: webACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclID)),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: func(context.Context, *awswafv2.GetWebACLInput, []request.Option) (*awswafv2.GetWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: webACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclID)),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACL: getWebACL},
+				cr:     webACL(withExternalName(aclID), withRules(rules)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclID), withRules(rules), withStatus(aclARN, 700), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DefaultActionChanged": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACL: getWebACL},
+				cr:     webACL(withExternalName(aclID), withRules(rules), withDefaultAction(v1alpha1.DefaultActionBlock)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclID), withRules(rules), withDefaultAction(v1alpha1.DefaultActionBlock), withStatus(aclARN, 700), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InvalidRules": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACL: getWebACL},
+				cr:     webACL(withExternalName(aclID), withRules("{")),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclID), withRules("{"), withStatus(aclARN, 700), withConditions(xpv1.Available())),
+				err: errors.Wrap(errors.New("unexpected end of JSON input"), errRules),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateWebACL: func(_ context.Context, input *awswafv2.CreateWebACLInput, _ []request.Option) (*awswafv2.CreateWebACLOutput, error) {
+						if len(input.Rules) != 1 || input.DefaultAction.Allow == nil {
+							return nil, errBoom
+						}
+						return &awswafv2.CreateWebACLOutput{Summary: &awswafv2.WebACLSummary{Id: &aclID}}, nil
+					},
+				},
+				cr: webACL(withRules(rules)),
+			},
+			want: want{
+				cr:     webACL(withRules(rules), withExternalName(aclID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{ExternalNameAssigned: true},
+			},
+		},
+		"CreateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockCreateWebACL: func(context.Context, *awswafv2.CreateWebACLInput, []request.Option) (*awswafv2.CreateWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: webACL(),
+			},
+			want: want{
+				cr:  webACL(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: getWebACL,
+					MockUpdateWebACL: func(_ context.Context, input *awswafv2.UpdateWebACLInput, _ []request.Option) (*awswafv2.UpdateWebACLOutput, error) {
+						if awsclient.StringValue(input.LockToken) != lockToken || input.DefaultAction.Block == nil {
+							return nil, errBoom
+						}
+						return &awswafv2.UpdateWebACLOutput{}, nil
+					},
+				},
+				cr: webACL(withExternalName(aclID), withDefaultAction(v1alpha1.DefaultActionBlock)),
+			},
+		},
+		"UpdateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: getWebACL,
+					MockUpdateWebACL: func(context.Context, *awswafv2.UpdateWebACLInput, []request.Option) (*awswafv2.UpdateWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: webACL(withExternalName(aclID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: getWebACL,
+					MockDeleteWebACL: func(context.Context, *awswafv2.DeleteWebACLInput, []request.Option) (*awswafv2.DeleteWebACLOutput, error) {
+						return &awswafv2.DeleteWebACLOutput{}, nil
+					},
+				},
+				cr: webACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr: webACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACL: getWebACL,
+					MockDeleteWebACL: func(context.Context, *awswafv2.DeleteWebACLInput, []request.Option) (*awswafv2.DeleteWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: webACL(withExternalName(aclID)),
+			},
+			want: want{
+				cr:  webACL(withExternalName(aclID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webaclassociation
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	awsv1alpha1 "github.com/crossplane/provider-aws/apis/v1alpha1"
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2"
+	"github.com/crossplane/provider-aws/pkg/connection"
+	"github.com/crossplane/provider-aws/pkg/features"
+	"github.com/crossplane/provider-aws/pkg/setup"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a WebACLAssociation resource"
+	errCreateSession    = "cannot create a new session"
+	errGet              = "failed to get the WebACL of the resource"
+	errAssociate        = "failed to associate WebACL"
+	errDisassociate     = "failed to disassociate WebACL"
+)
+
+// SetupWebACLAssociation adds a controller that reconciles
+// WebACLAssociations.
+func SetupWebACLAssociation(mgr ctrl.Manager, o setup.Options) error {
+	name := managed.ControllerName(v1alpha1.WebACLAssociationGroupKind)
+	if !o.Enabled(name) {
+		return nil
+	}
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForController(name)).
+		For(&v1alpha1.WebACLAssociation{}).
+		Complete(o.NewReconciler(mgr, name, resource.ManagedKind(v1alpha1.WebACLAssociationGroupVersionKind),
+			&connector{kube: mgr.GetClient(), newClientFn: wafv2.NewClient},
+			managed.WithInitializers(),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) wafv2.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WebACLAssociation)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client wafv2.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WebACLAssociation)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	resp, err := e.client.GetWebACLForResourceWithContext(ctx, &awswafv2.GetWebACLForResourceInput{
+		ResourceArn: cr.Spec.ForProvider.ResourceARN,
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errGet)
+	}
+	if resp.WebACL == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	cr.SetConditions(xpv1.Available())
+
+	// A resource can only be associated with one web ACL, so a different
+	// web ACL is replaced by associating the desired one.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: awsclient.StringValue(resp.WebACL.ARN) == awsclient.StringValue(cr.Spec.ForProvider.WebACLARN),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WebACLAssociation)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+	return managed.ExternalCreation{}, e.associate(ctx, cr)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WebACLAssociation)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	return managed.ExternalUpdate{}, e.associate(ctx, cr)
+}
+
+func (e *external) associate(ctx context.Context, cr *v1alpha1.WebACLAssociation) error {
+	_, err := e.client.AssociateWebACLWithContext(ctx, &awswafv2.AssociateWebACLInput{
+		ResourceArn: cr.Spec.ForProvider.ResourceARN,
+		WebACLArn:   cr.Spec.ForProvider.WebACLARN,
+	})
+	return awsclient.Wrap(err, errAssociate)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WebACLAssociation)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+	_, err := e.client.DisassociateWebACLWithContext(ctx, &awswafv2.DisassociateWebACLInput{
+		ResourceArn: cr.Spec.ForProvider.ResourceARN,
+	})
+	return awsclient.Wrap(resource.Ignore(wafv2.IsNotFound, err), errDisassociate)
+}
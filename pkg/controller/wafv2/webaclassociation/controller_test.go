@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webaclassociation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	awswafv2 "github.com/aws/aws-sdk-go/service/wafv2"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/wafv2/v1alpha1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+	"github.com/crossplane/provider-aws/pkg/clients/wafv2/fake"
+)
+
+var (
+	aclARN      = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/main/abc123"
+	otherACLARN = "arn:aws:wafv2:us-east-1:123456789012:regional/webacl/other/def456"
+	resourceARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/main/0123456789abcdef"
+	errBoom     = errors.New("boom")
+)
+
+type args struct {
+	client *fake.MockClient
+	cr     resource.Managed
+}
+
+type associationModifier func(*v1alpha1.WebACLAssociation)
+
+func withConditions(c ...xpv1.Condition) associationModifier {
+	return func(r *v1alpha1.WebACLAssociation) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func association(m ...associationModifier) *v1alpha1.WebACLAssociation {
+	cr := &v1alpha1.WebACLAssociation{}
+	cr.Spec.ForProvider.WebACLARN = &aclARN
+	cr.Spec.ForProvider.ResourceARN = &resourceARN
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getWebACLForResource(arn *string) func(context.Context, *awswafv2.GetWebACLForResourceInput, []request.Option) (*awswafv2.GetWebACLForResourceOutput, error) {
+	return func(_ context.Context, input *awswafv2.GetWebACLForResourceInput, _ []request.Option) (*awswafv2.GetWebACLForResourceOutput, error) {
+		if awsclient.StringValue(input.ResourceArn) != resourceARN {
+			return nil, errBoom
+		}
+		if arn == nil {
+			return &awswafv2.GetWebACLForResourceOutput{}, nil
+		}
+		return &awswafv2.GetWebACLForResourceOutput{WebACL: &awswafv2.WebACL{ARN: arn}}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NotAssociated": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACLForResource: getWebACLForResource(nil)},
+				cr:     association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"ResourceNotFound": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACLForResource: func(context.Context, *awswafv2.GetWebACLForResourceInput, []request.Option) (*awswafv2.GetWebACLForResourceOutput, error) {
+						return nil, awserr.New(awswafv2.ErrCodeWAFNonexistentItemException, "", nil)
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(),
+			},
+		},
+		"GetError": {
+			args: args{
+				client: &fake.MockClient{
+					MockGetWebACLForResource: func(context.Context, *awswafv2.GetWebACLForResourceInput, []request.Option) (*awswafv2.GetWebACLForResourceOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(),
+				err: awsclient.Wrap(errBoom, errGet),
+			},
+		},
+		"Associated": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACLForResource: getWebACLForResource(&aclARN)},
+				cr:     association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"AssociatedWithOtherWebACL": {
+			args: args{
+				client: &fake.MockClient{MockGetWebACLForResource: getWebACLForResource(&otherACLARN)},
+				cr:     association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockAssociateWebACL: func(_ context.Context, input *awswafv2.AssociateWebACLInput, _ []request.Option) (*awswafv2.AssociateWebACLOutput, error) {
+						if awsclient.StringValue(input.WebACLArn) != aclARN {
+							return nil, errBoom
+						}
+						return &awswafv2.AssociateWebACLOutput{}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Creating())),
+			},
+		},
+		"AssociateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockAssociateWebACL: func(context.Context, *awswafv2.AssociateWebACLInput, []request.Option) (*awswafv2.AssociateWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errAssociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockClient{
+					MockDisassociateWebACL: func(context.Context, *awswafv2.DisassociateWebACLInput, []request.Option) (*awswafv2.DisassociateWebACLOutput, error) {
+						return &awswafv2.DisassociateWebACLOutput{}, nil
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr: association(withConditions(xpv1.Deleting())),
+			},
+		},
+		"DisassociateError": {
+			args: args{
+				client: &fake.MockClient{
+					MockDisassociateWebACL: func(context.Context, *awswafv2.DisassociateWebACLInput, []request.Option) (*awswafv2.DisassociateWebACLOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: association(),
+			},
+			want: want{
+				cr:  association(withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDisassociate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
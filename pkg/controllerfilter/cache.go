@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllerfilter
+
+import (
+	"reflect"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// NewCacheFunc returns a cache.NewCacheFunc that only caches the managed
+// resources matching the supplied label selector, e.g. team=platform.
+// Managed resources that aren't cached are never reconciled, which allows
+// several deployments of the provider to each reconcile a shard of them. All
+// managed resources are cached if the selector is empty.
+func NewCacheFunc(selector string) (cache.NewCacheFunc, error) {
+	if selector == "" {
+		return cache.New, nil
+	}
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid resource selector %q", selector)
+	}
+	return func(cfg *rest.Config, o cache.Options) (cache.Cache, error) {
+		o.SelectorsByObject = SelectorsByObject(o.Scheme, sel)
+		return cache.New(cfg, o)
+	}, nil
+}
+
+// SelectorsByObject returns the supplied label selector for each managed
+// resource kind known to the supplied scheme.
+func SelectorsByObject(s *runtime.Scheme, sel labels.Selector) cache.SelectorsByObject {
+	sbo := cache.SelectorsByObject{}
+	for _, t := range s.AllKnownTypes() {
+		obj, ok := reflect.New(t).Interface().(client.Object)
+		if !ok {
+			continue
+		}
+		if _, ok := obj.(resource.Managed); !ok {
+			continue
+		}
+		sbo[obj] = cache.ObjectSelector{Label: sel}
+	}
+	return sbo
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controllerfilter selects the controllers the provider sets up, so
+// that it can be run with only the controllers of the kinds that are needed,
+// and the managed resources they reconcile.
+package controllerfilter
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// A Filter selects controllers by glob patterns matched against the lower
+// cased API group or <kind>.<group> of the kind they reconcile, for example
+// rds.aws.crossplane.io, *.ec2.aws.crossplane.io or queue.sqs.aws.crossplane.io.
+type Filter struct {
+	enable  []string
+	disable []string
+}
+
+// New returns a Filter that enables the controllers matching any of the
+// enable patterns, or all controllers if there are none, except those that
+// match any of the disable patterns. Each pattern may be a comma separated
+// list of patterns.
+func New(enable, disable []string) (*Filter, error) {
+	e, err := parse(enable)
+	if err != nil {
+		return nil, err
+	}
+	d, err := parse(disable)
+	if err != nil {
+		return nil, err
+	}
+	return &Filter{enable: e, disable: d}, nil
+}
+
+func parse(specs []string) ([]string, error) {
+	var patterns []string
+	for _, s := range specs {
+		for _, p := range strings.Split(s, ",") {
+			p = strings.ToLower(strings.TrimSpace(p))
+			if p == "" {
+				continue
+			}
+			if _, err := path.Match(p, ""); err != nil {
+				return nil, errors.Wrapf(err, "invalid controller pattern %q", p)
+			}
+			patterns = append(patterns, p)
+		}
+	}
+	return patterns, nil
+}
+
+// Enabled returns true if the controller with the supplied name, e.g.
+// managed/queue.sqs.aws.crossplane.io, should be set up. All controllers
+// are enabled by a nil Filter.
+func (f *Filter) Enabled(name string) bool {
+	if f == nil {
+		return true
+	}
+	gk := strings.ToLower(name)
+	if i := strings.Index(gk, "/"); i >= 0 {
+		gk = gk[i+1:]
+	}
+	group := gk
+	if i := strings.Index(gk, "."); i >= 0 {
+		group = gk[i+1:]
+	}
+	if len(f.enable) > 0 && !matches(f.enable, gk, group) {
+		return false
+	}
+	return !matches(f.disable, gk, group)
+}
+
+func matches(patterns []string, gk, group string) bool {
+	for _, p := range patterns {
+		if ok, _ := path.Match(p, gk); ok {
+			return true
+		}
+		if ok, _ := path.Match(p, group); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllerfilter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+const (
+	queue  = "managed/queue.sqs.aws.crossplane.io"
+	vpc    = "managed/vpc.ec2.aws.crossplane.io"
+	subnet = "managed/subnet.ec2.aws.crossplane.io"
+	fleet  = "fleet/fleet.fleet.aws.crossplane.io"
+)
+
+func TestEnabled(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		enable  []string
+		disable []string
+		want    map[string]bool
+	}{
+		"NoPatterns": {
+			reason: "All controllers should be enabled by default.",
+			want:   map[string]bool{queue: true, vpc: true, subnet: true, fleet: true},
+		},
+		"EnableGroup": {
+			reason: "Only the controllers of an enabled API group should be enabled.",
+			enable: []string{"ec2.aws.crossplane.io"},
+			want:   map[string]bool{queue: false, vpc: true, subnet: true, fleet: false},
+		},
+		"EnableKindGlob": {
+			reason: "Kinds should be matched by glob, and comma separated patterns should be supported.",
+			enable: []string{"VPC.*, queue.sqs.aws.crossplane.io"},
+			want:   map[string]bool{queue: true, vpc: true, subnet: false, fleet: false},
+		},
+		"DisableKind": {
+			reason:  "A disabled kind should not be enabled.",
+			disable: []string{"subnet.ec2.aws.crossplane.io"},
+			want:    map[string]bool{queue: true, vpc: true, subnet: false, fleet: true},
+		},
+		"DisableTakesPrecedence": {
+			reason:  "A kind that is both enabled and disabled should be disabled.",
+			enable:  []string{"*.aws.crossplane.io"},
+			disable: []string{"*.ec2.aws.crossplane.io"},
+			want:    map[string]bool{queue: true, vpc: false, subnet: false, fleet: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			f, err := New(tc.enable, tc.disable)
+			if err != nil {
+				t.Fatalf("New(...): %v", err)
+			}
+			got := map[string]bool{}
+			for c := range tc.want {
+				got[c] = f.Enabled(c)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nEnabled(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewInvalidPattern(t *testing.T) {
+	if _, err := New([]string{"[ec2"}, nil); err == nil {
+		t.Errorf("New(...): want error for malformed pattern, got nil")
+	}
+}
+
+func TestSelectorsByObject(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = v1beta1.SchemeBuilder.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	sel := labels.SelectorFromSet(labels.Set{"team": "platform"})
+
+	got := map[string]string{}
+	for obj, os := range SelectorsByObject(s, sel) {
+		got[reflect.TypeOf(obj).Elem().Name()] = os.Label.String()
+	}
+	want := map[string]string{"Queue": "team=platform"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SelectorsByObject(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestNewCacheFuncInvalidSelector(t *testing.T) {
+	if _, err := NewCacheFunc("team in (platform"); err == nil {
+		t.Errorf("NewCacheFunc(...): want error for malformed selector, got nil")
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion helps moving managed resource kinds to new API
+// versions. It converts resources between versions of a kind whose fields
+// were renamed, serves the conversion webhook of CRDs with several versions,
+// and migrates stored resources to the storage version of their CRD.
+//
+// A kind served at several versions marks one of them as the hub by
+// implementing the Hub interface of controller-runtime, and implements its
+// Convertible interface for the others, typically by calling Convert with the
+// fields renamed between them:
+//
+//	var renames = conversion.Renames{"spec.forProvider.vpcID": "spec.forProvider.vpcId"}
+//
+//	func (in *Subnet) ConvertTo(hub ctrlconversion.Hub) error {
+//		return conversion.Convert(in, hub, renames)
+//	}
+//
+//	func (in *Subnet) ConvertFrom(hub ctrlconversion.Hub) error {
+//		return conversion.Convert(hub, in, renames.Inverse())
+//	}
+package conversion
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+// WebhookPath is the path the conversion webhook is served at.
+const WebhookPath = "/convert"
+
+const (
+	errMarshal   = "cannot marshal source object"
+	errUnmarshal = "cannot unmarshal destination object"
+	errRename    = "cannot rename field"
+)
+
+// Renames maps the dotted JSON paths of fields of one version of a kind, e.g.
+// spec.forProvider.vpcID, to their paths in another version.
+type Renames map[string]string
+
+// Inverse returns the renames from the other version back to this one.
+func (r Renames) Inverse() Renames {
+	i := make(Renames, len(r))
+	for from, to := range r {
+		i[to] = from
+	}
+	return i
+}
+
+// Convert the supplied source object to the supplied destination object of
+// another version of the same kind, renaming the supplied fields. Fields
+// that exist in only one of the versions are dropped. The apiVersion and kind
+// of the destination are kept.
+func Convert(src, dst runtime.Object, renames Renames) error {
+	b, err := json.Marshal(src)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+	m := map[string]interface{}{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+	delete(m, "apiVersion")
+	delete(m, "kind")
+	for from, to := range renames {
+		if err := rename(m, strings.Split(from, "."), strings.Split(to, ".")); err != nil {
+			return errors.Wrapf(err, "%s %s", errRename, from)
+		}
+	}
+	if b, err = json.Marshal(m); err != nil {
+		return errors.Wrap(err, errUnmarshal)
+	}
+	return errors.Wrap(json.Unmarshal(b, dst), errUnmarshal)
+}
+
+// rename moves the value at the supplied path of the supplied object, if any,
+// to the other supplied path.
+func rename(obj map[string]interface{}, from, to []string) error {
+	parent := obj
+	for _, f := range from[:len(from)-1] {
+		next, ok := parent[f].(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		parent = next
+	}
+	v, ok := parent[from[len(from)-1]]
+	if !ok {
+		return nil
+	}
+	delete(parent, from[len(from)-1])
+
+	parent = obj
+	for _, f := range to[:len(to)-1] {
+		if _, ok := parent[f]; !ok {
+			parent[f] = map[string]interface{}{}
+		}
+		next, ok := parent[f].(map[string]interface{})
+		if !ok {
+			return errors.Errorf("%s is not an object", f)
+		}
+		parent = next
+	}
+	parent[to[len(to)-1]] = v
+	return nil
+}
+
+// SetupWebhook serves the conversion webhook of the CRDs whose kinds have a
+// hub version. The API server calls it to convert resources between the
+// versions of such a CRD.
+func SetupWebhook(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(WebhookPath, &conversion.Webhook{})
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type oldParameters struct {
+	VPCID  string `json:"vpcID,omitempty"`
+	Region string `json:"region,omitempty"`
+	Legacy string `json:"legacy,omitempty"`
+}
+
+type newParameters struct {
+	VPCID  string `json:"vpcId,omitempty"`
+	Region string `json:"region,omitempty"`
+}
+
+type oldKind struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		ForProvider oldParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (o *oldKind) DeepCopyObject() runtime.Object { c := *o; return &c }
+
+type newKind struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              struct {
+		ForProvider newParameters `json:"forProvider"`
+	} `json:"spec"`
+}
+
+func (n *newKind) DeepCopyObject() runtime.Object { c := *n; return &c }
+
+func TestConvert(t *testing.T) {
+	renames := Renames{"spec.forProvider.vpcID": "spec.forProvider.vpcId"}
+
+	src := &oldKind{TypeMeta: metav1.TypeMeta{APIVersion: "ec2.aws.crossplane.io/v1alpha1", Kind: "Subnet"}}
+	src.SetName("cool")
+	src.Spec.ForProvider = oldParameters{VPCID: "vpc-1", Region: "us-east-1", Legacy: "dropped"}
+
+	dst := &newKind{TypeMeta: metav1.TypeMeta{APIVersion: "ec2.aws.crossplane.io/v1beta1", Kind: "Subnet"}}
+	if err := Convert(src, dst, renames); err != nil {
+		t.Fatalf("Convert(...): %s", err)
+	}
+	want := &newKind{TypeMeta: metav1.TypeMeta{APIVersion: "ec2.aws.crossplane.io/v1beta1", Kind: "Subnet"}}
+	want.SetName("cool")
+	want.Spec.ForProvider = newParameters{VPCID: "vpc-1", Region: "us-east-1"}
+	if diff := cmp.Diff(want, dst); diff != "" {
+		t.Errorf("Convert(...): -want, +got:\n%s", diff)
+	}
+
+	// Converting back should restore the renamed field.
+	back := &oldKind{TypeMeta: metav1.TypeMeta{APIVersion: "ec2.aws.crossplane.io/v1alpha1", Kind: "Subnet"}}
+	if err := Convert(dst, back, renames.Inverse()); err != nil {
+		t.Fatalf("Convert(...): %s", err)
+	}
+	wantBack := src.DeepCopyObject().(*oldKind)
+	wantBack.Spec.ForProvider.Legacy = ""
+	if diff := cmp.Diff(wantBack, back); diff != "" {
+		t.Errorf("Convert(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestRename(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		obj    map[string]interface{}
+		from   string
+		to     string
+		want   map[string]interface{}
+		err    bool
+	}{
+		"Renamed": {
+			reason: "The value should be moved to its new path.",
+			obj:    map[string]interface{}{"spec": map[string]interface{}{"vpcID": "a"}},
+			from:   "spec.vpcID",
+			to:     "spec.vpcId",
+			want:   map[string]interface{}{"spec": map[string]interface{}{"vpcId": "a"}},
+		},
+		"Moved": {
+			reason: "Objects on the new path should be created.",
+			obj:    map[string]interface{}{"spec": map[string]interface{}{"vpcID": "a"}},
+			from:   "spec.vpcID",
+			to:     "spec.vpc.id",
+			want:   map[string]interface{}{"spec": map[string]interface{}{"vpc": map[string]interface{}{"id": "a"}}},
+		},
+		"Absent": {
+			reason: "Fields that aren't set should be ignored.",
+			obj:    map[string]interface{}{"spec": map[string]interface{}{}},
+			from:   "spec.vpcID",
+			to:     "spec.vpcId",
+			want:   map[string]interface{}{"spec": map[string]interface{}{}},
+		},
+		"NotAnObject": {
+			reason: "Values can't be moved into fields that aren't objects.",
+			obj:    map[string]interface{}{"spec": map[string]interface{}{"vpcID": "a", "vpc": "b"}},
+			from:   "spec.vpcID",
+			to:     "spec.vpc.id",
+			want:   map[string]interface{}{"spec": map[string]interface{}{"vpc": "b"}},
+			err:    true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := rename(tc.obj, strings.Split(tc.from, "."), strings.Split(tc.to, "."))
+			if diff := cmp.Diff(tc.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nrename(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, tc.obj); diff != "" {
+				t.Errorf("\n%s\nrename(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
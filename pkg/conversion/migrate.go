@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	errGetCRD           = "cannot get CustomResourceDefinition"
+	errNoStorageVersion = "CustomResourceDefinition has no storage version"
+	errListObjects      = "cannot list objects"
+	errUpdateObject     = "cannot update object"
+	errUpdateCRDStatus  = "cannot update stored versions of CustomResourceDefinition"
+)
+
+// A Migrator migrates the stored objects of CRDs to their storage version.
+// A version can only be removed from a CRD once no objects are stored at it,
+// which the API server only knows from the storedVersions in the status of
+// the CRD.
+type Migrator struct {
+	kube client.Client
+}
+
+// NewMigrator returns a Migrator that uses the supplied client, whose scheme
+// must include CustomResourceDefinitions.
+func NewMigrator(kube client.Client) *Migrator {
+	return &Migrator{kube: kube}
+}
+
+// Migrate the objects of the CRD with the supplied name to its storage
+// version, and record that they're only stored at that version. It returns
+// the number of objects it migrated.
+func (m *Migrator) Migrate(ctx context.Context, name string) (int, error) {
+	crd := &extv1.CustomResourceDefinition{}
+	if err := m.kube.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+		return 0, errors.Wrap(err, errGetCRD)
+	}
+	storage := ""
+	for _, v := range crd.Spec.Versions {
+		if v.Storage {
+			storage = v.Name
+		}
+	}
+	if storage == "" {
+		return 0, errors.New(errNoStorageVersion)
+	}
+
+	gvk := schema.GroupVersionKind{Group: crd.Spec.Group, Version: storage, Kind: crd.Spec.Names.ListKind}
+	l := &unstructured.UnstructuredList{}
+	l.SetGroupVersionKind(gvk)
+	if err := m.kube.List(ctx, l); err != nil {
+		return 0, errors.Wrap(err, errListObjects)
+	}
+
+	// Updating an object without changing it makes the API server store it
+	// anew, at the storage version.
+	n := 0
+	for i := range l.Items {
+		o := &l.Items[i]
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			err := m.kube.Update(ctx, o)
+			if kerrors.IsConflict(err) {
+				// Get the latest revision for the next attempt.
+				if err := m.kube.Get(ctx, types.NamespacedName{Namespace: o.GetNamespace(), Name: o.GetName()}, o); err != nil {
+					return err
+				}
+			}
+			return err
+		})
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return n, errors.Wrapf(err, "%s %s", errUpdateObject, o.GetName())
+		}
+		n++
+	}
+
+	crd.Status.StoredVersions = []string{storage}
+	return n, errors.Wrap(m.kube.Status().Update(ctx, crd), errUpdateCRDStatus)
+}
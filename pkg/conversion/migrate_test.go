@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+func TestMigrate(t *testing.T) {
+	const name = "queues.sqs.aws.crossplane.io"
+
+	crd := func(storedVersions ...string) *extv1.CustomResourceDefinition {
+		return &extv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: extv1.CustomResourceDefinitionSpec{
+				Group: sqsv1beta1.Group,
+				Names: extv1.CustomResourceDefinitionNames{Kind: sqsv1beta1.QueueKind, ListKind: sqsv1beta1.QueueKind + "List", Plural: "queues"},
+				Versions: []extv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: true},
+					{Name: "v1beta1", Served: true, Storage: true},
+				},
+			},
+			Status: extv1.CustomResourceDefinitionStatus{StoredVersions: storedVersions},
+		}
+	}
+	queue := func(name string) *sqsv1beta1.Queue {
+		q := &sqsv1beta1.Queue{}
+		q.SetName(name)
+		return q
+	}
+
+	type want struct {
+		n              int
+		storedVersions []string
+		err            error
+	}
+	cases := map[string]struct {
+		reason  string
+		objects []client.Object
+		want    want
+	}{
+		"Migrated": {
+			reason:  "All objects should be migrated, and only the storage version should remain stored.",
+			objects: []client.Object{crd("v1alpha1", "v1beta1"), queue("a"), queue("b")},
+			want:    want{n: 2, storedVersions: []string{"v1beta1"}},
+		},
+		"NoCRD": {
+			reason: "A CRD that doesn't exist can't be migrated.",
+			want:   want{err: errors.Wrap(errors.New(`customresourcedefinitions.apiextensions.k8s.io "queues.sqs.aws.crossplane.io" not found`), errGetCRD)},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			s := runtime.NewScheme()
+			_ = extv1.AddToScheme(s)
+			_ = sqsv1beta1.SchemeBuilder.AddToScheme(s)
+			kube := fake.NewClientBuilder().WithScheme(s).WithObjects(tc.objects...).Build()
+
+			got, err := NewMigrator(kube).Migrate(context.Background(), name)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nMigrate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.n, got); diff != "" {
+				t.Errorf("\n%s\nMigrate(...): -want migrated, +got migrated:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			c := &extv1.CustomResourceDefinition{}
+			if err := kube.Get(context.Background(), types.NamespacedName{Name: name}, c); err != nil {
+				t.Fatalf("Get(...): %s", err)
+			}
+			if diff := cmp.Diff(tc.want.storedVersions, c.Status.StoredVersions); diff != "" {
+				t.Errorf("\n%s\nMigrate(...): -want stored versions, +got stored versions:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
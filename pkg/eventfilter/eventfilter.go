@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package eventfilter deduplicates the Kubernetes events the controllers emit
+// about managed resources. A resource that is stuck, e.g. because an AWS
+// limit was exceeded, otherwise gets an identical warning event on every
+// reconcile.
+package eventfilter
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// DefaultWindow is the default window within which identical events are
+// suppressed.
+const DefaultWindow = 5 * time.Minute
+
+// requestID matches the AWS request IDs in error messages, which differ
+// between otherwise identical events.
+var requestID = regexp.MustCompile(`(?i)request ?id: [A-Za-z0-9-]+`)
+
+type key struct {
+	uid       types.UID
+	eventtype string
+	reason    string
+	message   string
+}
+
+type entry struct {
+	emitted    time.Time
+	suppressed int
+}
+
+// A Recorder emits an event about an object only if no identical event was
+// emitted about it within its window. Suppressed events are counted, and the
+// count is reported with the next identical event emitted after the window.
+type Recorder struct {
+	inner  record.EventRecorder
+	window time.Duration
+	now    func() time.Time
+
+	mu    sync.Mutex
+	seen  map[key]*entry
+	swept time.Time
+}
+
+// An Option configures a Recorder.
+type Option func(*Recorder)
+
+// WithClock configures the clock of the Recorder.
+func WithClock(now func() time.Time) Option {
+	return func(r *Recorder) { r.now = now }
+}
+
+// NewRecorder returns a Recorder that suppresses events identical to one the
+// supplied EventRecorder emitted within the supplied window.
+func NewRecorder(inner record.EventRecorder, window time.Duration, o ...Option) *Recorder {
+	r := &Recorder{
+		inner:  inner,
+		window: window,
+		now:    time.Now,
+		seen:   map[key]*entry{},
+	}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// Event emits the supplied event, unless it is suppressed.
+func (r *Recorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if m, ok := r.filter(object, eventtype, reason, message); ok {
+		r.inner.Event(object, eventtype, reason, m)
+	}
+}
+
+// Eventf emits the supplied event, unless it is suppressed.
+func (r *Recorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf emits the supplied event, unless it is suppressed.
+func (r *Recorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	if m, ok := r.filter(object, eventtype, reason, fmt.Sprintf(messageFmt, args...)); ok {
+		r.inner.AnnotatedEventf(object, annotations, eventtype, reason, "%s", m)
+	}
+}
+
+// filter returns the message to emit, and whether to emit it.
+func (r *Recorder) filter(object runtime.Object, eventtype, reason, message string) (string, bool) {
+	o, err := meta.Accessor(object)
+	if err != nil || o.GetUID() == "" {
+		return message, true
+	}
+	k := key{uid: o.GetUID(), eventtype: eventtype, reason: reason, message: requestID.ReplaceAllString(message, "")}
+	now := r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.sweep(now)
+	e, ok := r.seen[k]
+	if ok && now.Sub(e.emitted) < r.window {
+		e.suppressed++
+		return "", false
+	}
+	r.seen[k] = &entry{emitted: now}
+	if ok && e.suppressed > 0 {
+		message = fmt.Sprintf("%s (repeated %d times since %s)", message, e.suppressed, e.emitted.UTC().Format(time.RFC3339))
+	}
+	return message, true
+}
+
+// sweep forgets the events that were emitted more than two windows ago, so
+// that the events of deleted objects don't accumulate. Counts of events that
+// weren't repeated since their window ended are dropped with them.
+func (r *Recorder) sweep(now time.Time) {
+	if now.Sub(r.swept) < r.window {
+		return
+	}
+	r.swept = now
+	for k, e := range r.seen {
+		if now.Sub(e.emitted) >= 2*r.window {
+			delete(r.seen, k)
+		}
+	}
+}
+
+type filteredManager struct {
+	manager.Manager
+	window time.Duration
+}
+
+// WrapManager returns a manager whose event recorders suppress events
+// identical to one they emitted within the supplied window.
+func WrapManager(mgr manager.Manager, window time.Duration) manager.Manager {
+	return &filteredManager{Manager: mgr, window: window}
+}
+
+// GetEventRecorderFor returns a filtered event recorder for the supplied
+// controller.
+func (m *filteredManager) GetEventRecorderFor(name string) record.EventRecorder {
+	return NewRecorder(m.Manager.GetEventRecorderFor(name), m.window)
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eventfilter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	sqsv1beta1 "github.com/crossplane/provider-aws/apis/sqs/v1beta1"
+)
+
+func TestRecorder(t *testing.T) {
+	start := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	queue := func(uid string) runtime.Object {
+		q := &sqsv1beta1.Queue{}
+		q.SetUID(types.UID(uid))
+		return q
+	}
+
+	type event struct {
+		after   time.Duration
+		object  runtime.Object
+		reason  string
+		message string
+	}
+
+	cases := map[string]struct {
+		reason string
+		events []event
+		want   []string
+	}{
+		"Distinct": {
+			reason: "Events that differ should all be emitted.",
+			events: []event{
+				{object: queue("a"), reason: "CannotObserve", message: "boom"},
+				{object: queue("a"), reason: "CannotObserve", message: "bang"},
+				{object: queue("a"), reason: "CannotUpdate", message: "boom"},
+				{object: queue("b"), reason: "CannotObserve", message: "boom"},
+			},
+			want: []string{
+				"Warning CannotObserve boom",
+				"Warning CannotObserve bang",
+				"Warning CannotUpdate boom",
+				"Warning CannotObserve boom",
+			},
+		},
+		"Repeated": {
+			reason: "Events identical to one emitted within the window should be suppressed and counted.",
+			events: []event{
+				{object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+				{after: time.Minute, object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+				{after: 2 * time.Minute, object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+				{after: 5 * time.Minute, object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+				{after: 6 * time.Minute, object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+				{after: 11 * time.Minute, object: queue("a"), reason: "CannotObserve", message: "LimitExceeded"},
+			},
+			want: []string{
+				"Warning CannotObserve LimitExceeded",
+				"Warning CannotObserve LimitExceeded (repeated 2 times since 2022-03-01T12:00:00Z)",
+				"Warning CannotObserve LimitExceeded (repeated 1 times since 2022-03-01T12:05:00Z)",
+			},
+		},
+		"RequestIDs": {
+			reason: "Events that only differ in their AWS request IDs should be considered identical.",
+			events: []event{
+				{object: queue("a"), reason: "CannotObserve", message: "boom (last AWS request ID: 1a)"},
+				{after: time.Minute, object: queue("a"), reason: "CannotObserve", message: "boom (last AWS request ID: 2b)"},
+			},
+			want: []string{
+				"Warning CannotObserve boom (last AWS request ID: 1a)",
+			},
+		},
+		"NoUID": {
+			reason: "Events about objects without a UID should always be emitted.",
+			events: []event{
+				{object: &sqsv1beta1.Queue{}, reason: "CannotObserve", message: "boom"},
+				{object: &sqsv1beta1.Queue{}, reason: "CannotObserve", message: "boom"},
+			},
+			want: []string{
+				"Warning CannotObserve boom",
+				"Warning CannotObserve boom",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := start
+			fake := record.NewFakeRecorder(len(tc.events))
+			r := NewRecorder(fake, DefaultWindow, WithClock(func() time.Time { return now }))
+			for _, e := range tc.events {
+				now = start.Add(e.after)
+				r.Event(e.object, corev1.EventTypeWarning, e.reason, e.message)
+			}
+			close(fake.Events)
+
+			var got []string
+			for e := range fake.Events {
+				got = append(got, e)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nEvent(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
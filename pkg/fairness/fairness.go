@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fairness contains a rate limiter that shares the global reconcile
+// budget of the provider fairly between the kinds of managed resources.
+package fairness
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// DefaultWindow is the time after its last request that a kind stops taking
+// a share of the reconcile budget.
+const DefaultWindow = 30 * time.Second
+
+var (
+	queueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "provider_aws_fairness_queue_depth",
+		Help: "Number of requests of a kind that are waiting for their share of the reconcile budget.",
+	}, []string{"kind"})
+
+	delayed = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "provider_aws_fairness_delayed_total",
+		Help: "Total number of requests of a kind that were delayed to share the reconcile budget.",
+	}, []string{"kind"})
+
+	activeKinds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "provider_aws_fairness_active_kinds",
+		Help: "Number of kinds currently sharing the reconcile budget.",
+	})
+)
+
+func init() {
+	metrics.Registry.MustRegister(queueDepth, delayed, activeKinds)
+}
+
+// A RateLimiter limits the rate of reconciles across all controllers of the
+// provider. Rather than serving requests first come, first served, the budget
+// is split evenly between the kinds that recently requested a reconcile. A
+// kind with thousands of resources, e.g. Route53 records, can use the whole
+// budget while it's the only kind with work to do, but can't starve kinds
+// that share the same AWS throttle budget once they need to reconcile too.
+//
+// A RateLimiter is meant to be used as the global rate limiter of a
+// crossplane-runtime ratelimiter.Reconciler. It derives the kind of a request
+// from the name of the controller the reconciler prefixes its items with.
+type RateLimiter struct {
+	rps    float64
+	burst  int
+	window time.Duration
+	now    func() time.Time
+
+	mu      sync.Mutex
+	kinds   map[string]*kind
+	waiting map[string]string
+}
+
+type kind struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+	waiting  int
+}
+
+// An Option configures a RateLimiter.
+type Option func(*RateLimiter)
+
+// WithWindow configures the time after its last request that a kind stops
+// taking a share of the reconcile budget.
+func WithWindow(d time.Duration) Option {
+	return func(r *RateLimiter) { r.window = d }
+}
+
+// WithClock configures the clock of the RateLimiter.
+func WithClock(now func() time.Time) Option {
+	return func(r *RateLimiter) { r.now = now }
+}
+
+// NewRateLimiter returns a RateLimiter that allows the supplied average number
+// of reconciles per second, with bursts of up to ten times as many, in line
+// with the crossplane-runtime global rate limiter.
+func NewRateLimiter(rps int, o ...Option) *RateLimiter {
+	r := &RateLimiter{
+		rps:     float64(rps),
+		burst:   rps * 10,
+		window:  DefaultWindow,
+		now:     time.Now,
+		kinds:   map[string]*kind{},
+		waiting: map[string]string{},
+	}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// When returns how long the supplied item must wait before it's reconciled.
+func (r *RateLimiter) When(item interface{}) time.Duration {
+	now := r.now()
+	name := kindOf(item)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k, ok := r.kinds[name]
+	if !ok {
+		k = &kind{limiter: rate.NewLimiter(rate.Limit(r.rps), r.burst)}
+		r.kinds[name] = k
+	}
+	k.lastSeen = now
+	r.share(now)
+
+	d := k.limiter.ReserveN(now, 1).DelayFrom(now)
+	if d == 0 {
+		return 0
+	}
+	delayed.WithLabelValues(name).Inc()
+	if _, ok := r.waiting[itemKey(item)]; !ok {
+		r.waiting[itemKey(item)] = name
+		k.waiting++
+		queueDepth.WithLabelValues(name).Set(float64(k.waiting))
+	}
+	return d
+}
+
+// share splits the budget evenly between the kinds that requested a
+// reconcile within the window.
+func (r *RateLimiter) share(now time.Time) {
+	active := 0
+	for _, k := range r.kinds {
+		if now.Sub(k.lastSeen) <= r.window {
+			active++
+		}
+	}
+	activeKinds.Set(float64(active))
+
+	limit := rate.Limit(r.rps / float64(active))
+	burst := r.burst / active
+	if burst < 1 {
+		burst = 1
+	}
+	for _, k := range r.kinds {
+		if now.Sub(k.lastSeen) > r.window {
+			continue
+		}
+		if k.limiter.Limit() != limit {
+			k.limiter.SetLimitAt(now, limit)
+		}
+		if k.limiter.Burst() != burst {
+			k.limiter.SetBurstAt(now, burst)
+		}
+	}
+}
+
+// Forget the supplied item, which is called once it's being reconciled.
+func (r *RateLimiter) Forget(item interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name, ok := r.waiting[itemKey(item)]
+	if !ok {
+		return
+	}
+	delete(r.waiting, itemKey(item))
+	k := r.kinds[name]
+	k.waiting--
+	queueDepth.WithLabelValues(name).Set(float64(k.waiting))
+}
+
+// NumRequeues always returns zero. Requeues are tracked per controller by
+// their own rate limiters.
+func (r *RateLimiter) NumRequeues(_ interface{}) int {
+	return 0
+}
+
+// Depth returns the number of requests of the supplied kind that are waiting
+// for their share of the budget.
+func (r *RateLimiter) Depth(kind string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if k, ok := r.kinds[kind]; ok {
+		return k.waiting
+	}
+	return 0
+}
+
+func itemKey(item interface{}) string {
+	return fmt.Sprint(item)
+}
+
+// kindOf returns the kind of the supplied item. Items are the name of a
+// controller followed by the namespace and name of the reconciled resource,
+// e.g. managed/queue.sqs.aws.crossplane.io/example for a cluster scoped
+// resource, so everything up to the last slash identifies the controller
+// and thus the kind.
+func kindOf(item interface{}) string {
+	s := itemKey(item)
+	if i := strings.LastIndex(s, "/"); i > 0 {
+		return s[:i]
+	}
+	return s
+}
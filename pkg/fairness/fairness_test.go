@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fairness
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+const (
+	records = "managed/resourcerecordset.route53.aws.crossplane.io"
+	queues  = "managed/queue.sqs.aws.crossplane.io"
+)
+
+func TestKindOf(t *testing.T) {
+	cases := map[string]struct {
+		item interface{}
+		want string
+	}{
+		"ClusterScoped": {
+			item: records + "/example",
+			want: records,
+		},
+		"NoSeparator": {
+			item: "example",
+			want: "example",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, kindOf(tc.item)); diff != "" {
+				t.Errorf("kindOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWhen(t *testing.T) {
+	now := time.Now()
+	clock := func() time.Time { return now }
+
+	cases := map[string]struct {
+		reason string
+		run    func(r *RateLimiter) time.Duration
+		want   time.Duration
+	}{
+		"SingleKindUsesBurst": {
+			reason: "A single kind should be able to use the whole burst.",
+			run: func(r *RateLimiter) time.Duration {
+				var d time.Duration
+				for i := 0; i < 10; i++ {
+					d = r.When(fmt.Sprintf("%s/r%d", records, i))
+				}
+				return d
+			},
+			want: 0,
+		},
+		"SingleKindIsLimited": {
+			reason: "A single kind should be limited to the budget once its burst is used.",
+			run: func(r *RateLimiter) time.Duration {
+				var d time.Duration
+				for i := 0; i < 11; i++ {
+					d = r.When(fmt.Sprintf("%s/r%d", records, i))
+				}
+				return d
+			},
+			want: time.Second,
+		},
+		"OtherKindIsNotStarved": {
+			reason: "A kind should not have to wait for the backlog of another kind.",
+			run: func(r *RateLimiter) time.Duration {
+				for i := 0; i < 1000; i++ {
+					r.When(fmt.Sprintf("%s/r%d", records, i))
+				}
+				return r.When(queues + "/q")
+			},
+			want: 0,
+		},
+		"BudgetIsShared": {
+			reason: "Active kinds should share the budget evenly.",
+			run: func(r *RateLimiter) time.Duration {
+				r.When(queues + "/q")
+				var d time.Duration
+				for i := 0; i < 6; i++ {
+					d = r.When(fmt.Sprintf("%s/r%d", records, i))
+				}
+				// The burst of 10 is split in two, so the sixth record
+				// waits for a token that refills at half the rate.
+				return d
+			},
+			want: 2 * time.Second,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := NewRateLimiter(1, WithClock(clock))
+			if diff := cmp.Diff(tc.want, tc.run(r)); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestIdleKindReleasesShare(t *testing.T) {
+	now := time.Now()
+	r := NewRateLimiter(1, WithClock(func() time.Time { return now }), WithWindow(time.Minute))
+
+	r.When(queues + "/q")
+	now = now.Add(2 * time.Minute)
+
+	// The queue kind has been idle for longer than the window, so the
+	// records get the whole burst of 10 again.
+	var d time.Duration
+	for i := 0; i < 10; i++ {
+		d = r.When(fmt.Sprintf("%s/r%d", records, i))
+	}
+	if d != 0 {
+		t.Errorf("When(...): want 0, got %s", d)
+	}
+}
+
+func TestDepth(t *testing.T) {
+	now := time.Now()
+	r := NewRateLimiter(1, WithClock(func() time.Time { return now }))
+
+	for i := 0; i < 12; i++ {
+		r.When(fmt.Sprintf("%s/r%d", records, i))
+	}
+	if diff := cmp.Diff(2, r.Depth(records)); diff != "" {
+		t.Errorf("Depth(...): -want, +got:\n%s", diff)
+	}
+
+	r.Forget(records + "/r10")
+	r.Forget(records + "/r0")
+	if diff := cmp.Diff(1, r.Depth(records)); diff != "" {
+		t.Errorf("Depth(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(0, r.Depth(queues)); diff != "" {
+		t.Errorf("Depth(...): -want, +got:\n%s", diff)
+	}
+}
@@ -24,4 +24,20 @@ const (
 	// External Secret Stores. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores feature.Flag = "EnableAlphaExternalSecretStores"
+
+	// EnableAlphaHealthEvents enables alpha support for reporting open AWS
+	// Health events that affect managed resources in their AWSHealth
+	// condition. It requires a Business or Enterprise support plan.
+	EnableAlphaHealthEvents feature.Flag = "EnableAlphaHealthEvents"
+
+	// EnableAlphaQuotaChecks enables alpha support for checking the AWS
+	// service quotas that creating a managed resource counts against before
+	// it is created, and reporting quotas it would exceed in its
+	// QuotaExceeded condition.
+	EnableAlphaQuotaChecks feature.Flag = "EnableAlphaQuotaChecks"
+
+	// EnableAlphaPolicyValidation enables alpha support for validating IAM
+	// policy documents with IAM Access Analyzer before they are written, and
+	// reporting the issues found in their PolicyFindings condition.
+	EnableAlphaPolicyValidation feature.Flag = "EnableAlphaPolicyValidation"
 )
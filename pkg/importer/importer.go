@@ -0,0 +1,220 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package importer generates the manifests of managed resources that
+// represent existing AWS resources, so that they can be brought under the
+// management of the provider.
+package importer
+
+import (
+	"context"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity/cognitoidentityiface"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errFmtUnknownKind   = "cannot import %s: unknown kind, must be one of %s"
+	errFmtAmbiguousKind = "cannot import %s: ambiguous kind, must be one of %s"
+	errFmtID            = "cannot import %s: identifier must be of the form %s"
+	errToUnstructured   = "cannot convert managed resource to unstructured"
+	errMarshal          = "cannot marshal managed resource"
+	errWrite            = "cannot write managed resource"
+)
+
+// Clients of the AWS APIs that external resources are described with.
+type Clients struct {
+	CognitoIdentity         cognitoidentityiface.CognitoIdentityAPI
+	CognitoIdentityProvider cognitoidentityprovideriface.CognitoIdentityProviderAPI
+}
+
+// NewClients returns the Clients of the supplied session.
+func NewClients(s *session.Session) *Clients {
+	return &Clients{
+		CognitoIdentity:         cognitoidentity.New(s),
+		CognitoIdentityProvider: cognitoidentityprovider.New(s),
+	}
+}
+
+// An Importer describes an existing external resource and returns a managed
+// resource that represents it.
+type Importer struct {
+	// Kind of the managed resources.
+	Kind schema.GroupVersionKind
+
+	// ID describes the parts of the identifier of an external resource,
+	// e.g. userPoolID/clientID.
+	ID string
+
+	// Import the external resource with the supplied identifier. The
+	// returned managed resource must have its spec, including the region,
+	// set. Its external name and metadata are set by the caller.
+	Import func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error)
+
+	// List returns the identifiers of the external resources that pass the
+	// supplied filter. It is nil if external resources of the kind can't be
+	// enumerated, e.g. because they are nested under other resources.
+	List func(ctx context.Context, c *Clients, f Filter) ([]string, error)
+}
+
+// A Filter selects external resources by their name and tags.
+type Filter struct {
+	// Name the external resource must match. All names match if nil.
+	Name *regexp.Regexp
+
+	// Tags the external resource must have.
+	Tags map[string]string
+}
+
+// MatchesName returns true if the supplied name passes the filter.
+func (f Filter) MatchesName(name *string) bool {
+	return f.Name == nil || f.Name.MatchString(aws.StringValue(name))
+}
+
+// MatchesTags returns true if the supplied tags pass the filter.
+func (f Filter) MatchesTags(tags map[string]*string) bool {
+	for k, v := range f.Tags {
+		t, ok := tags[k]
+		if !ok || aws.StringValue(t) != v {
+			return false
+		}
+	}
+	return true
+}
+
+// For returns the Importer of the supplied kind, which may either be the
+// name of the kind, e.g. UserPoolClient, or be qualified with its group, e.g.
+// userpoolclient.cognitoidentityprovider.aws.crossplane.io. Names are case
+// insensitive.
+func For(kind string) (Importer, error) {
+	var found []Importer
+	for _, i := range kinds {
+		gk := i.Kind.GroupKind()
+		if strings.EqualFold(kind, gk.String()) {
+			return i, nil
+		}
+		if strings.EqualFold(kind, gk.Kind) {
+			found = append(found, i)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return Importer{}, errors.Errorf(errFmtUnknownKind, kind, supported())
+	case 1:
+		return found[0], nil
+	default:
+		return Importer{}, errors.Errorf(errFmtAmbiguousKind, kind, supported())
+	}
+}
+
+func supported() string {
+	s := make([]string, 0, len(kinds))
+	for _, i := range kinds {
+		s = append(s, i.Kind.GroupKind().String())
+	}
+	sort.Strings(s)
+	return strings.Join(s, ", ")
+}
+
+// Listable returns the kinds, qualified with their group, whose external
+// resources can be enumerated, i.e. that an Import can import.
+func Listable() []string {
+	var s []string
+	for _, i := range kinds {
+		if i.List != nil {
+			s = append(s, i.Kind.GroupKind().String())
+		}
+	}
+	sort.Strings(s)
+	return s
+}
+
+// Resource returns a managed resource that represents the external resource
+// with the supplied identifier. The managed resource is named after the last
+// part of the identifier unless a name is supplied.
+func (i Importer) Resource(ctx context.Context, c *Clients, region, id, name string) (resource.Managed, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != len(strings.Split(i.ID, "/")) {
+		return nil, errors.Errorf(errFmtID, i.Kind.Kind, i.ID)
+	}
+
+	mg, err := i.Import(ctx, c, region, parts)
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = NameFor(parts[len(parts)-1])
+	}
+	mg.GetObjectKind().SetGroupVersionKind(i.Kind)
+	mg.SetName(name)
+	meta.SetExternalName(mg, parts[len(parts)-1])
+	return mg, nil
+}
+
+// Import the external resource of the supplied kind and identifier, and write
+// the manifest of a managed resource that represents it to w. The managed
+// resource is named after the last part of the identifier unless a name is
+// supplied.
+func Import(ctx context.Context, c *Clients, region, kind, id, name string, w io.Writer) error {
+	i, err := For(kind)
+	if err != nil {
+		return err
+	}
+	mg, err := i.Resource(ctx, c, region, id, name)
+	if err != nil {
+		return err
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(mg)
+	if err != nil {
+		return errors.Wrap(err, errToUnstructured)
+	}
+	// The status is observed by the provider once the managed resource is
+	// created, and the creation timestamp is set by the API server.
+	delete(u, "status")
+	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
+
+	b, err := yaml.Marshal(u)
+	if err != nil {
+		return errors.Wrap(err, errMarshal)
+	}
+	_, err = w.Write(b)
+	return errors.Wrap(err, errWrite)
+}
+
+var invalidName = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// NameFor returns a valid Kubernetes object name for the supplied identifier
+// of an external resource, e.g. us-east-1-abcdef for us-east-1_AbCdEf.
+func NameFor(id string) string {
+	return strings.Trim(invalidName.ReplaceAllString(strings.ToLower(id), "-"), "-.")
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider/cognitoidentityprovideriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+type mockCognitoClient struct {
+	cognitoidentityprovideriface.CognitoIdentityProviderAPI
+
+	DescribeUserPoolClientWithContextFunc func(aws.Context, *svcsdk.DescribeUserPoolClientInput, ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error)
+}
+
+func (m *mockCognitoClient) DescribeUserPoolClientWithContext(ctx aws.Context, in *svcsdk.DescribeUserPoolClientInput, opts ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+	return m.DescribeUserPoolClientWithContextFunc(ctx, in, opts...)
+}
+
+func TestImport(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	c := &Clients{CognitoIdentityProvider: &mockCognitoClient{
+		DescribeUserPoolClientWithContextFunc: func(_ aws.Context, in *svcsdk.DescribeUserPoolClientInput, _ ...request.Option) (*svcsdk.DescribeUserPoolClientOutput, error) {
+			if aws.StringValue(in.UserPoolId) != "us-east-1_AbCdEf" {
+				return nil, errBoom
+			}
+			return &svcsdk.DescribeUserPoolClientOutput{UserPoolClient: &svcsdk.UserPoolClientType{
+				ClientId:     in.ClientId,
+				ClientName:   aws.String("example"),
+				UserPoolId:   in.UserPoolId,
+				CallbackURLs: []*string{aws.String("https://example.com/callback")},
+			}}, nil
+		},
+	}}
+
+	type args struct {
+		kind string
+		id   string
+		name string
+	}
+	type want struct {
+		out string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Imported": {
+			reason: "The manifest of an imported resource should have its spec and external name set.",
+			args: args{
+				kind: "userpoolclient",
+				id:   "us-east-1_AbCdEf/abc123",
+			},
+			want: want{
+				out: `apiVersion: cognitoidentityprovider.aws.crossplane.io/v1alpha1
+kind: UserPoolClient
+metadata:
+  annotations:
+    crossplane.io/external-name: abc123
+  name: abc123
+spec:
+  forProvider:
+    callbackURLs:
+    - https://example.com/callback
+    clientName: example
+    region: us-east-1
+    userPoolId: us-east-1_AbCdEf
+`,
+			},
+		},
+		"Named": {
+			reason: "The manifest of an imported resource should have the supplied name.",
+			args: args{
+				kind: "UserPoolClient.cognitoidentityprovider.aws.crossplane.io",
+				id:   "us-east-1_AbCdEf/abc123",
+				name: "example",
+			},
+			want: want{
+				out: `apiVersion: cognitoidentityprovider.aws.crossplane.io/v1alpha1
+kind: UserPoolClient
+metadata:
+  annotations:
+    crossplane.io/external-name: abc123
+  name: example
+spec:
+  forProvider:
+    callbackURLs:
+    - https://example.com/callback
+    clientName: example
+    region: us-east-1
+    userPoolId: us-east-1_AbCdEf
+`,
+			},
+		},
+		"DescribeError": {
+			reason: "Errors describing the external resource should be returned.",
+			args: args{
+				kind: "UserPoolClient",
+				id:   "us-east-1_Other/abc123",
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UnknownKind": {
+			reason: "Kinds that can't be imported should be rejected.",
+			args: args{
+				kind: "Queue",
+				id:   "example",
+			},
+			want: want{
+				err: errors.Errorf(errFmtUnknownKind, "Queue", supported()),
+			},
+		},
+		"InvalidID": {
+			reason: "Identifiers that don't have the parts of the kind should be rejected.",
+			args: args{
+				kind: "UserPoolClient",
+				id:   "abc123",
+			},
+			want: want{
+				err: errors.Errorf(errFmtID, "UserPoolClient", "userPoolID/clientID"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := Import(context.Background(), c, "us-east-1", tc.args.kind, tc.args.id, tc.args.name, out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nImport(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out.String()); diff != "" {
+				t.Errorf("\n%s\nImport(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNameFor(t *testing.T) {
+	cases := map[string]struct {
+		id   string
+		want string
+	}{
+		"Valid":   {id: "example.com", want: "example.com"},
+		"Invalid": {id: "us-east-1_AbCdEf", want: "us-east-1-abcdef"},
+		"Trimmed": {id: "_Example_", want: "example"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, NameFor(tc.id)); diff != "" {
+				t.Errorf("NameFor(%q): -want, +got:\n%s", tc.id, diff)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	f := Filter{
+		Name: regexp.MustCompile("^prod-"),
+		Tags: map[string]string{"env": "prod"},
+	}
+
+	cases := map[string]struct {
+		name *string
+		tags map[string]*string
+		want bool
+	}{
+		"Matches":      {name: aws.String("prod-pool"), tags: map[string]*string{"env": aws.String("prod"), "team": aws.String("a")}, want: true},
+		"NameMismatch": {name: aws.String("dev-pool"), tags: map[string]*string{"env": aws.String("prod")}},
+		"TagMismatch":  {name: aws.String("prod-pool"), tags: map[string]*string{"env": aws.String("dev")}},
+		"TagMissing":   {name: aws.String("prod-pool")},
+		"NameMissing":  {tags: map[string]*string{"env": aws.String("prod")}},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := f.MatchesName(tc.name) && f.MatchesTags(tc.tags)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Matches(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
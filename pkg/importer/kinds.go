@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package importer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	svcsdk "github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	cognitoidentityv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	cognitoidentityproviderv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	awsclients "github.com/crossplane/provider-aws/pkg/clients"
+	cognitoidentitypool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentity/identitypool"
+	cognitogroup "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/group"
+	cognitoidentityprovider "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/identityprovider"
+	cognitouserpool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpool"
+	cognitouserpoolclient "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpoolclient"
+	cognitouserpooldomain "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpooldomain"
+)
+
+const (
+	errDescribe = "cannot describe external resource"
+	errGetMFA   = "cannot get MFA configuration of user pool"
+	errList     = "cannot list external resources"
+
+	// maxResults is the largest page size the Cognito list operations
+	// accept.
+	maxResults = 60
+)
+
+// kinds are the managed resource kinds that can be imported. Their spec is
+// generated from the response of the same API the controller observes them
+// with, amended with the fields the controller manages separately.
+var kinds = []Importer{
+	{
+		Kind: cognitoidentityv1alpha1.IdentityPoolGroupVersionKind,
+		ID:   "identityPoolID",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentity.DescribeIdentityPoolWithContext(ctx, &cognitoidentity.DescribeIdentityPoolInput{
+				IdentityPoolId: &id[0],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitoidentitypool.GenerateIdentityPool(resp)
+			cr.Spec.ForProvider.Region = region
+			for _, p := range resp.CognitoIdentityProviders {
+				cr.Spec.ForProvider.CognitoIdentityProviders = append(cr.Spec.ForProvider.CognitoIdentityProviders, &cognitoidentityv1alpha1.CognitoIdentityProviderParameters{
+					ClientID:             p.ClientId,
+					ProviderName:         p.ProviderName,
+					ServerSideTokenCheck: p.ServerSideTokenCheck,
+				})
+			}
+			cr.Spec.ForProvider.OpenIDConnectProviderARNs = resp.OpenIdConnectProviderARNs
+			return cr, nil
+		},
+		List: func(ctx context.Context, c *Clients, f Filter) ([]string, error) {
+			var ids []string
+			var err error
+			perr := c.CognitoIdentity.ListIdentityPoolsPagesWithContext(ctx, &cognitoidentity.ListIdentityPoolsInput{MaxResults: aws.Int64(maxResults)}, func(page *cognitoidentity.ListIdentityPoolsOutput, _ bool) bool {
+				for _, p := range page.IdentityPools {
+					if !f.MatchesName(p.IdentityPoolName) {
+						continue
+					}
+					if len(f.Tags) > 0 {
+						// Tags are only returned by the describe operation.
+						var resp *cognitoidentity.IdentityPool
+						resp, err = c.CognitoIdentity.DescribeIdentityPoolWithContext(ctx, &cognitoidentity.DescribeIdentityPoolInput{IdentityPoolId: p.IdentityPoolId})
+						if err != nil {
+							return false
+						}
+						if !f.MatchesTags(resp.IdentityPoolTags) {
+							continue
+						}
+					}
+					ids = append(ids, aws.StringValue(p.IdentityPoolId))
+				}
+				return true
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			return ids, awsclients.Wrap(perr, errList)
+		},
+	},
+	{
+		Kind: cognitoidentityproviderv1alpha1.GroupGroupVersionKind,
+		ID:   "userPoolID/groupName",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentityProvider.GetGroupWithContext(ctx, &svcsdk.GetGroupInput{
+				UserPoolId: &id[0],
+				GroupName:  &id[1],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitogroup.GenerateGroup(resp)
+			cr.Spec.ForProvider.Region = region
+			cr.Spec.ForProvider.UserPoolID = &id[0]
+			cr.Spec.ForProvider.RoleARN = resp.Group.RoleArn
+			return cr, nil
+		},
+	},
+	{
+		Kind: cognitoidentityproviderv1alpha1.IdentityProviderGroupVersionKind,
+		ID:   "userPoolID/providerName",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentityProvider.DescribeIdentityProviderWithContext(ctx, &svcsdk.DescribeIdentityProviderInput{
+				UserPoolId:   &id[0],
+				ProviderName: &id[1],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitoidentityprovider.GenerateIdentityProvider(resp)
+			cr.Spec.ForProvider.Region = region
+			cr.Spec.ForProvider.UserPoolID = &id[0]
+			return cr, nil
+		},
+	},
+	{
+		Kind: cognitoidentityproviderv1alpha1.UserPoolGroupVersionKind,
+		ID:   "userPoolID",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentityProvider.DescribeUserPoolWithContext(ctx, &svcsdk.DescribeUserPoolInput{
+				UserPoolId: &id[0],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitouserpool.GenerateUserPool(resp)
+			cr.Spec.ForProvider.Region = region
+			cr.Spec.ForProvider.LambdaConfig = lambdaConfigParameters(resp.UserPool.LambdaConfig)
+			if awsclients.StringValue(resp.UserPool.MfaConfiguration) == svcsdk.UserPoolMfaTypeOff {
+				return cr, nil
+			}
+			mfa, err := c.CognitoIdentityProvider.GetUserPoolMfaConfigWithContext(ctx, &svcsdk.GetUserPoolMfaConfigInput{
+				UserPoolId: &id[0],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errGetMFA)
+			}
+			if s := mfa.SmsMfaConfiguration; s != nil {
+				cr.Spec.ForProvider.SmsMFAConfiguration = &cognitoidentityproviderv1alpha1.SmsMFAConfigType{
+					SmsAuthenticationMessage: s.SmsAuthenticationMessage,
+				}
+				if s.SmsConfiguration != nil {
+					cr.Spec.ForProvider.SmsMFAConfiguration.SmsConfiguration = &cognitoidentityproviderv1alpha1.SmsConfigurationType{
+						ExternalID:   s.SmsConfiguration.ExternalId,
+						SnsCallerARN: s.SmsConfiguration.SnsCallerArn,
+					}
+				}
+			}
+			if s := mfa.SoftwareTokenMfaConfiguration; s != nil {
+				cr.Spec.ForProvider.SoftwareTokenMFAConfiguration = &cognitoidentityproviderv1alpha1.SoftwareTokenMFAConfigType{
+					Enabled: s.Enabled,
+				}
+			}
+			return cr, nil
+		},
+		List: func(ctx context.Context, c *Clients, f Filter) ([]string, error) {
+			var ids []string
+			var err error
+			perr := c.CognitoIdentityProvider.ListUserPoolsPagesWithContext(ctx, &svcsdk.ListUserPoolsInput{MaxResults: aws.Int64(maxResults)}, func(page *svcsdk.ListUserPoolsOutput, _ bool) bool {
+				for _, p := range page.UserPools {
+					if !f.MatchesName(p.Name) {
+						continue
+					}
+					if len(f.Tags) > 0 {
+						// Tags are only returned by the describe operation.
+						var resp *svcsdk.DescribeUserPoolOutput
+						resp, err = c.CognitoIdentityProvider.DescribeUserPoolWithContext(ctx, &svcsdk.DescribeUserPoolInput{UserPoolId: p.Id})
+						if err != nil {
+							return false
+						}
+						if !f.MatchesTags(resp.UserPool.UserPoolTags) {
+							continue
+						}
+					}
+					ids = append(ids, aws.StringValue(p.Id))
+				}
+				return true
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			return ids, awsclients.Wrap(perr, errList)
+		},
+	},
+	{
+		Kind: cognitoidentityproviderv1alpha1.UserPoolClientGroupVersionKind,
+		ID:   "userPoolID/clientID",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentityProvider.DescribeUserPoolClientWithContext(ctx, &svcsdk.DescribeUserPoolClientInput{
+				UserPoolId: &id[0],
+				ClientId:   &id[1],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitouserpoolclient.GenerateUserPoolClient(resp)
+			cr.Spec.ForProvider.Region = region
+			cr.Spec.ForProvider.UserPoolID = &id[0]
+			return cr, nil
+		},
+	},
+	{
+		Kind: cognitoidentityproviderv1alpha1.UserPoolDomainGroupVersionKind,
+		ID:   "domain",
+		Import: func(ctx context.Context, c *Clients, region string, id []string) (resource.Managed, error) {
+			resp, err := c.CognitoIdentityProvider.DescribeUserPoolDomainWithContext(ctx, &svcsdk.DescribeUserPoolDomainInput{
+				Domain: &id[0],
+			})
+			if err != nil {
+				return nil, awsclients.Wrap(err, errDescribe)
+			}
+			cr := cognitouserpooldomain.GenerateUserPoolDomain(resp)
+			cr.Spec.ForProvider.Region = region
+			if d := resp.DomainDescription; d != nil {
+				cr.Spec.ForProvider.UserPoolID = d.UserPoolId
+				if d.CustomDomainConfig != nil {
+					cr.Spec.ForProvider.CustomDomainConfig = &cognitoidentityproviderv1alpha1.CustomDomainConfigParameters{
+						CertificateARN: d.CustomDomainConfig.CertificateArn,
+					}
+				}
+			}
+			return cr, nil
+		},
+	},
+}
+
+func lambdaConfigParameters(c *svcsdk.LambdaConfigType) *cognitoidentityproviderv1alpha1.LambdaConfigParameters {
+	if c == nil {
+		return nil
+	}
+	p := &cognitoidentityproviderv1alpha1.LambdaConfigParameters{
+		CreateAuthChallenge:         c.CreateAuthChallenge,
+		CustomMessage:               c.CustomMessage,
+		DefineAuthChallenge:         c.DefineAuthChallenge,
+		KMSKeyID:                    c.KMSKeyID,
+		PostAuthentication:          c.PostAuthentication,
+		PostConfirmation:            c.PostConfirmation,
+		PreAuthentication:           c.PreAuthentication,
+		PreSignUp:                   c.PreSignUp,
+		PreTokenGeneration:          c.PreTokenGeneration,
+		UserMigration:               c.UserMigration,
+		VerifyAuthChallengeResponse: c.VerifyAuthChallengeResponse,
+	}
+	if c.CustomEmailSender != nil {
+		p.CustomEmailSender = &cognitoidentityproviderv1alpha1.CustomLambdaVersionConfig{
+			LambdaARN:     c.CustomEmailSender.LambdaArn,
+			LambdaVersion: c.CustomEmailSender.LambdaVersion,
+		}
+	}
+	if c.CustomSMSSender != nil {
+		p.CustomSMSSender = &cognitoidentityproviderv1alpha1.CustomLambdaVersionConfig{
+			LambdaARN:     c.CustomSMSSender.LambdaArn,
+			LambdaVersion: c.CustomSMSSender.LambdaVersion,
+		}
+	}
+	return p
+}
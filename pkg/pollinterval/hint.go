@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pollinterval
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DefaultTransitioning is how often managed resources are polled while their
+// external resources are transitioning between states.
+const DefaultTransitioning = 15 * time.Second
+
+type contextKey struct{}
+
+type hint struct {
+	transitioning time.Duration
+
+	mu sync.Mutex
+	d  time.Duration
+}
+
+// Hint that the managed resource reconciled with the supplied context should
+// be polled again after the supplied duration. Hints only ever shorten the
+// poll interval, and the shortest one wins. They're ignored unless the
+// reconciler is wrapped by a Reconciler.
+func Hint(ctx context.Context, d time.Duration) {
+	h, ok := ctx.Value(contextKey{}).(*hint)
+	if !ok || d <= 0 {
+		return
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.d == 0 || d < h.d {
+		h.d = d
+	}
+}
+
+// States of an external resource that are transitional, i.e. that change
+// without the provider doing anything, such as an RDS instance that is
+// modifying or a CloudFront distribution that is deploying.
+type States map[string]bool
+
+// Transitional returns the supplied transitional states. States are matched
+// case insensitively.
+func Transitional(states ...string) States {
+	s := States{}
+	for _, st := range states {
+		s[strings.ToLower(st)] = true
+	}
+	return s
+}
+
+// Hint that the managed resource reconciled with the supplied context should
+// be polled again soon if the supplied state of its external resource is
+// transitional. It is polled at its poll interval otherwise.
+func (s States) Hint(ctx context.Context, state string) {
+	h, ok := ctx.Value(contextKey{}).(*hint)
+	if ok && s[strings.ToLower(state)] {
+		Hint(ctx, h.transitioning)
+	}
+}
+
+// A Reconciler polls managed resources again after the shortest duration
+// hinted while reconciling them, if it is shorter than their poll interval.
+type Reconciler struct {
+	inner         reconcile.Reconciler
+	transitioning time.Duration
+}
+
+// A ReconcilerOption configures a Reconciler.
+type ReconcilerOption func(*Reconciler)
+
+// WithTransitioning configures how often managed resources are polled while
+// their external resources are transitioning between states, instead of
+// DefaultTransitioning. Zero disables transitional state hints.
+func WithTransitioning(d time.Duration) ReconcilerOption {
+	return func(r *Reconciler) { r.transitioning = d }
+}
+
+// NewReconciler wraps the supplied reconciler, typically a managed resource
+// reconciler, so that it honors poll interval hints.
+func NewReconciler(r reconcile.Reconciler, o ...ReconcilerOption) *Reconciler {
+	pr := &Reconciler{inner: r, transitioning: DefaultTransitioning}
+	for _, fn := range o {
+		fn(pr)
+	}
+	return pr
+}
+
+// Reconcile the supplied request, shortening the requeue of a successful
+// reconcile to the hinted duration.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	h := &hint{transitioning: r.transitioning}
+	res, err := r.inner.Reconcile(context.WithValue(ctx, contextKey{}, h), req)
+
+	// The managed reconciler only asks to be requeued after a duration, its
+	// poll interval, when it succeeds.
+	if err != nil || res.Requeue || res.RequeueAfter == 0 {
+		return res, err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.d > 0 && h.d < res.RequeueAfter {
+		res.RequeueAfter = h.d
+	}
+	return res, nil
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pollinterval
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcileHints(t *testing.T) {
+	errBoom := errors.New("boom")
+	states := Transitional("modifying", "InProgress")
+
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		inner  reconcile.Func
+		opts   []ReconcilerOption
+		want   want
+	}{
+		"Transitioning": {
+			reason: "A resource in a transitional state should be polled again after the transitioning interval.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "Modifying")
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			want: want{result: reconcile.Result{RequeueAfter: DefaultTransitioning}},
+		},
+		"TransitioningConfigured": {
+			reason: "A resource in a transitional state should be polled again after the configured transitioning interval.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "Modifying")
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			opts: []ReconcilerOption{WithTransitioning(5 * time.Second)},
+			want: want{result: reconcile.Result{RequeueAfter: 5 * time.Second}},
+		},
+		"TransitioningDisabled": {
+			reason: "Transitional states should be ignored if the transitioning interval is zero.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "Modifying")
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			opts: []ReconcilerOption{WithTransitioning(0)},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"Stable": {
+			reason: "A resource in a stable state should be polled again after its poll interval.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "available")
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"ShortestHint": {
+			reason: "The shortest hint should win.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				Hint(ctx, 30*time.Second)
+				Hint(ctx, 5*time.Second)
+				Hint(ctx, 20*time.Second)
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 5 * time.Second}},
+		},
+		"LongerThanPollInterval": {
+			reason: "Hints should never lengthen the poll interval.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				Hint(ctx, time.Hour)
+				return reconcile.Result{RequeueAfter: time.Minute}, nil
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"Failed": {
+			reason: "Hints should be ignored if the reconcile failed.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "modifying")
+				return reconcile.Result{Requeue: true}, nil
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"Error": {
+			reason: "Hints should be ignored if the reconcile returned an error.",
+			inner: func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+				states.Hint(ctx, "modifying")
+				return reconcile.Result{RequeueAfter: time.Minute}, errBoom
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}, err: errBoom},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewReconciler(tc.inner, tc.opts...).Reconcile(context.Background(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pollinterval configures how often the managed resources of an API
+// group or kind are checked for drift.
+package pollinterval
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const controllerPrefix = "managed/"
+
+// Overrides of the poll interval, keyed by lower cased API group or
+// <kind>.<group>.
+type Overrides map[string]time.Duration
+
+// Parse parses overrides of the form <group>=<duration> or
+// <kind>.<group>=<duration>, for example ec2.aws.crossplane.io=1m or
+// DBInstance.rds.aws.crossplane.io=15m.
+func Parse(specs []string) (Overrides, error) {
+	o := Overrides{}
+	for _, s := range specs {
+		kv := strings.SplitN(s, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, errors.Errorf("invalid poll interval %q: must be <kind>.<group>=<duration> or <group>=<duration>", s)
+		}
+		d, err := time.ParseDuration(kv[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid poll interval %q", s)
+		}
+		if d <= 0 {
+			return nil, errors.Errorf("invalid poll interval %q: must be positive", s)
+		}
+		o[strings.ToLower(kv[0])] = d
+	}
+	return o, nil
+}
+
+// For returns the poll interval of the controller with the supplied name, as
+// returned by managed.ControllerName. An override of the kind takes
+// precedence over one of its API group. def is returned if neither is
+// overridden.
+func (o Overrides) For(name string, def time.Duration) time.Duration {
+	gk := strings.ToLower(strings.TrimPrefix(name, controllerPrefix))
+	if d, ok := o[gk]; ok {
+		return d
+	}
+	if i := strings.Index(gk, "."); i >= 0 {
+		if d, ok := o[gk[i+1:]]; ok {
+			return d
+		}
+	}
+	return def
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pollinterval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestParse(t *testing.T) {
+	type want struct {
+		o   Overrides
+		err bool
+	}
+	cases := map[string]struct {
+		specs []string
+		want  want
+	}{
+		"None": {
+			want: want{o: Overrides{}},
+		},
+		"GroupAndKind": {
+			specs: []string{"rds.aws.crossplane.io=15m", "SecurityGroup.ec2.aws.crossplane.io=1m"},
+			want: want{o: Overrides{
+				"rds.aws.crossplane.io":               15 * time.Minute,
+				"securitygroup.ec2.aws.crossplane.io": time.Minute,
+			}},
+		},
+		"MissingDuration": {
+			specs: []string{"rds.aws.crossplane.io"},
+			want:  want{err: true},
+		},
+		"MissingKey": {
+			specs: []string{"=1m"},
+			want:  want{err: true},
+		},
+		"InvalidDuration": {
+			specs: []string{"rds.aws.crossplane.io=often"},
+			want:  want{err: true},
+		},
+		"NotPositive": {
+			specs: []string{"rds.aws.crossplane.io=0s"},
+			want:  want{err: true},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o, err := Parse(tc.specs)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("Parse(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("Parse(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFor(t *testing.T) {
+	o := Overrides{
+		"rds.aws.crossplane.io":               15 * time.Minute,
+		"dbsubnetgroup.rds.aws.crossplane.io": time.Hour,
+	}
+	cases := map[string]struct {
+		name string
+		want time.Duration
+	}{
+		"Group":              {name: "managed/dbinstance.rds.aws.crossplane.io", want: 15 * time.Minute},
+		"KindOverridesGroup": {name: "managed/dbsubnetgroup.rds.aws.crossplane.io", want: time.Hour},
+		"NotOverridden":      {name: "managed/securitygroup.ec2.aws.crossplane.io", want: time.Minute},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, o.For(tc.name, time.Minute)); diff != "" {
+				t.Errorf("For(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package probe implements a readiness probe that reports the provider as not
+// ready when the credentials of a ProviderConfig that is in use can't be
+// resolved to an AWS identity, e.g. because they expired or a role no longer
+// trusts the provider.
+package probe
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+const (
+	// DefaultInterval is how long the result of checking the credentials of
+	// a ProviderConfig is cached by default.
+	DefaultInterval = 5 * time.Minute
+
+	checkTimeout = 5 * time.Second
+
+	errListPCs      = "cannot list ProviderConfigs"
+	errGetAWSConfig = "cannot get AWS config"
+	errResolve      = "cannot resolve caller identity"
+)
+
+// A result of checking the credentials of a ProviderConfig.
+type result struct {
+	generation int64
+	checked    time.Time
+	err        error
+}
+
+// A Checker checks that the credentials of every ProviderConfig that is used
+// by at least one managed resource resolve to an AWS identity. Results are
+// cached, so that probes don't call STS more than once per interval and
+// ProviderConfig, unless the ProviderConfig changes.
+type Checker struct {
+	kube           client.Client
+	newConfigFn    func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error)
+	newSTSClientFn func(cfg aws.Config) awsclient.STSClient
+	interval       time.Duration
+	now            func() time.Time
+
+	mu      sync.Mutex
+	results map[string]result
+}
+
+// An Option configures a Checker.
+type Option func(*Checker)
+
+// WithInterval configures how long results are cached.
+func WithInterval(d time.Duration) Option {
+	return func(c *Checker) { c.interval = d }
+}
+
+// WithClock configures the clock used to expire cached results.
+func WithClock(now func() time.Time) Option {
+	return func(c *Checker) { c.now = now }
+}
+
+// WithConfigFn configures how the AWS config of a ProviderConfig is built.
+func WithConfigFn(fn func(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig, region string) (*aws.Config, error)) Option {
+	return func(c *Checker) { c.newConfigFn = fn }
+}
+
+// WithSTSClientFn configures how STS clients are built.
+func WithSTSClientFn(fn func(cfg aws.Config) awsclient.STSClient) Option {
+	return func(c *Checker) { c.newSTSClientFn = fn }
+}
+
+// NewChecker returns a Checker that reads ProviderConfigs using the supplied
+// client.
+func NewChecker(kube client.Client, o ...Option) *Checker {
+	c := &Checker{
+		kube:           kube,
+		newConfigFn:    awsclient.UseProviderConfigCredentials,
+		newSTSClientFn: awsclient.NewSTSClient,
+		interval:       DefaultInterval,
+		now:            time.Now,
+		results:        map[string]result{},
+	}
+	for _, fn := range o {
+		fn(c)
+	}
+	return c
+}
+
+// Check the credentials of the ProviderConfigs in use. It satisfies
+// healthz.Checker.
+func (c *Checker) Check(req *http.Request) error {
+	ctx, cancel := context.WithTimeout(req.Context(), checkTimeout)
+	defer cancel()
+
+	l := &v1beta1.ProviderConfigList{}
+	if err := c.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListPCs)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	seen := map[string]bool{}
+	var failed []string
+	for i := range l.Items {
+		pc := &l.Items[i]
+		if pc.Status.Users == 0 || pc.GetDeletionTimestamp() != nil {
+			continue
+		}
+		seen[pc.GetName()] = true
+		r, ok := c.results[pc.GetName()]
+		if !ok || r.generation != pc.GetGeneration() || c.now().Sub(r.checked) >= c.interval {
+			r = result{generation: pc.GetGeneration(), checked: c.now(), err: c.check(ctx, pc)}
+			c.results[pc.GetName()] = r
+		}
+		if r.err != nil {
+			failed = append(failed, pc.GetName()+": "+r.err.Error())
+		}
+	}
+
+	// Forget ProviderConfigs that are gone or no longer used.
+	for name := range c.results {
+		if !seen[name] {
+			delete(c.results, name)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+	sort.Strings(failed)
+	return errors.Errorf("cannot use credentials of ProviderConfigs: %s", strings.Join(failed, "; "))
+}
+
+func (c *Checker) check(ctx context.Context, pc *v1beta1.ProviderConfig) error {
+	cfg, err := c.newConfigFn(ctx, c.kube, pc, awsclient.GlobalRegion)
+	if err != nil {
+		return errors.Wrap(err, errGetAWSConfig)
+	}
+	_, err = awsclient.ResolveCallerIdentity(ctx, c.newSTSClientFn(*cfg))
+	return errors.Wrap(err, errResolve)
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package probe
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis/v1beta1"
+	awsclient "github.com/crossplane/provider-aws/pkg/clients"
+)
+
+var errBoom = errors.New("boom")
+
+type mockSTSClient struct {
+	calls *int
+	err   error
+}
+
+func (m mockSTSClient) GetCallerIdentity(_ context.Context, _ *sts.GetCallerIdentityInput, _ ...func(*sts.Options)) (*sts.GetCallerIdentityOutput, error) {
+	*m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &sts.GetCallerIdentityOutput{Account: aws.String("123456789012"), Arn: aws.String("arn:aws:iam::123456789012:user/crossplane")}, nil
+}
+
+func providerConfig(name string, users int64) v1beta1.ProviderConfig {
+	pc := v1beta1.ProviderConfig{}
+	pc.SetName(name)
+	pc.Status.Users = users
+	return pc
+}
+
+func kube(pcs ...v1beta1.ProviderConfig) client.Client {
+	return &test.MockClient{
+		MockList: test.NewMockListFn(nil, func(obj client.ObjectList) error {
+			obj.(*v1beta1.ProviderConfigList).Items = pcs
+			return nil
+		}),
+	}
+}
+
+func withConfig(err error) Option {
+	return WithConfigFn(func(context.Context, client.Client, *v1beta1.ProviderConfig, string) (*aws.Config, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &aws.Config{}, nil
+	})
+}
+
+func TestCheck(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		kube    client.Client
+		config  error
+		sts     error
+		checks  int
+		advance time.Duration
+		wantErr bool
+		wantSTS int
+	}{
+		"Ready": {
+			reason:  "The provider should be ready if the credentials of every ProviderConfig in use resolve.",
+			kube:    kube(providerConfig("used", 1), providerConfig("unused", 0)),
+			checks:  1,
+			wantSTS: 1,
+		},
+		"Cached": {
+			reason:  "Credentials should only be checked once per interval.",
+			kube:    kube(providerConfig("used", 1)),
+			checks:  3,
+			advance: time.Minute,
+			wantSTS: 1,
+		},
+		"Expired": {
+			reason:  "Credentials should be checked again once the cached result expired.",
+			kube:    kube(providerConfig("used", 1)),
+			checks:  2,
+			advance: DefaultInterval,
+			wantSTS: 2,
+		},
+		"CredentialsError": {
+			reason:  "The provider should not be ready if the credentials of a ProviderConfig in use can't be loaded.",
+			kube:    kube(providerConfig("used", 1)),
+			config:  errBoom,
+			checks:  1,
+			wantErr: true,
+		},
+		"STSError": {
+			reason:  "The provider should not be ready if the credentials of a ProviderConfig in use don't resolve to an identity.",
+			kube:    kube(providerConfig("used", 1)),
+			sts:     errBoom,
+			checks:  1,
+			wantErr: true,
+			wantSTS: 1,
+		},
+		"ListError": {
+			reason:  "The provider should not be ready if ProviderConfigs can't be listed.",
+			kube:    &test.MockClient{MockList: test.NewMockListFn(errBoom)},
+			checks:  1,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			calls := 0
+			c := NewChecker(tc.kube,
+				withConfig(tc.config),
+				WithSTSClientFn(func(aws.Config) awsclient.STSClient { return mockSTSClient{calls: &calls, err: tc.sts} }),
+				WithClock(func() time.Time { return now }),
+			)
+			var err error
+			for i := 0; i < tc.checks; i++ {
+				err = c.Check(httptest.NewRequest("GET", "/readyz", nil))
+				now = now.Add(tc.advance)
+			}
+			if (err != nil) != tc.wantErr {
+				t.Errorf("\n%s\nCheck(...): want error %t, got %v", tc.reason, tc.wantErr, err)
+			}
+			if calls != tc.wantSTS {
+				t.Errorf("\n%s\nCheck(...): want %d STS calls, got %d", tc.reason, tc.wantSTS, calls)
+			}
+		})
+	}
+}
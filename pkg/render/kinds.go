@@ -0,0 +1,750 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apigatewayv2v1alpha1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1alpha1"
+	apigatewayv2v1beta1 "github.com/crossplane/provider-aws/apis/apigatewayv2/v1beta1"
+	athenav1alpha1 "github.com/crossplane/provider-aws/apis/athena/v1alpha1"
+	cloudfrontv1alpha1 "github.com/crossplane/provider-aws/apis/cloudfront/v1alpha1"
+	cloudsearchv1alpha1 "github.com/crossplane/provider-aws/apis/cloudsearch/v1alpha1"
+	cloudwatchlogsv1alpha1 "github.com/crossplane/provider-aws/apis/cloudwatchlogs/v1alpha1"
+	cognitoidentityv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentity/v1alpha1"
+	cognitoidentityproviderv1alpha1 "github.com/crossplane/provider-aws/apis/cognitoidentityprovider/v1alpha1"
+	docdbv1alpha1 "github.com/crossplane/provider-aws/apis/docdb/v1alpha1"
+	dynamodbv1alpha1 "github.com/crossplane/provider-aws/apis/dynamodb/v1alpha1"
+	ec2v1alpha1 "github.com/crossplane/provider-aws/apis/ec2/v1alpha1"
+	efsv1alpha1 "github.com/crossplane/provider-aws/apis/efs/v1alpha1"
+	eksv1alpha1 "github.com/crossplane/provider-aws/apis/eks/v1alpha1"
+	elasticachev1alpha1 "github.com/crossplane/provider-aws/apis/elasticache/v1alpha1"
+	elbv2v1alpha1 "github.com/crossplane/provider-aws/apis/elbv2/v1alpha1"
+	gluev1alpha1 "github.com/crossplane/provider-aws/apis/glue/v1alpha1"
+	iamv1alpha1 "github.com/crossplane/provider-aws/apis/iam/v1alpha1"
+	iotv1alpha1 "github.com/crossplane/provider-aws/apis/iot/v1alpha1"
+	kafkav1alpha1 "github.com/crossplane/provider-aws/apis/kafka/v1alpha1"
+	kinesisv1alpha1 "github.com/crossplane/provider-aws/apis/kinesis/v1alpha1"
+	kmsv1alpha1 "github.com/crossplane/provider-aws/apis/kms/v1alpha1"
+	lambdav1beta1 "github.com/crossplane/provider-aws/apis/lambda/v1beta1"
+	neptunev1alpha1 "github.com/crossplane/provider-aws/apis/neptune/v1alpha1"
+	prometheusservice "github.com/crossplane/provider-aws/apis/prometheusservice/v1alpha1"
+	ramv1alpha1 "github.com/crossplane/provider-aws/apis/ram/v1alpha1"
+	rdsv1alpha1 "github.com/crossplane/provider-aws/apis/rds/v1alpha1"
+	route53resolverv1alpha1 "github.com/crossplane/provider-aws/apis/route53resolver/v1alpha1"
+	secretsmanagerv1beta1 "github.com/crossplane/provider-aws/apis/secretsmanager/v1beta1"
+	servicediscoveryv1alpha1 "github.com/crossplane/provider-aws/apis/servicediscovery/v1alpha1"
+	sfnv1alpha1 "github.com/crossplane/provider-aws/apis/sfn/v1alpha1"
+	transferv1alpha1 "github.com/crossplane/provider-aws/apis/transfer/v1alpha1"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/api"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/apimapping"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/authorizer"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/deployment"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/domainname"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/integration"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/integrationresponse"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/model"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/route"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/routeresponse"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/stage"
+	"github.com/crossplane/provider-aws/pkg/controller/apigatewayv2/vpclink"
+	athenaworkgroup "github.com/crossplane/provider-aws/pkg/controller/athena/workgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/cachepolicy"
+	cloudfrontorginaccessidentity "github.com/crossplane/provider-aws/pkg/controller/cloudfront/cloudfrontoriginaccessidentity"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudfront/distribution"
+	cloudfrontresponseheaderspolicy "github.com/crossplane/provider-aws/pkg/controller/cloudfront/responseheaderspolicy"
+	"github.com/crossplane/provider-aws/pkg/controller/cloudsearch/domain"
+	cwloggroup "github.com/crossplane/provider-aws/pkg/controller/cloudwatchlogs/loggroup"
+	cognitoidentitypool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentity/identitypool"
+	cognitogroup "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/group"
+	cognitoidentityprovider "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/identityprovider"
+	cognitouserpool "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpool"
+	cognitouserpoolclient "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpoolclient"
+	cognitouserpooldomain "github.com/crossplane/provider-aws/pkg/controller/cognitoidentityprovider/userpooldomain"
+	docdbcluster "github.com/crossplane/provider-aws/pkg/controller/docdb/dbcluster"
+	docdbclusterparametergroup "github.com/crossplane/provider-aws/pkg/controller/docdb/dbclusterparametergroup"
+	docdbinstance "github.com/crossplane/provider-aws/pkg/controller/docdb/dbinstance"
+	docdbsubnetgroup "github.com/crossplane/provider-aws/pkg/controller/docdb/dbsubnetgroup"
+	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/backup"
+	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/globaltable"
+	"github.com/crossplane/provider-aws/pkg/controller/dynamodb/table"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplate"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/launchtemplateversion"
+	ec2route "github.com/crossplane/provider-aws/pkg/controller/ec2/route"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgateway"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroute"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayroutetable"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/transitgatewayvpcattachment"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/volume"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcendpointserviceconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/ec2/vpcpeeringconnection"
+	"github.com/crossplane/provider-aws/pkg/controller/efs/filesystem"
+	efsmounttarget "github.com/crossplane/provider-aws/pkg/controller/efs/mounttarget"
+	eksaddon "github.com/crossplane/provider-aws/pkg/controller/eks/addon"
+	"github.com/crossplane/provider-aws/pkg/controller/elasticache/cacheparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/listener"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/loadbalancer"
+	"github.com/crossplane/provider-aws/pkg/controller/elbv2/targetgroup"
+	glueclassifier "github.com/crossplane/provider-aws/pkg/controller/glue/classifier"
+	glueconnection "github.com/crossplane/provider-aws/pkg/controller/glue/connection"
+	gluecrawler "github.com/crossplane/provider-aws/pkg/controller/glue/crawler"
+	glueDatabase "github.com/crossplane/provider-aws/pkg/controller/glue/database"
+	gluejob "github.com/crossplane/provider-aws/pkg/controller/glue/job"
+	gluesecurityconfiguration "github.com/crossplane/provider-aws/pkg/controller/glue/securityconfiguration"
+	"github.com/crossplane/provider-aws/pkg/controller/iam/instanceprofile"
+	iotpolicy "github.com/crossplane/provider-aws/pkg/controller/iot/policy"
+	"github.com/crossplane/provider-aws/pkg/controller/iot/thing"
+	kafkacluster "github.com/crossplane/provider-aws/pkg/controller/kafka/cluster"
+	kafkaconfiguration "github.com/crossplane/provider-aws/pkg/controller/kafka/configuration"
+	kinesisstream "github.com/crossplane/provider-aws/pkg/controller/kinesis/stream"
+	"github.com/crossplane/provider-aws/pkg/controller/kms/alias"
+	"github.com/crossplane/provider-aws/pkg/controller/kms/key"
+	"github.com/crossplane/provider-aws/pkg/controller/lambda/function"
+	neptunecluster "github.com/crossplane/provider-aws/pkg/controller/neptune/dbcluster"
+	prometheusserviceworkspace "github.com/crossplane/provider-aws/pkg/controller/prometheusservice/workspace"
+	"github.com/crossplane/provider-aws/pkg/controller/ram/resourceshare"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/dbcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/dbclusterparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/dbinstance"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/dbparametergroup"
+	"github.com/crossplane/provider-aws/pkg/controller/rds/globalcluster"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverendpoint"
+	"github.com/crossplane/provider-aws/pkg/controller/route53resolver/resolverrule"
+	"github.com/crossplane/provider-aws/pkg/controller/secretsmanager/secret"
+	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/httpnamespace"
+	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/privatednsnamespace"
+	"github.com/crossplane/provider-aws/pkg/controller/servicediscovery/publicdnsnamespace"
+	"github.com/crossplane/provider-aws/pkg/controller/sfn/activity"
+	"github.com/crossplane/provider-aws/pkg/controller/sfn/statemachine"
+	transferserver "github.com/crossplane/provider-aws/pkg/controller/transfer/server"
+	transferuser "github.com/crossplane/provider-aws/pkg/controller/transfer/user"
+)
+
+// kinds are the managed resource kinds whose controllers generate the inputs
+// of their create and update operations from the spec alone.
+var kinds = map[schema.GroupVersionKind]Renderer{
+	apigatewayv2v1alpha1.APIGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return api.GenerateCreateApiInput(mg.(*apigatewayv2v1alpha1.API))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return api.GenerateUpdateApiInput(mg.(*apigatewayv2v1alpha1.API))
+		},
+	},
+	apigatewayv2v1alpha1.APIMappingGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return apimapping.GenerateCreateApiMappingInput(mg.(*apigatewayv2v1alpha1.APIMapping))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return apimapping.GenerateUpdateApiMappingInput(mg.(*apigatewayv2v1alpha1.APIMapping))
+		},
+	},
+	apigatewayv2v1alpha1.AuthorizerGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return authorizer.GenerateCreateAuthorizerInput(mg.(*apigatewayv2v1alpha1.Authorizer))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return authorizer.GenerateUpdateAuthorizerInput(mg.(*apigatewayv2v1alpha1.Authorizer))
+		},
+	},
+	apigatewayv2v1alpha1.DeploymentGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return deployment.GenerateCreateDeploymentInput(mg.(*apigatewayv2v1alpha1.Deployment))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return deployment.GenerateUpdateDeploymentInput(mg.(*apigatewayv2v1alpha1.Deployment))
+		},
+	},
+	apigatewayv2v1alpha1.DomainNameGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return domainname.GenerateCreateDomainNameInput(mg.(*apigatewayv2v1alpha1.DomainName))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return domainname.GenerateUpdateDomainNameInput(mg.(*apigatewayv2v1alpha1.DomainName))
+		},
+	},
+	apigatewayv2v1alpha1.IntegrationGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return integration.GenerateCreateIntegrationInput(mg.(*apigatewayv2v1alpha1.Integration))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return integration.GenerateUpdateIntegrationInput(mg.(*apigatewayv2v1alpha1.Integration))
+		},
+	},
+	apigatewayv2v1alpha1.IntegrationResponseGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return integrationresponse.GenerateCreateIntegrationResponseInput(mg.(*apigatewayv2v1alpha1.IntegrationResponse))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return integrationresponse.GenerateUpdateIntegrationResponseInput(mg.(*apigatewayv2v1alpha1.IntegrationResponse))
+		},
+	},
+	apigatewayv2v1alpha1.ModelGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return model.GenerateCreateModelInput(mg.(*apigatewayv2v1alpha1.Model))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return model.GenerateUpdateModelInput(mg.(*apigatewayv2v1alpha1.Model))
+		},
+	},
+	apigatewayv2v1alpha1.RouteGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return route.GenerateCreateRouteInput(mg.(*apigatewayv2v1alpha1.Route))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return route.GenerateUpdateRouteInput(mg.(*apigatewayv2v1alpha1.Route))
+		},
+	},
+	apigatewayv2v1alpha1.RouteResponseGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return routeresponse.GenerateCreateRouteResponseInput(mg.(*apigatewayv2v1alpha1.RouteResponse))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return routeresponse.GenerateUpdateRouteResponseInput(mg.(*apigatewayv2v1alpha1.RouteResponse))
+		},
+	},
+	apigatewayv2v1alpha1.StageGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return stage.GenerateCreateStageInput(mg.(*apigatewayv2v1alpha1.Stage))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return stage.GenerateUpdateStageInput(mg.(*apigatewayv2v1alpha1.Stage))
+		},
+	},
+	apigatewayv2v1beta1.VPCLinkGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return vpclink.GenerateCreateVpcLinkInput(mg.(*apigatewayv2v1beta1.VPCLink))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return vpclink.GenerateUpdateVpcLinkInput(mg.(*apigatewayv2v1beta1.VPCLink))
+		},
+	},
+	athenav1alpha1.WorkGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return athenaworkgroup.GenerateCreateWorkGroupInput(mg.(*athenav1alpha1.WorkGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return athenaworkgroup.GenerateUpdateWorkGroupInput(mg.(*athenav1alpha1.WorkGroup))
+		},
+	},
+	cloudfrontv1alpha1.CachePolicyGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cachepolicy.GenerateCreateCachePolicyInput(mg.(*cloudfrontv1alpha1.CachePolicy))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cachepolicy.GenerateUpdateCachePolicyInput(mg.(*cloudfrontv1alpha1.CachePolicy))
+		},
+	},
+	cloudfrontv1alpha1.CloudFrontOriginAccessIdentityGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cloudfrontorginaccessidentity.GenerateCreateCloudFrontOriginAccessIdentityInput(mg.(*cloudfrontv1alpha1.CloudFrontOriginAccessIdentity))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cloudfrontorginaccessidentity.GenerateUpdateCloudFrontOriginAccessIdentityInput(mg.(*cloudfrontv1alpha1.CloudFrontOriginAccessIdentity))
+		},
+	},
+	cloudfrontv1alpha1.DistributionGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return distribution.GenerateCreateDistributionInput(mg.(*cloudfrontv1alpha1.Distribution))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return distribution.GenerateUpdateDistributionInput(mg.(*cloudfrontv1alpha1.Distribution))
+		},
+	},
+	cloudfrontv1alpha1.ResponseHeadersPolicyGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cloudfrontresponseheaderspolicy.GenerateCreateResponseHeadersPolicyInput(mg.(*cloudfrontv1alpha1.ResponseHeadersPolicy))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cloudfrontresponseheaderspolicy.GenerateUpdateResponseHeadersPolicyInput(mg.(*cloudfrontv1alpha1.ResponseHeadersPolicy))
+		},
+	},
+	cloudsearchv1alpha1.DomainGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return domain.GenerateCreateDomainInput(mg.(*cloudsearchv1alpha1.Domain))
+		},
+	},
+	cloudwatchlogsv1alpha1.LogGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cwloggroup.GenerateCreateLogGroupInput(mg.(*cloudwatchlogsv1alpha1.LogGroup))
+		},
+	},
+	cognitoidentityv1alpha1.IdentityPoolGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitoidentitypool.GenerateCreateIdentityPoolInput(mg.(*cognitoidentityv1alpha1.IdentityPool))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitoidentitypool.GenerateUpdateIdentityPoolInput(mg.(*cognitoidentityv1alpha1.IdentityPool))
+		},
+	},
+	cognitoidentityproviderv1alpha1.GroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitogroup.GenerateCreateGroupInput(mg.(*cognitoidentityproviderv1alpha1.Group))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitogroup.GenerateUpdateGroupInput(mg.(*cognitoidentityproviderv1alpha1.Group))
+		},
+	},
+	cognitoidentityproviderv1alpha1.IdentityProviderGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitoidentityprovider.GenerateCreateIdentityProviderInput(mg.(*cognitoidentityproviderv1alpha1.IdentityProvider))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitoidentityprovider.GenerateUpdateIdentityProviderInput(mg.(*cognitoidentityproviderv1alpha1.IdentityProvider))
+		},
+	},
+	cognitoidentityproviderv1alpha1.UserPoolGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitouserpool.GenerateCreateUserPoolInput(mg.(*cognitoidentityproviderv1alpha1.UserPool))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitouserpool.GenerateUpdateUserPoolInput(mg.(*cognitoidentityproviderv1alpha1.UserPool))
+		},
+	},
+	cognitoidentityproviderv1alpha1.UserPoolClientGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitouserpoolclient.GenerateCreateUserPoolClientInput(mg.(*cognitoidentityproviderv1alpha1.UserPoolClient))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitouserpoolclient.GenerateUpdateUserPoolClientInput(mg.(*cognitoidentityproviderv1alpha1.UserPoolClient))
+		},
+	},
+	cognitoidentityproviderv1alpha1.UserPoolDomainGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cognitouserpooldomain.GenerateCreateUserPoolDomainInput(mg.(*cognitoidentityproviderv1alpha1.UserPoolDomain))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cognitouserpooldomain.GenerateUpdateUserPoolDomainInput(mg.(*cognitoidentityproviderv1alpha1.UserPoolDomain))
+		},
+	},
+	docdbv1alpha1.DBClusterGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return docdbcluster.GenerateCreateDBClusterInput(mg.(*docdbv1alpha1.DBCluster))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return docdbcluster.GenerateModifyDBClusterInput(mg.(*docdbv1alpha1.DBCluster))
+		},
+	},
+	docdbv1alpha1.DBClusterParameterGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return docdbclusterparametergroup.GenerateCreateDBClusterParameterGroupInput(mg.(*docdbv1alpha1.DBClusterParameterGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return docdbclusterparametergroup.GenerateModifyDBClusterParameterGroupInput(mg.(*docdbv1alpha1.DBClusterParameterGroup))
+		},
+	},
+	docdbv1alpha1.DBInstanceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return docdbinstance.GenerateCreateDBInstanceInput(mg.(*docdbv1alpha1.DBInstance))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return docdbinstance.GenerateModifyDBInstanceInput(mg.(*docdbv1alpha1.DBInstance))
+		},
+	},
+	docdbv1alpha1.DBSubnetGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return docdbsubnetgroup.GenerateCreateDBSubnetGroupInput(mg.(*docdbv1alpha1.DBSubnetGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return docdbsubnetgroup.GenerateModifyDBSubnetGroupInput(mg.(*docdbv1alpha1.DBSubnetGroup))
+		},
+	},
+	dynamodbv1alpha1.BackupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return backup.GenerateCreateBackupInput(mg.(*dynamodbv1alpha1.Backup))
+		},
+	},
+	dynamodbv1alpha1.GlobalTableGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return globaltable.GenerateCreateGlobalTableInput(mg.(*dynamodbv1alpha1.GlobalTable))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return globaltable.GenerateUpdateGlobalTableInput(mg.(*dynamodbv1alpha1.GlobalTable))
+		},
+	},
+	dynamodbv1alpha1.TableGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return table.GenerateCreateTableInput(mg.(*dynamodbv1alpha1.Table))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return table.GenerateUpdateTableInput(mg.(*dynamodbv1alpha1.Table))
+		},
+	},
+	ec2v1alpha1.LaunchTemplateGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return launchtemplate.GenerateCreateLaunchTemplateInput(mg.(*ec2v1alpha1.LaunchTemplate))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return launchtemplate.GenerateModifyLaunchTemplateInput(mg.(*ec2v1alpha1.LaunchTemplate))
+		},
+	},
+	ec2v1alpha1.LaunchTemplateVersionGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return launchtemplateversion.GenerateCreateLaunchTemplateVersionInput(mg.(*ec2v1alpha1.LaunchTemplateVersion))
+		},
+	},
+	ec2v1alpha1.RouteGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return ec2route.GenerateCreateRouteInput(mg.(*ec2v1alpha1.Route))
+		},
+	},
+	ec2v1alpha1.TransitGatewayGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transitgateway.GenerateCreateTransitGatewayInput(mg.(*ec2v1alpha1.TransitGateway))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return transitgateway.GenerateModifyTransitGatewayInput(mg.(*ec2v1alpha1.TransitGateway))
+		},
+	},
+	ec2v1alpha1.TransitGatewayRouteGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transitgatewayroute.GenerateCreateTransitGatewayRouteInput(mg.(*ec2v1alpha1.TransitGatewayRoute))
+		},
+	},
+	ec2v1alpha1.TransitGatewayRouteTableGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transitgatewayroutetable.GenerateCreateTransitGatewayRouteTableInput(mg.(*ec2v1alpha1.TransitGatewayRouteTable))
+		},
+	},
+	ec2v1alpha1.TransitGatewayVPCAttachmentGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transitgatewayvpcattachment.GenerateCreateTransitGatewayVpcAttachmentInput(mg.(*ec2v1alpha1.TransitGatewayVPCAttachment))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return transitgatewayvpcattachment.GenerateModifyTransitGatewayVpcAttachmentInput(mg.(*ec2v1alpha1.TransitGatewayVPCAttachment))
+		},
+	},
+	ec2v1alpha1.VPCEndpointGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return vpcendpoint.GenerateCreateVpcEndpointInput(mg.(*ec2v1alpha1.VPCEndpoint))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return vpcendpoint.GenerateModifyVpcEndpointInput(mg.(*ec2v1alpha1.VPCEndpoint))
+		},
+	},
+	ec2v1alpha1.VPCEndpointServiceConfigurationGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return vpcendpointserviceconfiguration.GenerateCreateVpcEndpointServiceConfigurationInput(mg.(*ec2v1alpha1.VPCEndpointServiceConfiguration))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return vpcendpointserviceconfiguration.GenerateModifyVpcEndpointServiceConfigurationInput(mg.(*ec2v1alpha1.VPCEndpointServiceConfiguration))
+		},
+	},
+	ec2v1alpha1.VPCPeeringConnectionGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return vpcpeeringconnection.GenerateCreateVpcPeeringConnectionInput(mg.(*ec2v1alpha1.VPCPeeringConnection))
+		},
+	},
+	ec2v1alpha1.VolumeGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return volume.GenerateCreateVolumeInput(mg.(*ec2v1alpha1.Volume))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return volume.GenerateModifyVolumeInput(mg.(*ec2v1alpha1.Volume))
+		},
+	},
+	efsv1alpha1.FileSystemGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return filesystem.GenerateCreateFileSystemInput(mg.(*efsv1alpha1.FileSystem))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return filesystem.GenerateUpdateFileSystemInput(mg.(*efsv1alpha1.FileSystem))
+		},
+	},
+	efsv1alpha1.MountTargetGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return efsmounttarget.GenerateCreateMountTargetInput(mg.(*efsv1alpha1.MountTarget))
+		},
+	},
+	eksv1alpha1.AddonGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return eksaddon.GenerateCreateAddonInput(mg.(*eksv1alpha1.Addon))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return eksaddon.GenerateUpdateAddonInput(mg.(*eksv1alpha1.Addon))
+		},
+	},
+	elasticachev1alpha1.CacheParameterGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return cacheparametergroup.GenerateCreateCacheParameterGroupInput(mg.(*elasticachev1alpha1.CacheParameterGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return cacheparametergroup.GenerateModifyCacheParameterGroupInput(mg.(*elasticachev1alpha1.CacheParameterGroup))
+		},
+	},
+	elbv2v1alpha1.ListenerGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return listener.GenerateCreateListenerInput(mg.(*elbv2v1alpha1.Listener))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return listener.GenerateModifyListenerInput(mg.(*elbv2v1alpha1.Listener))
+		},
+	},
+	elbv2v1alpha1.LoadBalancerGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return loadbalancer.GenerateCreateLoadBalancerInput(mg.(*elbv2v1alpha1.LoadBalancer))
+		},
+	},
+	elbv2v1alpha1.TargetGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return targetgroup.GenerateCreateTargetGroupInput(mg.(*elbv2v1alpha1.TargetGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return targetgroup.GenerateModifyTargetGroupInput(mg.(*elbv2v1alpha1.TargetGroup))
+		},
+	},
+	gluev1alpha1.ClassifierGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return glueclassifier.GenerateCreateClassifierInput(mg.(*gluev1alpha1.Classifier))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return glueclassifier.GenerateUpdateClassifierInput(mg.(*gluev1alpha1.Classifier))
+		},
+	},
+	gluev1alpha1.ConnectionGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return glueconnection.GenerateCreateConnectionInput(mg.(*gluev1alpha1.Connection))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return glueconnection.GenerateUpdateConnectionInput(mg.(*gluev1alpha1.Connection))
+		},
+	},
+	gluev1alpha1.CrawlerGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return gluecrawler.GenerateCreateCrawlerInput(mg.(*gluev1alpha1.Crawler))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return gluecrawler.GenerateUpdateCrawlerInput(mg.(*gluev1alpha1.Crawler))
+		},
+	},
+	gluev1alpha1.DatabaseGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return glueDatabase.GenerateCreateDatabaseInput(mg.(*gluev1alpha1.Database))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return glueDatabase.GenerateUpdateDatabaseInput(mg.(*gluev1alpha1.Database))
+		},
+	},
+	gluev1alpha1.JobGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return gluejob.GenerateCreateJobInput(mg.(*gluev1alpha1.Job))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return gluejob.GenerateUpdateJobInput(mg.(*gluev1alpha1.Job))
+		},
+	},
+	gluev1alpha1.SecurityConfigurationGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return gluesecurityconfiguration.GenerateCreateSecurityConfigurationInput(mg.(*gluev1alpha1.SecurityConfiguration))
+		},
+	},
+	iamv1alpha1.InstanceProfileGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return instanceprofile.GenerateCreateInstanceProfileInput(mg.(*iamv1alpha1.InstanceProfile))
+		},
+	},
+	iotv1alpha1.PolicyGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return iotpolicy.GenerateCreatePolicyInput(mg.(*iotv1alpha1.Policy))
+		},
+	},
+	iotv1alpha1.ThingGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return thing.GenerateCreateThingInput(mg.(*iotv1alpha1.Thing))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return thing.GenerateUpdateThingInput(mg.(*iotv1alpha1.Thing))
+		},
+	},
+	kafkav1alpha1.ClusterGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return kafkacluster.GenerateCreateClusterInput(mg.(*kafkav1alpha1.Cluster))
+		},
+	},
+	kafkav1alpha1.ConfigurationGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return kafkaconfiguration.GenerateCreateConfigurationInput(mg.(*kafkav1alpha1.Configuration))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return kafkaconfiguration.GenerateUpdateConfigurationInput(mg.(*kafkav1alpha1.Configuration))
+		},
+	},
+	kinesisv1alpha1.StreamGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return kinesisstream.GenerateCreateStreamInput(mg.(*kinesisv1alpha1.Stream))
+		},
+	},
+	kmsv1alpha1.AliasGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return alias.GenerateCreateAliasInput(mg.(*kmsv1alpha1.Alias))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return alias.GenerateUpdateAliasInput(mg.(*kmsv1alpha1.Alias))
+		},
+	},
+	kmsv1alpha1.KeyGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return key.GenerateCreateKeyInput(mg.(*kmsv1alpha1.Key))
+		},
+	},
+	lambdav1beta1.FunctionGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return function.GenerateCreateFunctionInput(mg.(*lambdav1beta1.Function))
+		},
+	},
+	neptunev1alpha1.DBClusterGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return neptunecluster.GenerateCreateDBClusterInput(mg.(*neptunev1alpha1.DBCluster))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return neptunecluster.GenerateModifyDBClusterInput(mg.(*neptunev1alpha1.DBCluster))
+		},
+	},
+	prometheusservice.WorkspaceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return prometheusserviceworkspace.GenerateCreateWorkspaceInput(mg.(*prometheusservice.Workspace))
+		},
+	},
+	ramv1alpha1.ResourceShareGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return resourceshare.GenerateCreateResourceShareInput(mg.(*ramv1alpha1.ResourceShare))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return resourceshare.GenerateUpdateResourceShareInput(mg.(*ramv1alpha1.ResourceShare))
+		},
+	},
+	rdsv1alpha1.DBClusterGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return dbcluster.GenerateCreateDBClusterInput(mg.(*rdsv1alpha1.DBCluster))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return dbcluster.GenerateModifyDBClusterInput(mg.(*rdsv1alpha1.DBCluster))
+		},
+	},
+	rdsv1alpha1.DBClusterParameterGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return dbclusterparametergroup.GenerateCreateDBClusterParameterGroupInput(mg.(*rdsv1alpha1.DBClusterParameterGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return dbclusterparametergroup.GenerateModifyDBClusterParameterGroupInput(mg.(*rdsv1alpha1.DBClusterParameterGroup))
+		},
+	},
+	rdsv1alpha1.DBInstanceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return dbinstance.GenerateCreateDBInstanceInput(mg.(*rdsv1alpha1.DBInstance))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return dbinstance.GenerateModifyDBInstanceInput(mg.(*rdsv1alpha1.DBInstance))
+		},
+	},
+	rdsv1alpha1.DBParameterGroupGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return dbparametergroup.GenerateCreateDBParameterGroupInput(mg.(*rdsv1alpha1.DBParameterGroup))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return dbparametergroup.GenerateModifyDBParameterGroupInput(mg.(*rdsv1alpha1.DBParameterGroup))
+		},
+	},
+	rdsv1alpha1.GlobalClusterGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return globalcluster.GenerateCreateGlobalClusterInput(mg.(*rdsv1alpha1.GlobalCluster))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return globalcluster.GenerateModifyGlobalClusterInput(mg.(*rdsv1alpha1.GlobalCluster))
+		},
+	},
+	route53resolverv1alpha1.ResolverEndpointGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return resolverendpoint.GenerateCreateResolverEndpointInput(mg.(*route53resolverv1alpha1.ResolverEndpoint))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return resolverendpoint.GenerateUpdateResolverEndpointInput(mg.(*route53resolverv1alpha1.ResolverEndpoint))
+		},
+	},
+	route53resolverv1alpha1.ResolverRuleGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return resolverrule.GenerateCreateResolverRuleInput(mg.(*route53resolverv1alpha1.ResolverRule))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return resolverrule.GenerateUpdateResolverRuleInput(mg.(*route53resolverv1alpha1.ResolverRule))
+		},
+	},
+	secretsmanagerv1beta1.SecretGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return secret.GenerateCreateSecretInput(mg.(*secretsmanagerv1beta1.Secret))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return secret.GenerateUpdateSecretInput(mg.(*secretsmanagerv1beta1.Secret))
+		},
+	},
+	servicediscoveryv1alpha1.HTTPNamespaceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return httpnamespace.GenerateCreateHttpNamespaceInput(mg.(*servicediscoveryv1alpha1.HTTPNamespace))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return httpnamespace.GenerateUpdateHttpNamespaceInput(mg.(*servicediscoveryv1alpha1.HTTPNamespace))
+		},
+	},
+	servicediscoveryv1alpha1.PrivateDNSNamespaceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return privatednsnamespace.GenerateCreatePrivateDnsNamespaceInput(mg.(*servicediscoveryv1alpha1.PrivateDNSNamespace))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return privatednsnamespace.GenerateUpdatePrivateDnsNamespaceInput(mg.(*servicediscoveryv1alpha1.PrivateDNSNamespace))
+		},
+	},
+	servicediscoveryv1alpha1.PublicDNSNamespaceGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return publicdnsnamespace.GenerateCreatePublicDnsNamespaceInput(mg.(*servicediscoveryv1alpha1.PublicDNSNamespace))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return publicdnsnamespace.GenerateUpdatePublicDnsNamespaceInput(mg.(*servicediscoveryv1alpha1.PublicDNSNamespace))
+		},
+	},
+	sfnv1alpha1.ActivityGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return activity.GenerateCreateActivityInput(mg.(*sfnv1alpha1.Activity))
+		},
+	},
+	sfnv1alpha1.StateMachineGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return statemachine.GenerateCreateStateMachineInput(mg.(*sfnv1alpha1.StateMachine))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return statemachine.GenerateUpdateStateMachineInput(mg.(*sfnv1alpha1.StateMachine))
+		},
+	},
+	transferv1alpha1.ServerGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transferserver.GenerateCreateServerInput(mg.(*transferv1alpha1.Server))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return transferserver.GenerateUpdateServerInput(mg.(*transferv1alpha1.Server))
+		},
+	},
+	transferv1alpha1.UserGroupVersionKind: {
+		Create: func(mg resource.Managed) interface{} {
+			return transferuser.GenerateCreateUserInput(mg.(*transferv1alpha1.User))
+		},
+		Update: func(mg resource.Managed) interface{} {
+			return transferuser.GenerateUpdateUserInput(mg.(*transferv1alpha1.User))
+		},
+	},
+}
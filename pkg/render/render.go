@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render renders the inputs of the AWS API operations controllers
+// would make for a managed resource, without calling AWS. This allows users
+// to validate how their spec is converted before applying it.
+package render
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go/aws/awsutil"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	errRead        = "cannot read manifest"
+	errDecode      = "cannot decode manifest"
+	errWrite       = "cannot write input"
+	errFmtNoRender = "cannot render %s: its controller does not generate its inputs from the spec alone"
+)
+
+// A Renderer renders the inputs of the create and update operations the
+// controller of a kind generates from the spec of a managed resource. Update
+// is nil if the controller doesn't generate an update input.
+type Renderer struct {
+	Create func(mg resource.Managed) interface{}
+	Update func(mg resource.Managed) interface{}
+}
+
+// An Input of an AWS API operation.
+type Input struct {
+	// Type is the name of the AWS SDK type of the input, e.g.
+	// CreateUserPoolClientInput.
+	Type string
+
+	// Value of the input.
+	Value interface{}
+}
+
+// Inputs returns the inputs of the create and update operations the
+// controller of the supplied managed resource would make.
+//
+// Inputs are rendered from the spec alone. Controllers may amend them when
+// they actually create or update the resource, e.g. with values they resolved
+// from AWS or from the status of the resource.
+func Inputs(mg resource.Managed) ([]Input, error) {
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	r, ok := kinds[gvk]
+	if !ok {
+		return nil, errors.Errorf(errFmtNoRender, gvk.Kind)
+	}
+	in := []Input{input(r.Create(mg))}
+	if r.Update != nil {
+		in = append(in, input(r.Update(mg)))
+	}
+	return in, nil
+}
+
+func input(v interface{}) Input {
+	return Input{Type: reflect.Indirect(reflect.ValueOf(v)).Type().Name(), Value: v}
+}
+
+// Render the inputs of the managed resources in the supplied stream of YAML
+// or JSON manifests to w. Manifests of objects that aren't managed resources,
+// e.g. ProviderConfigs, are skipped.
+func Render(s *runtime.Scheme, r io.Reader, w io.Writer) error {
+	d := serializer.NewCodecFactory(s).UniversalDeserializer()
+	yr := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		data, err := yr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errRead)
+		}
+		if len(bytes.TrimSpace(data)) == 0 {
+			continue
+		}
+		obj, gvk, err := d.Decode(data, nil, nil)
+		if runtime.IsNotRegisteredError(err) {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, errDecode)
+		}
+		mg, ok := obj.(resource.Managed)
+		if !ok {
+			continue
+		}
+		mg.GetObjectKind().SetGroupVersionKind(*gvk)
+		in, err := Inputs(mg)
+		if err != nil {
+			return err
+		}
+		for _, i := range in {
+			// Inputs are printed the way the AWS SDK prints them, which
+			// omits the fields that aren't set.
+			if _, err := fmt.Fprintf(w, "# %s %s: %s\n%s\n", gvk.Kind, mg.GetName(), i.Type, awsutil.Prettify(i.Value)); err != nil {
+				return errors.Wrap(err, errWrite)
+			}
+		}
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-aws/apis"
+)
+
+const providerConfig = `
+apiVersion: aws.crossplane.io/v1beta1
+kind: ProviderConfig
+metadata:
+  name: default
+spec:
+  credentials:
+    source: InjectedIdentity
+`
+
+const userPoolClient = `
+apiVersion: cognitoidentityprovider.aws.crossplane.io/v1alpha1
+kind: UserPoolClient
+metadata:
+  name: example
+spec:
+  forProvider:
+    region: us-east-1
+    clientName: example
+    callbackURLs:
+    - https://example.com/callback
+`
+
+const queue = `
+apiVersion: sqs.aws.crossplane.io/v1beta1
+kind: Queue
+metadata:
+  name: example
+spec:
+  forProvider:
+    region: us-east-1
+`
+
+func TestRender(t *testing.T) {
+	type want struct {
+		out string
+		err error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		manifest string
+		want     want
+	}{
+		"RenderCreateAndUpdate": {
+			reason:   "The create and update inputs of a managed resource should be rendered, skipping other objects.",
+			manifest: providerConfig + "---" + userPoolClient,
+			want: want{
+				out: `# UserPoolClient example: CreateUserPoolClientInput
+{
+  CallbackURLs: ["https://example.com/callback"],
+  ClientName: "example"
+}
+# UserPoolClient example: UpdateUserPoolClientInput
+{
+  CallbackURLs: ["https://example.com/callback"],
+  ClientName: "example"
+}
+`,
+			},
+		},
+		"NoRenderer": {
+			reason:   "Managed resources whose controllers don't generate their inputs should not be rendered.",
+			manifest: queue,
+			want: want{
+				err: errors.Errorf(errFmtNoRender, "Queue"),
+			},
+		},
+	}
+
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := Render(s, strings.NewReader(tc.manifest), out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nRender(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.out, out.String()); diff != "" {
+				t.Errorf("\n%s\nRender(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
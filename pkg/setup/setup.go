@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package setup configures the controllers of the provider. It carries the
+// provider wide controller configuration, like poll interval and concurrency
+// overrides and the controllers to set up, from the command line to each
+// controller and builds the managed resource reconcilers every controller
+// uses.
+package setup
+
+import (
+	"context"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlcontroller "sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-aws/pkg/backoff"
+	"github.com/crossplane/provider-aws/pkg/clients/accessanalyzer"
+	"github.com/crossplane/provider-aws/pkg/clients/dependency"
+	"github.com/crossplane/provider-aws/pkg/clients/dryrun"
+	"github.com/crossplane/provider-aws/pkg/clients/health"
+	"github.com/crossplane/provider-aws/pkg/clients/iam"
+	"github.com/crossplane/provider-aws/pkg/clients/operation"
+	"github.com/crossplane/provider-aws/pkg/clients/quota"
+	"github.com/crossplane/provider-aws/pkg/clients/requestlog"
+	"github.com/crossplane/provider-aws/pkg/concurrency"
+	"github.com/crossplane/provider-aws/pkg/controllerfilter"
+	"github.com/crossplane/provider-aws/pkg/pollinterval"
+	"github.com/crossplane/provider-aws/pkg/throttle"
+)
+
+// Options configure the controllers of the provider.
+type Options struct {
+	controller.Options
+
+	// PollIntervals overrides the poll interval of some API groups or kinds.
+	PollIntervals pollinterval.Overrides
+
+	// Transitioning is how often managed resources are polled while their
+	// external resources are transitioning between states. Zero disables
+	// transitional state hints.
+	Transitioning time.Duration
+
+	// Backoff backs off failures of retryable AWS API calls. Backing off is
+	// disabled if it is nil.
+	Backoff *backoff.Backoff
+
+	// Concurrency overrides the number of concurrent reconciles of some API
+	// groups or kinds.
+	Concurrency concurrency.Overrides
+
+	// Filter selects the controllers that are set up. All controllers are
+	// set up if it is nil.
+	Filter *controllerfilter.Filter
+
+	// Health reports the open AWS Health events affecting managed resources.
+	// Events are not reported if it is nil.
+	Health *health.Watcher
+
+	// PolicyValidator validates the policy documents of IAM policies and roles
+	// with IAM Access Analyzer before they are written. Policies are not
+	// validated if it is nil.
+	PolicyValidator *accessanalyzer.Validator
+
+	// Quota checks the AWS service quotas creating a managed resource counts
+	// against before it is created. Quotas are not checked if it is nil.
+	Quota *quota.Checker
+
+	// IAMPropagationTimeout is how long creating a resource that uses an IAM
+	// entity is retried while the entity has not propagated yet. Retrying is
+	// disabled if it is zero.
+	IAMPropagationTimeout time.Duration
+
+	// Throttle limits the rate of AWS API requests to each AWS account.
+	// Requests are not limited if it is nil.
+	Throttle *throttle.Limiter
+}
+
+// Enabled returns true if the controller with the supplied name, as returned
+// by managed.ControllerName, should be set up.
+func (o Options) Enabled(name string) bool {
+	return o.Filter.Enabled(name)
+}
+
+// ForController returns the controller-runtime options of the controller with
+// the supplied name, with its number of concurrent reconciles overridden, if
+// any.
+func (o Options) ForController(name string) ctrlcontroller.Options {
+	co := o.ForControllerRuntime()
+	co.MaxConcurrentReconciles = o.Concurrency.For(name, co.MaxConcurrentReconciles)
+	return co
+}
+
+// NewReconciler returns a managed resource reconciler for the controller with
+// the supplied name that connects to AWS using the supplied connecter. The
+// AWS API operations it makes are recorded, dry runs are honored, and managed
+// resources aren't deleted while managed resources that reference them
+// exist. It polls at the configured interval, logs and records events as the
+// controller, honors poll interval hints, backs off retryable AWS API
+// failures and is rate limited by the global rate limiter. Its AWS API
+// requests are logged at debug level and limited by the throttle. The
+// supplied options take precedence over these defaults.
+func (o Options) NewReconciler(mgr ctrl.Manager, name string, of resource.ManagedKind, c managed.ExternalConnecter, opts ...managed.ReconcilerOption) reconcile.Reconciler {
+	oc := operation.NewConnecter(c, operation.WithQuotaChecker(o.Quota), operation.WithHealthWatcher(o.Health))
+	ro := append([]managed.ReconcilerOption{
+		managed.WithExternalConnecter(dryrun.NewConnecter(dependency.NewConnecter(mgr.GetClient(), oc))),
+		managed.WithReferenceResolver(dependency.NewReferenceResolver(mgr.GetClient(), managed.NewAPISimpleReferenceResolver(mgr.GetClient()))),
+		managed.WithPollInterval(o.PollIntervals.For(name, o.PollInterval)),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+	}, opts...)
+	r := pollinterval.NewReconciler(o.withClients(name, managed.NewReconciler(mgr, of, ro...)), pollinterval.WithTransitioning(o.Transitioning))
+	return ratelimiter.NewReconciler(name, backoff.NewReconciler(r, backoff.WithBackoff(o.Backoff)), o.GlobalRateLimiter)
+}
+
+// withClients returns a reconciler that configures the AWS clients the
+// supplied reconciler uses through the context of each reconcile, so that
+// they are configured per controller rather than per process.
+func (o Options) withClients(name string, r reconcile.Reconciler) reconcile.Reconciler {
+	log := o.Logger.WithValues("controller", name)
+	return reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		ctx = requestlog.WithLogger(ctx, log)
+		ctx = throttle.WithLimiter(ctx, o.Throttle)
+		ctx = iam.WithPropagationTimeout(ctx, o.IAMPropagationTimeout)
+		return r.Reconcile(ctx, req)
+	})
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setup
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+
+	"github.com/crossplane/provider-aws/pkg/concurrency"
+	"github.com/crossplane/provider-aws/pkg/controllerfilter"
+)
+
+func TestEnabled(t *testing.T) {
+	f, err := controllerfilter.New(nil, []string{"ec2.aws.crossplane.io"})
+	if err != nil {
+		t.Fatalf("controllerfilter.New(...): %v", err)
+	}
+	cases := map[string]struct {
+		o    Options
+		name string
+		want bool
+	}{
+		"NoFilter": {
+			name: "managed/vpc.ec2.aws.crossplane.io",
+			want: true,
+		},
+		"Disabled": {
+			o:    Options{Filter: f},
+			name: "managed/vpc.ec2.aws.crossplane.io",
+			want: false,
+		},
+		"Enabled": {
+			o:    Options{Filter: f},
+			name: "managed/queue.sqs.aws.crossplane.io",
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, tc.o.Enabled(tc.name)); diff != "" {
+				t.Errorf("Enabled(%q): -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
+
+func TestForController(t *testing.T) {
+	o := Options{
+		Options:     controller.Options{MaxConcurrentReconciles: 10},
+		Concurrency: concurrency.Overrides{"ec2.aws.crossplane.io": 20},
+	}
+	cases := map[string]struct {
+		name string
+		want int
+	}{
+		"Overridden": {
+			name: "managed/vpc.ec2.aws.crossplane.io",
+			want: 20,
+		},
+		"Default": {
+			name: "managed/queue.sqs.aws.crossplane.io",
+			want: 10,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := o.ForController(tc.name).MaxConcurrentReconciles
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ForController(%q).MaxConcurrentReconciles: -want, +got:\n%s", tc.name, diff)
+			}
+		})
+	}
+}
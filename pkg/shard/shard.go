@@ -0,0 +1,322 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package shard distributes the controllers of the provider across replicas.
+// Controllers are assigned to shards by hashing their API group, and each
+// shard has its own leader election lease. A replica runs the controllers
+// of the shards it leads, so that several replicas reconcile concurrently
+// instead of a single leader reconciling everything.
+package shard
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+)
+
+// Lease timings, the same as the ones of the leader election of the
+// controller manager.
+const (
+	LeaseDuration = 60 * time.Second
+	RenewDeadline = 50 * time.Second
+	RetryPeriod   = 2 * time.Second
+)
+
+// Of returns the shard, out of the supplied number of shards, of the
+// controller with the supplied name, e.g. managed/queue.sqs.aws.crossplane.io.
+// Controllers of the same API group are always in the same shard, and
+// runnables without a name are in shard 0.
+func Of(name string, shards int) int {
+	if shards <= 1 || name == "" {
+		return 0
+	}
+	gk := strings.ToLower(name)
+	if i := strings.Index(gk, "/"); i >= 0 {
+		gk = gk[i+1:]
+	}
+	group := gk
+	if i := strings.Index(gk, "."); i >= 0 {
+		group = gk[i+1:]
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(group))
+	return int(h.Sum32() % uint32(shards))
+}
+
+// nameOf returns the name of the supplied controller-runtime controller.
+// Controllers don't expose their name through an interface, but the type
+// that implements them has an exported Name field.
+func nameOf(r manager.Runnable) string {
+	v := reflect.ValueOf(r)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+	f := v.FieldByName("Name")
+	if !f.IsValid() || f.Kind() != reflect.String {
+		return ""
+	}
+	return f.String()
+}
+
+// An ElectFn runs the leader election of the supplied shard until the
+// supplied context is done. It closes the returned channel when it starts
+// leading the shard.
+type ElectFn func(ctx context.Context, shard int) <-chan struct{}
+
+type shardedManager struct {
+	manager.Manager
+	shards int
+	elect  ElectFn
+	log    logging.Logger
+
+	mu      sync.Mutex
+	leading map[int]<-chan struct{}
+}
+
+// An Option configures the manager returned by WrapManager.
+type Option func(*shardedManager)
+
+// WithElectFn configures how the leader of a shard is elected.
+func WithElectFn(fn ElectFn) Option {
+	return func(m *shardedManager) { m.elect = fn }
+}
+
+// WithLogger configures the logger of the manager returned by WrapManager.
+func WithLogger(l logging.Logger) Option {
+	return func(m *shardedManager) { m.log = l }
+}
+
+// WrapManager returns a manager that only starts a controller once it leads
+// the controller's shard. Other runnables that need leader election have no
+// name and are in shard 0. The wrapped manager must not use leader election itself.
+func WrapManager(mgr manager.Manager, shards int, o ...Option) manager.Manager {
+	m := &shardedManager{
+		Manager: mgr,
+		shards:  shards,
+		log:     logging.NewNopLogger(),
+		leading: map[int]<-chan struct{}{},
+	}
+	for _, fn := range o {
+		fn(m)
+	}
+	return m
+}
+
+// Add the supplied runnable. Runnables that need leader election are started
+// once the shard they belong to is led.
+func (m *shardedManager) Add(r manager.Runnable) error {
+	if le, ok := r.(manager.LeaderElectionRunnable); ok && !le.NeedLeaderElection() {
+		return m.Manager.Add(r)
+	}
+	// The manager injects dependencies into the runnables added to it, which
+	// would otherwise not reach the wrapped one.
+	if err := m.Manager.SetFields(r); err != nil {
+		return err
+	}
+	name := nameOf(r)
+	return m.Manager.Add(&runnable{inner: r, name: name, shard: Of(name, m.shards), manager: m})
+}
+
+// led returns a channel that is closed when the supplied shard is led,
+// starting its leader election if necessary.
+func (m *shardedManager) led(ctx context.Context, shard int) <-chan struct{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if ch, ok := m.leading[shard]; ok {
+		return ch
+	}
+	ch := m.elect(ctx, shard)
+	m.leading[shard] = ch
+	return ch
+}
+
+type runnable struct {
+	inner   manager.Runnable
+	name    string
+	shard   int
+	manager *shardedManager
+}
+
+// NeedLeaderElection is false, because the runnable elects the leader of its
+// shard itself.
+func (r *runnable) NeedLeaderElection() bool {
+	return false
+}
+
+// Start the wrapped runnable once the shard it belongs to is led.
+func (r *runnable) Start(ctx context.Context) error {
+	select {
+	case <-r.manager.led(ctx, r.shard):
+	case <-ctx.Done():
+		return nil
+	}
+	r.manager.log.Debug("Starting controller of led shard", "controller", r.name, "shard", r.shard)
+	return r.inner.Start(ctx)
+}
+
+// An Elector elects the leaders of shards using a lease per shard.
+type Elector struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+	identity  string
+	log       logging.Logger
+	onLost    func()
+
+	// A replica that leads as many shards as it may gives up the lease of
+	// any other shard it acquires, so that another replica can lead it.
+	capacity chan struct{}
+}
+
+// An ElectorOption configures an Elector.
+type ElectorOption func(*Elector)
+
+// WithMaxLeases limits the number of shards a replica leads. Unlimited if
+// zero.
+func WithMaxLeases(n int) ElectorOption {
+	return func(e *Elector) {
+		if n > 0 {
+			e.capacity = make(chan struct{}, n)
+		}
+	}
+}
+
+// WithElectorLogger configures the logger of an Elector.
+func WithElectorLogger(l logging.Logger) ElectorOption {
+	return func(e *Elector) { e.log = l }
+}
+
+// WithOnLost configures what an Elector does when it loses the lease of a
+// shard it leads, after logging it. Its controllers can't be stopped
+// individually, so it exits the process by default, like the controller
+// manager does when it loses its lease.
+func WithOnLost(fn func()) ElectorOption {
+	return func(e *Elector) { e.onLost = fn }
+}
+
+// NewElector returns an Elector that elects the leaders of shards using
+// leases with the supplied name prefix, in the supplied namespace.
+func NewElector(cfg *rest.Config, namespace, name string, o ...ElectorOption) (*Elector, error) {
+	cs, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create Kubernetes client")
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get hostname")
+	}
+	e := &Elector{
+		client:    cs,
+		namespace: namespace,
+		name:      name,
+		identity:  host + "_" + string(uuid.NewUUID()),
+		log:       logging.NewNopLogger(),
+		onLost:    func() { os.Exit(1) },
+	}
+	for _, fn := range o {
+		fn(e)
+	}
+	return e, nil
+}
+
+// Elect the leader of the supplied shard until the supplied context is done.
+// The returned channel is closed when this replica starts leading it.
+func (e *Elector) Elect(ctx context.Context, shard int) <-chan struct{} {
+	ch := make(chan struct{})
+	go e.run(ctx, shard, ch)
+	return ch
+}
+
+func (e *Elector) run(ctx context.Context, shard int, leading chan struct{}) {
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta:  metav1.ObjectMeta{Namespace: e.namespace, Name: fmt.Sprintf("%s-shard-%d", e.name, shard)},
+		Client:     e.client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{Identity: e.identity},
+	}
+	for ctx.Err() == nil {
+		lctx, cancel := context.WithCancel(ctx)
+		led := false
+		le, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+			Lock:            lock,
+			LeaseDuration:   LeaseDuration,
+			RenewDeadline:   RenewDeadline,
+			RetryPeriod:     RetryPeriod,
+			ReleaseOnCancel: true,
+			Name:            lock.LeaseMeta.Name,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(context.Context) {
+					if e.capacity != nil {
+						select {
+						case e.capacity <- struct{}{}:
+						default:
+							e.log.Debug("Releasing shard lease, leading as many shards as allowed", "shard", shard)
+							cancel()
+							return
+						}
+					}
+					led = true
+					e.log.Debug("Leading shard", "shard", shard)
+					close(leading)
+				},
+				OnStoppedLeading: func() {
+					if led && ctx.Err() == nil {
+						e.log.Info("Shard leader election lost", "shard", shard)
+						e.onLost()
+					}
+				},
+			},
+		})
+		if err != nil {
+			cancel()
+			e.log.Info("Cannot elect shard leader", "shard", shard, "error", err)
+			return
+		}
+		le.Run(lctx)
+		cancel()
+		if led {
+			return
+		}
+		// Give other replicas a lease duration to acquire the released
+		// lease, with jitter so that replicas don't retry in lockstep.
+		select {
+		case <-ctx.Done():
+		case <-time.After(LeaseDuration + time.Duration(rand.Int63n(int64(LeaseDuration)))): // nolint:gosec
+		}
+	}
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shard
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestOf(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		a, b   string
+		shards int
+		same   bool
+	}{
+		"SameGroup": {
+			reason: "Controllers of the same API group should be in the same shard.",
+			a:      "managed/queue.sqs.aws.crossplane.io",
+			b:      "managed/Queue.sqs.aws.crossplane.io",
+			shards: 7,
+			same:   true,
+		},
+		"SingleShard": {
+			reason: "All controllers should be in shard 0 if there is a single shard.",
+			a:      "managed/queue.sqs.aws.crossplane.io",
+			b:      "managed/bucket.s3.aws.crossplane.io",
+			shards: 1,
+			same:   true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			a, b := Of(tc.a, tc.shards), Of(tc.b, tc.shards)
+			if a < 0 || a >= tc.shards {
+				t.Errorf("\n%s\nOf(%q, %d): got %d, want shard in [0, %d)", tc.reason, tc.a, tc.shards, a, tc.shards)
+			}
+			if diff := cmp.Diff(tc.same, a == b); diff != "" {
+				t.Errorf("\n%s\nOf(...) == Of(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A fakeManager records the runnables added to it.
+type fakeManager struct {
+	manager.Manager
+	added []manager.Runnable
+}
+
+func (m *fakeManager) Add(r manager.Runnable) error {
+	m.added = append(m.added, r)
+	return nil
+}
+
+func (m *fakeManager) SetFields(interface{}) error { return nil }
+
+// A fakeController is named like the controllers of controller-runtime.
+type fakeController struct {
+	Name    string
+	started chan struct{}
+}
+
+func (c *fakeController) Start(ctx context.Context) error {
+	close(c.started)
+	<-ctx.Done()
+	return nil
+}
+
+type otherRunnable struct{ manager.Runnable }
+
+func (otherRunnable) NeedLeaderElection() bool { return false }
+
+func TestWrapManager(t *testing.T) {
+	const shards = 4
+	name := "managed/queue.sqs.aws.crossplane.io"
+
+	leading := map[int]chan struct{}{}
+	for i := 0; i < shards; i++ {
+		leading[i] = make(chan struct{})
+	}
+	var mu sync.Mutex
+	elected := map[int]int{}
+	elect := func(_ context.Context, shard int) <-chan struct{} {
+		mu.Lock()
+		defer mu.Unlock()
+		elected[shard]++
+		return leading[shard]
+	}
+
+	fm := &fakeManager{}
+	m := WrapManager(fm, shards, WithElectFn(elect))
+
+	// Runnables that don't need leader election should be added as is.
+	other := otherRunnable{}
+	if err := m.Add(other); err != nil {
+		t.Fatalf("Add(...): %s", err)
+	}
+	c1 := &fakeController{Name: name, started: make(chan struct{})}
+	c2 := &fakeController{Name: name, started: make(chan struct{})}
+	for _, c := range []*fakeController{c1, c2} {
+		if err := m.Add(c); err != nil {
+			t.Fatalf("Add(...): %s", err)
+		}
+	}
+	if diff := cmp.Diff(3, len(fm.added)); diff != "" {
+		t.Fatalf("Add(...): -want runnables, +got runnables:\n%s", diff)
+	}
+	if fm.added[0] != manager.Runnable(other) {
+		t.Errorf("Add(...): runnable that doesn't need leader election was wrapped")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for _, r := range fm.added[1:] {
+		if le, ok := r.(manager.LeaderElectionRunnable); !ok || le.NeedLeaderElection() {
+			t.Errorf("Add(...): wrapped runnable should not need the leader election of the manager")
+		}
+		go func(r manager.Runnable) { _ = r.Start(ctx) }(r)
+	}
+
+	// Controllers should not start before their shard is led.
+	select {
+	case <-c1.started:
+		t.Fatalf("Start(...): controller started before its shard was led")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	shard := Of(name, shards)
+	close(leading[shard])
+	for _, c := range []*fakeController{c1, c2} {
+		select {
+		case <-c.started:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Start(...): controller didn't start after its shard was led")
+		}
+	}
+
+	// The leader of a shard should only be elected once.
+	mu.Lock()
+	defer mu.Unlock()
+	if diff := cmp.Diff(map[int]int{shard: 1}, elected); diff != "" {
+		t.Errorf("Start(...): -want elections, +got elections:\n%s", diff)
+	}
+}
+
+// An unnamedRunnable needs leader election but is not a controller.
+type unnamedRunnable struct {
+	started chan struct{}
+}
+
+func (r *unnamedRunnable) Start(ctx context.Context) error {
+	close(r.started)
+	<-ctx.Done()
+	return nil
+}
+
+func TestWrapManagerUnnamed(t *testing.T) {
+	const shards = 4
+
+	leading := map[int]chan struct{}{}
+	for i := 0; i < shards; i++ {
+		leading[i] = make(chan struct{})
+	}
+	elect := func(_ context.Context, shard int) <-chan struct{} {
+		return leading[shard]
+	}
+
+	fm := &fakeManager{}
+	m := WrapManager(fm, shards, WithElectFn(elect))
+	u := &unnamedRunnable{started: make(chan struct{})}
+	if err := m.Add(u); err != nil {
+		t.Fatalf("Add(...): %s", err)
+	}
+	if diff := cmp.Diff(1, len(fm.added)); diff != "" {
+		t.Fatalf("Add(...): -want runnables, +got runnables:\n%s", diff)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = fm.added[0].Start(ctx) }()
+
+	// Unnamed runnables should only start once shard 0 is led.
+	for i := 1; i < shards; i++ {
+		close(leading[i])
+	}
+	select {
+	case <-u.started:
+		t.Fatalf("Start(...): unnamed runnable started before shard 0 was led")
+	case <-time.After(50 * time.Millisecond):
+	}
+	close(leading[0])
+	select {
+	case <-u.started:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("Start(...): unnamed runnable didn't start after shard 0 was led")
+	}
+}
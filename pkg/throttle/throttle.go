@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle limits the rate at which the provider makes AWS API
+// requests to each AWS account. AWS throttles API requests per account, so a
+// provider that manages resources in many accounts keeps a separate budget
+// for each of them: an account with thousands of resources is throttled by
+// the provider before AWS throttles it, without slowing down requests to the
+// other accounts.
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// idleTimeout is how long the budget of an account that no requests were
+// made to is kept.
+const idleTimeout = time.Hour
+
+var delayed = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "provider_aws_account_throttle_delayed_total",
+	Help: "Total number of AWS API requests to an account that were delayed to stay within its request budget.",
+}, []string{"account"})
+
+func init() {
+	metrics.Registry.MustRegister(delayed)
+}
+
+type bucket struct {
+	limiter *rate.Limiter
+	used    time.Time
+}
+
+// A Limiter limits the rate of AWS API requests to each AWS account.
+type Limiter struct {
+	rps   float64
+	burst int
+	now   func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	pruned  time.Time
+}
+
+// An Option configures a Limiter.
+type Option func(*Limiter)
+
+// WithBurst configures how many requests may be made to an account at once
+// after it was idle.
+func WithBurst(n int) Option {
+	return func(l *Limiter) { l.burst = n }
+}
+
+// WithClock configures the clock of the Limiter.
+func WithClock(now func() time.Time) Option {
+	return func(l *Limiter) { l.now = now }
+}
+
+// NewLimiter returns a Limiter that allows the supplied average number of
+// requests per second to each account, with bursts of up to as many.
+func NewLimiter(rps float64, o ...Option) *Limiter {
+	l := &Limiter{
+		rps:     rps,
+		burst:   int(rps),
+		now:     time.Now,
+		buckets: map[string]*bucket{},
+	}
+	for _, fn := range o {
+		fn(l)
+	}
+	if l.burst < 1 {
+		l.burst = 1
+	}
+	return l
+}
+
+// Delay reserves a request to the supplied account and returns how long to
+// wait before making it.
+func (l *Limiter) Delay(account string) time.Duration {
+	now := l.now()
+	d := l.bucket(account, now).ReserveN(now, 1).DelayFrom(now)
+	if d > 0 {
+		delayed.WithLabelValues(account).Inc()
+	}
+	return d
+}
+
+// Wait until a request may be made to the supplied account, or the supplied
+// context is done.
+func (l *Limiter) Wait(ctx context.Context, account string) error {
+	d := l.Delay(account)
+	if d == 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Accounts returns the number of accounts the Limiter keeps a budget for.
+func (l *Limiter) Accounts() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return len(l.buckets)
+}
+
+func (l *Limiter) bucket(account string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.pruned) > idleTimeout {
+		for k, b := range l.buckets {
+			if now.Sub(b.used) > idleTimeout {
+				delete(l.buckets, k)
+			}
+		}
+		l.pruned = now
+	}
+
+	b, ok := l.buckets[account]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.rps), l.burst)}
+		l.buckets[account] = b
+	}
+	b.used = now
+	return b.limiter
+}
+
+type contextKey struct{}
+
+// WithLimiter returns a copy of the supplied context whose AWS API requests
+// are limited by the supplied Limiter, as used by HandlerV1 and
+// AddMiddlewareV2. Requests are not limited unless their context has a
+// Limiter.
+func WithLimiter(ctx context.Context, l *Limiter) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+func wait(ctx context.Context, account string) error {
+	l, _ := ctx.Value(contextKey{}).(*Limiter)
+	if l == nil {
+		return nil
+	}
+	return l.Wait(ctx, account)
+}
+
+// HandlerV1 returns a handler that waits until an AWS SDK v1 request may be
+// made to the supplied account. It must be added to the front of the Sign
+// handlers of a session, which run before every attempt of a request, so
+// that retries wait too and requests are signed once they may be made.
+func HandlerV1(account string) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "crossplane.AccountThrottle",
+		Fn: func(r *request.Request) {
+			if err := wait(r.Context(), account); err != nil {
+				r.Error = err
+			}
+		},
+	}
+}
+
+// AddMiddlewareV2 returns a function that adds a middleware to the supplied
+// AWS SDK v2 stack that waits until a request may be made to the supplied
+// account. It's added after the retry middleware, so that retries of a
+// request wait too.
+func AddMiddlewareV2(account string) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Finalize.Add(middleware.FinalizeMiddlewareFunc("crossplane.AccountThrottle",
+			func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+				if err := wait(ctx, account); err != nil {
+					return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+				}
+				return next.HandleFinalize(ctx, in)
+			}), middleware.After)
+	}
+}
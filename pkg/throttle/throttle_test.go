@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDelay(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	type request struct {
+		account string
+		want    time.Duration
+	}
+	cases := map[string]struct {
+		reason   string
+		l        *Limiter
+		requests []request
+	}{
+		"WithinBurst": {
+			reason:   "Requests within the burst of an account should not be delayed.",
+			l:        NewLimiter(2, WithClock(clock)),
+			requests: []request{{"a", 0}, {"a", 0}},
+		},
+		"BeyondBurst": {
+			reason:   "Requests beyond the burst of an account should be delayed until its budget allows them.",
+			l:        NewLimiter(2, WithClock(clock)),
+			requests: []request{{"a", 0}, {"a", 0}, {"a", 500 * time.Millisecond}, {"a", time.Second}},
+		},
+		"PerAccount": {
+			reason:   "Requests to an account should not use the budget of another.",
+			l:        NewLimiter(1, WithClock(clock)),
+			requests: []request{{"a", 0}, {"a", time.Second}, {"b", 0}, {"b", time.Second}},
+		},
+		"Burst": {
+			reason:   "The burst should be configurable.",
+			l:        NewLimiter(1, WithClock(clock), WithBurst(3)),
+			requests: []request{{"a", 0}, {"a", 0}, {"a", 0}, {"a", time.Second}},
+		},
+		"SlowerThanOncePerSecond": {
+			reason:   "The burst should be at least one request.",
+			l:        NewLimiter(0.5, WithClock(clock)),
+			requests: []request{{"a", 0}, {"a", 2 * time.Second}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			for i, r := range tc.requests {
+				if diff := cmp.Diff(r.want, tc.l.Delay(r.account)); diff != "" {
+					t.Errorf("\n%s\nDelay(%q) #%d: -want, +got:\n%s", tc.reason, r.account, i, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestWait(t *testing.T) {
+	l := NewLimiter(0.001)
+	if err := l.Wait(context.Background(), "a"); err != nil {
+		t.Errorf("Wait(...): first request: %s", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if diff := cmp.Diff(context.Canceled, l.Wait(ctx, "a"), cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("Wait(...): request beyond budget: -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestWaitContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := wait(ctx, "a"); err != nil {
+		t.Errorf("wait(...): no limiter: %s", err)
+	}
+
+	l := NewLimiter(0.001)
+	if err := wait(WithLimiter(context.Background(), l), "a"); err != nil {
+		t.Errorf("wait(...): first request: %s", err)
+	}
+	if diff := cmp.Diff(context.Canceled, wait(WithLimiter(ctx, l), "a"), cmpopts.EquateErrors()); diff != "" {
+		t.Errorf("wait(...): request beyond budget: -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewLimiter(1, WithClock(func() time.Time { return now }))
+
+	l.Delay("a")
+	now = now.Add(2 * idleTimeout)
+	l.Delay("b")
+
+	if diff := cmp.Diff(1, l.Accounts()); diff != "" {
+		t.Errorf("Accounts(): the budgets of idle accounts should be dropped: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package warmup contains a rate limiter that reconciles managed resources in
+// dependency order when the provider starts.
+package warmup
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// A Tier of managed resources. Resources of a tier usually only depend on
+// resources of lower tiers.
+type Tier int
+
+// Tiers of managed resources, in the order they're reconciled during warm-up.
+const (
+	TierNetworking Tier = iota
+	TierIdentity
+	TierDataStores
+	TierWorkloads
+)
+
+// tiers maps API groups to their tier. Groups that aren't listed are
+// workloads.
+var tiers = map[string]Tier{
+	"ec2.aws.crossplane.io":                  TierNetworking,
+	"elasticloadbalancing.aws.crossplane.io": TierNetworking,
+	"elbv2.aws.crossplane.io":                TierNetworking,
+	"route53.aws.crossplane.io":              TierNetworking,
+	"route53resolver.aws.crossplane.io":      TierNetworking,
+	"servicediscovery.aws.crossplane.io":     TierNetworking,
+
+	"acm.aws.crossplane.io":                     TierIdentity,
+	"acmpca.aws.crossplane.io":                  TierIdentity,
+	"cognitoidentity.aws.crossplane.io":         TierIdentity,
+	"cognitoidentityprovider.aws.crossplane.io": TierIdentity,
+	"iam.aws.crossplane.io":                     TierIdentity,
+	"kms.aws.crossplane.io":                     TierIdentity,
+	"ram.aws.crossplane.io":                     TierIdentity,
+	"secretsmanager.aws.crossplane.io":          TierIdentity,
+
+	"athena.aws.crossplane.io":         TierDataStores,
+	"cache.aws.crossplane.io":          TierDataStores,
+	"cloudsearch.aws.crossplane.io":    TierDataStores,
+	"cloudwatchlogs.aws.crossplane.io": TierDataStores,
+	"database.aws.crossplane.io":       TierDataStores,
+	"docdb.aws.crossplane.io":          TierDataStores,
+	"dynamodb.aws.crossplane.io":       TierDataStores,
+	"ecr.aws.crossplane.io":            TierDataStores,
+	"efs.aws.crossplane.io":            TierDataStores,
+	"elasticache.aws.crossplane.io":    TierDataStores,
+	"glue.aws.crossplane.io":           TierDataStores,
+	"kafka.aws.crossplane.io":          TierDataStores,
+	"kinesis.aws.crossplane.io":        TierDataStores,
+	"mq.aws.crossplane.io":             TierDataStores,
+	"neptune.aws.crossplane.io":        TierDataStores,
+	"notification.aws.crossplane.io":   TierDataStores,
+	"rds.aws.crossplane.io":            TierDataStores,
+	"redshift.aws.crossplane.io":       TierDataStores,
+	"s3.aws.crossplane.io":             TierDataStores,
+	"sns.aws.crossplane.io":            TierDataStores,
+	"sqs.aws.crossplane.io":            TierDataStores,
+}
+
+// kindTiers overrides the tier of the group for some kinds, e.g. EC2
+// instances run in a VPC rather than being part of it.
+var kindTiers = map[string]Tier{
+	"instance.ec2.aws.crossplane.io": TierWorkloads,
+}
+
+// TierOf returns the tier of the supplied lower case group kind, e.g.
+// vpc.ec2.aws.crossplane.io.
+func TierOf(groupKind string) Tier {
+	if t, ok := kindTiers[groupKind]; ok {
+		return t
+	}
+	i := strings.Index(groupKind, ".")
+	if t, ok := tiers[groupKind[i+1:]]; ok {
+		return t
+	}
+	return TierWorkloads
+}
+
+// A RateLimiter holds back reconciles of higher tiers for a while after the
+// provider starts, so that e.g. a subnet isn't reconciled before its VPC
+// exists, and a database isn't reconciled before its subnet group exists.
+// Without it, a cold start of a large fleet reconciles resources in random
+// order and many of them fail on references that aren't resolvable yet,
+// which costs AWS API calls and backoff time.
+//
+// Warm-up starts with the first reconcile, i.e. once the provider became the
+// leader, and opens one tier per stage. Held reconciles of a tier are
+// released at the supplied rate once their tier opens, so they don't hit the
+// API all at once. Reconciles of open tiers, and all reconciles after
+// warm-up, are subject to the wrapped rate limiter.
+//
+// A RateLimiter is meant to be used as the global rate limiter of a
+// crossplane-runtime ratelimiter.Reconciler.
+type RateLimiter struct {
+	workqueue.RateLimiter
+
+	stage    time.Duration
+	interval time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	start time.Time
+	held  map[Tier]int
+}
+
+// An Option configures a RateLimiter.
+type Option func(*RateLimiter)
+
+// WithClock configures the clock of the RateLimiter.
+func WithClock(now func() time.Time) Option {
+	return func(r *RateLimiter) { r.now = now }
+}
+
+// NewRateLimiter returns a RateLimiter that wraps the supplied rate limiter.
+// It opens one tier per stage and releases held reconciles at the supplied
+// rate per second. Rates below one are raised to one reconcile per second.
+func NewRateLimiter(rl workqueue.RateLimiter, rps int, stage time.Duration, o ...Option) *RateLimiter {
+	if rps < 1 {
+		rps = 1
+	}
+	r := &RateLimiter{
+		RateLimiter: rl,
+		stage:       stage,
+		interval:    time.Second / time.Duration(rps),
+		now:         time.Now,
+		held:        map[Tier]int{},
+	}
+	for _, fn := range o {
+		fn(r)
+	}
+	return r
+}
+
+// When returns how long the supplied item must wait before it's reconciled.
+func (r *RateLimiter) When(item interface{}) time.Duration {
+	now := r.now()
+	t := TierOf(kindOf(item))
+
+	r.mu.Lock()
+	if r.start.IsZero() {
+		r.start = now
+	}
+	open := r.start.Add(time.Duration(t) * r.stage)
+	if !now.Before(open) {
+		r.mu.Unlock()
+		return r.RateLimiter.When(item)
+	}
+	release := open.Add(time.Duration(r.held[t]) * r.interval)
+	r.held[t]++
+	r.mu.Unlock()
+
+	return release.Sub(now)
+}
+
+// kindOf returns the group kind of the supplied item. Items are the name of a
+// controller followed by the namespace and name of the reconciled resource,
+// e.g. managed/queue.sqs.aws.crossplane.io/example.
+func kindOf(item interface{}) string {
+	s, _ := item.(string)
+	if i := strings.LastIndex(s, "/"); i > 0 {
+		s = s[:i]
+	}
+	if i := strings.Index(s, "/"); i >= 0 {
+		s = s[i+1:]
+	}
+	return s
+}
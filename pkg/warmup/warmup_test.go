@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/util/workqueue"
+)
+
+const (
+	vpc      = "managed/vpc.ec2.aws.crossplane.io/example"
+	instance = "managed/instance.ec2.aws.crossplane.io/example"
+	role     = "managed/role.iam.aws.crossplane.io/example"
+	database = "managed/rdsinstance.database.aws.crossplane.io/example"
+	function = "managed/function.lambda.aws.crossplane.io/example"
+)
+
+// A fixed rate limiter delays every item by the same duration.
+type fixed struct {
+	workqueue.RateLimiter
+	d time.Duration
+}
+
+func (f fixed) When(_ interface{}) time.Duration { return f.d }
+
+func TestTierOf(t *testing.T) {
+	cases := map[string]struct {
+		item string
+		want Tier
+	}{
+		"Networking": {item: vpc, want: TierNetworking},
+		"Identity":   {item: role, want: TierIdentity},
+		"DataStore":  {item: database, want: TierDataStores},
+		"Workload":   {item: function, want: TierWorkloads},
+		"KindOverridesGroup": {
+			item: instance,
+			want: TierWorkloads,
+		},
+		"UnknownGroup": {
+			item: "managed/thing.unknown.aws.crossplane.io/example",
+			want: TierWorkloads,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, TierOf(kindOf(tc.item))); diff != "" {
+				t.Errorf("TierOf(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWhen(t *testing.T) {
+	start := time.Now()
+
+	cases := map[string]struct {
+		reason string
+		run    func(r *RateLimiter, now *time.Time) time.Duration
+		want   time.Duration
+	}{
+		"LowestTierIsNotHeld": {
+			reason: "Reconciles of the lowest tier should only be subject to the wrapped rate limiter.",
+			run: func(r *RateLimiter, _ *time.Time) time.Duration {
+				return r.When(vpc)
+			},
+			want: time.Millisecond,
+		},
+		"HigherTierIsHeld": {
+			reason: "Reconciles of a higher tier should be held until their tier opens.",
+			run: func(r *RateLimiter, _ *time.Time) time.Duration {
+				r.When(vpc)
+				return r.When(database)
+			},
+			want: 2 * time.Minute,
+		},
+		"HeldReconcilesArePaced": {
+			reason: "Held reconciles of a tier should be released at the supplied rate.",
+			run: func(r *RateLimiter, _ *time.Time) time.Duration {
+				r.When(role)
+				r.When(role)
+				return r.When(role)
+			},
+			want: time.Minute + 2*100*time.Millisecond,
+		},
+		"OpenTierIsNotHeld": {
+			reason: "Reconciles of a tier should not be held once it opened.",
+			run: func(r *RateLimiter, now *time.Time) time.Duration {
+				r.When(vpc)
+				*now = now.Add(time.Minute)
+				return r.When(role)
+			},
+			want: time.Millisecond,
+		},
+		"WarmUpStartsWithFirstReconcile": {
+			reason: "Warm-up should start with the first reconcile, not when the rate limiter is created.",
+			run: func(r *RateLimiter, now *time.Time) time.Duration {
+				*now = now.Add(time.Hour)
+				return r.When(function)
+			},
+			want: 3 * time.Minute,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := start
+			r := NewRateLimiter(fixed{d: time.Millisecond}, 10, time.Minute, WithClock(func() time.Time { return now }))
+			if diff := cmp.Diff(tc.want, tc.run(r, &now)); diff != "" {
+				t.Errorf("\n%s\nWhen(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewRateLimiterInvalidRate(t *testing.T) {
+	now := time.Now()
+	r := NewRateLimiter(fixed{d: time.Millisecond}, 0, time.Minute, WithClock(func() time.Time { return now }))
+	r.When(role)
+	if diff := cmp.Diff(time.Minute+time.Second, r.When(role)); diff != "" {
+		t.Errorf("When(...): held reconciles should be released once per second if the rate is not positive: -want, +got:\n%s", diff)
+	}
+}